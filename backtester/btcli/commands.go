@@ -3,7 +3,9 @@ package main
 import (
 	"errors"
 	"fmt"
+	"math"
 	"path/filepath"
+	"strconv"
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/backtester/btrpc"
@@ -622,3 +624,188 @@ func executeStrategyFromConfig(c *cli.Context) error {
 	jsonOutput(result)
 	return nil
 }
+
+var executeSweepFromFileCommand = &cli.Command{
+	Name:      "executesweepfromfile",
+	Usage:     "runs a parameter sweep from a sweep config file in the background",
+	ArgsUsage: "<path>",
+	Action:    executeSweepFromFile,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:    "path",
+			Aliases: []string{"p"},
+			Usage:   "the filepath to a sweep config to execute",
+		},
+	},
+}
+
+func executeSweepFromFile(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	var path string
+	if c.IsSet("path") {
+		path = c.String("path")
+	} else {
+		path = c.Args().First()
+	}
+
+	client := btrpc.NewBacktesterServiceClient(conn)
+	result, err := client.ExecuteSweepFromFile(
+		c.Context,
+		&btrpc.ExecuteSweepFromFileRequest{
+			SweepFilePath: path,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var listAllSweepsCommand = &cli.Command{
+	Name:   "listallsweeps",
+	Usage:  "returns a list of all parameter sweeps and their progress",
+	Action: listAllSweeps,
+}
+
+func listAllSweeps(c *cli.Context) error {
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := btrpc.NewBacktesterServiceClient(conn)
+	result, err := client.ListAllSweeps(
+		c.Context,
+		&btrpc.ListAllSweepsRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var getSweepResultsCommand = &cli.Command{
+	Name:      "getsweepresults",
+	Usage:     "returns the progress and ranked results of a parameter sweep",
+	ArgsUsage: "<id> <maximumresults>",
+	Action:    getSweepResults,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "id",
+			Usage: "the id of the parameter sweep",
+		},
+		&cli.UintFlag{
+			Name:    "maximumresults",
+			Aliases: []string{"m"},
+			Usage:   "the maximum amount of ranked results to return per sweep or walk-forward window. 0 returns all results",
+		},
+	},
+}
+
+func getSweepResults(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	var id string
+	if c.IsSet("id") {
+		id = c.String("id")
+	} else {
+		id = c.Args().First()
+	}
+
+	var maximumResults uint64
+	if c.IsSet("maximumresults") {
+		maximumResults = uint64(c.Uint("maximumresults"))
+	} else if c.Args().Get(1) != "" {
+		var err error
+		maximumResults, err = strconv.ParseUint(c.Args().Get(1), 10, 32)
+		if err != nil {
+			return err
+		}
+	}
+	if maximumResults > math.MaxUint32 {
+		return fmt.Errorf("maximum results cannot exceed %v", uint32(math.MaxUint32))
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := btrpc.NewBacktesterServiceClient(conn)
+	result, err := client.GetSweepResults(
+		c.Context,
+		&btrpc.GetSweepResultsRequest{
+			Id:             id,
+			MaximumResults: uint32(maximumResults),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var clearSweepCommand = &cli.Command{
+	Name:      "clearsweep",
+	Usage:     "clears/deletes a parameter sweep from the server - if it is not running",
+	ArgsUsage: "<id>",
+	Action:    clearSweep,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "id",
+			Usage: "the id of the parameter sweep",
+		},
+	},
+}
+
+func clearSweep(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	var id string
+	if c.IsSet("id") {
+		id = c.String("id")
+	} else {
+		id = c.Args().First()
+	}
+
+	client := btrpc.NewBacktesterServiceClient(conn)
+	result, err := client.ClearSweep(
+		c.Context,
+		&btrpc.ClearSweepRequest{
+			Id: id,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
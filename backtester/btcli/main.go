@@ -120,6 +120,10 @@ func main() {
 		stopAllTasksCommand,
 		clearTaskCommand,
 		clearAllTasksCommand,
+		executeSweepFromFileCommand,
+		listAllSweepsCommand,
+		getSweepResultsCommand,
+		clearSweepCommand,
 	}
 
 	ctx, cancel := context.WithCancel(context.Background())
@@ -1,6 +1,6 @@
 // Code generated by protoc-gen-go-grpc. DO NOT EDIT.
 // versions:
-// - protoc-gen-go-grpc v1.4.0
+// - protoc-gen-go-grpc v1.5.1
 // - protoc             (unknown)
 // source: btrpc.proto
 
@@ -15,8 +15,8 @@ import (
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the grpc package it is being compiled against.
-// Requires gRPC-Go v1.62.0 or later.
-const _ = grpc.SupportPackageIsVersion8
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
 
 const (
 	BacktesterService_ExecuteStrategyFromFile_FullMethodName   = "/btrpc.BacktesterService/ExecuteStrategyFromFile"
@@ -28,6 +28,10 @@ const (
 	BacktesterService_StopAllTasks_FullMethodName              = "/btrpc.BacktesterService/StopAllTasks"
 	BacktesterService_ClearTask_FullMethodName                 = "/btrpc.BacktesterService/ClearTask"
 	BacktesterService_ClearAllTasks_FullMethodName             = "/btrpc.BacktesterService/ClearAllTasks"
+	BacktesterService_ExecuteSweepFromFile_FullMethodName      = "/btrpc.BacktesterService/ExecuteSweepFromFile"
+	BacktesterService_ListAllSweeps_FullMethodName             = "/btrpc.BacktesterService/ListAllSweeps"
+	BacktesterService_GetSweepResults_FullMethodName           = "/btrpc.BacktesterService/GetSweepResults"
+	BacktesterService_ClearSweep_FullMethodName                = "/btrpc.BacktesterService/ClearSweep"
 )
 
 // BacktesterServiceClient is the client API for BacktesterService service.
@@ -43,6 +47,10 @@ type BacktesterServiceClient interface {
 	StopAllTasks(ctx context.Context, in *StopAllTasksRequest, opts ...grpc.CallOption) (*StopAllTasksResponse, error)
 	ClearTask(ctx context.Context, in *ClearTaskRequest, opts ...grpc.CallOption) (*ClearTaskResponse, error)
 	ClearAllTasks(ctx context.Context, in *ClearAllTasksRequest, opts ...grpc.CallOption) (*ClearAllTasksResponse, error)
+	ExecuteSweepFromFile(ctx context.Context, in *ExecuteSweepFromFileRequest, opts ...grpc.CallOption) (*ExecuteSweepResponse, error)
+	ListAllSweeps(ctx context.Context, in *ListAllSweepsRequest, opts ...grpc.CallOption) (*ListAllSweepsResponse, error)
+	GetSweepResults(ctx context.Context, in *GetSweepResultsRequest, opts ...grpc.CallOption) (*GetSweepResultsResponse, error)
+	ClearSweep(ctx context.Context, in *ClearSweepRequest, opts ...grpc.CallOption) (*ClearSweepResponse, error)
 }
 
 type backtesterServiceClient struct {
@@ -143,9 +151,49 @@ func (c *backtesterServiceClient) ClearAllTasks(ctx context.Context, in *ClearAl
 	return out, nil
 }
 
+func (c *backtesterServiceClient) ExecuteSweepFromFile(ctx context.Context, in *ExecuteSweepFromFileRequest, opts ...grpc.CallOption) (*ExecuteSweepResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExecuteSweepResponse)
+	err := c.cc.Invoke(ctx, BacktesterService_ExecuteSweepFromFile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *backtesterServiceClient) ListAllSweeps(ctx context.Context, in *ListAllSweepsRequest, opts ...grpc.CallOption) (*ListAllSweepsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAllSweepsResponse)
+	err := c.cc.Invoke(ctx, BacktesterService_ListAllSweeps_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *backtesterServiceClient) GetSweepResults(ctx context.Context, in *GetSweepResultsRequest, opts ...grpc.CallOption) (*GetSweepResultsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSweepResultsResponse)
+	err := c.cc.Invoke(ctx, BacktesterService_GetSweepResults_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *backtesterServiceClient) ClearSweep(ctx context.Context, in *ClearSweepRequest, opts ...grpc.CallOption) (*ClearSweepResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClearSweepResponse)
+	err := c.cc.Invoke(ctx, BacktesterService_ClearSweep_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BacktesterServiceServer is the server API for BacktesterService service.
 // All implementations must embed UnimplementedBacktesterServiceServer
-// for forward compatibility
+// for forward compatibility.
 type BacktesterServiceServer interface {
 	ExecuteStrategyFromFile(context.Context, *ExecuteStrategyFromFileRequest) (*ExecuteStrategyResponse, error)
 	ExecuteStrategyFromConfig(context.Context, *ExecuteStrategyFromConfigRequest) (*ExecuteStrategyResponse, error)
@@ -156,12 +204,19 @@ type BacktesterServiceServer interface {
 	StopAllTasks(context.Context, *StopAllTasksRequest) (*StopAllTasksResponse, error)
 	ClearTask(context.Context, *ClearTaskRequest) (*ClearTaskResponse, error)
 	ClearAllTasks(context.Context, *ClearAllTasksRequest) (*ClearAllTasksResponse, error)
+	ExecuteSweepFromFile(context.Context, *ExecuteSweepFromFileRequest) (*ExecuteSweepResponse, error)
+	ListAllSweeps(context.Context, *ListAllSweepsRequest) (*ListAllSweepsResponse, error)
+	GetSweepResults(context.Context, *GetSweepResultsRequest) (*GetSweepResultsResponse, error)
+	ClearSweep(context.Context, *ClearSweepRequest) (*ClearSweepResponse, error)
 	mustEmbedUnimplementedBacktesterServiceServer()
 }
 
-// UnimplementedBacktesterServiceServer must be embedded to have forward compatible implementations.
-type UnimplementedBacktesterServiceServer struct {
-}
+// UnimplementedBacktesterServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedBacktesterServiceServer struct{}
 
 func (UnimplementedBacktesterServiceServer) ExecuteStrategyFromFile(context.Context, *ExecuteStrategyFromFileRequest) (*ExecuteStrategyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExecuteStrategyFromFile not implemented")
@@ -190,7 +245,20 @@ func (UnimplementedBacktesterServiceServer) ClearTask(context.Context, *ClearTas
 func (UnimplementedBacktesterServiceServer) ClearAllTasks(context.Context, *ClearAllTasksRequest) (*ClearAllTasksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ClearAllTasks not implemented")
 }
+func (UnimplementedBacktesterServiceServer) ExecuteSweepFromFile(context.Context, *ExecuteSweepFromFileRequest) (*ExecuteSweepResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecuteSweepFromFile not implemented")
+}
+func (UnimplementedBacktesterServiceServer) ListAllSweeps(context.Context, *ListAllSweepsRequest) (*ListAllSweepsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAllSweeps not implemented")
+}
+func (UnimplementedBacktesterServiceServer) GetSweepResults(context.Context, *GetSweepResultsRequest) (*GetSweepResultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSweepResults not implemented")
+}
+func (UnimplementedBacktesterServiceServer) ClearSweep(context.Context, *ClearSweepRequest) (*ClearSweepResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearSweep not implemented")
+}
 func (UnimplementedBacktesterServiceServer) mustEmbedUnimplementedBacktesterServiceServer() {}
+func (UnimplementedBacktesterServiceServer) testEmbeddedByValue()                           {}
 
 // UnsafeBacktesterServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to BacktesterServiceServer will
@@ -200,6 +268,13 @@ type UnsafeBacktesterServiceServer interface {
 }
 
 func RegisterBacktesterServiceServer(s grpc.ServiceRegistrar, srv BacktesterServiceServer) {
+	// If the following call pancis, it indicates UnimplementedBacktesterServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
 	s.RegisterService(&BacktesterService_ServiceDesc, srv)
 }
 
@@ -365,6 +440,78 @@ func _BacktesterService_ClearAllTasks_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BacktesterService_ExecuteSweepFromFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecuteSweepFromFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BacktesterServiceServer).ExecuteSweepFromFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BacktesterService_ExecuteSweepFromFile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BacktesterServiceServer).ExecuteSweepFromFile(ctx, req.(*ExecuteSweepFromFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BacktesterService_ListAllSweeps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAllSweepsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BacktesterServiceServer).ListAllSweeps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BacktesterService_ListAllSweeps_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BacktesterServiceServer).ListAllSweeps(ctx, req.(*ListAllSweepsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BacktesterService_GetSweepResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSweepResultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BacktesterServiceServer).GetSweepResults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BacktesterService_GetSweepResults_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BacktesterServiceServer).GetSweepResults(ctx, req.(*GetSweepResultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BacktesterService_ClearSweep_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearSweepRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BacktesterServiceServer).ClearSweep(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BacktesterService_ClearSweep_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BacktesterServiceServer).ClearSweep(ctx, req.(*ClearSweepRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // BacktesterService_ServiceDesc is the grpc.ServiceDesc for BacktesterService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -408,6 +555,22 @@ var BacktesterService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ClearAllTasks",
 			Handler:    _BacktesterService_ClearAllTasks_Handler,
 		},
+		{
+			MethodName: "ExecuteSweepFromFile",
+			Handler:    _BacktesterService_ExecuteSweepFromFile_Handler,
+		},
+		{
+			MethodName: "ListAllSweeps",
+			Handler:    _BacktesterService_ListAllSweeps_Handler,
+		},
+		{
+			MethodName: "GetSweepResults",
+			Handler:    _BacktesterService_GetSweepResults_Handler,
+		},
+		{
+			MethodName: "ClearSweep",
+			Handler:    _BacktesterService_ClearSweep_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "btrpc.proto",
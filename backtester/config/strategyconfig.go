@@ -50,6 +50,10 @@ func (c *Config) Validate() error {
 	if err != nil {
 		return err
 	}
+	err = c.validateOrderbookData()
+	if err != nil {
+		return err
+	}
 	return c.validateMinMaxes()
 }
 
@@ -148,6 +152,25 @@ func (c *Config) validateDate() error {
 	return nil
 }
 
+// validateOrderbookData ensures orderbook data has a single source which is
+// compatible with the candle data source
+func (c *Config) validateOrderbookData() error {
+	obd := c.DataSettings.OrderbookData
+	if obd == nil {
+		return nil
+	}
+	if (obd.FullPath == "") == !obd.UseDatabase {
+		return errInvalidOrderbookSource
+	}
+	if c.DataSettings.LiveData != nil {
+		return fmt.Errorf("%w orderbook data with live data", errFeatureIncompatible)
+	}
+	if obd.UseDatabase && c.DataSettings.DatabaseData == nil {
+		return fmt.Errorf("%w orderbook data from the database requires database data settings", errFeatureIncompatible)
+	}
+	return nil
+}
+
 // validateCurrencySettings checks whether someone has set invalid currency setting data in their config
 func (c *Config) validateCurrencySettings() error {
 	if len(c.CurrencySettings) == 0 {
@@ -155,15 +178,8 @@ func (c *Config) validateCurrencySettings() error {
 	}
 	var hasFutures, hasSlippage bool
 	for i := range c.CurrencySettings {
-		if c.CurrencySettings[i].Asset == asset.PerpetualSwap ||
-			c.CurrencySettings[i].Asset == asset.PerpetualContract {
-			return errPerpetualsUnsupported
-		}
 		if c.CurrencySettings[i].Asset.IsFutures() {
 			hasFutures = true
-			if c.CurrencySettings[i].Quote.String() == "PERP" || c.CurrencySettings[i].Base.String() == "PI" {
-				return errPerpetualsUnsupported
-			}
 		}
 		if c.CurrencySettings[i].SpotDetails != nil {
 			if c.FundingSettings.UseExchangeLevelFunding {
@@ -220,6 +236,15 @@ func (c *Config) validateCurrencySettings() error {
 	return nil
 }
 
+// IsPerpetual returns whether the currency settings describe a perpetual
+// futures contract, which is subject to periodic funding rate payments
+func (c *CurrencySettings) IsPerpetual() bool {
+	if c.Asset == asset.PerpetualSwap || c.Asset == asset.PerpetualContract {
+		return true
+	}
+	return c.Asset.IsFutures() && (c.Quote.String() == "PERP" || c.Base.String() == "PI")
+}
+
 // PrintSetting prints relevant settings to the console for easy reading
 func (c *Config) PrintSetting() {
 	log.Infoln(common.Config, common.CMDColours.H1+"------------------Backtester Settings------------------------"+common.CMDColours.Default)
@@ -292,7 +317,7 @@ func (c *Config) PrintSetting() {
 		log.Infof(common.Config, "Maximum slippage percent: %v", c.CurrencySettings[i].MaximumSlippagePercent.Round(8))
 		log.Infof(common.Config, "Buy rules: %+v", c.CurrencySettings[i].BuySide)
 		log.Infof(common.Config, "Sell rules: %+v", c.CurrencySettings[i].SellSide)
-		if c.CurrencySettings[i].FuturesDetails != nil && c.CurrencySettings[i].Asset == asset.Futures {
+		if c.CurrencySettings[i].FuturesDetails != nil && c.CurrencySettings[i].Asset.IsFutures() {
 			log.Infof(common.Config, "Leverage rules: %+v", c.CurrencySettings[i].FuturesDetails.Leverage)
 		}
 		log.Infof(common.Config, "Can use exchange defined order execution limits: %+v", c.CurrencySettings[i].CanUseExchangeLimits)
@@ -325,6 +350,9 @@ func (c *Config) PrintSetting() {
 		log.Infof(common.Config, "Data type: %v", c.DataSettings.DataType)
 		log.Infof(common.Config, "Interval: %v", c.DataSettings.Interval)
 		log.Infof(common.Config, "CSV file: %v", c.DataSettings.CSVData.FullPath)
+		if c.DataSettings.CSVData.FundingRateFullPath != "" {
+			log.Infof(common.Config, "CSV funding rate file: %v", c.DataSettings.CSVData.FundingRateFullPath)
+		}
 	}
 	if c.DataSettings.DatabaseData != nil {
 		log.Infoln(common.Config, common.CMDColours.H2+"------------------Database Settings--------------------------"+common.CMDColours.Default)
@@ -333,4 +361,12 @@ func (c *Config) PrintSetting() {
 		log.Infof(common.Config, "Start date: %v", c.DataSettings.DatabaseData.StartDate.Format(time.DateTime))
 		log.Infof(common.Config, "End date: %v", c.DataSettings.DatabaseData.EndDate.Format(time.DateTime))
 	}
+	if c.DataSettings.OrderbookData != nil {
+		log.Infoln(common.Config, common.CMDColours.H2+"------------------Orderbook Settings-------------------------"+common.CMDColours.Default)
+		if c.DataSettings.OrderbookData.UseDatabase {
+			log.Infoln(common.Config, "Orderbook source: database")
+		} else {
+			log.Infof(common.Config, "Orderbook file: %v", c.DataSettings.OrderbookData.FullPath)
+		}
+	}
 }
@@ -98,6 +98,31 @@ func TestValidateDate(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestValidateOrderbookData(t *testing.T) {
+	t.Parallel()
+	c := Config{}
+	assert.NoError(t, c.validateOrderbookData())
+
+	c.DataSettings.OrderbookData = &OrderbookData{}
+	assert.ErrorIs(t, c.validateOrderbookData(), errInvalidOrderbookSource)
+
+	c.DataSettings.OrderbookData = &OrderbookData{FullPath: "orderbook.jsonl.gz", UseDatabase: true}
+	assert.ErrorIs(t, c.validateOrderbookData(), errInvalidOrderbookSource)
+
+	c.DataSettings.OrderbookData = &OrderbookData{UseDatabase: true}
+	assert.ErrorIs(t, c.validateOrderbookData(), errFeatureIncompatible)
+
+	c.DataSettings.DatabaseData = &DatabaseData{}
+	assert.NoError(t, c.validateOrderbookData())
+
+	c.DataSettings.LiveData = &LiveData{}
+	assert.ErrorIs(t, c.validateOrderbookData(), errFeatureIncompatible)
+
+	c.DataSettings.LiveData = nil
+	c.DataSettings.OrderbookData = &OrderbookData{FullPath: "orderbook.jsonl.gz"}
+	assert.NoError(t, c.validateOrderbookData())
+}
+
 func TestValidateCurrencySettings(t *testing.T) {
 	t.Parallel()
 	c := Config{}
@@ -127,12 +152,12 @@ func TestValidateCurrencySettings(t *testing.T) {
 
 	c.CurrencySettings[0].Asset = asset.PerpetualSwap
 	err = c.validateCurrencySettings()
-	assert.ErrorIs(t, err, errPerpetualsUnsupported)
+	assert.NoError(t, err, "validateCurrencySettings should allow perpetual swaps")
 
 	c.CurrencySettings[0].Asset = asset.USDTMarginedFutures
 	c.CurrencySettings[0].Quote = currency.NewCode("PERP")
 	err = c.validateCurrencySettings()
-	assert.ErrorIs(t, err, errPerpetualsUnsupported)
+	assert.NoError(t, err, "validateCurrencySettings should allow perpetual futures contracts")
 
 	c.CurrencySettings[0].MinimumSlippagePercent = decimal.NewFromInt(2)
 	c.CurrencySettings[0].MaximumSlippagePercent = decimal.NewFromInt(3)
@@ -177,6 +202,25 @@ func TestValidateCurrencySettings(t *testing.T) {
 	assert.ErrorIs(t, err, errBadInitialFunds)
 }
 
+func TestIsPerpetual(t *testing.T) {
+	t.Parallel()
+	c := &CurrencySettings{
+		Asset: asset.Spot,
+		Base:  currency.BTC,
+		Quote: currency.USDT,
+	}
+	assert.False(t, c.IsPerpetual(), "IsPerpetual should return false for spot")
+
+	c.Asset = asset.PerpetualSwap
+	assert.True(t, c.IsPerpetual(), "IsPerpetual should return true for perpetual swaps")
+
+	c.Asset = asset.Futures
+	assert.False(t, c.IsPerpetual(), "IsPerpetual should return false for dated futures")
+
+	c.Quote = currency.NewCode("PERP")
+	assert.True(t, c.IsPerpetual(), "IsPerpetual should return true for PERP contracts")
+}
+
 func TestValidateMinMaxes(t *testing.T) {
 	t.Parallel()
 	c := &Config{}
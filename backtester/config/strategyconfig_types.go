@@ -24,8 +24,8 @@ var (
 	errSizeLessThanZero                 = errors.New("size less than zero")
 	errMaxSizeMinSizeMismatch           = errors.New("maximum size must be greater to minimum size")
 	errMinMaxEqual                      = errors.New("minimum and maximum limits cannot be equal")
-	errPerpetualsUnsupported            = errors.New("perpetual futures not yet supported")
 	errFeatureIncompatible              = errors.New("feature is not compatible")
+	errInvalidOrderbookSource           = errors.New("orderbook data requires either a file path or database usage, but not both")
 )
 
 // Config defines what is in an individual strategy config
@@ -50,6 +50,9 @@ type DataSettings struct {
 	DatabaseData            *DatabaseData  `json:"database-data,omitempty"`
 	LiveData                *LiveData      `json:"live-data,omitempty"`
 	CSVData                 *CSVData       `json:"csv-data,omitempty"`
+	// OrderbookData is optional recorded orderbook data which orders are
+	// filled against instead of candle data
+	OrderbookData *OrderbookData `json:"orderbook-data,omitempty"`
 }
 
 // FundingSettings contains funding details for individual currencies
@@ -177,6 +180,9 @@ type APIData struct {
 // CSVData defines all fields to configure CSV based data
 type CSVData struct {
 	FullPath string `json:"full-path"`
+	// FundingRateFullPath is an optional path to historical funding rates
+	// used when backtesting perpetual futures contracts
+	FundingRateFullPath string `json:"funding-rate-full-path,omitempty"`
 }
 
 // DatabaseData defines all fields to configure database based data
@@ -188,6 +194,14 @@ type DatabaseData struct {
 	InclusiveEndDate bool            `json:"inclusive-end-date"`
 }
 
+// OrderbookData defines where recorded orderbook snapshots and updates are
+// loaded from. FullPath is a line delimited JSON file, optionally gzip
+// compressed, while UseDatabase loads data from the database-data settings
+type OrderbookData struct {
+	FullPath    string `json:"full-path,omitempty"`
+	UseDatabase bool   `json:"use-database,omitempty"`
+}
+
 // LiveData defines all fields to configure live data
 type LiveData struct {
 	NewEventTimeout           time.Duration `json:"new-event-timeout"`
@@ -0,0 +1,231 @@
+package config
+
+import (
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/file"
+	"github.com/thrasher-corp/gocryptotrader/encoding/json"
+)
+
+// ReadSweepConfigFromFile will take a sweep config from a path and load the
+// strategy config it references
+func ReadSweepConfigFromFile(path string) (*SweepConfig, error) {
+	if !file.Exists(path) {
+		return nil, fmt.Errorf("%w %v", common.ErrFileNotFound, path)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp *SweepConfig
+	err = json.Unmarshal(data, &resp)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StrategyConfig == nil && resp.StrategyConfigPath != "" {
+		strategyPath := resp.StrategyConfigPath
+		if !filepath.IsAbs(strategyPath) {
+			strategyPath = filepath.Join(filepath.Dir(path), strategyPath)
+		}
+		resp.StrategyConfig, err = ReadStrategyConfigFromFile(strategyPath)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
+
+// Validate checks all sweep config settings along with the strategy config
+func (s *SweepConfig) Validate() error {
+	if s == nil {
+		return fmt.Errorf("%w sweep config", gctcommon.ErrNilPointer)
+	}
+	if s.StrategyConfig == nil {
+		return errNoStrategyConfig
+	}
+	if s.StrategyConfig.DataSettings.LiveData != nil {
+		return fmt.Errorf("%w sweeps with live data", errFeatureIncompatible)
+	}
+	switch s.RankBy {
+	case SharpeRatio, SortinoRatio, InformationRatio, CalmarRatio:
+	default:
+		return fmt.Errorf("%w '%v'", errInvalidRankingRatio, s.RankBy)
+	}
+	if s.MaximumWorkers < 0 {
+		return fmt.Errorf("%w %v", errInvalidMaximumWorkers, s.MaximumWorkers)
+	}
+	if len(s.Parameters) == 0 {
+		return errNoSweepParameters
+	}
+	keys := make(map[string]bool, len(s.Parameters))
+	for i := range s.Parameters {
+		if keys[s.Parameters[i].Key] {
+			return fmt.Errorf("%w %v", errDuplicateSweepParameter, s.Parameters[i].Key)
+		}
+		keys[s.Parameters[i].Key] = true
+	}
+	if _, err := s.Combinations(); err != nil {
+		return err
+	}
+	if s.WalkForward != nil {
+		if _, err := s.WalkForwardWindows(); err != nil {
+			return err
+		}
+	}
+	return s.StrategyConfig.Validate()
+}
+
+// GetValues returns every value the parameter is swept over
+func (p *SweepParameter) GetValues() ([]float64, error) {
+	if p.Key == "" {
+		return nil, errSweepParameterKeyUnset
+	}
+	hasRange := p.Minimum != 0 || p.Maximum != 0 || p.Step != 0
+	if (len(p.Values) > 0) == hasRange {
+		return nil, fmt.Errorf("%w %v", errInvalidSweepParameter, p.Key)
+	}
+	if len(p.Values) > 0 {
+		return p.Values, nil
+	}
+	if p.Step <= 0 || p.Maximum < p.Minimum {
+		return nil, fmt.Errorf("%w %v minimum: %v maximum: %v step: %v", errInvalidSweepRange, p.Key, p.Minimum, p.Maximum, p.Step)
+	}
+	minimum := decimal.NewFromFloat(p.Minimum)
+	maximum := decimal.NewFromFloat(p.Maximum)
+	step := decimal.NewFromFloat(p.Step)
+	if maximum.Sub(minimum).Div(step).GreaterThanOrEqual(decimal.NewFromInt(maxSweepCombinations)) {
+		return nil, fmt.Errorf("%w %v, limit is %v", errTooManyCombinations, p.Key, maxSweepCombinations)
+	}
+	var values []float64
+	for v := minimum; v.LessThanOrEqual(maximum); v = v.Add(step) {
+		values = append(values, v.InexactFloat64())
+	}
+	return values, nil
+}
+
+// Combinations returns the custom settings of every combination of the sweep
+// parameters
+func (s *SweepConfig) Combinations() ([]map[string]any, error) {
+	combinations := []map[string]any{{}}
+	for i := range s.Parameters {
+		values, err := s.Parameters[i].GetValues()
+		if err != nil {
+			return nil, err
+		}
+		if len(combinations)*len(values) > maxSweepCombinations {
+			return nil, fmt.Errorf("%w, limit is %v", errTooManyCombinations, maxSweepCombinations)
+		}
+		next := make([]map[string]any, 0, len(combinations)*len(values))
+		for j := range combinations {
+			for k := range values {
+				combination := maps.Clone(combinations[j])
+				combination[s.Parameters[i].Key] = values[k]
+				next = append(next, combination)
+			}
+		}
+		combinations = next
+	}
+	return combinations, nil
+}
+
+// WalkForwardWindows splits the strategy config's date range into
+// consecutive in-sample and out-of-sample windows
+func (s *SweepConfig) WalkForwardWindows() ([]WalkForwardWindow, error) {
+	if s.WalkForward == nil {
+		return nil, fmt.Errorf("%w walk-forward settings", gctcommon.ErrNilPointer)
+	}
+	if s.WalkForward.InSampleCandles <= 0 || s.WalkForward.OutOfSampleCandles <= 0 {
+		return nil, fmt.Errorf("%w in-sample and out-of-sample candles must be greater than zero", errInvalidWalkForwardWindows)
+	}
+	start, end, ok := s.StrategyConfig.dateRange()
+	if !ok {
+		return nil, errWalkForwardDataRequired
+	}
+	interval := s.StrategyConfig.DataSettings.Interval.Duration()
+	if interval <= 0 {
+		return nil, fmt.Errorf("%w candle interval unset", errInvalidWalkForwardWindows)
+	}
+	inSample := interval * time.Duration(s.WalkForward.InSampleCandles)
+	outOfSample := interval * time.Duration(s.WalkForward.OutOfSampleCandles)
+	var windows []WalkForwardWindow
+	for i := time.Duration(0); ; i++ {
+		offset := outOfSample * i
+		window := WalkForwardWindow{
+			InSampleStart: start,
+			InSampleEnd:   start.Add(inSample + offset),
+		}
+		if !s.WalkForward.Anchored {
+			window.InSampleStart = start.Add(offset)
+		}
+		window.OutOfSampleStart = window.InSampleEnd
+		window.OutOfSampleEnd = window.OutOfSampleStart.Add(outOfSample)
+		if window.OutOfSampleEnd.After(end) {
+			break
+		}
+		windows = append(windows, window)
+	}
+	if len(windows) == 0 {
+		return nil, fmt.Errorf("%w date range %v to %v is too short for a single window", errInvalidWalkForwardWindows, start, end)
+	}
+	return windows, nil
+}
+
+// BuildStrategyConfig returns a copy of the strategy config with the custom
+// settings applied. When start and end are set, they replace the data date
+// range
+func (s *SweepConfig) BuildStrategyConfig(customSettings map[string]any, start, end time.Time) (*Config, error) {
+	if s.StrategyConfig == nil {
+		return nil, errNoStrategyConfig
+	}
+	data, err := json.Marshal(s.StrategyConfig)
+	if err != nil {
+		return nil, err
+	}
+	var cfg *Config
+	err = json.Unmarshal(data, &cfg)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.StrategySettings.CustomSettings == nil {
+		cfg.StrategySettings.CustomSettings = make(map[string]any, len(customSettings))
+	}
+	maps.Copy(cfg.StrategySettings.CustomSettings, customSettings)
+	if start.IsZero() || end.IsZero() {
+		return cfg, nil
+	}
+	switch {
+	case cfg.DataSettings.APIData != nil:
+		cfg.DataSettings.APIData.StartDate = start
+		cfg.DataSettings.APIData.EndDate = end
+		cfg.DataSettings.APIData.InclusiveEndDate = false
+	case cfg.DataSettings.DatabaseData != nil:
+		cfg.DataSettings.DatabaseData.StartDate = start
+		cfg.DataSettings.DatabaseData.EndDate = end
+		cfg.DataSettings.DatabaseData.InclusiveEndDate = false
+	default:
+		return nil, errWalkForwardDataRequired
+	}
+	return cfg, nil
+}
+
+// dateRange returns the date range of the config's candle data, if it has one
+func (c *Config) dateRange() (start, end time.Time, ok bool) {
+	switch {
+	case c.DataSettings.APIData != nil:
+		start, end = c.DataSettings.APIData.StartDate, c.DataSettings.APIData.EndDate
+	case c.DataSettings.DatabaseData != nil:
+		start, end = c.DataSettings.DatabaseData.StartDate, c.DataSettings.DatabaseData.EndDate
+	default:
+		return time.Time{}, time.Time{}, false
+	}
+	return start, end, !start.IsZero() && end.After(start)
+}
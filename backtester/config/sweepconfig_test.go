@@ -0,0 +1,223 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/file"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+)
+
+func newTestSweepConfig() *SweepConfig {
+	start := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	return &SweepConfig{
+		StrategyConfig: &Config{
+			StrategySettings: StrategySettings{
+				Name:           dca,
+				CustomSettings: map[string]any{"untouched": 1.0},
+			},
+			DataSettings: DataSettings{
+				Interval: kline.OneDay,
+				APIData: &APIData{
+					StartDate:        start,
+					EndDate:          start.AddDate(0, 0, 10),
+					InclusiveEndDate: true,
+				},
+			},
+		},
+		RankBy: SharpeRatio,
+		Parameters: []SweepParameter{
+			{Key: "a", Minimum: 1, Maximum: 2, Step: 0.5},
+			{Key: "b", Values: []float64{10, 20}},
+		},
+	}
+}
+
+func TestReadSweepConfigFromFile(t *testing.T) {
+	t.Parallel()
+	_, err := ReadSweepConfigFromFile("test")
+	assert.ErrorIs(t, err, common.ErrFileNotFound)
+
+	s, err := ReadSweepConfigFromFile(filepath.Join("strategyexamples", "rsi-api-candles.sweep"))
+	require.NoError(t, err)
+	require.NotNil(t, s.StrategyConfig, "ReadSweepConfigFromFile must load the referenced strategy config")
+	assert.Equal(t, "rsi", s.StrategyConfig.StrategySettings.Name)
+	assert.NoError(t, s.Validate())
+
+	tempDir := t.TempDir()
+	p := filepath.Join(tempDir, "bad.sweep")
+	require.NoError(t, os.WriteFile(p, []byte(`{"strategy-config-path":"missing.strat"}`), file.DefaultPermissionOctal))
+	_, err = ReadSweepConfigFromFile(p)
+	assert.ErrorIs(t, err, common.ErrFileNotFound)
+}
+
+func TestSweepConfigValidate(t *testing.T) {
+	t.Parallel()
+	var s *SweepConfig
+	assert.ErrorIs(t, s.Validate(), gctcommon.ErrNilPointer)
+
+	s = &SweepConfig{}
+	assert.ErrorIs(t, s.Validate(), errNoStrategyConfig)
+
+	s = newTestSweepConfig()
+	s.StrategyConfig.DataSettings.LiveData = &LiveData{}
+	assert.ErrorIs(t, s.Validate(), errFeatureIncompatible)
+
+	s = newTestSweepConfig()
+	s.RankBy = "vibes"
+	assert.ErrorIs(t, s.Validate(), errInvalidRankingRatio)
+
+	s = newTestSweepConfig()
+	s.MaximumWorkers = -1
+	assert.ErrorIs(t, s.Validate(), errInvalidMaximumWorkers)
+
+	s = newTestSweepConfig()
+	s.Parameters = nil
+	assert.ErrorIs(t, s.Validate(), errNoSweepParameters)
+
+	s = newTestSweepConfig()
+	s.Parameters[1].Key = "a"
+	assert.ErrorIs(t, s.Validate(), errDuplicateSweepParameter)
+
+	s = newTestSweepConfig()
+	s.Parameters[0].Step = 0
+	assert.ErrorIs(t, s.Validate(), errInvalidSweepRange)
+
+	s = newTestSweepConfig()
+	s.WalkForward = &WalkForward{}
+	assert.ErrorIs(t, s.Validate(), errInvalidWalkForwardWindows)
+
+	s = newTestSweepConfig()
+	s.StrategyConfig.StrategySettings.Name = ""
+	assert.Error(t, s.Validate())
+}
+
+func TestGetValues(t *testing.T) {
+	t.Parallel()
+	p := SweepParameter{}
+	_, err := p.GetValues()
+	assert.ErrorIs(t, err, errSweepParameterKeyUnset)
+
+	p.Key = "test"
+	_, err = p.GetValues()
+	assert.ErrorIs(t, err, errInvalidSweepParameter)
+
+	p.Values = []float64{1}
+	p.Step = 1
+	_, err = p.GetValues()
+	assert.ErrorIs(t, err, errInvalidSweepParameter)
+
+	p = SweepParameter{Key: "test", Minimum: 2, Maximum: 1, Step: 1}
+	_, err = p.GetValues()
+	assert.ErrorIs(t, err, errInvalidSweepRange)
+
+	p = SweepParameter{Key: "test", Minimum: 0, Maximum: 1, Step: 0.00001}
+	_, err = p.GetValues()
+	assert.ErrorIs(t, err, errTooManyCombinations)
+
+	p = SweepParameter{Key: "test", Minimum: 0.1, Maximum: 0.3, Step: 0.1}
+	values, err := p.GetValues()
+	require.NoError(t, err)
+	assert.Equal(t, []float64{0.1, 0.2, 0.3}, values, "GetValues should include the maximum without float drift")
+
+	p = SweepParameter{Key: "test", Values: []float64{5, 1}}
+	values, err = p.GetValues()
+	require.NoError(t, err)
+	assert.Equal(t, []float64{5, 1}, values)
+}
+
+func TestCombinations(t *testing.T) {
+	t.Parallel()
+	s := newTestSweepConfig()
+	combinations, err := s.Combinations()
+	require.NoError(t, err)
+	require.Len(t, combinations, 6)
+	assert.Equal(t, map[string]any{"a": 1.0, "b": 10.0}, combinations[0])
+	assert.Equal(t, map[string]any{"a": 2.0, "b": 20.0}, combinations[5])
+
+	s.Parameters = append(s.Parameters,
+		SweepParameter{Key: "c", Minimum: 1, Maximum: 100, Step: 1},
+		SweepParameter{Key: "d", Minimum: 1, Maximum: 100, Step: 1},
+	)
+	_, err = s.Combinations()
+	assert.ErrorIs(t, err, errTooManyCombinations)
+}
+
+func TestWalkForwardWindows(t *testing.T) {
+	t.Parallel()
+	s := newTestSweepConfig()
+	_, err := s.WalkForwardWindows()
+	assert.ErrorIs(t, err, gctcommon.ErrNilPointer)
+
+	s.WalkForward = &WalkForward{InSampleCandles: 4}
+	_, err = s.WalkForwardWindows()
+	assert.ErrorIs(t, err, errInvalidWalkForwardWindows)
+
+	s.WalkForward.OutOfSampleCandles = 2
+	s.StrategyConfig.DataSettings.Interval = 0
+	_, err = s.WalkForwardWindows()
+	assert.ErrorIs(t, err, errInvalidWalkForwardWindows)
+
+	s.StrategyConfig.DataSettings.Interval = kline.OneDay
+	windows, err := s.WalkForwardWindows()
+	require.NoError(t, err)
+	require.Len(t, windows, 3)
+	start := s.StrategyConfig.DataSettings.APIData.StartDate
+	assert.Equal(t, start.AddDate(0, 0, 4), windows[2].InSampleStart)
+	assert.Equal(t, start.AddDate(0, 0, 8), windows[2].InSampleEnd)
+	assert.Equal(t, windows[2].InSampleEnd, windows[2].OutOfSampleStart)
+	assert.Equal(t, start.AddDate(0, 0, 10), windows[2].OutOfSampleEnd)
+
+	s.WalkForward.Anchored = true
+	windows, err = s.WalkForwardWindows()
+	require.NoError(t, err)
+	require.Len(t, windows, 3)
+	assert.Equal(t, start, windows[2].InSampleStart, "anchored windows should always start at the start date")
+
+	s.WalkForward.InSampleCandles = 10
+	_, err = s.WalkForwardWindows()
+	assert.ErrorIs(t, err, errInvalidWalkForwardWindows)
+
+	s.StrategyConfig.DataSettings.APIData = nil
+	s.StrategyConfig.DataSettings.CSVData = &CSVData{}
+	_, err = s.WalkForwardWindows()
+	assert.ErrorIs(t, err, errWalkForwardDataRequired)
+}
+
+func TestBuildStrategyConfig(t *testing.T) {
+	t.Parallel()
+	_, err := (&SweepConfig{}).BuildStrategyConfig(nil, time.Time{}, time.Time{})
+	assert.ErrorIs(t, err, errNoStrategyConfig)
+
+	s := newTestSweepConfig()
+	cfg, err := s.BuildStrategyConfig(map[string]any{"a": 2.0}, time.Time{}, time.Time{})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"a": 2.0, "untouched": 1.0}, cfg.StrategySettings.CustomSettings)
+	assert.NotContains(t, s.StrategyConfig.StrategySettings.CustomSettings, "a", "BuildStrategyConfig must not modify the original config")
+	assert.True(t, cfg.DataSettings.APIData.InclusiveEndDate)
+
+	start := time.Date(2022, 1, 2, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 0, 1)
+	cfg, err = s.BuildStrategyConfig(nil, start, end)
+	require.NoError(t, err)
+	assert.True(t, start.Equal(cfg.DataSettings.APIData.StartDate))
+	assert.True(t, end.Equal(cfg.DataSettings.APIData.EndDate))
+	assert.False(t, cfg.DataSettings.APIData.InclusiveEndDate)
+	assert.True(t, s.StrategyConfig.DataSettings.APIData.EndDate.After(end), "BuildStrategyConfig must not modify the original dates")
+
+	s.StrategyConfig.DataSettings.APIData = nil
+	s.StrategyConfig.DataSettings.DatabaseData = &DatabaseData{}
+	cfg, err = s.BuildStrategyConfig(nil, start, end)
+	require.NoError(t, err)
+	assert.True(t, start.Equal(cfg.DataSettings.DatabaseData.StartDate))
+
+	s.StrategyConfig.DataSettings.DatabaseData = nil
+	_, err = s.BuildStrategyConfig(nil, start, end)
+	assert.ErrorIs(t, err, errWalkForwardDataRequired)
+}
@@ -0,0 +1,79 @@
+package config
+
+import (
+	"errors"
+	"time"
+)
+
+// Ratio names which sweep results can be ranked by
+const (
+	SharpeRatio      = "sharpe"
+	SortinoRatio     = "sortino"
+	InformationRatio = "information"
+	CalmarRatio      = "calmar"
+)
+
+// maxSweepCombinations limits the amount of backtests a single sweep can run
+// per window
+const maxSweepCombinations = 10000
+
+var (
+	errNoStrategyConfig          = errors.New("sweep config has no strategy config")
+	errNoSweepParameters         = errors.New("sweep config has no parameters")
+	errSweepParameterKeyUnset    = errors.New("sweep parameter key unset")
+	errDuplicateSweepParameter   = errors.New("duplicate sweep parameter")
+	errInvalidSweepParameter     = errors.New("sweep parameter requires either values or a range, but not both")
+	errInvalidSweepRange         = errors.New("invalid sweep parameter range")
+	errInvalidRankingRatio       = errors.New("invalid ranking ratio")
+	errInvalidMaximumWorkers     = errors.New("maximum workers cannot be negative")
+	errTooManyCombinations       = errors.New("too many sweep parameter combinations")
+	errInvalidWalkForwardWindows = errors.New("invalid walk-forward windows")
+	errWalkForwardDataRequired   = errors.New("walk-forward requires api or database data with a start and end date")
+)
+
+// SweepConfig defines ranges or grids of strategy custom settings to run a
+// backtest for each combination of, along with how to rank the results
+type SweepConfig struct {
+	Nickname string `json:"nickname"`
+	// StrategyConfigPath is the strategy config each combination is applied
+	// to. Relative paths are resolved from the sweep config's directory
+	StrategyConfigPath string  `json:"strategy-config-path,omitempty"`
+	StrategyConfig     *Config `json:"strategy-config,omitempty"`
+	// RankBy is the ratio results are ranked by, highest first
+	RankBy             string `json:"rank-by"`
+	UseGeometricRatios bool   `json:"use-geometric-ratios"`
+	// MaximumWorkers is the amount of backtests run at once. Zero uses the
+	// number of CPUs
+	MaximumWorkers int              `json:"maximum-workers"`
+	Parameters     []SweepParameter `json:"parameters"`
+	WalkForward    *WalkForward     `json:"walk-forward,omitempty"`
+}
+
+// SweepParameter is a strategy custom setting to sweep over. Either a grid of
+// values or a range with a step can be set
+type SweepParameter struct {
+	Key     string    `json:"key"`
+	Values  []float64 `json:"values,omitempty"`
+	Minimum float64   `json:"minimum,omitempty"`
+	Maximum float64   `json:"maximum,omitempty"`
+	Step    float64   `json:"step,omitempty"`
+}
+
+// WalkForward splits the strategy config's date range into rolling windows.
+// The sweep is ranked over each in-sample window and the best combination is
+// then tested over the out-of-sample window which follows it
+type WalkForward struct {
+	InSampleCandles    int64 `json:"in-sample-candles"`
+	OutOfSampleCandles int64 `json:"out-of-sample-candles"`
+	// Anchored keeps the start of each in-sample window at the start of the
+	// date range rather than rolling it forward
+	Anchored bool `json:"anchored"`
+}
+
+// WalkForwardWindow holds the date ranges of a single walk-forward window
+type WalkForwardWindow struct {
+	InSampleStart    time.Time
+	InSampleEnd      time.Time
+	OutOfSampleStart time.Time
+	OutOfSampleEnd   time.Time
+}
@@ -0,0 +1,134 @@
+package fundingrate
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	fundingratesql "github.com/thrasher-corp/gocryptotrader/database/repository/fundingrate"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	gctfundingrate "github.com/thrasher-corp/gocryptotrader/exchanges/fundingrate"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+var errInvalidCSVRow = errors.New("invalid funding rate csv row")
+
+// LoadFromAPI retrieves historical funding rates from a GoCryptoTrader exchange wrapper
+func LoadFromAPI(ctx context.Context, exch exchange.IBotExchange, a asset.Item, fPair currency.Pair, startDate, endDate time.Time) (*gctfundingrate.HistoricalRates, error) {
+	if exch == nil {
+		return nil, fmt.Errorf("%w exchange", gctcommon.ErrNilPointer)
+	}
+	rates, err := exch.GetHistoricalFundingRates(ctx, &gctfundingrate.HistoricalRatesRequest{
+		Asset:                a,
+		Pair:                 fPair,
+		StartDate:            startDate,
+		EndDate:              endDate,
+		RespectHistoryLimits: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve funding rate data for %v %v %v, %w", exch.GetName(), a, fPair, err)
+	}
+	return processRates(strings.ToLower(exch.GetName()), a, fPair, startDate, endDate, rates.FundingRates)
+}
+
+// LoadFromCSV reads historical funding rates from a CSV file where each row
+// contains a unix timestamp in seconds and the funding rate for that time
+func LoadFromCSV(filepath, exchangeName string, a asset.Item, fPair currency.Pair, startDate, endDate time.Time) (*gctfundingrate.HistoricalRates, error) {
+	csvFile, err := os.Open(filepath)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if closeErr := csvFile.Close(); closeErr != nil {
+			log.Errorln(common.Data, closeErr)
+		}
+	}()
+
+	var rates []gctfundingrate.Rate
+	csvData := csv.NewReader(csvFile)
+	for {
+		row, err := csvData.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, fmt.Errorf("could not read csv funding rate data for %v %v %v, %w", exchangeName, a, fPair, err)
+		}
+		if len(row) < 2 {
+			return nil, fmt.Errorf("%w %v, expected timestamp and rate", errInvalidCSVRow, row)
+		}
+		ts, err := strconv.ParseInt(row[0], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w invalid timestamp %v %w", errInvalidCSVRow, row[0], err)
+		}
+		rate, err := decimal.NewFromString(row[1])
+		if err != nil {
+			return nil, fmt.Errorf("%w invalid rate %v %w", errInvalidCSVRow, row[1], err)
+		}
+		rates = append(rates, gctfundingrate.Rate{
+			Time: time.Unix(ts, 0).UTC(),
+			Rate: rate,
+		})
+	}
+	return processRates(exchangeName, a, fPair, startDate, endDate, rates)
+}
+
+// LoadFromDatabase retrieves historical funding rates from GoCryptoTrader's database
+func LoadFromDatabase(exchangeName string, a asset.Item, fPair currency.Pair, startDate, endDate time.Time) (*gctfundingrate.HistoricalRates, error) {
+	dbRates, err := fundingratesql.GetInRange(exchangeName, a.String(), fPair.Base.String(), fPair.Quote.String(), startDate, endDate)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve database funding rate data for %v %v %v, %w", exchangeName, a, fPair, err)
+	}
+	rates := make([]gctfundingrate.Rate, len(dbRates))
+	for i := range dbRates {
+		rates[i] = gctfundingrate.Rate{
+			Time:    dbRates[i].Timestamp.UTC(),
+			Rate:    decimal.NewFromFloat(dbRates[i].Rate),
+			Payment: decimal.NewFromFloat(dbRates[i].Payment),
+		}
+	}
+	return processRates(exchangeName, a, fPair, startDate, endDate, rates)
+}
+
+// processRates sorts, de-duplicates and filters rates to the
+// backtesting date range
+func processRates(exchangeName string, a asset.Item, fPair currency.Pair, startDate, endDate time.Time, rates []gctfundingrate.Rate) (*gctfundingrate.HistoricalRates, error) {
+	filtered := make([]gctfundingrate.Rate, 0, len(rates))
+	for i := range rates {
+		if (!startDate.IsZero() && rates[i].Time.Before(startDate)) ||
+			(!endDate.IsZero() && rates[i].Time.After(endDate)) {
+			continue
+		}
+		filtered = append(filtered, rates[i])
+	}
+	slices.SortFunc(filtered, func(a, b gctfundingrate.Rate) int {
+		return a.Time.Compare(b.Time)
+	})
+	filtered = slices.CompactFunc(filtered, func(a, b gctfundingrate.Rate) bool {
+		return a.Time.Equal(b.Time)
+	})
+	if len(filtered) == 0 {
+		return nil, fmt.Errorf("%v %v %v %w between %v and %v", exchangeName, a, fPair, gctfundingrate.ErrNoFundingRatesFound, startDate, endDate)
+	}
+	return &gctfundingrate.HistoricalRates{
+		Exchange:     exchangeName,
+		Asset:        a,
+		Pair:         fPair,
+		StartDate:    filtered[0].Time,
+		EndDate:      filtered[len(filtered)-1].Time,
+		LatestRate:   filtered[len(filtered)-1],
+		FundingRates: filtered,
+	}, nil
+}
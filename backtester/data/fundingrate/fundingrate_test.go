@@ -0,0 +1,163 @@
+package fundingrate
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/drivers"
+	exchangeDB "github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+	fundingratesql "github.com/thrasher-corp/gocryptotrader/database/repository/fundingrate"
+	"github.com/thrasher-corp/gocryptotrader/database/testhelpers"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	gctfundingrate "github.com/thrasher-corp/gocryptotrader/exchanges/fundingrate"
+)
+
+const testExchange = "binance"
+
+var testCSV = filepath.Join("..", "..", "..", "testdata", "binance_BTCUSDT_perpetual_fundingrates_2022_01_01_2022_01_05.csv")
+
+func TestMain(m *testing.M) {
+	var err error
+	testhelpers.TempDir, err = os.MkdirTemp("", "gct-temp")
+	if err != nil {
+		fmt.Printf("failed to create temp file: %v", err)
+		os.Exit(1)
+	}
+
+	t := m.Run()
+
+	err = os.RemoveAll(testhelpers.TempDir)
+	if err != nil {
+		fmt.Printf("Failed to remove temp db file: %v", err)
+	}
+
+	os.Exit(t)
+}
+
+type fakeExchange struct {
+	exchange.IBotExchange
+	rates []gctfundingrate.Rate
+}
+
+func (f *fakeExchange) GetName() string {
+	return "Binance"
+}
+
+func (f *fakeExchange) GetHistoricalFundingRates(_ context.Context, r *gctfundingrate.HistoricalRatesRequest) (*gctfundingrate.HistoricalRates, error) {
+	if len(f.rates) == 0 {
+		return nil, gctfundingrate.ErrNoFundingRatesFound
+	}
+	return &gctfundingrate.HistoricalRates{
+		Asset:        r.Asset,
+		Pair:         r.Pair,
+		FundingRates: f.rates,
+	}, nil
+}
+
+func TestLoadFromAPI(t *testing.T) {
+	t.Parallel()
+	p := currency.NewBTCUSDT()
+	start := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(time.Hour * 24)
+	_, err := LoadFromAPI(t.Context(), nil, asset.PerpetualSwap, p, start, end)
+	assert.ErrorIs(t, err, gctcommon.ErrNilPointer)
+
+	_, err = LoadFromAPI(t.Context(), &fakeExchange{}, asset.PerpetualSwap, p, start, end)
+	assert.ErrorIs(t, err, gctfundingrate.ErrNoFundingRatesFound)
+
+	exch := &fakeExchange{
+		rates: []gctfundingrate.Rate{
+			{Time: start.Add(time.Hour * 16), Rate: decimal.NewFromFloat(0.0002)},
+			{Time: start, Rate: decimal.NewFromFloat(0.0001)},
+			{Time: start.Add(time.Hour * 48), Rate: decimal.NewFromFloat(0.0003)},
+		},
+	}
+	resp, err := LoadFromAPI(t.Context(), exch, asset.PerpetualSwap, p, start, end)
+	require.NoError(t, err)
+	assert.Equal(t, testExchange, resp.Exchange)
+	require.Len(t, resp.FundingRates, 2, "rates outside of the date range must be removed")
+	assert.True(t, resp.FundingRates[0].Time.Equal(start), "rates should be sorted by time")
+	assert.True(t, resp.LatestRate.Rate.Equal(decimal.NewFromFloat(0.0002)), "latest rate should be set")
+}
+
+func TestLoadFromCSV(t *testing.T) {
+	t.Parallel()
+	p := currency.NewBTCUSDT()
+	_, err := LoadFromCSV("fake", testExchange, asset.PerpetualSwap, p, time.Time{}, time.Time{})
+	assert.Error(t, err)
+
+	resp, err := LoadFromCSV(testCSV, testExchange, asset.PerpetualSwap, p, time.Time{}, time.Time{})
+	require.NoError(t, err)
+	require.Len(t, resp.FundingRates, 12)
+	assert.True(t, resp.FundingRates[0].Rate.Equal(decimal.NewFromFloat(0.0001)))
+	assert.True(t, resp.FundingRates[4].Rate.IsNegative(), "negative funding rates should be supported")
+
+	start := time.Date(2022, 1, 2, 0, 0, 0, 0, time.UTC)
+	resp, err = LoadFromCSV(testCSV, testExchange, asset.PerpetualSwap, p, start, start.Add(time.Hour*8))
+	require.NoError(t, err)
+	assert.Len(t, resp.FundingRates, 2)
+}
+
+func TestLoadFromDatabase(t *testing.T) {
+	t.Parallel()
+	dbConfig := database.Config{
+		Enabled: true,
+		Driver:  database.DBSQLite3,
+		ConnectionDetails: drivers.ConnectionDetails{
+			Database: "fundingrate",
+		},
+	}
+	database.MigrationDir = filepath.Join("..", "..", "..", "database", "migrations")
+	testhelpers.MigrationDir = filepath.Join("..", "..", "..", "database", "migrations")
+	conn, err := testhelpers.ConnectToDatabase(&dbConfig)
+	require.NoError(t, err)
+	defer func() {
+		assert.NoError(t, testhelpers.CloseDatabase(conn))
+	}()
+
+	require.NoError(t, exchangeDB.InsertMany([]exchangeDB.Details{{Name: testExchange}}))
+	p := currency.NewBTCUSDT()
+	start := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	err = fundingratesql.Insert(
+		fundingratesql.Data{Exchange: testExchange, Base: p.Base.String(), Quote: p.Quote.String(), AssetType: asset.PerpetualSwap.String(), Rate: 0.0001, Timestamp: start},
+		fundingratesql.Data{Exchange: testExchange, Base: p.Base.String(), Quote: p.Quote.String(), AssetType: asset.PerpetualSwap.String(), Rate: -0.0002, Timestamp: start.Add(time.Hour * 8)},
+	)
+	require.NoError(t, err)
+
+	_, err = LoadFromDatabase(testExchange, asset.PerpetualSwap, p, start.Add(time.Hour*24), start.Add(time.Hour*48))
+	assert.ErrorIs(t, err, gctfundingrate.ErrNoFundingRatesFound)
+
+	resp, err := LoadFromDatabase(testExchange, asset.PerpetualSwap, p, start, start.Add(time.Hour*24))
+	require.NoError(t, err)
+	require.Len(t, resp.FundingRates, 2)
+	assert.True(t, resp.FundingRates[1].Rate.Equal(decimal.NewFromFloat(-0.0002)))
+}
+
+func TestProcessRates(t *testing.T) {
+	t.Parallel()
+	p := currency.NewBTCUSDT()
+	start := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	_, err := processRates(testExchange, asset.PerpetualSwap, p, start, start.Add(time.Hour), nil)
+	assert.ErrorIs(t, err, gctfundingrate.ErrNoFundingRatesFound)
+
+	resp, err := processRates(testExchange, asset.PerpetualSwap, p, time.Time{}, time.Time{}, []gctfundingrate.Rate{
+		{Time: start.Add(time.Hour * 8)},
+		{Time: start},
+		{Time: start},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.FundingRates, 2, "duplicate rates must be removed")
+	assert.True(t, resp.StartDate.Equal(start))
+	assert.True(t, resp.EndDate.Equal(start.Add(time.Hour*8)))
+}
@@ -0,0 +1,309 @@
+package orderbook
+
+import (
+	"cmp"
+	"compress/gzip"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	orderbooksql "github.com/thrasher-corp/gocryptotrader/database/repository/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/encoding/json"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	gctorderbook "github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// NewReplay creates an orderbook replay from recorded snapshots and updates.
+// Entries are sorted chronologically, entries after the end date are discarded
+// and replay begins from the latest snapshot at or before the start date
+func NewReplay(exchangeName string, a asset.Item, cp currency.Pair, entries []Entry, startDate, endDate time.Time) (*Replay, error) {
+	if exchangeName == "" {
+		return nil, fmt.Errorf("%w exchange name", gctcommon.ErrEmptyParams)
+	}
+	if !a.IsValid() {
+		return nil, fmt.Errorf("%w %v", asset.ErrNotSupported, a)
+	}
+	if cp.IsEmpty() {
+		return nil, currency.ErrCurrencyPairEmpty
+	}
+	filtered := make([]Entry, 0, len(entries))
+	for i := range entries {
+		if !endDate.IsZero() && entries[i].Timestamp.After(endDate) {
+			continue
+		}
+		filtered = append(filtered, entries[i])
+	}
+	slices.SortStableFunc(filtered, func(a, b Entry) int {
+		if c := a.Timestamp.Compare(b.Timestamp); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.UpdateID, b.UpdateID)
+	})
+	first := -1
+	for i := range filtered {
+		if !filtered[i].Snapshot {
+			continue
+		}
+		if first != -1 && filtered[i].Timestamp.After(startDate) {
+			break
+		}
+		first = i
+		if startDate.IsZero() {
+			break
+		}
+	}
+	if first == -1 {
+		return nil, fmt.Errorf("%v %v %v %w between %v and %v", exchangeName, a, cp, ErrNoSnapshot, startDate, endDate)
+	}
+	filtered = filtered[first:]
+	for i := range filtered {
+		if filtered[i].Snapshot {
+			filtered[i].Bids.SortBids()
+			filtered[i].Asks.SortAsks()
+		}
+	}
+	id, err := uuid.NewV4()
+	if err != nil {
+		return nil, err
+	}
+	depth := gctorderbook.NewDepth(id)
+	depth.AssignOptions(&gctorderbook.Book{
+		Exchange: exchangeName,
+		Asset:    a,
+		Pair:     cp,
+	})
+	return &Replay{
+		exchange: exchangeName,
+		asset:    a,
+		pair:     cp,
+		entries:  filtered,
+		depth:    depth,
+	}, nil
+}
+
+// LoadFromFile reads recorded orderbook snapshots and updates from a file of
+// line delimited JSON entries. Files with a .gz extension are decompressed
+func LoadFromFile(filepath, exchangeName string, a asset.Item, cp currency.Pair, startDate, endDate time.Time) (*Replay, error) {
+	f, err := os.Open(filepath)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if closeErr := f.Close(); closeErr != nil {
+			log.Errorln(common.Data, closeErr)
+		}
+	}()
+	var r io.Reader = f
+	if strings.HasSuffix(strings.ToLower(filepath), ".gz") {
+		var gz *gzip.Reader
+		gz, err = gzip.NewReader(f)
+		if err != nil {
+			return nil, fmt.Errorf("could not decompress orderbook data for %v %v %v, %w", exchangeName, a, cp, err)
+		}
+		defer func() {
+			if closeErr := gz.Close(); closeErr != nil {
+				log.Errorln(common.Data, closeErr)
+			}
+		}()
+		r = gz
+	}
+	entries, err := readEntries(r, exchangeName, a, cp)
+	if err != nil {
+		return nil, fmt.Errorf("could not read orderbook data for %v %v %v, %w", exchangeName, a, cp, err)
+	}
+	return NewReplay(exchangeName, a, cp, entries, startDate, endDate)
+}
+
+// readEntries decodes line delimited JSON entries, skipping entries recorded
+// for other exchanges, assets or pairs
+func readEntries(r io.Reader, exchangeName string, a asset.Item, cp currency.Pair) ([]Entry, error) {
+	var entries []Entry
+	decoder := json.NewDecoder(r)
+	for {
+		var fe fileEntry
+		if err := decoder.Decode(&fe); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, err
+		}
+		if fe.Exchange != "" && !strings.EqualFold(fe.Exchange, exchangeName) {
+			continue
+		}
+		if fe.Asset != "" {
+			fa, err := asset.New(fe.Asset)
+			if err != nil {
+				return nil, fmt.Errorf("%w %w", errInvalidEntry, err)
+			}
+			if fa != a {
+				continue
+			}
+		}
+		if fe.Pair != "" {
+			fp, err := currency.NewPairFromString(fe.Pair)
+			if err != nil {
+				return nil, fmt.Errorf("%w %w", errInvalidEntry, err)
+			}
+			if !fp.Equal(cp) {
+				continue
+			}
+		}
+		if fe.Timestamp.Time().IsZero() {
+			return nil, fmt.Errorf("%w update ID %v timestamp unset", errInvalidEntry, fe.UpdateID)
+		}
+		entries = append(entries, Entry{
+			Timestamp:   fe.Timestamp.Time().UTC(),
+			UpdateID:    fe.UpdateID,
+			Snapshot:    fe.Snapshot,
+			Invalidated: fe.Invalidated,
+			Bids:        fe.Bids.Levels(),
+			Asks:        fe.Asks.Levels(),
+		})
+	}
+	if len(entries) == 0 {
+		return nil, ErrNoEntries
+	}
+	return entries, nil
+}
+
+// LoadFromDatabase retrieves recorded orderbook snapshots and updates from
+// GoCryptoTrader's database, including the latest snapshot before the start date
+func LoadFromDatabase(exchangeName string, a asset.Item, cp currency.Pair, startDate, endDate time.Time) (*Replay, error) {
+	var dbEntries []orderbooksql.Data
+	snapshot, err := orderbooksql.GetLatestSnapshotBefore(exchangeName, a.String(), cp.Base.String(), cp.Quote.String(), startDate)
+	switch {
+	case err == nil:
+		dbEntries = append(dbEntries, snapshot)
+	case !errors.Is(err, sql.ErrNoRows):
+		return nil, fmt.Errorf("could not retrieve database orderbook data for %v %v %v, %w", exchangeName, a, cp, err)
+	}
+	ranged, err := orderbooksql.GetInRange(exchangeName, a.String(), cp.Base.String(), cp.Quote.String(), startDate, endDate)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve database orderbook data for %v %v %v, %w", exchangeName, a, cp, err)
+	}
+	dbEntries = append(dbEntries, ranged...)
+	if len(dbEntries) == 0 {
+		return nil, fmt.Errorf("%v %v %v %w between %v and %v", exchangeName, a, cp, ErrNoEntries, startDate, endDate)
+	}
+	entries := make([]Entry, len(dbEntries))
+	for i := range dbEntries {
+		entries[i] = Entry{
+			Timestamp: dbEntries[i].Timestamp.UTC(),
+			UpdateID:  dbEntries[i].UpdateID,
+			Snapshot:  dbEntries[i].Snapshot,
+			Bids:      convertLevels(dbEntries[i].Bids),
+			Asks:      convertLevels(dbEntries[i].Asks),
+		}
+	}
+	return NewReplay(exchangeName, a, cp, entries, startDate, endDate)
+}
+
+func convertLevels(levels []orderbooksql.Level) gctorderbook.Levels {
+	resp := make(gctorderbook.Levels, len(levels))
+	for i := range levels {
+		resp[i] = gctorderbook.Level{
+			Price:  levels[i].Price,
+			Amount: levels[i].Amount,
+		}
+	}
+	return resp
+}
+
+// Advance applies all recorded snapshots and updates up to and including
+// time t. When set, the observer is called with the orderbook after each
+// applied entry so that changes between data events can be assessed
+func (r *Replay) Advance(t time.Time, observer Observer) error {
+	if r == nil {
+		return fmt.Errorf("%w orderbook replay", gctcommon.ErrNilPointer)
+	}
+	var errs error
+	for ; r.offset < len(r.entries) && !r.entries[r.offset].Timestamp.After(t); r.offset++ {
+		if err := r.apply(&r.entries[r.offset]); err != nil {
+			errs = gctcommon.AppendError(errs, err)
+			continue
+		}
+		if observer == nil || !r.hasSnapshot {
+			continue
+		}
+		book, err := r.depth.Retrieve()
+		if err != nil {
+			errs = gctcommon.AppendError(errs, err)
+			continue
+		}
+		if err := observer(book); err != nil {
+			errs = gctcommon.AppendError(errs, err)
+		}
+	}
+	return errs
+}
+
+// apply loads a snapshot or applies an incremental update to the depth.
+// Updates are ignored until a valid snapshot has been loaded, including after
+// an invalidated entry
+func (r *Replay) apply(e *Entry) error {
+	if e.Invalidated {
+		r.hasSnapshot = false
+		return nil
+	}
+	if e.Snapshot {
+		err := r.depth.LoadSnapshot(&gctorderbook.Book{
+			Bids:         e.Bids,
+			Asks:         e.Asks,
+			Exchange:     r.exchange,
+			Pair:         r.pair,
+			Asset:        r.asset,
+			LastUpdated:  e.Timestamp,
+			LastUpdateID: e.UpdateID,
+		})
+		r.hasSnapshot = err == nil
+		return err
+	}
+	if !r.hasSnapshot {
+		return nil
+	}
+	err := r.depth.ProcessUpdate(&gctorderbook.Update{
+		UpdateID:   e.UpdateID,
+		UpdateTime: e.Timestamp,
+		Asset:      r.asset,
+		Pair:       r.pair,
+		Bids:       e.Bids,
+		Asks:       e.Asks,
+		AllowEmpty: true,
+	})
+	if err != nil {
+		r.hasSnapshot = false
+		return fmt.Errorf("%v %v %v update ID %v at %v %w", r.exchange, r.asset, r.pair, e.UpdateID, e.Timestamp, err)
+	}
+	return nil
+}
+
+// GetOrderbook returns the orderbook as of the last replayed entry
+func (r *Replay) GetOrderbook() (*gctorderbook.Book, error) {
+	if r == nil {
+		return nil, fmt.Errorf("%w orderbook replay", gctcommon.ErrNilPointer)
+	}
+	if !r.hasSnapshot {
+		return nil, fmt.Errorf("%v %v %v %w", r.exchange, r.asset, r.pair, ErrNoSnapshot)
+	}
+	return r.depth.Retrieve()
+}
+
+// Reset returns the replay to its first entry so it can be replayed again
+func (r *Replay) Reset() error {
+	if r == nil {
+		return fmt.Errorf("%w orderbook replay", gctcommon.ErrNilPointer)
+	}
+	r.offset = 0
+	r.hasSnapshot = false
+	return nil
+}
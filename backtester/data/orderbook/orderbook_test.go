@@ -0,0 +1,303 @@
+package orderbook
+
+import (
+	"compress/gzip"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/drivers"
+	exchangeDB "github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+	orderbooksql "github.com/thrasher-corp/gocryptotrader/database/repository/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/database/testhelpers"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	gctorderbook "github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+const testExchange = "binance"
+
+var (
+	testFile  = filepath.Join("..", "..", "..", "testdata", "binance_BTCUSDT_orderbook_2019_01_01_2019_01_08.jsonl.gz")
+	testStart = time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)
+)
+
+func TestMain(m *testing.M) {
+	var err error
+	testhelpers.TempDir, err = os.MkdirTemp("", "gct-temp")
+	if err != nil {
+		fmt.Printf("failed to create temp file: %v", err)
+		os.Exit(1)
+	}
+
+	t := m.Run()
+
+	err = os.RemoveAll(testhelpers.TempDir)
+	if err != nil {
+		fmt.Printf("Failed to remove temp db file: %v", err)
+	}
+
+	os.Exit(t)
+}
+
+func testEntries() []Entry {
+	return []Entry{
+		{
+			Timestamp: testStart.Add(time.Minute * 2),
+			UpdateID:  3,
+			Bids:      gctorderbook.Levels{{Price: 99, Amount: 0}},
+		},
+		{
+			Timestamp: testStart.Add(time.Minute),
+			UpdateID:  2,
+			Snapshot:  true,
+			Bids:      gctorderbook.Levels{{Price: 98, Amount: 2}, {Price: 99, Amount: 1}},
+			Asks:      gctorderbook.Levels{{Price: 102, Amount: 2}, {Price: 101, Amount: 1}},
+		},
+		{
+			Timestamp: testStart,
+			UpdateID:  1,
+			Snapshot:  true,
+			Bids:      gctorderbook.Levels{{Price: 90, Amount: 1}},
+			Asks:      gctorderbook.Levels{{Price: 110, Amount: 1}},
+		},
+		{
+			Timestamp: testStart.Add(time.Hour),
+			UpdateID:  4,
+			Asks:      gctorderbook.Levels{{Price: 100, Amount: 5}},
+		},
+	}
+}
+
+func TestNewReplay(t *testing.T) {
+	t.Parallel()
+	p := currency.NewBTCUSDT()
+	_, err := NewReplay("", asset.Spot, p, nil, testStart, testStart)
+	assert.ErrorIs(t, err, gctcommon.ErrEmptyParams)
+
+	_, err = NewReplay(testExchange, asset.Empty, p, nil, testStart, testStart)
+	assert.ErrorIs(t, err, asset.ErrNotSupported)
+
+	_, err = NewReplay(testExchange, asset.Spot, currency.EMPTYPAIR, nil, testStart, testStart)
+	assert.ErrorIs(t, err, currency.ErrCurrencyPairEmpty)
+
+	_, err = NewReplay(testExchange, asset.Spot, p, testEntries()[:1], testStart, testStart)
+	assert.ErrorIs(t, err, ErrNoSnapshot)
+
+	r, err := NewReplay(testExchange, asset.Spot, p, testEntries(), testStart.Add(time.Minute*30), testStart.Add(time.Minute*30))
+	require.NoError(t, err)
+	require.Len(t, r.entries, 2, "entries before the latest snapshot and after the end date must be removed")
+	assert.Equal(t, int64(2), r.entries[0].UpdateID)
+	assert.Equal(t, 99.0, r.entries[0].Bids[0].Price, "snapshot bids must be sorted")
+	assert.Equal(t, 101.0, r.entries[0].Asks[0].Price, "snapshot asks must be sorted")
+
+	r, err = NewReplay(testExchange, asset.Spot, p, testEntries(), time.Time{}, time.Time{})
+	require.NoError(t, err)
+	assert.Len(t, r.entries, 4, "replay must start from the first snapshot without a start date")
+}
+
+func TestAdvance(t *testing.T) {
+	t.Parallel()
+	var r *Replay
+	assert.ErrorIs(t, r.Advance(testStart, nil), gctcommon.ErrNilPointer)
+
+	r, err := NewReplay(testExchange, asset.Spot, currency.NewBTCUSDT(), testEntries(), time.Time{}, time.Time{})
+	require.NoError(t, err)
+
+	_, err = r.GetOrderbook()
+	assert.ErrorIs(t, err, ErrNoSnapshot)
+
+	var observed []float64
+	observer := func(ob *gctorderbook.Book) error {
+		observed = append(observed, ob.Bids[0].Price)
+		return nil
+	}
+	require.NoError(t, r.Advance(testStart.Add(time.Minute*2), observer))
+	assert.Equal(t, []float64{90, 99, 98}, observed, "observer must be called after each entry")
+
+	ob, err := r.GetOrderbook()
+	require.NoError(t, err)
+	require.Len(t, ob.Bids, 1)
+	assert.Equal(t, 98.0, ob.Bids[0].Price)
+
+	require.NoError(t, r.Advance(testStart.Add(time.Hour), nil))
+	ob, err = r.GetOrderbook()
+	require.NoError(t, err)
+	require.Len(t, ob.Asks, 3)
+	assert.Equal(t, 100.0, ob.Asks[0].Price)
+
+	errObserver := func(*gctorderbook.Book) error { return errInvalidEntry }
+	require.NoError(t, r.Reset())
+	assert.ErrorIs(t, r.Advance(testStart, errObserver), errInvalidEntry)
+
+	entries := append(testEntries(),
+		Entry{Timestamp: testStart.Add(time.Hour * 2), Invalidated: true},
+		Entry{Timestamp: testStart.Add(time.Hour * 3), UpdateID: 10, Bids: gctorderbook.Levels{{Price: 80, Amount: 1}}},
+	)
+	r, err = NewReplay(testExchange, asset.Spot, currency.NewBTCUSDT(), entries, time.Time{}, time.Time{})
+	require.NoError(t, err)
+	require.NoError(t, r.Advance(testStart.Add(time.Hour*3), nil))
+	_, err = r.GetOrderbook()
+	assert.ErrorIs(t, err, ErrNoSnapshot, "invalidated entries must discard the orderbook until the next snapshot")
+}
+
+func TestGetOrderbook(t *testing.T) {
+	t.Parallel()
+	var r *Replay
+	_, err := r.GetOrderbook()
+	assert.ErrorIs(t, err, gctcommon.ErrNilPointer)
+
+	r, err = NewReplay(testExchange, asset.Spot, currency.NewBTCUSDT(), testEntries(), time.Time{}, time.Time{})
+	require.NoError(t, err)
+	require.NoError(t, r.Advance(testStart, nil))
+	ob, err := r.GetOrderbook()
+	require.NoError(t, err)
+	assert.Equal(t, testExchange, ob.Exchange)
+	assert.True(t, ob.LastUpdated.Equal(testStart))
+}
+
+func TestReset(t *testing.T) {
+	t.Parallel()
+	var r *Replay
+	assert.ErrorIs(t, r.Reset(), gctcommon.ErrNilPointer)
+
+	r, err := NewReplay(testExchange, asset.Spot, currency.NewBTCUSDT(), testEntries(), time.Time{}, time.Time{})
+	require.NoError(t, err)
+	require.NoError(t, r.Advance(testStart.Add(time.Hour), nil))
+	require.NoError(t, r.Reset())
+	assert.Zero(t, r.offset)
+	_, err = r.GetOrderbook()
+	assert.ErrorIs(t, err, ErrNoSnapshot)
+}
+
+func TestLoadFromFile(t *testing.T) {
+	t.Parallel()
+	p := currency.NewBTCUSDT()
+	_, err := LoadFromFile("", testExchange, asset.Spot, p, testStart, testStart)
+	assert.ErrorIs(t, err, os.ErrNotExist)
+
+	r, err := LoadFromFile(testFile, testExchange, asset.Spot, p, testStart.Add(time.Hour*36), testStart.Add(time.Hour*48))
+	require.NoError(t, err)
+	require.NoError(t, r.Advance(testStart.Add(time.Hour*48), nil))
+	ob, err := r.GetOrderbook()
+	require.NoError(t, err)
+	assert.NotEmpty(t, ob.Bids)
+	assert.NotEmpty(t, ob.Asks)
+
+	dir := t.TempDir()
+	plain := filepath.Join(dir, "orderbook.jsonl")
+	data := `{"exchange":"binance","asset":"spot","pair":"BTC-USDT","timestamp":1546300800000,"update_id":1,"snapshot":true,"bids":[["99","1"]],"asks":[["101","1"]]}
+{"exchange":"binance","asset":"spot","pair":"ETH-USDT","timestamp":1546300800000,"update_id":1,"snapshot":true,"bids":[["9","1"]],"asks":[["11","1"]]}
+{"exchange":"okx","timestamp":1546300800000,"update_id":1,"snapshot":true,"bids":[["98","1"]],"asks":[["102","1"]]}
+`
+	require.NoError(t, os.WriteFile(plain, []byte(data), 0o600))
+	r, err = LoadFromFile(plain, testExchange, asset.Spot, p, testStart, testStart)
+	require.NoError(t, err)
+	assert.Len(t, r.entries, 1, "entries for other exchanges and pairs must be skipped")
+
+	_, err = LoadFromFile(plain, testExchange, asset.Futures, p, testStart, testStart)
+	assert.ErrorIs(t, err, ErrNoEntries)
+
+	compressed := filepath.Join(dir, "orderbook.jsonl.gz")
+	f, err := os.Create(compressed)
+	require.NoError(t, err)
+	gz := gzip.NewWriter(f)
+	_, err = gz.Write([]byte(data))
+	require.NoError(t, err)
+	require.NoError(t, gz.Close())
+	require.NoError(t, f.Close())
+	r, err = LoadFromFile(compressed, testExchange, asset.Spot, p, testStart, testStart)
+	require.NoError(t, err)
+	assert.Len(t, r.entries, 1)
+
+	require.NoError(t, os.WriteFile(compressed, []byte(data), 0o600))
+	_, err = LoadFromFile(compressed, testExchange, asset.Spot, p, testStart, testStart)
+	assert.ErrorIs(t, err, gzip.ErrHeader)
+}
+
+func TestReadEntries(t *testing.T) {
+	t.Parallel()
+	p := currency.NewBTCUSDT()
+	_, err := readEntries(strings.NewReader(`{"asset":"fake","timestamp":1546300800}`), testExchange, asset.Spot, p)
+	assert.ErrorIs(t, err, errInvalidEntry)
+
+	_, err = readEntries(strings.NewReader(`{"pair":"*","timestamp":1546300800}`), testExchange, asset.Spot, p)
+	assert.ErrorIs(t, err, errInvalidEntry)
+
+	_, err = readEntries(strings.NewReader(`{"update_id":1}`), testExchange, asset.Spot, p)
+	assert.ErrorIs(t, err, errInvalidEntry)
+
+	_, err = readEntries(strings.NewReader(`{`), testExchange, asset.Spot, p)
+	assert.Error(t, err)
+
+	entries, err := readEntries(strings.NewReader(`{"timestamp":1546300800,"update_id":7,"bids":[[99,0]],"asks":[]}`), testExchange, asset.Spot, p)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.True(t, entries[0].Timestamp.Equal(testStart))
+	assert.Equal(t, int64(7), entries[0].UpdateID)
+	assert.False(t, entries[0].Snapshot)
+	assert.Equal(t, gctorderbook.Levels{{Price: 99}}, entries[0].Bids)
+
+	entries, err = readEntries(strings.NewReader(`{"timestamp":1546300800,"update_id":0,"snapshot":false,"invalidated":true,"bids":null,"asks":null}`), testExchange, asset.Spot, p)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.True(t, entries[0].Invalidated)
+}
+
+func TestLoadFromDatabase(t *testing.T) {
+	t.Parallel()
+	dbConfig := database.Config{
+		Enabled: true,
+		Driver:  database.DBSQLite3,
+		ConnectionDetails: drivers.ConnectionDetails{
+			Database: "orderbook",
+		},
+	}
+	database.MigrationDir = filepath.Join("..", "..", "..", "database", "migrations")
+	testhelpers.MigrationDir = filepath.Join("..", "..", "..", "database", "migrations")
+	conn, err := testhelpers.ConnectToDatabase(&dbConfig)
+	require.NoError(t, err)
+	defer func() {
+		assert.NoError(t, testhelpers.CloseDatabase(conn))
+	}()
+
+	require.NoError(t, exchangeDB.InsertMany([]exchangeDB.Details{{Name: testExchange}}))
+	p := currency.NewBTCUSDT()
+	err = orderbooksql.Insert(
+		orderbooksql.Data{
+			Exchange: testExchange, Base: p.Base.String(), Quote: p.Quote.String(), AssetType: asset.Spot.String(),
+			UpdateID: 1, Snapshot: true, Timestamp: testStart,
+			Bids: []orderbooksql.Level{{Price: 99, Amount: 1}},
+			Asks: []orderbooksql.Level{{Price: 101, Amount: 1}},
+		},
+		orderbooksql.Data{
+			Exchange: testExchange, Base: p.Base.String(), Quote: p.Quote.String(), AssetType: asset.Spot.String(),
+			UpdateID: 2, Timestamp: testStart.Add(time.Hour * 2),
+			Asks: []orderbooksql.Level{{Price: 100, Amount: 3}},
+		},
+	)
+	require.NoError(t, err)
+
+	_, err = LoadFromDatabase("fake", asset.Spot, p, testStart, testStart)
+	assert.Error(t, err)
+
+	_, err = LoadFromDatabase(testExchange, asset.Futures, p, testStart, testStart.Add(time.Hour*4))
+	assert.ErrorIs(t, err, ErrNoEntries)
+
+	r, err := LoadFromDatabase(testExchange, asset.Spot, p, testStart.Add(time.Hour), testStart.Add(time.Hour*4))
+	require.NoError(t, err)
+	require.Len(t, r.entries, 2, "the latest snapshot before the start date must be included")
+	require.NoError(t, r.Advance(testStart.Add(time.Hour*4), nil))
+	ob, err := r.GetOrderbook()
+	require.NoError(t, err)
+	require.Len(t, ob.Asks, 2)
+	assert.Equal(t, 100.0, ob.Asks[0].Price)
+	assert.Equal(t, 3.0, ob.Asks[0].Amount)
+}
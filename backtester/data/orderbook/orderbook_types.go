@@ -0,0 +1,65 @@
+package orderbook
+
+import (
+	"errors"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	gctorderbook "github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/types"
+)
+
+var (
+	// ErrNoSnapshot is returned when there is no orderbook snapshot to
+	// replay updates against
+	ErrNoSnapshot = errors.New("no orderbook snapshot")
+	// ErrNoEntries is returned when no orderbook data is found
+	ErrNoEntries = errors.New("no orderbook entries found")
+
+	errInvalidEntry = errors.New("invalid orderbook entry")
+)
+
+// Entry is a recorded orderbook snapshot or incremental update. Incremental
+// update levels replace the amount at their price, with an amount of zero
+// removing the price level. Invalidated entries mark the orderbook as unknown
+// until the next snapshot
+type Entry struct {
+	Timestamp   time.Time
+	UpdateID    int64
+	Snapshot    bool
+	Invalidated bool
+	Bids        gctorderbook.Levels
+	Asks        gctorderbook.Levels
+}
+
+// Replay reconstructs orderbook depth from recorded snapshots and incremental
+// updates in chronological order, allowing fills to be simulated against the
+// orderbook as it was at any point of a backtesting run
+type Replay struct {
+	exchange    string
+	asset       asset.Item
+	pair        currency.Pair
+	entries     []Entry
+	offset      int
+	depth       *gctorderbook.Depth
+	hasSnapshot bool
+}
+
+// Observer is called with the orderbook after each replayed entry
+type Observer func(*gctorderbook.Book) error
+
+// fileEntry is the line delimited JSON format of recorded orderbook files.
+// The exchange, asset and pair fields are optional and allow a single file to
+// contain recordings for multiple orderbooks
+type fileEntry struct {
+	Exchange    string                              `json:"exchange,omitempty"`
+	Asset       string                              `json:"asset,omitempty"`
+	Pair        string                              `json:"pair,omitempty"`
+	Timestamp   types.Time                          `json:"timestamp"`
+	UpdateID    int64                               `json:"update_id"`
+	Snapshot    bool                                `json:"snapshot"`
+	Invalidated bool                                `json:"invalidated,omitempty"`
+	Bids        gctorderbook.LevelsArrayPriceAmount `json:"bids"`
+	Asks        gctorderbook.LevelsArrayPriceAmount `json:"asks"`
+}
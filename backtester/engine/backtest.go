@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/gofrs/uuid"
+	"github.com/shopspring/decimal"
 	"github.com/thrasher-corp/gocryptotrader/backtester/common"
 	"github.com/thrasher-corp/gocryptotrader/backtester/data"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/exchange"
@@ -299,6 +300,10 @@ func (bt *BackTest) processSingleDataEvent(ev data.Event, funds funding.IFundRel
 	if err != nil {
 		return err
 	}
+	err = bt.processRestingOrders(ev, funds)
+	if err != nil {
+		log.Errorf(common.Backtester, "processRestingOrders %v", err)
+	}
 	d, err := bt.DataHolder.GetDataForCurrency(ev)
 	if err != nil {
 		return err
@@ -358,6 +363,10 @@ func (bt *BackTest) processSimultaneousDataEvents() error {
 				log.Errorln(common.Backtester, err)
 			}
 		}
+		err = bt.processRestingOrders(latestData, funds.FundReleaser())
+		if err != nil {
+			log.Errorf(common.Backtester, "processRestingOrders %v", err)
+		}
 		dataEvents = append(dataEvents, dataHolders[i])
 	}
 	signals, err := bt.Strategy.OnSimultaneousSignals(dataEvents, bt.Funding, bt.Portfolio)
@@ -414,6 +423,11 @@ func (bt *BackTest) updateStatsForDataEvent(ev data.Event, funds funding.IFundRe
 			return err
 		}
 
+		err = bt.applyFundingRates(ev, cr)
+		if err != nil {
+			return err
+		}
+
 		err = bt.Portfolio.UpdatePNL(ev, ev.GetClosePrice())
 		if err != nil {
 			if errors.Is(err, futures.ErrPositionNotFound) {
@@ -452,6 +466,42 @@ func (bt *BackTest) updateStatsForDataEvent(ev data.Event, funds funding.IFundRe
 	return nil
 }
 
+// applyFundingRates applies any perpetual funding rates which have occurred
+// by the data event's time to the latest open position and realises the
+// payment against collateral
+func (bt *BackTest) applyFundingRates(ev data.Event, cr funding.ICollateralReleaser) error {
+	side := gctorder.UnknownSide
+	size := decimal.Zero
+	positions, err := bt.Portfolio.GetPositions(ev)
+	if err != nil && !errors.Is(err, futures.ErrPositionNotFound) {
+		return fmt.Errorf("GetPositions %v %v %v %v", ev.GetExchange(), ev.GetAssetType(), ev.Pair(), err)
+	}
+	if len(positions) > 0 && positions[len(positions)-1].Status == gctorder.Open {
+		side = positions[len(positions)-1].LatestDirection
+		size = positions[len(positions)-1].LatestSize
+	}
+	payment, err := cr.ApplyFundingRates(ev.GetTime(), side, size, ev.GetClosePrice())
+	if err != nil {
+		return fmt.Errorf("ApplyFundingRates %v %v %v %v", ev.GetExchange(), ev.GetAssetType(), ev.Pair(), err)
+	}
+	if payment.IsZero() {
+		return nil
+	}
+	exch, err := bt.exchangeManager.GetExchangeByName(ev.GetExchange())
+	if err != nil {
+		return fmt.Errorf("GetExchangeByName %v %v %v %v", ev.GetExchange(), ev.GetAssetType(), ev.Pair(), err)
+	}
+	receivingCurrency, receivingAsset, err := exch.GetCurrencyForRealisedPNL(ev.GetAssetType(), ev.Pair())
+	if err != nil {
+		return fmt.Errorf("GetCurrencyForRealisedPNL %v %v %v %v", ev.GetExchange(), ev.GetAssetType(), ev.Pair(), err)
+	}
+	err = bt.Funding.RealisePNL(ev.GetExchange(), receivingAsset, receivingCurrency, payment)
+	if err != nil {
+		return fmt.Errorf("RealisePNL %v %v %v %v", ev.GetExchange(), ev.GetAssetType(), ev.Pair(), err)
+	}
+	return bt.Funding.UpdateCollateralForEvent(ev, false)
+}
+
 // processSignalEvent receives an event from the strategy for processing under the portfolio
 func (bt *BackTest) processSignalEvent(ev signal.Event, funds funding.IFundReserver) error {
 	if ev == nil {
@@ -497,9 +547,20 @@ func (bt *BackTest) processOrderEvent(ev order.Event, funds funding.IFundRelease
 			log.Errorf(common.Backtester, "ExecuteOrder fill event should always be returned, please fix, %v", err)
 			return fmt.Errorf("ExecuteOrder fill event should always be returned, please fix, %v", err)
 		}
-		if !errors.Is(err, exchange.ErrCannotTransact) {
+		switch {
+		case errors.Is(err, exchange.ErrOrderCancelled):
+			err = bt.Strategy.OnOrderCancelled(ev)
+			if err != nil {
+				log.Errorf(common.Backtester, "OnOrderCancelled %v %v %v %v", ev.GetExchange(), ev.GetAssetType(), ev.Pair(), err)
+			}
+		case !errors.Is(err, exchange.ErrCannotTransact):
 			log.Errorf(common.Backtester, "ExecuteOrder %v %v %v %v", f.GetExchange(), f.GetAssetType(), f.Pair(), err)
 		}
+	} else if f.GetOrder() != nil && ev.GetOrderType() != gctorder.UnknownType && ev.GetOrderType() != gctorder.Market {
+		err = bt.Strategy.OnOrderFilled(f)
+		if err != nil {
+			log.Errorf(common.Backtester, "OnOrderFilled %v %v %v %v", ev.GetExchange(), ev.GetAssetType(), ev.Pair(), err)
+		}
 	}
 	err = bt.Statistic.SetEventForOffset(f)
 	if err != nil {
@@ -509,6 +570,31 @@ func (bt *BackTest) processOrderEvent(ev order.Event, funds funding.IFundRelease
 	return nil
 }
 
+// processRestingOrders fills or cancels any resting non-market orders for the
+// data event before the strategy assesses it. Fills are processed immediately
+// so that holdings are up to date when the strategy raises its signal
+func (bt *BackTest) processRestingOrders(ev data.Event, funds funding.IFundReleaser) error {
+	filled, cancelled, err := bt.Exchange.ProcessRestingOrders(ev, bt.orderManager, funds)
+	for i := range filled {
+		fillErr := bt.processFillEvent(filled[i], funds)
+		if fillErr != nil {
+			err = gctcommon.AppendError(err, fillErr)
+			continue
+		}
+		fillErr = bt.Strategy.OnOrderFilled(filled[i])
+		if fillErr != nil {
+			err = gctcommon.AppendError(err, fmt.Errorf("OnOrderFilled %v %v %v %w", ev.GetExchange(), ev.GetAssetType(), ev.Pair(), fillErr))
+		}
+	}
+	for i := range cancelled {
+		cancelErr := bt.Strategy.OnOrderCancelled(cancelled[i])
+		if cancelErr != nil {
+			err = gctcommon.AppendError(err, fmt.Errorf("OnOrderCancelled %v %v %v %w", ev.GetExchange(), ev.GetAssetType(), ev.Pair(), cancelErr))
+		}
+	}
+	return err
+}
+
 func (bt *BackTest) processFillEvent(ev fill.Event, funds funding.IFundReleaser) error {
 	_, err := bt.Portfolio.OnFill(ev, funds)
 	if err != nil {
@@ -44,6 +44,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/binance"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/binanceus"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fundingrate"
 	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
 )
@@ -235,6 +236,111 @@ func TestLoadDataCSV(t *testing.T) {
 	}
 }
 
+func TestLoadFundingRates(t *testing.T) {
+	t.Parallel()
+	bt := &BackTest{}
+	cp := currency.NewPair(currency.BTC, currency.USDT)
+	cfg := &config.Config{}
+	_, err := bt.loadFundingRates(cfg, nil, cp, asset.USDTMarginedFutures)
+	assert.ErrorIs(t, err, engine.ErrExchangeNotFound)
+
+	exch := &binance.Exchange{}
+	exch.SetDefaults()
+	rates, err := bt.loadFundingRates(cfg, exch, cp, asset.USDTMarginedFutures)
+	assert.NoError(t, err, "loadFundingRates should not error without a data source")
+	assert.Nil(t, rates, "loadFundingRates should return no rates without a data source")
+
+	cfg.DataSettings.CSVData = &config.CSVData{}
+	rates, err = bt.loadFundingRates(cfg, exch, cp, asset.USDTMarginedFutures)
+	assert.NoError(t, err, "loadFundingRates should not error without a funding rate file")
+	assert.Nil(t, rates, "loadFundingRates should return no rates without a funding rate file")
+
+	cfg.DataSettings.CSVData.FundingRateFullPath = filepath.Join("..", "..", "testdata", "binance_BTCUSDT_perpetual_fundingrates_2022_01_01_2022_01_05.csv")
+	rates, err = bt.loadFundingRates(cfg, exch, cp, asset.USDTMarginedFutures)
+	require.NoError(t, err, "loadFundingRates must not error")
+	require.NotNil(t, rates, "loadFundingRates must return rates")
+	assert.Len(t, rates.FundingRates, 12)
+}
+
+func TestLoadOrderbookData(t *testing.T) {
+	t.Parallel()
+	bt := &BackTest{}
+	cp := currency.NewBTCUSDT()
+	cfg := &config.Config{}
+	_, err := bt.loadOrderbookData(cfg, nil, cp, asset.Spot, nil)
+	assert.ErrorIs(t, err, engine.ErrExchangeNotFound)
+
+	exch := &binance.Exchange{}
+	exch.SetDefaults()
+	_, err = bt.loadOrderbookData(cfg, exch, cp, asset.Spot, nil)
+	assert.ErrorIs(t, err, gctcommon.ErrNilPointer)
+
+	cfg.DataSettings.OrderbookData = &config.OrderbookData{
+		FullPath: filepath.Join("..", "..", "testdata", "binance_BTCUSDT_orderbook_2019_01_01_2019_01_08.jsonl.gz"),
+	}
+	_, err = bt.loadOrderbookData(cfg, exch, cp, asset.Spot, nil)
+	assert.ErrorIs(t, err, errNilData)
+
+	start := time.Date(2019, 1, 2, 0, 0, 0, 0, time.UTC)
+	klineData := &kline.DataFromKline{
+		Item: &gctkline.Item{
+			Interval: gctkline.OneDay,
+			Candles:  []gctkline.Candle{{Time: start}, {Time: start.Add(gctkline.OneDay.Duration())}},
+		},
+	}
+	replay, err := bt.loadOrderbookData(cfg, exch, cp, asset.Spot, klineData)
+	require.NoError(t, err, "loadOrderbookData must not error")
+	require.NotNil(t, replay, "loadOrderbookData must return a replay")
+	require.NoError(t, replay.Advance(start, nil), "Advance must not error")
+	ob, err := replay.GetOrderbook()
+	require.NoError(t, err, "GetOrderbook must not error")
+	assert.True(t, ob.LastUpdated.Equal(start), "replay must begin at the candle data start date")
+}
+
+func TestApplyFundingRates(t *testing.T) {
+	t.Parallel()
+	cp := currency.NewPair(currency.BTC, currency.USDT)
+	a := asset.USDTMarginedFutures
+	f, err := funding.SetupFundingManager(&engine.ExchangeManager{}, false, true, false)
+	require.NoError(t, err, "SetupFundingManager must not error")
+	contract, err := funding.CreateItem(testExchange, a, currency.NewCode(cp.String()), decimal.Zero, decimal.Zero)
+	require.NoError(t, err, "CreateItem must not error")
+	collateral, err := funding.CreateItem(testExchange, a, cp.Quote, decimal.NewFromInt(1337), decimal.Zero)
+	require.NoError(t, err, "CreateItem must not error")
+	pair, err := funding.CreateCollateral(contract, collateral)
+	require.NoError(t, err, "CreateCollateral must not error")
+	err = f.AddItem(contract)
+	require.NoError(t, err, "AddItem must not error")
+	err = f.AddItem(collateral)
+	require.NoError(t, err, "AddItem must not error")
+
+	tt := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	err = f.SetFundingRates(testExchange, a, cp, &fundingrate.HistoricalRates{
+		FundingRates: []fundingrate.Rate{{Time: tt, Rate: decimal.NewFromFloat(0.01)}},
+	})
+	require.NoError(t, err, "SetFundingRates must not error")
+
+	bt := &BackTest{
+		Funding:   f,
+		Portfolio: &fakeFolio{},
+	}
+	ev := &evkline.Kline{
+		Base: &event.Base{
+			Exchange:     testExchange,
+			AssetType:    a,
+			CurrencyPair: cp,
+			Time:         tt,
+		},
+		Close: decimal.NewFromInt(1337),
+	}
+	cr, err := pair.CollateralReleaser()
+	require.NoError(t, err, "CollateralReleaser must not error")
+	err = bt.applyFundingRates(ev, cr)
+	assert.NoError(t, err, "applyFundingRates should not error without an open position")
+	assert.True(t, cr.FundingPaid().IsZero(), "FundingPaid should be zero without an open position")
+	assert.True(t, cr.FundingReceived().IsZero(), "FundingReceived should be zero without an open position")
+}
+
 func TestLoadDataDatabase(t *testing.T) {
 	t.Parallel()
 	bt := BackTest{
@@ -1057,6 +1163,39 @@ func TestProcessFillEvent(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestProcessRestingOrders(t *testing.T) {
+	t.Parallel()
+	bt := &BackTest{
+		Exchange:  &exchange.Exchange{},
+		Strategy:  &fakeStrat{},
+		Portfolio: &fakeFolio{},
+		Statistic: &fakeStats{},
+		Funding:   &fakeFunding{},
+	}
+	err := bt.processRestingOrders(nil, nil)
+	assert.ErrorIs(t, err, common.ErrNilEvent)
+
+	cp := currency.NewBTCUSDT()
+	ev := &evkline.Kline{
+		Base: &event.Base{
+			Exchange:     testExchange,
+			Time:         time.Now(),
+			Interval:     gctkline.FifteenMin,
+			CurrencyPair: cp,
+			AssetType:    asset.Spot,
+		},
+	}
+	b, err := funding.CreateItem(testExchange, asset.Spot, cp.Base, decimal.Zero, decimal.Zero)
+	require.NoError(t, err, "CreateItem must not error")
+	quote, err := funding.CreateItem(testExchange, asset.Spot, cp.Quote, decimal.NewFromInt(1337), decimal.Zero)
+	require.NoError(t, err, "CreateItem must not error")
+	pair, err := funding.CreatePair(b, quote)
+	require.NoError(t, err, "CreatePair must not error")
+
+	err = bt.processRestingOrders(ev, pair)
+	assert.NoError(t, err, "processRestingOrders should not error without resting orders")
+}
+
 func TestProcessFuturesFillEvent(t *testing.T) {
 	t.Parallel()
 	bt := &BackTest{
@@ -1689,6 +1828,7 @@ func TestNewBacktesterFromConfigs(t *testing.T) {
 func TestProcessSingleDataEvent(t *testing.T) {
 	t.Parallel()
 	bt := &BackTest{
+		Exchange:   &exchange.Exchange{},
 		Strategy:   &fakeStrat{},
 		Portfolio:  &fakeFolio{},
 		Statistic:  &fakeStats{},
@@ -73,6 +73,7 @@ type TaskMetaData struct {
 
 // TaskManager contains all strategy tasks
 type TaskManager struct {
-	m     sync.Mutex
-	tasks []*BackTest
+	m      sync.Mutex
+	tasks  []*BackTest
+	sweeps []*Sweep
 }
@@ -20,6 +20,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchange/accounts"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fundingrate"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
 	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
@@ -223,6 +224,10 @@ func (f fakeFunding) SetFunding(string, asset.Item, *accounts.Balance, bool) err
 	return nil
 }
 
+func (f fakeFunding) SetFundingRates(string, asset.Item, currency.Pair, *fundingrate.HistoricalRates) error {
+	return nil
+}
+
 func (f fakeFunding) Reset() error {
 	return nil
 }
@@ -313,6 +318,14 @@ func (f fakeStrat) SetCustomSettings(map[string]any) error {
 
 func (f fakeStrat) SetDefaults() {}
 
+func (f fakeStrat) OnOrderFilled(fill.Event) error {
+	return nil
+}
+
+func (f fakeStrat) OnOrderCancelled(order.Event) error {
+	return nil
+}
+
 func (f fakeStrat) CloseAllPositions([]holdings.Holding, []data.Event) ([]signal.Event, error) {
 	return []signal.Event{
 		&signal.Signal{
@@ -5,10 +5,12 @@ import (
 	"encoding/base64"
 	"errors"
 	"fmt"
+	"maps"
 	"math"
 	"net"
 	"net/http"
 	"path/filepath"
+	"slices"
 	"strings"
 	"time"
 
@@ -32,6 +34,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 var (
@@ -801,3 +804,190 @@ func (s *GRPCServer) ClearAllTasks(_ context.Context, _ *btrpc.ClearAllTasksRequ
 		RemainingTasks: remainingResponse,
 	}, nil
 }
+
+// convertSweepSummary converts a sweep summary into a RPC format
+func convertSweepSummary(sweep *SweepSummary) *btrpc.SweepSummary {
+	sweepSummary := &btrpc.SweepSummary{
+		Id:            sweep.ID.String(),
+		Nickname:      sweep.Nickname,
+		StrategyName:  sweep.Strategy,
+		RankBy:        sweep.RankBy,
+		TotalRuns:     sweep.TotalRuns,
+		CompletedRuns: sweep.CompletedRuns,
+	}
+	if !sweep.DateLoaded.IsZero() {
+		sweepSummary.DateLoaded = sweep.DateLoaded.Format(gctcommon.SimpleTimeFormatWithTimezone)
+	}
+	if !sweep.DateStarted.IsZero() {
+		sweepSummary.DateStarted = sweep.DateStarted.Format(gctcommon.SimpleTimeFormatWithTimezone)
+	}
+	if !sweep.DateEnded.IsZero() {
+		sweepSummary.DateEnded = sweep.DateEnded.Format(gctcommon.SimpleTimeFormatWithTimezone)
+	}
+	if sweep.Err != nil {
+		sweepSummary.Error = sweep.Err.Error()
+	}
+	return sweepSummary
+}
+
+// convertSweepResult converts a sweep result into a RPC format
+func convertSweepResult(result *SweepResult) *btrpc.SweepResult {
+	if result == nil {
+		return nil
+	}
+	keys := slices.Sorted(maps.Keys(result.CustomSettings))
+	customSettings := make([]*btrpc.CustomSettings, len(keys))
+	for i := range keys {
+		customSettings[i] = &btrpc.CustomSettings{
+			KeyField: keys[i],
+			KeyValue: fmt.Sprint(result.CustomSettings[keys[i]]),
+		}
+	}
+	sweepResult := &btrpc.SweepResult{
+		Rank:           int64(result.Rank),
+		CustomSettings: customSettings,
+		Score:          result.Score.String(),
+	}
+	if result.Ratios != nil {
+		sweepResult.Ratios = &btrpc.Ratios{
+			SharpeRatio:      result.Ratios.SharpeRatio.String(),
+			SortinoRatio:     result.Ratios.SortinoRatio.String(),
+			InformationRatio: result.Ratios.InformationRatio.String(),
+			CalmarRatio:      result.Ratios.CalmarRatio.String(),
+		}
+	}
+	if result.Err != nil {
+		sweepResult.Error = result.Err.Error()
+	}
+	return sweepResult
+}
+
+// convertSweepResults converts ranked sweep results into a RPC format,
+// limiting them to the best maximumResults when it is set
+func convertSweepResults(results []*SweepResult, maximumResults uint32) []*btrpc.SweepResult {
+	if maximumResults > 0 && len(results) > int(maximumResults) {
+		results = results[:maximumResults]
+	}
+	resp := make([]*btrpc.SweepResult, len(results))
+	for i := range results {
+		resp[i] = convertSweepResult(results[i])
+	}
+	return resp
+}
+
+// ExecuteSweepFromFile loads a parameter sweep from the filepath provided and
+// runs it in the background. Use GetSweepResults to retrieve its progress and
+// ranked results
+func (s *GRPCServer) ExecuteSweepFromFile(_ context.Context, request *btrpc.ExecuteSweepFromFileRequest) (*btrpc.ExecuteSweepResponse, error) {
+	if s.config == nil {
+		return nil, fmt.Errorf("%w server config", gctcommon.ErrNilPointer)
+	}
+	if s.manager == nil {
+		return nil, fmt.Errorf("%w task manager", gctcommon.ErrNilPointer)
+	}
+	if request == nil {
+		return nil, fmt.Errorf("%w request", gctcommon.ErrNilPointer)
+	}
+	cfg, err := config.ReadSweepConfigFromFile(request.SweepFilePath)
+	if err != nil {
+		return nil, err
+	}
+	sweep, err := NewSweep(cfg, s.config)
+	if err != nil {
+		return nil, err
+	}
+	err = s.manager.AddSweep(sweep)
+	if err != nil {
+		return nil, err
+	}
+	go func() {
+		if err := sweep.Run(); err != nil {
+			log.Errorf(log.GRPCSys, "Sweep %v failed: %v", sweep.ID, err)
+		}
+	}()
+	sum, err := sweep.GenerateSummary()
+	if err != nil {
+		return nil, err
+	}
+	return &btrpc.ExecuteSweepResponse{
+		Sweep: convertSweepSummary(sum),
+	}, nil
+}
+
+// ListAllSweeps returns all parameter sweeps managed by the server
+func (s *GRPCServer) ListAllSweeps(_ context.Context, _ *btrpc.ListAllSweepsRequest) (*btrpc.ListAllSweepsResponse, error) {
+	if s.manager == nil {
+		return nil, fmt.Errorf("%w task manager", gctcommon.ErrNilPointer)
+	}
+	list, err := s.manager.ListSweeps()
+	if err != nil {
+		return nil, err
+	}
+	response := make([]*btrpc.SweepSummary, len(list))
+	for i := range list {
+		response[i] = convertSweepSummary(list[i])
+	}
+	return &btrpc.ListAllSweepsResponse{
+		Sweeps: response,
+	}, nil
+}
+
+// GetSweepResults returns the progress and ranked results of a parameter sweep
+func (s *GRPCServer) GetSweepResults(_ context.Context, req *btrpc.GetSweepResultsRequest) (*btrpc.GetSweepResultsResponse, error) {
+	if s.manager == nil {
+		return nil, fmt.Errorf("%w task manager", gctcommon.ErrNilPointer)
+	}
+	if req == nil {
+		return nil, fmt.Errorf("%w GetSweepResultsRequest", gctcommon.ErrNilPointer)
+	}
+	id, err := uuid.FromString(req.Id)
+	if err != nil {
+		return nil, err
+	}
+	sweep, err := s.manager.GetSweepSummary(id)
+	if err != nil {
+		return nil, err
+	}
+	windows := make([]*btrpc.WalkForwardWindow, len(sweep.WalkForward))
+	for i := range sweep.WalkForward {
+		windows[i] = &btrpc.WalkForwardWindow{
+			InSampleStartDate:    timestamppb.New(sweep.WalkForward[i].Window.InSampleStart),
+			InSampleEndDate:      timestamppb.New(sweep.WalkForward[i].Window.InSampleEnd),
+			OutOfSampleStartDate: timestamppb.New(sweep.WalkForward[i].Window.OutOfSampleStart),
+			OutOfSampleEndDate:   timestamppb.New(sweep.WalkForward[i].Window.OutOfSampleEnd),
+			InSampleResults:      convertSweepResults(sweep.WalkForward[i].InSample, req.MaximumResults),
+			OutOfSampleResult:    convertSweepResult(sweep.WalkForward[i].OutOfSample),
+		}
+	}
+	return &btrpc.GetSweepResultsResponse{
+		Sweep:              convertSweepSummary(sweep),
+		Results:            convertSweepResults(sweep.Results, req.MaximumResults),
+		WalkForwardWindows: windows,
+	}, nil
+}
+
+// ClearSweep removes a parameter sweep from memory, but only if it is not
+// running
+func (s *GRPCServer) ClearSweep(_ context.Context, req *btrpc.ClearSweepRequest) (*btrpc.ClearSweepResponse, error) {
+	if s.manager == nil {
+		return nil, fmt.Errorf("%w task manager", gctcommon.ErrNilPointer)
+	}
+	if req == nil {
+		return nil, fmt.Errorf("%w ClearSweepRequest", gctcommon.ErrNilPointer)
+	}
+	id, err := uuid.FromString(req.Id)
+	if err != nil {
+		return nil, err
+	}
+	sweep, err := s.manager.GetSweepSummary(id)
+	if err != nil {
+		return nil, err
+	}
+	err = s.manager.ClearSweep(id)
+	if err != nil {
+		return nil, err
+	}
+	return &btrpc.ClearSweepResponse{
+		ClearedSweep: convertSweepSummary(sweep),
+	}, nil
+}
@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/thrasher-corp/gocryptotrader/backtester/btrpc"
@@ -544,3 +545,119 @@ func TestGRPCClearAllTasks(t *testing.T) {
 	assert.NoError(t, err, "ClearAllTasks should not error")
 	assert.Empty(t, s.manager.tasks, "tasks should be empty")
 }
+
+func TestGRPCExecuteSweepFromFile(t *testing.T) {
+	t.Parallel()
+	s := &GRPCServer{}
+	_, err := s.ExecuteSweepFromFile(t.Context(), nil)
+	assert.ErrorIs(t, err, gctcommon.ErrNilPointer)
+
+	s.config = &config.BacktesterConfig{}
+	_, err = s.ExecuteSweepFromFile(t.Context(), nil)
+	assert.ErrorIs(t, err, gctcommon.ErrNilPointer)
+
+	s.manager = NewTaskManager()
+	_, err = s.ExecuteSweepFromFile(t.Context(), nil)
+	assert.ErrorIs(t, err, gctcommon.ErrNilPointer)
+
+	_, err = s.ExecuteSweepFromFile(t.Context(), &btrpc.ExecuteSweepFromFileRequest{SweepFilePath: "test"})
+	assert.ErrorIs(t, err, common.ErrFileNotFound)
+
+	_, err = s.ExecuteSweepFromFile(t.Context(), &btrpc.ExecuteSweepFromFileRequest{SweepFilePath: dcaConfigPath})
+	assert.Error(t, err, "ExecuteSweepFromFile should error when given a strategy config")
+	assert.Empty(t, s.manager.sweeps, "sweeps should be empty")
+}
+
+func TestGRPCListAllSweeps(t *testing.T) {
+	t.Parallel()
+	s := &GRPCServer{}
+	_, err := s.ListAllSweeps(t.Context(), nil)
+	assert.ErrorIs(t, err, gctcommon.ErrNilPointer)
+
+	s.manager = NewTaskManager()
+	sweep := newTestSweep(t)
+	err = s.manager.AddSweep(sweep)
+	require.NoError(t, err, "AddSweep must not error")
+
+	resp, err := s.ListAllSweeps(t.Context(), &btrpc.ListAllSweepsRequest{})
+	require.NoError(t, err, "ListAllSweeps must not error")
+	require.Len(t, resp.Sweeps, 1)
+	assert.Equal(t, sweep.ID.String(), resp.Sweeps[0].Id)
+	assert.Equal(t, "rsi", resp.Sweeps[0].StrategyName)
+	assert.NotEmpty(t, resp.Sweeps[0].DateLoaded)
+	assert.Empty(t, resp.Sweeps[0].DateStarted)
+}
+
+func TestGRPCGetSweepResults(t *testing.T) {
+	t.Parallel()
+	s := &GRPCServer{}
+	_, err := s.GetSweepResults(t.Context(), nil)
+	assert.ErrorIs(t, err, gctcommon.ErrNilPointer)
+
+	s.manager = NewTaskManager()
+	_, err = s.GetSweepResults(t.Context(), nil)
+	assert.ErrorIs(t, err, gctcommon.ErrNilPointer)
+
+	_, err = s.GetSweepResults(t.Context(), &btrpc.GetSweepResultsRequest{Id: "test"})
+	assert.Error(t, err, "GetSweepResults should error on an invalid id")
+
+	sweep := newTestSweep(t)
+	err = s.manager.AddSweep(sweep)
+	require.NoError(t, err, "AddSweep must not error")
+	require.NoError(t, sweep.Run(), "Run must not error")
+
+	resp, err := s.GetSweepResults(t.Context(), &btrpc.GetSweepResultsRequest{Id: sweep.ID.String(), MaximumResults: 2})
+	require.NoError(t, err, "GetSweepResults must not error")
+	assert.Equal(t, int64(165), resp.Sweep.CompletedRuns)
+	assert.NotEmpty(t, resp.Sweep.DateEnded)
+	assert.Empty(t, resp.Results)
+	require.Len(t, resp.WalkForwardWindows, 3)
+	require.Len(t, resp.WalkForwardWindows[0].InSampleResults, 2, "results should be limited to the maximum")
+	assert.Equal(t, int64(1), resp.WalkForwardWindows[0].InSampleResults[0].Rank)
+	assert.Equal(t, "20", resp.WalkForwardWindows[0].InSampleResults[0].Score)
+	require.NotNil(t, resp.WalkForwardWindows[0].OutOfSampleResult)
+	assert.Equal(t, "20", resp.WalkForwardWindows[0].OutOfSampleResult.Ratios.SharpeRatio)
+	assert.True(t, resp.WalkForwardWindows[0].InSampleEndDate.AsTime().Equal(resp.WalkForwardWindows[0].OutOfSampleStartDate.AsTime()))
+}
+
+func TestGRPCClearSweep(t *testing.T) {
+	t.Parallel()
+	s := &GRPCServer{}
+	_, err := s.ClearSweep(t.Context(), nil)
+	assert.ErrorIs(t, err, gctcommon.ErrNilPointer)
+
+	s.manager = NewTaskManager()
+	_, err = s.ClearSweep(t.Context(), nil)
+	assert.ErrorIs(t, err, gctcommon.ErrNilPointer)
+
+	sweep := newTestSweep(t)
+	err = s.manager.AddSweep(sweep)
+	require.NoError(t, err, "AddSweep must not error")
+
+	resp, err := s.ClearSweep(t.Context(), &btrpc.ClearSweepRequest{Id: sweep.ID.String()})
+	require.NoError(t, err, "ClearSweep must not error")
+	assert.Equal(t, sweep.ID.String(), resp.ClearedSweep.Id)
+	assert.Empty(t, s.manager.sweeps, "sweeps should be empty")
+}
+
+func TestConvertSweepResult(t *testing.T) {
+	t.Parallel()
+	assert.Nil(t, convertSweepResult(nil))
+
+	r := convertSweepResult(&SweepResult{
+		Rank:           2,
+		CustomSettings: map[string]any{"b": 2.5, "a": 1.0},
+		Score:          decimal.NewFromInt(3),
+		Ratios:         &statistics.Ratios{CalmarRatio: decimal.NewFromInt(3)},
+	})
+	assert.Equal(t, int64(2), r.Rank)
+	require.Len(t, r.CustomSettings, 2)
+	assert.Equal(t, "a", r.CustomSettings[0].KeyField, "custom settings should be sorted by key")
+	assert.Equal(t, "2.5", r.CustomSettings[1].KeyValue)
+	assert.Equal(t, "3", r.Ratios.CalmarRatio)
+	assert.Empty(t, r.Error)
+
+	r = convertSweepResult(&SweepResult{Err: errTestSweepRun})
+	assert.Equal(t, errTestSweepRun.Error(), r.Error)
+	assert.Nil(t, r.Ratios)
+}
@@ -8,15 +8,18 @@ import (
 	"runtime"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/shopspring/decimal"
 	"github.com/thrasher-corp/gocryptotrader/backtester/common"
 	"github.com/thrasher-corp/gocryptotrader/backtester/config"
 	"github.com/thrasher-corp/gocryptotrader/backtester/data"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data/fundingrate"
 	"github.com/thrasher-corp/gocryptotrader/backtester/data/kline"
 	"github.com/thrasher-corp/gocryptotrader/backtester/data/kline/api"
 	"github.com/thrasher-corp/gocryptotrader/backtester/data/kline/csv"
 	"github.com/thrasher-corp/gocryptotrader/backtester/data/kline/database"
+	dataorderbook "github.com/thrasher-corp/gocryptotrader/backtester/data/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/eventholder"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/exchange"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/exchange/slippage"
@@ -40,6 +43,7 @@ import (
 	gctexchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/currencystate"
+	gctfundingrate "github.com/thrasher-corp/gocryptotrader/exchanges/fundingrate"
 	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/log"
 )
@@ -496,6 +500,7 @@ func (bt *BackTest) setupExchangeSettings(cfg *config.Config) (*exchange.Exchang
 		if err != nil {
 			return nil, err
 		}
+		var orderbookReplay *dataorderbook.Replay
 		if bt.LiveDataHandler == nil {
 			err = bt.Funding.AddUSDTrackingData(klineData)
 			if err != nil &&
@@ -512,6 +517,27 @@ func (bt *BackTest) setupExchangeSettings(cfg *config.Config) (*exchange.Exchang
 			if err != nil {
 				return nil, err
 			}
+
+			if cfg.CurrencySettings[i].IsPerpetual() {
+				var rates *gctfundingrate.HistoricalRates
+				rates, err = bt.loadFundingRates(cfg, exch, pair, a)
+				if err != nil {
+					return nil, err
+				}
+				if rates != nil {
+					err = bt.Funding.SetFundingRates(exchangeName, a, pair, rates)
+					if err != nil {
+						return nil, err
+					}
+				}
+			}
+
+			if cfg.DataSettings.OrderbookData != nil {
+				orderbookReplay, err = bt.loadOrderbookData(cfg, exch, pair, a, klineData)
+				if err != nil {
+					return nil, err
+				}
+			}
 		}
 		var makerFee, takerFee decimal.Decimal
 		if cfg.CurrencySettings[i].MakerFee != nil && cfg.CurrencySettings[i].MakerFee.GreaterThan(decimal.Zero) {
@@ -625,6 +651,7 @@ func (bt *BackTest) setupExchangeSettings(cfg *config.Config) (*exchange.Exchang
 			SkipCandleVolumeFitting:   cfg.CurrencySettings[i].SkipCandleVolumeFitting,
 			CanUseExchangeLimits:      cfg.CurrencySettings[i].CanUseExchangeLimits,
 			UseExchangePNLCalculation: cfg.CurrencySettings[i].UseExchangePNLCalculation,
+			Orderbook:                 orderbookReplay,
 		})
 	}
 
@@ -863,6 +890,98 @@ func (bt *BackTest) loadData(cfg *config.Config, exch gctexchange.IBotExchange,
 	return resp, nil
 }
 
+// loadFundingRates retrieves historical funding rates for a perpetual contract
+// from the same data source used to load its candles
+func (bt *BackTest) loadFundingRates(cfg *config.Config, exch gctexchange.IBotExchange, fPair currency.Pair, a asset.Item) (*gctfundingrate.HistoricalRates, error) {
+	if exch == nil {
+		return nil, engine.ErrExchangeNotFound
+	}
+	log.Infof(common.Setup, "Loading funding rates for %v %v %v...\n", exch.GetName(), a, fPair)
+	switch {
+	case cfg.DataSettings.CSVData != nil:
+		if cfg.DataSettings.CSVData.FundingRateFullPath == "" {
+			log.Warnf(common.Setup, "No funding rate file set for perpetual %v %v %v, funding payments will not be applied", exch.GetName(), a, fPair)
+			return nil, nil
+		}
+		return fundingrate.LoadFromCSV(
+			cfg.DataSettings.CSVData.FundingRateFullPath,
+			strings.ToLower(exch.GetName()),
+			a,
+			fPair,
+			time.Time{},
+			time.Time{})
+	case cfg.DataSettings.DatabaseData != nil:
+		err := bt.databaseManager.Start(&sync.WaitGroup{})
+		if err != nil {
+			return nil, err
+		}
+		defer func() {
+			stopErr := bt.databaseManager.Stop()
+			if stopErr != nil {
+				log.Errorln(common.Setup, stopErr)
+			}
+		}()
+		return fundingrate.LoadFromDatabase(
+			exch.GetName(),
+			a,
+			fPair,
+			cfg.DataSettings.DatabaseData.StartDate,
+			cfg.DataSettings.DatabaseData.EndDate)
+	case cfg.DataSettings.APIData != nil:
+		return fundingrate.LoadFromAPI(
+			context.TODO(),
+			exch,
+			a,
+			fPair,
+			cfg.DataSettings.APIData.StartDate,
+			cfg.DataSettings.APIData.EndDate)
+	}
+	return nil, nil
+}
+
+// loadOrderbookData retrieves recorded orderbook data covering the same period
+// as the currency's candle data, so that its orders are filled against
+// orderbook depth
+func (bt *BackTest) loadOrderbookData(cfg *config.Config, exch gctexchange.IBotExchange, fPair currency.Pair, a asset.Item, klineData *kline.DataFromKline) (*dataorderbook.Replay, error) {
+	if exch == nil {
+		return nil, engine.ErrExchangeNotFound
+	}
+	if cfg.DataSettings.OrderbookData == nil {
+		return nil, fmt.Errorf("%w orderbook data settings", gctcommon.ErrNilPointer)
+	}
+	if klineData == nil || klineData.Item == nil || len(klineData.Item.Candles) == 0 {
+		return nil, fmt.Errorf("%w for %v %v %v orderbook data", errNilData, exch.GetName(), a, fPair)
+	}
+	start := klineData.Item.Candles[0].Time
+	end := klineData.Item.Candles[len(klineData.Item.Candles)-1].Time.Add(klineData.Item.Interval.Duration())
+	log.Infof(common.Setup, "Loading orderbook data for %v %v %v...\n", exch.GetName(), a, fPair)
+	if !cfg.DataSettings.OrderbookData.UseDatabase {
+		return dataorderbook.LoadFromFile(
+			cfg.DataSettings.OrderbookData.FullPath,
+			strings.ToLower(exch.GetName()),
+			a,
+			fPair,
+			start,
+			end)
+	}
+	err := bt.databaseManager.Start(&sync.WaitGroup{})
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		stopErr := bt.databaseManager.Stop()
+		if stopErr != nil {
+			log.Errorln(common.Setup, stopErr)
+		}
+	}()
+	return dataorderbook.LoadFromDatabase(
+		strings.ToLower(exch.GetName()),
+		a,
+		fPair,
+		start,
+		end)
+}
+
 func loadDatabaseData(cfg *config.Config, name string, fPair currency.Pair, a asset.Item, dataType int64, isUSDTrackingPair bool) (*kline.DataFromKline, error) {
 	if cfg == nil || cfg.DataSettings.DatabaseData == nil {
 		return nil, errors.New("nil config data received")
@@ -0,0 +1,308 @@
+package engine
+
+import (
+	"fmt"
+	"runtime"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/backtester/config"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/statistics"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// NewSweep validates a sweep config and prepares it to be run. Reports are
+// not generated for the individual backtests of a sweep
+func NewSweep(sweepCfg *config.SweepConfig, backtesterCfg *config.BacktesterConfig) (*Sweep, error) {
+	if sweepCfg == nil {
+		return nil, fmt.Errorf("%w sweep config", gctcommon.ErrNilPointer)
+	}
+	if backtesterCfg == nil {
+		return nil, fmt.Errorf("%w backtester config", gctcommon.ErrNilPointer)
+	}
+	if err := sweepCfg.Validate(); err != nil {
+		return nil, err
+	}
+	id, err := uuid.NewV4()
+	if err != nil {
+		return nil, err
+	}
+	btCfg := *backtesterCfg
+	btCfg.Verbose = false
+	btCfg.Report.GenerateReport = false
+	btCfg.Report.OutputPath = ""
+	btCfg.Report.TemplatePath = ""
+
+	workers := sweepCfg.MaximumWorkers
+	if workers == 0 {
+		workers = runtime.NumCPU()
+	}
+	s := &Sweep{
+		ID:            id,
+		Nickname:      sweepCfg.Nickname,
+		Strategy:      sweepCfg.StrategyConfig.StrategySettings.Name,
+		RankBy:        sweepCfg.RankBy,
+		DateLoaded:    time.Now(),
+		cfg:           sweepCfg,
+		backtesterCfg: &btCfg,
+		workers:       workers,
+	}
+	s.runner = s.runBacktest
+	return s, nil
+}
+
+// Run executes every backtest of the sweep and waits for them to finish
+func (s *Sweep) Run() error {
+	if s == nil {
+		return fmt.Errorf("%w sweep", gctcommon.ErrNilPointer)
+	}
+	s.m.Lock()
+	if !s.DateStarted.IsZero() {
+		s.m.Unlock()
+		return fmt.Errorf("%w %v", errSweepAlreadyStarted, s.ID)
+	}
+	s.DateStarted = time.Now()
+	s.m.Unlock()
+
+	err := s.run()
+
+	s.m.Lock()
+	s.DateEnded = time.Now()
+	s.Err = err
+	s.m.Unlock()
+	return err
+}
+
+func (s *Sweep) run() error {
+	combinations, err := s.cfg.Combinations()
+	if err != nil {
+		return err
+	}
+	if s.cfg.WalkForward == nil {
+		s.m.Lock()
+		s.TotalRuns = int64(len(combinations))
+		s.m.Unlock()
+		results, err := s.runCombinations(combinations, time.Time{}, time.Time{})
+		if err != nil {
+			return err
+		}
+		s.m.Lock()
+		s.Results = results
+		s.m.Unlock()
+		return nil
+	}
+
+	windows, err := s.cfg.WalkForwardWindows()
+	if err != nil {
+		return err
+	}
+	s.m.Lock()
+	s.TotalRuns = int64(len(windows) * (len(combinations) + 1))
+	s.m.Unlock()
+	for i := range windows {
+		inSample, err := s.runCombinations(combinations, windows[i].InSampleStart, windows[i].InSampleEnd)
+		if err != nil {
+			return err
+		}
+		wf := &WalkForwardResult{
+			Window:   windows[i],
+			InSample: inSample,
+		}
+		if inSample[0].Err != nil {
+			wf.OutOfSample = &SweepResult{Err: errNoInSampleResult}
+		} else {
+			outOfSample, err := s.runCombinations([]map[string]any{inSample[0].CustomSettings}, windows[i].OutOfSampleStart, windows[i].OutOfSampleEnd)
+			if err != nil {
+				return err
+			}
+			wf.OutOfSample = outOfSample[0]
+		}
+		s.m.Lock()
+		s.WalkForward = append(s.WalkForward, wf)
+		s.m.Unlock()
+	}
+	return nil
+}
+
+// runCombinations runs a backtest for each combination of custom settings over
+// a bounded pool of workers and returns the ranked results
+func (s *Sweep) runCombinations(combinations []map[string]any, start, end time.Time) ([]*SweepResult, error) {
+	configs := make([]*config.Config, len(combinations))
+	for i := range combinations {
+		var err error
+		configs[i], err = s.cfg.BuildStrategyConfig(combinations[i], start, end)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	results := make([]*SweepResult, len(combinations))
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for range min(s.workers, len(configs)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				results[i] = s.runCombination(configs[i], combinations[i])
+				s.m.Lock()
+				s.CompletedRuns++
+				s.m.Unlock()
+			}
+		}()
+	}
+	for i := range configs {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	rankSweepResults(results)
+	return results, nil
+}
+
+func (s *Sweep) runCombination(cfg *config.Config, customSettings map[string]any) *SweepResult {
+	result := &SweepResult{CustomSettings: customSettings}
+	result.Ratios, result.Err = s.runner(cfg)
+	if result.Err != nil {
+		log.Errorf(common.Backtester, "Sweep %v run with custom settings %v failed: %v", s.ID, customSettings, result.Err)
+		return result
+	}
+	result.Score, result.Err = getRatioByName(result.Ratios, s.RankBy)
+	return result
+}
+
+// runBacktest runs a backtest to completion and returns its ratios
+func (s *Sweep) runBacktest(cfg *config.Config) (*statistics.Ratios, error) {
+	bt, err := NewBacktesterFromConfigs(cfg, s.backtesterCfg)
+	if err != nil {
+		return nil, err
+	}
+	err = bt.ExecuteStrategy(true)
+	if err != nil {
+		return nil, err
+	}
+	stats, ok := bt.Statistic.(*statistics.Statistic)
+	if !ok {
+		return nil, gctcommon.GetTypeAssertError("*statistics.Statistic", bt.Statistic)
+	}
+	return getSweepRatios(stats, s.cfg.UseGeometricRatios)
+}
+
+// getSweepRatios returns the USD total ratios of a backtest when USD tracking
+// is enabled, otherwise the average ratios of every exchange asset pair
+func getSweepRatios(stats *statistics.Statistic, geometric bool) (*statistics.Ratios, error) {
+	if stats.FundingStatistics != nil && stats.FundingStatistics.TotalUSDStatistics != nil {
+		ratios := stats.FundingStatistics.TotalUSDStatistics.ArithmeticRatios
+		if geometric {
+			ratios = stats.FundingStatistics.TotalUSDStatistics.GeometricRatios
+		}
+		if ratios != nil {
+			return ratios, nil
+		}
+	}
+	resp := &statistics.Ratios{}
+	var count int64
+	for _, pairStats := range stats.ExchangeAssetPairStatistics {
+		ratios := pairStats.ArithmeticRatios
+		if geometric {
+			ratios = pairStats.GeometricRatios
+		}
+		if ratios == nil {
+			continue
+		}
+		resp.SharpeRatio = resp.SharpeRatio.Add(ratios.SharpeRatio)
+		resp.SortinoRatio = resp.SortinoRatio.Add(ratios.SortinoRatio)
+		resp.InformationRatio = resp.InformationRatio.Add(ratios.InformationRatio)
+		resp.CalmarRatio = resp.CalmarRatio.Add(ratios.CalmarRatio)
+		count++
+	}
+	if count == 0 {
+		return nil, errNoSweepStatistics
+	}
+	divisor := decimal.NewFromInt(count)
+	resp.SharpeRatio = resp.SharpeRatio.Div(divisor)
+	resp.SortinoRatio = resp.SortinoRatio.Div(divisor)
+	resp.InformationRatio = resp.InformationRatio.Div(divisor)
+	resp.CalmarRatio = resp.CalmarRatio.Div(divisor)
+	return resp, nil
+}
+
+// getRatioByName returns the value of the ratio a sweep is ranked by
+func getRatioByName(ratios *statistics.Ratios, name string) (decimal.Decimal, error) {
+	if ratios == nil {
+		return decimal.Zero, errNoSweepStatistics
+	}
+	switch name {
+	case config.SharpeRatio:
+		return ratios.SharpeRatio, nil
+	case config.SortinoRatio:
+		return ratios.SortinoRatio, nil
+	case config.InformationRatio:
+		return ratios.InformationRatio, nil
+	case config.CalmarRatio:
+		return ratios.CalmarRatio, nil
+	default:
+		return decimal.Zero, fmt.Errorf("%w '%v'", errUnhandledRankingType, name)
+	}
+}
+
+// rankSweepResults sorts results by score, highest first, with failed runs
+// placed last and left unranked
+func rankSweepResults(results []*SweepResult) {
+	slices.SortStableFunc(results, func(a, b *SweepResult) int {
+		switch {
+		case a.Err != nil && b.Err != nil:
+			return 0
+		case a.Err != nil:
+			return 1
+		case b.Err != nil:
+			return -1
+		}
+		return b.Score.Cmp(a.Score)
+	})
+	for i := range results {
+		if results[i].Err != nil {
+			break
+		}
+		results[i].Rank = i + 1
+	}
+}
+
+// GenerateSummary returns a snapshot of the sweep's progress and results
+func (s *Sweep) GenerateSummary() (*SweepSummary, error) {
+	if s == nil {
+		return nil, fmt.Errorf("%w sweep", gctcommon.ErrNilPointer)
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+	return &SweepSummary{
+		ID:            s.ID,
+		Nickname:      s.Nickname,
+		Strategy:      s.Strategy,
+		RankBy:        s.RankBy,
+		DateLoaded:    s.DateLoaded,
+		DateStarted:   s.DateStarted,
+		DateEnded:     s.DateEnded,
+		TotalRuns:     s.TotalRuns,
+		CompletedRuns: s.CompletedRuns,
+		Err:           s.Err,
+		Results:       slices.Clone(s.Results),
+		WalkForward:   slices.Clone(s.WalkForward),
+	}, nil
+}
+
+// IsRunning returns whether the sweep has started and not yet finished
+func (s *Sweep) IsRunning() bool {
+	if s == nil {
+		return false
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+	return !s.DateStarted.IsZero() && s.DateEnded.IsZero()
+}
@@ -0,0 +1,213 @@
+package engine
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/backtester/config"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/statistics"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/key"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+var errTestSweepRun = errors.New("test sweep run error")
+
+func newTestSweep(t *testing.T) *Sweep {
+	t.Helper()
+	cfg, err := config.ReadSweepConfigFromFile(filepath.Join("..", "config", "strategyexamples", "rsi-api-candles.sweep"))
+	require.NoError(t, err, "ReadSweepConfigFromFile must not error")
+	s, err := NewSweep(cfg, &config.BacktesterConfig{})
+	require.NoError(t, err, "NewSweep must not error")
+	// rank each combination by its rsi-period so results are predictable
+	s.runner = func(cfg *config.Config) (*statistics.Ratios, error) {
+		period, ok := cfg.StrategySettings.CustomSettings["rsi-period"].(float64)
+		if !ok {
+			return nil, errTestSweepRun
+		}
+		return &statistics.Ratios{SharpeRatio: decimal.NewFromFloat(period)}, nil
+	}
+	return s
+}
+
+func TestNewSweep(t *testing.T) {
+	t.Parallel()
+	_, err := NewSweep(nil, nil)
+	assert.ErrorIs(t, err, gctcommon.ErrNilPointer)
+
+	_, err = NewSweep(&config.SweepConfig{}, nil)
+	assert.ErrorIs(t, err, gctcommon.ErrNilPointer)
+
+	_, err = NewSweep(&config.SweepConfig{}, &config.BacktesterConfig{})
+	assert.Error(t, err, "NewSweep should error on an invalid sweep config")
+
+	cfg, err := config.ReadSweepConfigFromFile(filepath.Join("..", "config", "strategyexamples", "rsi-api-candles.sweep"))
+	require.NoError(t, err, "ReadSweepConfigFromFile must not error")
+	btCfg := &config.BacktesterConfig{
+		Verbose: true,
+		Report: config.Report{
+			GenerateReport: true,
+			OutputPath:     "output",
+			TemplatePath:   "template",
+		},
+	}
+	cfg.MaximumWorkers = 0
+	s, err := NewSweep(cfg, btCfg)
+	require.NoError(t, err, "NewSweep must not error")
+	assert.False(t, s.ID.IsNil(), "NewSweep should set an ID")
+	assert.Equal(t, "rsi", s.Strategy)
+	assert.Equal(t, config.SharpeRatio, s.RankBy)
+	assert.Positive(t, s.workers, "NewSweep should default workers to the number of CPUs")
+	assert.Empty(t, s.backtesterCfg.Report.OutputPath, "NewSweep should disable reports")
+	assert.False(t, s.backtesterCfg.Verbose, "NewSweep should disable verbose logging")
+	assert.Equal(t, "output", btCfg.Report.OutputPath, "NewSweep must not modify the backtester config")
+}
+
+func TestSweepRun(t *testing.T) {
+	t.Parallel()
+	var s *Sweep
+	assert.ErrorIs(t, s.Run(), gctcommon.ErrNilPointer)
+
+	s = newTestSweep(t)
+	s.cfg.WalkForward = nil
+	require.NoError(t, s.Run(), "Run must not error")
+	assert.ErrorIs(t, s.Run(), errSweepAlreadyStarted)
+	assert.False(t, s.DateEnded.IsZero(), "Run should set DateEnded")
+	assert.Equal(t, int64(54), s.TotalRuns)
+	assert.Equal(t, s.TotalRuns, s.CompletedRuns)
+	require.Len(t, s.Results, 54)
+	assert.Equal(t, 1, s.Results[0].Rank)
+	assert.Equal(t, 20.0, s.Results[0].CustomSettings["rsi-period"], "the highest rsi-period should be ranked first")
+	assert.True(t, s.Results[0].Score.Equal(decimal.NewFromInt(20)))
+	assert.Equal(t, 54, s.Results[53].Rank)
+	assert.Equal(t, 10.0, s.Results[53].CustomSettings["rsi-period"])
+	assert.Empty(t, s.WalkForward)
+
+	s = newTestSweep(t)
+	require.NoError(t, s.Run(), "Run must not error")
+	assert.Equal(t, int64(165), s.TotalRuns)
+	assert.Equal(t, s.TotalRuns, s.CompletedRuns)
+	assert.Empty(t, s.Results)
+	require.Len(t, s.WalkForward, 3)
+	for i := range s.WalkForward {
+		require.Len(t, s.WalkForward[i].InSample, 54)
+		require.NotNil(t, s.WalkForward[i].OutOfSample)
+		require.NoError(t, s.WalkForward[i].OutOfSample.Err)
+		assert.Equal(t, s.WalkForward[i].InSample[0].CustomSettings, s.WalkForward[i].OutOfSample.CustomSettings, "the best in-sample combination should be tested out-of-sample")
+	}
+	assert.Equal(t, s.WalkForward[0].Window.OutOfSampleEnd, s.WalkForward[1].Window.OutOfSampleStart, "windows should roll forward by the out-of-sample duration")
+
+	s = newTestSweep(t)
+	s.runner = func(*config.Config) (*statistics.Ratios, error) {
+		return nil, errTestSweepRun
+	}
+	require.NoError(t, s.Run(), "Run must not error when individual runs fail")
+	require.Len(t, s.WalkForward, 3)
+	assert.ErrorIs(t, s.WalkForward[0].InSample[0].Err, errTestSweepRun)
+	assert.Zero(t, s.WalkForward[0].InSample[0].Rank, "failed runs should not be ranked")
+	assert.ErrorIs(t, s.WalkForward[0].OutOfSample.Err, errNoInSampleResult)
+}
+
+func TestGetSweepRatios(t *testing.T) {
+	t.Parallel()
+	stats := &statistics.Statistic{}
+	_, err := getSweepRatios(stats, false)
+	assert.ErrorIs(t, err, errNoSweepStatistics)
+
+	stats.ExchangeAssetPairStatistics = map[key.ExchangeAssetPair]*statistics.CurrencyPairStatistic{
+		key.NewExchangeAssetPair("a", asset.Spot, currency.NewBTCUSDT()): {
+			ArithmeticRatios: &statistics.Ratios{SharpeRatio: decimal.NewFromInt(1), CalmarRatio: decimal.NewFromInt(4)},
+			GeometricRatios:  &statistics.Ratios{SharpeRatio: decimal.NewFromInt(10)},
+		},
+		key.NewExchangeAssetPair("b", asset.Spot, currency.NewBTCUSDT()): {
+			ArithmeticRatios: &statistics.Ratios{SharpeRatio: decimal.NewFromInt(3), CalmarRatio: decimal.NewFromInt(2)},
+		},
+	}
+	ratios, err := getSweepRatios(stats, false)
+	require.NoError(t, err)
+	assert.True(t, ratios.SharpeRatio.Equal(decimal.NewFromInt(2)), "getSweepRatios should average pair ratios")
+	assert.True(t, ratios.CalmarRatio.Equal(decimal.NewFromInt(3)), "getSweepRatios should average pair ratios")
+
+	ratios, err = getSweepRatios(stats, true)
+	require.NoError(t, err)
+	assert.True(t, ratios.SharpeRatio.Equal(decimal.NewFromInt(10)), "getSweepRatios should skip pairs without geometric ratios")
+
+	usd := &statistics.Ratios{SharpeRatio: decimal.NewFromInt(7)}
+	stats.FundingStatistics = &statistics.FundingStatistics{
+		TotalUSDStatistics: &statistics.TotalFundingStatistics{ArithmeticRatios: usd},
+	}
+	ratios, err = getSweepRatios(stats, false)
+	require.NoError(t, err)
+	assert.Equal(t, usd, ratios, "getSweepRatios should prefer USD totals")
+}
+
+func TestGetRatioByName(t *testing.T) {
+	t.Parallel()
+	_, err := getRatioByName(nil, config.SharpeRatio)
+	assert.ErrorIs(t, err, errNoSweepStatistics)
+
+	r := &statistics.Ratios{
+		SharpeRatio:      decimal.NewFromInt(1),
+		SortinoRatio:     decimal.NewFromInt(2),
+		InformationRatio: decimal.NewFromInt(3),
+		CalmarRatio:      decimal.NewFromInt(4),
+	}
+	for name, expected := range map[string]int64{
+		config.SharpeRatio:      1,
+		config.SortinoRatio:     2,
+		config.InformationRatio: 3,
+		config.CalmarRatio:      4,
+	} {
+		v, err := getRatioByName(r, name)
+		require.NoError(t, err)
+		assert.Truef(t, v.Equal(decimal.NewFromInt(expected)), "getRatioByName should return %v for %v", expected, name)
+	}
+
+	_, err = getRatioByName(r, "vibes")
+	assert.ErrorIs(t, err, errUnhandledRankingType)
+}
+
+func TestRankSweepResults(t *testing.T) {
+	t.Parallel()
+	results := []*SweepResult{
+		{Score: decimal.NewFromInt(1)},
+		{Err: errTestSweepRun},
+		{Score: decimal.NewFromInt(3)},
+		{Score: decimal.NewFromInt(-1)},
+	}
+	rankSweepResults(results)
+	assert.True(t, results[0].Score.Equal(decimal.NewFromInt(3)))
+	assert.Equal(t, 1, results[0].Rank)
+	assert.True(t, results[2].Score.Equal(decimal.NewFromInt(-1)))
+	assert.Equal(t, 3, results[2].Rank)
+	assert.ErrorIs(t, results[3].Err, errTestSweepRun)
+	assert.Zero(t, results[3].Rank)
+}
+
+func TestSweepGenerateSummary(t *testing.T) {
+	t.Parallel()
+	var s *Sweep
+	_, err := s.GenerateSummary()
+	assert.ErrorIs(t, err, gctcommon.ErrNilPointer)
+	assert.False(t, s.IsRunning())
+
+	s = newTestSweep(t)
+	s.cfg.WalkForward = nil
+	sum, err := s.GenerateSummary()
+	require.NoError(t, err)
+	assert.Equal(t, s.ID, sum.ID)
+	assert.True(t, sum.DateStarted.IsZero())
+	assert.False(t, s.IsRunning())
+
+	require.NoError(t, s.Run())
+	sum, err = s.GenerateSummary()
+	require.NoError(t, err)
+	assert.Len(t, sum.Results, 54)
+	assert.Equal(t, int64(54), sum.CompletedRuns)
+	assert.False(t, s.IsRunning())
+}
@@ -0,0 +1,82 @@
+package engine
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/config"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/statistics"
+)
+
+var (
+	errSweepNotFound        = errors.New("sweep not found")
+	errSweepAlreadyStarted  = errors.New("sweep already started")
+	errSweepAlreadyAdded    = errors.New("sweep already added")
+	errNoSweepStatistics    = errors.New("no ratios calculated for sweep run")
+	errNoInSampleResult     = errors.New("no successful in-sample result to test out-of-sample")
+	errUnhandledRankingType = errors.New("unhandled ranking ratio")
+)
+
+// Sweep runs a backtest for every combination of a sweep config's parameters
+// and ranks the results by the chosen ratio
+type Sweep struct {
+	m             sync.Mutex
+	ID            uuid.UUID
+	Nickname      string
+	Strategy      string
+	RankBy        string
+	DateLoaded    time.Time
+	DateStarted   time.Time
+	DateEnded     time.Time
+	TotalRuns     int64
+	CompletedRuns int64
+	Err           error
+	Results       []*SweepResult
+	WalkForward   []*WalkForwardResult
+	cfg           *config.SweepConfig
+	backtesterCfg *config.BacktesterConfig
+	workers       int
+	runner        sweepRunner
+}
+
+// sweepRunner runs a backtest for a strategy config and returns its ratios
+type sweepRunner func(*config.Config) (*statistics.Ratios, error)
+
+// SweepResult holds the outcome of a single combination of a sweep
+type SweepResult struct {
+	// Rank starts at 1 for the best result. Failed runs are not ranked
+	Rank           int
+	CustomSettings map[string]any
+	Score          decimal.Decimal
+	Ratios         *statistics.Ratios
+	Err            error
+}
+
+// WalkForwardResult holds the ranked in-sample results of a walk-forward
+// window along with the out-of-sample result of the best in-sample
+// combination
+type WalkForwardResult struct {
+	Window      config.WalkForwardWindow
+	InSample    []*SweepResult
+	OutOfSample *SweepResult
+}
+
+// SweepSummary holds details of a Sweep rather than passing the entire
+// contents around
+type SweepSummary struct {
+	ID            uuid.UUID
+	Nickname      string
+	Strategy      string
+	RankBy        string
+	DateLoaded    time.Time
+	DateStarted   time.Time
+	DateEnded     time.Time
+	TotalRuns     int64
+	CompletedRuns int64
+	Err           error
+	Results       []*SweepResult
+	WalkForward   []*WalkForwardResult
+}
@@ -215,3 +215,76 @@ func (r *TaskManager) ClearAllTasks() (clearedRuns, remainingRuns []*TaskSummary
 	}
 	return clearedRuns, remainingRuns, nil
 }
+
+// AddSweep adds a parameter sweep to the manager
+func (r *TaskManager) AddSweep(s *Sweep) error {
+	if r == nil {
+		return fmt.Errorf("%w TaskManager", gctcommon.ErrNilPointer)
+	}
+	if s == nil {
+		return fmt.Errorf("%w Sweep", gctcommon.ErrNilPointer)
+	}
+	r.m.Lock()
+	defer r.m.Unlock()
+	for i := range r.sweeps {
+		if r.sweeps[i].ID == s.ID {
+			return fmt.Errorf("%w %s", errSweepAlreadyAdded, s.ID)
+		}
+	}
+	r.sweeps = append(r.sweeps, s)
+	return nil
+}
+
+// ListSweeps details all parameter sweeps
+func (r *TaskManager) ListSweeps() ([]*SweepSummary, error) {
+	if r == nil {
+		return nil, fmt.Errorf("%w TaskManager", gctcommon.ErrNilPointer)
+	}
+	r.m.Lock()
+	defer r.m.Unlock()
+	resp := make([]*SweepSummary, len(r.sweeps))
+	for i := range r.sweeps {
+		sum, err := r.sweeps[i].GenerateSummary()
+		if err != nil {
+			return nil, err
+		}
+		resp[i] = sum
+	}
+	return resp, nil
+}
+
+// GetSweepSummary returns the progress and results of a parameter sweep
+func (r *TaskManager) GetSweepSummary(id uuid.UUID) (*SweepSummary, error) {
+	if r == nil {
+		return nil, fmt.Errorf("%w TaskManager", gctcommon.ErrNilPointer)
+	}
+	r.m.Lock()
+	defer r.m.Unlock()
+	for i := range r.sweeps {
+		if r.sweeps[i].ID == id {
+			return r.sweeps[i].GenerateSummary()
+		}
+	}
+	return nil, fmt.Errorf("%s %w", id, errSweepNotFound)
+}
+
+// ClearSweep removes a parameter sweep from memory, but only if it is not
+// running
+func (r *TaskManager) ClearSweep(id uuid.UUID) error {
+	if r == nil {
+		return fmt.Errorf("%w TaskManager", gctcommon.ErrNilPointer)
+	}
+	r.m.Lock()
+	defer r.m.Unlock()
+	for i := range r.sweeps {
+		if r.sweeps[i].ID != id {
+			continue
+		}
+		if r.sweeps[i].IsRunning() {
+			return fmt.Errorf("%w %v, currently running", errCannotClear, id)
+		}
+		r.sweeps = slices.Delete(r.sweeps, i, i+1)
+		return nil
+	}
+	return fmt.Errorf("%s %w", id, errSweepNotFound)
+}
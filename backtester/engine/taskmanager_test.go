@@ -363,3 +363,91 @@ func TestClearAllRuns(t *testing.T) {
 	_, _, err = rm.ClearAllTasks()
 	assert.ErrorIs(t, err, gctcommon.ErrNilPointer)
 }
+
+func TestAddSweep(t *testing.T) {
+	t.Parallel()
+	rm := NewTaskManager()
+	err := rm.AddSweep(nil)
+	assert.ErrorIs(t, err, gctcommon.ErrNilPointer)
+
+	s := newTestSweep(t)
+	err = rm.AddSweep(s)
+	assert.NoError(t, err)
+	assert.Len(t, rm.sweeps, 1)
+
+	err = rm.AddSweep(s)
+	assert.ErrorIs(t, err, errSweepAlreadyAdded)
+
+	rm = nil
+	err = rm.AddSweep(s)
+	assert.ErrorIs(t, err, gctcommon.ErrNilPointer)
+}
+
+func TestListSweeps(t *testing.T) {
+	t.Parallel()
+	rm := NewTaskManager()
+	list, err := rm.ListSweeps()
+	assert.NoError(t, err)
+	assert.Empty(t, list)
+
+	s := newTestSweep(t)
+	err = rm.AddSweep(s)
+	assert.NoError(t, err)
+	list, err = rm.ListSweeps()
+	assert.NoError(t, err)
+	if assert.Len(t, list, 1) {
+		assert.Equal(t, s.ID, list[0].ID)
+	}
+
+	rm = nil
+	_, err = rm.ListSweeps()
+	assert.ErrorIs(t, err, gctcommon.ErrNilPointer)
+}
+
+func TestGetSweepSummary(t *testing.T) {
+	t.Parallel()
+	rm := NewTaskManager()
+	id, err := uuid.NewV4()
+	assert.NoError(t, err)
+
+	_, err = rm.GetSweepSummary(id)
+	assert.ErrorIs(t, err, errSweepNotFound)
+
+	s := newTestSweep(t)
+	err = rm.AddSweep(s)
+	assert.NoError(t, err)
+	sum, err := rm.GetSweepSummary(s.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, s.ID, sum.ID)
+
+	rm = nil
+	_, err = rm.GetSweepSummary(id)
+	assert.ErrorIs(t, err, gctcommon.ErrNilPointer)
+}
+
+func TestClearSweep(t *testing.T) {
+	t.Parallel()
+	rm := NewTaskManager()
+	id, err := uuid.NewV4()
+	assert.NoError(t, err)
+
+	err = rm.ClearSweep(id)
+	assert.ErrorIs(t, err, errSweepNotFound)
+
+	s := newTestSweep(t)
+	err = rm.AddSweep(s)
+	assert.NoError(t, err)
+
+	s.DateStarted = time.Now()
+	err = rm.ClearSweep(s.ID)
+	assert.ErrorIs(t, err, errCannotClear)
+
+	s.DateEnded = time.Now()
+	err = rm.ClearSweep(s.ID)
+	assert.NoError(t, err)
+	assert.Empty(t, rm.sweeps)
+
+	rm = nil
+	err = rm.ClearSweep(id)
+	assert.ErrorIs(t, err, gctcommon.ErrNilPointer)
+}
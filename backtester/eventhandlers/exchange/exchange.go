@@ -27,6 +27,7 @@ func (e *Exchange) Reset() error {
 		return gctcommon.ErrNilPointer
 	}
 	e.CurrencySettings = nil
+	e.restingOrders = nil
 	return nil
 }
 
@@ -45,16 +46,17 @@ func (e *Exchange) ExecuteOrder(o order.Event, dh data.Handler, om *engine.Order
 		return f, fmt.Errorf("%w order direction %v", ErrCannotTransact, o.GetDirection())
 	}
 
-	allocatedFunds := o.GetAllocatedFunds()
 	cs, err := e.GetCurrencySettings(o.GetExchange(), o.GetAssetType(), o.Pair())
 	if err != nil {
 		return f, err
 	}
 	f.Direction = o.GetDirection()
+	if isRestingOrderType(o) {
+		return e.placeRestingOrder(o, f, &cs, om, funds)
+	}
 
 	var price, adjustedPrice,
-		amount, adjustedAmount,
-		fee decimal.Decimal
+		amount, adjustedAmount decimal.Decimal
 	amount = o.GetAmount()
 	price = o.GetClosePrice()
 	if cs.UseRealOrders {
@@ -79,6 +81,13 @@ func (e *Exchange) ExecuteOrder(o order.Event, dh data.Handler, om *engine.Order
 			}
 			return f, nil
 		}
+	} else if ob := e.orderbookForMarketOrder(o, f, &cs); ob != nil {
+		price, amount, err = fillFromOrderbook(ob, f, amount, decimal.Zero)
+		if err != nil {
+			f.AppendReasonf("could not fill order against orderbook: %v", err)
+			return f, allocateFundsPostOrder(f, funds, err, o.GetAmount(), o.GetAllocatedFunds(), decimal.Zero, decimal.Zero, decimal.Zero)
+		}
+		adjustedPrice = price
 	} else {
 		slippageRate := slippage.EstimateSlippagePercentage(cs.MinimumSlippageRate, cs.MaximumSlippageRate)
 		if cs.SkipCandleVolumeFitting || o.GetAssetType().IsFutures() || o.GetDirection() == gctorder.ClosePosition {
@@ -112,7 +121,15 @@ func (e *Exchange) ExecuteOrder(o order.Event, dh data.Handler, om *engine.Order
 		f.Slippage = slippageRate.Mul(decimal.NewFromInt(100)).Sub(decimal.NewFromInt(100))
 	}
 
-	adjustedAmount = reduceAmountToFitPortfolioLimit(adjustedPrice, amount, allocatedFunds, f.GetDirection())
+	return e.fillOrder(o, f, &cs, om, funds, price, adjustedPrice, amount, cs.TakerFee)
+}
+
+// fillOrder fits the order amount to portfolio limits at adjustedPrice and to
+// exchange limits before placing the order at price and allocating funds post
+// order
+func (e *Exchange) fillOrder(o order.Event, f *fill.Fill, cs *Settings, om *engine.OrderManager, funds funding.IFundReleaser, price, adjustedPrice, amount, feeRate decimal.Decimal) (fill.Event, error) {
+	allocatedFunds := o.GetAllocatedFunds()
+	adjustedAmount := reduceAmountToFitPortfolioLimit(adjustedPrice, amount, allocatedFunds, f.GetDirection())
 	if !adjustedAmount.Equal(amount) {
 		f.AppendReasonf("Order size shrunk from %v to %v to remain within portfolio limits", amount, adjustedAmount)
 		amount = adjustedAmount
@@ -127,14 +144,14 @@ func (e *Exchange) ExecuteOrder(o order.Event, dh data.Handler, om *engine.Order
 			amount = adjustedAmount
 		}
 	}
-	err = verifyOrderWithinLimits(f, amount, &cs)
+	err := verifyOrderWithinLimits(f, amount, cs)
 	if err != nil {
 		return f, err
 	}
 
-	fee = calculateExchangeFee(price, amount, cs.TakerFee)
+	fee := calculateExchangeFee(price, amount, feeRate)
 
-	orderID, err := e.placeOrder(context.TODO(), price, amount, fee, cs.UseRealOrders, cs.CanUseExchangeLimits, f, om)
+	orderID, err := e.placeOrder(context.TODO(), price, amount, fee, cs.UseRealOrders, cs.CanUseExchangeLimits, o.GetOrderType(), f, om)
 	if err != nil {
 		f.AppendReasonf("could not place order: %v", err)
 		setCannotPurchaseDirection(f)
@@ -145,9 +162,9 @@ func (e *Exchange) ExecuteOrder(o order.Event, dh data.Handler, om *engine.Order
 		if ords[i].OrderID != orderID {
 			continue
 		}
-		ords[i].Date = o.GetTime()
-		ords[i].LastUpdated = o.GetTime()
-		ords[i].CloseTime = o.GetTime()
+		ords[i].Date = f.GetTime()
+		ords[i].LastUpdated = f.GetTime()
+		ords[i].CloseTime = f.GetTime()
 		f.Order = &ords[i]
 		f.PurchasePrice = decimal.NewFromFloat(ords[i].Price)
 		f.Amount = decimal.NewFromFloat(ords[i].Amount)
@@ -177,8 +194,8 @@ func allocateFundsPostOrder(f *fill.Fill, funds funding.IFundReleaser, orderErro
 		return fmt.Errorf("%w: funding", gctcommon.ErrNilPointer)
 	}
 
-	switch f.AssetType {
-	case asset.Spot:
+	switch {
+	case f.AssetType == asset.Spot:
 		pr, err := funds.PairReleaser()
 		if err != nil {
 			return err
@@ -219,7 +236,7 @@ func allocateFundsPostOrder(f *fill.Fill, funds funding.IFundReleaser, orderErro
 		default:
 			return fmt.Errorf("%w asset type %v", common.ErrInvalidDataType, f.GetDirection())
 		}
-	case asset.Futures:
+	case f.AssetType.IsFutures():
 		cr, err := funds.CollateralReleaser()
 		if err != nil {
 			return err
@@ -335,10 +352,13 @@ func reduceAmountToFitPortfolioLimit(adjustedPrice, amount, sizedPortfolioTotal
 	return amount
 }
 
-func (e *Exchange) placeOrder(ctx context.Context, price, amount, fee decimal.Decimal, useRealOrders, useExchangeLimits bool, f fill.Event, orderManager *engine.OrderManager) (string, error) {
+func (e *Exchange) placeOrder(ctx context.Context, price, amount, fee decimal.Decimal, useRealOrders, useExchangeLimits bool, orderType gctorder.Type, f fill.Event, orderManager *engine.OrderManager) (string, error) {
 	if f == nil {
 		return "", common.ErrNilEvent
 	}
+	if orderType == gctorder.UnknownType {
+		orderType = gctorder.Market
+	}
 	orderID, err := uuid.NewV4()
 	if err != nil {
 		return "", err
@@ -351,7 +371,7 @@ func (e *Exchange) placeOrder(ctx context.Context, price, amount, fee decimal.De
 		Side:             f.GetDirection(),
 		AssetType:        f.GetAssetType(),
 		Pair:             f.Pair(),
-		Type:             gctorder.Market,
+		Type:             orderType,
 		RetrieveFees:     true,
 		RetrieveFeeDelay: time.Millisecond * 500,
 	}
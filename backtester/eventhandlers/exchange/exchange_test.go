@@ -196,26 +196,26 @@ func TestPlaceOrder(t *testing.T) {
 	require.NoError(t, err, "Start must not error")
 
 	e := Exchange{}
-	_, err = e.placeOrder(t.Context(), decimal.NewFromInt(1), decimal.NewFromInt(1), decimal.Zero, false, true, nil, nil)
+	_, err = e.placeOrder(t.Context(), decimal.NewFromInt(1), decimal.NewFromInt(1), decimal.Zero, false, true, gctorder.Market, nil, nil)
 	assert.ErrorIs(t, err, common.ErrNilEvent)
 	f := &fill.Fill{
 		Base: &event.Base{},
 	}
-	_, err = e.placeOrder(t.Context(), decimal.NewFromInt(1), decimal.NewFromInt(1), decimal.Zero, false, true, f, bot.OrderManager)
+	_, err = e.placeOrder(t.Context(), decimal.NewFromInt(1), decimal.NewFromInt(1), decimal.Zero, false, true, gctorder.Market, f, bot.OrderManager)
 	assert.ErrorIs(t, err, gctcommon.ErrExchangeNameNotSet)
 
 	f.Exchange = testExchange
 	require.NoError(t, exch.UpdateOrderExecutionLimits(t.Context(), asset.Spot), "UpdateOrderExecutionLimits must not error")
 
-	_, err = e.placeOrder(t.Context(), decimal.NewFromInt(1), decimal.NewFromInt(1), decimal.Zero, false, true, f, bot.OrderManager)
+	_, err = e.placeOrder(t.Context(), decimal.NewFromInt(1), decimal.NewFromInt(1), decimal.Zero, false, true, gctorder.Market, f, bot.OrderManager)
 	assert.ErrorIs(t, err, gctorder.ErrPairIsEmpty)
 
 	f.CurrencyPair = currency.NewBTCUSDT()
 	f.AssetType = asset.Spot
 	f.Direction = gctorder.Buy
-	_, err = e.placeOrder(t.Context(), decimal.NewFromInt(1), decimal.NewFromInt(1), decimal.Zero, false, true, f, bot.OrderManager)
+	_, err = e.placeOrder(t.Context(), decimal.NewFromInt(1), decimal.NewFromInt(1), decimal.Zero, false, true, gctorder.Market, f, bot.OrderManager)
 	assert.NoError(t, err, "placeOrder should not error")
-	_, err = e.placeOrder(t.Context(), decimal.NewFromInt(1), decimal.NewFromInt(1), decimal.Zero, true, true, f, bot.OrderManager)
+	_, err = e.placeOrder(t.Context(), decimal.NewFromInt(1), decimal.NewFromInt(1), decimal.Zero, true, true, gctorder.Market, f, bot.OrderManager)
 	assert.ErrorIs(t, err, exchange.ErrCredentialsAreEmpty)
 }
 
@@ -641,6 +641,10 @@ func TestAllocateFundsPostOrder(t *testing.T) {
 	err = allocateFundsPostOrder(f, collateralPair, nil, one, one, one, one, decimal.Zero)
 	assert.NoError(t, err, "allocateFundsPostOrder should not error")
 
+	f.AssetType = asset.USDTMarginedFutures
+	err = allocateFundsPostOrder(f, collateralPair, nil, one, one, one, one, decimal.Zero)
+	assert.NoError(t, err, "allocateFundsPostOrder should not error for perpetual futures")
+
 	f.AssetType = asset.Margin
 	err = allocateFundsPostOrder(f, collateralPair, nil, one, one, one, one, decimal.Zero)
 	assert.ErrorIs(t, err, common.ErrInvalidDataType)
@@ -5,6 +5,7 @@ import (
 
 	"github.com/shopspring/decimal"
 	"github.com/thrasher-corp/gocryptotrader/backtester/data"
+	dataorderbook "github.com/thrasher-corp/gocryptotrader/backtester/data/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/fill"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/order"
 	"github.com/thrasher-corp/gocryptotrader/backtester/funding"
@@ -18,11 +19,17 @@ import (
 var (
 	// ErrCannotTransact returns when its an issue to do nothing for an event
 	ErrCannotTransact = errors.New("cannot transact")
+	// ErrOrderCancelled returns when a non-market order is cancelled before it could be filled
+	ErrOrderCancelled = errors.New("order cancelled")
 
 	errExceededPortfolioLimit  = errors.New("exceeded portfolio limit")
 	errNilCurrencySettings     = errors.New("received nil currency settings")
 	errInvalidDirection        = errors.New("received invalid order direction")
 	errNoCurrencySettingsFound = errors.New("no currency settings found")
+	errInvalidLimitPrice       = errors.New("limit price must be greater than zero")
+	errInvalidTriggerPrice     = errors.New("trigger price must be greater than zero")
+	errInvalidExpiry           = errors.New("expiry must be after the order time")
+	errRealOrdersMarketOnly    = errors.New("only market orders are supported when using real orders")
 )
 
 // ExecutionHandler interface dictates what functions are required to submit an order
@@ -30,12 +37,35 @@ type ExecutionHandler interface {
 	SetExchangeAssetCurrencySettings(asset.Item, currency.Pair, *Settings)
 	GetCurrencySettings(string, asset.Item, currency.Pair) (Settings, error)
 	ExecuteOrder(order.Event, data.Handler, *engine.OrderManager, funding.IFundReleaser) (fill.Event, error)
+	ProcessRestingOrders(data.Event, *engine.OrderManager, funding.IFundReleaser) ([]fill.Event, []order.Event, error)
+	GetRestingOrders() []order.Event
 	Reset() error
 }
 
 // Exchange contains all the currency settings
 type Exchange struct {
 	CurrencySettings []Settings
+	restingOrders    []*restingOrder
+}
+
+// restingOrder is a simulated non-market order which is held until later
+// data allows it to be filled or it is cancelled
+type restingOrder struct {
+	order.Event
+	// triggered is set when a stop limit order's trigger price has been
+	// reached and it is resting as a limit order
+	triggered bool
+	// queue tracks a limit order's position in the orderbook queue at its
+	// limit price when orderbook data is available
+	queue *queuePosition
+}
+
+// queuePosition estimates a resting limit order's place in the orderbook
+// queue at its limit price
+type queuePosition struct {
+	ahead       decimal.Decimal
+	levelAmount decimal.Decimal
+	filled      bool
 }
 
 // Settings allow the eventhandler to size an order within the limitations set by the config file
@@ -62,6 +92,10 @@ type Settings struct {
 	SkipCandleVolumeFitting bool
 
 	UseExchangePNLCalculation bool
+
+	// Orderbook replays recorded orderbook data so that simulated orders are
+	// filled against orderbook depth instead of candle data
+	Orderbook *dataorderbook.Replay
 }
 
 // MinMax are the rules which limit the placement of orders.
@@ -0,0 +1,151 @@
+package exchange
+
+import (
+	"fmt"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/exchange/slippage"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/fill"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/order"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
+	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	gctorderbook "github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+// advanceOrderbook replays the recorded orderbook up to the event's time,
+// updating the queue position of the event's resting limit orders with every
+// replayed change, and returns the orderbook as of the event's time
+func (e *Exchange) advanceOrderbook(ev common.Event, cs *Settings) (*gctorderbook.Book, error) {
+	if cs.Orderbook == nil {
+		return nil, fmt.Errorf("%w orderbook replay", gctcommon.ErrNilPointer)
+	}
+	err := cs.Orderbook.Advance(ev.GetTime(), func(ob *gctorderbook.Book) error {
+		for _, r := range e.restingOrders {
+			if r.queue == nil ||
+				r.GetExchange() != ev.GetExchange() ||
+				r.GetAssetType() != ev.GetAssetType() ||
+				!r.Pair().Equal(ev.Pair()) {
+				continue
+			}
+			r.queue.observe(ob, r.GetDirection(), r.GetLimitPrice())
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return cs.Orderbook.GetOrderbook()
+}
+
+// fillFromOrderbook walks the orderbook to determine the price and amount an
+// order is filled at. When limitPrice is positive, levels beyond it are not
+// consumed
+func fillFromOrderbook(ob *gctorderbook.Book, f *fill.Fill, amount, limitPrice decimal.Decimal) (price, filled decimal.Decimal, err error) {
+	price, filled, err = slippage.SimulateOrderbookFill(ob, f.GetDirection(), amount, limitPrice)
+	if err != nil {
+		return decimal.Zero, decimal.Zero, err
+	}
+	if !filled.Equal(amount) {
+		f.AppendReasonf("Order size shrunk from %v to %v to fit orderbook liquidity", amount, filled)
+	}
+	f.AppendReasonf("Price simulated against orderbook depth at %v", price)
+	f.VolumeAdjustedPrice = f.ClosePrice
+	f.Slippage = orderbookSlippage(f.GetDirection(), f.ClosePrice, price)
+	return price, filled, nil
+}
+
+// orderbookSlippage returns the percentage difference between the reference
+// price and the orderbook fill price, where a negative value is unfavourable
+func orderbookSlippage(side gctorder.Side, reference, price decimal.Decimal) decimal.Decimal {
+	if reference.IsZero() {
+		return decimal.Zero
+	}
+	diff := price.Sub(reference)
+	if side.IsLong() {
+		diff = diff.Neg()
+	}
+	return diff.Div(reference).Mul(decimal.NewFromInt(100))
+}
+
+// isMarketable returns whether a limit order would immediately match against
+// the best price on the opposite side of the orderbook
+func isMarketable(ob *gctorderbook.Book, side gctorder.Side, limitPrice decimal.Decimal) bool {
+	if side.IsLong() {
+		return len(ob.Asks) > 0 && decimal.NewFromFloat(ob.Asks[0].Price).LessThanOrEqual(limitPrice)
+	}
+	return len(ob.Bids) > 0 && decimal.NewFromFloat(ob.Bids[0].Price).GreaterThanOrEqual(limitPrice)
+}
+
+// newQueuePosition places a limit order at the back of the orderbook queue at
+// its limit price
+func newQueuePosition(ob *gctorderbook.Book, side gctorder.Side, limitPrice decimal.Decimal) *queuePosition {
+	amount := amountAtPrice(ob, side, limitPrice)
+	return &queuePosition{
+		ahead:       amount,
+		levelAmount: amount,
+	}
+}
+
+// observe updates the queue position with an orderbook change. Reductions in
+// the amount resting at the limit price are assumed to be consumed from the
+// front of the queue. The order is filled once the opposite side of the book
+// crosses its limit price, once more than the amount ahead of it has been
+// consumed or once its price level is cleared and traded through
+func (q *queuePosition) observe(ob *gctorderbook.Book, side gctorder.Side, limitPrice decimal.Decimal) {
+	if q.filled {
+		return
+	}
+	if isMarketable(ob, side, limitPrice) {
+		q.filled = true
+		return
+	}
+	amount := amountAtPrice(ob, side, limitPrice)
+	if amount.LessThan(q.levelAmount) {
+		consumed := q.levelAmount.Sub(amount)
+		if consumed.GreaterThan(q.ahead) || (amount.IsZero() && isTradedThrough(ob, side, limitPrice)) {
+			q.filled = true
+			return
+		}
+		q.ahead = q.ahead.Sub(consumed)
+	}
+	q.levelAmount = amount
+}
+
+// amountAtPrice returns the amount resting at price on the order's side of
+// the orderbook
+func amountAtPrice(ob *gctorderbook.Book, side gctorder.Side, price decimal.Decimal) decimal.Decimal {
+	levels := ob.Asks
+	if side.IsLong() {
+		levels = ob.Bids
+	}
+	for i := range levels {
+		if decimal.NewFromFloat(levels[i].Price).Equal(price) {
+			return decimal.NewFromFloat(levels[i].Amount)
+		}
+	}
+	return decimal.Zero
+}
+
+// isTradedThrough returns whether the best price on the order's side of the
+// orderbook has moved beyond its limit price
+func isTradedThrough(ob *gctorderbook.Book, side gctorder.Side, limitPrice decimal.Decimal) bool {
+	if side.IsLong() {
+		return len(ob.Bids) == 0 || decimal.NewFromFloat(ob.Bids[0].Price).LessThan(limitPrice)
+	}
+	return len(ob.Asks) == 0 || decimal.NewFromFloat(ob.Asks[0].Price).GreaterThan(limitPrice)
+}
+
+// orderbookForMarketOrder returns the orderbook a market order is filled
+// against, or nil when candle data is to be used instead
+func (e *Exchange) orderbookForMarketOrder(o order.Event, f *fill.Fill, cs *Settings) *gctorderbook.Book {
+	if cs.Orderbook == nil || o.GetDirection() == gctorder.ClosePosition {
+		return nil
+	}
+	ob, err := e.advanceOrderbook(o, cs)
+	if err != nil {
+		f.AppendReasonf("Orderbook unavailable, using candle data: %v", err)
+		return nil
+	}
+	return ob
+}
@@ -0,0 +1,216 @@
+package exchange
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	dataorderbook "github.com/thrasher-corp/gocryptotrader/backtester/data/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/event"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/fill"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
+	gctconfig "github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/engine"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/binanceus"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/currencystate"
+	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	gctorderbook "github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+func newTestBook() *gctorderbook.Book {
+	return &gctorderbook.Book{
+		Bids: gctorderbook.Levels{{Price: 99, Amount: 2}, {Price: 98, Amount: 1}},
+		Asks: gctorderbook.Levels{{Price: 101, Amount: 1}, {Price: 102, Amount: 3}},
+	}
+}
+
+func newTestReplay(t *testing.T, tm time.Time, updates ...dataorderbook.Entry) *dataorderbook.Replay {
+	t.Helper()
+	book := newTestBook()
+	entries := append([]dataorderbook.Entry{{
+		Timestamp: tm,
+		UpdateID:  1,
+		Snapshot:  true,
+		Bids:      book.Bids,
+		Asks:      book.Asks,
+	}}, updates...)
+	r, err := dataorderbook.NewReplay(testExchange, asset.Spot, currency.NewBTCUSDT(), entries, tm, time.Time{})
+	require.NoError(t, err, "NewReplay must not error")
+	return r
+}
+
+func newTestOrderManager(t *testing.T) *engine.OrderManager {
+	t.Helper()
+	em := engine.NewExchangeManager()
+	exch, err := em.NewExchangeByName(testExchange)
+	require.NoError(t, err, "NewExchangeByName must not error")
+	exch.SetDefaults()
+	exch.GetBase().States = currencystate.NewCurrencyStates()
+	require.NoError(t, em.Add(exch), "ExchangeManager.Add must not error")
+	var wg sync.WaitGroup
+	om, err := engine.SetupOrderManager(em, &engine.CommunicationManager{}, &wg, &gctconfig.OrderManager{})
+	require.NoError(t, err, "SetupOrderManager must not error")
+	require.NoError(t, om.Start(), "OrderManager.Start must not error")
+	return om
+}
+
+func TestOrderbookSlippage(t *testing.T) {
+	t.Parallel()
+	hundred := decimal.NewFromInt(100)
+	assert.True(t, orderbookSlippage(gctorder.Buy, decimal.Zero, hundred).IsZero())
+	assert.True(t, decimal.NewFromInt(-2).Equal(orderbookSlippage(gctorder.Buy, hundred, decimal.NewFromInt(102))), "paying more than the reference price is unfavourable")
+	assert.True(t, decimal.NewFromInt(-2).Equal(orderbookSlippage(gctorder.Sell, hundred, decimal.NewFromInt(98))), "receiving less than the reference price is unfavourable")
+	assert.True(t, decimal.NewFromInt(1).Equal(orderbookSlippage(gctorder.Sell, hundred, decimal.NewFromInt(101))))
+}
+
+func TestIsMarketable(t *testing.T) {
+	t.Parallel()
+	ob := newTestBook()
+	assert.True(t, isMarketable(ob, gctorder.Buy, decimal.NewFromInt(101)))
+	assert.False(t, isMarketable(ob, gctorder.Buy, decimal.NewFromInt(100)))
+	assert.True(t, isMarketable(ob, gctorder.Sell, decimal.NewFromInt(99)))
+	assert.False(t, isMarketable(ob, gctorder.Sell, decimal.NewFromInt(100)))
+	assert.False(t, isMarketable(&gctorderbook.Book{}, gctorder.Buy, decimal.NewFromInt(1000)), "an empty book cannot be matched")
+}
+
+func TestFillFromOrderbook(t *testing.T) {
+	t.Parallel()
+	f := &fill.Fill{Base: &event.Base{}, Direction: gctorder.Buy, ClosePrice: decimal.NewFromInt(100)}
+	_, _, err := fillFromOrderbook(nil, f, decimal.NewFromInt(1), decimal.Zero)
+	assert.ErrorIs(t, err, gctcommon.ErrNilPointer)
+
+	price, amount, err := fillFromOrderbook(newTestBook(), f, decimal.NewFromInt(2), decimal.Zero)
+	require.NoError(t, err)
+	assert.True(t, decimal.NewFromFloat(101.5).Equal(price), "price must be the volume weighted average of consumed levels")
+	assert.True(t, decimal.NewFromInt(2).Equal(amount))
+	assert.True(t, decimal.NewFromFloat(-1.5).Equal(f.Slippage))
+	assert.True(t, f.ClosePrice.Equal(f.VolumeAdjustedPrice))
+
+	f = &fill.Fill{Base: &event.Base{}, Direction: gctorder.Buy, ClosePrice: decimal.NewFromInt(100)}
+	price, amount, err = fillFromOrderbook(newTestBook(), f, decimal.NewFromInt(2), decimal.NewFromInt(101))
+	require.NoError(t, err)
+	assert.True(t, decimal.NewFromInt(101).Equal(price))
+	assert.True(t, decimal.NewFromInt(1).Equal(amount), "amount must be limited to levels within the limit price")
+	assert.Len(t, f.Reasons, 2, "shrunk orders must explain why")
+}
+
+func TestQueuePositionObserve(t *testing.T) {
+	t.Parallel()
+	limit := decimal.NewFromInt(99)
+	q := newQueuePosition(newTestBook(), gctorder.Buy, limit)
+	assert.True(t, decimal.NewFromInt(2).Equal(q.ahead), "order must queue behind the resting amount")
+
+	ob := newTestBook()
+	ob.Bids[0].Amount = 1.5
+	q.observe(ob, gctorder.Buy, limit)
+	assert.False(t, q.filled)
+	assert.True(t, decimal.NewFromFloat(1.5).Equal(q.ahead), "consumed amount must move the order forward")
+
+	ob.Bids[0].Amount = 3
+	q.observe(ob, gctorder.Buy, limit)
+	assert.False(t, q.filled)
+	assert.True(t, decimal.NewFromFloat(1.5).Equal(q.ahead), "orders joining the level must queue behind")
+
+	ob.Bids[0].Amount = 2
+	q.observe(ob, gctorder.Buy, limit)
+	assert.False(t, q.filled)
+	assert.True(t, decimal.NewFromFloat(0.5).Equal(q.ahead))
+
+	ob.Bids[0].Amount = 0.4
+	q.observe(ob, gctorder.Buy, limit)
+	assert.True(t, q.filled, "consuming more than the amount ahead must fill the order")
+
+	q = newQueuePosition(newTestBook(), gctorder.Sell, decimal.NewFromInt(101))
+	ob = newTestBook()
+	ob.Bids = append(gctorderbook.Levels{{Price: 101, Amount: 1}}, ob.Bids...)
+	q.observe(ob, gctorder.Sell, decimal.NewFromInt(101))
+	assert.True(t, q.filled, "a crossing bid must fill a sell order")
+
+	q = newQueuePosition(newTestBook(), gctorder.Buy, limit)
+	ob = newTestBook()
+	ob.Bids = ob.Bids[1:]
+	q.observe(ob, gctorder.Buy, limit)
+	assert.True(t, q.filled, "a level cleared and traded through must fill the order")
+}
+
+func TestAdvanceOrderbook(t *testing.T) {
+	t.Parallel()
+	tm := time.Date(2022, 1, 1, 12, 0, 0, 0, time.UTC)
+	e := &Exchange{}
+	o := newRestingOrder(tm, gctorder.Limit, gctorder.Buy, decimal.NewFromInt(99), decimal.Zero)
+	_, err := e.advanceOrderbook(o, &Settings{})
+	assert.ErrorIs(t, err, gctcommon.ErrNilPointer)
+
+	cs := &Settings{Orderbook: newTestReplay(t, tm, dataorderbook.Entry{
+		Timestamp: tm.Add(time.Minute),
+		UpdateID:  2,
+		Bids:      gctorderbook.Levels{{Price: 99, Amount: 1}},
+	})}
+	ob, err := e.advanceOrderbook(o, cs)
+	require.NoError(t, err, "advanceOrderbook must not error")
+	e.restingOrders = []*restingOrder{{Event: o, queue: newQueuePosition(ob, gctorder.Buy, decimal.NewFromInt(99))}}
+
+	later := newRestingOrder(tm.Add(time.Hour), gctorder.Limit, gctorder.Buy, decimal.NewFromInt(99), decimal.Zero)
+	ob, err = e.advanceOrderbook(later, cs)
+	require.NoError(t, err, "advanceOrderbook must not error")
+	assert.Equal(t, 1.0, ob.Bids[0].Amount)
+	assert.True(t, decimal.NewFromInt(1).Equal(e.restingOrders[0].queue.ahead), "replayed updates must update the queue position")
+}
+
+func TestOrderbookFills(t *testing.T) {
+	t.Parallel()
+	tm := time.Date(2022, 1, 1, 12, 0, 0, 0, time.UTC)
+	om := newTestOrderManager(t)
+	b := &binanceus.Exchange{}
+	b.Name = testExchange
+	e := &Exchange{}
+	e.SetExchangeAssetCurrencySettings(asset.Spot, currency.NewBTCUSDT(), &Settings{
+		Exchange: b,
+		Pair:     currency.NewBTCUSDT(),
+		Asset:    asset.Spot,
+		MakerFee: decimal.NewFromFloat(0.001),
+		TakerFee: decimal.NewFromFloat(0.002),
+		Orderbook: newTestReplay(t, tm,
+			dataorderbook.Entry{Timestamp: tm.Add(time.Minute * 30), UpdateID: 2, Bids: gctorderbook.Levels{{Price: 99, Amount: 1.5}}},
+			dataorderbook.Entry{Timestamp: tm.Add(time.Minute * 90), UpdateID: 3, Bids: gctorderbook.Levels{{Price: 99, Amount: 0}}},
+		),
+	})
+
+	market := newRestingOrder(tm, gctorder.Market, gctorder.Buy, decimal.Zero, decimal.Zero)
+	market.Amount = decimal.NewFromInt(2)
+	resp, err := e.ExecuteOrder(market, nil, om, newRestingOrderFunds(t))
+	require.NoError(t, err, "ExecuteOrder must not error")
+	assert.True(t, decimal.NewFromFloat(101.5).Equal(resp.GetPurchasePrice()), "market orders must fill against orderbook depth")
+
+	marketable := newRestingOrder(tm, gctorder.Limit, gctorder.Buy, decimal.NewFromInt(101), decimal.Zero)
+	marketable.Amount = decimal.NewFromInt(2)
+	resp, err = e.ExecuteOrder(marketable, nil, om, newRestingOrderFunds(t))
+	require.NoError(t, err, "ExecuteOrder must not error")
+	assert.True(t, decimal.NewFromInt(101).Equal(resp.GetPurchasePrice()))
+	assert.True(t, decimal.NewFromInt(1).Equal(resp.GetAmount()), "marketable limit orders must not fill beyond their limit price")
+	assert.True(t, decimal.NewFromFloat(0.202).Equal(resp.GetExchangeFee()), "marketable limit orders must be charged taker fees")
+
+	limit := newRestingOrder(tm, gctorder.Limit, gctorder.Buy, decimal.NewFromInt(99), decimal.Zero)
+	resp, err = e.ExecuteOrder(limit, nil, om, newRestingOrderFunds(t))
+	require.NoError(t, err, "ExecuteOrder must not error")
+	assert.Equal(t, gctorder.DoNothing, resp.GetDirection())
+	require.Len(t, e.restingOrders, 1)
+	require.NotNil(t, e.restingOrders[0].queue, "limit orders must track their orderbook queue position")
+
+	funds := newRestingOrderFunds(t)
+	filled, _, err := e.ProcessRestingOrders(newKlineEvent(tm.Add(time.Hour), 100, 110, 90, 100), om, funds)
+	require.NoError(t, err, "ProcessRestingOrders must not error")
+	assert.Empty(t, filled, "candle prices must not fill orders tracked by the orderbook queue")
+
+	filled, _, err = e.ProcessRestingOrders(newKlineEvent(tm.Add(time.Hour*2), 100, 110, 100, 100), om, funds)
+	require.NoError(t, err, "ProcessRestingOrders must not error")
+	require.Len(t, filled, 1, "order must be filled once its level is traded through")
+	assert.True(t, decimal.NewFromInt(99).Equal(filled[0].GetPurchasePrice()))
+	assert.True(t, decimal.NewFromFloat(0.099).Equal(filled[0].GetExchangeFee()), "queued limit orders must be charged maker fees")
+	assert.Empty(t, e.GetRestingOrders())
+}
@@ -0,0 +1,402 @@
+package exchange
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/exchange/slippage"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/fill"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/order"
+	"github.com/thrasher-corp/gocryptotrader/backtester/funding"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/engine"
+	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	gctorderbook "github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+// GetRestingOrders returns all simulated orders which are yet to be filled
+// or cancelled
+func (e *Exchange) GetRestingOrders() []order.Event {
+	resp := make([]order.Event, len(e.restingOrders))
+	for i := range e.restingOrders {
+		resp[i] = e.restingOrders[i].Event
+	}
+	return resp
+}
+
+// ProcessRestingOrders assesses all resting orders for the data event's
+// exchange, asset and pair against its candle, or against the replayed
+// orderbook when orderbook data is available. Orders which have expired
+// are cancelled and have their allocated funds released, orders which can be
+// filled are placed with the order manager and returned as fill events
+func (e *Exchange) ProcessRestingOrders(ev data.Event, om *engine.OrderManager, funds funding.IFundReleaser) (filled []fill.Event, cancelled []order.Event, err error) {
+	if ev == nil {
+		return nil, nil, common.ErrNilEvent
+	}
+	if funds == nil {
+		return nil, nil, fmt.Errorf("%w funds", gctcommon.ErrNilPointer)
+	}
+	var (
+		ob         *gctorderbook.Book
+		isReplayed bool
+	)
+	remaining := e.restingOrders[:0]
+	for _, r := range e.restingOrders {
+		if !r.matchesEvent(ev) {
+			remaining = append(remaining, r)
+			continue
+		}
+		if !isReplayed {
+			// replaying the orderbook updates the queue position of every
+			// resting order, so it is done before any order is assessed
+			isReplayed = true
+			var obErr error
+			ob, obErr = e.replayOrderbook(ev)
+			if obErr != nil {
+				err = gctcommon.AppendError(err, obErr)
+			}
+		}
+		f := r.newFill(ev)
+		if r.hasExpired(ev.GetTime()) {
+			if cancelErr := r.cancel(f, funds, fmt.Sprintf("time in force %v expired", r.GetTimeInForce())); cancelErr != nil {
+				err = gctcommon.AppendError(err, cancelErr)
+				remaining = append(remaining, r)
+				continue
+			}
+			cancelled = append(cancelled, r.Event)
+			continue
+		}
+		var (
+			price decimal.Decimal
+			ok    bool
+		)
+		if ob != nil && r.queue != nil {
+			price, ok = r.GetLimitPrice(), r.queue.filled
+		} else {
+			price, ok = r.match(ev.GetOpenPrice(), ev.GetHighPrice(), ev.GetLowPrice())
+		}
+		if !ok {
+			remaining = append(remaining, r)
+			continue
+		}
+		cs, settingsErr := e.GetCurrencySettings(r.GetExchange(), r.GetAssetType(), r.Pair())
+		if settingsErr != nil {
+			err = gctcommon.AppendError(err, settingsErr)
+			remaining = append(remaining, r)
+			continue
+		}
+		resp, fillErr := e.fillRestingOrder(r, f, &cs, om, funds, ob, price, ev.GetHighPrice(), ev.GetLowPrice(), ev.GetVolume(), true)
+		if fillErr != nil {
+			// release the funds of an order which cannot be filled so that
+			// they are not held indefinitely
+			if cancelErr := r.cancel(f, funds, fillErr.Error()); cancelErr != nil {
+				err = gctcommon.AppendError(err, cancelErr)
+				remaining = append(remaining, r)
+				continue
+			}
+			cancelled = append(cancelled, r.Event)
+			continue
+		}
+		filled = append(filled, resp)
+	}
+	e.restingOrders = remaining
+	return filled, cancelled, err
+}
+
+// replayOrderbook advances the data event's orderbook replay, returning nil
+// when there is no orderbook data so that candle data is used instead
+func (e *Exchange) replayOrderbook(ev data.Event) (*gctorderbook.Book, error) {
+	cs, err := e.GetCurrencySettings(ev.GetExchange(), ev.GetAssetType(), ev.Pair())
+	if err != nil || cs.Orderbook == nil {
+		return nil, err
+	}
+	return e.advanceOrderbook(ev, &cs)
+}
+
+// cancel releases the funds allocated to a resting order returning any
+// error other than ErrOrderCancelled
+func (r *restingOrder) cancel(f *fill.Fill, funds funding.IFundReleaser, reason string) error {
+	_, err := cancelOrder(r, f, funds, reason)
+	if err != nil && !errors.Is(err, ErrOrderCancelled) {
+		return err
+	}
+	return nil
+}
+
+// placeRestingOrder fills a non-market order when it can be immediately
+// matched against the order event's close price, or against the orderbook
+// when orderbook data is available, otherwise it is held by the exchange
+// until later data allows it to be filled or it is cancelled
+func (e *Exchange) placeRestingOrder(o order.Event, f *fill.Fill, cs *Settings, om *engine.OrderManager, funds funding.IFundReleaser) (fill.Event, error) {
+	if cs.UseRealOrders {
+		return cancelOrder(o, f, funds, fmt.Sprintf("%v %v", o.GetOrderType(), errRealOrdersMarketOnly))
+	}
+	if err := validateRestingOrder(o); err != nil {
+		return cancelOrder(o, f, funds, err.Error())
+	}
+	r := &restingOrder{Event: o}
+	var ob *gctorderbook.Book
+	if cs.Orderbook != nil {
+		var err error
+		ob, err = e.advanceOrderbook(o, cs)
+		if err != nil {
+			f.AppendReasonf("Orderbook unavailable, using candle data: %v", err)
+			ob = nil
+		}
+	}
+	tif := o.GetTimeInForce()
+	closePrice := o.GetClosePrice()
+	var (
+		price decimal.Decimal
+		ok    bool
+	)
+	if ob != nil && o.GetOrderType() == gctorder.Limit {
+		price, ok = o.GetLimitPrice(), isMarketable(ob, o.GetDirection(), o.GetLimitPrice())
+	} else {
+		price, ok = r.match(closePrice, closePrice, closePrice)
+	}
+	switch {
+	case ok && tif.Is(gctorder.PostOnly):
+		return cancelOrder(o, f, funds, "post only order would immediately match")
+	case ok:
+		f.AppendReasonf("%v order immediately matched at %v", o.GetOrderType(), price)
+		resp, err := e.fillRestingOrder(r, f, cs, om, funds, ob, price, closePrice, closePrice, decimal.Zero, false)
+		if err != nil {
+			return cancelOrder(o, f, funds, err.Error())
+		}
+		return resp, nil
+	case tif.Is(gctorder.ImmediateOrCancel) || tif.Is(gctorder.FillOrKill):
+		return cancelOrder(o, f, funds, fmt.Sprintf("%v order could not be immediately filled", tif))
+	}
+	if ob != nil && o.GetOrderType() == gctorder.Limit {
+		r.queue = newQueuePosition(ob, o.GetDirection(), o.GetLimitPrice())
+		f.AppendReasonf("Order queued behind %v at its limit price", r.queue.ahead)
+	}
+	e.restingOrders = append(e.restingOrders, r)
+	// the fill dependent event is raised once the resting order is filled
+	f.FillDependentEvent = nil
+	f.SetDirection(gctorder.DoNothing)
+	f.AppendReasonf("%v %v order for %v placed at limit price %v trigger price %v and is resting until filled",
+		o.GetOrderType(),
+		o.GetDirection(),
+		o.GetAmount(),
+		o.GetLimitPrice(),
+		o.GetTriggerPrice())
+	return f, nil
+}
+
+// fillRestingOrder fills a matched resting order at price. Limit orders which
+// have rested before being filled are charged maker fees, all others are
+// charged taker fees. When an orderbook is supplied, orders which have not
+// reached the front of the orderbook queue are filled against its depth,
+// otherwise slippage is applied to stop and take profit orders
+func (e *Exchange) fillRestingOrder(r *restingOrder, f *fill.Fill, cs *Settings, om *engine.OrderManager, funds funding.IFundReleaser, ob *gctorderbook.Book, price, high, low, volume decimal.Decimal, hasRested bool) (fill.Event, error) {
+	f.VolumeAdjustedPrice = price
+	amount := r.GetAmount()
+	feeRate := cs.TakerFee
+	if r.isLimit() && hasRested {
+		feeRate = cs.MakerFee
+	}
+	switch {
+	case ob != nil && r.queue != nil:
+		f.AppendReasonf("Order reached the front of the orderbook queue at %v", price)
+	case ob != nil:
+		var limitPrice decimal.Decimal
+		if r.isLimit() {
+			limitPrice = r.GetLimitPrice()
+		}
+		var err error
+		price, amount, err = fillFromOrderbook(ob, f, amount, limitPrice)
+		if err != nil {
+			return f, err
+		}
+	default:
+		if !cs.SkipCandleVolumeFitting && !r.GetAssetType().IsFutures() {
+			var adjustedAmount decimal.Decimal
+			_, adjustedAmount = ensureOrderFitsWithinHLV(price, amount, high, low, volume)
+			if !amount.Equal(adjustedAmount) {
+				f.AppendReasonf("Order size shrunk from %v to %v to fit candle", amount, adjustedAmount)
+				amount = adjustedAmount
+			}
+		}
+		if r.isLimit() {
+			break
+		}
+		slippageRate := slippage.EstimateSlippagePercentage(cs.MinimumSlippageRate, cs.MaximumSlippageRate)
+		adjustedPrice, err := applySlippageToPrice(f.GetDirection(), price, slippageRate)
+		if err != nil {
+			return f, err
+		}
+		if !adjustedPrice.Equal(price) {
+			f.AppendReasonf("Price has slipped from %v to %v", price, adjustedPrice)
+			price = adjustedPrice
+		}
+		f.Slippage = slippageRate.Mul(decimal.NewFromInt(100)).Sub(decimal.NewFromInt(100))
+	}
+	return e.fillOrder(r, f, cs, om, funds, price, price, amount, feeRate)
+}
+
+// cancelOrder releases the funds allocated to an order which will not be
+// filled and returns ErrOrderCancelled
+func cancelOrder(o order.Event, f *fill.Fill, funds funding.IFundReleaser, reason string) (fill.Event, error) {
+	f.FillDependentEvent = nil
+	f.AppendReasonf("%v order cancelled: %v", o.GetOrderType(), reason)
+	err := allocateFundsPostOrder(f, funds, ErrOrderCancelled, o.GetAmount(), o.GetAllocatedFunds(), decimal.Zero, decimal.Zero, decimal.Zero)
+	if err != nil && !errors.Is(err, ErrOrderCancelled) {
+		return f, err
+	}
+	return f, fmt.Errorf("%w %v %v %v %v: %v", ErrOrderCancelled, o.GetExchange(), o.GetAssetType(), o.Pair(), o.GetOrderType(), reason)
+}
+
+// isRestingOrderType returns whether an order is required to be matched
+// against price data before it can be filled
+func isRestingOrderType(o order.Event) bool {
+	if o.IsLiquidating() || o.IsClosingPosition() {
+		return false
+	}
+	return o.GetOrderType() != gctorder.UnknownType && o.GetOrderType() != gctorder.Market
+}
+
+// validateRestingOrder ensures an order contains the prices and time in force
+// values required to simulate it
+func validateRestingOrder(o order.Event) error {
+	switch o.GetOrderType() {
+	case gctorder.Limit:
+		if !o.GetLimitPrice().IsPositive() {
+			return errInvalidLimitPrice
+		}
+	case gctorder.Stop, gctorder.StopMarket, gctorder.TakeProfit, gctorder.TakeProfitMarket:
+		if !o.GetTriggerPrice().IsPositive() {
+			return errInvalidTriggerPrice
+		}
+	case gctorder.StopLimit:
+		if !o.GetLimitPrice().IsPositive() {
+			return errInvalidLimitPrice
+		}
+		if !o.GetTriggerPrice().IsPositive() {
+			return errInvalidTriggerPrice
+		}
+	default:
+		return fmt.Errorf("%w %v", gctorder.ErrUnsupportedOrderType, o.GetOrderType())
+	}
+	tif := o.GetTimeInForce()
+	switch {
+	case tif.Is(gctorder.GoodTillCrossing), tif.Is(gctorder.StopOrReduce):
+		return fmt.Errorf("%w %v", gctorder.ErrUnsupportedTimeInForce, tif)
+	case tif.Is(gctorder.PostOnly) && o.GetOrderType() != gctorder.Limit:
+		return fmt.Errorf("%w %v for %v orders", gctorder.ErrUnsupportedTimeInForce, tif, o.GetOrderType())
+	case tif.Is(gctorder.GoodTillTime) && !o.GetExpiry().After(o.GetTime()):
+		return errInvalidExpiry
+	}
+	return nil
+}
+
+// matchesEvent returns whether the data event is for the order's exchange,
+// asset and pair and occurs after the order was placed
+func (r *restingOrder) matchesEvent(ev data.Event) bool {
+	return r.GetExchange() == ev.GetExchange() &&
+		r.GetAssetType() == ev.GetAssetType() &&
+		r.Pair().Equal(ev.Pair()) &&
+		ev.GetTime().After(r.GetTime())
+}
+
+// hasExpired returns whether the order's time in force has lapsed by time t
+func (r *restingOrder) hasExpired(t time.Time) bool {
+	tif := r.GetTimeInForce()
+	switch {
+	case tif.Is(gctorder.GoodTillDay):
+		return !t.UTC().Truncate(day).Equal(r.GetTime().UTC().Truncate(day))
+	case tif.Is(gctorder.GoodTillTime):
+		return !t.Before(r.GetExpiry())
+	}
+	return false
+}
+
+// isLimit returns whether the order is filled at its limit price
+func (r *restingOrder) isLimit() bool {
+	return r.GetOrderType() == gctorder.Limit || r.GetOrderType() == gctorder.StopLimit
+}
+
+// match determines whether the order can be filled within a candle's open,
+// high and low prices and returns the price it would be filled at. Orders
+// whose price is crossed at the open are filled at the open price
+func (r *restingOrder) match(open, high, low decimal.Decimal) (decimal.Decimal, bool) {
+	isBuy := r.GetDirection().IsLong()
+	switch r.GetOrderType() {
+	case gctorder.Limit:
+		if isBuy {
+			return matchAtOrBelow(r.GetLimitPrice(), open, low)
+		}
+		return matchAtOrAbove(r.GetLimitPrice(), open, high)
+	case gctorder.Stop, gctorder.StopMarket:
+		if isBuy {
+			return matchAtOrAbove(r.GetTriggerPrice(), open, high)
+		}
+		return matchAtOrBelow(r.GetTriggerPrice(), open, low)
+	case gctorder.TakeProfit, gctorder.TakeProfitMarket:
+		if isBuy {
+			return matchAtOrBelow(r.GetTriggerPrice(), open, low)
+		}
+		return matchAtOrAbove(r.GetTriggerPrice(), open, high)
+	case gctorder.StopLimit:
+		if !r.triggered {
+			var triggerPrice decimal.Decimal
+			var ok bool
+			if isBuy {
+				triggerPrice, ok = matchAtOrAbove(r.GetTriggerPrice(), open, high)
+			} else {
+				triggerPrice, ok = matchAtOrBelow(r.GetTriggerPrice(), open, low)
+			}
+			if !ok {
+				return decimal.Zero, false
+			}
+			r.triggered = true
+			// the limit order is only active from the trigger price onwards
+			open = triggerPrice
+		}
+		if isBuy {
+			return matchAtOrBelow(r.GetLimitPrice(), open, low)
+		}
+		return matchAtOrAbove(r.GetLimitPrice(), open, high)
+	}
+	return decimal.Zero, false
+}
+
+// newFill creates a fill event for the order at the data event's time
+func (r *restingOrder) newFill(ev data.Event) *fill.Fill {
+	b := *ev.GetBase()
+	b.Reasons = nil
+	return &fill.Fill{
+		Base:               &b,
+		Direction:          r.GetDirection(),
+		Amount:             r.GetAmount(),
+		ClosePrice:         ev.GetClosePrice(),
+		FillDependentEvent: r.GetFillDependentEvent(),
+	}
+}
+
+const day = time.Hour * 24
+
+func matchAtOrBelow(price, open, low decimal.Decimal) (decimal.Decimal, bool) {
+	if open.LessThanOrEqual(price) {
+		return open, true
+	}
+	if low.LessThanOrEqual(price) {
+		return price, true
+	}
+	return decimal.Zero, false
+}
+
+func matchAtOrAbove(price, open, high decimal.Decimal) (decimal.Decimal, bool) {
+	if open.GreaterThanOrEqual(price) {
+		return open, true
+	}
+	if high.GreaterThanOrEqual(price) {
+		return price, true
+	}
+	return decimal.Zero, false
+}
@@ -0,0 +1,333 @@
+package exchange
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/event"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/fill"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/kline"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/order"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/signal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/funding"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
+	gctconfig "github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/engine"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/binanceus"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/currencystate"
+	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+func newRestingOrderFunds(t *testing.T) *funding.SpotPair {
+	t.Helper()
+	base, err := funding.CreateItem(testExchange, asset.Spot, currency.BTC, leet, decimal.Zero)
+	require.NoError(t, err, "CreateItem must not error")
+	quote, err := funding.CreateItem(testExchange, asset.Spot, currency.USDT, leet, decimal.Zero)
+	require.NoError(t, err, "CreateItem must not error")
+	require.NoError(t, quote.Reserve(leet), "Reserve must not error")
+	p, err := funding.CreatePair(base, quote)
+	require.NoError(t, err, "CreatePair must not error")
+	return p
+}
+
+func newRestingOrder(tm time.Time, orderType gctorder.Type, side gctorder.Side, limitPrice, triggerPrice decimal.Decimal) *order.Order {
+	return &order.Order{
+		Base: &event.Base{
+			Exchange:     testExchange,
+			Time:         tm,
+			Interval:     gctkline.OneDay,
+			CurrencyPair: currency.NewBTCUSDT(),
+			AssetType:    asset.Spot,
+		},
+		Direction:      side,
+		OrderType:      orderType,
+		LimitPrice:     limitPrice,
+		TriggerPrice:   triggerPrice,
+		Amount:         decimal.NewFromInt(1),
+		AllocatedFunds: leet,
+		ClosePrice:     decimal.NewFromInt(100),
+	}
+}
+
+func newKlineEvent(tm time.Time, o, h, l, c int64) *kline.Kline {
+	return &kline.Kline{
+		Base: &event.Base{
+			Exchange:     testExchange,
+			Time:         tm,
+			Interval:     gctkline.OneDay,
+			CurrencyPair: currency.NewBTCUSDT(),
+			AssetType:    asset.Spot,
+		},
+		Open:   decimal.NewFromInt(o),
+		High:   decimal.NewFromInt(h),
+		Low:    decimal.NewFromInt(l),
+		Close:  decimal.NewFromInt(c),
+		Volume: leet,
+	}
+}
+
+func TestIsRestingOrderType(t *testing.T) {
+	t.Parallel()
+	o := &order.Order{Base: &event.Base{}}
+	assert.False(t, isRestingOrderType(o), "unset order type should not rest")
+	o.OrderType = gctorder.Market
+	assert.False(t, isRestingOrderType(o), "market orders should not rest")
+	o.OrderType = gctorder.Limit
+	assert.True(t, isRestingOrderType(o), "limit orders should rest")
+	o.LiquidatingPosition = true
+	assert.False(t, isRestingOrderType(o), "liquidation orders should not rest")
+	o.LiquidatingPosition = false
+	o.ClosingPosition = true
+	assert.False(t, isRestingOrderType(o), "closing orders should not rest")
+}
+
+func TestValidateRestingOrder(t *testing.T) {
+	t.Parallel()
+	tm := time.Now()
+	one := decimal.NewFromInt(1)
+	o := newRestingOrder(tm, gctorder.Limit, gctorder.Buy, decimal.Zero, decimal.Zero)
+	assert.ErrorIs(t, validateRestingOrder(o), errInvalidLimitPrice)
+
+	o.LimitPrice = one
+	assert.NoError(t, validateRestingOrder(o))
+
+	o.OrderType = gctorder.Stop
+	assert.ErrorIs(t, validateRestingOrder(o), errInvalidTriggerPrice)
+
+	o.TriggerPrice = one
+	assert.NoError(t, validateRestingOrder(o))
+
+	o.OrderType = gctorder.StopLimit
+	o.LimitPrice = decimal.Zero
+	assert.ErrorIs(t, validateRestingOrder(o), errInvalidLimitPrice)
+
+	o.LimitPrice = one
+	o.TriggerPrice = decimal.Zero
+	assert.ErrorIs(t, validateRestingOrder(o), errInvalidTriggerPrice)
+
+	o.TriggerPrice = one
+	assert.NoError(t, validateRestingOrder(o))
+
+	o.OrderType = gctorder.TrailingStop
+	assert.ErrorIs(t, validateRestingOrder(o), gctorder.ErrUnsupportedOrderType)
+
+	o.OrderType = gctorder.StopLimit
+	o.TimeInForce = gctorder.PostOnly
+	assert.ErrorIs(t, validateRestingOrder(o), gctorder.ErrUnsupportedTimeInForce)
+
+	o.OrderType = gctorder.Limit
+	assert.NoError(t, validateRestingOrder(o))
+
+	o.TimeInForce = gctorder.GoodTillCrossing
+	assert.ErrorIs(t, validateRestingOrder(o), gctorder.ErrUnsupportedTimeInForce)
+
+	o.TimeInForce = gctorder.GoodTillTime
+	o.Expiry = tm
+	assert.ErrorIs(t, validateRestingOrder(o), errInvalidExpiry)
+
+	o.Expiry = tm.Add(time.Hour)
+	assert.NoError(t, validateRestingOrder(o))
+}
+
+func TestRestingOrderMatch(t *testing.T) {
+	t.Parallel()
+	tm := time.Now()
+	p90, p100, p110 := decimal.NewFromInt(90), decimal.NewFromInt(100), decimal.NewFromInt(110)
+	for _, tc := range []struct {
+		name         string
+		orderType    gctorder.Type
+		side         gctorder.Side
+		limitPrice   decimal.Decimal
+		triggerPrice decimal.Decimal
+		open         decimal.Decimal
+		high         decimal.Decimal
+		low          decimal.Decimal
+		price        decimal.Decimal
+		matched      bool
+	}{
+		{name: "limit buy touched", orderType: gctorder.Limit, side: gctorder.Buy, limitPrice: p90, open: p100, high: p110, low: p90, price: p90, matched: true},
+		{name: "limit buy gapped", orderType: gctorder.Limit, side: gctorder.Buy, limitPrice: p100, open: p90, high: p110, low: p90, price: p90, matched: true},
+		{name: "limit buy untouched", orderType: gctorder.Limit, side: gctorder.Buy, limitPrice: p90, open: p100, high: p110, low: p100},
+		{name: "limit sell touched", orderType: gctorder.Limit, side: gctorder.Sell, limitPrice: p110, open: p100, high: p110, low: p90, price: p110, matched: true},
+		{name: "limit sell untouched", orderType: gctorder.Limit, side: gctorder.Sell, limitPrice: p110, open: p100, high: p100, low: p90},
+		{name: "stop buy triggered", orderType: gctorder.Stop, side: gctorder.Buy, triggerPrice: p110, open: p100, high: p110, low: p90, price: p110, matched: true},
+		{name: "stop sell triggered", orderType: gctorder.StopMarket, side: gctorder.Sell, triggerPrice: p90, open: p100, high: p110, low: p90, price: p90, matched: true},
+		{name: "stop sell untriggered", orderType: gctorder.Stop, side: gctorder.Sell, triggerPrice: p90, open: p100, high: p110, low: p100},
+		{name: "take profit sell triggered", orderType: gctorder.TakeProfit, side: gctorder.Sell, triggerPrice: p110, open: p100, high: p110, low: p90, price: p110, matched: true},
+		{name: "take profit buy triggered", orderType: gctorder.TakeProfitMarket, side: gctorder.Buy, triggerPrice: p90, open: p100, high: p110, low: p90, price: p90, matched: true},
+		{name: "stop limit sell filled", orderType: gctorder.StopLimit, side: gctorder.Sell, triggerPrice: p90, limitPrice: p90, open: p100, high: p110, low: p90, price: p90, matched: true},
+		{name: "stop limit buy untriggered", orderType: gctorder.StopLimit, side: gctorder.Buy, triggerPrice: p110, limitPrice: p110, open: p100, high: p100, low: p90},
+		{name: "market", orderType: gctorder.Market, side: gctorder.Buy, open: p100, high: p110, low: p90},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			r := &restingOrder{Event: newRestingOrder(tm, tc.orderType, tc.side, tc.limitPrice, tc.triggerPrice)}
+			price, ok := r.match(tc.open, tc.high, tc.low)
+			assert.Equal(t, tc.matched, ok, "match should return the correct match status")
+			assert.True(t, tc.price.Equal(price), "match should return the correct price")
+		})
+	}
+}
+
+func TestRestingOrderStopLimitRemainsTriggered(t *testing.T) {
+	t.Parallel()
+	r := &restingOrder{Event: newRestingOrder(time.Now(), gctorder.StopLimit, gctorder.Buy, decimal.NewFromInt(100), decimal.NewFromInt(110))}
+	_, ok := r.match(decimal.NewFromInt(105), decimal.NewFromInt(115), decimal.NewFromInt(105))
+	assert.False(t, ok, "limit should not be matched after triggering above the limit price")
+	assert.True(t, r.triggered, "stop limit should be triggered")
+
+	price, ok := r.match(decimal.NewFromInt(105), decimal.NewFromInt(106), decimal.NewFromInt(99))
+	assert.True(t, ok, "triggered stop limit should match once its limit price is reached")
+	assert.True(t, decimal.NewFromInt(100).Equal(price), "triggered stop limit should fill at its limit price")
+}
+
+func TestRestingOrderHasExpired(t *testing.T) {
+	t.Parallel()
+	tm := time.Date(2022, 1, 1, 12, 0, 0, 0, time.UTC)
+	r := &restingOrder{Event: newRestingOrder(tm, gctorder.Limit, gctorder.Buy, decimal.NewFromInt(1), decimal.Zero)}
+	assert.False(t, r.hasExpired(tm.Add(day*365)), "good till cancelled orders should not expire")
+
+	r.Event.(*order.Order).TimeInForce = gctorder.GoodTillDay
+	assert.False(t, r.hasExpired(tm.Add(time.Hour)), "good till day orders should not expire on the same day")
+	assert.True(t, r.hasExpired(tm.Add(day)), "good till day orders should expire on the next day")
+
+	r.Event.(*order.Order).TimeInForce = gctorder.GoodTillTime
+	r.Event.(*order.Order).Expiry = tm.Add(time.Hour)
+	assert.False(t, r.hasExpired(tm.Add(time.Minute)), "good till time orders should not expire before expiry")
+	assert.True(t, r.hasExpired(tm.Add(time.Hour)), "good till time orders should expire at expiry")
+}
+
+func TestRestingOrderMatchesEvent(t *testing.T) {
+	t.Parallel()
+	tm := time.Now()
+	r := &restingOrder{Event: newRestingOrder(tm, gctorder.Limit, gctorder.Buy, decimal.NewFromInt(1), decimal.Zero)}
+	assert.False(t, r.matchesEvent(newKlineEvent(tm, 1, 1, 1, 1)), "events at the order time should not match")
+	ev := newKlineEvent(tm.Add(time.Minute), 1, 1, 1, 1)
+	assert.True(t, r.matchesEvent(ev), "later events for the same pair should match")
+	ev.CurrencyPair = currency.NewBTCUSD()
+	assert.False(t, r.matchesEvent(ev), "events for other pairs should not match")
+}
+
+func TestCancelOrder(t *testing.T) {
+	t.Parallel()
+	funds := newRestingOrderFunds(t)
+	o := newRestingOrder(time.Now(), gctorder.Limit, gctorder.Buy, decimal.NewFromInt(1), decimal.Zero)
+	f := &fill.Fill{Base: o.Base, Direction: gctorder.Buy}
+	_, err := cancelOrder(o, f, funds, "test")
+	assert.ErrorIs(t, err, ErrOrderCancelled)
+	assert.Equal(t, gctorder.CouldNotBuy, f.GetDirection(), "cancelled buy orders should be marked as could not buy")
+	assert.True(t, funds.QuoteAvailable().Equal(leet), "cancelled orders should release their allocated funds")
+}
+
+func TestGetRestingOrders(t *testing.T) {
+	t.Parallel()
+	e := &Exchange{}
+	assert.Empty(t, e.GetRestingOrders())
+	o := newRestingOrder(time.Now(), gctorder.Limit, gctorder.Buy, decimal.NewFromInt(1), decimal.Zero)
+	e.restingOrders = append(e.restingOrders, &restingOrder{Event: o})
+	resp := e.GetRestingOrders()
+	require.Len(t, resp, 1)
+	assert.Equal(t, o, resp[0])
+	require.NoError(t, e.Reset())
+	assert.Empty(t, e.GetRestingOrders(), "Reset should clear resting orders")
+}
+
+func TestPlaceRestingOrder(t *testing.T) {
+	t.Parallel()
+	tm := time.Now()
+	e := &Exchange{}
+	cs := &Settings{}
+	o := newRestingOrder(tm, gctorder.Limit, gctorder.Buy, decimal.NewFromInt(90), decimal.Zero)
+	f := &fill.Fill{Base: o.Base, Direction: gctorder.Buy, FillDependentEvent: &signal.Signal{}}
+	resp, err := e.placeRestingOrder(o, f, cs, nil, newRestingOrderFunds(t))
+	require.NoError(t, err, "placeRestingOrder must not error")
+	assert.Equal(t, gctorder.DoNothing, resp.GetDirection(), "resting orders should not change holdings on placement")
+	assert.Nil(t, resp.GetFillDependentEvent(), "resting orders should defer their fill dependent event")
+	assert.Len(t, e.GetRestingOrders(), 1)
+
+	o = newRestingOrder(tm, gctorder.Limit, gctorder.Buy, decimal.NewFromInt(90), decimal.Zero)
+	o.TimeInForce = gctorder.ImmediateOrCancel
+	_, err = e.placeRestingOrder(o, &fill.Fill{Base: o.Base, Direction: gctorder.Buy}, cs, nil, newRestingOrderFunds(t))
+	assert.ErrorIs(t, err, ErrOrderCancelled)
+
+	o = newRestingOrder(tm, gctorder.Limit, gctorder.Buy, decimal.NewFromInt(110), decimal.Zero)
+	o.TimeInForce = gctorder.PostOnly
+	_, err = e.placeRestingOrder(o, &fill.Fill{Base: o.Base, Direction: gctorder.Buy}, cs, nil, newRestingOrderFunds(t))
+	assert.ErrorIs(t, err, ErrOrderCancelled)
+
+	o = newRestingOrder(tm, gctorder.Limit, gctorder.Buy, decimal.Zero, decimal.Zero)
+	_, err = e.placeRestingOrder(o, &fill.Fill{Base: o.Base, Direction: gctorder.Buy}, cs, nil, newRestingOrderFunds(t))
+	assert.ErrorIs(t, err, ErrOrderCancelled)
+
+	cs.UseRealOrders = true
+	o = newRestingOrder(tm, gctorder.Limit, gctorder.Buy, decimal.NewFromInt(90), decimal.Zero)
+	_, err = e.placeRestingOrder(o, &fill.Fill{Base: o.Base, Direction: gctorder.Buy}, cs, nil, newRestingOrderFunds(t))
+	assert.ErrorIs(t, err, ErrOrderCancelled)
+	assert.Len(t, e.GetRestingOrders(), 1, "cancelled orders should not rest")
+}
+
+func TestProcessRestingOrders(t *testing.T) {
+	t.Parallel()
+	e := &Exchange{}
+	_, _, err := e.ProcessRestingOrders(nil, nil, nil)
+	assert.ErrorIs(t, err, common.ErrNilEvent)
+
+	tm := time.Date(2022, 1, 1, 12, 0, 0, 0, time.UTC)
+	_, _, err = e.ProcessRestingOrders(newKlineEvent(tm, 1, 1, 1, 1), nil, nil)
+	assert.ErrorIs(t, err, gctcommon.ErrNilPointer)
+
+	em := engine.NewExchangeManager()
+	exch, err := em.NewExchangeByName(testExchange)
+	require.NoError(t, err, "NewExchangeByName must not error")
+	exch.SetDefaults()
+	exch.GetBase().States = currencystate.NewCurrencyStates()
+	require.NoError(t, em.Add(exch), "ExchangeManager.Add must not error")
+	var wg sync.WaitGroup
+	om, err := engine.SetupOrderManager(em, &engine.CommunicationManager{}, &wg, &gctconfig.OrderManager{})
+	require.NoError(t, err, "SetupOrderManager must not error")
+	require.NoError(t, om.Start(), "OrderManager.Start must not error")
+
+	b := &binanceus.Exchange{}
+	b.Name = testExchange
+	e.SetExchangeAssetCurrencySettings(asset.Spot, currency.NewBTCUSDT(), &Settings{
+		Exchange:                b,
+		Pair:                    currency.NewBTCUSDT(),
+		Asset:                   asset.Spot,
+		MakerFee:                decimal.NewFromFloat(0.001),
+		TakerFee:                decimal.NewFromFloat(0.002),
+		SkipCandleVolumeFitting: true,
+	})
+
+	limit := newRestingOrder(tm, gctorder.Limit, gctorder.Buy, decimal.NewFromInt(90), decimal.Zero)
+	gtd := newRestingOrder(tm, gctorder.Limit, gctorder.Buy, decimal.NewFromInt(50), decimal.Zero)
+	gtd.TimeInForce = gctorder.GoodTillDay
+	e.restingOrders = []*restingOrder{{Event: limit}, {Event: gtd}}
+
+	funds := newRestingOrderFunds(t)
+	filled, cancelled, err := e.ProcessRestingOrders(newKlineEvent(tm.Add(time.Hour), 100, 110, 95, 100), om, funds)
+	require.NoError(t, err, "ProcessRestingOrders must not error")
+	assert.Empty(t, filled, "unmatched orders should not be filled")
+	assert.Empty(t, cancelled, "unexpired orders should not be cancelled")
+	assert.Len(t, e.GetRestingOrders(), 2)
+
+	filled, cancelled, err = e.ProcessRestingOrders(newKlineEvent(tm.Add(day), 100, 110, 85, 100), om, funds)
+	require.NoError(t, err, "ProcessRestingOrders must not error")
+	require.Len(t, filled, 1, "matched limit order must be filled")
+	require.Len(t, cancelled, 1, "expired good till day order must be cancelled")
+	assert.Equal(t, gtd, cancelled[0])
+	assert.Empty(t, e.GetRestingOrders(), "filled and cancelled orders should no longer rest")
+
+	f := filled[0]
+	assert.True(t, decimal.NewFromInt(90).Equal(f.GetPurchasePrice()), "limit order should fill at its limit price")
+	assert.True(t, decimal.NewFromFloat(0.09).Equal(f.GetExchangeFee()), "rested limit order should be charged maker fees")
+	require.NotNil(t, f.GetOrder(), "filled order must have an order detail")
+	assert.Equal(t, gctorder.Limit, f.GetOrder().Type)
+}
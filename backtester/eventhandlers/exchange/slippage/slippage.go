@@ -1,9 +1,11 @@
 package slippage
 
 import (
+	"fmt"
 	"math/rand"
 
 	"github.com/shopspring/decimal"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
 	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 )
@@ -41,3 +43,45 @@ func CalculateSlippageByOrderbook(ob *orderbook.Book, side gctorder.Side, alloca
 	amount = decimal.NewFromFloat(result.Amount * (1 - feeRate.InexactFloat64()))
 	return
 }
+
+// SimulateOrderbookFill walks the side of the orderbook an order of amount
+// would consume and returns the volume weighted average price it would be
+// filled at. When limitPrice is positive, levels priced beyond it are not
+// consumed. The filled amount is less than amount when there is insufficient
+// liquidity
+func SimulateOrderbookFill(ob *orderbook.Book, side gctorder.Side, amount, limitPrice decimal.Decimal) (price, filled decimal.Decimal, err error) {
+	if ob == nil {
+		return decimal.Zero, decimal.Zero, fmt.Errorf("%w orderbook", gctcommon.ErrNilPointer)
+	}
+	if !amount.IsPositive() {
+		return decimal.Zero, decimal.Zero, gctorder.ErrAmountIsInvalid
+	}
+	var levels orderbook.Levels
+	var isBuy bool
+	switch {
+	case side.IsLong():
+		levels, isBuy = ob.Asks, true
+	case side.IsShort():
+		levels = ob.Bids
+	default:
+		return decimal.Zero, decimal.Zero, fmt.Errorf("%v %w", side, gctorder.ErrSideIsInvalid)
+	}
+	var cost decimal.Decimal
+	for i := range levels {
+		levelPrice := decimal.NewFromFloat(levels[i].Price)
+		if limitPrice.IsPositive() &&
+			((isBuy && levelPrice.GreaterThan(limitPrice)) || (!isBuy && levelPrice.LessThan(limitPrice))) {
+			break
+		}
+		levelAmount := decimal.Min(decimal.NewFromFloat(levels[i].Amount), amount.Sub(filled))
+		cost = cost.Add(levelAmount.Mul(levelPrice))
+		filled = filled.Add(levelAmount)
+		if filled.GreaterThanOrEqual(amount) {
+			break
+		}
+	}
+	if filled.IsZero() {
+		return decimal.Zero, decimal.Zero, fmt.Errorf("%v %v %v %w to %v %v", ob.Exchange, ob.Asset, ob.Pair, ErrNoLiquidity, side, amount)
+	}
+	return cost.Div(filled), filled, nil
+}
@@ -6,10 +6,12 @@ import (
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/bitstamp"
 	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 )
 
 func TestRandomSlippage(t *testing.T) {
@@ -35,3 +37,37 @@ func TestCalculateSlippageByOrderbook(t *testing.T) {
 	orderSize := price.Mul(amount).Add(price.Mul(amount).Mul(feeRate))
 	assert.True(t, orderSize.LessThan(amountOfFunds), "order size should be less than funds")
 }
+
+func TestSimulateOrderbookFill(t *testing.T) {
+	t.Parallel()
+	_, _, err := SimulateOrderbookFill(nil, gctorder.Buy, decimal.NewFromInt(1), decimal.Zero)
+	assert.ErrorIs(t, err, gctcommon.ErrNilPointer)
+
+	ob := &orderbook.Book{
+		Bids: orderbook.Levels{{Price: 99, Amount: 1}, {Price: 98, Amount: 1}},
+		Asks: orderbook.Levels{{Price: 101, Amount: 1}, {Price: 102, Amount: 1}},
+	}
+	_, _, err = SimulateOrderbookFill(ob, gctorder.Buy, decimal.Zero, decimal.Zero)
+	assert.ErrorIs(t, err, gctorder.ErrAmountIsInvalid)
+
+	_, _, err = SimulateOrderbookFill(ob, gctorder.ClosePosition, decimal.NewFromInt(1), decimal.Zero)
+	assert.ErrorIs(t, err, gctorder.ErrSideIsInvalid)
+
+	price, filled, err := SimulateOrderbookFill(ob, gctorder.Buy, decimal.NewFromInt(2), decimal.Zero)
+	require.NoError(t, err, "SimulateOrderbookFill must not error")
+	assert.Equal(t, "101.5", price.String(), "buy orders should fill at the volume weighted ask price")
+	assert.Equal(t, "2", filled.String(), "buy orders should be fully filled")
+
+	price, filled, err = SimulateOrderbookFill(ob, gctorder.Short, decimal.NewFromInt(3), decimal.Zero)
+	require.NoError(t, err, "SimulateOrderbookFill must not error")
+	assert.Equal(t, "98.5", price.String(), "short orders should fill at the volume weighted bid price")
+	assert.Equal(t, "2", filled.String(), "orders should only fill the available liquidity")
+
+	price, filled, err = SimulateOrderbookFill(ob, gctorder.Buy, decimal.NewFromInt(2), decimal.NewFromInt(101))
+	require.NoError(t, err, "SimulateOrderbookFill must not error")
+	assert.Equal(t, "101", price.String(), "limit orders should not fill beyond their limit price")
+	assert.Equal(t, "1", filled.String(), "limit orders should only fill up to their limit price")
+
+	_, _, err = SimulateOrderbookFill(ob, gctorder.Sell, decimal.NewFromInt(1), decimal.NewFromInt(100))
+	assert.ErrorIs(t, err, ErrNoLiquidity)
+}
@@ -74,7 +74,14 @@ func (p *Portfolio) OnSignal(ev signal.Event, exchangeSettings *exchange.Setting
 		return cannotPurchase(ev, o)
 	}
 
-	o.OrderType = gctorder.Market
+	o.OrderType = ev.GetOrderType()
+	if o.OrderType == gctorder.UnknownType {
+		o.OrderType = gctorder.Market
+	}
+	o.LimitPrice = ev.GetLimitPrice()
+	o.TriggerPrice = ev.GetTriggerPrice()
+	o.TimeInForce = ev.GetTimeInForce()
+	o.Expiry = ev.GetExpiry()
 	o.BuyLimit = ev.GetBuyLimit()
 	o.SellLimit = ev.GetSellLimit()
 	var sizingFunds decimal.Decimal
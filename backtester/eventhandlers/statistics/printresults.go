@@ -372,6 +372,11 @@ func (f *FundingStatistics) PrintResults(wasAnyDataMissing bool) error {
 				log.Infof(common.FundingStatistics, "%s Highest Contract Holdings: %v %v at %v", sep, futuresResults[i].HighestHoldings.Value, futuresResults[i].ReportItem.Currency, futuresResults[i].HighestHoldings.Time)
 				log.Infof(common.FundingStatistics, "%s Initial Contract Holdings: %v %v at %v", sep, futuresResults[i].InitialHoldings.Value, futuresResults[i].ReportItem.Currency, futuresResults[i].InitialHoldings.Time)
 				log.Infof(common.FundingStatistics, "%s Final Contract Holdings: %v %v at %v", sep, futuresResults[i].FinalHoldings.Value, futuresResults[i].ReportItem.Currency, futuresResults[i].FinalHoldings.Time)
+				if futuresResults[i].ReportItem.HasFundingRates {
+					log.Infof(common.FundingStatistics, "%s Funding Paid: %v %v", sep, futuresResults[i].ReportItem.FundingPaid, futuresResults[i].ReportItem.PairedWith)
+					log.Infof(common.FundingStatistics, "%s Funding Received: %v %v", sep, futuresResults[i].ReportItem.FundingReceived, futuresResults[i].ReportItem.PairedWith)
+					log.Infof(common.FundingStatistics, "%s Net Funding: %v %v", sep, futuresResults[i].ReportItem.FundingReceived.Sub(futuresResults[i].ReportItem.FundingPaid), futuresResults[i].ReportItem.PairedWith)
+				}
 			}
 			if i != len(futuresResults)-1 {
 				log.Infoln(common.FundingStatistics, "")
@@ -4,6 +4,8 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/backtester/common"
 	"github.com/thrasher-corp/gocryptotrader/backtester/data"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/holdings"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/fill"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/order"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/signal"
 	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
 )
@@ -51,3 +53,16 @@ func (s *Strategy) SetSimultaneousProcessing(b bool) {
 func (s *Strategy) CloseAllPositions([]holdings.Holding, []data.Event) ([]signal.Event, error) {
 	return nil, gctcommon.ErrFunctionNotSupported
 }
+
+// OnOrderFilled is called when a non-market order placed by the strategy
+// has been filled. Strategies can override it to track their resting orders
+func (s *Strategy) OnOrderFilled(fill.Event) error {
+	return nil
+}
+
+// OnOrderCancelled is called when a non-market order placed by the strategy
+// has been cancelled or has expired before being filled. Strategies can
+// override it to track their resting orders
+func (s *Strategy) OnOrderCancelled(order.Event) error {
+	return nil
+}
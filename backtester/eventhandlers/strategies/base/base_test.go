@@ -78,3 +78,15 @@ func TestCloseAllPositions(t *testing.T) {
 	_, err := s.CloseAllPositions(nil, nil)
 	assert.ErrorIs(t, err, gctcommon.ErrFunctionNotSupported)
 }
+
+func TestOnOrderFilled(t *testing.T) {
+	t.Parallel()
+	s := &Strategy{}
+	assert.NoError(t, s.OnOrderFilled(nil))
+}
+
+func TestOnOrderCancelled(t *testing.T) {
+	t.Parallel()
+	s := &Strategy{}
+	assert.NoError(t, s.OnOrderCancelled(nil))
+}
@@ -1,6 +1,8 @@
 package order
 
 import (
+	"time"
+
 	"github.com/shopspring/decimal"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/signal"
 	"github.com/thrasher-corp/gocryptotrader/currency"
@@ -103,3 +105,28 @@ func (o *Order) IsLiquidating() bool {
 func (o *Order) GetClosePrice() decimal.Decimal {
 	return o.ClosePrice
 }
+
+// GetOrderType returns the order type
+func (o *Order) GetOrderType() order.Type {
+	return o.OrderType
+}
+
+// GetLimitPrice returns the limit price of a non-market order
+func (o *Order) GetLimitPrice() decimal.Decimal {
+	return o.LimitPrice
+}
+
+// GetTriggerPrice returns the trigger price of a non-market order
+func (o *Order) GetTriggerPrice() decimal.Decimal {
+	return o.TriggerPrice
+}
+
+// GetTimeInForce returns the time in force of a non-market order
+func (o *Order) GetTimeInForce() order.TimeInForce {
+	return o.TimeInForce
+}
+
+// GetExpiry returns when a GoodTillTime order expires
+func (o *Order) GetExpiry() time.Time {
+	return o.Expiry
+}
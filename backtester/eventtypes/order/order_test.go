@@ -2,6 +2,7 @@ package order
 
 import (
 	"testing"
+	"time"
 
 	"github.com/shopspring/decimal"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/event"
@@ -170,3 +171,54 @@ func TestIsClosingPosition(t *testing.T) {
 		t.Errorf("received '%v' expected '%v'", k.IsClosingPosition(), true)
 	}
 }
+
+func TestGetOrderType(t *testing.T) {
+	t.Parallel()
+	s := Order{
+		OrderType: gctorder.Limit,
+	}
+	if s.GetOrderType() != gctorder.Limit {
+		t.Errorf("received '%v' expected '%v'", s.GetOrderType(), gctorder.Limit)
+	}
+}
+
+func TestGetLimitPrice(t *testing.T) {
+	t.Parallel()
+	s := Order{
+		LimitPrice: decimal.NewFromInt(1337),
+	}
+	if !s.GetLimitPrice().Equal(decimal.NewFromInt(1337)) {
+		t.Errorf("received '%v' expected '%v'", s.GetLimitPrice(), 1337)
+	}
+}
+
+func TestGetTriggerPrice(t *testing.T) {
+	t.Parallel()
+	s := Order{
+		TriggerPrice: decimal.NewFromInt(1337),
+	}
+	if !s.GetTriggerPrice().Equal(decimal.NewFromInt(1337)) {
+		t.Errorf("received '%v' expected '%v'", s.GetTriggerPrice(), 1337)
+	}
+}
+
+func TestGetTimeInForce(t *testing.T) {
+	t.Parallel()
+	s := Order{
+		TimeInForce: gctorder.GoodTillDay,
+	}
+	if s.GetTimeInForce() != gctorder.GoodTillDay {
+		t.Errorf("received '%v' expected '%v'", s.GetTimeInForce(), gctorder.GoodTillDay)
+	}
+}
+
+func TestGetExpiry(t *testing.T) {
+	t.Parallel()
+	tt := time.Now()
+	s := Order{
+		Expiry: tt,
+	}
+	if !s.GetExpiry().Equal(tt) {
+		t.Errorf("received '%v' expected '%v'", s.GetExpiry(), tt)
+	}
+}
@@ -1,6 +1,8 @@
 package order
 
 import (
+	"time"
+
 	"github.com/shopspring/decimal"
 	"github.com/thrasher-corp/gocryptotrader/backtester/common"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/event"
@@ -24,6 +26,10 @@ type Order struct {
 	FillDependentEvent  signal.Event
 	ClosingPosition     bool
 	LiquidatingPosition bool
+	LimitPrice          decimal.Decimal
+	TriggerPrice        decimal.Decimal
+	TimeInForce         order.TimeInForce
+	Expiry              time.Time
 }
 
 // Event inherits common event interfaces along with extra functions related to handling orders
@@ -44,4 +50,9 @@ type Event interface {
 	GetFillDependentEvent() signal.Event
 	IsClosingPosition() bool
 	IsLiquidating() bool
+	GetOrderType() order.Type
+	GetLimitPrice() decimal.Decimal
+	GetTriggerPrice() decimal.Decimal
+	GetTimeInForce() order.TimeInForce
+	GetExpiry() time.Time
 }
@@ -1,6 +1,8 @@
 package signal
 
 import (
+	"time"
+
 	"github.com/shopspring/decimal"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/kline"
 	"github.com/thrasher-corp/gocryptotrader/currency"
@@ -114,6 +116,31 @@ func (s *Signal) MatchOrderAmount() bool {
 	return s.MatchesOrderAmount
 }
 
+// GetOrderType returns the order type
+func (s *Signal) GetOrderType() order.Type {
+	return s.OrderType
+}
+
+// GetLimitPrice returns the limit price of a non-market order
+func (s *Signal) GetLimitPrice() decimal.Decimal {
+	return s.LimitPrice
+}
+
+// GetTriggerPrice returns the trigger price of a non-market order
+func (s *Signal) GetTriggerPrice() decimal.Decimal {
+	return s.TriggerPrice
+}
+
+// GetTimeInForce returns the time in force of a non-market order
+func (s *Signal) GetTimeInForce() order.TimeInForce {
+	return s.TimeInForce
+}
+
+// GetExpiry returns when a GoodTillTime order expires
+func (s *Signal) GetExpiry() time.Time {
+	return s.Expiry
+}
+
 // ToKline is used to convert a signal event
 // to a data event for the purpose of closing all positions
 // function CloseAllPositions is builds signal data, but
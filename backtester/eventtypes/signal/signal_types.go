@@ -1,6 +1,8 @@
 package signal
 
 import (
+	"time"
+
 	"github.com/shopspring/decimal"
 	"github.com/thrasher-corp/gocryptotrader/backtester/common"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/event"
@@ -29,6 +31,11 @@ type Event interface {
 	SetAmount(decimal.Decimal)
 	MatchOrderAmount() bool
 	IsNil() bool
+	GetOrderType() order.Type
+	GetLimitPrice() decimal.Decimal
+	GetTriggerPrice() decimal.Decimal
+	GetTimeInForce() order.TimeInForce
+	GetExpiry() time.Time
 }
 
 // Signal contains everything needed for a strategy to raise a signal event
@@ -66,4 +73,18 @@ type Signal struct {
 	// MatchOrderAmount flags to other event handlers
 	// that the order amount must match the set Amount property
 	MatchesOrderAmount bool
+	// OrderType is an optional parameter to place a non-market order
+	// Limit, Stop, StopLimit and TakeProfit orders are held by the
+	// exchange handler until later candle data allows them to be filled
+	OrderType order.Type
+	// LimitPrice is the price used by Limit and StopLimit orders
+	LimitPrice decimal.Decimal
+	// TriggerPrice is the price which triggers Stop, StopLimit
+	// and TakeProfit orders
+	TriggerPrice decimal.Decimal
+	// TimeInForce determines how long a non-market order remains open
+	// GoodTillCancel is used when unset
+	TimeInForce order.TimeInForce
+	// Expiry is when a GoodTillTime order is cancelled
+	Expiry time.Time
 }
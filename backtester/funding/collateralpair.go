@@ -3,6 +3,7 @@ package funding
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/shopspring/decimal"
 	"github.com/thrasher-corp/gocryptotrader/currency"
@@ -105,6 +106,51 @@ func (c *CollateralPair) Liquidate() {
 	c.currentDirection = nil
 }
 
+// FundingPaid returns the cumulative funding rate payments paid
+// by the contract's positions
+func (c *CollateralPair) FundingPaid() decimal.Decimal {
+	return c.contract.fundingPaid
+}
+
+// FundingReceived returns the cumulative funding rate payments received
+// by the contract's positions
+func (c *CollateralPair) FundingReceived() decimal.Decimal {
+	return c.contract.fundingReceived
+}
+
+// ApplyFundingRates applies all funding rates which have occurred up to and
+// including time t against an open position of size contracts at price.
+// Positive rates are paid by longs to shorts and negative rates by shorts to
+// longs. It returns the net amount to realise against collateral, where a
+// negative amount is a payment
+func (c *CollateralPair) ApplyFundingRates(t time.Time, side gctorder.Side, size, price decimal.Decimal) (decimal.Decimal, error) {
+	var direction decimal.Decimal
+	switch {
+	case size.IsZero():
+	case side.IsLong():
+		direction = decimal.NewFromInt(-1)
+	case side.IsShort():
+		direction = decimal.NewFromInt(1)
+	default:
+		return decimal.Zero, fmt.Errorf("%w %v for %v %v %v", errInvalidPositionSide, side, c.contract.exchange, c.contract.asset, c.contract.currency)
+	}
+	var net decimal.Decimal
+	for ; c.contract.fundingRateIndex < len(c.contract.fundingRates); c.contract.fundingRateIndex++ {
+		rate := c.contract.fundingRates[c.contract.fundingRateIndex]
+		if rate.Time.After(t) {
+			break
+		}
+		payment := size.Abs().Mul(price).Mul(rate.Rate).Mul(direction)
+		if payment.IsNegative() {
+			c.contract.fundingPaid = c.contract.fundingPaid.Add(payment.Abs())
+		} else {
+			c.contract.fundingReceived = c.contract.fundingReceived.Add(payment)
+		}
+		net = net.Add(payment)
+	}
+	return net, nil
+}
+
 // CurrentHoldings returns available contract holdings
 func (c *CollateralPair) CurrentHoldings() decimal.Decimal {
 	return c.contract.available
@@ -2,12 +2,14 @@ package funding
 
 import (
 	"testing"
+	"time"
 
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fundingrate"
 	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
 )
 
@@ -264,3 +266,58 @@ func TestCollateralCurrentHoldings(t *testing.T) {
 		t.Errorf("received '%v' expected '%v'", c.CurrentHoldings(), decimal.NewFromInt(1337))
 	}
 }
+
+func TestCollateralFundingPaidReceived(t *testing.T) {
+	t.Parallel()
+	c := &CollateralPair{
+		contract: &Item{
+			fundingPaid:     decimal.NewFromInt(1),
+			fundingReceived: decimal.NewFromInt(2),
+		},
+	}
+	assert.Equal(t, decimal.NewFromInt(1), c.FundingPaid())
+	assert.Equal(t, decimal.NewFromInt(2), c.FundingReceived())
+}
+
+func TestCollateralApplyFundingRates(t *testing.T) {
+	t.Parallel()
+	tt := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	c := &CollateralPair{
+		contract: &Item{
+			asset: asset.Futures,
+			fundingRates: []fundingrate.Rate{
+				{Time: tt, Rate: decimal.NewFromFloat(0.01)},
+				{Time: tt.Add(time.Hour * 8), Rate: decimal.NewFromFloat(-0.02)},
+				{Time: tt.Add(time.Hour * 16), Rate: decimal.NewFromFloat(0.01)},
+			},
+		},
+	}
+	size := decimal.NewFromInt(2)
+	price := decimal.NewFromInt(100)
+
+	_, err := c.ApplyFundingRates(tt, gctorder.UnknownSide, size, price)
+	assert.ErrorIs(t, err, errInvalidPositionSide)
+
+	net, err := c.ApplyFundingRates(tt.Add(-time.Hour), gctorder.Long, size, price)
+	require.NoError(t, err, "ApplyFundingRates must not error")
+	assert.True(t, net.IsZero(), "ApplyFundingRates should not apply future rates")
+
+	net, err = c.ApplyFundingRates(tt, gctorder.Long, size, price)
+	require.NoError(t, err, "ApplyFundingRates must not error")
+	assert.Equal(t, "-2", net.String(), "longs should pay positive funding rates")
+	assert.Equal(t, "2", c.FundingPaid().String())
+
+	net, err = c.ApplyFundingRates(tt.Add(time.Hour*8), gctorder.Short, size, price)
+	require.NoError(t, err, "ApplyFundingRates must not error")
+	assert.Equal(t, "-4", net.String(), "shorts should pay negative funding rates")
+	assert.Equal(t, "6", c.FundingPaid().String())
+
+	net, err = c.ApplyFundingRates(tt.Add(time.Hour*16), gctorder.Short, size, price)
+	require.NoError(t, err, "ApplyFundingRates must not error")
+	assert.Equal(t, "2", net.String(), "shorts should receive positive funding rates")
+	assert.Equal(t, "2", c.FundingReceived().String())
+
+	net, err = c.ApplyFundingRates(tt.Add(time.Hour*24), gctorder.Short, size, price)
+	require.NoError(t, err, "ApplyFundingRates must not error")
+	assert.True(t, net.IsZero(), "ApplyFundingRates should not reapply rates")
+}
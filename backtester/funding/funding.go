@@ -3,6 +3,7 @@ package funding
 import (
 	"context"
 	"fmt"
+	"slices"
 	"sort"
 	"strings"
 	"time"
@@ -18,6 +19,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/exchange/accounts"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fundingrate"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
 	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
@@ -298,14 +300,17 @@ func (f *FundManager) GenerateReport() (*Report, error) {
 	items := make([]ReportItem, len(f.items))
 	for x := range f.items {
 		item := ReportItem{
-			Exchange:       f.items[x].exchange,
-			Asset:          f.items[x].asset,
-			Currency:       f.items[x].currency,
-			InitialFunds:   f.items[x].initialFunds,
-			TransferFee:    f.items[x].transferFee,
-			FinalFunds:     f.items[x].available,
-			IsCollateral:   f.items[x].isCollateral,
-			AppendedViaAPI: f.items[x].appendedViaAPI,
+			Exchange:        f.items[x].exchange,
+			Asset:           f.items[x].asset,
+			Currency:        f.items[x].currency,
+			InitialFunds:    f.items[x].initialFunds,
+			TransferFee:     f.items[x].transferFee,
+			FinalFunds:      f.items[x].available,
+			IsCollateral:    f.items[x].isCollateral,
+			AppendedViaAPI:  f.items[x].appendedViaAPI,
+			HasFundingRates: len(f.items[x].fundingRates) > 0,
+			FundingPaid:     f.items[x].fundingPaid,
+			FundingReceived: f.items[x].fundingReceived,
 		}
 
 		if !f.disableUSDTracking &&
@@ -773,6 +778,37 @@ func (f *FundManager) RealisePNL(receivingExchange string, receivingAsset asset.
 	return fmt.Errorf("%w to allocate %v to %v %v %v", ErrFundsNotFound, realisedPNL, receivingExchange, receivingAsset, receivingCurrency)
 }
 
+// SetFundingRates stores historical funding rates against a perpetual futures
+// contract item so that they can be applied to positions as the backtest runs
+func (f *FundManager) SetFundingRates(exch string, a asset.Item, cp currency.Pair, rates *fundingrate.HistoricalRates) error {
+	if rates == nil {
+		return fmt.Errorf("%w funding rates", gctcommon.ErrNilPointer)
+	}
+	if len(rates.FundingRates) == 0 {
+		return fmt.Errorf("%v %v %v %w", exch, a, cp, errNoFundingRates)
+	}
+	if !a.IsFutures() {
+		return fmt.Errorf("%v %v %v %w", exch, a, cp, errNotFutures)
+	}
+	exch = strings.ToLower(exch)
+	contractCode := currency.NewCode(cp.String())
+	for i := range f.items {
+		if f.items[i].exchange != exch ||
+			f.items[i].asset != a ||
+			f.items[i].isCollateral ||
+			!f.items[i].MatchesCurrency(contractCode) {
+			continue
+		}
+		f.items[i].fundingRates = slices.Clone(rates.FundingRates)
+		slices.SortFunc(f.items[i].fundingRates, func(a, b fundingrate.Rate) int {
+			return a.Time.Compare(b.Time)
+		})
+		f.items[i].fundingRateIndex = 0
+		return nil
+	}
+	return fmt.Errorf("%v %v %v %w", exch, a, cp, ErrFundsNotFound)
+}
+
 // HasExchangeBeenLiquidated checks for any items with a matching exchange
 // and returns whether it has been liquidated
 func (f *FundManager) HasExchangeBeenLiquidated(ev common.Event) bool {
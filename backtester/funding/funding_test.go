@@ -19,6 +19,7 @@ import (
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/binance"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fundingrate"
 	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 )
 
@@ -600,6 +601,49 @@ func TestRealisePNL(t *testing.T) {
 	assert.ErrorIs(t, err, ErrFundsNotFound)
 }
 
+func TestSetFundingRates(t *testing.T) {
+	t.Parallel()
+	f := FundManager{}
+	cp := currency.NewPair(currency.BTC, currency.USDT)
+	err := f.SetFundingRates("test", asset.Futures, cp, nil)
+	assert.ErrorIs(t, err, gctcommon.ErrNilPointer)
+
+	rates := &fundingrate.HistoricalRates{}
+	err = f.SetFundingRates("test", asset.Futures, cp, rates)
+	assert.ErrorIs(t, err, errNoFundingRates)
+
+	tt := time.Now().Truncate(time.Hour)
+	rates.FundingRates = []fundingrate.Rate{
+		{Time: tt, Rate: decimal.NewFromFloat(0.01)},
+		{Time: tt.Add(-time.Hour), Rate: decimal.NewFromFloat(0.02)},
+	}
+	err = f.SetFundingRates("test", asset.Spot, cp, rates)
+	assert.ErrorIs(t, err, errNotFutures)
+
+	err = f.SetFundingRates("test", asset.Futures, cp, rates)
+	assert.ErrorIs(t, err, ErrFundsNotFound)
+
+	f.items = append(f.items, &Item{
+		exchange: "test",
+		asset:    asset.Futures,
+		currency: currency.NewCode(cp.String()),
+	})
+	err = f.SetFundingRates("TEST", asset.Futures, cp, rates)
+	require.NoError(t, err, "SetFundingRates must not error")
+	require.Len(t, f.items[0].fundingRates, 2)
+	assert.Equal(t, tt.Add(-time.Hour), f.items[0].fundingRates[0].Time, "funding rates should be sorted by time")
+	assert.Equal(t, tt, rates.FundingRates[0].Time, "SetFundingRates should not modify the provided rates")
+
+	f.items[0].fundingPaid = decimal.NewFromInt(1)
+	f.items[0].fundingReceived = decimal.NewFromInt(2)
+	report, err := f.GenerateReport()
+	require.NoError(t, err, "GenerateReport must not error")
+	require.Len(t, report.Items, 1)
+	assert.True(t, report.Items[0].HasFundingRates)
+	assert.Equal(t, decimal.NewFromInt(1), report.Items[0].FundingPaid)
+	assert.Equal(t, decimal.NewFromInt(2), report.Items[0].FundingReceived)
+}
+
 func TestCreateCollateral(t *testing.T) {
 	t.Parallel()
 	collat := &Item{
@@ -11,6 +11,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/engine"
 	"github.com/thrasher-corp/gocryptotrader/exchange/accounts"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fundingrate"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 )
 
@@ -31,6 +32,8 @@ var (
 	errCannotMatchTrackingToItem  = errors.New("cannot match tracking data to funding items")
 	errNotFutures                 = errors.New("item linking collateral currencies must be a futures asset")
 	errExchangeManagerRequired    = errors.New("exchange manager required")
+	errNoFundingRates             = errors.New("no funding rates provided")
+	errInvalidPositionSide        = errors.New("invalid position side")
 )
 
 // IFundingManager limits funding usage for portfolio event handling
@@ -52,6 +55,7 @@ type IFundingManager interface {
 	HasExchangeBeenLiquidated(handler common.Event) bool
 	RealisePNL(receivingExchange string, receivingAsset asset.Item, receivingCurrency currency.Code, realisedPNL decimal.Decimal) error
 	SetFunding(string, asset.Item, *accounts.Balance, bool) error
+	SetFundingRates(string, asset.Item, currency.Pair, *fundingrate.HistoricalRates) error
 }
 
 // IFundingTransferer allows for funding amounts to be transferred
@@ -118,6 +122,8 @@ type ICollateralReader interface {
 	InitialFunds() decimal.Decimal
 	AvailableFunds() decimal.Decimal
 	CurrentHoldings() decimal.Decimal
+	FundingPaid() decimal.Decimal
+	FundingReceived() decimal.Decimal
 }
 
 // IPairReleaser limits funding usage for exchange event handling
@@ -134,6 +140,7 @@ type ICollateralReleaser interface {
 	UpdateContracts(order.Side, decimal.Decimal) error
 	TakeProfit(contracts, positionReturns decimal.Decimal) error
 	ReleaseContracts(decimal.Decimal) error
+	ApplyFundingRates(t time.Time, side order.Side, size, price decimal.Decimal) (decimal.Decimal, error)
 	Liquidate()
 }
 
@@ -163,6 +170,11 @@ type Item struct {
 	isLiquidated      bool
 	appendedViaAPI    bool
 	collateralCandles map[currency.Code]kline.DataFromKline
+	// funding rate details are only used by perpetual futures contracts
+	fundingRates     []fundingrate.Rate
+	fundingRateIndex int
+	fundingPaid      decimal.Decimal
+	fundingReceived  decimal.Decimal
 }
 
 // SpotPair holds two currencies that are associated with each other
@@ -219,6 +231,9 @@ type ReportItem struct {
 	IsCollateral         bool
 	AppendedViaAPI       bool
 	PairedWith           currency.Code
+	HasFundingRates      bool
+	FundingPaid          decimal.Decimal
+	FundingReceived      decimal.Decimal
 }
 
 // ItemSnapshot holds USD values to allow for tracking
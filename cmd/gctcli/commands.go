@@ -13,9 +13,11 @@ import (
 
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/encoding/json"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/margin"
 	"github.com/thrasher-corp/gocryptotrader/gctrpc"
 	"github.com/urfave/cli/v2"
+	"google.golang.org/protobuf/encoding/protojson"
 )
 
 var (
@@ -1096,6 +1098,10 @@ var getOrdersCommand = &cli.Command{
 			Value:       time.Now().Format(time.DateTime),
 			Destination: &endTime,
 		},
+		&cli.BoolFlag{
+			Name:  "include_history",
+			Usage: "also return orders persisted to the database by the order manager",
+		},
 	},
 }
 
@@ -1180,8 +1186,9 @@ func getOrders(c *cli.Context) error {
 			Base:      p.Base.String(),
 			Quote:     p.Quote.String(),
 		},
-		StartDate: s.Format(common.SimpleTimeFormatWithTimezone),
-		EndDate:   e.Format(common.SimpleTimeFormatWithTimezone),
+		StartDate:      s.Format(common.SimpleTimeFormatWithTimezone),
+		EndDate:        e.Format(common.SimpleTimeFormatWithTimezone),
+		IncludeHistory: c.Bool("include_history"),
 	})
 	if err != nil {
 		return err
@@ -1209,6 +1216,10 @@ var getManagedOrdersCommand = &cli.Command{
 			Name:  "pair",
 			Usage: "the currency pair to get orders for",
 		},
+		&cli.BoolFlag{
+			Name:  "include_history",
+			Usage: "also return orders persisted to the database which are no longer tracked",
+		},
 	},
 }
 
@@ -1268,6 +1279,7 @@ func getManagedOrders(c *cli.Context) error {
 			Base:      p.Base.String(),
 			Quote:     p.Quote.String(),
 		},
+		IncludeHistory: c.Bool("include_history"),
 	})
 	if err != nil {
 		return err
@@ -1661,6 +1673,127 @@ func simulateOrder(c *cli.Context) error {
 	return nil
 }
 
+var routeOrderCommand = &cli.Command{
+	Name:      "routeorder",
+	Usage:     "splits an order across exchanges by orderbook depth and fees, optionally executing it",
+	ArgsUsage: "<pair> <asset> <side> <amount>",
+	Action:    routeOrder,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "pair",
+			Usage: "the currency pair",
+		},
+		&cli.StringFlag{
+			Name:  "asset",
+			Usage: "the asset type of the currency pair, defaults to spot",
+		},
+		&cli.StringFlag{
+			Name:  "side",
+			Usage: "the order side to use (BUY OR SELL)",
+		},
+		&cli.Float64Flag{
+			Name:  "amount",
+			Usage: "the total base currency amount for the order",
+		},
+		&cli.StringFlag{
+			Name:  "exchanges",
+			Usage: "comma separated exchanges to route to, defaults to all enabled exchanges",
+		},
+		&cli.BoolFlag{
+			Name:  "ignore_balances",
+			Usage: "plans the route without limiting exchanges to their available balances",
+		},
+		&cli.BoolFlag{
+			Name:  "execute",
+			Usage: "submits the routed orders, otherwise the route is only planned",
+		},
+	},
+}
+
+func routeOrder(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	var currencyPair string
+	if c.IsSet("pair") {
+		currencyPair = c.String("pair")
+	} else {
+		currencyPair = c.Args().First()
+	}
+	if !validPair(currencyPair) {
+		return errInvalidPair
+	}
+
+	var assetType string
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(1)
+	}
+
+	var orderSide string
+	if c.IsSet("side") {
+		orderSide = c.String("side")
+	} else {
+		orderSide = c.Args().Get(2)
+	}
+	if orderSide == "" {
+		return errors.New("side must be set")
+	}
+
+	var amount float64
+	if c.IsSet("amount") {
+		amount = c.Float64("amount")
+	} else if c.Args().Get(3) != "" {
+		var err error
+		amount, err = strconv.ParseFloat(c.Args().Get(3), 64)
+		if err != nil {
+			return err
+		}
+	}
+	if amount <= 0 {
+		return errors.New("amount must be set")
+	}
+
+	var exchanges []string
+	if c.IsSet("exchanges") {
+		exchanges = strings.Split(c.String("exchanges"), ",")
+	}
+
+	p, err := currency.NewPairDelimiter(currencyPair, pairDelimiter)
+	if err != nil {
+		return err
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.RouteOrder(c.Context, &gctrpc.RouteOrderRequest{
+		Pair: &gctrpc.CurrencyPair{
+			Delimiter: p.Delimiter,
+			Base:      p.Base.String(),
+			Quote:     p.Quote.String(),
+		},
+		AssetType:      assetType,
+		Side:           orderSide,
+		Amount:         amount,
+		Exchanges:      exchanges,
+		IgnoreBalances: c.Bool("ignore_balances"),
+		Execute:        c.Bool("execute"),
+	})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
 var cancelOrderCommand = &cli.Command{
 	Name:      "cancelorder",
 	Usage:     "cancel order cancels an exchange order",
@@ -1934,6 +2067,23 @@ var cancelAllOrdersCommand = &cli.Command{
 	},
 }
 
+var setKillSwitchCommand = &cli.Command{
+	Name:      "setkillswitch",
+	Usage:     "enables or disables the kill switch which blocks all new orders",
+	ArgsUsage: "<enabled> <cancel_orders>",
+	Action:    setKillSwitch,
+	Flags: []cli.Flag{
+		&cli.BoolFlag{
+			Name:  "enabled",
+			Usage: "whether new orders are blocked",
+		},
+		&cli.BoolFlag{
+			Name:  "cancel_orders",
+			Usage: "cancels all orders when enabling the kill switch",
+		},
+	},
+}
+
 var modifyOrderCommand = &cli.Command{
 	Name:      "modifyorder",
 	Usage:     "modify price and/or amount of a previously submitted order",
@@ -1993,6 +2143,52 @@ func cancelAllOrders(c *cli.Context) error {
 	return nil
 }
 
+func setKillSwitch(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	var enabled bool
+	if c.IsSet("enabled") {
+		enabled = c.Bool("enabled")
+	} else {
+		var err error
+		enabled, err = strconv.ParseBool(c.Args().First())
+		if err != nil {
+			return err
+		}
+	}
+
+	var cancelOrders bool
+	if c.IsSet("cancel_orders") {
+		cancelOrders = c.Bool("cancel_orders")
+	} else if c.Args().Get(1) != "" {
+		var err error
+		cancelOrders, err = strconv.ParseBool(c.Args().Get(1))
+		if err != nil {
+			return err
+		}
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.SetKillSwitch(c.Context, &gctrpc.SetKillSwitchRequest{
+		Enabled:      enabled,
+		CancelOrders: cancelOrders,
+	})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
 func modifyOrder(c *cli.Context) error {
 	if c.NArg() == 0 && c.NumFlags() == 0 {
 		return cli.ShowSubcommandHelp(c)
@@ -2117,7 +2313,7 @@ var addEventCommand = &cli.Command{
 		},
 		&cli.StringFlag{
 			Name:  "item",
-			Usage: "the item to trigger the event",
+			Usage: "the item to trigger the event: PRICE, ORDERBOOK, RSI, MACD_CROSSOVER, BOLLINGER_BREAKOUT, PRICE_CHANGE, SPREAD or FUNDING_RATE",
 		},
 		&cli.StringFlag{
 			Name:  "condition",
@@ -2139,6 +2335,42 @@ var addEventCommand = &cli.Command{
 			Name:  "orderbook_amount",
 			Usage: "the orderbook amount to trigger the event",
 		},
+		&cli.DurationFlag{
+			Name:  "interval",
+			Usage: "the candle interval for indicator and price change items e.g. 1h",
+		},
+		&cli.Int64Flag{
+			Name:  "period",
+			Usage: "the RSI or Bollinger band period",
+		},
+		&cli.Int64Flag{
+			Name:  "fast_period",
+			Usage: "the MACD fast period",
+		},
+		&cli.Int64Flag{
+			Name:  "slow_period",
+			Usage: "the MACD slow period",
+		},
+		&cli.Int64Flag{
+			Name:  "signal_period",
+			Usage: "the MACD signal period",
+		},
+		&cli.Float64Flag{
+			Name:  "standard_deviations",
+			Usage: "the Bollinger band width in standard deviations",
+		},
+		&cli.StringFlag{
+			Name:  "direction",
+			Usage: "the crossover or breakout direction: ABOVE or BELOW",
+		},
+		&cli.DurationFlag{
+			Name:  "window",
+			Usage: "the window a price change is measured over e.g. 24h",
+		},
+		&cli.Float64Flag{
+			Name:  "value",
+			Usage: "the threshold for RSI, price change percentage, spread percentage and funding rate items",
+		},
 		&cli.StringFlag{
 			Name:  "pair",
 			Usage: "the currency pair",
@@ -2151,6 +2383,18 @@ var addEventCommand = &cli.Command{
 			Name:  "action",
 			Usage: "the action for the event to perform upon trigger",
 		},
+		&cli.StringFlag{
+			Name:  "operator",
+			Usage: "the operator joining conditions: AND or OR",
+		},
+		&cli.StringFlag{
+			Name:  "conditions",
+			Usage: `a JSON array of conditions used instead of item e.g. '[{"item":"RSI","conditionParams":{"condition":"<","interval":"3600000000000","period":14,"value":30}}]'`,
+		},
+		&cli.StringFlag{
+			Name:  "actions",
+			Usage: `a JSON array of actions e.g. '[{"action":"SUBMIT_ORDER","side":"BUY","orderType":"MARKET","amount":0.1}]'`,
+		},
 	},
 }
 
@@ -2160,15 +2404,8 @@ func addEvent(c *cli.Context) error {
 	}
 
 	var exchangeName string
-	var item string
-	var condition string
-	var price float64
-	var checkBids bool
-	var checkAsks bool
-	var orderbookAmount float64
 	var currencyPair string
 	var assetType string
-	var action string
 
 	if c.IsSet("exchange") {
 		exchangeName = c.String("exchange")
@@ -2176,32 +2413,39 @@ func addEvent(c *cli.Context) error {
 		return errors.New("exchange name is required")
 	}
 
-	if c.IsSet("item") {
-		item = c.String("item")
-	} else {
-		return errors.New("item is required")
-	}
-
-	if c.IsSet("condition") {
-		condition = c.String("condition")
-	} else {
-		return errors.New("condition is required")
-	}
-
-	if c.IsSet("price") {
-		price = c.Float64("price")
-	}
-
-	if c.IsSet("check_bids") {
-		checkBids = c.Bool("check_bids")
+	var conditions []*gctrpc.EventCondition
+	if c.IsSet("conditions") {
+		var raw []json.RawMessage
+		if err := json.Unmarshal([]byte(c.String("conditions")), &raw); err != nil {
+			return fmt.Errorf("invalid conditions: %w", err)
+		}
+		conditions = make([]*gctrpc.EventCondition, len(raw))
+		for i := range raw {
+			conditions[i] = &gctrpc.EventCondition{}
+			if err := protojson.Unmarshal(raw[i], conditions[i]); err != nil {
+				return fmt.Errorf("invalid condition: %w", err)
+			}
+		}
 	}
 
-	if c.IsSet("check_asks") {
-		checkAsks = c.Bool("check_asks")
+	var actions []*gctrpc.EventAction
+	if c.IsSet("actions") {
+		var raw []json.RawMessage
+		if err := json.Unmarshal([]byte(c.String("actions")), &raw); err != nil {
+			return fmt.Errorf("invalid actions: %w", err)
+		}
+		actions = make([]*gctrpc.EventAction, len(raw))
+		for i := range raw {
+			actions[i] = &gctrpc.EventAction{}
+			if err := protojson.Unmarshal(raw[i], actions[i]); err != nil {
+				return fmt.Errorf("invalid action: %w", err)
+			}
+		}
 	}
 
-	if c.IsSet("orderbook_amount") {
-		orderbookAmount = c.Float64("orderbook_amount")
+	item := c.String("item")
+	if item == "" && len(conditions) == 0 {
+		return errors.New("item or conditions are required")
 	}
 
 	if c.IsSet("pair") {
@@ -2223,10 +2467,9 @@ func addEvent(c *cli.Context) error {
 		return errInvalidAsset
 	}
 
-	if c.IsSet("action") {
-		action = c.String("action")
-	} else {
-		return errors.New("action is required")
+	action := c.String("action")
+	if action == "" && len(actions) == 0 {
+		return errors.New("action or actions are required")
 	}
 
 	p, err := currency.NewPairDelimiter(currencyPair, pairDelimiter)
@@ -2245,19 +2488,31 @@ func addEvent(c *cli.Context) error {
 		Exchange: exchangeName,
 		Item:     item,
 		ConditionParams: &gctrpc.ConditionParams{
-			Condition:       condition,
-			Price:           price,
-			CheckBids:       checkBids,
-			CheckAsks:       checkAsks,
-			OrderbookAmount: orderbookAmount,
+			Condition:          c.String("condition"),
+			Price:              c.Float64("price"),
+			CheckBids:          c.Bool("check_bids"),
+			CheckAsks:          c.Bool("check_asks"),
+			OrderbookAmount:    c.Float64("orderbook_amount"),
+			Interval:           int64(c.Duration("interval")),
+			Period:             c.Int64("period"),
+			FastPeriod:         c.Int64("fast_period"),
+			SlowPeriod:         c.Int64("slow_period"),
+			SignalPeriod:       c.Int64("signal_period"),
+			StandardDeviations: c.Float64("standard_deviations"),
+			Direction:          c.String("direction"),
+			Window:             int64(c.Duration("window")),
+			Value:              c.Float64("value"),
 		},
 		Pair: &gctrpc.CurrencyPair{
 			Delimiter: p.Delimiter,
 			Base:      p.Base.String(),
 			Quote:     p.Quote.String(),
 		},
-		AssetType: assetType,
-		Action:    action,
+		AssetType:  assetType,
+		Action:     action,
+		Operator:   c.String("operator"),
+		Conditions: conditions,
+		Actions:    actions,
 	})
 	if err != nil {
 		return err
@@ -3009,6 +3264,153 @@ func withdrawlRequestByDate(c *cli.Context) error {
 	return nil
 }
 
+var withdrawalApprovalCommand = &cli.Command{
+	Name:      "withdrawalapprovals",
+	Usage:     "manage withdrawal requests awaiting approval",
+	ArgsUsage: "<command> <args>",
+	Subcommands: []*cli.Command{
+		{
+			Name:   "pending",
+			Usage:  "lists withdrawal requests awaiting approval",
+			Action: getPendingWithdrawals,
+		},
+		{
+			Name:      "approve",
+			Usage:     "approves a pending withdrawal request",
+			ArgsUsage: "<id> <approver>",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "id",
+					Usage: "pending withdrawal id",
+				},
+				&cli.StringFlag{
+					Name:  "approver",
+					Usage: "name of the approver, which must be the authenticated user",
+				},
+			},
+			Action: approveWithdrawal,
+		},
+		{
+			Name:      "reject",
+			Usage:     "rejects a pending withdrawal request",
+			ArgsUsage: "<id> <approver> <reason>",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "id",
+					Usage: "pending withdrawal id",
+				},
+				&cli.StringFlag{
+					Name:  "approver",
+					Usage: "name of the approver, which must be the authenticated user",
+				},
+				&cli.StringFlag{
+					Name:  "reason",
+					Usage: "reason for rejecting the withdrawal",
+				},
+			},
+			Action: rejectWithdrawal,
+		},
+	},
+}
+
+func getPendingWithdrawals(c *cli.Context) error {
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetPendingWithdrawals(c.Context, &gctrpc.GetPendingWithdrawalsRequest{})
+	if err != nil {
+		return err
+	}
+	jsonOutput(result)
+	return nil
+}
+
+func approveWithdrawal(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	var id, approver string
+	if c.IsSet("id") {
+		id = c.String("id")
+	} else {
+		id = c.Args().First()
+	}
+	if c.IsSet("approver") {
+		approver = c.String("approver")
+	} else {
+		approver = c.Args().Get(1)
+	}
+	if id == "" {
+		return errors.New("an ID must be specified")
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.ApproveWithdrawal(c.Context, &gctrpc.ApproveWithdrawalRequest{
+		Id:       id,
+		Approver: approver,
+	})
+	if err != nil {
+		return err
+	}
+	jsonOutput(result)
+	return nil
+}
+
+func rejectWithdrawal(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	var id, approver, reason string
+	if c.IsSet("id") {
+		id = c.String("id")
+	} else {
+		id = c.Args().First()
+	}
+	if c.IsSet("approver") {
+		approver = c.String("approver")
+	} else {
+		approver = c.Args().Get(1)
+	}
+	if c.IsSet("reason") {
+		reason = c.String("reason")
+	} else {
+		reason = c.Args().Get(2)
+	}
+	if id == "" {
+		return errors.New("an ID must be specified")
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.RejectWithdrawal(c.Context, &gctrpc.RejectWithdrawalRequest{
+		Id:       id,
+		Approver: approver,
+		Reason:   reason,
+	})
+	if err != nil {
+		return err
+	}
+	jsonOutput(result)
+	return nil
+}
+
 var getLoggerDetailsCommand = &cli.Command{
 	Name:      "getloggerdetails",
 	Usage:     "gets an individual loggers details",
@@ -3298,6 +3700,113 @@ func getExchangeTickerStream(c *cli.Context) error {
 	}
 }
 
+var getOrderStreamCommand = &cli.Command{
+	Name:      "getorderstream",
+	Usage:     "streams order updates from the order manager as orders are placed, filled, cancelled or rejected",
+	ArgsUsage: "<exchange> <asset> <pair> <statuses>",
+	Action:    getOrderStream,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "exchange",
+			Usage: "the optional exchange to filter order updates by",
+		},
+		&cli.StringFlag{
+			Name:  "asset",
+			Usage: "the optional asset type to filter order updates by",
+		},
+		&cli.StringFlag{
+			Name:  "pair",
+			Usage: "the optional currency pair to filter order updates by",
+		},
+		&cli.StringFlag{
+			Name:  "statuses",
+			Usage: "the optional comma separated order statuses to filter order updates by e.g. FILLED,CANCELLED",
+		},
+	},
+}
+
+func getOrderStream(c *cli.Context) error {
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	var assetType string
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(1)
+	}
+	assetType = strings.ToLower(assetType)
+	if assetType != "" && !validAsset(assetType) {
+		return errInvalidAsset
+	}
+
+	var currencyPair string
+	if c.IsSet("pair") {
+		currencyPair = c.String("pair")
+	} else {
+		currencyPair = c.Args().Get(2)
+	}
+
+	var pair *gctrpc.CurrencyPair
+	if currencyPair != "" {
+		if !validPair(currencyPair) {
+			return errInvalidPair
+		}
+		p, err := currency.NewPairDelimiter(currencyPair, pairDelimiter)
+		if err != nil {
+			return err
+		}
+		pair = &gctrpc.CurrencyPair{
+			Delimiter: p.Delimiter,
+			Base:      p.Base.String(),
+			Quote:     p.Quote.String(),
+		}
+	}
+
+	var statuses string
+	if c.IsSet("statuses") {
+		statuses = c.String("statuses")
+	} else {
+		statuses = c.Args().Get(3)
+	}
+
+	var statusList []string
+	if statuses != "" {
+		statusList = strings.Split(statuses, ",")
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetOrderStream(c.Context,
+		&gctrpc.GetOrderStreamRequest{
+			Exchange:  exchangeName,
+			AssetType: assetType,
+			Pair:      pair,
+			Statuses:  statusList,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	for {
+		resp, err := result.Recv()
+		if err != nil {
+			return err
+		}
+		jsonOutput(resp)
+	}
+}
+
 var getAuditEventCommand = &cli.Command{
 	Name:      "getauditevent",
 	Usage:     "gets audit events matching query parameters",
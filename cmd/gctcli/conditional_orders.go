@@ -0,0 +1,275 @@
+package main
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"github.com/urfave/cli/v2"
+)
+
+var errConditionalOrderIDNotSet = errors.New("conditional order ID must be set")
+
+var conditionalOrderCommands = &cli.Command{
+	Name:      "conditionalorders",
+	Usage:     "manage synthetic stop, trailing stop, OCO and bracket orders hosted by the order manager",
+	ArgsUsage: "<command> <args>",
+	Subcommands: []*cli.Command{
+		{
+			Name:      "add",
+			Usage:     "adds a conditional order which is submitted to the exchange once triggered by ticker or orderbook updates",
+			ArgsUsage: "<exchange> <pair> <asset> <type> <side> <amount>",
+			Action:    addConditionalOrder,
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "exchange",
+					Usage: "the exchange to place the conditional order on",
+				},
+				&cli.StringFlag{
+					Name:  "pair",
+					Usage: "the currency pair",
+				},
+				&cli.StringFlag{
+					Name:  "asset",
+					Usage: "the asset type of the currency pair",
+				},
+				&cli.StringFlag{
+					Name:  "type",
+					Usage: "the conditional order type (STOP_MARKET, STOP_LIMIT, TRAILING_STOP, OCO or BRACKET)",
+				},
+				&cli.StringFlag{
+					Name:  "side",
+					Usage: "the side of the order submitted when triggered, or the entry side for BRACKET orders (BUY or SELL)",
+				},
+				&cli.Float64Flag{
+					Name:  "amount",
+					Usage: "the amount for the order",
+				},
+				&cli.Float64Flag{
+					Name:  "trigger_price",
+					Usage: "the stop trigger price, required for all types except TRAILING_STOP",
+				},
+				&cli.Float64Flag{
+					Name:  "limit_price",
+					Usage: "the limit price submitted once the stop triggers, required for STOP_LIMIT and optional for OCO and BRACKET",
+				},
+				&cli.Float64Flag{
+					Name:  "take_profit_price",
+					Usage: "the take profit price, required for OCO and BRACKET",
+				},
+				&cli.Float64Flag{
+					Name:  "entry_price",
+					Usage: "the limit price of a BRACKET entry order, a market order is used when unset",
+				},
+				&cli.Float64Flag{
+					Name:  "trailing_amount",
+					Usage: "the absolute price retracement that triggers a TRAILING_STOP",
+				},
+				&cli.Float64Flag{
+					Name:  "trailing_percent",
+					Usage: "the percentage price retracement that triggers a TRAILING_STOP e.g. 1 = 1%",
+				},
+			},
+		},
+		{
+			Name:      "get",
+			Usage:     "returns conditional orders hosted by the order manager",
+			ArgsUsage: "<exchange> <active_only>",
+			Action:    getConditionalOrders,
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "exchange",
+					Usage: "the optional exchange to filter conditional orders by",
+				},
+				&cli.BoolFlag{
+					Name:  "active_only",
+					Usage: "only return conditional orders which have not triggered, been cancelled or failed",
+				},
+			},
+		},
+		{
+			Name:      "cancel",
+			Usage:     "cancels an active conditional order along with any pending bracket entry order",
+			ArgsUsage: "<id>",
+			Action:    cancelConditionalOrder,
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "id",
+					Usage: "the conditional order ID",
+				},
+			},
+		},
+	},
+}
+
+func addConditionalOrder(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	var currencyPair string
+	if c.IsSet("pair") {
+		currencyPair = c.String("pair")
+	} else {
+		currencyPair = c.Args().Get(1)
+	}
+	if !validPair(currencyPair) {
+		return errInvalidPair
+	}
+
+	var assetType string
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(2)
+	}
+	assetType = strings.ToLower(assetType)
+	if !validAsset(assetType) {
+		return errInvalidAsset
+	}
+
+	var orderType string
+	if c.IsSet("type") {
+		orderType = c.String("type")
+	} else {
+		orderType = c.Args().Get(3)
+	}
+
+	var orderSide string
+	if c.IsSet("side") {
+		orderSide = c.String("side")
+	} else {
+		orderSide = c.Args().Get(4)
+	}
+
+	var amount float64
+	if c.IsSet("amount") {
+		amount = c.Float64("amount")
+	} else if c.Args().Get(5) != "" {
+		var err error
+		amount, err = strconv.ParseFloat(c.Args().Get(5), 64)
+		if err != nil {
+			return err
+		}
+	}
+
+	p, err := currency.NewPairDelimiter(currencyPair, pairDelimiter)
+	if err != nil {
+		return err
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.AddConditionalOrder(c.Context,
+		&gctrpc.AddConditionalOrderRequest{
+			Exchange: exchangeName,
+			Pair: &gctrpc.CurrencyPair{
+				Delimiter: p.Delimiter,
+				Base:      p.Base.String(),
+				Quote:     p.Quote.String(),
+			},
+			Asset:           assetType,
+			Type:            orderType,
+			Side:            orderSide,
+			Amount:          amount,
+			TriggerPrice:    c.Float64("trigger_price"),
+			LimitPrice:      c.Float64("limit_price"),
+			TakeProfitPrice: c.Float64("take_profit_price"),
+			EntryPrice:      c.Float64("entry_price"),
+			TrailingAmount:  c.Float64("trailing_amount"),
+			TrailingPercent: c.Float64("trailing_percent"),
+		})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func getConditionalOrders(c *cli.Context) error {
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	var activeOnly bool
+	if c.IsSet("active_only") {
+		activeOnly = c.Bool("active_only")
+	} else if c.Args().Get(1) != "" {
+		var err error
+		activeOnly, err = strconv.ParseBool(c.Args().Get(1))
+		if err != nil {
+			return err
+		}
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetConditionalOrders(c.Context,
+		&gctrpc.GetConditionalOrdersRequest{
+			Exchange:   exchangeName,
+			ActiveOnly: activeOnly,
+		})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func cancelConditionalOrder(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	var id string
+	if c.IsSet("id") {
+		id = c.String("id")
+	} else {
+		id = c.Args().First()
+	}
+	if id == "" {
+		return errConditionalOrderIDNotSet
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.CancelConditionalOrder(c.Context,
+		&gctrpc.CancelConditionalOrderRequest{
+			Id: id,
+		})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
@@ -147,6 +147,18 @@ var dataHistoryJobCommands = &cli.Command{
 			Flags:  append(baseJobSubCommands, secondaryValidationJobSubCommands...),
 			Action: upsertDataHistoryJob,
 		},
+		{
+			Name:   "auditcandles",
+			Usage:  "will audit database candle data for gaps, duplicates, OHLCV inconsistencies and outliers against an optional secondary exchange - can repair issues using API candle data",
+			Flags:  append(baseJobSubCommands, candleAuditJobSubCommands...),
+			Action: upsertDataHistoryJob,
+		},
+		{
+			Name:   "audittrades",
+			Usage:  "will audit database trade data for invalid trades and intervals without trades - can repair issues using API trade data",
+			Flags:  append(baseJobSubCommands, tradeAuditJobSubCommands...),
+			Action: upsertDataHistoryJob,
+		},
 	},
 }
 
@@ -292,6 +304,26 @@ var (
 		comparisonDecimalPlacesFlag,
 		intolerancePercentageFlag,
 	}
+	auditRepairFlag = &cli.BoolFlag{
+		Name:  "replace_on_issue",
+		Usage: "if true, issues found are repaired where possible by backfilling, replacing or removing database data using API data",
+	}
+	candleAuditJobSubCommands = []cli.Flag{
+		&cli.StringFlag{
+			Name:  "secondary_exchange",
+			Usage: "if set, the exchange to compare candle data to when checking for outliers",
+		},
+		&cli.Float64Flag{
+			Name:  "intolerance_percentage",
+			Usage: "the percentage a candle price can differ from the secondary exchange before it is considered an outlier",
+		},
+		requestSize500Flag,
+		auditRepairFlag,
+	}
+	tradeAuditJobSubCommands = []cli.Flag{
+		requestSize10Flag,
+		auditRepairFlag,
+	}
 )
 
 func getDataHistoryJob(c *cli.Context) error {
@@ -461,6 +493,10 @@ func upsertDataHistoryJob(c *cli.Context) error {
 		dataType = 4
 	case "secondaryvalidatecandles":
 		dataType = 5
+	case "auditcandles":
+		dataType = 6
+	case "audittrades":
+		dataType = 7
 	default:
 		return errors.New("unrecognised command, cannot set data type")
 	}
@@ -0,0 +1,165 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/thrasher-corp/gocryptotrader/common/file"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"github.com/urfave/cli/v2"
+)
+
+var ledgerCommands = &cli.Command{
+	Name:      "ledger",
+	Usage:     "returns the spot cost basis, lots and PNL tracked by the ledger manager",
+	ArgsUsage: "<command> <args>",
+	Subcommands: []*cli.Command{
+		{
+			Name:      "pnl",
+			Usage:     "returns the holdings, cost basis and realised and unrealised PNL of each currency",
+			ArgsUsage: "<currency>",
+			Action:    getLedgerPNL,
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "currency",
+					Usage: "limits the results to a single currency",
+				},
+			},
+		},
+		{
+			Name:      "lots",
+			Usage:     "returns the lots of each currency and the disposals matched against them",
+			ArgsUsage: "<currency> <include_closed>",
+			Action:    getLedgerLots,
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "currency",
+					Usage: "limits the results to a single currency",
+				},
+				&cli.BoolFlag{
+					Name:  "include_closed",
+					Usage: "includes fully disposed of lots",
+				},
+			},
+		},
+		{
+			Name:      "export",
+			Usage:     "exports a ledger report as CSV",
+			ArgsUsage: "<report> <output>",
+			Action:    exportLedgerCSV,
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "report",
+					Usage: "the report to export, either summary, lots or disposals",
+					Value: "summary",
+				},
+				&cli.StringFlag{
+					Name:  "output",
+					Usage: "the file to write the report to, the report is printed when unset",
+				},
+				&cli.StringFlag{
+					Name:  "currency",
+					Usage: "limits the report to a single currency",
+				},
+				&cli.BoolFlag{
+					Name:  "include_closed",
+					Usage: "includes fully disposed of lots in the lots report",
+				},
+			},
+		},
+	},
+}
+
+func getLedgerPNL(c *cli.Context) error {
+	var curr string
+	if c.IsSet("currency") {
+		curr = c.String("currency")
+	} else {
+		curr = c.Args().First()
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetLedgerPNL(c.Context, &gctrpc.GetLedgerPNLRequest{Currency: curr})
+	if err != nil {
+		return err
+	}
+	jsonOutput(result)
+	return nil
+}
+
+func getLedgerLots(c *cli.Context) error {
+	var curr string
+	if c.IsSet("currency") {
+		curr = c.String("currency")
+	} else {
+		curr = c.Args().First()
+	}
+	includeClosed := c.Bool("include_closed")
+	if !c.IsSet("include_closed") && c.Args().Get(1) != "" {
+		var err error
+		if includeClosed, err = strconv.ParseBool(c.Args().Get(1)); err != nil {
+			return err
+		}
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetLedgerLots(c.Context, &gctrpc.GetLedgerLotsRequest{
+		Currency:      curr,
+		IncludeClosed: includeClosed,
+	})
+	if err != nil {
+		return err
+	}
+	jsonOutput(result)
+	return nil
+}
+
+func exportLedgerCSV(c *cli.Context) error {
+	report := c.String("report")
+	if !c.IsSet("report") && c.Args().First() != "" {
+		report = c.Args().First()
+	}
+	var output string
+	if c.IsSet("output") {
+		output = c.String("output")
+	} else {
+		output = c.Args().Get(1)
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.ExportLedgerCSV(c.Context, &gctrpc.ExportLedgerCSVRequest{
+		Report:        report,
+		Currency:      c.String("currency"),
+		IncludeClosed: c.Bool("include_closed"),
+	})
+	if err != nil {
+		return err
+	}
+	if output == "" {
+		fmt.Print(result.Csv)
+		return nil
+	}
+	if err := file.Write(output, []byte(result.Csv)); err != nil {
+		return err
+	}
+	fmt.Printf("Ledger %s report written to %s\n", result.Report, output)
+	return nil
+}
@@ -2,6 +2,9 @@ package main
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
 	"fmt"
 	"log"
 	"os"
@@ -25,6 +28,9 @@ var (
 	host          string
 	username      string
 	password      string
+	rpcToken      string
+	clientCert    string
+	clientKey     string
 	pairDelimiter string
 	certPath      string
 	timeout       time.Duration
@@ -44,17 +50,21 @@ func jsonOutput(in any) {
 }
 
 func setupClient(c *cli.Context) (*grpc.ClientConn, context.CancelFunc, error) {
-	creds, err := credentials.NewClientTLSFromFile(certPath, "")
+	creds, err := getTransportCredentials()
 	if err != nil {
 		return nil, nil, err
 	}
 
+	var rpcCreds credentials.PerRPCCredentials = auth.BasicAuth{
+		Username: username,
+		Password: password,
+	}
+	if rpcToken != "" {
+		rpcCreds = auth.BearerToken{Token: rpcToken}
+	}
 	opts := []grpc.DialOption{
 		grpc.WithTransportCredentials(creds),
-		grpc.WithPerRPCCredentials(auth.BasicAuth{
-			Username: username,
-			Password: password,
-		}),
+		grpc.WithPerRPCCredentials(rpcCreds),
 	}
 
 	var cancel context.CancelFunc
@@ -72,6 +82,34 @@ func setupClient(c *cli.Context) (*grpc.ClientConn, context.CancelFunc, error) {
 	return conn, cancel, err
 }
 
+// getTransportCredentials returns the TLS credentials used to connect to the
+// gRPC server, presenting a client certificate when one is supplied
+func getTransportCredentials() (credentials.TransportCredentials, error) {
+	if clientCert == "" && clientKey == "" {
+		return credentials.NewClientTLSFromFile(certPath, "")
+	}
+	if clientCert == "" || clientKey == "" {
+		return nil, errors.New("both clientcert and clientkey must be supplied")
+	}
+	cert, err := tls.LoadX509KeyPair(clientCert, clientKey)
+	if err != nil {
+		return nil, err
+	}
+	pemData, err := os.ReadFile(certPath)
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pemData) {
+		return nil, fmt.Errorf("no certificates found in %s", certPath)
+	}
+	return credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{cert},
+		RootCAs:      pool,
+		MinVersion:   tls.VersionTLS12,
+	}), nil
+}
+
 func main() {
 	app := cli.NewApp()
 	app.Name = "gctcli"
@@ -97,6 +135,11 @@ func main() {
 			Usage:       "the gRPC password",
 			Destination: &password,
 		},
+		&cli.StringFlag{
+			Name:        "rpctoken",
+			Usage:       "the gRPC bearer token, used instead of the gRPC username and password",
+			Destination: &rpcToken,
+		},
 		&cli.StringFlag{
 			Name:        "delimiter",
 			Value:       "-",
@@ -109,6 +152,16 @@ func main() {
 			Usage:       "the path to TLS cert of the gRPC server",
 			Destination: &certPath,
 		},
+		&cli.StringFlag{
+			Name:        "clientcert",
+			Usage:       "the path to a TLS client cert to authenticate with the gRPC server",
+			Destination: &clientCert,
+		},
+		&cli.StringFlag{
+			Name:        "clientkey",
+			Usage:       "the path to the TLS client cert key",
+			Destination: &clientKey,
+		},
 		&cli.DurationFlag{
 			Name:        "timeout",
 			Value:       defaultTimeout,
@@ -187,9 +240,11 @@ func main() {
 		getOrderCommand,
 		submitOrderCommand,
 		simulateOrderCommand,
+		routeOrderCommand,
 		cancelOrderCommand,
 		cancelBatchOrdersCommand,
 		cancelAllOrdersCommand,
+		setKillSwitchCommand,
 		modifyOrderCommand,
 		getEventsCommand,
 		addEventCommand,
@@ -200,11 +255,13 @@ func main() {
 		withdrawCryptocurrencyFundsCommand,
 		withdrawFiatFundsCommand,
 		withdrawalRequestCommand,
+		withdrawalApprovalCommand,
 		getLoggerDetailsCommand,
 		setLoggerDetailsCommand,
 		exchangePairManagerCommand,
 		getTickerStreamCommand,
 		getExchangeTickerStreamCommand,
+		getOrderStreamCommand,
 		getAuditEventCommand,
 		getHistoricCandlesCommand,
 		getHistoricCandlesExtendedCommand,
@@ -220,6 +277,10 @@ func main() {
 		getMarginRatesHistoryCommand,
 		orderbookCommand,
 		getCurrencyTradeURLCommand,
+		conditionalOrderCommands,
+		ledgerCommands,
+		rebalanceCommands,
+		generateRPCUserCommand,
 	}
 
 	ctx, cancel := context.WithCancel(context.Background())
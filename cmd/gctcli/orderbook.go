@@ -50,6 +50,10 @@ var orderbookCommand = &cli.Command{
 		getOrderbooksCommand,
 		getOrderbookStreamCommand,
 		getExchangeOrderbookStreamCommand,
+		getAggregatedOrderbookStreamCommand,
+		getOrderbookAnalyticsCommand,
+		getOrderbookAnalyticsStreamCommand,
+		untrackOrderbookAnalyticsCommand,
 		whaleBombCommand,
 	},
 }
@@ -730,6 +734,324 @@ func getExchangeOrderbookStream(c *cli.Context) error {
 	}
 }
 
+var getAggregatedOrderbookStreamCommand = &cli.Command{
+	Name:      "getaggregatedorderbookstream",
+	Usage:     "streams an orderbook consolidated across exchanges with per exchange attribution",
+	ArgsUsage: "<exchanges> <pair> <asset>",
+	Action:    getAggregatedOrderbookStream,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "exchanges",
+			Usage: "comma separated exchanges to aggregate, each optionally with its own pair and fee e.g. binance:btc-usdt,kraken:btc-usd:0.0026",
+		},
+		&cli.StringFlag{
+			Name:  "pair",
+			Usage: "the currency pair to aggregate",
+		},
+		&cli.StringFlag{
+			Name:  "asset",
+			Usage: "the asset type of the currency pair",
+		},
+		&cli.BoolFlag{
+			Name:  "normalisequote",
+			Usage: "optional - converts sources quoted in other currencies e.g. USDT and USDC into the pair's quote currency",
+		},
+		&cli.BoolFlag{
+			Name:  "includefees",
+			Usage: "optional - groups levels by their fee adjusted price",
+		},
+		&cli.Int64Flag{
+			Name:  "depthlimit",
+			Usage: "optional - limit how many levels are returned on each side",
+		},
+	},
+}
+
+func getAggregatedOrderbookStream(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	var exchanges, pair, assetType string
+	if c.IsSet("exchanges") {
+		exchanges = c.String("exchanges")
+	} else {
+		exchanges = c.Args().First()
+	}
+
+	if c.IsSet("pair") {
+		pair = c.String("pair")
+	} else {
+		pair = c.Args().Get(1)
+	}
+	if !validPair(pair) {
+		return errInvalidPair
+	}
+	p, err := currency.NewPairDelimiter(pair, pairDelimiter)
+	if err != nil {
+		return err
+	}
+
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(2)
+	}
+	assetType = strings.ToLower(assetType)
+	if !validAsset(assetType) {
+		return errInvalidAsset
+	}
+
+	var sources []*gctrpc.AggregatedOrderbookSource
+	for source := range strings.SplitSeq(exchanges, ",") {
+		parts := strings.Split(source, ":")
+		if len(parts) > 3 || parts[0] == "" {
+			return fmt.Errorf("invalid aggregated orderbook source %q", source)
+		}
+		s := &gctrpc.AggregatedOrderbookSource{Exchange: parts[0]}
+		if len(parts) > 1 && parts[1] != "" {
+			if !validPair(parts[1]) {
+				return errInvalidPair
+			}
+			sp, err := currency.NewPairDelimiter(parts[1], pairDelimiter)
+			if err != nil {
+				return err
+			}
+			s.Pair = &gctrpc.CurrencyPair{Delimiter: sp.Delimiter, Base: sp.Base.String(), Quote: sp.Quote.String()}
+		}
+		if len(parts) > 2 {
+			if s.Fee, err = strconv.ParseFloat(parts[2], 64); err != nil {
+				return err
+			}
+		}
+		sources = append(sources, s)
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetAggregatedOrderbookStream(c.Context,
+		&gctrpc.GetAggregatedOrderbookStreamRequest{
+			Sources: sources,
+			Pair: &gctrpc.CurrencyPair{
+				Delimiter: p.Delimiter,
+				Base:      p.Base.String(),
+				Quote:     p.Quote.String(),
+			},
+			AssetType:      assetType,
+			NormaliseQuote: c.Bool("normalisequote"),
+			IncludeFees:    c.Bool("includefees"),
+			MaxLevels:      int32(c.Int64("depthlimit")), //nolint:gosec // Depth limit is a user supplied level count
+		})
+	if err != nil {
+		return err
+	}
+
+	for {
+		resp, err := result.Recv()
+		if err != nil {
+			return err
+		}
+		jsonOutput(resp)
+	}
+}
+
+var orderbookAnalyticsFlags = []cli.Flag{
+	&cli.StringFlag{
+		Name:  "exchange",
+		Usage: "the exchange of the orderbook",
+	},
+	&cli.StringFlag{
+		Name:  "pair",
+		Usage: "the currency pair of the orderbook",
+	},
+	&cli.StringFlag{
+		Name:  "asset",
+		Usage: "the asset type of the currency pair",
+	},
+	&cli.StringFlag{
+		Name:  "bands",
+		Usage: "optional - comma separated basis point distances from the mid price to measure depth and imbalance within e.g. 10,25,50",
+	},
+	&cli.Int64Flag{
+		Name:  "levels",
+		Usage: "optional - the number of levels on each side the weighted mid price is calculated from",
+	},
+	&cli.DurationFlag{
+		Name:  "window",
+		Usage: "optional - the trailing window spread statistics and flow rates are calculated over e.g. 5m",
+	},
+}
+
+var getOrderbookAnalyticsCommand = &cli.Command{
+	Name:      "getorderbookanalytics",
+	Usage:     "gets imbalance, weighted mid, microprice, spread, depth and liquidity flow analytics of an orderbook",
+	ArgsUsage: "<exchange> <pair> <asset>",
+	Action:    getOrderbookAnalytics,
+	Flags:     orderbookAnalyticsFlags,
+}
+
+var getOrderbookAnalyticsStreamCommand = &cli.Command{
+	Name:      "getorderbookanalyticsstream",
+	Usage:     "streams imbalance, weighted mid, microprice, spread, depth and liquidity flow analytics of an orderbook",
+	ArgsUsage: "<exchange> <pair> <asset>",
+	Action:    getOrderbookAnalyticsStream,
+	Flags:     orderbookAnalyticsFlags,
+}
+
+var untrackOrderbookAnalyticsCommand = &cli.Command{
+	Name:      "untrackorderbookanalytics",
+	Usage:     "stops tracking the analytics of an orderbook, once any analytics streams of it have finished",
+	ArgsUsage: "<exchange> <pair> <asset>",
+	Action:    untrackOrderbookAnalytics,
+	Flags:     orderbookAnalyticsFlags[:3],
+}
+
+func getOrderbookAnalytics(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	req, err := orderbookAnalyticsRequest(c)
+	if err != nil {
+		return err
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetOrderbookAnalytics(c.Context, req)
+	if err != nil {
+		return err
+	}
+	jsonOutput(result)
+	return nil
+}
+
+func getOrderbookAnalyticsStream(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	req, err := orderbookAnalyticsRequest(c)
+	if err != nil {
+		return err
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetOrderbookAnalyticsStream(c.Context, req)
+	if err != nil {
+		return err
+	}
+
+	for {
+		resp, err := result.Recv()
+		if err != nil {
+			return err
+		}
+		jsonOutput(resp)
+	}
+}
+
+func untrackOrderbookAnalytics(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	req, err := orderbookAnalyticsRequest(c)
+	if err != nil {
+		return err
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.UntrackOrderbookAnalytics(c.Context, &gctrpc.UntrackOrderbookAnalyticsRequest{
+		Exchange:  req.Exchange,
+		Pair:      req.Pair,
+		AssetType: req.AssetType,
+	})
+	if err != nil {
+		return err
+	}
+	jsonOutput(result)
+	return nil
+}
+
+func orderbookAnalyticsRequest(c *cli.Context) (*gctrpc.GetOrderbookAnalyticsRequest, error) {
+	var exchangeName, pair, assetType string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	if c.IsSet("pair") {
+		pair = c.String("pair")
+	} else {
+		pair = c.Args().Get(1)
+	}
+	if !validPair(pair) {
+		return nil, errInvalidPair
+	}
+	p, err := currency.NewPairDelimiter(pair, pairDelimiter)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(2)
+	}
+	assetType = strings.ToLower(assetType)
+	if !validAsset(assetType) {
+		return nil, errInvalidAsset
+	}
+
+	var bands []float64
+	if c.IsSet("bands") {
+		for band := range strings.SplitSeq(c.String("bands"), ",") {
+			bps, err := strconv.ParseFloat(strings.TrimSpace(band), 64)
+			if err != nil {
+				return nil, err
+			}
+			bands = append(bands, bps)
+		}
+	}
+
+	return &gctrpc.GetOrderbookAnalyticsRequest{
+		Exchange: exchangeName,
+		Pair: &gctrpc.CurrencyPair{
+			Delimiter: p.Delimiter,
+			Base:      p.Base.String(),
+			Quote:     p.Quote.String(),
+		},
+		AssetType: assetType,
+		Bands:     bands,
+		Levels:    c.Int64("levels"),
+		Window:    int64(c.Duration("window")),
+	}, nil
+}
+
 var whaleBombCommand = &cli.Command{
 	Name:      "whalebomb",
 	Usage:     "whale bomb finds the amount required to reach a price target",
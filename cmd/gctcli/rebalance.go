@@ -0,0 +1,100 @@
+package main
+
+import (
+	"errors"
+
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"github.com/urfave/cli/v2"
+)
+
+var rebalanceCommands = &cli.Command{
+	Name:      "rebalance",
+	Usage:     "previews and approves plans to rebalance holdings to their target weights",
+	ArgsUsage: "<command> <args>",
+	Subcommands: []*cli.Command{
+		{
+			Name:   "preview",
+			Usage:  "plans the trades needed to rebalance holdings, replacing any plan awaiting approval",
+			Action: previewRebalance,
+		},
+		{
+			Name:   "plan",
+			Usage:  "returns the most recent rebalance plan",
+			Action: getRebalancePlan,
+		},
+		{
+			Name:      "approve",
+			Usage:     "submits the trades of the rebalance plan awaiting approval",
+			ArgsUsage: "<id>",
+			Action:    approveRebalancePlan,
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "id",
+					Usage: "the ID of the plan to approve",
+				},
+			},
+		},
+	},
+}
+
+func previewRebalance(c *cli.Context) error {
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.PreviewRebalance(c.Context, &gctrpc.PreviewRebalanceRequest{})
+	if err != nil {
+		return err
+	}
+	jsonOutput(result)
+	return nil
+}
+
+func getRebalancePlan(c *cli.Context) error {
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetRebalancePlan(c.Context, &gctrpc.GetRebalancePlanRequest{})
+	if err != nil {
+		return err
+	}
+	jsonOutput(result)
+	return nil
+}
+
+func approveRebalancePlan(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	var id string
+	if c.IsSet("id") {
+		id = c.String("id")
+	} else {
+		id = c.Args().First()
+	}
+	if id == "" {
+		return errors.New("an ID must be specified")
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.ApproveRebalancePlan(c.Context, &gctrpc.ApproveRebalancePlanRequest{Id: id})
+	if err != nil {
+		return err
+	}
+	jsonOutput(result)
+	return nil
+}
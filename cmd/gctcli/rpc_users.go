@@ -0,0 +1,80 @@
+package main
+
+import (
+	"errors"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc/auth"
+	"github.com/urfave/cli/v2"
+)
+
+var generateRPCUserCommand = &cli.Command{
+	Name:      "generaterpcuser",
+	Usage:     "generates a gRPC user for the remoteControl users config, does not connect to the gRPC server",
+	ArgsUsage: "<username> <scopes>",
+	Action:    generateRPCUser,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "username",
+			Usage: "the name of the gRPC user, matched against the common name of client certificates",
+		},
+		&cli.StringSliceFlag{
+			Name:  "scopes",
+			Usage: "the scopes granted to the user: read, trade, withdraw and/or admin",
+			Value: cli.NewStringSlice("read"),
+		},
+		&cli.StringFlag{
+			Name:  "password",
+			Usage: "an optional password for HTTP basic authentication, stored as a bcrypt hash",
+		},
+		&cli.BoolFlag{
+			Name:  "notoken",
+			Usage: "does not generate a bearer token for the user",
+		},
+	},
+}
+
+func generateRPCUser(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	username := c.String("username")
+	if !c.IsSet("username") {
+		username = c.Args().First()
+	}
+	if username == "" {
+		return errors.New("invalid username supplied")
+	}
+
+	scopes := c.StringSlice("scopes")
+	if !c.IsSet("scopes") && c.Args().Len() > 1 {
+		scopes = c.Args().Tail()
+	}
+
+	user := config.RPCUser{
+		Username: username,
+		Scopes:   scopes,
+	}
+	if pw := c.String("password"); pw != "" {
+		hash, err := auth.HashPassword(pw)
+		if err != nil {
+			return err
+		}
+		user.PasswordHash = hash
+	}
+
+	var token string
+	if !c.Bool("notoken") {
+		var err error
+		if token, user.TokenHash, err = auth.GenerateToken(); err != nil {
+			return err
+		}
+	}
+
+	jsonOutput(struct {
+		Token string         `json:"token,omitempty"`
+		User  config.RPCUser `json:"user"`
+	}{Token: token, User: user})
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 	"crypto/x509"
 	"crypto/x509/pkix"
 	"encoding/pem"
+	"flag"
 	"log"
 	"math/big"
 	"net"
@@ -18,6 +19,17 @@ import (
 )
 
 func main() {
+	var client, caCertFile, caKeyFile string
+	flag.StringVar(&client, "client", "", "generates a gRPC client certificate for the named user, signed by the cacert and cakey")
+	flag.StringVar(&caCertFile, "cacert", "cert.pem", "the certificate used to sign client certificates")
+	flag.StringVar(&caKeyFile, "cakey", "key.pem", "the private key used to sign client certificates")
+	flag.Parse()
+
+	if client != "" {
+		genClientCert(client, caCertFile, caKeyFile)
+		return
+	}
+
 	privKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
 	if err != nil {
 		log.Fatalf("failed to generate private key: %s", err)
@@ -54,7 +66,7 @@ func main() {
 		BasicConstraintsValid: true,
 
 		KeyUsage:    x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
-		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
 
 		IPAddresses: []net.IP{
 			net.ParseIP("127.0.0.1"),
@@ -103,3 +115,82 @@ func main() {
 
 	log.Printf("ok!")
 }
+
+// genClientCert generates a client certificate with the user as its common
+// name, signed by the gRPC server certificate so it can be verified using the
+// server certificate as the client certificate authority
+func genClientCert(user, caCertFile, caKeyFile string) {
+	caPair, err := tls.LoadX509KeyPair(caCertFile, caKeyFile)
+	if err != nil {
+		log.Fatalf("failed to load certificate authority: %s", err)
+	}
+	caCert, err := x509.ParseCertificate(caPair.Certificate[0])
+	if err != nil {
+		log.Fatalf("failed to parse certificate authority: %s", err)
+	}
+
+	privKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		log.Fatalf("failed to generate private key: %s", err)
+	}
+
+	serialNumberLimit := new(big.Int).Lsh(big.NewInt(1), 128)
+	serialNumber, err := rand.Int(rand.Reader, serialNumberLimit)
+	if err != nil {
+		log.Fatalf("failed to generate serial number: %s", err)
+	}
+
+	notBefore := time.Now()
+	notAfter := notBefore.Add(time.Hour * 24 * 365)
+	if notAfter.After(caCert.NotAfter) {
+		notAfter = caCert.NotAfter
+	}
+
+	template := x509.Certificate{
+		SerialNumber: serialNumber,
+		Subject: pkix.Name{
+			Organization: []string{"gocryptotrader"},
+			CommonName:   user,
+		},
+		NotBefore:   notBefore,
+		NotAfter:    notAfter,
+		KeyUsage:    x509.KeyUsageDigitalSignature,
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+
+	derBytes, err := x509.CreateCertificate(rand.Reader, &template, caCert, &privKey.PublicKey, caPair.PrivateKey)
+	if err != nil {
+		log.Fatalf("Failed to create client certificate: %s", err)
+	}
+
+	b, err := x509.MarshalECPrivateKey(privKey)
+	if err != nil {
+		log.Fatalf("failed to marshal ECDSA private key: %s", err)
+	}
+
+	certFile, keyFile := user+"-cert.pem", user+"-key.pem"
+	err = file.Write(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: b}))
+	if err != nil {
+		log.Fatalf("failed to write %s file %s", keyFile, err)
+	}
+	log.Printf("wrote %s file", keyFile)
+
+	err = file.Write(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: derBytes}))
+	if err != nil {
+		log.Fatalf("failed to write %s file %s", certFile, err)
+	}
+	log.Printf("wrote %s file", certFile)
+
+	log.Printf("testing client certificate verification..")
+	pool := x509.NewCertPool()
+	pool.AddCert(caCert)
+	clientCert, err := x509.ParseCertificate(derBytes)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if _, err = clientCert.Verify(x509.VerifyOptions{Roots: pool, KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}}); err != nil {
+		log.Fatal(err)
+	}
+
+	log.Printf("ok!")
+}
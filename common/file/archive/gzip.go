@@ -0,0 +1,250 @@
+package archive
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common/file"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// RotatingFileTimeFormat is the UTC time format rotating file names are
+// suffixed with. File names sort in the order they were created
+const RotatingFileTimeFormat = "20060102T150405.000000000Z"
+
+const gzipExtension = ".gz"
+
+var (
+	errDirectoryNotSet  = errors.New("directory not set")
+	errPrefixNotSet     = errors.New("file prefix not set")
+	errInvalidRotation  = errors.New("max file size and age cannot be negative")
+	errInvalidPrefixSep = errors.New("file prefix cannot contain a path separator")
+)
+
+// RotatingGzipWriter writes gzip compressed data to append only files in a
+// directory, starting a new file once rotated. Each file opened starts a new
+// gzip member so a file appended to again remains readable as a single stream
+type RotatingGzipWriter struct {
+	dir     string
+	prefix  string
+	ext     string
+	maxSize int64
+	maxAge  time.Duration
+
+	file   *os.File
+	gz     *gzip.Writer
+	size   int64
+	opened time.Time
+	m      sync.Mutex
+}
+
+// NewRotatingGzipWriter returns a writer of files named
+// <prefix>_<time><ext>.gz in dir. A max size in compressed bytes or max age of
+// zero disables that rotation limit
+func NewRotatingGzipWriter(dir, prefix, ext string, maxSize int64, maxAge time.Duration) (*RotatingGzipWriter, error) {
+	if dir == "" {
+		return nil, errDirectoryNotSet
+	}
+	if prefix == "" {
+		return nil, errPrefixNotSet
+	}
+	if strings.ContainsAny(prefix, `/\`) {
+		return nil, fmt.Errorf("%w: %q", errInvalidPrefixSep, prefix)
+	}
+	if maxSize < 0 || maxAge < 0 {
+		return nil, errInvalidRotation
+	}
+	return &RotatingGzipWriter{dir: dir, prefix: prefix, ext: ext, maxSize: maxSize, maxAge: maxAge}, nil
+}
+
+// Write compresses p to the current file, opening a new file if none is open
+func (r *RotatingGzipWriter) Write(p []byte) (int, error) {
+	r.m.Lock()
+	defer r.m.Unlock()
+	if r.gz == nil {
+		if err := r.open(); err != nil {
+			return 0, err
+		}
+	}
+	return r.gz.Write(p)
+}
+
+// RotationDue returns whether the current file has reached its max size or age
+func (r *RotatingGzipWriter) RotationDue() bool {
+	r.m.Lock()
+	defer r.m.Unlock()
+	if r.gz == nil {
+		return false
+	}
+	return (r.maxSize > 0 && r.size >= r.maxSize) || (r.maxAge > 0 && time.Since(r.opened) >= r.maxAge)
+}
+
+// Rotate closes the current file so the next write starts a new file
+func (r *RotatingGzipWriter) Rotate() error {
+	r.m.Lock()
+	defer r.m.Unlock()
+	return r.close()
+}
+
+// Flush writes pending compressed data to the current file so it can be read
+// before the file is closed
+func (r *RotatingGzipWriter) Flush() error {
+	r.m.Lock()
+	defer r.m.Unlock()
+	if r.gz == nil {
+		return nil
+	}
+	return r.gz.Flush()
+}
+
+// Close closes the current file
+func (r *RotatingGzipWriter) Close() error {
+	r.m.Lock()
+	defer r.m.Unlock()
+	return r.close()
+}
+
+// Filename returns the path of the current file or an empty string if no file
+// is open
+func (r *RotatingGzipWriter) Filename() string {
+	r.m.Lock()
+	defer r.m.Unlock()
+	if r.file == nil {
+		return ""
+	}
+	return r.file.Name()
+}
+
+// open opens a new file. NOTE: This requires locking.
+func (r *RotatingGzipWriter) open() error {
+	if err := os.MkdirAll(r.dir, file.DefaultPermissionOctal); err != nil {
+		return err
+	}
+	now := time.Now()
+	name := filepath.Join(r.dir, r.prefix+"_"+now.UTC().Format(RotatingFileTimeFormat)+r.ext+gzipExtension)
+	f, err := os.OpenFile(name, os.O_WRONLY|os.O_CREATE|os.O_APPEND, file.DefaultPermissionOctal)
+	if err != nil {
+		return err
+	}
+	r.file = f
+	r.gz = gzip.NewWriter(&countingWriter{w: f, n: &r.size})
+	r.size = 0
+	r.opened = now
+	return nil
+}
+
+// close closes the current file. NOTE: This requires locking.
+func (r *RotatingGzipWriter) close() error {
+	if r.gz == nil {
+		return nil
+	}
+	err := r.gz.Close()
+	if closeErr := r.file.Close(); closeErr != nil {
+		err = errors.Join(err, closeErr)
+	}
+	r.gz = nil
+	r.file = nil
+	return err
+}
+
+// countingWriter counts the bytes written to the underlying writer
+type countingWriter struct {
+	w io.Writer
+	n *int64
+}
+
+// Write implements io.Writer
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	*c.n += int64(n)
+	return n, err
+}
+
+// RotatingGzipFiles returns the paths of the files in dir written by a
+// RotatingGzipWriter with the prefix and extension, in the order they were
+// created
+func RotatingGzipFiles(dir, prefix, ext string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	files := make([]string, 0, len(entries))
+	for _, e := range entries {
+		name := e.Name()
+		if e.IsDir() || !strings.HasPrefix(name, prefix+"_") || !strings.HasSuffix(name, ext+gzipExtension) {
+			continue
+		}
+		stamp := strings.TrimSuffix(strings.TrimPrefix(name, prefix+"_"), ext+gzipExtension)
+		if _, err := time.Parse(RotatingFileTimeFormat, stamp); err != nil {
+			continue
+		}
+		files = append(files, filepath.Join(dir, name))
+	}
+	slices.Sort(files)
+	return files, nil
+}
+
+// ReadGzipLines calls fn with each newline delimited line of a gzip file,
+// which may hold several gzip members. A truncated final member, as left by a
+// writer which was not closed, ends the file without error and the incomplete
+// line being read is dropped. Returning io.EOF from fn stops reading without error
+func ReadGzipLines(path string, fn func(line []byte) error) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if closeErr := f.Close(); closeErr != nil {
+			log.Errorf(log.Global, ErrUnableToCloseFile, path, closeErr)
+		}
+	}()
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			return nil
+		}
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	defer func() {
+		if closeErr := gz.Close(); closeErr != nil {
+			log.Errorf(log.Global, ErrUnableToCloseFile, path, closeErr)
+		}
+	}()
+	br := bufio.NewReader(gz)
+	for {
+		line, err := br.ReadBytes('\n')
+		switch {
+		case errors.Is(err, io.ErrUnexpectedEOF):
+			return nil
+		case errors.Is(err, io.EOF):
+			if line = bytes.TrimSpace(line); len(line) != 0 {
+				if err := fn(line); err != nil && !errors.Is(err, io.EOF) {
+					return err
+				}
+			}
+			return nil
+		case err != nil:
+			return fmt.Errorf("%s: %w", path, err)
+		}
+		line = bytes.TrimSpace(line)
+		if len(line) == 0 {
+			continue
+		}
+		if err := fn(line); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+	}
+}
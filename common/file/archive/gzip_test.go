@@ -0,0 +1,129 @@
+package archive
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRotatingGzipWriter(t *testing.T) {
+	t.Parallel()
+	_, err := NewRotatingGzipWriter("", "test", ".jsonl", 0, 0)
+	assert.ErrorIs(t, err, errDirectoryNotSet)
+	_, err = NewRotatingGzipWriter(t.TempDir(), "", ".jsonl", 0, 0)
+	assert.ErrorIs(t, err, errPrefixNotSet)
+	_, err = NewRotatingGzipWriter(t.TempDir(), "a/b", ".jsonl", 0, 0)
+	assert.ErrorIs(t, err, errInvalidPrefixSep)
+	_, err = NewRotatingGzipWriter(t.TempDir(), "test", ".jsonl", -1, 0)
+	assert.ErrorIs(t, err, errInvalidRotation)
+	w, err := NewRotatingGzipWriter(t.TempDir(), "test", ".jsonl", 0, time.Hour)
+	require.NoError(t, err)
+	assert.Empty(t, w.Filename(), "Filename should be empty before the first write")
+	assert.False(t, w.RotationDue(), "RotationDue should be false before the first write")
+	assert.NoError(t, w.Flush())
+	assert.NoError(t, w.Close())
+}
+
+func TestRotatingGzipWriter(t *testing.T) {
+	t.Parallel()
+	dir := filepath.Join(t.TempDir(), "nested")
+	w, err := NewRotatingGzipWriter(dir, "book", ".jsonl", 1, 0)
+	require.NoError(t, err)
+
+	_, err = w.Write([]byte("one\ntwo\n"))
+	require.NoError(t, err)
+	first := w.Filename()
+	require.NotEmpty(t, first)
+	require.NoError(t, w.Flush())
+	assert.True(t, w.RotationDue(), "RotationDue should be true once the max size is reached")
+
+	require.NoError(t, w.Rotate())
+	assert.Empty(t, w.Filename())
+	_, err = w.Write([]byte("three\n"))
+	require.NoError(t, err)
+	second := w.Filename()
+	require.NotEqual(t, first, second)
+	require.NoError(t, w.Close())
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "book_invalid.jsonl.gz"), nil, 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "other_20200101T000000.000000000Z.jsonl.gz"), nil, 0o600))
+	files, err := RotatingGzipFiles(dir, "book", ".jsonl")
+	require.NoError(t, err)
+	assert.Equal(t, []string{first, second}, files)
+
+	_, err = RotatingGzipFiles(filepath.Join(dir, "missing"), "book", ".jsonl")
+	assert.ErrorIs(t, err, os.ErrNotExist)
+
+	var lines []string
+	for _, f := range files {
+		require.NoError(t, ReadGzipLines(f, func(line []byte) error {
+			lines = append(lines, string(line))
+			return nil
+		}))
+	}
+	assert.Equal(t, []string{"one", "two", "three"}, lines)
+
+	w, err = NewRotatingGzipWriter(dir, "age", ".jsonl", 0, time.Nanosecond)
+	require.NoError(t, err)
+	_, err = w.Write([]byte("x\n"))
+	require.NoError(t, err)
+	time.Sleep(time.Millisecond)
+	assert.True(t, w.RotationDue(), "RotationDue should be true once the max age is reached")
+	require.NoError(t, w.Close())
+}
+
+func TestReadGzipLines(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	w, err := NewRotatingGzipWriter(dir, "book", ".jsonl", 0, 0)
+	require.NoError(t, err)
+	_, err = w.Write([]byte("one\n\ntwo\nthree"))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	files, err := RotatingGzipFiles(dir, "book", ".jsonl")
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	path := files[0]
+
+	var lines []string
+	collect := func(line []byte) error {
+		lines = append(lines, string(line))
+		return nil
+	}
+	require.NoError(t, ReadGzipLines(path, collect))
+	assert.Equal(t, []string{"one", "two", "three"}, lines, "Should read a final line without a newline")
+
+	lines = nil
+	require.NoError(t, ReadGzipLines(path, func(line []byte) error {
+		lines = append(lines, string(line))
+		return io.EOF
+	}))
+	assert.Equal(t, []string{"one"}, lines, "Returning io.EOF should stop reading")
+
+	assert.ErrorIs(t, ReadGzipLines(path, func([]byte) error { return errPrefixNotSet }), errPrefixNotSet)
+	assert.ErrorIs(t, ReadGzipLines(filepath.Join(dir, "missing"), collect), os.ErrNotExist)
+
+	// A writer which is flushed but not closed leaves a truncated member
+	w, err = NewRotatingGzipWriter(dir, "open", ".jsonl", 0, 0)
+	require.NoError(t, err)
+	_, err = w.Write([]byte("one\ntw"))
+	require.NoError(t, err)
+	require.NoError(t, w.Flush())
+	lines = nil
+	require.NoError(t, ReadGzipLines(w.Filename(), collect))
+	assert.Equal(t, []string{"one"}, lines, "Should drop the incomplete line of a truncated member")
+	require.NoError(t, w.Close())
+
+	empty := filepath.Join(dir, "empty.gz")
+	require.NoError(t, os.WriteFile(empty, nil, 0o600))
+	assert.NoError(t, ReadGzipLines(empty, collect))
+
+	invalid := filepath.Join(dir, "invalid.gz")
+	require.NoError(t, os.WriteFile(invalid, []byte("not gzip data"), 0o600))
+	assert.Error(t, ReadGzipLines(invalid, collect))
+}
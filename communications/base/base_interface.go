@@ -2,11 +2,18 @@ package base
 
 import (
 	"errors"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/log"
 )
 
+var (
+	errRelayerNotFound     = errors.New("communication relayer not found")
+	errRelayerNotConnected = errors.New("communication relayer is not enabled and connected")
+)
+
 // IComm is the main interface array across the communication packages
 type IComm []ICommunicate
 
@@ -50,6 +57,20 @@ func (c IComm) PushEvent(event Event) {
 	}
 }
 
+// PushEventTo pushes an event to a single enabled communication link by name
+func (c IComm) PushEventTo(name string, event Event) error {
+	for i := range c {
+		if !strings.EqualFold(c[i].GetName(), name) {
+			continue
+		}
+		if !c[i].IsEnabled() || !c[i].IsConnected() {
+			return fmt.Errorf("%w: %s", errRelayerNotConnected, name)
+		}
+		return c[i].PushEvent(event)
+	}
+	return fmt.Errorf("%w: %s", errRelayerNotFound, name)
+}
+
 // GetStatus returns the status of the comms relayers
 func (c IComm) GetStatus() map[string]CommsStatus {
 	result := make(map[string]CommsStatus)
@@ -3,6 +3,9 @@ package base
 import (
 	"testing"
 	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 var b Base
@@ -151,3 +154,18 @@ func TestPushEvent(t *testing.T) {
 		}
 	}
 }
+
+func TestPushEventTo(t *testing.T) {
+	t.Parallel()
+	connected := &CommunicationProvider{isEnabled: true, isConnected: true}
+	ic := IComm{connected}
+	assert.ErrorIs(t, ic.PushEventTo("moon", Event{}), errRelayerNotFound)
+
+	require.NoError(t, ic.PushEventTo("SOMETESTPROVIDER", Event{}))
+	assert.True(t, connected.PushEventCalled, "PushEventTo should push to the named relayer")
+
+	disconnected := &CommunicationProvider{isEnabled: true}
+	ic = IComm{disconnected}
+	assert.ErrorIs(t, ic.PushEventTo("someTestProvider", Event{}), errRelayerNotConnected)
+	assert.False(t, disconnected.PushEventCalled, "PushEventTo should not push to a disconnected relayer")
+}
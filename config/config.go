@@ -1463,6 +1463,7 @@ func (c *Config) ReadConfigFromFile(path string, dryrun bool) error {
 // readConfig loads config from a io.Reader into the config object
 // versions manager will upgrade/downgrade if appropriate
 // If encrypted, prompts for encryption key
+// Secret references are resolved using their secret provider
 func (c *Config) readConfig(d io.Reader) error {
 	j, err := io.ReadAll(d)
 	if err != nil {
@@ -1479,7 +1480,11 @@ func (c *Config) readConfig(d io.Reader) error {
 		return err
 	}
 
-	return json.Unmarshal(j, c)
+	if err := json.Unmarshal(j, c); err != nil {
+		return err
+	}
+
+	return c.resolveSecrets(context.Background())
 }
 
 // saveWithEncryptPrompt will prompt the user if they want to encrypt their config
@@ -1543,6 +1548,7 @@ func (c *Config) SaveConfigToFile(configPath string) error {
 }
 
 // Save saves your configuration to the writer as a JSON object with encryption, if configured
+// Resolved secrets are saved as their secret references
 // If there is an error when preparing the data to store, the writer is never requested
 func (c *Config) Save(writerProvider func() (io.Writer, error)) error {
 	payload, err := json.MarshalIndent(c, "", " ")
@@ -1550,6 +1556,10 @@ func (c *Config) Save(writerProvider func() (io.Writer, error)) error {
 		return err
 	}
 
+	if payload, err = c.restoreSecretReferences(payload); err != nil {
+		return err
+	}
+
 	if c.EncryptConfig == fileEncryptionEnabled {
 		// Ensure we have the key from session or from user
 		if len(c.sessionDK) == 0 {
@@ -1590,8 +1600,12 @@ func (c *Config) CheckRemoteControlConfig() {
 	m.Lock()
 	defer m.Unlock()
 
-	setDefaultIfZeroWarn("Remote control", "username", &c.RemoteControl.Username, DefaultGRPCUsername)
-	setDefaultIfZeroWarn("Remote control", "password", &c.RemoteControl.Password, DefaultGRPCPassword)
+	// The single username and password is only defaulted when no users are
+	// configured so it cannot be used to bypass user scopes
+	if len(c.RemoteControl.Users) == 0 {
+		setDefaultIfZeroWarn("Remote control", "username", &c.RemoteControl.Username, DefaultGRPCUsername)
+		setDefaultIfZeroWarn("Remote control", "password", &c.RemoteControl.Password, DefaultGRPCPassword)
+	}
 	setDefaultIfZeroWarn("Remote control gRPC", "listen address", &c.RemoteControl.GRPC.ListenAddress, "localhost:9052")
 	setDefaultIfZeroWarn("Remote control gRPC", "gRPC proxy listen address", &c.RemoteControl.GRPC.GRPCProxyListenAddress, "localhost:9053")
 
@@ -1599,6 +1613,10 @@ func (c *Config) CheckRemoteControlConfig() {
 		log.Warnln(log.ConfigMgr, "gRPC proxy cannot be enabled when gRPC is disabled, disabling gRPC proxy")
 		c.RemoteControl.GRPC.GRPCProxyEnabled = false
 	}
+	if c.RemoteControl.GRPC.GRPCProxyEnabled && c.RemoteControl.GRPC.RequireClientCertificate {
+		log.Warnln(log.ConfigMgr, "gRPC proxy cannot be enabled when client certificates are required, disabling gRPC proxy")
+		c.RemoteControl.GRPC.GRPCProxyEnabled = false
+	}
 }
 
 // CheckConfig checks all config settings
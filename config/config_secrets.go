@@ -0,0 +1,106 @@
+package config
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/buger/jsonparser"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/config/secrets"
+	"github.com/thrasher-corp/gocryptotrader/encoding/json"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+var errResolvingSecret = errors.New("error resolving secret")
+
+// secretFields returns the config values which can reference a secret
+func (c *Config) secretFields() []secretField {
+	fields := []secretField{
+		{id: "remoteControl.password", path: []string{"remoteControl", "password"}, value: &c.RemoteControl.Password},
+		{id: "communications.slack.verificationToken", path: []string{"communications", "slack", "verificationToken"}, value: &c.Communications.SlackConfig.VerificationToken},
+		{id: "communications.smsGlobal.password", path: []string{"communications", "smsGlobal", "password"}, value: &c.Communications.SMSGlobalConfig.Password},
+		{id: "communications.smtp.accountPassword", path: []string{"communications", "smtp", "accountPassword"}, value: &c.Communications.SMTPConfig.AccountPassword},
+		{id: "communications.telegram.verificationToken", path: []string{"communications", "telegram", "verificationToken"}, value: &c.Communications.TelegramConfig.VerificationToken},
+	}
+	for i := range c.Exchanges {
+		creds := &c.Exchanges[i].API.Credentials
+		for _, f := range []struct {
+			name  string
+			value *string
+		}{
+			{"key", &creds.Key},
+			{"secret", &creds.Secret},
+			{"clientID", &creds.ClientID},
+			{"pemKey", &creds.PEMKey},
+			{"otpSecret", &creds.OTPSecret},
+			{"tradePassword", &creds.TradePassword},
+			{"pin", &creds.PIN},
+		} {
+			fields = append(fields, secretField{
+				id:    "exchanges." + strings.ToLower(c.Exchanges[i].Name) + ".api.credentials." + f.name,
+				path:  []string{"exchanges", "[" + strconv.Itoa(i) + "]", "api", "credentials", f.name},
+				value: f.value,
+			})
+		}
+	}
+	return fields
+}
+
+// resolveSecrets replaces secret references in the config with the values
+// returned by their provider. The references are kept so they are saved
+// instead of the secret values
+func (c *Config) resolveSecrets(ctx context.Context) error {
+	c.secretRefs = nil
+	fields := c.secretFields()
+	var resolver *secrets.Resolver
+	for i := range fields {
+		if !secrets.IsReference(*fields[i].value) {
+			continue
+		}
+		if resolver == nil {
+			var err error
+			if resolver, err = secrets.NewResolver(ctx, c.Secrets); err != nil {
+				return fmt.Errorf("%w: %w", errResolvingSecret, err)
+			}
+			c.secretRefs = make(map[string]resolvedSecret)
+		}
+		v, err := resolver.Resolve(ctx, *fields[i].value)
+		if err != nil {
+			return fmt.Errorf("%w %s: %w", errResolvingSecret, fields[i].id, err)
+		}
+		c.secretRefs[fields[i].id] = resolvedSecret{reference: *fields[i].value, value: v}
+		*fields[i].value = v
+	}
+	return nil
+}
+
+// restoreSecretReferences replaces resolved secret values in the JSON config
+// payload with their references. Secrets changed since they were resolved are
+// saved as they are
+func (c *Config) restoreSecretReferences(payload []byte) ([]byte, error) {
+	if len(c.secretRefs) == 0 {
+		return payload, nil
+	}
+	fields := c.secretFields()
+	for i := range fields {
+		ref, ok := c.secretRefs[fields[i].id]
+		if !ok {
+			continue
+		}
+		if *fields[i].value != ref.value {
+			log.Warnf(log.ConfigMgr, "Config value %s no longer matches its secret reference %s and will be saved inline", fields[i].id, ref.reference)
+			continue
+		}
+		v, err := json.Marshal(ref.reference)
+		if err != nil {
+			return nil, err
+		}
+		if payload, err = jsonparser.Set(payload, v, fields[i].path...); err != nil {
+			return nil, fmt.Errorf("%w %s: %w", common.ErrSettingField, fields[i].id, err)
+		}
+	}
+	return payload, nil
+}
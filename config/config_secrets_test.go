@@ -0,0 +1,72 @@
+package config
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/buger/jsonparser"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const secretRefConfig = `{"name":"test","remoteControl":{"password":"secret://env/GCT_CONFIG_TEST_RPC_PASSWORD"},"exchanges":[{"name":"Binance","api":{"credentials":{"key":"secret://env/GCT_CONFIG_TEST_KEY","secret":"inline"}}},{"name":"Bitstamp","api":{"credentials":{"key":"secret://env/GCT_CONFIG_TEST_KEY"}}}]}`
+
+func TestResolveSecrets(t *testing.T) {
+	t.Setenv("GCT_CONFIG_TEST_RPC_PASSWORD", "rpcpassword")
+	t.Setenv("GCT_CONFIG_TEST_KEY", "apikey")
+
+	c := &Config{}
+	require.NoError(t, c.readConfig(strings.NewReader(secretRefConfig)), "readConfig must not error")
+	assert.Equal(t, "rpcpassword", c.RemoteControl.Password)
+	assert.Equal(t, "apikey", c.Exchanges[0].API.Credentials.Key)
+	assert.Equal(t, "inline", c.Exchanges[0].API.Credentials.Secret, "inline secrets should not change")
+	assert.Equal(t, "apikey", c.Exchanges[1].API.Credentials.Key)
+	assert.Len(t, c.secretRefs, 3)
+
+	c = &Config{}
+	err := c.readConfig(strings.NewReader(`{"exchanges":[{"name":"Binance","api":{"credentials":{"key":"secret://env/GCT_CONFIG_TEST_MISSING"}}}]}`))
+	assert.ErrorIs(t, err, errResolvingSecret)
+	assert.ErrorContains(t, err, "exchanges.binance.api.credentials.key", "error should identify the config value")
+
+	c = &Config{}
+	err = c.readConfig(strings.NewReader(`{"remoteControl":{"password":"secret://keyring/password"}}`))
+	assert.ErrorIs(t, err, errResolvingSecret, "references to unconfigured providers should error")
+}
+
+func TestRestoreSecretReferences(t *testing.T) {
+	t.Setenv("GCT_CONFIG_TEST_RPC_PASSWORD", "rpcpassword")
+	t.Setenv("GCT_CONFIG_TEST_KEY", "apikey")
+
+	c := &Config{}
+	require.NoError(t, c.readConfig(strings.NewReader(secretRefConfig)), "readConfig must not error")
+
+	// Reordered exchanges must keep their own references
+	c.Exchanges[0], c.Exchanges[1] = c.Exchanges[1], c.Exchanges[0]
+	// Secrets changed at runtime are saved inline
+	c.RemoteControl.Password = "changed"
+
+	var buf bytes.Buffer
+	require.NoError(t, c.Save(func() (io.Writer, error) { return &buf, nil }), "Save must not error")
+	payload := buf.Bytes()
+	assert.NotContains(t, string(payload), "apikey", "resolved secrets must not be saved")
+
+	v, err := jsonparser.GetString(payload, "remoteControl", "password")
+	require.NoError(t, err, "GetString must not error")
+	assert.Equal(t, "changed", v)
+	v, err = jsonparser.GetString(payload, "exchanges", "[0]", "name")
+	require.NoError(t, err, "GetString must not error")
+	assert.Equal(t, "Bitstamp", v)
+	v, err = jsonparser.GetString(payload, "exchanges", "[0]", "api", "credentials", "key")
+	require.NoError(t, err, "GetString must not error")
+	assert.Equal(t, "secret://env/GCT_CONFIG_TEST_KEY", v)
+	v, err = jsonparser.GetString(payload, "exchanges", "[1]", "api", "credentials", "key")
+	require.NoError(t, err, "GetString must not error")
+	assert.Equal(t, "secret://env/GCT_CONFIG_TEST_KEY", v)
+	v, err = jsonparser.GetString(payload, "exchanges", "[1]", "api", "credentials", "secret")
+	require.NoError(t, err, "GetString must not error")
+	assert.Equal(t, "inline", v)
+
+	assert.Equal(t, "apikey", c.Exchanges[0].API.Credentials.Key, "Save must not change the running config")
+}
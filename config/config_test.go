@@ -1581,6 +1581,14 @@ func TestCheckRemoteControlConfig(t *testing.T) {
 	c.CheckRemoteControlConfig()
 	assert.True(t, c.RemoteControl.GRPC.Enabled, "gRPC should be true")
 	assert.True(t, c.RemoteControl.GRPC.GRPCProxyEnabled, "gRPCProxyEnabled should be true when gRPC is enabled")
+	c.RemoteControl.GRPC.RequireClientCertificate = true
+	c.CheckRemoteControlConfig()
+	assert.False(t, c.RemoteControl.GRPC.GRPCProxyEnabled, "gRPCProxyEnabled should be set to false when client certificates are required")
+
+	c.RemoteControl = RemoteControlConfig{Users: []RPCUser{{Username: "dashboard"}}}
+	c.CheckRemoteControlConfig()
+	assert.Empty(t, c.RemoteControl.Username, "Username should not be defaulted when users are configured")
+	assert.Empty(t, c.RemoteControl.Password, "Password should not be defaulted when users are configured")
 }
 
 func TestCheckConfig(t *testing.T) {
@@ -6,8 +6,11 @@ import (
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/config/secrets"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/subscription"
 	gctscript "github.com/thrasher-corp/gocryptotrader/gctscript/vm"
@@ -103,11 +106,18 @@ type Config struct {
 	DataHistoryManager   DataHistoryManager        `json:"dataHistoryManager"`
 	CurrencyStateManager CurrencyStateManager      `json:"currencyStateManager"`
 	Profiler             Profiler                  `json:"profiler"`
+	Metrics              MetricsConfig             `json:"metrics"`
+	TradeCandleManager   TradeCandleManager        `json:"tradeCandleManager"`
+	WithdrawManager      WithdrawManager           `json:"withdrawManager"`
+	LedgerManager        LedgerManager             `json:"ledgerManager"`
+	RebalanceManager     RebalanceManager          `json:"rebalanceManager"`
+	OrderbookCapture     OrderbookCaptureManager   `json:"orderbookCaptureManager"`
 	NTPClient            NTPClientConfig           `json:"ntpclient"`
 	GCTScript            gctscript.Config          `json:"gctscript"`
 	Currency             currency.Config           `json:"currencyConfig"`
 	Communications       base.CommunicationsConfig `json:"communications"`
 	RemoteControl        RemoteControlConfig       `json:"remoteControl"`
+	Secrets              *secrets.Config           `json:"secrets,omitempty"`
 	Portfolio            *portfolio.Base           `json:"portfolioAddresses"`
 	Exchanges            []Exchange                `json:"exchanges"`
 	BankAccounts         []banking.Account         `json:"bankAccounts"`
@@ -121,6 +131,9 @@ type Config struct {
 	storedSalt            []byte
 	sessionDK             []byte
 	EncryptionKeyProvider EncryptionKeyProvider `json:"-"`
+	// secretRefs holds resolved secret references so they are saved in
+	// place of their values
+	secretRefs map[string]resolvedSecret
 }
 
 // EncryptionKeyProvider is a function config can use to prompt the user for an encryption key
@@ -134,6 +147,31 @@ type OrderManager struct {
 	FuturesTrackingSeekDuration   time.Duration `json:"futuresTrackingSeekDuration"`
 	RespectOrderHistoryLimits     bool          `json:"respectOrderHistoryLimits"`
 	CancelOrdersOnShutdown        bool          `json:"cancelOrdersOnShutdown"`
+	Risk                          RiskLimits    `json:"risk"`
+}
+
+// RiskLimits holds the pre-trade risk checks applied by the order manager
+// before an order is sent to an exchange
+type RiskLimits struct {
+	Enabled bool `json:"enabled"`
+	// KillSwitch blocks all new orders while enabled
+	KillSwitch bool        `json:"killSwitch"`
+	Limits     []RiskLimit `json:"limits"`
+}
+
+// RiskLimit defines limits for orders matching an exchange, asset and pair.
+// Empty matchers apply to everything and zero values disable a check
+type RiskLimit struct {
+	Exchange           string        `json:"exchange"`
+	Asset              asset.Item    `json:"asset"`
+	Pair               currency.Pair `json:"pair"`
+	MaxOrderNotional   float64       `json:"maxOrderNotional"`
+	MaxPosition        float64       `json:"maxPosition"`
+	MaxOrdersPerMinute int64         `json:"maxOrdersPerMinute"`
+	MaxDailyLoss       float64       `json:"maxDailyLoss"`
+	// MaxPriceDeviation is the percentage an order price may differ from the
+	// last ticker price
+	MaxPriceDeviation float64 `json:"maxPriceDeviation"`
 }
 
 // DataHistoryManager holds all information required for the data history manager
@@ -199,6 +237,7 @@ type Exchange struct {
 	Features                      *FeaturesConfig        `json:"features"`
 	BankAccounts                  []banking.Account      `json:"bankAccounts,omitempty"`
 	Orderbook                     Orderbook              `json:"orderbook"`
+	PaperTrading                  *PaperTradingConfig    `json:"paperTrading,omitempty"`
 
 	// Deprecated settings which will be removed in a future update
 	AuthenticatedAPISupport          *bool   `json:"authenticatedApiSupport,omitempty"`
@@ -215,6 +254,26 @@ type Exchange struct {
 	WebsocketURL                     *string `json:"websocketUrl,omitempty"`
 }
 
+// PaperTradingConfig defines the paper trading settings for an exchange. When enabled, orders are filled by a
+// simulated matching engine against live market data and are never sent to the exchange
+type PaperTradingConfig struct {
+	Enabled       bool          `json:"enabled"`
+	MatchInterval time.Duration `json:"matchInterval,omitempty"`
+	// OrderRetention is how long filled, cancelled and rejected orders are kept after their last update
+	OrderRetention time.Duration `json:"orderRetention,omitempty"`
+	// MakerFee and TakerFee override the exchange fee schedule with a fee rate, e.g. 0.001 for 0.1%
+	MakerFee *float64              `json:"makerFee,omitempty"`
+	TakerFee *float64              `json:"takerFee,omitempty"`
+	Balances []PaperTradingBalance `json:"balances,omitempty"`
+}
+
+// PaperTradingBalance defines a starting balance for paper trading
+type PaperTradingBalance struct {
+	Asset    asset.Item    `json:"asset"`
+	Currency currency.Code `json:"currency"`
+	Amount   float64       `json:"amount"`
+}
+
 // Profiler defines the profiler configuration to enable pprof
 type Profiler struct {
 	Enabled              bool   `json:"enabled"`
@@ -223,6 +282,136 @@ type Profiler struct {
 	BlockProfileRate     int    `json:"block_profile_rate"`
 }
 
+// MetricsConfig defines the configuration for the Prometheus metrics endpoint
+type MetricsConfig struct {
+	Enabled       bool   `json:"enabled"`
+	ListenAddress string `json:"listenAddress"`
+}
+
+// TradeCandleManager defines the configuration for building candles from
+// websocket trades
+type TradeCandleManager struct {
+	Enabled        bool             `json:"enabled"`
+	Exchanges      []string         `json:"exchanges"`
+	Intervals      []kline.Interval `json:"intervals"`
+	CloseDelay     time.Duration    `json:"closeDelay"`
+	SaveToDatabase bool             `json:"saveToDatabase"`
+	Verbose        bool             `json:"verbose"`
+}
+
+// WithdrawManager defines the configuration for withdrawal approvals, limits
+// and status tracking
+type WithdrawManager struct {
+	// RequiredApprovals is the number of distinct approvers needed before a
+	// withdrawal is submitted to an exchange. Zero disables approvals
+	RequiredApprovals int `json:"requiredApprovals"`
+	// ApprovalThresholds are per currency amounts below which a withdrawal
+	// does not need approval. Currencies without a threshold always need
+	// approval
+	ApprovalThresholds map[string]float64 `json:"approvalThresholds,omitempty"`
+	ApprovalExpiry     time.Duration      `json:"approvalExpiry"`
+	// DailyLimits are the maximum per currency amounts which can be withdrawn
+	// each UTC day, including withdrawals pending approval
+	DailyLimits   map[string]float64    `json:"dailyLimits,omitempty"`
+	StatusTracker WithdrawStatusTracker `json:"statusTracker"`
+	Verbose       bool                  `json:"verbose"`
+}
+
+// LedgerManager defines the configuration for the spot cost basis and PNL
+// ledger
+type LedgerManager struct {
+	Enabled bool `json:"enabled"`
+	// Method is how disposals are matched against lots, either fifo, lifo or
+	// average. Defaults to fifo
+	Method string `json:"method"`
+	// ReportingCurrency is the currency PNL is reported in. Defaults to USD
+	ReportingCurrency string         `json:"reportingCurrency"`
+	Backfill          LedgerBackfill `json:"backfill"`
+	Verbose           bool           `json:"verbose"`
+}
+
+// LedgerBackfill defines how spot order history is loaded from exchanges when
+// the ledger starts
+type LedgerBackfill struct {
+	Enabled bool `json:"enabled"`
+	// Exchanges limits the backfill to the named exchanges. All loaded
+	// exchanges are used when empty
+	Exchanges []string      `json:"exchanges"`
+	Lookback  time.Duration `json:"lookback"`
+}
+
+// RebalanceManager defines the configuration for rebalancing spot holdings
+// across exchanges to target weights
+type RebalanceManager struct {
+	Enabled bool `json:"enabled"`
+	// Interval is how often a rebalance plan is made. Defaults to one hour
+	Interval time.Duration `json:"interval"`
+	// Execute submits scheduled plans through the order manager. Plans are
+	// otherwise held for approval
+	Execute bool `json:"execute"`
+	// ReportingCurrency is the currency holdings are valued in. Defaults to
+	// USD
+	ReportingCurrency string `json:"reportingCurrency"`
+	// DriftTolerance is how far the weight of a holding can drift from its
+	// target, as a fraction of the portfolio value, before holdings are
+	// rebalanced
+	DriftTolerance float64 `json:"driftTolerance"`
+	// MinimumTradeValue is the reporting currency value below which trades
+	// are not planned
+	MinimumTradeValue float64 `json:"minimumTradeValue"`
+	// PlanExpiry is how long a plan can await approval. Defaults to 15
+	// minutes
+	PlanExpiry time.Duration `json:"planExpiry"`
+	// Exchanges limits the exchanges holdings are rebalanced across. All
+	// enabled exchanges which support authenticated requests are used when
+	// empty
+	Exchanges []string          `json:"exchanges"`
+	Targets   []RebalanceTarget `json:"targets"`
+	Verbose   bool              `json:"verbose"`
+}
+
+// RebalanceTarget defines the target weight of a currency across exchanges or,
+// when an exchange is set, on a single exchange
+type RebalanceTarget struct {
+	Currency string  `json:"currency"`
+	Exchange string  `json:"exchange,omitempty"`
+	Weight   float64 `json:"weight"`
+}
+
+// OrderbookCaptureManager defines the configuration for capturing orderbook
+// snapshots and incremental updates to compressed files
+type OrderbookCaptureManager struct {
+	Enabled bool `json:"enabled"`
+	// Directory is where captures are written. Defaults to the
+	// orderbookcapture folder of the data directory
+	Directory string `json:"directory"`
+	// SnapshotInterval is how often the full book is written between updates.
+	// Defaults to one minute
+	SnapshotInterval time.Duration `json:"snapshotInterval"`
+	// MaxFileSize is the compressed size in bytes a file is rotated at.
+	// Defaults to 64MiB
+	MaxFileSize int64 `json:"maxFileSize"`
+	// MaxFileAge is how long a file is written to before it is rotated.
+	// Defaults to one hour
+	MaxFileAge time.Duration            `json:"maxFileAge"`
+	Targets    []OrderbookCaptureTarget `json:"targets"`
+	Verbose    bool                     `json:"verbose"`
+}
+
+// OrderbookCaptureTarget defines an exchange orderbook to capture
+type OrderbookCaptureTarget struct {
+	Exchange string `json:"exchange"`
+	Asset    string `json:"asset"`
+	Pair     string `json:"pair"`
+}
+
+// WithdrawStatusTracker defines how submitted withdrawals are polled for
+// status changes
+type WithdrawStatusTracker struct {
+	Enabled  bool          `json:"enabled"`
+	Interval time.Duration `json:"interval"`
+}
+
 // NTPClientConfig defines a network time protocol configuration to allow for
 // positive and negative differences
 type NTPClientConfig struct {
@@ -240,15 +429,32 @@ type GRPCConfig struct {
 	GRPCProxyListenAddress string `json:"grpcProxyListenAddress"`
 	GRPCAllowBotShutdown   bool   `json:"grpcAllowBotShutdown"`
 	TimeInNanoSeconds      bool   `json:"timeInNanoSeconds"`
+	// ClientCAFile is a PEM certificate authority used to verify client
+	// certificates. Clients presenting a verified certificate are
+	// authenticated as the user matching its common name
+	ClientCAFile             string `json:"clientCAFile,omitempty"`
+	RequireClientCertificate bool   `json:"requireClientCertificate,omitempty"`
 }
 
 // RemoteControlConfig stores the RPC services config
 type RemoteControlConfig struct {
 	Username string     `json:"username"`
 	Password string     `json:"password"`
+	Users    []RPCUser  `json:"users,omitempty"`
 	GRPC     GRPCConfig `json:"gRPC"`
 }
 
+// RPCUser defines a gRPC user, its hashed credentials and the scopes it is
+// permitted to use
+type RPCUser struct {
+	Username string `json:"username"`
+	// PasswordHash is a bcrypt hash of the user's basic auth password
+	PasswordHash string `json:"passwordHash,omitempty"`
+	// TokenHash is a hex encoded SHA-256 hash of the user's bearer token
+	TokenHash string   `json:"tokenHash,omitempty"`
+	Scopes    []string `json:"scopes"`
+}
+
 // Post holds the bot configuration data
 type Post struct {
 	Data Config `json:"data"`
@@ -341,3 +547,17 @@ type Orderbook struct {
 	WebsocketBufferLimit   int  `json:"websocketBufferLimit"`
 	WebsocketBufferEnabled bool `json:"websocketBufferEnabled"`
 }
+
+// secretField is a config value which can reference a secret
+type secretField struct {
+	// id identifies the field independently of its position in the config
+	id    string
+	path  []string
+	value *string
+}
+
+// resolvedSecret holds a secret reference and the value it resolved to
+type resolvedSecret struct {
+	reference string
+	value     string
+}
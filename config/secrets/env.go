@@ -0,0 +1,85 @@
+package secrets
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Name returns the provider name used in secret references
+func (EnvProvider) Name() string {
+	return EnvProviderName
+}
+
+// Secret returns the value of the environment variable named by the path
+func (EnvProvider) Secret(_ context.Context, path string) (string, error) {
+	v, ok := os.LookupEnv(path)
+	if !ok || v == "" {
+		return "", fmt.Errorf("%w: environment variable %s", errSecretNotFound, path)
+	}
+	return v, nil
+}
+
+// Name returns the provider name used in secret references
+func (p *FDProvider) Name() string {
+	return FDProviderName
+}
+
+// Secret returns a secret from a file descriptor using a path in the form
+// <fd>/<name>. The file descriptor is read in full the first time it is used
+func (p *FDProvider) Secret(_ context.Context, path string) (string, error) {
+	fdStr, name, ok := strings.Cut(path, "/")
+	if !ok || name == "" {
+		return "", fmt.Errorf("%w %q: must be in the form <fd>/<name>", errInvalidReference, path)
+	}
+	fd, err := strconv.Atoi(fdStr)
+	if err != nil || fd < 0 {
+		return "", fmt.Errorf("%w: %s", errInvalidFileDescriptor, fdStr)
+	}
+	p.m.Lock()
+	defer p.m.Unlock()
+	secrets, ok := p.secrets[fd]
+	if !ok {
+		f := os.NewFile(uintptr(fd), "fd"+fdStr)
+		if f == nil {
+			return "", fmt.Errorf("%w: %d", errInvalidFileDescriptor, fd)
+		}
+		secrets, err = parseSecretLines(f)
+		_ = f.Close()
+		if err != nil {
+			return "", fmt.Errorf("%w %d: %w", errInvalidFileDescriptor, fd, err)
+		}
+		if p.secrets == nil {
+			p.secrets = make(map[int]map[string]string)
+		}
+		p.secrets[fd] = secrets
+	}
+	v, ok := secrets[name]
+	if !ok || v == "" {
+		return "", fmt.Errorf("%w: %s in file descriptor %d", errSecretNotFound, name, fd)
+	}
+	return v, nil
+}
+
+// parseSecretLines parses name=value lines, ignoring blank lines and lines
+// starting with #
+func parseSecretLines(r io.Reader) (map[string]string, error) {
+	secrets := make(map[string]string)
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		line := strings.TrimSpace(s.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		name, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return nil, errInvalidSecretLine
+		}
+		secrets[strings.TrimSpace(name)] = strings.TrimSpace(value)
+	}
+	return secrets, s.Err()
+}
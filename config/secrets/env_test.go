@@ -0,0 +1,48 @@
+package secrets
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnvProvider(t *testing.T) {
+	t.Setenv("GCT_SECRETS_TEST_ENV", "value")
+	t.Setenv("GCT_SECRETS_TEST_EMPTY", "")
+	var p EnvProvider
+	assert.Equal(t, EnvProviderName, p.Name())
+	v, err := p.Secret(t.Context(), "GCT_SECRETS_TEST_ENV")
+	require.NoError(t, err, "Secret must not error")
+	assert.Equal(t, "value", v)
+	_, err = p.Secret(t.Context(), "GCT_SECRETS_TEST_EMPTY")
+	assert.ErrorIs(t, err, errSecretNotFound, "empty environment variables should error")
+}
+
+func TestFDProvider(t *testing.T) {
+	t.Parallel()
+	p := &FDProvider{secrets: map[int]map[string]string{1337: {"key": "value"}}}
+	assert.Equal(t, FDProviderName, p.Name())
+	_, err := p.Secret(t.Context(), "1337")
+	assert.ErrorIs(t, err, errInvalidReference)
+	_, err = p.Secret(t.Context(), "fd/key")
+	assert.ErrorIs(t, err, errInvalidFileDescriptor)
+	_, err = p.Secret(t.Context(), "-1/key")
+	assert.ErrorIs(t, err, errInvalidFileDescriptor)
+	_, err = p.Secret(t.Context(), "1337/missing")
+	assert.ErrorIs(t, err, errSecretNotFound)
+	v, err := p.Secret(t.Context(), "1337/key")
+	require.NoError(t, err, "Secret must not error")
+	assert.Equal(t, "value", v, "read file descriptors should be cached")
+}
+
+func TestParseSecretLines(t *testing.T) {
+	t.Parallel()
+	s, err := parseSecretLines(strings.NewReader("# comment\n\nbinance_key = abc\nbinance_secret=d=e\n"))
+	require.NoError(t, err, "parseSecretLines must not error")
+	assert.Equal(t, map[string]string{"binance_key": "abc", "binance_secret": "d=e"}, s)
+
+	_, err = parseSecretLines(strings.NewReader("novalue"))
+	assert.ErrorIs(t, err, errInvalidSecretLine)
+}
@@ -0,0 +1,125 @@
+package secrets
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+	"github.com/ProtonMail/go-crypto/openpgp/packet"
+	"github.com/thrasher-corp/gocryptotrader/encoding/json"
+)
+
+const keyringArmorType = "PGP MESSAGE"
+
+// keyringConfig encrypts keyrings with AES-256 and rejects messages without
+// integrity protection, so a tampered keyring fails to decrypt
+var keyringConfig = &packet.Config{
+	DefaultCipher:                        packet.CipherAES256,
+	InsecureAllowUnauthenticatedMessages: false,
+}
+
+// NewKeyringProvider returns a KeyringProvider for the keyring file at the path
+func NewKeyringProvider(path string, passphrase []byte) (*KeyringProvider, error) {
+	if path == "" {
+		return nil, errKeyringPathUnset
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	secrets, err := ReadKeyring(f, passphrase)
+	if err != nil {
+		return nil, fmt.Errorf("keyring %s: %w", path, err)
+	}
+	return &KeyringProvider{secrets: secrets}, nil
+}
+
+// Name returns the provider name used in secret references
+func (p *KeyringProvider) Name() string {
+	return KeyringProviderName
+}
+
+// Secret returns the keyring secret with the name of the path
+func (p *KeyringProvider) Secret(_ context.Context, path string) (string, error) {
+	v, ok := p.secrets[path]
+	if !ok || v == "" {
+		return "", fmt.Errorf("%w: %s in keyring", errSecretNotFound, path)
+	}
+	return v, nil
+}
+
+// ReadKeyring decrypts an OpenPGP symmetrically encrypted keyring, armored or
+// binary, containing a JSON object of secret names to values. Keyrings created
+// with `gpg --symmetric` are supported. Keyrings without integrity protection
+// or which fail the integrity check are rejected
+func ReadKeyring(r io.Reader, passphrase []byte) (map[string]string, error) {
+	if len(passphrase) == 0 {
+		return nil, errKeyringPassphraseEmpty
+	}
+	br := bufio.NewReader(r)
+	var body io.Reader = br
+	if prefix, _ := br.Peek(len("-----BEGIN")); bytes.Equal(prefix, []byte("-----BEGIN")) {
+		block, err := armor.Decode(br)
+		if err != nil {
+			return nil, err
+		}
+		body = block.Body
+	}
+	var attempted bool
+	md, err := openpgp.ReadMessage(body, nil, func([]openpgp.Key, bool) ([]byte, error) {
+		// ReadMessage prompts until decryption succeeds, so only the supplied
+		// passphrase is attempted
+		if attempted {
+			return nil, errKeyringPassphraseFailed
+		}
+		attempted = true
+		return passphrase, nil
+	}, keyringConfig)
+	if err != nil {
+		return nil, err
+	}
+	// The integrity check is made once the body has been read in full, so no
+	// secrets are returned unless the whole keyring is read without error
+	data, err := io.ReadAll(md.UnverifiedBody)
+	if err != nil {
+		return nil, err
+	}
+	secrets := make(map[string]string)
+	if err := json.Unmarshal(data, &secrets); err != nil {
+		return nil, err
+	}
+	return secrets, nil
+}
+
+// WriteKeyring writes the secrets as an armored OpenPGP message symmetrically
+// encrypted with the passphrase
+func WriteKeyring(w io.Writer, passphrase []byte, secrets map[string]string) error {
+	if len(passphrase) == 0 {
+		return errKeyringPassphraseEmpty
+	}
+	data, err := json.Marshal(secrets)
+	if err != nil {
+		return err
+	}
+	aw, err := armor.Encode(w, keyringArmorType, nil)
+	if err != nil {
+		return err
+	}
+	pw, err := openpgp.SymmetricallyEncrypt(aw, passphrase, nil, keyringConfig)
+	if err != nil {
+		return err
+	}
+	if _, err := pw.Write(data); err != nil {
+		return err
+	}
+	if err := pw.Close(); err != nil {
+		return err
+	}
+	return aw.Close()
+}
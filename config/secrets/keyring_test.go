@@ -0,0 +1,113 @@
+package secrets
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/rand"
+	"crypto/sha256"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+	"github.com/ProtonMail/go-crypto/openpgp/packet"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadWriteKeyring(t *testing.T) {
+	t.Parallel()
+	var buf bytes.Buffer
+	assert.ErrorIs(t, WriteKeyring(&buf, nil, nil), errKeyringPassphraseEmpty)
+
+	secrets := map[string]string{"binance/key": "abc", "binance/secret": "def"}
+	require.NoError(t, WriteKeyring(&buf, []byte("hunter2"), secrets), "WriteKeyring must not error")
+	assert.True(t, bytes.HasPrefix(buf.Bytes(), []byte("-----BEGIN PGP MESSAGE-----")), "keyring should be armored")
+	assert.NotContains(t, buf.String(), "abc", "keyring must be encrypted")
+
+	_, err := ReadKeyring(bytes.NewReader(buf.Bytes()), nil)
+	assert.ErrorIs(t, err, errKeyringPassphraseEmpty)
+
+	_, err = ReadKeyring(bytes.NewReader(buf.Bytes()), []byte("hunter3"))
+	assert.ErrorIs(t, err, errKeyringPassphraseFailed)
+
+	got, err := ReadKeyring(bytes.NewReader(buf.Bytes()), []byte("hunter2"))
+	require.NoError(t, err, "ReadKeyring must not error")
+	assert.Equal(t, secrets, got)
+
+	var binary bytes.Buffer
+	w, err := openpgp.SymmetricallyEncrypt(&binary, []byte("hunter2"), nil, &packet.Config{})
+	require.NoError(t, err, "SymmetricallyEncrypt must not error")
+	_, err = w.Write([]byte(`{"key":"value"}`))
+	require.NoError(t, err, "Write must not error")
+	require.NoError(t, w.Close(), "Close must not error")
+	got, err = ReadKeyring(&binary, []byte("hunter2"))
+	require.NoError(t, err, "ReadKeyring must not error for binary keyrings")
+	assert.Equal(t, map[string]string{"key": "value"}, got)
+
+	block, err := armor.Decode(bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err, "armor Decode must not error")
+	var tampered bytes.Buffer
+	_, err = tampered.ReadFrom(block.Body)
+	require.NoError(t, err, "ReadFrom must not error")
+	tampered.Bytes()[tampered.Len()-30] ^= 0xff
+	_, err = ReadKeyring(&tampered, []byte("hunter2"))
+	assert.Error(t, err, "ReadKeyring should reject tampered keyrings")
+
+	_, err = ReadKeyring(bytes.NewReader(unprotectedKeyring(t, []byte("hunter2"), []byte(`{"key":"value"}`))), []byte("hunter2"))
+	assert.ErrorContains(t, err, "not integrity protected", "ReadKeyring should reject keyrings without integrity protection")
+}
+
+// unprotectedKeyring returns a legacy symmetrically encrypted OpenPGP message
+// without a modification detection code
+func unprotectedKeyring(t *testing.T, passphrase, data []byte) []byte {
+	t.Helper()
+	salt := make([]byte, 8)
+	_, err := rand.Read(salt)
+	require.NoError(t, err, "rand Read must not error")
+	key := sha256.Sum256(append(salt, passphrase...))
+	c, err := aes.NewCipher(key[:])
+	require.NoError(t, err, "NewCipher must not error")
+
+	literal := append([]byte{'b', 0, 0, 0, 0, 0}, data...)
+	plaintext := append([]byte{0xc0 | 11, byte(len(literal))}, literal...)
+	prefix := make([]byte, c.BlockSize())
+	_, err = rand.Read(prefix)
+	require.NoError(t, err, "rand Read must not error")
+	stream, encryptedPrefix := packet.NewOCFBEncrypter(c, prefix, packet.OCFBResync)
+	ciphertext := make([]byte, len(plaintext))
+	stream.XORKeyStream(ciphertext, plaintext)
+	encrypted := append(encryptedPrefix, ciphertext...)
+
+	// Version 4 symmetric key encrypted session key using AES-256 and a salted
+	// SHA-256 string-to-key
+	sessionKey := append([]byte{4, 9, 1, 8}, salt...)
+	msg := append([]byte{0xc0 | 3, byte(len(sessionKey))}, sessionKey...)
+	msg = append(msg, 0xc0|9, byte(len(encrypted)))
+	return append(msg, encrypted...)
+}
+
+func TestKeyringProvider(t *testing.T) {
+	t.Parallel()
+	_, err := NewKeyringProvider("", []byte("hunter2"))
+	assert.ErrorIs(t, err, errKeyringPathUnset)
+
+	path := filepath.Join(t.TempDir(), "keyring.asc")
+	_, err = NewKeyringProvider(path, []byte("hunter2"))
+	assert.ErrorIs(t, err, os.ErrNotExist)
+
+	f, err := os.Create(path)
+	require.NoError(t, err, "Create must not error")
+	require.NoError(t, WriteKeyring(f, []byte("hunter2"), map[string]string{"key": "value"}), "WriteKeyring must not error")
+	require.NoError(t, f.Close(), "Close must not error")
+
+	p, err := NewKeyringProvider(path, []byte("hunter2"))
+	require.NoError(t, err, "NewKeyringProvider must not error")
+	assert.Equal(t, KeyringProviderName, p.Name())
+	v, err := p.Secret(t.Context(), "key")
+	require.NoError(t, err, "Secret must not error")
+	assert.Equal(t, "value", v)
+	_, err = p.Secret(t.Context(), "missing")
+	assert.ErrorIs(t, err, errSecretNotFound)
+}
@@ -0,0 +1,114 @@
+package secrets
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// defaultFDProvider is shared between resolvers as file descriptors can only
+// be read once
+var defaultFDProvider = &FDProvider{}
+
+// IsReference returns whether a config value references a secret
+func IsReference(value string) bool {
+	return strings.HasPrefix(value, ReferencePrefix)
+}
+
+// ParseReference parses a secret reference in the form
+// secret://<provider>/<path>
+func ParseReference(value string) (Reference, error) {
+	if !IsReference(value) {
+		return Reference{}, errNotAReference
+	}
+	provider, path, ok := strings.Cut(strings.TrimPrefix(value, ReferencePrefix), "/")
+	if !ok || provider == "" || path == "" {
+		return Reference{}, fmt.Errorf("%w %q: must be in the form %s<provider>/<path>", errInvalidReference, value, ReferencePrefix)
+	}
+	return Reference{Provider: strings.ToLower(provider), Path: path}, nil
+}
+
+// String returns the reference in the form secret://<provider>/<path>
+func (r Reference) String() string {
+	return ReferencePrefix + r.Provider + "/" + r.Path
+}
+
+// NewResolver returns a Resolver for the env and fd providers along with any
+// providers set up in the config. The keyring passphrase and vault token are
+// resolved when the resolver is created
+func NewResolver(ctx context.Context, cfg *Config) (*Resolver, error) {
+	r := &Resolver{providers: make(map[string]Provider)}
+	r.AddProvider(EnvProvider{})
+	r.AddProvider(defaultFDProvider)
+	if cfg == nil {
+		return r, nil
+	}
+	if cfg.Keyring != nil {
+		passphrase := cfg.Keyring.Passphrase
+		if passphrase == "" {
+			passphrase = defaultKeyringPassphrase
+		}
+		pass, err := r.resolveProviderSecret(ctx, "keyring passphrase", passphrase, EnvProviderName, FDProviderName)
+		if err != nil {
+			return nil, err
+		}
+		k, err := NewKeyringProvider(cfg.Keyring.Path, []byte(pass))
+		if err != nil {
+			return nil, err
+		}
+		r.AddProvider(k)
+	}
+	if cfg.Vault != nil {
+		token := cfg.Vault.Token
+		if token == "" {
+			token = defaultVaultToken
+		}
+		tok, err := r.resolveProviderSecret(ctx, "vault token", token, EnvProviderName, FDProviderName, KeyringProviderName)
+		if err != nil {
+			return nil, err
+		}
+		v, err := NewVaultProvider(cfg.Vault, tok)
+		if err != nil {
+			return nil, err
+		}
+		r.AddProvider(v)
+	}
+	return r, nil
+}
+
+// AddProvider adds or replaces a provider used to resolve references
+func (r *Resolver) AddProvider(p Provider) {
+	r.providers[p.Name()] = p
+}
+
+// Resolve returns the secret referenced by the value
+func (r *Resolver) Resolve(ctx context.Context, value string) (string, error) {
+	ref, err := ParseReference(value)
+	if err != nil {
+		return "", err
+	}
+	p, ok := r.providers[ref.Provider]
+	if !ok {
+		return "", fmt.Errorf("%w: %s", errProviderNotConfigured, ref.Provider)
+	}
+	secret, err := p.Secret(ctx, ref.Path)
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", ref, err)
+	}
+	return secret, nil
+}
+
+// resolveProviderSecret resolves a secret required to set up a provider,
+// ensuring it is referenced from one of the allowed providers
+func (r *Resolver) resolveProviderSecret(ctx context.Context, name, value string, allowed ...string) (string, error) {
+	ref, err := ParseReference(value)
+	if err != nil {
+		return "", fmt.Errorf("%s %w", name, errInlineSecret)
+	}
+	for _, a := range allowed {
+		if ref.Provider == a {
+			return r.Resolve(ctx, value)
+		}
+	}
+	return "", fmt.Errorf("%s %w: must use one of %s", name, errInvalidReference, strings.Join(allowed, ", "))
+}
@@ -0,0 +1,94 @@
+package secrets
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseReference(t *testing.T) {
+	t.Parallel()
+	_, err := ParseReference("inline")
+	assert.ErrorIs(t, err, errNotAReference)
+
+	for _, v := range []string{"secret://", "secret://env", "secret://env/", "secret:///path"} {
+		_, err = ParseReference(v)
+		assert.ErrorIsf(t, err, errInvalidReference, "ParseReference should error for %q", v)
+	}
+
+	ref, err := ParseReference("secret://Vault/gct/binance#key")
+	require.NoError(t, err, "ParseReference must not error")
+	assert.Equal(t, VaultProviderName, ref.Provider, "provider should be lowercased")
+	assert.Equal(t, "gct/binance#key", ref.Path)
+	assert.Equal(t, "secret://vault/gct/binance#key", ref.String())
+	assert.True(t, IsReference(ref.String()))
+	assert.False(t, IsReference("key"))
+}
+
+func TestNewResolver(t *testing.T) {
+	t.Setenv("GCT_SECRETS_TEST_PASSPHRASE", "hunter2")
+	t.Setenv("GCT_SECRETS_TEST_KEY", "envkey")
+
+	r, err := NewResolver(t.Context(), nil)
+	require.NoError(t, err, "NewResolver must not error")
+	v, err := r.Resolve(t.Context(), "secret://env/GCT_SECRETS_TEST_KEY")
+	require.NoError(t, err, "Resolve must not error")
+	assert.Equal(t, "envkey", v)
+
+	_, err = r.Resolve(t.Context(), "key")
+	assert.ErrorIs(t, err, errNotAReference)
+	_, err = r.Resolve(t.Context(), "secret://keyring/key")
+	assert.ErrorIs(t, err, errProviderNotConfigured)
+	_, err = r.Resolve(t.Context(), "secret://env/GCT_SECRETS_TEST_MISSING")
+	assert.ErrorIs(t, err, errSecretNotFound)
+
+	path := filepath.Join(t.TempDir(), "keyring.asc")
+	f, err := os.Create(path)
+	require.NoError(t, err, "Create must not error")
+	require.NoError(t, WriteKeyring(f, []byte("hunter2"), map[string]string{"binance/key": "keyringkey"}), "WriteKeyring must not error")
+	require.NoError(t, f.Close(), "Close must not error")
+
+	_, err = NewResolver(t.Context(), &Config{Keyring: &KeyringConfig{Path: path, Passphrase: "hunter2"}})
+	assert.ErrorIs(t, err, errInlineSecret, "inline keyring passphrases should not be allowed")
+
+	_, err = NewResolver(t.Context(), &Config{Keyring: &KeyringConfig{Path: path, Passphrase: "secret://vault/passphrase"}})
+	assert.ErrorIs(t, err, errInvalidReference, "keyring passphrases should not be referenced from the vault")
+
+	_, err = NewResolver(t.Context(), &Config{Keyring: &KeyringConfig{Path: path}})
+	assert.ErrorIs(t, err, errSecretNotFound, "default keyring passphrase should be read from the environment")
+
+	r, err = NewResolver(t.Context(), &Config{Keyring: &KeyringConfig{Path: path, Passphrase: "secret://env/GCT_SECRETS_TEST_PASSPHRASE"}})
+	require.NoError(t, err, "NewResolver must not error")
+	v, err = r.Resolve(t.Context(), "secret://keyring/binance/key")
+	require.NoError(t, err, "Resolve must not error")
+	assert.Equal(t, "keyringkey", v)
+
+	_, err = NewResolver(t.Context(), &Config{Vault: &VaultConfig{Address: "http://localhost:8200", Token: "secret://env/GCT_SECRETS_TEST_KEY"}})
+	require.NoError(t, err, "NewResolver must not error")
+}
+
+type staticProvider map[string]string
+
+func (staticProvider) Name() string { return "static" }
+
+func (s staticProvider) Secret(_ context.Context, path string) (string, error) {
+	v, ok := s[path]
+	if !ok {
+		return "", errSecretNotFound
+	}
+	return v, nil
+}
+
+func TestResolverAddProvider(t *testing.T) {
+	t.Parallel()
+	r, err := NewResolver(t.Context(), nil)
+	require.NoError(t, err, "NewResolver must not error")
+	r.AddProvider(staticProvider{"a/b": "c"})
+	v, err := r.Resolve(t.Context(), "secret://static/a/b")
+	require.NoError(t, err, "Resolve must not error")
+	assert.Equal(t, "c", v)
+}
@@ -0,0 +1,121 @@
+package secrets
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// ReferencePrefix prefixes a config value which references a secret stored by
+// a provider instead of holding the secret inline
+const ReferencePrefix = "secret://"
+
+// Provider names used in secret references
+const (
+	EnvProviderName     = "env"
+	FDProviderName      = "fd"
+	KeyringProviderName = "keyring"
+	VaultProviderName   = "vault"
+)
+
+const (
+	defaultKeyringPassphrase = ReferencePrefix + EnvProviderName + "/GCT_KEYRING_PASSPHRASE"
+	defaultVaultToken        = ReferencePrefix + EnvProviderName + "/VAULT_TOKEN"
+	defaultVaultMount        = "secret"
+	defaultVaultField        = "value"
+	defaultVaultTimeout      = 10 * time.Second
+)
+
+var (
+	errNotAReference           = errors.New("value is not a secret reference")
+	errInvalidReference        = errors.New("invalid secret reference")
+	errProviderNotConfigured   = errors.New("secret provider not configured")
+	errSecretNotFound          = errors.New("secret not found")
+	errInlineSecret            = errors.New("must be a secret reference rather than an inline secret")
+	errInvalidFileDescriptor   = errors.New("invalid file descriptor")
+	errInvalidSecretLine       = errors.New("invalid secret line, must be in the form name=value")
+	errKeyringPathUnset        = errors.New("keyring path not set")
+	errKeyringPassphraseEmpty  = errors.New("keyring passphrase is empty")
+	errKeyringPassphraseFailed = errors.New("keyring passphrase incorrect")
+	errVaultAddressUnset       = errors.New("vault address not set")
+	errVaultTokenEmpty         = errors.New("vault token is empty")
+	errUnexpectedStatusCode    = errors.New("unexpected status code")
+)
+
+// Provider retrieves secrets from a backend
+type Provider interface {
+	// Name returns the provider name used in secret references
+	Name() string
+	// Secret returns the secret stored at the path
+	Secret(ctx context.Context, path string) (string, error)
+}
+
+// Config holds the settings for secret providers which require setup. The
+// env and fd providers are always available
+type Config struct {
+	Keyring *KeyringConfig `json:"keyring,omitempty"`
+	Vault   *VaultConfig   `json:"vault,omitempty"`
+}
+
+// KeyringConfig holds the settings for an OpenPGP encrypted keyring file
+type KeyringConfig struct {
+	Path string `json:"path"`
+	// Passphrase must reference an env or fd secret. Defaults to the
+	// GCT_KEYRING_PASSPHRASE environment variable
+	Passphrase string `json:"passphrase,omitempty"`
+}
+
+// VaultConfig holds the settings for a HashiCorp Vault compatible KV version 2
+// secrets engine
+type VaultConfig struct {
+	Address   string `json:"address"`
+	Mount     string `json:"mount,omitempty"`
+	Namespace string `json:"namespace,omitempty"`
+	// Token must reference an env, fd or keyring secret. Defaults to the
+	// VAULT_TOKEN environment variable
+	Token   string        `json:"token,omitempty"`
+	Timeout time.Duration `json:"timeout,omitempty"`
+}
+
+// Reference is a parsed secret reference in the form
+// secret://<provider>/<path>
+type Reference struct {
+	Provider string
+	Path     string
+}
+
+// Resolver resolves secret references using its configured providers
+type Resolver struct {
+	providers map[string]Provider
+}
+
+// EnvProvider returns secrets from environment variables
+type EnvProvider struct{}
+
+// FDProvider returns secrets read from inherited file descriptors. Each file
+// descriptor is read once and must contain name=value lines
+type FDProvider struct {
+	m       sync.Mutex
+	secrets map[int]map[string]string
+}
+
+// KeyringProvider returns secrets from an OpenPGP symmetrically encrypted
+// keyring file containing a JSON object of secret names to values
+type KeyringProvider struct {
+	secrets map[string]string
+}
+
+// VaultProvider returns secrets from a HashiCorp Vault compatible KV version 2
+// secrets engine
+type VaultProvider struct {
+	address   string
+	mount     string
+	namespace string
+	token     string
+	client    *http.Client
+
+	m     sync.Mutex
+	cache map[string]map[string]any
+}
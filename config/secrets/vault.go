@@ -0,0 +1,108 @@
+package secrets
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/thrasher-corp/gocryptotrader/encoding/json"
+)
+
+// NewVaultProvider returns a VaultProvider for the config authenticating with
+// the token
+func NewVaultProvider(cfg *VaultConfig, token string) (*VaultProvider, error) {
+	if cfg == nil || cfg.Address == "" {
+		return nil, errVaultAddressUnset
+	}
+	if token == "" {
+		return nil, errVaultTokenEmpty
+	}
+	if _, err := url.ParseRequestURI(cfg.Address); err != nil {
+		return nil, err
+	}
+	mount := strings.Trim(cfg.Mount, "/")
+	if mount == "" {
+		mount = defaultVaultMount
+	}
+	timeout := cfg.Timeout
+	if timeout <= 0 {
+		timeout = defaultVaultTimeout
+	}
+	return &VaultProvider{
+		address:   strings.TrimRight(cfg.Address, "/"),
+		mount:     mount,
+		namespace: cfg.Namespace,
+		token:     token,
+		client:    &http.Client{Timeout: timeout},
+		cache:     make(map[string]map[string]any),
+	}, nil
+}
+
+// Name returns the provider name used in secret references
+func (p *VaultProvider) Name() string {
+	return VaultProviderName
+}
+
+// Secret returns a field of a KV version 2 secret using a path in the form
+// <secret path>#<field>. The field defaults to "value" when not set
+func (p *VaultProvider) Secret(ctx context.Context, path string) (string, error) {
+	secretPath, field, _ := strings.Cut(path, "#")
+	secretPath = strings.Trim(secretPath, "/")
+	if secretPath == "" {
+		return "", fmt.Errorf("%w %q: must be in the form <path>#<field>", errInvalidReference, path)
+	}
+	if field == "" {
+		field = defaultVaultField
+	}
+	data, err := p.getSecret(ctx, secretPath)
+	if err != nil {
+		return "", err
+	}
+	v, ok := data[field].(string)
+	if !ok || v == "" {
+		return "", fmt.Errorf("%w: field %s of vault secret %s", errSecretNotFound, field, secretPath)
+	}
+	return v, nil
+}
+
+// getSecret returns the data of a KV version 2 secret, caching it so that a
+// secret holding several fields is only fetched once
+func (p *VaultProvider) getSecret(ctx context.Context, secretPath string) (map[string]any, error) {
+	p.m.Lock()
+	defer p.m.Unlock()
+	if data, ok := p.cache[secretPath]; ok {
+		return data, nil
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.address+"/v1/"+p.mount+"/data/"+secretPath, http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("X-Vault-Token", p.token)
+	if p.namespace != "" {
+		req.Header.Set("X-Vault-Namespace", p.namespace)
+	}
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil, fmt.Errorf("%w: vault secret %s", errSecretNotFound, secretPath)
+	default:
+		return nil, fmt.Errorf("%w %d fetching vault secret %s", errUnexpectedStatusCode, resp.StatusCode, secretPath)
+	}
+	var body struct {
+		Data struct {
+			Data map[string]any `json:"data"`
+		} `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return nil, err
+	}
+	p.cache[secretPath] = body.Data.Data
+	return body.Data.Data, nil
+}
@@ -0,0 +1,95 @@
+package secrets
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newVaultStandIn returns a server which serves KV version 2 secrets in the
+// same format as Vault
+func newVaultStandIn(t *testing.T, requests *atomic.Int32) *httptest.Server {
+	t.Helper()
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		if r.Header.Get("X-Vault-Token") != "root" || r.Header.Get("X-Vault-Namespace") != "gct" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		switch r.URL.Path {
+		case "/v1/kv/data/exchanges/binance":
+			_, err := w.Write([]byte(`{"data":{"data":{"key":"abc","secret":"def","value":"ghi"},"metadata":{"version":1}}}`))
+			assert.NoError(t, err)
+		case "/v1/kv/data/broken":
+			w.WriteHeader(http.StatusInternalServerError)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func TestNewVaultProvider(t *testing.T) {
+	t.Parallel()
+	_, err := NewVaultProvider(nil, "root")
+	assert.ErrorIs(t, err, errVaultAddressUnset)
+	_, err = NewVaultProvider(&VaultConfig{Address: "http://localhost:8200"}, "")
+	assert.ErrorIs(t, err, errVaultTokenEmpty)
+	_, err = NewVaultProvider(&VaultConfig{Address: "localhost"}, "root")
+	assert.Error(t, err, "NewVaultProvider should error on an invalid address")
+
+	p, err := NewVaultProvider(&VaultConfig{Address: "http://localhost:8200/"}, "root")
+	require.NoError(t, err, "NewVaultProvider must not error")
+	assert.Equal(t, VaultProviderName, p.Name())
+	assert.Equal(t, "http://localhost:8200", p.address)
+	assert.Equal(t, defaultVaultMount, p.mount)
+	assert.Equal(t, defaultVaultTimeout, p.client.Timeout)
+}
+
+func TestVaultProviderSecret(t *testing.T) {
+	t.Parallel()
+	var requests atomic.Int32
+	s := newVaultStandIn(t, &requests)
+
+	p, err := NewVaultProvider(&VaultConfig{Address: s.URL, Mount: "/kv/", Namespace: "gct"}, "root")
+	require.NoError(t, err, "NewVaultProvider must not error")
+
+	v, err := p.Secret(t.Context(), "exchanges/binance#key")
+	require.NoError(t, err, "Secret must not error")
+	assert.Equal(t, "abc", v)
+	v, err = p.Secret(t.Context(), "/exchanges/binance#secret")
+	require.NoError(t, err, "Secret must not error")
+	assert.Equal(t, "def", v)
+	v, err = p.Secret(t.Context(), "exchanges/binance")
+	require.NoError(t, err, "Secret must not error")
+	assert.Equal(t, "ghi", v, "field should default to value")
+	assert.Equal(t, int32(1), requests.Load(), "secrets should be cached")
+
+	_, err = p.Secret(t.Context(), "exchanges/binance#missing")
+	assert.ErrorIs(t, err, errSecretNotFound)
+	_, err = p.Secret(t.Context(), "#key")
+	assert.ErrorIs(t, err, errInvalidReference)
+	_, err = p.Secret(t.Context(), "exchanges/kraken#key")
+	assert.ErrorIs(t, err, errSecretNotFound)
+	_, err = p.Secret(t.Context(), "broken#key")
+	assert.ErrorIs(t, err, errUnexpectedStatusCode)
+
+	p, err = NewVaultProvider(&VaultConfig{Address: s.URL, Mount: "kv", Namespace: "gct"}, "wrong")
+	require.NoError(t, err, "NewVaultProvider must not error")
+	_, err = p.Secret(t.Context(), "exchanges/binance#key")
+	assert.ErrorIs(t, err, errUnexpectedStatusCode, "invalid tokens should error")
+
+	r, err := NewResolver(t.Context(), nil)
+	require.NoError(t, err, "NewResolver must not error")
+	p, err = NewVaultProvider(&VaultConfig{Address: s.URL, Mount: "kv", Namespace: "gct"}, "root")
+	require.NoError(t, err, "NewVaultProvider must not error")
+	r.AddProvider(p)
+	v, err = r.Resolve(t.Context(), "secret://vault/exchanges/binance#key")
+	require.NoError(t, err, "Resolve must not error")
+	assert.Equal(t, "abc", v)
+}
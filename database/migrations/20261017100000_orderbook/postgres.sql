@@ -0,0 +1,18 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS orderbook
+(
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    exchange_name_id uuid REFERENCES exchange(id) NOT NULL,
+    base varchar(30) NOT NULL,
+    quote varchar(30) NOT NULL,
+    asset varchar NOT NULL,
+    update_id BIGINT NOT NULL,
+    snapshot BOOLEAN NOT NULL,
+    bids TEXT NOT NULL,
+    asks TEXT NOT NULL,
+    timestamp TIMESTAMPTZ NOT NULL,
+    CONSTRAINT uniqueorderbook
+        unique(exchange_name_id, base, quote, asset, timestamp, update_id)
+);
+-- +goose Down
+DROP TABLE orderbook;
@@ -0,0 +1,18 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS orderbook
+(
+    id text not null primary key,
+    exchange_name_id uuid REFERENCES exchange(id) NOT NULL,
+    base text NOT NULL,
+    quote text NOT NULL,
+    asset TEXT NOT NULL,
+    update_id INTEGER NOT NULL,
+    snapshot BOOLEAN NOT NULL,
+    bids TEXT NOT NULL,
+    asks TEXT NOT NULL,
+    timestamp TIMESTAMP NOT NULL,
+    CONSTRAINT uniqueorderbook
+        unique(exchange_name_id, base, quote, asset, timestamp, update_id) ON CONFLICT IGNORE
+);
+-- +goose Down
+DROP TABLE orderbook;
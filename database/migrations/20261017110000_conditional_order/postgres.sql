@@ -0,0 +1,27 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS conditional_order
+(
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    exchange_name_id uuid REFERENCES exchange(id) NOT NULL,
+    base varchar(30) NOT NULL,
+    quote varchar(30) NOT NULL,
+    asset varchar NOT NULL,
+    order_type varchar NOT NULL,
+    side varchar NOT NULL,
+    amount DOUBLE PRECISION NOT NULL,
+    trigger_price DOUBLE PRECISION NOT NULL,
+    limit_price DOUBLE PRECISION NOT NULL,
+    take_profit_price DOUBLE PRECISION NOT NULL,
+    entry_price DOUBLE PRECISION NOT NULL,
+    trailing_amount DOUBLE PRECISION NOT NULL,
+    trailing_percent DOUBLE PRECISION NOT NULL,
+    reference_price DOUBLE PRECISION NOT NULL,
+    entry_order_id TEXT NOT NULL,
+    order_id TEXT NOT NULL,
+    status varchar NOT NULL,
+    failure_reason TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL
+);
+-- +goose Down
+DROP TABLE conditional_order;
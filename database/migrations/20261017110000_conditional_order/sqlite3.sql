@@ -0,0 +1,29 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS conditional_order
+(
+    id text NOT NULL primary key,
+    exchange_name_id text NOT NULL,
+    base text NOT NULL,
+    quote text NOT NULL,
+    asset text NOT NULL,
+    order_type text NOT NULL,
+    side text NOT NULL,
+    amount real NOT NULL,
+    trigger_price real NOT NULL,
+    limit_price real NOT NULL,
+    take_profit_price real NOT NULL,
+    entry_price real NOT NULL,
+    trailing_amount real NOT NULL,
+    trailing_percent real NOT NULL,
+    reference_price real NOT NULL,
+    entry_order_id text NOT NULL,
+    order_id text NOT NULL,
+    status text NOT NULL,
+    failure_reason text NOT NULL,
+    created_at timestamp NOT NULL,
+    updated_at timestamp NOT NULL,
+    FOREIGN KEY(exchange_name_id) REFERENCES exchange(id) ON DELETE RESTRICT,
+    UNIQUE(id) ON CONFLICT REPLACE
+);
+-- +goose Down
+DROP TABLE conditional_order;
@@ -0,0 +1,60 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS managed_order
+(
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    exchange_name_id uuid REFERENCES exchange(id) NOT NULL,
+    order_id TEXT NOT NULL,
+    client_order_id TEXT NOT NULL,
+    client_id TEXT NOT NULL,
+    account_id TEXT NOT NULL,
+    base varchar(30) NOT NULL,
+    quote varchar(30) NOT NULL,
+    asset varchar NOT NULL,
+    order_type varchar NOT NULL,
+    side varchar NOT NULL,
+    status varchar NOT NULL,
+    time_in_force varchar NOT NULL,
+    margin_type varchar NOT NULL,
+    hidden_order BOOLEAN NOT NULL,
+    reduce_only BOOLEAN NOT NULL,
+    leverage DOUBLE PRECISION NOT NULL,
+    price DOUBLE PRECISION NOT NULL,
+    amount DOUBLE PRECISION NOT NULL,
+    contract_amount DOUBLE PRECISION NOT NULL,
+    quote_amount DOUBLE PRECISION NOT NULL,
+    limit_price_upper DOUBLE PRECISION NOT NULL,
+    limit_price_lower DOUBLE PRECISION NOT NULL,
+    trigger_price DOUBLE PRECISION NOT NULL,
+    average_executed_price DOUBLE PRECISION NOT NULL,
+    executed_amount DOUBLE PRECISION NOT NULL,
+    remaining_amount DOUBLE PRECISION NOT NULL,
+    cost DOUBLE PRECISION NOT NULL,
+    cost_asset varchar(30) NOT NULL,
+    fee DOUBLE PRECISION NOT NULL,
+    fee_asset varchar(30) NOT NULL,
+    settlement_currency varchar(30) NOT NULL,
+    date TIMESTAMPTZ NOT NULL,
+    close_time TIMESTAMPTZ NOT NULL,
+    last_updated TIMESTAMPTZ NOT NULL
+);
+CREATE INDEX managed_order_exchange_status_idx ON managed_order(exchange_name_id, status);
+
+CREATE TABLE IF NOT EXISTS managed_order_trade
+(
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    managed_order_id uuid REFERENCES managed_order(id) ON DELETE CASCADE NOT NULL,
+    tid TEXT NOT NULL,
+    price DOUBLE PRECISION NOT NULL,
+    amount DOUBLE PRECISION NOT NULL,
+    fee DOUBLE PRECISION NOT NULL,
+    fee_asset varchar(30) NOT NULL,
+    total DOUBLE PRECISION NOT NULL,
+    order_type varchar NOT NULL,
+    side varchar NOT NULL,
+    description TEXT NOT NULL,
+    is_maker BOOLEAN NOT NULL,
+    timestamp TIMESTAMPTZ NOT NULL
+);
+-- +goose Down
+DROP TABLE managed_order_trade;
+DROP TABLE managed_order;
@@ -0,0 +1,62 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS managed_order
+(
+    id text NOT NULL primary key,
+    exchange_name_id text NOT NULL,
+    order_id text NOT NULL,
+    client_order_id text NOT NULL,
+    client_id text NOT NULL,
+    account_id text NOT NULL,
+    base text NOT NULL,
+    quote text NOT NULL,
+    asset text NOT NULL,
+    order_type text NOT NULL,
+    side text NOT NULL,
+    status text NOT NULL,
+    time_in_force text NOT NULL,
+    margin_type text NOT NULL,
+    hidden_order boolean NOT NULL,
+    reduce_only boolean NOT NULL,
+    leverage real NOT NULL,
+    price real NOT NULL,
+    amount real NOT NULL,
+    contract_amount real NOT NULL,
+    quote_amount real NOT NULL,
+    limit_price_upper real NOT NULL,
+    limit_price_lower real NOT NULL,
+    trigger_price real NOT NULL,
+    average_executed_price real NOT NULL,
+    executed_amount real NOT NULL,
+    remaining_amount real NOT NULL,
+    cost real NOT NULL,
+    cost_asset text NOT NULL,
+    fee real NOT NULL,
+    fee_asset text NOT NULL,
+    settlement_currency text NOT NULL,
+    date timestamp NOT NULL,
+    close_time timestamp NOT NULL,
+    last_updated timestamp NOT NULL,
+    FOREIGN KEY(exchange_name_id) REFERENCES exchange(id) ON DELETE RESTRICT
+);
+CREATE INDEX managed_order_exchange_status_idx ON managed_order(exchange_name_id, status);
+
+CREATE TABLE IF NOT EXISTS managed_order_trade
+(
+    id text NOT NULL primary key,
+    managed_order_id text NOT NULL,
+    tid text NOT NULL,
+    price real NOT NULL,
+    amount real NOT NULL,
+    fee real NOT NULL,
+    fee_asset text NOT NULL,
+    total real NOT NULL,
+    order_type text NOT NULL,
+    side text NOT NULL,
+    description text NOT NULL,
+    is_maker boolean NOT NULL,
+    timestamp timestamp NOT NULL,
+    FOREIGN KEY(managed_order_id) REFERENCES managed_order(id) ON DELETE CASCADE
+);
+-- +goose Down
+DROP TABLE managed_order_trade;
+DROP TABLE managed_order;
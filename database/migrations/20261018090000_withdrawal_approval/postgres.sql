@@ -0,0 +1,8 @@
+-- +goose Up
+ALTER TABLE withdrawal_history
+    ADD asset varchar NOT NULL DEFAULT '',
+    ADD approval TEXT;
+-- +goose Down
+ALTER TABLE withdrawal_history
+    DROP approval,
+    DROP asset;
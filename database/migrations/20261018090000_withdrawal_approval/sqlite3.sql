@@ -0,0 +1,10 @@
+-- +goose Up
+ALTER TABLE withdrawal_history
+    ADD asset TEXT NOT NULL DEFAULT '';
+ALTER TABLE withdrawal_history
+    ADD approval TEXT;
+-- +goose Down
+ALTER TABLE withdrawal_history
+    DROP approval;
+ALTER TABLE withdrawal_history
+    DROP asset;
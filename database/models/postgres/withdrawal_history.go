@@ -34,6 +34,8 @@ type WithdrawalHistory struct {
 	CreatedAt      time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
 	UpdatedAt      time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
 	ExchangeNameID string      `boil:"exchange_name_id" json:"exchange_name_id" toml:"exchange_name_id" yaml:"exchange_name_id"`
+	Asset          string      `boil:"asset" json:"asset" toml:"asset" yaml:"asset"`
+	Approval       null.String `boil:"approval" json:"approval,omitempty" toml:"approval" yaml:"approval,omitempty"`
 
 	R *withdrawalHistoryR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L withdrawalHistoryL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -50,6 +52,8 @@ var WithdrawalHistoryColumns = struct {
 	CreatedAt      string
 	UpdatedAt      string
 	ExchangeNameID string
+	Asset          string
+	Approval       string
 }{
 	ID:             "id",
 	ExchangeID:     "exchange_id",
@@ -61,6 +65,8 @@ var WithdrawalHistoryColumns = struct {
 	CreatedAt:      "created_at",
 	UpdatedAt:      "updated_at",
 	ExchangeNameID: "exchange_name_id",
+	Asset:          "asset",
+	Approval:       "approval",
 }
 
 // Generated where
@@ -92,6 +98,8 @@ var WithdrawalHistoryWhere = struct {
 	CreatedAt      whereHelpertime_Time
 	UpdatedAt      whereHelpertime_Time
 	ExchangeNameID whereHelperstring
+	Asset          whereHelperstring
+	Approval       whereHelpernull_String
 }{
 	ID:             whereHelperstring{field: "\"withdrawal_history\".\"id\""},
 	ExchangeID:     whereHelperstring{field: "\"withdrawal_history\".\"exchange_id\""},
@@ -103,6 +111,8 @@ var WithdrawalHistoryWhere = struct {
 	CreatedAt:      whereHelpertime_Time{field: "\"withdrawal_history\".\"created_at\""},
 	UpdatedAt:      whereHelpertime_Time{field: "\"withdrawal_history\".\"updated_at\""},
 	ExchangeNameID: whereHelperstring{field: "\"withdrawal_history\".\"exchange_name_id\""},
+	Asset:          whereHelperstring{field: "\"withdrawal_history\".\"asset\""},
+	Approval:       whereHelpernull_String{field: "\"withdrawal_history\".\"approval\""},
 }
 
 // WithdrawalHistoryRels is where relationship names are stored.
@@ -132,9 +142,9 @@ func (*withdrawalHistoryR) NewStruct() *withdrawalHistoryR {
 type withdrawalHistoryL struct{}
 
 var (
-	withdrawalHistoryAllColumns            = []string{"id", "exchange_id", "status", "currency", "amount", "description", "withdraw_type", "created_at", "updated_at", "exchange_name_id"}
-	withdrawalHistoryColumnsWithoutDefault = []string{"exchange_id", "status", "currency", "amount", "description", "withdraw_type", "exchange_name_id"}
-	withdrawalHistoryColumnsWithDefault    = []string{"id", "created_at", "updated_at"}
+	withdrawalHistoryAllColumns            = []string{"id", "exchange_id", "status", "currency", "amount", "description", "withdraw_type", "created_at", "updated_at", "exchange_name_id", "asset", "approval"}
+	withdrawalHistoryColumnsWithoutDefault = []string{"exchange_id", "status", "currency", "amount", "description", "withdraw_type", "exchange_name_id", "approval"}
+	withdrawalHistoryColumnsWithDefault    = []string{"id", "created_at", "updated_at", "asset"}
 	withdrawalHistoryPrimaryKeyColumns     = []string{"id"}
 )
 
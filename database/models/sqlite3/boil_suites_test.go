@@ -14,9 +14,15 @@ import "testing"
 func TestParent(t *testing.T) {
 	t.Run("AuditEvents", testAuditEvents)
 	t.Run("Candles", testCandles)
+	t.Run("ConditionalOrders", testConditionalOrders)
 	t.Run("Datahistoryjobs", testDatahistoryjobs)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresults)
+	t.Run("Events", testEvents)
 	t.Run("Exchanges", testExchanges)
+	t.Run("Fundingrates", testFundingrates)
+	t.Run("ManagedOrders", testManagedOrders)
+	t.Run("ManagedOrderTrades", testManagedOrderTrades)
+	t.Run("Orderbooks", testOrderbooks)
 	t.Run("Scripts", testScripts)
 	t.Run("ScriptExecutions", testScriptExecutions)
 	t.Run("Trades", testTrades)
@@ -28,9 +34,15 @@ func TestParent(t *testing.T) {
 func TestDelete(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsDelete)
 	t.Run("Candles", testCandlesDelete)
+	t.Run("ConditionalOrders", testConditionalOrdersDelete)
 	t.Run("Datahistoryjobs", testDatahistoryjobsDelete)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsDelete)
+	t.Run("Events", testEventsDelete)
 	t.Run("Exchanges", testExchangesDelete)
+	t.Run("Fundingrates", testFundingratesDelete)
+	t.Run("ManagedOrders", testManagedOrdersDelete)
+	t.Run("ManagedOrderTrades", testManagedOrderTradesDelete)
+	t.Run("Orderbooks", testOrderbooksDelete)
 	t.Run("Scripts", testScriptsDelete)
 	t.Run("ScriptExecutions", testScriptExecutionsDelete)
 	t.Run("Trades", testTradesDelete)
@@ -42,9 +54,15 @@ func TestDelete(t *testing.T) {
 func TestQueryDeleteAll(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsQueryDeleteAll)
 	t.Run("Candles", testCandlesQueryDeleteAll)
+	t.Run("ConditionalOrders", testConditionalOrdersQueryDeleteAll)
 	t.Run("Datahistoryjobs", testDatahistoryjobsQueryDeleteAll)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsQueryDeleteAll)
+	t.Run("Events", testEventsQueryDeleteAll)
 	t.Run("Exchanges", testExchangesQueryDeleteAll)
+	t.Run("Fundingrates", testFundingratesQueryDeleteAll)
+	t.Run("ManagedOrders", testManagedOrdersQueryDeleteAll)
+	t.Run("ManagedOrderTrades", testManagedOrderTradesQueryDeleteAll)
+	t.Run("Orderbooks", testOrderbooksQueryDeleteAll)
 	t.Run("Scripts", testScriptsQueryDeleteAll)
 	t.Run("ScriptExecutions", testScriptExecutionsQueryDeleteAll)
 	t.Run("Trades", testTradesQueryDeleteAll)
@@ -56,9 +74,15 @@ func TestQueryDeleteAll(t *testing.T) {
 func TestSliceDeleteAll(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsSliceDeleteAll)
 	t.Run("Candles", testCandlesSliceDeleteAll)
+	t.Run("ConditionalOrders", testConditionalOrdersSliceDeleteAll)
 	t.Run("Datahistoryjobs", testDatahistoryjobsSliceDeleteAll)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsSliceDeleteAll)
+	t.Run("Events", testEventsSliceDeleteAll)
 	t.Run("Exchanges", testExchangesSliceDeleteAll)
+	t.Run("Fundingrates", testFundingratesSliceDeleteAll)
+	t.Run("ManagedOrders", testManagedOrdersSliceDeleteAll)
+	t.Run("ManagedOrderTrades", testManagedOrderTradesSliceDeleteAll)
+	t.Run("Orderbooks", testOrderbooksSliceDeleteAll)
 	t.Run("Scripts", testScriptsSliceDeleteAll)
 	t.Run("ScriptExecutions", testScriptExecutionsSliceDeleteAll)
 	t.Run("Trades", testTradesSliceDeleteAll)
@@ -70,9 +94,15 @@ func TestSliceDeleteAll(t *testing.T) {
 func TestExists(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsExists)
 	t.Run("Candles", testCandlesExists)
+	t.Run("ConditionalOrders", testConditionalOrdersExists)
 	t.Run("Datahistoryjobs", testDatahistoryjobsExists)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsExists)
+	t.Run("Events", testEventsExists)
 	t.Run("Exchanges", testExchangesExists)
+	t.Run("Fundingrates", testFundingratesExists)
+	t.Run("ManagedOrders", testManagedOrdersExists)
+	t.Run("ManagedOrderTrades", testManagedOrderTradesExists)
+	t.Run("Orderbooks", testOrderbooksExists)
 	t.Run("Scripts", testScriptsExists)
 	t.Run("ScriptExecutions", testScriptExecutionsExists)
 	t.Run("Trades", testTradesExists)
@@ -84,9 +114,15 @@ func TestExists(t *testing.T) {
 func TestFind(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsFind)
 	t.Run("Candles", testCandlesFind)
+	t.Run("ConditionalOrders", testConditionalOrdersFind)
 	t.Run("Datahistoryjobs", testDatahistoryjobsFind)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsFind)
+	t.Run("Events", testEventsFind)
 	t.Run("Exchanges", testExchangesFind)
+	t.Run("Fundingrates", testFundingratesFind)
+	t.Run("ManagedOrders", testManagedOrdersFind)
+	t.Run("ManagedOrderTrades", testManagedOrderTradesFind)
+	t.Run("Orderbooks", testOrderbooksFind)
 	t.Run("Scripts", testScriptsFind)
 	t.Run("ScriptExecutions", testScriptExecutionsFind)
 	t.Run("Trades", testTradesFind)
@@ -98,9 +134,15 @@ func TestFind(t *testing.T) {
 func TestBind(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsBind)
 	t.Run("Candles", testCandlesBind)
+	t.Run("ConditionalOrders", testConditionalOrdersBind)
 	t.Run("Datahistoryjobs", testDatahistoryjobsBind)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsBind)
+	t.Run("Events", testEventsBind)
 	t.Run("Exchanges", testExchangesBind)
+	t.Run("Fundingrates", testFundingratesBind)
+	t.Run("ManagedOrders", testManagedOrdersBind)
+	t.Run("ManagedOrderTrades", testManagedOrderTradesBind)
+	t.Run("Orderbooks", testOrderbooksBind)
 	t.Run("Scripts", testScriptsBind)
 	t.Run("ScriptExecutions", testScriptExecutionsBind)
 	t.Run("Trades", testTradesBind)
@@ -112,9 +154,15 @@ func TestBind(t *testing.T) {
 func TestOne(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsOne)
 	t.Run("Candles", testCandlesOne)
+	t.Run("ConditionalOrders", testConditionalOrdersOne)
 	t.Run("Datahistoryjobs", testDatahistoryjobsOne)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsOne)
+	t.Run("Events", testEventsOne)
 	t.Run("Exchanges", testExchangesOne)
+	t.Run("Fundingrates", testFundingratesOne)
+	t.Run("ManagedOrders", testManagedOrdersOne)
+	t.Run("ManagedOrderTrades", testManagedOrderTradesOne)
+	t.Run("Orderbooks", testOrderbooksOne)
 	t.Run("Scripts", testScriptsOne)
 	t.Run("ScriptExecutions", testScriptExecutionsOne)
 	t.Run("Trades", testTradesOne)
@@ -126,9 +174,15 @@ func TestOne(t *testing.T) {
 func TestAll(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsAll)
 	t.Run("Candles", testCandlesAll)
+	t.Run("ConditionalOrders", testConditionalOrdersAll)
 	t.Run("Datahistoryjobs", testDatahistoryjobsAll)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsAll)
+	t.Run("Events", testEventsAll)
 	t.Run("Exchanges", testExchangesAll)
+	t.Run("Fundingrates", testFundingratesAll)
+	t.Run("ManagedOrders", testManagedOrdersAll)
+	t.Run("ManagedOrderTrades", testManagedOrderTradesAll)
+	t.Run("Orderbooks", testOrderbooksAll)
 	t.Run("Scripts", testScriptsAll)
 	t.Run("ScriptExecutions", testScriptExecutionsAll)
 	t.Run("Trades", testTradesAll)
@@ -140,9 +194,15 @@ func TestAll(t *testing.T) {
 func TestCount(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsCount)
 	t.Run("Candles", testCandlesCount)
+	t.Run("ConditionalOrders", testConditionalOrdersCount)
 	t.Run("Datahistoryjobs", testDatahistoryjobsCount)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsCount)
+	t.Run("Events", testEventsCount)
 	t.Run("Exchanges", testExchangesCount)
+	t.Run("Fundingrates", testFundingratesCount)
+	t.Run("ManagedOrders", testManagedOrdersCount)
+	t.Run("ManagedOrderTrades", testManagedOrderTradesCount)
+	t.Run("Orderbooks", testOrderbooksCount)
 	t.Run("Scripts", testScriptsCount)
 	t.Run("ScriptExecutions", testScriptExecutionsCount)
 	t.Run("Trades", testTradesCount)
@@ -154,9 +214,15 @@ func TestCount(t *testing.T) {
 func TestHooks(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsHooks)
 	t.Run("Candles", testCandlesHooks)
+	t.Run("ConditionalOrders", testConditionalOrdersHooks)
 	t.Run("Datahistoryjobs", testDatahistoryjobsHooks)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsHooks)
+	t.Run("Events", testEventsHooks)
 	t.Run("Exchanges", testExchangesHooks)
+	t.Run("Fundingrates", testFundingratesHooks)
+	t.Run("ManagedOrders", testManagedOrdersHooks)
+	t.Run("ManagedOrderTrades", testManagedOrderTradesHooks)
+	t.Run("Orderbooks", testOrderbooksHooks)
 	t.Run("Scripts", testScriptsHooks)
 	t.Run("ScriptExecutions", testScriptExecutionsHooks)
 	t.Run("Trades", testTradesHooks)
@@ -170,12 +236,24 @@ func TestInsert(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsInsertWhitelist)
 	t.Run("Candles", testCandlesInsert)
 	t.Run("Candles", testCandlesInsertWhitelist)
+	t.Run("ConditionalOrders", testConditionalOrdersInsert)
+	t.Run("ConditionalOrders", testConditionalOrdersInsertWhitelist)
 	t.Run("Datahistoryjobs", testDatahistoryjobsInsert)
 	t.Run("Datahistoryjobs", testDatahistoryjobsInsertWhitelist)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsInsert)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsInsertWhitelist)
+	t.Run("Events", testEventsInsert)
+	t.Run("Events", testEventsInsertWhitelist)
 	t.Run("Exchanges", testExchangesInsert)
 	t.Run("Exchanges", testExchangesInsertWhitelist)
+	t.Run("Fundingrates", testFundingratesInsert)
+	t.Run("Fundingrates", testFundingratesInsertWhitelist)
+	t.Run("ManagedOrders", testManagedOrdersInsert)
+	t.Run("ManagedOrders", testManagedOrdersInsertWhitelist)
+	t.Run("ManagedOrderTrades", testManagedOrderTradesInsert)
+	t.Run("ManagedOrderTrades", testManagedOrderTradesInsertWhitelist)
+	t.Run("Orderbooks", testOrderbooksInsert)
+	t.Run("Orderbooks", testOrderbooksInsertWhitelist)
 	t.Run("Scripts", testScriptsInsert)
 	t.Run("Scripts", testScriptsInsertWhitelist)
 	t.Run("ScriptExecutions", testScriptExecutionsInsert)
@@ -196,9 +274,15 @@ func TestToOne(t *testing.T) {
 	t.Run("CandleToDatahistoryjobUsingValidationJob", testCandleToOneDatahistoryjobUsingValidationJob)
 	t.Run("CandleToDatahistoryjobUsingSourceJob", testCandleToOneDatahistoryjobUsingSourceJob)
 	t.Run("CandleToExchangeUsingExchangeName", testCandleToOneExchangeUsingExchangeName)
+	t.Run("ConditionalOrderToExchangeUsingExchangeName", testConditionalOrderToOneExchangeUsingExchangeName)
 	t.Run("DatahistoryjobToExchangeUsingExchangeName", testDatahistoryjobToOneExchangeUsingExchangeName)
 	t.Run("DatahistoryjobToExchangeUsingSecondaryExchange", testDatahistoryjobToOneExchangeUsingSecondaryExchange)
 	t.Run("DatahistoryjobresultToDatahistoryjobUsingJob", testDatahistoryjobresultToOneDatahistoryjobUsingJob)
+	t.Run("EventToExchangeUsingExchangeName", testEventToOneExchangeUsingExchangeName)
+	t.Run("FundingrateToExchangeUsingExchangeName", testFundingrateToOneExchangeUsingExchangeName)
+	t.Run("ManagedOrderToExchangeUsingExchangeName", testManagedOrderToOneExchangeUsingExchangeName)
+	t.Run("ManagedOrderTradeToManagedOrderUsingManagedOrder", testManagedOrderTradeToOneManagedOrderUsingManagedOrder)
+	t.Run("OrderbookToExchangeUsingExchangeName", testOrderbookToOneExchangeUsingExchangeName)
 	t.Run("ScriptExecutionToScriptUsingScript", testScriptExecutionToOneScriptUsingScript)
 	t.Run("TradeToExchangeUsingExchangeName", testTradeToOneExchangeUsingExchangeName)
 	t.Run("WithdrawalCryptoToWithdrawalHistoryUsingWithdrawalHistory", testWithdrawalCryptoToOneWithdrawalHistoryUsingWithdrawalHistory)
@@ -210,6 +294,8 @@ func TestToOne(t *testing.T) {
 // or deadlocks can occur.
 func TestOneToOne(t *testing.T) {
 	t.Run("ExchangeToCandleUsingExchangeNameCandle", testExchangeOneToOneCandleUsingExchangeNameCandle)
+	t.Run("ExchangeToFundingrateUsingExchangeNameFundingrate", testExchangeOneToOneFundingrateUsingExchangeNameFundingrate)
+	t.Run("ExchangeToOrderbookUsingExchangeNameOrderbook", testExchangeOneToOneOrderbookUsingExchangeNameOrderbook)
 	t.Run("ExchangeToTradeUsingExchangeNameTrade", testExchangeOneToOneTradeUsingExchangeNameTrade)
 }
 
@@ -221,9 +307,13 @@ func TestToMany(t *testing.T) {
 	t.Run("DatahistoryjobToPrerequisiteJobDatahistoryjobs", testDatahistoryjobToManyPrerequisiteJobDatahistoryjobs)
 	t.Run("DatahistoryjobToJobDatahistoryjobs", testDatahistoryjobToManyJobDatahistoryjobs)
 	t.Run("DatahistoryjobToJobDatahistoryjobresults", testDatahistoryjobToManyJobDatahistoryjobresults)
+	t.Run("ExchangeToExchangeNameConditionalOrders", testExchangeToManyExchangeNameConditionalOrders)
 	t.Run("ExchangeToExchangeNameDatahistoryjobs", testExchangeToManyExchangeNameDatahistoryjobs)
 	t.Run("ExchangeToSecondaryExchangeDatahistoryjobs", testExchangeToManySecondaryExchangeDatahistoryjobs)
+	t.Run("ExchangeToExchangeNameEvents", testExchangeToManyExchangeNameEvents)
+	t.Run("ExchangeToExchangeNameManagedOrders", testExchangeToManyExchangeNameManagedOrders)
 	t.Run("ExchangeToExchangeNameWithdrawalHistories", testExchangeToManyExchangeNameWithdrawalHistories)
+	t.Run("ManagedOrderToManagedOrderTrades", testManagedOrderToManyManagedOrderTrades)
 	t.Run("ScriptToScriptExecutions", testScriptToManyScriptExecutions)
 	t.Run("WithdrawalHistoryToWithdrawalCryptos", testWithdrawalHistoryToManyWithdrawalCryptos)
 	t.Run("WithdrawalHistoryToWithdrawalFiats", testWithdrawalHistoryToManyWithdrawalFiats)
@@ -235,9 +325,15 @@ func TestToOneSet(t *testing.T) {
 	t.Run("CandleToDatahistoryjobUsingValidationJobCandles", testCandleToOneSetOpDatahistoryjobUsingValidationJob)
 	t.Run("CandleToDatahistoryjobUsingSourceJobCandles", testCandleToOneSetOpDatahistoryjobUsingSourceJob)
 	t.Run("CandleToExchangeUsingExchangeNameCandle", testCandleToOneSetOpExchangeUsingExchangeName)
+	t.Run("ConditionalOrderToExchangeUsingExchangeNameConditionalOrders", testConditionalOrderToOneSetOpExchangeUsingExchangeName)
 	t.Run("DatahistoryjobToExchangeUsingExchangeNameDatahistoryjobs", testDatahistoryjobToOneSetOpExchangeUsingExchangeName)
 	t.Run("DatahistoryjobToExchangeUsingSecondaryExchangeDatahistoryjobs", testDatahistoryjobToOneSetOpExchangeUsingSecondaryExchange)
 	t.Run("DatahistoryjobresultToDatahistoryjobUsingJobDatahistoryjobresults", testDatahistoryjobresultToOneSetOpDatahistoryjobUsingJob)
+	t.Run("EventToExchangeUsingExchangeNameEvents", testEventToOneSetOpExchangeUsingExchangeName)
+	t.Run("FundingrateToExchangeUsingExchangeNameFundingrate", testFundingrateToOneSetOpExchangeUsingExchangeName)
+	t.Run("ManagedOrderToExchangeUsingExchangeNameManagedOrders", testManagedOrderToOneSetOpExchangeUsingExchangeName)
+	t.Run("ManagedOrderTradeToManagedOrderUsingManagedOrderTrades", testManagedOrderTradeToOneSetOpManagedOrderUsingManagedOrder)
+	t.Run("OrderbookToExchangeUsingExchangeNameOrderbook", testOrderbookToOneSetOpExchangeUsingExchangeName)
 	t.Run("ScriptExecutionToScriptUsingScriptExecutions", testScriptExecutionToOneSetOpScriptUsingScript)
 	t.Run("TradeToExchangeUsingExchangeNameTrade", testTradeToOneSetOpExchangeUsingExchangeName)
 	t.Run("WithdrawalCryptoToWithdrawalHistoryUsingWithdrawalCryptos", testWithdrawalCryptoToOneSetOpWithdrawalHistoryUsingWithdrawalHistory)
@@ -257,6 +353,8 @@ func TestToOneRemove(t *testing.T) {
 // or deadlocks can occur.
 func TestOneToOneSet(t *testing.T) {
 	t.Run("ExchangeToCandleUsingExchangeNameCandle", testExchangeOneToOneSetOpCandleUsingExchangeNameCandle)
+	t.Run("ExchangeToFundingrateUsingExchangeNameFundingrate", testExchangeOneToOneSetOpFundingrateUsingExchangeNameFundingrate)
+	t.Run("ExchangeToOrderbookUsingExchangeNameOrderbook", testExchangeOneToOneSetOpOrderbookUsingExchangeNameOrderbook)
 	t.Run("ExchangeToTradeUsingExchangeNameTrade", testExchangeOneToOneSetOpTradeUsingExchangeNameTrade)
 }
 
@@ -272,9 +370,13 @@ func TestToManyAdd(t *testing.T) {
 	t.Run("DatahistoryjobToPrerequisiteJobDatahistoryjobs", testDatahistoryjobToManyAddOpPrerequisiteJobDatahistoryjobs)
 	t.Run("DatahistoryjobToJobDatahistoryjobs", testDatahistoryjobToManyAddOpJobDatahistoryjobs)
 	t.Run("DatahistoryjobToJobDatahistoryjobresults", testDatahistoryjobToManyAddOpJobDatahistoryjobresults)
+	t.Run("ExchangeToExchangeNameConditionalOrders", testExchangeToManyAddOpExchangeNameConditionalOrders)
 	t.Run("ExchangeToExchangeNameDatahistoryjobs", testExchangeToManyAddOpExchangeNameDatahistoryjobs)
 	t.Run("ExchangeToSecondaryExchangeDatahistoryjobs", testExchangeToManyAddOpSecondaryExchangeDatahistoryjobs)
+	t.Run("ExchangeToExchangeNameEvents", testExchangeToManyAddOpExchangeNameEvents)
+	t.Run("ExchangeToExchangeNameManagedOrders", testExchangeToManyAddOpExchangeNameManagedOrders)
 	t.Run("ExchangeToExchangeNameWithdrawalHistories", testExchangeToManyAddOpExchangeNameWithdrawalHistories)
+	t.Run("ManagedOrderToManagedOrderTrades", testManagedOrderToManyAddOpManagedOrderTrades)
 	t.Run("ScriptToScriptExecutions", testScriptToManyAddOpScriptExecutions)
 	t.Run("WithdrawalHistoryToWithdrawalCryptos", testWithdrawalHistoryToManyAddOpWithdrawalCryptos)
 	t.Run("WithdrawalHistoryToWithdrawalFiats", testWithdrawalHistoryToManyAddOpWithdrawalFiats)
@@ -303,9 +405,15 @@ func TestToManyRemove(t *testing.T) {
 func TestReload(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsReload)
 	t.Run("Candles", testCandlesReload)
+	t.Run("ConditionalOrders", testConditionalOrdersReload)
 	t.Run("Datahistoryjobs", testDatahistoryjobsReload)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsReload)
+	t.Run("Events", testEventsReload)
 	t.Run("Exchanges", testExchangesReload)
+	t.Run("Fundingrates", testFundingratesReload)
+	t.Run("ManagedOrders", testManagedOrdersReload)
+	t.Run("ManagedOrderTrades", testManagedOrderTradesReload)
+	t.Run("Orderbooks", testOrderbooksReload)
 	t.Run("Scripts", testScriptsReload)
 	t.Run("ScriptExecutions", testScriptExecutionsReload)
 	t.Run("Trades", testTradesReload)
@@ -317,9 +425,15 @@ func TestReload(t *testing.T) {
 func TestReloadAll(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsReloadAll)
 	t.Run("Candles", testCandlesReloadAll)
+	t.Run("ConditionalOrders", testConditionalOrdersReloadAll)
 	t.Run("Datahistoryjobs", testDatahistoryjobsReloadAll)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsReloadAll)
+	t.Run("Events", testEventsReloadAll)
 	t.Run("Exchanges", testExchangesReloadAll)
+	t.Run("Fundingrates", testFundingratesReloadAll)
+	t.Run("ManagedOrders", testManagedOrdersReloadAll)
+	t.Run("ManagedOrderTrades", testManagedOrderTradesReloadAll)
+	t.Run("Orderbooks", testOrderbooksReloadAll)
 	t.Run("Scripts", testScriptsReloadAll)
 	t.Run("ScriptExecutions", testScriptExecutionsReloadAll)
 	t.Run("Trades", testTradesReloadAll)
@@ -331,9 +445,15 @@ func TestReloadAll(t *testing.T) {
 func TestSelect(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsSelect)
 	t.Run("Candles", testCandlesSelect)
+	t.Run("ConditionalOrders", testConditionalOrdersSelect)
 	t.Run("Datahistoryjobs", testDatahistoryjobsSelect)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsSelect)
+	t.Run("Events", testEventsSelect)
 	t.Run("Exchanges", testExchangesSelect)
+	t.Run("Fundingrates", testFundingratesSelect)
+	t.Run("ManagedOrders", testManagedOrdersSelect)
+	t.Run("ManagedOrderTrades", testManagedOrderTradesSelect)
+	t.Run("Orderbooks", testOrderbooksSelect)
 	t.Run("Scripts", testScriptsSelect)
 	t.Run("ScriptExecutions", testScriptExecutionsSelect)
 	t.Run("Trades", testTradesSelect)
@@ -345,9 +465,15 @@ func TestSelect(t *testing.T) {
 func TestUpdate(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsUpdate)
 	t.Run("Candles", testCandlesUpdate)
+	t.Run("ConditionalOrders", testConditionalOrdersUpdate)
 	t.Run("Datahistoryjobs", testDatahistoryjobsUpdate)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsUpdate)
+	t.Run("Events", testEventsUpdate)
 	t.Run("Exchanges", testExchangesUpdate)
+	t.Run("Fundingrates", testFundingratesUpdate)
+	t.Run("ManagedOrders", testManagedOrdersUpdate)
+	t.Run("ManagedOrderTrades", testManagedOrderTradesUpdate)
+	t.Run("Orderbooks", testOrderbooksUpdate)
 	t.Run("Scripts", testScriptsUpdate)
 	t.Run("ScriptExecutions", testScriptExecutionsUpdate)
 	t.Run("Trades", testTradesUpdate)
@@ -359,9 +485,15 @@ func TestUpdate(t *testing.T) {
 func TestSliceUpdateAll(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsSliceUpdateAll)
 	t.Run("Candles", testCandlesSliceUpdateAll)
+	t.Run("ConditionalOrders", testConditionalOrdersSliceUpdateAll)
 	t.Run("Datahistoryjobs", testDatahistoryjobsSliceUpdateAll)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsSliceUpdateAll)
+	t.Run("Events", testEventsSliceUpdateAll)
 	t.Run("Exchanges", testExchangesSliceUpdateAll)
+	t.Run("Fundingrates", testFundingratesSliceUpdateAll)
+	t.Run("ManagedOrders", testManagedOrdersSliceUpdateAll)
+	t.Run("ManagedOrderTrades", testManagedOrderTradesSliceUpdateAll)
+	t.Run("Orderbooks", testOrderbooksSliceUpdateAll)
 	t.Run("Scripts", testScriptsSliceUpdateAll)
 	t.Run("ScriptExecutions", testScriptExecutionsSliceUpdateAll)
 	t.Run("Trades", testTradesSliceUpdateAll)
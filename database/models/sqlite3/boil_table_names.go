@@ -6,10 +6,16 @@ package sqlite3
 var TableNames = struct {
 	AuditEvent              string
 	Candle                  string
+	ConditionalOrder        string
 	Datahistoryjob          string
 	Datahistoryjobrelations string
 	Datahistoryjobresult    string
+	Event                   string
 	Exchange                string
+	Fundingrate             string
+	ManagedOrder            string
+	ManagedOrderTrade       string
+	Orderbook               string
 	Script                  string
 	ScriptExecution         string
 	Trade                   string
@@ -19,10 +25,16 @@ var TableNames = struct {
 }{
 	AuditEvent:              "audit_event",
 	Candle:                  "candle",
+	ConditionalOrder:        "conditional_order",
 	Datahistoryjob:          "datahistoryjob",
 	Datahistoryjobrelations: "datahistoryjobrelations",
 	Datahistoryjobresult:    "datahistoryjobresult",
+	Event:                   "event",
 	Exchange:                "exchange",
+	Fundingrate:             "fundingrate",
+	ManagedOrder:            "managed_order",
+	ManagedOrderTrade:       "managed_order_trade",
+	Orderbook:               "orderbook",
 	Script:                  "script",
 	ScriptExecution:         "script_execution",
 	Trade:                   "trade",
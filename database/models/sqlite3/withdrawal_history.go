@@ -33,6 +33,8 @@ type WithdrawalHistory struct {
 	WithdrawType   int64       `boil:"withdraw_type" json:"withdraw_type" toml:"withdraw_type" yaml:"withdraw_type"`
 	CreatedAt      string      `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
 	UpdatedAt      string      `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	Asset          string      `boil:"asset" json:"asset" toml:"asset" yaml:"asset"`
+	Approval       null.String `boil:"approval" json:"approval,omitempty" toml:"approval" yaml:"approval,omitempty"`
 
 	R *withdrawalHistoryR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L withdrawalHistoryL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -49,6 +51,8 @@ var WithdrawalHistoryColumns = struct {
 	WithdrawType   string
 	CreatedAt      string
 	UpdatedAt      string
+	Asset          string
+	Approval       string
 }{
 	ID:             "id",
 	ExchangeNameID: "exchange_name_id",
@@ -60,6 +64,8 @@ var WithdrawalHistoryColumns = struct {
 	WithdrawType:   "withdraw_type",
 	CreatedAt:      "created_at",
 	UpdatedAt:      "updated_at",
+	Asset:          "asset",
+	Approval:       "approval",
 }
 
 // Generated where
@@ -75,6 +81,8 @@ var WithdrawalHistoryWhere = struct {
 	WithdrawType   whereHelperint64
 	CreatedAt      whereHelperstring
 	UpdatedAt      whereHelperstring
+	Asset          whereHelperstring
+	Approval       whereHelpernull_String
 }{
 	ID:             whereHelperstring{field: "\"withdrawal_history\".\"id\""},
 	ExchangeNameID: whereHelperstring{field: "\"withdrawal_history\".\"exchange_name_id\""},
@@ -86,6 +94,8 @@ var WithdrawalHistoryWhere = struct {
 	WithdrawType:   whereHelperint64{field: "\"withdrawal_history\".\"withdraw_type\""},
 	CreatedAt:      whereHelperstring{field: "\"withdrawal_history\".\"created_at\""},
 	UpdatedAt:      whereHelperstring{field: "\"withdrawal_history\".\"updated_at\""},
+	Asset:          whereHelperstring{field: "\"withdrawal_history\".\"asset\""},
+	Approval:       whereHelpernull_String{field: "\"withdrawal_history\".\"approval\""},
 }
 
 // WithdrawalHistoryRels is where relationship names are stored.
@@ -115,9 +125,9 @@ func (*withdrawalHistoryR) NewStruct() *withdrawalHistoryR {
 type withdrawalHistoryL struct{}
 
 var (
-	withdrawalHistoryAllColumns            = []string{"id", "exchange_name_id", "exchange_id", "status", "currency", "amount", "description", "withdraw_type", "created_at", "updated_at"}
-	withdrawalHistoryColumnsWithoutDefault = []string{"id", "exchange_name_id", "exchange_id", "status", "currency", "amount", "description", "withdraw_type"}
-	withdrawalHistoryColumnsWithDefault    = []string{"created_at", "updated_at"}
+	withdrawalHistoryAllColumns            = []string{"id", "exchange_name_id", "exchange_id", "status", "currency", "amount", "description", "withdraw_type", "created_at", "updated_at", "asset", "approval"}
+	withdrawalHistoryColumnsWithoutDefault = []string{"id", "exchange_name_id", "exchange_id", "status", "currency", "amount", "description", "withdraw_type", "approval"}
+	withdrawalHistoryColumnsWithDefault    = []string{"created_at", "updated_at", "asset"}
 	withdrawalHistoryPrimaryKeyColumns     = []string{"id"}
 )
 
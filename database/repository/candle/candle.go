@@ -216,6 +216,60 @@ func Insert(in *Item) (uint64, error) {
 	return totalInserted, nil
 }
 
+// Replace deletes all candles matching the item from start up to but excluding
+// end and inserts the item's candles in a single transaction, so existing
+// candles are kept if the insert fails
+func Replace(in *Item, start, end time.Time) (uint64, error) {
+	if database.DB.SQL == nil {
+		return 0, database.ErrDatabaseSupportDisabled
+	}
+	if len(in.Candles) < 1 {
+		return 0, errNoCandleData
+	}
+	if !start.Before(end) {
+		return 0, errInvalidInput
+	}
+
+	ctx := context.TODO()
+	tx, err := database.DB.SQL.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	queries := []qm.QueryMod{
+		qm.Where("base = ?", strings.ToUpper(in.Base)),
+		qm.Where("quote = ?", strings.ToUpper(in.Quote)),
+		qm.Where("interval = ?", in.Interval),
+		qm.Where("asset = ?", strings.ToLower(in.Asset)),
+		qm.Where("exchange_name_id = ?", in.ExchangeID),
+	}
+	var totalInserted uint64
+	if repository.GetSQLDialect() == database.DBSQLite3 {
+		queries = append(queries, qm.Where("timestamp >= ? and timestamp < ?", start.UTC().Format(time.RFC3339), end.UTC().Format(time.RFC3339)))
+		if _, err = modelSQLite.Candles(queries...).DeleteAll(ctx, tx); err == nil {
+			totalInserted, err = insertSQLite(ctx, tx, in)
+		}
+	} else {
+		queries = append(queries, qm.Where("timestamp >= ? and timestamp < ?", start.UTC(), end.UTC()))
+		if _, err = modelPSQL.Candles(queries...).DeleteAll(ctx, tx); err == nil {
+			totalInserted, err = insertPostgresSQL(ctx, tx, in)
+		}
+	}
+	if err != nil {
+		errRB := tx.Rollback()
+		if errRB != nil {
+			log.Errorln(log.DatabaseMgr, errRB)
+		}
+		return 0, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return 0, err
+	}
+	return totalInserted, nil
+}
+
 func insertSQLite(ctx context.Context, tx *sql.Tx, in *Item) (uint64, error) {
 	var totalInserted uint64
 	for x := range in.Candles {
@@ -199,6 +199,65 @@ func TestSeries(t *testing.T) {
 	}
 }
 
+func TestReplace(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		config *database.Config
+		seedDB func(includeOHLCVData bool) error
+	}{
+		{
+			name:   "postgresql",
+			config: testhelpers.PostgresTestDatabase,
+			seedDB: seedDB,
+		},
+		{
+			name: "SQLite",
+			config: &database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+			seedDB: seedDB,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if !testhelpers.CheckValidConfig(&tc.config.ConnectionDetails) {
+				t.Skip("database not configured skipping test")
+			}
+
+			dbConn, err := testhelpers.ConnectToDatabase(tc.config)
+			require.NoError(t, err)
+
+			if tc.seedDB != nil {
+				require.NoError(t, tc.seedDB(true))
+			}
+
+			data, err := genOHCLVData()
+			require.NoError(t, err)
+			start := time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)
+			end := start.AddDate(0, 0, 10)
+			misaligned := data
+			misaligned.Candles = []Candle{{Timestamp: start.Add(time.Hour * 60), Open: 1, High: 1, Low: 1, Close: 1}}
+			_, err = Insert(&misaligned)
+			require.NoError(t, err)
+
+			_, err = Replace(&Item{}, start, end)
+			assert.ErrorIs(t, err, errNoCandleData)
+			_, err = Replace(&data, end, start)
+			assert.ErrorIs(t, err, errInvalidInput)
+
+			data.Candles = data.Candles[:5]
+			r, err := Replace(&data, start, end)
+			require.NoError(t, err)
+			assert.Equal(t, uint64(5), r)
+
+			ret, err := Series(testExchanges[0].Name, "BTC", "USDT", 86400, "spot", start, start.AddDate(1, 0, 0))
+			require.NoError(t, err)
+			assert.Len(t, ret.Candles, 360, "Replace should delete every candle in the range, including misaligned candles")
+			assert.NoError(t, testhelpers.CloseDatabase(dbConn))
+		})
+	}
+}
+
 func seedDB(includeOHLCVData bool) error {
 	err := exchange.InsertMany(testExchanges)
 	if err != nil {
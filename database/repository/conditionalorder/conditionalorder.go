@@ -0,0 +1,255 @@
+package conditionalorder
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/models/postgres"
+	"github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+)
+
+var errNoStatuses = errors.New("no statuses provided")
+
+// Setup returns a DBService
+func Setup(db database.IDatabase) (*DBService, error) {
+	if db == nil {
+		return nil, database.ErrNilInstance
+	}
+	if !db.IsConnected() {
+		return nil, database.ErrDatabaseNotConnected
+	}
+	cfg := db.GetConfig()
+	dbCon, err := db.GetSQL()
+	if err != nil {
+		return nil, err
+	}
+	return &DBService{
+		sql:    dbCon,
+		driver: cfg.Driver,
+	}, nil
+}
+
+// Upsert inserts or updates conditional orders into the database
+func (db *DBService) Upsert(orders ...*ConditionalOrder) error {
+	ctx := context.TODO()
+	ctx = boil.SkipTimestamps(ctx)
+
+	tx, err := db.sql.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("beginTx %w", err)
+	}
+	defer func() {
+		if err != nil {
+			errRB := tx.Rollback()
+			if errRB != nil {
+				log.Errorf(log.DatabaseMgr, "Upsert tx.Rollback %v", errRB)
+			}
+		}
+	}()
+
+	switch db.driver {
+	case database.DBSQLite3, database.DBSQLite:
+		err = upsertSQLite(ctx, tx, orders...)
+	case database.DBPostgreSQL:
+		err = upsertPostgres(ctx, tx, orders...)
+	default:
+		return database.ErrNoDatabaseProvided
+	}
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// GetByStatus returns all conditional orders matching any of the supplied
+// statuses ordered by creation time
+func (db *DBService) GetByStatus(statuses ...string) ([]ConditionalOrder, error) {
+	if len(statuses) == 0 {
+		return nil, errNoStatuses
+	}
+	args := make([]any, len(statuses))
+	for i := range statuses {
+		args[i] = statuses[i]
+	}
+	switch db.driver {
+	case database.DBSQLite3, database.DBSQLite:
+		return db.getByStatusSQLite(args)
+	case database.DBPostgreSQL:
+		return db.getByStatusPostgres(args)
+	default:
+		return nil, database.ErrNoDatabaseProvided
+	}
+}
+
+func upsertSQLite(ctx context.Context, tx *sql.Tx, orders ...*ConditionalOrder) error {
+	for i := range orders {
+		exch, err := sqlite3.Exchanges(
+			qm.Where("name = ?", strings.ToLower(orders[i].ExchangeName))).One(ctx, tx)
+		if err != nil {
+			return fmt.Errorf("could not retrieve exchange '%v', %w", orders[i].ExchangeName, err)
+		}
+		tempOrder := sqlite3.ConditionalOrder{
+			ID:              orders[i].ID,
+			ExchangeNameID:  exch.ID,
+			Base:            strings.ToUpper(orders[i].Base),
+			Quote:           strings.ToUpper(orders[i].Quote),
+			Asset:           strings.ToLower(orders[i].Asset),
+			OrderType:       orders[i].Type,
+			Side:            orders[i].Side,
+			Amount:          orders[i].Amount,
+			TriggerPrice:    orders[i].TriggerPrice,
+			LimitPrice:      orders[i].LimitPrice,
+			TakeProfitPrice: orders[i].TakeProfitPrice,
+			EntryPrice:      orders[i].EntryPrice,
+			TrailingAmount:  orders[i].TrailingAmount,
+			TrailingPercent: orders[i].TrailingPercent,
+			ReferencePrice:  orders[i].ReferencePrice,
+			EntryOrderID:    orders[i].EntryOrderID,
+			OrderID:         orders[i].OrderID,
+			Status:          orders[i].Status,
+			FailureReason:   orders[i].FailureReason,
+			CreatedAt:       orders[i].CreatedAt.UTC().Format(time.RFC3339),
+			UpdatedAt:       orders[i].UpdatedAt.UTC().Format(time.RFC3339),
+		}
+		err = tempOrder.Insert(ctx, tx, boil.Infer())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func upsertPostgres(ctx context.Context, tx *sql.Tx, orders ...*ConditionalOrder) error {
+	for i := range orders {
+		exch, err := postgres.Exchanges(
+			qm.Where("name = ?", strings.ToLower(orders[i].ExchangeName))).One(ctx, tx)
+		if err != nil {
+			return fmt.Errorf("could not retrieve exchange '%v', %w", orders[i].ExchangeName, err)
+		}
+		tempOrder := postgres.ConditionalOrder{
+			ID:              orders[i].ID,
+			ExchangeNameID:  exch.ID,
+			Base:            strings.ToUpper(orders[i].Base),
+			Quote:           strings.ToUpper(orders[i].Quote),
+			Asset:           strings.ToLower(orders[i].Asset),
+			OrderType:       orders[i].Type,
+			Side:            orders[i].Side,
+			Amount:          orders[i].Amount,
+			TriggerPrice:    orders[i].TriggerPrice,
+			LimitPrice:      orders[i].LimitPrice,
+			TakeProfitPrice: orders[i].TakeProfitPrice,
+			EntryPrice:      orders[i].EntryPrice,
+			TrailingAmount:  orders[i].TrailingAmount,
+			TrailingPercent: orders[i].TrailingPercent,
+			ReferencePrice:  orders[i].ReferencePrice,
+			EntryOrderID:    orders[i].EntryOrderID,
+			OrderID:         orders[i].OrderID,
+			Status:          orders[i].Status,
+			FailureReason:   orders[i].FailureReason,
+			CreatedAt:       orders[i].CreatedAt.UTC(),
+			UpdatedAt:       orders[i].UpdatedAt.UTC(),
+		}
+		err = tempOrder.Upsert(ctx, tx, true, []string{"id"}, boil.Infer(), boil.Infer())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (db *DBService) getByStatusSQLite(statuses []any) ([]ConditionalOrder, error) {
+	results, err := sqlite3.ConditionalOrders(
+		qm.Load(sqlite3.ConditionalOrderRels.ExchangeName),
+		qm.WhereIn("status in ?", statuses...),
+		qm.OrderBy("created_at")).All(context.TODO(), db.sql)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]ConditionalOrder, len(results))
+	for i := range results {
+		if results[i].R == nil || results[i].R.ExchangeName == nil {
+			return nil, fmt.Errorf("could not retrieve exchange '%v' for conditional order %v", results[i].ExchangeNameID, results[i].ID)
+		}
+		created, err := time.Parse(time.RFC3339, results[i].CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		updated, err := time.Parse(time.RFC3339, results[i].UpdatedAt)
+		if err != nil {
+			return nil, err
+		}
+		resp[i] = ConditionalOrder{
+			ID:              results[i].ID,
+			ExchangeName:    results[i].R.ExchangeName.Name,
+			Base:            results[i].Base,
+			Quote:           results[i].Quote,
+			Asset:           results[i].Asset,
+			Type:            results[i].OrderType,
+			Side:            results[i].Side,
+			Amount:          results[i].Amount,
+			TriggerPrice:    results[i].TriggerPrice,
+			LimitPrice:      results[i].LimitPrice,
+			TakeProfitPrice: results[i].TakeProfitPrice,
+			EntryPrice:      results[i].EntryPrice,
+			TrailingAmount:  results[i].TrailingAmount,
+			TrailingPercent: results[i].TrailingPercent,
+			ReferencePrice:  results[i].ReferencePrice,
+			EntryOrderID:    results[i].EntryOrderID,
+			OrderID:         results[i].OrderID,
+			Status:          results[i].Status,
+			FailureReason:   results[i].FailureReason,
+			CreatedAt:       created,
+			UpdatedAt:       updated,
+		}
+	}
+	return resp, nil
+}
+
+func (db *DBService) getByStatusPostgres(statuses []any) ([]ConditionalOrder, error) {
+	results, err := postgres.ConditionalOrders(
+		qm.Load(postgres.ConditionalOrderRels.ExchangeName),
+		qm.WhereIn("status in ?", statuses...),
+		qm.OrderBy("created_at")).All(context.TODO(), db.sql)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]ConditionalOrder, len(results))
+	for i := range results {
+		if results[i].R == nil || results[i].R.ExchangeName == nil {
+			return nil, fmt.Errorf("could not retrieve exchange '%v' for conditional order %v", results[i].ExchangeNameID, results[i].ID)
+		}
+		resp[i] = ConditionalOrder{
+			ID:              results[i].ID,
+			ExchangeName:    results[i].R.ExchangeName.Name,
+			Base:            results[i].Base,
+			Quote:           results[i].Quote,
+			Asset:           results[i].Asset,
+			Type:            results[i].OrderType,
+			Side:            results[i].Side,
+			Amount:          results[i].Amount,
+			TriggerPrice:    results[i].TriggerPrice,
+			LimitPrice:      results[i].LimitPrice,
+			TakeProfitPrice: results[i].TakeProfitPrice,
+			EntryPrice:      results[i].EntryPrice,
+			TrailingAmount:  results[i].TrailingAmount,
+			TrailingPercent: results[i].TrailingPercent,
+			ReferencePrice:  results[i].ReferencePrice,
+			EntryOrderID:    results[i].EntryOrderID,
+			OrderID:         results[i].OrderID,
+			Status:          results[i].Status,
+			FailureReason:   results[i].FailureReason,
+			CreatedAt:       results[i].CreatedAt,
+			UpdatedAt:       results[i].UpdatedAt,
+		}
+	}
+	return resp, nil
+}
@@ -0,0 +1,158 @@
+package conditionalorder
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/drivers"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+	"github.com/thrasher-corp/gocryptotrader/database/testhelpers"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+var (
+	verbose       = false
+	testExchanges = []exchange.Details{
+		{
+			Name: "one",
+		},
+	}
+)
+
+func TestMain(m *testing.M) {
+	if verbose {
+		err := testhelpers.EnableVerboseTestOutput()
+		if err != nil {
+			fmt.Printf("failed to enable verbose test output: %v", err)
+			os.Exit(1)
+		}
+	}
+	var err error
+	testhelpers.PostgresTestDatabase = testhelpers.GetConnectionDetails()
+	testhelpers.TempDir, err = os.MkdirTemp("", "gct-temp")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	exitCode := m.Run()
+	if err = os.RemoveAll(testhelpers.TempDir); err != nil {
+		fmt.Printf("failed to remove temp dir: %s", err)
+	}
+	os.Exit(exitCode)
+}
+
+func TestSetup(t *testing.T) {
+	t.Parallel()
+	_, err := Setup(nil)
+	assert.ErrorIs(t, err, database.ErrNilInstance)
+
+	_, err = Setup(&database.Instance{})
+	assert.ErrorIs(t, err, database.ErrDatabaseNotConnected)
+}
+
+func TestConditionalOrders(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		name   string
+		config *database.Config
+		seedDB func() error
+	}{
+		{
+			name:   "postgresql",
+			config: testhelpers.PostgresTestDatabase,
+			seedDB: seedDB,
+		},
+		{
+			name: "SQLite",
+			config: &database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+			seedDB: seedDB,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			if !testhelpers.CheckValidConfig(&tc.config.ConnectionDetails) {
+				t.Skip("database not configured skipping test")
+			}
+
+			dbConn, err := testhelpers.ConnectToDatabase(tc.config)
+			require.NoError(t, err)
+
+			if tc.seedDB != nil {
+				require.NoError(t, tc.seedDB())
+			}
+
+			db, err := Setup(dbConn)
+			require.NoError(t, err)
+			conditionalOrderSQLTester(t, db)
+			assert.NoError(t, testhelpers.CloseDatabase(dbConn))
+		})
+	}
+}
+
+func conditionalOrderSQLTester(t *testing.T, db *DBService) {
+	t.Helper()
+	_, err := db.GetByStatus()
+	require.ErrorIs(t, err, errNoStatuses)
+
+	created := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	orders := make([]*ConditionalOrder, 4)
+	for i := range orders {
+		id, err := uuid.NewV4()
+		require.NoError(t, err)
+		orders[i] = &ConditionalOrder{
+			ID:             id.String(),
+			ExchangeName:   testExchanges[0].Name,
+			Base:           currency.BTC.String(),
+			Quote:          currency.USDT.String(),
+			Asset:          asset.Spot.String(),
+			Type:           "TRAILING_STOP",
+			Side:           "SELL",
+			Amount:         1,
+			TrailingAmount: 100,
+			ReferencePrice: 1000 + float64(i),
+			Status:         "ACTIVE",
+			CreatedAt:      created.Add(time.Minute * time.Duration(i)),
+			UpdatedAt:      created.Add(time.Minute * time.Duration(i)),
+		}
+	}
+	orders[3].Status = "CANCELLED"
+	require.NoError(t, db.Upsert(orders...))
+
+	orders[0].ReferencePrice = 1337
+	orders[0].Status = "TRIGGERED"
+	orders[0].OrderID = "1337"
+	orders[0].UpdatedAt = created.Add(time.Hour)
+	require.NoError(t, db.Upsert(orders[0]), "Upsert must not error when updating an existing conditional order")
+
+	resp, err := db.GetByStatus("ACTIVE")
+	require.NoError(t, err)
+	require.Len(t, resp, 2)
+	assert.Equal(t, orders[1].ID, resp[0].ID, "GetByStatus should order by creation time")
+	assert.Equal(t, testExchanges[0].Name, resp[0].ExchangeName)
+	assert.Equal(t, orders[1].ReferencePrice, resp[0].ReferencePrice)
+	assert.True(t, resp[0].CreatedAt.Equal(orders[1].CreatedAt))
+
+	resp, err = db.GetByStatus("TRIGGERED", "CANCELLED")
+	require.NoError(t, err)
+	require.Len(t, resp, 2)
+	assert.Equal(t, orders[0].ID, resp[0].ID)
+	assert.Equal(t, 1337.0, resp[0].ReferencePrice, "Upsert should update existing conditional orders")
+	assert.Equal(t, "1337", resp[0].OrderID)
+	assert.True(t, resp[0].UpdatedAt.Equal(orders[0].UpdatedAt))
+	assert.Equal(t, orders[3].ID, resp[1].ID)
+}
+
+func seedDB() error {
+	return exchange.InsertMany(testExchanges)
+}
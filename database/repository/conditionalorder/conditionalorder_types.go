@@ -0,0 +1,46 @@
+package conditionalorder
+
+import (
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+)
+
+// ConditionalOrder is a DTO for database data
+type ConditionalOrder struct {
+	ID              string
+	ExchangeName    string
+	Base            string
+	Quote           string
+	Asset           string
+	Type            string
+	Side            string
+	Amount          float64
+	TriggerPrice    float64
+	LimitPrice      float64
+	TakeProfitPrice float64
+	EntryPrice      float64
+	TrailingAmount  float64
+	TrailingPercent float64
+	ReferencePrice  float64
+	EntryOrderID    string
+	OrderID         string
+	Status          string
+	FailureReason   string
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// DBService is a service which allows the interaction with
+// the database without a direct reference to a global
+type DBService struct {
+	sql    database.ISQL
+	driver string
+}
+
+// IDBService allows using the conditional order database service
+// without needing to care about implementation
+type IDBService interface {
+	Upsert(...*ConditionalOrder) error
+	GetByStatus(...string) ([]ConditionalOrder, error)
+}
@@ -0,0 +1,230 @@
+package event
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/models/postgres"
+	"github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+)
+
+var (
+	errNilEvent      = errors.New("nil event received")
+	errEventIDNotSet = errors.New("event ID not set")
+)
+
+// Setup returns a DBService
+func Setup(db database.IDatabase) (*DBService, error) {
+	if db == nil {
+		return nil, database.ErrNilInstance
+	}
+	if !db.IsConnected() {
+		return nil, database.ErrDatabaseNotConnected
+	}
+	cfg := db.GetConfig()
+	dbCon, err := db.GetSQL()
+	if err != nil {
+		return nil, err
+	}
+	return &DBService{
+		sql:    dbCon,
+		driver: cfg.Driver,
+	}, nil
+}
+
+// Upsert inserts or updates events in the database
+func (db *DBService) Upsert(events ...*Event) error {
+	for i := range events {
+		if events[i] == nil {
+			return errNilEvent
+		}
+		if events[i].ID <= 0 {
+			return errEventIDNotSet
+		}
+	}
+	ctx := context.TODO()
+	ctx = boil.SkipTimestamps(ctx)
+
+	tx, err := db.sql.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("beginTx %w", err)
+	}
+	defer func() {
+		if err != nil {
+			errRB := tx.Rollback()
+			if errRB != nil {
+				log.Errorf(log.DatabaseMgr, "Upsert tx.Rollback %v", errRB)
+			}
+		}
+	}()
+
+	switch db.driver {
+	case database.DBSQLite3, database.DBSQLite:
+		err = upsertSQLite(ctx, tx, events...)
+	case database.DBPostgreSQL:
+		err = upsertPostgres(ctx, tx, events...)
+	default:
+		return database.ErrNoDatabaseProvided
+	}
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// Delete removes an event from the database
+func (db *DBService) Delete(id int64) error {
+	var err error
+	switch db.driver {
+	case database.DBSQLite3, database.DBSQLite:
+		_, err = sqlite3.Events(qm.Where("id = ?", id)).DeleteAll(context.TODO(), db.sql)
+	case database.DBPostgreSQL:
+		_, err = postgres.Events(qm.Where("id = ?", id)).DeleteAll(context.TODO(), db.sql)
+	default:
+		return database.ErrNoDatabaseProvided
+	}
+	return err
+}
+
+// GetAll returns all events ordered by ID
+func (db *DBService) GetAll() ([]Event, error) {
+	switch db.driver {
+	case database.DBSQLite3, database.DBSQLite:
+		return db.getAllSQLite()
+	case database.DBPostgreSQL:
+		return db.getAllPostgres()
+	default:
+		return nil, database.ErrNoDatabaseProvided
+	}
+}
+
+func upsertSQLite(ctx context.Context, tx *sql.Tx, events ...*Event) error {
+	for i := range events {
+		exch, err := sqlite3.Exchanges(
+			qm.Where("name = ?", strings.ToLower(events[i].ExchangeName))).One(ctx, tx)
+		if err != nil {
+			return fmt.Errorf("could not retrieve exchange '%v', %w", events[i].ExchangeName, err)
+		}
+		tempEvent := sqlite3.Event{
+			ID:             events[i].ID,
+			ExchangeNameID: exch.ID,
+			Base:           strings.ToUpper(events[i].Base),
+			Quote:          strings.ToUpper(events[i].Quote),
+			Asset:          strings.ToLower(events[i].Asset),
+			Definition:     string(events[i].Definition),
+			Executed:       events[i].Executed,
+			CreatedAt:      events[i].CreatedAt.UTC().Format(time.RFC3339),
+			UpdatedAt:      events[i].UpdatedAt.UTC().Format(time.RFC3339),
+		}
+		exists, err := sqlite3.EventExists(ctx, tx, tempEvent.ID)
+		if err != nil {
+			return err
+		}
+		if exists {
+			_, err = tempEvent.Update(ctx, tx, boil.Infer())
+		} else {
+			err = tempEvent.Insert(ctx, tx, boil.Infer())
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func upsertPostgres(ctx context.Context, tx *sql.Tx, events ...*Event) error {
+	for i := range events {
+		exch, err := postgres.Exchanges(
+			qm.Where("name = ?", strings.ToLower(events[i].ExchangeName))).One(ctx, tx)
+		if err != nil {
+			return fmt.Errorf("could not retrieve exchange '%v', %w", events[i].ExchangeName, err)
+		}
+		tempEvent := postgres.Event{
+			ID:             events[i].ID,
+			ExchangeNameID: exch.ID,
+			Base:           strings.ToUpper(events[i].Base),
+			Quote:          strings.ToUpper(events[i].Quote),
+			Asset:          strings.ToLower(events[i].Asset),
+			Definition:     string(events[i].Definition),
+			Executed:       events[i].Executed,
+			CreatedAt:      events[i].CreatedAt.UTC(),
+			UpdatedAt:      events[i].UpdatedAt.UTC(),
+		}
+		err = tempEvent.Upsert(ctx, tx, true, []string{"id"}, boil.Infer(), boil.Infer())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (db *DBService) getAllSQLite() ([]Event, error) {
+	results, err := sqlite3.Events(
+		qm.Load(sqlite3.EventRels.ExchangeName),
+		qm.OrderBy("id")).All(context.TODO(), db.sql)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]Event, len(results))
+	for i := range results {
+		if results[i].R == nil || results[i].R.ExchangeName == nil {
+			return nil, fmt.Errorf("could not retrieve exchange '%v' for event %v", results[i].ExchangeNameID, results[i].ID)
+		}
+		created, err := time.Parse(time.RFC3339, results[i].CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		updated, err := time.Parse(time.RFC3339, results[i].UpdatedAt)
+		if err != nil {
+			return nil, err
+		}
+		resp[i] = Event{
+			ID:           results[i].ID,
+			ExchangeName: results[i].R.ExchangeName.Name,
+			Base:         results[i].Base,
+			Quote:        results[i].Quote,
+			Asset:        results[i].Asset,
+			Definition:   []byte(results[i].Definition),
+			Executed:     results[i].Executed,
+			CreatedAt:    created,
+			UpdatedAt:    updated,
+		}
+	}
+	return resp, nil
+}
+
+func (db *DBService) getAllPostgres() ([]Event, error) {
+	results, err := postgres.Events(
+		qm.Load(postgres.EventRels.ExchangeName),
+		qm.OrderBy("id")).All(context.TODO(), db.sql)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]Event, len(results))
+	for i := range results {
+		if results[i].R == nil || results[i].R.ExchangeName == nil {
+			return nil, fmt.Errorf("could not retrieve exchange '%v' for event %v", results[i].ExchangeNameID, results[i].ID)
+		}
+		resp[i] = Event{
+			ID:           results[i].ID,
+			ExchangeName: results[i].R.ExchangeName.Name,
+			Base:         results[i].Base,
+			Quote:        results[i].Quote,
+			Asset:        results[i].Asset,
+			Definition:   []byte(results[i].Definition),
+			Executed:     results[i].Executed,
+			CreatedAt:    results[i].CreatedAt,
+			UpdatedAt:    results[i].UpdatedAt,
+		}
+	}
+	return resp, nil
+}
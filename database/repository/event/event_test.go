@@ -0,0 +1,145 @@
+package event
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/drivers"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+	"github.com/thrasher-corp/gocryptotrader/database/testhelpers"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+var (
+	verbose       = false
+	testExchanges = []exchange.Details{
+		{
+			Name: "one",
+		},
+	}
+)
+
+func TestMain(m *testing.M) {
+	if verbose {
+		err := testhelpers.EnableVerboseTestOutput()
+		if err != nil {
+			fmt.Printf("failed to enable verbose test output: %v", err)
+			os.Exit(1)
+		}
+	}
+	var err error
+	testhelpers.PostgresTestDatabase = testhelpers.GetConnectionDetails()
+	testhelpers.TempDir, err = os.MkdirTemp("", "gct-temp")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	exitCode := m.Run()
+	if err = os.RemoveAll(testhelpers.TempDir); err != nil {
+		fmt.Printf("failed to remove temp dir: %s", err)
+	}
+	os.Exit(exitCode)
+}
+
+func TestSetup(t *testing.T) {
+	t.Parallel()
+	_, err := Setup(nil)
+	assert.ErrorIs(t, err, database.ErrNilInstance)
+
+	_, err = Setup(&database.Instance{})
+	assert.ErrorIs(t, err, database.ErrDatabaseNotConnected)
+}
+
+func TestEvents(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		name   string
+		config *database.Config
+		seedDB func() error
+	}{
+		{
+			name:   "postgresql",
+			config: testhelpers.PostgresTestDatabase,
+			seedDB: seedDB,
+		},
+		{
+			name: "SQLite",
+			config: &database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+			seedDB: seedDB,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			if !testhelpers.CheckValidConfig(&tc.config.ConnectionDetails) {
+				t.Skip("database not configured skipping test")
+			}
+
+			dbConn, err := testhelpers.ConnectToDatabase(tc.config)
+			require.NoError(t, err)
+
+			if tc.seedDB != nil {
+				require.NoError(t, tc.seedDB())
+			}
+
+			db, err := Setup(dbConn)
+			require.NoError(t, err)
+			eventSQLTester(t, db)
+			assert.NoError(t, testhelpers.CloseDatabase(dbConn))
+		})
+	}
+}
+
+func eventSQLTester(t *testing.T, db *DBService) {
+	t.Helper()
+	require.ErrorIs(t, db.Upsert(nil), errNilEvent)
+	require.ErrorIs(t, db.Upsert(&Event{}), errEventIDNotSet)
+
+	created := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	events := make([]*Event, 3)
+	for i := range events {
+		events[i] = &Event{
+			ID:           int64(len(events) - i),
+			ExchangeName: testExchanges[0].Name,
+			Base:         currency.BTC.String(),
+			Quote:        currency.USDT.String(),
+			Asset:        asset.Spot.String(),
+			Definition:   []byte(`{"item":"PRICE","condition":{"condition":">","price":1000},"action":"ACTION_TEST"}`),
+			CreatedAt:    created,
+			UpdatedAt:    created,
+		}
+	}
+	require.NoError(t, db.Upsert(events...))
+
+	events[0].Executed = true
+	events[0].UpdatedAt = created.Add(time.Hour)
+	require.NoError(t, db.Upsert(events[0]), "Upsert must not error when updating an existing event")
+
+	resp, err := db.GetAll()
+	require.NoError(t, err)
+	require.Len(t, resp, 3)
+	assert.Equal(t, int64(1), resp[0].ID, "GetAll should order by ID")
+	assert.Equal(t, testExchanges[0].Name, resp[0].ExchangeName)
+	assert.Equal(t, events[2].Definition, resp[0].Definition)
+	assert.True(t, resp[2].Executed, "Upsert should update existing events")
+	assert.True(t, resp[2].UpdatedAt.Equal(events[0].UpdatedAt))
+
+	require.NoError(t, db.Delete(2))
+	resp, err = db.GetAll()
+	require.NoError(t, err)
+	require.Len(t, resp, 2)
+	assert.Equal(t, int64(3), resp[1].ID, "Delete should remove the event")
+}
+
+func seedDB() error {
+	return exchange.InsertMany(testExchanges)
+}
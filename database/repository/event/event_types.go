@@ -0,0 +1,35 @@
+package event
+
+import (
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+)
+
+// Event is a DTO for database data
+type Event struct {
+	ID           int64
+	ExchangeName string
+	Base         string
+	Quote        string
+	Asset        string
+	Definition   []byte
+	Executed     bool
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// DBService is a service which allows the interaction with
+// the database without a direct reference to a global
+type DBService struct {
+	sql    database.ISQL
+	driver string
+}
+
+// IDBService allows using the event database service
+// without needing to care about implementation
+type IDBService interface {
+	Upsert(...*Event) error
+	Delete(int64) error
+	GetAll() ([]Event, error)
+}
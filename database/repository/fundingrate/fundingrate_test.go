@@ -0,0 +1,144 @@
+package fundingrate
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/drivers"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+	"github.com/thrasher-corp/gocryptotrader/database/testhelpers"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+var (
+	verbose       = false
+	testExchanges = []exchange.Details{
+		{
+			Name: "one",
+		},
+	}
+)
+
+func TestMain(m *testing.M) {
+	if verbose {
+		err := testhelpers.EnableVerboseTestOutput()
+		if err != nil {
+			fmt.Printf("failed to enable verbose test output: %v", err)
+			os.Exit(1)
+		}
+	}
+	var err error
+	testhelpers.PostgresTestDatabase = testhelpers.GetConnectionDetails()
+	testhelpers.TempDir, err = os.MkdirTemp("", "gct-temp")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	exitCode := m.Run()
+	if err = os.RemoveAll(testhelpers.TempDir); err != nil {
+		fmt.Printf("failed to remove temp dir: %s", err)
+	}
+	os.Exit(exitCode)
+}
+
+func TestFundingRates(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		name   string
+		config *database.Config
+		seedDB func() error
+	}{
+		{
+			name:   "postgresql",
+			config: testhelpers.PostgresTestDatabase,
+			seedDB: seedDB,
+		},
+		{
+			name: "SQLite",
+			config: &database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+			seedDB: seedDB,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			if !testhelpers.CheckValidConfig(&tc.config.ConnectionDetails) {
+				t.Skip("database not configured skipping test")
+			}
+
+			dbConn, err := testhelpers.ConnectToDatabase(tc.config)
+			require.NoError(t, err)
+
+			if tc.seedDB != nil {
+				require.NoError(t, tc.seedDB())
+			}
+
+			fundingRateSQLTester(t)
+			assert.NoError(t, testhelpers.CloseDatabase(dbConn))
+		})
+	}
+}
+
+func fundingRateSQLTester(t *testing.T) {
+	t.Helper()
+	err := Insert(Data{})
+	require.ErrorIs(t, err, errExchangeNotSet)
+
+	firstTime := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	rates := make([]Data, 10)
+	for i := range rates {
+		rates[i] = Data{
+			Timestamp: firstTime.Add(time.Hour * 8 * time.Duration(i)),
+			Exchange:  testExchanges[0].Name,
+			Base:      currency.BTC.String(),
+			Quote:     currency.USDT.String(),
+			AssetType: asset.PerpetualSwap.String(),
+			Rate:      0.0001 * float64(i),
+		}
+	}
+	require.NoError(t, Insert(rates...))
+
+	duplicates := make([]Data, len(rates))
+	for i := range rates {
+		duplicates[i] = rates[i]
+		duplicates[i].ID = ""
+	}
+	require.NoError(t, Insert(duplicates...), "Insert must not error on duplicate funding rates")
+
+	resp, err := GetInRange(
+		testExchanges[0].Name,
+		asset.PerpetualSwap.String(),
+		currency.BTC.String(),
+		currency.USDT.String(),
+		firstTime.Add(-time.Hour),
+		firstTime.Add(time.Hour*80))
+	require.NoError(t, err)
+	require.Len(t, resp, len(rates), "unique constraints must prevent duplicate funding rates")
+	for i := range resp {
+		assert.Truef(t, resp[i].Timestamp.Equal(rates[i].Timestamp), "rate %d timestamp should be ordered", i)
+		assert.Equalf(t, rates[i].Rate, resp[i].Rate, "rate %d should match", i)
+	}
+
+	resp, err = GetInRange(
+		testExchanges[0].Name,
+		asset.PerpetualSwap.String(),
+		currency.BTC.String(),
+		currency.USDT.String(),
+		firstTime.Add(time.Hour*100),
+		firstTime.Add(time.Hour*200))
+	require.NoError(t, err)
+	assert.Empty(t, resp, "GetInRange should return no rates outside of range")
+}
+
+func seedDB() error {
+	return exchange.InsertMany(testExchanges)
+}
@@ -0,0 +1,17 @@
+package fundingrate
+
+import "time"
+
+// Data defines funding rate data in its simplest
+// db friendly form
+type Data struct {
+	ID             string
+	Exchange       string
+	ExchangeNameID string
+	Base           string
+	Quote          string
+	AssetType      string
+	Rate           float64
+	Payment        float64
+	Timestamp      time.Time
+}
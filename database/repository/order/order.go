@@ -0,0 +1,481 @@
+package order
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/models/postgres"
+	"github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+)
+
+var (
+	errNoStatuses    = errors.New("no statuses provided")
+	errNilOrder      = errors.New("nil order received")
+	errOrderIDNotSet = errors.New("order ID not set")
+)
+
+// Setup returns a DBService
+func Setup(db database.IDatabase) (*DBService, error) {
+	if db == nil {
+		return nil, database.ErrNilInstance
+	}
+	if !db.IsConnected() {
+		return nil, database.ErrDatabaseNotConnected
+	}
+	cfg := db.GetConfig()
+	dbCon, err := db.GetSQL()
+	if err != nil {
+		return nil, err
+	}
+	return &DBService{
+		sql:    dbCon,
+		driver: cfg.Driver,
+	}, nil
+}
+
+// Upsert inserts or updates managed orders in the database. Any trades stored
+// against an order are replaced with the trades supplied
+func (db *DBService) Upsert(orders ...*Details) error {
+	for i := range orders {
+		if orders[i] == nil {
+			return errNilOrder
+		}
+		if orders[i].ID == "" {
+			return errOrderIDNotSet
+		}
+	}
+	ctx := context.TODO()
+	ctx = boil.SkipTimestamps(ctx)
+
+	tx, err := db.sql.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("beginTx %w", err)
+	}
+	defer func() {
+		if err != nil {
+			errRB := tx.Rollback()
+			if errRB != nil {
+				log.Errorf(log.DatabaseMgr, "Upsert tx.Rollback %v", errRB)
+			}
+		}
+	}()
+
+	switch db.driver {
+	case database.DBSQLite3, database.DBSQLite:
+		err = upsertSQLite(ctx, tx, orders...)
+	case database.DBPostgreSQL:
+		err = upsertPostgres(ctx, tx, orders...)
+	default:
+		return database.ErrNoDatabaseProvided
+	}
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// GetByStatus returns all managed orders matching any of the supplied statuses
+// ordered by order date
+func (db *DBService) GetByStatus(statuses ...string) ([]Details, error) {
+	if len(statuses) == 0 {
+		return nil, errNoStatuses
+	}
+	args := make([]any, len(statuses))
+	for i := range statuses {
+		args[i] = statuses[i]
+	}
+	query := []qm.QueryMod{qm.WhereIn("status in ?", args...)}
+	switch db.driver {
+	case database.DBSQLite3, database.DBSQLite:
+		return db.getSQLite(query)
+	case database.DBPostgreSQL:
+		return db.getPostgres(query)
+	default:
+		return nil, database.ErrNoDatabaseProvided
+	}
+}
+
+// GetByExchangePair returns all managed orders for an exchange, asset and
+// currency pair ordered by order date. Zero start or end times are not used to
+// filter results
+func (db *DBService) GetByExchangePair(exchangeName, base, quote, asset string, start, end time.Time) ([]Details, error) {
+	switch db.driver {
+	case database.DBSQLite3, database.DBSQLite:
+		exch, err := sqlite3.Exchanges(qm.Where("name = ?", strings.ToLower(exchangeName))).One(context.TODO(), db.sql)
+		if err != nil {
+			return nil, fmt.Errorf("could not retrieve exchange '%v', %w", exchangeName, err)
+		}
+		query := pairQuery(exch.ID, base, quote, asset)
+		if !start.IsZero() {
+			query = append(query, qm.Where("date >= ?", start.UTC().Format(time.RFC3339)))
+		}
+		if !end.IsZero() {
+			query = append(query, qm.Where("date <= ?", end.UTC().Format(time.RFC3339)))
+		}
+		return db.getSQLite(query)
+	case database.DBPostgreSQL:
+		exch, err := postgres.Exchanges(qm.Where("name = ?", strings.ToLower(exchangeName))).One(context.TODO(), db.sql)
+		if err != nil {
+			return nil, fmt.Errorf("could not retrieve exchange '%v', %w", exchangeName, err)
+		}
+		query := pairQuery(exch.ID, base, quote, asset)
+		if !start.IsZero() {
+			query = append(query, qm.Where("date >= ?", start.UTC()))
+		}
+		if !end.IsZero() {
+			query = append(query, qm.Where("date <= ?", end.UTC()))
+		}
+		return db.getPostgres(query)
+	default:
+		return nil, database.ErrNoDatabaseProvided
+	}
+}
+
+func pairQuery(exchangeID, base, quote, asset string) []qm.QueryMod {
+	return []qm.QueryMod{
+		qm.Where("exchange_name_id = ?", exchangeID),
+		qm.Where("base = ?", strings.ToUpper(base)),
+		qm.Where("quote = ?", strings.ToUpper(quote)),
+		qm.Where("asset = ?", strings.ToLower(asset)),
+	}
+}
+
+func upsertSQLite(ctx context.Context, tx *sql.Tx, orders ...*Details) error {
+	for i := range orders {
+		exch, err := sqlite3.Exchanges(
+			qm.Where("name = ?", strings.ToLower(orders[i].ExchangeName))).One(ctx, tx)
+		if err != nil {
+			return fmt.Errorf("could not retrieve exchange '%v', %w", orders[i].ExchangeName, err)
+		}
+		tempOrder := sqlite3.ManagedOrder{
+			ID:                   orders[i].ID,
+			ExchangeNameID:       exch.ID,
+			OrderID:              orders[i].OrderID,
+			ClientOrderID:        orders[i].ClientOrderID,
+			ClientID:             orders[i].ClientID,
+			AccountID:            orders[i].AccountID,
+			Base:                 strings.ToUpper(orders[i].Base),
+			Quote:                strings.ToUpper(orders[i].Quote),
+			Asset:                strings.ToLower(orders[i].Asset),
+			OrderType:            orders[i].Type,
+			Side:                 orders[i].Side,
+			Status:               orders[i].Status,
+			TimeInForce:          orders[i].TimeInForce,
+			MarginType:           orders[i].MarginType,
+			HiddenOrder:          orders[i].HiddenOrder,
+			ReduceOnly:           orders[i].ReduceOnly,
+			Leverage:             orders[i].Leverage,
+			Price:                orders[i].Price,
+			Amount:               orders[i].Amount,
+			ContractAmount:       orders[i].ContractAmount,
+			QuoteAmount:          orders[i].QuoteAmount,
+			LimitPriceUpper:      orders[i].LimitPriceUpper,
+			LimitPriceLower:      orders[i].LimitPriceLower,
+			TriggerPrice:         orders[i].TriggerPrice,
+			AverageExecutedPrice: orders[i].AverageExecutedPrice,
+			ExecutedAmount:       orders[i].ExecutedAmount,
+			RemainingAmount:      orders[i].RemainingAmount,
+			Cost:                 orders[i].Cost,
+			CostAsset:            orders[i].CostAsset,
+			Fee:                  orders[i].Fee,
+			FeeAsset:             orders[i].FeeAsset,
+			SettlementCurrency:   orders[i].SettlementCurrency,
+			Date:                 orders[i].Date.UTC().Format(time.RFC3339),
+			CloseTime:            orders[i].CloseTime.UTC().Format(time.RFC3339),
+			LastUpdated:          orders[i].LastUpdated.UTC().Format(time.RFC3339),
+		}
+		// Replacing the row would cascade and delete its trades, so existing
+		// orders are updated in place
+		exists, err := sqlite3.ManagedOrderExists(ctx, tx, tempOrder.ID)
+		if err != nil {
+			return err
+		}
+		if exists {
+			_, err = tempOrder.Update(ctx, tx, boil.Infer())
+		} else {
+			err = tempOrder.Insert(ctx, tx, boil.Infer())
+		}
+		if err != nil {
+			return err
+		}
+		_, err = sqlite3.ManagedOrderTrades(qm.Where("managed_order_id = ?", tempOrder.ID)).DeleteAll(ctx, tx)
+		if err != nil {
+			return err
+		}
+		for j := range orders[i].Trades {
+			var id uuid.UUID
+			id, err = uuid.NewV4()
+			if err != nil {
+				return err
+			}
+			tempTrade := sqlite3.ManagedOrderTrade{
+				ID:             id.String(),
+				ManagedOrderID: tempOrder.ID,
+				Tid:            orders[i].Trades[j].TID,
+				Price:          orders[i].Trades[j].Price,
+				Amount:         orders[i].Trades[j].Amount,
+				Fee:            orders[i].Trades[j].Fee,
+				FeeAsset:       orders[i].Trades[j].FeeAsset,
+				Total:          orders[i].Trades[j].Total,
+				OrderType:      orders[i].Trades[j].Type,
+				Side:           orders[i].Trades[j].Side,
+				Description:    orders[i].Trades[j].Description,
+				IsMaker:        orders[i].Trades[j].IsMaker,
+				Timestamp:      orders[i].Trades[j].Timestamp.UTC().Format(time.RFC3339),
+			}
+			err = tempTrade.Insert(ctx, tx, boil.Infer())
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func upsertPostgres(ctx context.Context, tx *sql.Tx, orders ...*Details) error {
+	for i := range orders {
+		exch, err := postgres.Exchanges(
+			qm.Where("name = ?", strings.ToLower(orders[i].ExchangeName))).One(ctx, tx)
+		if err != nil {
+			return fmt.Errorf("could not retrieve exchange '%v', %w", orders[i].ExchangeName, err)
+		}
+		tempOrder := postgres.ManagedOrder{
+			ID:                   orders[i].ID,
+			ExchangeNameID:       exch.ID,
+			OrderID:              orders[i].OrderID,
+			ClientOrderID:        orders[i].ClientOrderID,
+			ClientID:             orders[i].ClientID,
+			AccountID:            orders[i].AccountID,
+			Base:                 strings.ToUpper(orders[i].Base),
+			Quote:                strings.ToUpper(orders[i].Quote),
+			Asset:                strings.ToLower(orders[i].Asset),
+			OrderType:            orders[i].Type,
+			Side:                 orders[i].Side,
+			Status:               orders[i].Status,
+			TimeInForce:          orders[i].TimeInForce,
+			MarginType:           orders[i].MarginType,
+			HiddenOrder:          orders[i].HiddenOrder,
+			ReduceOnly:           orders[i].ReduceOnly,
+			Leverage:             orders[i].Leverage,
+			Price:                orders[i].Price,
+			Amount:               orders[i].Amount,
+			ContractAmount:       orders[i].ContractAmount,
+			QuoteAmount:          orders[i].QuoteAmount,
+			LimitPriceUpper:      orders[i].LimitPriceUpper,
+			LimitPriceLower:      orders[i].LimitPriceLower,
+			TriggerPrice:         orders[i].TriggerPrice,
+			AverageExecutedPrice: orders[i].AverageExecutedPrice,
+			ExecutedAmount:       orders[i].ExecutedAmount,
+			RemainingAmount:      orders[i].RemainingAmount,
+			Cost:                 orders[i].Cost,
+			CostAsset:            orders[i].CostAsset,
+			Fee:                  orders[i].Fee,
+			FeeAsset:             orders[i].FeeAsset,
+			SettlementCurrency:   orders[i].SettlementCurrency,
+			Date:                 orders[i].Date.UTC(),
+			CloseTime:            orders[i].CloseTime.UTC(),
+			LastUpdated:          orders[i].LastUpdated.UTC(),
+		}
+		err = tempOrder.Upsert(ctx, tx, true, []string{"id"}, boil.Infer(), boil.Infer())
+		if err != nil {
+			return err
+		}
+		_, err = postgres.ManagedOrderTrades(qm.Where("managed_order_id = ?", tempOrder.ID)).DeleteAll(ctx, tx)
+		if err != nil {
+			return err
+		}
+		for j := range orders[i].Trades {
+			tempTrade := postgres.ManagedOrderTrade{
+				ManagedOrderID: tempOrder.ID,
+				Tid:            orders[i].Trades[j].TID,
+				Price:          orders[i].Trades[j].Price,
+				Amount:         orders[i].Trades[j].Amount,
+				Fee:            orders[i].Trades[j].Fee,
+				FeeAsset:       orders[i].Trades[j].FeeAsset,
+				Total:          orders[i].Trades[j].Total,
+				OrderType:      orders[i].Trades[j].Type,
+				Side:           orders[i].Trades[j].Side,
+				Description:    orders[i].Trades[j].Description,
+				IsMaker:        orders[i].Trades[j].IsMaker,
+				Timestamp:      orders[i].Trades[j].Timestamp.UTC(),
+			}
+			err = tempTrade.Insert(ctx, tx, boil.Infer())
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (db *DBService) getSQLite(query []qm.QueryMod) ([]Details, error) {
+	query = append(query,
+		qm.Load(sqlite3.ManagedOrderRels.ExchangeName),
+		qm.Load(sqlite3.ManagedOrderRels.ManagedOrderTrades, qm.OrderBy("timestamp")),
+		qm.OrderBy("date"))
+	results, err := sqlite3.ManagedOrders(query...).All(context.TODO(), db.sql)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]Details, len(results))
+	for i := range results {
+		if results[i].R == nil || results[i].R.ExchangeName == nil {
+			return nil, fmt.Errorf("could not retrieve exchange '%v' for managed order %v", results[i].ExchangeNameID, results[i].ID)
+		}
+		times := make([]time.Time, 3)
+		for j, t := range []string{results[i].Date, results[i].CloseTime, results[i].LastUpdated} {
+			times[j], err = time.Parse(time.RFC3339, t)
+			if err != nil {
+				return nil, err
+			}
+		}
+		resp[i] = Details{
+			ID:                   results[i].ID,
+			ExchangeName:         results[i].R.ExchangeName.Name,
+			OrderID:              results[i].OrderID,
+			ClientOrderID:        results[i].ClientOrderID,
+			ClientID:             results[i].ClientID,
+			AccountID:            results[i].AccountID,
+			Base:                 results[i].Base,
+			Quote:                results[i].Quote,
+			Asset:                results[i].Asset,
+			Type:                 results[i].OrderType,
+			Side:                 results[i].Side,
+			Status:               results[i].Status,
+			TimeInForce:          results[i].TimeInForce,
+			MarginType:           results[i].MarginType,
+			HiddenOrder:          results[i].HiddenOrder,
+			ReduceOnly:           results[i].ReduceOnly,
+			Leverage:             results[i].Leverage,
+			Price:                results[i].Price,
+			Amount:               results[i].Amount,
+			ContractAmount:       results[i].ContractAmount,
+			QuoteAmount:          results[i].QuoteAmount,
+			LimitPriceUpper:      results[i].LimitPriceUpper,
+			LimitPriceLower:      results[i].LimitPriceLower,
+			TriggerPrice:         results[i].TriggerPrice,
+			AverageExecutedPrice: results[i].AverageExecutedPrice,
+			ExecutedAmount:       results[i].ExecutedAmount,
+			RemainingAmount:      results[i].RemainingAmount,
+			Cost:                 results[i].Cost,
+			CostAsset:            results[i].CostAsset,
+			Fee:                  results[i].Fee,
+			FeeAsset:             results[i].FeeAsset,
+			SettlementCurrency:   results[i].SettlementCurrency,
+			Date:                 times[0],
+			CloseTime:            times[1],
+			LastUpdated:          times[2],
+		}
+		trades := results[i].R.ManagedOrderTrades
+		if len(trades) == 0 {
+			continue
+		}
+		resp[i].Trades = make([]Trade, len(trades))
+		for j := range trades {
+			var ts time.Time
+			ts, err = time.Parse(time.RFC3339, trades[j].Timestamp)
+			if err != nil {
+				return nil, err
+			}
+			resp[i].Trades[j] = Trade{
+				TID:         trades[j].Tid,
+				Price:       trades[j].Price,
+				Amount:      trades[j].Amount,
+				Fee:         trades[j].Fee,
+				FeeAsset:    trades[j].FeeAsset,
+				Total:       trades[j].Total,
+				Type:        trades[j].OrderType,
+				Side:        trades[j].Side,
+				Description: trades[j].Description,
+				IsMaker:     trades[j].IsMaker,
+				Timestamp:   ts,
+			}
+		}
+	}
+	return resp, nil
+}
+
+func (db *DBService) getPostgres(query []qm.QueryMod) ([]Details, error) {
+	query = append(query,
+		qm.Load(postgres.ManagedOrderRels.ExchangeName),
+		qm.Load(postgres.ManagedOrderRels.ManagedOrderTrades, qm.OrderBy("timestamp")),
+		qm.OrderBy("date"))
+	results, err := postgres.ManagedOrders(query...).All(context.TODO(), db.sql)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]Details, len(results))
+	for i := range results {
+		if results[i].R == nil || results[i].R.ExchangeName == nil {
+			return nil, fmt.Errorf("could not retrieve exchange '%v' for managed order %v", results[i].ExchangeNameID, results[i].ID)
+		}
+		resp[i] = Details{
+			ID:                   results[i].ID,
+			ExchangeName:         results[i].R.ExchangeName.Name,
+			OrderID:              results[i].OrderID,
+			ClientOrderID:        results[i].ClientOrderID,
+			ClientID:             results[i].ClientID,
+			AccountID:            results[i].AccountID,
+			Base:                 results[i].Base,
+			Quote:                results[i].Quote,
+			Asset:                results[i].Asset,
+			Type:                 results[i].OrderType,
+			Side:                 results[i].Side,
+			Status:               results[i].Status,
+			TimeInForce:          results[i].TimeInForce,
+			MarginType:           results[i].MarginType,
+			HiddenOrder:          results[i].HiddenOrder,
+			ReduceOnly:           results[i].ReduceOnly,
+			Leverage:             results[i].Leverage,
+			Price:                results[i].Price,
+			Amount:               results[i].Amount,
+			ContractAmount:       results[i].ContractAmount,
+			QuoteAmount:          results[i].QuoteAmount,
+			LimitPriceUpper:      results[i].LimitPriceUpper,
+			LimitPriceLower:      results[i].LimitPriceLower,
+			TriggerPrice:         results[i].TriggerPrice,
+			AverageExecutedPrice: results[i].AverageExecutedPrice,
+			ExecutedAmount:       results[i].ExecutedAmount,
+			RemainingAmount:      results[i].RemainingAmount,
+			Cost:                 results[i].Cost,
+			CostAsset:            results[i].CostAsset,
+			Fee:                  results[i].Fee,
+			FeeAsset:             results[i].FeeAsset,
+			SettlementCurrency:   results[i].SettlementCurrency,
+			Date:                 results[i].Date,
+			CloseTime:            results[i].CloseTime,
+			LastUpdated:          results[i].LastUpdated,
+		}
+		trades := results[i].R.ManagedOrderTrades
+		if len(trades) == 0 {
+			continue
+		}
+		resp[i].Trades = make([]Trade, len(trades))
+		for j := range trades {
+			resp[i].Trades[j] = Trade{
+				TID:         trades[j].Tid,
+				Price:       trades[j].Price,
+				Amount:      trades[j].Amount,
+				Fee:         trades[j].Fee,
+				FeeAsset:    trades[j].FeeAsset,
+				Total:       trades[j].Total,
+				Type:        trades[j].OrderType,
+				Side:        trades[j].Side,
+				Description: trades[j].Description,
+				IsMaker:     trades[j].IsMaker,
+				Timestamp:   trades[j].Timestamp,
+			}
+		}
+	}
+	return resp, nil
+}
@@ -0,0 +1,185 @@
+package order
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/drivers"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+	"github.com/thrasher-corp/gocryptotrader/database/testhelpers"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+var (
+	verbose       = false
+	testExchanges = []exchange.Details{
+		{
+			Name: "one",
+		},
+	}
+)
+
+func TestMain(m *testing.M) {
+	if verbose {
+		err := testhelpers.EnableVerboseTestOutput()
+		if err != nil {
+			fmt.Printf("failed to enable verbose test output: %v", err)
+			os.Exit(1)
+		}
+	}
+	var err error
+	testhelpers.PostgresTestDatabase = testhelpers.GetConnectionDetails()
+	testhelpers.TempDir, err = os.MkdirTemp("", "gct-temp")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	exitCode := m.Run()
+	if err = os.RemoveAll(testhelpers.TempDir); err != nil {
+		fmt.Printf("failed to remove temp dir: %s", err)
+	}
+	os.Exit(exitCode)
+}
+
+func TestSetup(t *testing.T) {
+	t.Parallel()
+	_, err := Setup(nil)
+	assert.ErrorIs(t, err, database.ErrNilInstance)
+
+	_, err = Setup(&database.Instance{})
+	assert.ErrorIs(t, err, database.ErrDatabaseNotConnected)
+}
+
+func TestOrders(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		name   string
+		config *database.Config
+		seedDB func() error
+	}{
+		{
+			name:   "postgresql",
+			config: testhelpers.PostgresTestDatabase,
+			seedDB: seedDB,
+		},
+		{
+			name: "SQLite",
+			config: &database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+			seedDB: seedDB,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			if !testhelpers.CheckValidConfig(&tc.config.ConnectionDetails) {
+				t.Skip("database not configured skipping test")
+			}
+
+			dbConn, err := testhelpers.ConnectToDatabase(tc.config)
+			require.NoError(t, err)
+
+			if tc.seedDB != nil {
+				require.NoError(t, tc.seedDB())
+			}
+
+			db, err := Setup(dbConn)
+			require.NoError(t, err)
+			orderSQLTester(t, db)
+			assert.NoError(t, testhelpers.CloseDatabase(dbConn))
+		})
+	}
+}
+
+func orderSQLTester(t *testing.T, db *DBService) {
+	t.Helper()
+	_, err := db.GetByStatus()
+	require.ErrorIs(t, err, errNoStatuses)
+	require.ErrorIs(t, db.Upsert(nil), errNilOrder)
+	require.ErrorIs(t, db.Upsert(&Details{}), errOrderIDNotSet)
+
+	date := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	orders := make([]*Details, 3)
+	for i := range orders {
+		id, err := uuid.NewV4()
+		require.NoError(t, err)
+		orders[i] = &Details{
+			ID:           id.String(),
+			ExchangeName: testExchanges[0].Name,
+			OrderID:      fmt.Sprintf("order%d", i),
+			Base:         currency.BTC.String(),
+			Quote:        currency.USDT.String(),
+			Asset:        asset.Spot.String(),
+			Type:         "LIMIT",
+			Side:         "BUY",
+			Status:       "NEW",
+			TimeInForce:  "GTC,POSTONLY",
+			Price:        1000,
+			Amount:       1,
+			Date:         date.Add(time.Hour * time.Duration(i)),
+			LastUpdated:  date.Add(time.Hour * time.Duration(i)),
+		}
+	}
+	orders[2].Quote = currency.USD.String()
+	require.NoError(t, db.Upsert(orders...))
+
+	orders[0].Status = "PARTIALLY_FILLED"
+	orders[0].ExecutedAmount = 0.5
+	orders[0].RemainingAmount = 0.5
+	orders[0].Trades = []Trade{
+		{TID: "1", Price: 1000, Amount: 0.25, Side: "BUY", Timestamp: date.Add(time.Minute * 2)},
+		{TID: "2", Price: 999, Amount: 0.25, Side: "BUY", IsMaker: true, Timestamp: date.Add(time.Minute)},
+	}
+	require.NoError(t, db.Upsert(orders[0]), "Upsert must not error when updating an existing order")
+
+	orders[0].Status = "FILLED"
+	orders[0].ExecutedAmount = 1
+	orders[0].RemainingAmount = 0
+	orders[0].Trades = append(orders[0].Trades, Trade{TID: "3", Price: 1001, Amount: 0.5, Side: "BUY", Timestamp: date.Add(time.Minute * 3)})
+	orders[0].CloseTime = date.Add(time.Minute * 3)
+	require.NoError(t, db.Upsert(orders[0]))
+
+	resp, err := db.GetByStatus("NEW")
+	require.NoError(t, err)
+	require.Len(t, resp, 2)
+	assert.Equal(t, orders[1].ID, resp[0].ID, "GetByStatus should order by date")
+	assert.Equal(t, testExchanges[0].Name, resp[0].ExchangeName)
+	assert.Equal(t, "GTC,POSTONLY", resp[0].TimeInForce)
+	assert.Empty(t, resp[0].Trades)
+
+	resp, err = db.GetByStatus("FILLED")
+	require.NoError(t, err)
+	require.Len(t, resp, 1)
+	assert.Equal(t, 1.0, resp[0].ExecutedAmount, "Upsert should update existing orders")
+	assert.True(t, resp[0].CloseTime.Equal(orders[0].CloseTime))
+	require.Len(t, resp[0].Trades, 3, "Upsert should replace existing trades")
+	assert.Equal(t, "2", resp[0].Trades[0].TID, "trades should be ordered by timestamp")
+	assert.True(t, resp[0].Trades[0].IsMaker)
+	assert.True(t, resp[0].Trades[0].Timestamp.Equal(date.Add(time.Minute)))
+
+	resp, err = db.GetByExchangePair(testExchanges[0].Name, "btc", "usdt", "SPOT", time.Time{}, time.Time{})
+	require.NoError(t, err)
+	require.Len(t, resp, 2)
+	assert.Equal(t, orders[0].ID, resp[0].ID)
+
+	resp, err = db.GetByExchangePair(testExchanges[0].Name, "btc", "usdt", "spot", date.Add(time.Minute), date.Add(time.Hour*2))
+	require.NoError(t, err)
+	require.Len(t, resp, 1)
+	assert.Equal(t, orders[1].ID, resp[0].ID)
+
+	_, err = db.GetByExchangePair("bruh", "btc", "usdt", "spot", time.Time{}, time.Time{})
+	assert.Error(t, err, "GetByExchangePair should error for an unknown exchange")
+}
+
+func seedDB() error {
+	return exchange.InsertMany(testExchanges)
+}
@@ -0,0 +1,77 @@
+package order
+
+import (
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+)
+
+// Details is a DTO for managed order database data
+type Details struct {
+	ID                   string
+	ExchangeName         string
+	OrderID              string
+	ClientOrderID        string
+	ClientID             string
+	AccountID            string
+	Base                 string
+	Quote                string
+	Asset                string
+	Type                 string
+	Side                 string
+	Status               string
+	TimeInForce          string
+	MarginType           string
+	HiddenOrder          bool
+	ReduceOnly           bool
+	Leverage             float64
+	Price                float64
+	Amount               float64
+	ContractAmount       float64
+	QuoteAmount          float64
+	LimitPriceUpper      float64
+	LimitPriceLower      float64
+	TriggerPrice         float64
+	AverageExecutedPrice float64
+	ExecutedAmount       float64
+	RemainingAmount      float64
+	Cost                 float64
+	CostAsset            string
+	Fee                  float64
+	FeeAsset             string
+	SettlementCurrency   string
+	Date                 time.Time
+	CloseTime            time.Time
+	LastUpdated          time.Time
+	Trades               []Trade
+}
+
+// Trade is a DTO for managed order fill database data
+type Trade struct {
+	TID         string
+	Price       float64
+	Amount      float64
+	Fee         float64
+	FeeAsset    string
+	Total       float64
+	Type        string
+	Side        string
+	Description string
+	IsMaker     bool
+	Timestamp   time.Time
+}
+
+// DBService is a service which allows the interaction with
+// the database without a direct reference to a global
+type DBService struct {
+	sql    database.ISQL
+	driver string
+}
+
+// IDBService allows using the managed order database service
+// without needing to care about implementation
+type IDBService interface {
+	Upsert(...*Details) error
+	GetByStatus(...string) ([]Details, error)
+	GetByExchangePair(exchangeName, base, quote, asset string, start, end time.Time) ([]Details, error)
+}
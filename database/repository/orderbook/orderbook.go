@@ -0,0 +1,311 @@
+package orderbook
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/models/postgres"
+	"github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
+	"github.com/thrasher-corp/gocryptotrader/database/repository"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+	"github.com/thrasher-corp/gocryptotrader/encoding/json"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+)
+
+var errExchangeNotSet = errors.New("exchange name/uuid not set, cannot insert")
+
+// sqliteTimeFormat is a fixed width timestamp layout so that orderbook
+// updates within the same second are correctly ordered and compared
+const sqliteTimeFormat = "2006-01-02T15:04:05.000000000Z07:00"
+
+var uniqueColumns = []string{"exchange_name_id", "base", "quote", "asset", "timestamp", "update_id"}
+
+// Insert saves orderbook snapshots and updates to the database
+func Insert(books ...Data) error {
+	for i := range books {
+		if books[i].ExchangeNameID == "" && books[i].Exchange != "" {
+			exchangeUUID, err := exchange.UUIDByName(books[i].Exchange)
+			if err != nil {
+				return err
+			}
+			books[i].ExchangeNameID = exchangeUUID.String()
+		} else if books[i].ExchangeNameID == "" && books[i].Exchange == "" {
+			return errExchangeNotSet
+		}
+	}
+
+	ctx := context.TODO()
+	ctx = boil.SkipTimestamps(ctx)
+
+	tx, err := database.DB.SQL.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("beginTx %w", err)
+	}
+	defer func() {
+		if err != nil {
+			errRB := tx.Rollback()
+			if errRB != nil {
+				log.Errorf(log.DatabaseMgr, "Insert tx.Rollback %v", errRB)
+			}
+		}
+	}()
+
+	if repository.GetSQLDialect() == database.DBSQLite3 || repository.GetSQLDialect() == database.DBSQLite {
+		err = insertSQLite(ctx, tx, books...)
+	} else {
+		err = insertPostgres(ctx, tx, books...)
+	}
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func insertSQLite(ctx context.Context, tx *sql.Tx, books ...Data) error {
+	for i := range books {
+		if books[i].ID == "" {
+			freshUUID, err := uuid.NewV4()
+			if err != nil {
+				return err
+			}
+			books[i].ID = freshUUID.String()
+		}
+		bids, asks, err := marshalLevels(&books[i])
+		if err != nil {
+			return err
+		}
+		tempBook := sqlite3.Orderbook{
+			ID:             books[i].ID,
+			ExchangeNameID: books[i].ExchangeNameID,
+			Base:           strings.ToUpper(books[i].Base),
+			Quote:          strings.ToUpper(books[i].Quote),
+			Asset:          strings.ToLower(books[i].AssetType),
+			UpdateID:       books[i].UpdateID,
+			Snapshot:       books[i].Snapshot,
+			Bids:           bids,
+			Asks:           asks,
+			Timestamp:      books[i].Timestamp.UTC().Format(sqliteTimeFormat),
+		}
+		err = tempBook.Insert(ctx, tx, boil.Infer())
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func insertPostgres(ctx context.Context, tx *sql.Tx, books ...Data) error {
+	for i := range books {
+		if books[i].ID == "" {
+			freshUUID, err := uuid.NewV4()
+			if err != nil {
+				return err
+			}
+			books[i].ID = freshUUID.String()
+		}
+		bids, asks, err := marshalLevels(&books[i])
+		if err != nil {
+			return err
+		}
+		tempBook := postgres.Orderbook{
+			ID:             books[i].ID,
+			ExchangeNameID: books[i].ExchangeNameID,
+			Base:           strings.ToUpper(books[i].Base),
+			Quote:          strings.ToUpper(books[i].Quote),
+			Asset:          strings.ToLower(books[i].AssetType),
+			UpdateID:       books[i].UpdateID,
+			Snapshot:       books[i].Snapshot,
+			Bids:           bids,
+			Asks:           asks,
+			Timestamp:      books[i].Timestamp.UTC(),
+		}
+		err = tempBook.Upsert(ctx, tx, false, uniqueColumns, boil.Infer(), boil.Infer())
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetInRange returns all orderbook snapshots and updates for an exchange,
+// asset and pair in a date range ordered by time and update ID
+func GetInRange(exchangeName, assetType, base, quote string, startDate, endDate time.Time) (books []Data, err error) {
+	if repository.GetSQLDialect() == database.DBSQLite3 || repository.GetSQLDialect() == database.DBSQLite {
+		books, err = getInRangeSQLite(exchangeName, assetType, base, quote, startDate, endDate)
+		if err != nil {
+			return books, fmt.Errorf("orderbook.GetInRange getInRangeSQLite %w", err)
+		}
+	} else {
+		books, err = getInRangePostgres(exchangeName, assetType, base, quote, startDate, endDate)
+		if err != nil {
+			return books, fmt.Errorf("orderbook.GetInRange getInRangePostgres %w", err)
+		}
+	}
+
+	return books, nil
+}
+
+// GetLatestSnapshotBefore returns the most recent orderbook snapshot for an
+// exchange, asset and pair at or before the supplied time
+func GetLatestSnapshotBefore(exchangeName, assetType, base, quote string, t time.Time) (Data, error) {
+	exchangeUUID, err := exchange.UUIDByName(exchangeName)
+	if err != nil {
+		return Data{}, err
+	}
+	query := []qm.QueryMod{
+		qm.Where("exchange_name_id = ?", exchangeUUID),
+		qm.Where("asset = ?", strings.ToLower(assetType)),
+		qm.Where("base = ?", strings.ToUpper(base)),
+		qm.Where("quote = ?", strings.ToUpper(quote)),
+		qm.Where("snapshot = ?", true),
+		qm.OrderBy("timestamp desc, update_id desc"),
+	}
+	if repository.GetSQLDialect() == database.DBSQLite3 || repository.GetSQLDialect() == database.DBSQLite {
+		query = append(query, qm.Where("timestamp <= ?", t.UTC().Format(sqliteTimeFormat)))
+		var result *sqlite3.Orderbook
+		result, err = sqlite3.Orderbooks(query...).One(context.TODO(), database.DB.SQL)
+		if err != nil {
+			return Data{}, fmt.Errorf("orderbook.GetLatestSnapshotBefore %w", err)
+		}
+		return sqliteToData(exchangeName, result)
+	}
+	query = append(query, qm.Where("timestamp <= ?", t.UTC()))
+	result, err := postgres.Orderbooks(query...).One(context.TODO(), database.DB.SQL)
+	if err != nil {
+		return Data{}, fmt.Errorf("orderbook.GetLatestSnapshotBefore %w", err)
+	}
+	return postgresToData(exchangeName, result)
+}
+
+func getInRangeSQLite(exchangeName, assetType, base, quote string, startDate, endDate time.Time) ([]Data, error) {
+	exchangeUUID, err := exchange.UUIDByName(exchangeName)
+	if err != nil {
+		return nil, err
+	}
+	wheres := map[string]any{
+		"exchange_name_id": exchangeUUID,
+		"asset":            strings.ToLower(assetType),
+		"base":             strings.ToUpper(base),
+		"quote":            strings.ToUpper(quote),
+	}
+	result, err := sqlite3.Orderbooks(generateQuery(wheres, startDate, endDate, true)...).All(context.TODO(), database.DB.SQL)
+	if err != nil {
+		return nil, err
+	}
+	books := make([]Data, len(result))
+	for i := range result {
+		books[i], err = sqliteToData(exchangeName, result[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return books, nil
+}
+
+func getInRangePostgres(exchangeName, assetType, base, quote string, startDate, endDate time.Time) ([]Data, error) {
+	exchangeUUID, err := exchange.UUIDByName(exchangeName)
+	if err != nil {
+		return nil, err
+	}
+	wheres := map[string]any{
+		"exchange_name_id": exchangeUUID,
+		"asset":            strings.ToLower(assetType),
+		"base":             strings.ToUpper(base),
+		"quote":            strings.ToUpper(quote),
+	}
+	result, err := postgres.Orderbooks(generateQuery(wheres, startDate, endDate, false)...).All(context.TODO(), database.DB.SQL)
+	if err != nil {
+		return nil, err
+	}
+	books := make([]Data, len(result))
+	for i := range result {
+		books[i], err = postgresToData(exchangeName, result[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return books, nil
+}
+
+func sqliteToData(exchangeName string, result *sqlite3.Orderbook) (Data, error) {
+	ts, err := time.Parse(time.RFC3339Nano, result.Timestamp)
+	if err != nil {
+		return Data{}, err
+	}
+	resp := Data{
+		ID:             result.ID,
+		Exchange:       strings.ToLower(exchangeName),
+		ExchangeNameID: result.ExchangeNameID,
+		Base:           strings.ToUpper(result.Base),
+		Quote:          strings.ToUpper(result.Quote),
+		AssetType:      strings.ToLower(result.Asset),
+		UpdateID:       result.UpdateID,
+		Snapshot:       result.Snapshot,
+		Timestamp:      ts,
+	}
+	return resp, unmarshalLevels(&resp, result.Bids, result.Asks)
+}
+
+func postgresToData(exchangeName string, result *postgres.Orderbook) (Data, error) {
+	resp := Data{
+		ID:             result.ID,
+		Exchange:       strings.ToLower(exchangeName),
+		ExchangeNameID: result.ExchangeNameID,
+		Base:           strings.ToUpper(result.Base),
+		Quote:          strings.ToUpper(result.Quote),
+		AssetType:      strings.ToLower(result.Asset),
+		UpdateID:       result.UpdateID,
+		Snapshot:       result.Snapshot,
+		Timestamp:      result.Timestamp.UTC(),
+	}
+	return resp, unmarshalLevels(&resp, result.Bids, result.Asks)
+}
+
+func marshalLevels(d *Data) (bids, asks string, err error) {
+	b, err := json.Marshal(d.Bids)
+	if err != nil {
+		return "", "", err
+	}
+	a, err := json.Marshal(d.Asks)
+	if err != nil {
+		return "", "", err
+	}
+	return string(b), string(a), nil
+}
+
+func unmarshalLevels(d *Data, bids, asks string) error {
+	if err := json.Unmarshal([]byte(bids), &d.Bids); err != nil {
+		return fmt.Errorf("could not unmarshal bids %w", err)
+	}
+	if err := json.Unmarshal([]byte(asks), &d.Asks); err != nil {
+		return fmt.Errorf("could not unmarshal asks %w", err)
+	}
+	return nil
+}
+
+func generateQuery(clauses map[string]any, start, end time.Time, isSQLite bool) []qm.QueryMod {
+	query := []qm.QueryMod{
+		qm.OrderBy("timestamp, update_id"),
+	}
+	if isSQLite {
+		query = append(query, qm.Where("timestamp BETWEEN ? AND ?", start.UTC().Format(sqliteTimeFormat), end.UTC().Format(sqliteTimeFormat)))
+	} else {
+		query = append(query, qm.Where("timestamp BETWEEN ? AND ?", start.UTC(), end.UTC()))
+	}
+	for k, v := range clauses {
+		query = append(query, qm.Where(k+` = ?`, v))
+	}
+
+	return query
+}
@@ -0,0 +1,159 @@
+package orderbook
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/drivers"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+	"github.com/thrasher-corp/gocryptotrader/database/testhelpers"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+var (
+	verbose       = false
+	testExchanges = []exchange.Details{
+		{
+			Name: "one",
+		},
+	}
+)
+
+func TestMain(m *testing.M) {
+	if verbose {
+		err := testhelpers.EnableVerboseTestOutput()
+		if err != nil {
+			fmt.Printf("failed to enable verbose test output: %v", err)
+			os.Exit(1)
+		}
+	}
+	var err error
+	testhelpers.PostgresTestDatabase = testhelpers.GetConnectionDetails()
+	testhelpers.TempDir, err = os.MkdirTemp("", "gct-temp")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	exitCode := m.Run()
+	if err = os.RemoveAll(testhelpers.TempDir); err != nil {
+		fmt.Printf("failed to remove temp dir: %s", err)
+	}
+	os.Exit(exitCode)
+}
+
+func TestOrderbooks(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		name   string
+		config *database.Config
+		seedDB func() error
+	}{
+		{
+			name:   "postgresql",
+			config: testhelpers.PostgresTestDatabase,
+			seedDB: seedDB,
+		},
+		{
+			name: "SQLite",
+			config: &database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+			seedDB: seedDB,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			if !testhelpers.CheckValidConfig(&tc.config.ConnectionDetails) {
+				t.Skip("database not configured skipping test")
+			}
+
+			dbConn, err := testhelpers.ConnectToDatabase(tc.config)
+			require.NoError(t, err)
+
+			if tc.seedDB != nil {
+				require.NoError(t, tc.seedDB())
+			}
+
+			orderbookSQLTester(t)
+			assert.NoError(t, testhelpers.CloseDatabase(dbConn))
+		})
+	}
+}
+
+func orderbookSQLTester(t *testing.T) {
+	t.Helper()
+	err := Insert(Data{})
+	require.ErrorIs(t, err, errExchangeNotSet)
+
+	firstTime := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	books := make([]Data, 10)
+	for i := range books {
+		books[i] = Data{
+			Timestamp: firstTime.Add(time.Millisecond * 100 * time.Duration(i)),
+			Exchange:  testExchanges[0].Name,
+			Base:      currency.BTC.String(),
+			Quote:     currency.USDT.String(),
+			AssetType: asset.Spot.String(),
+			UpdateID:  int64(i + 1),
+			Snapshot:  i%5 == 0,
+			Bids:      []Level{{Price: 100 - float64(i), Amount: 1}},
+			Asks:      []Level{{Price: 101 + float64(i), Amount: 2}},
+		}
+	}
+	require.NoError(t, Insert(books...))
+
+	duplicates := make([]Data, len(books))
+	for i := range books {
+		duplicates[i] = books[i]
+		duplicates[i].ID = ""
+	}
+	require.NoError(t, Insert(duplicates...), "Insert must not error on duplicate orderbook updates")
+
+	resp, err := GetInRange(
+		testExchanges[0].Name,
+		asset.Spot.String(),
+		currency.BTC.String(),
+		currency.USDT.String(),
+		firstTime.Add(-time.Hour),
+		firstTime.Add(time.Hour))
+	require.NoError(t, err)
+	require.Len(t, resp, len(books), "unique constraints must prevent duplicate orderbook updates")
+	for i := range resp {
+		assert.Truef(t, resp[i].Timestamp.Equal(books[i].Timestamp), "book %d timestamp should be ordered", i)
+		assert.Equalf(t, books[i].UpdateID, resp[i].UpdateID, "book %d update ID should match", i)
+		assert.Equalf(t, books[i].Snapshot, resp[i].Snapshot, "book %d snapshot should match", i)
+		assert.Equalf(t, books[i].Bids, resp[i].Bids, "book %d bids should match", i)
+		assert.Equalf(t, books[i].Asks, resp[i].Asks, "book %d asks should match", i)
+	}
+
+	snapshot, err := GetLatestSnapshotBefore(
+		testExchanges[0].Name,
+		asset.Spot.String(),
+		currency.BTC.String(),
+		currency.USDT.String(),
+		firstTime.Add(time.Millisecond*700))
+	require.NoError(t, err)
+	assert.Equal(t, books[5].UpdateID, snapshot.UpdateID, "GetLatestSnapshotBefore should return the latest snapshot")
+
+	resp, err = GetInRange(
+		testExchanges[0].Name,
+		asset.Spot.String(),
+		currency.BTC.String(),
+		currency.USDT.String(),
+		firstTime.Add(time.Hour*100),
+		firstTime.Add(time.Hour*200))
+	require.NoError(t, err)
+	assert.Empty(t, resp, "GetInRange should return no orderbook updates outside of range")
+}
+
+func seedDB() error {
+	return exchange.InsertMany(testExchanges)
+}
@@ -0,0 +1,26 @@
+package orderbook
+
+import "time"
+
+// Data defines a recorded orderbook snapshot or incremental update in its
+// simplest db friendly form
+type Data struct {
+	ID             string
+	Exchange       string
+	ExchangeNameID string
+	Base           string
+	Quote          string
+	AssetType      string
+	UpdateID       int64
+	Snapshot       bool
+	Bids           []Level
+	Asks           []Level
+	Timestamp      time.Time
+}
+
+// Level defines an orderbook price level. An incremental update level with an
+// amount of zero removes the price level from the book
+type Level struct {
+	Price  float64 `json:"price"`
+	Amount float64 `json:"amount"`
+}
@@ -3,6 +3,8 @@ package withdraw
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
+	"fmt"
 	"time"
 
 	"github.com/gofrs/uuid"
@@ -13,10 +15,12 @@ import (
 	modelSQLite "github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
 	"github.com/thrasher-corp/gocryptotrader/database/repository"
 	exchangeDB "github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/log"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
 	"github.com/thrasher-corp/sqlboiler/boil"
 	"github.com/thrasher-corp/sqlboiler/queries/qm"
+	"github.com/volatiletech/null"
 )
 
 // Event stores Withdrawal Response details in database
@@ -24,45 +28,86 @@ func Event(res *withdraw.Response) {
 	if database.DB.SQL == nil {
 		return
 	}
+	if err := insertEvent(res, nil); err != nil {
+		log.Errorln(log.DatabaseMgr, err)
+	}
+}
 
+// AddPending stores a withdrawal request awaiting approval under its response
+// ID along with its approval state. Trade passwords, one time passwords and
+// PINs are not stored
+func AddPending(res *withdraw.Response, approval *Approval) error {
+	if database.DB.SQL == nil {
+		return database.ErrDatabaseSupportDisabled
+	}
+	if res.ID == uuid.Nil {
+		return fmt.Errorf("pending withdrawal %w", errIDUnset)
+	}
+	if approval == nil {
+		return fmt.Errorf("pending withdrawal %s %w", res.ID, errApprovalUnset)
+	}
+	cpy := *res
+	return insertEvent(&cpy, approval)
+}
+
+// insertEvent stores a withdrawal response and its approval state in a single
+// transaction
+func insertEvent(res *withdraw.Response, approval *Approval) error {
 	ctx := context.Background()
 	ctx = boil.SkipTimestamps(ctx)
 
 	exchangeUUID, err := exchangeDB.UUIDByName(res.Exchange.Name)
 	if err != nil {
-		log.Errorln(log.DatabaseMgr, err)
-		return
+		return err
+	}
+
+	var approvalJSON null.String
+	if approval != nil {
+		approvalJSON, err = marshalApproval(approval)
+		if err != nil {
+			return err
+		}
 	}
 
 	res.Exchange.Name = exchangeUUID.String()
 	tx, err := database.DB.SQL.BeginTx(ctx, nil)
 	if err != nil {
-		log.Errorf(log.DatabaseMgr, "Event transaction being failed: %v", err)
-		return
+		return fmt.Errorf("event transaction begin failed: %w", err)
 	}
 
 	if repository.GetSQLDialect() == database.DBSQLite3 {
-		err = addSQLiteEvent(ctx, tx, res)
+		err = addSQLiteEvent(ctx, tx, res, approvalJSON)
 	} else {
-		err = addPSQLEvent(ctx, tx, res)
+		err = addPSQLEvent(ctx, tx, res, approvalJSON)
 	}
 	if err != nil {
-		log.Errorf(log.DatabaseMgr, "Event insert failed: %v", err)
-		err = tx.Rollback()
-		if err != nil {
-			log.Errorf(log.DatabaseMgr, "Event Transaction rollback failed: %v", err)
+		if errRb := tx.Rollback(); errRb != nil {
+			log.Errorf(log.DatabaseMgr, "Event Transaction rollback failed: %v", errRb)
 		}
-		return
+		return fmt.Errorf("event insert failed: %w", err)
 	}
 
-	err = tx.Commit()
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("event transaction commit failed: %w", err)
+	}
+	return nil
+}
+
+// marshalApproval encodes an approval state for storage without the request
+// secrets
+func marshalApproval(approval *Approval) (null.String, error) {
+	a := *approval
+	a.Request.TradePassword = ""
+	a.Request.OneTimePassword = 0
+	a.Request.PIN = 0
+	b, err := json.Marshal(&a)
 	if err != nil {
-		log.Errorf(log.DatabaseMgr, "Event Transaction commit failed: %v", err)
-		return
+		return null.String{}, err
 	}
+	return null.StringFrom(string(b)), nil
 }
 
-func addPSQLEvent(ctx context.Context, tx *sql.Tx, res *withdraw.Response) (err error) {
+func addPSQLEvent(ctx context.Context, tx *sql.Tx, res *withdraw.Response, approval null.String) (err error) {
 	tempEvent := modelPSQL.WithdrawalHistory{
 		ExchangeNameID: res.Exchange.Name,
 		ExchangeID:     res.Exchange.ID,
@@ -70,6 +115,12 @@ func addPSQLEvent(ctx context.Context, tx *sql.Tx, res *withdraw.Response) (err
 		Currency:       res.RequestDetails.Currency.String(),
 		Amount:         res.RequestDetails.Amount,
 		WithdrawType:   int(res.RequestDetails.Type),
+		Asset:          res.RequestDetails.Asset.String(),
+		Approval:       approval,
+	}
+	if approval.Valid {
+		// Requests awaiting approval keep their ID so approvals can be stored against it
+		tempEvent.ID = res.ID.String()
 	}
 
 	if res.RequestDetails.Description != "" {
@@ -132,15 +183,20 @@ func addPSQLEvent(ctx context.Context, tx *sql.Tx, res *withdraw.Response) (err
 	return nil
 }
 
-func addSQLiteEvent(ctx context.Context, tx *sql.Tx, res *withdraw.Response) (err error) {
-	newUUID, errUUID := uuid.NewV4()
-	if errUUID != nil {
-		log.Errorf(log.DatabaseMgr, "Failed to generate UUID: %v", errUUID)
-		err = tx.Rollback()
-		if err != nil {
-			log.Errorf(log.DatabaseMgr, "Rollback failed: %v", err)
+func addSQLiteEvent(ctx context.Context, tx *sql.Tx, res *withdraw.Response, approval null.String) (err error) {
+	newUUID := res.ID
+	if !approval.Valid {
+		// Only requests awaiting approval keep their ID so approvals can be stored against it
+		var errUUID error
+		newUUID, errUUID = uuid.NewV4()
+		if errUUID != nil {
+			log.Errorf(log.DatabaseMgr, "Failed to generate UUID: %v", errUUID)
+			err = tx.Rollback()
+			if err != nil {
+				log.Errorf(log.DatabaseMgr, "Rollback failed: %v", err)
+			}
+			return errUUID
 		}
-		return
 	}
 
 	tempEvent := modelSQLite.WithdrawalHistory{
@@ -151,6 +207,8 @@ func addSQLiteEvent(ctx context.Context, tx *sql.Tx, res *withdraw.Response) (er
 		Currency:       res.RequestDetails.Currency.String(),
 		Amount:         res.RequestDetails.Amount,
 		WithdrawType:   int64(res.RequestDetails.Type),
+		Asset:          res.RequestDetails.Asset.String(),
+		Approval:       approval,
 	}
 
 	if res.RequestDetails.Description != "" {
@@ -261,6 +319,104 @@ func GetEventsByDate(exchange string, start, end time.Time, limit int) ([]*withd
 	return getByColumns(append(generateWhereQuery([]string{"exchange_name_id"}, []string{exch.String()}, 0), betweenQuery...))
 }
 
+// UpdateStatus updates the exchange status of a stored withdrawal request
+func UpdateStatus(id, status string) error {
+	return update(id, map[string]any{
+		modelSQLite.WithdrawalHistoryColumns.Status: status,
+	})
+}
+
+// UpdateExchangeResponse updates the exchange ID and status of a stored
+// withdrawal request once it has been submitted to its exchange
+func UpdateExchangeResponse(id string, res *withdraw.ExchangeResponse) error {
+	if res == nil {
+		return fmt.Errorf("withdrawal %s %w", id, errExchangeResponseUnset)
+	}
+	return update(id, map[string]any{
+		modelSQLite.WithdrawalHistoryColumns.ExchangeID: res.ID,
+		modelSQLite.WithdrawalHistoryColumns.Status:     res.Status,
+	})
+}
+
+// UpdateApproval updates the approval state of a stored withdrawal request
+func UpdateApproval(id string, approval *Approval) error {
+	if approval == nil {
+		return fmt.Errorf("withdrawal %s %w", id, errApprovalUnset)
+	}
+	a, err := marshalApproval(approval)
+	if err != nil {
+		return err
+	}
+	return update(id, map[string]any{
+		modelSQLite.WithdrawalHistoryColumns.Approval: a,
+	})
+}
+
+// update sets columns of a stored withdrawal request along with its updated
+// time
+func update(id string, columns map[string]any) error {
+	if database.DB.SQL == nil {
+		return database.ErrDatabaseSupportDisabled
+	}
+
+	ctx := context.Background()
+	ctx = boil.SkipTimestamps(ctx)
+
+	var updated int64
+	var err error
+	if repository.GetSQLDialect() == database.DBSQLite3 {
+		cols := modelSQLite.M(columns)
+		cols[modelSQLite.WithdrawalHistoryColumns.UpdatedAt] = time.Now().UTC().Format(time.RFC3339)
+		updated, err = modelSQLite.WithdrawalHistories(qm.Where("id = ?", id)).UpdateAll(ctx, database.DB.SQL, cols)
+	} else {
+		cols := modelPSQL.M(columns)
+		cols[modelPSQL.WithdrawalHistoryColumns.UpdatedAt] = time.Now().UTC()
+		updated, err = modelPSQL.WithdrawalHistories(qm.Where("id = ?", id)).UpdateAll(ctx, database.DB.SQL, cols)
+	}
+	if err != nil {
+		return err
+	}
+	if updated == 0 {
+		return fmt.Errorf("%w for withdrawal %s", common.ErrNoResults, id)
+	}
+	return nil
+}
+
+// GetRecordsSince returns withdrawal requests stored since the start time
+// along with the approval state of requests which required approval, oldest
+// first
+func GetRecordsSince(start time.Time) ([]*Record, error) {
+	return getRecordsByColumns([]qm.QueryMod{
+		qm.Where("created_at >= ?", start.UTC()),
+		qm.OrderBy("created_at"),
+	})
+}
+
+// parseAsset returns the asset of a stored withdrawal request. Requests
+// stored before assets were recorded return an empty asset
+func parseAsset(id, a string) asset.Item {
+	if a == "" {
+		return asset.Empty
+	}
+	item, err := asset.New(a)
+	if err != nil {
+		log.Errorf(log.DatabaseMgr, "record: %v has an invalid asset: %v", id, err)
+	}
+	return item
+}
+
+// unmarshalApproval decodes the stored approval state of a withdrawal request
+func unmarshalApproval(s null.String) (*Approval, error) {
+	if !s.Valid || s.String == "" {
+		return nil, nil
+	}
+	var a Approval
+	if err := json.Unmarshal([]byte(s.String), &a); err != nil {
+		return nil, err
+	}
+	return &a, nil
+}
+
 func generateWhereQuery(columns, id []string, limit int) []qm.QueryMod {
 	x := len(columns)
 	if limit > 0 {
@@ -284,11 +440,23 @@ func generateWhereBetweenQuery(column string, start, end any, limit int) []qm.Qu
 }
 
 func getByColumns(q []qm.QueryMod) ([]*withdraw.Response, error) {
+	records, err := getRecordsByColumns(q)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]*withdraw.Response, len(records))
+	for i := range records {
+		resp[i] = records[i].Response
+	}
+	return resp, nil
+}
+
+func getRecordsByColumns(q []qm.QueryMod) ([]*Record, error) {
 	if database.DB.SQL == nil {
 		return nil, database.ErrDatabaseSupportDisabled
 	}
 
-	var resp []*withdraw.Response
+	var resp []*Record
 	ctx := context.Background()
 	if repository.GetSQLDialect() == database.DBSQLite3 {
 		v, err := modelSQLite.WithdrawalHistories(q...).All(ctx, database.DB.SQL)
@@ -310,6 +478,7 @@ func getByColumns(q []qm.QueryMod) ([]*withdraw.Response, error) {
 				Description: v[x].Description.String,
 				Amount:      v[x].Amount,
 				Type:        withdraw.RequestType(v[x].WithdrawType),
+				Asset:       parseAsset(v[x].ID, v[x].Asset),
 			}
 
 			exchangeName, err := v[x].ExchangeName().One(ctx, database.DB.SQL)
@@ -360,7 +529,11 @@ func getByColumns(q []qm.QueryMod) ([]*withdraw.Response, error) {
 				tempResp.RequestDetails.Fiat.Bank.SWIFTCode = x.SwiftCode
 				tempResp.RequestDetails.Fiat.Bank.BSBNumber = x.BSB
 			}
-			resp = append(resp, tempResp)
+			approval, err := unmarshalApproval(v[x].Approval)
+			if err != nil {
+				return nil, fmt.Errorf("record %v approval: %w", v[x].ID, err)
+			}
+			resp = append(resp, &Record{Response: tempResp, Approval: approval})
 		}
 	} else {
 		v, err := modelPSQL.WithdrawalHistories(q...).All(ctx, database.DB.SQL)
@@ -379,6 +552,7 @@ func getByColumns(q []qm.QueryMod) ([]*withdraw.Response, error) {
 				Description: v[x].Description.String,
 				Amount:      v[x].Amount,
 				Type:        withdraw.RequestType(v[x].WithdrawType),
+				Asset:       parseAsset(v[x].ID, v[x].Asset),
 			}
 			tempResp.CreatedAt = v[x].CreatedAt
 			tempResp.UpdatedAt = v[x].UpdatedAt
@@ -415,7 +589,11 @@ func getByColumns(q []qm.QueryMod) ([]*withdraw.Response, error) {
 				tempResp.RequestDetails.Fiat.Bank.SWIFTCode = x.SwiftCode
 				tempResp.RequestDetails.Fiat.Bank.BSBNumber = x.BSB
 			}
-			resp = append(resp, tempResp)
+			approval, err := unmarshalApproval(v[x].Approval)
+			if err != nil {
+				return nil, fmt.Errorf("record %v approval: %w", v[x].ID, err)
+			}
+			resp = append(resp, &Record{Response: tempResp, Approval: approval})
 		}
 	}
 	if len(resp) == 0 {
@@ -4,9 +4,11 @@ import (
 	"fmt"
 	"math/rand"
 	"os"
+	"slices"
 	"testing"
 	"time"
 
+	"github.com/gofrs/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/thrasher-corp/gocryptotrader/common"
@@ -15,6 +17,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/database/drivers"
 	"github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
 	"github.com/thrasher-corp/gocryptotrader/database/testhelpers"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/banking"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
 )
@@ -187,4 +190,72 @@ func withdrawHelper(t *testing.T) {
 	if err != nil {
 		t.Error(err)
 	}
+
+	err = UpdateStatus(withdraw.DryRunID.String(), "completed")
+	assert.ErrorIs(t, err, common.ErrNoResults)
+
+	if len(v) > 0 {
+		err = UpdateStatus(v[0].ID.String(), "completed")
+		require.NoError(t, err, "UpdateStatus must not error")
+		updated, err := GetEventByUUID(v[0].ID.String())
+		require.NoError(t, err, "GetEventByUUID must not error")
+		assert.Equal(t, "completed", updated.Exchange.Status)
+	}
+
+	pendingHelper(t)
+}
+
+func pendingHelper(t *testing.T) {
+	t.Helper()
+	id, err := uuid.NewV4()
+	require.NoError(t, err, "NewV4 must not error")
+	req := withdraw.Request{
+		Exchange:      testExchanges[0].Name,
+		Currency:      currency.BTC,
+		Amount:        2,
+		Type:          withdraw.Crypto,
+		Asset:         asset.Futures,
+		TradePassword: "hunter2",
+		PIN:           1234,
+		Crypto:        withdraw.CryptoRequest{Address: "1337", Chain: "bitcoin"},
+	}
+	res := &withdraw.Response{
+		ID:             id,
+		Exchange:       withdraw.ExchangeResponse{Name: testExchanges[0].Name, Status: "pending approval"},
+		RequestDetails: req,
+	}
+	assert.ErrorIs(t, AddPending(&withdraw.Response{}, &Approval{}), errIDUnset)
+	assert.ErrorIs(t, AddPending(res, nil), errApprovalUnset)
+	expires := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+	approval := &Approval{Requester: "alice", RequiredApprovals: 2, ExpiresAt: expires, Request: req}
+	require.NoError(t, AddPending(res, approval), "AddPending must not error")
+	assert.Equal(t, testExchanges[0].Name, res.Exchange.Name, "AddPending should not alter the response")
+
+	approval.Approvals = []Approver{{Name: "bob", Time: time.Now().UTC().Truncate(time.Second)}}
+	require.NoError(t, UpdateApproval(id.String(), approval), "UpdateApproval must not error")
+	assert.ErrorIs(t, UpdateApproval(id.String(), nil), errApprovalUnset)
+
+	records, err := GetRecordsSince(time.Now().Add(-time.Hour))
+	require.NoError(t, err, "GetRecordsSince must not error")
+	i := slices.IndexFunc(records, func(r *Record) bool { return r.ID == id })
+	require.NotEqual(t, -1, i, "GetRecordsSince must return the pending withdrawal")
+	r := records[i]
+	assert.Equal(t, asset.Futures, r.RequestDetails.Asset)
+	assert.Equal(t, "pending approval", r.Exchange.Status)
+	require.NotNil(t, r.Approval, "Approval must be returned")
+	assert.Equal(t, "alice", r.Approval.Requester)
+	assert.Equal(t, 2, r.Approval.RequiredApprovals)
+	assert.True(t, expires.Equal(r.Approval.ExpiresAt), "ExpiresAt should be stored")
+	require.Len(t, r.Approval.Approvals, 1)
+	assert.Equal(t, "bob", r.Approval.Approvals[0].Name)
+	assert.Equal(t, "bitcoin", r.Approval.Request.Crypto.Chain)
+	assert.Empty(t, r.Approval.Request.TradePassword, "trade passwords should not be stored")
+	assert.Zero(t, r.Approval.Request.PIN, "PINs should not be stored")
+
+	assert.ErrorIs(t, UpdateExchangeResponse(id.String(), nil), errExchangeResponseUnset)
+	require.NoError(t, UpdateExchangeResponse(id.String(), &withdraw.ExchangeResponse{ID: "1337", Status: "processing"}), "UpdateExchangeResponse must not error")
+	updated, err := GetEventByUUID(id.String())
+	require.NoError(t, err, "GetEventByUUID must not error")
+	assert.Equal(t, "1337", updated.Exchange.ID)
+	assert.Equal(t, "processing", updated.Exchange.Status)
 }
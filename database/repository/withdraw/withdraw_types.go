@@ -0,0 +1,38 @@
+package withdraw
+
+import (
+	"errors"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
+)
+
+var (
+	errIDUnset               = errors.New("ID unset")
+	errApprovalUnset         = errors.New("approval unset")
+	errExchangeResponseUnset = errors.New("exchange response unset")
+)
+
+// Approval is the approval state of a withdrawal request awaiting approval.
+// The request is stored with it as the withdrawal tables do not hold every
+// request field
+type Approval struct {
+	Requester         string           `json:"requester,omitempty"`
+	RequiredApprovals int              `json:"requiredApprovals"`
+	Approvals         []Approver       `json:"approvals,omitempty"`
+	ExpiresAt         time.Time        `json:"expiresAt"`
+	Request           withdraw.Request `json:"request"`
+}
+
+// Approver is a user who approved a withdrawal request
+type Approver struct {
+	Name string    `json:"name"`
+	Time time.Time `json:"time"`
+}
+
+// Record is a stored withdrawal request and its approval state when it
+// required approval
+type Record struct {
+	*withdraw.Response
+	Approval *Approval
+}
@@ -76,6 +76,12 @@ func IsRunning() bool {
 	return dispatcher.isRunning()
 }
 
+// GetQueueDepth returns the number of jobs waiting to be relayed and the jobs
+// limit of the dispatch service.
+func GetQueueDepth() (depth, limit int) {
+	return dispatcher.getQueueDepth()
+}
+
 // start sets defaults and config and spawns workers.
 // Does not provide locking protection.
 func (d *Dispatcher) start(workers, channelCapacity int) error {
@@ -167,6 +173,17 @@ func (d *Dispatcher) isRunning() bool {
 	return d.running
 }
 
+// getQueueDepth returns the length and capacity of the jobs channel.
+func (d *Dispatcher) getQueueDepth() (depth, limit int) {
+	if d == nil {
+		return 0, 0
+	}
+
+	d.m.RLock()
+	defer d.m.RUnlock()
+	return len(d.jobs), cap(d.jobs)
+}
+
 // relayer routine relays communications across the defined routes.
 func (d *Dispatcher) relayer() {
 	for {
@@ -80,6 +80,32 @@ func TestStartStop(t *testing.T) {
 	assert.False(t, d.isRunning(), "IsRunning should return false")
 }
 
+func TestGetQueueDepth(t *testing.T) {
+	t.Parallel()
+	var d *Dispatcher
+	depth, limit := d.getQueueDepth()
+	assert.Zero(t, depth, "getQueueDepth should return zero depth on a nil dispatcher")
+	assert.Zero(t, limit, "getQueueDepth should return zero limit on a nil dispatcher")
+
+	d = NewDispatcher()
+	depth, limit = d.getQueueDepth()
+	assert.Zero(t, depth, "getQueueDepth should return zero depth when not started")
+	assert.Zero(t, limit, "getQueueDepth should return zero limit when not started")
+
+	require.NoError(t, d.start(1, 50), "start must not error")
+	id, err := d.getNewID(uuid.NewV4)
+	require.NoError(t, err, "getNewID must not error")
+	_, err = d.subscribe(id)
+	require.NoError(t, err, "subscribe must not error")
+	for range 10 {
+		require.NoError(t, d.publish(id, "woah-nelly"), "publish must not error")
+	}
+	depth, limit = d.getQueueDepth()
+	assert.LessOrEqual(t, depth, 10, "getQueueDepth should not return more jobs than published")
+	assert.Equal(t, 50, limit, "getQueueDepth should return the jobs limit")
+	require.NoError(t, d.stop(), "stop must not error")
+}
+
 func TestSubscribe(t *testing.T) {
 	t.Parallel()
 	var d *Dispatcher
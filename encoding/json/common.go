@@ -15,4 +15,7 @@ type (
 	UnmarshalTypeError = json.UnmarshalTypeError
 	// A SyntaxError describes improper JSON
 	SyntaxError = json.SyntaxError
+	// A Number represents a JSON number literal, decoded when the decoder
+	// is set to UseNumber
+	Number = json.Number
 )
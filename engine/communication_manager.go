@@ -96,6 +96,19 @@ func (m *CommunicationManager) PushEvent(evt base.Event) {
 }
 
 // run takes awaiting messages and pushes them to be handled by communications
+// PushEventTo sends an event to a single named relayer, or to all relayers
+// when no name is supplied
+func (m *CommunicationManager) PushEventTo(relayer string, evt base.Event) error {
+	if !m.IsRunning() {
+		return fmt.Errorf("communications manager %w", ErrSubSystemNotStarted)
+	}
+	if relayer == "" {
+		m.PushEvent(evt)
+		return nil
+	}
+	return m.comms.PushEventTo(relayer, evt)
+}
+
 func (m *CommunicationManager) run() {
 	log.Debugf(log.Global, "Communications manager %s", MsgSubSystemStarted)
 	defer func() {
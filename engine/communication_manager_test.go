@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/thrasher-corp/gocryptotrader/communications"
 	"github.com/thrasher-corp/gocryptotrader/communications/base"
 )
@@ -130,3 +131,22 @@ func TestPushEvent(t *testing.T) {
 	m = nil
 	m.PushEvent(base.Event{})
 }
+
+func TestPushEventTo(t *testing.T) {
+	t.Parallel()
+	var m *CommunicationManager
+	assert.ErrorIs(t, m.PushEventTo("", base.Event{}), ErrSubSystemNotStarted)
+
+	m, err := SetupCommunicationManager(&base.CommunicationsConfig{
+		SlackConfig: base.SlackConfig{
+			Name:    "Slack",
+			Enabled: true,
+		},
+	})
+	require.NoError(t, err)
+	assert.ErrorIs(t, m.PushEventTo("", base.Event{}), ErrSubSystemNotStarted)
+
+	require.NoError(t, m.Start())
+	assert.NoError(t, m.PushEventTo("", base.Event{}), "PushEventTo should not error when relaying to all relayers")
+	assert.Error(t, m.PushEventTo("Slack", base.Event{}), "PushEventTo should error when the relayer is not connected")
+}
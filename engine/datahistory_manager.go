@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"slices"
 	"strings"
 	"sync/atomic"
 	"time"
@@ -67,8 +68,10 @@ func SetupDataHistoryManager(em iExchangeManager, dcm iDatabaseConnectionManager
 		maxResultInsertions:        cfg.MaxResultInsertions,
 		tradeLoader:                trade.GetTradesInRange,
 		tradeSaver:                 trade.SaveTradesToDatabase,
+		tradeDeleter:               trade.DeleteTradesFromDatabase,
 		candleLoader:               kline.LoadFromDatabase,
 		candleSaver:                kline.StoreInDatabase,
+		candleReplacer:             kline.ReplaceInDatabase,
 	}, nil
 }
 
@@ -187,7 +190,8 @@ func (m *DataHistoryManager) compareJobsToData(jobs ...*DataHistoryJob) error {
 		case dataHistoryCandleDataType,
 			dataHistoryCandleValidationDataType,
 			dataHistoryCandleValidationSecondarySourceType,
-			dataHistoryConvertTradesDataType:
+			dataHistoryConvertTradesDataType,
+			dataHistoryCandleAuditDataType:
 			candles, err = m.candleLoader(jobs[i].Exchange, jobs[i].Pair, jobs[i].Asset, jobs[i].Interval, jobs[i].StartDate, jobs[i].EndDate)
 			if err != nil && !errors.Is(err, candle.ErrNoCandleDataFound) {
 				return fmt.Errorf("%s could not load candle data: %w", jobs[i].Nickname, err)
@@ -196,7 +200,7 @@ func (m *DataHistoryManager) compareJobsToData(jobs ...*DataHistoryJob) error {
 			if err != nil {
 				return err
 			}
-		case dataHistoryTradeDataType:
+		case dataHistoryTradeDataType, dataHistoryTradeAuditDataType:
 			for x := range jobs[i].rangeHolder.Ranges {
 				results, ok := jobs[i].Results[jobs[i].rangeHolder.Ranges[x].Start.Time.Unix()]
 				if !ok {
@@ -322,7 +326,9 @@ func (m *DataHistoryManager) runJob(job *DataHistoryJob) error {
 	}
 
 	if job.DataType == dataHistoryCandleValidationDataType ||
-		job.DataType == dataHistoryCandleValidationSecondarySourceType {
+		job.DataType == dataHistoryCandleValidationSecondarySourceType ||
+		job.DataType == dataHistoryCandleAuditDataType ||
+		job.DataType == dataHistoryTradeAuditDataType {
 		err = m.runValidationJob(job, exch)
 		if err != nil {
 			return err
@@ -498,7 +504,8 @@ completionCheck:
 }
 
 // runValidationJob verifies existing database candle data against
-// the original API's data, or a secondary exchange source
+// the original API's data, or a secondary exchange source, or audits
+// stored candle and trade data
 func (m *DataHistoryManager) runValidationJob(job *DataHistoryJob, exch exchange.IBotExchange) error {
 	if !m.IsRunning() {
 		return ErrSubSystemNotStarted
@@ -554,7 +561,16 @@ timesToFetch:
 				job.DataType)
 		}
 		intervalsProcessed++
-		result, err := m.validateCandles(job, exch, intervalsToCheck[i], requestEnd)
+		var result *DataHistoryJobResult
+		var err error
+		switch job.DataType {
+		case dataHistoryCandleAuditDataType:
+			result, err = m.auditCandles(job, exch, intervalsToCheck[i], requestEnd)
+		case dataHistoryTradeAuditDataType:
+			result, err = m.auditTrades(job, exch, intervalsToCheck[i], requestEnd)
+		default:
+			result, err = m.validateCandles(job, exch, intervalsToCheck[i], requestEnd)
+		}
 		if err != nil {
 			return err
 		}
@@ -1067,6 +1083,388 @@ func (m *DataHistoryManager) CheckCandleIssue(job *DataHistoryJob, multiplier in
 	return issue, replace
 }
 
+// auditCandles checks stored candles in a range for gaps, duplicates, OHLCV
+// inconsistencies and, when a secondary exchange is set, outliers. If the job
+// allows replacing data, issues are repaired with the exchange's API candles
+// and the range is rewritten with the audit issues attached to each candle
+func (m *DataHistoryManager) auditCandles(job *DataHistoryJob, exch exchange.IBotExchange, startRange, endRange time.Time) (*DataHistoryJobResult, error) {
+	if !m.IsRunning() {
+		return nil, ErrSubSystemNotStarted
+	}
+	if job == nil {
+		return nil, errNilJob
+	}
+	if exch == nil {
+		return nil, ErrExchangeNotFound
+	}
+	if err := common.StartEndTimeCheck(startRange, endRange); err != nil {
+		return nil, err
+	}
+	id, err := uuid.NewV4()
+	if err != nil {
+		return nil, err
+	}
+	r := &DataHistoryJobResult{
+		ID:                id,
+		JobID:             job.ID,
+		IntervalStartDate: startRange,
+		IntervalEndDate:   endRange,
+		Status:            dataHistoryStatusComplete,
+		Date:              time.Now(),
+	}
+	dbCandles, err := m.candleLoader(job.Exchange, job.Pair, job.Asset, job.Interval, startRange, endRange)
+	if err != nil && !errors.Is(err, candle.ErrNoCandleDataFound) {
+		r.Result = "could not get database candles: " + err.Error()
+		r.Status = dataHistoryStatusFailed
+		return r, nil //nolint:nilerr // error is returned in the job result
+	}
+	if dbCandles == nil {
+		dbCandles = &kline.Item{}
+	}
+
+	interval := job.Interval.Duration()
+	candles := make(map[int64]kline.Candle)
+	issues := make(map[int64][]string)
+	affected := make(map[int64]bool)
+	var duplicates, inconsistent, outliers, missing int
+	var duplicateTimes []int64
+	for i := range dbCandles.Candles {
+		start := dbCandles.Candles[i].Time.Truncate(interval)
+		if start.Before(startRange) || !start.Before(endRange) {
+			continue
+		}
+		existing, ok := candles[start.Unix()]
+		if !ok {
+			candles[start.Unix()] = dbCandles.Candles[i]
+			continue
+		}
+		duplicates++
+		affected[start.Unix()] = true
+		duplicateTimes = append(duplicateTimes, start.Unix())
+		issues[start.Unix()] = append(issues[start.Unix()], "duplicate candle at "+dbCandles.Candles[i].Time.Format(common.SimpleTimeFormatWithTimezone))
+		if !existing.Time.Equal(start) && dbCandles.Candles[i].Time.Equal(start) {
+			candles[start.Unix()] = dbCandles.Candles[i]
+		}
+	}
+
+	var gaps, needsAPIData []int64
+	for t := startRange.Truncate(interval); t.Before(endRange); t = t.Add(interval) {
+		if t.Before(startRange) {
+			continue
+		}
+		c, ok := candles[t.Unix()]
+		if !ok {
+			missing++
+			affected[t.Unix()] = true
+			gaps = append(gaps, t.Unix())
+			continue
+		}
+		if candleIssues := checkCandleConsistency(&c); len(candleIssues) > 0 {
+			inconsistent++
+			affected[t.Unix()] = true
+			issues[t.Unix()] = append(issues[t.Unix()], candleIssues...)
+			needsAPIData = append(needsAPIData, t.Unix())
+		}
+	}
+
+	isOutlier := make(map[int64]bool)
+	if job.SecondaryExchangeSource != "" && len(candles) > 0 {
+		secondary, err := m.exchangeManager.GetExchangeByName(job.SecondaryExchangeSource)
+		if err != nil {
+			r.Result = "could not get secondary exchange: " + err.Error()
+			r.Status = dataHistoryStatusFailed
+			return r, nil //nolint:nilerr // error is returned in the job result
+		}
+		secondaryCandles, err := secondary.GetHistoricCandlesExtended(context.TODO(), job.Pair, job.Asset, job.Interval, startRange, endRange)
+		if err != nil {
+			r.Result = "could not get secondary exchange candles: " + err.Error()
+			r.Status = dataHistoryStatusFailed
+			return r, nil //nolint:nilerr // error is returned in the job result
+		}
+		for i := range secondaryCandles.Candles {
+			t := secondaryCandles.Candles[i].Time.Truncate(interval).Unix()
+			c, ok := candles[t]
+			if !ok {
+				continue
+			}
+			outlierIssues := checkCandleOutlier(&c, &secondaryCandles.Candles[i], job.IssueTolerancePercentage)
+			if len(outlierIssues) == 0 {
+				continue
+			}
+			outliers++
+			isOutlier[t] = true
+			affected[t] = true
+			issues[t] = append(issues[t], outlierIssues...)
+			if !slices.Contains(needsAPIData, t) {
+				needsAPIData = append(needsAPIData, t)
+			}
+		}
+	}
+
+	repaired := make(map[int64]bool)
+	if job.ReplaceOnIssue && len(affected) > 0 {
+		if len(gaps) > 0 || len(needsAPIData) > 0 {
+			apiCandleMap := make(map[int64]kline.Candle)
+			apiCandles, err := exch.GetHistoricCandlesExtended(context.TODO(), job.Pair, job.Asset, job.Interval, startRange, endRange)
+			if err != nil {
+				r.Result += "could not get API candles for repair: " + err.Error() + ". "
+			} else {
+				for i := range apiCandles.Candles {
+					apiCandleMap[apiCandles.Candles[i].Time.Truncate(interval).Unix()] = apiCandles.Candles[i]
+				}
+			}
+			for _, t := range gaps {
+				if c, ok := apiCandleMap[t]; ok {
+					candles[t] = c
+					issues[t] = append(issues[t], "missing candle backfilled with API data")
+					repaired[t] = true
+				}
+			}
+			for _, t := range needsAPIData {
+				if c, ok := apiCandleMap[t]; ok {
+					candles[t] = c
+					issues[t] = append(issues[t], "replaced with API data")
+					repaired[t] = true
+					continue
+				}
+				c := candles[t]
+				if clampCandle(&c) {
+					candles[t] = c
+					issues[t] = append(issues[t], "high and low clamped to open and close")
+					repaired[t] = !isOutlier[t]
+				}
+			}
+		}
+		if len(repaired) > 0 || duplicates > 0 {
+			// replacing the audited range in a single transaction removes
+			// duplicates, including misaligned candles, and links every candle
+			// to this job. Existing candles are kept if the replacement fails
+			for _, t := range duplicateTimes {
+				if !slices.Contains(needsAPIData, t) {
+					repaired[t] = true
+				}
+			}
+			item := &kline.Item{
+				Exchange:        job.Exchange,
+				Pair:            job.Pair,
+				Asset:           job.Asset,
+				Interval:        job.Interval,
+				SourceJobID:     dbCandles.SourceJobID,
+				ValidationJobID: job.ID,
+			}
+			if item.SourceJobID == uuid.Nil {
+				item.SourceJobID = job.ID
+			}
+			for t, c := range candles {
+				c.Time = c.Time.Truncate(interval)
+				c.ValidationIssues = strings.Join(issues[t], ", ")
+				item.Candles = append(item.Candles, c)
+			}
+			item.SortCandlesByTimestamp(false)
+			if _, err := m.candleReplacer(item, startRange, endRange); err != nil {
+				r.Result += "could not save repaired candles: " + err.Error() + ". "
+				r.Status = dataHistoryStatusFailed
+				clear(repaired)
+			}
+		}
+	}
+
+	var numRepaired int
+	for _, ok := range repaired {
+		if ok {
+			numRepaired++
+		}
+	}
+	r.Result += fmt.Sprintf("audited %d candles: %d missing, %d duplicate, %d inconsistent, %d outliers, %d of %d affected intervals repaired",
+		len(dbCandles.Candles), missing, duplicates, inconsistent, outliers, numRepaired, len(affected))
+	if numRepaired < len(affected) && r.Status != dataHistoryStatusFailed {
+		r.Status = dataHistoryIntervalIssuesFound
+	}
+	var details []string
+	for t := startRange.Truncate(interval); t.Before(endRange); t = t.Add(interval) {
+		if _, ok := candles[t.Unix()]; !ok && !t.Before(startRange) {
+			details = append(details, "missing candle at "+t.Format(common.SimpleTimeFormatWithTimezone))
+		}
+		if len(issues[t.Unix()]) > 0 {
+			details = append(details, fmt.Sprintf("issues found at %s: %s", t.Format(common.SimpleTimeFormatWithTimezone), strings.Join(issues[t.Unix()], ", ")))
+		}
+	}
+	if len(details) > 0 {
+		r.Result += " -- " + strings.Join(details, " -- ")
+	}
+	return r, nil
+}
+
+// checkCandleConsistency returns any OHLCV inconsistencies found in a candle
+func checkCandleConsistency(c *kline.Candle) []string {
+	var issues []string
+	if c.Open <= 0 || c.High <= 0 || c.Low <= 0 || c.Close <= 0 {
+		issues = append(issues, fmt.Sprintf("non-positive price open: %v high: %v low: %v close: %v", c.Open, c.High, c.Low, c.Close))
+	}
+	if c.High < c.Open || c.High < c.Close {
+		issues = append(issues, fmt.Sprintf("high %v below open %v or close %v", c.High, c.Open, c.Close))
+	}
+	if c.Low > c.Open || c.Low > c.Close {
+		issues = append(issues, fmt.Sprintf("low %v above open %v or close %v", c.Low, c.Open, c.Close))
+	}
+	if c.High < c.Low {
+		issues = append(issues, fmt.Sprintf("high %v below low %v", c.High, c.Low))
+	}
+	if c.Volume < 0 {
+		issues = append(issues, fmt.Sprintf("negative volume %v", c.Volume))
+	}
+	if c.Volume == 0 && c.High != c.Low {
+		issues = append(issues, fmt.Sprintf("zero volume with price movement high: %v low: %v", c.High, c.Low))
+	}
+	return issues
+}
+
+// checkCandleOutlier returns any prices of a candle which differ from a
+// secondary source's candle by more than the tolerance percentage
+func checkCandleOutlier(c, secondary *kline.Candle, tolerance float64) []string {
+	var issues []string
+	for _, field := range []struct {
+		name             string
+		value, reference float64
+	}{
+		{"Open", c.Open, secondary.Open},
+		{"High", c.High, secondary.High},
+		{"Low", c.Low, secondary.Low},
+		{"Close", c.Close, secondary.Close},
+	} {
+		if field.reference <= 0 {
+			continue
+		}
+		if diff := math.Abs(gctmath.PercentageChange(field.reference, field.value)); diff > tolerance {
+			issues = append(issues, fmt.Sprintf("%s outlier db: %v secondary: %v diff: %v %%", field.name, field.value, field.reference, diff))
+		}
+	}
+	return issues
+}
+
+// clampCandle widens a candle's high and low to cover its open and close. It
+// returns false if clamping does not resolve the candle's inconsistencies
+func clampCandle(c *kline.Candle) bool {
+	clamped := *c
+	clamped.High = max(c.Open, c.High, c.Low, c.Close)
+	clamped.Low = min(c.Open, c.High, c.Low, c.Close)
+	if clamped == *c || len(checkCandleConsistency(&clamped)) > 0 {
+		return false
+	}
+	*c = clamped
+	return true
+}
+
+// auditTrades checks stored trades in a range for invalid prices and amounts
+// and for intervals without any trades. If the job allows replacing data,
+// invalid trades are deleted and empty intervals are backfilled with the
+// exchange's API trades
+func (m *DataHistoryManager) auditTrades(job *DataHistoryJob, exch exchange.IBotExchange, startRange, endRange time.Time) (*DataHistoryJobResult, error) {
+	if !m.IsRunning() {
+		return nil, ErrSubSystemNotStarted
+	}
+	if job == nil {
+		return nil, errNilJob
+	}
+	if exch == nil {
+		return nil, ErrExchangeNotFound
+	}
+	if err := common.StartEndTimeCheck(startRange, endRange); err != nil {
+		return nil, err
+	}
+	id, err := uuid.NewV4()
+	if err != nil {
+		return nil, err
+	}
+	r := &DataHistoryJobResult{
+		ID:                id,
+		JobID:             job.ID,
+		IntervalStartDate: startRange,
+		IntervalEndDate:   endRange,
+		Status:            dataHistoryStatusComplete,
+		Date:              time.Now(),
+	}
+	trades, err := m.tradeLoader(job.Exchange, job.Asset.String(), job.Pair.Base.String(), job.Pair.Quote.String(), startRange, endRange)
+	if err != nil {
+		r.Result = "could not get trades in range: " + err.Error()
+		r.Status = dataHistoryStatusFailed
+		return r, nil //nolint:nilerr // error is returned in the job result
+	}
+
+	interval := job.Interval.Duration()
+	var details []string
+	var invalid []trade.Data
+	hasTrades := make(map[int64]bool)
+	for i := range trades {
+		if trades[i].Price <= 0 || trades[i].Amount <= 0 {
+			invalid = append(invalid, trades[i])
+			details = append(details, fmt.Sprintf("invalid trade at %s price: %v amount: %v",
+				trades[i].Timestamp.Format(common.SimpleTimeFormatWithTimezone), trades[i].Price, trades[i].Amount))
+			continue
+		}
+		hasTrades[trades[i].Timestamp.Truncate(interval).Unix()] = true
+	}
+	var gaps []time.Time
+	for t := startRange.Truncate(interval); t.Before(endRange); t = t.Add(interval) {
+		if !t.Before(startRange) && !hasTrades[t.Unix()] {
+			gaps = append(gaps, t)
+		}
+	}
+
+	var repaired, backfilled int
+	emptyIntervals := len(gaps)
+	unrepaired := len(invalid) + len(gaps)
+	if job.ReplaceOnIssue && len(invalid) > 0 {
+		if err := m.tradeDeleter(invalid...); err != nil {
+			details = append(details, "could not delete invalid trades: "+err.Error())
+		} else {
+			repaired += len(invalid)
+			details = append(details, fmt.Sprintf("deleted %d invalid trades", len(invalid)))
+		}
+	}
+	if job.ReplaceOnIssue && len(gaps) > 0 {
+		apiTrades, err := exch.GetHistoricTrades(context.TODO(), job.Pair, job.Asset, startRange, endRange)
+		if err != nil {
+			details = append(details, "could not get API trades for repair: "+err.Error())
+		}
+		filled := make(map[int64]bool)
+		var toSave []trade.Data
+		for i := range apiTrades {
+			t := apiTrades[i].Timestamp.Truncate(interval)
+			if apiTrades[i].Price <= 0 || apiTrades[i].Amount <= 0 || !slices.ContainsFunc(gaps, t.Equal) {
+				continue
+			}
+			toSave = append(toSave, apiTrades[i])
+			filled[t.Unix()] = true
+		}
+		if len(toSave) > 0 {
+			if err := m.tradeSaver(toSave...); err != nil {
+				details = append(details, "could not save backfilled trades: "+err.Error())
+			} else {
+				repaired += len(filled)
+				backfilled = len(toSave)
+				gaps = slices.DeleteFunc(gaps, func(t time.Time) bool { return filled[t.Unix()] })
+			}
+		}
+	}
+	unrepaired -= repaired
+	for i := range gaps {
+		details = append(details, fmt.Sprintf("no trades between %s - %s",
+			gaps[i].Format(common.SimpleTimeFormatWithTimezone),
+			gaps[i].Add(interval).Format(common.SimpleTimeFormatWithTimezone)))
+	}
+
+	r.Result = fmt.Sprintf("audited %d trades: %d invalid, %d intervals without trades, %d repaired, %d trades backfilled",
+		len(trades), len(invalid), emptyIntervals, repaired, backfilled)
+	if len(details) > 0 {
+		r.Result += " -- " + strings.Join(details, " -- ")
+	}
+	if unrepaired > 0 {
+		r.Status = dataHistoryIntervalIssuesFound
+	}
+	return r, nil
+}
+
 // SetJobRelationship will add/modify/delete a relationship with an existing job
 // it will add the relationship and set the jobNickname job to paused
 // if deleting, it will remove the relationship from the database and set the job to active
@@ -1206,6 +1604,15 @@ func (m *DataHistoryManager) validateJob(job *DataHistoryJob) error {
 	if err != nil {
 		return fmt.Errorf("job %s cannot process job: %v", job.Nickname, err)
 	}
+	if job.DataType == dataHistoryCandleAuditDataType && job.SecondaryExchangeSource != "" {
+		if _, err = m.exchangeManager.GetExchangeByName(job.SecondaryExchangeSource); err != nil {
+			return fmt.Errorf("job %s cannot process job: %w", job.Nickname, err)
+		}
+		if job.IssueTolerancePercentage <= 0 {
+			log.Warnf(log.DataHistory, "job %s issue tolerance percentage %v invalid. defaulting to %v%% when checking for outliers", job.Nickname, job.IssueTolerancePercentage, defaultAuditIssueTolerancePercentage)
+			job.IssueTolerancePercentage = defaultAuditIssueTolerancePercentage
+		}
+	}
 	pairs, err := exch.GetEnabledPairs(job.Asset)
 	if err != nil {
 		return fmt.Errorf("job %s exchange %s asset %s currency %s %w", job.Nickname, job.Exchange, job.Asset, job.Pair, err)
@@ -1227,7 +1634,7 @@ func (m *DataHistoryManager) validateJob(job *DataHistoryJob) error {
 	if job.RequestSizeLimit <= 0 {
 		job.RequestSizeLimit = defaultDataHistoryRequestSizeLimit
 	}
-	if job.DataType == dataHistoryTradeDataType {
+	if job.DataType == dataHistoryTradeDataType || job.DataType == dataHistoryTradeAuditDataType {
 		if job.Interval > kline.FourHour {
 			log.Warnf(log.DataHistory, "job %s interval %v above the limit of 4h, defaulting to %v interval size worth of trades to fetch", job.Nickname, job.Interval.Word(), defaultDataHistoryTradeInterval)
 			job.Interval = defaultDataHistoryTradeInterval
@@ -1255,6 +1662,11 @@ func (m *DataHistoryManager) validateJob(job *DataHistoryJob) error {
 		return fmt.Errorf("job conversion interval %s %s %w %s", job.Nickname, job.ConversionInterval.Word(), kline.ErrUnsupportedInterval, job.Exchange)
 	}
 
+	if job.DataType == dataHistoryCandleAuditDataType && job.RequestSizeLimit > defaultDataHistoryRequestSizeLimit {
+		log.Warnf(log.DataHistory, "job %s audit batch %v above limit of %v. defaulting to %v intervals to process per request", job.Nickname, job.RequestSizeLimit, defaultDataHistoryRequestSizeLimit, defaultDataHistoryRequestSizeLimit)
+		job.RequestSizeLimit = defaultDataHistoryRequestSizeLimit
+	}
+
 	if job.DataType == dataHistoryCandleValidationDataType {
 		if job.DecimalPlaceComparison == 0 {
 			log.Warnf(log.DataHistory, "job %s decimal place comparison %v invalid. defaulting to %v decimal places when comparing data for validation", job.Nickname, job.DecimalPlaceComparison, defaultDecimalPlaceComparison)
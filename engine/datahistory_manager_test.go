@@ -420,6 +420,24 @@ func TestValidateJob(t *testing.T) {
 	dhj.Exchange = ""
 	err = m.validateJob(dhj)
 	assert.ErrorIs(t, err, common.ErrExchangeNameNotSet)
+
+	dhj.Exchange = testExchange
+	dhj.DataType = dataHistoryCandleAuditDataType
+	err = m.validateJob(dhj)
+	assert.ErrorIs(t, err, ErrExchangeNotFound)
+
+	dhj.SecondaryExchangeSource = "Binance"
+	dhj.RequestSizeLimit = 999
+	err = m.validateJob(dhj)
+	assert.NoError(t, err)
+	assert.Equal(t, defaultAuditIssueTolerancePercentage, dhj.IssueTolerancePercentage, "outlier tolerance should be defaulted")
+	assert.Equal(t, defaultDataHistoryRequestSizeLimit, dhj.RequestSizeLimit, "audit request size should be capped")
+
+	dhj.DataType = dataHistoryTradeAuditDataType
+	dhj.Interval = kline.OneDay
+	err = m.validateJob(dhj)
+	assert.NoError(t, err)
+	assert.Equal(t, defaultDataHistoryTradeInterval, dhj.Interval, "trade audit interval should be limited")
 }
 
 func TestGetAllJobStatusBetween(t *testing.T) {
@@ -580,6 +598,26 @@ func TestRunJob(t *testing.T) {
 			DataType:                dataHistoryCandleValidationSecondarySourceType,
 			SecondaryExchangeSource: "Binance",
 		},
+		{
+			Nickname:  "TestRunJobDataHistoryCandleAuditDataType",
+			Exchange:  testExchange,
+			Asset:     asset.Spot,
+			Pair:      currency.NewBTCUSDT(),
+			StartDate: tt.Add(-kline.OneHour.Duration()),
+			EndDate:   tt,
+			Interval:  kline.OneHour,
+			DataType:  dataHistoryCandleAuditDataType,
+		},
+		{
+			Nickname:  "TestRunJobDataHistoryTradeAuditDataType",
+			Exchange:  testExchange,
+			Asset:     asset.Spot,
+			Pair:      currency.NewBTCUSDT(),
+			StartDate: tt.Add(-kline.OneMin.Duration()),
+			EndDate:   tt,
+			Interval:  kline.OneMin,
+			DataType:  dataHistoryTradeAuditDataType,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -1026,6 +1064,191 @@ func TestValidateCandles(t *testing.T) {
 	}
 }
 
+func TestAuditCandles(t *testing.T) {
+	t.Parallel()
+	m, _ := createDHM(t)
+	_, err := m.auditCandles(nil, nil, time.Time{}, time.Time{})
+	assert.ErrorIs(t, err, errNilJob)
+
+	start := time.Now().Truncate(kline.OneHour.Duration()).Add(-kline.FourHour.Duration())
+	j := &DataHistoryJob{
+		ID:        uuid.Must(uuid.NewV4()),
+		Exchange:  testExchange,
+		Asset:     asset.Spot,
+		Pair:      currency.NewBTCUSDT(),
+		StartDate: start,
+		EndDate:   start.Add(kline.FourHour.Duration()),
+		Interval:  kline.OneHour,
+	}
+	_, err = m.auditCandles(j, nil, time.Time{}, time.Time{})
+	assert.ErrorIs(t, err, ErrExchangeNotFound)
+
+	fakeExchange := dhmExchange{}
+	_, err = m.auditCandles(j, fakeExchange, time.Time{}, time.Time{})
+	assert.ErrorIs(t, err, common.ErrDateUnset)
+
+	sourceJobID := uuid.Must(uuid.NewV4())
+	m.candleLoader = func(exch string, cp currency.Pair, a asset.Item, interval kline.Interval, _, _ time.Time) (*kline.Item, error) {
+		return &kline.Item{
+			Exchange:    exch,
+			Pair:        cp,
+			Asset:       a,
+			Interval:    interval,
+			SourceJobID: sourceJobID,
+			Candles: []kline.Candle{
+				{Time: start, Open: 1, High: 10, Low: 1, Close: 4, Volume: 8},
+				{Time: start.Add(time.Hour), Open: 1, High: 2, Low: 1, Close: 4, Volume: 5},
+				{Time: start.Add(time.Hour + time.Minute*30), Open: 1, High: 4, Low: 1, Close: 4, Volume: 5},
+				{Time: start.Add(time.Hour * 3), Open: 2, High: 10, Low: 1, Close: 5},
+			},
+		}, nil
+	}
+	var saved *kline.Item
+	m.candleReplacer = func(item *kline.Item, replaceStart, replaceEnd time.Time) (uint64, error) {
+		assert.Equal(t, j.StartDate, replaceStart, "repaired candles must replace the audited range")
+		assert.Equal(t, j.EndDate, replaceEnd, "repaired candles must replace the audited range")
+		saved = item
+		return uint64(len(item.Candles)), nil
+	}
+
+	r, err := m.auditCandles(j, fakeExchange, j.StartDate, j.EndDate)
+	require.NoError(t, err, "auditCandles must not error")
+	assert.Equal(t, dataHistoryIntervalIssuesFound, r.Status)
+	assert.Contains(t, r.Result, "1 missing, 1 duplicate, 2 inconsistent, 0 outliers, 0 of 3 affected intervals repaired")
+	assert.Contains(t, r.Result, "missing candle at "+start.Add(time.Hour*2).Format(common.SimpleTimeFormatWithTimezone))
+	assert.Contains(t, r.Result, "zero volume with price movement")
+	assert.Nil(t, saved, "candles must not be modified unless the job allows replacing data")
+
+	j.ReplaceOnIssue = true
+	r, err = m.auditCandles(j, fakeExchange, j.StartDate, j.EndDate)
+	require.NoError(t, err, "auditCandles must not error")
+	assert.Equal(t, dataHistoryIntervalIssuesFound, r.Status, "zero volume candles without API data cannot be repaired")
+	assert.Contains(t, r.Result, "2 of 3 affected intervals repaired")
+	require.NotNil(t, saved, "repaired candles must be saved")
+	require.Len(t, saved.Candles, 4, "duplicates must be removed and gaps backfilled")
+	assert.Equal(t, sourceJobID, saved.SourceJobID, "source job must be preserved")
+	assert.Equal(t, j.ID, saved.ValidationJobID, "candles must be linked to the audit job")
+	assert.Equal(t, start.Add(time.Hour*2), saved.Candles[2].Time)
+	assert.Contains(t, saved.Candles[2].ValidationIssues, "backfilled")
+	assert.Contains(t, saved.Candles[1].ValidationIssues, "replaced with API data")
+	assert.Empty(t, saved.Candles[0].ValidationIssues)
+
+	m.candleReplacer = func(*kline.Item, time.Time, time.Time) (uint64, error) {
+		return 0, errTradeCandleTest
+	}
+	r, err = m.auditCandles(j, fakeExchange, j.StartDate, j.EndDate)
+	require.NoError(t, err, "auditCandles must not error")
+	assert.Equal(t, dataHistoryStatusFailed, r.Status)
+	assert.Contains(t, r.Result, "could not save repaired candles")
+}
+
+func TestCheckCandleConsistency(t *testing.T) {
+	t.Parallel()
+	assert.Empty(t, checkCandleConsistency(&kline.Candle{Open: 2, High: 3, Low: 1, Close: 2, Volume: 1}))
+	assert.Empty(t, checkCandleConsistency(&kline.Candle{Open: 2, High: 2, Low: 2, Close: 2}), "flat zero volume candles should be consistent")
+	assert.Len(t, checkCandleConsistency(&kline.Candle{Open: 2, High: 1, Low: 1, Close: 2, Volume: 1}), 1, "high below open should be flagged")
+	assert.Len(t, checkCandleConsistency(&kline.Candle{Open: 2, High: 3, Low: 3, Close: 2, Volume: 1}), 1, "low above open should be flagged")
+	assert.Len(t, checkCandleConsistency(&kline.Candle{Open: 2, High: 3, Low: 1, Close: 2, Volume: -1}), 1, "negative volume should be flagged")
+	assert.Len(t, checkCandleConsistency(&kline.Candle{Open: 2, High: 3, Low: 1, Close: 2}), 1, "zero volume price movement should be flagged")
+	assert.NotEmpty(t, checkCandleConsistency(&kline.Candle{Open: 0, High: 3, Low: 1, Close: 2, Volume: 1}), "non-positive prices should be flagged")
+}
+
+func TestCheckCandleOutlier(t *testing.T) {
+	t.Parallel()
+	c := &kline.Candle{Open: 100, High: 110, Low: 90, Close: 104}
+	assert.Empty(t, checkCandleOutlier(c, &kline.Candle{Open: 101, High: 111, Low: 91, Close: 103}, 5))
+	issues := checkCandleOutlier(c, &kline.Candle{Open: 101, High: 111, Low: 91, Close: 90}, 5)
+	require.Len(t, issues, 1, "only prices outside of the tolerance should be flagged")
+	assert.Contains(t, issues[0], "Close outlier")
+	assert.Empty(t, checkCandleOutlier(c, &kline.Candle{}, 5), "missing secondary prices should be ignored")
+}
+
+func TestClampCandle(t *testing.T) {
+	t.Parallel()
+	c := kline.Candle{Open: 2, High: 1, Low: 3, Close: 4, Volume: 1}
+	require.True(t, clampCandle(&c), "clampCandle must repair high and low")
+	assert.Equal(t, 4.0, c.High)
+	assert.Equal(t, 1.0, c.Low)
+
+	c = kline.Candle{Open: 2, High: 3, Low: 1, Close: 2}
+	assert.False(t, clampCandle(&c), "clamping should not repair zero volume candles")
+	c = kline.Candle{Open: 2, High: 3, Low: 1, Close: 2, Volume: 1}
+	assert.False(t, clampCandle(&c), "consistent candles should not be clamped")
+}
+
+// auditTradeExchange returns trades for the second interval of a trade audit
+type auditTradeExchange struct {
+	dhmExchange
+}
+
+func (f auditTradeExchange) GetHistoricTrades(_ context.Context, p currency.Pair, a asset.Item, startTime, _ time.Time) ([]trade.Data, error) {
+	return []trade.Data{
+		{Exchange: testExchange, CurrencyPair: p, AssetType: a, Side: order.Buy, Price: 1337, Amount: 1, Timestamp: startTime.Add(time.Minute)},
+		{Exchange: testExchange, CurrencyPair: p, AssetType: a, Side: order.Sell, Price: 1336, Amount: 2, Timestamp: startTime.Add(time.Minute * 16)},
+	}, nil
+}
+
+func TestAuditTrades(t *testing.T) {
+	t.Parallel()
+	m, _ := createDHM(t)
+	_, err := m.auditTrades(nil, nil, time.Time{}, time.Time{})
+	assert.ErrorIs(t, err, errNilJob)
+
+	start := time.Now().Truncate(kline.OneHour.Duration()).Add(-kline.OneHour.Duration())
+	j := &DataHistoryJob{
+		Exchange:  testExchange,
+		Asset:     asset.Spot,
+		Pair:      currency.NewBTCUSDT(),
+		StartDate: start,
+		EndDate:   start.Add(kline.FifteenMin.Duration() * 3),
+		Interval:  kline.FifteenMin,
+	}
+	_, err = m.auditTrades(j, nil, time.Time{}, time.Time{})
+	assert.ErrorIs(t, err, ErrExchangeNotFound)
+
+	invalidID := uuid.Must(uuid.NewV4())
+	m.tradeLoader = func(exch, _, _, _ string, _, _ time.Time) ([]trade.Data, error) {
+		return []trade.Data{
+			{Exchange: exch, Price: 1337, Amount: 1, Timestamp: start.Add(time.Minute)},
+			{ID: invalidID, Exchange: exch, Amount: 1, Timestamp: start.Add(time.Minute * 20)},
+		}, nil
+	}
+	var deleted, saved []trade.Data
+	m.tradeDeleter = func(trades ...trade.Data) error {
+		deleted = append(deleted, trades...)
+		return nil
+	}
+	m.tradeSaver = func(trades ...trade.Data) error {
+		saved = append(saved, trades...)
+		return nil
+	}
+
+	r, err := m.auditTrades(j, auditTradeExchange{}, j.StartDate, j.EndDate)
+	require.NoError(t, err, "auditTrades must not error")
+	assert.Equal(t, dataHistoryIntervalIssuesFound, r.Status)
+	assert.Contains(t, r.Result, "audited 2 trades: 1 invalid, 2 intervals without trades, 0 repaired")
+	assert.Empty(t, deleted, "trades must not be deleted unless the job allows replacing data")
+	assert.Empty(t, saved, "trades must not be saved unless the job allows replacing data")
+
+	j.ReplaceOnIssue = true
+	r, err = m.auditTrades(j, auditTradeExchange{}, j.StartDate, j.EndDate)
+	require.NoError(t, err, "auditTrades must not error")
+	assert.Equal(t, dataHistoryIntervalIssuesFound, r.Status, "intervals without API trades cannot be repaired")
+	assert.Contains(t, r.Result, "2 repaired, 1 trades backfilled")
+	assert.Contains(t, r.Result, "no trades between "+start.Add(time.Minute*30).Format(common.SimpleTimeFormatWithTimezone))
+	require.Len(t, deleted, 1, "invalid trades must be deleted")
+	assert.Equal(t, invalidID, deleted[0].ID)
+	require.Len(t, saved, 1, "only trades within empty intervals must be backfilled")
+	assert.Equal(t, start.Add(time.Minute*16), saved[0].Timestamp)
+
+	m.tradeLoader = func(string, string, string, string, time.Time, time.Time) ([]trade.Data, error) {
+		return nil, errTradeCandleTest
+	}
+	r, err = m.auditTrades(j, auditTradeExchange{}, j.StartDate, j.EndDate)
+	require.NoError(t, err, "auditTrades must not error")
+	assert.Equal(t, dataHistoryStatusFailed, r.Status)
+}
+
 func TestSetJobRelationship(t *testing.T) {
 	t.Parallel()
 	m, j := createDHM(t)
@@ -29,6 +29,11 @@ const (
 	dataHistoryConvertCandlesDataType
 	dataHistoryCandleValidationDataType
 	dataHistoryCandleValidationSecondarySourceType
+	dataHistoryCandleAuditDataType
+	dataHistoryTradeAuditDataType
+	// dataHistoryTradeCandleStreamDataType marks candles built from streamed
+	// trades by the trade candle manager. These jobs are never run
+	dataHistoryTradeCandleStreamDataType
 )
 
 // DataHistoryJob status descriptors
@@ -81,13 +86,20 @@ func (d dataHistoryDataType) String() string {
 		return "conversion validation"
 	case 5:
 		return "conversion validation secondary source"
+	case 6:
+		return "candle audit"
+	case 7:
+		return "trade audit"
+	case 8:
+		return "trade candle stream"
 	}
 	return ""
 }
 
-// Valid ensures the value set is legitimate
+// Valid ensures the value set is legitimate for a job which can be run. Trade
+// candle stream jobs are only created by the trade candle manager
 func (d dataHistoryDataType) Valid() bool {
-	return int64(d) >= 0 && int64(d) <= 5
+	return int64(d) >= 0 && int64(d) <= 7
 }
 
 var (
@@ -120,6 +132,9 @@ const (
 	defaultDataHistoryTicker                  = time.Minute
 	defaultDataHistoryTradeRequestSize uint64 = 10
 	defaultDecimalPlaceComparison      uint64 = 3
+	// defaultAuditIssueTolerancePercentage is the default percentage a stored candle
+	// can differ from a secondary exchange's candle before it is an outlier
+	defaultAuditIssueTolerancePercentage = 5.0
 )
 
 // DataHistoryManager is responsible for synchronising,
@@ -139,7 +154,9 @@ type DataHistoryManager struct {
 	candleLoader               func(string, currency.Pair, asset.Item, kline.Interval, time.Time, time.Time) (*kline.Item, error)
 	tradeLoader                func(string, string, string, string, time.Time, time.Time) ([]trade.Data, error)
 	tradeSaver                 func(...trade.Data) error
+	tradeDeleter               func(...trade.Data) error
 	candleSaver                func(*kline.Item, bool) (uint64, error)
+	candleReplacer             func(*kline.Item, time.Time, time.Time) (uint64, error)
 }
 
 // DataHistoryJob used to gather candle/trade history and save
@@ -21,6 +21,8 @@ import (
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/alert"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook/analytics"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/paper"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
 	gctscript "github.com/thrasher-corp/gocryptotrader/gctscript/vm"
@@ -41,6 +43,11 @@ type Engine struct {
 	eventManager            *eventManager
 	ExchangeManager         *ExchangeManager
 	ntpManager              *ntpManager
+	metricsManager          *metricsManager
+	tradeCandleManager      *tradeCandleManager
+	ledgerManager           *ledgerManager
+	rebalanceManager        *rebalanceManager
+	orderbookCaptureManager *orderbookCaptureManager
 	OrderManager            *OrderManager
 	portfolioManager        *portfolioManager
 	gctScriptManager        *gctscript.GctScriptManager
@@ -302,6 +309,17 @@ func (bot *Engine) Start() error {
 		}
 	}
 
+	if bot.Config.Metrics.Enabled {
+		if m, err := setupMetricsManager(&bot.Config.Metrics, bot); err != nil {
+			gctlog.Errorf(gctlog.Global, "Metrics manager unable to setup: %v", err)
+		} else {
+			bot.metricsManager = m
+			if err := bot.metricsManager.Start(); err != nil {
+				gctlog.Errorf(gctlog.Global, "Metrics manager unable to start: %v", err)
+			}
+		}
+	}
+
 	if bot.Settings.EnableDatabaseManager {
 		if d, err := SetupDatabaseConnectionManager(&bot.Config.Database); err != nil {
 			gctlog.Errorf(gctlog.Global, "Database manager unable to setup: %v", err)
@@ -435,10 +453,24 @@ func (bot *Engine) Start() error {
 		}
 	}
 
-	if w, err := SetupWithdrawManager(bot.ExchangeManager, bot.portfolioManager, bot.Settings.EnableDryRun); err != nil {
+	if bot.Config.TradeCandleManager.Enabled {
+		if t, err := setupTradeCandleManager(bot.ExchangeManager, bot.DatabaseManager, &bot.Config.TradeCandleManager); err != nil {
+			gctlog.Errorf(gctlog.Global, "Trade candle manager unable to setup: %v", err)
+		} else {
+			bot.tradeCandleManager = t
+			if err := bot.tradeCandleManager.Start(); err != nil {
+				gctlog.Errorf(gctlog.Global, "Trade candle manager unable to start: %v", err)
+			}
+		}
+	}
+
+	if w, err := SetupWithdrawManager(bot.ExchangeManager, bot.portfolioManager, bot.CommunicationsManager, &bot.Config.WithdrawManager, bot.Settings.EnableDryRun); err != nil {
 		return err
 	} else { //nolint:revive // TODO: revive false positive, see https://github.com/mgechev/revive/pull/832 for more information
 		bot.WithdrawManager = w
+		if err := bot.WithdrawManager.Start(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Withdraw manager unable to start: %v", err)
+		}
 	}
 
 	if bot.Settings.EnableDepositAddressManager {
@@ -459,12 +491,55 @@ func (bot *Engine) Start() error {
 			gctlog.Errorf(gctlog.Global, "Order manager unable to setup: %s", err)
 		} else {
 			bot.OrderManager = o
+			if bot.DatabaseManager.IsRunning() {
+				if err = bot.OrderManager.SetOrderDatabase(bot.DatabaseManager.GetInstance()); err != nil {
+					gctlog.Errorf(gctlog.Global, "Order manager unable to load orders: %s", err)
+				}
+				if err = bot.OrderManager.SetConditionalOrderDatabase(bot.DatabaseManager.GetInstance()); err != nil {
+					gctlog.Errorf(gctlog.Global, "Order manager unable to load conditional orders: %s", err)
+				}
+			}
 			if err = bot.OrderManager.Start(); err != nil {
 				gctlog.Errorf(gctlog.Global, "Order manager unable to start: %s", err)
 			}
 		}
 	}
 
+	if bot.Config.LedgerManager.Enabled {
+		if l, err := setupLedgerManager(bot.ExchangeManager, bot.OrderManager, &bot.Config.LedgerManager); err != nil {
+			gctlog.Errorf(gctlog.Global, "Ledger manager unable to setup: %v", err)
+		} else {
+			bot.ledgerManager = l
+			if err := bot.ledgerManager.Start(); err != nil {
+				gctlog.Errorf(gctlog.Global, "Ledger manager unable to start: %v", err)
+			} else if err := bot.OrderManager.SetSpotPNLSource(bot.ledgerManager); err != nil {
+				gctlog.Errorf(gctlog.Global, "Order manager unable to use the ledger for risk limits: %v", err)
+			}
+		}
+	}
+
+	if bot.Config.RebalanceManager.Enabled {
+		if r, err := setupRebalanceManager(bot.ExchangeManager, bot.OrderManager, &bot.Config.RebalanceManager); err != nil {
+			gctlog.Errorf(gctlog.Global, "Rebalance manager unable to setup: %v", err)
+		} else {
+			bot.rebalanceManager = r
+			if err := bot.rebalanceManager.Start(); err != nil {
+				gctlog.Errorf(gctlog.Global, "Rebalance manager unable to start: %v", err)
+			}
+		}
+	}
+
+	if bot.Config.OrderbookCapture.Enabled {
+		if o, err := setupOrderbookCaptureManager(bot.ExchangeManager, &bot.Config.OrderbookCapture, bot.Settings.DataDir); err != nil {
+			gctlog.Errorf(gctlog.Global, "Orderbook capture manager unable to setup: %v", err)
+		} else {
+			bot.orderbookCaptureManager = o
+			if err := bot.orderbookCaptureManager.Start(); err != nil {
+				gctlog.Errorf(gctlog.Global, "Orderbook capture manager unable to start: %v", err)
+			}
+		}
+	}
+
 	if bot.Settings.EnableExchangeSyncManager {
 		cfg := bot.Config.SyncManagerConfig
 		cfg.SynchronizeTicker = bot.Settings.EnableTickerSyncing
@@ -502,17 +577,6 @@ func (bot *Engine) Start() error {
 		}
 	}
 
-	if bot.Settings.EnableEventManager {
-		if e, err := setupEventManager(bot.CommunicationsManager, bot.ExchangeManager, bot.Settings.EventManagerDelay, bot.Settings.EnableDryRun); err != nil {
-			gctlog.Errorf(gctlog.Global, "Unable to initialise event manager. Err: %s", err)
-		} else {
-			bot.eventManager = e
-			if err = bot.eventManager.Start(); err != nil {
-				gctlog.Errorf(gctlog.Global, "failed to start event manager. Err: %s", err)
-			}
-		}
-	}
-
 	if bot.Settings.EnableWebsocketRoutine {
 		if w, err := setupWebsocketRoutineManager(bot.ExchangeManager, bot.OrderManager, bot.currencyPairSyncer, &bot.Config.Currency, bot.Settings.Verbose); err != nil {
 			gctlog.Errorf(gctlog.Global, "Unable to initialise websocket routine manager. Err: %s", err)
@@ -535,6 +599,22 @@ func (bot *Engine) Start() error {
 		}
 	}
 
+	if bot.Settings.EnableEventManager {
+		if e, err := setupEventManager(bot.CommunicationsManager, bot.ExchangeManager, bot.OrderManager, bot.gctScriptManager, bot.Settings.EventManagerDelay, bot.Settings.EnableDryRun); err != nil {
+			gctlog.Errorf(gctlog.Global, "Unable to initialise event manager. Err: %s", err)
+		} else {
+			bot.eventManager = e
+			if bot.DatabaseManager.IsRunning() {
+				if err = bot.eventManager.SetEventDatabase(bot.DatabaseManager.GetInstance()); err != nil {
+					gctlog.Errorf(gctlog.Global, "Event manager unable to load events: %s", err)
+				}
+			}
+			if err = bot.eventManager.Start(); err != nil {
+				gctlog.Errorf(gctlog.Global, "failed to start event manager. Err: %s", err)
+			}
+		}
+	}
+
 	if bot.Settings.EnableCurrencyStateManager {
 		if c, err := SetupCurrencyStateManager(
 			bot.Config.CurrencyStateManager.Delay,
@@ -589,6 +669,11 @@ func (bot *Engine) Stop() {
 			gctlog.Errorf(gctlog.Global, "NTP manager unable to stop. Error: %v", err)
 		}
 	}
+	if bot.metricsManager.IsRunning() {
+		if err := bot.metricsManager.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Metrics manager unable to stop. Error: %v", err)
+		}
+	}
 	if bot.CommunicationsManager.IsRunning() {
 		if err := bot.CommunicationsManager.Stop(); err != nil {
 			gctlog.Errorf(gctlog.Global, "Communication manager unable to stop. Error: %v", err)
@@ -604,6 +689,34 @@ func (bot *Engine) Stop() {
 			gctlog.Errorf(gctlog.Global, "Connection manager unable to stop. Error: %v", err)
 		}
 	}
+	if bot.WithdrawManager.IsRunning() {
+		if err := bot.WithdrawManager.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Withdraw manager unable to stop. Error: %v", err)
+		}
+	}
+	if bot.tradeCandleManager.IsRunning() {
+		if err := bot.tradeCandleManager.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Trade candle manager unable to stop. Error: %v", err)
+		}
+	}
+	if bot.orderbookCaptureManager.IsRunning() {
+		if err := bot.orderbookCaptureManager.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Orderbook capture manager unable to stop. Error: %v", err)
+		}
+	}
+	if err := analytics.UntrackAll(); err != nil {
+		gctlog.Errorf(gctlog.Global, "Orderbook analytics unable to stop. Error: %v", err)
+	}
+	if bot.rebalanceManager.IsRunning() {
+		if err := bot.rebalanceManager.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Rebalance manager unable to stop. Error: %v", err)
+		}
+	}
+	if bot.ledgerManager.IsRunning() {
+		if err := bot.ledgerManager.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Ledger manager unable to stop. Error: %v", err)
+		}
+	}
 	if bot.dataHistoryManager.IsRunning() {
 		if err := bot.dataHistoryManager.Stop(); err != nil {
 			gctlog.Errorf(gctlog.DataHistory, "data history manager unable to stop. Error: %v", err)
@@ -774,6 +887,15 @@ func (bot *Engine) LoadExchange(name string) error {
 		return err
 	}
 
+	if exchCfg.PaperTrading != nil && exchCfg.PaperTrading.Enabled {
+		exch, err = paper.New(exch, exchCfg.PaperTrading)
+		if err != nil {
+			exchCfg.Enabled = false
+			return err
+		}
+		gctlog.Warnf(gctlog.ExchangeSys, "%s paper trading is enabled, orders will be simulated and not sent to the exchange", exch.GetName())
+	}
+
 	err = bot.ExchangeManager.Add(exch)
 	if err != nil {
 		return err
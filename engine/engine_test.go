@@ -13,6 +13,7 @@ import (
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/bitfinex"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/bitstamp"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/paper"
 )
 
 // blockedCIExchanges are exchanges that are not able to be tested on CI
@@ -266,6 +267,31 @@ func TestDryRunParamInteraction(t *testing.T) {
 	}
 }
 
+func TestLoadExchangePaperTrading(t *testing.T) {
+	t.Parallel()
+	bot := &Engine{
+		ExchangeManager: NewExchangeManager(),
+		Config: &config.Config{
+			Exchanges: []config.Exchange{
+				{
+					Name:                    testExchange,
+					WebsocketTrafficTimeout: time.Second,
+					PaperTrading:            &config.PaperTradingConfig{Enabled: true, MakerFee: new(float64)},
+				},
+			},
+		},
+	}
+	*bot.Config.Exchanges[0].PaperTrading.MakerFee = -1
+	assert.Error(t, bot.LoadExchange(testExchange), "LoadExchange should error with an invalid paper trading config")
+
+	*bot.Config.Exchanges[0].PaperTrading.MakerFee = 0
+	assert.NoError(t, bot.LoadExchange(testExchange), "LoadExchange should not error")
+	exch, err := bot.GetExchangeByName(testExchange)
+	require.NoError(t, err, "GetExchangeByName must not error")
+	assert.IsType(t, &paper.Exchange{}, exch, "exchange should be wrapped for paper trading")
+	assert.NoError(t, bot.UnloadExchange(testExchange), "UnloadExchange should not error")
+}
+
 func TestFlagSetWith(t *testing.T) {
 	var isRunning bool
 	flags := make(FlagSet)
@@ -1,8 +1,8 @@
 package engine
 
 import (
-	"errors"
 	"fmt"
+	"path/filepath"
 	"slices"
 	"strings"
 	"sync/atomic"
@@ -11,13 +11,14 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/communications/base"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
-	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
-	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/log"
 )
 
-// setupEventManager loads and validates the communications manager config
-func setupEventManager(comManager iCommsManager, exchangeManager iExchangeManager, sleepDelay time.Duration, verbose bool) (*eventManager, error) {
+// setupEventManager loads and validates the communications manager config.
+// The order and script managers are optional and only required by events
+// which submit or cancel orders or run scripts
+func setupEventManager(comManager iCommsManager, exchangeManager iExchangeManager, orderManager iOrderManager, scriptManager iGCTScriptManager, sleepDelay time.Duration, verbose bool) (*eventManager, error) {
 	if comManager == nil {
 		return nil, errNilComManager
 	}
@@ -30,6 +31,8 @@ func setupEventManager(comManager iCommsManager, exchangeManager iExchangeManage
 	return &eventManager{
 		comms:           comManager,
 		exchangeManager: exchangeManager,
+		orderManager:    orderManager,
+		scriptManager:   scriptManager,
 		verbose:         verbose,
 		sleepDelay:      sleepDelay,
 		shutdown:        make(chan struct{}),
@@ -72,73 +75,132 @@ func (m *eventManager) Stop() error {
 
 func (m *eventManager) run() {
 	t := time.NewTicker(m.sleepDelay)
-	select {
-	case <-m.shutdown:
-		return
-	case <-t.C:
-		total, executed := m.getEventCounter()
-		if total > 0 && executed != total {
-			m.m.Lock()
-			for i := range m.events {
-				m.executeEvent(i)
+	defer t.Stop()
+	for {
+		select {
+		case <-m.shutdown:
+			return
+		case <-t.C:
+			pending := m.getPendingEvents()
+			for i := range pending {
+				m.executeEvent(&pending[i])
 			}
-			m.m.Unlock()
 		}
 	}
 }
 
-func (m *eventManager) executeEvent(i int) {
-	if !m.events[i].Executed {
+// getPendingEvents returns a copy of all events which have not been executed
+// so their conditions can be checked without holding the lock
+func (m *eventManager) getPendingEvents() []Event {
+	m.m.Lock()
+	defer m.m.Unlock()
+	var pending []Event
+	for i := range m.events {
+		if !m.events[i].Executed {
+			pending = append(pending, m.events[i])
+		}
+	}
+	return pending
+}
+
+func (m *eventManager) executeEvent(e *Event) {
+	if m.verbose {
+		log.Debugf(log.EventMgr, "Events: Processing event %s.\n", e.String())
+	}
+	if err := m.checkEventCondition(e); err != nil {
 		if m.verbose {
-			log.Debugf(log.EventMgr, "Events: Processing event %s.\n", m.events[i].String())
+			log.Debugf(log.EventMgr, "Events: ID: %d %v\n", e.ID, err)
 		}
-		err := m.checkEventCondition(&m.events[i])
-		if err != nil {
-			msg := fmt.Sprintf(
-				"Events: ID: %d triggered on %s successfully [%v]\n", m.events[i].ID,
-				m.events[i].Exchange, m.events[i].String(),
-			)
-			log.Infoln(log.EventMgr, msg)
-			m.comms.PushEvent(base.Event{Type: "event", Message: msg})
-			m.events[i].Executed = true
-		} else if m.verbose {
-			log.Debugf(log.EventMgr, "%v", err)
+		return
+	}
+	if !m.setExecuted(e.ID) {
+		return
+	}
+	msg := fmt.Sprintf("Events: ID: %d triggered on %s successfully [%v]\n", e.ID, e.Exchange, e.String())
+	log.Infoln(log.EventMgr, msg)
+	m.comms.PushEvent(base.Event{Type: "event", Message: msg})
+	for i := range e.Actions {
+		if err := m.performAction(e, &e.Actions[i]); err != nil {
+			log.Errorf(log.EventMgr, "Events: ID: %d action %s failed: %v\n", e.ID, e.Actions[i].Action, err)
 		}
 	}
 }
 
+// setExecuted marks an event as executed and returns false if it has been
+// removed or already executed
+func (m *eventManager) setExecuted(id int64) bool {
+	m.m.Lock()
+	defer m.m.Unlock()
+	for i := range m.events {
+		if m.events[i].ID != id {
+			continue
+		}
+		if m.events[i].Executed {
+			return false
+		}
+		m.events[i].Executed = true
+		m.persistEvent(&m.events[i])
+		return true
+	}
+	return false
+}
+
 // Add adds an event to the Events chain and returns an index/eventID
 // and an error
 func (m *eventManager) Add(exchange, item string, condition EventConditionParams, p currency.Pair, a asset.Item, action string) (int64, error) {
+	return m.AddEvent(&Event{
+		Exchange:  exchange,
+		Item:      item,
+		Condition: condition,
+		Pair:      p,
+		Asset:     a,
+		Action:    action,
+	})
+}
+
+// AddEvent validates and adds an event, which may use composite conditions and
+// multiple actions, to the Events chain and returns its ID
+func (m *eventManager) AddEvent(e *Event) (int64, error) {
 	if m == nil {
 		return 0, fmt.Errorf("event manager %w", ErrNilSubsystem)
 	}
 	if atomic.LoadInt32(&m.started) == 0 {
 		return 0, fmt.Errorf("event manager %w", ErrSubSystemNotStarted)
 	}
-	err := m.isValidEvent(exchange, item, condition, action)
+	if e == nil {
+		return 0, errNilEvent
+	}
+	err := m.isValidEvent(e)
 	if err != nil {
 		return 0, err
 	}
-	evt := Event{
-		Exchange:  exchange,
-		Item:      item,
-		Condition: condition,
-		Pair:      p,
-		Asset:     a,
-		Action:    action,
-		Executed:  false,
-	}
+	evt := *e
+	evt.Item = strings.ToUpper(evt.Item)
+	evt.Operator = strings.ToUpper(evt.Operator)
+	evt.Executed = false
+	evt.CreatedAt = time.Now()
 	m.m.Lock()
-	if len(m.events) > 0 {
-		evt.ID = int64(len(m.events) + 1)
-	}
+	defer m.m.Unlock()
+	m.nextID++
+	evt.ID = m.nextID
 	m.events = append(m.events, evt)
-	m.m.Unlock()
-
+	m.persistEvent(&m.events[len(m.events)-1])
 	return evt.ID, nil
 }
 
+// GetEvents returns a copy of all events
+func (m *eventManager) GetEvents() ([]Event, error) {
+	if m == nil {
+		return nil, fmt.Errorf("event manager %w", ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&m.started) == 0 {
+		return nil, fmt.Errorf("event manager %w", ErrSubSystemNotStarted)
+	}
+	m.m.Lock()
+	defer m.m.Unlock()
+	return slices.Clone(m.events), nil
+}
+
 // Remove deletes an event by its ID
 func (m *eventManager) Remove(eventID int64) bool {
 	if m == nil || atomic.LoadInt32(&m.started) == 0 {
@@ -149,6 +211,7 @@ func (m *eventManager) Remove(eventID int64) bool {
 	for i := range m.events {
 		if m.events[i].ID == eventID {
 			m.events = slices.Delete(m.events, i, i+1)
+			m.deletePersistedEvent(eventID)
 			return true
 		}
 	}
@@ -184,59 +247,178 @@ func (m *eventManager) checkEventCondition(e *Event) error {
 	if e == nil {
 		return errNilEvent
 	}
-	if e.Item == ItemPrice {
-		return e.processTicker()
+	if len(e.Conditions) > 0 {
+		return m.checkConditions(e, e.Operator, e.Conditions)
 	}
-	return e.processOrderbook()
+	return m.checkItem(e, e.Item, &e.Condition)
 }
 
-// isValidEvent checks the actions to be taken and returns an error if incorrect
-func (m *eventManager) isValidEvent(exchange, item string, condition EventConditionParams, action string) error {
-	exchange = strings.ToUpper(exchange)
-	item = strings.ToUpper(item)
-	action = strings.ToUpper(action)
-
-	if !m.isValidExchange(exchange) {
+// isValidEvent checks the conditions and actions to be taken and returns an
+// error if incorrect
+func (m *eventManager) isValidEvent(e *Event) error {
+	if !m.isValidExchange(strings.ToUpper(e.Exchange)) {
 		return errExchangeDisabled
 	}
 
-	if !isValidItem(item) {
-		return errInvalidItem
+	if len(e.Conditions) > 0 {
+		if err := isValidOperator(e.Operator); err != nil {
+			return err
+		}
+		for i := range e.Conditions {
+			if err := isValidEventCondition(&e.Conditions[i], e.Asset); err != nil {
+				return err
+			}
+		}
+	} else if err := isValidItemCondition(e.Item, &e.Condition, e.Asset); err != nil {
+		return err
 	}
 
-	if !isValidCondition(condition.Condition) {
-		return errInvalidCondition
+	if e.Action == "" && len(e.Actions) == 0 {
+		return errInvalidAction
+	}
+	if e.Action != "" {
+		if err := isValidAction(e.Action); err != nil {
+			return err
+		}
 	}
+	for i := range e.Actions {
+		if err := isValidEventAction(&e.Actions[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
 
-	if item == ItemPrice {
-		if condition.Price <= 0 {
+// isValidExchange validates the exchange
+func (m *eventManager) isValidExchange(exchangeName string) bool {
+	_, err := m.exchangeManager.GetExchangeByName(exchangeName)
+	return err == nil
+}
+
+// isValidEventCondition validates a single condition or group of conditions
+func isValidEventCondition(c *EventCondition, a asset.Item) error {
+	if len(c.Conditions) == 0 {
+		return isValidItemCondition(c.Item, &c.Params, a)
+	}
+	if err := isValidOperator(c.Operator); err != nil {
+		return err
+	}
+	for i := range c.Conditions {
+		if err := isValidEventCondition(&c.Conditions[i], a); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isValidItemCondition validates the parameters required by an item
+func isValidItemCondition(item string, params *EventConditionParams, a asset.Item) error {
+	item = strings.ToUpper(item)
+	if !isValidItem(item) {
+		return errInvalidItem
+	}
+
+	switch item {
+	case ItemMACDCrossover, ItemBollingerBreakout:
+		if !isValidDirection(params.Direction) {
+			return fmt.Errorf("%w: direction must be %s or %s", errInvalidCondition, DirectionAbove, DirectionBelow)
+		}
+	default:
+		if !isValidCondition(params.Condition) {
 			return errInvalidCondition
 		}
 	}
 
-	if item == ItemOrderbook {
-		if condition.OrderbookAmount <= 0 {
+	switch item {
+	case ItemPrice:
+		if params.Price <= 0 {
+			return errInvalidCondition
+		}
+	case ItemOrderbook:
+		if params.OrderbookAmount <= 0 {
 			return errInvalidCondition
 		}
+	case ItemRSI:
+		if params.Interval <= 0 || params.Period <= 1 {
+			return fmt.Errorf("%w: RSI requires an interval and a period greater than 1", errInvalidCondition)
+		}
+		if params.Value < 0 || params.Value > 100 {
+			return fmt.Errorf("%w: RSI value must be between 0 and 100", errInvalidCondition)
+		}
+	case ItemMACDCrossover:
+		if params.Interval <= 0 || params.FastPeriod <= 0 || params.SignalPeriod <= 0 || params.SlowPeriod <= params.FastPeriod {
+			return fmt.Errorf("%w: MACD requires an interval, fast and signal periods and a slow period greater than the fast period", errInvalidCondition)
+		}
+	case ItemBollingerBreakout:
+		if params.Interval <= 0 || params.Period <= 1 || params.StandardDeviations <= 0 {
+			return fmt.Errorf("%w: Bollinger bands require an interval, a period greater than 1 and standard deviations", errInvalidCondition)
+		}
+	case ItemPriceChange:
+		if params.Interval <= 0 || params.Window < params.Interval.Duration() {
+			return fmt.Errorf("%w: price change requires an interval and a window of at least one interval", errInvalidCondition)
+		}
+	case ItemSpread:
+		if params.Value < 0 {
+			return fmt.Errorf("%w: spread value must not be negative", errInvalidCondition)
+		}
+	case ItemFundingRate:
+		if !a.IsFutures() {
+			return fmt.Errorf("%w: funding rates require a futures asset", errInvalidCondition)
+		}
 	}
+	return nil
+}
 
+// isValidAction validates the legacy action string
+func isValidAction(action string) error {
+	action = strings.ToUpper(action)
 	if strings.Contains(action, ",") {
 		a := strings.Split(action, ",")
-
 		if a[0] != ActionSMSNotify {
 			return errInvalidAction
 		}
 	} else if action != ActionConsolePrint && action != ActionTest {
 		return errInvalidAction
 	}
-
 	return nil
 }
 
-// isValidExchange validates the exchange
-func (m *eventManager) isValidExchange(exchangeName string) bool {
-	_, err := m.exchangeManager.GetExchangeByName(exchangeName)
-	return err == nil
+// isValidEventAction validates the parameters required by an action
+func isValidEventAction(a *EventAction) error {
+	switch strings.ToUpper(a.Action) {
+	case ActionConsolePrint, ActionTest, ActionCommsRelay:
+	case ActionSubmitOrder:
+		if _, err := order.StringToOrderSide(a.Side); err != nil {
+			return fmt.Errorf("%w: %w", errInvalidAction, err)
+		}
+		oType, err := order.StringToOrderType(a.OrderType)
+		if err != nil {
+			return fmt.Errorf("%w: %w", errInvalidAction, err)
+		}
+		if a.Amount <= 0 {
+			return fmt.Errorf("%w: order amount must be greater than 0", errInvalidAction)
+		}
+		if oType == order.Limit && a.Price <= 0 {
+			return fmt.Errorf("%w: limit order price must be greater than 0", errInvalidAction)
+		}
+	case ActionCancelOrder:
+		if a.OrderID == "" {
+			return fmt.Errorf("%w: order ID required to cancel an order", errInvalidAction)
+		}
+	case ActionRunScript:
+		if a.Script == "" {
+			return fmt.Errorf("%w: script name required", errInvalidAction)
+		}
+		// Scripts are loaded relative to the script directory, so paths which
+		// could escape it are rejected
+		a.Script = filepath.Clean(a.Script)
+		if !filepath.IsLocal(a.Script) {
+			return fmt.Errorf("%w: script %q must be within the script directory", errInvalidAction, a.Script)
+		}
+	default:
+		return fmt.Errorf("%w: %q", errInvalidAction, a.Action)
+	}
+	return nil
 }
 
 // isValidCondition validates passed in condition
@@ -248,11 +430,30 @@ func isValidCondition(condition string) bool {
 	return false
 }
 
+// isValidOperator validates the operator joining conditions. An empty operator
+// defaults to AND
+func isValidOperator(operator string) error {
+	switch strings.ToUpper(operator) {
+	case "", OperatorAnd, OperatorOr:
+		return nil
+	}
+	return fmt.Errorf("%w: %q", errInvalidOperator, operator)
+}
+
+// isValidDirection validates passed in crossover or breakout direction
+func isValidDirection(direction string) bool {
+	switch strings.ToUpper(direction) {
+	case DirectionAbove, DirectionBelow:
+		return true
+	}
+	return false
+}
+
 // isValidItem validates passed in Item
 func isValidItem(item string) bool {
 	item = strings.ToUpper(item)
 	switch item {
-	case ItemPrice, ItemOrderbook:
+	case ItemPrice, ItemOrderbook, ItemRSI, ItemMACDCrossover, ItemBollingerBreakout, ItemPriceChange, ItemSpread, ItemFundingRate:
 		return true
 	}
 	return false
@@ -260,26 +461,40 @@ func isValidItem(item string) bool {
 
 // String turns the structure event into a string
 func (e *Event) String() string {
+	if len(e.Conditions) > 0 {
+		return fmt.Sprintf(
+			"If the %s [%s] on %s meets %s %v then %s.", e.Pair.String(),
+			strings.ToUpper(e.Asset.String()), e.Exchange, operatorOrDefault(e.Operator), e.Conditions, e.actionsString(),
+		)
+	}
 	return fmt.Sprintf(
 		"If the %s [%s] %s on %s meets the following %v then %s.", e.Pair.String(),
-		strings.ToUpper(e.Asset.String()), e.Item, e.Exchange, e.Condition, e.Action,
+		strings.ToUpper(e.Asset.String()), e.Item, e.Exchange, e.Condition, e.actionsString(),
 	)
 }
 
-func (e *Event) processTicker() error {
-	t, err := ticker.GetTicker(e.Exchange, e.Pair, e.Asset)
-	if err != nil {
-		return fmt.Errorf("failed to get ticker. Err: %w", err)
+func (e *Event) actionsString() string {
+	actions := make([]string, 0, len(e.Actions)+1)
+	if e.Action != "" {
+		actions = append(actions, e.Action)
+	}
+	for i := range e.Actions {
+		actions = append(actions, e.Actions[i].Action)
 	}
+	return strings.Join(actions, ",")
+}
 
-	if t.Last == 0 {
-		return errTickerLastPriceZero
+// operatorOrDefault returns the operator joining conditions, defaulting to AND
+func operatorOrDefault(operator string) string {
+	if operator == "" {
+		return OperatorAnd
 	}
-	return e.shouldProcessEvent(t.Last, e.Condition.Price)
+	return strings.ToUpper(operator)
 }
 
-func (e *Event) shouldProcessEvent(actual, threshold float64) error {
-	switch e.Condition.Condition {
+// meetsCondition compares the actual value against the threshold
+func meetsCondition(condition string, actual, threshold float64) error {
+	switch condition {
 	case ConditionGreaterThan:
 		if actual > threshold {
 			return nil
@@ -301,36 +516,5 @@ func (e *Event) shouldProcessEvent(actual, threshold float64) error {
 			return nil
 		}
 	}
-	return errors.New("does not meet conditions")
-}
-
-func (e *Event) processOrderbook() error {
-	ob, err := orderbook.Get(e.Exchange, e.Pair, e.Asset)
-	if err != nil {
-		return fmt.Errorf("events: Failed to get orderbook. Err: %w", err)
-	}
-	if !e.Condition.CheckBids && !e.Condition.CheckAsks {
-		return nil
-	}
-
-	if e.Condition.CheckBids {
-		for x := range ob.Bids {
-			subtotal := ob.Bids[x].Amount * ob.Bids[x].Price
-			err = e.shouldProcessEvent(subtotal, e.Condition.OrderbookAmount)
-			if err == nil {
-				log.Debugf(log.EventMgr, "Events: Bid Amount: %f Price: %v Subtotal: %v\n", ob.Bids[x].Amount, ob.Bids[x].Price, subtotal)
-			}
-		}
-	}
-
-	if e.Condition.CheckAsks {
-		for x := range ob.Asks {
-			subtotal := ob.Asks[x].Amount * ob.Asks[x].Price
-			err = e.shouldProcessEvent(subtotal, e.Condition.OrderbookAmount)
-			if err == nil {
-				log.Debugf(log.EventMgr, "Events: Ask Amount: %f Price: %v Subtotal: %v\n", ob.Asks[x].Amount, ob.Asks[x].Price, subtotal)
-			}
-		}
-	}
-	return err
+	return fmt.Errorf("%w: %v %s %v", errConditionNotMet, actual, condition, threshold)
 }
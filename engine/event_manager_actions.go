@@ -0,0 +1,93 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	gctscript "github.com/thrasher-corp/gocryptotrader/gctscript/vm"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// performAction carries out an action for a triggered event
+func (m *eventManager) performAction(e *Event, a *EventAction) error {
+	switch strings.ToUpper(a.Action) {
+	case ActionConsolePrint, ActionTest:
+		log.Infof(log.EventMgr, "Events: ID: %d %s\n", e.ID, a.Message)
+		return nil
+	case ActionSubmitOrder:
+		return m.submitOrder(e, a)
+	case ActionCancelOrder:
+		return m.cancelOrder(e, a)
+	case ActionRunScript:
+		return m.runScript(a)
+	case ActionCommsRelay:
+		msg := a.Message
+		if msg == "" {
+			msg = fmt.Sprintf("Events: ID: %d triggered on %s [%v]", e.ID, e.Exchange, e.String())
+		}
+		return m.comms.PushEventTo(a.Relayer, base.Event{Type: "event", Message: msg})
+	}
+	return fmt.Errorf("%w: %q", errInvalidAction, a.Action)
+}
+
+func (m *eventManager) submitOrder(e *Event, a *EventAction) error {
+	if m.orderManager == nil || !m.orderManager.IsRunning() {
+		return fmt.Errorf("order manager %w", ErrSubSystemNotStarted)
+	}
+	side, err := order.StringToOrderSide(a.Side)
+	if err != nil {
+		return err
+	}
+	oType, err := order.StringToOrderType(a.OrderType)
+	if err != nil {
+		return err
+	}
+	resp, err := m.orderManager.Submit(context.TODO(), &order.Submit{
+		Exchange:  e.Exchange,
+		Pair:      e.Pair,
+		AssetType: e.Asset,
+		Side:      side,
+		Type:      oType,
+		Amount:    a.Amount,
+		Price:     a.Price,
+	})
+	if err != nil {
+		return err
+	}
+	log.Infof(log.EventMgr, "Events: ID: %d submitted %s order %s\n", e.ID, e.Exchange, resp.OrderID)
+	return nil
+}
+
+func (m *eventManager) cancelOrder(e *Event, a *EventAction) error {
+	if m.orderManager == nil || !m.orderManager.IsRunning() {
+		return fmt.Errorf("order manager %w", ErrSubSystemNotStarted)
+	}
+	return m.orderManager.Cancel(context.TODO(), &order.Cancel{
+		Exchange:  e.Exchange,
+		OrderID:   a.OrderID,
+		Pair:      e.Pair,
+		AssetType: e.Asset,
+	})
+}
+
+func (m *eventManager) runScript(a *EventAction) error {
+	if m.scriptManager == nil || !m.scriptManager.IsRunning() {
+		return errScriptingDisabled
+	}
+	if !filepath.IsLocal(a.Script) {
+		return fmt.Errorf("%w: script %q must be within the script directory", errInvalidAction, a.Script)
+	}
+	vm := m.scriptManager.New()
+	if vm == nil {
+		return errVMCreationFailed
+	}
+	if err := vm.Load(filepath.Join(gctscript.ScriptPath, a.Script)); err != nil {
+		return err
+	}
+	go vm.CompileAndRun()
+	return nil
+}
@@ -0,0 +1,341 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gct-ta/indicators"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fundingrate"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// checkConditions checks a group of conditions joined by an operator. AND
+// requires every condition to be met and OR requires any
+func (m *eventManager) checkConditions(e *Event, operator string, conditions []EventCondition) error {
+	var errs []error
+	for i := range conditions {
+		err := m.checkCondition(e, &conditions[i])
+		if operatorOrDefault(operator) == OperatorOr {
+			if err == nil {
+				return nil
+			}
+			errs = append(errs, err)
+			continue
+		}
+		if err != nil {
+			return err
+		}
+	}
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+	return nil
+}
+
+// checkCondition checks a single condition or a nested group of conditions
+func (m *eventManager) checkCondition(e *Event, c *EventCondition) error {
+	if len(c.Conditions) > 0 {
+		return m.checkConditions(e, c.Operator, c.Conditions)
+	}
+	return m.checkItem(e, c.Item, &c.Params)
+}
+
+// checkItem checks whether the event's pair meets an item's condition
+func (m *eventManager) checkItem(e *Event, item string, params *EventConditionParams) error {
+	switch strings.ToUpper(item) {
+	case ItemPrice:
+		return e.processTicker(params)
+	case ItemOrderbook:
+		return e.processOrderbook(params)
+	case ItemRSI:
+		return m.processRSI(e, params)
+	case ItemMACDCrossover:
+		return m.processMACDCrossover(e, params)
+	case ItemBollingerBreakout:
+		return m.processBollingerBreakout(e, params)
+	case ItemPriceChange:
+		return m.processPriceChange(e, params)
+	case ItemSpread:
+		return e.processSpread(params)
+	case ItemFundingRate:
+		return m.processFundingRate(e, params)
+	}
+	return fmt.Errorf("%w: %q", errInvalidItem, item)
+}
+
+func (e *Event) processTicker(params *EventConditionParams) error {
+	t, err := ticker.GetTicker(e.Exchange, e.Pair, e.Asset)
+	if err != nil {
+		return fmt.Errorf("failed to get ticker. Err: %w", err)
+	}
+
+	if t.Last == 0 {
+		return errTickerLastPriceZero
+	}
+	return meetsCondition(params.Condition, t.Last, params.Price)
+}
+
+func (e *Event) processOrderbook(params *EventConditionParams) error {
+	ob, err := orderbook.Get(e.Exchange, e.Pair, e.Asset)
+	if err != nil {
+		return fmt.Errorf("events: Failed to get orderbook. Err: %w", err)
+	}
+	if !params.CheckBids && !params.CheckAsks {
+		return nil
+	}
+
+	err = errConditionNotMet
+	if params.CheckBids {
+		for x := range ob.Bids {
+			subtotal := ob.Bids[x].Amount * ob.Bids[x].Price
+			if meetsCondition(params.Condition, subtotal, params.OrderbookAmount) == nil {
+				log.Debugf(log.EventMgr, "Events: Bid Amount: %f Price: %v Subtotal: %v\n", ob.Bids[x].Amount, ob.Bids[x].Price, subtotal)
+				err = nil
+			}
+		}
+	}
+
+	if params.CheckAsks {
+		for x := range ob.Asks {
+			subtotal := ob.Asks[x].Amount * ob.Asks[x].Price
+			if meetsCondition(params.Condition, subtotal, params.OrderbookAmount) == nil {
+				log.Debugf(log.EventMgr, "Events: Ask Amount: %f Price: %v Subtotal: %v\n", ob.Asks[x].Amount, ob.Asks[x].Price, subtotal)
+				err = nil
+			}
+		}
+	}
+	return err
+}
+
+// processSpread compares the spread between the best bid and ask as a
+// percentage of the mid price
+func (e *Event) processSpread(params *EventConditionParams) error {
+	ob, err := orderbook.Get(e.Exchange, e.Pair, e.Asset)
+	if err != nil {
+		return fmt.Errorf("events: Failed to get orderbook. Err: %w", err)
+	}
+	if len(ob.Bids) == 0 || len(ob.Asks) == 0 {
+		return errOrderbookEmpty
+	}
+	bid, ask := ob.Bids[0].Price, ob.Asks[0].Price
+	mid := (bid + ask) / 2
+	if mid <= 0 {
+		return errOrderbookEmpty
+	}
+	return meetsCondition(params.Condition, (ask-bid)/mid*100, params.Value)
+}
+
+func (m *eventManager) processRSI(e *Event, params *EventConditionParams) error {
+	k, err := m.getCandles(e, params.Interval, indicatorCandleCount(params.Period))
+	if err != nil {
+		return err
+	}
+	rsi, err := k.GetRelativeStrengthIndexOnClose(params.Period)
+	if err != nil {
+		return err
+	}
+	last, err := lastValues(rsi, 1)
+	if err != nil {
+		return err
+	}
+	return meetsCondition(params.Condition, last[0], params.Value)
+}
+
+// processMACDCrossover checks whether the MACD line crossed its signal line in
+// the desired direction between the last two candles
+func (m *eventManager) processMACDCrossover(e *Event, params *EventConditionParams) error {
+	k, err := m.getCandles(e, params.Interval, indicatorCandleCount(params.SlowPeriod+params.SignalPeriod))
+	if err != nil {
+		return err
+	}
+	macd, err := k.GetMovingAverageConvergenceDivergenceOnClose(params.FastPeriod, params.SlowPeriod, params.SignalPeriod)
+	if err != nil {
+		return err
+	}
+	results, err := lastValues(macd.Results, 2)
+	if err != nil {
+		return err
+	}
+	signals, err := lastValues(macd.SignalVals, 2)
+	if err != nil {
+		return err
+	}
+	previous, current := results[0]-signals[0], results[1]-signals[1]
+	switch strings.ToUpper(params.Direction) {
+	case DirectionAbove:
+		if previous <= 0 && current > 0 {
+			return nil
+		}
+	case DirectionBelow:
+		if previous >= 0 && current < 0 {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: MACD has not crossed %s its signal", errConditionNotMet, strings.ToLower(params.Direction))
+}
+
+// processBollingerBreakout checks whether the last close is outside the
+// Bollinger band in the desired direction
+func (m *eventManager) processBollingerBreakout(e *Event, params *EventConditionParams) error {
+	k, err := m.getCandles(e, params.Interval, indicatorCandleCount(params.Period))
+	if err != nil {
+		return err
+	}
+	bands, err := k.GetBollingerBands(params.Period, params.StandardDeviations, params.StandardDeviations, indicators.Sma)
+	if err != nil {
+		return err
+	}
+	closePrice := k.Candles[len(k.Candles)-1].Close
+	switch strings.ToUpper(params.Direction) {
+	case DirectionAbove:
+		upper, err := lastValues(bands.Upper, 1)
+		if err != nil {
+			return err
+		}
+		return meetsCondition(ConditionGreaterThan, closePrice, upper[0])
+	case DirectionBelow:
+		lower, err := lastValues(bands.Lower, 1)
+		if err != nil {
+			return err
+		}
+		return meetsCondition(ConditionLessThan, closePrice, lower[0])
+	}
+	return fmt.Errorf("%w: direction %q", errInvalidCondition, params.Direction)
+}
+
+// processPriceChange compares the percentage change from the open of the first
+// candle in the window to the last close
+func (m *eventManager) processPriceChange(e *Event, params *EventConditionParams) error {
+	count := priceChangeCandleCount(params)
+	k, err := m.getCandles(e, params.Interval, count)
+	if err != nil {
+		return err
+	}
+	if int64(len(k.Candles)) > count {
+		k.Candles = k.Candles[int64(len(k.Candles))-count:]
+	}
+	open := k.Candles[0].Open
+	if open == 0 {
+		return fmt.Errorf("%w: opening price is 0", errNotEnoughCandles)
+	}
+	change := (k.Candles[len(k.Candles)-1].Close - open) / open * 100
+	return meetsCondition(params.Condition, change, params.Value)
+}
+
+func (m *eventManager) processFundingRate(e *Event, params *EventConditionParams) error {
+	rate, err := m.getFundingRate(e)
+	if err != nil {
+		return err
+	}
+	return meetsCondition(params.Condition, rate, params.Value)
+}
+
+// getCandles returns the most recent candles for the event's pair, reusing
+// candles fetched within the cache duration
+func (m *eventManager) getCandles(e *Event, interval kline.Interval, count int64) (*kline.Item, error) {
+	key := candleCacheKey(e, interval, count)
+	m.dataMtx.Lock()
+	defer m.dataMtx.Unlock()
+	if c, ok := m.candles[key]; ok && time.Since(c.fetched) < eventDataCacheDuration {
+		return copyCandles(c.item), nil
+	}
+	exch, err := m.exchangeManager.GetExchangeByName(e.Exchange)
+	if err != nil {
+		return nil, err
+	}
+	end := time.Now()
+	start := end.Add(-interval.Duration() * time.Duration(count)).Truncate(interval.Duration())
+	k, err := exch.GetHistoricCandles(context.TODO(), e.Pair, e.Asset, interval, start, end)
+	if err != nil {
+		return nil, err
+	}
+	if len(k.Candles) == 0 {
+		return nil, errNotEnoughCandles
+	}
+	if m.candles == nil {
+		m.candles = make(map[string]*cachedCandles)
+	}
+	m.candles[key] = &cachedCandles{item: k, fetched: time.Now()}
+	return copyCandles(k), nil
+}
+
+// getFundingRate returns the latest funding rate for the event's pair, reusing
+// a rate fetched within the cache duration
+func (m *eventManager) getFundingRate(e *Event) (float64, error) {
+	key := fundingRateCacheKey(e)
+	m.dataMtx.Lock()
+	defer m.dataMtx.Unlock()
+	if r, ok := m.fundingRates[key]; ok && time.Since(r.fetched) < eventDataCacheDuration {
+		return r.rate, nil
+	}
+	exch, err := m.exchangeManager.GetExchangeByName(e.Exchange)
+	if err != nil {
+		return 0, err
+	}
+	rates, err := exch.GetLatestFundingRates(context.TODO(), &fundingrate.LatestRateRequest{
+		Asset: e.Asset,
+		Pair:  e.Pair,
+	})
+	if err != nil {
+		return 0, err
+	}
+	if len(rates) == 0 {
+		return 0, errNoFundingRate
+	}
+	rate := rates[0].LatestRate.Rate.InexactFloat64()
+	if m.fundingRates == nil {
+		m.fundingRates = make(map[string]*cachedFundingRate)
+	}
+	m.fundingRates[key] = &cachedFundingRate{rate: rate, fetched: time.Now()}
+	return rate, nil
+}
+
+// indicatorCandleCount returns the number of candles requested for an
+// indicator so its smoothing has enough history
+func indicatorCandleCount(period int64) int64 {
+	return max(period*3, minimumEventCandles)
+}
+
+// priceChangeCandleCount returns the number of candles covering a price change
+// window
+func priceChangeCandleCount(params *EventConditionParams) int64 {
+	return int64(math.Ceil(float64(params.Window) / float64(params.Interval)))
+}
+
+func candleCacheKey(e *Event, interval kline.Interval, count int64) string {
+	return fmt.Sprintf("%s|%s|%s|%s|%d", strings.ToLower(e.Exchange), e.Pair, e.Asset, interval, count)
+}
+
+func fundingRateCacheKey(e *Event) string {
+	return fmt.Sprintf("%s|%s|%s", strings.ToLower(e.Exchange), e.Pair, e.Asset)
+}
+
+// copyCandles returns a copy of cached candles so callers can trim them
+func copyCandles(k *kline.Item) *kline.Item {
+	c := *k
+	c.Candles = make([]kline.Candle, len(k.Candles))
+	copy(c.Candles, k.Candles)
+	return &c
+}
+
+// lastValues returns the last n values of an indicator, erroring when they are
+// unavailable
+func lastValues(values []float64, n int) ([]float64, error) {
+	if len(values) < n {
+		return nil, errNotEnoughCandles
+	}
+	last := values[len(values)-n:]
+	for i := range last {
+		if math.IsNaN(last[i]) {
+			return nil, errNotEnoughCandles
+		}
+	}
+	return last, nil
+}
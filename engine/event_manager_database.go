@@ -0,0 +1,154 @@
+package engine
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	dbevent "github.com/thrasher-corp/gocryptotrader/database/repository/event"
+	"github.com/thrasher-corp/gocryptotrader/encoding/json"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// SetEventDatabase enables persistence of events and loads all events saved
+// when the event manager was last running
+func (m *eventManager) SetEventDatabase(db database.IDatabase) error {
+	if m == nil {
+		return fmt.Errorf("event manager %w", ErrNilSubsystem)
+	}
+	dbService, err := dbevent.Setup(db)
+	if err != nil {
+		return err
+	}
+	return m.loadEvents(dbService)
+}
+
+// loadEvents sets the event database service and adds all events from it
+func (m *eventManager) loadEvents(db dbevent.IDBService) error {
+	records, err := db.GetAll()
+	if err != nil {
+		return err
+	}
+	m.m.Lock()
+	defer m.m.Unlock()
+	m.db = db
+	var loaded int
+	for i := range records {
+		m.nextID = max(m.nextID, records[i].ID)
+		e, err := eventFromRecord(&records[i])
+		if err != nil {
+			log.Errorf(log.EventMgr, "Unable to load event %d from database: %v", records[i].ID, err)
+			continue
+		}
+		exch, err := m.exchangeManager.GetExchangeByName(e.Exchange)
+		if err != nil {
+			log.Errorf(log.EventMgr, "Unable to load event %d from database: %v", e.ID, err)
+			continue
+		}
+		// Exchange names are stored in lower case
+		e.Exchange = exch.GetName()
+		if m.hasEvent(e.ID) {
+			continue
+		}
+		m.events = append(m.events, *e)
+		loaded++
+	}
+	if loaded > 0 {
+		log.Infof(log.EventMgr, "Loaded %d events from database", loaded)
+	}
+	return nil
+}
+
+// hasEvent returns whether an event ID is in use. The lock must be held
+func (m *eventManager) hasEvent(id int64) bool {
+	for i := range m.events {
+		if m.events[i].ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+// persistEvent saves an event to the database when one is set. Failures are
+// logged as the event is still tracked in memory. The lock must be held
+func (m *eventManager) persistEvent(e *Event) {
+	if m.db == nil {
+		return
+	}
+	r, err := eventToRecord(e)
+	if err != nil {
+		log.Errorf(log.EventMgr, "Unable to persist event %d: %v", e.ID, err)
+		return
+	}
+	if err := m.db.Upsert(r); err != nil {
+		log.Errorf(log.EventMgr, "Unable to persist event %d: %v", e.ID, err)
+	}
+}
+
+// deletePersistedEvent removes an event from the database when one is set.
+// The lock must be held
+func (m *eventManager) deletePersistedEvent(id int64) {
+	if m.db == nil {
+		return
+	}
+	if err := m.db.Delete(id); err != nil {
+		log.Errorf(log.EventMgr, "Unable to delete event %d from database: %v", id, err)
+	}
+}
+
+func eventToRecord(e *Event) (*dbevent.Event, error) {
+	definition, err := json.Marshal(&eventDefinition{
+		Item:       e.Item,
+		Condition:  e.Condition,
+		Operator:   e.Operator,
+		Conditions: e.Conditions,
+		Action:     e.Action,
+		Actions:    e.Actions,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &dbevent.Event{
+		ID:           e.ID,
+		ExchangeName: e.Exchange,
+		Base:         e.Pair.Base.String(),
+		Quote:        e.Pair.Quote.String(),
+		Asset:        e.Asset.String(),
+		Definition:   definition,
+		Executed:     e.Executed,
+		CreatedAt:    e.CreatedAt,
+		UpdatedAt:    time.Now(),
+	}, nil
+}
+
+func eventFromRecord(r *dbevent.Event) (*Event, error) {
+	var d eventDefinition
+	if err := json.Unmarshal(r.Definition, &d); err != nil {
+		return nil, err
+	}
+	a, err := asset.New(r.Asset)
+	if err != nil {
+		return nil, err
+	}
+	pair, err := currency.NewPairFromStrings(r.Base, r.Quote)
+	if err != nil {
+		return nil, err
+	}
+	return &Event{
+		ID:         r.ID,
+		Exchange:   strings.ToLower(r.ExchangeName),
+		Item:       d.Item,
+		Condition:  d.Condition,
+		Operator:   d.Operator,
+		Conditions: d.Conditions,
+		Pair:       pair,
+		Asset:      a,
+		Action:     d.Action,
+		Actions:    d.Actions,
+		Executed:   r.Executed,
+		CreatedAt:  r.CreatedAt,
+	}, nil
+}
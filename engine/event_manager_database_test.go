@@ -0,0 +1,167 @@
+package engine
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	dbevent "github.com/thrasher-corp/gocryptotrader/database/repository/event"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+)
+
+// fakeEventDB stores event records in memory
+type fakeEventDB struct {
+	m       sync.Mutex
+	records map[int64]dbevent.Event
+}
+
+func (f *fakeEventDB) Upsert(events ...*dbevent.Event) error {
+	f.m.Lock()
+	defer f.m.Unlock()
+	if f.records == nil {
+		f.records = make(map[int64]dbevent.Event)
+	}
+	for i := range events {
+		f.records[events[i].ID] = *events[i]
+	}
+	return nil
+}
+
+func (f *fakeEventDB) Delete(id int64) error {
+	f.m.Lock()
+	defer f.m.Unlock()
+	delete(f.records, id)
+	return nil
+}
+
+func (f *fakeEventDB) GetAll() ([]dbevent.Event, error) {
+	f.m.Lock()
+	defer f.m.Unlock()
+	resp := make([]dbevent.Event, 0, len(f.records))
+	for _, r := range f.records {
+		resp = append(resp, r)
+	}
+	return resp, nil
+}
+
+func (f *fakeEventDB) get(id int64) (dbevent.Event, bool) {
+	f.m.Lock()
+	defer f.m.Unlock()
+	r, ok := f.records[id]
+	return r, ok
+}
+
+func TestSetEventDatabase(t *testing.T) {
+	t.Parallel()
+	var m *eventManager
+	assert.ErrorIs(t, m.SetEventDatabase(nil), ErrNilSubsystem)
+
+	m, err := setupEventManager(&CommunicationManager{}, NewExchangeManager(), nil, nil, 0, false)
+	require.NoError(t, err)
+	assert.ErrorIs(t, m.SetEventDatabase(nil), database.ErrNilInstance)
+}
+
+func TestEventPersistence(t *testing.T) {
+	t.Parallel()
+	em := NewExchangeManager()
+	exch, err := em.NewExchangeByName(testExchange)
+	require.NoError(t, err)
+	exch.SetDefaults()
+	require.NoError(t, em.Add(exch))
+
+	m, err := setupEventManager(&CommunicationManager{}, em, nil, nil, 0, false)
+	require.NoError(t, err)
+
+	db := &fakeEventDB{}
+	saved := &Event{
+		ID:       5,
+		Exchange: testExchange,
+		Item:     ItemPrice,
+		Condition: EventConditionParams{
+			Condition: ConditionGreaterThan,
+			Price:     1337,
+		},
+		Pair:   currency.NewBTCUSDT(),
+		Asset:  asset.Spot,
+		Action: ActionTest,
+	}
+	r, err := eventToRecord(saved)
+	require.NoError(t, err)
+	unknown := *r
+	unknown.ID = 7
+	unknown.ExchangeName = "bruh"
+	require.NoError(t, db.Upsert(r, &unknown))
+
+	require.NoError(t, m.loadEvents(db))
+	require.NoError(t, m.loadEvents(db), "loadEvents must not error when events are already loaded")
+	require.NoError(t, m.Start())
+
+	events, err := m.GetEvents()
+	require.NoError(t, err)
+	require.Len(t, events, 1, "events for unknown exchanges should not be loaded")
+	assert.Equal(t, testExchange, events[0].Exchange, "loaded events should use the exchange's name")
+	assert.Equal(t, int64(5), events[0].ID)
+
+	id, err := m.AddEvent(&Event{
+		Exchange:   testExchange,
+		Conditions: []EventCondition{{Item: ItemRSI, Params: EventConditionParams{Condition: ConditionLessThan, Interval: kline.OneHour, Period: 14, Value: 30}}},
+		Pair:       currency.NewBTCUSDT(),
+		Asset:      asset.Spot,
+		Actions:    []EventAction{{Action: ActionCommsRelay}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(8), id, "new event IDs should follow the highest loaded ID")
+	_, ok := db.get(id)
+	assert.True(t, ok, "AddEvent should persist the event")
+
+	require.True(t, m.setExecuted(id))
+	r2, ok := db.get(id)
+	require.True(t, ok)
+	assert.True(t, r2.Executed, "executed events should be persisted")
+
+	require.True(t, m.Remove(id))
+	_, ok = db.get(id)
+	assert.False(t, ok, "Remove should delete the persisted event")
+}
+
+func TestEventRecordConversion(t *testing.T) {
+	t.Parallel()
+	e := &Event{
+		ID:       1,
+		Exchange: testExchange,
+		Operator: OperatorOr,
+		Conditions: []EventCondition{
+			{Item: ItemMACDCrossover, Params: EventConditionParams{Interval: kline.FourHour, FastPeriod: 12, SlowPeriod: 26, SignalPeriod: 9, Direction: DirectionAbove}},
+			{Operator: OperatorAnd, Conditions: []EventCondition{
+				{Item: ItemPriceChange, Params: EventConditionParams{Condition: ConditionLessThan, Interval: kline.OneHour, Window: time.Hour * 24, Value: -5}},
+				{Item: ItemSpread, Params: EventConditionParams{Condition: ConditionGreaterThan, Value: 0.5}},
+			}},
+		},
+		Pair:      currency.NewBTCUSDT(),
+		Asset:     asset.USDTMarginedFutures,
+		Actions:   []EventAction{{Action: ActionSubmitOrder, Side: "SELL", OrderType: "MARKET", Amount: 1}, {Action: ActionRunScript, Script: "moon.gct"}},
+		Executed:  true,
+		CreatedAt: time.Now().Truncate(time.Second),
+	}
+	r, err := eventToRecord(e)
+	require.NoError(t, err)
+	resp, err := eventFromRecord(r)
+	require.NoError(t, err)
+	resp.Exchange = e.Exchange
+	assert.Equal(t, e, resp, "events should survive a round trip through a database record")
+
+	r.Definition = []byte("moon")
+	_, err = eventFromRecord(r)
+	assert.Error(t, err, "eventFromRecord should error on an invalid definition")
+
+	r, err = eventToRecord(e)
+	require.NoError(t, err)
+	r.Asset = "moon"
+	_, err = eventFromRecord(r)
+	assert.ErrorIs(t, err, asset.ErrNotSupported)
+}
@@ -1,27 +1,34 @@
 package engine
 
 import (
+	"context"
+	"slices"
+	"sync"
 	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 )
 
 func TestSetupEventManager(t *testing.T) {
 	t.Parallel()
-	_, err := setupEventManager(nil, nil, 0, false)
+	_, err := setupEventManager(nil, nil, nil, nil, 0, false)
 	assert.ErrorIs(t, err, errNilComManager)
 
-	_, err = setupEventManager(&CommunicationManager{}, nil, 0, false)
+	_, err = setupEventManager(&CommunicationManager{}, nil, nil, nil, 0, false)
 	assert.ErrorIs(t, err, errNilExchangeManager)
 
-	m, err := setupEventManager(&CommunicationManager{}, &ExchangeManager{}, 0, false)
+	m, err := setupEventManager(&CommunicationManager{}, &ExchangeManager{}, nil, nil, 0, false)
 	require.NoError(t, err)
 
 	if m == nil { //nolint:staticcheck,nolintlint // SA5011 Ignore the nil warnings
@@ -33,7 +40,7 @@ func TestSetupEventManager(t *testing.T) {
 }
 
 func TestEventManagerStart(t *testing.T) {
-	m, err := setupEventManager(&CommunicationManager{}, &ExchangeManager{}, 0, false)
+	m, err := setupEventManager(&CommunicationManager{}, &ExchangeManager{}, nil, nil, 0, false)
 	assert.NoError(t, err)
 
 	err = m.Start()
@@ -49,7 +56,7 @@ func TestEventManagerStart(t *testing.T) {
 
 func TestEventManagerIsRunning(t *testing.T) {
 	t.Parallel()
-	m, err := setupEventManager(&CommunicationManager{}, &ExchangeManager{}, 0, false)
+	m, err := setupEventManager(&CommunicationManager{}, &ExchangeManager{}, nil, nil, 0, false)
 	assert.NoError(t, err)
 
 	err = m.Start()
@@ -70,7 +77,7 @@ func TestEventManagerIsRunning(t *testing.T) {
 
 func TestEventManagerStop(t *testing.T) {
 	t.Parallel()
-	m, err := setupEventManager(&CommunicationManager{}, &ExchangeManager{}, 0, false)
+	m, err := setupEventManager(&CommunicationManager{}, &ExchangeManager{}, nil, nil, 0, false)
 	assert.NoError(t, err)
 
 	err = m.Start()
@@ -90,7 +97,7 @@ func TestEventManagerStop(t *testing.T) {
 func TestEventManagerAdd(t *testing.T) {
 	t.Parallel()
 	em := NewExchangeManager()
-	m, err := setupEventManager(&CommunicationManager{}, em, 0, false)
+	m, err := setupEventManager(&CommunicationManager{}, em, nil, nil, 0, false)
 	assert.NoError(t, err)
 
 	_, err = m.Add("", "", EventConditionParams{}, currency.NewPair(currency.BTC, currency.USDC), asset.Spot, "")
@@ -121,18 +128,379 @@ func TestEventManagerAdd(t *testing.T) {
 	_, err = m.Add(testExchange, ItemPrice, cond, currency.NewPair(currency.BTC, currency.USDC), asset.Spot, "")
 	assert.ErrorIs(t, err, errInvalidAction)
 
-	_, err = m.Add(testExchange, ItemPrice, cond, currency.NewPair(currency.BTC, currency.USDC), asset.Spot, ActionTest)
+	id, err := m.Add(testExchange, ItemPrice, cond, currency.NewPair(currency.BTC, currency.USDC), asset.Spot, ActionTest)
 	assert.NoError(t, err)
+	assert.Equal(t, int64(1), id, "the first event should have an ID of 1")
 
 	action := ActionSMSNotify + "," + ActionTest
-	_, err = m.Add(testExchange, ItemPrice, cond, currency.NewPair(currency.BTC, currency.USDC), asset.Spot, action)
+	id, err = m.Add(testExchange, ItemPrice, cond, currency.NewPair(currency.BTC, currency.USDC), asset.Spot, action)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), id, "event IDs should increment")
+
+	require.True(t, m.Remove(2))
+	id, err = m.Add(testExchange, ItemPrice, cond, currency.NewPair(currency.BTC, currency.USDC), asset.Spot, action)
 	assert.NoError(t, err)
+	assert.Equal(t, int64(3), id, "event IDs should not be reused after removal")
+}
+
+func TestEventManagerAddEvent(t *testing.T) {
+	t.Parallel()
+	em := NewExchangeManager()
+	m, err := setupEventManager(&CommunicationManager{}, em, nil, nil, 0, false)
+	require.NoError(t, err)
+
+	_, err = m.AddEvent(nil)
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted)
+	require.NoError(t, m.Start())
+	_, err = m.AddEvent(nil)
+	assert.ErrorIs(t, err, errNilEvent)
+
+	exch, err := em.NewExchangeByName(testExchange)
+	require.NoError(t, err)
+	exch.SetDefaults()
+	require.NoError(t, em.Add(exch))
+
+	rsi := EventCondition{Item: ItemRSI, Params: EventConditionParams{Condition: ConditionLessThan, Interval: kline.OneHour, Period: 14, Value: 30}}
+	spread := EventCondition{Item: ItemSpread, Params: EventConditionParams{Condition: ConditionGreaterThan, Value: 1}}
+	e := &Event{
+		Exchange:   testExchange,
+		Operator:   OperatorOr,
+		Conditions: []EventCondition{rsi, {Operator: OperatorAnd, Conditions: []EventCondition{spread, rsi}}},
+		Pair:       currency.NewBTCUSDT(),
+		Asset:      asset.Spot,
+	}
+	_, err = m.AddEvent(e)
+	assert.ErrorIs(t, err, errInvalidAction, "AddEvent should error without an action")
+
+	e.Actions = []EventAction{{Action: ActionSubmitOrder, Side: "BUY", OrderType: "MARKET", Amount: 1}, {Action: ActionCommsRelay, Relayer: "Slack"}}
+	id, err := m.AddEvent(e)
+	require.NoError(t, err)
+	events, err := m.GetEvents()
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, id, events[0].ID)
+	assert.Len(t, events[0].Conditions, 2)
+	assert.False(t, events[0].CreatedAt.IsZero(), "AddEvent should set the creation time")
+
+	e.Operator = "XOR"
+	_, err = m.AddEvent(e)
+	assert.ErrorIs(t, err, errInvalidOperator)
+
+	e.Operator = ""
+	e.Conditions[1].Operator = "XOR"
+	_, err = m.AddEvent(e)
+	assert.ErrorIs(t, err, errInvalidOperator, "nested operators should be validated")
+
+	e.Conditions[1].Operator = OperatorAnd
+	e.Conditions[1].Conditions = append(e.Conditions[1].Conditions, EventCondition{Item: ItemFundingRate, Params: EventConditionParams{Condition: ConditionGreaterThan}})
+	_, err = m.AddEvent(e)
+	assert.ErrorIs(t, err, errInvalidCondition, "funding rate conditions should require a futures asset")
+
+	m = nil
+	_, err = m.GetEvents()
+	assert.ErrorIs(t, err, ErrNilSubsystem)
+}
+
+func TestIsValidItemCondition(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		item   string
+		params EventConditionParams
+		asset  asset.Item
+		err    error
+	}{
+		{item: "moon", err: errInvalidItem},
+		{item: ItemPrice, params: EventConditionParams{Condition: "!="}, err: errInvalidCondition},
+		{item: ItemPrice, params: EventConditionParams{Condition: ConditionGreaterThan}, err: errInvalidCondition},
+		{item: ItemPrice, params: EventConditionParams{Condition: ConditionGreaterThan, Price: 1}},
+		{item: ItemOrderbook, params: EventConditionParams{Condition: ConditionGreaterThan}, err: errInvalidCondition},
+		{item: ItemRSI, params: EventConditionParams{Condition: ConditionLessThan, Interval: kline.OneHour, Period: 1, Value: 30}, err: errInvalidCondition},
+		{item: ItemRSI, params: EventConditionParams{Condition: ConditionLessThan, Interval: kline.OneHour, Period: 14, Value: 101}, err: errInvalidCondition},
+		{item: "rsi", params: EventConditionParams{Condition: ConditionLessThan, Interval: kline.OneHour, Period: 14, Value: 30}},
+		{item: ItemMACDCrossover, params: EventConditionParams{Interval: kline.OneHour, FastPeriod: 12, SlowPeriod: 26, SignalPeriod: 9}, err: errInvalidCondition},
+		{item: ItemMACDCrossover, params: EventConditionParams{Interval: kline.OneHour, FastPeriod: 26, SlowPeriod: 12, SignalPeriod: 9, Direction: DirectionAbove}, err: errInvalidCondition},
+		{item: ItemMACDCrossover, params: EventConditionParams{Interval: kline.OneHour, FastPeriod: 12, SlowPeriod: 26, SignalPeriod: 9, Direction: DirectionAbove}},
+		{item: ItemBollingerBreakout, params: EventConditionParams{Interval: kline.OneHour, Period: 20, Direction: DirectionBelow}, err: errInvalidCondition},
+		{item: ItemBollingerBreakout, params: EventConditionParams{Interval: kline.OneHour, Period: 20, StandardDeviations: 2, Direction: DirectionBelow}},
+		{item: ItemPriceChange, params: EventConditionParams{Condition: ConditionLessThan, Interval: kline.OneHour, Window: time.Minute, Value: -5}, err: errInvalidCondition},
+		{item: ItemPriceChange, params: EventConditionParams{Condition: ConditionLessThan, Interval: kline.OneHour, Window: time.Hour * 24, Value: -5}},
+		{item: ItemSpread, params: EventConditionParams{Condition: ConditionGreaterThan, Value: -1}, err: errInvalidCondition},
+		{item: ItemSpread, params: EventConditionParams{Condition: ConditionGreaterThan, Value: 0.5}},
+		{item: ItemFundingRate, params: EventConditionParams{Condition: ConditionGreaterThan}, asset: asset.Spot, err: errInvalidCondition},
+		{item: ItemFundingRate, params: EventConditionParams{Condition: ConditionGreaterThan, Value: 0.0001}, asset: asset.PerpetualSwap},
+	} {
+		t.Run(tc.item, func(t *testing.T) {
+			t.Parallel()
+			if tc.asset == asset.Empty {
+				tc.asset = asset.Spot
+			}
+			err := isValidItemCondition(tc.item, &tc.params, tc.asset)
+			if tc.err != nil {
+				assert.ErrorIs(t, err, tc.err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestIsValidEventAction(t *testing.T) {
+	t.Parallel()
+	assert.ErrorIs(t, isValidEventAction(&EventAction{Action: "moon"}), errInvalidAction)
+	assert.NoError(t, isValidEventAction(&EventAction{Action: ActionCommsRelay}))
+	assert.ErrorIs(t, isValidEventAction(&EventAction{Action: ActionSubmitOrder, Side: "moon"}), errInvalidAction)
+	assert.ErrorIs(t, isValidEventAction(&EventAction{Action: ActionSubmitOrder, Side: "BUY", OrderType: "LIMIT", Amount: 1}), errInvalidAction, "limit orders should require a price")
+	assert.ErrorIs(t, isValidEventAction(&EventAction{Action: ActionSubmitOrder, Side: "BUY", OrderType: "MARKET"}), errInvalidAction, "orders should require an amount")
+	assert.NoError(t, isValidEventAction(&EventAction{Action: ActionSubmitOrder, Side: "BUY", OrderType: "LIMIT", Amount: 1, Price: 1}))
+	assert.ErrorIs(t, isValidEventAction(&EventAction{Action: ActionCancelOrder}), errInvalidAction)
+	assert.NoError(t, isValidEventAction(&EventAction{Action: ActionCancelOrder, OrderID: "1337"}))
+	assert.ErrorIs(t, isValidEventAction(&EventAction{Action: ActionRunScript}), errInvalidAction)
+	assert.NoError(t, isValidEventAction(&EventAction{Action: ActionRunScript, Script: "moon.gct"}))
+	for _, script := range []string{"../moon.gct", "scripts/../../moon.gct", "/tmp/moon.gct", ".."} {
+		assert.ErrorIsf(t, isValidEventAction(&EventAction{Action: ActionRunScript, Script: script}), errInvalidAction, "isValidEventAction should error for script %q", script)
+	}
+	a := &EventAction{Action: ActionRunScript, Script: "strategies/../moon.gct"}
+	require.NoError(t, isValidEventAction(a))
+	assert.Equal(t, "moon.gct", a.Script, "isValidEventAction should clean the script path")
+}
+
+func TestMeetsCondition(t *testing.T) {
+	t.Parallel()
+	assert.NoError(t, meetsCondition(ConditionGreaterThan, 2, 1))
+	assert.ErrorIs(t, meetsCondition(ConditionGreaterThan, 1, 1), errConditionNotMet)
+	assert.NoError(t, meetsCondition(ConditionGreaterThanOrEqual, 1, 1))
+	assert.NoError(t, meetsCondition(ConditionLessThan, 1, 2))
+	assert.ErrorIs(t, meetsCondition(ConditionLessThanOrEqual, 2, 1), errConditionNotMet)
+	assert.NoError(t, meetsCondition(ConditionIsEqual, 1, 1))
+	assert.ErrorIs(t, meetsCondition("moon", 1, 1), errConditionNotMet)
+}
+
+// candlesFromCloses returns hourly candles which open at the previous close
+func candlesFromCloses(closes []float64) *kline.Item {
+	k := &kline.Item{Interval: kline.OneHour, Candles: make([]kline.Candle, len(closes))}
+	start := time.Now().Add(-kline.OneHour.Duration() * time.Duration(len(closes))).Truncate(kline.OneHour.Duration())
+	for i := range closes {
+		o := closes[i]
+		if i > 0 {
+			o = closes[i-1]
+		}
+		k.Candles[i] = kline.Candle{
+			Time:   start.Add(kline.OneHour.Duration() * time.Duration(i)),
+			Open:   o,
+			High:   max(o, closes[i]),
+			Low:    min(o, closes[i]),
+			Close:  closes[i],
+			Volume: 1,
+		}
+	}
+	return k
+}
+
+func TestCheckIndicatorConditions(t *testing.T) {
+	t.Parallel()
+	m, err := setupEventManager(&CommunicationManager{}, NewExchangeManager(), nil, nil, 0, false)
+	require.NoError(t, err)
+	require.NoError(t, m.Start())
+
+	e := &Event{Exchange: "eventindicators", Pair: currency.NewBTCUSDT(), Asset: asset.Spot}
+	declining := make([]float64, minimumEventCandles)
+	flat := make([]float64, minimumEventCandles)
+	for i := range declining {
+		declining[i] = 1000 - float64(i)*5
+		flat[i] = 100 + float64(i%2)
+	}
+	// A sharp rise on the last candle
+	rising := append(slices.Clone(declining[:len(declining)-1]), declining[len(declining)-2]*1.2)
+	flat[len(flat)-1] = 120
+	m.candles = map[string]*cachedCandles{
+		candleCacheKey(e, kline.FourHour, indicatorCandleCount(14)):                                                                     {item: candlesFromCloses(declining), fetched: time.Now()},
+		candleCacheKey(e, kline.OneHour, indicatorCandleCount(26+9)):                                                                    {item: candlesFromCloses(rising), fetched: time.Now()},
+		candleCacheKey(e, kline.OneHour, indicatorCandleCount(20)):                                                                      {item: candlesFromCloses(flat), fetched: time.Now()},
+		candleCacheKey(e, kline.OneHour, priceChangeCandleCount(&EventConditionParams{Interval: kline.OneHour, Window: time.Hour * 4})): {item: candlesFromCloses(rising), fetched: time.Now()},
+	}
+
+	rsi := EventConditionParams{Condition: ConditionLessThan, Interval: kline.FourHour, Period: 14, Value: 30}
+	assert.NoError(t, m.checkItem(e, ItemRSI, &rsi), "RSI should be oversold after a long decline")
+	rsi.Condition = ConditionGreaterThan
+	assert.ErrorIs(t, m.checkItem(e, ItemRSI, &rsi), errConditionNotMet)
+
+	macd := EventConditionParams{Interval: kline.OneHour, FastPeriod: 12, SlowPeriod: 26, SignalPeriod: 9, Direction: DirectionAbove}
+	assert.NoError(t, m.checkItem(e, ItemMACDCrossover, &macd), "MACD should cross above its signal after a sharp rise")
+	macd.Direction = DirectionBelow
+	assert.ErrorIs(t, m.checkItem(e, ItemMACDCrossover, &macd), errConditionNotMet)
+
+	bollinger := EventConditionParams{Interval: kline.OneHour, Period: 20, StandardDeviations: 2, Direction: DirectionAbove}
+	assert.NoError(t, m.checkItem(e, ItemBollingerBreakout, &bollinger), "the last close should break above the upper band")
+	bollinger.Direction = DirectionBelow
+	assert.ErrorIs(t, m.checkItem(e, ItemBollingerBreakout, &bollinger), errConditionNotMet)
+
+	change := EventConditionParams{Condition: ConditionGreaterThan, Interval: kline.OneHour, Window: time.Hour * 4, Value: 15}
+	assert.NoError(t, m.checkItem(e, ItemPriceChange, &change), "the price change should only cover the window")
+	change.Value = 25
+	assert.ErrorIs(t, m.checkItem(e, ItemPriceChange, &change), errConditionNotMet)
+
+	k, err := m.getCandles(e, kline.OneHour, indicatorCandleCount(20))
+	require.NoError(t, err)
+	k.Candles = k.Candles[:1]
+	bollinger.Direction = DirectionAbove
+	assert.NoError(t, m.checkItem(e, ItemBollingerBreakout, &bollinger), "cached candles should not be modified by callers")
+
+	_, err = m.getCandles(e, kline.OneDay, indicatorCandleCount(20))
+	assert.ErrorIs(t, err, ErrExchangeNotFound, "uncached candles should be requested from the exchange")
+
+	assert.ErrorIs(t, m.checkItem(e, "moon", &rsi), errInvalidItem)
+}
+
+func TestCheckCompositeConditions(t *testing.T) {
+	t.Parallel()
+	m, err := setupEventManager(&CommunicationManager{}, NewExchangeManager(), nil, nil, 0, false)
+	require.NoError(t, err)
+	require.NoError(t, m.Start())
+
+	e := &Event{Exchange: "eventcomposite", Pair: currency.NewBTCUSDT(), Asset: asset.PerpetualSwap}
+	require.NoError(t, ticker.ProcessTicker(&ticker.Price{ExchangeName: e.Exchange, Pair: e.Pair, AssetType: e.Asset, Last: 100}))
+	require.NoError(t, (&orderbook.Book{
+		Exchange: e.Exchange,
+		Pair:     e.Pair,
+		Asset:    e.Asset,
+		Bids:     orderbook.Levels{{Price: 99, Amount: 1}},
+		Asks:     orderbook.Levels{{Price: 101, Amount: 1}},
+	}).Process())
+	m.fundingRates = map[string]*cachedFundingRate{fundingRateCacheKey(e): {rate: 0.0005, fetched: time.Now()}}
+
+	priceAbove := EventCondition{Item: ItemPrice, Params: EventConditionParams{Condition: ConditionGreaterThan, Price: 50}}
+	priceBelow := EventCondition{Item: ItemPrice, Params: EventConditionParams{Condition: ConditionLessThan, Price: 50}}
+	spread := EventCondition{Item: ItemSpread, Params: EventConditionParams{Condition: ConditionGreaterThan, Value: 1.9}}
+	funding := EventCondition{Item: ItemFundingRate, Params: EventConditionParams{Condition: ConditionGreaterThan, Value: 0.0001}}
+
+	e.Conditions = []EventCondition{priceAbove, spread, funding}
+	assert.NoError(t, m.checkEventCondition(e), "all conditions should be met")
+
+	e.Conditions = []EventCondition{priceAbove, priceBelow}
+	assert.ErrorIs(t, m.checkEventCondition(e), errConditionNotMet, "AND should require all conditions")
+
+	e.Operator = OperatorOr
+	assert.NoError(t, m.checkEventCondition(e), "OR should require any condition")
+
+	e.Conditions = []EventCondition{priceBelow, {Operator: OperatorAnd, Conditions: []EventCondition{spread, funding}}}
+	assert.NoError(t, m.checkEventCondition(e), "nested groups should be checked")
+
+	e.Conditions = []EventCondition{priceBelow, {Operator: OperatorAnd, Conditions: []EventCondition{spread, priceBelow}}}
+	assert.ErrorIs(t, m.checkEventCondition(e), errConditionNotMet)
+
+	spread.Params.Value = 2.1
+	e.Conditions = []EventCondition{spread}
+	assert.ErrorIs(t, m.checkEventCondition(e), errConditionNotMet)
+}
+
+// fakeEventComms records events pushed by the event manager
+type fakeEventComms struct {
+	m      sync.Mutex
+	events []base.Event
+	relays map[string][]base.Event
+}
+
+func (f *fakeEventComms) PushEvent(evt base.Event) {
+	f.m.Lock()
+	defer f.m.Unlock()
+	f.events = append(f.events, evt)
+}
+
+func (f *fakeEventComms) PushEventTo(relayer string, evt base.Event) error {
+	f.m.Lock()
+	defer f.m.Unlock()
+	if f.relays == nil {
+		f.relays = make(map[string][]base.Event)
+	}
+	f.relays[relayer] = append(f.relays[relayer], evt)
+	return nil
+}
+
+// fakeEventOrderManager records orders submitted and cancelled by the event
+// manager
+type fakeEventOrderManager struct {
+	iOrderManager
+	submitted []*order.Submit
+	cancelled []*order.Cancel
+}
+
+func (f *fakeEventOrderManager) IsRunning() bool { return true }
+
+func (f *fakeEventOrderManager) Submit(_ context.Context, s *order.Submit) (*OrderSubmitResponse, error) {
+	f.submitted = append(f.submitted, s)
+	return &OrderSubmitResponse{Detail: &order.Detail{OrderID: "1337"}}, nil
+}
+
+func (f *fakeEventOrderManager) Cancel(_ context.Context, c *order.Cancel) error {
+	f.cancelled = append(f.cancelled, c)
+	return nil
+}
+
+func TestExecuteEvent(t *testing.T) {
+	t.Parallel()
+	em := NewExchangeManager()
+	exch, err := em.NewExchangeByName(testExchange)
+	require.NoError(t, err)
+	exch.SetDefaults()
+	require.NoError(t, em.Add(exch))
+
+	comms := &fakeEventComms{}
+	om := &fakeEventOrderManager{}
+	m, err := setupEventManager(comms, em, om, nil, 0, false)
+	require.NoError(t, err)
+	require.NoError(t, m.Start())
+
+	e := &Event{
+		Exchange:   testExchange,
+		Conditions: []EventCondition{{Item: ItemFundingRate, Params: EventConditionParams{Condition: ConditionGreaterThan, Value: 0.0001}}},
+		Pair:       currency.NewBTCUSDT(),
+		Asset:      asset.PerpetualSwap,
+		Actions: []EventAction{
+			{Action: ActionSubmitOrder, Side: "BUY", OrderType: "LIMIT", Amount: 1, Price: 100},
+			{Action: ActionCancelOrder, OrderID: "1337"},
+			{Action: ActionCommsRelay, Relayer: "Slack", Message: "moon"},
+			{Action: ActionRunScript, Script: "moon.gct"},
+		},
+	}
+	id, err := m.AddEvent(e)
+	require.NoError(t, err)
+	e.ID = id
+	m.fundingRates = map[string]*cachedFundingRate{fundingRateCacheKey(e): {rate: 0.00001, fetched: time.Now()}}
+
+	m.executeEvent(e)
+	assert.Empty(t, comms.events, "events should not trigger when their conditions are not met")
+
+	m.fundingRates[fundingRateCacheKey(e)].rate = 0.0005
+	m.executeEvent(e)
+	require.Len(t, comms.events, 1, "triggered events must be pushed to comms")
+	require.Len(t, om.submitted, 1, "SUBMIT_ORDER must submit an order")
+	assert.Equal(t, order.Buy, om.submitted[0].Side)
+	assert.Equal(t, order.Limit, om.submitted[0].Type)
+	assert.Equal(t, 100.0, om.submitted[0].Price)
+	assert.Equal(t, asset.PerpetualSwap, om.submitted[0].AssetType)
+	require.Len(t, om.cancelled, 1, "CANCEL_ORDER must cancel an order")
+	assert.Equal(t, "1337", om.cancelled[0].OrderID)
+	require.Len(t, comms.relays["Slack"], 1, "COMMS_RELAY must push to the relayer")
+	assert.Equal(t, "moon", comms.relays["Slack"][0].Message)
+
+	total, executed := m.getEventCounter()
+	assert.Equal(t, 1, total)
+	assert.Equal(t, 1, executed, "triggered events should be marked as executed")
+
+	m.executeEvent(e)
+	assert.Len(t, comms.events, 1, "events should only trigger once")
+
+	assert.ErrorIs(t, m.runScript(&e.Actions[3]), errScriptingDisabled)
+	m.orderManager = nil
+	assert.ErrorIs(t, m.submitOrder(e, &e.Actions[0]), ErrSubSystemNotStarted)
+	assert.ErrorIs(t, m.cancelOrder(e, &e.Actions[1]), ErrSubSystemNotStarted)
+	assert.ErrorIs(t, m.performAction(e, &EventAction{Action: "moon"}), errInvalidAction)
 }
 
 func TestEventManagerRemove(t *testing.T) {
 	t.Parallel()
 	em := NewExchangeManager()
-	m, err := setupEventManager(&CommunicationManager{}, em, 0, false)
+	m, err := setupEventManager(&CommunicationManager{}, em, nil, nil, 0, false)
 	assert.NoError(t, err)
 
 	if m.Remove(0) {
@@ -169,7 +537,7 @@ func TestEventManagerRemove(t *testing.T) {
 func TestGetEventCounter(t *testing.T) {
 	t.Parallel()
 	em := NewExchangeManager()
-	m, err := setupEventManager(&CommunicationManager{}, em, 0, false)
+	m, err := setupEventManager(&CommunicationManager{}, em, nil, nil, 0, false)
 	assert.NoError(t, err)
 
 	total, executed := m.getEventCounter()
@@ -208,7 +576,7 @@ func TestGetEventCounter(t *testing.T) {
 
 func TestCheckEventCondition(t *testing.T) {
 	em := NewExchangeManager()
-	m, err := setupEventManager(&CommunicationManager{}, em, 0, false)
+	m, err := setupEventManager(&CommunicationManager{}, em, nil, nil, 0, false)
 	require.NoError(t, err, "setupEventManager must not error")
 
 	m.m.Lock()
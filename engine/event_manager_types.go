@@ -6,13 +6,21 @@ import (
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	dbevent "github.com/thrasher-corp/gocryptotrader/database/repository/event"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 )
 
 // Event const vars
 const (
-	ItemPrice     = "PRICE"
-	ItemOrderbook = "ORDERBOOK"
+	ItemPrice             = "PRICE"
+	ItemOrderbook         = "ORDERBOOK"
+	ItemRSI               = "RSI"
+	ItemMACDCrossover     = "MACD_CROSSOVER"
+	ItemBollingerBreakout = "BOLLINGER_BREAKOUT"
+	ItemPriceChange       = "PRICE_CHANGE"
+	ItemSpread            = "SPREAD"
+	ItemFundingRate       = "FUNDING_RATE"
 
 	ConditionGreaterThan        = ">"
 	ConditionGreaterThanOrEqual = ">="
@@ -20,11 +28,27 @@ const (
 	ConditionLessThanOrEqual    = "<="
 	ConditionIsEqual            = "=="
 
+	OperatorAnd = "AND"
+	OperatorOr  = "OR"
+
+	DirectionAbove = "ABOVE"
+	DirectionBelow = "BELOW"
+
 	ActionSMSNotify    = "SMS"
 	ActionConsolePrint = "CONSOLE_PRINT"
 	ActionTest         = "ACTION_TEST"
+	ActionSubmitOrder  = "SUBMIT_ORDER"
+	ActionCancelOrder  = "CANCEL_ORDER"
+	ActionRunScript    = "RUN_SCRIPT"
+	ActionCommsRelay   = "COMMS_RELAY"
 
 	defaultSleepDelay = time.Millisecond * 500
+	// eventDataCacheDuration is how long candles and funding rates fetched
+	// for event conditions are reused before being requested again
+	eventDataCacheDuration = time.Second * 30
+	// minimumEventCandles is the least number of candles requested for
+	// indicator conditions so their smoothing has enough history
+	minimumEventCandles = 100
 )
 
 // vars related to events package
@@ -33,42 +57,126 @@ var (
 	errInvalidItem         = errors.New("invalid item")
 	errInvalidCondition    = errors.New("invalid conditional option")
 	errInvalidAction       = errors.New("invalid action")
+	errInvalidOperator     = errors.New("invalid condition operator")
 	errExchangeDisabled    = errors.New("desired exchange is disabled")
 	errNilEvent            = errors.New("nil event received")
 	errNilComManager       = errors.New("nil communications manager received")
 	errTickerLastPriceZero = errors.New("ticker last price is 0")
+	errConditionNotMet     = errors.New("does not meet conditions")
+	errNotEnoughCandles    = errors.New("not enough candles to evaluate condition")
+	errOrderbookEmpty      = errors.New("orderbook has no bids or asks")
+	errNoFundingRate       = errors.New("no funding rate returned")
+	errScriptingDisabled   = errors.New("gctscript manager is not running")
+	errVMCreationFailed    = errors.New("unable to create VM instance")
 )
 
 // EventConditionParams holds the event condition variables
 type EventConditionParams struct {
-	Condition string
-	Price     float64
+	Condition string  `json:"condition,omitempty"`
+	Price     float64 `json:"price,omitempty"`
+
+	CheckBids       bool    `json:"checkBids,omitempty"`
+	CheckAsks       bool    `json:"checkAsks,omitempty"`
+	OrderbookAmount float64 `json:"orderbookAmount,omitempty"`
+
+	// Interval is the candle interval used by indicator and price change
+	// conditions
+	Interval kline.Interval `json:"interval,omitempty"`
+	// Period is used by RSI and Bollinger band conditions
+	Period       int64 `json:"period,omitempty"`
+	FastPeriod   int64 `json:"fastPeriod,omitempty"`
+	SlowPeriod   int64 `json:"slowPeriod,omitempty"`
+	SignalPeriod int64 `json:"signalPeriod,omitempty"`
+	// StandardDeviations sets the width of Bollinger bands
+	StandardDeviations float64 `json:"standardDeviations,omitempty"`
+	// Direction is either ABOVE or BELOW for crossover and breakout conditions
+	Direction string `json:"direction,omitempty"`
+	// Window is the period a price change is measured over
+	Window time.Duration `json:"window,omitempty"`
+	// Value is the threshold for RSI, price change percentage, spread
+	// percentage and funding rate conditions
+	Value float64 `json:"value,omitempty"`
+}
+
+// EventCondition is a single condition or a group of conditions joined by an
+// AND or OR operator
+type EventCondition struct {
+	Item       string               `json:"item,omitempty"`
+	Params     EventConditionParams `json:"params"`
+	Operator   string               `json:"operator,omitempty"`
+	Conditions []EventCondition     `json:"conditions,omitempty"`
+}
 
-	CheckBids       bool
-	CheckAsks       bool
-	OrderbookAmount float64
+// EventAction is an action performed when an event is triggered
+type EventAction struct {
+	Action    string  `json:"action"`
+	Side      string  `json:"side,omitempty"`
+	OrderType string  `json:"orderType,omitempty"`
+	Amount    float64 `json:"amount,omitempty"`
+	Price     float64 `json:"price,omitempty"`
+	OrderID   string  `json:"orderID,omitempty"`
+	Script    string  `json:"script,omitempty"`
+	// Relayer is the name of the communications relayer to push to, all
+	// relayers are used when empty
+	Relayer string `json:"relayer,omitempty"`
+	Message string `json:"message,omitempty"`
 }
 
-// Event struct holds the event variables
+// Event struct holds the event variables. When Conditions are set they are
+// joined by Operator and used instead of Item and Condition
 type Event struct {
-	ID        int64
-	Exchange  string
-	Item      string
-	Condition EventConditionParams
-	Pair      currency.Pair
-	Asset     asset.Item
-	Action    string
-	Executed  bool
+	ID         int64
+	Exchange   string
+	Item       string
+	Condition  EventConditionParams
+	Operator   string
+	Conditions []EventCondition
+	Pair       currency.Pair
+	Asset      asset.Item
+	Action     string
+	Actions    []EventAction
+	Executed   bool
+	CreatedAt  time.Time
+}
+
+// eventDefinition is the persisted form of an event's conditions and actions
+type eventDefinition struct {
+	Item       string               `json:"item,omitempty"`
+	Condition  EventConditionParams `json:"condition"`
+	Operator   string               `json:"operator,omitempty"`
+	Conditions []EventCondition     `json:"conditions,omitempty"`
+	Action     string               `json:"action,omitempty"`
+	Actions    []EventAction        `json:"actions,omitempty"`
 }
 
 // eventManager holds communication manager data
 type eventManager struct {
 	started         int32
 	comms           iCommsManager
+	orderManager    iOrderManager
+	scriptManager   iGCTScriptManager
 	events          []Event
+	nextID          int64
+	db              dbevent.IDBService
 	verbose         bool
 	sleepDelay      time.Duration
 	exchangeManager iExchangeManager
 	shutdown        chan struct{}
 	m               sync.Mutex
+
+	dataMtx      sync.Mutex
+	candles      map[string]*cachedCandles
+	fundingRates map[string]*cachedFundingRate
+}
+
+// cachedCandles holds candles fetched for event conditions
+type cachedCandles struct {
+	item    *kline.Item
+	fetched time.Time
+}
+
+// cachedFundingRate holds a funding rate fetched for event conditions
+type cachedFundingRate struct {
+	rate    float64
+	fetched time.Time
 }
@@ -17,6 +17,7 @@ import (
 	"os"
 	"path/filepath"
 	"runtime"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -52,12 +53,15 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kucoin"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/lbank"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/okx"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook/analytics"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/poloniex"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/stats"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/yobit"
 	"github.com/thrasher-corp/gocryptotrader/gctscript/vm"
 	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/ledger"
 )
 
 var (
@@ -81,6 +85,7 @@ func (bot *Engine) GetSubsystemsStatus() map[string]bool {
 		OrderManagerName:              bot.OrderManager.IsRunning(),
 		PortfolioManagerName:          bot.portfolioManager.IsRunning(),
 		NTPManagerName:                bot.ntpManager.IsRunning(),
+		MetricsManagerName:            bot.metricsManager.IsRunning(),
 		DatabaseConnectionManagerName: bot.DatabaseManager.IsRunning(),
 		SyncManagerName:               bot.Settings.EnableExchangeSyncManager,
 		grpcName:                      bot.Settings.EnableGRPC,
@@ -89,6 +94,11 @@ func (bot *Engine) GetSubsystemsStatus() map[string]bool {
 		dispatch.Name:                 dispatch.IsRunning(),
 		dataHistoryManagerName:        bot.dataHistoryManager.IsRunning(),
 		CurrencyStateManagementName:   bot.currencyStateManager.IsRunning(),
+		TradeCandleManagerName:        bot.tradeCandleManager.IsRunning(),
+		WithdrawManagerName:           bot.WithdrawManager.IsRunning(),
+		LedgerManagerName:             bot.ledgerManager.IsRunning(),
+		RebalanceManagerName:          bot.rebalanceManager.IsRunning(),
+		OrderbookCaptureManagerName:   bot.orderbookCaptureManager.IsRunning(),
 	}
 }
 
@@ -185,6 +195,17 @@ func (bot *Engine) SetSubsystem(subSystemName string, enable bool) error {
 			return bot.ntpManager.Start()
 		}
 		return bot.ntpManager.Stop()
+	case MetricsManagerName:
+		if enable {
+			if bot.metricsManager == nil {
+				bot.metricsManager, err = setupMetricsManager(&bot.Config.Metrics, bot)
+				if err != nil {
+					return err
+				}
+			}
+			return bot.metricsManager.Start()
+		}
+		return bot.metricsManager.Stop()
 	case DatabaseConnectionManagerName:
 		if enable {
 			if bot.DatabaseManager == nil {
@@ -248,6 +269,61 @@ func (bot *Engine) SetSubsystem(subSystemName string, enable bool) error {
 			return bot.dataHistoryManager.Start()
 		}
 		return bot.dataHistoryManager.Stop()
+	case TradeCandleManagerName:
+		if enable {
+			if bot.tradeCandleManager == nil {
+				bot.tradeCandleManager, err = setupTradeCandleManager(bot.ExchangeManager, bot.DatabaseManager, &bot.Config.TradeCandleManager)
+				if err != nil {
+					return err
+				}
+			}
+			return bot.tradeCandleManager.Start()
+		}
+		return bot.tradeCandleManager.Stop()
+	case WithdrawManagerName:
+		if enable {
+			if bot.WithdrawManager == nil {
+				bot.WithdrawManager, err = SetupWithdrawManager(bot.ExchangeManager, bot.portfolioManager, bot.CommunicationsManager, &bot.Config.WithdrawManager, bot.Settings.EnableDryRun)
+				if err != nil {
+					return err
+				}
+			}
+			return bot.WithdrawManager.Start()
+		}
+		return bot.WithdrawManager.Stop()
+	case LedgerManagerName:
+		if enable {
+			if bot.ledgerManager == nil {
+				bot.ledgerManager, err = setupLedgerManager(bot.ExchangeManager, bot.OrderManager, &bot.Config.LedgerManager)
+				if err != nil {
+					return err
+				}
+			}
+			return bot.ledgerManager.Start()
+		}
+		return bot.ledgerManager.Stop()
+	case RebalanceManagerName:
+		if enable {
+			if bot.rebalanceManager == nil {
+				bot.rebalanceManager, err = setupRebalanceManager(bot.ExchangeManager, bot.OrderManager, &bot.Config.RebalanceManager)
+				if err != nil {
+					return err
+				}
+			}
+			return bot.rebalanceManager.Start()
+		}
+		return bot.rebalanceManager.Stop()
+	case OrderbookCaptureManagerName:
+		if enable {
+			if bot.orderbookCaptureManager == nil {
+				bot.orderbookCaptureManager, err = setupOrderbookCaptureManager(bot.ExchangeManager, &bot.Config.OrderbookCapture, bot.Settings.DataDir)
+				if err != nil {
+					return err
+				}
+			}
+			return bot.orderbookCaptureManager.Start()
+		}
+		return bot.orderbookCaptureManager.Stop()
 	case vm.Name:
 		if enable {
 			if bot.gctScriptManager == nil {
@@ -560,6 +636,34 @@ func GetExchangeLowestPriceByCurrencyPair(p currency.Pair, assetType asset.Item)
 	return result[0].Exchange, nil
 }
 
+// convertCurrencyAmount converts amounts using foreign exchange rates.
+// Currencies without a rate, such as cryptocurrencies, are converted using the
+// last spot ticker price against the target currency or USD on any loaded
+// exchange
+func convertCurrencyAmount(em iExchangeManager, amount float64, from, to currency.Code) (float64, error) {
+	v, err := ledger.ConvertCurrency(amount, from, to)
+	if err == nil {
+		return v, nil
+	}
+	exchs, exchErr := em.GetExchanges()
+	if exchErr != nil {
+		return 0, exchErr
+	}
+	for _, quote := range []currency.Code{to, currency.USD, currency.USDT, currency.USDC} {
+		if from.Equal(quote) {
+			continue
+		}
+		for _, exch := range exchs {
+			t, tickErr := ticker.GetTicker(exch.GetName(), currency.NewPair(from, quote), asset.Spot)
+			if tickErr != nil || t.Last <= 0 {
+				continue
+			}
+			return ledger.ConvertCurrency(amount*t.Last, quote, to)
+		}
+	}
+	return 0, err
+}
+
 // GetCryptocurrenciesByExchange returns a list of cryptocurrencies the exchange supports
 func (bot *Engine) GetCryptocurrenciesByExchange(exchangeName string, enabledExchangesOnly, enabledPairs bool, assetType asset.Item) ([]string, error) {
 	var cryptocurrencies []string
@@ -776,6 +880,85 @@ func (bot *Engine) GetAllActiveTickers() []EnabledExchangeCurrencies {
 	return tickerData
 }
 
+// NewOrderbookAggregator returns an orderbook aggregator across the loaded exchanges in the config. Source exchange
+// names are matched to the loaded exchanges and sources without a fee are assigned the exchange's taker fee
+func (bot *Engine) NewOrderbookAggregator(ctx context.Context, cfg *orderbook.AggregatorConfig) (*orderbook.Aggregator, error) {
+	if bot == nil {
+		return nil, errNilBot
+	}
+	if cfg == nil {
+		return nil, fmt.Errorf("%w: %T", common.ErrNilPointer, cfg)
+	}
+	c := *cfg
+	c.Sources = slices.Clone(cfg.Sources)
+	for i := range c.Sources {
+		exch, err := bot.GetExchangeByName(c.Sources[i].Exchange)
+		if err != nil {
+			return nil, err
+		}
+		c.Sources[i].Exchange = exch.GetName()
+		if c.Sources[i].Fee != 0 {
+			continue
+		}
+		p := c.Sources[i].Pair
+		if p.IsEmpty() {
+			p = c.Pair
+		}
+		c.Sources[i].Fee, err = exch.GetFeeByType(ctx, &exchange.FeeBuilder{
+			FeeType:       exchange.OfflineTradeFee,
+			Pair:          p,
+			PurchasePrice: 1,
+			Amount:        1,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("%s fee: %w", exch.GetName(), err)
+		}
+	}
+	return orderbook.NewAggregator(&c)
+}
+
+// TrackOrderbookAnalytics returns the analytics tracker of an exchange orderbook, tracking it until untracked if not
+// already tracked. The orderbook is fetched when it has not yet been received from the exchange
+func (bot *Engine) TrackOrderbookAnalytics(ctx context.Context, exchName string, p currency.Pair, a asset.Item, cfg *analytics.Config) (*analytics.Tracker, error) {
+	return bot.trackOrderbookAnalytics(ctx, exchName, p, a, cfg, analytics.Track)
+}
+
+// SubscribeOrderbookAnalytics returns the analytics tracker of an exchange orderbook for a subscriber, which must
+// release the tracker once finished. The orderbook is fetched when it has not yet been received from the exchange
+func (bot *Engine) SubscribeOrderbookAnalytics(ctx context.Context, exchName string, p currency.Pair, a asset.Item, cfg *analytics.Config) (*analytics.Tracker, error) {
+	return bot.trackOrderbookAnalytics(ctx, exchName, p, a, cfg, analytics.TrackSubscriber)
+}
+
+func (bot *Engine) trackOrderbookAnalytics(ctx context.Context, exchName string, p currency.Pair, a asset.Item, cfg *analytics.Config, track func(string, currency.Pair, asset.Item, *analytics.Config) (*analytics.Tracker, error)) (*analytics.Tracker, error) {
+	if bot == nil {
+		return nil, errNilBot
+	}
+	exch, err := bot.GetExchangeByName(exchName)
+	if err != nil {
+		return nil, err
+	}
+	t, err := track(exch.GetName(), p, a, cfg)
+	if !errors.Is(err, orderbook.ErrOrderbookNotFound) {
+		return t, err
+	}
+	if _, err := exch.UpdateOrderbook(ctx, p, a); err != nil {
+		return nil, err
+	}
+	return track(exch.GetName(), p, a, cfg)
+}
+
+// UntrackOrderbookAnalytics stops tracking the analytics of an exchange orderbook once it has no subscribers
+func (bot *Engine) UntrackOrderbookAnalytics(exchName string, p currency.Pair, a asset.Item) error {
+	if bot == nil {
+		return errNilBot
+	}
+	exch, err := bot.GetExchangeByName(exchName)
+	if err != nil {
+		return err
+	}
+	return analytics.Untrack(exch.GetName(), p, a)
+}
+
 func verifyCert(pemData []byte) error {
 	var pemBlock *pem.Block
 	pemBlock, _ = pem.Decode(pemData)
@@ -858,7 +1041,7 @@ func genCert(targetDir string) error {
 		IsCA:                  true,
 		BasicConstraintsValid: true,
 		KeyUsage:              x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
-		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
 		IPAddresses: []net.IP{
 			net.ParseIP("127.0.0.1"),
 			net.ParseIP("::1"),
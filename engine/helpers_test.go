@@ -99,7 +99,7 @@ func CreateTestBot(tb testing.TB) *Engine {
 }
 
 func TestGetSubsystemsStatus(t *testing.T) {
-	assert.Len(t, (&Engine{}).GetSubsystemsStatus(), 13, "GetSubsystemStatus should return the correct number of subsystems")
+	assert.Len(t, (&Engine{}).GetSubsystemsStatus(), 19, "GetSubsystemStatus should return the correct number of subsystems")
 }
 
 func TestGetRPCEndpoints(t *testing.T) {
@@ -151,6 +151,12 @@ func TestSetSubsystem(t *testing.T) { //nolint // TO-DO: Fix race t.Parallel() u
 			EnableError:  errNilNTPConfigValues,
 			DisableError: ErrNilSubsystem,
 		},
+		{
+			Subsystem:    MetricsManagerName,
+			Engine:       &Engine{Config: &config.Config{Metrics: config.MetricsConfig{ListenAddress: "localhost:0"}}},
+			EnableError:  nil,
+			DisableError: nil,
+		},
 		{
 			Subsystem:    DatabaseConnectionManagerName,
 			Engine:       &Engine{Config: &config.Config{}},
@@ -187,6 +193,52 @@ func TestSetSubsystem(t *testing.T) { //nolint // TO-DO: Fix race t.Parallel() u
 			EnableError:  database.ErrNilInstance,
 			DisableError: ErrNilSubsystem,
 		},
+		{
+			Subsystem:    TradeCandleManagerName,
+			Engine:       &Engine{Config: &config.Config{}, ExchangeManager: NewExchangeManager()},
+			EnableError:  nil,
+			DisableError: nil,
+		},
+		{
+			Subsystem:    WithdrawManagerName,
+			Engine:       &Engine{Config: &config.Config{}, ExchangeManager: NewExchangeManager()},
+			EnableError:  nil,
+			DisableError: nil,
+		},
+		{
+			Subsystem:    LedgerManagerName,
+			Engine:       &Engine{Config: &config.Config{}, ExchangeManager: NewExchangeManager()},
+			EnableError:  nil,
+			DisableError: nil,
+		},
+		{
+			Subsystem:    RebalanceManagerName,
+			Engine:       &Engine{Config: &config.Config{}, ExchangeManager: NewExchangeManager()},
+			EnableError:  errNoRebalanceTargets,
+			DisableError: ErrNilSubsystem,
+		},
+		{
+			Subsystem: RebalanceManagerName,
+			Engine: &Engine{Config: &config.Config{RebalanceManager: config.RebalanceManager{
+				Targets: []config.RebalanceTarget{{Currency: "BTC", Weight: 1}},
+			}}, ExchangeManager: NewExchangeManager()},
+			EnableError:  nil,
+			DisableError: nil,
+		},
+		{
+			Subsystem:    OrderbookCaptureManagerName,
+			Engine:       &Engine{Config: &config.Config{}, ExchangeManager: NewExchangeManager(), Settings: Settings{DataDir: t.TempDir()}},
+			EnableError:  errNoOrderbookCaptureTargets,
+			DisableError: ErrNilSubsystem,
+		},
+		{
+			Subsystem: OrderbookCaptureManagerName,
+			Engine: &Engine{Config: &config.Config{OrderbookCapture: config.OrderbookCaptureManager{
+				Targets: []config.OrderbookCaptureTarget{{Exchange: "Binance", Asset: "spot", Pair: "BTC-USDT"}},
+			}}, ExchangeManager: NewExchangeManager(), Settings: Settings{DataDir: t.TempDir()}},
+			EnableError:  nil,
+			DisableError: nil,
+		},
 		{
 			Subsystem:    vm.Name,
 			Engine:       &Engine{Config: &config.Config{}},
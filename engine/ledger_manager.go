@@ -0,0 +1,357 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/ledger"
+)
+
+// setupLedgerManager creates a new ledger manager
+func setupLedgerManager(em iExchangeManager, om iOrderUpdateSource, cfg *config.LedgerManager) (*ledgerManager, error) {
+	if em == nil {
+		return nil, errNilExchangeManager
+	}
+	if om == nil {
+		return nil, errNilOrderManager
+	}
+	if cfg == nil {
+		return nil, errNilConfig
+	}
+	method := ledger.FIFO
+	if cfg.Method != "" {
+		var err error
+		if method, err = ledger.ParseMethod(cfg.Method); err != nil {
+			return nil, err
+		}
+	}
+	reporting := currency.USD
+	if cfg.ReportingCurrency != "" {
+		reporting = currency.NewCode(cfg.ReportingCurrency).Upper()
+	}
+	lookback := cfg.Backfill.Lookback
+	if lookback <= 0 {
+		lookback = defaultLedgerBackfillLookback
+	}
+	exchanges := make([]string, len(cfg.Backfill.Exchanges))
+	for i := range cfg.Backfill.Exchanges {
+		exchanges[i] = strings.ToLower(cfg.Backfill.Exchanges[i])
+	}
+	m := &ledgerManager{
+		exchangeManager:   em,
+		orderManager:      om,
+		backfill:          cfg.Backfill.Enabled,
+		backfillExchanges: exchanges,
+		backfillLookback:  lookback,
+		verbose:           cfg.Verbose,
+		rates:             make(map[ledgerRateKey]ledgerRate),
+	}
+	l, err := ledger.New(method, reporting, m.convert)
+	if err != nil {
+		return nil, err
+	}
+	m.ledger = l
+	return m, nil
+}
+
+// IsRunning safely checks whether the subsystem is running
+func (m *ledgerManager) IsRunning() bool {
+	return m != nil && atomic.LoadInt32(&m.started) == 1
+}
+
+// Start runs the subsystem
+func (m *ledgerManager) Start() error {
+	if m == nil {
+		return fmt.Errorf("ledger manager %w", ErrNilSubsystem)
+	}
+	if !atomic.CompareAndSwapInt32(&m.started, 0, 1) {
+		return fmt.Errorf("ledger manager %w", ErrSubSystemAlreadyStarted)
+	}
+	m.shutdown = make(chan struct{})
+	m.wg.Add(1)
+	go m.run()
+	if m.backfill {
+		m.wg.Add(1)
+		go m.backfillOrderHistory()
+	}
+	log.Debugf(log.Global, "Ledger manager %s", MsgSubSystemStarted)
+	return nil
+}
+
+// Stop attempts to shutdown the subsystem
+func (m *ledgerManager) Stop() error {
+	if m == nil {
+		return fmt.Errorf("ledger manager %w", ErrNilSubsystem)
+	}
+	if !atomic.CompareAndSwapInt32(&m.started, 1, 0) {
+		return fmt.Errorf("ledger manager %w", ErrSubSystemNotStarted)
+	}
+	close(m.shutdown)
+	m.wg.Wait()
+	if m.pipe != nil {
+		if err := m.pipe.Release(); err != nil {
+			log.Errorf(log.Global, "Ledger manager unable to release order updates: %s", err)
+		}
+		m.pipe = nil
+	}
+	log.Debugf(log.Global, "Ledger manager %s", MsgSubSystemShutdown)
+	return nil
+}
+
+// Ledger returns the ledger built by the subsystem
+func (m *ledgerManager) Ledger() (*ledger.Ledger, error) {
+	if m == nil {
+		return nil, fmt.Errorf("ledger manager %w", ErrNilSubsystem)
+	}
+	if !m.IsRunning() {
+		return nil, fmt.Errorf("ledger manager %w", ErrSubSystemNotStarted)
+	}
+	return m.ledger, nil
+}
+
+// SpotRealisedPNL returns the realised PNL in the reporting currency of spot
+// fills on an exchange and pair since a time. An empty exchange or pair matches
+// every exchange or pair
+func (m *ledgerManager) SpotRealisedPNL(exchange string, p currency.Pair, since time.Time) (float64, error) {
+	l, err := m.Ledger()
+	if err != nil {
+		return 0, err
+	}
+	return l.RealisedPNL(exchange, p, since).InexactFloat64(), nil
+}
+
+// run adds the fills of order updates to the ledger, subscribing to the order
+// manager again whenever it is not subscribed
+func (m *ledgerManager) run() {
+	defer m.wg.Done()
+	m.subscribe()
+	t := time.NewTicker(ledgerSubscribeInterval)
+	defer t.Stop()
+	for {
+		var updates <-chan *order.Detail
+		if m.pipe != nil {
+			updates = m.pipe.Channel()
+		}
+		select {
+		case <-m.shutdown:
+			return
+		case <-t.C:
+			if m.pipe == nil {
+				m.subscribe()
+			}
+		case od, ok := <-updates:
+			if !ok {
+				log.Warnf(log.Global, "Ledger manager order updates released: %v", m.pipe.Err())
+				m.pipe = nil
+				continue
+			}
+			m.addOrder(od)
+		}
+	}
+}
+
+// subscribe subscribes to order updates and adds the fills of the spot orders
+// already held by the order manager
+func (m *ledgerManager) subscribe() {
+	pipe, err := m.orderManager.SubscribeOrderUpdates()
+	if err != nil {
+		if m.verbose {
+			log.Errorf(log.Global, "Ledger manager unable to subscribe to order updates: %s", err)
+		}
+		return
+	}
+	m.pipe = pipe
+	// Orders are read after subscribing so no fills are missed. Fills added
+	// twice are ignored by the ledger
+	orders, err := m.orderManager.GetOrdersFiltered(&order.Filter{AssetType: asset.Spot})
+	if err != nil {
+		log.Errorf(log.Global, "Ledger manager unable to get orders: %s", err)
+		return
+	}
+	for i := range orders {
+		m.addOrder(&orders[i])
+	}
+}
+
+// backfillOrderHistory adds the fills of the spot order history of each
+// exchange which supports authenticated requests
+func (m *ledgerManager) backfillOrderHistory() {
+	defer m.wg.Done()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case <-m.shutdown:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	exchs, err := m.exchangeManager.GetExchanges()
+	if err != nil {
+		log.Errorf(log.Global, "Ledger manager unable to get exchanges: %s", err)
+		return
+	}
+	end := time.Now()
+	start := end.Add(-m.backfillLookback)
+	for _, exch := range exchs {
+		if ctx.Err() != nil {
+			return
+		}
+		name := exch.GetName()
+		if len(m.backfillExchanges) > 0 && !slices.Contains(m.backfillExchanges, strings.ToLower(name)) {
+			continue
+		}
+		if !exch.IsRESTAuthenticationSupported() {
+			if m.verbose {
+				log.Debugf(log.Global, "Ledger manager skipping %s order history, authenticated requests are not supported", name)
+			}
+			continue
+		}
+		pairs, err := exch.GetEnabledPairs(asset.Spot)
+		if err != nil || len(pairs) == 0 {
+			continue
+		}
+		orders, err := exch.GetOrderHistory(ctx, &order.MultiOrderRequest{
+			Pairs:     pairs,
+			AssetType: asset.Spot,
+			Type:      order.AnyType,
+			Side:      order.AnySide,
+			StartTime: start,
+			EndTime:   end,
+		})
+		if err != nil {
+			log.Errorf(log.Global, "Ledger manager unable to get %s order history: %s", name, err)
+			continue
+		}
+		var added int
+		for i := range orders {
+			if orders[i].Exchange == "" {
+				orders[i].Exchange = name
+			}
+			if orders[i].AssetType == asset.Empty {
+				orders[i].AssetType = asset.Spot
+			}
+			added += m.addOrder(&orders[i])
+		}
+		if m.verbose {
+			log.Debugf(log.Global, "Ledger manager added %d fills from %d %s orders", added, len(orders), name)
+		}
+	}
+}
+
+// addOrder adds the fills of a spot order to the ledger and returns the number
+// of fills added
+func (m *ledgerManager) addOrder(od *order.Detail) int {
+	if od.AssetType != asset.Spot {
+		return 0
+	}
+	added, err := m.ledger.AddOrder(od)
+	if err != nil {
+		log.Errorf(log.Global, "Ledger manager unable to add %s order %s: %s", od.Exchange, od.OrderID, err)
+	}
+	if added > 0 && m.verbose {
+		log.Debugf(log.Global, "Ledger manager added %d %s fills from %s order %s", added, od.Pair, od.Exchange, od.OrderID)
+	}
+	return added
+}
+
+// convert converts amounts to the reporting currency at the rate at a time.
+// Amounts are converted at the current rate when the time is zero or recent,
+// otherwise at the close of a spot candle containing the time
+func (m *ledgerManager) convert(amount float64, from, to currency.Code, at time.Time) (float64, error) {
+	if at.IsZero() || time.Since(at) < ledgerCurrentRateWindow {
+		return convertCurrencyAmount(m.exchangeManager, amount, from, to)
+	}
+	if ledger.AtParity(from, to) {
+		return amount, nil
+	}
+	rate, err := m.historicalRate(from, to, at)
+	if err != nil {
+		return 0, err
+	}
+	return amount * rate, nil
+}
+
+// historicalRate returns the rate of a currency in another at a time, caching
+// rates and failed lookups by the minute
+func (m *ledgerManager) historicalRate(from, to currency.Code, at time.Time) (float64, error) {
+	key := ledgerRateKey{from: from.Item, to: to.Item, minute: at.Unix() / 60}
+	m.ratesMu.Lock()
+	r, ok := m.rates[key]
+	m.ratesMu.Unlock()
+	if ok {
+		return r.rate, r.err
+	}
+	r.rate, r.err = m.fetchHistoricalRate(from, to, at)
+	m.ratesMu.Lock()
+	if len(m.rates) >= ledgerRateCacheLimit {
+		clear(m.rates)
+	}
+	m.rates[key] = r
+	m.ratesMu.Unlock()
+	return r.rate, r.err
+}
+
+// fetchHistoricalRate returns the close of the shortest closed spot candle
+// containing a time for the currency against the target currency, or a
+// currency at parity with it, on any loaded exchange
+func (m *ledgerManager) fetchHistoricalRate(from, to currency.Code, at time.Time) (float64, error) {
+	exchs, err := m.exchangeManager.GetExchanges()
+	if err != nil {
+		return 0, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), ledgerRateTimeout)
+	defer cancel()
+	go func() {
+		select {
+		case <-m.shutdown:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	quotes := []currency.Code{to}
+	for _, c := range []currency.Code{currency.USD, currency.USDT, currency.USDC} {
+		if !c.Equal(to) && ledger.AtParity(c, to) {
+			quotes = append(quotes, c)
+		}
+	}
+	for _, interval := range []kline.Interval{kline.OneMin, kline.OneHour, kline.OneDay} {
+		start := at.Truncate(interval.Duration())
+		end := start.Add(interval.Duration())
+		if end.After(time.Now()) {
+			continue
+		}
+		for _, quote := range quotes {
+			if from.Equal(quote) {
+				continue
+			}
+			pair := currency.NewPair(from, quote)
+			for _, exch := range exchs {
+				if ctx.Err() != nil {
+					return 0, fmt.Errorf("%w for %s to %s at %s: %w", errNoHistoricalRate, from, to, at, ctx.Err())
+				}
+				k, err := exch.GetHistoricCandles(ctx, pair, asset.Spot, interval, start, end)
+				if err != nil || k == nil {
+					continue
+				}
+				for i := range k.Candles {
+					c := &k.Candles[i]
+					if c.Close > 0 && !c.Time.After(at) && c.Time.Add(interval.Duration()).After(at) {
+						return c.Close, nil
+					}
+				}
+			}
+		}
+	}
+	return 0, fmt.Errorf("%w for %s to %s at %s", errNoHistoricalRate, from, to, at)
+}
@@ -0,0 +1,272 @@
+package engine
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/sharedtestvalues"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/ledger"
+)
+
+type ledgerTestExchange struct {
+	sharedtestvalues.CustomEx
+	m       sync.Mutex
+	history []order.Detail
+	request *order.MultiOrderRequest
+	candles map[kline.Interval][]kline.Candle
+	fetches int
+}
+
+func (l *ledgerTestExchange) IsRESTAuthenticationSupported() bool { return true }
+
+func (l *ledgerTestExchange) GetEnabledPairs(asset.Item) (currency.Pairs, error) {
+	return currency.Pairs{btcusdPair}, nil
+}
+
+func (l *ledgerTestExchange) GetHistoricCandles(_ context.Context, p currency.Pair, _ asset.Item, i kline.Interval, _, _ time.Time) (*kline.Item, error) {
+	l.m.Lock()
+	defer l.m.Unlock()
+	l.fetches++
+	if !p.Equal(currency.NewPair(currency.XRP, currency.USDT)) {
+		return nil, currency.ErrPairNotEnabled
+	}
+	return &kline.Item{Pair: p, Interval: i, Candles: l.candles[i]}, nil
+}
+
+func (l *ledgerTestExchange) GetOrderHistory(_ context.Context, r *order.MultiOrderRequest) (order.FilteredOrders, error) {
+	l.m.Lock()
+	defer l.m.Unlock()
+	l.request = r
+	return l.history, nil
+}
+
+func TestSetupLedgerManager(t *testing.T) {
+	t.Parallel()
+	_, err := setupLedgerManager(nil, nil, nil)
+	assert.ErrorIs(t, err, errNilExchangeManager)
+	em := NewExchangeManager()
+	_, err = setupLedgerManager(em, nil, nil)
+	assert.ErrorIs(t, err, errNilOrderManager)
+	var om *OrderManager
+	_, err = setupLedgerManager(em, om, nil)
+	assert.ErrorIs(t, err, errNilConfig)
+	_, err = setupLedgerManager(em, om, &config.LedgerManager{Method: "hifo"})
+	assert.Error(t, err, "setupLedgerManager should error on an invalid method")
+
+	m, err := setupLedgerManager(em, om, &config.LedgerManager{})
+	require.NoError(t, err, "setupLedgerManager must not error")
+	assert.Equal(t, ledger.FIFO, m.ledger.Method())
+	assert.Equal(t, currency.USD, m.ledger.ReportingCurrency())
+	assert.Equal(t, defaultLedgerBackfillLookback, m.backfillLookback)
+
+	m, err = setupLedgerManager(em, om, &config.LedgerManager{
+		Method:            "average",
+		ReportingCurrency: "eur",
+		Backfill:          config.LedgerBackfill{Exchanges: []string{"Binance"}, Lookback: time.Hour},
+	})
+	require.NoError(t, err, "setupLedgerManager must not error")
+	assert.Equal(t, ledger.AverageCost, m.ledger.Method())
+	assert.Equal(t, currency.EUR, m.ledger.ReportingCurrency())
+	assert.Equal(t, []string{"binance"}, m.backfillExchanges)
+	assert.Equal(t, time.Hour, m.backfillLookback)
+}
+
+func TestLedgerManagerStartStop(t *testing.T) {
+	t.Parallel()
+	var m *ledgerManager
+	assert.False(t, m.IsRunning())
+	assert.ErrorIs(t, m.Start(), ErrNilSubsystem)
+	assert.ErrorIs(t, m.Stop(), ErrNilSubsystem)
+	_, err := m.Ledger()
+	assert.ErrorIs(t, err, ErrNilSubsystem)
+
+	var om *OrderManager
+	m, err = setupLedgerManager(NewExchangeManager(), om, &config.LedgerManager{})
+	require.NoError(t, err, "setupLedgerManager must not error")
+	_, err = m.Ledger()
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted)
+	_, err = m.SpotRealisedPNL("", currency.EMPTYPAIR, time.Time{})
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted)
+	assert.ErrorIs(t, m.Stop(), ErrSubSystemNotStarted)
+	require.NoError(t, m.Start(), "Start must not error")
+	assert.True(t, m.IsRunning())
+	assert.ErrorIs(t, m.Start(), ErrSubSystemAlreadyStarted)
+	_, err = m.Ledger()
+	assert.NoError(t, err, "Ledger should not error when running")
+	require.NoError(t, m.Stop(), "Stop must not error")
+	assert.False(t, m.IsRunning())
+}
+
+func TestLedgerManagerOrderUpdates(t *testing.T) {
+	t.Parallel()
+	em := NewExchangeManager()
+	exch := &ledgerTestExchange{}
+	require.NoError(t, em.Add(exch), "Add must not error")
+	var wg sync.WaitGroup
+	om, err := SetupOrderManager(em, &CommunicationManager{}, &wg, &config.OrderManager{})
+	require.NoError(t, err, "SetupOrderManager must not error")
+	om.started = 1
+	od := &order.Detail{
+		Exchange:             exch.GetName(),
+		OrderID:              "1",
+		Pair:                 btcusdPair,
+		AssetType:            asset.Spot,
+		Side:                 order.Buy,
+		Status:               order.Filled,
+		Price:                100,
+		Amount:               1,
+		ExecutedAmount:       1,
+		AverageExecutedPrice: 100,
+		LastUpdated:          time.Now(),
+	}
+	require.NoError(t, om.Add(od), "Add must not error")
+	require.NoError(t, om.Add(&order.Detail{
+		Exchange:       exch.GetName(),
+		OrderID:        "2",
+		Pair:           btcusdPair,
+		AssetType:      asset.Margin,
+		Side:           order.Buy,
+		Status:         order.Filled,
+		Price:          100,
+		Amount:         1,
+		ExecutedAmount: 1,
+	}), "Add must not error")
+
+	m, err := setupLedgerManager(em, om, &config.LedgerManager{})
+	require.NoError(t, err, "setupLedgerManager must not error")
+	require.NoError(t, m.Start(), "Start must not error")
+	t.Cleanup(func() { assert.NoError(t, m.Stop(), "Stop should not error") })
+
+	l, err := m.Ledger()
+	require.NoError(t, err, "Ledger must not error")
+	require.Eventually(t, func() bool {
+		return len(l.Lots(currency.BTC, true)) == 1
+	}, time.Second*5, time.Millisecond*10, "existing spot orders must be added")
+
+	sell := &order.Detail{
+		Exchange:    exch.GetName(),
+		OrderID:     "3",
+		Pair:        btcusdPair,
+		AssetType:   asset.Spot,
+		Side:        order.Sell,
+		Status:      order.Active,
+		Price:       150,
+		Amount:      1,
+		LastUpdated: time.Now().Add(time.Second),
+	}
+	require.NoError(t, om.Add(sell), "Add must not error")
+	fill := sell.Copy()
+	fill.Status = order.PartiallyFilled
+	fill.Trades = []order.TradeHistory{{TID: "a", Price: 150, Amount: 0.5, Side: order.Sell, Timestamp: sell.LastUpdated}}
+	require.NoError(t, om.UpdateExistingOrder(&fill), "UpdateExistingOrder must not error")
+	require.Eventually(t, func() bool {
+		s := l.Summaries(currency.BTC)
+		return len(s) == 1 && s[0].RealisedPNL.Equal(decimal.NewFromInt(25))
+	}, time.Second*5, time.Millisecond*10, "order updates must be added")
+
+	pnl, err := m.SpotRealisedPNL(exch.GetName(), btcusdPair, time.Time{})
+	require.NoError(t, err, "SpotRealisedPNL must not error")
+	assert.Equal(t, 25.0, pnl)
+	pnl, err = m.SpotRealisedPNL(exch.GetName(), btcusdPair, time.Now().Add(time.Hour))
+	require.NoError(t, err, "SpotRealisedPNL must not error")
+	assert.Zero(t, pnl, "SpotRealisedPNL should exclude disposals before the given time")
+}
+
+func TestLedgerManagerBackfill(t *testing.T) {
+	t.Parallel()
+	exch := &ledgerTestExchange{history: []order.Detail{{
+		OrderID:              "1",
+		Pair:                 btcusdPair,
+		Side:                 order.Buy,
+		Status:               order.Filled,
+		Amount:               2,
+		ExecutedAmount:       2,
+		AverageExecutedPrice: 100,
+	}}}
+	em := NewExchangeManager()
+	require.NoError(t, em.Add(exch), "Add must not error")
+	var om *OrderManager
+	m, err := setupLedgerManager(em, om, &config.LedgerManager{Backfill: config.LedgerBackfill{Enabled: true, Exchanges: []string{"CustomEx"}}})
+	require.NoError(t, err, "setupLedgerManager must not error")
+	m.wg.Add(1)
+	m.shutdown = make(chan struct{})
+	m.backfillOrderHistory()
+
+	lots := m.ledger.Lots(currency.BTC, false)
+	require.Len(t, lots, 1, "order history must be added")
+	assert.Equal(t, "customex", lots[0].Exchange)
+	assert.Equal(t, "200", lots[0].CostBasis.String())
+	require.NotNil(t, exch.request, "GetOrderHistory must be called")
+	assert.Equal(t, asset.Spot, exch.request.AssetType)
+	assert.Equal(t, currency.Pairs{btcusdPair}, exch.request.Pairs)
+	assert.Equal(t, defaultLedgerBackfillLookback, exch.request.EndTime.Sub(exch.request.StartTime))
+
+	m.backfillExchanges = []string{"binance"}
+	exch.history[0].OrderID = "2"
+	m.wg.Add(1)
+	m.backfillOrderHistory()
+	assert.Len(t, m.ledger.Lots(currency.BTC, false), 1, "unconfigured exchanges should not be backfilled")
+}
+
+func TestLedgerManagerConvert(t *testing.T) {
+	t.Parallel()
+	exch := &ledgerTestExchange{}
+	em := NewExchangeManager()
+	require.NoError(t, em.Add(exch), "Add must not error")
+	var om *OrderManager
+	m, err := setupLedgerManager(em, om, &config.LedgerManager{})
+	require.NoError(t, err, "setupLedgerManager must not error")
+
+	v, err := m.convert(10, currency.USDT, currency.USD, time.Time{})
+	require.NoError(t, err, "convert must not error")
+	assert.Equal(t, 10.0, v)
+
+	_, err = m.convert(10, currency.DOGE, currency.USD, time.Time{})
+	assert.Error(t, err, "convert should error without a ticker")
+
+	require.NoError(t, ticker.ProcessTicker(&ticker.Price{
+		ExchangeName: exch.GetName(),
+		Pair:         currency.NewPair(currency.XRP, currency.USDT),
+		AssetType:    asset.Spot,
+		Last:         0.5,
+	}), "ProcessTicker must not error")
+	v, err = m.convert(10, currency.XRP, currency.USD, time.Time{})
+	require.NoError(t, err, "convert must not error")
+	assert.Equal(t, 5.0, v, "convert should use the last price against a USD stablecoin")
+	v, err = m.convert(10, currency.XRP, currency.USD, time.Now())
+	require.NoError(t, err, "convert must not error")
+	assert.Equal(t, 5.0, v, "recent fills should use the current rate")
+
+	at := time.Date(2024, 1, 1, 12, 30, 15, 0, time.UTC)
+	v, err = m.convert(10, currency.USDC, currency.USD, at)
+	require.NoError(t, err, "convert must not error")
+	assert.Equal(t, 10.0, v, "stablecoins should convert at parity at any time")
+	_, err = m.convert(10, currency.XRP, currency.USD, at)
+	assert.ErrorIs(t, err, errNoHistoricalRate, "historical fills should not use the current rate")
+	fetches := exch.fetches
+	_, err = m.convert(10, currency.XRP, currency.USD, at.Add(time.Second))
+	assert.ErrorIs(t, err, errNoHistoricalRate)
+	assert.Equal(t, fetches, exch.fetches, "failed lookups should be cached")
+
+	at = at.Add(24 * time.Hour)
+	exch.candles = map[kline.Interval][]kline.Candle{
+		kline.OneHour: {
+			{Time: at.Truncate(time.Hour).Add(-time.Hour), Close: 0.1},
+			{Time: at.Truncate(time.Hour), Close: 0.2},
+		},
+	}
+	v, err = m.convert(10, currency.XRP, currency.USD, at)
+	require.NoError(t, err, "convert must not error")
+	assert.Equal(t, 2.0, v, "convert should use the close of the candle containing the fill time")
+}
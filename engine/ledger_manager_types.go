@@ -0,0 +1,68 @@
+package engine
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/ledger"
+)
+
+const (
+	// LedgerManagerName is an exported subsystem name
+	LedgerManagerName = "ledger_manager"
+
+	defaultLedgerBackfillLookback = 30 * 24 * time.Hour
+	ledgerSubscribeInterval       = 10 * time.Second
+	// ledgerCurrentRateWindow is how recent a fill must be to be valued at
+	// the current rate rather than a historical rate
+	ledgerCurrentRateWindow = time.Minute
+	ledgerRateTimeout       = 30 * time.Second
+	ledgerRateCacheLimit    = 10000
+)
+
+var (
+	errNilOrderManager  = errors.New("cannot start with nil order manager")
+	errNoHistoricalRate = errors.New("no historical rate")
+)
+
+// iOrderUpdateSource defines a limited scoped order manager which relays order
+// updates
+type iOrderUpdateSource interface {
+	SubscribeOrderUpdates() (*OrderUpdatePipe, error)
+	GetOrdersFiltered(*order.Filter) ([]order.Detail, error)
+}
+
+// ledgerManager builds a cost basis ledger from the spot fills of orders
+// handled by the order manager and, optionally, the order history of exchanges
+type ledgerManager struct {
+	started           int32
+	exchangeManager   iExchangeManager
+	orderManager      iOrderUpdateSource
+	ledger            *ledger.Ledger
+	backfill          bool
+	backfillExchanges []string
+	backfillLookback  time.Duration
+	verbose           bool
+	shutdown          chan struct{}
+	wg                sync.WaitGroup
+	// pipe is only accessed by the run routine while the manager is running
+	pipe    *OrderUpdatePipe
+	ratesMu sync.Mutex
+	rates   map[ledgerRateKey]ledgerRate
+}
+
+// ledgerRateKey identifies a historical rate by the minute
+type ledgerRateKey struct {
+	from   *currency.Item
+	to     *currency.Item
+	minute int64
+}
+
+// ledgerRate holds a historical rate or the error looking it up
+type ledgerRate struct {
+	rate float64
+	err  error
+}
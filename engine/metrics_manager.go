@@ -0,0 +1,417 @@
+package engine
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"net"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchange/websocket"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/vm"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// setupMetricsManager creates a new metrics manager
+func setupMetricsManager(cfg *config.MetricsConfig, bot *Engine) (*metricsManager, error) {
+	if cfg == nil {
+		return nil, errNilConfig
+	}
+	if bot == nil {
+		return nil, errNilBot
+	}
+	listenAddress := cfg.ListenAddress
+	if listenAddress == "" {
+		listenAddress = defaultMetricsListenAddress
+	}
+	return &metricsManager{
+		listenAddress: listenAddress,
+		bot:           bot,
+		collector:     newMetricsCollector(),
+	}, nil
+}
+
+// IsRunning safely checks whether the subsystem is running
+func (m *metricsManager) IsRunning() bool {
+	return m != nil && atomic.LoadInt32(&m.started) == 1
+}
+
+// Start runs the subsystem
+func (m *metricsManager) Start() error {
+	if m == nil {
+		return fmt.Errorf("metrics manager %w", ErrNilSubsystem)
+	}
+	if !atomic.CompareAndSwapInt32(&m.started, 0, 1) {
+		return fmt.Errorf("metrics manager %w", ErrSubSystemAlreadyStarted)
+	}
+
+	lc := net.ListenConfig{}
+	ln, err := lc.Listen(context.TODO(), "tcp", m.listenAddress)
+	if err != nil {
+		atomic.StoreInt32(&m.started, 0)
+		return fmt.Errorf("metrics manager listen error: %w", err)
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(metricsPath, m.handleMetrics)
+	m.server = &http.Server{
+		Addr:         ln.Addr().String(),
+		ReadTimeout:  metricsServerTimeout,
+		WriteTimeout: metricsServerTimeout,
+		Handler:      mux,
+	}
+
+	m.prevRESTReporter = request.GetGlobalReporter()
+	m.prevWebsocketReporter = websocket.GetGlobalReporter()
+	request.SetupGlobalReporter(restReporter{c: m.collector, next: m.prevRESTReporter})
+	websocket.SetupGlobalReporter(websocketReporter{c: m.collector, next: m.prevWebsocketReporter})
+
+	go func(srv *http.Server) {
+		if err := srv.Serve(ln); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Errorf(log.Global, "Metrics manager serve error: %s", err)
+		}
+	}(m.server)
+
+	log.Infof(log.Global, "Metrics manager listening on http://%s%s", m.server.Addr, metricsPath)
+	log.Debugf(log.Global, "Metrics manager %s", MsgSubSystemStarted)
+	return nil
+}
+
+// Stop attempts to shutdown the subsystem
+func (m *metricsManager) Stop() error {
+	if m == nil {
+		return fmt.Errorf("metrics manager %w", ErrNilSubsystem)
+	}
+	if !atomic.CompareAndSwapInt32(&m.started, 1, 0) {
+		return fmt.Errorf("metrics manager %w", ErrSubSystemNotStarted)
+	}
+	request.SetupGlobalReporter(m.prevRESTReporter)
+	websocket.SetupGlobalReporter(m.prevWebsocketReporter)
+	m.prevRESTReporter, m.prevWebsocketReporter = nil, nil
+
+	ctx, cancel := context.WithTimeout(context.Background(), metricsServerTimeout)
+	defer cancel()
+	if err := m.server.Shutdown(ctx); err != nil {
+		return fmt.Errorf("metrics manager shutdown error: %w", err)
+	}
+	log.Debugf(log.Global, "Metrics manager %s", MsgSubSystemShutdown)
+	return nil
+}
+
+// handleMetrics writes the current metrics in the Prometheus text format
+func (m *metricsManager) handleMetrics(w http.ResponseWriter, _ *http.Request) {
+	var b bytes.Buffer
+	m.collector.write(&b, m.collectGauges())
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	if _, err := w.Write(b.Bytes()); err != nil {
+		log.Errorf(log.Global, "Metrics manager unable to write response: %s", err)
+	}
+}
+
+// collectGauges returns the current state of the engine subsystems
+func (m *metricsManager) collectGauges() map[string][]metricSample {
+	gauges := make(map[string][]metricSample)
+
+	depth, limit := dispatch.GetQueueDepth()
+	gauges[metricDispatchQueueDepth] = []metricSample{{value: float64(depth)}}
+	gauges[metricDispatchQueueLimit] = []metricSample{{value: float64(limit)}}
+	gauges[metricScriptVMs] = []metricSample{{value: float64(vm.VMSCount.Len())}}
+
+	if exchs, err := m.bot.ExchangeManager.GetExchanges(); err == nil {
+		for _, e := range exchs {
+			if !e.SupportsWebsocket() || !e.IsWebsocketEnabled() {
+				continue
+			}
+			ws, err := e.GetWebsocket()
+			if err != nil {
+				continue
+			}
+			var connected float64
+			if ws.IsConnected() {
+				connected = 1
+			}
+			gauges[metricWebsocketConnected] = append(gauges[metricWebsocketConnected], metricSample{
+				labels: formatMetricLabels("exchange", e.GetName()),
+				value:  connected,
+			})
+		}
+	}
+
+	if m.bot.currencyPairSyncer.IsRunning() {
+		for _, s := range m.bot.currencyPairSyncer.getStaleness() {
+			gauges[metricSyncStaleness] = append(gauges[metricSyncStaleness], metricSample{
+				labels: formatMetricLabels(
+					"exchange", s.Key.Exchange,
+					"asset", s.Key.Asset.String(),
+					"pair", s.Key.Pair().String(),
+					"item", strings.ToLower(s.Item.String())),
+				value: s.Staleness.Seconds(),
+			})
+		}
+	}
+
+	if m.bot.OrderManager.IsRunning() {
+		for exch, statuses := range m.bot.OrderManager.orderStore.getOrderCounts() {
+			for status, count := range statuses {
+				gauges[metricOrders] = append(gauges[metricOrders], metricSample{
+					labels: formatMetricLabels("exchange", exch, "status", status.String()),
+					value:  float64(count),
+				})
+			}
+		}
+	}
+	return gauges
+}
+
+// newMetricsCollector returns an empty metrics collector
+func newMetricsCollector() *metricsCollector {
+	return &metricsCollector{
+		counters:   make(map[string]map[string]float64),
+		histograms: make(map[string]map[string]*metricHistogram),
+	}
+}
+
+// inc increments a counter series
+func (c *metricsCollector) inc(name, labels string) {
+	c.m.Lock()
+	defer c.m.Unlock()
+	series, ok := c.counters[name]
+	if !ok {
+		series = make(map[string]float64)
+		c.counters[name] = series
+	}
+	series[labels]++
+}
+
+// observe records a duration in a histogram series
+func (c *metricsCollector) observe(name, labels string, d time.Duration) {
+	v := d.Seconds()
+	c.m.Lock()
+	defer c.m.Unlock()
+	series, ok := c.histograms[name]
+	if !ok {
+		series = make(map[string]*metricHistogram)
+		c.histograms[name] = series
+	}
+	h, ok := series[labels]
+	if !ok {
+		h = &metricHistogram{buckets: make([]uint64, len(metricDurationBuckets))}
+		series[labels] = h
+	}
+	if i, _ := slices.BinarySearch(metricDurationBuckets, v); i < len(h.buckets) {
+		h.buckets[i]++
+	}
+	h.count++
+	h.sum += v
+}
+
+// write writes the collected series and the supplied gauges to b in the
+// Prometheus text format, ordered by metric name and labels
+func (c *metricsCollector) write(b *bytes.Buffer, gauges map[string][]metricSample) {
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	names := make([]string, 0, len(c.counters)+len(c.histograms)+len(gauges))
+	for name := range c.counters {
+		names = append(names, name)
+	}
+	for name := range c.histograms {
+		names = append(names, name)
+	}
+	for name := range gauges {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	for _, name := range names {
+		if series, ok := c.counters[name]; ok {
+			writeMetricHeader(b, name, metricTypeCounter)
+			for _, labels := range slices.Sorted(maps.Keys(series)) {
+				writeMetricLine(b, name, labels, series[labels])
+			}
+			continue
+		}
+		if series, ok := c.histograms[name]; ok {
+			writeMetricHeader(b, name, metricTypeHistogram)
+			for _, labels := range slices.Sorted(maps.Keys(series)) {
+				h := series[labels]
+				var cumulative uint64
+				for i, bound := range metricDurationBuckets {
+					cumulative += h.buckets[i]
+					writeMetricLine(b, name+"_bucket", joinMetricLabels(labels, formatMetricLabels("le", formatMetricValue(bound))), float64(cumulative))
+				}
+				writeMetricLine(b, name+"_bucket", joinMetricLabels(labels, `{le="+Inf"}`), float64(h.count))
+				writeMetricLine(b, name+"_sum", labels, h.sum)
+				writeMetricLine(b, name+"_count", labels, float64(h.count))
+			}
+			continue
+		}
+		samples := gauges[name]
+		slices.SortFunc(samples, func(a, b metricSample) int { return strings.Compare(a.labels, b.labels) })
+		writeMetricHeader(b, name, metricTypeGauge)
+		for i := range samples {
+			writeMetricLine(b, name, samples[i].labels, samples[i].value)
+		}
+	}
+}
+
+// Latency implements request.Reporter
+func (r restReporter) Latency(name, method, path string, t time.Duration) {
+	r.c.observe(metricRESTLatency, formatMetricLabels("exchange", name, "method", method, "endpoint", metricEndpoint(path)), t)
+	if r.next != nil {
+		r.next.Latency(name, method, path, t)
+	}
+}
+
+// RequestError implements request.ErrorReporter
+func (r restReporter) RequestError(name, method, path string, statusCode int) {
+	r.c.inc(metricRESTErrors, formatMetricLabels("exchange", name, "method", method, "endpoint", metricEndpoint(path), "status", strconv.Itoa(statusCode)))
+	if next, ok := r.next.(request.ErrorReporter); ok {
+		next.RequestError(name, method, path, statusCode)
+	}
+}
+
+// RateLimitWait implements request.RateLimitReporter
+func (r restReporter) RateLimitWait(name string, t time.Duration) {
+	r.c.observe(metricRateLimitWait, formatMetricLabels("exchange", name), t)
+	if next, ok := r.next.(request.RateLimitReporter); ok {
+		next.RateLimitWait(name, t)
+	}
+}
+
+// Latency implements websocket.Reporter
+func (r websocketReporter) Latency(name string, message []byte, t time.Duration) {
+	r.c.observe(metricWebsocketLatency, formatMetricLabels("exchange", name), t)
+	if r.next != nil {
+		r.next.Latency(name, message, t)
+	}
+}
+
+// MessageReceived implements websocket.TrafficReporter
+func (r websocketReporter) MessageReceived(name string) {
+	r.c.inc(metricWebsocketMessages, formatMetricLabels("exchange", name))
+	if next, ok := r.next.(websocket.TrafficReporter); ok {
+		next.MessageReceived(name)
+	}
+}
+
+// Reconnected implements websocket.TrafficReporter
+func (r websocketReporter) Reconnected(name string) {
+	r.c.inc(metricWebsocketReconnects, formatMetricLabels("exchange", name))
+	if next, ok := r.next.(websocket.TrafficReporter); ok {
+		next.Reconnected(name)
+	}
+}
+
+// DataDropped implements websocket.TrafficReporter
+func (r websocketReporter) DataDropped(name string) {
+	r.c.inc(metricWebsocketDropped, formatMetricLabels("exchange", name))
+	if next, ok := r.next.(websocket.TrafficReporter); ok {
+		next.DataDropped(name)
+	}
+}
+
+// metricEndpoint returns the route of a request URL without its host or query
+// and with ID-like path segments replaced, so order IDs, symbols and parameters
+// do not create a series per request
+func metricEndpoint(path string) string {
+	if u, err := url.Parse(path); err == nil && u.Path != "" {
+		path = u.Path
+	} else if i := strings.IndexByte(path, '?'); i != -1 {
+		path = path[:i]
+	}
+	if !strings.HasPrefix(path, "/") {
+		return path
+	}
+	segments := strings.Split(path, "/")
+	for i := range segments {
+		if isMetricIDSegment(segments[i]) {
+			segments[i] = metricIDPlaceholder
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+// isMetricIDSegment returns whether a path segment looks like an identifier or
+// symbol rather than part of a fixed route. Version segments such as v1 are
+// kept
+func isMetricIDSegment(seg string) bool {
+	if len(seg) > metricMaxRouteSegmentLength || strings.ContainsAny(seg, ":@%=,") {
+		return true
+	}
+	var digits, upperRun, maxUpperRun int
+	for _, r := range seg {
+		switch {
+		case r >= '0' && r <= '9':
+			digits++
+			upperRun = 0
+		case r >= 'A' && r <= 'Z':
+			upperRun++
+			maxUpperRun = max(maxUpperRun, upperRun)
+		default:
+			upperRun = 0
+		}
+	}
+	if maxUpperRun > 1 {
+		// Consecutive capitals are found in symbols such as BTCUSDT
+		return true
+	}
+	if digits == 0 {
+		return false
+	}
+	return len(seg) < 2 || seg[0] != 'v' || digits != len(seg)-1
+}
+
+// formatMetricLabels renders label name and value pairs
+func formatMetricLabels(pairs ...string) string {
+	var sb strings.Builder
+	sb.WriteByte('{')
+	for i := 0; i+1 < len(pairs); i += 2 {
+		if i > 0 {
+			sb.WriteByte(',')
+		}
+		sb.WriteString(pairs[i])
+		sb.WriteString(`="`)
+		sb.WriteString(metricLabelEscaper.Replace(pairs[i+1]))
+		sb.WriteByte('"')
+	}
+	sb.WriteByte('}')
+	return sb.String()
+}
+
+var metricLabelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// joinMetricLabels combines two rendered label sets
+func joinMetricLabels(a, b string) string {
+	if a == "" || a == "{}" {
+		return b
+	}
+	return a[:len(a)-1] + "," + b[1:]
+}
+
+func formatMetricValue(v float64) string {
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
+
+func writeMetricHeader(b *bytes.Buffer, name, metricType string) {
+	fmt.Fprintf(b, "# HELP %s %s\n# TYPE %s %s\n", name, metricHelp[name], name, metricType)
+}
+
+func writeMetricLine(b *bytes.Buffer, name, labels string, v float64) {
+	b.WriteString(name)
+	b.WriteString(labels)
+	b.WriteByte(' ')
+	b.WriteString(formatMetricValue(v))
+	b.WriteByte('\n')
+}
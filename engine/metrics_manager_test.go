@@ -0,0 +1,192 @@
+package engine
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/common/key"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchange/websocket"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
+)
+
+func TestSetupMetricsManager(t *testing.T) {
+	t.Parallel()
+	_, err := setupMetricsManager(nil, &Engine{})
+	assert.ErrorIs(t, err, errNilConfig)
+
+	_, err = setupMetricsManager(&config.MetricsConfig{}, nil)
+	assert.ErrorIs(t, err, errNilBot)
+
+	m, err := setupMetricsManager(&config.MetricsConfig{}, &Engine{})
+	require.NoError(t, err, "setupMetricsManager must not error")
+	assert.Equal(t, defaultMetricsListenAddress, m.listenAddress, "listenAddress should default when not set")
+	assert.NotNil(t, m.collector, "collector should be set")
+}
+
+func TestMetricsManagerStartStop(t *testing.T) {
+	var m *metricsManager
+	assert.False(t, m.IsRunning(), "IsRunning should return false on a nil manager")
+	assert.ErrorIs(t, m.Start(), ErrNilSubsystem)
+	assert.ErrorIs(t, m.Stop(), ErrNilSubsystem)
+
+	m, err := setupMetricsManager(&config.MetricsConfig{ListenAddress: "localhost:0"}, &Engine{})
+	require.NoError(t, err, "setupMetricsManager must not error")
+	assert.ErrorIs(t, m.Stop(), ErrSubSystemNotStarted)
+
+	prevREST, prevWebsocket := &testRESTReporter{}, &testWebsocketReporter{}
+	request.SetupGlobalReporter(prevREST)
+	websocket.SetupGlobalReporter(prevWebsocket)
+	t.Cleanup(func() {
+		request.SetupGlobalReporter(nil)
+		websocket.SetupGlobalReporter(nil)
+	})
+
+	require.NoError(t, m.Start(), "Start must not error")
+	assert.True(t, m.IsRunning(), "IsRunning should return true")
+	assert.ErrorIs(t, m.Start(), ErrSubSystemAlreadyStarted)
+
+	request.GetGlobalReporter().Latency("test", http.MethodGet, "/v1/ticker", time.Millisecond)
+	request.GetGlobalReporter().(request.ErrorReporter).RequestError("test", http.MethodGet, "/v1/ticker", http.StatusBadRequest)
+	websocket.GetGlobalReporter().Latency("test", nil, time.Millisecond)
+	websocket.GetGlobalReporter().(websocket.TrafficReporter).MessageReceived("test")
+	assert.Equal(t, 1, prevREST.latencies, "REST latency should be passed to the previous global reporter")
+	assert.Equal(t, 1, prevREST.errors, "REST errors should be passed to the previous global reporter")
+	assert.Equal(t, 1, prevWebsocket.latencies, "websocket latency should be passed to the previous global reporter")
+	assert.Equal(t, 1, prevWebsocket.messages, "websocket messages should be passed to the previous global reporter")
+
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, "http://"+m.server.Addr+metricsPath, http.NoBody)
+	require.NoError(t, err, "NewRequestWithContext must not error")
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err, "metrics request must not error")
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err, "ReadAll must not error")
+	require.NoError(t, resp.Body.Close(), "Body Close must not error")
+	assert.Equal(t, http.StatusOK, resp.StatusCode, "metrics request should return OK")
+	assert.Contains(t, string(body), "# TYPE "+metricDispatchQueueDepth+" gauge", "metrics should contain the dispatch queue depth")
+	assert.Contains(t, string(body), metricScriptVMs+" ", "metrics should contain the gctscript virtual machine count")
+
+	require.NoError(t, m.Stop(), "Stop must not error")
+	assert.False(t, m.IsRunning(), "IsRunning should return false")
+	assert.Same(t, prevREST, request.GetGlobalReporter(), "Stop should restore the previous REST reporter")
+	assert.Same(t, prevWebsocket, websocket.GetGlobalReporter(), "Stop should restore the previous websocket reporter")
+}
+
+type testRESTReporter struct {
+	latencies, errors int
+}
+
+func (r *testRESTReporter) Latency(string, string, string, time.Duration) { r.latencies++ }
+
+func (r *testRESTReporter) RequestError(string, string, string, int) { r.errors++ }
+
+type testWebsocketReporter struct {
+	latencies, messages int
+}
+
+func (r *testWebsocketReporter) Latency(string, []byte, time.Duration) { r.latencies++ }
+
+func (r *testWebsocketReporter) MessageReceived(string) { r.messages++ }
+
+func (r *testWebsocketReporter) Reconnected(string) {}
+
+func (r *testWebsocketReporter) DataDropped(string) {}
+
+func TestMetricsManagerCollectGauges(t *testing.T) {
+	t.Parallel()
+	syncer := &SyncManager{started: 1, initSyncCompleted: 1}
+	syncer.config.SynchronizeTicker = true
+	syncer.add(key.NewExchangeAssetPair("test", asset.Spot, currency.NewBTCUSDT()), syncBase{})
+	om := &OrderManager{started: 1}
+	om.orderStore.Orders = map[string][]*order.Detail{"test": {{OrderID: "1", Status: order.Active}}}
+
+	m, err := setupMetricsManager(&config.MetricsConfig{}, &Engine{currencyPairSyncer: syncer, OrderManager: om})
+	require.NoError(t, err, "setupMetricsManager must not error")
+	gauges := m.collectGauges()
+	assert.Len(t, gauges[metricDispatchQueueDepth], 1, "collectGauges should return the dispatch queue depth")
+	assert.Len(t, gauges[metricDispatchQueueLimit], 1, "collectGauges should return the dispatch queue limit")
+	assert.Len(t, gauges[metricScriptVMs], 1, "collectGauges should return the gctscript virtual machine count")
+	assert.Empty(t, gauges[metricWebsocketConnected], "collectGauges should not return websocket state without exchanges")
+	require.Len(t, gauges[metricSyncStaleness], 1, "collectGauges must return the sync staleness")
+	assert.Equal(t, `{exchange="test",asset="spot",pair="BTCUSDT",item="ticker"}`, gauges[metricSyncStaleness][0].labels, "sync staleness labels should be correct")
+	require.Len(t, gauges[metricOrders], 1, "collectGauges must return the order counts")
+	assert.Equal(t, metricSample{labels: `{exchange="test",status="ACTIVE"}`, value: 1}, gauges[metricOrders][0], "order counts should be correct")
+}
+
+func TestMetricsCollectorWrite(t *testing.T) {
+	t.Parallel()
+	c := newMetricsCollector()
+	rest := restReporter{c: c}
+	rest.Latency("test", http.MethodGet, "https://api.test.com/v1/ticker?symbol=BTCUSDT", 20*time.Millisecond)
+	rest.Latency("test", http.MethodGet, "https://api.test.com/v1/ticker?symbol=ETHUSDT", 20*time.Second)
+	rest.RequestError("test", http.MethodGet, "https://api.test.com/v1/ticker", http.StatusTooManyRequests)
+	ws := websocketReporter{c: c}
+	ws.MessageReceived("test")
+	ws.MessageReceived("test")
+
+	var b bytes.Buffer
+	c.write(&b, map[string][]metricSample{
+		metricDispatchQueueDepth: {{value: 3}},
+	})
+	assert.Equal(t, `# HELP gct_dispatch_queue_depth Jobs waiting to be relayed by the dispatcher.
+# TYPE gct_dispatch_queue_depth gauge
+gct_dispatch_queue_depth 3
+# HELP gct_rest_request_duration_seconds REST request latency by exchange, method and endpoint.
+# TYPE gct_rest_request_duration_seconds histogram
+gct_rest_request_duration_seconds_bucket{exchange="test",method="GET",endpoint="/v1/ticker",le="0.005"} 0
+gct_rest_request_duration_seconds_bucket{exchange="test",method="GET",endpoint="/v1/ticker",le="0.01"} 0
+gct_rest_request_duration_seconds_bucket{exchange="test",method="GET",endpoint="/v1/ticker",le="0.025"} 1
+gct_rest_request_duration_seconds_bucket{exchange="test",method="GET",endpoint="/v1/ticker",le="0.05"} 1
+gct_rest_request_duration_seconds_bucket{exchange="test",method="GET",endpoint="/v1/ticker",le="0.1"} 1
+gct_rest_request_duration_seconds_bucket{exchange="test",method="GET",endpoint="/v1/ticker",le="0.25"} 1
+gct_rest_request_duration_seconds_bucket{exchange="test",method="GET",endpoint="/v1/ticker",le="0.5"} 1
+gct_rest_request_duration_seconds_bucket{exchange="test",method="GET",endpoint="/v1/ticker",le="1"} 1
+gct_rest_request_duration_seconds_bucket{exchange="test",method="GET",endpoint="/v1/ticker",le="2.5"} 1
+gct_rest_request_duration_seconds_bucket{exchange="test",method="GET",endpoint="/v1/ticker",le="5"} 1
+gct_rest_request_duration_seconds_bucket{exchange="test",method="GET",endpoint="/v1/ticker",le="10"} 1
+gct_rest_request_duration_seconds_bucket{exchange="test",method="GET",endpoint="/v1/ticker",le="+Inf"} 2
+gct_rest_request_duration_seconds_sum{exchange="test",method="GET",endpoint="/v1/ticker"} 20.02
+gct_rest_request_duration_seconds_count{exchange="test",method="GET",endpoint="/v1/ticker"} 2
+# HELP gct_rest_request_errors_total REST requests which failed or returned an unsuccessful status code by exchange, method, endpoint and status.
+# TYPE gct_rest_request_errors_total counter
+gct_rest_request_errors_total{exchange="test",method="GET",endpoint="/v1/ticker",status="429"} 1
+# HELP gct_websocket_messages_received_total Websocket messages received by exchange.
+# TYPE gct_websocket_messages_received_total counter
+gct_websocket_messages_received_total{exchange="test"} 2
+`, b.String(), "write should render the Prometheus text format")
+}
+
+func TestMetricEndpoint(t *testing.T) {
+	t.Parallel()
+	for path, exp := range map[string]string{
+		"https://api.test.com/v1/ticker?symbol=BTCUSDT": "/v1/ticker",
+		"/v1/ticker?symbol=BTCUSDT":                     "/v1/ticker",
+		"https://api.test.com":                          "https://api.test.com",
+		"%zz?a=b":                                       "%zz",
+		"/v2/orders/1337":                               "/v2/orders/{id}",
+		"/api/v3/order/5f3b1c2e-9a8d-4e4f-8a1b-2c3d4e5f6a7b/fills": "/api/v3/order/{id}/fills",
+		"/products/BTC-USD/ticker":                                 "/products/{id}/ticker",
+		"/v2/ticker/tBTCUSD":                                       "/v2/ticker/{id}",
+		"/v2/candles/trade:1m:tBTCUSD/hist":                        "/v2/candles/{id}/hist",
+		"/api/v3/openOrders":                                       "/api/v3/openOrders",
+		"/accounts/acc1/balances":                                  "/accounts/{id}/balances",
+	} {
+		assert.Equalf(t, exp, metricEndpoint(path), "metricEndpoint should return the correct endpoint for %q", path)
+	}
+}
+
+func TestFormatMetricLabels(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, "{}", formatMetricLabels(), "formatMetricLabels should return empty braces without labels")
+	assert.Equal(t, `{a="1",b="q\"\\\n"}`, formatMetricLabels("a", "1", "b", "q\"\\\n"), "formatMetricLabels should escape label values")
+	assert.Equal(t, `{le="1"}`, joinMetricLabels("", `{le="1"}`), "joinMetricLabels should return the second labels when the first are empty")
+	assert.Equal(t, `{a="1",le="1"}`, joinMetricLabels(`{a="1"}`, `{le="1"}`), "joinMetricLabels should join labels")
+}
@@ -0,0 +1,110 @@
+package engine
+
+import (
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/exchange/websocket"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
+)
+
+const (
+	// MetricsManagerName is an exported subsystem name
+	MetricsManagerName = "metrics"
+
+	defaultMetricsListenAddress = "localhost:9095"
+	metricsPath                 = "/metrics"
+	metricsServerTimeout        = 10 * time.Second
+	metricIDPlaceholder         = "{id}"
+	metricMaxRouteSegmentLength = 24
+
+	metricRESTLatency         = "gct_rest_request_duration_seconds"
+	metricRESTErrors          = "gct_rest_request_errors_total"
+	metricRateLimitWait       = "gct_rest_rate_limit_wait_seconds"
+	metricWebsocketLatency    = "gct_websocket_request_duration_seconds"
+	metricWebsocketConnected  = "gct_websocket_connected"
+	metricWebsocketReconnects = "gct_websocket_reconnects_total"
+	metricWebsocketMessages   = "gct_websocket_messages_received_total"
+	metricWebsocketDropped    = "gct_websocket_data_dropped_total"
+	metricSyncStaleness       = "gct_sync_staleness_seconds"
+	metricOrders              = "gct_orders"
+	metricDispatchQueueDepth  = "gct_dispatch_queue_depth"
+	metricDispatchQueueLimit  = "gct_dispatch_queue_limit"
+	metricScriptVMs           = "gct_gctscript_virtual_machines"
+
+	metricTypeCounter   = "counter"
+	metricTypeGauge     = "gauge"
+	metricTypeHistogram = "histogram"
+)
+
+// metricDurationBuckets are the histogram upper bounds in seconds used for
+// request latencies and rate limiter waits
+var metricDurationBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+var metricHelp = map[string]string{
+	metricRESTLatency:         "REST request latency by exchange, method and endpoint.",
+	metricRESTErrors:          "REST requests which failed or returned an unsuccessful status code by exchange, method, endpoint and status.",
+	metricRateLimitWait:       "Time spent waiting on the REST rate limiter by exchange.",
+	metricWebsocketLatency:    "Websocket request to response latency by exchange.",
+	metricWebsocketConnected:  "Whether an enabled exchange websocket is connected.",
+	metricWebsocketReconnects: "Websocket reconnections by exchange.",
+	metricWebsocketMessages:   "Websocket messages received by exchange.",
+	metricWebsocketDropped:    "Websocket data dropped because the data handler buffer was full by exchange.",
+	metricSyncStaleness:       "Time since the sync manager last updated an exchange, asset, pair and sync item.",
+	metricOrders:              "Orders held by the order manager by exchange and status.",
+	metricDispatchQueueDepth:  "Jobs waiting to be relayed by the dispatcher.",
+	metricDispatchQueueLimit:  "Maximum jobs the dispatcher can queue.",
+	metricScriptVMs:           "Running gctscript virtual machines.",
+}
+
+// metricsManager serves engine metrics in the Prometheus text format
+type metricsManager struct {
+	started       int32
+	listenAddress string
+	bot           *Engine
+	collector     *metricsCollector
+	server        *http.Server
+	// prevRESTReporter and prevWebsocketReporter are the global reporters
+	// set before the manager started, which are chained to while running and
+	// restored once stopped
+	prevRESTReporter      request.Reporter
+	prevWebsocketReporter websocket.Reporter
+}
+
+// metricsCollector holds the counters and histograms pushed by the exchange
+// request and websocket reporters. Series are keyed by metric name and then
+// by their rendered labels
+type metricsCollector struct {
+	m          sync.Mutex
+	counters   map[string]map[string]float64
+	histograms map[string]map[string]*metricHistogram
+}
+
+// metricHistogram holds the observations of a histogram series. Bucket counts
+// are not cumulative
+type metricHistogram struct {
+	buckets []uint64
+	count   uint64
+	sum     float64
+}
+
+// metricSample is a single gauge value and its rendered labels
+type metricSample struct {
+	labels string
+	value  float64
+}
+
+// restReporter reports exchange REST metrics to the collector before passing
+// them to the next reporter
+type restReporter struct {
+	c    *metricsCollector
+	next request.Reporter
+}
+
+// websocketReporter reports exchange websocket metrics to the collector before
+// passing them to the next reporter
+type websocketReporter struct {
+	c    *metricsCollector
+	next websocket.Reporter
+}
@@ -60,6 +60,9 @@ func SetupOrderManager(exchangeManager iExchangeManager, communicationsManager i
 			CancelOrdersOnShutdown: cfg.CancelOrdersOnShutdown,
 		},
 	}
+	if err := om.setupRiskControls(&cfg.Risk); err != nil {
+		return nil, err
+	}
 	return om, nil
 }
 
@@ -94,6 +97,7 @@ func (m *OrderManager) Stop() error {
 	log.Debugln(log.OrderMgr, "Order manager shutting down...")
 	close(m.shutdown)
 	atomic.CompareAndSwapInt32(&m.started, 1, 0)
+	m.orderUpdates.releaseAll(fmt.Errorf("order manager %w", ErrSubSystemNotStarted))
 	return nil
 }
 
@@ -115,6 +119,7 @@ func (m *OrderManager) gracefulShutdown() {
 func (m *OrderManager) run() {
 	log.Debugln(log.OrderMgr, "Order manager started.")
 	m.processOrders()
+	m.processConditionalOrders()
 	for {
 		select {
 		case <-m.shutdown:
@@ -125,6 +130,7 @@ func (m *OrderManager) run() {
 		case <-time.After(orderManagerInterval):
 			// Process orders go routine allows shutdown procedures to continue
 			go m.processOrders()
+			m.processConditionalOrders()
 		}
 	}
 }
@@ -221,6 +227,7 @@ func (m *OrderManager) Cancel(ctx context.Context, cancel *order.Cancel) error {
 		err = fmt.Errorf("%v - Failed to update existing order when cancelled: %w", cancel.Exchange, err)
 		return err
 	}
+	m.orderUpdated(od.Exchange, od.OrderID)
 
 	msg := fmt.Sprintf("Exchange %s order ID=%v cancelled.",
 		od.Exchange, od.OrderID)
@@ -338,6 +345,7 @@ func (m *OrderManager) GetOrderInfo(ctx context.Context, exchangeName, orderID s
 	if err != nil {
 		return order.Detail{}, err
 	}
+	m.orderUpdated(upsertResponse.OrderDetails.Exchange, upsertResponse.OrderDetails.OrderID)
 
 	return upsertResponse.OrderDetails, nil
 }
@@ -408,6 +416,10 @@ func (m *OrderManager) Modify(ctx context.Context, mod *order.Modify) (*order.Mo
 		mod.Price = det.Price
 	}
 
+	if err := m.checkModifyRisk(det, mod); err != nil {
+		return nil, err
+	}
+
 	// Get exchange instance and submit order modification request.
 	exch, err := m.orderStore.exchangeManager.GetExchangeByName(mod.Exchange)
 	if err != nil {
@@ -439,6 +451,11 @@ func (m *OrderManager) Modify(ctx context.Context, mod *order.Modify) (*order.Mo
 		message = "Exchange %s order ID=%v: modified on exchange, but failed to modify locally"
 	} else {
 		message = "Exchange %s order ID=%v: modified successfully"
+		orderID := res.OrderID
+		if orderID == "" {
+			orderID = mod.OrderID
+		}
+		m.orderUpdated(mod.Exchange, orderID)
 	}
 	m.orderStore.commsManager.PushEvent(base.Event{
 		Type:    "order",
@@ -467,6 +484,10 @@ func (m *OrderManager) Submit(ctx context.Context, newOrder *order.Submit) (*Ord
 	if err != nil {
 		return nil, err
 	}
+	err = m.checkRisk(newOrder, nil)
+	if err != nil {
+		return nil, err
+	}
 	// Checks for exchange min max limits for order amounts before order
 	// execution can occur
 	err = exch.CheckOrderExecutionLimits(newOrder.AssetType,
@@ -492,6 +513,7 @@ func (m *OrderManager) Submit(ctx context.Context, newOrder *order.Submit) (*Ord
 
 	result, err := exch.SubmitOrder(ctx, newOrder)
 	if err != nil {
+		m.relayRejectedOrder(newOrder)
 		return nil, err
 	}
 
@@ -518,6 +540,10 @@ func (m *OrderManager) SubmitFakeOrder(newOrder *order.Submit, resultingOrder *o
 	if err != nil {
 		return nil, err
 	}
+	err = m.checkRisk(newOrder, nil)
+	if err != nil {
+		return nil, err
+	}
 	if checkExchangeLimits {
 		// Checks for exchange min max limits for order amounts before order
 		// execution can occur
@@ -601,6 +627,8 @@ func (m *OrderManager) processSubmittedOrder(newOrderResp *order.SubmitResponse)
 	} else if err != nil {
 		// Non-fatal error: Unable to store order, but error does not need to be returned to caller
 		log.Errorf(log.OrderMgr, "Unable to add %v order %v to orderStore: %s", detail.Exchange, detail.OrderID, err)
+	} else {
+		m.orderUpdated(detail.Exchange, detail.OrderID)
 	}
 
 	msg := fmt.Sprintf("Exchange %s submitted order ID=%v [Ours: %v] pair=%v price=%v amount=%v quoteAmount=%v side=%v type=%v for time %v.",
@@ -859,7 +887,12 @@ func (m *OrderManager) Add(o *order.Detail) error {
 		return fmt.Errorf("order manager %w", ErrSubSystemNotStarted)
 	}
 
-	return m.orderStore.add(o)
+	err := m.orderStore.add(o)
+	if err != nil {
+		return err
+	}
+	m.orderUpdated(o.Exchange, o.OrderID)
+	return nil
 }
 
 // GetByExchangeAndID returns a copy of an order from an exchange if it matches the ID
@@ -881,7 +914,12 @@ func (m *OrderManager) UpdateExistingOrder(od *order.Detail) error {
 	if atomic.LoadInt32(&m.started) == 0 {
 		return fmt.Errorf("order manager %w", ErrSubSystemNotStarted)
 	}
-	return m.orderStore.updateExisting(od)
+	err := m.orderStore.updateExisting(od)
+	if err != nil {
+		return err
+	}
+	m.orderUpdated(od.Exchange, od.OrderID)
+	return nil
 }
 
 // UpsertOrder updates an existing order or adds a new one to the orderstore
@@ -915,6 +953,8 @@ func (m *OrderManager) UpsertOrder(od *order.Detail) (resp *OrderUpsertResponse,
 		return nil, err
 	}
 
+	m.orderUpdated(upsertResponse.OrderDetails.Exchange, upsertResponse.OrderDetails.OrderID)
+
 	status := "updated"
 	if upsertResponse.IsNewOrder {
 		status = "added"
@@ -1142,6 +1182,21 @@ func (s *store) getFilteredOrders(f *order.Filter) ([]order.Detail, error) {
 	return os, nil
 }
 
+// getOrderCounts returns the number of orders held per exchange and status
+func (s *store) getOrderCounts() map[string]map[order.Status]int {
+	s.m.RLock()
+	defer s.m.RUnlock()
+	counts := make(map[string]map[order.Status]int, len(s.Orders))
+	for exch, orders := range s.Orders {
+		c := make(map[order.Status]int)
+		for i := range orders {
+			c[orders[i].Status]++
+		}
+		counts[exch] = c
+	}
+	return counts
+}
+
 // getActiveOrders returns copy of the orders that are active
 func (s *store) getActiveOrders(f *order.Filter) []order.Detail {
 	s.m.RLock()
@@ -0,0 +1,683 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/conditionalorder"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// StringToConditionalOrderType converts a string to a conditional order type
+func StringToConditionalOrderType(t string) (ConditionalOrderType, error) {
+	switch ct := ConditionalOrderType(strings.ToUpper(strings.ReplaceAll(t, "-", "_"))); ct {
+	case StopMarketConditionalOrder,
+		StopLimitConditionalOrder,
+		TrailingStopConditionalOrder,
+		OneCancelsOtherConditionalOrder,
+		BracketConditionalOrder:
+		return ct, nil
+	}
+	return "", fmt.Errorf("%w '%v'", errUnknownConditionalOrderType, t)
+}
+
+// IsActive returns whether the conditional order can still be triggered
+func (s ConditionalOrderStatus) IsActive() bool {
+	return s == ConditionalOrderActive || s == ConditionalOrderPendingEntry
+}
+
+// SetConditionalOrderDatabase enables persistence of conditional orders and
+// loads any which were active when the order manager was last stopped
+func (m *OrderManager) SetConditionalOrderDatabase(db database.IDatabase) error {
+	if m == nil {
+		return fmt.Errorf("order manager %w", ErrNilSubsystem)
+	}
+	dbService, err := conditionalorder.Setup(db)
+	if err != nil {
+		return err
+	}
+	return m.loadConditionalOrders(dbService)
+}
+
+// loadConditionalOrders sets the conditional order database service and
+// loads all active conditional orders from it
+func (m *OrderManager) loadConditionalOrders(db conditionalorder.IDBService) error {
+	records, err := db.GetByStatus(string(ConditionalOrderActive), string(ConditionalOrderPendingEntry))
+	if err != nil {
+		return err
+	}
+	loaded := make([]*ConditionalOrder, 0, len(records))
+	for i := range records {
+		c, err := conditionalOrderFromRecord(&records[i])
+		if err != nil {
+			return err
+		}
+		loaded = append(loaded, c)
+	}
+	m.conditionalOrders.m.Lock()
+	defer m.conditionalOrders.m.Unlock()
+	m.conditionalOrders.db = db
+loading:
+	for i := range loaded {
+		for j := range m.conditionalOrders.orders {
+			if m.conditionalOrders.orders[j].ID == loaded[i].ID {
+				continue loading
+			}
+		}
+		m.conditionalOrders.orders = append(m.conditionalOrders.orders, loaded[i])
+	}
+	if len(loaded) > 0 {
+		log.Infof(log.OrderMgr, "Order manager loaded %d active conditional orders", len(loaded))
+	}
+	return nil
+}
+
+// AddConditionalOrder validates and stores a client side conditional order.
+// Bracket orders have their entry order submitted immediately
+func (m *OrderManager) AddConditionalOrder(ctx context.Context, c *ConditionalOrder) (*ConditionalOrder, error) {
+	if m == nil {
+		return nil, fmt.Errorf("order manager %w", ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&m.started) == 0 {
+		return nil, fmt.Errorf("order manager %w", ErrSubSystemNotStarted)
+	}
+	if c == nil {
+		return nil, fmt.Errorf("%w conditional order", common.ErrNilPointer)
+	}
+	exch, err := m.orderStore.exchangeManager.GetExchangeByName(c.Exchange)
+	if err != nil {
+		return nil, err
+	}
+	cpy := *c
+	cpy.Exchange = exch.GetName()
+	if err = cpy.validate(); err != nil {
+		return nil, err
+	}
+	cpy.ID, err = uuid.NewV4()
+	if err != nil {
+		return nil, err
+	}
+	cpy.CreatedAt = time.Now()
+	cpy.UpdatedAt = cpy.CreatedAt
+	cpy.ReferencePrice = 0
+	cpy.EntryOrderID = ""
+	cpy.OrderID = ""
+	cpy.FailureReason = ""
+	cpy.Status = ConditionalOrderActive
+
+	if cpy.Type == BracketConditionalOrder {
+		entry := &order.Submit{
+			Exchange:  cpy.Exchange,
+			Type:      order.Market,
+			Side:      cpy.Side,
+			Pair:      cpy.Pair,
+			AssetType: cpy.Asset,
+			Amount:    cpy.Amount,
+		}
+		if cpy.EntryPrice > 0 {
+			entry.Type = order.Limit
+			entry.Price = cpy.EntryPrice
+		}
+		resp, err := m.submitConditionalOrder(ctx, entry)
+		if err != nil {
+			return nil, fmt.Errorf("bracket entry order: %w", err)
+		}
+		cpy.EntryOrderID = resp.OrderID
+		if resp.Status != order.Filled {
+			cpy.Status = ConditionalOrderPendingEntry
+		}
+	}
+
+	m.conditionalOrders.m.Lock()
+	m.conditionalOrders.orders = append(m.conditionalOrders.orders, &cpy)
+	resp := cpy
+	m.conditionalOrders.m.Unlock()
+
+	m.persistConditionalOrders(&resp)
+	m.subscribeConditionalOrderFeeds()
+	log.Debugf(log.OrderMgr, "Order manager added %v conditional order %v %v %v %v", resp.Type, resp.Exchange, resp.Asset, resp.Pair, resp.ID)
+	return &resp, nil
+}
+
+// GetConditionalOrders returns a copy of all conditional orders, optionally
+// filtered by exchange and whether they are active
+func (m *OrderManager) GetConditionalOrders(exchangeName string, activeOnly bool) ([]ConditionalOrder, error) {
+	if m == nil {
+		return nil, fmt.Errorf("order manager %w", ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&m.started) == 0 {
+		return nil, fmt.Errorf("order manager %w", ErrSubSystemNotStarted)
+	}
+	m.conditionalOrders.m.Lock()
+	defer m.conditionalOrders.m.Unlock()
+	resp := make([]ConditionalOrder, 0, len(m.conditionalOrders.orders))
+	for _, c := range m.conditionalOrders.orders {
+		if exchangeName != "" && !strings.EqualFold(c.Exchange, exchangeName) {
+			continue
+		}
+		if activeOnly && !c.Status.IsActive() {
+			continue
+		}
+		resp = append(resp, *c)
+	}
+	return resp, nil
+}
+
+// CancelConditionalOrder cancels an active conditional order. A bracket order
+// awaiting its entry fill will have its entry order cancelled on the exchange
+func (m *OrderManager) CancelConditionalOrder(ctx context.Context, id uuid.UUID) (*ConditionalOrder, error) {
+	if m == nil {
+		return nil, fmt.Errorf("order manager %w", ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&m.started) == 0 {
+		return nil, fmt.Errorf("order manager %w", ErrSubSystemNotStarted)
+	}
+	m.conditionalOrders.m.Lock()
+	var c *ConditionalOrder
+	for i := range m.conditionalOrders.orders {
+		if m.conditionalOrders.orders[i].ID == id {
+			c = m.conditionalOrders.orders[i]
+			break
+		}
+	}
+	if c == nil {
+		m.conditionalOrders.m.Unlock()
+		return nil, fmt.Errorf("%w %v", ErrConditionalOrderNotFound, id)
+	}
+	if !c.Status.IsActive() {
+		m.conditionalOrders.m.Unlock()
+		return nil, fmt.Errorf("%w %v status %v", errConditionalOrderNotCancelable, id, c.Status)
+	}
+	pendingEntry := *c
+	m.conditionalOrders.m.Unlock()
+
+	if pendingEntry.Status == ConditionalOrderPendingEntry {
+		err := m.Cancel(ctx, &order.Cancel{
+			Exchange:  pendingEntry.Exchange,
+			OrderID:   pendingEntry.EntryOrderID,
+			Side:      pendingEntry.Side,
+			Pair:      pendingEntry.Pair,
+			AssetType: pendingEntry.Asset,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("bracket entry order %v: %w", pendingEntry.EntryOrderID, err)
+		}
+	}
+
+	m.conditionalOrders.m.Lock()
+	if !c.Status.IsActive() {
+		// triggered while the entry order was being cancelled
+		m.conditionalOrders.m.Unlock()
+		return nil, fmt.Errorf("%w %v status %v", errConditionalOrderNotCancelable, id, c.Status)
+	}
+	c.Status = ConditionalOrderCancelled
+	c.UpdatedAt = time.Now()
+	resp := *c
+	m.conditionalOrders.m.Unlock()
+
+	m.persistConditionalOrders(&resp)
+	return &resp, nil
+}
+
+// processConditionalOrders ensures exchanges with active conditional orders
+// are subscribed to and persists any outstanding trailing stop updates
+func (m *OrderManager) processConditionalOrders() {
+	m.subscribeConditionalOrderFeeds()
+
+	m.conditionalOrders.m.Lock()
+	dirty := make([]*ConditionalOrder, 0, len(m.conditionalOrders.dirty))
+	for _, c := range m.conditionalOrders.orders {
+		if m.conditionalOrders.dirty[c.ID] {
+			cpy := *c
+			dirty = append(dirty, &cpy)
+		}
+	}
+	clear(m.conditionalOrders.dirty)
+	m.conditionalOrders.m.Unlock()
+
+	m.persistConditionalOrders(dirty...)
+}
+
+// subscribeConditionalOrderFeeds subscribes to the ticker and orderbook
+// updates of every exchange with active conditional orders. Exchanges which
+// have not yet published any updates are retried on the next cycle
+func (m *OrderManager) subscribeConditionalOrderFeeds() {
+	m.conditionalOrders.m.Lock()
+	defer m.conditionalOrders.m.Unlock()
+	if m.conditionalOrders.feeds == nil {
+		m.conditionalOrders.feeds = make(map[string]*conditionalOrderFeed)
+	}
+	for _, c := range m.conditionalOrders.orders {
+		if !c.Status.IsActive() {
+			continue
+		}
+		exch := strings.ToLower(c.Exchange)
+		feed, ok := m.conditionalOrders.feeds[exch]
+		if !ok {
+			feed = &conditionalOrderFeed{}
+			m.conditionalOrders.feeds[exch] = feed
+		}
+		if !feed.ticker {
+			if pipe, err := ticker.SubscribeToExchangeTickers(exch); err == nil {
+				feed.ticker = true
+				m.orderStore.wg.Add(1)
+				go m.listenConditionalOrderFeed(exch, pipe, true, m.shutdown)
+			}
+		}
+		if !feed.orderbook {
+			if pipe, err := orderbook.SubscribeToExchangeOrderbooks(exch); err == nil {
+				feed.orderbook = true
+				m.orderStore.wg.Add(1)
+				go m.listenConditionalOrderFeed(exch, pipe, false, m.shutdown)
+			}
+		}
+	}
+}
+
+// listenConditionalOrderFeed evaluates conditional orders against each
+// update received from a dispatch pipe until shutdown
+func (m *OrderManager) listenConditionalOrderFeed(exch string, pipe dispatch.Pipe, isTicker bool, shutdown <-chan struct{}) {
+	defer func() {
+		if err := pipe.Release(); err != nil {
+			log.Errorln(log.OrderMgr, err)
+		}
+		m.conditionalOrders.m.Lock()
+		if feed, ok := m.conditionalOrders.feeds[exch]; ok {
+			if isTicker {
+				feed.ticker = false
+			} else {
+				feed.orderbook = false
+			}
+		}
+		m.conditionalOrders.m.Unlock()
+		m.orderStore.wg.Done()
+	}()
+	for {
+		select {
+		case <-shutdown:
+			return
+		case data, ok := <-pipe.Channel():
+			if !ok {
+				return
+			}
+			if err := m.processConditionalOrderUpdate(data); err != nil {
+				log.Errorf(log.OrderMgr, "Order manager conditional order update error: %v", err)
+			}
+		}
+	}
+}
+
+// processConditionalOrderUpdate evaluates conditional orders against a
+// ticker or orderbook update delivered via dispatch
+func (m *OrderManager) processConditionalOrderUpdate(data any) error {
+	switch d := data.(type) {
+	case *ticker.Price:
+		m.processConditionalOrderPrice(d.ExchangeName, d.Pair, d.AssetType, conditionalOrderPrice{
+			Bid:  d.Bid,
+			Ask:  d.Ask,
+			Last: d.Last,
+		})
+	case *orderbook.Depth:
+		// An empty side of the book is ignored and the other side is still
+		// evaluated
+		bid, _ := d.GetBestBid()
+		ask, _ := d.GetBestAsk()
+		if bid == 0 && ask == 0 {
+			return nil
+		}
+		m.processConditionalOrderPrice(d.Exchange(), d.Pair(), d.Asset(), conditionalOrderPrice{
+			Bid: bid,
+			Ask: ask,
+		})
+	default:
+		return common.GetTypeAssertError("*ticker.Price or *orderbook.Depth", data)
+	}
+	return nil
+}
+
+type triggeredConditionalOrder struct {
+	c      *ConditionalOrder
+	submit *order.Submit
+}
+
+// processConditionalOrderPrice evaluates all active conditional orders for
+// an exchange asset pair against a price update and submits any that trigger
+func (m *OrderManager) processConditionalOrderPrice(exch string, pair currency.Pair, a asset.Item, price conditionalOrderPrice) {
+	var triggered []triggeredConditionalOrder
+	var updated []*ConditionalOrder
+	m.conditionalOrders.m.Lock()
+	for _, c := range m.conditionalOrders.orders {
+		if !c.Status.IsActive() ||
+			c.Asset != a ||
+			!strings.EqualFold(c.Exchange, exch) ||
+			!c.Pair.Equal(pair) {
+			continue
+		}
+		if c.Status == ConditionalOrderPendingEntry {
+			if !m.checkBracketEntry(c) {
+				continue
+			}
+			cpy := *c
+			updated = append(updated, &cpy)
+			if !c.Status.IsActive() {
+				continue
+			}
+		}
+		previousReference := c.ReferencePrice
+		submit := c.evaluate(price)
+		if submit == nil {
+			if c.ReferencePrice != previousReference {
+				if m.conditionalOrders.dirty == nil {
+					m.conditionalOrders.dirty = make(map[uuid.UUID]bool)
+				}
+				m.conditionalOrders.dirty[c.ID] = true
+			}
+			continue
+		}
+		c.Status = ConditionalOrderTriggered
+		c.UpdatedAt = time.Now()
+		triggered = append(triggered, triggeredConditionalOrder{c: c, submit: submit})
+	}
+	m.conditionalOrders.m.Unlock()
+
+	for i := range triggered {
+		resp, err := m.submitConditionalOrder(context.TODO(), triggered[i].submit)
+		m.conditionalOrders.m.Lock()
+		c := triggered[i].c
+		if err != nil {
+			c.Status = ConditionalOrderFailed
+			c.FailureReason = err.Error()
+			log.Errorf(log.OrderMgr, "Order manager conditional order %v failed to submit: %v", c.ID, err)
+		} else {
+			c.OrderID = resp.OrderID
+			log.Infof(log.OrderMgr, "Order manager conditional order %v triggered %v %v order ID=%v", c.ID, triggered[i].submit.Side, triggered[i].submit.Type, c.OrderID)
+		}
+		c.UpdatedAt = time.Now()
+		delete(m.conditionalOrders.dirty, c.ID)
+		cpy := *c
+		m.conditionalOrders.m.Unlock()
+		updated = append(updated, &cpy)
+	}
+	m.persistConditionalOrders(updated...)
+}
+
+// checkBracketEntry activates a bracket order once its entry order is filled
+// and fails it if the entry order is no longer open. Returns true if the
+// conditional order status has changed
+func (m *OrderManager) checkBracketEntry(c *ConditionalOrder) bool {
+	entry, err := m.orderStore.getByExchangeAndID(c.Exchange, c.EntryOrderID)
+	if err != nil {
+		return false
+	}
+	switch {
+	case entry.Status == order.Filled:
+		c.Status = ConditionalOrderActive
+	case entry.Status.IsInactive():
+		c.Status = ConditionalOrderFailed
+		c.FailureReason = fmt.Sprintf("%v: entry order status %v", errEntryOrderNotFilled, entry.Status)
+	default:
+		return false
+	}
+	c.UpdatedAt = time.Now()
+	return true
+}
+
+// submitConditionalOrder submits an order on behalf of a conditional order
+func (m *OrderManager) submitConditionalOrder(ctx context.Context, s *order.Submit) (*OrderSubmitResponse, error) {
+	if m.conditionalOrders.submitter != nil {
+		return m.conditionalOrders.submitter(ctx, s)
+	}
+	return m.Submit(ctx, s)
+}
+
+// persistConditionalOrders saves conditional orders to the database if
+// persistence is enabled
+func (m *OrderManager) persistConditionalOrders(orders ...*ConditionalOrder) {
+	if len(orders) == 0 {
+		return
+	}
+	m.conditionalOrders.m.Lock()
+	db := m.conditionalOrders.db
+	m.conditionalOrders.m.Unlock()
+	if db == nil {
+		return
+	}
+	records := make([]*conditionalorder.ConditionalOrder, len(orders))
+	for i := range orders {
+		records[i] = orders[i].toRecord()
+	}
+	if err := db.Upsert(records...); err != nil {
+		log.Errorf(log.OrderMgr, "Order manager unable to save conditional orders: %v", err)
+	}
+}
+
+// validate ensures a conditional order has everything required to trigger
+func (c *ConditionalOrder) validate() error {
+	if c.Exchange == "" {
+		return common.ErrExchangeNameNotSet
+	}
+	if c.Pair.IsEmpty() {
+		return currency.ErrCurrencyPairEmpty
+	}
+	if !c.Asset.IsValid() {
+		return fmt.Errorf("%w %v", asset.ErrInvalidAsset, c.Asset)
+	}
+	if c.Side != order.Buy && c.Side != order.Sell {
+		return fmt.Errorf("%w %v, conditional orders must be buy or sell", order.ErrSideIsInvalid, c.Side)
+	}
+	if c.Amount <= 0 {
+		return order.ErrAmountIsInvalid
+	}
+	switch c.Type {
+	case StopMarketConditionalOrder:
+		if c.TriggerPrice <= 0 {
+			return errInvalidTriggerPrice
+		}
+	case StopLimitConditionalOrder:
+		if c.TriggerPrice <= 0 {
+			return errInvalidTriggerPrice
+		}
+		if c.LimitPrice <= 0 {
+			return errInvalidLimitPrice
+		}
+	case TrailingStopConditionalOrder:
+		if (c.TrailingAmount > 0) == (c.TrailingPercent > 0) ||
+			c.TrailingAmount < 0 ||
+			c.TrailingPercent < 0 ||
+			c.TrailingPercent >= 100 {
+			return errInvalidTrailingOffset
+		}
+	case OneCancelsOtherConditionalOrder, BracketConditionalOrder:
+		if c.TriggerPrice <= 0 {
+			return errInvalidTriggerPrice
+		}
+		if c.TakeProfitPrice <= 0 {
+			return errInvalidTakeProfitPrice
+		}
+		if c.LimitPrice < 0 {
+			return errInvalidLimitPrice
+		}
+		if c.EntryPrice < 0 {
+			return errInvalidEntryPrice
+		}
+		if exitSide := c.exitSide(); (exitSide == order.Sell && c.TakeProfitPrice <= c.TriggerPrice) ||
+			(exitSide == order.Buy && c.TakeProfitPrice >= c.TriggerPrice) {
+			return fmt.Errorf("%w: %v take profit %v stop %v", errTakeProfitOnWrongSide, exitSide, c.TakeProfitPrice, c.TriggerPrice)
+		}
+	default:
+		return fmt.Errorf("%w '%v'", errUnknownConditionalOrderType, c.Type)
+	}
+	return nil
+}
+
+// exitSide returns the side of the order submitted when triggered
+func (c *ConditionalOrder) exitSide() order.Side {
+	if c.Type != BracketConditionalOrder {
+		return c.Side
+	}
+	if c.Side == order.Buy {
+		return order.Sell
+	}
+	return order.Buy
+}
+
+// evaluate updates the trailing reference price and returns the order to
+// submit if the conditional order has been triggered by the price update
+func (c *ConditionalOrder) evaluate(p conditionalOrderPrice) *order.Submit {
+	side := c.exitSide()
+	price := p.forSide(side)
+	if price <= 0 {
+		return nil
+	}
+	switch c.Type {
+	case StopMarketConditionalOrder:
+		if stopTriggered(side, price, c.TriggerPrice) {
+			return c.submission(side, order.Market, 0)
+		}
+	case StopLimitConditionalOrder:
+		if stopTriggered(side, price, c.TriggerPrice) {
+			return c.submission(side, order.Limit, c.LimitPrice)
+		}
+	case TrailingStopConditionalOrder:
+		if c.ReferencePrice == 0 ||
+			(side == order.Sell && price > c.ReferencePrice) ||
+			(side == order.Buy && price < c.ReferencePrice) {
+			c.ReferencePrice = price
+		}
+		offset := c.TrailingAmount
+		if c.TrailingPercent > 0 {
+			offset = c.ReferencePrice * c.TrailingPercent / 100
+		}
+		stop := c.ReferencePrice - offset
+		if side == order.Buy {
+			stop = c.ReferencePrice + offset
+		}
+		if stopTriggered(side, price, stop) {
+			return c.submission(side, order.Market, 0)
+		}
+	case OneCancelsOtherConditionalOrder, BracketConditionalOrder:
+		if (side == order.Sell && price >= c.TakeProfitPrice) ||
+			(side == order.Buy && price <= c.TakeProfitPrice) {
+			return c.submission(side, order.Limit, c.TakeProfitPrice)
+		}
+		if stopTriggered(side, price, c.TriggerPrice) {
+			if c.LimitPrice > 0 {
+				return c.submission(side, order.Limit, c.LimitPrice)
+			}
+			return c.submission(side, order.Market, 0)
+		}
+	}
+	return nil
+}
+
+func (c *ConditionalOrder) submission(side order.Side, orderType order.Type, price float64) *order.Submit {
+	return &order.Submit{
+		Exchange:   c.Exchange,
+		Type:       orderType,
+		Side:       side,
+		Pair:       c.Pair,
+		AssetType:  c.Asset,
+		Amount:     c.Amount,
+		Price:      price,
+		ReduceOnly: c.Type == BracketConditionalOrder && c.Asset.IsFutures(),
+	}
+}
+
+// stopTriggered returns whether a sell price has fallen to the trigger price
+// or a buy price has risen to it
+func stopTriggered(side order.Side, price, trigger float64) bool {
+	if side == order.Sell {
+		return price <= trigger
+	}
+	return price >= trigger
+}
+
+// forSide returns the price used to evaluate an order of the given side
+func (p conditionalOrderPrice) forSide(side order.Side) float64 {
+	if side == order.Sell && p.Bid > 0 {
+		return p.Bid
+	}
+	if side == order.Buy && p.Ask > 0 {
+		return p.Ask
+	}
+	return p.Last
+}
+
+func (c *ConditionalOrder) toRecord() *conditionalorder.ConditionalOrder {
+	return &conditionalorder.ConditionalOrder{
+		ID:              c.ID.String(),
+		ExchangeName:    c.Exchange,
+		Base:            c.Pair.Base.String(),
+		Quote:           c.Pair.Quote.String(),
+		Asset:           c.Asset.String(),
+		Type:            string(c.Type),
+		Side:            c.Side.String(),
+		Amount:          c.Amount,
+		TriggerPrice:    c.TriggerPrice,
+		LimitPrice:      c.LimitPrice,
+		TakeProfitPrice: c.TakeProfitPrice,
+		EntryPrice:      c.EntryPrice,
+		TrailingAmount:  c.TrailingAmount,
+		TrailingPercent: c.TrailingPercent,
+		ReferencePrice:  c.ReferencePrice,
+		EntryOrderID:    c.EntryOrderID,
+		OrderID:         c.OrderID,
+		Status:          string(c.Status),
+		FailureReason:   c.FailureReason,
+		CreatedAt:       c.CreatedAt,
+		UpdatedAt:       c.UpdatedAt,
+	}
+}
+
+func conditionalOrderFromRecord(r *conditionalorder.ConditionalOrder) (*ConditionalOrder, error) {
+	id, err := uuid.FromString(r.ID)
+	if err != nil {
+		return nil, err
+	}
+	a, err := asset.New(r.Asset)
+	if err != nil {
+		return nil, err
+	}
+	side, err := order.StringToOrderSide(r.Side)
+	if err != nil {
+		return nil, err
+	}
+	t, err := StringToConditionalOrderType(r.Type)
+	if err != nil {
+		return nil, err
+	}
+	return &ConditionalOrder{
+		ID:              id,
+		Exchange:        r.ExchangeName,
+		Pair:            currency.NewPair(currency.NewCode(r.Base), currency.NewCode(r.Quote)),
+		Asset:           a,
+		Type:            t,
+		Side:            side,
+		Amount:          r.Amount,
+		TriggerPrice:    r.TriggerPrice,
+		LimitPrice:      r.LimitPrice,
+		TakeProfitPrice: r.TakeProfitPrice,
+		EntryPrice:      r.EntryPrice,
+		TrailingAmount:  r.TrailingAmount,
+		TrailingPercent: r.TrailingPercent,
+		ReferencePrice:  r.ReferencePrice,
+		EntryOrderID:    r.EntryOrderID,
+		OrderID:         r.OrderID,
+		Status:          ConditionalOrderStatus(r.Status),
+		FailureReason:   r.FailureReason,
+		CreatedAt:       r.CreatedAt,
+		UpdatedAt:       r.UpdatedAt,
+	}, nil
+}
@@ -0,0 +1,576 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/conditionalorder"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+)
+
+var errTestConditionalSubmit = errors.New("test conditional submit error")
+
+// fakeConditionalOrderDB stores conditional order records in memory
+type fakeConditionalOrderDB struct {
+	m       sync.Mutex
+	records map[string]conditionalorder.ConditionalOrder
+}
+
+func (f *fakeConditionalOrderDB) Upsert(orders ...*conditionalorder.ConditionalOrder) error {
+	f.m.Lock()
+	defer f.m.Unlock()
+	if f.records == nil {
+		f.records = make(map[string]conditionalorder.ConditionalOrder)
+	}
+	for i := range orders {
+		f.records[orders[i].ID] = *orders[i]
+	}
+	return nil
+}
+
+func (f *fakeConditionalOrderDB) GetByStatus(statuses ...string) ([]conditionalorder.ConditionalOrder, error) {
+	f.m.Lock()
+	defer f.m.Unlock()
+	var resp []conditionalorder.ConditionalOrder
+	for _, r := range f.records {
+		for i := range statuses {
+			if r.Status == statuses[i] {
+				resp = append(resp, r)
+			}
+		}
+	}
+	return resp, nil
+}
+
+func (f *fakeConditionalOrderDB) get(id uuid.UUID) (conditionalorder.ConditionalOrder, bool) {
+	f.m.Lock()
+	defer f.m.Unlock()
+	r, ok := f.records[id.String()]
+	return r, ok
+}
+
+// conditionalOrdersSetup returns an order manager which records submitted
+// orders rather than sending them to the exchange
+func conditionalOrdersSetup(t *testing.T) (*OrderManager, *[]order.Submit) {
+	t.Helper()
+	m := OrdersSetup(t)
+	var mu sync.Mutex
+	submitted := &[]order.Submit{}
+	m.conditionalOrders.submitter = func(_ context.Context, s *order.Submit) (*OrderSubmitResponse, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		*submitted = append(*submitted, *s)
+		return &OrderSubmitResponse{Detail: &order.Detail{OrderID: "conditional" + s.Type.String(), Status: order.New}}, nil
+	}
+	return m, submitted
+}
+
+func TestStringToConditionalOrderType(t *testing.T) {
+	t.Parallel()
+	for s, expected := range map[string]ConditionalOrderType{
+		"stop_market":   StopMarketConditionalOrder,
+		"STOP-LIMIT":    StopLimitConditionalOrder,
+		"trailing_stop": TrailingStopConditionalOrder,
+		"oco":           OneCancelsOtherConditionalOrder,
+		"Bracket":       BracketConditionalOrder,
+	} {
+		ct, err := StringToConditionalOrderType(s)
+		require.NoError(t, err)
+		assert.Equal(t, expected, ct)
+	}
+	_, err := StringToConditionalOrderType("moon")
+	assert.ErrorIs(t, err, errUnknownConditionalOrderType)
+}
+
+func TestConditionalOrderValidate(t *testing.T) {
+	t.Parallel()
+	valid := ConditionalOrder{
+		Exchange:        testExchange,
+		Pair:            btcusdPair,
+		Asset:           asset.Spot,
+		Type:            OneCancelsOtherConditionalOrder,
+		Side:            order.Sell,
+		Amount:          1,
+		TriggerPrice:    90,
+		TakeProfitPrice: 110,
+	}
+	require.NoError(t, valid.validate())
+
+	for _, tc := range []struct {
+		name   string
+		modify func(*ConditionalOrder)
+		err    error
+	}{
+		{"exchange", func(c *ConditionalOrder) { c.Exchange = "" }, common.ErrExchangeNameNotSet},
+		{"pair", func(c *ConditionalOrder) { c.Pair = currency.EMPTYPAIR }, currency.ErrCurrencyPairEmpty},
+		{"asset", func(c *ConditionalOrder) { c.Asset = asset.Empty }, asset.ErrInvalidAsset},
+		{"side", func(c *ConditionalOrder) { c.Side = order.Long }, order.ErrSideIsInvalid},
+		{"amount", func(c *ConditionalOrder) { c.Amount = 0 }, order.ErrAmountIsInvalid},
+		{"type", func(c *ConditionalOrder) { c.Type = "moon" }, errUnknownConditionalOrderType},
+		{"stop trigger", func(c *ConditionalOrder) { c.Type = StopMarketConditionalOrder; c.TriggerPrice = 0 }, errInvalidTriggerPrice},
+		{"stop limit trigger", func(c *ConditionalOrder) { c.Type = StopLimitConditionalOrder; c.TriggerPrice = 0 }, errInvalidTriggerPrice},
+		{"stop limit price", func(c *ConditionalOrder) { c.Type = StopLimitConditionalOrder }, errInvalidLimitPrice},
+		{"trailing unset", func(c *ConditionalOrder) { c.Type = TrailingStopConditionalOrder }, errInvalidTrailingOffset},
+		{"trailing both", func(c *ConditionalOrder) {
+			c.Type = TrailingStopConditionalOrder
+			c.TrailingAmount = 1
+			c.TrailingPercent = 1
+		}, errInvalidTrailingOffset},
+		{"trailing percent", func(c *ConditionalOrder) { c.Type = TrailingStopConditionalOrder; c.TrailingPercent = 100 }, errInvalidTrailingOffset},
+		{"oco trigger", func(c *ConditionalOrder) { c.TriggerPrice = 0 }, errInvalidTriggerPrice},
+		{"oco take profit", func(c *ConditionalOrder) { c.TakeProfitPrice = 0 }, errInvalidTakeProfitPrice},
+		{"oco limit", func(c *ConditionalOrder) { c.LimitPrice = -1 }, errInvalidLimitPrice},
+		{"bracket entry", func(c *ConditionalOrder) { c.Type = BracketConditionalOrder; c.EntryPrice = -1 }, errInvalidEntryPrice},
+		{"oco wrong side", func(c *ConditionalOrder) { c.Side = order.Buy }, errTakeProfitOnWrongSide},
+		{"bracket wrong side", func(c *ConditionalOrder) { c.Type = BracketConditionalOrder }, errTakeProfitOnWrongSide},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			c := valid
+			tc.modify(&c)
+			assert.ErrorIs(t, c.validate(), tc.err)
+		})
+	}
+
+	bracket := valid
+	bracket.Type = BracketConditionalOrder
+	bracket.Side = order.Buy
+	assert.NoError(t, bracket.validate(), "a buy bracket should exit with a sell take profit above the stop")
+}
+
+func TestConditionalOrderEvaluate(t *testing.T) {
+	t.Parallel()
+	c := &ConditionalOrder{Type: StopMarketConditionalOrder, Side: order.Sell, Amount: 1, TriggerPrice: 100}
+	assert.Nil(t, c.evaluate(conditionalOrderPrice{}), "evaluate should ignore an update without prices")
+	assert.Nil(t, c.evaluate(conditionalOrderPrice{Bid: 101, Ask: 99}))
+	s := c.evaluate(conditionalOrderPrice{Bid: 100, Ask: 102})
+	require.NotNil(t, s, "a sell stop must trigger when the bid reaches the trigger price")
+	assert.Equal(t, order.Market, s.Type)
+	assert.Equal(t, order.Sell, s.Side)
+
+	c = &ConditionalOrder{Type: StopLimitConditionalOrder, Side: order.Buy, Amount: 1, TriggerPrice: 100, LimitPrice: 101}
+	assert.Nil(t, c.evaluate(conditionalOrderPrice{Bid: 100, Ask: 99}))
+	s = c.evaluate(conditionalOrderPrice{Last: 100})
+	require.NotNil(t, s, "a buy stop must trigger from the last price when no ask is available")
+	assert.Equal(t, order.Limit, s.Type)
+	assert.Equal(t, 101.0, s.Price)
+
+	c = &ConditionalOrder{Type: TrailingStopConditionalOrder, Side: order.Sell, Amount: 1, TrailingAmount: 10}
+	assert.Nil(t, c.evaluate(conditionalOrderPrice{Bid: 100}))
+	assert.Nil(t, c.evaluate(conditionalOrderPrice{Bid: 120}))
+	assert.Equal(t, 120.0, c.ReferencePrice, "the reference price should follow the highest bid")
+	assert.Nil(t, c.evaluate(conditionalOrderPrice{Bid: 111}))
+	assert.Equal(t, 120.0, c.ReferencePrice, "the reference price should not follow the price down")
+	require.NotNil(t, c.evaluate(conditionalOrderPrice{Bid: 110}))
+
+	c = &ConditionalOrder{Type: TrailingStopConditionalOrder, Side: order.Buy, Amount: 1, TrailingPercent: 10}
+	assert.Nil(t, c.evaluate(conditionalOrderPrice{Ask: 100}))
+	assert.Nil(t, c.evaluate(conditionalOrderPrice{Ask: 80}))
+	assert.Nil(t, c.evaluate(conditionalOrderPrice{Ask: 87}))
+	require.NotNil(t, c.evaluate(conditionalOrderPrice{Ask: 88}), "a buy trailing stop must trigger 10% above the lowest ask")
+
+	c = &ConditionalOrder{Type: OneCancelsOtherConditionalOrder, Side: order.Sell, Amount: 1, TriggerPrice: 90, TakeProfitPrice: 110}
+	assert.Nil(t, c.evaluate(conditionalOrderPrice{Bid: 100}))
+	s = c.evaluate(conditionalOrderPrice{Bid: 111})
+	require.NotNil(t, s)
+	assert.Equal(t, order.Limit, s.Type, "the take profit leg should submit a limit order")
+	assert.Equal(t, 110.0, s.Price)
+	s = c.evaluate(conditionalOrderPrice{Bid: 89})
+	require.NotNil(t, s)
+	assert.Equal(t, order.Market, s.Type, "the stop leg should submit a market order without a limit price")
+	c.LimitPrice = 88
+	s = c.evaluate(conditionalOrderPrice{Bid: 89})
+	require.NotNil(t, s)
+	assert.Equal(t, order.Limit, s.Type, "the stop leg should submit a limit order with a limit price")
+
+	c = &ConditionalOrder{Type: BracketConditionalOrder, Side: order.Sell, Asset: asset.Futures, Amount: 1, TriggerPrice: 110, TakeProfitPrice: 90}
+	s = c.evaluate(conditionalOrderPrice{Bid: 100, Ask: 111})
+	require.NotNil(t, s, "a short bracket must exit with a buy stop")
+	assert.Equal(t, order.Buy, s.Side)
+	assert.True(t, s.ReduceOnly, "futures bracket exits should be reduce only")
+}
+
+func TestAddConditionalOrder(t *testing.T) {
+	t.Parallel()
+	var m *OrderManager
+	_, err := m.AddConditionalOrder(t.Context(), nil)
+	assert.ErrorIs(t, err, ErrNilSubsystem)
+
+	m, submitted := conditionalOrdersSetup(t)
+	_, err = m.AddConditionalOrder(t.Context(), nil)
+	assert.ErrorIs(t, err, common.ErrNilPointer)
+
+	_, err = m.AddConditionalOrder(t.Context(), &ConditionalOrder{Exchange: "fake"})
+	assert.ErrorIs(t, err, ErrExchangeNotFound)
+
+	_, err = m.AddConditionalOrder(t.Context(), &ConditionalOrder{Exchange: testExchange})
+	assert.ErrorIs(t, err, currency.ErrCurrencyPairEmpty)
+
+	db := &fakeConditionalOrderDB{}
+	m.conditionalOrders.db = db
+	c := &ConditionalOrder{
+		Exchange:     "bitstamp",
+		Pair:         btcusdPair,
+		Asset:        asset.Spot,
+		Type:         StopMarketConditionalOrder,
+		Side:         order.Sell,
+		Amount:       1,
+		TriggerPrice: 100,
+		Status:       ConditionalOrderTriggered,
+		OrderID:      "1337",
+	}
+	resp, err := m.AddConditionalOrder(t.Context(), c)
+	require.NoError(t, err)
+	assert.False(t, resp.ID.IsNil(), "AddConditionalOrder should assign an ID")
+	assert.Equal(t, testExchange, resp.Exchange, "AddConditionalOrder should use the exchange's name")
+	assert.Equal(t, ConditionalOrderActive, resp.Status)
+	assert.Empty(t, resp.OrderID)
+	assert.Empty(t, *submitted, "stop orders should not be submitted until triggered")
+	_, ok := db.get(resp.ID)
+	assert.True(t, ok, "AddConditionalOrder should persist the conditional order")
+
+	bracket := &ConditionalOrder{
+		Exchange:        testExchange,
+		Pair:            btcusdPair,
+		Asset:           asset.Spot,
+		Type:            BracketConditionalOrder,
+		Side:            order.Buy,
+		Amount:          1,
+		EntryPrice:      100,
+		TriggerPrice:    90,
+		TakeProfitPrice: 120,
+	}
+	resp, err = m.AddConditionalOrder(t.Context(), bracket)
+	require.NoError(t, err)
+	assert.Equal(t, ConditionalOrderPendingEntry, resp.Status)
+	assert.Equal(t, "conditionalLIMIT", resp.EntryOrderID)
+	require.Len(t, *submitted, 1)
+	assert.Equal(t, order.Buy, (*submitted)[0].Side)
+	assert.Equal(t, 100.0, (*submitted)[0].Price)
+
+	m.conditionalOrders.submitter = func(context.Context, *order.Submit) (*OrderSubmitResponse, error) {
+		return nil, errTestConditionalSubmit
+	}
+	_, err = m.AddConditionalOrder(t.Context(), bracket)
+	assert.ErrorIs(t, err, errTestConditionalSubmit)
+
+	m.started = 0
+	_, err = m.AddConditionalOrder(t.Context(), c)
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted)
+}
+
+func TestGetConditionalOrders(t *testing.T) {
+	t.Parallel()
+	var m *OrderManager
+	_, err := m.GetConditionalOrders("", false)
+	assert.ErrorIs(t, err, ErrNilSubsystem)
+
+	m = OrdersSetup(t)
+	m.conditionalOrders.orders = []*ConditionalOrder{
+		{Exchange: testExchange, Status: ConditionalOrderActive},
+		{Exchange: testExchange, Status: ConditionalOrderCancelled},
+		{Exchange: "binance", Status: ConditionalOrderPendingEntry},
+	}
+	resp, err := m.GetConditionalOrders("", false)
+	require.NoError(t, err)
+	assert.Len(t, resp, 3)
+
+	resp, err = m.GetConditionalOrders("", true)
+	require.NoError(t, err)
+	assert.Len(t, resp, 2)
+
+	resp, err = m.GetConditionalOrders("BITSTAMP", false)
+	require.NoError(t, err)
+	assert.Len(t, resp, 2)
+
+	m.started = 0
+	_, err = m.GetConditionalOrders("", false)
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted)
+}
+
+func TestCancelConditionalOrder(t *testing.T) {
+	t.Parallel()
+	var m *OrderManager
+	_, err := m.CancelConditionalOrder(t.Context(), uuid.Nil)
+	assert.ErrorIs(t, err, ErrNilSubsystem)
+
+	m, _ = conditionalOrdersSetup(t)
+	db := &fakeConditionalOrderDB{}
+	m.conditionalOrders.db = db
+	_, err = m.CancelConditionalOrder(t.Context(), uuid.Nil)
+	assert.ErrorIs(t, err, ErrConditionalOrderNotFound)
+
+	resp, err := m.AddConditionalOrder(t.Context(), &ConditionalOrder{
+		Exchange:       testExchange,
+		Pair:           btcusdPair,
+		Asset:          asset.Spot,
+		Type:           TrailingStopConditionalOrder,
+		Side:           order.Sell,
+		Amount:         1,
+		TrailingAmount: 10,
+	})
+	require.NoError(t, err)
+	resp, err = m.CancelConditionalOrder(t.Context(), resp.ID)
+	require.NoError(t, err)
+	assert.Equal(t, ConditionalOrderCancelled, resp.Status)
+	r, ok := db.get(resp.ID)
+	require.True(t, ok)
+	assert.Equal(t, string(ConditionalOrderCancelled), r.Status, "CancelConditionalOrder should persist the cancellation")
+
+	_, err = m.CancelConditionalOrder(t.Context(), resp.ID)
+	assert.ErrorIs(t, err, errConditionalOrderNotCancelable)
+
+	resp, err = m.AddConditionalOrder(t.Context(), &ConditionalOrder{
+		Exchange:        testExchange,
+		Pair:            btcusdPair,
+		Asset:           asset.Spot,
+		Type:            BracketConditionalOrder,
+		Side:            order.Buy,
+		Amount:          1,
+		TriggerPrice:    90,
+		TakeProfitPrice: 120,
+	})
+	require.NoError(t, err)
+	_, err = m.CancelConditionalOrder(t.Context(), resp.ID)
+	assert.ErrorIs(t, err, ErrExchangeNotFound, "CancelConditionalOrder should error when the entry order cannot be cancelled")
+
+	require.NoError(t, m.orderStore.add(&order.Detail{
+		Exchange:  testExchange,
+		OrderID:   resp.EntryOrderID,
+		Pair:      btcusdPair,
+		AssetType: asset.Spot,
+		Status:    order.New,
+	}))
+	resp, err = m.CancelConditionalOrder(t.Context(), resp.ID)
+	require.NoError(t, err)
+	assert.Equal(t, ConditionalOrderCancelled, resp.Status)
+	entry, err := m.orderStore.getByExchangeAndID(testExchange, resp.EntryOrderID)
+	require.NoError(t, err)
+	assert.Equal(t, order.Cancelled, entry.Status, "CancelConditionalOrder should cancel the bracket entry order")
+
+	m.started = 0
+	_, err = m.CancelConditionalOrder(t.Context(), resp.ID)
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted)
+}
+
+func TestProcessConditionalOrderPrice(t *testing.T) {
+	t.Parallel()
+	m, submitted := conditionalOrdersSetup(t)
+	db := &fakeConditionalOrderDB{}
+	m.conditionalOrders.db = db
+
+	trailing, err := m.AddConditionalOrder(t.Context(), &ConditionalOrder{
+		Exchange:        testExchange,
+		Pair:            btcusdPair,
+		Asset:           asset.Spot,
+		Type:            TrailingStopConditionalOrder,
+		Side:            order.Sell,
+		Amount:          1,
+		TrailingPercent: 10,
+	})
+	require.NoError(t, err)
+	bracket, err := m.AddConditionalOrder(t.Context(), &ConditionalOrder{
+		Exchange:        testExchange,
+		Pair:            btcusdPair,
+		Asset:           asset.Spot,
+		Type:            BracketConditionalOrder,
+		Side:            order.Buy,
+		Amount:          1,
+		TriggerPrice:    90,
+		TakeProfitPrice: 120,
+	})
+	require.NoError(t, err)
+	require.Len(t, *submitted, 1, "the bracket entry order must be submitted")
+
+	m.processConditionalOrderPrice(testExchange, btcusdPair, asset.Margin, conditionalOrderPrice{Bid: 200})
+	m.processConditionalOrderPrice("binance", btcusdPair, asset.Spot, conditionalOrderPrice{Bid: 200})
+	orders, err := m.GetConditionalOrders("", true)
+	require.NoError(t, err)
+	for i := range orders {
+		assert.Zero(t, orders[i].ReferencePrice, "updates for other assets and exchanges should be ignored")
+	}
+
+	m.processConditionalOrderPrice("BITSTAMP", btcusdPair, asset.Spot, conditionalOrderPrice{Bid: 130, Ask: 131})
+	orders, err = m.GetConditionalOrders("", true)
+	require.NoError(t, err)
+	require.Len(t, orders, 2)
+	assert.Equal(t, 130.0, orders[0].ReferencePrice)
+	assert.Equal(t, ConditionalOrderPendingEntry, orders[1].Status, "a bracket should not trigger before its entry is filled")
+	assert.Len(t, *submitted, 1)
+	assert.True(t, m.conditionalOrders.dirty[trailing.ID], "trailing stop reference updates should be flagged for persistence")
+
+	m.processConditionalOrders()
+	r, ok := db.get(trailing.ID)
+	require.True(t, ok)
+	assert.Equal(t, 130.0, r.ReferencePrice, "processConditionalOrders should persist trailing stop updates")
+	assert.Empty(t, m.conditionalOrders.dirty)
+
+	require.NoError(t, m.orderStore.add(&order.Detail{
+		Exchange:  testExchange,
+		OrderID:   bracket.EntryOrderID,
+		Pair:      btcusdPair,
+		AssetType: asset.Spot,
+		Status:    order.Filled,
+	}))
+	m.processConditionalOrderPrice(testExchange, btcusdPair, asset.Spot, conditionalOrderPrice{Bid: 117, Ask: 118})
+	orders, err = m.GetConditionalOrders("", false)
+	require.NoError(t, err)
+	assert.Equal(t, ConditionalOrderTriggered, orders[0].Status, "the trailing stop should trigger 10% below the highest bid")
+	assert.Equal(t, "conditionalMARKET", orders[0].OrderID)
+	assert.Equal(t, ConditionalOrderActive, orders[1].Status, "a bracket should activate once its entry is filled")
+	require.Len(t, *submitted, 2)
+	assert.Equal(t, order.Sell, (*submitted)[1].Side)
+
+	m.conditionalOrders.submitter = func(context.Context, *order.Submit) (*OrderSubmitResponse, error) {
+		return nil, errTestConditionalSubmit
+	}
+	m.processConditionalOrderPrice(testExchange, btcusdPair, asset.Spot, conditionalOrderPrice{Bid: 120})
+	orders, err = m.GetConditionalOrders("", false)
+	require.NoError(t, err)
+	assert.Equal(t, ConditionalOrderFailed, orders[1].Status)
+	assert.Equal(t, errTestConditionalSubmit.Error(), orders[1].FailureReason)
+	r, ok = db.get(bracket.ID)
+	require.True(t, ok)
+	assert.Equal(t, string(ConditionalOrderFailed), r.Status, "failed submissions should be persisted")
+}
+
+func TestCheckBracketEntry(t *testing.T) {
+	t.Parallel()
+	m := OrdersSetup(t)
+	c := &ConditionalOrder{Exchange: testExchange, EntryOrderID: "entry", Status: ConditionalOrderPendingEntry}
+	assert.False(t, m.checkBracketEntry(c), "checkBracketEntry should wait for an unknown entry order")
+
+	entry := &order.Detail{Exchange: testExchange, OrderID: "entry", Status: order.PartiallyFilled}
+	require.NoError(t, m.orderStore.add(entry))
+	assert.False(t, m.checkBracketEntry(c), "checkBracketEntry should wait for a partially filled entry order")
+
+	entry.Status = order.Rejected
+	require.NoError(t, m.orderStore.updateExisting(entry))
+	assert.True(t, m.checkBracketEntry(c))
+	assert.Equal(t, ConditionalOrderFailed, c.Status)
+	assert.Contains(t, c.FailureReason, errEntryOrderNotFilled.Error())
+}
+
+func TestProcessConditionalOrderUpdate(t *testing.T) {
+	t.Parallel()
+	m, submitted := conditionalOrdersSetup(t)
+	err := m.processConditionalOrderUpdate("moon")
+	assert.ErrorIs(t, err, common.ErrTypeAssertFailure)
+
+	cp := currency.NewPair(currency.LTC, currency.XRP)
+	m.conditionalOrders.orders = []*ConditionalOrder{{
+		Exchange:     testExchange,
+		Pair:         cp,
+		Asset:        asset.Spot,
+		Type:         StopMarketConditionalOrder,
+		Side:         order.Buy,
+		Amount:       1,
+		TriggerPrice: 100,
+		Status:       ConditionalOrderActive,
+	}}
+	require.NoError(t, m.processConditionalOrderUpdate(&ticker.Price{ExchangeName: testExchange, Pair: cp, AssetType: asset.Spot, Bid: 98, Ask: 99}))
+	assert.Empty(t, *submitted)
+
+	d, err := orderbook.DeployDepth(testExchange, cp, asset.Spot)
+	require.NoError(t, err)
+	require.NoError(t, d.LoadSnapshot(&orderbook.Book{
+		Exchange:    testExchange,
+		Pair:        cp,
+		Asset:       asset.Spot,
+		Asks:        orderbook.Levels{{Price: 100, Amount: 1}},
+		LastUpdated: time.Now(),
+	}))
+	require.NoError(t, m.processConditionalOrderUpdate(d))
+	require.Len(t, *submitted, 1, "the buy stop must trigger from the best ask")
+	assert.Equal(t, order.Market, (*submitted)[0].Type)
+}
+
+func TestSubscribeConditionalOrderFeeds(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, dispatch.EnsureRunning(dispatch.DefaultMaxWorkers, dispatch.DefaultJobsLimit))
+	m, submitted := conditionalOrdersSetup(t)
+	cp := currency.NewPair(currency.DOGE, currency.XRP)
+	_, err := m.AddConditionalOrder(t.Context(), &ConditionalOrder{
+		Exchange:     testExchange,
+		Pair:         cp,
+		Asset:        asset.Spot,
+		Type:         StopMarketConditionalOrder,
+		Side:         order.Sell,
+		Amount:       1,
+		TriggerPrice: 100,
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, ticker.ProcessTicker(&ticker.Price{ExchangeName: testExchange, Pair: cp, AssetType: asset.Spot, Bid: 101, Ask: 102}))
+	m.subscribeConditionalOrderFeeds()
+	m.conditionalOrders.m.Lock()
+	require.Contains(t, m.conditionalOrders.feeds, "bitstamp")
+	assert.True(t, m.conditionalOrders.feeds["bitstamp"].ticker, "subscribeConditionalOrderFeeds should subscribe to exchange tickers")
+	m.conditionalOrders.m.Unlock()
+
+	require.NoError(t, ticker.ProcessTicker(&ticker.Price{ExchangeName: testExchange, Pair: cp, AssetType: asset.Spot, Bid: 99, Ask: 102}))
+	assert.Eventually(t, func() bool {
+		orders, err := m.GetConditionalOrders(testExchange, false)
+		return err == nil && len(orders) == 1 && orders[0].Status == ConditionalOrderTriggered
+	}, time.Second*5, time.Millisecond*10, "ticker updates should trigger conditional orders")
+	assert.Len(t, *submitted, 1)
+
+	close(m.shutdown)
+	m.orderStore.wg.Wait()
+	m.conditionalOrders.m.Lock()
+	assert.False(t, m.conditionalOrders.feeds["bitstamp"].ticker, "feeds should be released on shutdown")
+	m.conditionalOrders.m.Unlock()
+}
+
+func TestLoadConditionalOrders(t *testing.T) {
+	t.Parallel()
+	var m *OrderManager
+	assert.ErrorIs(t, m.SetConditionalOrderDatabase(nil), ErrNilSubsystem)
+
+	m = OrdersSetup(t)
+	assert.ErrorIs(t, m.SetConditionalOrderDatabase(nil), database.ErrNilInstance)
+
+	active := &ConditionalOrder{
+		ID:             uuid.Must(uuid.NewV4()),
+		Exchange:       "bitstamp",
+		Pair:           btcusdPair,
+		Asset:          asset.Spot,
+		Type:           TrailingStopConditionalOrder,
+		Side:           order.Sell,
+		Amount:         1,
+		TrailingAmount: 10,
+		ReferencePrice: 1337,
+		Status:         ConditionalOrderActive,
+		CreatedAt:      time.Now(),
+	}
+	cancelled := *active
+	cancelled.ID = uuid.Must(uuid.NewV4())
+	cancelled.Status = ConditionalOrderCancelled
+	db := &fakeConditionalOrderDB{}
+	require.NoError(t, db.Upsert(active.toRecord(), cancelled.toRecord()))
+
+	require.NoError(t, m.loadConditionalOrders(db))
+	require.NoError(t, m.loadConditionalOrders(db), "loadConditionalOrders must not duplicate loaded orders")
+	orders, err := m.GetConditionalOrders("", false)
+	require.NoError(t, err)
+	require.Len(t, orders, 1, "only active conditional orders should be loaded")
+	assert.Equal(t, active.ID, orders[0].ID)
+	assert.Equal(t, 1337.0, orders[0].ReferencePrice, "the trailing reference price should survive a restart")
+	assert.True(t, orders[0].Pair.Equal(btcusdPair))
+
+	_, err = conditionalOrderFromRecord(&conditionalorder.ConditionalOrder{ID: "moon"})
+	assert.Error(t, err, "conditionalOrderFromRecord should error on an invalid ID")
+}
@@ -0,0 +1,122 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/conditionalorder"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+// ConditionalOrderType defines the trigger behaviour of a conditional order
+// hosted by the order manager
+type ConditionalOrderType string
+
+// ConditionalOrderStatus defines the state of a conditional order
+type ConditionalOrderStatus string
+
+// Conditional order types
+const (
+	// StopMarketConditionalOrder submits a market order once the trigger price
+	// is crossed
+	StopMarketConditionalOrder ConditionalOrderType = "STOP_MARKET"
+	// StopLimitConditionalOrder submits a limit order at the limit price once
+	// the trigger price is crossed
+	StopLimitConditionalOrder ConditionalOrderType = "STOP_LIMIT"
+	// TrailingStopConditionalOrder submits a market order once the price
+	// retraces from its most favourable point by a fixed amount or percentage
+	TrailingStopConditionalOrder ConditionalOrderType = "TRAILING_STOP"
+	// OneCancelsOtherConditionalOrder pairs a stop with a take profit. The
+	// first leg to trigger is submitted and the other is discarded
+	OneCancelsOtherConditionalOrder ConditionalOrderType = "OCO"
+	// BracketConditionalOrder submits an entry order and, once it is filled,
+	// protects the position with a one-cancels-other stop and take profit
+	BracketConditionalOrder ConditionalOrderType = "BRACKET"
+)
+
+// Conditional order statuses
+const (
+	ConditionalOrderActive       ConditionalOrderStatus = "ACTIVE"
+	ConditionalOrderPendingEntry ConditionalOrderStatus = "PENDING_ENTRY"
+	ConditionalOrderTriggered    ConditionalOrderStatus = "TRIGGERED"
+	ConditionalOrderCancelled    ConditionalOrderStatus = "CANCELLED"
+	ConditionalOrderFailed       ConditionalOrderStatus = "FAILED"
+)
+
+// Public conditional order errors
+var (
+	ErrConditionalOrderNotFound = errors.New("conditional order not found")
+)
+
+var (
+	errUnknownConditionalOrderType   = errors.New("unknown conditional order type")
+	errInvalidTriggerPrice           = errors.New("trigger price must be greater than zero")
+	errInvalidLimitPrice             = errors.New("limit price must be greater than zero")
+	errInvalidTakeProfitPrice        = errors.New("take profit price must be greater than zero")
+	errInvalidEntryPrice             = errors.New("entry price cannot be negative")
+	errInvalidTrailingOffset         = errors.New("trailing stop requires either a trailing amount or a trailing percentage between 0 and 100")
+	errTakeProfitOnWrongSide         = errors.New("take profit price is on the wrong side of the stop trigger price")
+	errConditionalOrderNotCancelable = errors.New("conditional order is no longer active")
+	errEntryOrderNotFilled           = errors.New("bracket entry order was not filled")
+)
+
+// ConditionalOrder is a client side order hosted by the order manager which
+// is submitted to the exchange once its conditions are met by ticker or
+// orderbook updates. Side is the side of the order submitted when triggered,
+// except for bracket orders where it is the side of the entry order and the
+// stop and take profit legs use the opposing side
+type ConditionalOrder struct {
+	ID              uuid.UUID
+	Exchange        string
+	Pair            currency.Pair
+	Asset           asset.Item
+	Type            ConditionalOrderType
+	Side            order.Side
+	Amount          float64
+	TriggerPrice    float64
+	LimitPrice      float64
+	TakeProfitPrice float64
+	EntryPrice      float64
+	TrailingAmount  float64
+	TrailingPercent float64
+	// ReferencePrice is the most favourable price seen by a trailing stop
+	ReferencePrice float64
+	EntryOrderID   string
+	OrderID        string
+	Status         ConditionalOrderStatus
+	FailureReason  string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// conditionalOrderStore holds all conditional orders and the dispatch
+// subscriptions used to trigger them
+type conditionalOrderStore struct {
+	m      sync.Mutex
+	orders []*ConditionalOrder
+	db     conditionalorder.IDBService
+	// feeds tracks which exchanges have ticker and orderbook subscriptions
+	feeds map[string]*conditionalOrderFeed
+	// dirty tracks conditional orders with updates yet to be persisted
+	dirty     map[uuid.UUID]bool
+	submitter func(context.Context, *order.Submit) (*OrderSubmitResponse, error)
+}
+
+type conditionalOrderFeed struct {
+	ticker    bool
+	orderbook bool
+}
+
+// conditionalOrderPrice holds the prices used to evaluate conditional orders.
+// Sell orders are triggered by the bid and buy orders by the ask, falling
+// back to the last price when unavailable
+type conditionalOrderPrice struct {
+	Bid  float64
+	Ask  float64
+	Last float64
+}
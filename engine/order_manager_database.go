@@ -0,0 +1,278 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	dborder "github.com/thrasher-corp/gocryptotrader/database/repository/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/margin"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// rehydratedOrderStatuses are the order statuses loaded into the order store
+// on startup so they continue to be tracked
+var rehydratedOrderStatuses = []order.Status{
+	order.New,
+	order.Active,
+	order.Open,
+	order.PartiallyFilled,
+	order.PendingCancel,
+	order.Hidden,
+	order.AutoDeleverage,
+	order.Pending,
+}
+
+// SetOrderDatabase enables persistence of all managed orders and their fills
+// and loads any orders which were active when the order manager was last
+// stopped
+func (m *OrderManager) SetOrderDatabase(db database.IDatabase) error {
+	if m == nil {
+		return fmt.Errorf("order manager %w", ErrNilSubsystem)
+	}
+	dbService, err := dborder.Setup(db)
+	if err != nil {
+		return err
+	}
+	return m.loadOrders(dbService)
+}
+
+// loadOrders sets the order database service and adds all active orders from
+// it to the order store
+func (m *OrderManager) loadOrders(db dborder.IDBService) error {
+	statuses := make([]string, len(rehydratedOrderStatuses))
+	for i := range rehydratedOrderStatuses {
+		statuses[i] = rehydratedOrderStatuses[i].String()
+	}
+	records, err := db.GetByStatus(statuses...)
+	if err != nil {
+		return err
+	}
+	m.orderStore.dbMtx.Lock()
+	m.orderStore.db = db
+	m.orderStore.dbMtx.Unlock()
+	var loaded int
+	for i := range records {
+		od, err := orderFromRecord(&records[i])
+		if err != nil {
+			log.Errorf(log.OrderMgr, "Unable to load %v order %v from database: %v", records[i].ExchangeName, records[i].OrderID, err)
+			continue
+		}
+		exch, err := m.orderStore.exchangeManager.GetExchangeByName(od.Exchange)
+		if err != nil {
+			log.Errorf(log.OrderMgr, "Unable to load %v order %v from database: %v", od.Exchange, od.OrderID, err)
+			continue
+		}
+		// Exchange names are stored in lower case
+		od.Exchange = exch.GetName()
+		err = m.orderStore.add(od)
+		if err != nil {
+			if !errors.Is(err, ErrOrdersAlreadyExists) {
+				log.Errorf(log.OrderMgr, "Unable to load %v order %v from database: %v", od.Exchange, od.OrderID, err)
+			}
+			continue
+		}
+		loaded++
+	}
+	if loaded > 0 {
+		log.Infof(log.OrderMgr, "Loaded %d active orders from database", loaded)
+	}
+	return nil
+}
+
+// persistOrder saves the current state of an order in the order store to the
+// database when one is set. Failures are logged as the order is still tracked
+// in memory
+func (m *OrderManager) persistOrder(exchangeName, orderID string) {
+	m.orderStore.dbMtx.Lock()
+	defer m.orderStore.dbMtx.Unlock()
+	if m.orderStore.db == nil {
+		return
+	}
+	od, err := m.orderStore.getByExchangeAndID(exchangeName, orderID)
+	if err != nil {
+		log.Errorf(log.OrderMgr, "Unable to persist %v order %v: %v", exchangeName, orderID, err)
+		return
+	}
+	err = m.orderStore.db.Upsert(orderToRecord(od))
+	if err != nil {
+		log.Errorf(log.OrderMgr, "Unable to persist %v order %v: %v", exchangeName, orderID, err)
+	}
+}
+
+// GetOrderHistory returns all persisted orders for an exchange, asset and pair
+// including those no longer tracked by the order store
+func (m *OrderManager) GetOrderHistory(exchangeName string, a asset.Item, pair currency.Pair, start, end time.Time) ([]order.Detail, error) {
+	if m == nil {
+		return nil, fmt.Errorf("order manager %w", ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&m.started) == 0 {
+		return nil, fmt.Errorf("order manager %w", ErrSubSystemNotStarted)
+	}
+	m.orderStore.dbMtx.Lock()
+	db := m.orderStore.db
+	m.orderStore.dbMtx.Unlock()
+	if db == nil {
+		return nil, errOrderDatabaseNotSet
+	}
+	records, err := db.GetByExchangePair(exchangeName, pair.Base.String(), pair.Quote.String(), a.String(), start, end)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]order.Detail, 0, len(records))
+	for i := range records {
+		od, err := orderFromRecord(&records[i])
+		if err != nil {
+			return nil, err
+		}
+		// Exchange names are stored in lower case
+		od.Exchange = exchangeName
+		resp = append(resp, *od)
+	}
+	return resp, nil
+}
+
+func orderToRecord(od *order.Detail) *dborder.Details {
+	r := &dborder.Details{
+		ID:                   od.InternalOrderID.String(),
+		ExchangeName:         od.Exchange,
+		OrderID:              od.OrderID,
+		ClientOrderID:        od.ClientOrderID,
+		ClientID:             od.ClientID,
+		AccountID:            od.AccountID,
+		Base:                 od.Pair.Base.String(),
+		Quote:                od.Pair.Quote.String(),
+		Asset:                od.AssetType.String(),
+		Type:                 od.Type.String(),
+		Side:                 od.Side.String(),
+		Status:               od.Status.String(),
+		TimeInForce:          od.TimeInForce.String(),
+		HiddenOrder:          od.HiddenOrder,
+		ReduceOnly:           od.ReduceOnly,
+		Leverage:             od.Leverage,
+		Price:                od.Price,
+		Amount:               od.Amount,
+		ContractAmount:       od.ContractAmount,
+		QuoteAmount:          od.QuoteAmount,
+		LimitPriceUpper:      od.LimitPriceUpper,
+		LimitPriceLower:      od.LimitPriceLower,
+		TriggerPrice:         od.TriggerPrice,
+		AverageExecutedPrice: od.AverageExecutedPrice,
+		ExecutedAmount:       od.ExecutedAmount,
+		RemainingAmount:      od.RemainingAmount,
+		Cost:                 od.Cost,
+		CostAsset:            od.CostAsset.String(),
+		Fee:                  od.Fee,
+		FeeAsset:             od.FeeAsset.String(),
+		SettlementCurrency:   od.SettlementCurrency.String(),
+		Date:                 od.Date,
+		CloseTime:            od.CloseTime,
+		LastUpdated:          od.LastUpdated,
+	}
+	if od.MarginType != margin.Unset {
+		r.MarginType = od.MarginType.String()
+	}
+	if len(od.Trades) > 0 {
+		r.Trades = make([]dborder.Trade, len(od.Trades))
+		for i := range od.Trades {
+			r.Trades[i] = dborder.Trade{
+				TID:         od.Trades[i].TID,
+				Price:       od.Trades[i].Price,
+				Amount:      od.Trades[i].Amount,
+				Fee:         od.Trades[i].Fee,
+				FeeAsset:    od.Trades[i].FeeAsset,
+				Total:       od.Trades[i].Total,
+				Type:        od.Trades[i].Type.String(),
+				Side:        od.Trades[i].Side.String(),
+				Description: od.Trades[i].Description,
+				IsMaker:     od.Trades[i].IsMaker,
+				Timestamp:   od.Trades[i].Timestamp,
+			}
+		}
+	}
+	return r
+}
+
+// orderFromRecord converts a database record to an order. Order types, sides
+// and statuses which cannot be parsed are left unknown so the order is still
+// available
+func orderFromRecord(r *dborder.Details) (*order.Detail, error) {
+	id, err := uuid.FromString(r.ID)
+	if err != nil {
+		return nil, err
+	}
+	a, err := asset.New(r.Asset)
+	if err != nil {
+		return nil, err
+	}
+	pair, err := currency.NewPairFromStrings(r.Base, r.Quote)
+	if err != nil {
+		return nil, err
+	}
+	od := &order.Detail{
+		HiddenOrder:          r.HiddenOrder,
+		ReduceOnly:           r.ReduceOnly,
+		Leverage:             r.Leverage,
+		Price:                r.Price,
+		Amount:               r.Amount,
+		ContractAmount:       r.ContractAmount,
+		LimitPriceUpper:      r.LimitPriceUpper,
+		LimitPriceLower:      r.LimitPriceLower,
+		TriggerPrice:         r.TriggerPrice,
+		AverageExecutedPrice: r.AverageExecutedPrice,
+		QuoteAmount:          r.QuoteAmount,
+		ExecutedAmount:       r.ExecutedAmount,
+		RemainingAmount:      r.RemainingAmount,
+		Cost:                 r.Cost,
+		CostAsset:            currency.NewCode(r.CostAsset),
+		Fee:                  r.Fee,
+		FeeAsset:             currency.NewCode(r.FeeAsset),
+		Exchange:             r.ExchangeName,
+		InternalOrderID:      id,
+		OrderID:              r.OrderID,
+		ClientOrderID:        r.ClientOrderID,
+		AccountID:            r.AccountID,
+		ClientID:             r.ClientID,
+		AssetType:            a,
+		Date:                 r.Date,
+		CloseTime:            r.CloseTime,
+		LastUpdated:          r.LastUpdated,
+		Pair:                 pair,
+		SettlementCurrency:   currency.NewCode(r.SettlementCurrency),
+	}
+	od.Type, _ = order.StringToOrderType(r.Type)
+	od.Side, _ = order.StringToOrderSide(r.Side)
+	od.Status, _ = order.StringToOrderStatus(r.Status)
+	od.MarginType, _ = margin.StringToMarginType(r.MarginType)
+	for tif := range strings.SplitSeq(r.TimeInForce, ",") {
+		t, _ := order.StringToTimeInForce(tif)
+		od.TimeInForce |= t
+	}
+	if len(r.Trades) > 0 {
+		od.Trades = make([]order.TradeHistory, len(r.Trades))
+		for i := range r.Trades {
+			od.Trades[i] = order.TradeHistory{
+				Price:       r.Trades[i].Price,
+				Amount:      r.Trades[i].Amount,
+				Fee:         r.Trades[i].Fee,
+				Exchange:    r.ExchangeName,
+				TID:         r.Trades[i].TID,
+				Description: r.Trades[i].Description,
+				IsMaker:     r.Trades[i].IsMaker,
+				FeeAsset:    r.Trades[i].FeeAsset,
+				Total:       r.Trades[i].Total,
+				Timestamp:   r.Trades[i].Timestamp,
+			}
+			od.Trades[i].Type, _ = order.StringToOrderType(r.Trades[i].Type)
+			od.Trades[i].Side, _ = order.StringToOrderSide(r.Trades[i].Side)
+		}
+	}
+	return od, nil
+}
@@ -0,0 +1,311 @@
+package engine
+
+import (
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	dborder "github.com/thrasher-corp/gocryptotrader/database/repository/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/margin"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+// fakeOrderDB stores managed order records in memory
+type fakeOrderDB struct {
+	m       sync.Mutex
+	records map[string]dborder.Details
+}
+
+func (f *fakeOrderDB) Upsert(orders ...*dborder.Details) error {
+	f.m.Lock()
+	defer f.m.Unlock()
+	if f.records == nil {
+		f.records = make(map[string]dborder.Details)
+	}
+	for i := range orders {
+		r := *orders[i]
+		r.ExchangeName = strings.ToLower(r.ExchangeName)
+		f.records[r.ID] = r
+	}
+	return nil
+}
+
+func (f *fakeOrderDB) GetByStatus(statuses ...string) ([]dborder.Details, error) {
+	f.m.Lock()
+	defer f.m.Unlock()
+	var resp []dborder.Details
+	for _, r := range f.records {
+		for i := range statuses {
+			if r.Status == statuses[i] {
+				resp = append(resp, r)
+			}
+		}
+	}
+	return resp, nil
+}
+
+func (f *fakeOrderDB) GetByExchangePair(exchangeName, base, quote, a string, start, end time.Time) ([]dborder.Details, error) {
+	f.m.Lock()
+	defer f.m.Unlock()
+	var resp []dborder.Details
+	for _, r := range f.records {
+		if r.ExchangeName != strings.ToLower(exchangeName) ||
+			!strings.EqualFold(r.Base, base) ||
+			!strings.EqualFold(r.Quote, quote) ||
+			!strings.EqualFold(r.Asset, a) ||
+			(!start.IsZero() && r.Date.Before(start)) ||
+			(!end.IsZero() && r.Date.After(end)) {
+			continue
+		}
+		resp = append(resp, r)
+	}
+	return resp, nil
+}
+
+func (f *fakeOrderDB) getByOrderID(orderID string) (dborder.Details, bool) {
+	f.m.Lock()
+	defer f.m.Unlock()
+	for _, r := range f.records {
+		if r.OrderID == orderID {
+			return r, true
+		}
+	}
+	return dborder.Details{}, false
+}
+
+func TestSetOrderDatabase(t *testing.T) {
+	t.Parallel()
+	var m *OrderManager
+	assert.ErrorIs(t, m.SetOrderDatabase(nil), ErrNilSubsystem)
+
+	m = OrdersSetup(t)
+	assert.ErrorIs(t, m.SetOrderDatabase(nil), database.ErrNilInstance)
+}
+
+func TestLoadOrders(t *testing.T) {
+	t.Parallel()
+	m := OrdersSetup(t)
+	db := &fakeOrderDB{}
+	active := &order.Detail{
+		Exchange:        testExchange,
+		InternalOrderID: uuid.Must(uuid.NewV4()),
+		OrderID:         "active",
+		Pair:            btcusdPair,
+		AssetType:       asset.Spot,
+		Type:            order.Limit,
+		Side:            order.Buy,
+		Status:          order.PartiallyFilled,
+		Amount:          2,
+		ExecutedAmount:  1,
+		Date:            time.Now(),
+	}
+	filled := active.Copy()
+	filled.InternalOrderID = uuid.Must(uuid.NewV4())
+	filled.OrderID = "filled"
+	filled.Status = order.Filled
+	unknownExchange := active.Copy()
+	unknownExchange.InternalOrderID = uuid.Must(uuid.NewV4())
+	unknownExchange.OrderID = "unknown"
+	unknownExchange.Exchange = "bruh"
+	require.NoError(t, db.Upsert(orderToRecord(active), orderToRecord(&filled), orderToRecord(&unknownExchange)))
+
+	require.NoError(t, m.loadOrders(db))
+	require.NoError(t, m.loadOrders(db), "loadOrders must not error when orders are already loaded")
+
+	od, err := m.orderStore.getByExchangeAndID(testExchange, "active")
+	require.NoError(t, err, "active orders must be loaded into the order store")
+	assert.Equal(t, testExchange, od.Exchange, "loaded orders should use the exchange's name")
+	assert.Equal(t, active.InternalOrderID, od.InternalOrderID, "loaded orders should keep their internal ID")
+	assert.Equal(t, order.PartiallyFilled, od.Status)
+
+	_, err = m.orderStore.getByExchangeAndID(testExchange, "filled")
+	assert.ErrorIs(t, err, ErrOrderNotFound, "inactive orders should not be loaded")
+}
+
+func TestPersistOrder(t *testing.T) {
+	t.Parallel()
+	m := OrdersSetup(t)
+	m.persistOrder(testExchange, "1337")
+
+	db := &fakeOrderDB{}
+	require.NoError(t, m.loadOrders(db))
+	m.persistOrder(testExchange, "1337")
+	assert.Empty(t, db.records, "persistOrder should not save an order missing from the order store")
+
+	require.NoError(t, m.Add(&order.Detail{
+		Exchange:  testExchange,
+		OrderID:   "1337",
+		Pair:      btcusdPair,
+		AssetType: asset.Spot,
+		Status:    order.New,
+		Amount:    1,
+	}))
+	r, ok := db.getByOrderID("1337")
+	require.True(t, ok, "Add should persist the order")
+	assert.Equal(t, order.New.String(), r.Status)
+
+	require.NoError(t, m.UpdateExistingOrder(&order.Detail{
+		Exchange:       testExchange,
+		OrderID:        "1337",
+		Pair:           btcusdPair,
+		AssetType:      asset.Spot,
+		Status:         order.PartiallyFilled,
+		Amount:         1,
+		ExecutedAmount: 0.5,
+		Trades:         []order.TradeHistory{{TID: "1", Price: 100, Amount: 0.5, Side: order.Buy}},
+	}))
+	r, ok = db.getByOrderID("1337")
+	require.True(t, ok)
+	assert.Equal(t, order.PartiallyFilled.String(), r.Status, "UpdateExistingOrder should persist the order")
+	require.Len(t, r.Trades, 1, "UpdateExistingOrder should persist fills")
+
+	_, err := m.UpsertOrder(&order.Detail{
+		Exchange:       testExchange,
+		OrderID:        "1337",
+		Pair:           btcusdPair,
+		AssetType:      asset.Spot,
+		Status:         order.Filled,
+		Amount:         1,
+		ExecutedAmount: 1,
+	})
+	require.NoError(t, err)
+	r, ok = db.getByOrderID("1337")
+	require.True(t, ok)
+	assert.Equal(t, order.Filled.String(), r.Status, "UpsertOrder should persist the order")
+
+	require.NoError(t, m.Add(&order.Detail{
+		Exchange:  testExchange,
+		OrderID:   "cancel",
+		Pair:      btcusdPair,
+		AssetType: asset.Spot,
+		Status:    order.New,
+		Amount:    1,
+	}))
+	require.NoError(t, m.Cancel(t.Context(), &order.Cancel{
+		Exchange:  testExchange,
+		OrderID:   "cancel",
+		Pair:      btcusdPair,
+		AssetType: asset.Spot,
+	}))
+	r, ok = db.getByOrderID("cancel")
+	require.True(t, ok)
+	assert.Equal(t, order.Cancelled.String(), r.Status, "Cancel should persist the cancelled order")
+}
+
+func TestGetOrderHistory(t *testing.T) {
+	t.Parallel()
+	var m *OrderManager
+	_, err := m.GetOrderHistory(testExchange, asset.Spot, btcusdPair, time.Time{}, time.Time{})
+	assert.ErrorIs(t, err, ErrNilSubsystem)
+
+	m = OrdersSetup(t)
+	_, err = m.GetOrderHistory(testExchange, asset.Spot, btcusdPair, time.Time{}, time.Time{})
+	assert.ErrorIs(t, err, errOrderDatabaseNotSet)
+
+	db := &fakeOrderDB{}
+	require.NoError(t, m.loadOrders(db))
+	require.NoError(t, db.Upsert(orderToRecord(&order.Detail{
+		Exchange:        testExchange,
+		InternalOrderID: uuid.Must(uuid.NewV4()),
+		OrderID:         "old",
+		Pair:            btcusdPair,
+		AssetType:       asset.Spot,
+		Status:          order.Filled,
+		Date:            time.Now().Add(-time.Hour),
+	})))
+	resp, err := m.GetOrderHistory(testExchange, asset.Spot, btcusdPair, time.Time{}, time.Time{})
+	require.NoError(t, err)
+	require.Len(t, resp, 1)
+	assert.Equal(t, "old", resp[0].OrderID)
+	assert.Equal(t, testExchange, resp[0].Exchange)
+
+	resp, err = m.GetOrderHistory(testExchange, asset.Spot, btcusdPair, time.Now().Add(-time.Minute), time.Time{})
+	require.NoError(t, err)
+	assert.Empty(t, resp)
+
+	m.started = 0
+	_, err = m.GetOrderHistory(testExchange, asset.Spot, btcusdPair, time.Time{}, time.Time{})
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted)
+}
+
+func TestOrderRecordConversion(t *testing.T) {
+	t.Parallel()
+	od := &order.Detail{
+		HiddenOrder:          true,
+		TimeInForce:          order.GoodTillCancel | order.PostOnly,
+		ReduceOnly:           true,
+		Leverage:             10,
+		Price:                100,
+		Amount:               2,
+		ContractAmount:       20,
+		LimitPriceUpper:      110,
+		LimitPriceLower:      90,
+		TriggerPrice:         95,
+		AverageExecutedPrice: 99,
+		QuoteAmount:          200,
+		ExecutedAmount:       1,
+		RemainingAmount:      1,
+		Cost:                 99,
+		CostAsset:            currency.USDT,
+		Fee:                  0.1,
+		FeeAsset:             currency.USDT,
+		Exchange:             testExchange,
+		InternalOrderID:      uuid.Must(uuid.NewV4()),
+		OrderID:              "1337",
+		ClientOrderID:        "client order",
+		AccountID:            "account",
+		ClientID:             "client",
+		Type:                 order.StopLimit,
+		Side:                 order.Short,
+		Status:               order.PartiallyFilled,
+		AssetType:            asset.USDTMarginedFutures,
+		Date:                 time.Now().Add(-time.Hour),
+		LastUpdated:          time.Now(),
+		Pair:                 btcusdPair,
+		MarginType:           margin.Isolated,
+		SettlementCurrency:   currency.USDT,
+		Trades: []order.TradeHistory{{
+			Price:     99,
+			Amount:    1,
+			Fee:       0.1,
+			Exchange:  testExchange,
+			TID:       "1",
+			Type:      order.Limit,
+			Side:      order.Short,
+			Timestamp: time.Now(),
+			IsMaker:   true,
+			FeeAsset:  "USDT",
+			Total:     99,
+		}},
+	}
+	resp, err := orderFromRecord(orderToRecord(od))
+	require.NoError(t, err)
+	assert.Equal(t, od, resp, "orders should survive a round trip through a database record")
+
+	r := orderToRecord(&order.Detail{InternalOrderID: od.InternalOrderID, AssetType: asset.Spot, Pair: btcusdPair})
+	assert.Empty(t, r.MarginType, "unset margin types should be stored empty")
+	resp, err = orderFromRecord(r)
+	require.NoError(t, err)
+	assert.Equal(t, margin.Unset, resp.MarginType)
+	assert.Equal(t, order.UnknownTIF, resp.TimeInForce)
+
+	r.Type = "MOON"
+	resp, err = orderFromRecord(r)
+	require.NoError(t, err, "orderFromRecord must not error on an unknown order type")
+	assert.Equal(t, order.UnknownType, resp.Type)
+
+	r.Asset = "moon"
+	_, err = orderFromRecord(r)
+	assert.ErrorIs(t, err, asset.ErrNotSupported)
+
+	r.ID = "moon"
+	_, err = orderFromRecord(r)
+	assert.Error(t, err, "orderFromRecord should error on an invalid ID")
+}
@@ -0,0 +1,323 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// riskRateWindow is the window orders are counted over for the orders per
+// minute limit
+const riskRateWindow = time.Minute
+
+// setupRiskControls validates and loads the risk limits from config
+func (m *OrderManager) setupRiskControls(cfg *config.RiskLimits) error {
+	for i := range cfg.Limits {
+		l := &cfg.Limits[i]
+		if l.MaxOrderNotional < 0 || l.MaxPosition < 0 || l.MaxOrdersPerMinute < 0 || l.MaxDailyLoss < 0 || l.MaxPriceDeviation < 0 {
+			return fmt.Errorf("%w %d: limits cannot be negative", errInvalidRiskLimit, i)
+		}
+		if !l.Pair.IsEmpty() && (l.Pair.Base.IsEmpty() || l.Pair.Quote.IsEmpty()) {
+			return fmt.Errorf("%w %d: invalid pair %v", errInvalidRiskLimit, i, l.Pair)
+		}
+		// Realised PNL is only tracked for futures positions and spot fills
+		if l.MaxDailyLoss > 0 && l.Asset != asset.Empty && l.Asset != asset.Spot && !l.Asset.IsFutures() {
+			return fmt.Errorf("%w %d: maxDailyLoss requires a spot or futures asset, received %q", errInvalidRiskLimit, i, l.Asset)
+		}
+	}
+	m.risk.enabled = cfg.Enabled
+	m.risk.limits = make([]config.RiskLimit, len(cfg.Limits))
+	copy(m.risk.limits, cfg.Limits)
+	m.risk.submissions = make(map[int][]time.Time)
+	if cfg.KillSwitch {
+		m.risk.killSwitch = 1
+	}
+	return nil
+}
+
+// SetSpotPNLSource sets the source of the realised PNL of spot fills used by
+// daily loss limits
+func (m *OrderManager) SetSpotPNLSource(src iSpotPNLSource) error {
+	if m == nil {
+		return fmt.Errorf("order manager %w", ErrNilSubsystem)
+	}
+	if src == nil {
+		return errNilSpotPNLSource
+	}
+	m.risk.m.Lock()
+	m.risk.spotPNL = src
+	m.risk.m.Unlock()
+	return nil
+}
+
+// SetKillSwitch enables or disables the kill switch. While enabled all new
+// orders are rejected. Enabling it with cancelOrders set also cancels all
+// orders tracked by the order manager
+func (m *OrderManager) SetKillSwitch(ctx context.Context, enabled, cancelOrders bool) error {
+	if m == nil {
+		return fmt.Errorf("order manager %w", ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&m.started) == 0 {
+		return fmt.Errorf("order manager %w", ErrSubSystemNotStarted)
+	}
+	var msg string
+	if enabled {
+		atomic.StoreInt32(&m.risk.killSwitch, 1)
+		msg = "Kill switch enabled, new orders are blocked"
+		log.Warnln(log.OrderMgr, msg)
+	} else {
+		atomic.StoreInt32(&m.risk.killSwitch, 0)
+		msg = "Kill switch disabled, new orders are allowed"
+		log.Infoln(log.OrderMgr, msg)
+	}
+	m.orderStore.commsManager.PushEvent(base.Event{Type: "order", Message: msg})
+	if !enabled || !cancelOrders {
+		return nil
+	}
+	exchanges, err := m.orderStore.exchangeManager.GetExchanges()
+	if err != nil {
+		return err
+	}
+	m.CancelAllOrders(ctx, exchanges)
+	return nil
+}
+
+// IsKillSwitchEnabled returns whether new orders are blocked by the kill switch
+func (m *OrderManager) IsKillSwitchEnabled() bool {
+	return m != nil && atomic.LoadInt32(&m.risk.killSwitch) == 1
+}
+
+// checkRisk ensures an order is permitted by the kill switch and every risk
+// limit which matches it. When an existing order is being modified, replacing
+// is the order prior to modification and its remaining amount is excluded from
+// the projected position. Accepted orders count towards the orders per minute
+// limit
+func (m *OrderManager) checkRisk(s *order.Submit, replacing *order.Detail) error {
+	if m.IsKillSwitchEnabled() {
+		return errKillSwitchEnabled
+	}
+	m.risk.m.Lock()
+	defer m.risk.m.Unlock()
+	if !m.risk.enabled {
+		return nil
+	}
+	now := time.Now()
+	var matched []int
+	for i := range m.risk.limits {
+		l := &m.risk.limits[i]
+		if !riskLimitMatches(l, s) {
+			continue
+		}
+		if err := m.checkRiskLimit(l, s, replacing, m.risk.recentSubmissions(i, now)); err != nil {
+			return fmt.Errorf("order manager: %s %s %s: %w", s.Exchange, s.AssetType, s.Pair, err)
+		}
+		matched = append(matched, i)
+	}
+	for _, i := range matched {
+		m.risk.submissions[i] = append(m.risk.submissions[i], now)
+	}
+	return nil
+}
+
+// checkRiskLimit checks an order against a single risk limit
+func (m *OrderManager) checkRiskLimit(l *config.RiskLimit, s *order.Submit, replacing *order.Detail, recent int) error {
+	if l.MaxOrdersPerMinute > 0 && int64(recent) >= l.MaxOrdersPerMinute {
+		return fmt.Errorf("%w: %d orders in the last minute reaches maximum of %d", errRiskLimitExceeded, recent, l.MaxOrdersPerMinute)
+	}
+	var last float64
+	// Market orders are priced at the last ticker price
+	if l.MaxPriceDeviation > 0 || ((l.MaxOrderNotional > 0 || l.MaxPosition > 0) && s.Price <= 0) {
+		t, err := ticker.GetTicker(s.Exchange, s.Pair, s.AssetType)
+		if err != nil || t.Last <= 0 {
+			return fmt.Errorf("%w: no last ticker price", errRiskPriceUnavailable)
+		}
+		last = t.Last
+	}
+	if l.MaxPriceDeviation > 0 && s.Price > 0 {
+		deviation := math.Abs(s.Price-last) / last * 100
+		if deviation > l.MaxPriceDeviation {
+			return fmt.Errorf("%w: price %v deviates %.2f%% from last price %v, maximum %v%%", errRiskLimitExceeded, s.Price, deviation, last, l.MaxPriceDeviation)
+		}
+	}
+	price := s.Price
+	if price <= 0 {
+		price = last
+	}
+	amount := s.Amount
+	if amount == 0 && s.QuoteAmount > 0 {
+		amount = s.QuoteAmount / price
+	}
+	if l.MaxOrderNotional > 0 {
+		notional := amount * price
+		if notional > l.MaxOrderNotional {
+			return fmt.Errorf("%w: order notional %v exceeds maximum %v", errRiskLimitExceeded, notional, l.MaxOrderNotional)
+		}
+	}
+	if l.MaxPosition > 0 {
+		current := m.getProjectedPosition(s, replacing)
+		if replacing != nil {
+			// Only the unfilled amount of a modified order can change the
+			// position
+			amount = max(amount-replacing.ExecutedAmount, 0)
+		}
+		projected := current
+		if s.Side.IsLong() {
+			projected += amount
+		} else if s.Side.IsShort() {
+			projected -= amount
+		}
+		if math.Abs(projected) > l.MaxPosition && math.Abs(projected) > math.Abs(current) {
+			return fmt.Errorf("%w: projected position %v exceeds maximum %v", errRiskLimitExceeded, projected, l.MaxPosition)
+		}
+	}
+	if l.MaxDailyLoss > 0 && !s.ReduceOnly {
+		pnl, err := m.getDailyRealisedPNL(l)
+		if err != nil {
+			return err
+		}
+		if -pnl >= l.MaxDailyLoss {
+			return fmt.Errorf("%w: daily realised loss %v reaches maximum %v", errRiskLimitExceeded, -pnl, l.MaxDailyLoss)
+		}
+	}
+	return nil
+}
+
+// recentSubmissions removes submissions which have left the rate window and
+// returns the number remaining for the limit. The risk controls must be locked
+func (r *riskControls) recentSubmissions(limit int, now time.Time) int {
+	times := r.submissions[limit]
+	cutoff := now.Add(-riskRateWindow)
+	i := 0
+	for i < len(times) && !times[i].After(cutoff) {
+		i++
+	}
+	r.submissions[limit] = times[i:]
+	return len(times) - i
+}
+
+// getProjectedPosition returns the net position for the order's exchange,
+// asset and pair from the filled amounts and remaining amounts of active orders
+// in the order store. Only the filled amount of the replacing order is
+// included. Long positions are positive and short positions negative
+func (m *OrderManager) getProjectedPosition(s *order.Submit, replacing *order.Detail) float64 {
+	m.orderStore.m.RLock()
+	defer m.orderStore.m.RUnlock()
+	var position float64
+	orders := m.orderStore.Orders[strings.ToLower(s.Exchange)]
+	for _, od := range orders {
+		if od.AssetType != s.AssetType || !od.Pair.Equal(s.Pair) {
+			continue
+		}
+		amount := od.ExecutedAmount
+		if od.IsActive() && (replacing == nil || od.InternalOrderID != replacing.InternalOrderID) {
+			amount = od.Amount
+		}
+		switch {
+		case od.Side.IsLong():
+			position += amount
+		case od.Side.IsShort():
+			position -= amount
+		}
+	}
+	return position
+}
+
+// getDailyRealisedPNL returns the realised PNL since the start of the UTC day
+// across the exchanges, assets and pairs matching a risk limit. Futures PNL is
+// taken after fees from tracked futures positions. Spot PNL is taken from the
+// spot PNL source, which limits on the spot asset require while limits without
+// an asset only include when it is available. The risk controls must be locked
+func (m *OrderManager) getDailyRealisedPNL(l *config.RiskLimit) (float64, error) {
+	start := time.Now().UTC().Truncate(24 * time.Hour)
+	var pnl float64
+	if l.Asset == asset.Empty || l.Asset.IsFutures() {
+		futuresPNL, err := m.getDailyFuturesPNL(l, start)
+		if err != nil {
+			return 0, err
+		}
+		pnl += futuresPNL
+	}
+	if l.Asset == asset.Empty || l.Asset == asset.Spot {
+		if m.risk.spotPNL == nil {
+			if l.Asset == asset.Spot {
+				return 0, fmt.Errorf("%w: spot PNL requires the ledger manager", errRiskPNLUnavailable)
+			}
+			return pnl, nil
+		}
+		spotPNL, err := m.risk.spotPNL.SpotRealisedPNL(l.Exchange, l.Pair, start)
+		if err != nil {
+			if l.Asset == asset.Spot {
+				return 0, fmt.Errorf("%w: %w", errRiskPNLUnavailable, err)
+			}
+			return pnl, nil
+		}
+		pnl += spotPNL
+	}
+	return pnl, nil
+}
+
+// getDailyFuturesPNL returns the realised PNL after fees since a time of the
+// tracked futures positions matching a risk limit
+func (m *OrderManager) getDailyFuturesPNL(l *config.RiskLimit, start time.Time) (float64, error) {
+	positions, err := m.orderStore.futuresPositionController.GetAllPositions()
+	if err != nil {
+		if errors.Is(err, futures.ErrNoPositionsFound) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	var pnl float64
+	for i := range positions {
+		p := &positions[i]
+		if (l.Exchange != "" && !strings.EqualFold(l.Exchange, p.Exchange)) ||
+			(l.Asset != asset.Empty && l.Asset != p.Asset) ||
+			(!l.Pair.IsEmpty() && !l.Pair.Equal(p.Pair)) {
+			continue
+		}
+		for j := range p.PNLHistory {
+			h := &p.PNLHistory[j]
+			if !h.IsOrder || h.Time.Before(start) {
+				continue
+			}
+			pnl += h.RealisedPNLBeforeFees.Sub(h.Fee).InexactFloat64()
+		}
+	}
+	return pnl, nil
+}
+
+// checkModifyRisk ensures a modification of an order is permitted by the kill
+// switch and every risk limit which matches the order at its new price and
+// amount
+func (m *OrderManager) checkModifyRisk(det *order.Detail, mod *order.Modify) error {
+	return m.checkRisk(&order.Submit{
+		Exchange:    det.Exchange,
+		Type:        det.Type,
+		Side:        det.Side,
+		Pair:        det.Pair,
+		AssetType:   det.AssetType,
+		TimeInForce: det.TimeInForce,
+		ReduceOnly:  det.ReduceOnly,
+		Leverage:    det.Leverage,
+		Price:       mod.Price,
+		Amount:      mod.Amount,
+	}, det)
+}
+
+// riskLimitMatches returns whether a risk limit applies to an order
+func riskLimitMatches(l *config.RiskLimit, s *order.Submit) bool {
+	return (l.Exchange == "" || strings.EqualFold(l.Exchange, s.Exchange)) &&
+		(l.Asset == asset.Empty || l.Asset == s.AssetType) &&
+		(l.Pair.IsEmpty() || l.Pair.Equal(s.Pair))
+}
@@ -0,0 +1,345 @@
+package engine
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+)
+
+func TestSetupRiskControls(t *testing.T) {
+	t.Parallel()
+	var wg sync.WaitGroup
+	_, err := SetupOrderManager(NewExchangeManager(), &CommunicationManager{}, &wg, &config.OrderManager{
+		Risk: config.RiskLimits{Limits: []config.RiskLimit{{MaxOrderNotional: -1}}},
+	})
+	assert.ErrorIs(t, err, errInvalidRiskLimit)
+
+	_, err = SetupOrderManager(NewExchangeManager(), &CommunicationManager{}, &wg, &config.OrderManager{
+		Risk: config.RiskLimits{Limits: []config.RiskLimit{{Pair: currency.Pair{Base: currency.BTC}}}},
+	})
+	assert.ErrorIs(t, err, errInvalidRiskLimit)
+
+	_, err = SetupOrderManager(NewExchangeManager(), &CommunicationManager{}, &wg, &config.OrderManager{
+		Risk: config.RiskLimits{Limits: []config.RiskLimit{{Asset: asset.Margin, MaxDailyLoss: 1}}},
+	})
+	assert.ErrorIs(t, err, errInvalidRiskLimit, "SetupOrderManager should error when maxDailyLoss is set for a margin limit")
+
+	_, err = SetupOrderManager(NewExchangeManager(), &CommunicationManager{}, &wg, &config.OrderManager{
+		Risk: config.RiskLimits{Limits: []config.RiskLimit{{Asset: asset.Spot, MaxDailyLoss: 1}, {MaxDailyLoss: 1}}},
+	})
+	assert.NoError(t, err, "SetupOrderManager should allow maxDailyLoss for spot limits and limits without an asset")
+
+	m, err := SetupOrderManager(NewExchangeManager(), &CommunicationManager{}, &wg, &config.OrderManager{
+		Risk: config.RiskLimits{
+			Enabled:    true,
+			KillSwitch: true,
+			Limits:     []config.RiskLimit{{MaxOrderNotional: 1}},
+		},
+	})
+	require.NoError(t, err)
+	assert.True(t, m.risk.enabled)
+	assert.True(t, m.IsKillSwitchEnabled(), "kill switch should be loaded from config")
+	assert.Len(t, m.risk.limits, 1)
+}
+
+func TestSetKillSwitch(t *testing.T) {
+	t.Parallel()
+	var m *OrderManager
+	assert.ErrorIs(t, m.SetKillSwitch(t.Context(), true, false), ErrNilSubsystem)
+	assert.False(t, m.IsKillSwitchEnabled())
+
+	m = OrdersSetup(t)
+	m.started = 0
+	assert.ErrorIs(t, m.SetKillSwitch(t.Context(), true, false), ErrSubSystemNotStarted)
+	m.started = 1
+
+	require.NoError(t, m.SetKillSwitch(t.Context(), true, false))
+	assert.True(t, m.IsKillSwitchEnabled())
+	s := &order.Submit{
+		Exchange:  testExchange,
+		Pair:      btcusdPair,
+		AssetType: asset.Spot,
+		Side:      order.Buy,
+		Type:      order.Limit,
+		Price:     1,
+		Amount:    1,
+	}
+	_, err := m.Submit(t.Context(), s)
+	assert.ErrorIs(t, err, errKillSwitchEnabled)
+	_, err = m.SubmitFakeOrder(s, &order.SubmitResponse{}, false)
+	assert.ErrorIs(t, err, errKillSwitchEnabled)
+
+	require.NoError(t, m.Add(&order.Detail{
+		Exchange:  testExchange,
+		OrderID:   "killswitch",
+		Pair:      btcusdPair,
+		AssetType: asset.Spot,
+		Status:    order.New,
+		Amount:    1,
+	}))
+	require.NoError(t, m.SetKillSwitch(t.Context(), true, true))
+	od, err := m.orderStore.getByExchangeAndID(testExchange, "killswitch")
+	require.NoError(t, err)
+	assert.Equal(t, order.Cancelled, od.Status, "enabling the kill switch with cancel orders should cancel open orders")
+
+	require.NoError(t, m.SetKillSwitch(t.Context(), false, true))
+	assert.False(t, m.IsKillSwitchEnabled())
+	assert.NoError(t, m.checkRisk(s, nil))
+}
+
+func TestCheckRisk(t *testing.T) {
+	t.Parallel()
+	const exch = "riskexch"
+	m := OrdersSetup(t)
+	pair := currency.NewPair(currency.BTC, currency.USDT)
+	require.NoError(t, ticker.ProcessTicker(&ticker.Price{ExchangeName: exch, Pair: pair, AssetType: asset.Spot, Last: 100}))
+	require.NoError(t, m.setupRiskControls(&config.RiskLimits{
+		Limits: []config.RiskLimit{
+			{Exchange: "bruh", MaxOrderNotional: 1},
+			{Exchange: exch, Asset: asset.Spot, Pair: pair, MaxOrderNotional: 1000, MaxPriceDeviation: 5},
+		},
+	}))
+	s := &order.Submit{
+		Exchange:  exch,
+		Pair:      pair,
+		AssetType: asset.Spot,
+		Side:      order.Buy,
+		Type:      order.Limit,
+		Price:     100,
+		Amount:    20,
+	}
+	assert.NoError(t, m.checkRisk(s, nil), "checkRisk should not error when risk limits are disabled")
+
+	m.risk.enabled = true
+	assert.ErrorIs(t, m.checkRisk(s, nil), errRiskLimitExceeded, "checkRisk should error when the order notional exceeds the limit")
+
+	s.Amount = 1
+	s.Price = 110
+	assert.ErrorIs(t, m.checkRisk(s, nil), errRiskLimitExceeded, "checkRisk should error when the price deviates from the last price")
+
+	s.Price = 104
+	assert.NoError(t, m.checkRisk(s, nil))
+
+	s.Type = order.Market
+	s.Price = 0
+	s.Amount = 0
+	s.QuoteAmount = 1001
+	assert.ErrorIs(t, m.checkRisk(s, nil), errRiskLimitExceeded, "checkRisk should price market orders by quote amount")
+	s.QuoteAmount = 999
+	assert.NoError(t, m.checkRisk(s, nil))
+
+	s.Pair = currency.NewPair(currency.ETH, currency.USDT)
+	s.Amount = 1000
+	assert.NoError(t, m.checkRisk(s, nil), "checkRisk should ignore limits which do not match the order")
+
+	m.risk.limits = []config.RiskLimit{{MaxOrderNotional: 1000}}
+	assert.ErrorIs(t, m.checkRisk(s, nil), errRiskPriceUnavailable, "checkRisk should error on market orders without a last price")
+}
+
+func TestCheckRiskOrdersPerMinute(t *testing.T) {
+	t.Parallel()
+	m := OrdersSetup(t)
+	require.NoError(t, m.setupRiskControls(&config.RiskLimits{
+		Enabled: true,
+		Limits:  []config.RiskLimit{{Exchange: testExchange, MaxOrdersPerMinute: 2}},
+	}))
+	s := &order.Submit{Exchange: testExchange, Pair: btcusdPair, AssetType: asset.Spot, Side: order.Buy, Price: 1, Amount: 1}
+	require.NoError(t, m.checkRisk(s, nil))
+	require.NoError(t, m.checkRisk(s, nil))
+	assert.ErrorIs(t, m.checkRisk(s, nil), errRiskLimitExceeded, "checkRisk should error when the order rate exceeds the limit")
+
+	m.risk.submissions[0] = []time.Time{time.Now().Add(-riskRateWindow * 2), time.Now()}
+	assert.NoError(t, m.checkRisk(s, nil), "checkRisk should not count orders outside the rate window")
+	assert.Len(t, m.risk.submissions[0], 2)
+}
+
+func TestCheckRiskMaxPosition(t *testing.T) {
+	t.Parallel()
+	m := OrdersSetup(t)
+	pair := currency.NewPair(currency.LTC, currency.USD)
+	require.NoError(t, m.setupRiskControls(&config.RiskLimits{
+		Enabled: true,
+		Limits:  []config.RiskLimit{{Exchange: testExchange, MaxPosition: 2}},
+	}))
+	require.NoError(t, m.orderStore.add(&order.Detail{
+		Exchange:       testExchange,
+		OrderID:        "filled",
+		Pair:           pair,
+		AssetType:      asset.Spot,
+		Side:           order.Buy,
+		Status:         order.Filled,
+		Amount:         1,
+		ExecutedAmount: 1,
+	}))
+	require.NoError(t, m.orderStore.add(&order.Detail{
+		Exchange:       testExchange,
+		OrderID:        "open",
+		Pair:           pair,
+		AssetType:      asset.Spot,
+		Side:           order.Buy,
+		Status:         order.PartiallyFilled,
+		Amount:         0.5,
+		ExecutedAmount: 0.25,
+	}))
+	assert.Equal(t, 1.5, m.getProjectedPosition(&order.Submit{Exchange: testExchange, Pair: pair, AssetType: asset.Spot}, nil))
+
+	s := &order.Submit{Exchange: testExchange, Pair: pair, AssetType: asset.Spot, Side: order.Buy, Price: 1, Amount: 1}
+	assert.ErrorIs(t, m.checkRisk(s, nil), errRiskLimitExceeded, "checkRisk should error when the projected position exceeds the limit")
+
+	s.Amount = 0.5
+	assert.NoError(t, m.checkRisk(s, nil))
+
+	s.Side = order.Sell
+	s.Amount = 3
+	assert.NoError(t, m.checkRisk(s, nil), "checkRisk should allow orders which reduce the position")
+
+	s.Amount = 4
+	assert.ErrorIs(t, m.checkRisk(s, nil), errRiskLimitExceeded)
+}
+
+func TestCheckRiskMaxDailyLoss(t *testing.T) {
+	t.Parallel()
+	const exch = "risklossexch"
+	m := OrdersSetup(t)
+	require.NoError(t, m.setupRiskControls(&config.RiskLimits{
+		Enabled: true,
+		Limits:  []config.RiskLimit{{Exchange: exch, Asset: asset.Futures, MaxDailyLoss: 5}},
+	}))
+	s := &order.Submit{Exchange: exch, Pair: btcusdPair, AssetType: asset.Futures, Side: order.Buy, Price: 1, Amount: 1}
+	pnl, err := m.getDailyRealisedPNL(&m.risk.limits[0])
+	require.NoError(t, err)
+	assert.Zero(t, pnl, "getDailyRealisedPNL should return zero when no position is tracked")
+
+	now := time.Now()
+	require.NoError(t, m.orderStore.futuresPositionController.TrackNewOrder(&order.Detail{
+		Exchange:  exch,
+		OrderID:   "open",
+		Pair:      btcusdPair,
+		AssetType: asset.Futures,
+		Side:      order.Long,
+		Status:    order.Filled,
+		Price:     100,
+		Amount:    1,
+		Date:      now.Add(-time.Second),
+	}))
+	require.NoError(t, m.orderStore.futuresPositionController.TrackNewOrder(&order.Detail{
+		Exchange:  exch,
+		OrderID:   "close",
+		Pair:      btcusdPair,
+		AssetType: asset.Futures,
+		Side:      order.Short,
+		Status:    order.Filled,
+		Price:     90,
+		Amount:    1,
+		Date:      now,
+	}))
+	pnl, err = m.getDailyRealisedPNL(&m.risk.limits[0])
+	require.NoError(t, err)
+	assert.Equal(t, -10.0, pnl)
+
+	pnl, err = m.getDailyRealisedPNL(&config.RiskLimit{Exchange: "otherexch", Asset: asset.Futures})
+	require.NoError(t, err)
+	assert.Zero(t, pnl, "getDailyRealisedPNL should exclude positions on other exchanges")
+
+	assert.ErrorIs(t, m.checkRisk(s, nil), errRiskLimitExceeded, "checkRisk should error when the daily loss exceeds the limit")
+	s.ReduceOnly = true
+	assert.NoError(t, m.checkRisk(s, nil), "checkRisk should allow reduce only orders once the daily loss limit is reached")
+}
+
+type fakeSpotPNLSource struct {
+	pnl float64
+	err error
+}
+
+func (f *fakeSpotPNLSource) SpotRealisedPNL(string, currency.Pair, time.Time) (float64, error) {
+	return f.pnl, f.err
+}
+
+func TestCheckRiskMaxDailyLossSpot(t *testing.T) {
+	t.Parallel()
+	const exch = "riskspotlossexch"
+	m := OrdersSetup(t)
+	require.NoError(t, m.setupRiskControls(&config.RiskLimits{
+		Enabled: true,
+		Limits:  []config.RiskLimit{{Exchange: exch, Asset: asset.Spot, MaxDailyLoss: 5}},
+	}))
+	s := &order.Submit{Exchange: exch, Pair: btcusdPair, AssetType: asset.Spot, Side: order.Buy, Price: 1, Amount: 1}
+	assert.ErrorIs(t, m.checkRisk(s, nil), errRiskPNLUnavailable, "checkRisk should error when spot PNL is unavailable")
+
+	var nilManager *OrderManager
+	assert.ErrorIs(t, nilManager.SetSpotPNLSource(&fakeSpotPNLSource{}), ErrNilSubsystem)
+	assert.ErrorIs(t, m.SetSpotPNLSource(nil), errNilSpotPNLSource)
+
+	src := &fakeSpotPNLSource{err: ErrSubSystemNotStarted}
+	require.NoError(t, m.SetSpotPNLSource(src))
+	assert.ErrorIs(t, m.checkRisk(s, nil), errRiskPNLUnavailable, "checkRisk should error when the spot PNL source errors")
+
+	src.err = nil
+	src.pnl = -2
+	assert.NoError(t, m.checkRisk(s, nil))
+
+	src.pnl = -6
+	assert.ErrorIs(t, m.checkRisk(s, nil), errRiskLimitExceeded, "checkRisk should error when the spot daily loss exceeds the limit")
+
+	pnl, err := m.getDailyRealisedPNL(&config.RiskLimit{Exchange: exch})
+	require.NoError(t, err)
+	assert.Equal(t, -6.0, pnl, "getDailyRealisedPNL should include spot PNL for limits without an asset")
+
+	src.err = ErrSubSystemNotStarted
+	pnl, err = m.getDailyRealisedPNL(&config.RiskLimit{Exchange: exch})
+	require.NoError(t, err, "getDailyRealisedPNL should not error for limits without an asset when spot PNL is unavailable")
+	assert.Zero(t, pnl)
+}
+
+func TestModifyRisk(t *testing.T) {
+	t.Parallel()
+	m := OrdersSetup(t)
+	pair := currency.NewPair(currency.BTC, currency.USDT)
+	require.NoError(t, m.orderStore.add(&order.Detail{
+		Exchange:       testExchange,
+		OrderID:        "modify",
+		Pair:           pair,
+		AssetType:      asset.Spot,
+		Side:           order.Buy,
+		Type:           order.Limit,
+		Status:         order.PartiallyFilled,
+		Price:          10,
+		Amount:         1,
+		ExecutedAmount: 0.5,
+	}))
+	require.NoError(t, m.SetKillSwitch(t.Context(), true, false))
+	_, err := m.Modify(t.Context(), &order.Modify{Exchange: testExchange, OrderID: "modify", Price: 11})
+	assert.ErrorIs(t, err, errKillSwitchEnabled)
+	require.NoError(t, m.SetKillSwitch(t.Context(), false, false))
+
+	require.NoError(t, m.setupRiskControls(&config.RiskLimits{
+		Enabled: true,
+		Limits:  []config.RiskLimit{{Exchange: testExchange, MaxOrderNotional: 50, MaxPosition: 2}},
+	}))
+	od, err := m.orderStore.getByExchangeAndID(testExchange, "modify")
+	require.NoError(t, err)
+	assert.Equal(t, 0.5, m.getProjectedPosition(&order.Submit{Exchange: testExchange, Pair: pair, AssetType: asset.Spot}, od), "getProjectedPosition should only include the filled amount of the replacing order")
+
+	_, err = m.Modify(t.Context(), &order.Modify{Exchange: testExchange, OrderID: "modify", Amount: 6})
+	assert.ErrorIs(t, err, errRiskLimitExceeded, "Modify should error when the new notional exceeds the limit")
+	assert.ErrorIs(t, m.checkModifyRisk(od, &order.Modify{Price: 10, Amount: 3}), errRiskLimitExceeded, "checkModifyRisk should error when the new amount exceeds the position limit")
+	assert.NoError(t, m.checkModifyRisk(od, &order.Modify{Price: 10, Amount: 2}), "checkModifyRisk should not count the replaced order towards the position")
+}
+
+func TestRiskLimitMatches(t *testing.T) {
+	t.Parallel()
+	s := &order.Submit{Exchange: testExchange, Pair: btcusdPair, AssetType: asset.Spot}
+	assert.True(t, riskLimitMatches(&config.RiskLimit{}, s), "empty matchers should match every order")
+	assert.True(t, riskLimitMatches(&config.RiskLimit{Exchange: "bitstamp", Asset: asset.Spot, Pair: btcusdPair}, s))
+	assert.False(t, riskLimitMatches(&config.RiskLimit{Exchange: "bruh"}, s))
+	assert.False(t, riskLimitMatches(&config.RiskLimit{Asset: asset.Futures}, s))
+	assert.False(t, riskLimitMatches(&config.RiskLimit{Pair: currency.NewPair(currency.ETH, currency.USD)}, s))
+}
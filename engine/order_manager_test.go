@@ -1132,6 +1132,24 @@ func TestGetActiveOrders(t *testing.T) {
 	}
 }
 
+func TestStoreGetOrderCounts(t *testing.T) {
+	t.Parallel()
+	s := store{Orders: map[string][]*order.Detail{
+		"test": {
+			{OrderID: "1", Status: order.Active},
+			{OrderID: "2", Status: order.Active},
+			{OrderID: "3", Status: order.Filled},
+		},
+		"test2": {
+			{OrderID: "4", Status: order.Cancelled},
+		},
+	}}
+	assert.Equal(t, map[string]map[order.Status]int{
+		"test":  {order.Active: 2, order.Filled: 1},
+		"test2": {order.Cancelled: 1},
+	}, s.getOrderCounts(), "getOrderCounts should count orders by exchange and status")
+}
+
 func TestGetFuturesPositionsForExchange(t *testing.T) {
 	t.Parallel()
 	o := &OrderManager{}
@@ -5,7 +5,10 @@ import (
 	"sync"
 	"time"
 
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	dborder "github.com/thrasher-corp/gocryptotrader/database/repository/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 )
@@ -24,9 +27,18 @@ var (
 	errNilCommunicationsManager = errors.New("cannot start with nil communications manager")
 	errNilOrder                 = errors.New("nil order received")
 	errFuturesTrackingDisabled  = errors.New("tracking futures positions disabled. enable it via config under orderManager activelyTrackFuturesPositions")
+	errOrderDatabaseNotSet      = errors.New("order database not set")
+	errOrderUpdatesLagged       = errors.New("order update subscriber fell behind and was released")
+	errNilOrderUpdatePipe       = errors.New("nil order update pipe")
 	orderManagerInterval        = time.Second * 10
 
 	errInvalidFuturesTrackingSeekDuration = errors.New("invalid config value for futuresTrackingSeekDuration")
+	errKillSwitchEnabled                  = errors.New("kill switch enabled, new orders are blocked")
+	errRiskLimitExceeded                  = errors.New("order exceeds risk limit")
+	errInvalidRiskLimit                   = errors.New("invalid risk limit")
+	errRiskPriceUnavailable               = errors.New("unable to determine price for risk checks")
+	errRiskPNLUnavailable                 = errors.New("unable to determine realised PNL for risk checks")
+	errNilSpotPNLSource                   = errors.New("spot PNL source is nil")
 )
 
 type orderManagerConfig struct {
@@ -50,6 +62,59 @@ type OrderManager struct {
 	activelyTrackFuturesPositions bool
 	futuresPositionSeekDuration   time.Duration
 	respectOrderHistoryLimits     bool
+	conditionalOrders             conditionalOrderStore
+	orderUpdates                  orderUpdateFeed
+	risk                          riskControls
+}
+
+// riskControls holds the pre-trade risk limits and the kill switch
+type riskControls struct {
+	killSwitch int32
+	m          sync.Mutex
+	enabled    bool
+	limits     []config.RiskLimit
+	// submissions holds the times orders matching each limit were accepted,
+	// keyed by the limit's index
+	submissions map[int][]time.Time
+	// spotPNL provides the realised PNL of spot fills for daily loss limits
+	spotPNL iSpotPNLSource
+}
+
+// iSpotPNLSource provides the realised PNL of spot fills
+type iSpotPNLSource interface {
+	SpotRealisedPNL(exchange string, p currency.Pair, since time.Time) (float64, error)
+}
+
+// orderUpdateFeed relays order state changes to subscribers in the order they
+// occur
+type orderUpdateFeed struct {
+	m     sync.Mutex
+	pipes map[*OrderUpdatePipe]struct{}
+	// last tracks the most recently relayed state of each order so unchanged
+	// updates from polling are not relayed
+	last map[uuid.UUID]orderUpdateState
+	// expiries holds when the state of each terminal order stops being tracked
+	expiries  map[uuid.UUID]time.Time
+	nextPrune time.Time
+}
+
+// orderUpdateState holds the order fields which are relayed on change
+type orderUpdateState struct {
+	status               order.Status
+	price                float64
+	amount               float64
+	executedAmount       float64
+	averageExecutedPrice float64
+	fee                  float64
+	trades               int
+}
+
+// OrderUpdatePipe receives a copy of an order each time its status, amounts,
+// fees or fills change
+type OrderUpdatePipe struct {
+	c    chan *order.Detail
+	feed *orderUpdateFeed
+	err  error
 }
 
 // store holds all orders by exchange
@@ -60,6 +125,9 @@ type store struct {
 	exchangeManager           iExchangeManager
 	wg                        *sync.WaitGroup
 	futuresPositionController futures.PositionController
+	// dbMtx serialises writes so the latest order state is always persisted
+	dbMtx sync.Mutex
+	db    dborder.IDBService
 }
 
 // OrderSubmitResponse contains the order response along with an internal order ID
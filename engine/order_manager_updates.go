@@ -0,0 +1,210 @@
+package engine
+
+import (
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// orderUpdateBufferSize is the number of order updates a subscriber can fall
+// behind by before it is released
+const orderUpdateBufferSize = 256
+
+// terminalOrderRetention is how long the last state of a terminal order is kept
+// so repeated terminal updates are not relayed again
+const terminalOrderRetention = time.Hour
+
+// SubscribeOrderUpdates returns a pipe which receives a copy of every order
+// handled by the order manager whenever its status, amounts, fees or fills
+// change, including websocket updates and orders rejected on submission
+func (m *OrderManager) SubscribeOrderUpdates() (*OrderUpdatePipe, error) {
+	if m == nil {
+		return nil, fmt.Errorf("order manager %w", ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&m.started) == 0 {
+		return nil, fmt.Errorf("order manager %w", ErrSubSystemNotStarted)
+	}
+	f := &m.orderUpdates
+	f.m.Lock()
+	defer f.m.Unlock()
+	if f.pipes == nil {
+		f.pipes = make(map[*OrderUpdatePipe]struct{})
+	}
+	p := &OrderUpdatePipe{
+		c:    make(chan *order.Detail, orderUpdateBufferSize),
+		feed: f,
+	}
+	f.pipes[p] = struct{}{}
+	return p, nil
+}
+
+// Channel returns the channel which receives order updates. It is closed once
+// the pipe is released
+func (p *OrderUpdatePipe) Channel() <-chan *order.Detail {
+	return p.c
+}
+
+// Err returns the reason the order manager released the pipe, if any
+func (p *OrderUpdatePipe) Err() error {
+	if p == nil {
+		return errNilOrderUpdatePipe
+	}
+	p.feed.m.Lock()
+	defer p.feed.m.Unlock()
+	return p.err
+}
+
+// Release stops order updates being sent to the pipe and closes its channel
+func (p *OrderUpdatePipe) Release() error {
+	if p == nil {
+		return errNilOrderUpdatePipe
+	}
+	p.feed.m.Lock()
+	defer p.feed.m.Unlock()
+	if _, ok := p.feed.pipes[p]; ok {
+		p.feed.release(p, nil)
+	}
+	return nil
+}
+
+// orderUpdated persists and relays the current state of an order in the order
+// store after it has changed
+func (m *OrderManager) orderUpdated(exchangeName, orderID string) {
+	m.persistOrder(exchangeName, orderID)
+	m.relayOrderUpdate(exchangeName, orderID)
+}
+
+// relayOrderUpdate sends the current state of an order in the order store to
+// all subscribers when it differs from the last state sent
+func (m *OrderManager) relayOrderUpdate(exchangeName, orderID string) {
+	f := &m.orderUpdates
+	f.m.Lock()
+	defer f.m.Unlock()
+	// The order is read while locked so subscribers receive updates in the
+	// order they are applied
+	od, err := m.orderStore.getByExchangeAndID(exchangeName, orderID)
+	if err != nil {
+		log.Errorf(log.OrderMgr, "Unable to relay %v order %v update: %v", exchangeName, orderID, err)
+		return
+	}
+	state := orderUpdateState{
+		status:               od.Status,
+		price:                od.Price,
+		amount:               od.Amount,
+		executedAmount:       od.ExecutedAmount,
+		averageExecutedPrice: od.AverageExecutedPrice,
+		fee:                  od.Fee,
+		trades:               len(od.Trades),
+	}
+	if last, ok := f.last[od.InternalOrderID]; ok && last == state {
+		return
+	}
+	if f.last == nil {
+		f.last = make(map[uuid.UUID]orderUpdateState)
+	}
+	f.last[od.InternalOrderID] = state
+	now := time.Now()
+	if isTerminalOrderStatus(od.Status) {
+		// Terminal orders are not expected to change again so their state is
+		// only kept long enough to suppress re-synced or repeated updates
+		if f.expiries == nil {
+			f.expiries = make(map[uuid.UUID]time.Time)
+		}
+		f.expiries[od.InternalOrderID] = now.Add(terminalOrderRetention)
+	} else {
+		delete(f.expiries, od.InternalOrderID)
+	}
+	f.prune(now)
+	f.relay(od)
+}
+
+// prune removes the state of terminal orders once their retention period has
+// passed. Pruning runs at most once a minute. The feed must be locked
+func (f *orderUpdateFeed) prune(now time.Time) {
+	if now.Before(f.nextPrune) {
+		return
+	}
+	f.nextPrune = now.Add(time.Minute)
+	for id, expiry := range f.expiries {
+		if now.After(expiry) {
+			delete(f.expiries, id)
+			delete(f.last, id)
+		}
+	}
+}
+
+// isTerminalOrderStatus returns whether an order with the status has finished
+// on the exchange
+func isTerminalOrderStatus(s order.Status) bool {
+	switch s {
+	case order.Filled, order.Cancelled, order.PartiallyCancelled, order.PartiallyFilledCancelled,
+		order.Rejected, order.InsufficientBalance, order.MarketUnavailable, order.Expired,
+		order.Closed, order.Liquidated:
+		return true
+	}
+	return false
+}
+
+// relayRejectedOrder sends an order which the exchange refused to accept to all
+// subscribers. Rejected orders are not added to the order store
+func (m *OrderManager) relayRejectedOrder(s *order.Submit) {
+	f := &m.orderUpdates
+	f.m.Lock()
+	defer f.m.Unlock()
+	if len(f.pipes) == 0 {
+		return
+	}
+	now := time.Now()
+	f.relay(&order.Detail{
+		Exchange:      s.Exchange,
+		ClientOrderID: s.ClientOrderID,
+		ClientID:      s.ClientID,
+		Pair:          s.Pair,
+		AssetType:     s.AssetType,
+		Type:          s.Type,
+		Side:          s.Side,
+		Status:        order.Rejected,
+		TimeInForce:   s.TimeInForce,
+		Price:         s.Price,
+		Amount:        s.Amount,
+		QuoteAmount:   s.QuoteAmount,
+		TriggerPrice:  s.TriggerPrice,
+		Leverage:      s.Leverage,
+		ReduceOnly:    s.ReduceOnly,
+		Date:          now,
+		LastUpdated:   now,
+	})
+}
+
+// relay sends a copy of the order to every pipe, releasing any pipe which is
+// too far behind to receive it. The feed must be locked
+func (f *orderUpdateFeed) relay(od *order.Detail) {
+	for p := range f.pipes {
+		select {
+		case p.c <- od.CopyToPointer():
+		default:
+			f.release(p, errOrderUpdatesLagged)
+		}
+	}
+}
+
+// release removes the pipe from the feed and closes its channel. The feed must
+// be locked
+func (f *orderUpdateFeed) release(p *OrderUpdatePipe, err error) {
+	delete(f.pipes, p)
+	p.err = err
+	close(p.c)
+}
+
+// releaseAll releases every pipe subscribed to the feed
+func (f *orderUpdateFeed) releaseAll(err error) {
+	f.m.Lock()
+	defer f.m.Unlock()
+	for p := range f.pipes {
+		f.release(p, err)
+	}
+}
@@ -0,0 +1,150 @@
+package engine
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+func TestSubscribeOrderUpdates(t *testing.T) {
+	t.Parallel()
+	var m *OrderManager
+	_, err := m.SubscribeOrderUpdates()
+	assert.ErrorIs(t, err, ErrNilSubsystem)
+
+	m = OrdersSetup(t)
+	m.started = 0
+	_, err = m.SubscribeOrderUpdates()
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted)
+	m.started = 1
+
+	pipe, err := m.SubscribeOrderUpdates()
+	require.NoError(t, err)
+
+	od := &order.Detail{
+		Exchange:  testExchange,
+		OrderID:   "1337",
+		Pair:      btcusdPair,
+		AssetType: asset.Spot,
+		Status:    order.New,
+		Price:     100,
+		Amount:    1,
+	}
+	require.NoError(t, m.Add(od))
+	require.Len(t, pipe.Channel(), 1, "Add must relay the order")
+	update := <-pipe.Channel()
+	assert.Equal(t, order.New, update.Status)
+	assert.Equal(t, testExchange, update.Exchange)
+
+	_, err = m.UpsertOrder(od)
+	require.NoError(t, err)
+	assert.Empty(t, pipe.Channel(), "unchanged orders should not be relayed")
+
+	fill := od.Copy()
+	fill.Status = order.PartiallyFilled
+	fill.ExecutedAmount = 0.5
+	fill.AverageExecutedPrice = 100
+	fill.Fee = 0.01
+	fill.Trades = []order.TradeHistory{{TID: "1", Price: 100, Amount: 0.5, Fee: 0.01, Side: order.Buy}}
+	require.NoError(t, m.UpdateExistingOrder(&fill))
+	require.Len(t, pipe.Channel(), 1, "UpdateExistingOrder must relay a changed order")
+	update = <-pipe.Channel()
+	assert.Equal(t, order.PartiallyFilled, update.Status)
+	assert.Equal(t, 0.01, update.Fee)
+	require.Len(t, update.Trades, 1, "fills must be relayed")
+	assert.Equal(t, 100.0, update.Trades[0].Price)
+	assert.Contains(t, m.orderUpdates.last, update.InternalOrderID, "active orders should be tracked")
+
+	fill.Status = order.Filled
+	fill.ExecutedAmount = 1
+	require.NoError(t, m.UpdateExistingOrder(&fill))
+	require.Len(t, pipe.Channel(), 1, "UpdateExistingOrder must relay a filled order")
+	update = <-pipe.Channel()
+	assert.Equal(t, order.Filled, update.Status)
+	assert.Contains(t, m.orderUpdates.expiries, update.InternalOrderID, "terminal orders should expire")
+
+	require.NoError(t, m.UpdateExistingOrder(&fill))
+	assert.Empty(t, pipe.Channel(), "repeated terminal updates should not be relayed")
+
+	m.orderUpdates.m.Lock()
+	m.orderUpdates.expiries[update.InternalOrderID] = time.Now().Add(-time.Second)
+	m.orderUpdates.nextPrune = time.Time{}
+	m.orderUpdates.prune(time.Now())
+	m.orderUpdates.m.Unlock()
+	assert.NotContains(t, m.orderUpdates.last, update.InternalOrderID, "expired terminal orders should be pruned")
+	assert.NotContains(t, m.orderUpdates.expiries, update.InternalOrderID, "expired terminal orders should be pruned")
+
+	require.NoError(t, pipe.Release())
+	_, ok := <-pipe.Channel()
+	assert.False(t, ok, "Release should close the pipe's channel")
+	assert.NoError(t, pipe.Err(), "Err should not error when the pipe was released by the subscriber")
+	assert.NoError(t, pipe.Release(), "Release should not error when already released")
+
+	pipe = nil
+	assert.ErrorIs(t, pipe.Release(), errNilOrderUpdatePipe)
+	assert.ErrorIs(t, pipe.Err(), errNilOrderUpdatePipe)
+}
+
+func TestOrderUpdatesLagged(t *testing.T) {
+	t.Parallel()
+	m := OrdersSetup(t)
+	pipe, err := m.SubscribeOrderUpdates()
+	require.NoError(t, err)
+
+	od := &order.Detail{InternalOrderID: uuid.Must(uuid.NewV4()), Exchange: testExchange, OrderID: "1337"}
+	m.orderUpdates.m.Lock()
+	for range orderUpdateBufferSize + 1 {
+		m.orderUpdates.relay(od)
+	}
+	m.orderUpdates.m.Unlock()
+
+	var received int
+	for range pipe.Channel() {
+		received++
+	}
+	assert.Equal(t, orderUpdateBufferSize, received, "all buffered updates should be received before the channel closes")
+	assert.ErrorIs(t, pipe.Err(), errOrderUpdatesLagged)
+}
+
+func TestRelayRejectedOrder(t *testing.T) {
+	t.Parallel()
+	m := OrdersSetup(t)
+	s := &order.Submit{
+		Exchange:      testExchange,
+		Pair:          btcusdPair,
+		AssetType:     asset.Spot,
+		Side:          order.Buy,
+		Type:          order.Limit,
+		Price:         100,
+		Amount:        1,
+		ClientOrderID: "client",
+	}
+	m.relayRejectedOrder(s)
+
+	pipe, err := m.SubscribeOrderUpdates()
+	require.NoError(t, err)
+	m.relayRejectedOrder(s)
+	require.Len(t, pipe.Channel(), 1)
+	update := <-pipe.Channel()
+	assert.Equal(t, order.Rejected, update.Status)
+	assert.Equal(t, "client", update.ClientOrderID)
+	assert.False(t, update.Date.IsZero(), "rejected orders should be timestamped")
+	assert.Empty(t, m.orderStore.Orders[strings.ToLower(testExchange)], "rejected orders should not be added to the order store")
+}
+
+func TestOrderUpdatesStop(t *testing.T) {
+	t.Parallel()
+	m := OrdersSetup(t)
+	pipe, err := m.SubscribeOrderUpdates()
+	require.NoError(t, err)
+	require.NoError(t, m.Stop())
+	_, ok := <-pipe.Channel()
+	assert.False(t, ok, "Stop should close all order update pipes")
+	assert.ErrorIs(t, pipe.Err(), ErrSubSystemNotStarted)
+}
@@ -0,0 +1,453 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchange/order/limits"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+// NewOrderRouter returns an order router which plans routes from the exchange
+// manager's cached orderbooks and executes them through the order manager
+func NewOrderRouter(exchangeManager iExchangeManager, orderManager iOrderManager) (*OrderRouter, error) {
+	if exchangeManager == nil {
+		return nil, errNilExchangeManager
+	}
+	return &OrderRouter{
+		exchangeManager: exchangeManager,
+		orderManager:    orderManager,
+	}, nil
+}
+
+// PlanRoute splits an order across exchanges by the best price available in
+// each exchange's cached orderbook after trading fees. Each exchange is limited
+// by its available balance and order execution limits. Exchanges whose legs do
+// not satisfy their execution limits are excluded and the route is planned again
+func (o *OrderRouter) PlanRoute(ctx context.Context, r *RouteRequest) (*RoutePlan, error) {
+	if o == nil {
+		return nil, fmt.Errorf("order router %w", ErrNilSubsystem)
+	}
+	if r == nil {
+		return nil, errNilRouteRequest
+	}
+	if r.Pair.IsEmpty() {
+		return nil, currency.ErrCurrencyPairEmpty
+	}
+	if !r.Asset.IsValid() {
+		return nil, fmt.Errorf("%w %v", asset.ErrNotSupported, r.Asset)
+	}
+	var side order.Side
+	switch {
+	case r.Side.IsLong():
+		side = order.Buy
+	case r.Side.IsShort():
+		side = order.Sell
+	default:
+		return nil, fmt.Errorf("%w %v, routed orders must be buy or sell", order.ErrSideIsInvalid, r.Side)
+	}
+	if r.Amount <= 0 {
+		return nil, order.ErrAmountIsInvalid
+	}
+
+	var exchanges []exchange.IBotExchange
+	if len(r.Exchanges) > 0 {
+		for _, name := range r.Exchanges {
+			exch, err := o.exchangeManager.GetExchangeByName(name)
+			if err != nil {
+				return nil, err
+			}
+			exchanges = append(exchanges, exch)
+		}
+	} else {
+		var err error
+		exchanges, err = o.exchangeManager.GetExchanges()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Exchanges are ordered by name so routes are deterministic when prices tie
+	slices.SortFunc(exchanges, func(a, b exchange.IBotExchange) int {
+		return strings.Compare(a.GetName(), b.GetName())
+	})
+
+	excluded := make(map[string]string)
+	for {
+		venues, skipped := loadRouteVenues(ctx, exchanges, r, side, excluded)
+		allocateRoute(venues, side, r.Amount)
+		plan := &RoutePlan{
+			Pair:            r.Pair,
+			Asset:           r.Asset,
+			Side:            side,
+			RequestedAmount: r.Amount,
+			Skipped:         skipped,
+		}
+		var rejected bool
+		for _, v := range venues {
+			if len(v.leg.Levels) == 0 {
+				continue
+			}
+			if err := finaliseRouteLeg(ctx, v, r, side); err != nil {
+				excluded[v.name] = err.Error()
+				rejected = true
+				continue
+			}
+			plan.Legs = append(plan.Legs, v.leg)
+		}
+		if rejected {
+			continue
+		}
+		if len(plan.Legs) == 0 {
+			reasons := make([]string, len(plan.Skipped))
+			for i := range plan.Skipped {
+				reasons[i] = plan.Skipped[i].Exchange + ": " + plan.Skipped[i].Reason
+			}
+			return nil, fmt.Errorf("%w for %v %v %v [%s]", errNoRouteLiquidity, side, r.Pair, r.Asset, strings.Join(reasons, ", "))
+		}
+		plan.summarise()
+		return plan, nil
+	}
+}
+
+// ExecuteRoute submits each leg of a route plan as a market order through the
+// order manager. Every leg is attempted and its result returned, along with an
+// error when any leg was rejected
+func (o *OrderRouter) ExecuteRoute(ctx context.Context, plan *RoutePlan) ([]RouteLegResult, error) {
+	if o == nil {
+		return nil, fmt.Errorf("order router %w", ErrNilSubsystem)
+	}
+	if plan == nil {
+		return nil, errNilRoutePlan
+	}
+	if o.orderManager == nil || !o.orderManager.IsRunning() {
+		return nil, fmt.Errorf("order manager %w", ErrSubSystemNotStarted)
+	}
+	results := make([]RouteLegResult, len(plan.Legs))
+	var failed int
+	for i := range plan.Legs {
+		results[i].Leg = plan.Legs[i]
+		s, err := o.legToSubmit(plan, &plan.Legs[i])
+		if err == nil {
+			results[i].Response, err = o.orderManager.Submit(ctx, s)
+		}
+		if err != nil {
+			results[i].Err = err
+			failed++
+		}
+	}
+	if failed > 0 {
+		return results, fmt.Errorf("%w: %d of %d legs failed", errRouteLegsRejected, failed, len(plan.Legs))
+	}
+	return results, nil
+}
+
+// legToSubmit returns the market order for a route leg, satisfying the
+// exchange's trading requirements
+func (o *OrderRouter) legToSubmit(plan *RoutePlan, leg *RouteLeg) (*order.Submit, error) {
+	exch, err := o.exchangeManager.GetExchangeByName(leg.Exchange)
+	if err != nil {
+		return nil, err
+	}
+	return newMarketSubmit(exch, plan.Pair, plan.Asset, plan.Side, leg.Amount, leg.Cost)
+}
+
+// newMarketSubmit returns a market order satisfying the exchange's trading
+// requirements. Spot market buys are submitted with the quote amount when the
+// exchange requires it
+func newMarketSubmit(exch exchange.IBotExchange, pair currency.Pair, a asset.Item, side order.Side, amount, quoteAmount float64) (*order.Submit, error) {
+	s := &order.Submit{
+		Exchange:  exch.GetName(),
+		Pair:      pair,
+		AssetType: a,
+		Side:      side,
+		Type:      order.Market,
+		Amount:    amount,
+	}
+	reqs := exch.GetTradingRequirements()
+	if reqs.SpotMarketBuyQuotation && a == asset.Spot && side.IsLong() {
+		s.Amount = 0
+		s.QuoteAmount = quoteAmount
+	}
+	if reqs.ClientOrderID {
+		id, err := uuid.NewV4()
+		if err != nil {
+			return nil, err
+		}
+		s.ClientOrderID = id.String()
+	}
+	return s, nil
+}
+
+// loadRouteVenues returns the exchanges which can be routed to along with the
+// reasons any exchange supporting the pair cannot be used. Exchanges which do
+// not support the pair are only reported when explicitly requested
+func loadRouteVenues(ctx context.Context, exchanges []exchange.IBotExchange, r *RouteRequest, side order.Side, excluded map[string]string) ([]*routeVenue, []RouteSkipped) {
+	var venues []*routeVenue
+	var skipped []RouteSkipped
+	explicit := len(r.Exchanges) > 0
+	for _, exch := range exchanges {
+		name := exch.GetName()
+		if reason, ok := excluded[name]; ok {
+			skipped = append(skipped, RouteSkipped{Exchange: name, Reason: reason})
+			continue
+		}
+		if !exch.IsEnabled() {
+			if explicit {
+				skipped = append(skipped, RouteSkipped{Exchange: name, Reason: errExchangeNotEnabled.Error()})
+			}
+			continue
+		}
+		pairs, err := exch.GetEnabledPairs(r.Asset)
+		if err != nil || !pairs.Contains(r.Pair, true) {
+			if explicit {
+				skipped = append(skipped, RouteSkipped{Exchange: name, Reason: fmt.Sprintf("%v %v %v", r.Pair, r.Asset, currency.ErrPairNotEnabled)})
+			}
+			continue
+		}
+		v, err := loadRouteVenue(ctx, exch, r, side)
+		if err != nil {
+			skipped = append(skipped, RouteSkipped{Exchange: name, Reason: err.Error()})
+			continue
+		}
+		venues = append(venues, v)
+	}
+	return venues, skipped
+}
+
+// loadRouteVenue loads an exchange's orderbook side, trading fee rate and capacity
+func loadRouteVenue(ctx context.Context, exch exchange.IBotExchange, r *RouteRequest, side order.Side) (*routeVenue, error) {
+	book, err := exch.GetCachedOrderbook(r.Pair, r.Asset)
+	if err != nil {
+		return nil, err
+	}
+	levels := book.Bids
+	if side.IsLong() {
+		levels = book.Asks
+	}
+	if len(levels) == 0 {
+		return nil, errNoOrderbookLiquidity
+	}
+	fee, err := exch.GetFeeByType(ctx, &exchange.FeeBuilder{
+		FeeType:       exchange.CryptocurrencyTradeFee,
+		Pair:          r.Pair,
+		PurchasePrice: levels[0].Price,
+		Amount:        1,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to get trading fee: %w", err)
+	}
+	feeRate := fee / levels[0].Price
+	if feeRate < 0 || feeRate >= 1 {
+		return nil, fmt.Errorf("%w rate %v", errRouteFeeInvalid, feeRate)
+	}
+	v := &routeVenue{
+		exch:          exch,
+		name:          exch.GetName(),
+		levels:        levels,
+		feeRate:       feeRate,
+		baseCapacity:  math.Inf(1),
+		quoteCapacity: math.Inf(1),
+	}
+	if !r.IgnoreBalances {
+		code := r.Pair.Base
+		if side.IsLong() {
+			code = r.Pair.Quote
+		}
+		free, err := getFreeBalance(ctx, exch, r.Asset, code)
+		if err != nil {
+			return nil, fmt.Errorf("unable to get %v balance: %w", code, err)
+		}
+		if free <= 0 {
+			return nil, fmt.Errorf("no available %v balance", code)
+		}
+		if side.IsLong() {
+			v.quoteCapacity = free
+		} else {
+			v.baseCapacity = free
+		}
+	}
+	if l, err := exch.GetOrderExecutionLimits(r.Asset, r.Pair); err == nil {
+		v.limits = &l
+		if l.MaximumBaseAmount > 0 {
+			v.baseCapacity = math.Min(v.baseCapacity, l.MaximumBaseAmount)
+		}
+		if l.MarketMaxQty > 0 {
+			v.baseCapacity = math.Min(v.baseCapacity, l.MarketMaxQty)
+		}
+	}
+	v.leg.Exchange = v.name
+	return v, nil
+}
+
+// finaliseRouteLeg rounds a venue's leg to the exchange's amount step, checks it
+// against the exchange's order execution limits and calculates its fee
+func finaliseRouteLeg(ctx context.Context, v *routeVenue, r *RouteRequest, side order.Side) error {
+	var amount float64
+	for i := range v.leg.Levels {
+		amount += v.leg.Levels[i].Amount
+	}
+	if v.limits != nil && v.limits.AmountStepIncrementSize > 0 {
+		amount = v.limits.FloorAmountToStepIncrement(amount)
+		v.leg.Levels = trimRouteLevels(v.leg.Levels, amount)
+	}
+	if amount <= 0 {
+		return fmt.Errorf("%w: amount rounds to zero", limits.ErrAmountBelowMin)
+	}
+	v.leg.Amount = amount
+	for i := range v.leg.Levels {
+		v.leg.Cost += v.leg.Levels[i].Price * v.leg.Levels[i].Amount
+	}
+	v.leg.AveragePrice = v.leg.Cost / amount
+	v.leg.WorstPrice = v.leg.Levels[len(v.leg.Levels)-1].Price
+	err := v.exch.CheckOrderExecutionLimits(r.Asset, r.Pair, v.leg.AveragePrice, amount, order.Market)
+	if err != nil && !errors.Is(err, limits.ErrExchangeLimitNotLoaded) && !errors.Is(err, limits.ErrOrderLimitNotFound) {
+		return err
+	}
+	v.leg.Fee, err = v.exch.GetFeeByType(ctx, &exchange.FeeBuilder{
+		FeeType:       exchange.CryptocurrencyTradeFee,
+		Pair:          r.Pair,
+		PurchasePrice: v.leg.AveragePrice,
+		Amount:        amount,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to get trading fee: %w", err)
+	}
+	if side.IsLong() {
+		v.leg.EffectivePrice = (v.leg.Cost + v.leg.Fee) / amount
+	} else {
+		v.leg.EffectivePrice = (v.leg.Cost - v.leg.Fee) / amount
+	}
+	return nil
+}
+
+// summarise totals the plan's legs and sets its status
+func (p *RoutePlan) summarise() {
+	var cost float64
+	for i := range p.Legs {
+		p.RoutedAmount += p.Legs[i].Amount
+		p.TotalFee += p.Legs[i].Fee
+		cost += p.Legs[i].Cost
+	}
+	p.UnroutedAmount = math.Max(p.RequestedAmount-p.RoutedAmount, 0)
+	p.AveragePrice = cost / p.RoutedAmount
+	direction := "Buying"
+	if p.Side.IsLong() {
+		p.EffectivePrice = (cost + p.TotalFee) / p.RoutedAmount
+	} else {
+		direction = "Selling"
+		p.EffectivePrice = (cost - p.TotalFee) / p.RoutedAmount
+	}
+	p.Status = fmt.Sprintf("%s %v %v across %d exchange(s) at an average price of %v, %v including fees.",
+		direction, p.RoutedAmount, p.Pair.Base, len(p.Legs), p.AveragePrice, p.EffectivePrice)
+	if p.UnroutedAmount > routeDustAmount {
+		p.Status += fmt.Sprintf(" [WARNING]: %v %v could not be routed.", p.UnroutedAmount, p.Pair.Base)
+	}
+}
+
+// allocateRoute repeatedly takes liquidity from the venue offering the best
+// price after fees until the amount is allocated or no liquidity remains
+func allocateRoute(venues []*routeVenue, side order.Side, amount float64) {
+	buy := side.IsLong()
+	remaining := amount
+	for remaining > routeDustAmount {
+		var best *routeVenue
+		var bestPrice float64
+		for _, v := range venues {
+			if v.exhausted(buy) {
+				continue
+			}
+			p := v.effectivePrice(buy)
+			if best == nil || (buy && p < bestPrice) || (!buy && p > bestPrice) {
+				best, bestPrice = v, p
+			}
+		}
+		if best == nil {
+			return
+		}
+		level := best.levels[best.position]
+		available := level.Amount - best.taken
+		take := math.Min(math.Min(available, remaining), best.baseCapacity)
+		if buy {
+			take = math.Min(take, best.quoteCapacity/bestPrice)
+		}
+		if take <= routeDustAmount {
+			// The remaining capacity cannot purchase a meaningful amount
+			best.baseCapacity = 0
+			continue
+		}
+		best.taken += take
+		if best.taken >= level.Amount-routeDustAmount {
+			best.position++
+			best.taken = 0
+		}
+		best.baseCapacity -= take
+		if buy {
+			best.quoteCapacity -= take * bestPrice
+		}
+		remaining -= take
+		if n := len(best.leg.Levels); n > 0 && best.leg.Levels[n-1].Price == level.Price {
+			best.leg.Levels[n-1].Amount += take
+			continue
+		}
+		best.leg.Levels = append(best.leg.Levels, orderbook.Level{Price: level.Price, Amount: take})
+	}
+}
+
+// exhausted returns whether the venue has no liquidity or capacity remaining
+func (v *routeVenue) exhausted(buy bool) bool {
+	return v.position >= len(v.levels) ||
+		v.baseCapacity <= routeDustAmount ||
+		(buy && v.quoteCapacity <= routeDustAmount)
+}
+
+// effectivePrice returns the venue's next level price after fees
+func (v *routeVenue) effectivePrice(buy bool) float64 {
+	if buy {
+		return v.levels[v.position].Price * (1 + v.feeRate)
+	}
+	return v.levels[v.position].Price * (1 - v.feeRate)
+}
+
+// getFreeBalance returns the free balance of a currency across an exchange's
+// cached sub accounts for an asset
+func getFreeBalance(ctx context.Context, exch exchange.IBotExchange, a asset.Item, code currency.Code) (float64, error) {
+	subAccounts, err := exch.GetCachedSubAccounts(ctx, a)
+	if err != nil {
+		return 0, err
+	}
+	var free float64
+	for _, s := range subAccounts {
+		for c, b := range s.Balances {
+			if c.Equal(code) {
+				free += b.Free
+			}
+		}
+	}
+	return free, nil
+}
+
+// trimRouteLevels returns the levels reduced to the amount, removing liquidity
+// from the worst priced levels first
+func trimRouteLevels(levels orderbook.Levels, amount float64) orderbook.Levels {
+	trimmed := make(orderbook.Levels, 0, len(levels))
+	for i := range levels {
+		if amount <= routeDustAmount {
+			break
+		}
+		l := levels[i]
+		l.Amount = math.Min(l.Amount, amount)
+		amount -= l.Amount
+		trimmed = append(trimmed, l)
+	}
+	return trimmed
+}
@@ -0,0 +1,294 @@
+package engine
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchange/accounts"
+	"github.com/thrasher-corp/gocryptotrader/exchange/order/limits"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
+)
+
+// routerExchange overrides the exchange functionality used by the order router
+type routerExchange struct {
+	exchange.IBotExchange
+	name         string
+	feeRate      float64
+	book         *orderbook.Book
+	balances     accounts.CurrencyBalances
+	limits       *limits.MinMaxLevel
+	requirements protocol.TradingRequirements
+}
+
+func (r *routerExchange) GetName() string { return r.name }
+
+func (r *routerExchange) IsEnabled() bool { return true }
+
+func (r *routerExchange) GetEnabledPairs(asset.Item) (currency.Pairs, error) {
+	return currency.Pairs{currency.NewBTCUSDT()}, nil
+}
+
+func (r *routerExchange) GetCachedOrderbook(currency.Pair, asset.Item) (*orderbook.Book, error) {
+	if r.book == nil {
+		return nil, orderbook.ErrOrderbookNotFound
+	}
+	return r.book, nil
+}
+
+func (r *routerExchange) GetFeeByType(_ context.Context, f *exchange.FeeBuilder) (float64, error) {
+	return r.feeRate * f.PurchasePrice * f.Amount, nil
+}
+
+func (r *routerExchange) GetCachedSubAccounts(context.Context, asset.Item) (accounts.SubAccounts, error) {
+	if r.balances == nil {
+		return nil, accounts.ErrNoBalances
+	}
+	return accounts.SubAccounts{{AssetType: asset.Spot, Balances: r.balances}}, nil
+}
+
+func (r *routerExchange) GetOrderExecutionLimits(asset.Item, currency.Pair) (limits.MinMaxLevel, error) {
+	if r.limits == nil {
+		return limits.MinMaxLevel{}, limits.ErrOrderLimitNotFound
+	}
+	return *r.limits, nil
+}
+
+func (r *routerExchange) CheckOrderExecutionLimits(_ asset.Item, _ currency.Pair, price, amount float64, orderType order.Type) error {
+	if r.limits == nil {
+		return limits.ErrExchangeLimitNotLoaded
+	}
+	return r.limits.Validate(price, amount, orderType)
+}
+
+func (r *routerExchange) GetTradingRequirements() protocol.TradingRequirements {
+	return r.requirements
+}
+
+func setupRouterTest(t *testing.T, exchs ...*routerExchange) *OrderRouter {
+	t.Helper()
+	em := NewExchangeManager()
+	for _, e := range exchs {
+		require.NoError(t, em.Add(e))
+	}
+	o, err := NewOrderRouter(em, &fakeEventOrderManager{})
+	require.NoError(t, err)
+	return o
+}
+
+func TestNewOrderRouter(t *testing.T) {
+	t.Parallel()
+	_, err := NewOrderRouter(nil, nil)
+	assert.ErrorIs(t, err, errNilExchangeManager)
+
+	o, err := NewOrderRouter(NewExchangeManager(), nil)
+	require.NoError(t, err)
+	assert.NotNil(t, o)
+}
+
+func TestPlanRoute(t *testing.T) {
+	t.Parallel()
+	cheap := &routerExchange{
+		name:    "cheap",
+		feeRate: 0.01,
+		book: &orderbook.Book{
+			Asks: orderbook.Levels{{Price: 100, Amount: 1}, {Price: 103, Amount: 5}},
+			Bids: orderbook.Levels{{Price: 99, Amount: 1}},
+		},
+	}
+	lowFee := &routerExchange{
+		name: "lowfee",
+		book: &orderbook.Book{
+			Asks: orderbook.Levels{{Price: 100.5, Amount: 1}, {Price: 102, Amount: 1}},
+			Bids: orderbook.Levels{{Price: 98.5, Amount: 2}},
+		},
+	}
+	empty := &routerExchange{name: "empty"}
+	o := setupRouterTest(t, cheap, lowFee, empty)
+
+	var nilRouter *OrderRouter
+	_, err := nilRouter.PlanRoute(t.Context(), &RouteRequest{})
+	assert.ErrorIs(t, err, ErrNilSubsystem)
+	_, err = o.PlanRoute(t.Context(), nil)
+	assert.ErrorIs(t, err, errNilRouteRequest)
+	r := &RouteRequest{}
+	_, err = o.PlanRoute(t.Context(), r)
+	assert.ErrorIs(t, err, currency.ErrCurrencyPairEmpty)
+	r.Pair = currency.NewBTCUSDT()
+	_, err = o.PlanRoute(t.Context(), r)
+	assert.ErrorIs(t, err, asset.ErrNotSupported)
+	r.Asset = asset.Spot
+	_, err = o.PlanRoute(t.Context(), r)
+	assert.ErrorIs(t, err, order.ErrSideIsInvalid)
+	r.Side = order.Bid
+	_, err = o.PlanRoute(t.Context(), r)
+	assert.ErrorIs(t, err, order.ErrAmountIsInvalid)
+	r.Amount = 3
+	r.Exchanges = []string{"bruh"}
+	_, err = o.PlanRoute(t.Context(), r)
+	assert.ErrorIs(t, err, ErrExchangeNotFound)
+	r.Exchanges = nil
+
+	plan, err := o.PlanRoute(t.Context(), r)
+	require.ErrorIs(t, err, errNoRouteLiquidity, "PlanRoute must error when no exchange has an available balance")
+	assert.Nil(t, plan)
+
+	r.IgnoreBalances = true
+	plan, err = o.PlanRoute(t.Context(), r)
+	require.NoError(t, err)
+	assert.Equal(t, order.Buy, plan.Side, "PlanRoute should normalise the side")
+	require.Len(t, plan.Legs, 2)
+	// After fees cheap's ask at 100 costs 101, so it is used between lowfee's
+	// asks at 100.5 and 102
+	assert.Equal(t, "cheap", plan.Legs[0].Exchange)
+	assert.Equal(t, 1.0, plan.Legs[0].Amount)
+	assert.Equal(t, "lowfee", plan.Legs[1].Exchange)
+	assert.Equal(t, 2.0, plan.Legs[1].Amount)
+	assert.Equal(t, 102.0, plan.Legs[1].WorstPrice)
+	assert.InDelta(t, 101.25, plan.Legs[1].AveragePrice, 1e-9)
+	assert.InDelta(t, 1.0, plan.Legs[0].Fee, 1e-9)
+	assert.InDelta(t, 101.0, plan.Legs[0].EffectivePrice, 1e-9)
+	assert.Equal(t, 3.0, plan.RoutedAmount)
+	assert.Zero(t, plan.UnroutedAmount)
+	assert.InDelta(t, 302.5/3, plan.AveragePrice, 1e-9)
+	assert.InDelta(t, 303.5/3, plan.EffectivePrice, 1e-9)
+	require.Len(t, plan.Skipped, 1)
+	assert.Equal(t, "empty", plan.Skipped[0].Exchange)
+	assert.NotEmpty(t, plan.Status)
+
+	r.Amount = 10
+	plan, err = o.PlanRoute(t.Context(), r)
+	require.NoError(t, err)
+	assert.Equal(t, 8.0, plan.RoutedAmount)
+	assert.Equal(t, 2.0, plan.UnroutedAmount, "PlanRoute should report amounts which exceed available liquidity")
+	assert.Contains(t, plan.Status, "could not be routed")
+
+	r.Side = order.Sell
+	r.Amount = 2
+	plan, err = o.PlanRoute(t.Context(), r)
+	require.NoError(t, err)
+	require.Len(t, plan.Legs, 1, "PlanRoute should route sells to the best bid after fees")
+	assert.Equal(t, "lowfee", plan.Legs[0].Exchange)
+	assert.InDelta(t, 98.5, plan.EffectivePrice, 1e-9)
+
+	r.Exchanges = []string{"cheap"}
+	plan, err = o.PlanRoute(t.Context(), r)
+	require.NoError(t, err)
+	require.Len(t, plan.Legs, 1, "PlanRoute should only use requested exchanges")
+	assert.Equal(t, "cheap", plan.Legs[0].Exchange)
+	assert.InDelta(t, 99*0.99, plan.EffectivePrice, 1e-9)
+}
+
+func TestPlanRouteBalances(t *testing.T) {
+	t.Parallel()
+	a := &routerExchange{
+		name:     "a",
+		book:     &orderbook.Book{Asks: orderbook.Levels{{Price: 100, Amount: 5}}, Bids: orderbook.Levels{{Price: 100, Amount: 5}}},
+		balances: accounts.CurrencyBalances{currency.USDT: {Free: 150}, currency.BTC: {Free: 0.5}},
+	}
+	b := &routerExchange{
+		name:     "b",
+		book:     &orderbook.Book{Asks: orderbook.Levels{{Price: 110, Amount: 5}}, Bids: orderbook.Levels{{Price: 90, Amount: 5}}},
+		balances: accounts.CurrencyBalances{currency.USDT: {Free: 1000}, currency.BTC: {Free: 3}},
+	}
+	o := setupRouterTest(t, a, b)
+
+	plan, err := o.PlanRoute(t.Context(), &RouteRequest{Pair: currency.NewBTCUSDT(), Asset: asset.Spot, Side: order.Buy, Amount: 3})
+	require.NoError(t, err)
+	require.Len(t, plan.Legs, 2)
+	assert.InDelta(t, 1.5, plan.Legs[0].Amount, 1e-9, "buys should be limited by the available quote balance")
+	assert.InDelta(t, 1.5, plan.Legs[1].Amount, 1e-9)
+
+	plan, err = o.PlanRoute(t.Context(), &RouteRequest{Pair: currency.NewBTCUSDT(), Asset: asset.Spot, Side: order.Sell, Amount: 3})
+	require.NoError(t, err)
+	require.Len(t, plan.Legs, 2)
+	assert.InDelta(t, 0.5, plan.Legs[0].Amount, 1e-9, "sells should be limited by the available base balance")
+	assert.InDelta(t, 2.5, plan.Legs[1].Amount, 1e-9)
+}
+
+func TestPlanRouteLimits(t *testing.T) {
+	t.Parallel()
+	a := &routerExchange{
+		name:   "a",
+		book:   &orderbook.Book{Asks: orderbook.Levels{{Price: 100, Amount: 5}}},
+		limits: &limits.MinMaxLevel{MaximumBaseAmount: 2, AmountStepIncrementSize: 0.5},
+	}
+	b := &routerExchange{
+		name: "b",
+		book: &orderbook.Book{Asks: orderbook.Levels{{Price: 101, Amount: 0.25}, {Price: 102, Amount: 5}}},
+	}
+	o := setupRouterTest(t, a, b)
+
+	plan, err := o.PlanRoute(t.Context(), &RouteRequest{Pair: currency.NewBTCUSDT(), Asset: asset.Spot, Side: order.Buy, Amount: 3.2, IgnoreBalances: true})
+	require.NoError(t, err)
+	require.Len(t, plan.Legs, 2)
+	assert.Equal(t, 2.0, plan.Legs[0].Amount, "legs should be capped by the maximum order amount")
+	assert.InDelta(t, 1.2, plan.Legs[1].Amount, 1e-9)
+
+	plan, err = o.PlanRoute(t.Context(), &RouteRequest{Pair: currency.NewBTCUSDT(), Asset: asset.Spot, Side: order.Buy, Amount: 1.7, IgnoreBalances: true})
+	require.NoError(t, err)
+	require.Len(t, plan.Legs, 1)
+	assert.Equal(t, 1.5, plan.Legs[0].Amount, "legs should be floored to the amount step")
+	assert.InDelta(t, 0.2, plan.UnroutedAmount, 1e-9)
+
+	a.limits.MinimumBaseAmount = 2
+	plan, err = o.PlanRoute(t.Context(), &RouteRequest{Pair: currency.NewBTCUSDT(), Asset: asset.Spot, Side: order.Buy, Amount: 1, IgnoreBalances: true})
+	require.NoError(t, err)
+	require.Len(t, plan.Legs, 1, "exchanges whose legs fail execution limits should be excluded")
+	assert.Equal(t, "b", plan.Legs[0].Exchange)
+	assert.Equal(t, 1.0, plan.Legs[0].Amount)
+	require.Len(t, plan.Skipped, 1)
+	assert.Contains(t, plan.Skipped[0].Reason, limits.ErrAmountBelowMin.Error())
+}
+
+func TestExecuteRoute(t *testing.T) {
+	t.Parallel()
+	a := &routerExchange{
+		name:         "a",
+		book:         &orderbook.Book{Asks: orderbook.Levels{{Price: 100, Amount: 1}}},
+		requirements: protocol.TradingRequirements{SpotMarketBuyQuotation: true, ClientOrderID: true},
+	}
+	b := &routerExchange{
+		name: "b",
+		book: &orderbook.Book{Asks: orderbook.Levels{{Price: 101, Amount: 1}}},
+	}
+	o := setupRouterTest(t, a, b)
+	plan, err := o.PlanRoute(t.Context(), &RouteRequest{Pair: currency.NewBTCUSDT(), Asset: asset.Spot, Side: order.Buy, Amount: 2, IgnoreBalances: true})
+	require.NoError(t, err)
+
+	var nilRouter *OrderRouter
+	_, err = nilRouter.ExecuteRoute(t.Context(), plan)
+	assert.ErrorIs(t, err, ErrNilSubsystem)
+	_, err = o.ExecuteRoute(t.Context(), nil)
+	assert.ErrorIs(t, err, errNilRoutePlan)
+
+	om := &fakeEventOrderManager{}
+	o.orderManager = om
+	results, err := o.ExecuteRoute(t.Context(), plan)
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	require.Len(t, om.submitted, 2)
+	assert.Equal(t, "1337", results[0].Response.OrderID)
+	assert.Equal(t, order.Market, om.submitted[0].Type)
+	assert.Zero(t, om.submitted[0].Amount, "market buys should use the quote amount when required")
+	assert.Equal(t, 100.0, om.submitted[0].QuoteAmount)
+	assert.NotEmpty(t, om.submitted[0].ClientOrderID, "a client order ID should be set when required")
+	assert.Equal(t, 1.0, om.submitted[1].Amount)
+	assert.Empty(t, om.submitted[1].ClientOrderID)
+
+	plan.Legs = append(plan.Legs, RouteLeg{Exchange: "bruh", Amount: 1})
+	results, err = o.ExecuteRoute(t.Context(), plan)
+	assert.ErrorIs(t, err, errRouteLegsRejected)
+	require.Len(t, results, 3)
+	assert.ErrorIs(t, results[2].Err, ErrExchangeNotFound)
+
+	o.orderManager = nil
+	_, err = o.ExecuteRoute(t.Context(), plan)
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted)
+}
@@ -0,0 +1,110 @@
+package engine
+
+import (
+	"errors"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchange/order/limits"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+// routeDustAmount is the remaining amount below which a route is considered
+// fully allocated
+const routeDustAmount = 1e-12
+
+var (
+	errNilRouteRequest      = errors.New("nil route request")
+	errNilRoutePlan         = errors.New("nil route plan")
+	errNoRouteLiquidity     = errors.New("no exchange liquidity available to route order")
+	errRouteFeeInvalid      = errors.New("invalid trading fee")
+	errNoOrderbookLiquidity = errors.New("no orderbook liquidity")
+	errRouteLegsRejected    = errors.New("route legs rejected")
+)
+
+// OrderRouter splits orders across exchanges by the best effective price
+// available in their cached orderbooks
+type OrderRouter struct {
+	exchangeManager iExchangeManager
+	orderManager    iOrderManager
+}
+
+// RouteRequest defines an order to be split across exchanges
+type RouteRequest struct {
+	Pair  currency.Pair
+	Asset asset.Item
+	Side  order.Side
+	// Amount is the total base currency amount to buy or sell
+	Amount float64
+	// Exchanges limits routing to the named exchanges. All enabled exchanges
+	// are used when empty
+	Exchanges []string
+	// IgnoreBalances plans the route without limiting each exchange to its
+	// available balance
+	IgnoreBalances bool
+}
+
+// RoutePlan holds the legs an order is split into
+type RoutePlan struct {
+	Pair            currency.Pair
+	Asset           asset.Item
+	Side            order.Side
+	RequestedAmount float64
+	RoutedAmount    float64
+	UnroutedAmount  float64
+	// AveragePrice is the volume weighted price across all legs before fees
+	AveragePrice float64
+	// EffectivePrice is the average price including fees
+	EffectivePrice float64
+	TotalFee       float64
+	Legs           []RouteLeg
+	Skipped        []RouteSkipped
+	Status         string
+}
+
+// RouteLeg holds the portion of a routed order sent to a single exchange
+type RouteLeg struct {
+	Exchange       string
+	Amount         float64
+	AveragePrice   float64
+	WorstPrice     float64
+	EffectivePrice float64
+	// Cost is the quote currency value of the leg before fees
+	Cost   float64
+	Fee    float64
+	Levels orderbook.Levels
+}
+
+// RouteSkipped holds an exchange which could not be used for routing and why
+type RouteSkipped struct {
+	Exchange string
+	Reason   string
+}
+
+// RouteLegResult holds the outcome of submitting a route leg
+type RouteLegResult struct {
+	Leg      RouteLeg
+	Response *OrderSubmitResponse
+	Err      error
+}
+
+// routeVenue holds an exchange's orderbook side and capacity while a route is
+// being planned
+type routeVenue struct {
+	exch     exchange.IBotExchange
+	name     string
+	levels   orderbook.Levels
+	feeRate  float64
+	position int
+	// taken is the amount already allocated from the level at position
+	taken float64
+	// baseCapacity is the remaining base amount the exchange can trade
+	baseCapacity float64
+	// quoteCapacity is the remaining quote amount including fees the exchange
+	// can spend on buys
+	quoteCapacity float64
+	limits        *limits.MinMaxLevel
+	leg           RouteLeg
+}
@@ -0,0 +1,183 @@
+package engine
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook/capture"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// setupOrderbookCaptureManager creates a new orderbook capture manager.
+// Captures are written under the data directory when no directory is
+// configured
+func setupOrderbookCaptureManager(em iExchangeManager, cfg *config.OrderbookCaptureManager, dataDir string) (*orderbookCaptureManager, error) {
+	if em == nil {
+		return nil, errNilExchangeManager
+	}
+	if cfg == nil {
+		return nil, errNilConfig
+	}
+	if cfg.SnapshotInterval < 0 || cfg.MaxFileSize < 0 || cfg.MaxFileAge < 0 {
+		return nil, errInvalidOrderbookCaptureSetting
+	}
+	dir := cfg.Directory
+	if dir == "" {
+		if dataDir == "" {
+			return nil, errOrderbookCaptureDirectoryNotSet
+		}
+		dir = filepath.Join(dataDir, orderbookCaptureDirectory)
+	}
+	targets, err := loadOrderbookCaptureTargets(cfg.Targets)
+	if err != nil {
+		return nil, err
+	}
+	return &orderbookCaptureManager{
+		exchangeManager: em,
+		config: capture.Config{
+			Directory:        dir,
+			SnapshotInterval: cfg.SnapshotInterval,
+			MaxFileSize:      cfg.MaxFileSize,
+			MaxFileAge:       cfg.MaxFileAge,
+		},
+		targets:   targets,
+		verbose:   cfg.Verbose,
+		recorders: make([]*capture.Recorder, len(targets)),
+	}, nil
+}
+
+// loadOrderbookCaptureTargets validates the configured capture targets
+func loadOrderbookCaptureTargets(cfgTargets []config.OrderbookCaptureTarget) ([]orderbookCaptureTarget, error) {
+	if len(cfgTargets) == 0 {
+		return nil, errNoOrderbookCaptureTargets
+	}
+	targets := make([]orderbookCaptureTarget, 0, len(cfgTargets))
+	for i := range cfgTargets {
+		if cfgTargets[i].Exchange == "" {
+			return nil, fmt.Errorf("%w %d: exchange name unset", errInvalidOrderbookCaptureTarget, i)
+		}
+		a, err := asset.New(cfgTargets[i].Asset)
+		if err != nil {
+			return nil, fmt.Errorf("%w %d: %w", errInvalidOrderbookCaptureTarget, i, err)
+		}
+		p, err := currency.NewPairFromString(cfgTargets[i].Pair)
+		if err != nil {
+			return nil, fmt.Errorf("%w %d: %w", errInvalidOrderbookCaptureTarget, i, err)
+		}
+		target := orderbookCaptureTarget{exchange: strings.ToLower(cfgTargets[i].Exchange), asset: a, pair: p}
+		for j := range targets {
+			if targets[j].exchange == target.exchange && targets[j].asset == target.asset && targets[j].pair.Equal(target.pair) {
+				return nil, fmt.Errorf("%w %s %s %s", errDuplicateOrderbookCaptureTarget, cfgTargets[i].Exchange, a, p)
+			}
+		}
+		targets = append(targets, target)
+	}
+	return targets, nil
+}
+
+// IsRunning safely checks whether the subsystem is running
+func (m *orderbookCaptureManager) IsRunning() bool {
+	return m != nil && atomic.LoadInt32(&m.started) == 1
+}
+
+// Start runs the subsystem
+func (m *orderbookCaptureManager) Start() error {
+	if m == nil {
+		return fmt.Errorf("orderbook capture manager %w", ErrNilSubsystem)
+	}
+	if !atomic.CompareAndSwapInt32(&m.started, 0, 1) {
+		return fmt.Errorf("orderbook capture manager %w", ErrSubSystemAlreadyStarted)
+	}
+	m.shutdown = make(chan struct{})
+	m.attach()
+	m.wg.Add(1)
+	go m.run()
+	log.Debugf(log.OrderBook, "Orderbook capture manager %s", MsgSubSystemStarted)
+	return nil
+}
+
+// Stop attempts to shutdown the subsystem
+func (m *orderbookCaptureManager) Stop() error {
+	if m == nil {
+		return fmt.Errorf("orderbook capture manager %w", ErrNilSubsystem)
+	}
+	if !atomic.CompareAndSwapInt32(&m.started, 1, 0) {
+		return fmt.Errorf("orderbook capture manager %w", ErrSubSystemNotStarted)
+	}
+	close(m.shutdown)
+	m.wg.Wait()
+	m.m.Lock()
+	for i, r := range m.recorders {
+		if r == nil {
+			continue
+		}
+		if err := r.Stop(); err != nil {
+			log.Errorf(log.OrderBook, "Orderbook capture manager unable to stop %s %s %s capture: %s", m.targets[i].exchange, m.targets[i].asset, m.targets[i].pair, err)
+		}
+		m.recorders[i] = nil
+	}
+	m.m.Unlock()
+	log.Debugf(log.OrderBook, "Orderbook capture manager %s", MsgSubSystemShutdown)
+	return nil
+}
+
+// run attaches targets as their exchanges are loaded until shutdown
+func (m *orderbookCaptureManager) run() {
+	defer m.wg.Done()
+	t := time.NewTicker(orderbookCaptureAttachInterval)
+	defer t.Stop()
+	for {
+		select {
+		case <-m.shutdown:
+			return
+		case <-t.C:
+			m.attach()
+		}
+	}
+}
+
+// attach starts recording each target which is not yet recorded once its
+// exchange is loaded and its orderbook has been received
+func (m *orderbookCaptureManager) attach() {
+	m.m.Lock()
+	defer m.m.Unlock()
+	for i := range m.targets {
+		if m.recorders[i] != nil {
+			continue
+		}
+		target := &m.targets[i]
+		exch, err := m.exchangeManager.GetExchangeByName(target.exchange)
+		if err != nil {
+			if m.verbose {
+				log.Errorf(log.OrderBook, "Orderbook capture manager unable to capture %s %s %s: %s", target.exchange, target.asset, target.pair, err)
+			}
+			continue
+		}
+		depth, err := orderbook.GetDepth(exch.GetName(), target.pair, target.asset)
+		if err != nil {
+			if m.verbose {
+				log.Debugf(log.OrderBook, "Orderbook capture manager waiting on %s %s %s orderbook: %s", exch.GetName(), target.asset, target.pair, err)
+			}
+			continue
+		}
+		r, err := capture.NewRecorder(depth, exch.GetName(), target.asset, target.pair, &m.config)
+		if err == nil {
+			err = r.Start()
+		}
+		if err != nil {
+			log.Errorf(log.OrderBook, "Orderbook capture manager unable to capture %s %s %s: %s", exch.GetName(), target.asset, target.pair, err)
+			continue
+		}
+		m.recorders[i] = r
+		if m.verbose {
+			log.Debugf(log.OrderBook, "Orderbook capture manager capturing %s %s %s to %s", exch.GetName(), target.asset, target.pair, capture.Dir(m.config.Directory, exch.GetName(), target.asset, target.pair))
+		}
+	}
+}
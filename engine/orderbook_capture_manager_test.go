@@ -0,0 +1,124 @@
+package engine
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook/capture"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/sharedtestvalues"
+)
+
+// captureExchange overrides the exchange name so captured orderbooks are not
+// shared with other tests
+type captureExchange struct {
+	sharedtestvalues.CustomEx
+	name string
+}
+
+func (c *captureExchange) GetName() string { return c.name }
+
+func TestSetupOrderbookCaptureManager(t *testing.T) {
+	t.Parallel()
+	targets := []config.OrderbookCaptureTarget{{Exchange: "Binance", Asset: "spot", Pair: "BTC-USDT"}}
+	_, err := setupOrderbookCaptureManager(nil, nil, "")
+	assert.ErrorIs(t, err, errNilExchangeManager)
+	_, err = setupOrderbookCaptureManager(NewExchangeManager(), nil, "")
+	assert.ErrorIs(t, err, errNilConfig)
+	_, err = setupOrderbookCaptureManager(NewExchangeManager(), &config.OrderbookCaptureManager{Targets: targets, SnapshotInterval: -1}, "data")
+	assert.ErrorIs(t, err, errInvalidOrderbookCaptureSetting)
+	_, err = setupOrderbookCaptureManager(NewExchangeManager(), &config.OrderbookCaptureManager{Targets: targets}, "")
+	assert.ErrorIs(t, err, errOrderbookCaptureDirectoryNotSet)
+	_, err = setupOrderbookCaptureManager(NewExchangeManager(), &config.OrderbookCaptureManager{}, "data")
+	assert.ErrorIs(t, err, errNoOrderbookCaptureTargets)
+
+	for _, tc := range [][]config.OrderbookCaptureTarget{
+		{{Asset: "spot", Pair: "BTC-USDT"}},
+		{{Exchange: "Binance", Asset: "meow", Pair: "BTC-USDT"}},
+		{{Exchange: "Binance", Asset: "spot", Pair: ""}},
+	} {
+		_, err = setupOrderbookCaptureManager(NewExchangeManager(), &config.OrderbookCaptureManager{Targets: tc}, "data")
+		assert.ErrorIs(t, err, errInvalidOrderbookCaptureTarget)
+	}
+	_, err = setupOrderbookCaptureManager(NewExchangeManager(), &config.OrderbookCaptureManager{Targets: append(targets, config.OrderbookCaptureTarget{Exchange: "binance", Asset: "SPOT", Pair: "btc_usdt"})}, "data")
+	assert.ErrorIs(t, err, errDuplicateOrderbookCaptureTarget)
+
+	m, err := setupOrderbookCaptureManager(NewExchangeManager(), &config.OrderbookCaptureManager{Targets: targets}, "data")
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join("data", orderbookCaptureDirectory), m.config.Directory, "Directory should default to the data directory")
+	require.Len(t, m.targets, 1)
+	assert.Equal(t, "binance", m.targets[0].exchange, "Exchange names should be lowercased")
+	assert.Equal(t, asset.Spot, m.targets[0].asset)
+	assert.True(t, m.targets[0].pair.Equal(currency.NewBTCUSDT()))
+
+	m, err = setupOrderbookCaptureManager(NewExchangeManager(), &config.OrderbookCaptureManager{Targets: targets, Directory: "captures"}, "data")
+	require.NoError(t, err)
+	assert.Equal(t, "captures", m.config.Directory)
+}
+
+func TestOrderbookCaptureManagerStartStop(t *testing.T) {
+	t.Parallel()
+	var m *orderbookCaptureManager
+	assert.ErrorIs(t, m.Start(), ErrNilSubsystem)
+	assert.ErrorIs(t, m.Stop(), ErrNilSubsystem)
+	assert.False(t, m.IsRunning(), "IsRunning should be false on a nil manager")
+
+	m, err := setupOrderbookCaptureManager(NewExchangeManager(), &config.OrderbookCaptureManager{
+		Targets: []config.OrderbookCaptureTarget{{Exchange: "Binance", Asset: "spot", Pair: "BTC-USDT"}},
+	}, t.TempDir())
+	require.NoError(t, err)
+	assert.ErrorIs(t, m.Stop(), ErrSubSystemNotStarted)
+	require.NoError(t, m.Start())
+	assert.True(t, m.IsRunning(), "IsRunning should be true after Start")
+	assert.ErrorIs(t, m.Start(), ErrSubSystemAlreadyStarted)
+	require.NoError(t, m.Stop())
+	assert.False(t, m.IsRunning(), "IsRunning should be false after Stop")
+}
+
+func TestOrderbookCaptureManagerAttach(t *testing.T) {
+	t.Parallel()
+	const exchName = "orderbookcapturetest"
+	dir := t.TempDir()
+	em := NewExchangeManager()
+	m, err := setupOrderbookCaptureManager(em, &config.OrderbookCaptureManager{
+		Targets: []config.OrderbookCaptureTarget{{Exchange: exchName, Asset: "spot", Pair: "BTC-USDT"}},
+		Verbose: true,
+	}, dir)
+	require.NoError(t, err)
+	require.NoError(t, m.Start())
+
+	m.attach()
+	assert.Nil(t, m.recorders[0], "Targets should not be recorded before their exchange is loaded")
+	require.NoError(t, em.Add(&captureExchange{name: exchName}))
+	m.attach()
+	assert.Nil(t, m.recorders[0], "Targets should not be recorded before their orderbook is received")
+
+	now := time.Now()
+	require.NoError(t, (&orderbook.Book{
+		Exchange:    exchName,
+		Pair:        currency.NewBTCUSDT(),
+		Asset:       asset.Spot,
+		Bids:        orderbook.Levels{{Price: 100, Amount: 1}},
+		Asks:        orderbook.Levels{{Price: 101, Amount: 1}},
+		LastUpdated: now,
+	}).Process())
+	m.attach()
+	require.NotNil(t, m.recorders[0], "Targets should be recorded once their orderbook is received")
+	r := m.recorders[0]
+	require.Eventually(t, func() bool { return r.Stats().Written == 1 }, time.Second, time.Millisecond, "The current book should be captured")
+	require.NoError(t, m.Stop())
+	assert.Nil(t, m.recorders[0], "Stop should stop recorders")
+
+	reader, err := capture.NewReader(filepath.Join(dir, orderbookCaptureDirectory), exchName, asset.Spot, currency.NewBTCUSDT())
+	require.NoError(t, err)
+	book, err := reader.BookAt(now)
+	require.NoError(t, err)
+	assert.Equal(t, orderbook.Levels{{Price: 100, Amount: 1}}, book.Bids)
+	assert.Equal(t, orderbook.Levels{{Price: 101, Amount: 1}}, book.Asks)
+}
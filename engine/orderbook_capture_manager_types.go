@@ -0,0 +1,54 @@
+package engine
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook/capture"
+)
+
+const (
+	// OrderbookCaptureManagerName is an exported subsystem name
+	OrderbookCaptureManagerName = "orderbook_capture_manager"
+
+	// orderbookCaptureAttachInterval is how often targets whose exchange was
+	// not loaded are attached
+	orderbookCaptureAttachInterval = 5 * time.Second
+	orderbookCaptureDirectory      = "orderbookcapture"
+)
+
+var (
+	errNoOrderbookCaptureTargets       = errors.New("no orderbook capture targets")
+	errInvalidOrderbookCaptureTarget   = errors.New("invalid orderbook capture target")
+	errDuplicateOrderbookCaptureTarget = errors.New("duplicate orderbook capture target")
+	errInvalidOrderbookCaptureSetting  = errors.New("orderbook capture intervals and sizes cannot be negative")
+	errOrderbookCaptureDirectoryNotSet = errors.New("orderbook capture directory not set")
+)
+
+// orderbookCaptureManager records the snapshots and incremental updates of
+// chosen exchange orderbooks to rotating compressed files which can be read
+// back with the capture package
+type orderbookCaptureManager struct {
+	started         int32
+	exchangeManager iExchangeManager
+	config          capture.Config
+	targets         []orderbookCaptureTarget
+	verbose         bool
+	shutdown        chan struct{}
+	wg              sync.WaitGroup
+
+	m sync.Mutex
+	// recorders holds the recorder of each target by index, nil until the
+	// target's exchange is loaded
+	recorders []*capture.Recorder
+}
+
+// orderbookCaptureTarget is a validated orderbook to capture
+type orderbookCaptureTarget struct {
+	exchange string
+	asset    asset.Item
+	pair     currency.Pair
+}
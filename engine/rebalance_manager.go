@@ -0,0 +1,601 @@
+package engine
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchange/order/limits"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// setupRebalanceManager creates a new rebalance manager
+func setupRebalanceManager(em iExchangeManager, om iOrderManager, cfg *config.RebalanceManager) (*rebalanceManager, error) {
+	if em == nil {
+		return nil, errNilExchangeManager
+	}
+	if cfg == nil {
+		return nil, errNilConfig
+	}
+	if cfg.DriftTolerance < 0 || cfg.DriftTolerance >= 1 {
+		return nil, fmt.Errorf("%w: %v", errInvalidDriftTolerance, cfg.DriftTolerance)
+	}
+	exchanges := make([]string, len(cfg.Exchanges))
+	for i := range cfg.Exchanges {
+		exchanges[i] = strings.ToLower(cfg.Exchanges[i])
+	}
+	targets, err := loadRebalanceTargets(cfg.Targets, exchanges)
+	if err != nil {
+		return nil, err
+	}
+	reporting := currency.USD
+	if cfg.ReportingCurrency != "" {
+		reporting = currency.NewCode(cfg.ReportingCurrency).Upper()
+	}
+	interval := cfg.Interval
+	if interval <= 0 {
+		interval = defaultRebalanceInterval
+	}
+	planExpiry := cfg.PlanExpiry
+	if planExpiry <= 0 {
+		planExpiry = defaultRebalancePlanExpiry
+	}
+	return &rebalanceManager{
+		exchangeManager: em,
+		orderManager:    om,
+		reporting:       reporting,
+		targets:         targets,
+		tolerance:       cfg.DriftTolerance,
+		minTradeValue:   max(cfg.MinimumTradeValue, 0),
+		interval:        interval,
+		planExpiry:      planExpiry,
+		exchanges:       exchanges,
+		execute:         cfg.Execute,
+		verbose:         cfg.Verbose,
+	}, nil
+}
+
+// loadRebalanceTargets validates the configured target weights. A currency
+// can either have a single target across exchanges or targets on individual
+// exchanges
+func loadRebalanceTargets(cfg []config.RebalanceTarget, exchanges []string) ([]rebalanceTarget, error) {
+	if len(cfg) == 0 {
+		return nil, errNoRebalanceTargets
+	}
+	targets := make([]rebalanceTarget, len(cfg))
+	var sum float64
+	for i := range cfg {
+		t := rebalanceTarget{
+			currency: currency.NewCode(cfg[i].Currency).Upper(),
+			exchange: strings.ToLower(cfg[i].Exchange),
+			weight:   cfg[i].Weight,
+		}
+		if t.currency.IsEmpty() {
+			return nil, fmt.Errorf("%w: %w", errInvalidRebalanceTarget, currency.ErrCurrencyCodeEmpty)
+		}
+		if t.weight < 0 || t.weight > 1 {
+			return nil, fmt.Errorf("%w: %s weight %v must be between zero and one", errInvalidRebalanceTarget, t.currency, t.weight)
+		}
+		if t.exchange != "" && len(exchanges) > 0 && !slices.Contains(exchanges, t.exchange) {
+			return nil, fmt.Errorf("%w: %s %s", errRebalanceExchangeNotInScope, t.exchange, t.currency)
+		}
+		for j := range targets[:i] {
+			if !targets[j].currency.Equal(t.currency) {
+				continue
+			}
+			if targets[j].exchange == t.exchange {
+				return nil, fmt.Errorf("%w: duplicate %s target", errInvalidRebalanceTarget, t.currency)
+			}
+			if targets[j].exchange == "" || t.exchange == "" {
+				return nil, fmt.Errorf("%w: %s cannot have targets both across and on individual exchanges", errInvalidRebalanceTarget, t.currency)
+			}
+		}
+		targets[i] = t
+		sum += t.weight
+	}
+	if math.Abs(sum-1) > rebalanceWeightTolerance {
+		return nil, fmt.Errorf("%w: %v", errRebalanceWeightsInvalid, sum)
+	}
+	return targets, nil
+}
+
+// IsRunning safely checks whether the subsystem is running
+func (m *rebalanceManager) IsRunning() bool {
+	return m != nil && atomic.LoadInt32(&m.started) == 1
+}
+
+// Start runs the subsystem
+func (m *rebalanceManager) Start() error {
+	if m == nil {
+		return fmt.Errorf("rebalance manager %w", ErrNilSubsystem)
+	}
+	if !atomic.CompareAndSwapInt32(&m.started, 0, 1) {
+		return fmt.Errorf("rebalance manager %w", ErrSubSystemAlreadyStarted)
+	}
+	m.shutdown = make(chan struct{})
+	m.wg.Add(1)
+	go m.run()
+	log.Debugf(log.PortfolioMgr, "Rebalance manager %s", MsgSubSystemStarted)
+	return nil
+}
+
+// Stop attempts to shutdown the subsystem
+func (m *rebalanceManager) Stop() error {
+	if m == nil {
+		return fmt.Errorf("rebalance manager %w", ErrNilSubsystem)
+	}
+	if !atomic.CompareAndSwapInt32(&m.started, 1, 0) {
+		return fmt.Errorf("rebalance manager %w", ErrSubSystemNotStarted)
+	}
+	close(m.shutdown)
+	m.wg.Wait()
+	log.Debugf(log.PortfolioMgr, "Rebalance manager %s", MsgSubSystemShutdown)
+	return nil
+}
+
+// run plans a rebalance on each interval, executing it when configured to
+func (m *rebalanceManager) run() {
+	defer m.wg.Done()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case <-m.shutdown:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	t := time.NewTicker(m.interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-m.shutdown:
+			return
+		case <-t.C:
+			m.rebalance(ctx)
+		}
+	}
+}
+
+// rebalance plans a rebalance and executes it when configured to
+func (m *rebalanceManager) rebalance(ctx context.Context) {
+	plan, err := m.PlanRebalance(ctx)
+	if err != nil {
+		log.Errorf(log.PortfolioMgr, "Rebalance manager unable to plan rebalance: %s", err)
+		return
+	}
+	if m.verbose || plan.Status == RebalanceStatusPendingApproval {
+		log.Infof(log.PortfolioMgr, "Rebalance manager plan %s %s with %d trade(s) across holdings valued at %v %s",
+			plan.ID, plan.Status, len(plan.Trades), plan.TotalValue, plan.ReportingCurrency)
+	}
+	if !m.execute || plan.Status != RebalanceStatusPendingApproval {
+		return
+	}
+	plan, err = m.ApproveRebalancePlan(ctx, plan.ID.String())
+	if err != nil {
+		log.Errorf(log.PortfolioMgr, "Rebalance manager unable to execute plan: %s", err)
+		return
+	}
+	log.Infof(log.PortfolioMgr, "Rebalance manager plan %s %s", plan.ID, plan.Status)
+}
+
+// PlanRebalance plans the trades needed to return holdings to their target
+// weights. The plan replaces any plan awaiting approval
+func (m *rebalanceManager) PlanRebalance(ctx context.Context) (*RebalancePlan, error) {
+	if m == nil {
+		return nil, fmt.Errorf("rebalance manager %w", ErrNilSubsystem)
+	}
+	if !m.IsRunning() {
+		return nil, fmt.Errorf("rebalance manager %w", ErrSubSystemNotStarted)
+	}
+	plan, err := m.planRebalance(ctx)
+	if err != nil {
+		return nil, err
+	}
+	m.m.Lock()
+	defer m.m.Unlock()
+	if m.plan != nil && m.plan.Status == RebalanceStatusExecuting {
+		return nil, fmt.Errorf("%w: plan %s is executing", errRebalancePlanNotPending, m.plan.ID)
+	}
+	m.plan = plan
+	return plan.clone(), nil
+}
+
+// GetRebalancePlan returns the most recent rebalance plan
+func (m *rebalanceManager) GetRebalancePlan() (*RebalancePlan, error) {
+	if m == nil {
+		return nil, fmt.Errorf("rebalance manager %w", ErrNilSubsystem)
+	}
+	if !m.IsRunning() {
+		return nil, fmt.Errorf("rebalance manager %w", ErrSubSystemNotStarted)
+	}
+	m.m.Lock()
+	defer m.m.Unlock()
+	if m.plan == nil {
+		return nil, errNoRebalancePlan
+	}
+	if m.plan.Status == RebalanceStatusPendingApproval && time.Now().After(m.plan.ExpiresAt) {
+		m.plan.Status = RebalanceStatusExpired
+	}
+	return m.plan.clone(), nil
+}
+
+// ApproveRebalancePlan submits the trades of the plan awaiting approval
+// through the order manager. Every trade is attempted and the plan returned,
+// along with an error when any trade was rejected
+func (m *rebalanceManager) ApproveRebalancePlan(ctx context.Context, id string) (*RebalancePlan, error) {
+	if m == nil {
+		return nil, fmt.Errorf("rebalance manager %w", ErrNilSubsystem)
+	}
+	if !m.IsRunning() {
+		return nil, fmt.Errorf("rebalance manager %w", ErrSubSystemNotStarted)
+	}
+	u, err := uuid.FromString(id)
+	if err != nil {
+		return nil, fmt.Errorf("%w %v: %w", errNoRebalancePlan, id, err)
+	}
+	if m.orderManager == nil || !m.orderManager.IsRunning() {
+		return nil, fmt.Errorf("order manager %w", ErrSubSystemNotStarted)
+	}
+	m.m.Lock()
+	p := m.plan
+	if p == nil || p.ID != u {
+		m.m.Unlock()
+		return nil, fmt.Errorf("%w %v", errNoRebalancePlan, id)
+	}
+	if p.Status == RebalanceStatusPendingApproval && time.Now().After(p.ExpiresAt) {
+		p.Status = RebalanceStatusExpired
+	}
+	switch p.Status {
+	case RebalanceStatusPendingApproval:
+	case RebalanceStatusExpired:
+		m.m.Unlock()
+		return nil, fmt.Errorf("%w %v", errRebalancePlanExpired, id)
+	default:
+		m.m.Unlock()
+		return nil, fmt.Errorf("%w %v: %s", errRebalancePlanNotPending, id, p.Status)
+	}
+	p.Status = RebalanceStatusExecuting
+	trades := slices.Clone(p.Trades)
+	m.m.Unlock()
+
+	var failed int
+	for i := range trades {
+		if err := m.submitTrade(ctx, &trades[i]); err != nil {
+			trades[i].Error = err.Error()
+			failed++
+		}
+	}
+
+	m.m.Lock()
+	p.Trades = trades
+	p.ExecutedAt = time.Now()
+	p.Status = RebalanceStatusExecuted
+	if failed > 0 {
+		p.Status = RebalanceStatusFailed
+		err = fmt.Errorf("%w: %d of %d trades failed", errRebalanceTradesRejected, failed, len(trades))
+	}
+	resp := p.clone()
+	m.m.Unlock()
+	return resp, err
+}
+
+// submitTrade submits a planned trade as a market order
+func (m *rebalanceManager) submitTrade(ctx context.Context, t *RebalanceTrade) error {
+	exch, err := m.exchangeManager.GetExchangeByName(t.Exchange)
+	if err != nil {
+		return err
+	}
+	s, err := newMarketSubmit(exch, t.Pair, asset.Spot, t.Side, t.Amount, t.Amount*t.Price)
+	if err != nil {
+		return err
+	}
+	resp, err := m.orderManager.Submit(ctx, s)
+	if err != nil {
+		return err
+	}
+	if resp != nil && resp.Detail != nil {
+		t.OrderID = resp.OrderID
+	}
+	return nil
+}
+
+// rebalanceHoldings holds the spot balances of an exchange while a plan is
+// made
+type rebalanceHoldings struct {
+	exch  exchange.IBotExchange
+	name  string
+	total map[*currency.Item]float64
+	free  map[*currency.Item]float64
+}
+
+// planRebalance values the holdings of each target and, when any has drifted
+// from its target weight by more than the tolerance, matches the currencies to
+// sell against the currencies to buy using the spot pairs each exchange trades
+func (m *rebalanceManager) planRebalance(ctx context.Context) (*RebalancePlan, error) {
+	holdings, skipped, err := m.loadRebalanceHoldings(ctx)
+	if err != nil {
+		return nil, err
+	}
+	prices := make(map[*currency.Item]float64, len(m.targets))
+	for i := range m.targets {
+		c := m.targets[i].currency
+		if _, ok := prices[c.Item]; ok {
+			continue
+		}
+		if prices[c.Item], err = convertCurrencyAmount(m.exchangeManager, 1, c, m.reporting); err != nil {
+			return nil, fmt.Errorf("unable to value %s in %s: %w", c, m.reporting, err)
+		}
+	}
+
+	plan := &RebalancePlan{
+		ID:                uuid.Must(uuid.NewV4()),
+		ReportingCurrency: m.reporting,
+		Allocations:       make([]RebalanceAllocation, len(m.targets)),
+		Skipped:           skipped,
+		CreatedAt:         time.Now(),
+	}
+	buckets := make([]rebalanceBucket, len(m.targets))
+	for i := range m.targets {
+		t := &m.targets[i]
+		buckets[i].target = t
+		for _, h := range holdings {
+			if t.appliesTo(h.name) {
+				buckets[i].amount += h.total[t.currency.Item]
+			}
+		}
+		buckets[i].value = buckets[i].amount * prices[t.currency.Item]
+		plan.TotalValue += buckets[i].value
+	}
+	if plan.TotalValue <= rebalanceDustValue {
+		return nil, errNoRebalanceHoldings
+	}
+
+	var maxDrift float64
+	var sells, buys []*rebalanceBucket
+	for i := range buckets {
+		b := &buckets[i]
+		weight := b.value / plan.TotalValue
+		plan.Allocations[i] = RebalanceAllocation{
+			Currency:     b.target.currency,
+			Exchange:     b.target.exchange,
+			Amount:       b.amount,
+			Value:        b.value,
+			Weight:       weight,
+			TargetWeight: b.target.weight,
+			Drift:        weight - b.target.weight,
+		}
+		maxDrift = math.Max(maxDrift, math.Abs(plan.Allocations[i].Drift))
+		delta := b.target.weight*plan.TotalValue - b.value
+		switch {
+		case delta > rebalanceDustValue:
+			b.remaining = delta
+			buys = append(buys, b)
+		case delta < -rebalanceDustValue:
+			b.remaining = -delta
+			sells = append(sells, b)
+		}
+	}
+	if maxDrift <= m.tolerance {
+		plan.Status = RebalanceStatusBalanced
+		return plan, nil
+	}
+
+	// The largest differences are matched first so as few trades as possible
+	// are planned
+	byRemaining := func(a, b *rebalanceBucket) int {
+		if c := cmp.Compare(b.remaining, a.remaining); c != 0 {
+			return c
+		}
+		return cmp.Or(strings.Compare(a.target.currency.String(), b.target.currency.String()), strings.Compare(a.target.exchange, b.target.exchange))
+	}
+	slices.SortFunc(sells, byRemaining)
+	slices.SortFunc(buys, byRemaining)
+	for _, sell := range sells {
+		for _, buy := range buys {
+			if sell.remaining <= rebalanceDustValue {
+				break
+			}
+			if buy.remaining <= rebalanceDustValue || sell.target.currency.Equal(buy.target.currency) {
+				continue
+			}
+			for _, h := range holdings {
+				if !sell.target.appliesTo(h.name) || !buy.target.appliesTo(h.name) {
+					continue
+				}
+				if sell.remaining <= rebalanceDustValue || buy.remaining <= rebalanceDustValue {
+					break
+				}
+				pair, ok := findRebalancePair(h.exch, sell.target.currency, buy.target.currency)
+				if !ok {
+					continue
+				}
+				trade, err := m.planTrade(h, pair, sell, buy, prices)
+				if err != nil {
+					plan.Skipped = append(plan.Skipped, RebalanceSkipped{
+						Exchange: h.name,
+						Sell:     sell.target.currency,
+						Buy:      buy.target.currency,
+						Value:    trade.Value,
+						Reason:   err.Error(),
+					})
+					continue
+				}
+				plan.Trades = append(plan.Trades, *trade)
+			}
+		}
+	}
+	for i := range buckets {
+		plan.Allocations[i].TradeValue = buckets[i].traded
+	}
+
+	if len(plan.Trades) == 0 {
+		plan.Status = RebalanceStatusUntradable
+		return plan, nil
+	}
+	plan.Status = RebalanceStatusPendingApproval
+	plan.ExpiresAt = plan.CreatedAt.Add(m.planExpiry)
+	return plan, nil
+}
+
+// planTrade plans a market order on an exchange selling one bucket's currency
+// for another's, limited by the value both buckets need to trade and the
+// available balance of the currency sold. The amount is rounded to the
+// exchange's step size and checked against its order execution limits
+func (m *rebalanceManager) planTrade(h *rebalanceHoldings, pair currency.Pair, sell, buy *rebalanceBucket, prices map[*currency.Item]float64) (*RebalanceTrade, error) {
+	sold := sell.target.currency
+	trade := &RebalanceTrade{
+		Exchange: h.exch.GetName(),
+		Pair:     pair,
+		Side:     order.Buy,
+		Value:    math.Min(sell.remaining, buy.remaining),
+	}
+	base := buy.target.currency
+	if pair.Base.Equal(sold) {
+		trade.Side = order.Sell
+		base = sold
+	}
+	available := h.free[sold.Item] * prices[sold.Item]
+	if available <= rebalanceDustValue {
+		return trade, fmt.Errorf("no available %s balance", sold)
+	}
+	trade.Value = math.Min(trade.Value, available)
+
+	t, err := h.exch.GetCachedTicker(pair, asset.Spot)
+	if err != nil {
+		return trade, err
+	}
+	trade.Price = t.Last
+	if trade.Price <= 0 {
+		trade.Price = (t.Bid + t.Ask) / 2
+	}
+	if trade.Price <= 0 {
+		return trade, fmt.Errorf("%w for %s", errNoRebalancePrice, pair)
+	}
+
+	trade.Amount = trade.Value / prices[base.Item]
+	l, err := h.exch.GetOrderExecutionLimits(asset.Spot, pair)
+	if err == nil && l.AmountStepIncrementSize > 0 {
+		trade.Amount = l.FloorAmountToStepIncrement(trade.Amount)
+	}
+	if trade.Amount <= 0 {
+		return trade, fmt.Errorf("%w: amount rounds to zero", limits.ErrAmountBelowMin)
+	}
+	trade.Value = trade.Amount * prices[base.Item]
+	if trade.Value < m.minTradeValue {
+		return trade, fmt.Errorf("%w of %v %s", errRebalanceTradeTooSmall, m.minTradeValue, m.reporting)
+	}
+	if notional := trade.Amount * trade.Price; l.MinNotional > 0 && notional < l.MinNotional {
+		return trade, fmt.Errorf("%w minimum notional: %v value of order %v", limits.ErrNotionalValue, l.MinNotional, notional)
+	}
+	err = h.exch.CheckOrderExecutionLimits(asset.Spot, pair, trade.Price, trade.Amount, order.Market)
+	if err != nil && !errors.Is(err, limits.ErrExchangeLimitNotLoaded) && !errors.Is(err, limits.ErrOrderLimitNotFound) {
+		return trade, err
+	}
+
+	sell.remaining -= trade.Value
+	sell.traded -= trade.Value
+	buy.remaining -= trade.Value
+	buy.traded += trade.Value
+	h.free[sold.Item] -= trade.Value / prices[sold.Item]
+	return trade, nil
+}
+
+// loadRebalanceHoldings returns the cached spot balances of each rebalanced
+// exchange, ordered by name. Exchanges which are configured or have targets
+// must have balances, any other exchange without balances is skipped
+func (m *rebalanceManager) loadRebalanceHoldings(ctx context.Context) ([]*rebalanceHoldings, []RebalanceSkipped, error) {
+	exchs, err := m.exchangeManager.GetExchanges()
+	if err != nil {
+		return nil, nil, err
+	}
+	required := slices.Clone(m.exchanges)
+	for i := range m.targets {
+		if m.targets[i].exchange != "" && !slices.Contains(required, m.targets[i].exchange) {
+			required = append(required, m.targets[i].exchange)
+		}
+	}
+	var holdings []*rebalanceHoldings
+	var skipped []RebalanceSkipped
+	for _, exch := range exchs {
+		name := exch.GetName()
+		isRequired := slices.Contains(required, strings.ToLower(name))
+		if len(m.exchanges) > 0 {
+			if !isRequired {
+				continue
+			}
+		} else if !isRequired && (!exch.IsEnabled() || !exch.IsRESTAuthenticationSupported()) {
+			continue
+		}
+		subAccounts, err := exch.GetCachedSubAccounts(ctx, asset.Spot)
+		if err != nil {
+			if isRequired {
+				return nil, nil, fmt.Errorf("unable to get %s balances: %w", name, err)
+			}
+			skipped = append(skipped, RebalanceSkipped{Exchange: name, Reason: "unable to get balances: " + err.Error()})
+			continue
+		}
+		h := &rebalanceHoldings{
+			exch:  exch,
+			name:  name,
+			total: make(map[*currency.Item]float64),
+			free:  make(map[*currency.Item]float64),
+		}
+		for _, s := range subAccounts {
+			for c, b := range s.Balances {
+				h.total[c.Item] += b.Total
+				h.free[c.Item] += b.Free
+			}
+		}
+		holdings = append(holdings, h)
+		required = slices.DeleteFunc(required, func(e string) bool { return strings.EqualFold(e, name) })
+	}
+	if len(required) > 0 {
+		return nil, nil, fmt.Errorf("%w: %s", ErrExchangeNotFound, strings.Join(required, ", "))
+	}
+	slices.SortFunc(holdings, func(a, b *rebalanceHoldings) int {
+		return strings.Compare(a.name, b.name)
+	})
+	return holdings, skipped, nil
+}
+
+// findRebalancePair returns the enabled spot pair an exchange trades between
+// two currencies
+func findRebalancePair(exch exchange.IBotExchange, a, b currency.Code) (currency.Pair, bool) {
+	pairs, err := exch.GetEnabledPairs(asset.Spot)
+	if err != nil {
+		return currency.EMPTYPAIR, false
+	}
+	for _, p := range pairs {
+		if (p.Base.Equal(a) && p.Quote.Equal(b)) || (p.Base.Equal(b) && p.Quote.Equal(a)) {
+			return p, true
+		}
+	}
+	return currency.EMPTYPAIR, false
+}
+
+// appliesTo returns whether the target applies to holdings on an exchange
+func (t *rebalanceTarget) appliesTo(exch string) bool {
+	return t.exchange == "" || strings.EqualFold(t.exchange, exch)
+}
+
+// clone returns a copy of the plan
+func (p *RebalancePlan) clone() *RebalancePlan {
+	c := *p
+	c.Allocations = slices.Clone(p.Allocations)
+	c.Trades = slices.Clone(p.Trades)
+	c.Skipped = slices.Clone(p.Skipped)
+	return &c
+}
@@ -0,0 +1,367 @@
+package engine
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchange/accounts"
+	"github.com/thrasher-corp/gocryptotrader/exchange/order/limits"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/sharedtestvalues"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+)
+
+// rebalanceExchange overrides the exchange functionality used by the
+// rebalance manager
+type rebalanceExchange struct {
+	sharedtestvalues.CustomEx
+	name         string
+	pairs        currency.Pairs
+	balances     accounts.CurrencyBalances
+	limits       *limits.MinMaxLevel
+	requirements protocol.TradingRequirements
+}
+
+func (r *rebalanceExchange) GetName() string { return r.name }
+
+func (r *rebalanceExchange) IsRESTAuthenticationSupported() bool { return true }
+
+func (r *rebalanceExchange) GetEnabledPairs(asset.Item) (currency.Pairs, error) {
+	return r.pairs, nil
+}
+
+func (r *rebalanceExchange) GetCachedSubAccounts(context.Context, asset.Item) (accounts.SubAccounts, error) {
+	if r.balances == nil {
+		return nil, accounts.ErrNoBalances
+	}
+	return accounts.SubAccounts{{AssetType: asset.Spot, Balances: r.balances}}, nil
+}
+
+func (r *rebalanceExchange) GetCachedTicker(p currency.Pair, a asset.Item) (*ticker.Price, error) {
+	return ticker.GetTicker(r.name, p, a)
+}
+
+func (r *rebalanceExchange) GetOrderExecutionLimits(asset.Item, currency.Pair) (limits.MinMaxLevel, error) {
+	if r.limits == nil {
+		return limits.MinMaxLevel{}, limits.ErrOrderLimitNotFound
+	}
+	return *r.limits, nil
+}
+
+func (r *rebalanceExchange) CheckOrderExecutionLimits(_ asset.Item, _ currency.Pair, price, amount float64, orderType order.Type) error {
+	if r.limits == nil {
+		return limits.ErrExchangeLimitNotLoaded
+	}
+	return r.limits.Validate(price, amount, orderType)
+}
+
+func (r *rebalanceExchange) GetTradingRequirements() protocol.TradingRequirements {
+	return r.requirements
+}
+
+// setupRebalanceTest returns a running rebalance manager for an exchange
+// holding 1 BTC at 100 USDT and 100 USDT, which trades BTC and ETH against
+// USDT
+func setupRebalanceTest(t *testing.T, name string, om iOrderManager, cfg *config.RebalanceManager) (*rebalanceManager, *rebalanceExchange) {
+	t.Helper()
+	exch := &rebalanceExchange{
+		name: name,
+		pairs: currency.Pairs{
+			currency.NewPair(currency.BTC, currency.USDT),
+			currency.NewPair(currency.ETH, currency.USDT),
+		},
+		balances: accounts.CurrencyBalances{
+			currency.BTC:  {Total: 1, Free: 1},
+			currency.USDT: {Total: 100, Free: 100},
+		},
+	}
+	for _, tick := range []struct {
+		pair currency.Pair
+		last float64
+	}{
+		{currency.NewPair(currency.BTC, currency.USDT), 100},
+		{currency.NewPair(currency.ETH, currency.USDT), 10},
+	} {
+		require.NoError(t, ticker.ProcessTicker(&ticker.Price{
+			ExchangeName: name,
+			Pair:         tick.pair,
+			AssetType:    asset.Spot,
+			Last:         tick.last,
+		}), "ProcessTicker must not error")
+	}
+	em := NewExchangeManager()
+	require.NoError(t, em.Add(exch), "Add must not error")
+	if len(cfg.Targets) == 0 {
+		cfg.Targets = []config.RebalanceTarget{
+			{Currency: "BTC", Weight: 0.5},
+			{Currency: "ETH", Weight: 0.25},
+			{Currency: "USDT", Weight: 0.25},
+		}
+	}
+	m, err := setupRebalanceManager(em, om, cfg)
+	require.NoError(t, err, "setupRebalanceManager must not error")
+	require.NoError(t, m.Start(), "Start must not error")
+	t.Cleanup(func() { assert.NoError(t, m.Stop(), "Stop should not error") })
+	return m, exch
+}
+
+func TestSetupRebalanceManager(t *testing.T) {
+	t.Parallel()
+	_, err := setupRebalanceManager(nil, nil, nil)
+	assert.ErrorIs(t, err, errNilExchangeManager)
+	em := NewExchangeManager()
+	_, err = setupRebalanceManager(em, nil, nil)
+	assert.ErrorIs(t, err, errNilConfig)
+	_, err = setupRebalanceManager(em, nil, &config.RebalanceManager{DriftTolerance: 1})
+	assert.ErrorIs(t, err, errInvalidDriftTolerance)
+	_, err = setupRebalanceManager(em, nil, &config.RebalanceManager{})
+	assert.ErrorIs(t, err, errNoRebalanceTargets)
+
+	for _, tc := range []struct {
+		name      string
+		targets   []config.RebalanceTarget
+		exchanges []string
+		err       error
+	}{
+		{"empty currency", []config.RebalanceTarget{{Weight: 1}}, nil, currency.ErrCurrencyCodeEmpty},
+		{"negative weight", []config.RebalanceTarget{{Currency: "BTC", Weight: -1}}, nil, errInvalidRebalanceTarget},
+		{"weights below one", []config.RebalanceTarget{{Currency: "BTC", Weight: 0.5}}, nil, errRebalanceWeightsInvalid},
+		{"duplicate", []config.RebalanceTarget{{Currency: "BTC", Weight: 0.5}, {Currency: "btc", Weight: 0.5}}, nil, errInvalidRebalanceTarget},
+		{"across and on exchange", []config.RebalanceTarget{{Currency: "BTC", Weight: 0.5}, {Currency: "BTC", Exchange: "Binance", Weight: 0.5}}, nil, errInvalidRebalanceTarget},
+		{"exchange not rebalanced", []config.RebalanceTarget{{Currency: "BTC", Exchange: "Binance", Weight: 1}}, []string{"Kraken"}, errRebalanceExchangeNotInScope},
+	} {
+		_, err = setupRebalanceManager(em, nil, &config.RebalanceManager{Targets: tc.targets, Exchanges: tc.exchanges})
+		assert.ErrorIs(t, err, tc.err, tc.name)
+	}
+
+	m, err := setupRebalanceManager(em, nil, &config.RebalanceManager{
+		Exchanges: []string{"Binance"},
+		Targets: []config.RebalanceTarget{
+			{Currency: "btc", Exchange: "Binance", Weight: 0.6},
+			{Currency: "usdt", Weight: 0.4},
+		},
+	})
+	require.NoError(t, err, "setupRebalanceManager must not error")
+	assert.Equal(t, currency.USD, m.reporting)
+	assert.Equal(t, defaultRebalanceInterval, m.interval)
+	assert.Equal(t, defaultRebalancePlanExpiry, m.planExpiry)
+	assert.Equal(t, []string{"binance"}, m.exchanges)
+	require.Len(t, m.targets, 2)
+	assert.Equal(t, rebalanceTarget{currency: currency.BTC, exchange: "binance", weight: 0.6}, m.targets[0])
+}
+
+func TestRebalanceManagerStartStop(t *testing.T) {
+	t.Parallel()
+	var m *rebalanceManager
+	assert.False(t, m.IsRunning())
+	assert.ErrorIs(t, m.Start(), ErrNilSubsystem)
+	assert.ErrorIs(t, m.Stop(), ErrNilSubsystem)
+	_, err := m.PlanRebalance(t.Context())
+	assert.ErrorIs(t, err, ErrNilSubsystem)
+	_, err = m.GetRebalancePlan()
+	assert.ErrorIs(t, err, ErrNilSubsystem)
+	_, err = m.ApproveRebalancePlan(t.Context(), "")
+	assert.ErrorIs(t, err, ErrNilSubsystem)
+
+	m, err = setupRebalanceManager(NewExchangeManager(), nil, &config.RebalanceManager{Targets: []config.RebalanceTarget{{Currency: "BTC", Weight: 1}}})
+	require.NoError(t, err, "setupRebalanceManager must not error")
+	_, err = m.PlanRebalance(t.Context())
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted)
+	_, err = m.GetRebalancePlan()
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted)
+	_, err = m.ApproveRebalancePlan(t.Context(), "")
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted)
+	assert.ErrorIs(t, m.Stop(), ErrSubSystemNotStarted)
+	require.NoError(t, m.Start(), "Start must not error")
+	assert.True(t, m.IsRunning())
+	assert.ErrorIs(t, m.Start(), ErrSubSystemAlreadyStarted)
+	_, err = m.GetRebalancePlan()
+	assert.ErrorIs(t, err, errNoRebalancePlan)
+	require.NoError(t, m.Stop(), "Stop must not error")
+	assert.False(t, m.IsRunning())
+}
+
+func TestPlanRebalance(t *testing.T) {
+	t.Parallel()
+	m, exch := setupRebalanceTest(t, "rebalanceplan", nil, &config.RebalanceManager{DriftTolerance: 0.1})
+
+	plan, err := m.PlanRebalance(t.Context())
+	require.NoError(t, err, "PlanRebalance must not error")
+	assert.Equal(t, RebalanceStatusPendingApproval, plan.Status)
+	assert.Equal(t, 200.0, plan.TotalValue)
+	assert.Equal(t, plan.CreatedAt.Add(defaultRebalancePlanExpiry), plan.ExpiresAt)
+	assert.Empty(t, plan.Skipped)
+	require.Len(t, plan.Allocations, 3)
+	assert.Equal(t, RebalanceAllocation{Currency: currency.BTC, Amount: 1, Value: 100, Weight: 0.5, TargetWeight: 0.5}, plan.Allocations[0])
+	assert.Equal(t, RebalanceAllocation{Currency: currency.ETH, TargetWeight: 0.25, Drift: -0.25, TradeValue: 50}, plan.Allocations[1])
+	assert.Equal(t, RebalanceAllocation{Currency: currency.USDT, Amount: 100, Value: 100, Weight: 0.5, TargetWeight: 0.25, Drift: 0.25, TradeValue: -50}, plan.Allocations[2])
+	require.Len(t, plan.Trades, 1)
+	assert.Equal(t, RebalanceTrade{
+		Exchange: "rebalanceplan",
+		Pair:     currency.NewPair(currency.ETH, currency.USDT),
+		Side:     order.Buy,
+		Amount:   5,
+		Price:    10,
+		Value:    50,
+	}, plan.Trades[0])
+
+	current, err := m.GetRebalancePlan()
+	require.NoError(t, err, "GetRebalancePlan must not error")
+	assert.Equal(t, plan.ID, current.ID)
+
+	exch.limits = &limits.MinMaxLevel{AmountStepIncrementSize: 0.3}
+	plan, err = m.PlanRebalance(t.Context())
+	require.NoError(t, err, "PlanRebalance must not error")
+	require.Len(t, plan.Trades, 1)
+	assert.InDelta(t, 4.8, plan.Trades[0].Amount, 1e-9, "amount should be floored to the step size")
+	assert.InDelta(t, 48.0, plan.Trades[0].Value, 1e-9)
+
+	exch.limits = &limits.MinMaxLevel{MinNotional: 60}
+	plan, err = m.PlanRebalance(t.Context())
+	require.NoError(t, err, "PlanRebalance must not error")
+	assert.Equal(t, RebalanceStatusUntradable, plan.Status)
+	assert.Empty(t, plan.Trades)
+	require.Len(t, plan.Skipped, 1)
+	assert.Equal(t, currency.USDT, plan.Skipped[0].Sell)
+	assert.Equal(t, currency.ETH, plan.Skipped[0].Buy)
+	assert.Contains(t, plan.Skipped[0].Reason, limits.ErrNotionalValue.Error())
+	exch.limits = nil
+
+	m.minTradeValue = 51
+	plan, err = m.PlanRebalance(t.Context())
+	require.NoError(t, err, "PlanRebalance must not error")
+	assert.Equal(t, RebalanceStatusUntradable, plan.Status)
+	require.Len(t, plan.Skipped, 1)
+	assert.Contains(t, plan.Skipped[0].Reason, errRebalanceTradeTooSmall.Error())
+	m.minTradeValue = 0
+
+	exch.balances[currency.USDT] = accounts.Balance{Total: 100}
+	plan, err = m.PlanRebalance(t.Context())
+	require.NoError(t, err, "PlanRebalance must not error")
+	require.Len(t, plan.Skipped, 1)
+	assert.Equal(t, "no available USDT balance", plan.Skipped[0].Reason)
+
+	m.tolerance = 0.3
+	plan, err = m.PlanRebalance(t.Context())
+	require.NoError(t, err, "PlanRebalance must not error")
+	assert.Equal(t, RebalanceStatusBalanced, plan.Status)
+	assert.Empty(t, plan.Trades)
+	assert.Empty(t, plan.Skipped)
+
+	exch.balances = accounts.CurrencyBalances{currency.LTC: {Total: 1, Free: 1}}
+	_, err = m.PlanRebalance(t.Context())
+	assert.ErrorIs(t, err, errNoRebalanceHoldings)
+
+	exch.balances = nil
+	m.exchanges = []string{"rebalanceplan"}
+	_, err = m.PlanRebalance(t.Context())
+	assert.ErrorIs(t, err, accounts.ErrNoBalances, "PlanRebalance should error when a configured exchange has no balances")
+	m.exchanges = []string{"bruh"}
+	_, err = m.PlanRebalance(t.Context())
+	assert.ErrorIs(t, err, ErrExchangeNotFound)
+}
+
+func TestPlanRebalanceAcrossExchanges(t *testing.T) {
+	t.Parallel()
+	m, exch := setupRebalanceTest(t, "rebalancea", nil, &config.RebalanceManager{})
+	other := &rebalanceExchange{
+		name:     "rebalanceb",
+		pairs:    currency.Pairs{currency.NewPair(currency.BTC, currency.USDT)},
+		balances: accounts.CurrencyBalances{currency.BTC: {Total: 1, Free: 1}},
+	}
+	require.NoError(t, m.exchangeManager.(*ExchangeManager).Add(other), "Add must not error")
+	require.NoError(t, ticker.ProcessTicker(&ticker.Price{
+		ExchangeName: other.name,
+		Pair:         currency.NewPair(currency.BTC, currency.USDT),
+		AssetType:    asset.Spot,
+		Last:         100,
+	}), "ProcessTicker must not error")
+	exch.balances = accounts.CurrencyBalances{currency.USDT: {Total: 100, Free: 100}}
+	targets, err := loadRebalanceTargets([]config.RebalanceTarget{
+		{Currency: "BTC", Exchange: "rebalanceb", Weight: 0.25},
+		{Currency: "USDT", Weight: 0.75},
+	}, nil)
+	require.NoError(t, err, "loadRebalanceTargets must not error")
+	m.targets = targets
+
+	plan, err := m.PlanRebalance(t.Context())
+	require.NoError(t, err, "PlanRebalance must not error")
+	assert.Equal(t, 200.0, plan.TotalValue)
+	require.Len(t, plan.Trades, 1, "BTC must only be sold on the exchange it is targeted on")
+	assert.Equal(t, "rebalanceb", plan.Trades[0].Exchange)
+	assert.Equal(t, order.Sell, plan.Trades[0].Side)
+	assert.Equal(t, 0.5, plan.Trades[0].Amount)
+	assert.Equal(t, -50.0, plan.Allocations[0].TradeValue)
+	assert.Equal(t, 50.0, plan.Allocations[1].TradeValue)
+}
+
+func TestApproveRebalancePlan(t *testing.T) {
+	t.Parallel()
+	om := &fakeEventOrderManager{}
+	m, exch := setupRebalanceTest(t, "rebalanceapprove", om, &config.RebalanceManager{})
+	exch.requirements.SpotMarketBuyQuotation = true
+
+	_, err := m.ApproveRebalancePlan(t.Context(), "bruh")
+	assert.ErrorIs(t, err, errNoRebalancePlan)
+	plan, err := m.PlanRebalance(t.Context())
+	require.NoError(t, err, "PlanRebalance must not error")
+	_, err = m.ApproveRebalancePlan(t.Context(), "0196a1e4-1e53-7d4e-8d0e-4b8c9e1a2b3c")
+	assert.ErrorIs(t, err, errNoRebalancePlan)
+
+	approved, err := m.ApproveRebalancePlan(t.Context(), plan.ID.String())
+	require.NoError(t, err, "ApproveRebalancePlan must not error")
+	assert.Equal(t, RebalanceStatusExecuted, approved.Status)
+	assert.False(t, approved.ExecutedAt.IsZero())
+	require.Len(t, approved.Trades, 1)
+	assert.Equal(t, "1337", approved.Trades[0].OrderID)
+	require.Len(t, om.submitted, 1)
+	assert.Equal(t, order.Market, om.submitted[0].Type)
+	assert.Equal(t, order.Buy, om.submitted[0].Side)
+	assert.Equal(t, currency.NewPair(currency.ETH, currency.USDT), om.submitted[0].Pair)
+	assert.Zero(t, om.submitted[0].Amount)
+	assert.Equal(t, 50.0, om.submitted[0].QuoteAmount, "market buys should be quoted when required by the exchange")
+
+	_, err = m.ApproveRebalancePlan(t.Context(), plan.ID.String())
+	assert.ErrorIs(t, err, errRebalancePlanNotPending)
+
+	plan, err = m.PlanRebalance(t.Context())
+	require.NoError(t, err, "PlanRebalance must not error")
+	m.m.Lock()
+	m.plan.ExpiresAt = time.Now().Add(-time.Second)
+	m.m.Unlock()
+	_, err = m.ApproveRebalancePlan(t.Context(), plan.ID.String())
+	assert.ErrorIs(t, err, errRebalancePlanExpired)
+	current, err := m.GetRebalancePlan()
+	require.NoError(t, err, "GetRebalancePlan must not error")
+	assert.Equal(t, RebalanceStatusExpired, current.Status)
+
+	m.orderManager = nil
+	_, err = m.ApproveRebalancePlan(t.Context(), plan.ID.String())
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted)
+}
+
+func TestRebalanceExecute(t *testing.T) {
+	t.Parallel()
+	om := &fakeEventOrderManager{}
+	m, _ := setupRebalanceTest(t, "rebalanceexecute", om, &config.RebalanceManager{})
+
+	m.rebalance(t.Context())
+	assert.Empty(t, om.submitted, "plans should not be executed unless configured to")
+	plan, err := m.GetRebalancePlan()
+	require.NoError(t, err, "GetRebalancePlan must not error")
+	assert.Equal(t, RebalanceStatusPendingApproval, plan.Status)
+
+	m.execute = true
+	m.rebalance(t.Context())
+	assert.Len(t, om.submitted, 1, "plans should be executed when configured to")
+	plan, err = m.GetRebalancePlan()
+	require.NoError(t, err, "GetRebalancePlan must not error")
+	assert.Equal(t, RebalanceStatusExecuted, plan.Status)
+}
@@ -0,0 +1,161 @@
+package engine
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+const (
+	// RebalanceManagerName is an exported subsystem name
+	RebalanceManagerName = "rebalance_manager"
+
+	// RebalanceStatusBalanced is the status of a plan whose holdings are
+	// within the drift tolerance of their target weights
+	RebalanceStatusBalanced = "balanced"
+	// RebalanceStatusUntradable is the status of a plan whose holdings have
+	// drifted but no trades could be planned
+	RebalanceStatusUntradable = "untradable"
+	// RebalanceStatusPendingApproval is the status of a plan awaiting
+	// approval before its trades are submitted
+	RebalanceStatusPendingApproval = "pending approval"
+	// RebalanceStatusExecuting is the status of a plan whose trades are being
+	// submitted
+	RebalanceStatusExecuting = "executing"
+	// RebalanceStatusExecuted is the status of a plan whose trades were all
+	// submitted
+	RebalanceStatusExecuted = "executed"
+	// RebalanceStatusFailed is the status of a plan where one or more trades
+	// were rejected
+	RebalanceStatusFailed = "failed"
+	// RebalanceStatusExpired is the status of a plan which was not approved
+	// in time
+	RebalanceStatusExpired = "expired"
+
+	defaultRebalanceInterval   = time.Hour
+	defaultRebalancePlanExpiry = 15 * time.Minute
+	// rebalanceDustValue is the reporting currency value below which holdings
+	// are considered rebalanced
+	rebalanceDustValue = 1e-8
+	// rebalanceWeightTolerance is how far the sum of target weights can be
+	// from one
+	rebalanceWeightTolerance = 1e-6
+)
+
+var (
+	errNoRebalanceTargets          = errors.New("no rebalance targets")
+	errInvalidRebalanceTarget      = errors.New("invalid rebalance target")
+	errRebalanceWeightsInvalid     = errors.New("rebalance target weights must sum to one")
+	errInvalidDriftTolerance       = errors.New("drift tolerance must be between zero and one")
+	errRebalanceExchangeNotInScope = errors.New("rebalance target exchange is not a rebalanced exchange")
+	errNoRebalanceHoldings         = errors.New("no holdings of target currencies")
+	errNoRebalancePlan             = errors.New("no rebalance plan")
+	errRebalancePlanNotPending     = errors.New("rebalance plan is not pending approval")
+	errRebalancePlanExpired        = errors.New("rebalance plan expired")
+	errRebalanceTradesRejected     = errors.New("rebalance trades rejected")
+	errRebalanceTradeTooSmall      = errors.New("trade value below minimum")
+	errNoRebalancePrice            = errors.New("no ticker price")
+)
+
+// rebalanceManager periodically plans the spot trades needed to return
+// holdings to their target weights and executes them through the order manager
+// either automatically or once approved
+type rebalanceManager struct {
+	started         int32
+	exchangeManager iExchangeManager
+	orderManager    iOrderManager
+	reporting       currency.Code
+	targets         []rebalanceTarget
+	tolerance       float64
+	minTradeValue   float64
+	interval        time.Duration
+	planExpiry      time.Duration
+	exchanges       []string
+	execute         bool
+	verbose         bool
+	shutdown        chan struct{}
+	wg              sync.WaitGroup
+
+	m    sync.Mutex
+	plan *RebalancePlan
+}
+
+// rebalanceTarget is a validated target weight. An empty exchange applies the
+// weight to holdings across all rebalanced exchanges
+type rebalanceTarget struct {
+	currency currency.Code
+	exchange string
+	weight   float64
+}
+
+// rebalanceBucket holds the value of a target's holdings, the value which
+// remains to be traded and the value traded while a plan is made
+type rebalanceBucket struct {
+	target    *rebalanceTarget
+	amount    float64
+	value     float64
+	remaining float64
+	traded    float64
+}
+
+// RebalancePlan holds the trades needed to return holdings to their target
+// weights
+type RebalancePlan struct {
+	ID                uuid.UUID
+	Status            string
+	ReportingCurrency currency.Code
+	// TotalValue is the reporting currency value of all target currency
+	// holdings
+	TotalValue  float64
+	Allocations []RebalanceAllocation
+	Trades      []RebalanceTrade
+	Skipped     []RebalanceSkipped
+	CreatedAt   time.Time
+	ExpiresAt   time.Time
+	ExecutedAt  time.Time
+}
+
+// RebalanceAllocation holds the current and target weight of a currency's
+// holdings
+type RebalanceAllocation struct {
+	Currency currency.Code
+	// Exchange is empty when the weight applies across exchanges
+	Exchange     string
+	Amount       float64
+	Value        float64
+	Weight       float64
+	TargetWeight float64
+	// Drift is the weight less the target weight
+	Drift float64
+	// TradeValue is the reporting currency value the plan buys, or sells when
+	// negative
+	TradeValue float64
+}
+
+// RebalanceTrade holds a market order planned to rebalance holdings
+type RebalanceTrade struct {
+	Exchange string
+	Pair     currency.Pair
+	Side     order.Side
+	// Amount is in the base currency
+	Amount float64
+	// Price is the last ticker price the trade was planned at
+	Price float64
+	// Value is the reporting currency value of the trade
+	Value   float64
+	OrderID string
+	Error   string
+}
+
+// RebalanceSkipped holds a trade which could not be planned and why
+type RebalanceSkipped struct {
+	Exchange string
+	Sell     currency.Code
+	Buy      currency.Code
+	Value    float64
+	Reason   string
+}
@@ -1,14 +1,15 @@
 package engine
 
 import (
+	"bytes"
 	"context"
-	"encoding/base64"
 	"errors"
 	"fmt"
 	"net"
 	"net/http"
 	"os"
 	"path/filepath"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -42,11 +43,11 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/exchanges/margin"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook/analytics"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
 	"github.com/thrasher-corp/gocryptotrader/gctrpc"
-	"github.com/thrasher-corp/gocryptotrader/gctrpc/auth"
 	gctscript "github.com/thrasher-corp/gocryptotrader/gctscript/vm"
 	"github.com/thrasher-corp/gocryptotrader/log"
 	"github.com/thrasher-corp/gocryptotrader/portfolio"
@@ -54,8 +55,10 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
 	"github.com/thrasher-corp/gocryptotrader/utils"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -77,42 +80,34 @@ var (
 	errGRPCShutdownSignalIsNil = errors.New("cannot shutdown, gRPC shutdown channel is nil")
 	errInvalidStrategy         = errors.New("invalid strategy")
 	errSpecificPairNotEnabled  = errors.New("specified pair is not enabled")
+	errUnknownLedgerReport     = errors.New("unknown ledger report")
 )
 
 // RPCServer struct
 type RPCServer struct {
 	gctrpc.UnimplementedGoCryptoTraderServiceServer
 	*Engine
+	auth *rpcAuthenticator
 }
 
 func (s *RPCServer) authenticateClient(ctx context.Context) (context.Context, error) {
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
-		return ctx, errors.New("unable to extract metadata")
+		return ctx, status.Error(codes.Unauthenticated, "unable to extract metadata")
 	}
 
-	authStr, ok := md["authorization"]
-	if !ok {
-		return ctx, errors.New("authorization header missing")
-	}
-
-	if !strings.Contains(authStr[0], "Basic") {
-		return ctx, errors.New("basic not found in authorization header")
-	}
-
-	decoded, err := base64.StdEncoding.DecodeString(strings.Split(authStr[0], " ")[1])
+	method, _ := grpc.Method(ctx)
+	principal, err := s.auth.authenticate(ctx, md)
 	if err != nil {
-		return ctx, errors.New("unable to base64 decode authorization header")
+		log.Warnf(log.GRPCSys, "gRPC unauthenticated access attempt. Method: %s Err: %s\n", method, err)
+		return ctx, status.Error(codes.Unauthenticated, err.Error())
 	}
-
-	cred := strings.Split(string(decoded), ":")
-	username := cred[0]
-	password := cred[1]
-
-	if username != s.Config.RemoteControl.Username ||
-		password != s.Config.RemoteControl.Password {
-		return ctx, errors.New("username/password mismatch")
+	if err := principal.authorise(method); err != nil {
+		log.Warnf(log.GRPCSys, "gRPC user %s %s\n", principal.name, err)
+		return ctx, status.Error(codes.PermissionDenied, err.Error())
 	}
+	ctx = context.WithValue(ctx, rpcPrincipalKey{}, principal)
+
 	ctx, err = accounts.ParseCredentialsMetadata(ctx, md)
 	if err != nil {
 		return ctx, err
@@ -138,15 +133,21 @@ func StartRPCServer(engine *Engine) {
 		return
 	}
 
-	creds, err := credentials.NewServerTLSFromFile(filepath.Join(targetDir, "cert.pem"), filepath.Join(targetDir, "key.pem"))
+	tlsConfig, err := getRPCServerTLSConfig(targetDir, &engine.Config.RemoteControl.GRPC)
 	if err != nil {
-		log.Errorf(log.GRPCSys, "gRPC server could not load TLS keys: %s\n", err)
+		log.Errorf(log.GRPCSys, "gRPC server could not load TLS config: %s\n", err)
 		return
 	}
 
-	s := RPCServer{Engine: engine}
+	rpcAuth, err := newRPCAuthenticator(&engine.Config.RemoteControl)
+	if err != nil {
+		log.Errorf(log.GRPCSys, "gRPC server could not setup authentication: %s\n", err)
+		return
+	}
+
+	s := RPCServer{Engine: engine, auth: rpcAuth}
 	opts := []grpc.ServerOption{
-		grpc.Creds(creds),
+		grpc.Creds(credentials.NewTLS(tlsConfig)),
 		grpc.UnaryInterceptor(grpcauth.UnaryServerInterceptor(s.authenticateClient)),
 		grpc.StreamInterceptor(grpcauth.StreamServerInterceptor(s.authenticateClient)),
 	}
@@ -163,6 +164,11 @@ func StartRPCServer(engine *Engine) {
 	log.Debugln(log.GRPCSys, "gRPC server started!")
 
 	if s.Settings.EnableGRPCProxy {
+		if engine.Config.RemoteControl.GRPC.RequireClientCertificate {
+			// The proxy has no client certificate to dial the gRPC server with
+			log.Errorf(log.GRPCSys, "Unable to start gRPC proxy. Err: %s\n", errRPCProxyClientCertificate)
+			return
+		}
 		s.StartRPCRESTProxy()
 	}
 }
@@ -180,14 +186,10 @@ func (s *RPCServer) StartRPCRESTProxy() {
 		return
 	}
 
+	// The proxy forwards the caller's authorization header so the gRPC server
+	// enforces the scopes of the calling user
 	mux := runtime.NewServeMux()
-	opts := []grpc.DialOption{
-		grpc.WithTransportCredentials(creds),
-		grpc.WithPerRPCCredentials(auth.BasicAuth{
-			Username: s.Config.RemoteControl.Username,
-			Password: s.Config.RemoteControl.Password,
-		}),
-	}
+	opts := []grpc.DialOption{grpc.WithTransportCredentials(creds)}
 	err = gctrpc.RegisterGoCryptoTraderServiceHandlerFromEndpoint(context.Background(),
 		mux, s.Config.RemoteControl.GRPC.ListenAddress, opts)
 	if err != nil {
@@ -213,9 +215,16 @@ func (s *RPCServer) StartRPCRESTProxy() {
 }
 
 func (s *RPCServer) authClient(handler http.Handler) http.Handler {
+	rpcAuth := s.auth
+	if rpcAuth == nil {
+		var err error
+		if rpcAuth, err = newRPCAuthenticator(&s.Config.RemoteControl); err != nil {
+			log.Errorf(log.GRPCSys, "gRPC proxy server could not setup authentication: %s\n", err)
+		}
+	}
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		username, password, ok := r.BasicAuth()
-		if !ok || username != s.Config.RemoteControl.Username || password != s.Config.RemoteControl.Password {
+		md := metadata.Pairs("authorization", r.Header.Get("Authorization"))
+		if _, err := rpcAuth.authenticate(r.Context(), md); err != nil {
 			w.Header().Set("WWW-Authenticate", `Basic realm="restricted"`)
 			http.Error(w, "Access denied", http.StatusUnauthorized)
 			log.Warnf(log.GRPCSys, "gRPC proxy server unauthorised access attempt. IP: %s Path: %s\n", r.RemoteAddr, r.URL.Path)
@@ -907,6 +916,13 @@ func (s *RPCServer) GetOrders(ctx context.Context, r *gctrpc.GetOrdersRequest) (
 		return nil, err
 	}
 
+	if r.IncludeHistory {
+		resp, err = s.appendOrderHistory(resp, exch.GetName(), a, cp, start, end)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	orders := make([]*gctrpc.OrderDetails, len(resp))
 	for x := range resp {
 		trades := make([]*gctrpc.TradeHistory, len(resp[x].Trades))
@@ -996,6 +1012,26 @@ func (s *RPCServer) GetManagedOrders(_ context.Context, r *gctrpc.GetOrdersReque
 		return nil, err
 	}
 
+	if r.IncludeHistory {
+		var start, end time.Time
+		if r.StartDate != "" {
+			start, err = time.Parse(common.SimpleTimeFormatWithTimezone, r.StartDate)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if r.EndDate != "" {
+			end, err = time.Parse(common.SimpleTimeFormatWithTimezone, r.EndDate)
+			if err != nil {
+				return nil, err
+			}
+		}
+		resp, err = s.appendOrderHistory(resp, exch.GetName(), a, cp, start, end)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	orders := make([]*gctrpc.OrderDetails, len(resp))
 	for x := range resp {
 		trades := make([]*gctrpc.TradeHistory, len(resp[x].Trades))
@@ -1044,6 +1080,26 @@ func (s *RPCServer) GetManagedOrders(_ context.Context, r *gctrpc.GetOrdersReque
 	return &gctrpc.GetOrdersResponse{Orders: orders}, nil
 }
 
+// appendOrderHistory appends orders persisted by the order manager which are
+// not already present in the supplied orders
+func (s *RPCServer) appendOrderHistory(orders []order.Detail, exchName string, a asset.Item, cp currency.Pair, start, end time.Time) ([]order.Detail, error) {
+	history, err := s.OrderManager.GetOrderHistory(exchName, a, cp, start, end)
+	if err != nil {
+		return nil, err
+	}
+	known := make(map[string]bool, len(orders))
+	for i := range orders {
+		known[orders[i].OrderID] = true
+	}
+	for i := range history {
+		if known[history[i].OrderID] {
+			continue
+		}
+		orders = append(orders, history[i])
+	}
+	return orders, nil
+}
+
 // GetOrder returns order information based on exchange and order ID
 func (s *RPCServer) GetOrder(ctx context.Context, r *gctrpc.GetOrderRequest) (*gctrpc.OrderDetails, error) {
 	if r == nil {
@@ -1456,19 +1512,39 @@ func (s *RPCServer) ModifyOrder(ctx context.Context, r *gctrpc.ModifyOrderReques
 
 // GetEvents returns the stored events list
 func (s *RPCServer) GetEvents(_ context.Context, _ *gctrpc.GetEventsRequest) (*gctrpc.GetEventsResponse, error) {
-	return &gctrpc.GetEventsResponse{}, common.ErrNotYetImplemented
+	events, err := s.eventManager.GetEvents()
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.GetEventsResponse{Events: make([]*gctrpc.EventDetails, len(events))}
+	for i := range events {
+		resp.Events[i] = &gctrpc.EventDetails{
+			Id:              events[i].ID,
+			Exchange:        events[i].Exchange,
+			Item:            events[i].Item,
+			ConditionParams: conditionParamsToRPC(&events[i].Condition),
+			Pair: &gctrpc.CurrencyPair{
+				Delimiter: events[i].Pair.Delimiter,
+				Base:      events[i].Pair.Base.String(),
+				Quote:     events[i].Pair.Quote.String(),
+			},
+			Action:     events[i].Action,
+			Executed:   events[i].Executed,
+			AssetType:  events[i].Asset.String(),
+			Operator:   events[i].Operator,
+			Conditions: eventConditionsToRPC(events[i].Conditions),
+			Actions:    eventActionsToRPC(events[i].Actions),
+			CreatedAt:  events[i].CreatedAt.Format(common.SimpleTimeFormatWithTimezone),
+		}
+	}
+	return resp, nil
 }
 
 // AddEvent adds an event
 func (s *RPCServer) AddEvent(_ context.Context, r *gctrpc.AddEventRequest) (*gctrpc.AddEventResponse, error) {
-	evtCondition := EventConditionParams{
-		CheckBids:       r.ConditionParams.CheckBids,
-		CheckAsks:       r.ConditionParams.CheckAsks,
-		Condition:       r.ConditionParams.Condition,
-		OrderbookAmount: r.ConditionParams.OrderbookAmount,
-		Price:           r.ConditionParams.Price,
+	if r.Pair == nil {
+		return nil, errCurrencyPairUnset
 	}
-
 	p := currency.NewPairWithDelimiter(r.Pair.Base,
 		r.Pair.Quote, r.Pair.Delimiter)
 
@@ -1487,7 +1563,17 @@ func (s *RPCServer) AddEvent(_ context.Context, r *gctrpc.AddEventRequest) (*gct
 		return nil, err
 	}
 
-	id, err := s.eventManager.Add(r.Exchange, r.Item, evtCondition, p, a, r.Action)
+	id, err := s.eventManager.AddEvent(&Event{
+		Exchange:   r.Exchange,
+		Item:       r.Item,
+		Condition:  conditionParamsFromRPC(r.ConditionParams),
+		Operator:   r.Operator,
+		Conditions: eventConditionsFromRPC(r.Conditions),
+		Pair:       p,
+		Asset:      a,
+		Action:     r.Action,
+		Actions:    eventActionsFromRPC(r.Actions),
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -1495,6 +1581,127 @@ func (s *RPCServer) AddEvent(_ context.Context, r *gctrpc.AddEventRequest) (*gct
 	return &gctrpc.AddEventResponse{Id: id}, nil
 }
 
+func conditionParamsFromRPC(c *gctrpc.ConditionParams) EventConditionParams {
+	if c == nil {
+		return EventConditionParams{}
+	}
+	return EventConditionParams{
+		Condition:          c.Condition,
+		Price:              c.Price,
+		CheckBids:          c.CheckBids,
+		CheckAsks:          c.CheckAsks,
+		OrderbookAmount:    c.OrderbookAmount,
+		Interval:           kline.Interval(c.Interval),
+		Period:             c.Period,
+		FastPeriod:         c.FastPeriod,
+		SlowPeriod:         c.SlowPeriod,
+		SignalPeriod:       c.SignalPeriod,
+		StandardDeviations: c.StandardDeviations,
+		Direction:          c.Direction,
+		Window:             time.Duration(c.Window),
+		Value:              c.Value,
+	}
+}
+
+func conditionParamsToRPC(c *EventConditionParams) *gctrpc.ConditionParams {
+	return &gctrpc.ConditionParams{
+		Condition:          c.Condition,
+		Price:              c.Price,
+		CheckBids:          c.CheckBids,
+		CheckAsks:          c.CheckAsks,
+		OrderbookAmount:    c.OrderbookAmount,
+		Interval:           int64(c.Interval),
+		Period:             c.Period,
+		FastPeriod:         c.FastPeriod,
+		SlowPeriod:         c.SlowPeriod,
+		SignalPeriod:       c.SignalPeriod,
+		StandardDeviations: c.StandardDeviations,
+		Direction:          c.Direction,
+		Window:             int64(c.Window),
+		Value:              c.Value,
+	}
+}
+
+func eventConditionsFromRPC(conditions []*gctrpc.EventCondition) []EventCondition {
+	if len(conditions) == 0 {
+		return nil
+	}
+	resp := make([]EventCondition, 0, len(conditions))
+	for _, c := range conditions {
+		if c == nil {
+			continue
+		}
+		resp = append(resp, EventCondition{
+			Item:       c.Item,
+			Params:     conditionParamsFromRPC(c.ConditionParams),
+			Operator:   c.Operator,
+			Conditions: eventConditionsFromRPC(c.Conditions),
+		})
+	}
+	return resp
+}
+
+func eventConditionsToRPC(conditions []EventCondition) []*gctrpc.EventCondition {
+	if len(conditions) == 0 {
+		return nil
+	}
+	resp := make([]*gctrpc.EventCondition, len(conditions))
+	for i := range conditions {
+		resp[i] = &gctrpc.EventCondition{
+			Item:            conditions[i].Item,
+			ConditionParams: conditionParamsToRPC(&conditions[i].Params),
+			Operator:        conditions[i].Operator,
+			Conditions:      eventConditionsToRPC(conditions[i].Conditions),
+		}
+	}
+	return resp
+}
+
+func eventActionsFromRPC(actions []*gctrpc.EventAction) []EventAction {
+	if len(actions) == 0 {
+		return nil
+	}
+	resp := make([]EventAction, 0, len(actions))
+	for _, a := range actions {
+		if a == nil {
+			continue
+		}
+		resp = append(resp, EventAction{
+			Action:    a.Action,
+			Side:      a.Side,
+			OrderType: a.OrderType,
+			Amount:    a.Amount,
+			Price:     a.Price,
+			OrderID:   a.OrderId,
+			Script:    a.Script,
+			Relayer:   a.Relayer,
+			Message:   a.Message,
+		})
+	}
+	return resp
+}
+
+func eventActionsToRPC(actions []EventAction) []*gctrpc.EventAction {
+	if len(actions) == 0 {
+		return nil
+	}
+	resp := make([]*gctrpc.EventAction, len(actions))
+	for i := range actions {
+		resp[i] = &gctrpc.EventAction{
+			Action:    actions[i].Action,
+			Side:      actions[i].Side,
+			OrderType: actions[i].OrderType,
+			Amount:    actions[i].Amount,
+			Price:     actions[i].Price,
+			OrderId:   actions[i].OrderID,
+			Script:    actions[i].Script,
+			Relayer:   actions[i].Relayer,
+			Message:   actions[i].Message,
+		}
+	}
+	return resp
+}
+
 // RemoveEvent removes an event, specified by an event ID
 func (s *RPCServer) RemoveEvent(_ context.Context, r *gctrpc.RemoveEventRequest) (*gctrpc.GenericResponse, error) {
 	if !s.eventManager.Remove(r.Id) {
@@ -1846,6 +2053,87 @@ func (s *RPCServer) WithdrawalEventsByDate(_ context.Context, r *gctrpc.Withdraw
 	return parseMultipleEvents(ret), nil
 }
 
+// GetPendingWithdrawals returns withdrawal requests awaiting approval
+func (s *RPCServer) GetPendingWithdrawals(_ context.Context, _ *gctrpc.GetPendingWithdrawalsRequest) (*gctrpc.GetPendingWithdrawalsResponse, error) {
+	pending, err := s.WithdrawManager.GetPendingWithdrawals()
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.GetPendingWithdrawalsResponse{
+		Pending: make([]*gctrpc.PendingWithdrawal, len(pending)),
+	}
+	for i := range pending {
+		p := &gctrpc.PendingWithdrawal{
+			Id:       pending[i].ID.String(),
+			Exchange: pending[i].Request.Exchange,
+			Request: &gctrpc.WithdrawalRequestEvent{
+				Currency:    pending[i].Request.Currency.String(),
+				Description: pending[i].Request.Description,
+				Amount:      pending[i].Request.Amount,
+				Type:        int64(pending[i].Request.Type),
+			},
+			Approvals:         make([]*gctrpc.WithdrawalApproval, len(pending[i].Approvals)),
+			RequiredApprovals: int64(pending[i].RequiredApprovals),
+			CreatedAt:         timestamppb.New(pending[i].CreatedAt),
+			ExpiresAt:         timestamppb.New(pending[i].ExpiresAt),
+		}
+		switch pending[i].Request.Type {
+		case withdraw.Crypto:
+			p.Request.Crypto = &gctrpc.CryptoWithdrawalEvent{
+				Address:    pending[i].Request.Crypto.Address,
+				AddressTag: pending[i].Request.Crypto.AddressTag,
+				Fee:        pending[i].Request.Crypto.FeeAmount,
+			}
+		case withdraw.Fiat:
+			p.Request.Fiat = &gctrpc.FiatWithdrawalEvent{
+				BankName:      pending[i].Request.Fiat.Bank.BankName,
+				AccountName:   pending[i].Request.Fiat.Bank.AccountName,
+				AccountNumber: pending[i].Request.Fiat.Bank.AccountNumber,
+				Bsb:           pending[i].Request.Fiat.Bank.BSBNumber,
+				Swift:         pending[i].Request.Fiat.Bank.SWIFTCode,
+				Iban:          pending[i].Request.Fiat.Bank.IBAN,
+			}
+		}
+		for j := range pending[i].Approvals {
+			p.Approvals[j] = &gctrpc.WithdrawalApproval{
+				Approver: pending[i].Approvals[j].Approver,
+				Time:     timestamppb.New(pending[i].Approvals[j].Time),
+			}
+		}
+		resp.Pending[i] = p
+	}
+	return resp, nil
+}
+
+// ApproveWithdrawal approves a pending withdrawal request, which is submitted
+// to the exchange once it has the required number of approvals
+func (s *RPCServer) ApproveWithdrawal(ctx context.Context, r *gctrpc.ApproveWithdrawalRequest) (*gctrpc.WithdrawResponse, error) {
+	approver, err := withdrawalApprover(ctx, r.Approver)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.WithdrawManager.ApproveWithdrawal(ctx, r.Id, approver)
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.WithdrawResponse{
+		Id:     resp.ID.String(),
+		Status: resp.Exchange.Status,
+	}, nil
+}
+
+// RejectWithdrawal rejects a pending withdrawal request
+func (s *RPCServer) RejectWithdrawal(ctx context.Context, r *gctrpc.RejectWithdrawalRequest) (*gctrpc.GenericResponse, error) {
+	approver, err := withdrawalApprover(ctx, r.Approver)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.WithdrawManager.RejectWithdrawal(r.Id, approver, r.Reason); err != nil {
+		return nil, err
+	}
+	return &gctrpc.GenericResponse{Status: MsgStatusSuccess}, nil
+}
+
 // GetLoggerDetails returns a loggers details
 func (s *RPCServer) GetLoggerDetails(_ context.Context, r *gctrpc.GetLoggerDetailsRequest) (*gctrpc.GetLoggerDetailsResponse, error) {
 	levels, err := log.Level(r.Logger)
@@ -2268,6 +2556,390 @@ func (s *RPCServer) GetExchangeTickerStream(r *gctrpc.GetExchangeTickerStreamReq
 	}
 }
 
+// GetOrderStream streams order lifecycle updates from the order manager as
+// they occur, optionally filtered by exchange, asset, pair and status
+func (s *RPCServer) GetOrderStream(r *gctrpc.GetOrderStreamRequest, stream gctrpc.GoCryptoTraderService_GetOrderStreamServer) error {
+	if r == nil {
+		return errNilRequestData
+	}
+
+	var exchName string
+	if r.Exchange != "" {
+		exch, err := s.GetExchangeByName(r.Exchange)
+		if err != nil {
+			return err
+		}
+		exchName = exch.GetName()
+	}
+
+	var a asset.Item
+	if r.AssetType != "" {
+		var err error
+		a, err = asset.New(r.AssetType)
+		if err != nil {
+			return err
+		}
+	}
+
+	var p currency.Pair
+	if r.Pair != nil && (r.Pair.Base != "" || r.Pair.Quote != "") {
+		var err error
+		p, err = currency.NewPairFromStrings(r.Pair.Base, r.Pair.Quote)
+		if err != nil {
+			return err
+		}
+	}
+
+	statuses := make([]order.Status, len(r.Statuses))
+	for i := range r.Statuses {
+		var err error
+		statuses[i], err = order.StringToOrderStatus(r.Statuses[i])
+		if err != nil {
+			return err
+		}
+	}
+
+	pipe, err := s.OrderManager.SubscribeOrderUpdates()
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		pipeErr := pipe.Release()
+		if pipeErr != nil {
+			log.Errorln(log.GRPCSys, pipeErr)
+		}
+	}()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case od, ok := <-pipe.Channel():
+			if !ok {
+				return pipe.Err()
+			}
+			if (exchName != "" && !strings.EqualFold(od.Exchange, exchName)) ||
+				(a != asset.Empty && od.AssetType != a) ||
+				(!p.IsEmpty() && !od.Pair.Equal(p)) ||
+				(len(statuses) > 0 && !slices.Contains(statuses, od.Status)) {
+				continue
+			}
+			if err := stream.Send(s.orderDetailToRPC(od)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// GetAggregatedOrderbookStream streams an orderbook consolidated across
+// exchanges, pushing a new book each time an underlying orderbook changes
+func (s *RPCServer) GetAggregatedOrderbookStream(r *gctrpc.GetAggregatedOrderbookStreamRequest, stream gctrpc.GoCryptoTraderService_GetAggregatedOrderbookStreamServer) error {
+	if r == nil {
+		return errNilRequestData
+	}
+	if r.Pair == nil {
+		return errCurrencyPairUnset
+	}
+	p, err := currency.NewPairFromStrings(r.Pair.Base, r.Pair.Quote)
+	if err != nil {
+		return err
+	}
+	a, err := asset.New(r.AssetType)
+	if err != nil {
+		return err
+	}
+
+	sources := make([]orderbook.AggregatorSource, len(r.Sources))
+	for i := range r.Sources {
+		sources[i] = orderbook.AggregatorSource{Exchange: r.Sources[i].Exchange, Fee: r.Sources[i].Fee}
+		if r.Sources[i].Pair != nil {
+			sources[i].Pair, err = currency.NewPairFromStrings(r.Sources[i].Pair.Base, r.Sources[i].Pair.Quote)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	agg, err := s.NewOrderbookAggregator(stream.Context(), &orderbook.AggregatorConfig{
+		Pair:           p,
+		Asset:          a,
+		Sources:        sources,
+		NormaliseQuote: r.NormaliseQuote,
+		IncludeFees:    r.IncludeFees,
+		MaxLevels:      int(r.MaxLevels),
+	})
+	if err != nil {
+		return err
+	}
+
+	return agg.Stream(stream.Context(), func(book *orderbook.AggregatedBook) error {
+		return stream.Send(aggregatedBookToRPC(book))
+	})
+}
+
+// aggregatedBookToRPC converts an aggregated orderbook to its gRPC representation
+func aggregatedBookToRPC(book *orderbook.AggregatedBook) *gctrpc.AggregatedOrderbookResponse {
+	resp := &gctrpc.AggregatedOrderbookResponse{
+		Pair:        &gctrpc.CurrencyPair{Base: book.Pair.Base.String(), Quote: book.Pair.Quote.String(), Delimiter: book.Pair.Delimiter},
+		AssetType:   book.Asset.String(),
+		Bids:        aggregatedLevelsToRPC(book.Bids),
+		Asks:        aggregatedLevelsToRPC(book.Asks),
+		LastUpdated: book.LastUpdated.UnixMicro(),
+	}
+	if len(book.Unavailable) > 0 {
+		resp.Unavailable = make(map[string]string, len(book.Unavailable))
+		for exch, err := range book.Unavailable {
+			resp.Unavailable[exch] = err.Error()
+		}
+	}
+	return resp
+}
+
+// aggregatedLevelsToRPC converts aggregated orderbook levels to their gRPC representation
+func aggregatedLevelsToRPC(levels []orderbook.AggregatedLevel) []*gctrpc.AggregatedOrderbookLevel {
+	out := make([]*gctrpc.AggregatedOrderbookLevel, len(levels))
+	for i := range levels {
+		sources := make([]*gctrpc.AggregatedOrderbookLevelSource, len(levels[i].Sources))
+		for j, src := range levels[i].Sources {
+			sources[j] = &gctrpc.AggregatedOrderbookLevelSource{
+				Exchange:       src.Exchange,
+				Pair:           &gctrpc.CurrencyPair{Base: src.Pair.Base.String(), Quote: src.Pair.Quote.String(), Delimiter: src.Pair.Delimiter},
+				Price:          src.Price,
+				Amount:         src.Amount,
+				Fee:            src.Fee,
+				EffectivePrice: src.EffectivePrice,
+			}
+		}
+		out[i] = &gctrpc.AggregatedOrderbookLevel{
+			Price:   levels[i].Price,
+			Amount:  levels[i].Amount,
+			Sources: sources,
+		}
+	}
+	return out
+}
+
+// GetOrderbookAnalytics returns the latest microstructure analytics of an orderbook, tracking the orderbook with the
+// requested settings until untracked if it is not already tracked
+func (s *RPCServer) GetOrderbookAnalytics(ctx context.Context, r *gctrpc.GetOrderbookAnalyticsRequest) (*gctrpc.OrderbookAnalyticsResponse, error) {
+	t, err := s.trackOrderbookAnalytics(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	m, err := t.Metrics()
+	if err != nil {
+		return nil, err
+	}
+	return orderbookAnalyticsToRPC(m), nil
+}
+
+// GetOrderbookAnalyticsStream streams the microstructure analytics of an orderbook each time they are recalculated,
+// tracking the orderbook with the requested settings if it is not already tracked. An orderbook tracked only by streams
+// is untracked once they have all finished
+func (s *RPCServer) GetOrderbookAnalyticsStream(r *gctrpc.GetOrderbookAnalyticsRequest, stream gctrpc.GoCryptoTraderService_GetOrderbookAnalyticsStreamServer) error {
+	p, a, cfg, err := orderbookAnalyticsRequest(r)
+	if err != nil {
+		return err
+	}
+	t, err := s.SubscribeOrderbookAnalytics(stream.Context(), r.Exchange, p, a, cfg)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if releaseErr := t.Release(); releaseErr != nil {
+			log.Errorln(log.GRPCSys, releaseErr)
+		}
+	}()
+	pipe, err := t.Subscribe()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if pipeErr := pipe.Release(); pipeErr != nil {
+			log.Errorln(log.DispatchMgr, pipeErr)
+		}
+	}()
+
+	if m, err := t.Metrics(); err == nil {
+		if err := stream.Send(orderbookAnalyticsToRPC(m)); err != nil {
+			return err
+		}
+	}
+	for {
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case data, ok := <-pipe.Channel():
+			if !ok {
+				return errDispatchSystem
+			}
+			m, ok := data.(*analytics.Metrics)
+			if !ok {
+				return common.GetTypeAssertError("*analytics.Metrics", data)
+			}
+			if err := stream.Send(orderbookAnalyticsToRPC(m)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// UntrackOrderbookAnalytics stops tracking the analytics of an orderbook. An orderbook being streamed is untracked
+// once its streams have finished
+func (s *RPCServer) UntrackOrderbookAnalytics(_ context.Context, r *gctrpc.UntrackOrderbookAnalyticsRequest) (*gctrpc.GenericResponse, error) {
+	if r == nil {
+		return nil, errNilRequestData
+	}
+	if r.Pair == nil {
+		return nil, errCurrencyPairUnset
+	}
+	p, err := currency.NewPairFromStrings(r.Pair.Base, r.Pair.Quote)
+	if err != nil {
+		return nil, err
+	}
+	a, err := asset.New(r.AssetType)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.Engine.UntrackOrderbookAnalytics(r.Exchange, p, a); err != nil {
+		return nil, err
+	}
+	return &gctrpc.GenericResponse{Status: MsgStatusSuccess}, nil
+}
+
+// trackOrderbookAnalytics returns the analytics tracker of the orderbook requested, tracking it until untracked
+func (s *RPCServer) trackOrderbookAnalytics(ctx context.Context, r *gctrpc.GetOrderbookAnalyticsRequest) (*analytics.Tracker, error) {
+	p, a, cfg, err := orderbookAnalyticsRequest(r)
+	if err != nil {
+		return nil, err
+	}
+	return s.TrackOrderbookAnalytics(ctx, r.Exchange, p, a, cfg)
+}
+
+// orderbookAnalyticsRequest returns the orderbook and settings requested. Settings are only returned when at least
+// one is set, otherwise an orderbook already tracked is used regardless of its settings
+func orderbookAnalyticsRequest(r *gctrpc.GetOrderbookAnalyticsRequest) (currency.Pair, asset.Item, *analytics.Config, error) {
+	if r == nil {
+		return currency.EMPTYPAIR, asset.Empty, nil, errNilRequestData
+	}
+	if r.Pair == nil {
+		return currency.EMPTYPAIR, asset.Empty, nil, errCurrencyPairUnset
+	}
+	p, err := currency.NewPairFromStrings(r.Pair.Base, r.Pair.Quote)
+	if err != nil {
+		return currency.EMPTYPAIR, asset.Empty, nil, err
+	}
+	a, err := asset.New(r.AssetType)
+	if err != nil {
+		return currency.EMPTYPAIR, asset.Empty, nil, err
+	}
+	var cfg *analytics.Config
+	if len(r.Bands) != 0 || r.Levels != 0 || r.Window != 0 {
+		cfg = &analytics.Config{Bands: r.Bands, Levels: int(r.Levels), Window: time.Duration(r.Window)}
+	}
+	return p, a, cfg, nil
+}
+
+// orderbookAnalyticsToRPC converts orderbook analytics to their gRPC representation
+func orderbookAnalyticsToRPC(m *analytics.Metrics) *gctrpc.OrderbookAnalyticsResponse {
+	bands := make([]*gctrpc.OrderbookAnalyticsBand, len(m.Bands))
+	for i := range m.Bands {
+		bands[i] = &gctrpc.OrderbookAnalyticsBand{
+			Bps:       m.Bands[i].Bps,
+			BidAmount: m.Bands[i].BidAmount,
+			AskAmount: m.Bands[i].AskAmount,
+			BidValue:  m.Bands[i].BidValue,
+			AskValue:  m.Bands[i].AskValue,
+			Imbalance: m.Bands[i].Imbalance,
+		}
+	}
+	return &gctrpc.OrderbookAnalyticsResponse{
+		Exchange:         m.Exchange,
+		Pair:             &gctrpc.CurrencyPair{Base: m.Pair.Base.String(), Quote: m.Pair.Quote.String(), Delimiter: m.Pair.Delimiter},
+		AssetType:        m.Asset.String(),
+		UpdateId:         m.UpdateID,
+		LastUpdated:      timestamppb.New(m.LastUpdated),
+		BestBid:          m.BestBid,
+		BestAsk:          m.BestAsk,
+		MidPrice:         m.MidPrice,
+		WeightedMidPrice: m.WeightedMidPrice,
+		Microprice:       m.Microprice,
+		Spread:           m.Spread,
+		SpreadBps:        m.SpreadBps,
+		Imbalance:        m.Imbalance,
+		SpreadStats: &gctrpc.OrderbookAnalyticsSpreadStats{
+			Mean:   m.SpreadStats.Mean,
+			StdDev: m.SpreadStats.StdDev,
+			Min:    m.SpreadStats.Min,
+			Max:    m.SpreadStats.Max,
+		},
+		Bands:  bands,
+		Bids:   orderbookAnalyticsFlowToRPC(&m.Bids),
+		Asks:   orderbookAnalyticsFlowToRPC(&m.Asks),
+		Window: int64(m.Window),
+	}
+}
+
+// orderbookAnalyticsFlowToRPC converts orderbook liquidity flow to its gRPC representation
+func orderbookAnalyticsFlowToRPC(f *analytics.Flow) *gctrpc.OrderbookAnalyticsFlow {
+	return &gctrpc.OrderbookAnalyticsFlow{
+		ReplenishedPerSecond:    f.ReplenishedPerSecond,
+		CancelledPerSecond:      f.CancelledPerSecond,
+		ReplenishmentsPerSecond: f.ReplenishmentsPerSecond,
+		CancellationsPerSecond:  f.CancellationsPerSecond,
+	}
+}
+
+// orderDetailToRPC converts an order to its gRPC representation
+func (s *RPCServer) orderDetailToRPC(od *order.Detail) *gctrpc.OrderDetails {
+	trades := make([]*gctrpc.TradeHistory, len(od.Trades))
+	for i := range od.Trades {
+		t := &gctrpc.TradeHistory{
+			Id:        od.Trades[i].TID,
+			Price:     od.Trades[i].Price,
+			Amount:    od.Trades[i].Amount,
+			Exchange:  od.Exchange,
+			AssetType: od.AssetType.String(),
+			OrderSide: od.Trades[i].Side.String(),
+			Fee:       od.Trades[i].Fee,
+			Total:     od.Trades[i].Total,
+		}
+		if !od.Trades[i].Timestamp.IsZero() {
+			t.CreationTime = s.unixTimestamp(od.Trades[i].Timestamp)
+		}
+		trades[i] = t
+	}
+	resp := &gctrpc.OrderDetails{
+		Exchange:             od.Exchange,
+		Id:                   od.OrderID,
+		ClientOrderId:        od.ClientOrderID,
+		BaseCurrency:         od.Pair.Base.String(),
+		QuoteCurrency:        od.Pair.Quote.String(),
+		AssetType:            od.AssetType.String(),
+		OrderSide:            od.Side.String(),
+		OrderType:            od.Type.String(),
+		Status:               od.Status.String(),
+		Price:                od.Price,
+		Amount:               od.Amount,
+		OpenVolume:           od.Amount - od.ExecutedAmount,
+		Fee:                  od.Fee,
+		Cost:                 od.Cost,
+		Trades:               trades,
+		ContractAmount:       od.ContractAmount,
+		ExecutedAmount:       od.ExecutedAmount,
+		AverageExecutedPrice: od.AverageExecutedPrice,
+		FeeAsset:             od.FeeAsset.String(),
+	}
+	if !od.Date.IsZero() {
+		resp.CreationTime = od.Date.Format(common.SimpleTimeFormatWithTimezone)
+	}
+	if !od.LastUpdated.IsZero() {
+		resp.UpdateTime = od.LastUpdated.Format(common.SimpleTimeFormatWithTimezone)
+	}
+	return resp
+}
+
 // GetAuditEvent returns matching audit events from database
 func (s *RPCServer) GetAuditEvent(_ context.Context, r *gctrpc.GetAuditEventRequest) (*gctrpc.GetAuditEventResponse, error) {
 	start, err := time.Parse(common.SimpleTimeFormatWithTimezone, r.StartDate)
@@ -5884,3 +6556,451 @@ func (s *RPCServer) GetCurrencyTradeURL(ctx context.Context, r *gctrpc.GetCurren
 		Url: url,
 	}, nil
 }
+
+// AddConditionalOrder adds a conditional order to the order manager which is
+// submitted to the exchange once its trigger conditions are met
+func (s *RPCServer) AddConditionalOrder(ctx context.Context, r *gctrpc.AddConditionalOrderRequest) (*gctrpc.ConditionalOrder, error) {
+	if r == nil {
+		return nil, fmt.Errorf("%w AddConditionalOrderRequest", common.ErrNilPointer)
+	}
+	a, err := asset.New(r.Asset)
+	if err != nil {
+		return nil, err
+	}
+	if r.Pair == nil {
+		return nil, errCurrencyPairUnset
+	}
+	exch, err := s.GetExchangeByName(r.Exchange)
+	if err != nil {
+		return nil, err
+	}
+	cp := currency.NewPairWithDelimiter(r.Pair.Base, r.Pair.Quote, r.Pair.Delimiter)
+	err = checkParams(r.Exchange, exch, a, cp)
+	if err != nil {
+		return nil, err
+	}
+	oType, err := StringToConditionalOrderType(r.Type)
+	if err != nil {
+		return nil, err
+	}
+	side, err := order.StringToOrderSide(r.Side)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.OrderManager.AddConditionalOrder(ctx, &ConditionalOrder{
+		Exchange:        exch.GetName(),
+		Pair:            cp,
+		Asset:           a,
+		Type:            oType,
+		Side:            side,
+		Amount:          r.Amount,
+		TriggerPrice:    r.TriggerPrice,
+		LimitPrice:      r.LimitPrice,
+		TakeProfitPrice: r.TakeProfitPrice,
+		EntryPrice:      r.EntryPrice,
+		TrailingAmount:  r.TrailingAmount,
+		TrailingPercent: r.TrailingPercent,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return conditionalOrderToRPC(resp), nil
+}
+
+// GetConditionalOrders returns conditional orders hosted by the order manager
+func (s *RPCServer) GetConditionalOrders(_ context.Context, r *gctrpc.GetConditionalOrdersRequest) (*gctrpc.GetConditionalOrdersResponse, error) {
+	if r == nil {
+		return nil, fmt.Errorf("%w GetConditionalOrdersRequest", common.ErrNilPointer)
+	}
+	if r.Exchange != "" {
+		if _, err := s.GetExchangeByName(r.Exchange); err != nil {
+			return nil, err
+		}
+	}
+	orders, err := s.OrderManager.GetConditionalOrders(r.Exchange, r.ActiveOnly)
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.GetConditionalOrdersResponse{
+		Orders: make([]*gctrpc.ConditionalOrder, len(orders)),
+	}
+	for i := range orders {
+		resp.Orders[i] = conditionalOrderToRPC(&orders[i])
+	}
+	return resp, nil
+}
+
+// CancelConditionalOrder cancels an active conditional order along with any
+// pending bracket entry order
+func (s *RPCServer) CancelConditionalOrder(ctx context.Context, r *gctrpc.CancelConditionalOrderRequest) (*gctrpc.ConditionalOrder, error) {
+	if r == nil {
+		return nil, fmt.Errorf("%w CancelConditionalOrderRequest", common.ErrNilPointer)
+	}
+	id, err := uuid.FromString(r.Id)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.OrderManager.CancelConditionalOrder(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return conditionalOrderToRPC(resp), nil
+}
+
+func conditionalOrderToRPC(c *ConditionalOrder) *gctrpc.ConditionalOrder {
+	return &gctrpc.ConditionalOrder{
+		Id:       c.ID.String(),
+		Exchange: c.Exchange,
+		Pair: &gctrpc.CurrencyPair{
+			Delimiter: c.Pair.Delimiter,
+			Base:      c.Pair.Base.String(),
+			Quote:     c.Pair.Quote.String(),
+		},
+		Asset:           c.Asset.String(),
+		Type:            string(c.Type),
+		Side:            c.Side.String(),
+		Amount:          c.Amount,
+		TriggerPrice:    c.TriggerPrice,
+		LimitPrice:      c.LimitPrice,
+		TakeProfitPrice: c.TakeProfitPrice,
+		EntryPrice:      c.EntryPrice,
+		TrailingAmount:  c.TrailingAmount,
+		TrailingPercent: c.TrailingPercent,
+		ReferencePrice:  c.ReferencePrice,
+		EntryOrderId:    c.EntryOrderID,
+		OrderId:         c.OrderID,
+		Status:          string(c.Status),
+		FailureReason:   c.FailureReason,
+		CreatedAt:       timestamppb.New(c.CreatedAt),
+		UpdatedAt:       timestamppb.New(c.UpdatedAt),
+	}
+}
+
+// SetKillSwitch enables or disables the order manager's kill switch, which
+// blocks all new orders and optionally cancels open orders
+func (s *RPCServer) SetKillSwitch(ctx context.Context, r *gctrpc.SetKillSwitchRequest) (*gctrpc.GenericResponse, error) {
+	if r == nil {
+		return nil, fmt.Errorf("%w SetKillSwitchRequest", common.ErrNilPointer)
+	}
+	if err := s.OrderManager.SetKillSwitch(ctx, r.Enabled, r.CancelOrders); err != nil {
+		return nil, err
+	}
+	status := "disabled"
+	if r.Enabled {
+		status = "enabled"
+	}
+	return &gctrpc.GenericResponse{Status: MsgStatusSuccess, Data: "kill switch " + status}, nil
+}
+
+// RouteOrder plans an order split across exchanges by orderbook depth and
+// trading fees, optionally executing it through the order manager
+func (s *RPCServer) RouteOrder(ctx context.Context, r *gctrpc.RouteOrderRequest) (*gctrpc.RouteOrderResponse, error) {
+	if r == nil {
+		return nil, fmt.Errorf("%w RouteOrderRequest", common.ErrNilPointer)
+	}
+	if r.Pair == nil {
+		return nil, errCurrencyPairUnset
+	}
+	a := asset.Spot
+	if r.AssetType != "" {
+		var err error
+		a, err = asset.New(r.AssetType)
+		if err != nil {
+			return nil, err
+		}
+	}
+	side, err := order.StringToOrderSide(r.Side)
+	if err != nil {
+		return nil, err
+	}
+	router, err := NewOrderRouter(s.ExchangeManager, s.OrderManager)
+	if err != nil {
+		return nil, err
+	}
+	plan, err := router.PlanRoute(ctx, &RouteRequest{
+		Pair:           currency.NewPairWithDelimiter(r.Pair.Base, r.Pair.Quote, r.Pair.Delimiter),
+		Asset:          a,
+		Side:           side,
+		Amount:         r.Amount,
+		Exchanges:      r.Exchanges,
+		IgnoreBalances: r.IgnoreBalances,
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp := routePlanToRPC(plan)
+	if !r.Execute {
+		return resp, nil
+	}
+	results, err := router.ExecuteRoute(ctx, plan)
+	if err != nil && !errors.Is(err, errRouteLegsRejected) {
+		return nil, err
+	}
+	resp.Executed = true
+	for i := range results {
+		if results[i].Err != nil {
+			resp.Legs[i].Error = results[i].Err.Error()
+			continue
+		}
+		if results[i].Response != nil && results[i].Response.Detail != nil {
+			resp.Legs[i].OrderId = results[i].Response.OrderID
+		}
+	}
+	if err != nil {
+		resp.Status += " [WARNING]: " + err.Error()
+	}
+	return resp, nil
+}
+
+func routePlanToRPC(p *RoutePlan) *gctrpc.RouteOrderResponse {
+	resp := &gctrpc.RouteOrderResponse{
+		Pair: &gctrpc.CurrencyPair{
+			Delimiter: p.Pair.Delimiter,
+			Base:      p.Pair.Base.String(),
+			Quote:     p.Pair.Quote.String(),
+		},
+		AssetType:       p.Asset.String(),
+		Side:            p.Side.String(),
+		RequestedAmount: p.RequestedAmount,
+		RoutedAmount:    p.RoutedAmount,
+		UnroutedAmount:  p.UnroutedAmount,
+		AveragePrice:    p.AveragePrice,
+		EffectivePrice:  p.EffectivePrice,
+		TotalFee:        p.TotalFee,
+		Legs:            make([]*gctrpc.RouteOrderLeg, len(p.Legs)),
+		Skipped:         make([]*gctrpc.RouteOrderSkipped, len(p.Skipped)),
+		Status:          p.Status,
+	}
+	for i := range p.Legs {
+		leg := &p.Legs[i]
+		orders := make([]*gctrpc.OrderbookItem, len(leg.Levels))
+		for j := range leg.Levels {
+			orders[j] = &gctrpc.OrderbookItem{Price: leg.Levels[j].Price, Amount: leg.Levels[j].Amount}
+		}
+		resp.Legs[i] = &gctrpc.RouteOrderLeg{
+			Exchange:       leg.Exchange,
+			Amount:         leg.Amount,
+			AveragePrice:   leg.AveragePrice,
+			WorstPrice:     leg.WorstPrice,
+			EffectivePrice: leg.EffectivePrice,
+			Cost:           leg.Cost,
+			Fee:            leg.Fee,
+			Orders:         orders,
+		}
+	}
+	for i := range p.Skipped {
+		resp.Skipped[i] = &gctrpc.RouteOrderSkipped{Exchange: p.Skipped[i].Exchange, Reason: p.Skipped[i].Reason}
+	}
+	return resp
+}
+
+// GetLedgerPNL returns the holdings, cost basis and realised and unrealised
+// PNL of each currency tracked by the spot ledger
+func (s *RPCServer) GetLedgerPNL(_ context.Context, r *gctrpc.GetLedgerPNLRequest) (*gctrpc.GetLedgerPNLResponse, error) {
+	l, err := s.ledgerManager.Ledger()
+	if err != nil {
+		return nil, err
+	}
+	summaries := l.Summaries(currency.NewCode(r.Currency))
+	resp := &gctrpc.GetLedgerPNLResponse{
+		Method:            l.Method().String(),
+		ReportingCurrency: l.ReportingCurrency().String(),
+		Currencies:        make([]*gctrpc.LedgerCurrencyPNL, len(summaries)),
+	}
+	var realised, unrealised decimal.Decimal
+	for i := range summaries {
+		realised = realised.Add(summaries[i].RealisedPNL)
+		unrealised = unrealised.Add(summaries[i].UnrealisedPNL)
+		resp.Currencies[i] = &gctrpc.LedgerCurrencyPNL{
+			Currency:          summaries[i].Currency.String(),
+			Holdings:          summaries[i].Holdings.String(),
+			CostBasis:         summaries[i].CostBasis.String(),
+			AverageCost:       summaries[i].AverageCost.String(),
+			RealisedPnl:       summaries[i].RealisedPNL.String(),
+			Valued:            summaries[i].Valued,
+			MarketValue:       summaries[i].MarketValue.String(),
+			UnrealisedPnl:     summaries[i].UnrealisedPNL.String(),
+			UnmatchedAmount:   summaries[i].UnmatchedAmount.String(),
+			UnmatchedProceeds: summaries[i].UnmatchedProceeds.String(),
+			UnvaluedHoldings:  summaries[i].UnvaluedHoldings.String(),
+			UnvaluedDisposed:  summaries[i].UnvaluedDisposed.String(),
+		}
+	}
+	resp.RealisedPnl = realised.String()
+	resp.UnrealisedPnl = unrealised.String()
+	return resp, nil
+}
+
+// GetLedgerLots returns the lots tracked by the spot ledger and the disposals
+// matched against them
+func (s *RPCServer) GetLedgerLots(_ context.Context, r *gctrpc.GetLedgerLotsRequest) (*gctrpc.GetLedgerLotsResponse, error) {
+	l, err := s.ledgerManager.Ledger()
+	if err != nil {
+		return nil, err
+	}
+	lots := l.Lots(currency.NewCode(r.Currency), r.IncludeClosed)
+	resp := &gctrpc.GetLedgerLotsResponse{
+		Method:            l.Method().String(),
+		ReportingCurrency: l.ReportingCurrency().String(),
+		Lots:              make([]*gctrpc.LedgerLot, len(lots)),
+	}
+	for i := range lots {
+		lot := &gctrpc.LedgerLot{
+			Id:         lots[i].ID,
+			Currency:   lots[i].Currency.String(),
+			Exchange:   lots[i].Exchange,
+			Pair:       rpcLedgerPair(lots[i].Pair),
+			OrderId:    lots[i].OrderID,
+			TradeId:    lots[i].TradeID,
+			AcquiredAt: timestamppb.New(lots[i].AcquiredAt),
+			Amount:     lots[i].Amount.String(),
+			Remaining:  lots[i].Remaining.String(),
+			CostBasis:  lots[i].CostBasis.String(),
+			UnitCost:   lots[i].UnitCost().String(),
+			Disposals:  make([]*gctrpc.LedgerDisposal, len(lots[i].Disposals)),
+			Valued:     lots[i].Valued,
+		}
+		for j := range lots[i].Disposals {
+			d := &lots[i].Disposals[j]
+			lot.Disposals[j] = &gctrpc.LedgerDisposal{
+				Exchange:    d.Exchange,
+				Pair:        rpcLedgerPair(d.Pair),
+				OrderId:     d.OrderID,
+				TradeId:     d.TradeID,
+				Time:        timestamppb.New(d.Time),
+				Amount:      d.Amount.String(),
+				Proceeds:    d.Proceeds.String(),
+				CostBasis:   d.CostBasis.String(),
+				RealisedPnl: d.RealisedPNL.String(),
+				Valued:      d.Valued,
+			}
+		}
+		resp.Lots[i] = lot
+	}
+	return resp, nil
+}
+
+// ExportLedgerCSV returns a spot ledger report as CSV. The report is either
+// summary, lots or disposals
+func (s *RPCServer) ExportLedgerCSV(_ context.Context, r *gctrpc.ExportLedgerCSVRequest) (*gctrpc.ExportLedgerCSVResponse, error) {
+	l, err := s.ledgerManager.Ledger()
+	if err != nil {
+		return nil, err
+	}
+	c := currency.NewCode(r.Currency)
+	report := strings.ToLower(r.Report)
+	var buf bytes.Buffer
+	switch report {
+	case "", "summary":
+		report = "summary"
+		err = l.WriteSummariesCSV(&buf, c)
+	case "lots":
+		err = l.WriteLotsCSV(&buf, c, r.IncludeClosed)
+	case "disposals":
+		err = l.WriteDisposalsCSV(&buf, c)
+	default:
+		return nil, fmt.Errorf("%w: %q", errUnknownLedgerReport, r.Report)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.ExportLedgerCSVResponse{Report: report, Csv: buf.String()}, nil
+}
+
+// rpcLedgerPair converts a ledger currency pair to its gRPC representation
+func rpcLedgerPair(p currency.Pair) *gctrpc.CurrencyPair {
+	return &gctrpc.CurrencyPair{
+		Delimiter: p.Delimiter,
+		Base:      p.Base.String(),
+		Quote:     p.Quote.String(),
+	}
+}
+
+// PreviewRebalance plans the trades needed to return holdings to their target
+// weights. The plan replaces any plan awaiting approval
+func (s *RPCServer) PreviewRebalance(ctx context.Context, _ *gctrpc.PreviewRebalanceRequest) (*gctrpc.RebalancePlanResponse, error) {
+	plan, err := s.rebalanceManager.PlanRebalance(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return rebalancePlanToRPC(plan), nil
+}
+
+// GetRebalancePlan returns the most recent rebalance plan
+func (s *RPCServer) GetRebalancePlan(_ context.Context, _ *gctrpc.GetRebalancePlanRequest) (*gctrpc.RebalancePlanResponse, error) {
+	plan, err := s.rebalanceManager.GetRebalancePlan()
+	if err != nil {
+		return nil, err
+	}
+	return rebalancePlanToRPC(plan), nil
+}
+
+// ApproveRebalancePlan submits the trades of the rebalance plan awaiting
+// approval
+func (s *RPCServer) ApproveRebalancePlan(ctx context.Context, r *gctrpc.ApproveRebalancePlanRequest) (*gctrpc.RebalancePlanResponse, error) {
+	plan, err := s.rebalanceManager.ApproveRebalancePlan(ctx, r.Id)
+	if err != nil && !errors.Is(err, errRebalanceTradesRejected) {
+		return nil, err
+	}
+	return rebalancePlanToRPC(plan), nil
+}
+
+func rebalancePlanToRPC(p *RebalancePlan) *gctrpc.RebalancePlanResponse {
+	resp := &gctrpc.RebalancePlanResponse{
+		Id:                p.ID.String(),
+		Status:            p.Status,
+		ReportingCurrency: p.ReportingCurrency.String(),
+		TotalValue:        p.TotalValue,
+		Allocations:       make([]*gctrpc.RebalanceAllocation, len(p.Allocations)),
+		Trades:            make([]*gctrpc.RebalanceTrade, len(p.Trades)),
+		Skipped:           make([]*gctrpc.RebalanceSkipped, len(p.Skipped)),
+		CreatedAt:         timestamppb.New(p.CreatedAt),
+	}
+	if !p.ExpiresAt.IsZero() {
+		resp.ExpiresAt = timestamppb.New(p.ExpiresAt)
+	}
+	if !p.ExecutedAt.IsZero() {
+		resp.ExecutedAt = timestamppb.New(p.ExecutedAt)
+	}
+	for i := range p.Allocations {
+		a := &p.Allocations[i]
+		resp.Allocations[i] = &gctrpc.RebalanceAllocation{
+			Currency:     a.Currency.String(),
+			Exchange:     a.Exchange,
+			Amount:       a.Amount,
+			Value:        a.Value,
+			Weight:       a.Weight,
+			TargetWeight: a.TargetWeight,
+			Drift:        a.Drift,
+			TradeValue:   a.TradeValue,
+		}
+	}
+	for i := range p.Trades {
+		t := &p.Trades[i]
+		resp.Trades[i] = &gctrpc.RebalanceTrade{
+			Exchange: t.Exchange,
+			Pair: &gctrpc.CurrencyPair{
+				Delimiter: t.Pair.Delimiter,
+				Base:      t.Pair.Base.String(),
+				Quote:     t.Pair.Quote.String(),
+			},
+			Side:    t.Side.String(),
+			Amount:  t.Amount,
+			Price:   t.Price,
+			Value:   t.Value,
+			OrderId: t.OrderID,
+			Error:   t.Error,
+		}
+	}
+	for i := range p.Skipped {
+		sk := &p.Skipped[i]
+		resp.Skipped[i] = &gctrpc.RebalanceSkipped{
+			Exchange: sk.Exchange,
+			Sell:     sk.Sell.String(),
+			Buy:      sk.Buy.String(),
+			Value:    sk.Value,
+			Reason:   sk.Reason,
+		}
+	}
+	return resp
+}
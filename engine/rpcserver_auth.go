@@ -0,0 +1,430 @@
+package engine
+
+import (
+	"context"
+	"crypto/subtle"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/audit"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc/auth"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// gRPC scopes which can be granted to users
+const (
+	RPCScopeRead     = "read"
+	RPCScopeTrade    = "trade"
+	RPCScopeWithdraw = "withdraw"
+	RPCScopeAdmin    = "admin"
+
+	rpcAuditType = "gRPC"
+)
+
+var (
+	errRPCAuthNotSetup           = errors.New("gRPC authentication not setup")
+	errRPCNoCredentials          = errors.New("no gRPC users or username and password configured")
+	errRPCUsernameEmpty          = errors.New("gRPC user username is empty")
+	errRPCUserDuplicate          = errors.New("duplicate gRPC user")
+	errRPCUserNoScopes           = errors.New("gRPC user has no scopes")
+	errRPCScopeInvalid           = errors.New("invalid gRPC scope")
+	errRPCUserNoCredentials      = errors.New("gRPC user has no password hash, token hash or client certificate authority")
+	errRPCTokenHashInvalid       = errors.New("gRPC user token hash must be a hex encoded SHA-256 hash")
+	errRPCClientCARequired       = errors.New("client certificate authority file is required to require client certificates")
+	errRPCClientCAInvalid        = errors.New("no certificates found in client certificate authority file")
+	errRPCProxyClientCertificate = errors.New("gRPC proxy cannot be used when client certificates are required")
+	errAuthorizationMissing      = errors.New("authorization header missing")
+	errAuthorizationInvalid      = errors.New("invalid authorization header")
+	errUsernamePasswordMismatch  = errors.New("username/password mismatch")
+	errTokenMismatch             = errors.New("bearer token mismatch")
+	errPermissionDenied          = errors.New("permission denied")
+	errApproverMismatch          = errors.New("withdrawals can only be approved or rejected as the authenticated user")
+	errApproverUnauthenticated   = errors.New("withdrawal approvals require an authenticated user")
+)
+
+// rpcMethodScopes maps each gRPC method to the scope required to call it.
+// Methods which are not listed require the admin scope
+var rpcMethodScopes = map[string]string{
+	"GetInfo":                           RPCScopeRead,
+	"GetSubsystems":                     RPCScopeRead,
+	"EnableSubsystem":                   RPCScopeAdmin,
+	"DisableSubsystem":                  RPCScopeAdmin,
+	"GetRPCEndpoints":                   RPCScopeRead,
+	"GetCommunicationRelayers":          RPCScopeRead,
+	"GetExchanges":                      RPCScopeRead,
+	"DisableExchange":                   RPCScopeAdmin,
+	"GetExchangeInfo":                   RPCScopeRead,
+	"GetExchangeOTPCode":                RPCScopeAdmin,
+	"GetExchangeOTPCodes":               RPCScopeAdmin,
+	"EnableExchange":                    RPCScopeAdmin,
+	"GetTicker":                         RPCScopeRead,
+	"GetTickers":                        RPCScopeRead,
+	"GetOrderbook":                      RPCScopeRead,
+	"GetOrderbooks":                     RPCScopeRead,
+	"GetAccountBalances":                RPCScopeRead,
+	"UpdateAccountBalances":             RPCScopeRead,
+	"GetAccountBalancesStream":          RPCScopeRead,
+	"GetConfig":                         RPCScopeAdmin,
+	"GetPortfolio":                      RPCScopeRead,
+	"GetPortfolioSummary":               RPCScopeRead,
+	"AddPortfolioAddress":               RPCScopeAdmin,
+	"RemovePortfolioAddress":            RPCScopeAdmin,
+	"GetForexProviders":                 RPCScopeRead,
+	"GetForexRates":                     RPCScopeRead,
+	"GetOrders":                         RPCScopeRead,
+	"GetOrder":                          RPCScopeRead,
+	"SubmitOrder":                       RPCScopeTrade,
+	"SimulateOrder":                     RPCScopeRead,
+	"WhaleBomb":                         RPCScopeRead,
+	"CancelOrder":                       RPCScopeTrade,
+	"CancelBatchOrders":                 RPCScopeTrade,
+	"CancelAllOrders":                   RPCScopeTrade,
+	"GetEvents":                         RPCScopeRead,
+	"AddEvent":                          RPCScopeAdmin,
+	"RemoveEvent":                       RPCScopeAdmin,
+	"GetCryptocurrencyDepositAddresses": RPCScopeRead,
+	"GetCryptocurrencyDepositAddress":   RPCScopeRead,
+	"GetAvailableTransferChains":        RPCScopeRead,
+	"WithdrawFiatFunds":                 RPCScopeWithdraw,
+	"WithdrawCryptocurrencyFunds":       RPCScopeWithdraw,
+	"WithdrawalEventByID":               RPCScopeRead,
+	"WithdrawalEventsByExchange":        RPCScopeRead,
+	"WithdrawalEventsByDate":            RPCScopeRead,
+	"GetPendingWithdrawals":             RPCScopeRead,
+	"ApproveWithdrawal":                 RPCScopeWithdraw,
+	"RejectWithdrawal":                  RPCScopeWithdraw,
+	"GetLoggerDetails":                  RPCScopeRead,
+	"SetLoggerDetails":                  RPCScopeAdmin,
+	"GetExchangePairs":                  RPCScopeRead,
+	"SetExchangePair":                   RPCScopeAdmin,
+	"GetOrderbookStream":                RPCScopeRead,
+	"GetExchangeOrderbookStream":        RPCScopeRead,
+	"GetTickerStream":                   RPCScopeRead,
+	"GetExchangeTickerStream":           RPCScopeRead,
+	"GetOrderStream":                    RPCScopeRead,
+	"GetAggregatedOrderbookStream":      RPCScopeRead,
+	"GetAuditEvent":                     RPCScopeAdmin,
+	"GCTScriptExecute":                  RPCScopeAdmin,
+	"GCTScriptUpload":                   RPCScopeAdmin,
+	"GCTScriptReadScript":               RPCScopeRead,
+	"GCTScriptStatus":                   RPCScopeRead,
+	"GCTScriptQuery":                    RPCScopeRead,
+	"GCTScriptStop":                     RPCScopeAdmin,
+	"GCTScriptStopAll":                  RPCScopeAdmin,
+	"GCTScriptListAll":                  RPCScopeRead,
+	"GCTScriptAutoLoadToggle":           RPCScopeAdmin,
+	"GetHistoricCandles":                RPCScopeRead,
+	"SetExchangeAsset":                  RPCScopeAdmin,
+	"SetAllExchangePairs":               RPCScopeAdmin,
+	"UpdateExchangeSupportedPairs":      RPCScopeAdmin,
+	"GetExchangeAssets":                 RPCScopeRead,
+	"WebsocketGetInfo":                  RPCScopeRead,
+	"WebsocketSetEnabled":               RPCScopeAdmin,
+	"WebsocketGetSubscriptions":         RPCScopeRead,
+	"WebsocketSetProxy":                 RPCScopeAdmin,
+	"WebsocketSetURL":                   RPCScopeAdmin,
+	"GetRecentTrades":                   RPCScopeRead,
+	"GetHistoricTrades":                 RPCScopeRead,
+	"GetSavedTrades":                    RPCScopeRead,
+	"ConvertTradesToCandles":            RPCScopeAdmin,
+	"FindMissingSavedCandleIntervals":   RPCScopeRead,
+	"FindMissingSavedTradeIntervals":    RPCScopeRead,
+	"SetExchangeTradeProcessing":        RPCScopeAdmin,
+	"UpsertDataHistoryJob":              RPCScopeAdmin,
+	"GetDataHistoryJobDetails":          RPCScopeRead,
+	"GetActiveDataHistoryJobs":          RPCScopeRead,
+	"GetDataHistoryJobsBetween":         RPCScopeRead,
+	"GetDataHistoryJobSummary":          RPCScopeRead,
+	"SetDataHistoryJobStatus":           RPCScopeAdmin,
+	"UpdateDataHistoryJobPrerequisite":  RPCScopeAdmin,
+	"GetManagedOrders":                  RPCScopeRead,
+	"ModifyOrder":                       RPCScopeTrade,
+	"CurrencyStateGetAll":               RPCScopeRead,
+	"CurrencyStateTrading":              RPCScopeRead,
+	"CurrencyStateDeposit":              RPCScopeRead,
+	"CurrencyStateWithdraw":             RPCScopeRead,
+	"CurrencyStateTradingPair":          RPCScopeRead,
+	"GetFuturesPositionsSummary":        RPCScopeRead,
+	"GetFuturesPositionsOrders":         RPCScopeRead,
+	"GetCollateral":                     RPCScopeRead,
+	"Shutdown":                          RPCScopeAdmin,
+	"GetTechnicalAnalysis":              RPCScopeRead,
+	"GetMarginRatesHistory":             RPCScopeRead,
+	"GetManagedPosition":                RPCScopeRead,
+	"GetAllManagedPositions":            RPCScopeRead,
+	"GetFundingRates":                   RPCScopeRead,
+	"GetLatestFundingRate":              RPCScopeRead,
+	"GetOrderbookMovement":              RPCScopeRead,
+	"GetOrderbookAmountByNominal":       RPCScopeRead,
+	"GetOrderbookAmountByImpact":        RPCScopeRead,
+	"GetCollateralMode":                 RPCScopeRead,
+	"GetLeverage":                       RPCScopeRead,
+	"SetCollateralMode":                 RPCScopeTrade,
+	"SetMarginType":                     RPCScopeTrade,
+	"SetLeverage":                       RPCScopeTrade,
+	"ChangePositionMargin":              RPCScopeTrade,
+	"GetOpenInterest":                   RPCScopeRead,
+	"GetCurrencyTradeURL":               RPCScopeRead,
+	"AddConditionalOrder":               RPCScopeTrade,
+	"GetConditionalOrders":              RPCScopeRead,
+	"CancelConditionalOrder":            RPCScopeTrade,
+	"SetKillSwitch":                     RPCScopeAdmin,
+	"RouteOrder":                        RPCScopeTrade,
+	"GetLedgerPNL":                      RPCScopeRead,
+	"GetLedgerLots":                     RPCScopeRead,
+	"ExportLedgerCSV":                   RPCScopeRead,
+	"PreviewRebalance":                  RPCScopeRead,
+	"GetRebalancePlan":                  RPCScopeRead,
+	"ApproveRebalancePlan":              RPCScopeTrade,
+	"GetOrderbookAnalytics":             RPCScopeRead,
+	"GetOrderbookAnalyticsStream":       RPCScopeRead,
+	"UntrackOrderbookAnalytics":         RPCScopeAdmin,
+}
+
+// rpcPrincipal is an authenticated gRPC user
+type rpcPrincipal struct {
+	name   string
+	scopes []string
+}
+
+// rpcPrincipalKey is the context key for the authenticated gRPC user
+type rpcPrincipalKey struct{}
+
+// rpcUser is a configured gRPC user and its credentials
+type rpcUser struct {
+	rpcPrincipal
+	passwordHash string
+	tokenHash    []byte
+}
+
+// rpcAuthenticator authenticates gRPC requests and authorises them against
+// the scopes of the authenticated user
+type rpcAuthenticator struct {
+	users          map[string]*rpcUser
+	legacyUsername string
+	legacyPassword string
+}
+
+// newRPCAuthenticator validates the remote control users and returns an
+// authenticator for them. The single username and password is an admin user
+// which is only enabled when no users are configured
+func newRPCAuthenticator(cfg *config.RemoteControlConfig) (*rpcAuthenticator, error) {
+	if cfg == nil {
+		return nil, errNilConfig
+	}
+	a := &rpcAuthenticator{
+		users: make(map[string]*rpcUser, len(cfg.Users)),
+	}
+	if len(cfg.Users) == 0 {
+		if cfg.Username == "" {
+			return nil, errRPCNoCredentials
+		}
+		a.legacyUsername, a.legacyPassword = cfg.Username, cfg.Password
+	} else if cfg.Username != "" {
+		log.Warnf(log.GRPCSys, "Remote control users are configured, ignoring the remote control username %q and password", cfg.Username)
+	}
+	for i := range cfg.Users {
+		u := &cfg.Users[i]
+		if u.Username == "" {
+			return nil, errRPCUsernameEmpty
+		}
+		if _, ok := a.users[u.Username]; ok {
+			return nil, fmt.Errorf("%w %q", errRPCUserDuplicate, u.Username)
+		}
+		if len(u.Scopes) == 0 {
+			return nil, fmt.Errorf("%w %q", errRPCUserNoScopes, u.Username)
+		}
+		for _, scope := range u.Scopes {
+			if !isValidRPCScope(scope) {
+				return nil, fmt.Errorf("%w %q for user %q", errRPCScopeInvalid, scope, u.Username)
+			}
+		}
+		if u.PasswordHash == "" && u.TokenHash == "" && cfg.GRPC.ClientCAFile == "" {
+			return nil, fmt.Errorf("%w %q", errRPCUserNoCredentials, u.Username)
+		}
+		user := &rpcUser{
+			rpcPrincipal: rpcPrincipal{name: u.Username, scopes: u.Scopes},
+			passwordHash: u.PasswordHash,
+		}
+		if u.TokenHash != "" {
+			h, err := hex.DecodeString(u.TokenHash)
+			if err != nil || len(h) != 32 {
+				return nil, fmt.Errorf("%w %q", errRPCTokenHashInvalid, u.Username)
+			}
+			user.tokenHash = h
+		}
+		a.users[u.Username] = user
+	}
+	return a, nil
+}
+
+// authenticate returns the user making a request. A verified client
+// certificate whose common name matches a user takes precedence over the
+// authorization header
+func (a *rpcAuthenticator) authenticate(ctx context.Context, md metadata.MD) (*rpcPrincipal, error) {
+	if a == nil {
+		return nil, errRPCAuthNotSetup
+	}
+	if p, ok := peer.FromContext(ctx); ok {
+		if tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo); ok && len(tlsInfo.State.VerifiedChains) > 0 && len(tlsInfo.State.VerifiedChains[0]) > 0 {
+			if u, ok := a.users[tlsInfo.State.VerifiedChains[0][0].Subject.CommonName]; ok {
+				return &u.rpcPrincipal, nil
+			}
+		}
+	}
+
+	authStr := md.Get("authorization")
+	if len(authStr) == 0 {
+		return nil, errAuthorizationMissing
+	}
+	scheme, value, ok := strings.Cut(authStr[0], " ")
+	if !ok {
+		return nil, errAuthorizationInvalid
+	}
+	switch strings.ToLower(scheme) {
+	case "bearer":
+		return a.authenticateToken(value)
+	case "basic":
+		decoded, err := base64.StdEncoding.DecodeString(value)
+		if err != nil {
+			return nil, errors.New("unable to base64 decode authorization header")
+		}
+		username, password, ok := strings.Cut(string(decoded), ":")
+		if !ok {
+			return nil, errAuthorizationInvalid
+		}
+		return a.authenticatePassword(username, password)
+	}
+	return nil, fmt.Errorf("%w: unsupported scheme %q", errAuthorizationInvalid, scheme)
+}
+
+func (a *rpcAuthenticator) authenticateToken(token string) (*rpcPrincipal, error) {
+	h, err := hex.DecodeString(auth.HashToken(token))
+	if err != nil {
+		return nil, err
+	}
+	for _, u := range a.users {
+		if u.tokenHash != nil && subtle.ConstantTimeCompare(u.tokenHash, h) == 1 {
+			return &u.rpcPrincipal, nil
+		}
+	}
+	return nil, errTokenMismatch
+}
+
+func (a *rpcAuthenticator) authenticatePassword(username, password string) (*rpcPrincipal, error) {
+	if u, ok := a.users[username]; ok {
+		if u.passwordHash != "" && auth.CheckPassword(u.passwordHash, password) {
+			return &u.rpcPrincipal, nil
+		}
+		return nil, errUsernamePasswordMismatch
+	}
+	if a.legacyUsername != "" &&
+		subtle.ConstantTimeCompare([]byte(username), []byte(a.legacyUsername)) == 1 &&
+		subtle.ConstantTimeCompare([]byte(password), []byte(a.legacyPassword)) == 1 {
+		return &rpcPrincipal{name: username, scopes: []string{RPCScopeAdmin}}, nil
+	}
+	return nil, errUsernamePasswordMismatch
+}
+
+// authorise checks the user has the scope required by a gRPC method and
+// records the attempt in the audit table
+func (p *rpcPrincipal) authorise(fullMethod string) error {
+	method := path.Base(fullMethod)
+	scope := getRPCMethodScope(method)
+	if !p.hasScope(scope) {
+		audit.Event(p.name, rpcAuditType, fmt.Sprintf("%s denied, requires %s scope", method, scope))
+		return fmt.Errorf("%w: %s requires %s scope", errPermissionDenied, method, scope)
+	}
+	audit.Event(p.name, rpcAuditType, method)
+	return nil
+}
+
+// hasScope returns true if the user has been granted the scope or is an admin
+func (p *rpcPrincipal) hasScope(scope string) bool {
+	return slices.Contains(p.scopes, RPCScopeAdmin) || slices.Contains(p.scopes, scope)
+}
+
+// rpcPrincipalFromContext returns the authenticated gRPC user of a request
+func rpcPrincipalFromContext(ctx context.Context) (*rpcPrincipal, bool) {
+	p, ok := ctx.Value(rpcPrincipalKey{}).(*rpcPrincipal)
+	return p, ok
+}
+
+// withdrawalApprover returns the authenticated user approving or rejecting a
+// withdrawal. The user must hold the withdraw or admin scope and can only act
+// as themselves
+func withdrawalApprover(ctx context.Context, approver string) (string, error) {
+	p, ok := rpcPrincipalFromContext(ctx)
+	if !ok {
+		return "", errApproverUnauthenticated
+	}
+	if !p.hasScope(RPCScopeWithdraw) {
+		return "", fmt.Errorf("%w: %s requires %s scope to approve withdrawals", errPermissionDenied, p.name, RPCScopeWithdraw)
+	}
+	if approver != "" && approver != p.name {
+		return "", fmt.Errorf("%w: %s approving as %s", errApproverMismatch, p.name, approver)
+	}
+	return p.name, nil
+}
+
+// getRPCMethodScope returns the scope required by a gRPC method
+func getRPCMethodScope(method string) string {
+	if scope, ok := rpcMethodScopes[method]; ok {
+		return scope
+	}
+	return RPCScopeAdmin
+}
+
+func isValidRPCScope(scope string) bool {
+	switch scope {
+	case RPCScopeRead, RPCScopeTrade, RPCScopeWithdraw, RPCScopeAdmin:
+		return true
+	}
+	return false
+}
+
+// getRPCServerTLSConfig returns the gRPC server TLS config, verifying client
+// certificates against the client certificate authority when set
+func getRPCServerTLSConfig(certDir string, cfg *config.GRPCConfig) (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(filepath.Join(certDir, "cert.pem"), filepath.Join(certDir, "key.pem"))
+	if err != nil {
+		return nil, err
+	}
+	tlsConfig := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+	if cfg.ClientCAFile == "" {
+		if cfg.RequireClientCertificate {
+			return nil, errRPCClientCARequired
+		}
+		return tlsConfig, nil
+	}
+	pemData, err := os.ReadFile(cfg.ClientCAFile)
+	if err != nil {
+		return nil, err
+	}
+	tlsConfig.ClientCAs = x509.NewCertPool()
+	if !tlsConfig.ClientCAs.AppendCertsFromPEM(pemData) {
+		return nil, fmt.Errorf("%w %s", errRPCClientCAInvalid, cfg.ClientCAFile)
+	}
+	tlsConfig.ClientAuth = tls.VerifyClientCertIfGiven
+	if cfg.RequireClientCertificate {
+		tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	log.Debugf(log.GRPCSys, "gRPC server verifying client certificates against %s", cfg.ClientCAFile)
+	return tlsConfig, nil
+}
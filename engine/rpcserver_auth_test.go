@@ -0,0 +1,367 @@
+package engine
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"math/big"
+	mathrand "math/rand"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc/auth"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// fakeServerTransportStream allows grpc.Method to be used in tests
+type fakeServerTransportStream struct {
+	method string
+}
+
+func (f *fakeServerTransportStream) Method() string               { return f.method }
+func (f *fakeServerTransportStream) SetHeader(metadata.MD) error  { return nil }
+func (f *fakeServerTransportStream) SendHeader(metadata.MD) error { return nil }
+func (f *fakeServerTransportStream) SetTrailer(metadata.MD) error { return nil }
+
+func basicAuthMD(username, password string) metadata.MD {
+	return metadata.Pairs("authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte(username+":"+password)))
+}
+
+func TestRPCMethodScopes(t *testing.T) {
+	t.Parallel()
+	desc := gctrpc.GoCryptoTraderService_ServiceDesc
+	methods := make(map[string]struct{}, len(desc.Methods)+len(desc.Streams))
+	for i := range desc.Methods {
+		methods[desc.Methods[i].MethodName] = struct{}{}
+	}
+	for i := range desc.Streams {
+		methods[desc.Streams[i].StreamName] = struct{}{}
+	}
+	for method := range methods {
+		assert.Containsf(t, rpcMethodScopes, method, "rpcMethodScopes should contain a scope for %s", method)
+	}
+	for method, scope := range rpcMethodScopes {
+		assert.Containsf(t, methods, method, "rpcMethodScopes should not contain unknown method %s", method)
+		assert.Truef(t, isValidRPCScope(scope), "scope %q for %s should be valid", scope, method)
+	}
+	assert.Equal(t, RPCScopeTrade, getRPCMethodScope("SubmitOrder"), "SubmitOrder should require the trade scope")
+	assert.Equal(t, RPCScopeWithdraw, getRPCMethodScope("WithdrawCryptocurrencyFunds"), "WithdrawCryptocurrencyFunds should require the withdraw scope")
+	assert.Equal(t, RPCScopeAdmin, getRPCMethodScope("Shutdown"), "Shutdown should require the admin scope")
+	assert.Equal(t, RPCScopeAdmin, getRPCMethodScope("NotARealMethod"), "unknown methods should require the admin scope")
+}
+
+func TestNewRPCAuthenticator(t *testing.T) {
+	t.Parallel()
+	_, err := newRPCAuthenticator(nil)
+	assert.ErrorIs(t, err, errNilConfig)
+
+	_, err = newRPCAuthenticator(&config.RemoteControlConfig{})
+	assert.ErrorIs(t, err, errRPCNoCredentials)
+
+	tokenHash := auth.HashToken("token")
+	for _, tc := range []struct {
+		name  string
+		users []config.RPCUser
+		err   error
+	}{
+		{"empty username", []config.RPCUser{{TokenHash: tokenHash, Scopes: []string{RPCScopeRead}}}, errRPCUsernameEmpty},
+		{"duplicate user", []config.RPCUser{{Username: "a", TokenHash: tokenHash, Scopes: []string{RPCScopeRead}}, {Username: "a", TokenHash: tokenHash, Scopes: []string{RPCScopeRead}}}, errRPCUserDuplicate},
+		{"user named as legacy user", []config.RPCUser{{Username: "admin", TokenHash: tokenHash, Scopes: []string{RPCScopeRead}}}, nil},
+		{"no scopes", []config.RPCUser{{Username: "a", TokenHash: tokenHash}}, errRPCUserNoScopes},
+		{"invalid scope", []config.RPCUser{{Username: "a", TokenHash: tokenHash, Scopes: []string{"moon"}}}, errRPCScopeInvalid},
+		{"no credentials", []config.RPCUser{{Username: "a", Scopes: []string{RPCScopeRead}}}, errRPCUserNoCredentials},
+		{"invalid token hash", []config.RPCUser{{Username: "a", TokenHash: "token", Scopes: []string{RPCScopeRead}}}, errRPCTokenHashInvalid},
+		{"valid", []config.RPCUser{{Username: "a", TokenHash: tokenHash, Scopes: []string{RPCScopeRead}}}, nil},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			a, err := newRPCAuthenticator(&config.RemoteControlConfig{Username: "admin", Password: "admin", Users: tc.users})
+			if tc.err != nil {
+				assert.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err, "newRPCAuthenticator must not error")
+			assert.Len(t, a.users, len(tc.users), "users should be loaded")
+			assert.Empty(t, a.legacyUsername, "legacy credentials should be disabled when users are configured")
+		})
+	}
+
+	a, err := newRPCAuthenticator(&config.RemoteControlConfig{Username: "admin", Password: "admin"})
+	require.NoError(t, err, "newRPCAuthenticator must not error with only legacy credentials")
+	assert.Equal(t, "admin", a.legacyUsername, "legacy credentials should be enabled when no users are configured")
+
+	a, err = newRPCAuthenticator(&config.RemoteControlConfig{
+		GRPC:  config.GRPCConfig{ClientCAFile: "ca.pem"},
+		Users: []config.RPCUser{{Username: "a", Scopes: []string{RPCScopeRead}}},
+	})
+	require.NoError(t, err, "newRPCAuthenticator must not error for certificate only users")
+	assert.Contains(t, a.users, "a", "certificate only user should be loaded")
+}
+
+func TestRPCAuthenticatorAuthenticate(t *testing.T) {
+	t.Parallel()
+	var a *rpcAuthenticator
+	_, err := a.authenticate(t.Context(), metadata.MD{})
+	assert.ErrorIs(t, err, errRPCAuthNotSetup)
+
+	passwordHash, err := auth.HashPassword("hunter2")
+	require.NoError(t, err, "HashPassword must not error")
+	token, tokenHash, err := auth.GenerateToken()
+	require.NoError(t, err, "GenerateToken must not error")
+
+	a, err = newRPCAuthenticator(&config.RemoteControlConfig{
+		Username: "admin",
+		Password: "Sup3rdup3rS3cr3t",
+		GRPC:     config.GRPCConfig{ClientCAFile: "ca.pem"},
+		Users: []config.RPCUser{
+			{Username: "dashboard", TokenHash: tokenHash, Scopes: []string{RPCScopeRead}},
+			{Username: "trader", PasswordHash: passwordHash, Scopes: []string{RPCScopeRead, RPCScopeTrade}},
+			{Username: "treasury", Scopes: []string{RPCScopeWithdraw}},
+		},
+	})
+	require.NoError(t, err, "newRPCAuthenticator must not error")
+
+	for _, tc := range []struct {
+		name string
+		md   metadata.MD
+		user string
+		err  error
+	}{
+		{"missing", metadata.MD{}, "", errAuthorizationMissing},
+		{"no scheme", metadata.Pairs("authorization", "token"), "", errAuthorizationInvalid},
+		{"unsupported scheme", metadata.Pairs("authorization", "Digest token"), "", errAuthorizationInvalid},
+		{"basic no separator", metadata.Pairs("authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte("trader"))), "", errAuthorizationInvalid},
+		{"bearer", metadata.Pairs("authorization", "Bearer "+token), "dashboard", nil},
+		{"bearer mismatch", metadata.Pairs("authorization", "Bearer "+token+"a"), "", errTokenMismatch},
+		{"user password", basicAuthMD("trader", "hunter2"), "trader", nil},
+		{"user password with colon", basicAuthMD("trader", "hunter2:"), "", errUsernamePasswordMismatch},
+		{"user without password", basicAuthMD("treasury", ""), "", errUsernamePasswordMismatch},
+		{"legacy disabled by users", basicAuthMD("admin", "Sup3rdup3rS3cr3t"), "", errUsernamePasswordMismatch},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			p, err := a.authenticate(t.Context(), tc.md)
+			if tc.err != nil {
+				assert.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err, "authenticate must not error")
+			assert.Equal(t, tc.user, p.name, "authenticate should return the correct user")
+		})
+	}
+
+	certCtx := func(cn string) context.Context {
+		return peer.NewContext(t.Context(), &peer.Peer{AuthInfo: credentials.TLSInfo{State: tls.ConnectionState{
+			VerifiedChains: [][]*x509.Certificate{{{Subject: pkix.Name{CommonName: cn}}}},
+		}}})
+	}
+	p, err := a.authenticate(certCtx("treasury"), metadata.MD{})
+	require.NoError(t, err, "authenticate must not error with a verified client certificate")
+	assert.Equal(t, "treasury", p.name, "authenticate should return the client certificate user")
+
+	_, err = a.authenticate(certCtx("unknown"), metadata.MD{})
+	assert.ErrorIs(t, err, errAuthorizationMissing, "unknown client certificate users should fall back to the authorization header")
+
+	legacy, err := newRPCAuthenticator(&config.RemoteControlConfig{Username: "admin", Password: "Sup3rdup3rS3cr3t"})
+	require.NoError(t, err, "newRPCAuthenticator must not error")
+	p, err = legacy.authenticate(t.Context(), basicAuthMD("admin", "Sup3rdup3rS3cr3t"))
+	require.NoError(t, err, "authenticate must not error with legacy credentials")
+	assert.Equal(t, "admin", p.name, "authenticate should return the legacy user")
+	assert.True(t, p.hasScope(RPCScopeAdmin), "legacy user should be an admin")
+	_, err = legacy.authenticate(t.Context(), basicAuthMD("admin", "wrong"))
+	assert.ErrorIs(t, err, errUsernamePasswordMismatch)
+}
+
+func TestRPCPrincipalAuthorise(t *testing.T) {
+	t.Parallel()
+	reader := &rpcPrincipal{name: "reader", scopes: []string{RPCScopeRead}}
+	assert.NoError(t, reader.authorise("/gctrpc.GoCryptoTraderService/GetInfo"))
+	assert.ErrorIs(t, reader.authorise("/gctrpc.GoCryptoTraderService/SubmitOrder"), errPermissionDenied)
+	assert.ErrorIs(t, reader.authorise("/gctrpc.GoCryptoTraderService/Shutdown"), errPermissionDenied)
+
+	trader := &rpcPrincipal{name: "trader", scopes: []string{RPCScopeTrade}}
+	assert.NoError(t, trader.authorise("/gctrpc.GoCryptoTraderService/SubmitOrder"))
+	assert.ErrorIs(t, trader.authorise("/gctrpc.GoCryptoTraderService/GetInfo"), errPermissionDenied)
+	assert.ErrorIs(t, trader.authorise("/gctrpc.GoCryptoTraderService/WithdrawFiatFunds"), errPermissionDenied)
+
+	admin := &rpcPrincipal{name: "admin", scopes: []string{RPCScopeAdmin}}
+	assert.NoError(t, admin.authorise("/gctrpc.GoCryptoTraderService/WithdrawFiatFunds"))
+	assert.NoError(t, admin.authorise("/gctrpc.GoCryptoTraderService/Shutdown"))
+}
+
+func TestRPCServerAuthenticateClient(t *testing.T) {
+	t.Parallel()
+	token, tokenHash, err := auth.GenerateToken()
+	require.NoError(t, err, "GenerateToken must not error")
+	a, err := newRPCAuthenticator(&config.RemoteControlConfig{
+		Users: []config.RPCUser{{Username: "dashboard", TokenHash: tokenHash, Scopes: []string{RPCScopeRead}}},
+	})
+	require.NoError(t, err, "newRPCAuthenticator must not error")
+	s := &RPCServer{Engine: &Engine{}, auth: a}
+
+	methodCtx := func(method string, md metadata.MD) context.Context {
+		ctx := grpc.NewContextWithServerTransportStream(t.Context(), &fakeServerTransportStream{method: "/gctrpc.GoCryptoTraderService/" + method})
+		return metadata.NewIncomingContext(ctx, md)
+	}
+
+	_, err = s.authenticateClient(t.Context())
+	assert.Equal(t, codes.Unauthenticated, status.Code(err), "authenticateClient should return Unauthenticated without metadata")
+
+	_, err = s.authenticateClient(methodCtx("GetInfo", metadata.Pairs("authorization", "Bearer bad")))
+	assert.Equal(t, codes.Unauthenticated, status.Code(err), "authenticateClient should return Unauthenticated with an invalid token")
+
+	_, err = s.authenticateClient(methodCtx("SubmitOrder", metadata.Pairs("authorization", "Bearer "+token)))
+	assert.Equal(t, codes.PermissionDenied, status.Code(err), "authenticateClient should return PermissionDenied without the required scope")
+
+	ctx, err := s.authenticateClient(methodCtx("GetInfo", metadata.Pairs("authorization", "Bearer "+token)))
+	require.NoError(t, err, "authenticateClient must not error with the required scope")
+	principal, ok := rpcPrincipalFromContext(ctx)
+	require.True(t, ok, "authenticateClient must add the authenticated user to the context")
+	assert.Equal(t, "dashboard", principal.name)
+
+	_, err = (&RPCServer{Engine: &Engine{}}).authenticateClient(methodCtx("GetInfo", metadata.Pairs("authorization", "Bearer "+token)))
+	assert.Equal(t, codes.Unauthenticated, status.Code(err), "authenticateClient should fail closed without an authenticator")
+}
+
+func TestGetRPCServerTLSConfig(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	_, err := getRPCServerTLSConfig(dir, &config.GRPCConfig{})
+	assert.Error(t, err, "getRPCServerTLSConfig should error without a certificate")
+
+	require.NoError(t, genCert(dir), "genCert must not error")
+	tlsConfig, err := getRPCServerTLSConfig(dir, &config.GRPCConfig{})
+	require.NoError(t, err, "getRPCServerTLSConfig must not error")
+	assert.Equal(t, tls.NoClientCert, tlsConfig.ClientAuth, "client certificates should not be requested without a client certificate authority")
+
+	_, err = getRPCServerTLSConfig(dir, &config.GRPCConfig{RequireClientCertificate: true})
+	assert.ErrorIs(t, err, errRPCClientCARequired)
+
+	_, err = getRPCServerTLSConfig(dir, &config.GRPCConfig{ClientCAFile: filepath.Join(dir, "key.pem")})
+	assert.ErrorIs(t, err, errRPCClientCAInvalid)
+
+	tlsConfig, err = getRPCServerTLSConfig(dir, &config.GRPCConfig{ClientCAFile: filepath.Join(dir, "cert.pem")})
+	require.NoError(t, err, "getRPCServerTLSConfig must not error")
+	assert.Equal(t, tls.VerifyClientCertIfGiven, tlsConfig.ClientAuth, "client certificates should be verified if given")
+
+	tlsConfig, err = getRPCServerTLSConfig(dir, &config.GRPCConfig{ClientCAFile: filepath.Join(dir, "cert.pem"), RequireClientCertificate: true})
+	require.NoError(t, err, "getRPCServerTLSConfig must not error")
+	assert.Equal(t, tls.RequireAndVerifyClientCert, tlsConfig.ClientAuth, "client certificates should be required")
+	assert.Len(t, tlsConfig.Certificates, 1, "server certificate should be loaded")
+}
+
+func TestStartRPCServerMultiUser(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	tlsDir := filepath.Join(dir, "tls")
+	require.NoError(t, genCert(tlsDir), "genCert must not error")
+	caFile := filepath.Join(tlsDir, "cert.pem")
+	caPair, err := tls.LoadX509KeyPair(caFile, filepath.Join(tlsDir, "key.pem"))
+	require.NoError(t, err, "LoadX509KeyPair must not error")
+
+	clientKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err, "GenerateKey must not error")
+	clientDER, err := x509.CreateCertificate(rand.Reader, &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "treasury"},
+		NotBefore:    time.Now().Add(-time.Minute),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}, caPair.Leaf, &clientKey.PublicKey, caPair.PrivateKey)
+	require.NoError(t, err, "CreateCertificate must not error")
+
+	token, tokenHash, err := auth.GenerateToken()
+	require.NoError(t, err, "GenerateToken must not error")
+
+	gRPCPort := mathrand.Intn(65535-42069) + 42069 //nolint:gosec // Don't require crypto/rand usage here
+	e := &Engine{
+		Config: &config.Config{
+			RemoteControl: config.RemoteControlConfig{
+				GRPC: config.GRPCConfig{
+					Enabled:       true,
+					ListenAddress: "localhost:" + strconv.Itoa(gRPCPort),
+					ClientCAFile:  caFile,
+				},
+				Users: []config.RPCUser{
+					{Username: "dashboard", TokenHash: tokenHash, Scopes: []string{RPCScopeRead}},
+					{Username: "treasury", Scopes: []string{RPCScopeWithdraw}},
+				},
+			},
+		},
+		Settings: Settings{DataDir: dir},
+		uptime:   time.Now(),
+	}
+	StartRPCServer(e)
+
+	pool := x509.NewCertPool()
+	pool.AddCert(caPair.Leaf)
+	dial := func(opts ...grpc.DialOption) gctrpc.GoCryptoTraderServiceClient {
+		conn, err := grpc.NewClient(e.Config.RemoteControl.GRPC.ListenAddress, opts...)
+		require.NoError(t, err, "NewClient must not error")
+		t.Cleanup(func() { assert.NoError(t, conn.Close(), "Close should not error") })
+		return gctrpc.NewGoCryptoTraderServiceClient(conn)
+	}
+
+	dashboard := dial(
+		grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12})),
+		grpc.WithPerRPCCredentials(auth.BearerToken{Token: token}),
+	)
+	_, err = dashboard.GetInfo(t.Context(), &gctrpc.GetInfoRequest{})
+	assert.NoError(t, err, "GetInfo should not error for a read scoped user")
+	_, err = dashboard.SubmitOrder(t.Context(), &gctrpc.SubmitOrderRequest{})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err), "SubmitOrder should be denied for a read scoped user")
+
+	treasury := dial(grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{
+		RootCAs:      pool,
+		Certificates: []tls.Certificate{{Certificate: [][]byte{clientDER}, PrivateKey: clientKey}},
+		MinVersion:   tls.VersionTLS12,
+	})))
+	_, err = treasury.GetInfo(t.Context(), &gctrpc.GetInfoRequest{})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err), "GetInfo should be denied for a withdraw scoped client certificate user")
+	_, err = treasury.WithdrawFiatFunds(t.Context(), &gctrpc.WithdrawFiatRequest{})
+	assert.NotContains(t, []codes.Code{codes.Unauthenticated, codes.PermissionDenied}, status.Code(err), "WithdrawFiatFunds should be authorised for a withdraw scoped client certificate user")
+}
+
+func TestWithdrawalApprover(t *testing.T) {
+	t.Parallel()
+	_, err := withdrawalApprover(t.Context(), "alice")
+	assert.ErrorIs(t, err, errApproverUnauthenticated, "approvers without an authenticated user should error")
+
+	ctx := context.WithValue(t.Context(), rpcPrincipalKey{}, &rpcPrincipal{name: "bob", scopes: []string{RPCScopeWithdraw}})
+	approver, err := withdrawalApprover(ctx, "")
+	require.NoError(t, err, "withdrawalApprover must not error")
+	assert.Equal(t, "bob", approver, "approver should default to the authenticated user")
+	approver, err = withdrawalApprover(ctx, "bob")
+	require.NoError(t, err, "withdrawalApprover must not error")
+	assert.Equal(t, "bob", approver)
+	_, err = withdrawalApprover(ctx, "alice")
+	assert.ErrorIs(t, err, errApproverMismatch, "users should not approve as another user")
+
+	ctx = context.WithValue(t.Context(), rpcPrincipalKey{}, &rpcPrincipal{name: "admin", scopes: []string{RPCScopeAdmin}})
+	approver, err = withdrawalApprover(ctx, "")
+	require.NoError(t, err, "withdrawalApprover must not error for admins")
+	assert.Equal(t, "admin", approver)
+	_, err = withdrawalApprover(ctx, "alice")
+	assert.ErrorIs(t, err, errApproverMismatch, "admins should not approve as another user")
+
+	ctx = context.WithValue(t.Context(), rpcPrincipalKey{}, &rpcPrincipal{name: "dashboard", scopes: []string{RPCScopeRead, RPCScopeTrade}})
+	_, err = withdrawalApprover(ctx, "")
+	assert.ErrorIs(t, err, errPermissionDenied, "users without the withdraw scope should not approve")
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto/tls"
 	"crypto/x509"
+	"encoding/csv"
 	"errors"
 	"fmt"
 	"io"
@@ -32,6 +33,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/database/repository"
 	dbexchange "github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
 	sqltrade "github.com/thrasher-corp/gocryptotrader/database/repository/trade"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
 	"github.com/thrasher-corp/gocryptotrader/encoding/json"
 	"github.com/thrasher-corp/gocryptotrader/exchange/accounts"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
@@ -45,12 +47,15 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/exchanges/margin"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook/analytics"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
 	"github.com/thrasher-corp/gocryptotrader/gctrpc"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/banking"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/ledger"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
 	"github.com/thrasher-corp/goose"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
 )
 
@@ -3864,3 +3869,778 @@ func TestGetCurrencyTradeURL(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotEmpty(t, resp.Url)
 }
+
+func TestConditionalOrdersRPC(t *testing.T) {
+	t.Parallel()
+	exchName := "Binance"
+	em := NewExchangeManager()
+	exch, err := em.NewExchangeByName(exchName)
+	require.NoError(t, err)
+	exch.SetDefaults()
+	b := exch.GetBase()
+	cp := currency.NewBTCUSDT()
+	b.CurrencyPairs.Pairs = make(map[asset.Item]*currency.PairStore)
+	b.CurrencyPairs.Pairs[asset.Spot] = &currency.PairStore{
+		Available:     currency.Pairs{cp},
+		Enabled:       currency.Pairs{cp},
+		AssetEnabled:  true,
+		ConfigFormat:  &currency.PairFormat{Uppercase: true},
+		RequestFormat: &currency.PairFormat{Uppercase: true},
+	}
+	require.NoError(t, em.Add(exch))
+
+	var wg sync.WaitGroup
+	om, err := SetupOrderManager(em, &CommunicationManager{}, &wg, &config.OrderManager{})
+	require.NoError(t, err)
+	om.started = 1
+	s := RPCServer{Engine: &Engine{ExchangeManager: em, OrderManager: om}}
+
+	_, err = s.AddConditionalOrder(t.Context(), nil)
+	assert.ErrorIs(t, err, common.ErrNilPointer)
+
+	req := &gctrpc.AddConditionalOrderRequest{
+		Exchange: exchName,
+		Asset:    asset.Spot.String(),
+	}
+	_, err = s.AddConditionalOrder(t.Context(), req)
+	assert.ErrorIs(t, err, errCurrencyPairUnset)
+
+	req.Pair = &gctrpc.CurrencyPair{Base: currency.BTC.String(), Quote: currency.USDT.String()}
+	_, err = s.AddConditionalOrder(t.Context(), req)
+	assert.ErrorIs(t, err, errUnknownConditionalOrderType)
+
+	req.Type = "trailing_stop"
+	_, err = s.AddConditionalOrder(t.Context(), req)
+	assert.ErrorIs(t, err, order.ErrSideIsInvalid)
+
+	req.Side = order.Sell.String()
+	req.Amount = 1
+	req.TrailingPercent = 5
+	resp, err := s.AddConditionalOrder(t.Context(), req)
+	require.NoError(t, err)
+	assert.Equal(t, string(TrailingStopConditionalOrder), resp.Type)
+	assert.Equal(t, string(ConditionalOrderActive), resp.Status)
+	assert.Equal(t, exchName, resp.Exchange)
+
+	_, err = s.GetConditionalOrders(t.Context(), nil)
+	assert.ErrorIs(t, err, common.ErrNilPointer)
+
+	_, err = s.GetConditionalOrders(t.Context(), &gctrpc.GetConditionalOrdersRequest{Exchange: "bruh"})
+	assert.ErrorIs(t, err, ErrExchangeNotFound)
+
+	orders, err := s.GetConditionalOrders(t.Context(), &gctrpc.GetConditionalOrdersRequest{Exchange: exchName, ActiveOnly: true})
+	require.NoError(t, err)
+	require.Len(t, orders.Orders, 1)
+	assert.Equal(t, resp.Id, orders.Orders[0].Id)
+
+	_, err = s.CancelConditionalOrder(t.Context(), nil)
+	assert.ErrorIs(t, err, common.ErrNilPointer)
+
+	_, err = s.CancelConditionalOrder(t.Context(), &gctrpc.CancelConditionalOrderRequest{Id: "bruh"})
+	assert.Error(t, err, "CancelConditionalOrder should error on an invalid ID")
+
+	cancelled, err := s.CancelConditionalOrder(t.Context(), &gctrpc.CancelConditionalOrderRequest{Id: resp.Id})
+	require.NoError(t, err)
+	assert.Equal(t, string(ConditionalOrderCancelled), cancelled.Status)
+
+	orders, err = s.GetConditionalOrders(t.Context(), &gctrpc.GetConditionalOrdersRequest{ActiveOnly: true})
+	require.NoError(t, err)
+	assert.Empty(t, orders.Orders)
+}
+
+func TestGetManagedOrdersIncludeHistory(t *testing.T) {
+	t.Parallel()
+	exchName := "Binance"
+	em := NewExchangeManager()
+	exch, err := em.NewExchangeByName(exchName)
+	require.NoError(t, err)
+	exch.SetDefaults()
+	b := exch.GetBase()
+	cp := currency.NewBTCUSDT()
+	b.CurrencyPairs.Pairs = make(map[asset.Item]*currency.PairStore)
+	b.CurrencyPairs.Pairs[asset.Spot] = &currency.PairStore{
+		Available:     currency.Pairs{cp},
+		Enabled:       currency.Pairs{cp},
+		AssetEnabled:  true,
+		ConfigFormat:  &currency.PairFormat{Uppercase: true},
+		RequestFormat: &currency.PairFormat{Uppercase: true},
+	}
+	require.NoError(t, em.Add(exch))
+
+	var wg sync.WaitGroup
+	om, err := SetupOrderManager(em, &CommunicationManager{}, &wg, &config.OrderManager{})
+	require.NoError(t, err)
+	om.started = 1
+	s := RPCServer{Engine: &Engine{ExchangeManager: em, OrderManager: om}}
+
+	req := &gctrpc.GetOrdersRequest{
+		Exchange:       exchName,
+		AssetType:      asset.Spot.String(),
+		Pair:           &gctrpc.CurrencyPair{Base: currency.BTC.String(), Quote: currency.USDT.String()},
+		IncludeHistory: true,
+	}
+	_, err = s.GetManagedOrders(t.Context(), req)
+	assert.ErrorIs(t, err, errOrderDatabaseNotSet)
+
+	db := &fakeOrderDB{}
+	require.NoError(t, om.loadOrders(db))
+	require.NoError(t, om.Add(&order.Detail{
+		Exchange:  exchName,
+		OrderID:   "active",
+		Pair:      cp,
+		AssetType: asset.Spot,
+		Status:    order.New,
+		Amount:    1,
+	}))
+	require.NoError(t, db.Upsert(orderToRecord(&order.Detail{
+		Exchange:        exchName,
+		InternalOrderID: uuid.Must(uuid.NewV4()),
+		OrderID:         "filled",
+		Pair:            cp,
+		AssetType:       asset.Spot,
+		Status:          order.Filled,
+		Amount:          1,
+		Date:            time.Now().Add(-time.Hour),
+	})))
+
+	req.StartDate = "bad"
+	_, err = s.GetManagedOrders(t.Context(), req)
+	assert.Error(t, err, "GetManagedOrders should error on an invalid start date")
+
+	req.StartDate = ""
+	resp, err := s.GetManagedOrders(t.Context(), req)
+	require.NoError(t, err)
+	require.Len(t, resp.Orders, 2, "GetManagedOrders must return active and persisted orders without duplicates")
+	assert.Equal(t, "active", resp.Orders[0].Id)
+	assert.Equal(t, "filled", resp.Orders[1].Id)
+
+	req.IncludeHistory = false
+	resp, err = s.GetManagedOrders(t.Context(), req)
+	require.NoError(t, err)
+	require.Len(t, resp.Orders, 1)
+	assert.Equal(t, "active", resp.Orders[0].Id)
+}
+
+// fakeOrderStream captures orders sent by GetOrderStream
+type fakeOrderStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent chan *gctrpc.OrderDetails
+}
+
+func (f *fakeOrderStream) Context() context.Context { return f.ctx }
+
+func (f *fakeOrderStream) Send(o *gctrpc.OrderDetails) error {
+	f.sent <- o
+	return nil
+}
+
+func TestGetOrderStream(t *testing.T) {
+	t.Parallel()
+	exchName := "Binance"
+	em := NewExchangeManager()
+	exch, err := em.NewExchangeByName(exchName)
+	require.NoError(t, err)
+	exch.SetDefaults()
+	b := exch.GetBase()
+	cp := currency.NewBTCUSDT()
+	b.CurrencyPairs.Pairs = make(map[asset.Item]*currency.PairStore)
+	b.CurrencyPairs.Pairs[asset.Spot] = &currency.PairStore{
+		Available:     currency.Pairs{cp},
+		Enabled:       currency.Pairs{cp},
+		AssetEnabled:  true,
+		ConfigFormat:  &currency.PairFormat{Uppercase: true},
+		RequestFormat: &currency.PairFormat{Uppercase: true},
+	}
+	require.NoError(t, em.Add(exch))
+
+	var wg sync.WaitGroup
+	om, err := SetupOrderManager(em, &CommunicationManager{}, &wg, &config.OrderManager{})
+	require.NoError(t, err)
+	s := RPCServer{Engine: &Engine{ExchangeManager: em, OrderManager: om, Config: &config.Config{}}}
+
+	ctx, cancel := context.WithCancel(t.Context())
+	stream := &fakeOrderStream{ctx: ctx, sent: make(chan *gctrpc.OrderDetails, 10)}
+
+	err = s.GetOrderStream(nil, stream)
+	assert.ErrorIs(t, err, errNilRequestData)
+
+	err = s.GetOrderStream(&gctrpc.GetOrderStreamRequest{Exchange: "bruh"}, stream)
+	assert.ErrorIs(t, err, ErrExchangeNotFound)
+
+	err = s.GetOrderStream(&gctrpc.GetOrderStreamRequest{AssetType: "bruh"}, stream)
+	assert.ErrorIs(t, err, asset.ErrNotSupported)
+
+	err = s.GetOrderStream(&gctrpc.GetOrderStreamRequest{Statuses: []string{"bruh"}}, stream)
+	assert.Error(t, err, "GetOrderStream should error on an unknown status")
+
+	err = s.GetOrderStream(&gctrpc.GetOrderStreamRequest{}, stream)
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted)
+
+	om.started = 1
+	errs := make(chan error, 1)
+	go func() {
+		errs <- s.GetOrderStream(&gctrpc.GetOrderStreamRequest{
+			Exchange:  exchName,
+			AssetType: asset.Spot.String(),
+			Pair:      &gctrpc.CurrencyPair{Base: currency.BTC.String(), Quote: currency.USDT.String()},
+			Statuses:  []string{order.Filled.String()},
+		}, stream)
+	}()
+	require.Eventually(t, func() bool {
+		om.orderUpdates.m.Lock()
+		defer om.orderUpdates.m.Unlock()
+		return len(om.orderUpdates.pipes) == 1
+	}, time.Second, time.Millisecond, "GetOrderStream must subscribe to order updates")
+
+	od := &order.Detail{
+		Exchange:  exchName,
+		OrderID:   "1337",
+		Pair:      cp,
+		AssetType: asset.Spot,
+		Status:    order.New,
+		Price:     100,
+		Amount:    1,
+	}
+	require.NoError(t, om.Add(od))
+	filled := od.Copy()
+	filled.Status = order.Filled
+	filled.ExecutedAmount = 1
+	filled.AverageExecutedPrice = 99
+	filled.Fee = 0.1
+	filled.FeeAsset = currency.USDT
+	filled.Trades = []order.TradeHistory{{TID: "1", Price: 99, Amount: 1, Fee: 0.1, Side: order.Buy}}
+	require.NoError(t, om.UpdateExistingOrder(&filled))
+
+	select {
+	case resp := <-stream.sent:
+		assert.Equal(t, "1337", resp.Id)
+		assert.Equal(t, order.Filled.String(), resp.Status, "orders must be filtered by status")
+		assert.Equal(t, 99.0, resp.AverageExecutedPrice)
+		assert.Equal(t, 1.0, resp.ExecutedAmount)
+		assert.Equal(t, "USDT", resp.FeeAsset)
+		require.Len(t, resp.Trades, 1)
+		assert.Equal(t, 0.1, resp.Trades[0].Fee)
+	case <-time.After(time.Second):
+		require.Fail(t, "GetOrderStream must send filled orders")
+	}
+	assert.Empty(t, stream.sent, "orders not matching the filter should not be sent")
+
+	cancel()
+	select {
+	case err = <-errs:
+		assert.ErrorIs(t, err, context.Canceled)
+	case <-time.After(time.Second):
+		require.Fail(t, "GetOrderStream must return when the stream context is cancelled")
+	}
+}
+
+// fakeAggregatedOrderbookStream captures books sent by GetAggregatedOrderbookStream
+type fakeAggregatedOrderbookStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent chan *gctrpc.AggregatedOrderbookResponse
+}
+
+func (f *fakeAggregatedOrderbookStream) Context() context.Context { return f.ctx }
+
+func (f *fakeAggregatedOrderbookStream) Send(b *gctrpc.AggregatedOrderbookResponse) error {
+	f.sent <- b
+	return nil
+}
+
+func TestGetAggregatedOrderbookStream(t *testing.T) {
+	t.Parallel()
+	em := NewExchangeManager()
+	exch, err := em.NewExchangeByName("Binance")
+	require.NoError(t, err)
+	exch.SetDefaults()
+	require.NoError(t, em.Add(exch))
+	s := RPCServer{Engine: &Engine{ExchangeManager: em, Config: &config.Config{}}}
+
+	usdt := currency.NewPair(currency.LTC, currency.USDT)
+	usdc := currency.NewPair(currency.LTC, currency.USDC)
+	for _, p := range []currency.Pair{usdt, usdc} {
+		d, err := orderbook.DeployDepth(exch.GetName(), p, asset.Spot)
+		require.NoError(t, err)
+		require.NoError(t, d.LoadSnapshot(&orderbook.Book{
+			Bids:        orderbook.Levels{{Price: 80, Amount: 1}},
+			Asks:        orderbook.Levels{{Price: 81, Amount: 2}},
+			LastUpdated: time.Now(),
+		}))
+	}
+
+	ctx, cancel := context.WithCancel(t.Context())
+	stream := &fakeAggregatedOrderbookStream{ctx: ctx, sent: make(chan *gctrpc.AggregatedOrderbookResponse, 10)}
+
+	err = s.GetAggregatedOrderbookStream(nil, stream)
+	assert.ErrorIs(t, err, errNilRequestData)
+
+	err = s.GetAggregatedOrderbookStream(&gctrpc.GetAggregatedOrderbookStreamRequest{}, stream)
+	assert.ErrorIs(t, err, errCurrencyPairUnset)
+
+	req := &gctrpc.GetAggregatedOrderbookStreamRequest{
+		Pair:      &gctrpc.CurrencyPair{Base: "LTC", Quote: "USDT"},
+		AssetType: "bruh",
+	}
+	err = s.GetAggregatedOrderbookStream(req, stream)
+	assert.ErrorIs(t, err, asset.ErrNotSupported)
+
+	req.AssetType = asset.Spot.String()
+	req.Sources = []*gctrpc.AggregatedOrderbookSource{{Exchange: "bruh"}}
+	err = s.GetAggregatedOrderbookStream(req, stream)
+	assert.ErrorIs(t, err, ErrExchangeNotFound)
+
+	req.NormaliseQuote = true
+	req.Sources = []*gctrpc.AggregatedOrderbookSource{
+		{Exchange: "binance"},
+		{Exchange: "binance", Pair: &gctrpc.CurrencyPair{Base: "LTC", Quote: "USDC"}, Fee: 0.5},
+	}
+	errs := make(chan error, 1)
+	go func() { errs <- s.GetAggregatedOrderbookStream(req, stream) }()
+
+	select {
+	case resp := <-stream.sent:
+		assert.Equal(t, asset.Spot.String(), resp.AssetType)
+		require.Len(t, resp.Bids, 1)
+		assert.Equal(t, 80.0, resp.Bids[0].Price)
+		assert.Equal(t, 2.0, resp.Bids[0].Amount)
+		require.Len(t, resp.Bids[0].Sources, 2)
+		assert.Equal(t, "Binance", resp.Bids[0].Sources[0].Exchange, "source exchange should use the exchange name")
+		assert.Positive(t, resp.Bids[0].Sources[0].Fee, "source fee should default to the exchange taker fee")
+		assert.Equal(t, "USDC", resp.Bids[0].Sources[1].Pair.Quote)
+		assert.Equal(t, 40.0, resp.Bids[0].Sources[1].EffectivePrice)
+		require.Len(t, resp.Asks, 1)
+		assert.Equal(t, 4.0, resp.Asks[0].Amount)
+		assert.Empty(t, resp.Unavailable)
+	case <-time.After(time.Second):
+		require.Fail(t, "GetAggregatedOrderbookStream must send the aggregated book")
+	}
+
+	cancel()
+	select {
+	case err = <-errs:
+		assert.ErrorIs(t, err, context.Canceled)
+	case <-time.After(time.Second):
+		require.Fail(t, "GetAggregatedOrderbookStream must return when the stream context is cancelled")
+	}
+}
+
+// fakeOrderbookAnalyticsStream captures analytics sent by GetOrderbookAnalyticsStream
+type fakeOrderbookAnalyticsStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent chan *gctrpc.OrderbookAnalyticsResponse
+}
+
+func (f *fakeOrderbookAnalyticsStream) Context() context.Context { return f.ctx }
+
+func (f *fakeOrderbookAnalyticsStream) Send(m *gctrpc.OrderbookAnalyticsResponse) error {
+	f.sent <- m
+	return nil
+}
+
+func TestGetOrderbookAnalytics(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, dispatch.EnsureRunning(dispatch.DefaultMaxWorkers, dispatch.DefaultJobsLimit), "dispatch.EnsureRunning must not error")
+	em := NewExchangeManager()
+	exch, err := em.NewExchangeByName("Binance")
+	require.NoError(t, err)
+	exch.SetDefaults()
+	require.NoError(t, em.Add(exch))
+	s := RPCServer{Engine: &Engine{ExchangeManager: em, Config: &config.Config{}}}
+
+	p := currency.NewPair(currency.ADA, currency.USDC)
+	d, err := orderbook.DeployDepth(exch.GetName(), p, asset.Spot)
+	require.NoError(t, err)
+	now := time.Now()
+	require.NoError(t, d.LoadSnapshot(&orderbook.Book{
+		Bids:         orderbook.Levels{{Price: 99, Amount: 3}},
+		Asks:         orderbook.Levels{{Price: 101, Amount: 1}},
+		LastUpdated:  now,
+		LastUpdateID: 1,
+	}))
+
+	_, err = s.GetOrderbookAnalytics(t.Context(), nil)
+	assert.ErrorIs(t, err, errNilRequestData)
+	_, err = s.GetOrderbookAnalytics(t.Context(), &gctrpc.GetOrderbookAnalyticsRequest{})
+	assert.ErrorIs(t, err, errCurrencyPairUnset)
+	req := &gctrpc.GetOrderbookAnalyticsRequest{
+		Exchange:  "bruh",
+		Pair:      &gctrpc.CurrencyPair{Base: "ADA", Quote: "USDC"},
+		AssetType: "bruh",
+	}
+	_, err = s.GetOrderbookAnalytics(t.Context(), req)
+	assert.ErrorIs(t, err, asset.ErrNotSupported)
+	req.AssetType = asset.Spot.String()
+	_, err = s.GetOrderbookAnalytics(t.Context(), req)
+	assert.ErrorIs(t, err, ErrExchangeNotFound)
+
+	req.Exchange = "binance"
+	req.Bands = []float64{50, 500}
+	resp, err := s.GetOrderbookAnalytics(t.Context(), req)
+	require.NoError(t, err)
+	assert.Equal(t, "Binance", resp.Exchange)
+	assert.Equal(t, "USDC", resp.Pair.Quote)
+	assert.Equal(t, asset.Spot.String(), resp.AssetType)
+	assert.Equal(t, int64(1), resp.UpdateId)
+	assert.Equal(t, 100.0, resp.MidPrice)
+	assert.Equal(t, 100.5, resp.Microprice)
+	assert.Equal(t, 0.5, resp.Imbalance)
+	assert.Equal(t, 200.0, resp.SpreadBps)
+	require.Len(t, resp.Bands, 2)
+	assert.Zero(t, resp.Bands[0].BidAmount, "Levels outside of the band should be excluded")
+	assert.Equal(t, 3.0, resp.Bands[1].BidAmount)
+	require.NotNil(t, resp.SpreadStats)
+	assert.Equal(t, 200.0, resp.SpreadStats.Mean)
+	require.NotNil(t, resp.Bids)
+
+	req.Bands = []float64{25}
+	_, err = s.GetOrderbookAnalytics(t.Context(), req)
+	assert.Error(t, err, "GetOrderbookAnalytics should error when tracked with different settings")
+
+	ctx, cancel := context.WithCancel(t.Context())
+	stream := &fakeOrderbookAnalyticsStream{ctx: ctx, sent: make(chan *gctrpc.OrderbookAnalyticsResponse, 10)}
+	req.Bands = nil
+	errs := make(chan error, 1)
+	go func() { errs <- s.GetOrderbookAnalyticsStream(req, stream) }()
+	select {
+	case resp = <-stream.sent:
+		assert.Equal(t, int64(1), resp.UpdateId, "GetOrderbookAnalyticsStream should send the latest analytics first")
+	case <-time.After(time.Second):
+		require.Fail(t, "GetOrderbookAnalyticsStream must send the latest analytics")
+	}
+
+	require.NoError(t, d.ProcessUpdate(&orderbook.Update{UpdateID: 2, UpdateTime: now.Add(time.Second), Asset: asset.Spot, Bids: orderbook.Levels{{Price: 100, Amount: 1}}}))
+	timeout := time.After(time.Second)
+	for resp.UpdateId != 2 {
+		select {
+		case resp = <-stream.sent:
+		case <-timeout:
+			require.Fail(t, "GetOrderbookAnalyticsStream must send recalculated analytics")
+		}
+	}
+	assert.Equal(t, 100.0, resp.BestBid)
+
+	cancel()
+	select {
+	case err = <-errs:
+		assert.ErrorIs(t, err, context.Canceled)
+	case <-time.After(time.Second):
+		require.Fail(t, "GetOrderbookAnalyticsStream must return when the stream context is cancelled")
+	}
+
+	_, err = s.UntrackOrderbookAnalytics(t.Context(), nil)
+	assert.ErrorIs(t, err, errNilRequestData)
+	_, err = s.UntrackOrderbookAnalytics(t.Context(), &gctrpc.UntrackOrderbookAnalyticsRequest{})
+	assert.ErrorIs(t, err, errCurrencyPairUnset)
+	untrack := &gctrpc.UntrackOrderbookAnalyticsRequest{Exchange: "binance", Pair: req.Pair, AssetType: req.AssetType}
+	_, err = s.UntrackOrderbookAnalytics(t.Context(), untrack)
+	require.NoError(t, err)
+	_, err = analytics.GetMetrics(exch.GetName(), p, asset.Spot)
+	assert.ErrorIs(t, err, analytics.ErrNotTracked, "UntrackOrderbookAnalytics should untrack the orderbook")
+	_, err = s.UntrackOrderbookAnalytics(t.Context(), untrack)
+	assert.ErrorIs(t, err, analytics.ErrNotTracked)
+
+	ctx, cancel = context.WithCancel(t.Context())
+	stream = &fakeOrderbookAnalyticsStream{ctx: ctx, sent: make(chan *gctrpc.OrderbookAnalyticsResponse, 10)}
+	go func() { errs <- s.GetOrderbookAnalyticsStream(req, stream) }()
+	select {
+	case <-stream.sent:
+	case <-time.After(time.Second):
+		require.Fail(t, "GetOrderbookAnalyticsStream must send the latest analytics")
+	}
+	cancel()
+	select {
+	case err = <-errs:
+		assert.ErrorIs(t, err, context.Canceled)
+	case <-time.After(time.Second):
+		require.Fail(t, "GetOrderbookAnalyticsStream must return when the stream context is cancelled")
+	}
+	_, err = analytics.GetMetrics(exch.GetName(), p, asset.Spot)
+	assert.ErrorIs(t, err, analytics.ErrNotTracked, "GetOrderbookAnalyticsStream should untrack an orderbook only it tracked")
+}
+
+func TestAddAndGetEvents(t *testing.T) {
+	t.Parallel()
+	exchName := "Binance"
+	em := NewExchangeManager()
+	exch, err := em.NewExchangeByName(exchName)
+	require.NoError(t, err)
+	exch.SetDefaults()
+	b := exch.GetBase()
+	cp := currency.NewBTCUSDT()
+	b.CurrencyPairs.Pairs = make(map[asset.Item]*currency.PairStore)
+	b.CurrencyPairs.Pairs[asset.Spot] = &currency.PairStore{
+		Available:     currency.Pairs{cp},
+		Enabled:       currency.Pairs{cp},
+		AssetEnabled:  true,
+		ConfigFormat:  &currency.PairFormat{Uppercase: true},
+		RequestFormat: &currency.PairFormat{Uppercase: true},
+	}
+	require.NoError(t, em.Add(exch))
+
+	evm, err := setupEventManager(&CommunicationManager{}, em, nil, nil, 0, false)
+	require.NoError(t, err)
+	s := RPCServer{Engine: &Engine{ExchangeManager: em, eventManager: evm, Config: &config.Config{}}}
+
+	_, err = s.GetEvents(t.Context(), &gctrpc.GetEventsRequest{})
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted)
+	require.NoError(t, evm.Start())
+
+	_, err = s.AddEvent(t.Context(), &gctrpc.AddEventRequest{Exchange: exchName})
+	assert.ErrorIs(t, err, errCurrencyPairUnset)
+
+	req := &gctrpc.AddEventRequest{
+		Exchange:  exchName,
+		Pair:      &gctrpc.CurrencyPair{Base: cp.Base.String(), Quote: cp.Quote.String()},
+		AssetType: asset.Spot.String(),
+		Operator:  OperatorOr,
+		Conditions: []*gctrpc.EventCondition{
+			{Item: ItemPrice, ConditionParams: &gctrpc.ConditionParams{Condition: ConditionGreaterThan, Price: 1337}},
+			{Operator: OperatorAnd, Conditions: []*gctrpc.EventCondition{
+				{Item: ItemRSI, ConditionParams: &gctrpc.ConditionParams{Condition: ConditionLessThan, Interval: int64(kline.OneHour), Period: 14, Value: 30}},
+			}},
+		},
+		Actions: []*gctrpc.EventAction{{Action: ActionCancelOrder, OrderId: "1337"}},
+	}
+	resp, err := s.AddEvent(t.Context(), req)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), resp.Id)
+
+	events, err := s.GetEvents(t.Context(), &gctrpc.GetEventsRequest{})
+	require.NoError(t, err)
+	require.Len(t, events.Events, 1)
+	assert.Equal(t, OperatorOr, events.Events[0].Operator)
+	require.Len(t, events.Events[0].Conditions, 2)
+	require.Len(t, events.Events[0].Conditions[1].Conditions, 1, "nested conditions should be returned")
+	assert.Equal(t, int64(kline.OneHour), events.Events[0].Conditions[1].Conditions[0].ConditionParams.Interval)
+	require.Len(t, events.Events[0].Actions, 1)
+	assert.Equal(t, "1337", events.Events[0].Actions[0].OrderId)
+	assert.Equal(t, asset.Spot.String(), events.Events[0].AssetType)
+}
+
+func TestRPCServerSetKillSwitch(t *testing.T) {
+	t.Parallel()
+	var wg sync.WaitGroup
+	om, err := SetupOrderManager(NewExchangeManager(), &CommunicationManager{}, &wg, &config.OrderManager{})
+	require.NoError(t, err)
+	om.started = 1
+	s := RPCServer{Engine: &Engine{OrderManager: om}}
+
+	_, err = s.SetKillSwitch(t.Context(), nil)
+	assert.ErrorIs(t, err, common.ErrNilPointer)
+
+	resp, err := s.SetKillSwitch(t.Context(), &gctrpc.SetKillSwitchRequest{Enabled: true})
+	require.NoError(t, err)
+	assert.Equal(t, "kill switch enabled", resp.Data)
+	assert.True(t, om.IsKillSwitchEnabled())
+
+	resp, err = s.SetKillSwitch(t.Context(), &gctrpc.SetKillSwitchRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, "kill switch disabled", resp.Data)
+	assert.False(t, om.IsKillSwitchEnabled())
+}
+
+func TestRPCServerRouteOrder(t *testing.T) {
+	t.Parallel()
+	em := NewExchangeManager()
+	require.NoError(t, em.Add(&routerExchange{
+		name: "router",
+		book: &orderbook.Book{Asks: orderbook.Levels{{Price: 100, Amount: 1}, {Price: 101, Amount: 1}}},
+	}))
+	s := RPCServer{Engine: &Engine{ExchangeManager: em}}
+
+	_, err := s.RouteOrder(t.Context(), nil)
+	assert.ErrorIs(t, err, common.ErrNilPointer)
+	_, err = s.RouteOrder(t.Context(), &gctrpc.RouteOrderRequest{})
+	assert.ErrorIs(t, err, errCurrencyPairUnset)
+	req := &gctrpc.RouteOrderRequest{
+		Pair:           &gctrpc.CurrencyPair{Base: "BTC", Quote: "USDT"},
+		AssetType:      "moon",
+		Side:           "BUY",
+		Amount:         1.5,
+		IgnoreBalances: true,
+	}
+	_, err = s.RouteOrder(t.Context(), req)
+	assert.ErrorIs(t, err, asset.ErrNotSupported)
+
+	req.AssetType = ""
+	resp, err := s.RouteOrder(t.Context(), req)
+	require.NoError(t, err)
+	assert.False(t, resp.Executed)
+	assert.Equal(t, "spot", resp.AssetType)
+	assert.Equal(t, 1.5, resp.RoutedAmount)
+	require.Len(t, resp.Legs, 1)
+	assert.Equal(t, "router", resp.Legs[0].Exchange)
+	require.Len(t, resp.Legs[0].Orders, 2)
+	assert.Equal(t, 0.5, resp.Legs[0].Orders[1].Amount)
+
+	req.Execute = true
+	_, err = s.RouteOrder(t.Context(), req)
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted)
+}
+
+func TestWithdrawalApprovalRPCs(t *testing.T) {
+	t.Parallel()
+	s := &RPCServer{Engine: &Engine{}}
+	ctx := context.WithValue(t.Context(), rpcPrincipalKey{}, &rpcPrincipal{name: "bob", scopes: []string{RPCScopeWithdraw}})
+	_, err := s.GetPendingWithdrawals(t.Context(), &gctrpc.GetPendingWithdrawalsRequest{})
+	assert.ErrorIs(t, err, ErrNilSubsystem)
+	_, err = s.ApproveWithdrawal(ctx, &gctrpc.ApproveWithdrawalRequest{})
+	assert.ErrorIs(t, err, ErrNilSubsystem)
+	_, err = s.RejectWithdrawal(ctx, &gctrpc.RejectWithdrawalRequest{})
+	assert.ErrorIs(t, err, ErrNilSubsystem)
+
+	m, _, _, _ := withdrawApprovalTestHelper(t, &config.WithdrawManager{RequiredApprovals: 1})
+	s.WithdrawManager = m
+	requester := context.WithValue(t.Context(), rpcPrincipalKey{}, &rpcPrincipal{name: "alice", scopes: []string{RPCScopeWithdraw}})
+	pending, err := m.SubmitWithdrawal(requester, &withdraw.Request{
+		Exchange: "customex",
+		Currency: currency.BTC,
+		Amount:   1,
+		Type:     withdraw.Crypto,
+		Crypto:   withdraw.CryptoRequest{Address: "1337", AddressTag: "tag"},
+	})
+	require.NoError(t, err, "SubmitWithdrawal must not error")
+
+	resp, err := s.GetPendingWithdrawals(t.Context(), &gctrpc.GetPendingWithdrawalsRequest{})
+	require.NoError(t, err, "GetPendingWithdrawals must not error")
+	require.Len(t, resp.Pending, 1)
+	assert.Equal(t, pending.ID.String(), resp.Pending[0].Id)
+	assert.Equal(t, "customex", resp.Pending[0].Exchange)
+	assert.Equal(t, int64(1), resp.Pending[0].RequiredApprovals)
+	require.NotNil(t, resp.Pending[0].Request.Crypto)
+	assert.Equal(t, "tag", resp.Pending[0].Request.Crypto.AddressTag)
+
+	_, err = s.ApproveWithdrawal(t.Context(), &gctrpc.ApproveWithdrawalRequest{Id: pending.ID.String()})
+	assert.ErrorIs(t, err, errApproverUnauthenticated)
+	_, err = s.ApproveWithdrawal(requester, &gctrpc.ApproveWithdrawalRequest{Id: pending.ID.String()})
+	assert.ErrorIs(t, err, errWithdrawalSelfApproval)
+	_, err = s.ApproveWithdrawal(ctx, &gctrpc.ApproveWithdrawalRequest{Id: pending.ID.String(), Approver: "alice"})
+	assert.ErrorIs(t, err, errApproverMismatch)
+	_, err = s.RejectWithdrawal(ctx, &gctrpc.RejectWithdrawalRequest{Id: pending.ID.String(), Approver: "alice"})
+	assert.ErrorIs(t, err, errApproverMismatch)
+	approved, err := s.ApproveWithdrawal(ctx, &gctrpc.ApproveWithdrawalRequest{Id: pending.ID.String()})
+	require.NoError(t, err, "ApproveWithdrawal must not error")
+	assert.Equal(t, "processing", approved.Status)
+
+	_, err = s.RejectWithdrawal(ctx, &gctrpc.RejectWithdrawalRequest{Id: pending.ID.String()})
+	assert.ErrorIs(t, err, ErrWithdrawRequestNotFound)
+}
+
+func TestLedgerRPCs(t *testing.T) {
+	t.Parallel()
+	s := &RPCServer{Engine: &Engine{}}
+	_, err := s.GetLedgerPNL(t.Context(), &gctrpc.GetLedgerPNLRequest{})
+	assert.ErrorIs(t, err, ErrNilSubsystem)
+	_, err = s.GetLedgerLots(t.Context(), &gctrpc.GetLedgerLotsRequest{})
+	assert.ErrorIs(t, err, ErrNilSubsystem)
+	_, err = s.ExportLedgerCSV(t.Context(), &gctrpc.ExportLedgerCSVRequest{})
+	assert.ErrorIs(t, err, ErrNilSubsystem)
+
+	em := NewExchangeManager()
+	exch := &ledgerTestExchange{}
+	require.NoError(t, em.Add(exch), "Add must not error")
+	require.NoError(t, ticker.ProcessTicker(&ticker.Price{
+		ExchangeName: exch.GetName(),
+		Pair:         currency.NewPair(currency.ETH, currency.USD),
+		AssetType:    asset.Spot,
+		Last:         250,
+	}), "ProcessTicker must not error")
+	var om *OrderManager
+	m, err := setupLedgerManager(em, om, &config.LedgerManager{})
+	require.NoError(t, err, "setupLedgerManager must not error")
+	m.started = 1
+	s.ledgerManager = m
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i, f := range []ledger.Fill{
+		{Side: order.Buy, Price: 100, Amount: 1},
+		{Side: order.Buy, Price: 200, Amount: 1},
+		{Side: order.Sell, Price: 300, Amount: 1},
+	} {
+		f.Exchange = "customex"
+		f.Pair = currency.NewPair(currency.ETH, currency.USD)
+		f.OrderID = strconv.Itoa(i)
+		f.Time = start.Add(time.Duration(i) * time.Minute)
+		require.NoError(t, m.ledger.AddFill(&f), "AddFill must not error")
+	}
+
+	pnl, err := s.GetLedgerPNL(t.Context(), &gctrpc.GetLedgerPNLRequest{Currency: "eth"})
+	require.NoError(t, err, "GetLedgerPNL must not error")
+	assert.Equal(t, "fifo", pnl.Method)
+	assert.Equal(t, "USD", pnl.ReportingCurrency)
+	require.Len(t, pnl.Currencies, 1)
+	assert.Equal(t, "ETH", pnl.Currencies[0].Currency)
+	assert.Equal(t, "1", pnl.Currencies[0].Holdings)
+	assert.Equal(t, "200", pnl.Currencies[0].CostBasis)
+	assert.True(t, pnl.Currencies[0].Valued, "holdings should be valued at the last ticker price")
+	assert.Equal(t, "250", pnl.Currencies[0].MarketValue)
+	assert.Equal(t, "50", pnl.Currencies[0].UnrealisedPnl)
+	assert.Equal(t, "0", pnl.Currencies[0].UnvaluedHoldings)
+	assert.Equal(t, "200", pnl.RealisedPnl)
+	assert.Equal(t, "50", pnl.UnrealisedPnl)
+
+	lots, err := s.GetLedgerLots(t.Context(), &gctrpc.GetLedgerLotsRequest{IncludeClosed: true})
+	require.NoError(t, err, "GetLedgerLots must not error")
+	require.Len(t, lots.Lots, 2)
+	assert.Equal(t, uint64(1), lots.Lots[0].Id)
+	assert.Equal(t, "0", lots.Lots[0].Remaining)
+	require.Len(t, lots.Lots[0].Disposals, 1)
+	assert.Equal(t, "2", lots.Lots[0].Disposals[0].OrderId)
+	assert.Equal(t, "200", lots.Lots[0].Disposals[0].RealisedPnl)
+	assert.True(t, lots.Lots[0].Valued, "lots should be valued")
+	assert.True(t, lots.Lots[0].Disposals[0].Valued, "disposals should be valued")
+	assert.Equal(t, "ETH", lots.Lots[0].Pair.Base)
+	lots, err = s.GetLedgerLots(t.Context(), &gctrpc.GetLedgerLotsRequest{})
+	require.NoError(t, err, "GetLedgerLots must not error")
+	assert.Len(t, lots.Lots, 1, "closed lots should not be returned")
+
+	for _, report := range []string{"", "lots", "disposals"} {
+		resp, err := s.ExportLedgerCSV(t.Context(), &gctrpc.ExportLedgerCSVRequest{Report: report, IncludeClosed: true})
+		require.NoError(t, err, "ExportLedgerCSV must not error")
+		records, err := csv.NewReader(strings.NewReader(resp.Csv)).ReadAll()
+		require.NoError(t, err, "ReadAll must not error")
+		assert.NotEmpty(t, records)
+	}
+	resp, err := s.ExportLedgerCSV(t.Context(), &gctrpc.ExportLedgerCSVRequest{})
+	require.NoError(t, err, "ExportLedgerCSV must not error")
+	assert.Equal(t, "summary", resp.Report)
+	_, err = s.ExportLedgerCSV(t.Context(), &gctrpc.ExportLedgerCSVRequest{Report: "taxes"})
+	assert.ErrorIs(t, err, errUnknownLedgerReport)
+}
+
+func TestRebalanceRPCs(t *testing.T) {
+	t.Parallel()
+	s := &RPCServer{Engine: &Engine{}}
+	_, err := s.PreviewRebalance(t.Context(), &gctrpc.PreviewRebalanceRequest{})
+	assert.ErrorIs(t, err, ErrNilSubsystem)
+	_, err = s.GetRebalancePlan(t.Context(), &gctrpc.GetRebalancePlanRequest{})
+	assert.ErrorIs(t, err, ErrNilSubsystem)
+	_, err = s.ApproveRebalancePlan(t.Context(), &gctrpc.ApproveRebalancePlanRequest{})
+	assert.ErrorIs(t, err, ErrNilSubsystem)
+
+	om := &fakeEventOrderManager{}
+	s.rebalanceManager, _ = setupRebalanceTest(t, "rebalancerpc", om, &config.RebalanceManager{})
+	preview, err := s.PreviewRebalance(t.Context(), &gctrpc.PreviewRebalanceRequest{})
+	require.NoError(t, err, "PreviewRebalance must not error")
+	assert.Equal(t, RebalanceStatusPendingApproval, preview.Status)
+	assert.Equal(t, "USD", preview.ReportingCurrency)
+	assert.Equal(t, 200.0, preview.TotalValue)
+	assert.Len(t, preview.Allocations, 3)
+	require.Len(t, preview.Trades, 1)
+	assert.Equal(t, "BUY", preview.Trades[0].Side)
+	assert.Equal(t, "ETH", preview.Trades[0].Pair.Base)
+	assert.NotNil(t, preview.ExpiresAt)
+	assert.Nil(t, preview.ExecutedAt)
+
+	plan, err := s.GetRebalancePlan(t.Context(), &gctrpc.GetRebalancePlanRequest{})
+	require.NoError(t, err, "GetRebalancePlan must not error")
+	assert.Equal(t, preview.Id, plan.Id)
+
+	approved, err := s.ApproveRebalancePlan(t.Context(), &gctrpc.ApproveRebalancePlanRequest{Id: preview.Id})
+	require.NoError(t, err, "ApproveRebalancePlan must not error")
+	assert.Equal(t, RebalanceStatusExecuted, approved.Status)
+	assert.Equal(t, "1337", approved.Trades[0].OrderId)
+	assert.NotNil(t, approved.ExecutedAt)
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	gctscript "github.com/thrasher-corp/gocryptotrader/gctscript/vm"
 	"github.com/thrasher-corp/gocryptotrader/portfolio"
 )
 
@@ -49,6 +50,7 @@ type iExchangeManager interface {
 // iCommsManager limits exposure of accessible functions to communication manager
 type iCommsManager interface {
 	PushEvent(evt base.Event)
+	PushEventTo(relayer string, evt base.Event) error
 }
 
 // iOrderManager defines a limited scoped order manager
@@ -57,6 +59,7 @@ type iOrderManager interface {
 	Exists(*order.Detail) bool
 	Add(*order.Detail) error
 	Cancel(context.Context, *order.Cancel) error
+	Submit(context.Context, *order.Submit) (*OrderSubmitResponse, error)
 	GetByExchangeAndID(string, string) (*order.Detail, error)
 	UpdateExistingOrder(*order.Detail) error
 }
@@ -76,6 +79,12 @@ type iCurrencyPairSyncer interface {
 	WebsocketUpdate(string, currency.Pair, asset.Item, syncItemType, error) error
 }
 
+// iGCTScriptManager defines a limited scoped gctscript manager
+type iGCTScriptManager interface {
+	IsRunning() bool
+	New() *gctscript.VM
+}
+
 // iDatabaseConnectionManager defines a limited scoped databaseConnectionManager
 type iDatabaseConnectionManager interface {
 	GetInstance() database.IDatabase
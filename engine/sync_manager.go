@@ -253,6 +253,40 @@ func (m *SyncManager) get(k key.ExchangeAssetPair) *currencyPairSyncAgent {
 	return m.currencyPairs[k]
 }
 
+// getStaleness returns how long ago each tracked sync item was last updated, or
+// since it was first tracked if it has not been updated. Items which are being
+// synced at the time are skipped rather than waiting on their requests
+func (m *SyncManager) getStaleness() []syncItemStaleness {
+	if m == nil {
+		return nil
+	}
+	m.mux.Lock()
+	agents := make([]*currencyPairSyncAgent, 0, len(m.currencyPairs))
+	for _, c := range m.currencyPairs {
+		agents = append(agents, c)
+	}
+	m.mux.Unlock()
+
+	now := time.Now()
+	staleness := make([]syncItemStaleness, 0, len(agents))
+	for _, c := range agents {
+		for i := range c.trackers {
+			if !c.locks[i].TryLock() {
+				continue
+			}
+			if s := c.trackers[i]; s != nil {
+				last := s.LastUpdated
+				if last.IsZero() {
+					last = c.Created
+				}
+				staleness = append(staleness, syncItemStaleness{Key: c.Key, Item: syncItemType(i), Staleness: now.Sub(last)})
+			}
+			c.locks[i].Unlock()
+		}
+	}
+	return staleness
+}
+
 func newCurrencyPairSyncAgent(k key.ExchangeAssetPair) *currencyPairSyncAgent {
 	return &currencyPairSyncAgent{
 		Key:      k,
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -266,3 +267,35 @@ func TestSyncManagerWebsocketUpdate(t *testing.T) {
 	err = m.WebsocketUpdate("", currency.EMPTYPAIR, asset.Spot, SyncItemTrade, errors.New("test"))
 	require.NoError(t, err)
 }
+
+func TestSyncManagerGetStaleness(t *testing.T) {
+	t.Parallel()
+	var m *SyncManager
+	assert.Nil(t, m.getStaleness(), "getStaleness should return nil on a nil sync manager")
+
+	m = &SyncManager{initSyncCompleted: 1}
+	m.config.SynchronizeTicker = true
+	m.config.SynchronizeTrades = true
+	k := key.NewExchangeAssetPair("test", asset.Spot, currency.NewBTCUSDT())
+	c := m.add(k, syncBase{})
+	c.Created = time.Now().Add(-time.Hour)
+	require.NoError(t, m.update(c, SyncItemTicker, nil), "update must not error")
+
+	s := m.getStaleness()
+	require.Len(t, s, 2, "getStaleness must return an item for each enabled sync item")
+	for i := range s {
+		assert.Equal(t, k, s[i].Key, "Key should be the currency pair sync agent key")
+		switch s[i].Item {
+		case SyncItemTicker:
+			assert.Less(t, s[i].Staleness, time.Minute, "Staleness should be measured from the last update")
+		case SyncItemTrade:
+			assert.GreaterOrEqual(t, s[i].Staleness, time.Hour, "Staleness should be measured from creation without an update")
+		default:
+			assert.Failf(t, "unexpected sync item", "%s", s[i].Item)
+		}
+	}
+
+	c.locks[SyncItemTrade].Lock()
+	assert.Len(t, m.getStaleness(), 1, "getStaleness should skip items which are being synced")
+	c.locks[SyncItemTrade].Unlock()
+}
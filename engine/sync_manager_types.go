@@ -27,6 +27,13 @@ type currencyPairSyncAgent struct {
 	locks    []sync.Mutex
 }
 
+// syncItemStaleness holds how long ago a currency pair sync item was updated
+type syncItemStaleness struct {
+	Key       key.ExchangeAssetPair
+	Item      syncItemType
+	Staleness time.Duration
+}
+
 // SyncManager stores the exchange currency pair syncer object
 type SyncManager struct {
 	initSyncCompleted              int32
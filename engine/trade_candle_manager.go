@@ -0,0 +1,345 @@
+package engine
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/common/key"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/datahistoryjob"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// setupTradeCandleManager creates a new trade candle manager
+func setupTradeCandleManager(em iExchangeManager, dcm iDatabaseConnectionManager, cfg *config.TradeCandleManager) (*tradeCandleManager, error) {
+	if em == nil {
+		return nil, errNilExchangeManager
+	}
+	if cfg == nil {
+		return nil, errNilConfig
+	}
+	if cfg.SaveToDatabase && dcm == nil {
+		return nil, errNilDatabaseConnectionManager
+	}
+	intervals := cfg.Intervals
+	if len(intervals) == 0 {
+		intervals = defaultTradeCandleIntervals
+	}
+	builder, err := trade.NewCandleBuilder(intervals...)
+	if err != nil {
+		return nil, err
+	}
+	closeDelay := cfg.CloseDelay
+	if closeDelay <= 0 {
+		closeDelay = defaultTradeCandleCloseDelay
+	}
+	exchanges := make([]string, len(cfg.Exchanges))
+	for i := range cfg.Exchanges {
+		exchanges[i] = strings.ToLower(cfg.Exchanges[i])
+	}
+	return &tradeCandleManager{
+		exchangeManager: em,
+		databaseManager: dcm,
+		builder:         builder,
+		exchanges:       exchanges,
+		closeDelay:      closeDelay,
+		saveToDatabase:  cfg.SaveToDatabase,
+		verbose:         cfg.Verbose,
+		subscribed:      make(map[string]dispatch.Pipe),
+		sourceJobs:      make(map[string]*datahistoryjob.DataHistoryJob),
+		candleSaver:     kline.StoreInDatabase,
+	}, nil
+}
+
+// IsRunning safely checks whether the subsystem is running
+func (m *tradeCandleManager) IsRunning() bool {
+	return m != nil && atomic.LoadInt32(&m.started) == 1
+}
+
+// Start runs the subsystem
+func (m *tradeCandleManager) Start() error {
+	if m == nil {
+		return fmt.Errorf("trade candle manager %w", ErrNilSubsystem)
+	}
+	if !atomic.CompareAndSwapInt32(&m.started, 0, 1) {
+		return fmt.Errorf("trade candle manager %w", ErrSubSystemAlreadyStarted)
+	}
+	m.shutdown = make(chan struct{})
+	m.subscribe()
+	m.wg.Add(1)
+	go m.run()
+	log.Debugf(log.Global, "Trade candle manager %s", MsgSubSystemStarted)
+	return nil
+}
+
+// Stop attempts to shutdown the subsystem
+func (m *tradeCandleManager) Stop() error {
+	if m == nil {
+		return fmt.Errorf("trade candle manager %w", ErrNilSubsystem)
+	}
+	if !atomic.CompareAndSwapInt32(&m.started, 1, 0) {
+		return fmt.Errorf("trade candle manager %w", ErrSubSystemNotStarted)
+	}
+	close(m.shutdown)
+	m.wg.Wait()
+	m.m.Lock()
+	for exch, pipe := range m.subscribed {
+		if err := pipe.Release(); err != nil {
+			log.Errorf(log.Global, "Trade candle manager unable to release %s trade feed: %s", exch, err)
+		}
+		delete(m.subscribed, exch)
+	}
+	m.m.Unlock()
+	log.Debugf(log.Global, "Trade candle manager %s", MsgSubSystemShutdown)
+	return nil
+}
+
+// run subscribes to exchanges as they are loaded and closes candles once their
+// interval and the close delay have passed
+func (m *tradeCandleManager) run() {
+	defer m.wg.Done()
+	t := time.NewTicker(tradeCandleCloseCheckInterval)
+	defer t.Stop()
+	for {
+		select {
+		case <-m.shutdown:
+			return
+		case now := <-t.C:
+			m.subscribe()
+			closed, err := m.builder.Close(now.Add(-m.closeDelay))
+			if err != nil {
+				log.Errorf(log.Global, "Trade candle manager unable to close candles: %s", err)
+				continue
+			}
+			m.handleClosed(closed)
+		}
+	}
+}
+
+// subscribe starts consuming the trade feed of every enabled exchange which is
+// not yet subscribed
+func (m *tradeCandleManager) subscribe() {
+	exchs, err := m.exchangeManager.GetExchanges()
+	if err != nil {
+		log.Errorf(log.Global, "Trade candle manager unable to get exchanges: %s", err)
+		return
+	}
+	m.m.Lock()
+	defer m.m.Unlock()
+	for _, exch := range exchs {
+		name := strings.ToLower(exch.GetName())
+		if _, ok := m.subscribed[name]; ok {
+			continue
+		}
+		if len(m.exchanges) > 0 && !slices.Contains(m.exchanges, name) {
+			continue
+		}
+		pipe, err := trade.SubscribeToExchangeTrades(name)
+		if err != nil {
+			if m.verbose {
+				log.Errorf(log.Global, "Trade candle manager unable to subscribe to %s trades: %s", exch.GetName(), err)
+			}
+			continue
+		}
+		m.subscribed[name] = pipe
+		warnTradeProcessingDisabled(exch)
+		m.wg.Add(1)
+		go m.consume(name, pipe)
+		if m.verbose {
+			log.Debugf(log.Global, "Trade candle manager building %s candles from %s trades", m.builder.Intervals(), exch.GetName())
+		}
+	}
+}
+
+// warnTradeProcessingDisabled warns when an exchange will not process any
+// websocket trades for candles to be built from
+func warnTradeProcessingDisabled(exch exchange.IBotExchange) {
+	b := exch.GetBase()
+	if b == nil || b.IsSaveTradeDataEnabled() || b.IsTradeFeedEnabled() {
+		return
+	}
+	log.Warnf(log.Global, "Trade candle manager: %s has neither trade feed nor save trade data enabled, no candles will be built", exch.GetName())
+}
+
+// consume builds candles from an exchange's trade feed until shutdown or the
+// feed is closed
+func (m *tradeCandleManager) consume(exch string, pipe dispatch.Pipe) {
+	defer m.wg.Done()
+	for {
+		select {
+		case <-m.shutdown:
+			return
+		case data, ok := <-pipe.Channel():
+			if !ok {
+				m.m.Lock()
+				delete(m.subscribed, exch)
+				m.m.Unlock()
+				return
+			}
+			trades, ok := data.([]trade.Data)
+			if !ok {
+				log.Errorf(log.Global, "Trade candle manager received unhandled type %T from %s trade feed", data, exch)
+				continue
+			}
+			closed, err := m.builder.Add(trades...)
+			if err != nil {
+				log.Errorf(log.Global, "Trade candle manager unable to add %s trades: %s", exch, err)
+				continue
+			}
+			m.handleClosed(closed)
+		}
+	}
+}
+
+// handleClosed publishes closed candles and saves them to the database when
+// enabled
+func (m *tradeCandleManager) handleClosed(closed []trade.ClosedCandle) {
+	if len(closed) == 0 {
+		return
+	}
+	if err := trade.PublishCandles(closed...); err != nil {
+		log.Errorf(log.Global, "Trade candle manager unable to publish candles: %s", err)
+	}
+	if !m.saveToDatabase {
+		return
+	}
+	for _, item := range closedCandlesToItems(closed) {
+		if err := m.save(item); err != nil {
+			log.Errorf(log.Global, "Trade candle manager unable to save %s %s %s %s candles: %s",
+				item.Exchange, item.Asset, item.Pair, item.Interval.Short(), err)
+		}
+	}
+}
+
+// save stores candles in the database, marking them with the source job for
+// their exchange, asset, pair and interval
+func (m *tradeCandleManager) save(item *kline.Item) error {
+	id, err := m.getSourceJobID(item)
+	if err != nil {
+		return err
+	}
+	item.SourceJobID = id
+	inserted, err := m.candleSaver(item, false)
+	if err != nil {
+		return err
+	}
+	if m.verbose {
+		log.Debugf(log.Global, "Trade candle manager saved %d %s %s %s %s candles",
+			inserted, item.Exchange, item.Asset, item.Pair, item.Interval.Short())
+	}
+	return nil
+}
+
+// getSourceJobID returns the ID of the data history job used to mark candles
+// built from trades. The job has its own data type so it is not mistaken for a
+// trade conversion job, and its dates are extended to cover every candle saved
+func (m *tradeCandleManager) getSourceJobID(item *kline.Item) (uuid.UUID, error) {
+	nickname := strings.ToLower(strings.Join([]string{
+		tradeCandleSourceJobNickPrefix,
+		item.Exchange,
+		item.Asset.String(),
+		item.Pair.Base.String() + item.Pair.Quote.String(),
+		item.Interval.Short(),
+	}, "-"))
+
+	m.m.Lock()
+	defer m.m.Unlock()
+	job, ok := m.sourceJobs[nickname]
+	if !ok {
+		if m.jobDB == nil {
+			db := m.databaseManager.GetInstance()
+			if db == nil || !db.IsConnected() {
+				return uuid.Nil, errTradeCandleDatabaseNotConnected
+			}
+			jobDB, err := datahistoryjob.Setup(db)
+			if err != nil {
+				return uuid.Nil, err
+			}
+			m.jobDB = jobDB
+		}
+		var err error
+		job, err = m.jobDB.GetByNickName(nickname)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			id, err := uuid.NewV4()
+			if err != nil {
+				return uuid.Nil, err
+			}
+			job = &datahistoryjob.DataHistoryJob{
+				ID:           id.String(),
+				Nickname:     nickname,
+				ExchangeName: item.Exchange,
+				Asset:        item.Asset.String(),
+				Base:         item.Pair.Base.String(),
+				Quote:        item.Pair.Quote.String(),
+				Interval:     int64(item.Interval.Duration()),
+				Status:       int64(dataHistoryStatusComplete),
+				CreatedDate:  time.Now(),
+			}
+		case err != nil:
+			return uuid.Nil, err
+		}
+	}
+	id, err := uuid.FromString(job.ID)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	next := *job
+	next.DataType = int64(dataHistoryTradeCandleStreamDataType)
+	for i := range item.Candles {
+		if next.StartDate.IsZero() || item.Candles[i].Time.Before(next.StartDate) {
+			next.StartDate = item.Candles[i].Time
+		}
+		if end := item.Candles[i].Time.Add(item.Interval.Duration()); end.After(next.EndDate) {
+			next.EndDate = end
+		}
+	}
+	if !ok || next.DataType != job.DataType || !next.StartDate.Equal(job.StartDate) || !next.EndDate.Equal(job.EndDate) {
+		if err := m.jobDB.Upsert(&next); err != nil {
+			return uuid.Nil, err
+		}
+	}
+	m.sourceJobs[nickname] = &next
+	return id, nil
+}
+
+// closedCandlesToItems groups closed candles into kline items by exchange,
+// asset, pair and interval
+func closedCandlesToItems(closed []trade.ClosedCandle) []*kline.Item {
+	type itemKey struct {
+		key.ExchangeAssetPair
+		interval kline.Interval
+	}
+	var items []*kline.Item
+	byKey := make(map[itemKey]*kline.Item)
+	for i := range closed {
+		k := itemKey{
+			ExchangeAssetPair: key.NewExchangeAssetPair(strings.ToLower(closed[i].Exchange), closed[i].Asset, closed[i].Pair),
+			interval:          closed[i].Interval,
+		}
+		item, ok := byKey[k]
+		if !ok {
+			item = &kline.Item{
+				Exchange: closed[i].Exchange,
+				Pair:     closed[i].Pair,
+				Asset:    closed[i].Asset,
+				Interval: closed[i].Interval,
+			}
+			byKey[k] = item
+			items = append(items, item)
+		}
+		item.Candles = append(item.Candles, closed[i].Candle)
+	}
+	return items
+}
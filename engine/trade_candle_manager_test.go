@@ -0,0 +1,228 @@
+package engine
+
+import (
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/datahistoryjob"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+)
+
+var errTradeCandleTest = errors.New("trade candle test error")
+
+// tradeCandleJobService stores source jobs in memory
+type tradeCandleJobService struct {
+	datahistoryjob.IDBService
+	jobs      map[string]*datahistoryjob.DataHistoryJob
+	upsertErr error
+}
+
+func (s *tradeCandleJobService) GetByNickName(nickname string) (*datahistoryjob.DataHistoryJob, error) {
+	job, ok := s.jobs[nickname]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+	return job, nil
+}
+
+func (s *tradeCandleJobService) Upsert(jobs ...*datahistoryjob.DataHistoryJob) error {
+	if s.upsertErr != nil {
+		return s.upsertErr
+	}
+	for _, job := range jobs {
+		s.jobs[job.Nickname] = job
+	}
+	return nil
+}
+
+func TestSetupTradeCandleManager(t *testing.T) {
+	t.Parallel()
+	_, err := setupTradeCandleManager(nil, nil, nil)
+	assert.ErrorIs(t, err, errNilExchangeManager)
+
+	_, err = setupTradeCandleManager(NewExchangeManager(), nil, nil)
+	assert.ErrorIs(t, err, errNilConfig)
+
+	_, err = setupTradeCandleManager(NewExchangeManager(), nil, &config.TradeCandleManager{SaveToDatabase: true})
+	assert.ErrorIs(t, err, errNilDatabaseConnectionManager)
+
+	_, err = setupTradeCandleManager(NewExchangeManager(), nil, &config.TradeCandleManager{Intervals: []kline.Interval{kline.OneMin, kline.OneMin}})
+	assert.Error(t, err, "duplicate intervals should error")
+
+	m, err := setupTradeCandleManager(NewExchangeManager(), &DatabaseConnectionManager{}, &config.TradeCandleManager{Exchanges: []string{"Bitstamp"}, SaveToDatabase: true})
+	require.NoError(t, err, "setupTradeCandleManager must not error")
+	assert.Equal(t, defaultTradeCandleIntervals, m.builder.Intervals(), "default intervals should be used")
+	assert.Equal(t, defaultTradeCandleCloseDelay, m.closeDelay, "default close delay should be used")
+	assert.Equal(t, []string{"bitstamp"}, m.exchanges, "exchange names should be lowercased")
+}
+
+func TestTradeCandleManagerStartStop(t *testing.T) {
+	t.Parallel()
+	var m *tradeCandleManager
+	assert.ErrorIs(t, m.Start(), ErrNilSubsystem)
+	assert.ErrorIs(t, m.Stop(), ErrNilSubsystem)
+	assert.False(t, m.IsRunning(), "IsRunning should be false on a nil manager")
+
+	m, err := setupTradeCandleManager(NewExchangeManager(), nil, &config.TradeCandleManager{})
+	require.NoError(t, err, "setupTradeCandleManager must not error")
+	assert.ErrorIs(t, m.Stop(), ErrSubSystemNotStarted)
+	require.NoError(t, m.Start(), "Start must not error")
+	assert.True(t, m.IsRunning(), "IsRunning should be true after Start")
+	assert.ErrorIs(t, m.Start(), ErrSubSystemAlreadyStarted)
+	require.NoError(t, m.Stop(), "Stop must not error")
+	assert.False(t, m.IsRunning(), "IsRunning should be false after Stop")
+}
+
+func TestTradeCandleManagerRun(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, dispatch.EnsureRunning(dispatch.DefaultMaxWorkers, dispatch.DefaultJobsLimit))
+	em := NewExchangeManager()
+	exch, err := em.NewExchangeByName("Bitstamp")
+	require.NoError(t, err, "NewExchangeByName must not error")
+	exch.SetDefaults()
+	require.NoError(t, em.Add(exch), "Add must not error")
+
+	m, err := setupTradeCandleManager(em, &DatabaseConnectionManager{}, &config.TradeCandleManager{
+		Exchanges:      []string{"bitstamp", "binance"},
+		Intervals:      []kline.Interval{kline.OneMin},
+		SaveToDatabase: true,
+	})
+	require.NoError(t, err, "setupTradeCandleManager must not error")
+	jobs := &tradeCandleJobService{jobs: make(map[string]*datahistoryjob.DataHistoryJob)}
+	m.jobDB = jobs
+	saved := make(chan *kline.Item, 4)
+	m.candleSaver = func(item *kline.Item, _ bool) (uint64, error) {
+		saved <- item
+		return uint64(len(item.Candles)), nil
+	}
+
+	pipe, err := trade.SubscribeToExchangeCandles("Bitstamp")
+	require.NoError(t, err, "SubscribeToExchangeCandles must not error")
+	defer func() { assert.NoError(t, pipe.Release()) }()
+
+	require.NoError(t, m.Start(), "Start must not error")
+	defer func() { assert.NoError(t, m.Stop()) }()
+	m.m.Lock()
+	assert.Len(t, m.subscribed, 1, "only loaded exchanges in the config should be subscribed")
+	m.m.Unlock()
+
+	pair := currency.NewBTCUSD()
+	start := time.Now().Truncate(time.Minute).Add(-time.Minute * 2)
+	var tr trade.Trade
+	require.NoError(t, tr.Update(false,
+		trade.Data{Exchange: "Bitstamp", CurrencyPair: pair, AssetType: asset.Spot, Price: 100, Amount: 1, Timestamp: start},
+		trade.Data{Exchange: "Bitstamp", CurrencyPair: pair, AssetType: asset.Spot, Price: 101, Amount: 2, Timestamp: start.Add(time.Second)},
+		trade.Data{Exchange: "Bitstamp", CurrencyPair: pair, AssetType: asset.Spot, Price: 102, Amount: 1, Timestamp: start.Add(time.Minute)},
+	))
+
+	select {
+	case data := <-pipe.Channel():
+		candles, ok := data.([]trade.ClosedCandle)
+		require.True(t, ok, "pipe must receive closed candles")
+		require.Len(t, candles, 1, "a trade for the next minute must close the candle")
+		assert.Equal(t, start, candles[0].Time)
+		assert.Equal(t, 101.0, candles[0].Close)
+		assert.Equal(t, 3.0, candles[0].Volume)
+	case <-time.After(time.Second * 5):
+		require.Fail(t, "closed candles must be published")
+	}
+
+	select {
+	case item := <-saved:
+		require.Len(t, item.Candles, 1, "closed candle must be saved")
+		job, ok := jobs.jobs["tradecandles-bitstamp-spot-btcusd-1m"]
+		require.True(t, ok, "source job must be created")
+		assert.Equal(t, job.ID, item.SourceJobID.String(), "saved candles must reference the source job")
+		assert.Equal(t, int64(dataHistoryTradeCandleStreamDataType), job.DataType)
+		assert.Equal(t, int64(dataHistoryStatusComplete), job.Status)
+		assert.Equal(t, start, job.StartDate, "source job should start at the first saved candle")
+		assert.Equal(t, start.Add(time.Minute), job.EndDate, "source job should end after the last saved candle")
+	case <-time.After(time.Second * 5):
+		require.Fail(t, "closed candles must be saved")
+	}
+
+	select {
+	case data := <-pipe.Channel():
+		candles, ok := data.([]trade.ClosedCandle)
+		require.True(t, ok, "pipe must receive closed candles")
+		require.Len(t, candles, 1, "elapsed candles must be closed by the run loop")
+		assert.Equal(t, start.Add(time.Minute), candles[0].Time)
+	case <-time.After(time.Second * 5):
+		require.Fail(t, "elapsed candles must be closed")
+	}
+}
+
+func TestTradeCandleManagerGetSourceJobID(t *testing.T) {
+	t.Parallel()
+	m, err := setupTradeCandleManager(NewExchangeManager(), &DatabaseConnectionManager{}, &config.TradeCandleManager{SaveToDatabase: true})
+	require.NoError(t, err, "setupTradeCandleManager must not error")
+	item := &kline.Item{
+		Exchange: "Bitstamp",
+		Pair:     currency.NewBTCUSD(),
+		Asset:    asset.Spot,
+		Interval: kline.FiveMin,
+		Candles:  []kline.Candle{{Time: time.Now().Truncate(time.Minute * 5)}},
+	}
+
+	_, err = m.getSourceJobID(item)
+	assert.ErrorIs(t, err, errTradeCandleDatabaseNotConnected)
+
+	id := uuid.Must(uuid.NewV4())
+	jobs := &tradeCandleJobService{jobs: map[string]*datahistoryjob.DataHistoryJob{
+		"tradecandles-bitstamp-spot-btcusd-5m": {ID: id.String()},
+	}}
+	m.jobDB = jobs
+	got, err := m.getSourceJobID(item)
+	require.NoError(t, err, "getSourceJobID must not error")
+	assert.Equal(t, id, got, "existing source job should be reused")
+
+	item.Interval = kline.OneHour
+	jobs.upsertErr = errTradeCandleTest
+	_, err = m.getSourceJobID(item)
+	assert.ErrorIs(t, err, errTradeCandleTest)
+
+	jobs.upsertErr = nil
+	got, err = m.getSourceJobID(item)
+	require.NoError(t, err, "getSourceJobID must not error")
+	delete(jobs.jobs, "tradecandles-bitstamp-spot-btcusd-1h")
+	cached, err := m.getSourceJobID(item)
+	require.NoError(t, err, "getSourceJobID must not error")
+	assert.Equal(t, got, cached, "source job IDs should be cached")
+	assert.Empty(t, jobs.jobs["tradecandles-bitstamp-spot-btcusd-1h"], "source jobs should not be saved when their range is unchanged")
+
+	first := item.Candles[0].Time
+	item.Candles = []kline.Candle{{Time: first.Add(-time.Hour)}, {Time: first.Add(time.Hour * 2)}}
+	_, err = m.getSourceJobID(item)
+	require.NoError(t, err, "getSourceJobID must not error")
+	job := jobs.jobs["tradecandles-bitstamp-spot-btcusd-1h"]
+	require.NotNil(t, job, "source job must be saved when its range is extended")
+	assert.Equal(t, first.Add(-time.Hour), job.StartDate, "source job start should be extended to the earliest candle")
+	assert.Equal(t, first.Add(time.Hour*3), job.EndDate, "source job end should be extended past the latest candle")
+	assert.Equal(t, int64(dataHistoryTradeCandleStreamDataType), job.DataType)
+}
+
+func TestClosedCandlesToItems(t *testing.T) {
+	t.Parallel()
+	pair := currency.NewBTCUSD()
+	now := time.Now().Truncate(time.Hour)
+	items := closedCandlesToItems([]trade.ClosedCandle{
+		{Exchange: "Bitstamp", Pair: pair, Asset: asset.Spot, Interval: kline.OneMin, Candle: kline.Candle{Time: now}},
+		{Exchange: "Bitstamp", Pair: pair, Asset: asset.Spot, Interval: kline.FiveMin, Candle: kline.Candle{Time: now}},
+		{Exchange: "bitstamp", Pair: pair, Asset: asset.Spot, Interval: kline.OneMin, Candle: kline.Candle{Time: now.Add(time.Minute)}},
+	})
+	require.Len(t, items, 2, "candles must be grouped by exchange, asset, pair and interval")
+	assert.Equal(t, kline.OneMin, items[0].Interval)
+	assert.Len(t, items[0].Candles, 2)
+	assert.Equal(t, kline.FiveMin, items[1].Interval)
+	assert.Len(t, items[1].Candles, 1)
+}
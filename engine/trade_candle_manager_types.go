@@ -0,0 +1,47 @@
+package engine
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database/repository/datahistoryjob"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+)
+
+const (
+	// TradeCandleManagerName is an exported subsystem name
+	TradeCandleManagerName = "trade_candle_manager"
+
+	defaultTradeCandleCloseDelay   = 2 * time.Second
+	tradeCandleCloseCheckInterval  = time.Second
+	tradeCandleSourceJobNickPrefix = "tradecandles"
+)
+
+var defaultTradeCandleIntervals = []kline.Interval{kline.OneMin}
+
+var errTradeCandleDatabaseNotConnected = errors.New("database is not connected")
+
+// tradeCandleManager builds candles from the trades processed by exchange
+// websocket feeds, publishes closed candles via dispatch and optionally saves
+// them to the database
+type tradeCandleManager struct {
+	started         int32
+	exchangeManager iExchangeManager
+	databaseManager iDatabaseConnectionManager
+	builder         *trade.CandleBuilder
+	exchanges       []string
+	closeDelay      time.Duration
+	saveToDatabase  bool
+	verbose         bool
+	shutdown        chan struct{}
+	wg              sync.WaitGroup
+
+	m           sync.Mutex
+	subscribed  map[string]dispatch.Pipe
+	jobDB       datahistoryjob.IDBService
+	sourceJobs  map[string]*datahistoryjob.DataHistoryJob
+	candleSaver func(*kline.Item, bool) (uint64, error)
+}
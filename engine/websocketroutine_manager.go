@@ -162,6 +162,8 @@ func (m *WebsocketRoutineManager) websocketDataReceiver(ws *websocket.Manager) e
 		return errRoutineManagerNotStarted
 	}
 
+	filter := m.getWebsocketDataFilter(ws.GetName())
+
 	m.wg.Go(func() {
 		for {
 			select {
@@ -171,6 +173,9 @@ func (m *WebsocketRoutineManager) websocketDataReceiver(ws *websocket.Manager) e
 				if data == nil {
 					log.Errorf(log.WebsocketMgr, "exchange %s nil data sent to websocket", ws.GetName())
 				}
+				if filter != nil && !filter.IsWebsocketDataAllowed(data) {
+					continue
+				}
 				m.mu.RLock()
 				for x := range m.dataHandlers {
 					err := m.dataHandlers[x](ws.GetName(), data)
@@ -185,6 +190,20 @@ func (m *WebsocketRoutineManager) websocketDataReceiver(ws *websocket.Manager) e
 	return nil
 }
 
+// getWebsocketDataFilter returns the websocket data filter for an exchange or
+// nil if the exchange does not filter its websocket data
+func (m *WebsocketRoutineManager) getWebsocketDataFilter(exchName string) websocketDataFilter {
+	if m.exchangeManager == nil {
+		return nil
+	}
+	exch, err := m.exchangeManager.GetExchangeByName(exchName)
+	if err != nil {
+		return nil
+	}
+	filter, _ := exch.(websocketDataFilter)
+	return filter
+}
+
 // websocketDataHandler is the default central point for exchange websocket
 // implementations to send processed data which will then pass that to an
 // appropriate handler.
@@ -12,6 +12,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchange/websocket"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
@@ -277,6 +278,32 @@ func TestRegisterWebsocketDataHandlerWithFunctionality(t *testing.T) {
 	m.wg.Wait()
 }
 
+type filteredWebsocketExchange struct {
+	exchange.IBotExchange
+}
+
+func (f *filteredWebsocketExchange) GetName() string {
+	return "filtered"
+}
+
+func (f *filteredWebsocketExchange) IsWebsocketDataAllowed(data any) bool {
+	_, ok := data.(string)
+	return ok
+}
+
+func TestGetWebsocketDataFilter(t *testing.T) {
+	t.Parallel()
+	em := NewExchangeManager()
+	require.NoError(t, em.Add(&filteredWebsocketExchange{}), "Add must not error")
+	m := &WebsocketRoutineManager{exchangeManager: em}
+	assert.Nil(t, m.getWebsocketDataFilter("unloaded"), "getWebsocketDataFilter should return nil for an exchange which is not loaded")
+	filter := m.getWebsocketDataFilter("Filtered")
+	require.NotNil(t, filter, "getWebsocketDataFilter must return the exchange filter")
+	assert.True(t, filter.IsWebsocketDataAllowed("allowed"), "filter should allow data permitted by the exchange")
+	assert.False(t, filter.IsWebsocketDataAllowed(&order.Detail{}), "filter should reject data rejected by the exchange")
+	assert.Nil(t, (&WebsocketRoutineManager{}).getWebsocketDataFilter("filtered"), "getWebsocketDataFilter should return nil without an exchange manager")
+}
+
 func TestSetWebsocketDataHandler(t *testing.T) {
 	t.Parallel()
 	var m *WebsocketRoutineManager
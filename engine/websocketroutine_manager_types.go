@@ -37,6 +37,12 @@ type WebsocketRoutineManager struct {
 	mu              sync.RWMutex
 }
 
+// websocketDataFilter is implemented by exchanges which drop websocket data
+// before it reaches the data handlers, such as paper trading exchanges
+type websocketDataFilter interface {
+	IsWebsocketDataAllowed(data any) bool
+}
+
 // WebsocketDataHandler defines a function signature for a function that handles
 // data coming from websocket connections.
 type WebsocketDataHandler func(service string, incoming any) error
@@ -4,10 +4,18 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
+	"sync/atomic"
 	"time"
 
+	"github.com/gofrs/uuid"
 	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/database"
 	dbwithdraw "github.com/thrasher-corp/gocryptotrader/database/repository/withdraw"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/currencystate"
 	"github.com/thrasher-corp/gocryptotrader/log"
@@ -15,19 +23,178 @@ import (
 )
 
 // SetupWithdrawManager creates a new withdraw manager
-func SetupWithdrawManager(em iExchangeManager, pm iPortfolioManager, isDryRun bool) (*WithdrawManager, error) {
+func SetupWithdrawManager(em iExchangeManager, pm iPortfolioManager, cm iCommsManager, cfg *config.WithdrawManager, isDryRun bool) (*WithdrawManager, error) {
 	if em == nil {
 		return nil, errors.New("nil manager")
 	}
-	return &WithdrawManager{
-		exchangeManager:  em,
-		portfolioManager: pm,
-		isDryRun:         isDryRun,
-	}, nil
+	if cfg == nil {
+		cfg = &config.WithdrawManager{}
+	}
+	if cfg.RequiredApprovals < 0 {
+		return nil, fmt.Errorf("%w: required approvals %d", errInvalidWithdrawLimit, cfg.RequiredApprovals)
+	}
+	m := &WithdrawManager{
+		exchangeManager:    em,
+		portfolioManager:   pm,
+		commsManager:       cm,
+		isDryRun:           isDryRun,
+		requiredApprovals:  cfg.RequiredApprovals,
+		approvalThresholds: make(map[string]float64, len(cfg.ApprovalThresholds)),
+		approvalExpiry:     cfg.ApprovalExpiry,
+		dailyLimits:        make(map[string]float64, len(cfg.DailyLimits)),
+		trackerEnabled:     cfg.StatusTracker.Enabled,
+		trackerInterval:    cfg.StatusTracker.Interval,
+		verbose:            cfg.Verbose,
+		store:              dbWithdrawalStore{},
+		pending:            make(map[uuid.UUID]*PendingWithdrawal),
+		dailyUsage:         make(map[string]float64),
+	}
+	for code, amount := range cfg.ApprovalThresholds {
+		if amount < 0 {
+			return nil, fmt.Errorf("%w: %s approval threshold %v", errInvalidWithdrawLimit, code, amount)
+		}
+		m.approvalThresholds[strings.ToUpper(code)] = amount
+	}
+	for code, amount := range cfg.DailyLimits {
+		if amount <= 0 {
+			return nil, fmt.Errorf("%w: %s daily limit %v", errInvalidWithdrawLimit, code, amount)
+		}
+		m.dailyLimits[strings.ToUpper(code)] = amount
+	}
+	if m.approvalExpiry <= 0 {
+		m.approvalExpiry = defaultWithdrawApprovalExpiry
+	}
+	if m.trackerInterval <= 0 {
+		m.trackerInterval = defaultWithdrawStatusTrackerInterval
+	}
+	return m, nil
+}
+
+// IsRunning returns whether the withdraw manager is running
+func (m *WithdrawManager) IsRunning() bool {
+	return m != nil && atomic.LoadInt32(&m.started) == 1
+}
+
+// Start reloads stored withdrawals so pending approvals, daily limits and
+// status tracking survive a restart, then runs the withdrawal status tracker
+// when enabled, which polls exchanges for the status of submitted withdrawals
+func (m *WithdrawManager) Start() error {
+	if m == nil {
+		return fmt.Errorf("withdraw manager %w", ErrNilSubsystem)
+	}
+	if !atomic.CompareAndSwapInt32(&m.started, 0, 1) {
+		return fmt.Errorf("withdraw manager %w", ErrSubSystemAlreadyStarted)
+	}
+	m.loadWithdrawals(time.Now())
+	m.shutdown = make(chan struct{})
+	if m.trackerEnabled {
+		m.wg.Add(1)
+		go m.run()
+	}
+	log.Debugf(log.Global, "Withdraw manager %s", MsgSubSystemStarted)
+	return nil
+}
+
+// Stop stops the withdraw manager and its withdrawal status tracker
+func (m *WithdrawManager) Stop() error {
+	if m == nil {
+		return fmt.Errorf("withdraw manager %w", ErrNilSubsystem)
+	}
+	if !atomic.CompareAndSwapInt32(&m.started, 1, 0) {
+		return fmt.Errorf("withdraw manager %w", ErrSubSystemNotStarted)
+	}
+	close(m.shutdown)
+	m.wg.Wait()
+	log.Debugf(log.Global, "Withdraw manager %s", MsgSubSystemShutdown)
+	return nil
+}
+
+// loadWithdrawals restores withdrawals stored within the reload period.
+// Unexpired withdrawals awaiting approval are pending again, submitted
+// withdrawals still in progress are tracked and today's daily limit usage is
+// rebuilt from the stored requests
+func (m *WithdrawManager) loadWithdrawals(now time.Time) {
+	records, err := m.store.GetRecordsSince(now.Add(-max(m.approvalExpiry, withdrawalReloadPeriod)))
+	if err != nil {
+		if !errors.Is(err, database.ErrDatabaseSupportDisabled) && !errors.Is(err, common.ErrNoResults) {
+			log.Errorf(log.Global, "Withdraw manager unable to load stored withdrawals: %s", err)
+		}
+		return
+	}
+	day := now.UTC().Truncate(24 * time.Hour)
+	usage := make(map[string]float64)
+	m.m.Lock()
+	defer m.m.Unlock()
+	for _, r := range records {
+		req, ok := m.loadWithdrawal(r, now)
+		if !ok || r.CreatedAt.UTC().Before(day) {
+			continue
+		}
+		if code := req.Currency.Upper().String(); m.dailyLimits[code] > 0 {
+			usage[code] += req.Amount
+		}
+	}
+	m.usageDay = day
+	m.dailyUsage = usage
+	if m.verbose {
+		log.Debugf(log.Global, "Withdraw manager loaded %d pending and %d tracked withdrawals", len(m.pending), len(m.tracked))
+	}
+}
+
+// loadWithdrawal restores a stored withdrawal and returns its request and
+// whether it counts towards the daily limits. The lock must be held
+func (m *WithdrawManager) loadWithdrawal(r *dbwithdraw.Record, now time.Time) (*withdraw.Request, bool) {
+	if r.Exchange.Status == WithdrawalStatusPendingApproval {
+		if r.Approval == nil {
+			log.Warnf(log.Global, "Withdraw manager %s withdrawal %s has no stored approval state", r.Exchange.Name, r.ID)
+			return nil, false
+		}
+		p := pendingFromRecord(r)
+		if now.After(p.ExpiresAt) {
+			m.storeStatus(p.ID, WithdrawalStatusApprovalExpired)
+			return nil, false
+		}
+		if _, ok := m.pending[p.ID]; !ok {
+			m.pending[p.ID] = p
+		}
+		return &p.Request, true
+	}
+	if r.Exchange.ID == "" || r.Exchange.ID == withdraw.DryRunID.String() {
+		return nil, false
+	}
+	r.RequestDetails.Exchange = r.Exchange.Name
+	state, _ := withdrawalStateFromStatus(r.Exchange.Name, r.Exchange.Status)
+	if state == withdrawalFailed {
+		return nil, false
+	}
+	if state == withdrawalInProgress && !slices.ContainsFunc(m.tracked, func(t *withdraw.Response) bool { return t.ID == r.ID }) {
+		m.tracked = append(m.tracked, r.Response)
+	}
+	return &r.RequestDetails, true
+}
+
+func (m *WithdrawManager) run() {
+	defer m.wg.Done()
+	t := time.NewTicker(m.trackerInterval)
+	defer t.Stop()
+	for {
+		select {
+		case <-m.shutdown:
+			return
+		case <-t.C:
+			m.trackWithdrawals(context.Background())
+			m.m.Lock()
+			m.removeExpiredWithdrawals(time.Now())
+			m.m.Unlock()
+		}
+	}
 }
 
 // SubmitWithdrawal performs validation and submits a new withdraw request to
-// exchange
+// exchange. Requests which need approval are held until approved and are
+// returned with a pending approval status. The authenticated gRPC user
+// submitting the request is recorded as its requester and cannot approve it.
+// Requests without an asset are made from the spot account
 func (m *WithdrawManager) SubmitWithdrawal(ctx context.Context, req *withdraw.Request) (*withdraw.Response, error) {
 	if m == nil {
 		return nil, ErrNilSubsystem
@@ -35,12 +202,93 @@ func (m *WithdrawManager) SubmitWithdrawal(ctx context.Context, req *withdraw.Re
 	if req == nil {
 		return nil, withdraw.ErrRequestCannotBeNil
 	}
+	if req.Asset == asset.Empty {
+		req.Asset = asset.Spot
+	}
 
+	exch, err := m.validateWithdrawal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	m.m.Lock()
+	if err = m.reserveDailyLimit(req, now); err != nil {
+		m.m.Unlock()
+		return nil, err
+	}
+	if m.requiresApproval(req) {
+		id, errID := uuid.NewV4()
+		if errID != nil {
+			m.releaseDailyLimit(req, now)
+			m.m.Unlock()
+			return nil, errID
+		}
+		p := &PendingWithdrawal{
+			ID:                id,
+			Request:           *req,
+			Requester:         withdrawalRequester(ctx),
+			RequiredApprovals: m.requiredApprovals,
+			CreatedAt:         now,
+			ExpiresAt:         now.Add(m.approvalExpiry),
+		}
+		if err = m.store.AddPending(p.response(), p.approval()); err != nil && !errors.Is(err, database.ErrDatabaseSupportDisabled) {
+			log.Errorf(log.Global, "Withdraw manager unable to store %s withdrawal %s awaiting approval: %s", req.Exchange, id, err)
+		}
+		m.pending[id] = p
+		m.m.Unlock()
+		m.notify(fmt.Sprintf("%s withdrawal %s of %v %s requires %d approvals", req.Exchange, id, req.Amount, req.Currency, m.requiredApprovals))
+		return p.response(), nil
+	}
+	m.m.Unlock()
+
+	resp, err := m.submit(ctx, exch, req, uuid.Nil)
+	if err != nil {
+		m.m.Lock()
+		m.releaseDailyLimit(req, now)
+		m.m.Unlock()
+	}
+	return resp, err
+}
+
+// withdrawalRequester returns the authenticated gRPC user submitting a
+// withdrawal request, or an empty string when it was not submitted over gRPC
+func withdrawalRequester(ctx context.Context) string {
+	if p, ok := rpcPrincipalFromContext(ctx); ok {
+		return p.name
+	}
+	return ""
+}
+
+// validateWithdrawal checks that a withdrawal request can be submitted to its
+// exchange and returns the exchange
+func (m *WithdrawManager) validateWithdrawal(req *withdraw.Request) (exchange.IBotExchange, error) {
 	exch, err := m.exchangeManager.GetExchangeByName(req.Exchange)
 	if err != nil {
 		return nil, err
 	}
 
+	// Determines if the currency can be withdrawn from the exchange
+	errF := exch.CanWithdraw(req.Currency, req.Asset)
+	if errF != nil && !errors.Is(errF, currencystate.ErrCurrencyStateNotFound) { // Suppress not found error
+		return nil, errF
+	}
+
+	if !m.isDryRun && req.Type == withdraw.Crypto {
+		if !m.portfolioManager.IsWhiteListed(req.Crypto.Address) {
+			return nil, withdraw.ErrStrAddressNotWhiteListed
+		}
+		if !m.portfolioManager.IsExchangeSupported(req.Exchange, req.Crypto.Address) {
+			return nil, withdraw.ErrStrExchangeNotSupportedByAddress
+		}
+	}
+	return exch, nil
+}
+
+// submit sends a validated withdrawal request to its exchange and stores the
+// response. Approved withdrawals pass their pending ID so their stored request
+// is updated
+func (m *WithdrawManager) submit(ctx context.Context, exch exchange.IBotExchange, req *withdraw.Request, pendingID uuid.UUID) (*withdraw.Response, error) {
 	resp := &withdraw.Response{
 		Exchange: withdraw.ExchangeResponse{
 			Name: req.Exchange,
@@ -48,12 +296,7 @@ func (m *WithdrawManager) SubmitWithdrawal(ctx context.Context, req *withdraw.Re
 		RequestDetails: *req,
 	}
 
-	// Determines if the currency can be withdrawn from the exchange
-	errF := exch.CanWithdraw(req.Currency, asset.Spot)
-	if errF != nil && !errors.Is(errF, currencystate.ErrCurrencyStateNotFound) { // Suppress not found error
-		return nil, errF
-	}
-
+	var err error
 	if m.isDryRun {
 		log.Warnln(log.Global, "Dry run enabled, no withdrawal request will be submitted or have an event created")
 		resp.ID = withdraw.DryRunID
@@ -61,14 +304,6 @@ func (m *WithdrawManager) SubmitWithdrawal(ctx context.Context, req *withdraw.Re
 		resp.Exchange.ID = withdraw.DryRunID.String()
 	} else {
 		var ret *withdraw.ExchangeResponse
-		if req.Type == withdraw.Crypto {
-			if !m.portfolioManager.IsWhiteListed(req.Crypto.Address) {
-				return nil, withdraw.ErrStrAddressNotWhiteListed
-			}
-			if !m.portfolioManager.IsExchangeSupported(req.Exchange, req.Crypto.Address) {
-				return nil, withdraw.ErrStrExchangeNotSupportedByAddress
-			}
-		}
 		switch req.Type {
 		case withdraw.Fiat:
 			ret, err = exch.WithdrawFiatFunds(ctx, req)
@@ -85,13 +320,385 @@ func (m *WithdrawManager) SubmitWithdrawal(ctx context.Context, req *withdraw.Re
 			resp.Exchange.ID = ret.ID
 		}
 	}
-	dbwithdraw.Event(resp)
+	if pendingID == uuid.Nil {
+		dbwithdraw.Event(resp)
+	} else {
+		resp.ID = pendingID
+		if errStore := m.store.UpdateExchangeResponse(pendingID.String(), &resp.Exchange); errStore != nil && !errors.Is(errStore, database.ErrDatabaseSupportDisabled) {
+			log.Errorf(log.Global, "Withdraw manager unable to store %s withdrawal %s response: %s", req.Exchange, pendingID, errStore)
+		}
+	}
 	if err == nil {
 		withdraw.Cache.Add(resp.ID, resp)
+		if !m.isDryRun && resp.Exchange.ID != "" {
+			m.m.Lock()
+			m.tracked = append(m.tracked, resp)
+			m.m.Unlock()
+		}
 	}
 	return resp, err
 }
 
+// requiresApproval returns whether a withdrawal request must be approved
+// before it is submitted
+func (m *WithdrawManager) requiresApproval(req *withdraw.Request) bool {
+	if m.requiredApprovals == 0 {
+		return false
+	}
+	threshold, ok := m.approvalThresholds[req.Currency.Upper().String()]
+	return !ok || req.Amount >= threshold
+}
+
+// reserveDailyLimit adds a withdrawal request to the amount withdrawn today
+// and errors if it would exceed the daily limit for its currency. The lock
+// must be held
+func (m *WithdrawManager) reserveDailyLimit(req *withdraw.Request, now time.Time) error {
+	code := req.Currency.Upper().String()
+	limit, ok := m.dailyLimits[code]
+	if !ok {
+		return nil
+	}
+	if day := now.UTC().Truncate(24 * time.Hour); !m.usageDay.Equal(day) {
+		m.usageDay = day
+		clear(m.dailyUsage)
+	}
+	if used := m.dailyUsage[code]; used+req.Amount > limit {
+		return fmt.Errorf("%w: %v %s requested with %v of %v withdrawn today", errWithdrawDailyLimitExceeded, req.Amount, code, used, limit)
+	}
+	m.dailyUsage[code] += req.Amount
+	return nil
+}
+
+// releaseDailyLimit removes a withdrawal request reserved at the given time
+// from the amount withdrawn today. The lock must be held
+func (m *WithdrawManager) releaseDailyLimit(req *withdraw.Request, reserved time.Time) {
+	code := req.Currency.Upper().String()
+	if _, ok := m.dailyLimits[code]; !ok || !m.usageDay.Equal(reserved.UTC().Truncate(24*time.Hour)) {
+		return
+	}
+	m.dailyUsage[code] = max(m.dailyUsage[code]-req.Amount, 0)
+}
+
+// GetPendingWithdrawals returns withdrawal requests awaiting approval, oldest
+// first
+func (m *WithdrawManager) GetPendingWithdrawals() ([]PendingWithdrawal, error) {
+	if m == nil {
+		return nil, ErrNilSubsystem
+	}
+	m.m.Lock()
+	defer m.m.Unlock()
+	m.removeExpiredWithdrawals(time.Now())
+	resp := make([]PendingWithdrawal, 0, len(m.pending))
+	for _, p := range m.pending {
+		c := *p
+		c.Approvals = slices.Clone(p.Approvals)
+		resp = append(resp, c)
+	}
+	slices.SortFunc(resp, func(a, b PendingWithdrawal) int {
+		return a.CreatedAt.Compare(b.CreatedAt)
+	})
+	return resp, nil
+}
+
+// ApproveWithdrawal adds an approval to a pending withdrawal request and
+// submits it to the exchange once it has the required number of approvals
+func (m *WithdrawManager) ApproveWithdrawal(ctx context.Context, id, approver string) (*withdraw.Response, error) {
+	if m == nil {
+		return nil, ErrNilSubsystem
+	}
+	if approver == "" {
+		return nil, errApproverUnset
+	}
+	p, err := m.getPendingWithdrawal(id)
+	if err != nil {
+		return nil, err
+	}
+	if p.Requester != "" && strings.EqualFold(p.Requester, approver) {
+		m.m.Unlock()
+		return nil, fmt.Errorf("%w %s: %s", errWithdrawalSelfApproval, approver, id)
+	}
+	if slices.ContainsFunc(p.Approvals, func(a WithdrawalApproval) bool { return strings.EqualFold(a.Approver, approver) }) {
+		m.m.Unlock()
+		return nil, fmt.Errorf("%w %s: %s", errWithdrawalAlreadyApproved, approver, id)
+	}
+	p.Approvals = append(p.Approvals, WithdrawalApproval{Approver: approver, Time: time.Now()})
+	if err = m.store.UpdateApproval(p.ID.String(), p.approval()); err != nil && !errors.Is(err, database.ErrDatabaseSupportDisabled) {
+		log.Errorf(log.Global, "Withdraw manager unable to store %s withdrawal %s approval: %s", p.Request.Exchange, id, err)
+	}
+	if m.verbose {
+		log.Debugf(log.Global, "Withdraw manager %s withdrawal %s approved by %s %d/%d", p.Request.Exchange, id, approver, len(p.Approvals), p.RequiredApprovals)
+	}
+	if len(p.Approvals) < p.RequiredApprovals {
+		resp := p.response()
+		m.m.Unlock()
+		return resp, nil
+	}
+	delete(m.pending, p.ID)
+	m.m.Unlock()
+
+	// Whitelists and currency states may have changed while awaiting approval
+	exch, err := m.validateWithdrawal(&p.Request)
+	if err != nil {
+		m.storeStatus(p.ID, err.Error())
+		m.m.Lock()
+		m.releaseDailyLimit(&p.Request, p.CreatedAt)
+		m.m.Unlock()
+		return nil, err
+	}
+	resp, err := m.submit(ctx, exch, &p.Request, p.ID)
+	if err != nil {
+		m.m.Lock()
+		m.releaseDailyLimit(&p.Request, p.CreatedAt)
+		m.m.Unlock()
+	}
+	return resp, err
+}
+
+// RejectWithdrawal removes a pending withdrawal request
+func (m *WithdrawManager) RejectWithdrawal(id, approver, reason string) error {
+	if m == nil {
+		return ErrNilSubsystem
+	}
+	if approver == "" {
+		return errApproverUnset
+	}
+	p, err := m.getPendingWithdrawal(id)
+	if err != nil {
+		return err
+	}
+	delete(m.pending, p.ID)
+	m.releaseDailyLimit(&p.Request, p.CreatedAt)
+	m.m.Unlock()
+	m.storeStatus(p.ID, WithdrawalStatusRejected)
+	m.notify(fmt.Sprintf("%s withdrawal %s of %v %s rejected by %s: %s", p.Request.Exchange, p.ID, p.Request.Amount, p.Request.Currency, approver, reason))
+	return nil
+}
+
+// getPendingWithdrawal returns a pending withdrawal request by ID. The lock is
+// held on success and must be released by the caller
+func (m *WithdrawManager) getPendingWithdrawal(id string) (*PendingWithdrawal, error) {
+	u, err := uuid.FromString(id)
+	if err != nil {
+		return nil, fmt.Errorf("%w %v: %w", ErrWithdrawRequestNotFound, id, err)
+	}
+	m.m.Lock()
+	p, ok := m.pending[u]
+	if !ok {
+		m.m.Unlock()
+		return nil, fmt.Errorf("%w %v", ErrWithdrawRequestNotFound, id)
+	}
+	if time.Now().After(p.ExpiresAt) {
+		delete(m.pending, u)
+		m.releaseDailyLimit(&p.Request, p.CreatedAt)
+		m.m.Unlock()
+		m.storeStatus(u, WithdrawalStatusApprovalExpired)
+		return nil, fmt.Errorf("%w %v", errWithdrawalApprovalExpired, id)
+	}
+	return p, nil
+}
+
+// removeExpiredWithdrawals removes pending withdrawal requests which were not
+// approved in time. The lock must be held
+func (m *WithdrawManager) removeExpiredWithdrawals(now time.Time) {
+	for id, p := range m.pending {
+		if now.After(p.ExpiresAt) {
+			delete(m.pending, id)
+			m.releaseDailyLimit(&p.Request, p.CreatedAt)
+			m.storeStatus(id, WithdrawalStatusApprovalExpired)
+			log.Warnf(log.Global, "Withdraw manager %s withdrawal %s of %v %s expired awaiting approval", p.Request.Exchange, id, p.Request.Amount, p.Request.Currency)
+		}
+	}
+}
+
+// trackWithdrawals polls exchange withdrawal histories for the status of
+// submitted withdrawals and stores any changes. Completed and failed
+// withdrawals are no longer tracked
+func (m *WithdrawManager) trackWithdrawals(ctx context.Context) {
+	m.m.Lock()
+	tracked := slices.Clone(m.tracked)
+	m.m.Unlock()
+	if len(tracked) == 0 {
+		return
+	}
+
+	type historyKey struct {
+		exchange string
+		currency string
+		asset    asset.Item
+	}
+	histories := make(map[historyKey][]exchange.WithdrawalHistory)
+	for _, resp := range tracked {
+		key := historyKey{exchange: resp.RequestDetails.Exchange, currency: resp.RequestDetails.Currency.Upper().String(), asset: resp.RequestDetails.Asset}
+		history, ok := histories[key]
+		if !ok {
+			exch, err := m.exchangeManager.GetExchangeByName(key.exchange)
+			if err != nil {
+				log.Errorf(log.Global, "Withdraw manager unable to track %s withdrawals: %s", key.exchange, err)
+				histories[key] = nil
+				continue
+			}
+			history, err = exch.GetWithdrawalsHistory(ctx, resp.RequestDetails.Currency, key.asset)
+			if err != nil {
+				log.Errorf(log.Global, "Withdraw manager unable to get %s %s %s withdrawal history: %s", key.exchange, key.asset, key.currency, err)
+			}
+			histories[key] = history
+		}
+		i := slices.IndexFunc(history, func(h exchange.WithdrawalHistory) bool {
+			return h.TransferID == resp.Exchange.ID
+		})
+		if i == -1 {
+			continue
+		}
+		m.updateWithdrawalStatus(resp, history[i].Status)
+	}
+}
+
+// updateWithdrawalStatus stores a withdrawal status returned by an exchange
+// and notifies when the withdrawal has completed or failed
+func (m *WithdrawManager) updateWithdrawalStatus(resp *withdraw.Response, status string) {
+	state, known := withdrawalStateFromStatus(resp.RequestDetails.Exchange, status)
+	if status == resp.Exchange.Status && state == withdrawalInProgress {
+		return
+	}
+	if status != resp.Exchange.Status {
+		if !known {
+			log.Warnf(log.Global, "Withdraw manager %s withdrawal %s status %q is not a known status, derived %s from its text", resp.RequestDetails.Exchange, resp.Exchange.ID, status, state)
+		}
+		if resp.ID != uuid.Nil {
+			if err := m.store.UpdateStatus(resp.ID.String(), status); err != nil && !errors.Is(err, database.ErrDatabaseSupportDisabled) {
+				log.Errorf(log.Global, "Withdraw manager unable to store %s withdrawal %s status: %s", resp.RequestDetails.Exchange, resp.Exchange.ID, err)
+			}
+		}
+		if m.verbose {
+			log.Debugf(log.Global, "Withdraw manager %s withdrawal %s status changed from %q to %q", resp.RequestDetails.Exchange, resp.Exchange.ID, resp.Exchange.Status, status)
+		}
+	}
+
+	updated := *resp
+	updated.Exchange.Status = status
+	updated.UpdatedAt = time.Now()
+	m.m.Lock()
+	if i := slices.Index(m.tracked, resp); i != -1 {
+		if state == withdrawalInProgress {
+			m.tracked[i] = &updated
+		} else {
+			m.tracked = slices.Delete(m.tracked, i, i+1)
+		}
+	}
+	m.m.Unlock()
+	if resp.ID != uuid.Nil {
+		withdraw.Cache.Add(resp.ID, &updated)
+	}
+
+	switch state {
+	case withdrawalCompleted:
+		m.notify(fmt.Sprintf("%s withdrawal %s of %v %s completed with status %q", resp.RequestDetails.Exchange, resp.Exchange.ID, resp.RequestDetails.Amount, resp.RequestDetails.Currency, status))
+	case withdrawalFailed:
+		m.notify(fmt.Sprintf("%s withdrawal %s of %v %s failed with status %q", resp.RequestDetails.Exchange, resp.Exchange.ID, resp.RequestDetails.Amount, resp.RequestDetails.Currency, status))
+	}
+}
+
+// storeStatus stores the status of a withdrawal request
+func (m *WithdrawManager) storeStatus(id uuid.UUID, status string) {
+	if err := m.store.UpdateStatus(id.String(), status); err != nil && !errors.Is(err, database.ErrDatabaseSupportDisabled) {
+		log.Errorf(log.Global, "Withdraw manager unable to store withdrawal %s status: %s", id, err)
+	}
+}
+
+// notify logs a withdrawal event and pushes it to communications
+func (m *WithdrawManager) notify(msg string) {
+	log.Infof(log.Global, "Withdraw manager %s", msg)
+	if m.commsManager != nil {
+		m.commsManager.PushEvent(base.Event{Type: "withdrawal", Message: msg})
+	}
+}
+
+// withdrawalStateFromStatus returns whether a withdrawal has completed or
+// failed from the status returned by an exchange. Statuses which are not known
+// for the exchange are derived from their text and return false
+func withdrawalStateFromStatus(exchName, status string) (withdrawalState, bool) {
+	if state, ok := withdrawalStatuses[strings.ToLower(exchName)][strings.ToLower(status)]; ok {
+		return state, true
+	}
+	return withdrawalStateFromStatusText(status), false
+}
+
+// withdrawalStateFromStatusText derives whether a withdrawal has completed or
+// failed from the text of a status
+func withdrawalStateFromStatusText(status string) withdrawalState {
+	s := strings.ToLower(status)
+	for _, f := range []string{"fail", "reject", "cancel", "error", "refus", "expire", "invalid", "revoke"} {
+		if strings.Contains(s, f) {
+			return withdrawalFailed
+		}
+	}
+	for _, c := range []string{"complete", "success", "confirmed", "done", "finish", "credited", "sent"} {
+		if strings.Contains(s, c) {
+			return withdrawalCompleted
+		}
+	}
+	return withdrawalInProgress
+}
+
+// String implements the fmt.Stringer interface
+func (s withdrawalState) String() string {
+	switch s {
+	case withdrawalCompleted:
+		return "completed"
+	case withdrawalFailed:
+		return "failed"
+	default:
+		return "in progress"
+	}
+}
+
+// response returns a withdrawal response for a pending withdrawal
+func (p *PendingWithdrawal) response() *withdraw.Response {
+	return &withdraw.Response{
+		ID: p.ID,
+		Exchange: withdraw.ExchangeResponse{
+			Name:   p.Request.Exchange,
+			Status: WithdrawalStatusPendingApproval,
+		},
+		RequestDetails: p.Request,
+		CreatedAt:      p.CreatedAt,
+		UpdatedAt:      p.CreatedAt,
+	}
+}
+
+// approval returns the approval state of a pending withdrawal for storage
+func (p *PendingWithdrawal) approval() *dbwithdraw.Approval {
+	a := &dbwithdraw.Approval{
+		Requester:         p.Requester,
+		RequiredApprovals: p.RequiredApprovals,
+		Approvals:         make([]dbwithdraw.Approver, len(p.Approvals)),
+		ExpiresAt:         p.ExpiresAt,
+		Request:           p.Request,
+	}
+	for i := range p.Approvals {
+		a.Approvals[i] = dbwithdraw.Approver{Name: p.Approvals[i].Approver, Time: p.Approvals[i].Time}
+	}
+	return a
+}
+
+// pendingFromRecord returns a pending withdrawal from a stored withdrawal
+// request awaiting approval
+func pendingFromRecord(r *dbwithdraw.Record) *PendingWithdrawal {
+	p := &PendingWithdrawal{
+		ID:                r.ID,
+		Request:           r.Approval.Request,
+		Requester:         r.Approval.Requester,
+		Approvals:         make([]WithdrawalApproval, len(r.Approval.Approvals)),
+		RequiredApprovals: r.Approval.RequiredApprovals,
+		CreatedAt:         r.CreatedAt,
+		ExpiresAt:         r.Approval.ExpiresAt,
+	}
+	for i := range r.Approval.Approvals {
+		p.Approvals[i] = WithdrawalApproval{Approver: r.Approval.Approvals[i].Name, Time: r.Approval.Approvals[i].Time}
+	}
+	return p
+}
+
 // WithdrawalEventByID returns a withdrawal request by ID
 func (m *WithdrawManager) WithdrawalEventByID(id string) (*withdraw.Response, error) {
 	if m == nil {
@@ -151,3 +758,29 @@ func (m *WithdrawManager) WithdrawalEventByExchangeID(exchange, id string) (*wit
 
 	return dbwithdraw.GetEventByExchangeID(exchange, id)
 }
+
+// AddPending stores a withdrawal request awaiting approval
+func (dbWithdrawalStore) AddPending(res *withdraw.Response, approval *dbwithdraw.Approval) error {
+	return dbwithdraw.AddPending(res, approval)
+}
+
+// UpdateApproval stores the approval state of a withdrawal request
+func (dbWithdrawalStore) UpdateApproval(id string, approval *dbwithdraw.Approval) error {
+	return dbwithdraw.UpdateApproval(id, approval)
+}
+
+// UpdateStatus stores the status of a withdrawal request
+func (dbWithdrawalStore) UpdateStatus(id, status string) error {
+	return dbwithdraw.UpdateStatus(id, status)
+}
+
+// UpdateExchangeResponse stores the exchange response of an approved
+// withdrawal request
+func (dbWithdrawalStore) UpdateExchangeResponse(id string, res *withdraw.ExchangeResponse) error {
+	return dbwithdraw.UpdateExchangeResponse(id, res)
+}
+
+// GetRecordsSince returns withdrawal requests stored since the start time
+func (dbWithdrawalStore) GetRecordsSince(start time.Time) ([]*dbwithdraw.Record, error) {
+	return dbwithdraw.GetRecordsSince(start)
+}
@@ -1,16 +1,25 @@
 package engine
 
 import (
+	"context"
+	"slices"
 	"sync"
 	"testing"
 	"time"
 
+	"github.com/gofrs/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	dbwithdraw "github.com/thrasher-corp/gocryptotrader/database/repository/withdraw"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/currencystate"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/okx"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/sharedtestvalues"
 	"github.com/thrasher-corp/gocryptotrader/portfolio"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/banking"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
@@ -46,7 +55,7 @@ func withdrawManagerTestHelper(t *testing.T) (*ExchangeManager, *portfolioManage
 func TestSubmitWithdrawal(t *testing.T) {
 	t.Parallel()
 	em, pm := withdrawManagerTestHelper(t)
-	m, err := SetupWithdrawManager(em, pm, false)
+	m, err := SetupWithdrawManager(em, pm, nil, nil, false)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -119,7 +128,7 @@ func TestSubmitWithdrawal(t *testing.T) {
 func TestWithdrawEventByID(t *testing.T) {
 	t.Parallel()
 	em, pm := withdrawManagerTestHelper(t)
-	m, err := SetupWithdrawManager(em, pm, false)
+	m, err := SetupWithdrawManager(em, pm, nil, nil, false)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -141,7 +150,7 @@ func TestWithdrawEventByID(t *testing.T) {
 func TestWithdrawalEventByExchange(t *testing.T) {
 	t.Parallel()
 	em, pm := withdrawManagerTestHelper(t)
-	m, err := SetupWithdrawManager(em, pm, false)
+	m, err := SetupWithdrawManager(em, pm, nil, nil, false)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -156,7 +165,7 @@ func TestWithdrawalEventByExchange(t *testing.T) {
 func TestWithdrawEventByDate(t *testing.T) {
 	t.Parallel()
 	em, pm := withdrawManagerTestHelper(t)
-	m, err := SetupWithdrawManager(em, pm, false)
+	m, err := SetupWithdrawManager(em, pm, nil, nil, false)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -171,7 +180,7 @@ func TestWithdrawEventByDate(t *testing.T) {
 func TestWithdrawalEventByExchangeID(t *testing.T) {
 	t.Parallel()
 	em, _ := withdrawManagerTestHelper(t)
-	m, err := SetupWithdrawManager(em, nil, false)
+	m, err := SetupWithdrawManager(em, nil, nil, nil, false)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -182,3 +191,489 @@ func TestWithdrawalEventByExchangeID(t *testing.T) {
 	_, err = m.WithdrawalEventByExchangeID("xxx", "xxx")
 	assert.ErrorIs(t, err, ErrExchangeNotFound)
 }
+
+type withdrawTestExchange struct {
+	sharedtestvalues.CustomEx
+	m       sync.Mutex
+	history []exchange.WithdrawalHistory
+	assets  []asset.Item
+}
+
+func (w *withdrawTestExchange) WithdrawCryptocurrencyFunds(_ context.Context, req *withdraw.Request) (*withdraw.ExchangeResponse, error) {
+	if req.Amount == 1.5 {
+		return nil, errExpectedTestError
+	}
+	return &withdraw.ExchangeResponse{ID: "1337", Status: "processing"}, nil
+}
+
+func (w *withdrawTestExchange) GetWithdrawalsHistory(_ context.Context, _ currency.Code, a asset.Item) ([]exchange.WithdrawalHistory, error) {
+	w.m.Lock()
+	defer w.m.Unlock()
+	w.assets = append(w.assets, a)
+	return slices.Clone(w.history), nil
+}
+
+type withdrawTestStore struct {
+	m         sync.Mutex
+	pending   map[string]*dbwithdraw.Approval
+	responses map[string]withdraw.ExchangeResponse
+	statuses  []string
+	records   []*dbwithdraw.Record
+}
+
+func (s *withdrawTestStore) AddPending(res *withdraw.Response, approval *dbwithdraw.Approval) error {
+	s.m.Lock()
+	defer s.m.Unlock()
+	if s.pending == nil {
+		s.pending = make(map[string]*dbwithdraw.Approval)
+	}
+	s.pending[res.ID.String()] = approval
+	return nil
+}
+
+func (s *withdrawTestStore) UpdateApproval(id string, approval *dbwithdraw.Approval) error {
+	s.m.Lock()
+	defer s.m.Unlock()
+	s.pending[id] = approval
+	return nil
+}
+
+func (s *withdrawTestStore) UpdateStatus(id, status string) error {
+	s.m.Lock()
+	defer s.m.Unlock()
+	s.statuses = append(s.statuses, id+":"+status)
+	return nil
+}
+
+func (s *withdrawTestStore) UpdateExchangeResponse(id string, res *withdraw.ExchangeResponse) error {
+	s.m.Lock()
+	defer s.m.Unlock()
+	if s.responses == nil {
+		s.responses = make(map[string]withdraw.ExchangeResponse)
+	}
+	s.responses[id] = *res
+	return nil
+}
+
+func (s *withdrawTestStore) GetRecordsSince(time.Time) ([]*dbwithdraw.Record, error) {
+	s.m.Lock()
+	defer s.m.Unlock()
+	if len(s.records) == 0 {
+		return nil, common.ErrNoResults
+	}
+	return s.records, nil
+}
+
+type withdrawTestPortfolio struct {
+	whitelisted bool
+}
+
+func (withdrawTestPortfolio) GetPortfolioSummary() portfolio.Summary { return portfolio.Summary{} }
+
+func (p *withdrawTestPortfolio) IsWhiteListed(string) bool { return p.whitelisted }
+
+func (p *withdrawTestPortfolio) IsExchangeSupported(string, string) bool { return p.whitelisted }
+
+type withdrawTestComms struct {
+	m      sync.Mutex
+	events []base.Event
+}
+
+func (c *withdrawTestComms) PushEvent(evt base.Event) {
+	c.m.Lock()
+	c.events = append(c.events, evt)
+	c.m.Unlock()
+}
+
+func (c *withdrawTestComms) PushEventTo(_ string, evt base.Event) error {
+	c.PushEvent(evt)
+	return nil
+}
+
+func withdrawApprovalTestHelper(t *testing.T, cfg *config.WithdrawManager) (*WithdrawManager, *withdrawTestExchange, *withdrawTestPortfolio, *withdrawTestComms) {
+	t.Helper()
+	exch := &withdrawTestExchange{}
+	exch.States = currencystate.NewCurrencyStates()
+	em := NewExchangeManager()
+	require.NoError(t, em.Add(exch), "Add must not error")
+	pm := &withdrawTestPortfolio{whitelisted: true}
+	cm := &withdrawTestComms{}
+	m, err := SetupWithdrawManager(em, pm, cm, cfg, false)
+	require.NoError(t, err, "SetupWithdrawManager must not error")
+	return m, exch, pm, cm
+}
+
+func TestSetupWithdrawManager(t *testing.T) {
+	t.Parallel()
+	_, err := SetupWithdrawManager(nil, nil, nil, nil, false)
+	assert.Error(t, err, "SetupWithdrawManager should error without an exchange manager")
+
+	em := NewExchangeManager()
+	_, err = SetupWithdrawManager(em, nil, nil, &config.WithdrawManager{RequiredApprovals: -1}, false)
+	assert.ErrorIs(t, err, errInvalidWithdrawLimit)
+	_, err = SetupWithdrawManager(em, nil, nil, &config.WithdrawManager{ApprovalThresholds: map[string]float64{"btc": -1}}, false)
+	assert.ErrorIs(t, err, errInvalidWithdrawLimit)
+	_, err = SetupWithdrawManager(em, nil, nil, &config.WithdrawManager{DailyLimits: map[string]float64{"btc": 0}}, false)
+	assert.ErrorIs(t, err, errInvalidWithdrawLimit)
+
+	m, err := SetupWithdrawManager(em, nil, nil, &config.WithdrawManager{
+		RequiredApprovals:  2,
+		ApprovalThresholds: map[string]float64{"btc": 1},
+		DailyLimits:        map[string]float64{"eth": 10},
+	}, false)
+	require.NoError(t, err, "SetupWithdrawManager must not error")
+	assert.Equal(t, 2, m.requiredApprovals)
+	assert.Equal(t, map[string]float64{"BTC": 1}, m.approvalThresholds, "currencies should be upper cased")
+	assert.Equal(t, map[string]float64{"ETH": 10}, m.dailyLimits, "currencies should be upper cased")
+	assert.Equal(t, defaultWithdrawApprovalExpiry, m.approvalExpiry)
+	assert.Equal(t, defaultWithdrawStatusTrackerInterval, m.trackerInterval)
+}
+
+func TestWithdrawManagerStartStop(t *testing.T) {
+	t.Parallel()
+	var m *WithdrawManager
+	assert.False(t, m.IsRunning())
+	assert.ErrorIs(t, m.Start(), ErrNilSubsystem)
+	assert.ErrorIs(t, m.Stop(), ErrNilSubsystem)
+
+	m, _, _, _ = withdrawApprovalTestHelper(t, nil)
+	assert.ErrorIs(t, m.Stop(), ErrSubSystemNotStarted)
+	require.NoError(t, m.Start(), "Start must not error")
+	assert.True(t, m.IsRunning())
+	assert.ErrorIs(t, m.Start(), ErrSubSystemAlreadyStarted)
+	require.NoError(t, m.Stop(), "Stop must not error")
+	assert.False(t, m.IsRunning())
+}
+
+func TestWithdrawalApprovals(t *testing.T) {
+	t.Parallel()
+	m, _, pm, cm := withdrawApprovalTestHelper(t, &config.WithdrawManager{
+		RequiredApprovals:  2,
+		ApprovalThresholds: map[string]float64{"BTC": 1},
+	})
+	req := &withdraw.Request{
+		Exchange: "customex",
+		Currency: currency.BTC,
+		Amount:   0.5,
+		Type:     withdraw.Crypto,
+		Crypto:   withdraw.CryptoRequest{Address: "1337"},
+	}
+	resp, err := m.SubmitWithdrawal(t.Context(), req)
+	require.NoError(t, err, "SubmitWithdrawal must not error")
+	assert.Equal(t, "processing", resp.Exchange.Status, "withdrawals below the threshold should be submitted")
+
+	req.Amount = 2
+	requester := context.WithValue(t.Context(), rpcPrincipalKey{}, &rpcPrincipal{name: "dave", scopes: []string{RPCScopeWithdraw}})
+	resp, err = m.SubmitWithdrawal(requester, req)
+	require.NoError(t, err, "SubmitWithdrawal must not error")
+	assert.Equal(t, WithdrawalStatusPendingApproval, resp.Exchange.Status)
+	id := resp.ID.String()
+
+	pending, err := m.GetPendingWithdrawals()
+	require.NoError(t, err, "GetPendingWithdrawals must not error")
+	require.Len(t, pending, 1)
+	assert.Equal(t, resp.ID, pending[0].ID)
+	assert.Equal(t, 2, pending[0].RequiredApprovals)
+	assert.Equal(t, "dave", pending[0].Requester)
+
+	_, err = m.ApproveWithdrawal(t.Context(), id, "Dave")
+	assert.ErrorIs(t, err, errWithdrawalSelfApproval, "requesters should not approve their own withdrawals")
+
+	_, err = m.ApproveWithdrawal(t.Context(), id, "")
+	assert.ErrorIs(t, err, errApproverUnset)
+	_, err = m.ApproveWithdrawal(t.Context(), "bad", "alice")
+	assert.ErrorIs(t, err, ErrWithdrawRequestNotFound)
+	_, err = m.ApproveWithdrawal(t.Context(), withdraw.DryRunID.String(), "alice")
+	assert.ErrorIs(t, err, ErrWithdrawRequestNotFound)
+
+	resp, err = m.ApproveWithdrawal(t.Context(), id, "alice")
+	require.NoError(t, err, "ApproveWithdrawal must not error")
+	assert.Equal(t, WithdrawalStatusPendingApproval, resp.Exchange.Status)
+	_, err = m.ApproveWithdrawal(t.Context(), id, "ALICE")
+	assert.ErrorIs(t, err, errWithdrawalAlreadyApproved)
+
+	pm.whitelisted = false
+	_, err = m.ApproveWithdrawal(t.Context(), id, "bob")
+	assert.ErrorIs(t, err, withdraw.ErrStrAddressNotWhiteListed, "approved withdrawals should be validated again")
+	pm.whitelisted = true
+
+	resp, err = m.SubmitWithdrawal(t.Context(), req)
+	require.NoError(t, err, "SubmitWithdrawal must not error")
+	id = resp.ID.String()
+	_, err = m.ApproveWithdrawal(t.Context(), id, "alice")
+	require.NoError(t, err, "ApproveWithdrawal must not error")
+	resp, err = m.ApproveWithdrawal(t.Context(), id, "bob")
+	require.NoError(t, err, "ApproveWithdrawal must not error")
+	assert.Equal(t, "processing", resp.Exchange.Status, "approved withdrawals should be submitted")
+	assert.Equal(t, "1337", resp.Exchange.ID)
+	_, err = m.ApproveWithdrawal(t.Context(), id, "carol")
+	assert.ErrorIs(t, err, ErrWithdrawRequestNotFound)
+
+	resp, err = m.SubmitWithdrawal(t.Context(), req)
+	require.NoError(t, err, "SubmitWithdrawal must not error")
+	assert.ErrorIs(t, m.RejectWithdrawal(resp.ID.String(), "", ""), errApproverUnset)
+	require.NoError(t, m.RejectWithdrawal(resp.ID.String(), "alice", "unknown recipient"), "RejectWithdrawal must not error")
+	assert.ErrorIs(t, m.RejectWithdrawal(resp.ID.String(), "alice", ""), ErrWithdrawRequestNotFound)
+	cm.m.Lock()
+	require.NotEmpty(t, cm.events)
+	assert.Contains(t, cm.events[len(cm.events)-1].Message, "rejected by alice: unknown recipient")
+	cm.m.Unlock()
+
+	resp, err = m.SubmitWithdrawal(t.Context(), req)
+	require.NoError(t, err, "SubmitWithdrawal must not error")
+	m.m.Lock()
+	m.pending[resp.ID].ExpiresAt = time.Now().Add(-time.Minute)
+	m.m.Unlock()
+	_, err = m.ApproveWithdrawal(t.Context(), resp.ID.String(), "alice")
+	assert.ErrorIs(t, err, errWithdrawalApprovalExpired)
+
+	pending, err = m.GetPendingWithdrawals()
+	require.NoError(t, err, "GetPendingWithdrawals must not error")
+	assert.Empty(t, pending)
+
+	_, err = (*WithdrawManager)(nil).GetPendingWithdrawals()
+	assert.ErrorIs(t, err, ErrNilSubsystem)
+	_, err = (*WithdrawManager)(nil).ApproveWithdrawal(t.Context(), id, "alice")
+	assert.ErrorIs(t, err, ErrNilSubsystem)
+	assert.ErrorIs(t, (*WithdrawManager)(nil).RejectWithdrawal(id, "alice", ""), ErrNilSubsystem)
+}
+
+func TestWithdrawDailyLimits(t *testing.T) {
+	t.Parallel()
+	m, _, _, _ := withdrawApprovalTestHelper(t, &config.WithdrawManager{
+		RequiredApprovals:  1,
+		ApprovalThresholds: map[string]float64{"BTC": 2},
+		DailyLimits:        map[string]float64{"BTC": 4},
+	})
+	req := &withdraw.Request{
+		Exchange: "customex",
+		Currency: currency.BTC,
+		Amount:   1,
+		Type:     withdraw.Crypto,
+		Crypto:   withdraw.CryptoRequest{Address: "1337"},
+	}
+	_, err := m.SubmitWithdrawal(t.Context(), req)
+	require.NoError(t, err, "SubmitWithdrawal must not error")
+
+	req.Amount = 1.5
+	_, err = m.SubmitWithdrawal(t.Context(), req)
+	assert.ErrorIs(t, err, errExpectedTestError)
+	assert.Equal(t, 1.0, m.dailyUsage["BTC"], "failed withdrawals should not count towards the daily limit")
+
+	req.Amount = 3
+	resp, err := m.SubmitWithdrawal(t.Context(), req)
+	require.NoError(t, err, "SubmitWithdrawal must not error")
+	assert.Equal(t, WithdrawalStatusPendingApproval, resp.Exchange.Status)
+	assert.Equal(t, 4.0, m.dailyUsage["BTC"], "pending withdrawals should count towards the daily limit")
+
+	req.Amount = 0.5
+	_, err = m.SubmitWithdrawal(t.Context(), req)
+	assert.ErrorIs(t, err, errWithdrawDailyLimitExceeded)
+
+	require.NoError(t, m.RejectWithdrawal(resp.ID.String(), "alice", ""), "RejectWithdrawal must not error")
+	assert.Equal(t, 1.0, m.dailyUsage["BTC"], "rejected withdrawals should not count towards the daily limit")
+
+	req.Currency = currency.ETH
+	req.Amount = 100
+	m.requiredApprovals = 0
+	_, err = m.SubmitWithdrawal(t.Context(), req)
+	require.NoError(t, err, "SubmitWithdrawal must not error for currencies without a limit")
+
+	m.m.Lock()
+	m.usageDay = m.usageDay.AddDate(0, 0, -1)
+	m.dailyUsage["BTC"] = 4
+	m.m.Unlock()
+	req.Currency = currency.BTC
+	req.Amount = 4
+	_, err = m.SubmitWithdrawal(t.Context(), req)
+	require.NoError(t, err, "SubmitWithdrawal must not error on a new day")
+}
+
+func TestTrackWithdrawals(t *testing.T) {
+	t.Parallel()
+	m, exch, _, cm := withdrawApprovalTestHelper(t, nil)
+	store := &withdrawTestStore{}
+	m.store = store
+	m.trackWithdrawals(t.Context())
+
+	req := &withdraw.Request{
+		Exchange: "customex",
+		Currency: currency.BTC,
+		Amount:   1,
+		Type:     withdraw.Crypto,
+		Crypto:   withdraw.CryptoRequest{Address: "1337"},
+	}
+	_, err := m.SubmitWithdrawal(t.Context(), req)
+	require.NoError(t, err, "SubmitWithdrawal must not error")
+	assert.Equal(t, asset.Spot, req.Asset, "SubmitWithdrawal should default the asset to spot")
+	require.Len(t, m.tracked, 1, "submitted withdrawals must be tracked")
+	id, err := uuid.NewV4()
+	require.NoError(t, err, "NewV4 must not error")
+	m.tracked[0].ID = id
+	m.tracked[0].RequestDetails.Asset = asset.Margin
+
+	m.trackWithdrawals(t.Context())
+	assert.Empty(t, store.statuses, "withdrawals missing from the exchange history should not change")
+	exch.m.Lock()
+	assert.Equal(t, []asset.Item{asset.Margin}, exch.assets, "withdrawal history should be requested for the withdrawal asset")
+	exch.m.Unlock()
+
+	exch.m.Lock()
+	exch.history = []exchange.WithdrawalHistory{{TransferID: "1337", Status: "confirming"}}
+	exch.m.Unlock()
+	m.trackWithdrawals(t.Context())
+	assert.Equal(t, []string{id.String() + ":confirming"}, store.statuses)
+	require.Len(t, m.tracked, 1, "in progress withdrawals must be tracked")
+	assert.Equal(t, "confirming", m.tracked[0].Exchange.Status)
+
+	m.trackWithdrawals(t.Context())
+	assert.Len(t, store.statuses, 1, "unchanged statuses should not be stored")
+
+	exch.m.Lock()
+	exch.history[0].Status = "Completed"
+	exch.m.Unlock()
+	m.trackWithdrawals(t.Context())
+	assert.Equal(t, []string{id.String() + ":confirming", id.String() + ":Completed"}, store.statuses)
+	assert.Empty(t, m.tracked, "completed withdrawals should no longer be tracked")
+	cm.m.Lock()
+	require.NotEmpty(t, cm.events)
+	assert.Contains(t, cm.events[len(cm.events)-1].Message, "completed")
+	cm.m.Unlock()
+}
+
+func TestWithdrawalStorage(t *testing.T) {
+	t.Parallel()
+	m, _, _, _ := withdrawApprovalTestHelper(t, &config.WithdrawManager{RequiredApprovals: 2})
+	store := &withdrawTestStore{}
+	m.store = store
+	req := &withdraw.Request{
+		Exchange: "customex",
+		Currency: currency.BTC,
+		Amount:   2,
+		Type:     withdraw.Crypto,
+		Crypto:   withdraw.CryptoRequest{Address: "1337"},
+	}
+	requester := context.WithValue(t.Context(), rpcPrincipalKey{}, &rpcPrincipal{name: "dave", scopes: []string{RPCScopeWithdraw}})
+	resp, err := m.SubmitWithdrawal(requester, req)
+	require.NoError(t, err, "SubmitWithdrawal must not error")
+	id := resp.ID.String()
+	require.Contains(t, store.pending, id, "pending withdrawals must be stored")
+	assert.Equal(t, "dave", store.pending[id].Requester)
+	assert.Equal(t, 2, store.pending[id].RequiredApprovals)
+
+	_, err = m.ApproveWithdrawal(t.Context(), id, "alice")
+	require.NoError(t, err, "ApproveWithdrawal must not error")
+	require.Len(t, store.pending[id].Approvals, 1, "approvals must be stored")
+	assert.Equal(t, "alice", store.pending[id].Approvals[0].Name)
+
+	resp, err = m.ApproveWithdrawal(t.Context(), id, "bob")
+	require.NoError(t, err, "ApproveWithdrawal must not error")
+	assert.Equal(t, id, resp.ID.String(), "approved withdrawals should keep their pending ID")
+	assert.Equal(t, withdraw.ExchangeResponse{Name: "customex", ID: "1337", Status: "processing"}, store.responses[id], "approved withdrawals should update their stored request")
+
+	resp, err = m.SubmitWithdrawal(t.Context(), req)
+	require.NoError(t, err, "SubmitWithdrawal must not error")
+	require.NoError(t, m.RejectWithdrawal(resp.ID.String(), "alice", ""), "RejectWithdrawal must not error")
+	assert.Equal(t, []string{resp.ID.String() + ":" + WithdrawalStatusRejected}, store.statuses)
+}
+
+func TestLoadWithdrawals(t *testing.T) {
+	t.Parallel()
+	m, _, _, _ := withdrawApprovalTestHelper(t, &config.WithdrawManager{
+		RequiredApprovals: 2,
+		DailyLimits:       map[string]float64{"BTC": 10},
+	})
+	now := time.Now()
+	newRecord := func(status, exchangeID string, amount float64, created time.Time) *dbwithdraw.Record {
+		id, err := uuid.NewV4()
+		require.NoError(t, err, "NewV4 must not error")
+		return &dbwithdraw.Record{Response: &withdraw.Response{
+			ID:       id,
+			Exchange: withdraw.ExchangeResponse{Name: "customex", ID: exchangeID, Status: status},
+			RequestDetails: withdraw.Request{
+				Currency: currency.BTC,
+				Amount:   amount,
+				Type:     withdraw.Crypto,
+				Asset:    asset.Margin,
+			},
+			CreatedAt: created,
+		}}
+	}
+	pending := newRecord(WithdrawalStatusPendingApproval, "", 1, now)
+	pending.Approval = &dbwithdraw.Approval{
+		Requester:         "dave",
+		RequiredApprovals: 2,
+		Approvals:         []dbwithdraw.Approver{{Name: "alice", Time: now}},
+		ExpiresAt:         now.Add(time.Hour),
+		Request:           withdraw.Request{Exchange: "customex", Currency: currency.BTC, Amount: 1, Type: withdraw.Crypto, Crypto: withdraw.CryptoRequest{Address: "1337"}},
+	}
+	expired := newRecord(WithdrawalStatusPendingApproval, "", 1, now)
+	expired.Approval = &dbwithdraw.Approval{RequiredApprovals: 2, ExpiresAt: now.Add(-time.Minute)}
+	inProgress := newRecord("processing", "1337", 2, now)
+	completed := newRecord("completed", "1338", 3, now)
+	failed := newRecord("failed", "1339", 4, now)
+	unsubmitted := newRecord("insufficient funds", "", 5, now)
+	yesterday := newRecord("processing", "1340", 6, now.AddDate(0, 0, -1))
+	store := &withdrawTestStore{records: []*dbwithdraw.Record{pending, expired, inProgress, completed, failed, unsubmitted, yesterday}}
+	m.store = store
+
+	require.NoError(t, m.Start(), "Start must not error")
+	require.NoError(t, m.Stop(), "Stop must not error")
+
+	require.Len(t, m.pending, 1, "only unexpired withdrawals awaiting approval must be pending")
+	p := m.pending[pending.ID]
+	require.NotNil(t, p, "pending withdrawal must be restored")
+	assert.Equal(t, "dave", p.Requester)
+	assert.Equal(t, []WithdrawalApproval{{Approver: "alice", Time: now}}, p.Approvals)
+	assert.Equal(t, "1337", p.Request.Crypto.Address)
+	assert.Equal(t, []string{expired.ID.String() + ":" + WithdrawalStatusApprovalExpired}, store.statuses, "expired withdrawals should be stored as expired")
+
+	require.Len(t, m.tracked, 2, "in progress withdrawals must be tracked")
+	assert.Equal(t, inProgress.ID, m.tracked[0].ID)
+	assert.Equal(t, "customex", m.tracked[0].RequestDetails.Exchange, "tracked withdrawals should have their exchange set")
+	assert.Equal(t, asset.Margin, m.tracked[0].RequestDetails.Asset)
+	assert.Equal(t, yesterday.ID, m.tracked[1].ID)
+	assert.Equal(t, 6.0, m.dailyUsage["BTC"], "daily usage should include today's pending and successful withdrawals")
+
+	_, err := m.ApproveWithdrawal(t.Context(), pending.ID.String(), "dave")
+	assert.ErrorIs(t, err, errWithdrawalSelfApproval, "restored requesters should not approve their own withdrawals")
+	_, err = m.ApproveWithdrawal(t.Context(), pending.ID.String(), "alice")
+	assert.ErrorIs(t, err, errWithdrawalAlreadyApproved, "restored approvals should count")
+
+	require.NoError(t, m.Start(), "Start must not error")
+	require.NoError(t, m.Stop(), "Stop must not error")
+	assert.Len(t, m.pending, 1, "restarting should not duplicate pending withdrawals")
+	assert.Len(t, m.tracked, 2, "restarting should not duplicate tracked withdrawals")
+}
+
+func TestWithdrawalStateFromStatus(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		exchange, status string
+		exp              withdrawalState
+		known            bool
+	}{
+		{"Binance", "6", withdrawalCompleted, true},
+		{"Binance", "4", withdrawalInProgress, true},
+		{"Binance", "5", withdrawalFailed, true},
+		{"Binanceus", "1", withdrawalFailed, true},
+		{"Bitstamp", "2", withdrawalCompleted, true},
+		{"Bybit", "CancelByUser", withdrawalFailed, true},
+		{"Deribit", "confirmed", withdrawalInProgress, true},
+		{"Huobi", "confirmed", withdrawalCompleted, true},
+		{"GateIO", "DONE", withdrawalCompleted, true},
+		{"Okx", "-2", withdrawalFailed, true},
+		{"Okx", "2", withdrawalCompleted, true},
+		{"customex", "", withdrawalInProgress, false},
+		{"customex", "processing", withdrawalInProgress, false},
+		{"customex", "COMPLETED", withdrawalCompleted, false},
+		{"customex", "success", withdrawalCompleted, false},
+		{"customex", "Cancelled", withdrawalFailed, false},
+		{"customex", "rejected by review", withdrawalFailed, false},
+		{"Binance", "Completed", withdrawalCompleted, false},
+	} {
+		state, known := withdrawalStateFromStatus(tc.exchange, tc.status)
+		assert.Equalf(t, tc.exp, state, "withdrawalStateFromStatus should return the correct state for %s %q", tc.exchange, tc.status)
+		assert.Equalf(t, tc.known, known, "withdrawalStateFromStatus should return whether %s %q is known", tc.exchange, tc.status)
+	}
+}
@@ -2,15 +2,255 @@ package engine
 
 import (
 	"errors"
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid"
+	dbwithdraw "github.com/thrasher-corp/gocryptotrader/database/repository/withdraw"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
+)
+
+const (
+	// WithdrawManagerName is an exported subsystem name
+	WithdrawManagerName = "withdraw_manager"
+
+	// WithdrawalStatusPendingApproval is the status of a withdrawal awaiting
+	// approval before it is submitted to an exchange
+	WithdrawalStatusPendingApproval = "pending approval"
+	// WithdrawalStatusRejected is the status of a withdrawal rejected while
+	// awaiting approval
+	WithdrawalStatusRejected = "rejected"
+	// WithdrawalStatusApprovalExpired is the status of a withdrawal which was
+	// not approved in time
+	WithdrawalStatusApprovalExpired = "approval expired"
+
+	defaultWithdrawApprovalExpiry        = 24 * time.Hour
+	defaultWithdrawStatusTrackerInterval = time.Minute
+	// withdrawalReloadPeriod is how far back submitted withdrawals are
+	// reloaded for status tracking on start
+	withdrawalReloadPeriod = 7 * 24 * time.Hour
 )
 
 // ErrWithdrawRequestNotFound message to display when no record is found
 var ErrWithdrawRequestNotFound = errors.New("request not found")
 
+var (
+	errApproverUnset              = errors.New("approver unset")
+	errWithdrawalAlreadyApproved  = errors.New("withdrawal already approved by approver")
+	errWithdrawalSelfApproval     = errors.New("withdrawal cannot be approved by its requester")
+	errWithdrawalApprovalExpired  = errors.New("withdrawal approval expired")
+	errWithdrawDailyLimitExceeded = errors.New("withdrawal daily limit exceeded")
+	errInvalidWithdrawLimit       = errors.New("invalid withdrawal limit")
+)
+
+// withdrawalState is the outcome of a withdrawal derived from the status
+// returned by an exchange
+type withdrawalState uint8
+
+const (
+	withdrawalInProgress withdrawalState = iota
+	withdrawalCompleted
+	withdrawalFailed
+)
+
+// binanceWithdrawalStatuses are the numeric withdrawal statuses shared by
+// Binance and Binance US
+var binanceWithdrawalStatuses = map[string]withdrawalState{
+	"0": withdrawalInProgress, // Email sent
+	"1": withdrawalFailed,     // Cancelled
+	"2": withdrawalInProgress, // Awaiting approval
+	"3": withdrawalFailed,     // Rejected
+	"4": withdrawalInProgress, // Processing
+	"5": withdrawalFailed,     // Failure
+	"6": withdrawalCompleted,  // Completed
+}
+
+// withdrawalStatuses maps the lower cased withdrawal statuses returned by each
+// exchange's withdrawal history to their state, keyed by lower cased exchange
+// name
+var withdrawalStatuses = map[string]map[string]withdrawalState{
+	"binance":   binanceWithdrawalStatuses,
+	"binanceus": binanceWithdrawalStatuses,
+	"bitfinex": {
+		"pending":     withdrawalInProgress,
+		"processing":  withdrawalInProgress,
+		"unconfirmed": withdrawalInProgress,
+		"completed":   withdrawalCompleted,
+		"canceled":    withdrawalFailed,
+	},
+	"bitmex": {
+		"pending":   withdrawalInProgress,
+		"completed": withdrawalCompleted,
+		"canceled":  withdrawalFailed,
+	},
+	"bitstamp": {
+		"0": withdrawalInProgress, // Open
+		"1": withdrawalInProgress, // In process
+		"2": withdrawalCompleted,  // Finished
+		"3": withdrawalFailed,     // Cancelled
+		"4": withdrawalFailed,     // Failed
+	},
+	"btc markets": {
+		"accepted":              withdrawalInProgress,
+		"pending authorization": withdrawalInProgress,
+		"complete":              withdrawalCompleted,
+		"cancelled":             withdrawalFailed,
+		"failed":                withdrawalFailed,
+	},
+	"bybit": {
+		"securitycheck":           withdrawalInProgress,
+		"pending":                 withdrawalInProgress,
+		"blockchainconfirmed":     withdrawalInProgress,
+		"moreinformationrequired": withdrawalInProgress,
+		"unknown":                 withdrawalInProgress,
+		"success":                 withdrawalCompleted,
+		"cancelbyuser":            withdrawalFailed,
+		"reject":                  withdrawalFailed,
+		"fail":                    withdrawalFailed,
+	},
+	"coinbase": {
+		"pending":               withdrawalInProgress,
+		"waiting_for_clearing":  withdrawalInProgress,
+		"waiting_for_signature": withdrawalInProgress,
+		"completed":             withdrawalCompleted,
+		"canceled":              withdrawalFailed,
+		"expired":               withdrawalFailed,
+		"failed":                withdrawalFailed,
+	},
+	"deribit": {
+		"unconfirmed": withdrawalInProgress,
+		"confirmed":   withdrawalInProgress, // Confirmed by the user, not yet sent
+		"completed":   withdrawalCompleted,
+		"cancelled":   withdrawalFailed,
+		"interrupted": withdrawalFailed,
+		"rejected":    withdrawalFailed,
+	},
+	"gateio": {
+		"request": withdrawalInProgress,
+		"manual":  withdrawalInProgress,
+		"bcode":   withdrawalInProgress,
+		"extpend": withdrawalInProgress,
+		"verify":  withdrawalInProgress,
+		"proces":  withdrawalInProgress,
+		"pend":    withdrawalInProgress,
+		"dmove":   withdrawalInProgress,
+		"review":  withdrawalInProgress,
+		"done":    withdrawalCompleted,
+		"cancel":  withdrawalFailed,
+		"fail":    withdrawalFailed,
+		"invalid": withdrawalFailed,
+	},
+	"gemini": {
+		"advanced": withdrawalInProgress,
+		"complete": withdrawalCompleted,
+	},
+	"huobi": {
+		"verifying":       withdrawalInProgress,
+		"submitted":       withdrawalInProgress,
+		"reexamine":       withdrawalInProgress,
+		"pass":            withdrawalInProgress,
+		"pre-transfer":    withdrawalInProgress,
+		"wallet-transfer": withdrawalInProgress,
+		"confirmed":       withdrawalCompleted,
+		"failed":          withdrawalFailed,
+		"canceled":        withdrawalFailed,
+		"reject":          withdrawalFailed,
+		"wallet-reject":   withdrawalFailed,
+		"confirm-error":   withdrawalFailed,
+		"repealed":        withdrawalFailed,
+	},
+	"kraken": {
+		"initial": withdrawalInProgress,
+		"pending": withdrawalInProgress,
+		"settled": withdrawalInProgress,
+		"success": withdrawalCompleted,
+		"failure": withdrawalFailed,
+	},
+	"kucoin": {
+		"processing":        withdrawalInProgress,
+		"wallet_processing": withdrawalInProgress,
+		"success":           withdrawalCompleted,
+		"failure":           withdrawalFailed,
+	},
+	"lbank": {
+		"1": withdrawalInProgress, // Applying
+		"2": withdrawalFailed,     // Cancelled
+		"3": withdrawalFailed,     // Failed
+		"4": withdrawalCompleted,  // Completed
+	},
+	"okx": {
+		"-3": withdrawalInProgress, // Canceling
+		"-2": withdrawalFailed,     // Canceled
+		"-1": withdrawalFailed,     // Failed
+		"0":  withdrawalInProgress, // Waiting withdrawal
+		"1":  withdrawalInProgress, // Broadcasting
+		"2":  withdrawalCompleted,  // Success
+		"4":  withdrawalInProgress, // Waiting manual review
+		"5":  withdrawalInProgress, // Waiting manual review
+		"6":  withdrawalInProgress, // Waiting manual review
+		"7":  withdrawalInProgress, // Approved
+		"8":  withdrawalInProgress, // Waiting manual review
+		"9":  withdrawalInProgress, // Waiting manual review
+		"10": withdrawalInProgress, // Waiting transfer
+		"12": withdrawalInProgress, // Waiting manual review
+		"15": withdrawalInProgress, // Pending transaction validation
+		"16": withdrawalInProgress, // Delayed by local regulations
+		"17": withdrawalInProgress, // Pending travel rule verification
+	},
+}
+
 // WithdrawManager is responsible for performing withdrawal requests and
 // saving them to the database
 type WithdrawManager struct {
-	exchangeManager  iExchangeManager
-	portfolioManager iPortfolioManager
-	isDryRun         bool
+	exchangeManager    iExchangeManager
+	portfolioManager   iPortfolioManager
+	commsManager       iCommsManager
+	isDryRun           bool
+	requiredApprovals  int
+	approvalThresholds map[string]float64
+	approvalExpiry     time.Duration
+	dailyLimits        map[string]float64
+	trackerEnabled     bool
+	trackerInterval    time.Duration
+	verbose            bool
+	store              withdrawalStore
+
+	started  int32
+	shutdown chan struct{}
+	wg       sync.WaitGroup
+
+	m          sync.Mutex
+	pending    map[uuid.UUID]*PendingWithdrawal
+	usageDay   time.Time
+	dailyUsage map[string]float64
+	tracked    []*withdraw.Response
+}
+
+// withdrawalStore persists withdrawal requests and their approval state
+type withdrawalStore interface {
+	AddPending(*withdraw.Response, *dbwithdraw.Approval) error
+	UpdateApproval(id string, approval *dbwithdraw.Approval) error
+	UpdateStatus(id, status string) error
+	UpdateExchangeResponse(id string, res *withdraw.ExchangeResponse) error
+	GetRecordsSince(time.Time) ([]*dbwithdraw.Record, error)
+}
+
+// dbWithdrawalStore stores withdrawal requests in the database
+type dbWithdrawalStore struct{}
+
+// PendingWithdrawal is a withdrawal request awaiting approval
+type PendingWithdrawal struct {
+	ID                uuid.UUID
+	Request           withdraw.Request
+	Requester         string
+	Approvals         []WithdrawalApproval
+	RequiredApprovals int
+	CreatedAt         time.Time
+	ExpiresAt         time.Time
+}
+
+// WithdrawalApproval records who approved a pending withdrawal
+type WithdrawalApproval struct {
+	Approver string
+	Time     time.Time
 }
@@ -125,6 +125,9 @@ type connection struct {
 	ResponseMaxLimit     time.Duration
 	Traffic              chan struct{}
 	readMessageErrors    chan error
+	redirectURL          *url.URL
+	frameRecorder        FrameRecorder
+	conversation         int
 }
 
 // Dial sets proxy urls and then connects to the websocket
@@ -137,20 +140,27 @@ func (c *connection) Dial(ctx context.Context, dialer *gws.Dialer, headers http.
 		dialer.Proxy = http.ProxyURL(proxy)
 	}
 
-	var err error
+	dialURL, err := c.getDialURL()
+	if err != nil {
+		return err
+	}
+
 	var conStatus *http.Response
-	c.Connection, conStatus, err = dialer.DialContext(ctx, c.URL, headers)
+	c.Connection, conStatus, err = dialer.DialContext(ctx, dialURL, headers)
 	if err != nil {
 		if conStatus != nil {
 			_ = conStatus.Body.Close()
-			return fmt.Errorf("%s websocket connection: %v %v %v Error: %w", c.ExchangeName, c.URL, conStatus, conStatus.StatusCode, err)
+			return fmt.Errorf("%s websocket connection: %v %v %v Error: %w", c.ExchangeName, dialURL, conStatus, conStatus.StatusCode, err)
 		}
-		return fmt.Errorf("%s websocket connection: %v Error: %w", c.ExchangeName, c.URL, err)
+		return fmt.Errorf("%s websocket connection: %v Error: %w", c.ExchangeName, dialURL, err)
 	}
 	_ = conStatus.Body.Close()
 
 	if c.Verbose {
-		log.Infof(log.WebsocketMgr, "%v Websocket connected to %s\n", c.ExchangeName, c.URL)
+		log.Infof(log.WebsocketMgr, "%v Websocket connected to %s\n", c.ExchangeName, dialURL)
+	}
+	if c.frameRecorder != nil {
+		c.conversation = c.frameRecorder.StartConversation(removeURLQueryString(c.URL))
 	}
 	select {
 	case c.Traffic <- struct{}{}:
@@ -168,6 +178,13 @@ func (c *connection) SendJSONMessage(ctx context.Context, epl request.EndpointLi
 				log.Debugf(log.WebsocketMgr, "%v %v: Sending message: %v", c.ExchangeName, removeURLQueryString(c.URL), string(msg))
 			}
 		}
+		if c.frameRecorder != nil {
+			msg, err := json.Marshal(data)
+			if err != nil {
+				return err
+			}
+			return c.writeMessage(gws.TextMessage, msg)
+		}
 		return c.Connection.WriteJSON(data)
 	})
 }
@@ -178,10 +195,37 @@ func (c *connection) SendRawMessage(ctx context.Context, epl request.EndpointLim
 		if request.IsVerbose(ctx, c.Verbose) {
 			log.Debugf(log.WebsocketMgr, "%v %v: Sending message: %v", c.ExchangeName, removeURLQueryString(c.URL), string(message))
 		}
-		return c.Connection.WriteMessage(messageType, message)
+		return c.writeMessage(messageType, message)
 	})
 }
 
+// writeMessage writes a message to the connection and records it when a frame
+// recorder is set
+func (c *connection) writeMessage(messageType int, message []byte) error {
+	if err := c.Connection.WriteMessage(messageType, message); err != nil {
+		return err
+	}
+	if c.frameRecorder != nil {
+		c.frameRecorder.RecordFrame(c.conversation, true, messageType, message)
+	}
+	return nil
+}
+
+// getDialURL returns the connection URL, or the connection path and query on
+// the redirect URL's scheme and host when connections are redirected
+func (c *connection) getDialURL() (string, error) {
+	if c.redirectURL == nil {
+		return c.URL, nil
+	}
+	u, err := url.Parse(c.URL)
+	if err != nil {
+		return "", err
+	}
+	u.Scheme = c.redirectURL.Scheme
+	u.Host = c.redirectURL.Host
+	return u.String(), nil
+}
+
 func (c *connection) writeToConn(ctx context.Context, epl request.EndpointLimit, writeConn func() error) error {
 	if !c.IsConnected() {
 		return fmt.Errorf("%v websocket connection: cannot send message %w", c.ExchangeName, errWebsocketIsDisconnected)
@@ -290,6 +334,14 @@ func (c *connection) ReadMessage() Response {
 	default: // Non-Blocking write ensures 1 buffered signal per trafficCheckInterval to avoid flooding
 	}
 
+	if tr, ok := c.getReporter().(TrafficReporter); ok {
+		tr.MessageReceived(c.ExchangeName)
+	}
+
+	if c.frameRecorder != nil {
+		c.frameRecorder.RecordFrame(c.conversation, false, mType, resp)
+	}
+
 	var standardMessage []byte
 	switch mType {
 	case gws.TextMessage:
@@ -391,8 +443,8 @@ func (c *connection) SendMessageReturnResponsesWithInspector(ctx context.Context
 		return nil, err
 	}
 
-	if c.Reporter != nil {
-		c.Reporter.Latency(c.ExchangeName, outbound, time.Since(start))
+	if r := c.getReporter(); r != nil {
+		r.Latency(c.ExchangeName, outbound, time.Since(start))
 	}
 
 	return resps, err
@@ -471,3 +523,12 @@ func (c *connection) RequireMatchWithData(signature any, incoming []byte) error
 func (c *connection) IncomingWithData(signature any, data []byte) bool {
 	return c.Match.IncomingWithData(signature, data)
 }
+
+// getReporter returns the connection's reporter, falling back to the global
+// reporter so reporting can be enabled after connections are set up
+func (c *connection) getReporter() Reporter {
+	if c.Reporter != nil {
+		return c.Reporter
+	}
+	return GetGlobalReporter()
+}
@@ -114,6 +114,8 @@ type Manager struct {
 	AuthConn                      Connection // Authenticated Private connection
 	ExchangeLevelReporter         Reporter   // Latency reporter
 	MaxSubscriptionsPerConnection int
+	frameRecorder                 FrameRecorder
+	redirectURL                   *url.URL
 
 	// connectionManager stores all *potential* connections for the exchange, organised within connectionWrapper structs.
 	// Each connectionWrapper one connection (will be expanded soon) tailored for specific exchange functionalities or asset types. // TODO: Expand this to support multiple connections per connectionWrapper
@@ -164,12 +166,37 @@ type connectionWrapper struct {
 	connection    Connection
 }
 
-var globalReporter Reporter
+var (
+	globalReporter    Reporter
+	globalReporterMtx sync.RWMutex
+)
 
 // SetupGlobalReporter sets a reporter interface to be used
-// for all exchange requests
+// for all exchange requests which do not have their own reporter. A nil
+// reporter disables reporting
 func SetupGlobalReporter(r Reporter) {
+	globalReporterMtx.Lock()
 	globalReporter = r
+	globalReporterMtx.Unlock()
+}
+
+// GetGlobalReporter returns the reporter used for all exchange requests which
+// do not have their own reporter
+func GetGlobalReporter() Reporter {
+	globalReporterMtx.RLock()
+	defer globalReporterMtx.RUnlock()
+	return globalReporter
+}
+
+// trafficReporter returns the exchange level reporter, falling back to the
+// global reporter, if it supports traffic reporting
+func (m *Manager) trafficReporter() TrafficReporter {
+	r := m.ExchangeLevelReporter
+	if r == nil {
+		r = GetGlobalReporter()
+	}
+	tr, _ := r.(TrafficReporter)
+	return tr
 }
 
 // NewManager initialises the websocket struct
@@ -321,9 +348,6 @@ func (m *Manager) SetupNewConnection(c *ConnectionSetup) error {
 	if c.ConnectionLevelReporter == nil {
 		c.ConnectionLevelReporter = m.ExchangeLevelReporter
 	}
-	if c.ConnectionLevelReporter == nil {
-		c.ConnectionLevelReporter = globalReporter
-	}
 
 	if m.useMultiConnectionManagement {
 		// The connection and supporting functions are defined per connection
@@ -402,9 +426,51 @@ func (m *Manager) getConnectionFromSetup(c *ConnectionSetup) *connection {
 		RateLimit:            c.RateLimit,
 		Reporter:             c.ConnectionLevelReporter,
 		RateLimitDefinitions: m.rateLimitDefinitions,
+		redirectURL:          m.redirectURL,
+		frameRecorder:        m.frameRecorder,
 	}
 }
 
+// SetFrameRecorder sets a recorder for the frames sent and received by
+// connections and must be set while disconnected. A nil recorder disables
+// recording
+func (m *Manager) SetFrameRecorder(r FrameRecorder) {
+	m.m.Lock()
+	defer m.m.Unlock()
+	m.frameRecorder = r
+	for _, conn := range []Connection{m.Conn, m.AuthConn} {
+		if c, ok := conn.(*connection); ok {
+			c.frameRecorder = r
+		}
+	}
+}
+
+// RedirectConnections dials connections to the scheme and host of the URL,
+// keeping each connection's path and query, and must be set while
+// disconnected. This allows a local server, such as a replay server, to stand
+// in for the exchange. An empty URL removes the redirect
+func (m *Manager) RedirectConnections(u string) error {
+	var redirect *url.URL
+	if u != "" {
+		if err := checkWebsocketURL(u); err != nil {
+			return err
+		}
+		var err error
+		if redirect, err = url.Parse(u); err != nil {
+			return err
+		}
+	}
+	m.m.Lock()
+	defer m.m.Unlock()
+	m.redirectURL = redirect
+	for _, conn := range []Connection{m.Conn, m.AuthConn} {
+		if c, ok := conn.(*connection); ok {
+			c.redirectURL = redirect
+		}
+	}
+	return nil
+}
+
 // Connect initiates a websocket connection by using a package defined connection
 // function
 func (m *Manager) Connect() error {
@@ -947,6 +1013,9 @@ func (m *Manager) observeData(dropped *int) (exit bool) {
 				log.Warnf(log.WebsocketMgr, "%s exchange websocket ToRoutine channel buffer full; dropping messages", m.exchangeName)
 			}
 			*dropped++
+			if tr := m.trafficReporter(); tr != nil {
+				tr.DataDropped(m.exchangeName)
+			}
 		}
 		return false
 	}
@@ -972,9 +1041,7 @@ func (m *Manager) observeConnection(t *time.Timer) (exit bool) {
 		}
 		// Speedier reconnection, instead of waiting for the next cycle.
 		if m.IsEnabled() && (!m.IsConnected() && !m.IsConnecting()) {
-			if connectErr := m.Connect(); connectErr != nil {
-				log.Errorln(log.WebsocketMgr, connectErr)
-			}
+			m.reconnect()
 		}
 		m.DataHandler <- err // hand over the error to the data handler (shutdown and reconnection is priority)
 	case <-t.C:
@@ -998,16 +1065,24 @@ func (m *Manager) observeConnection(t *time.Timer) (exit bool) {
 			return true
 		}
 		if !m.IsConnecting() && !m.IsConnected() {
-			err := m.Connect()
-			if err != nil {
-				log.Errorln(log.WebsocketMgr, err)
-			}
+			m.reconnect()
 		}
 		t.Reset(m.connectionMonitorDelay)
 	}
 	return false
 }
 
+// reconnect connects the websocket after it has been disconnected
+func (m *Manager) reconnect() {
+	if err := m.Connect(); err != nil {
+		log.Errorln(log.WebsocketMgr, err)
+		return
+	}
+	if tr := m.trafficReporter(); tr != nil {
+		tr.Reconnected(m.exchangeName)
+	}
+}
+
 // monitorTraffic monitors to see if there has been traffic within the trafficTimeout time window. If there is no traffic
 // the connection is shutdown and will be reconnected by the connectionMonitor routine.
 func (m *Manager) monitorTraffic() func() bool {
@@ -12,6 +12,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -630,6 +631,17 @@ func (r *reporter) Latency(name string, message []byte, t time.Duration) {
 	r.t = t
 }
 
+type trafficReporter struct {
+	reporter
+	messages    atomic.Int64
+	reconnects  atomic.Int64
+	dataDropped atomic.Int64
+}
+
+func (r *trafficReporter) MessageReceived(string) { r.messages.Add(1) }
+func (r *trafficReporter) Reconnected(string)     { r.reconnects.Add(1) }
+func (r *trafficReporter) DataDropped(string)     { r.dataDropped.Add(1) }
+
 // readMessages helper func
 func readMessages(t *testing.T, wc *connection) {
 	t.Helper()
@@ -1171,7 +1183,8 @@ func TestMonitorFrame(t *testing.T) {
 
 func TestMonitorData(t *testing.T) {
 	t.Parallel()
-	ws := Manager{ShutdownC: make(chan struct{}), DataHandler: make(chan any, 10)}
+	tr := &trafficReporter{}
+	ws := Manager{ShutdownC: make(chan struct{}), DataHandler: make(chan any, 10), ExchangeLevelReporter: tr}
 	// Handle shutdown signal
 	close(ws.ShutdownC)
 	require.True(t, ws.observeData(nil))
@@ -1181,6 +1194,7 @@ func TestMonitorData(t *testing.T) {
 	var dropped int
 	require.False(t, ws.observeData(&dropped))
 	require.Equal(t, 1, dropped)
+	require.Equal(t, int64(1), tr.dataDropped.Load(), "DataDropped must be reported")
 	// Handle reinstate of ToRoutine functionality which will reset dropped counter
 	ws.ToRoutine = make(chan any, 10)
 	go func() { ws.DataHandler <- nil }()
@@ -1195,6 +1209,26 @@ func TestMonitorData(t *testing.T) {
 	require.True(t, innerShell())
 }
 
+func TestGetReporter(t *testing.T) {
+	c := &connection{}
+	require.Nil(t, c.getReporter(), "getReporter must return nil without a reporter")
+	m := &Manager{}
+	require.Nil(t, m.trafficReporter(), "trafficReporter must return nil without a reporter")
+
+	global := &trafficReporter{}
+	SetupGlobalReporter(global)
+	t.Cleanup(func() { SetupGlobalReporter(nil) })
+	assert.Same(t, global, GetGlobalReporter(), "GetGlobalReporter should return the global reporter")
+	assert.Same(t, global, c.getReporter(), "getReporter should fall back to the global reporter")
+	assert.Same(t, global, m.trafficReporter(), "trafficReporter should fall back to the global reporter")
+
+	local := &reporter{}
+	c.Reporter = local
+	assert.Same(t, local, c.getReporter(), "getReporter should prefer the connection reporter")
+	m.ExchangeLevelReporter = local
+	assert.Nil(t, m.trafficReporter(), "trafficReporter should return nil when the exchange reporter does not support traffic reporting")
+}
+
 func TestMonitorConnection(t *testing.T) {
 	t.Parallel()
 	ws := Manager{verbose: true, ReadMessageErrors: make(chan error, 1), ShutdownC: make(chan struct{})}
@@ -1356,3 +1390,76 @@ func TestShutdown(t *testing.T) {
 	require.Equal(t, m.ShutdownC, authConn.shutdown, "shutdown channels must be the same after original shutdown channel is closed")
 	require.Equal(t, m.ShutdownC, unauthConn.shutdown, "shutdown channels must be the same after original shutdown channel is closed")
 }
+
+type recordedFrame struct {
+	conversation int
+	outbound     bool
+	messageType  int
+	data         string
+}
+
+type frameRecorder struct {
+	m      sync.Mutex
+	urls   []string
+	frames []recordedFrame
+}
+
+func (r *frameRecorder) StartConversation(u string) int {
+	r.m.Lock()
+	defer r.m.Unlock()
+	r.urls = append(r.urls, u)
+	return len(r.urls) - 1
+}
+
+func (r *frameRecorder) RecordFrame(conversation int, outbound bool, messageType int, data []byte) {
+	r.m.Lock()
+	defer r.m.Unlock()
+	r.frames = append(r.frames, recordedFrame{conversation, outbound, messageType, string(data)})
+}
+
+func TestConnectionFrameRecorderAndRedirect(t *testing.T) {
+	t.Parallel()
+
+	mock := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/ws/v5", r.URL.Path, "redirected connection should keep its path")
+		assert.Equal(t, "token=1", r.URL.RawQuery, "redirected connection should keep its query")
+		mockws.WsMockUpgrader(t, w, r, mockws.EchoHandler)
+	}))
+	defer mock.Close()
+
+	m := NewManager()
+	m.Conn = &connection{ExchangeName: "test", URL: "wss://ws.exchange.invalid/ws/v5?token=1", Match: NewMatch(), ResponseMaxLimit: time.Second}
+	rec := &frameRecorder{}
+	m.SetFrameRecorder(rec)
+	assert.ErrorIs(t, m.RedirectConnections("http://localhost"), errInvalidWebsocketURL)
+	require.NoError(t, m.RedirectConnections("ws"+strings.TrimPrefix(mock.URL, "http")), "RedirectConnections must not error")
+	assert.Equal(t, rec, m.getConnectionFromSetup(&ConnectionSetup{}).frameRecorder, "new connections should use the frame recorder")
+	assert.NotNil(t, m.getConnectionFromSetup(&ConnectionSetup{}).redirectURL, "new connections should be redirected")
+
+	wc, ok := m.Conn.(*connection)
+	require.True(t, ok, "Conn must be a *connection")
+	require.NoError(t, wc.Dial(t.Context(), &gws.Dialer{}, http.Header{}), "Dial must not error")
+	require.NoError(t, wc.SendRawMessage(t.Context(), request.Unset, gws.TextMessage, []byte("raw")), "SendRawMessage must not error")
+	assert.Equal(t, "raw", string(wc.ReadMessage().Raw), "ReadMessage should return the echoed message")
+	require.NoError(t, wc.SendJSONMessage(t.Context(), request.Unset, map[string]int{"id": 1}), "SendJSONMessage must not error")
+	assert.Equal(t, `{"id":1}`, string(wc.ReadMessage().Raw), "ReadMessage should return the echoed message")
+	require.NoError(t, wc.Shutdown(), "Shutdown must not error")
+
+	rec.m.Lock()
+	defer rec.m.Unlock()
+	assert.Equal(t, []string{"wss://ws.exchange.invalid/ws/v5"}, rec.urls, "conversation should be started with the connection URL without its query")
+	assert.Equal(t, []recordedFrame{
+		{0, true, gws.TextMessage, "raw"},
+		{0, false, gws.TextMessage, "raw"},
+		{0, true, gws.TextMessage, `{"id":1}`},
+		{0, false, gws.TextMessage, `{"id":1}`},
+	}, rec.frames, "frames should be recorded in order")
+
+	require.NoError(t, m.RedirectConnections(""), "RedirectConnections must not error removing the redirect")
+	m.SetFrameRecorder(nil)
+	assert.Nil(t, wc.redirectURL, "redirect should be removed")
+	assert.Nil(t, wc.frameRecorder, "frame recorder should be removed")
+	dialURL, err := wc.getDialURL()
+	require.NoError(t, err, "getDialURL must not error")
+	assert.Equal(t, wc.URL, dialURL, "getDialURL should return the connection URL without a redirect")
+}
@@ -53,3 +53,22 @@ type UnhandledMessageWarning struct {
 type Reporter interface {
 	Latency(name string, message []byte, t time.Duration)
 }
+
+// TrafficReporter is an optional Reporter extension which is notified of
+// messages received, reconnections and data dropped because the ToRoutine
+// buffer is full
+type TrafficReporter interface {
+	MessageReceived(name string)
+	Reconnected(name string)
+	DataDropped(name string)
+}
+
+// FrameRecorder records the raw frames sent and received by connections so
+// they can be replayed in tests, see exchanges/mock for an implementation
+type FrameRecorder interface {
+	// StartConversation is called when a connection to the URL is established
+	// and returns the conversation the connection's frames are recorded to
+	StartConversation(url string) int
+	// RecordFrame records a frame sent to or received from the server
+	RecordFrame(conversation int, outbound bool, messageType int, data []byte)
+}
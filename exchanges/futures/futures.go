@@ -201,6 +201,23 @@ func (c *PositionController) GetAllOpenPositions() ([]Position, error) {
 	return openPositions, nil
 }
 
+// GetAllPositions returns all open and closed positions
+func (c *PositionController) GetAllPositions() ([]Position, error) {
+	if c == nil {
+		return nil, fmt.Errorf("position controller %w", common.ErrNilPointer)
+	}
+	c.m.Lock()
+	defer c.m.Unlock()
+	var positions []Position
+	for _, multiPositionTracker := range c.multiPositionTrackers {
+		positions = append(positions, multiPositionTracker.GetPositions()...)
+	}
+	if len(positions) == 0 {
+		return nil, ErrNoPositionsFound
+	}
+	return positions, nil
+}
+
 // UpdateOpenPositionUnrealisedPNL finds an open position from
 // an exchange asset pair, then calculates the unrealisedPNL
 // using the latest ticker data
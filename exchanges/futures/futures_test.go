@@ -2,6 +2,7 @@ package futures
 
 import (
 	"context"
+	"strconv"
 	"testing"
 	"time"
 
@@ -1041,6 +1042,38 @@ func TestGetAllOpenPositions(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestGetAllPositions(t *testing.T) {
+	t.Parallel()
+	var nilPC *PositionController
+	_, err := nilPC.GetAllPositions()
+	assert.ErrorIs(t, err, common.ErrNilPointer)
+
+	pc := SetupPositionController()
+	_, err = pc.GetAllPositions()
+	assert.ErrorIs(t, err, ErrNoPositionsFound)
+
+	cp := currency.NewPair(currency.BTC, currency.PERP)
+	tn := time.Now()
+	for i, side := range []order.Side{order.Long, order.Short} {
+		err = pc.TrackNewOrder(&order.Detail{
+			Date:      tn.Add(time.Duration(i) * time.Second),
+			Exchange:  testExchange,
+			Pair:      cp,
+			AssetType: asset.Futures,
+			Side:      side,
+			OrderID:   strconv.Itoa(i),
+			Price:     1337,
+			Amount:    1,
+		})
+		require.NoError(t, err, "TrackNewOrder must not error")
+	}
+	_, err = pc.GetAllOpenPositions()
+	assert.ErrorIs(t, err, ErrNoPositionsFound, "the position should be closed")
+	positions, err := pc.GetAllPositions()
+	require.NoError(t, err)
+	assert.Len(t, positions, 1, "GetAllPositions should return closed positions")
+}
+
 func TestPCTrackFundingDetails(t *testing.T) {
 	t.Parallel()
 	pc := SetupPositionController()
@@ -59,25 +59,50 @@ func LoadFromDatabase(exch string, pair currency.Pair, a asset.Item, interval In
 
 // StoreInDatabase returns Item from database seeded data
 func StoreInDatabase(in *Item, force bool) (uint64, error) {
+	databaseCandles, err := toDatabaseItem(in)
+	if err != nil {
+		return 0, err
+	}
+	if force {
+		_, err := candle.DeleteCandles(databaseCandles)
+		if err != nil {
+			return 0, err
+		}
+	}
+	return candle.Insert(databaseCandles)
+}
+
+// ReplaceInDatabase replaces all stored candles from start up to but excluding
+// end with the item's candles in a single transaction
+func ReplaceInDatabase(in *Item, start, end time.Time) (uint64, error) {
+	databaseCandles, err := toDatabaseItem(in)
+	if err != nil {
+		return 0, err
+	}
+	return candle.Replace(databaseCandles, start, end)
+}
+
+// toDatabaseItem converts an Item to its database representation
+func toDatabaseItem(in *Item) (*candle.Item, error) {
 	if in.Exchange == "" {
-		return 0, errors.New("name cannot be blank")
+		return nil, errors.New("name cannot be blank")
 	}
 	if in.Pair.IsEmpty() {
-		return 0, errors.New("currency pair cannot be empty")
+		return nil, errors.New("currency pair cannot be empty")
 	}
 	if !in.Asset.IsValid() {
-		return 0, errors.New("asset cannot be blank")
+		return nil, errors.New("asset cannot be blank")
 	}
 	if len(in.Candles) < 1 {
-		return 0, errors.New("candle data is empty")
+		return nil, errors.New("candle data is empty")
 	}
 
 	exchangeUUID, err := exchange.UUIDByName(in.Exchange)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 
-	databaseCandles := candle.Item{
+	databaseCandles := &candle.Item{
 		ExchangeID: exchangeUUID.String(),
 		Base:       in.Pair.Base.Upper().String(),
 		Quote:      in.Pair.Quote.Upper().String(),
@@ -104,13 +129,7 @@ func StoreInDatabase(in *Item, force bool) (uint64, error) {
 
 		databaseCandles.Candles = append(databaseCandles.Candles, can)
 	}
-	if force {
-		_, err := candle.DeleteCandles(&databaseCandles)
-		if err != nil {
-			return 0, err
-		}
-	}
-	return candle.Insert(&databaseCandles)
+	return databaseCandles, nil
 }
 
 // LoadFromGCTScriptCSV loads kline data from a CSV file
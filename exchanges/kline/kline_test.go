@@ -424,6 +424,10 @@ func TestStoreInDatabase(t *testing.T) {
 			require.NoError(t, err)
 			assert.Equal(t, uint64(365), r)
 
+			r, err = ReplaceInDatabase(&ohlcvData, ohlcvData.Candles[0].Time, ohlcvData.Candles[len(ohlcvData.Candles)-1].Time.AddDate(0, 0, 1))
+			require.NoError(t, err)
+			assert.Equal(t, uint64(365), r)
+
 			err = testhelpers.CloseDatabase(dbConn)
 			assert.NoError(t, err)
 		})
@@ -0,0 +1,399 @@
+package mock
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	gws "github.com/gorilla/websocket"
+	"github.com/thrasher-corp/gocryptotrader/common/file"
+	"github.com/thrasher-corp/gocryptotrader/encoding/json"
+)
+
+var (
+	errWebsocketConversationNotFound = errors.New("websocket conversation not found")
+	errWebsocketRecordingEmpty       = errors.New("websocket recording has no conversations")
+	errWebsocketFrameEmpty           = errors.New("websocket frame has no data")
+)
+
+// WebsocketRecording defines the websocket conversations recorded for an
+// exchange
+type WebsocketRecording struct {
+	Conversations []WebsocketConversation `json:"conversations"`
+}
+
+// WebsocketConversation defines the frames sent and received by a single
+// websocket connection in the order they occurred
+type WebsocketConversation struct {
+	URL    string           `json:"url"`
+	Frames []WebsocketFrame `json:"frames"`
+}
+
+// WebsocketFrame defines a websocket frame. Text frames which are valid JSON
+// are stored in Data for readability, all other frames are stored in Raw
+type WebsocketFrame struct {
+	Outbound bool            `json:"outbound,omitempty"`
+	Elapsed  int64           `json:"elapsed"` // milliseconds since the connection was established
+	Type     int             `json:"type"`
+	Data     json.RawMessage `json:"data,omitempty"`
+	Raw      []byte          `json:"raw,omitempty"`
+}
+
+// Payload returns the raw frame payload
+func (f *WebsocketFrame) Payload() []byte {
+	if f.Data != nil {
+		return f.Data
+	}
+	return f.Raw
+}
+
+// WebsocketRecorder records the frames of websocket connections
+type WebsocketRecorder struct {
+	m         sync.Mutex
+	maxFrames int
+	started   []time.Time
+	recording WebsocketRecording
+}
+
+// NewWebsocketRecorder returns a new websocket recorder. Frames beyond
+// maxFrames per conversation are not recorded, zero records all frames
+func NewWebsocketRecorder(maxFrames int) *WebsocketRecorder {
+	return &WebsocketRecorder{maxFrames: maxFrames}
+}
+
+// StartConversation starts recording a new conversation for a connection to
+// the URL and returns its index
+func (r *WebsocketRecorder) StartConversation(wsURL string) int {
+	r.m.Lock()
+	defer r.m.Unlock()
+	r.started = append(r.started, time.Now())
+	r.recording.Conversations = append(r.recording.Conversations, WebsocketConversation{URL: wsURL})
+	return len(r.recording.Conversations) - 1
+}
+
+// RecordFrame records a frame sent or received within a conversation
+func (r *WebsocketRecorder) RecordFrame(conversation int, outbound bool, messageType int, data []byte) {
+	r.m.Lock()
+	defer r.m.Unlock()
+	if conversation < 0 || conversation >= len(r.recording.Conversations) {
+		return
+	}
+	c := &r.recording.Conversations[conversation]
+	if r.maxFrames > 0 && len(c.Frames) >= r.maxFrames {
+		return
+	}
+	f := WebsocketFrame{
+		Outbound: outbound,
+		Elapsed:  time.Since(r.started[conversation]).Milliseconds(),
+		Type:     messageType,
+	}
+	if messageType == gws.TextMessage && json.Valid(data) {
+		f.Data = append(json.RawMessage(nil), data...)
+	} else {
+		f.Raw = append([]byte(nil), data...)
+	}
+	c.Frames = append(c.Frames, f)
+}
+
+// Recording returns a copy of the recorded conversations
+func (r *WebsocketRecorder) Recording() WebsocketRecording {
+	r.m.Lock()
+	defer r.m.Unlock()
+	rec := WebsocketRecording{Conversations: make([]WebsocketConversation, len(r.recording.Conversations))}
+	for i := range r.recording.Conversations {
+		rec.Conversations[i] = WebsocketConversation{
+			URL:    r.recording.Conversations[i].URL,
+			Frames: append([]WebsocketFrame(nil), r.recording.Conversations[i].Frames...),
+		}
+	}
+	return rec
+}
+
+// Save writes the recorded conversations to a JSON file. Recordings contain
+// outbound frames verbatim, so authentication frames must be reviewed and
+// scrubbed before recordings are committed
+func (r *WebsocketRecorder) Save(path string) error {
+	data, err := json.MarshalIndent(r.Recording(), "", " ")
+	if err != nil {
+		return err
+	}
+	return file.Write(path, data)
+}
+
+// LoadWebsocketRecording loads a websocket recording from a JSON file
+func LoadWebsocketRecording(path string) (*WebsocketRecording, error) {
+	if path == "" {
+		return nil, errJSONMockFilePathRequired
+	}
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var rec WebsocketRecording
+	if err := json.Unmarshal(contents, &rec); err != nil {
+		return nil, fmt.Errorf("contents of file %s are not a valid websocket recording: %w", path, err)
+	}
+	if len(rec.Conversations) == 0 {
+		return nil, fmt.Errorf("%w: %s", errWebsocketRecordingEmpty, path)
+	}
+	for i := range rec.Conversations {
+		for j := range rec.Conversations[i].Frames {
+			if len(rec.Conversations[i].Frames[j].Payload()) == 0 {
+				return nil, fmt.Errorf("%w: %s conversation %d frame %d", errWebsocketFrameEmpty, path, i, j)
+			}
+		}
+	}
+	return &rec, nil
+}
+
+// WebsocketReplayOptions defines how a recording is replayed
+type WebsocketReplayOptions struct {
+	// Speed scales the recorded time between frames, 1 replays frames at the
+	// recorded pace and 0 replays frames without delay
+	Speed float64
+	// OutboundMatcher is called with each recorded outbound frame and the
+	// message the client sent in its place. Any errors returned are available
+	// from WebsocketReplayServer.Err
+	OutboundMatcher func(recorded, received []byte) error
+	// RewriteIDs substitutes JSON values which differ between recorded
+	// outbound frames and the messages received in their place, such as
+	// request IDs, into subsequent inbound frames so responses can be matched
+	// to the client's requests
+	RewriteIDs bool
+}
+
+// WebsocketReplayServer serves recorded websocket conversations. Each
+// connection is served the first conversation not yet replayed whose URL path
+// matches the connection's path. Recorded inbound frames are written to the
+// client and each recorded outbound frame waits for a message from the client
+type WebsocketReplayServer struct {
+	*httptest.Server
+	recording *WebsocketRecording
+	opts      WebsocketReplayOptions
+	upgrader  gws.Upgrader
+	m         sync.Mutex
+	played    []bool
+	errs      []error
+}
+
+// NewWebsocketReplayServer starts a server replaying the recording at the path
+func NewWebsocketReplayServer(path string, opts *WebsocketReplayOptions) (*WebsocketReplayServer, error) {
+	rec, err := LoadWebsocketRecording(path)
+	if err != nil {
+		return nil, err
+	}
+	return NewWebsocketReplayServerFromRecording(rec, opts), nil
+}
+
+// NewWebsocketReplayServerFromRecording starts a server replaying the recording
+func NewWebsocketReplayServerFromRecording(rec *WebsocketRecording, opts *WebsocketReplayOptions) *WebsocketReplayServer {
+	s := &WebsocketReplayServer{
+		recording: rec,
+		played:    make([]bool, len(rec.Conversations)),
+		upgrader:  gws.Upgrader{CheckOrigin: func(_ *http.Request) bool { return true }},
+	}
+	if opts != nil {
+		s.opts = *opts
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// WebsocketURL returns the websocket URL of the server
+func (s *WebsocketReplayServer) WebsocketURL() string {
+	return "ws" + strings.TrimPrefix(s.URL, "http")
+}
+
+// Err returns any errors encountered while replaying
+func (s *WebsocketReplayServer) Err() error {
+	s.m.Lock()
+	defer s.m.Unlock()
+	return errors.Join(s.errs...)
+}
+
+// Remaining returns the URLs of conversations which have not been replayed
+func (s *WebsocketReplayServer) Remaining() []string {
+	s.m.Lock()
+	defer s.m.Unlock()
+	var remaining []string
+	for i := range s.played {
+		if !s.played[i] {
+			remaining = append(remaining, s.recording.Conversations[i].URL)
+		}
+	}
+	return remaining
+}
+
+func (s *WebsocketReplayServer) addError(err error) {
+	s.m.Lock()
+	s.errs = append(s.errs, err)
+	s.m.Unlock()
+}
+
+// nextConversation returns the next conversation to replay for the path
+func (s *WebsocketReplayServer) nextConversation(path string) (*WebsocketConversation, error) {
+	s.m.Lock()
+	defer s.m.Unlock()
+	for i := range s.recording.Conversations {
+		if s.played[i] || recordedPath(s.recording.Conversations[i].URL) != path {
+			continue
+		}
+		s.played[i] = true
+		return &s.recording.Conversations[i], nil
+	}
+	return nil, fmt.Errorf("%w for path %q", errWebsocketConversationNotFound, path)
+}
+
+func (s *WebsocketReplayServer) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	conversation, err := s.nextConversation(r.URL.Path)
+	if err != nil {
+		s.addError(err)
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	conn, err := s.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		s.addError(err)
+		return
+	}
+	defer conn.Close()
+
+	var previous int64
+	substitutions := make(map[any]any)
+	for i := range conversation.Frames {
+		f := &conversation.Frames[i]
+		if f.Outbound {
+			_, received, err := conn.ReadMessage()
+			if err != nil {
+				return // The client has disconnected
+			}
+			if s.opts.OutboundMatcher != nil {
+				if err := s.opts.OutboundMatcher(f.Payload(), received); err != nil {
+					s.addError(fmt.Errorf("%s frame %d: %w", conversation.URL, i, err))
+				}
+			}
+			if s.opts.RewriteIDs && f.Data != nil {
+				recorded, recErr := decodeJSONValue(f.Data)
+				current, curErr := decodeJSONValue(received)
+				if recErr == nil && curErr == nil {
+					collectSubstitutions(recorded, current, substitutions)
+				}
+			}
+			previous = f.Elapsed
+			continue
+		}
+		if s.opts.Speed > 0 && f.Elapsed > previous {
+			time.Sleep(time.Duration(float64(time.Duration(f.Elapsed-previous)*time.Millisecond) / s.opts.Speed))
+		}
+		previous = f.Elapsed
+		payload := f.Payload()
+		if len(substitutions) > 0 && f.Data != nil {
+			if payload, err = substitute(f.Data, substitutions); err != nil {
+				s.addError(fmt.Errorf("%s frame %d: %w", conversation.URL, i, err))
+				payload = f.Data
+			}
+		}
+		if err := conn.WriteMessage(f.Type, payload); err != nil {
+			return // The client has disconnected
+		}
+	}
+
+	// Keep the connection open until the client disconnects so the replay
+	// does not trigger a reconnection
+	for {
+		if _, _, err := conn.ReadMessage(); err != nil {
+			return
+		}
+	}
+}
+
+// decodeJSONValue decodes JSON keeping numbers as json.Number so they can be
+// compared and written back without loss of precision
+func decodeJSONValue(data []byte) (any, error) {
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.UseNumber()
+	var v any
+	return v, d.Decode(&v)
+}
+
+// collectSubstitutions walks a recorded and received JSON value together and
+// maps each recorded string or number to the received value where they differ
+func collectSubstitutions(recorded, received any, substitutions map[any]any) {
+	switch r := recorded.(type) {
+	case map[string]any:
+		if c, ok := received.(map[string]any); ok {
+			for k, v := range r {
+				if cv, ok := c[k]; ok {
+					collectSubstitutions(v, cv, substitutions)
+				}
+			}
+		}
+	case []any:
+		if c, ok := received.([]any); ok {
+			for i := range min(len(r), len(c)) {
+				collectSubstitutions(r[i], c[i], substitutions)
+			}
+		}
+	case string:
+		if c, ok := received.(string); ok && c != r {
+			substitutions[r] = c
+		}
+	case json.Number:
+		if c, ok := received.(json.Number); ok && c != r {
+			substitutions[r] = c
+		}
+	}
+}
+
+// substitute replaces the recorded values in a JSON frame with the values
+// received in their place
+func substitute(data []byte, substitutions map[any]any) ([]byte, error) {
+	v, err := decodeJSONValue(data)
+	if err != nil {
+		return nil, err
+	}
+	var changed bool
+	var walk func(any) any
+	walk = func(v any) any {
+		switch t := v.(type) {
+		case map[string]any:
+			for k := range t {
+				t[k] = walk(t[k])
+			}
+		case []any:
+			for i := range t {
+				t[i] = walk(t[i])
+			}
+		case string, json.Number:
+			if sub, ok := substitutions[t]; ok {
+				changed = true
+				return sub
+			}
+		}
+		return v
+	}
+	v = walk(v)
+	if !changed {
+		return data, nil
+	}
+	return json.Marshal(v)
+}
+
+// recordedPath returns the path of a recorded URL
+func recordedPath(s string) string {
+	u, err := url.Parse(s)
+	if err != nil {
+		return s
+	}
+	if u.Path == "" {
+		return "/"
+	}
+	return u.Path
+}
@@ -0,0 +1,170 @@
+package mock
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	gws "github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/encoding/json"
+)
+
+func TestWebsocketRecorder(t *testing.T) {
+	t.Parallel()
+	r := NewWebsocketRecorder(3)
+	r.RecordFrame(0, false, gws.TextMessage, []byte(`{}`))
+	assert.Empty(t, r.Recording().Conversations, "RecordFrame should ignore unknown conversations")
+
+	assert.Equal(t, 0, r.StartConversation("wss://test.com/ws"), "StartConversation should return the first conversation")
+	assert.Equal(t, 1, r.StartConversation("wss://test.com/private"), "StartConversation should return the second conversation")
+	r.RecordFrame(0, true, gws.TextMessage, []byte(`{"op":"subscribe"}`))
+	r.RecordFrame(0, false, gws.TextMessage, []byte(`pong`))
+	r.RecordFrame(0, false, gws.BinaryMessage, []byte{1, 2})
+	r.RecordFrame(0, false, gws.TextMessage, []byte(`{"dropped":true}`))
+	r.RecordFrame(1, false, gws.TextMessage, []byte(`{"private":true}`))
+
+	rec := r.Recording()
+	require.Len(t, rec.Conversations, 2, "Recording must return both conversations")
+	assert.Equal(t, "wss://test.com/ws", rec.Conversations[0].URL, "URL should be recorded")
+	require.Len(t, rec.Conversations[0].Frames, 3, "frames beyond maxFrames must not be recorded")
+	assert.True(t, rec.Conversations[0].Frames[0].Outbound, "outbound frames should be marked")
+	assert.JSONEq(t, `{"op":"subscribe"}`, string(rec.Conversations[0].Frames[0].Data), "JSON text frames should be stored as data")
+	assert.Nil(t, rec.Conversations[0].Frames[1].Data, "non JSON text frames should not be stored as data")
+	assert.Equal(t, []byte(`pong`), rec.Conversations[0].Frames[1].Raw, "non JSON text frames should be stored raw")
+	assert.Equal(t, gws.BinaryMessage, rec.Conversations[0].Frames[2].Type, "frame type should be recorded")
+	assert.Equal(t, []byte{1, 2}, rec.Conversations[0].Frames[2].Payload(), "Payload should return binary frames")
+	require.Len(t, rec.Conversations[1].Frames, 1, "second conversation must have its frame")
+
+	path := filepath.Join(t.TempDir(), "testdata", "websocket.json")
+	require.NoError(t, r.Save(path), "Save must not error")
+	loaded, err := LoadWebsocketRecording(path)
+	require.NoError(t, err, "LoadWebsocketRecording must not error")
+	assert.Equal(t, rec.Conversations[0].Frames[2].Raw, loaded.Conversations[0].Frames[2].Raw, "binary frames should survive a round trip")
+	assert.JSONEq(t, string(rec.Conversations[1].Frames[0].Payload()), string(loaded.Conversations[1].Frames[0].Payload()), "JSON frames should survive a round trip")
+}
+
+func TestLoadWebsocketRecording(t *testing.T) {
+	t.Parallel()
+	_, err := LoadWebsocketRecording("")
+	assert.ErrorIs(t, err, errJSONMockFilePathRequired)
+
+	dir := t.TempDir()
+	_, err = LoadWebsocketRecording(filepath.Join(dir, "missing.json"))
+	assert.ErrorIs(t, err, os.ErrNotExist)
+
+	for name, tc := range map[string]struct {
+		contents string
+		err      error
+	}{
+		"invalid.json": {`{"conversations":`, nil},
+		"empty.json":   {`{"conversations":[]}`, errWebsocketRecordingEmpty},
+		"frame.json":   {`{"conversations":[{"url":"wss://test.com","frames":[{"type":1}]}]}`, errWebsocketFrameEmpty},
+	} {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(path, []byte(tc.contents), 0o600), "WriteFile must not error")
+		_, err = LoadWebsocketRecording(path)
+		if tc.err == nil {
+			assert.Errorf(t, err, "LoadWebsocketRecording should error for %s", name)
+			continue
+		}
+		assert.ErrorIs(t, err, tc.err)
+	}
+}
+
+func testRecording() *WebsocketRecording {
+	return &WebsocketRecording{Conversations: []WebsocketConversation{
+		{
+			URL: "wss://test.com/ws/public?compress=true",
+			Frames: []WebsocketFrame{
+				{Elapsed: 1, Type: gws.TextMessage, Data: json.RawMessage(`{"event":"welcome"}`)},
+				{Outbound: true, Elapsed: 2, Type: gws.TextMessage, Data: json.RawMessage(`{"id":1,"req":"abc","op":"subscribe","args":["trades"]}`)},
+				{Elapsed: 3, Type: gws.TextMessage, Data: json.RawMessage(`{"id":1,"req":"abc","success":true,"price":1.10000000000000000001}`)},
+				{Elapsed: 30, Type: gws.BinaryMessage, Raw: []byte{1, 2, 3}},
+			},
+		},
+		{URL: "wss://test.com/ws/private", Frames: []WebsocketFrame{{Type: gws.TextMessage, Raw: []byte("hello")}}},
+	}}
+}
+
+func TestWebsocketReplayServer(t *testing.T) {
+	t.Parallel()
+	_, err := NewWebsocketReplayServer("", nil)
+	assert.ErrorIs(t, err, errJSONMockFilePathRequired)
+
+	errMismatch := errors.New("outbound mismatch")
+	s := NewWebsocketReplayServerFromRecording(testRecording(), &WebsocketReplayOptions{
+		Speed:      1,
+		RewriteIDs: true,
+		OutboundMatcher: func(recorded, received []byte) error {
+			assert.Contains(t, string(recorded), `"req":"abc"`, "OutboundMatcher should be called with the recorded frame")
+			assert.Contains(t, string(received), `"req":"xyz"`, "OutboundMatcher should be called with the received message")
+			return errMismatch
+		},
+	})
+	defer s.Close()
+	assert.ElementsMatch(t, []string{"wss://test.com/ws/public?compress=true", "wss://test.com/ws/private"}, s.Remaining(), "Remaining should return all conversations before replay")
+
+	_, resp, err := gws.DefaultDialer.DialContext(t.Context(), s.WebsocketURL()+"/unknown", nil)
+	require.Error(t, err, "Dial must error for an unknown path")
+	require.NoError(t, resp.Body.Close(), "Body Close must not error")
+	assert.ErrorIs(t, s.Err(), errWebsocketConversationNotFound)
+
+	conn, resp, err := gws.DefaultDialer.DialContext(t.Context(), s.WebsocketURL()+"/ws/public?compress=false", nil)
+	require.NoError(t, err, "Dial must not error")
+	require.NoError(t, resp.Body.Close(), "Body Close must not error")
+	defer conn.Close()
+	assert.Equal(t, []string{"wss://test.com/ws/private"}, s.Remaining(), "Remaining should not return the conversation being replayed")
+
+	mType, msg, err := conn.ReadMessage()
+	require.NoError(t, err, "ReadMessage must not error")
+	assert.Equal(t, gws.TextMessage, mType, "message type should be replayed")
+	assert.JSONEq(t, `{"event":"welcome"}`, string(msg), "inbound frames before an outbound frame should be replayed")
+
+	require.NoError(t, conn.WriteMessage(gws.TextMessage, []byte(`{"id":42,"req":"xyz","op":"subscribe","args":["trades"]}`)), "WriteMessage must not error")
+	_, msg, err = conn.ReadMessage()
+	require.NoError(t, err, "ReadMessage must not error")
+	assert.JSONEq(t, `{"id":42,"req":"xyz","success":true,"price":1.10000000000000000001}`, string(msg), "request IDs should be rewritten without losing precision")
+
+	start := time.Now()
+	mType, msg, err = conn.ReadMessage()
+	require.NoError(t, err, "ReadMessage must not error")
+	assert.GreaterOrEqual(t, time.Since(start), 20*time.Millisecond, "frames should be replayed at the recorded pace")
+	assert.Equal(t, gws.BinaryMessage, mType, "binary frames should be replayed as binary")
+	assert.Equal(t, []byte{1, 2, 3}, msg, "binary frames should be replayed verbatim")
+
+	assert.ErrorIs(t, s.Err(), errMismatch)
+}
+
+func TestSubstitute(t *testing.T) {
+	t.Parallel()
+	substitutions := make(map[any]any)
+	recorded, err := decodeJSONValue([]byte(`{"id":1,"nested":[{"ts":"100"}],"same":"a","missing":2}`))
+	require.NoError(t, err, "decodeJSONValue must not error")
+	received, err := decodeJSONValue([]byte(`{"id":2,"nested":[{"ts":"200"},{"ts":"300"}],"same":"a"}`))
+	require.NoError(t, err, "decodeJSONValue must not error")
+	collectSubstitutions(recorded, received, substitutions)
+	assert.Equal(t, map[any]any{json.Number("1"): json.Number("2"), "100": "200"}, substitutions, "collectSubstitutions should map differing values")
+
+	data := []byte(`{"unchanged":true}`)
+	out, err := substitute(data, substitutions)
+	require.NoError(t, err, "substitute must not error")
+	assert.Equal(t, data, out, "substitute should return the frame unchanged without substitutions")
+
+	out, err = substitute([]byte(`{"result":[{"id":1,"ts":"100"}],"other":1.5}`), substitutions)
+	require.NoError(t, err, "substitute must not error")
+	assert.JSONEq(t, `{"result":[{"id":2,"ts":"200"}],"other":1.5}`, string(out), "substitute should replace recorded values")
+
+	_, err = substitute([]byte(`{`), substitutions)
+	assert.Error(t, err, "substitute should error on invalid JSON")
+}
+
+func TestRecordedPath(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, "/ws", recordedPath("wss://test.com/ws?a=b"), "recordedPath should return the URL path")
+	assert.Equal(t, "/", recordedPath("wss://test.com"), "recordedPath should default to the root path")
+	assert.Equal(t, "%zz", recordedPath("%zz"), "recordedPath should return invalid URLs unchanged")
+}
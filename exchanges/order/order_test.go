@@ -992,31 +992,33 @@ func TestUpdateOrderFromDetail(t *testing.T) {
 	updated := time.Now()
 
 	om := &Detail{
-		TimeInForce:     GoodTillCancel | PostOnly,
-		HiddenOrder:     true,
-		Leverage:        1,
-		Price:           1,
-		Amount:          1,
-		LimitPriceUpper: 1,
-		LimitPriceLower: 1,
-		TriggerPrice:    1,
-		QuoteAmount:     1,
-		ExecutedAmount:  1,
-		RemainingAmount: 1,
-		Fee:             1,
-		Exchange:        "1",
-		InternalOrderID: id,
-		OrderID:         "1",
-		AccountID:       "1",
-		ClientID:        "1",
-		ClientOrderID:   "DukeOfWombleton",
-		Type:            1,
-		Side:            1,
-		Status:          1,
-		AssetType:       1,
-		LastUpdated:     updated,
-		Pair:            currency.NewBTCUSD(),
-		Trades:          []TradeHistory{},
+		TimeInForce:          GoodTillCancel | PostOnly,
+		HiddenOrder:          true,
+		Leverage:             1,
+		Price:                1,
+		Amount:               1,
+		LimitPriceUpper:      1,
+		LimitPriceLower:      1,
+		TriggerPrice:         1,
+		QuoteAmount:          1,
+		ExecutedAmount:       1,
+		RemainingAmount:      1,
+		AverageExecutedPrice: 1,
+		Fee:                  1,
+		FeeAsset:             currency.USDT,
+		Exchange:             "1",
+		InternalOrderID:      id,
+		OrderID:              "1",
+		AccountID:            "1",
+		ClientID:             "1",
+		ClientOrderID:        "DukeOfWombleton",
+		Type:                 1,
+		Side:                 1,
+		Status:               1,
+		AssetType:            1,
+		LastUpdated:          updated,
+		Pair:                 currency.NewBTCUSD(),
+		Trades:               []TradeHistory{},
 	}
 
 	od = &Detail{Exchange: "test"}
@@ -1040,7 +1042,9 @@ func TestUpdateOrderFromDetail(t *testing.T) {
 	assert.Equal(t, 1.0, od.QuoteAmount)
 	assert.Equal(t, 1.0, od.ExecutedAmount)
 	assert.Equal(t, 1.0, od.RemainingAmount)
+	assert.Equal(t, 1.0, od.AverageExecutedPrice)
 	assert.Equal(t, 1.0, od.Fee)
+	assert.Equal(t, currency.USDT, od.FeeAsset)
 	assert.Equal(t, "test", od.Exchange, "Should not be able to update exchange via modify")
 	assert.Equal(t, "1", od.OrderID)
 	assert.Equal(t, "1", od.ClientID)
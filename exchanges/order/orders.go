@@ -193,10 +193,18 @@ func (d *Detail) UpdateOrderFromDetail(m *Detail) error {
 		d.ExecutedAmount = m.ExecutedAmount
 		updated = true
 	}
+	if m.AverageExecutedPrice > 0 && m.AverageExecutedPrice != d.AverageExecutedPrice {
+		d.AverageExecutedPrice = m.AverageExecutedPrice
+		updated = true
+	}
 	if m.Fee > 0 && m.Fee != d.Fee {
 		d.Fee = m.Fee
 		updated = true
 	}
+	if !m.FeeAsset.IsEmpty() && !m.FeeAsset.Equal(d.FeeAsset) {
+		d.FeeAsset = m.FeeAsset
+		updated = true
+	}
 	if m.AccountID != "" && m.AccountID != d.AccountID {
 		d.AccountID = m.AccountID
 		updated = true
@@ -0,0 +1,386 @@
+package orderbook
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+var (
+	errNoAggregatorSources = errors.New("no aggregator sources supplied")
+	errBaseMismatch        = errors.New("source base currency does not match aggregated pair")
+	errQuoteMismatch       = errors.New("source quote currency does not match aggregated pair and quote normalisation is disabled")
+	errInvalidFee          = errors.New("fee rate must be between 0 and 1")
+	errInvalidRate         = errors.New("quote conversion rate must be greater than zero")
+	errDuplicateSource     = errors.New("duplicate aggregator source")
+	errNilCallback         = errors.New("nil callback")
+)
+
+// usdPegged are quote currencies treated as equivalent to USD when no foreign exchange rate is available
+var usdPegged = []currency.Code{currency.USD, currency.USDT, currency.USDC, currency.BUSD, currency.TUSD, currency.DAI, currency.USDP, currency.PYUSD}
+
+// QuoteConverter returns the rate which converts a price quoted in one currency to another
+type QuoteConverter func(from, to currency.Code) (float64, error)
+
+// AggregatorConfig defines the orderbooks which are consolidated into an aggregated book
+type AggregatorConfig struct {
+	Pair    currency.Pair
+	Asset   asset.Item
+	Sources []AggregatorSource
+	// NormaliseQuote allows sources quoted in a different currency to the aggregated pair, converting their prices
+	// with Converter
+	NormaliseQuote bool
+	// Converter converts source quote currencies, defaults to ConvertQuote when nil
+	Converter QuoteConverter
+	// IncludeFees groups levels by their fee adjusted price instead of their quoted price
+	IncludeFees bool
+	// MaxLevels limits the number of levels returned on each side, zero returns all levels
+	MaxLevels int
+}
+
+// AggregatorSource is an exchange orderbook feeding an aggregated book
+type AggregatorSource struct {
+	Exchange string
+	Pair     currency.Pair
+	// Fee is the taker fee rate charged by the exchange e.g. 0.001 for 0.1%
+	Fee float64
+}
+
+// AggregatedBook is an orderbook consolidated across exchanges
+type AggregatedBook struct {
+	Pair        currency.Pair
+	Asset       asset.Item
+	Bids        []AggregatedLevel
+	Asks        []AggregatedLevel
+	LastUpdated time.Time
+	// Unavailable lists the exchanges which were excluded from the book and why
+	Unavailable map[string]error
+}
+
+// AggregatedLevel is a price level with the liquidity each exchange contributes to it
+type AggregatedLevel struct {
+	Price   float64
+	Amount  float64
+	Sources []LevelSource
+}
+
+// LevelSource is the liquidity an exchange contributes to an aggregated level
+type LevelSource struct {
+	Exchange string
+	Pair     currency.Pair
+	// Price is the price as quoted by the exchange before quote normalisation
+	Price  float64
+	Amount float64
+	Fee    float64
+	// EffectivePrice is the normalised price after fees are applied
+	EffectivePrice float64
+}
+
+// Aggregator consolidates orderbooks for a pair across multiple exchanges
+type Aggregator struct {
+	pair        currency.Pair
+	asset       asset.Item
+	converter   QuoteConverter
+	includeFees bool
+	maxLevels   int
+
+	m       sync.Mutex
+	sources []*aggregatorSource
+}
+
+// aggregatorSource holds the latest normalised contribution of an exchange orderbook
+type aggregatorSource struct {
+	AggregatorSource
+	depth       *Depth
+	bids        []sourceLevel
+	asks        []sourceLevel
+	lastUpdated time.Time
+	err         error
+}
+
+// sourceLevel is a normalised level from an exchange orderbook
+type sourceLevel struct {
+	price float64
+	LevelSource
+}
+
+// NewAggregator returns an aggregator for the orderbooks supplied in the config. Each orderbook must already be
+// loaded into the orderbook store
+func NewAggregator(cfg *AggregatorConfig) (*Aggregator, error) {
+	if cfg == nil {
+		return nil, fmt.Errorf("%w: %T", common.ErrNilPointer, cfg)
+	}
+	if cfg.Pair.IsEmpty() {
+		return nil, errPairNotSet
+	}
+	if !cfg.Asset.IsValid() {
+		return nil, fmt.Errorf("%w: %q", asset.ErrNotSupported, cfg.Asset)
+	}
+	if len(cfg.Sources) == 0 {
+		return nil, errNoAggregatorSources
+	}
+	a := &Aggregator{
+		pair:        cfg.Pair,
+		asset:       cfg.Asset,
+		converter:   cfg.Converter,
+		includeFees: cfg.IncludeFees,
+		maxLevels:   cfg.MaxLevels,
+		sources:     make([]*aggregatorSource, 0, len(cfg.Sources)),
+	}
+	if a.converter == nil {
+		a.converter = ConvertQuote
+	}
+	for i := range cfg.Sources {
+		src := cfg.Sources[i]
+		if src.Exchange == "" {
+			return nil, ErrExchangeNameEmpty
+		}
+		if src.Pair.IsEmpty() {
+			src.Pair = cfg.Pair
+		}
+		if !src.Pair.Base.Equal(cfg.Pair.Base) {
+			return nil, fmt.Errorf("%w: %s %s", errBaseMismatch, src.Exchange, src.Pair)
+		}
+		if !cfg.NormaliseQuote && !src.Pair.Quote.Equal(cfg.Pair.Quote) {
+			return nil, fmt.Errorf("%w: %s %s", errQuoteMismatch, src.Exchange, src.Pair)
+		}
+		if src.Fee < 0 || src.Fee >= 1 {
+			return nil, fmt.Errorf("%w: %s %v", errInvalidFee, src.Exchange, src.Fee)
+		}
+		for _, existing := range a.sources {
+			if existing.Exchange == src.Exchange && existing.Pair.Equal(src.Pair) {
+				return nil, fmt.Errorf("%w: %s %s", errDuplicateSource, src.Exchange, src.Pair)
+			}
+		}
+		depth, err := GetDepth(src.Exchange, src.Pair, cfg.Asset)
+		if err != nil {
+			return nil, err
+		}
+		a.sources = append(a.sources, &aggregatorSource{AggregatorSource: src, depth: depth})
+	}
+	return a, nil
+}
+
+// Book refreshes every source and returns the aggregated book
+func (a *Aggregator) Book() (*AggregatedBook, error) {
+	if a == nil {
+		return nil, fmt.Errorf("%w: %T", common.ErrNilPointer, a)
+	}
+	a.m.Lock()
+	defer a.m.Unlock()
+	for _, src := range a.sources {
+		a.refresh(src)
+	}
+	return a.merge(), nil
+}
+
+// Stream calls fn with the aggregated book and again each time an underlying orderbook changes, only refreshing the
+// sources which have updated. Updates which arrive while fn is running are coalesced. Stream blocks until the context
+// is cancelled or fn returns an error
+func (a *Aggregator) Stream(ctx context.Context, fn func(*AggregatedBook) error) error {
+	if a == nil {
+		return fmt.Errorf("%w: %T", common.ErrNilPointer, a)
+	}
+	if fn == nil {
+		return errNilCallback
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	var wg sync.WaitGroup
+	defer func() {
+		cancel()
+		wg.Wait()
+	}()
+
+	updated := make(chan int, len(a.sources))
+	for i := range a.sources {
+		wg.Go(func() {
+			a.watch(ctx, i, updated)
+		})
+	}
+
+	book, err := a.Book()
+	if err != nil {
+		return err
+	}
+	if err := fn(book); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case i := <-updated:
+			a.m.Lock()
+			a.refresh(a.sources[i])
+		coalesce:
+			for {
+				select {
+				case i = <-updated:
+					a.refresh(a.sources[i])
+				default:
+					break coalesce
+				}
+			}
+			book = a.merge()
+			a.m.Unlock()
+			if err := fn(book); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// watch signals the index of a source each time its orderbook is updated until the context is cancelled
+func (a *Aggregator) watch(ctx context.Context, i int, updated chan<- int) {
+	for {
+		if kicked := <-a.sources[i].depth.Wait(ctx.Done()); kicked {
+			return
+		}
+		select {
+		case updated <- i:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// refresh loads the latest orderbook for a source and normalises its levels
+func (a *Aggregator) refresh(src *aggregatorSource) {
+	src.bids, src.asks, src.err = nil, nil, nil
+	b, err := src.depth.Retrieve()
+	if err != nil {
+		src.err = err
+		return
+	}
+	rate := 1.0
+	if !src.Pair.Quote.Equal(a.pair.Quote) {
+		if rate, err = a.converter(src.Pair.Quote, a.pair.Quote); err != nil {
+			src.err = err
+			return
+		}
+		if rate <= 0 {
+			src.err = fmt.Errorf("%w: %s to %s", errInvalidRate, src.Pair.Quote, a.pair.Quote)
+			return
+		}
+	}
+	src.lastUpdated = b.LastUpdated
+	src.bids = a.normalise(src, b.Bids, rate, 1-src.Fee)
+	src.asks = a.normalise(src, b.Asks, rate, 1+src.Fee)
+}
+
+// normalise converts orderbook levels to the aggregated quote currency and applies the fee multiplier
+func (a *Aggregator) normalise(src *aggregatorSource, levels Levels, rate, feeMultiplier float64) []sourceLevel {
+	out := make([]sourceLevel, len(levels))
+	for i := range levels {
+		price := levels[i].Price * rate
+		out[i] = sourceLevel{
+			price: price,
+			LevelSource: LevelSource{
+				Exchange:       src.Exchange,
+				Pair:           src.Pair,
+				Price:          levels[i].Price,
+				Amount:         levels[i].Amount,
+				Fee:            src.Fee,
+				EffectivePrice: price * feeMultiplier,
+			},
+		}
+		if a.includeFees {
+			out[i].price = out[i].EffectivePrice
+		}
+	}
+	return out
+}
+
+// merge consolidates the current source levels into an aggregated book
+func (a *Aggregator) merge() *AggregatedBook {
+	book := &AggregatedBook{Pair: a.pair, Asset: a.asset}
+	var bids, asks []sourceLevel
+	for _, src := range a.sources {
+		if src.err != nil {
+			if book.Unavailable == nil {
+				book.Unavailable = make(map[string]error)
+			}
+			book.Unavailable[src.Exchange] = src.err
+			continue
+		}
+		bids = append(bids, src.bids...)
+		asks = append(asks, src.asks...)
+		if src.lastUpdated.After(book.LastUpdated) {
+			book.LastUpdated = src.lastUpdated
+		}
+	}
+	book.Bids = a.consolidate(bids, func(x, y float64) int { return cmp.Compare(y, x) })
+	book.Asks = a.consolidate(asks, cmp.Compare[float64])
+	return book
+}
+
+// consolidate sorts levels into price order and combines levels at the same price
+func (a *Aggregator) consolidate(levels []sourceLevel, compare func(x, y float64) int) []AggregatedLevel {
+	slices.SortStableFunc(levels, func(x, y sourceLevel) int { return compare(x.price, y.price) })
+	out := make([]AggregatedLevel, 0, len(levels))
+	for i := range levels {
+		if n := len(out); n > 0 && out[n-1].Price == levels[i].price {
+			out[n-1].Amount += levels[i].Amount
+			out[n-1].Sources = append(out[n-1].Sources, levels[i].LevelSource)
+			continue
+		}
+		if a.maxLevels > 0 && len(out) == a.maxLevels {
+			break
+		}
+		out = append(out, AggregatedLevel{
+			Price:   levels[i].price,
+			Amount:  levels[i].Amount,
+			Sources: []LevelSource{levels[i].LevelSource},
+		})
+	}
+	return out
+}
+
+// BestBid returns the highest aggregated bid
+func (b *AggregatedBook) BestBid() (AggregatedLevel, error) {
+	if b == nil || len(b.Bids) == 0 {
+		return AggregatedLevel{}, fmt.Errorf("%w: no bids", errNotEnoughLiquidity)
+	}
+	return b.Bids[0], nil
+}
+
+// BestAsk returns the lowest aggregated ask
+func (b *AggregatedBook) BestAsk() (AggregatedLevel, error) {
+	if b == nil || len(b.Asks) == 0 {
+		return AggregatedLevel{}, fmt.Errorf("%w: no asks", errNotEnoughLiquidity)
+	}
+	return b.Asks[0], nil
+}
+
+// ConvertQuote converts between quote currencies using the foreign exchange rates held by the currency package,
+// treating USD and USD pegged stablecoins as equivalent when no rate is available
+func ConvertQuote(from, to currency.Code) (float64, error) {
+	if from.Equal(to) {
+		return 1, nil
+	}
+	rate, err := currency.ConvertFiat(1, from, to)
+	if err == nil && rate > 0 {
+		return rate, nil
+	}
+	if isUSDPegged(from) && isUSDPegged(to) {
+		return 1, nil
+	}
+	if err == nil {
+		err = errInvalidRate
+	}
+	return 0, fmt.Errorf("cannot convert %s to %s: %w", from, to, err)
+}
+
+func isUSDPegged(c currency.Code) bool {
+	return slices.ContainsFunc(usdPegged, c.Equal)
+}
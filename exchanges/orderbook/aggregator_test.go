@@ -0,0 +1,224 @@
+package orderbook
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+var errTestConversion = errors.New("test conversion failure")
+
+func deployAggregatorDepth(t *testing.T, exch string, p currency.Pair, bids, asks Levels) *Depth {
+	t.Helper()
+	d, err := DeployDepth(exch, p, asset.Spot)
+	require.NoError(t, err, "DeployDepth must not error")
+	require.NoError(t, d.LoadSnapshot(&Book{Bids: bids, Asks: asks, LastUpdated: time.Now()}), "LoadSnapshot must not error")
+	return d
+}
+
+func TestNewAggregator(t *testing.T) {
+	t.Parallel()
+	usd := currency.NewBTCUSD()
+	usdt := currency.NewBTCUSDT()
+	deployAggregatorDepth(t, "aggnewa", usd, Levels{{Price: 100, Amount: 1}}, Levels{{Price: 101, Amount: 1}})
+	deployAggregatorDepth(t, "aggnewb", usdt, Levels{{Price: 100, Amount: 1}}, Levels{{Price: 101, Amount: 1}})
+
+	_, err := NewAggregator(nil)
+	assert.ErrorIs(t, err, common.ErrNilPointer)
+
+	cfg := &AggregatorConfig{}
+	_, err = NewAggregator(cfg)
+	assert.ErrorIs(t, err, errPairNotSet)
+
+	cfg.Pair = usd
+	_, err = NewAggregator(cfg)
+	assert.ErrorIs(t, err, asset.ErrNotSupported)
+
+	cfg.Asset = asset.Spot
+	_, err = NewAggregator(cfg)
+	assert.ErrorIs(t, err, errNoAggregatorSources)
+
+	cfg.Sources = []AggregatorSource{{}}
+	_, err = NewAggregator(cfg)
+	assert.ErrorIs(t, err, ErrExchangeNameEmpty)
+
+	cfg.Sources = []AggregatorSource{{Exchange: "aggnewa", Pair: currency.NewPair(currency.ETH, currency.USD)}}
+	_, err = NewAggregator(cfg)
+	assert.ErrorIs(t, err, errBaseMismatch)
+
+	cfg.Sources = []AggregatorSource{{Exchange: "aggnewb", Pair: usdt}}
+	_, err = NewAggregator(cfg)
+	assert.ErrorIs(t, err, errQuoteMismatch)
+
+	cfg.NormaliseQuote = true
+	cfg.Sources = []AggregatorSource{{Exchange: "aggnewb", Pair: usdt, Fee: 1}}
+	_, err = NewAggregator(cfg)
+	assert.ErrorIs(t, err, errInvalidFee)
+
+	cfg.Sources = []AggregatorSource{{Exchange: "aggnewa"}, {Exchange: "aggnewa", Pair: usd}}
+	_, err = NewAggregator(cfg)
+	assert.ErrorIs(t, err, errDuplicateSource)
+
+	cfg.Sources = []AggregatorSource{{Exchange: "aggnewmissing"}}
+	_, err = NewAggregator(cfg)
+	assert.ErrorIs(t, err, ErrOrderbookNotFound)
+
+	cfg.Sources = []AggregatorSource{{Exchange: "aggnewa"}, {Exchange: "aggnewb", Pair: usdt}}
+	a, err := NewAggregator(cfg)
+	require.NoError(t, err, "NewAggregator must not error")
+	assert.Len(t, a.sources, 2)
+	assert.True(t, a.sources[0].Pair.Equal(usd), "source pair should default to the aggregated pair")
+}
+
+func TestAggregatorBook(t *testing.T) {
+	t.Parallel()
+	usd := currency.NewBTCUSD()
+	usdt := currency.NewBTCUSDT()
+	eur := currency.NewPair(currency.BTC, currency.EUR)
+	deployAggregatorDepth(t, "aggbooka", usd,
+		Levels{{Price: 100, Amount: 1}, {Price: 99, Amount: 2}},
+		Levels{{Price: 101, Amount: 1}, {Price: 102, Amount: 2}})
+	deployAggregatorDepth(t, "aggbookb", usdt,
+		Levels{{Price: 100, Amount: 3}, {Price: 98, Amount: 1}},
+		Levels{{Price: 100.5, Amount: 0.5}, {Price: 101, Amount: 4}})
+	deployAggregatorDepth(t, "aggbookc", eur, Levels{{Price: 90, Amount: 1}}, Levels{{Price: 92, Amount: 1}})
+
+	a, err := NewAggregator(&AggregatorConfig{
+		Pair:           usd,
+		Asset:          asset.Spot,
+		NormaliseQuote: true,
+		Sources: []AggregatorSource{
+			{Exchange: "aggbooka", Fee: 0.001},
+			{Exchange: "aggbookb", Pair: usdt, Fee: 0.002},
+			{Exchange: "aggbookc", Pair: eur},
+		},
+		Converter: func(from, to currency.Code) (float64, error) {
+			if from.Equal(currency.EUR) {
+				return 0, errTestConversion
+			}
+			return ConvertQuote(from, to)
+		},
+	})
+	require.NoError(t, err, "NewAggregator must not error")
+
+	b, err := a.Book()
+	require.NoError(t, err, "Book must not error")
+	assert.ErrorIs(t, b.Unavailable["aggbookc"], errTestConversion, "unconvertible source should be unavailable")
+	assert.False(t, b.LastUpdated.IsZero(), "LastUpdated should be set")
+
+	require.Len(t, b.Bids, 3)
+	assert.Equal(t, 100.0, b.Bids[0].Price)
+	assert.Equal(t, 4.0, b.Bids[0].Amount, "levels at the same price should be combined")
+	require.Len(t, b.Bids[0].Sources, 2)
+	assert.Equal(t, "aggbooka", b.Bids[0].Sources[0].Exchange)
+	assert.InDelta(t, 99.9, b.Bids[0].Sources[0].EffectivePrice, accuracy10dp)
+	assert.Equal(t, "aggbookb", b.Bids[0].Sources[1].Exchange)
+	assert.True(t, b.Bids[0].Sources[1].Pair.Equal(usdt), "source pair should be attributed")
+	assert.InDelta(t, 99.8, b.Bids[0].Sources[1].EffectivePrice, accuracy10dp)
+	assert.Equal(t, 99.0, b.Bids[1].Price)
+	assert.Equal(t, 98.0, b.Bids[2].Price)
+
+	require.Len(t, b.Asks, 3)
+	assert.Equal(t, 100.5, b.Asks[0].Price)
+	assert.Equal(t, 101.0, b.Asks[1].Price)
+	assert.Equal(t, 5.0, b.Asks[1].Amount)
+	assert.Equal(t, 102.0, b.Asks[2].Price)
+
+	bid, err := b.BestBid()
+	require.NoError(t, err, "BestBid must not error")
+	assert.Equal(t, 100.0, bid.Price)
+	ask, err := b.BestAsk()
+	require.NoError(t, err, "BestAsk must not error")
+	assert.Equal(t, 100.5, ask.Price)
+
+	a.includeFees = true
+	a.maxLevels = 1
+	b, err = a.Book()
+	require.NoError(t, err, "Book must not error")
+	require.Len(t, b.Bids, 1, "MaxLevels should limit bids")
+	assert.InDelta(t, 99.9, b.Bids[0].Price, accuracy10dp, "bids should be keyed by fee adjusted price")
+	require.Len(t, b.Asks, 1, "MaxLevels should limit asks")
+	assert.InDelta(t, 100.701, b.Asks[0].Price, accuracy10dp, "asks should be keyed by fee adjusted price")
+
+	_, err = (&AggregatedBook{}).BestBid()
+	assert.ErrorIs(t, err, errNotEnoughLiquidity)
+	_, err = (&AggregatedBook{}).BestAsk()
+	assert.ErrorIs(t, err, errNotEnoughLiquidity)
+
+	_, err = (*Aggregator)(nil).Book()
+	assert.ErrorIs(t, err, common.ErrNilPointer)
+}
+
+func TestAggregatorStream(t *testing.T) {
+	t.Parallel()
+	usd := currency.NewBTCUSD()
+	da := deployAggregatorDepth(t, "aggstreama", usd, Levels{{Price: 100, Amount: 1}}, Levels{{Price: 101, Amount: 1}})
+	deployAggregatorDepth(t, "aggstreamb", usd, Levels{{Price: 99, Amount: 1}}, Levels{{Price: 102, Amount: 1}})
+
+	a, err := NewAggregator(&AggregatorConfig{
+		Pair:    usd,
+		Asset:   asset.Spot,
+		Sources: []AggregatorSource{{Exchange: "aggstreama"}, {Exchange: "aggstreamb"}},
+	})
+	require.NoError(t, err, "NewAggregator must not error")
+
+	assert.ErrorIs(t, a.Stream(t.Context(), nil), errNilCallback)
+	assert.ErrorIs(t, (*Aggregator)(nil).Stream(t.Context(), nil), common.ErrNilPointer)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+	books := make(chan *AggregatedBook, 8)
+	errs := make(chan error, 1)
+	go func() {
+		errs <- a.Stream(ctx, func(b *AggregatedBook) error {
+			books <- b
+			return nil
+		})
+	}()
+
+	b := <-books
+	require.Len(t, b.Bids, 2)
+	assert.Equal(t, 100.0, b.Bids[0].Price)
+
+	require.Eventually(t, func() bool {
+		require.NoError(t, da.LoadSnapshot(&Book{Bids: Levels{{Price: 98, Amount: 1}}, Asks: Levels{{Price: 101, Amount: 1}}, LastUpdated: time.Now()}))
+		for {
+			select {
+			case b = <-books:
+				if len(b.Bids) > 0 && b.Bids[0].Price == 99 {
+					return true
+				}
+			default:
+				return false
+			}
+		}
+	}, time.Second*5, time.Millisecond*10, "stream should push the updated book")
+	assert.Equal(t, 98.0, b.Bids[1].Price)
+
+	cancel()
+	assert.ErrorIs(t, <-errs, context.Canceled)
+
+	stop := errors.New("stop")
+	assert.ErrorIs(t, a.Stream(t.Context(), func(*AggregatedBook) error { return stop }), stop)
+}
+
+func TestConvertQuote(t *testing.T) {
+	t.Parallel()
+	rate, err := ConvertQuote(currency.USDT, currency.USDT)
+	require.NoError(t, err)
+	assert.Equal(t, 1.0, rate)
+
+	rate, err = ConvertQuote(currency.USDT, currency.USD)
+	require.NoError(t, err)
+	assert.Equal(t, 1.0, rate, "USD pegged stablecoins should be at parity without a rate")
+
+	_, err = ConvertQuote(currency.BTC, currency.USD)
+	assert.Error(t, err)
+}
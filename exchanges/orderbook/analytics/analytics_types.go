@@ -0,0 +1,219 @@
+package analytics
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/common/key"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+var service *Service
+
+// Default analytics settings
+const (
+	DefaultLevels     = 5
+	DefaultWindow     = time.Minute
+	DefaultBufferSize = 4096
+	// MaxTrackers is the number of orderbooks which can be tracked at once
+	MaxTrackers = 100
+)
+
+// DefaultBands are the basis point distances from the mid price depth is
+// measured within when no bands are configured
+var DefaultBands = []float64{10, 25, 50, 100}
+
+// Public errors
+var (
+	ErrNotTracked     = errors.New("orderbook analytics not tracked")
+	ErrNoMetrics      = errors.New("no orderbook analytics calculated")
+	ErrIncompleteBook = errors.New("orderbook requires bids and asks")
+	ErrTooManyTracked = errors.New("too many orderbook analytics tracked")
+)
+
+var (
+	errNilDepth                   = errors.New("orderbook depth is nil")
+	errNilChange                  = errors.New("orderbook change is nil")
+	errInvalidBand                = errors.New("depth bands must be positive")
+	errDuplicateBand              = errors.New("duplicate depth band")
+	errInvalidLevels              = errors.New("weighted mid levels cannot be negative")
+	errInvalidWindow              = errors.New("window cannot be negative")
+	errInvalidBufferSize          = errors.New("buffer size cannot be negative")
+	errNoSnapshot                 = errors.New("update received before an orderbook snapshot")
+	errTrackerAlreadyStarted      = errors.New("tracker already started")
+	errTrackerNotStarted          = errors.New("tracker not started")
+	errTrackedWithDifferentConfig = errors.New("orderbook analytics already tracked with a different config")
+	errNoSubscribers              = errors.New("orderbook analytics tracker has no subscribers to release")
+)
+
+// Config defines how orderbook analytics are calculated. Zero values use the
+// defaults
+type Config struct {
+	// Bands are the basis point distances from the mid price which depth and
+	// imbalance are measured within
+	Bands []float64
+	// Levels is the number of price levels on each side of the book the
+	// weighted mid price is calculated from
+	Levels int
+	// Window is the trailing period spread statistics and flow rates are
+	// calculated over
+	Window time.Duration
+	// BufferSize is the number of changes held while waiting to be applied.
+	// When full, changes are dropped until the next snapshot is applied
+	BufferSize int
+}
+
+// Metrics are the analytics of an orderbook as of its last applied change.
+// Basis point values are relative to the mid price
+type Metrics struct {
+	Exchange    string
+	Pair        currency.Pair
+	Asset       asset.Item
+	UpdateID    int64
+	LastUpdated time.Time
+
+	BestBid float64
+	BestAsk float64
+	// MidPrice is the average of the best bid and ask
+	MidPrice float64
+	// WeightedMidPrice is the mid price of the volume weighted average bid and
+	// ask prices across the configured number of levels, weighted towards the
+	// side with less liquidity
+	WeightedMidPrice float64
+	// Microprice is the mid price of the best bid and ask weighted towards the
+	// side with less liquidity at the top of the book
+	Microprice float64
+	Spread     float64
+	SpreadBps  float64
+	// Imbalance is the top of book bid/ask imbalance between -1, all asks,
+	// and 1, all bids
+	Imbalance   float64
+	SpreadStats SpreadStats
+	Bands       []Band
+	Bids        Flow
+	Asks        Flow
+	// Window is the period spread statistics and flow rates were calculated
+	// over, which is shorter than the configured window until enough changes
+	// have been applied
+	Window time.Duration
+}
+
+// SpreadStats are time weighted statistics of the spread in basis points
+type SpreadStats struct {
+	Mean   float64
+	StdDev float64
+	Min    float64
+	Max    float64
+}
+
+// Band is the depth available within a basis point distance of the mid price
+type Band struct {
+	Bps       float64
+	BidAmount float64
+	AskAmount float64
+	BidValue  float64
+	AskValue  float64
+	// Imbalance is the bid/ask amount imbalance within the band between -1,
+	// all asks, and 1, all bids
+	Imbalance float64
+}
+
+// Flow holds the rates liquidity is added to and removed from a side of the
+// book. Removals include liquidity taken by trades as they cannot be told
+// apart from cancellations by level changes alone
+type Flow struct {
+	// ReplenishedPerSecond is the amount of liquidity added per second
+	ReplenishedPerSecond float64
+	// CancelledPerSecond is the amount of liquidity removed per second
+	CancelledPerSecond float64
+	// ReplenishmentsPerSecond is the number of level increases per second
+	ReplenishmentsPerSecond float64
+	// CancellationsPerSecond is the number of level decreases per second
+	CancellationsPerSecond float64
+}
+
+// Calculator calculates orderbook analytics from the snapshots and incremental
+// updates applied to an orderbook. Times are taken from the changes so
+// captured orderbook history can be replayed. Calculator is not safe for
+// concurrent use
+type Calculator struct {
+	config Config
+
+	exchange    string
+	pair        currency.Pair
+	asset       asset.Item
+	updateID    int64
+	lastUpdated time.Time
+	loaded      bool
+
+	bids orderbook.Levels
+	asks orderbook.Levels
+
+	start  time.Time
+	flows  []flowSample
+	bidSum flowTotals
+	askSum flowTotals
+
+	spreads []spreadSample
+}
+
+// flowTotals holds the liquidity added to and removed from a side of the book
+type flowTotals struct {
+	added    float64
+	removed  float64
+	adds     float64
+	removals float64
+}
+
+// flowSample is the liquidity flow of a single change
+type flowSample struct {
+	time time.Time
+	bids flowTotals
+	asks flowTotals
+}
+
+// spreadSample is the spread in basis points from a point in time until the
+// next sample
+type spreadSample struct {
+	time time.Time
+	bps  float64
+}
+
+// Tracker applies the changes of an orderbook depth to a calculator and
+// publishes the resulting metrics to its subscribers
+type Tracker struct {
+	exchange string
+	asset    asset.Item
+	pair     currency.Pair
+	depth    *orderbook.Depth
+	id       uuid.UUID
+	config   Config
+
+	calculator *Calculator
+	latest     atomic.Pointer[Metrics]
+	observer   *orderbook.BufferedObserver
+	// dropped is the number of changes dropped by previous observers
+	dropped  atomic.Uint64
+	shutdown chan struct{}
+	wg       sync.WaitGroup
+	m        sync.Mutex
+
+	// held is set while tracked by Track and subscribers counts the holders
+	// from TrackSubscriber, both are guarded by the service mutex
+	held        bool
+	subscribers int
+}
+
+// Service holds the tracked orderbooks and routes their metrics to
+// subscribers
+type Service struct {
+	trackers map[key.ExchangeAssetPair]*Tracker
+	mux      *dispatch.Mux
+	mu       sync.Mutex
+}
@@ -0,0 +1,355 @@
+package analytics
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"slices"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+// bpsScale converts a ratio to basis points
+const bpsScale = 10000
+
+// NewCalculator returns a calculator of orderbook analytics
+func NewCalculator(cfg *Config) (*Calculator, error) {
+	c, err := cfg.normalise()
+	if err != nil {
+		return nil, err
+	}
+	return &Calculator{config: c}, nil
+}
+
+// normalise validates the config and returns a copy with defaults applied and
+// bands sorted
+func (c *Config) normalise() (Config, error) {
+	var cfg Config
+	if c != nil {
+		cfg = *c
+	}
+	if cfg.Levels < 0 {
+		return cfg, errInvalidLevels
+	}
+	if cfg.Window < 0 {
+		return cfg, errInvalidWindow
+	}
+	if cfg.BufferSize < 0 {
+		return cfg, errInvalidBufferSize
+	}
+	if len(cfg.Bands) == 0 {
+		cfg.Bands = DefaultBands
+	}
+	cfg.Bands = slices.Clone(cfg.Bands)
+	slices.Sort(cfg.Bands)
+	for i := range cfg.Bands {
+		if cfg.Bands[i] <= 0 || math.IsNaN(cfg.Bands[i]) || math.IsInf(cfg.Bands[i], 0) {
+			return cfg, fmt.Errorf("%w: %v", errInvalidBand, cfg.Bands[i])
+		}
+		if i > 0 && cfg.Bands[i] == cfg.Bands[i-1] {
+			return cfg, fmt.Errorf("%w: %v", errDuplicateBand, cfg.Bands[i])
+		}
+	}
+	if cfg.Levels == 0 {
+		cfg.Levels = DefaultLevels
+	}
+	if cfg.Window == 0 {
+		cfg.Window = DefaultWindow
+	}
+	if cfg.BufferSize == 0 {
+		cfg.BufferSize = DefaultBufferSize
+	}
+	return cfg, nil
+}
+
+// equal returns whether two normalised configs match
+func (c *Config) equal(other *Config) bool {
+	return slices.Equal(c.Bands, other.Bands) &&
+		c.Levels == other.Levels &&
+		c.Window == other.Window &&
+		c.BufferSize == other.BufferSize
+}
+
+// Apply applies a snapshot or incremental update to the calculator. Liquidity
+// flow is measured from the level changes in updates, and from the difference
+// to the previous book for snapshots after the first. Levels beyond the max
+// depth of the book are discarded without being counted as removed.
+// Invalidated changes discard the book and its samples until the next snapshot
+func (c *Calculator) Apply(change *orderbook.Change) error {
+	if change == nil {
+		return errNilChange
+	}
+	if change.Invalidated {
+		*c = Calculator{config: c.config}
+		return nil
+	}
+	if !change.Snapshot && !c.loaded {
+		return errNoSnapshot
+	}
+	t := change.UpdateTime
+	if t.IsZero() {
+		t = change.LastPushed
+	}
+	if t.IsZero() {
+		t = time.Now()
+	}
+	if t.Before(c.lastUpdated) {
+		t = c.lastUpdated
+	}
+
+	var sample flowSample
+	if change.Snapshot {
+		if c.loaded {
+			sample.bids = diffLevels(c.bids, change.Bids)
+			sample.asks = diffLevels(c.asks, change.Asks)
+		} else {
+			c.start = t
+		}
+		c.bids = append(c.bids[:0], change.Bids...)
+		c.asks = append(c.asks[:0], change.Asks...)
+		c.loaded = true
+	} else {
+		var prev float64
+		for i := range change.Bids {
+			c.bids, prev = setLevel(c.bids, change.Bids[i], true)
+			sample.bids.record(prev, change.Bids[i].Amount)
+		}
+		for i := range change.Asks {
+			c.asks, prev = setLevel(c.asks, change.Asks[i], false)
+			sample.asks.record(prev, change.Asks[i].Amount)
+		}
+	}
+	if change.MaxDepth > 0 {
+		c.bids = c.bids[:min(len(c.bids), change.MaxDepth)]
+		c.asks = c.asks[:min(len(c.asks), change.MaxDepth)]
+	}
+
+	c.exchange, c.pair, c.asset = change.Exchange, change.Pair, change.Asset
+	c.updateID = change.UpdateID
+	c.lastUpdated = t
+
+	if sample.bids != (flowTotals{}) || sample.asks != (flowTotals{}) {
+		sample.time = t
+		c.flows = append(c.flows, sample)
+		c.bidSum.add(&sample.bids, 1)
+		c.askSum.add(&sample.asks, 1)
+	}
+	if len(c.bids) != 0 && len(c.asks) != 0 {
+		mid := (c.bids[0].Price + c.asks[0].Price) / 2
+		bps := (c.asks[0].Price - c.bids[0].Price) / mid * bpsScale
+		if len(c.spreads) == 0 || c.spreads[len(c.spreads)-1].bps != bps {
+			c.spreads = append(c.spreads, spreadSample{time: t, bps: bps})
+		}
+	}
+	c.evict()
+	return nil
+}
+
+// evict removes the samples which have fallen out of the window. The last
+// spread before the window is kept as it applies to the start of the window
+func (c *Calculator) evict() {
+	windowStart := c.lastUpdated.Add(-c.config.Window)
+	var i int
+	for ; i < len(c.flows) && !c.flows[i].time.After(windowStart); i++ {
+		c.bidSum.add(&c.flows[i].bids, -1)
+		c.askSum.add(&c.flows[i].asks, -1)
+	}
+	if i > 0 {
+		c.flows = slices.Delete(c.flows, 0, i)
+		if len(c.flows) == 0 {
+			// Resets floating point error accumulated by the running totals
+			c.bidSum, c.askSum = flowTotals{}, flowTotals{}
+		}
+	}
+	i = slices.IndexFunc(c.spreads, func(s spreadSample) bool { return s.time.After(windowStart) })
+	if i == -1 {
+		i = len(c.spreads)
+	}
+	if i > 1 {
+		c.spreads = slices.Delete(c.spreads, 0, i-1)
+	}
+}
+
+// Metrics returns the analytics of the book as of the last change applied
+func (c *Calculator) Metrics() (*Metrics, error) {
+	if len(c.bids) == 0 || len(c.asks) == 0 {
+		return nil, ErrIncompleteBook
+	}
+	bestBid, bestAsk := c.bids[0], c.asks[0]
+	m := &Metrics{
+		Exchange:    c.exchange,
+		Pair:        c.pair,
+		Asset:       c.asset,
+		UpdateID:    c.updateID,
+		LastUpdated: c.lastUpdated,
+		BestBid:     bestBid.Price,
+		BestAsk:     bestAsk.Price,
+		MidPrice:    (bestBid.Price + bestAsk.Price) / 2,
+		Spread:      bestAsk.Price - bestBid.Price,
+		Imbalance:   imbalance(bestBid.Amount, bestAsk.Amount),
+		Microprice:  weightedMid(bestBid.Price, bestBid.Amount, bestAsk.Price, bestAsk.Amount),
+		Bands:       make([]Band, len(c.config.Bands)),
+		Window:      min(c.config.Window, c.lastUpdated.Sub(c.start)),
+	}
+	m.SpreadBps = m.Spread / m.MidPrice * bpsScale
+
+	bidPrice, bidAmount := vwap(c.bids[:min(len(c.bids), c.config.Levels)])
+	askPrice, askAmount := vwap(c.asks[:min(len(c.asks), c.config.Levels)])
+	m.WeightedMidPrice = weightedMid(bidPrice, bidAmount, askPrice, askAmount)
+
+	for i, bps := range c.config.Bands {
+		b := &m.Bands[i]
+		b.Bps = bps
+		lower, upper := m.MidPrice*(1-bps/bpsScale), m.MidPrice*(1+bps/bpsScale)
+		for j := 0; j < len(c.bids) && c.bids[j].Price >= lower; j++ {
+			b.BidAmount += c.bids[j].Amount
+			b.BidValue += c.bids[j].Amount * c.bids[j].Price
+		}
+		for j := 0; j < len(c.asks) && c.asks[j].Price <= upper; j++ {
+			b.AskAmount += c.asks[j].Amount
+			b.AskValue += c.asks[j].Amount * c.asks[j].Price
+		}
+		b.Imbalance = imbalance(b.BidAmount, b.AskAmount)
+	}
+
+	if seconds := m.Window.Seconds(); seconds > 0 {
+		m.Bids = c.bidSum.rates(seconds)
+		m.Asks = c.askSum.rates(seconds)
+	}
+	m.SpreadStats = c.spreadStats(m.SpreadBps)
+	return m, nil
+}
+
+// spreadStats returns the time weighted spread statistics over the window
+func (c *Calculator) spreadStats(current float64) SpreadStats {
+	stats := SpreadStats{Mean: current, Min: current, Max: current}
+	windowStart := c.lastUpdated.Add(-c.config.Window)
+	var total, sum, sumSquares float64
+	for i := range c.spreads {
+		from := c.spreads[i].time
+		if from.Before(windowStart) {
+			from = windowStart
+		}
+		to := c.lastUpdated
+		if i+1 < len(c.spreads) {
+			to = c.spreads[i+1].time
+		}
+		d := to.Sub(from).Seconds()
+		if d <= 0 {
+			continue
+		}
+		bps := c.spreads[i].bps
+		total += d
+		sum += bps * d
+		sumSquares += bps * bps * d
+		stats.Min = min(stats.Min, bps)
+		stats.Max = max(stats.Max, bps)
+	}
+	if total > 0 {
+		stats.Mean = sum / total
+		stats.StdDev = math.Sqrt(max(sumSquares/total-stats.Mean*stats.Mean, 0))
+	}
+	return stats
+}
+
+// setLevel sets the amount of a price level, removing it when the amount is
+// zero, and returns the levels and the previous amount of the price level
+func setLevel(levels orderbook.Levels, l orderbook.Level, descending bool) (orderbook.Levels, float64) {
+	i, found := slices.BinarySearchFunc(levels, l.Price, func(e orderbook.Level, price float64) int {
+		if descending {
+			return cmp.Compare(price, e.Price)
+		}
+		return cmp.Compare(e.Price, price)
+	})
+	if !found {
+		if l.Amount == 0 {
+			return levels, 0
+		}
+		return slices.Insert(levels, i, orderbook.Level{Price: l.Price, Amount: l.Amount}), 0
+	}
+	prev := levels[i].Amount
+	if l.Amount == 0 {
+		return slices.Delete(levels, i, i+1), prev
+	}
+	levels[i].Amount = l.Amount
+	return levels, prev
+}
+
+// diffLevels returns the liquidity flow between two books
+func diffLevels(prev, next orderbook.Levels) flowTotals {
+	var f flowTotals
+	amounts := make(map[float64]float64, len(prev))
+	for i := range prev {
+		amounts[prev[i].Price] = prev[i].Amount
+	}
+	for i := range next {
+		f.record(amounts[next[i].Price], next[i].Amount)
+		delete(amounts, next[i].Price)
+	}
+	for _, amount := range amounts {
+		f.record(amount, 0)
+	}
+	return f
+}
+
+// record records the change in amount of a price level
+func (f *flowTotals) record(prev, next float64) {
+	switch d := next - prev; {
+	case d > 0:
+		f.added += d
+		f.adds++
+	case d < 0:
+		f.removed -= d
+		f.removals++
+	}
+}
+
+// add adds or, with a sign of -1, subtracts flow totals
+func (f *flowTotals) add(o *flowTotals, sign float64) {
+	f.added += o.added * sign
+	f.removed += o.removed * sign
+	f.adds += o.adds * sign
+	f.removals += o.removals * sign
+}
+
+// rates returns the flow totals per second
+func (f *flowTotals) rates(seconds float64) Flow {
+	return Flow{
+		ReplenishedPerSecond:    max(f.added, 0) / seconds,
+		CancelledPerSecond:      max(f.removed, 0) / seconds,
+		ReplenishmentsPerSecond: max(f.adds, 0) / seconds,
+		CancellationsPerSecond:  max(f.removals, 0) / seconds,
+	}
+}
+
+// vwap returns the volume weighted average price and total amount of levels
+func vwap(levels orderbook.Levels) (price, amount float64) {
+	var value float64
+	for i := range levels {
+		amount += levels[i].Amount
+		value += levels[i].Amount * levels[i].Price
+	}
+	if amount == 0 {
+		return 0, 0
+	}
+	return value / amount, amount
+}
+
+// weightedMid returns the mid of a bid and ask price weighted by the amount on
+// the opposite side, so the price is pulled towards the side with less
+// liquidity
+func weightedMid(bidPrice, bidAmount, askPrice, askAmount float64) float64 {
+	if bidAmount+askAmount == 0 {
+		return (bidPrice + askPrice) / 2
+	}
+	return (bidPrice*askAmount + askPrice*bidAmount) / (bidAmount + askAmount)
+}
+
+// imbalance returns the imbalance between bid and ask amounts between -1 and 1
+func imbalance(bid, ask float64) float64 {
+	if bid+ask == 0 {
+		return 0
+	}
+	return (bid - ask) / (bid + ask)
+}
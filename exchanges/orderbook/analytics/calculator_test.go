@@ -0,0 +1,189 @@
+package analytics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+const delta = 1e-9
+
+var (
+	testPair  = currency.NewBTCUSDT()
+	testStart = time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+)
+
+func testSnapshot() *orderbook.Change {
+	return &orderbook.Change{
+		Exchange:   "Test",
+		Pair:       testPair,
+		Asset:      asset.Spot,
+		UpdateID:   1,
+		UpdateTime: testStart,
+		Snapshot:   true,
+		Bids:       orderbook.Levels{{Price: 100, Amount: 2}, {Price: 99, Amount: 3}, {Price: 98, Amount: 5}},
+		Asks:       orderbook.Levels{{Price: 101, Amount: 1}, {Price: 102, Amount: 4}, {Price: 110, Amount: 10}},
+	}
+}
+
+func TestNewCalculator(t *testing.T) {
+	t.Parallel()
+	_, err := NewCalculator(&Config{Levels: -1})
+	assert.ErrorIs(t, err, errInvalidLevels)
+	_, err = NewCalculator(&Config{Window: -1})
+	assert.ErrorIs(t, err, errInvalidWindow)
+	_, err = NewCalculator(&Config{BufferSize: -1})
+	assert.ErrorIs(t, err, errInvalidBufferSize)
+	_, err = NewCalculator(&Config{Bands: []float64{10, 0}})
+	assert.ErrorIs(t, err, errInvalidBand)
+	_, err = NewCalculator(&Config{Bands: []float64{10, 5, 10}})
+	assert.ErrorIs(t, err, errDuplicateBand)
+
+	c, err := NewCalculator(nil)
+	require.NoError(t, err)
+	assert.Equal(t, DefaultBands, c.config.Bands)
+	assert.Equal(t, DefaultLevels, c.config.Levels)
+	assert.Equal(t, DefaultWindow, c.config.Window)
+	assert.Equal(t, DefaultBufferSize, c.config.BufferSize)
+	c.config.Bands[0] = 1
+	assert.NotEqual(t, 1.0, DefaultBands[0], "Default bands should not be shared")
+
+	c, err = NewCalculator(&Config{Bands: []float64{50, 5}})
+	require.NoError(t, err)
+	assert.Equal(t, []float64{5, 50}, c.config.Bands, "Bands should be sorted")
+}
+
+func TestCalculatorApply(t *testing.T) {
+	t.Parallel()
+	c, err := NewCalculator(&Config{Bands: []float64{200, 100}, Levels: 2})
+	require.NoError(t, err)
+
+	require.ErrorIs(t, c.Apply(nil), errNilChange)
+	require.ErrorIs(t, c.Apply(&orderbook.Change{UpdateTime: testStart}), errNoSnapshot)
+	_, err = c.Metrics()
+	require.ErrorIs(t, err, ErrIncompleteBook)
+
+	require.NoError(t, c.Apply(testSnapshot()))
+	m, err := c.Metrics()
+	require.NoError(t, err)
+	assert.Equal(t, "Test", m.Exchange)
+	assert.True(t, testPair.Equal(m.Pair))
+	assert.Equal(t, asset.Spot, m.Asset)
+	assert.Equal(t, int64(1), m.UpdateID)
+	assert.Equal(t, testStart, m.LastUpdated)
+	assert.Equal(t, 100.0, m.BestBid)
+	assert.Equal(t, 101.0, m.BestAsk)
+	assert.Equal(t, 100.5, m.MidPrice)
+	assert.Equal(t, 1.0, m.Spread)
+	assert.InDelta(t, 1/100.5*bpsScale, m.SpreadBps, delta)
+	assert.InDelta(t, 1.0/3, m.Imbalance, delta)
+	assert.InDelta(t, 302.0/3, m.Microprice, delta)
+	assert.InDelta(t, 100.6, m.WeightedMidPrice, delta)
+	require.Len(t, m.Bands, 2)
+	assert.Equal(t, Band{Bps: 100, BidAmount: 2, AskAmount: 1, BidValue: 200, AskValue: 101, Imbalance: 1.0 / 3}, m.Bands[0])
+	assert.Equal(t, Band{Bps: 200, BidAmount: 5, AskAmount: 5, BidValue: 497, AskValue: 509}, m.Bands[1])
+	assert.Zero(t, m.Window)
+	assert.Equal(t, Flow{}, m.Bids, "The first snapshot should not be counted as flow")
+	assert.Equal(t, SpreadStats{Mean: m.SpreadBps, Min: m.SpreadBps, Max: m.SpreadBps}, m.SpreadStats)
+
+	require.NoError(t, c.Apply(&orderbook.Change{
+		Exchange:   "Test",
+		UpdateID:   2,
+		UpdateTime: testStart.Add(10 * time.Second),
+		Bids:       orderbook.Levels{{Price: 100, Amount: 0}, {Price: 99.5, Amount: 1}, {Price: 97, Amount: 0}},
+		Asks:       orderbook.Levels{{Price: 101, Amount: 3}},
+	}))
+	require.NoError(t, c.Apply(&orderbook.Change{
+		Exchange:   "Test",
+		UpdateID:   3,
+		UpdateTime: testStart.Add(20 * time.Second),
+		Asks:       orderbook.Levels{{Price: 110, Amount: 0}},
+	}))
+	assert.Equal(t, orderbook.Levels{{Price: 99.5, Amount: 1}, {Price: 99, Amount: 3}, {Price: 98, Amount: 5}}, c.bids)
+	assert.Equal(t, orderbook.Levels{{Price: 101, Amount: 3}, {Price: 102, Amount: 4}}, c.asks)
+
+	m, err = c.Metrics()
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), m.UpdateID)
+	assert.Equal(t, 99.5, m.BestBid)
+	assert.Equal(t, 20*time.Second, m.Window)
+	assert.Equal(t, Flow{ReplenishedPerSecond: 0.05, CancelledPerSecond: 0.1, ReplenishmentsPerSecond: 0.05, CancellationsPerSecond: 0.05}, m.Bids)
+	assert.Equal(t, Flow{ReplenishedPerSecond: 0.1, CancelledPerSecond: 0.5, ReplenishmentsPerSecond: 0.05, CancellationsPerSecond: 0.05}, m.Asks)
+
+	first, second := 1/100.5*bpsScale, 1.5/100.25*bpsScale
+	assert.InDelta(t, second, m.SpreadBps, delta)
+	assert.InDelta(t, (first+second)/2, m.SpreadStats.Mean, delta, "Spread mean should be time weighted")
+	assert.InDelta(t, (second-first)/2, m.SpreadStats.StdDev, delta)
+	assert.InDelta(t, first, m.SpreadStats.Min, delta)
+	assert.InDelta(t, second, m.SpreadStats.Max, delta)
+
+	m.Bands[0].Bps = 1
+	m, err = c.Metrics()
+	require.NoError(t, err)
+	assert.Equal(t, 100.0, m.Bands[0].Bps, "Metrics should not share bands")
+
+	require.NoError(t, c.Apply(&orderbook.Change{UpdateTime: testStart.Add(30 * time.Second), Invalidated: true}))
+	_, err = c.Metrics()
+	require.ErrorIs(t, err, ErrIncompleteBook, "Invalidated changes should discard the book")
+	require.ErrorIs(t, c.Apply(&orderbook.Change{UpdateTime: testStart.Add(40 * time.Second)}), errNoSnapshot)
+	require.NoError(t, c.Apply(testSnapshot()))
+	m, err = c.Metrics()
+	require.NoError(t, err)
+	assert.Equal(t, Flow{}, m.Bids, "Flow should not be measured across an invalidation")
+}
+
+func TestCalculatorSnapshotFlow(t *testing.T) {
+	t.Parallel()
+	c, err := NewCalculator(nil)
+	require.NoError(t, err)
+	require.NoError(t, c.Apply(testSnapshot()))
+
+	snapshot := testSnapshot()
+	snapshot.UpdateTime = testStart.Add(2 * time.Second)
+	snapshot.Bids = orderbook.Levels{{Price: 100, Amount: 1}, {Price: 97, Amount: 1}}
+	snapshot.MaxDepth = 1
+	require.NoError(t, c.Apply(snapshot))
+	assert.Equal(t, orderbook.Levels{{Price: 100, Amount: 1}}, c.bids, "Levels beyond the max depth should be discarded")
+	assert.Equal(t, orderbook.Levels{{Price: 101, Amount: 1}}, c.asks, "Levels beyond the max depth should be discarded")
+	assert.Equal(t, flowTotals{added: 1, adds: 1, removed: 9, removals: 3}, c.bidSum, "Snapshots should be diffed against the previous book")
+	assert.Equal(t, flowTotals{}, c.askSum)
+
+	require.NoError(t, c.Apply(&orderbook.Change{UpdateTime: testStart.Add(3 * time.Second), Bids: orderbook.Levels{{Price: 99, Amount: 1}}, MaxDepth: 1}))
+	assert.Equal(t, orderbook.Levels{{Price: 100, Amount: 1}}, c.bids)
+}
+
+func TestCalculatorWindow(t *testing.T) {
+	t.Parallel()
+	c, err := NewCalculator(&Config{Window: 15 * time.Second})
+	require.NoError(t, err)
+	require.NoError(t, c.Apply(testSnapshot()))
+	require.NoError(t, c.Apply(&orderbook.Change{UpdateTime: testStart.Add(10 * time.Second), Bids: orderbook.Levels{{Price: 100, Amount: 1}}}))
+	require.NoError(t, c.Apply(&orderbook.Change{UpdateTime: testStart.Add(20 * time.Second), Asks: orderbook.Levels{{Price: 101, Amount: 2}}}))
+
+	m, err := c.Metrics()
+	require.NoError(t, err)
+	assert.Equal(t, 15*time.Second, m.Window)
+	assert.Equal(t, 1/15.0, m.Bids.CancelledPerSecond)
+	assert.Equal(t, 1/15.0, m.Asks.ReplenishedPerSecond)
+	bps := 1 / 100.5 * bpsScale
+	assert.InDelta(t, bps, m.SpreadStats.Mean, delta)
+	assert.InDelta(t, bps, m.SpreadStats.Min, delta)
+	assert.InDelta(t, bps, m.SpreadStats.Max, delta)
+	assert.Zero(t, m.SpreadStats.StdDev)
+
+	require.NoError(t, c.Apply(&orderbook.Change{UpdateTime: testStart.Add(40 * time.Second), Bids: orderbook.Levels{{Price: 99.5, Amount: 1}}}))
+	require.Len(t, c.flows, 1, "Flows outside of the window should be evicted")
+	require.Len(t, c.spreads, 1, "Only the last spread before the window should be kept")
+	m, err = c.Metrics()
+	require.NoError(t, err)
+	assert.Zero(t, m.Asks.ReplenishedPerSecond)
+	assert.Equal(t, 1/15.0, m.Bids.ReplenishedPerSecond)
+
+	require.NoError(t, c.Apply(&orderbook.Change{UpdateTime: testStart.Add(30 * time.Second), Bids: orderbook.Levels{{Price: 99.5, Amount: 2}}}))
+	assert.Equal(t, testStart.Add(40*time.Second), c.lastUpdated, "Changes out of order should not move time backwards")
+}
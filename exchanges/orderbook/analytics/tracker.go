@@ -0,0 +1,352 @@
+package analytics
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/key"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+func init() {
+	service = &Service{
+		trackers: make(map[key.ExchangeAssetPair]*Tracker),
+		mux:      dispatch.GetNewMux(nil),
+	}
+}
+
+// Track starts tracking the analytics of an orderbook which has been loaded,
+// returning its tracker. An orderbook already tracked returns its existing
+// tracker, erroring if a config is provided which differs from the config it
+// is tracked with. The orderbook is tracked until Untrack is called
+func Track(exchange string, p currency.Pair, a asset.Item, cfg *Config) (*Tracker, error) {
+	return track(exchange, p, a, cfg, false)
+}
+
+// TrackSubscriber tracks the analytics of an orderbook as Track does for a
+// subscriber, which must call Release on the tracker once finished. The
+// orderbook is untracked once all of its subscribers have released it, unless
+// it is also tracked by Track
+func TrackSubscriber(exchange string, p currency.Pair, a asset.Item, cfg *Config) (*Tracker, error) {
+	return track(exchange, p, a, cfg, true)
+}
+
+func track(exchange string, p currency.Pair, a asset.Item, cfg *Config, subscriber bool) (*Tracker, error) {
+	if err := validateScope(exchange, p, a); err != nil {
+		return nil, err
+	}
+	service.mu.Lock()
+	defer service.mu.Unlock()
+	k := trackerKey(exchange, a, p)
+	t, ok := service.trackers[k]
+	if ok && cfg != nil {
+		c, err := cfg.normalise()
+		if err != nil {
+			return nil, err
+		}
+		if !t.config.equal(&c) {
+			return nil, fmt.Errorf("%w %s %s %s", errTrackedWithDifferentConfig, exchange, a, p)
+		}
+	}
+	if !ok {
+		if len(service.trackers) >= MaxTrackers {
+			return nil, fmt.Errorf("%w, limit of %d reached", ErrTooManyTracked, MaxTrackers)
+		}
+		depth, err := orderbook.GetDepth(exchange, p, a)
+		if err != nil {
+			return nil, err
+		}
+		if t, err = NewTracker(depth, exchange, a, p, cfg); err != nil {
+			return nil, err
+		}
+		if err := t.Start(); err != nil {
+			return nil, err
+		}
+		service.trackers[k] = t
+	}
+	if subscriber {
+		t.subscribers++
+	} else {
+		t.held = true
+	}
+	return t, nil
+}
+
+// Untrack stops tracking the analytics of an orderbook. An orderbook with
+// subscribers remaining is untracked once they have all released it
+func Untrack(exchange string, p currency.Pair, a asset.Item) error {
+	if err := validateScope(exchange, p, a); err != nil {
+		return err
+	}
+	k := trackerKey(exchange, a, p)
+	service.mu.Lock()
+	t, ok := service.trackers[k]
+	if !ok {
+		service.mu.Unlock()
+		return fmt.Errorf("%w %s %s %s", ErrNotTracked, exchange, a, p)
+	}
+	t.held = false
+	if t.subscribers > 0 {
+		service.mu.Unlock()
+		return nil
+	}
+	delete(service.trackers, k)
+	service.mu.Unlock()
+	return t.Stop()
+}
+
+// UntrackAll stops tracking the analytics of every orderbook
+func UntrackAll() error {
+	service.mu.Lock()
+	trackers := service.trackers
+	service.trackers = make(map[key.ExchangeAssetPair]*Tracker)
+	service.mu.Unlock()
+	var errs error
+	for _, t := range trackers {
+		if err := t.Stop(); err != nil {
+			errs = common.AppendError(errs, fmt.Errorf("%s %s %s: %w", t.exchange, t.asset, t.pair, err))
+		}
+	}
+	return errs
+}
+
+// GetMetrics returns the latest analytics of a tracked orderbook
+func GetMetrics(exchange string, p currency.Pair, a asset.Item) (*Metrics, error) {
+	t, err := getTracker(exchange, p, a)
+	if err != nil {
+		return nil, err
+	}
+	return t.Metrics()
+}
+
+// Subscribe subscribes to the analytics of a tracked orderbook, returning a
+// pipe which receives *Metrics each time they are recalculated
+func Subscribe(exchange string, p currency.Pair, a asset.Item) (dispatch.Pipe, error) {
+	t, err := getTracker(exchange, p, a)
+	if err != nil {
+		return dispatch.Pipe{}, err
+	}
+	return t.Subscribe()
+}
+
+// getTracker returns the tracker of an orderbook
+func getTracker(exchange string, p currency.Pair, a asset.Item) (*Tracker, error) {
+	if err := validateScope(exchange, p, a); err != nil {
+		return nil, err
+	}
+	service.mu.Lock()
+	defer service.mu.Unlock()
+	t, ok := service.trackers[trackerKey(exchange, a, p)]
+	if !ok {
+		return nil, fmt.Errorf("%w %s %s %s", ErrNotTracked, exchange, a, p)
+	}
+	return t, nil
+}
+
+func trackerKey(exchange string, a asset.Item, p currency.Pair) key.ExchangeAssetPair {
+	return key.NewExchangeAssetPair(strings.ToLower(exchange), a, p)
+}
+
+func validateScope(exchange string, p currency.Pair, a asset.Item) error {
+	if exchange == "" {
+		return common.ErrExchangeNameNotSet
+	}
+	if p.IsEmpty() {
+		return currency.ErrCurrencyPairEmpty
+	}
+	if !a.IsValid() {
+		return fmt.Errorf("%w %q", asset.ErrNotSupported, a)
+	}
+	return nil
+}
+
+// NewTracker returns a tracker of the analytics of an orderbook depth
+func NewTracker(d *orderbook.Depth, exchange string, a asset.Item, p currency.Pair, cfg *Config) (*Tracker, error) {
+	if d == nil {
+		return nil, errNilDepth
+	}
+	if err := validateScope(exchange, p, a); err != nil {
+		return nil, err
+	}
+	c, err := cfg.normalise()
+	if err != nil {
+		return nil, err
+	}
+	id, err := service.mux.GetID()
+	if err != nil {
+		return nil, err
+	}
+	return &Tracker{
+		exchange: exchange,
+		asset:    a,
+		pair:     p,
+		depth:    d,
+		id:       id,
+		config:   c,
+	}, nil
+}
+
+// Start observes the depth and calculates its analytics until stopped. When
+// the depth holds a valid book its analytics are calculated before Start
+// returns
+func (t *Tracker) Start() error {
+	if t == nil {
+		return fmt.Errorf("%T %w", t, common.ErrNilPointer)
+	}
+	t.m.Lock()
+	defer t.m.Unlock()
+	if t.observer != nil {
+		return errTrackerAlreadyStarted
+	}
+	t.calculator = &Calculator{config: t.config}
+	o, err := t.depth.ObserveBuffered(t.config.BufferSize)
+	if err != nil {
+		return err
+	}
+	t.observer = o
+	if len(o.Changes()) > 0 {
+		t.applyPending(o.Changes())
+	}
+	t.shutdown = make(chan struct{})
+	t.wg.Add(1)
+	go t.run(o, t.shutdown)
+	return nil
+}
+
+// Stop stops observing the depth. The latest metrics remain available
+func (t *Tracker) Stop() error {
+	if t == nil {
+		return fmt.Errorf("%T %w", t, common.ErrNilPointer)
+	}
+	t.m.Lock()
+	defer t.m.Unlock()
+	if t.observer == nil {
+		return errTrackerNotStarted
+	}
+	t.observer.Close()
+	close(t.shutdown)
+	t.wg.Wait()
+	t.dropped.Add(t.observer.Dropped())
+	t.observer = nil
+	return nil
+}
+
+// IsRunning returns whether the tracker is observing its depth
+func (t *Tracker) IsRunning() bool {
+	if t == nil {
+		return false
+	}
+	t.m.Lock()
+	defer t.m.Unlock()
+	return t.observer != nil
+}
+
+// Metrics returns the latest analytics calculated
+func (t *Tracker) Metrics() (*Metrics, error) {
+	if t == nil {
+		return nil, fmt.Errorf("%T %w", t, common.ErrNilPointer)
+	}
+	m := t.latest.Load()
+	if m == nil {
+		return nil, fmt.Errorf("%w for %s %s %s", ErrNoMetrics, t.exchange, t.asset, t.pair)
+	}
+	cpy := *m // Don't let external functions have access to published metrics
+	cpy.Bands = slices.Clone(m.Bands)
+	return &cpy, nil
+}
+
+// Subscribe returns a pipe which receives *Metrics each time they are
+// recalculated. Received metrics are shared between subscribers so must not be
+// modified
+func (t *Tracker) Subscribe() (dispatch.Pipe, error) {
+	if t == nil {
+		return dispatch.Pipe{}, fmt.Errorf("%T %w", t, common.ErrNilPointer)
+	}
+	return service.mux.Subscribe(t.id)
+}
+
+// Release releases a subscriber of a tracker returned by TrackSubscriber. The
+// tracker is stopped once its last subscriber is released, unless the
+// orderbook is also tracked by Track
+func (t *Tracker) Release() error {
+	if t == nil {
+		return fmt.Errorf("%T %w", t, common.ErrNilPointer)
+	}
+	service.mu.Lock()
+	if t.subscribers == 0 {
+		service.mu.Unlock()
+		return fmt.Errorf("%w %s %s %s", errNoSubscribers, t.exchange, t.asset, t.pair)
+	}
+	t.subscribers--
+	k := trackerKey(t.exchange, t.asset, t.pair)
+	stop := t.subscribers == 0 && !t.held && service.trackers[k] == t
+	if stop {
+		delete(service.trackers, k)
+	}
+	service.mu.Unlock()
+	if stop {
+		return t.Stop()
+	}
+	return nil
+}
+
+// Dropped returns the number of changes dropped while the tracker was behind
+func (t *Tracker) Dropped() uint64 {
+	t.m.Lock()
+	defer t.m.Unlock()
+	dropped := t.dropped.Load()
+	if t.observer != nil {
+		dropped += t.observer.Dropped()
+	}
+	return dropped
+}
+
+// run applies buffered changes until shutdown
+func (t *Tracker) run(o *orderbook.BufferedObserver, shutdown <-chan struct{}) {
+	defer t.wg.Done()
+	changes := o.Changes()
+	for {
+		select {
+		case <-shutdown:
+			return
+		case c := <-changes:
+			t.apply(c)
+			t.applyPending(changes)
+		}
+		if o.Resyncing() {
+			if err := o.Snapshot(); err != nil {
+				log.Errorf(log.OrderBook, "Orderbook analytics %s %s %s snapshot error: %v", t.exchange, t.asset, t.pair, err)
+			}
+		}
+	}
+}
+
+// applyPending applies the changes buffered and publishes the resulting
+// metrics, so bursts of changes are published once. The latest metrics are
+// cleared while the book is invalid or one sided
+func (t *Tracker) applyPending(changes <-chan *orderbook.Change) {
+	for range len(changes) {
+		t.apply(<-changes)
+	}
+	m, err := t.calculator.Metrics()
+	if err != nil {
+		t.latest.Store(nil)
+		return
+	}
+	t.latest.Store(m)
+	if err := service.mux.Publish(m, t.id); err != nil {
+		log.Errorf(log.OrderBook, "Orderbook analytics %s %s %s publish error: %v", t.exchange, t.asset, t.pair, err)
+	}
+}
+
+func (t *Tracker) apply(c *orderbook.Change) {
+	if err := t.calculator.Apply(c); err != nil {
+		log.Errorf(log.OrderBook, "Orderbook analytics %s %s %s error: %v", t.exchange, t.asset, t.pair, err)
+	}
+}
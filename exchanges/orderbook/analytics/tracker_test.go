@@ -0,0 +1,230 @@
+package analytics
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+func newTestDepth(t *testing.T) *orderbook.Depth {
+	t.Helper()
+	d := orderbook.NewDepth(uuid.Must(uuid.NewV4()))
+	d.AssignOptions(&orderbook.Book{Exchange: "Test", Pair: testPair, Asset: asset.Spot})
+	return d
+}
+
+func loadTestSnapshot(t *testing.T, d *orderbook.Depth) {
+	t.Helper()
+	s := testSnapshot()
+	err := d.LoadSnapshot(&orderbook.Book{Bids: s.Bids, Asks: s.Asks, LastUpdated: s.UpdateTime, LastUpdateID: s.UpdateID})
+	require.NoError(t, err, "LoadSnapshot must not error")
+}
+
+func TestNewTracker(t *testing.T) {
+	t.Parallel()
+	d := newTestDepth(t)
+	_, err := NewTracker(nil, "Test", asset.Spot, testPair, nil)
+	assert.ErrorIs(t, err, errNilDepth)
+	_, err = NewTracker(d, "", asset.Spot, testPair, nil)
+	assert.ErrorIs(t, err, common.ErrExchangeNameNotSet)
+	_, err = NewTracker(d, "Test", asset.Empty, testPair, nil)
+	assert.ErrorIs(t, err, asset.ErrNotSupported)
+	_, err = NewTracker(d, "Test", asset.Spot, currency.EMPTYPAIR, nil)
+	assert.ErrorIs(t, err, currency.ErrCurrencyPairEmpty)
+	_, err = NewTracker(d, "Test", asset.Spot, testPair, &Config{Levels: -1})
+	assert.ErrorIs(t, err, errInvalidLevels)
+
+	tr, err := NewTracker(d, "Test", asset.Spot, testPair, nil)
+	require.NoError(t, err)
+	assert.False(t, tr.id.IsNil())
+	assert.Equal(t, DefaultBufferSize, tr.config.BufferSize)
+}
+
+func TestTrackerStartStop(t *testing.T) {
+	t.Parallel()
+	var nilTracker *Tracker
+	assert.ErrorIs(t, nilTracker.Start(), common.ErrNilPointer)
+	assert.ErrorIs(t, nilTracker.Stop(), common.ErrNilPointer)
+	assert.False(t, nilTracker.IsRunning())
+	_, err := nilTracker.Metrics()
+	assert.ErrorIs(t, err, common.ErrNilPointer)
+
+	d := newTestDepth(t)
+	tr, err := NewTracker(d, "Test", asset.Spot, testPair, nil)
+	require.NoError(t, err)
+	assert.ErrorIs(t, tr.Stop(), errTrackerNotStarted)
+	require.NoError(t, tr.Start())
+	assert.ErrorIs(t, tr.Start(), errTrackerAlreadyStarted)
+	assert.True(t, tr.IsRunning())
+	_, err = tr.Metrics()
+	assert.ErrorIs(t, err, ErrNoMetrics, "Metrics should error before the book is loaded")
+
+	loadTestSnapshot(t, d)
+	assert.Eventually(t, func() bool {
+		m, err := tr.Metrics()
+		return err == nil && m.BestBid == 100
+	}, time.Second, time.Millisecond, "Metrics should be calculated from the snapshot")
+
+	err = d.ProcessUpdate(&orderbook.Update{UpdateID: 2, UpdateTime: testStart.Add(time.Second), Asset: asset.Spot, Bids: orderbook.Levels{{Price: 100.5, Amount: 1}}})
+	require.NoError(t, err)
+	assert.Eventually(t, func() bool {
+		m, err := tr.Metrics()
+		return err == nil && m.BestBid == 100.5 && m.Bids.ReplenishedPerSecond == 1
+	}, time.Second, time.Millisecond, "Metrics should be calculated from updates")
+
+	require.NoError(t, tr.Stop())
+	assert.False(t, tr.IsRunning())
+	m, err := tr.Metrics()
+	require.NoError(t, err, "Metrics must remain available once stopped")
+	assert.Equal(t, int64(2), m.UpdateID)
+
+	require.NoError(t, tr.Start())
+	m, err = tr.Metrics()
+	require.NoError(t, err)
+	assert.Equal(t, Flow{}, m.Bids, "Metrics should be recalculated from the current book when started")
+	require.NoError(t, tr.Stop())
+}
+
+func TestTrackerInvalidated(t *testing.T) {
+	t.Parallel()
+	d := newTestDepth(t)
+	loadTestSnapshot(t, d)
+	tr, err := NewTracker(d, "Test", asset.Spot, testPair, nil)
+	require.NoError(t, err)
+	require.NoError(t, tr.Start())
+	_, err = tr.Metrics()
+	require.NoError(t, err)
+
+	require.ErrorIs(t, d.Invalidate(nil), orderbook.ErrOrderbookInvalid)
+	assert.Eventually(t, func() bool {
+		_, err := tr.Metrics()
+		return err != nil
+	}, time.Second, time.Millisecond, "Metrics should be cleared once the depth is invalidated")
+	require.NoError(t, tr.Stop())
+}
+
+func TestTrack(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, dispatch.EnsureRunning(dispatch.DefaultMaxWorkers, dispatch.DefaultJobsLimit), "dispatch.EnsureRunning must not error")
+
+	_, err := Track("", testPair, asset.Spot, nil)
+	assert.ErrorIs(t, err, common.ErrExchangeNameNotSet)
+	_, err = Track("TrackTest", testPair, asset.Spot, nil)
+	assert.ErrorIs(t, err, orderbook.ErrOrderbookNotFound)
+	_, err = GetMetrics("TrackTest", testPair, asset.Spot)
+	assert.ErrorIs(t, err, ErrNotTracked)
+	_, err = Subscribe("TrackTest", testPair, asset.Spot)
+	assert.ErrorIs(t, err, ErrNotTracked)
+	assert.ErrorIs(t, Untrack("TrackTest", testPair, asset.Spot), ErrNotTracked)
+
+	d, err := orderbook.DeployDepth("TrackTest", testPair, asset.Spot)
+	require.NoError(t, err, "DeployDepth must not error")
+	loadTestSnapshot(t, d)
+
+	tr, err := Track("TrackTest", testPair, asset.Spot, &Config{Bands: []float64{100}})
+	require.NoError(t, err)
+	again, err := Track("tracktest", testPair, asset.Spot, nil)
+	require.NoError(t, err)
+	assert.Same(t, tr, again, "Track should return the existing tracker")
+	again, err = Track("TrackTest", testPair, asset.Spot, &Config{Bands: []float64{100}, Window: DefaultWindow})
+	require.NoError(t, err)
+	assert.Same(t, tr, again, "Track should accept a matching config")
+	_, err = Track("TrackTest", testPair, asset.Spot, &Config{Bands: []float64{50}})
+	assert.ErrorIs(t, err, errTrackedWithDifferentConfig)
+
+	m, err := GetMetrics("TrackTest", testPair, asset.Spot)
+	require.NoError(t, err, "GetMetrics must not error once tracked")
+	require.Len(t, m.Bands, 1)
+	assert.Equal(t, 100.0, m.Bands[0].Bps)
+
+	pipe, err := Subscribe("TrackTest", testPair, asset.Spot)
+	require.NoError(t, err)
+	defer func() { assert.NoError(t, pipe.Release()) }()
+
+	err = d.ProcessUpdate(&orderbook.Update{UpdateID: 2, UpdateTime: testStart.Add(time.Second), Asset: asset.Spot, Asks: orderbook.Levels{{Price: 100.5, Amount: 1}}})
+	require.NoError(t, err)
+	timeout := time.After(time.Second)
+	for published := false; !published; {
+		select {
+		case data := <-pipe.Channel():
+			m, ok := data.(*Metrics)
+			require.True(t, ok, "Published data must be metrics")
+			published = m.BestAsk == 100.5
+		case <-timeout:
+			require.Fail(t, "Metrics should be published")
+		}
+	}
+
+	require.NoError(t, Untrack("TrackTest", testPair, asset.Spot))
+	assert.False(t, tr.IsRunning())
+	_, err = GetMetrics("TrackTest", testPair, asset.Spot)
+	assert.ErrorIs(t, err, ErrNotTracked)
+}
+
+func TestTrackSubscriber(t *testing.T) {
+	t.Parallel()
+	d, err := orderbook.DeployDepth("SubscriberTest", testPair, asset.Spot)
+	require.NoError(t, err, "DeployDepth must not error")
+	loadTestSnapshot(t, d)
+
+	tr, err := TrackSubscriber("SubscriberTest", testPair, asset.Spot, nil)
+	require.NoError(t, err)
+	again, err := TrackSubscriber("SubscriberTest", testPair, asset.Spot, nil)
+	require.NoError(t, err)
+	assert.Same(t, tr, again, "TrackSubscriber should return the existing tracker")
+	require.NoError(t, tr.Release())
+	assert.True(t, tr.IsRunning(), "Release should not stop a tracker with subscribers remaining")
+	require.NoError(t, tr.Release())
+	assert.False(t, tr.IsRunning(), "Release should stop a tracker once its last subscriber is released")
+	assert.ErrorIs(t, tr.Release(), errNoSubscribers)
+	_, err = GetMetrics("SubscriberTest", testPair, asset.Spot)
+	assert.ErrorIs(t, err, ErrNotTracked)
+
+	tr, err = TrackSubscriber("SubscriberTest", testPair, asset.Spot, nil)
+	require.NoError(t, err)
+	_, err = Track("SubscriberTest", testPair, asset.Spot, nil)
+	require.NoError(t, err)
+	require.NoError(t, tr.Release())
+	assert.True(t, tr.IsRunning(), "Release should not stop a tracker held by Track")
+
+	tr, err = TrackSubscriber("SubscriberTest", testPair, asset.Spot, nil)
+	require.NoError(t, err)
+	require.NoError(t, Untrack("SubscriberTest", testPair, asset.Spot))
+	assert.True(t, tr.IsRunning(), "Untrack should not stop a tracker with subscribers remaining")
+	require.NoError(t, tr.Release())
+	assert.False(t, tr.IsRunning(), "Release should stop an untracked tracker")
+}
+
+func TestUntrackAll(t *testing.T) {
+	// Not parallel as untracking every orderbook would interfere with other tests
+	trackers := make([]*Tracker, 0, MaxTrackers)
+	for i := range MaxTrackers {
+		exch := fmt.Sprintf("UntrackAllTest%d", i)
+		_, err := orderbook.DeployDepth(exch, testPair, asset.Spot)
+		require.NoError(t, err, "DeployDepth must not error")
+		tr, err := Track(exch, testPair, asset.Spot, nil)
+		require.NoError(t, err, "Track must not error")
+		trackers = append(trackers, tr)
+	}
+	_, err := orderbook.DeployDepth("UntrackAllTestLimit", testPair, asset.Spot)
+	require.NoError(t, err, "DeployDepth must not error")
+	_, err = Track("UntrackAllTestLimit", testPair, asset.Spot, nil)
+	assert.ErrorIs(t, err, ErrTooManyTracked)
+
+	require.NoError(t, UntrackAll())
+	for _, tr := range trackers {
+		assert.False(t, tr.IsRunning(), "UntrackAll should stop every tracker")
+	}
+	_, err = Track("UntrackAllTestLimit", testPair, asset.Spot, nil)
+	require.NoError(t, err, "Track must not error once trackers are untracked")
+	require.NoError(t, UntrackAll())
+}
@@ -0,0 +1,120 @@
+package capture
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common/file/archive"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+// Default capture settings
+const (
+	DefaultSnapshotInterval = time.Minute
+	DefaultFlushInterval    = time.Second
+	DefaultMaxFileSize      = 64 << 20
+	DefaultMaxFileAge       = time.Hour
+	DefaultBufferSize       = 4096
+)
+
+const (
+	filePrefix    = "orderbook"
+	fileExtension = ".jsonl"
+)
+
+// Public errors
+var (
+	ErrNoCaptureFiles = errors.New("no orderbook capture files")
+	ErrNoSnapshot     = errors.New("no orderbook snapshot captured")
+	ErrCaptureGap     = errors.New("orderbook invalid or not captured")
+)
+
+var (
+	errNilDepth                = errors.New("orderbook depth is nil")
+	errDirectoryNotSet         = errors.New("capture directory not set")
+	errExchangeNameUnset       = errors.New("exchange name unset")
+	errInvalidInterval         = errors.New("capture intervals cannot be negative")
+	errRecorderAlreadyStarted  = errors.New("recorder already started")
+	errRecorderNotStarted      = errors.New("recorder not started")
+	errInvalidRecord           = errors.New("invalid orderbook capture record")
+	errInvalidBufferSize       = errors.New("buffer size cannot be negative")
+	errCaptureRecordOutOfScope = errors.New("capture record is for a different orderbook")
+)
+
+// Config defines how an orderbook is captured. Zero values use the defaults
+type Config struct {
+	// Directory is the root directory captures are written to, each orderbook
+	// is written to its own exchange, asset and pair subdirectory
+	Directory string
+	// SnapshotInterval is how often the full book is written between updates
+	SnapshotInterval time.Duration
+	// FlushInterval is how often written data is flushed to disk
+	FlushInterval time.Duration
+	// MaxFileSize is the compressed size in bytes a file is rotated at
+	MaxFileSize int64
+	// MaxFileAge is how long a file is written to before it is rotated
+	MaxFileAge time.Duration
+	// BufferSize is the number of changes held while waiting to be written.
+	// When full, changes are dropped until the next snapshot is written
+	BufferSize int
+}
+
+// Recorder writes the snapshots and incremental updates applied to an
+// orderbook depth to rotating, gzip compressed, append only files. Each file
+// begins with a snapshot so it can be read without the files before it
+type Recorder struct {
+	exchange string
+	asset    asset.Item
+	pair     currency.Pair
+	depth    *orderbook.Depth
+	writer   *archive.RotatingGzipWriter
+
+	snapshotInterval time.Duration
+	flushInterval    time.Duration
+	bufferSize       int
+
+	observer *orderbook.BufferedObserver
+	// dropped is the number of changes dropped by previous observers
+	dropped  atomic.Uint64
+	written  atomic.Uint64
+	shutdown chan struct{}
+	wg       sync.WaitGroup
+	m        sync.Mutex
+}
+
+// Stats holds the number of changes a recorder has written and dropped
+type Stats struct {
+	Written uint64
+	Dropped uint64
+	File    string
+}
+
+// Reader reconstructs an orderbook from its captured files
+type Reader struct {
+	exchange string
+	asset    asset.Item
+	pair     currency.Pair
+	files    []string
+}
+
+// record is the line delimited JSON format of captured changes. Timestamps are
+// unix nanoseconds. Invalidated records mark where the book was invalidated or
+// changes were dropped, the book is unknown until the next snapshot. The format
+// can be read by the backtester orderbook replay
+type record struct {
+	Exchange    string                           `json:"exchange,omitempty"`
+	Asset       string                           `json:"asset,omitempty"`
+	Pair        string                           `json:"pair,omitempty"`
+	Timestamp   int64                            `json:"timestamp"`
+	Pushed      int64                            `json:"pushed,omitempty"`
+	UpdateID    int64                            `json:"update_id"`
+	Snapshot    bool                             `json:"snapshot"`
+	Invalidated bool                             `json:"invalidated,omitempty"`
+	MaxDepth    int                              `json:"max_depth,omitempty"`
+	Bids        orderbook.LevelsArrayPriceAmount `json:"bids"`
+	Asks        orderbook.LevelsArrayPriceAmount `json:"asks"`
+}
@@ -0,0 +1,196 @@
+package capture
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/common/file/archive"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/encoding/json"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+// NewReader returns a reader of the files an orderbook was captured to under
+// the root capture directory
+func NewReader(root, exchange string, a asset.Item, p currency.Pair) (*Reader, error) {
+	if root == "" {
+		return nil, errDirectoryNotSet
+	}
+	if exchange == "" {
+		return nil, errExchangeNameUnset
+	}
+	if !a.IsValid() {
+		return nil, fmt.Errorf("%w %q", asset.ErrNotSupported, a)
+	}
+	if p.IsEmpty() {
+		return nil, currency.ErrCurrencyPairEmpty
+	}
+	files, err := archive.RotatingGzipFiles(Dir(root, exchange, a, p), filePrefix, fileExtension)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("%w for %s %s %s", ErrNoCaptureFiles, exchange, a, p)
+	}
+	return &Reader{exchange: exchange, asset: a, pair: p, files: files}, nil
+}
+
+// Files returns the captured files in the order they were written
+func (r *Reader) Files() []string {
+	return append([]string(nil), r.files...)
+}
+
+// Changes calls fn with each captured change in the order they were applied to
+// the orderbook. Returning io.EOF from fn stops reading without error
+func (r *Reader) Changes(fn func(*orderbook.Change) error) error {
+	return r.changesFrom(0, fn)
+}
+
+// BookAt reconstructs the orderbook as it was at the time provided from the
+// latest snapshot captured at or before it and the updates which followed.
+// ErrCaptureGap is returned when the book was invalidated or changes were
+// dropped after the snapshot and no snapshot followed before the time provided
+func (r *Reader) BookAt(t time.Time) (*orderbook.Book, error) {
+	start := -1
+	for i := range r.files {
+		c, err := r.firstChange(i)
+		if err != nil {
+			return nil, err
+		}
+		if c == nil || !c.Snapshot {
+			continue
+		}
+		if c.UpdateTime.After(t) {
+			break
+		}
+		start = i
+	}
+	if start == -1 {
+		return nil, fmt.Errorf("%w for %s %s %s at or before %s", ErrNoSnapshot, r.exchange, r.asset, r.pair, t)
+	}
+	id, err := uuid.NewV4()
+	if err != nil {
+		return nil, err
+	}
+	depth := orderbook.NewDepth(id)
+	var gap time.Time
+	err = r.changesFrom(start, func(c *orderbook.Change) error {
+		if c.UpdateTime.After(t) {
+			return io.EOF
+		}
+		if c.Invalidated {
+			gap = c.UpdateTime
+			return nil
+		}
+		if c.Snapshot {
+			gap = time.Time{}
+			depth.AssignOptions(&orderbook.Book{Exchange: r.exchange, Pair: r.pair, Asset: r.asset, MaxDepth: c.MaxDepth})
+			return depth.LoadSnapshot(&orderbook.Book{
+				Bids:         c.Bids,
+				Asks:         c.Asks,
+				Exchange:     r.exchange,
+				Pair:         r.pair,
+				Asset:        r.asset,
+				LastUpdated:  c.UpdateTime,
+				LastPushed:   c.LastPushed,
+				LastUpdateID: c.UpdateID,
+			})
+		}
+		if !gap.IsZero() {
+			return nil
+		}
+		return depth.ProcessUpdate(&orderbook.Update{
+			UpdateID:   c.UpdateID,
+			UpdateTime: c.UpdateTime,
+			LastPushed: c.LastPushed,
+			Asset:      r.asset,
+			Pair:       r.pair,
+			Bids:       c.Bids,
+			Asks:       c.Asks,
+			AllowEmpty: true,
+		})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s %s %s: %w", r.exchange, r.asset, r.pair, err)
+	}
+	if !gap.IsZero() {
+		return nil, fmt.Errorf("%w for %s %s %s at %s since %s", ErrCaptureGap, r.exchange, r.asset, r.pair, t, gap)
+	}
+	return depth.Retrieve()
+}
+
+// firstChange returns the first change captured to a file or nil if the file
+// is empty
+func (r *Reader) firstChange(i int) (*orderbook.Change, error) {
+	var first *orderbook.Change
+	err := archive.ReadGzipLines(r.files[i], func(line []byte) error {
+		var err error
+		if first, err = r.decode(line); err != nil {
+			return err
+		}
+		return io.EOF
+	})
+	return first, err
+}
+
+// changesFrom calls fn with each change captured, beginning with a file index
+func (r *Reader) changesFrom(i int, fn func(*orderbook.Change) error) error {
+	var stopped bool
+	for ; i < len(r.files) && !stopped; i++ {
+		err := archive.ReadGzipLines(r.files[i], func(line []byte) error {
+			c, err := r.decode(line)
+			if err != nil {
+				return err
+			}
+			if err := fn(c); err != nil {
+				if errors.Is(err, io.EOF) {
+					stopped = true
+				}
+				return err
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// decode decodes a captured line, erroring if it is for another orderbook
+func (r *Reader) decode(line []byte) (*orderbook.Change, error) {
+	var rec record
+	if err := json.Unmarshal(line, &rec); err != nil {
+		return nil, fmt.Errorf("%w: %w", errInvalidRecord, err)
+	}
+	if rec.Timestamp == 0 {
+		return nil, fmt.Errorf("%w: update ID %d timestamp unset", errInvalidRecord, rec.UpdateID)
+	}
+	if (rec.Exchange != "" && !strings.EqualFold(rec.Exchange, r.exchange)) ||
+		(rec.Asset != "" && rec.Asset != r.asset.String()) ||
+		(rec.Pair != "" && rec.Pair != formatPair(r.pair)) {
+		return nil, fmt.Errorf("%w: %s %s %s", errCaptureRecordOutOfScope, rec.Exchange, rec.Asset, rec.Pair)
+	}
+	c := &orderbook.Change{
+		Exchange:    r.exchange,
+		Pair:        r.pair,
+		Asset:       r.asset,
+		UpdateID:    rec.UpdateID,
+		UpdateTime:  time.Unix(0, rec.Timestamp).UTC(),
+		Snapshot:    rec.Snapshot,
+		Invalidated: rec.Invalidated,
+		Bids:        rec.Bids.Levels(),
+		Asks:        rec.Asks.Levels(),
+		MaxDepth:    rec.MaxDepth,
+	}
+	if rec.Pushed != 0 {
+		c.LastPushed = time.Unix(0, rec.Pushed).UTC()
+	}
+	return c, nil
+}
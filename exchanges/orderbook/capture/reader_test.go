@@ -0,0 +1,138 @@
+package capture
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/common/file/archive"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+// writeTestFile writes lines to a new capture file. When closeFile is false
+// the file is left open, truncated part way through its final line
+func writeTestFile(t *testing.T, dir string, closeFile bool, lines ...string) {
+	t.Helper()
+	w, err := archive.NewRotatingGzipWriter(Dir(dir, "Test", asset.Spot, testPair), filePrefix, fileExtension, 0, 0)
+	require.NoError(t, err)
+	for i, l := range lines {
+		if closeFile || i != len(lines)-1 {
+			l += "\n"
+		}
+		_, err = w.Write([]byte(l))
+		require.NoError(t, err)
+	}
+	if closeFile {
+		require.NoError(t, w.Close())
+		return
+	}
+	require.NoError(t, w.Flush())
+	t.Cleanup(func() { assert.NoError(t, w.Close()) })
+}
+
+// ts returns the unix nanosecond timestamp of the test start plus a duration
+func ts(d time.Duration) string {
+	return strconv.FormatInt(testStart.Add(d).UnixNano(), 10)
+}
+
+func TestNewReader(t *testing.T) {
+	t.Parallel()
+	_, err := NewReader("", "Test", asset.Spot, testPair)
+	assert.ErrorIs(t, err, errDirectoryNotSet)
+	_, err = NewReader(t.TempDir(), "", asset.Spot, testPair)
+	assert.ErrorIs(t, err, errExchangeNameUnset)
+	_, err = NewReader(t.TempDir(), "Test", asset.Empty, testPair)
+	assert.ErrorIs(t, err, asset.ErrNotSupported)
+	_, err = NewReader(t.TempDir(), "Test", asset.Spot, currency.EMPTYPAIR)
+	assert.ErrorIs(t, err, currency.ErrCurrencyPairEmpty)
+	_, err = NewReader(t.TempDir(), "Test", asset.Spot, testPair)
+	assert.ErrorIs(t, err, ErrNoCaptureFiles)
+}
+
+func TestReaderBookAt(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	writeTestFile(t, dir, true,
+		`{"exchange":"Test","asset":"spot","pair":"BTC-USDT","timestamp":`+ts(0)+`,"update_id":1,"snapshot":true,"max_depth":2,"bids":[[100,1],[99,1]],"asks":[[101,1]]}`,
+		`{"timestamp":`+ts(time.Second)+`,"update_id":2,"snapshot":false,"bids":[[100.5,3]],"asks":[]}`,
+		`{"timestamp":`+ts(40*time.Second)+`,"update_id":0,"snapshot":false,"invalidated":true,"bids":null,"asks":null}`,
+		`{"timestamp":`+ts(50*time.Second)+`,"update_id":3,"snapshot":false,"bids":[[100,0]],"asks":[]}`,
+	)
+	time.Sleep(time.Millisecond)
+	// The final file was not closed and ends with a partially written line
+	writeTestFile(t, dir, false,
+		`{"timestamp":`+ts(time.Minute)+`,"update_id":3,"snapshot":true,"bids":[[90,1]],"asks":[[91,1]]}`,
+		`{"timestamp":`+ts(time.Minute+time.Second)+`,"update_id":4,"snapshot":false,"bids":[[90,0]],"asks":[]}`,
+		`{"timestamp":`+ts(2*time.Minute),
+	)
+
+	r, err := NewReader(dir, "test", asset.Spot, testPair)
+	require.NoError(t, err)
+
+	_, err = r.BookAt(testStart.Add(-time.Second))
+	assert.ErrorIs(t, err, ErrNoSnapshot)
+
+	book, err := r.BookAt(testStart)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), book.LastUpdateID)
+	assert.Equal(t, testStart, book.LastUpdated)
+	assert.Equal(t, orderbook.Levels{{Price: 100, Amount: 1}, {Price: 99, Amount: 1}}, book.Bids)
+
+	book, err = r.BookAt(testStart.Add(30 * time.Second))
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), book.LastUpdateID)
+	assert.Equal(t, orderbook.Levels{{Price: 100.5, Amount: 3}, {Price: 100, Amount: 1}}, book.Bids, "Updates should be truncated to the captured max depth")
+	assert.Equal(t, orderbook.Levels{{Price: 101, Amount: 1}}, book.Asks)
+
+	_, err = r.BookAt(testStart.Add(40 * time.Second))
+	assert.ErrorIs(t, err, ErrCaptureGap, "BookAt should error between an invalidation and the next snapshot")
+	_, err = r.BookAt(testStart.Add(50 * time.Second))
+	assert.ErrorIs(t, err, ErrCaptureGap, "Updates should not be applied after an invalidation")
+
+	book, err = r.BookAt(testStart.Add(time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), book.LastUpdateID)
+	assert.Empty(t, book.Bids)
+	assert.Equal(t, orderbook.Levels{{Price: 91, Amount: 1}}, book.Asks)
+
+	var count int
+	require.NoError(t, r.Changes(func(*orderbook.Change) error {
+		count++
+		return io.EOF
+	}))
+	assert.Equal(t, 1, count, "Returning io.EOF should stop reading")
+}
+
+func TestReaderInvalidRecords(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	writeTestFile(t, dir, true, `{"exchange":"Other","timestamp":1,"snapshot":true}`)
+	r, err := NewReader(dir, "Test", asset.Spot, testPair)
+	require.NoError(t, err)
+	_, err = r.BookAt(testStart)
+	assert.ErrorIs(t, err, errCaptureRecordOutOfScope)
+
+	dir = t.TempDir()
+	writeTestFile(t, dir, true, `{"update_id":1,"snapshot":true}`)
+	r, err = NewReader(dir, "Test", asset.Spot, testPair)
+	require.NoError(t, err)
+	_, err = r.BookAt(testStart)
+	assert.ErrorIs(t, err, errInvalidRecord)
+
+	dir = t.TempDir()
+	writeTestFile(t, dir, true, `not json`)
+	r, err = NewReader(dir, "Test", asset.Spot, testPair)
+	require.NoError(t, err)
+	assert.ErrorIs(t, r.Changes(func(*orderbook.Change) error { return nil }), errInvalidRecord)
+
+	require.NoError(t, os.Remove(r.Files()[0]))
+	assert.ErrorIs(t, r.Changes(func(*orderbook.Change) error { return nil }), os.ErrNotExist)
+	assert.Equal(t, filepath.Dir(r.Files()[0]), Dir(dir, "Test", asset.Spot, testPair))
+}
@@ -0,0 +1,251 @@
+package capture
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/file/archive"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/encoding/json"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// Dir returns the directory an orderbook is captured to under the root
+// capture directory
+func Dir(root, exchange string, a asset.Item, p currency.Pair) string {
+	return filepath.Join(root, strings.ToLower(exchange), a.String(), formatPair(p))
+}
+
+// NewRecorder returns a recorder of an orderbook depth
+func NewRecorder(d *orderbook.Depth, exchange string, a asset.Item, p currency.Pair, cfg *Config) (*Recorder, error) {
+	if d == nil {
+		return nil, errNilDepth
+	}
+	if exchange == "" {
+		return nil, errExchangeNameUnset
+	}
+	if !a.IsValid() {
+		return nil, fmt.Errorf("%w %q", asset.ErrNotSupported, a)
+	}
+	if p.IsEmpty() {
+		return nil, currency.ErrCurrencyPairEmpty
+	}
+	if cfg == nil || cfg.Directory == "" {
+		return nil, errDirectoryNotSet
+	}
+	if cfg.SnapshotInterval < 0 || cfg.FlushInterval < 0 {
+		return nil, errInvalidInterval
+	}
+	if cfg.BufferSize < 0 {
+		return nil, errInvalidBufferSize
+	}
+	r := &Recorder{
+		exchange:         exchange,
+		asset:            a,
+		pair:             p,
+		depth:            d,
+		snapshotInterval: cfg.SnapshotInterval,
+		flushInterval:    cfg.FlushInterval,
+		bufferSize:       cfg.BufferSize,
+	}
+	if r.snapshotInterval == 0 {
+		r.snapshotInterval = DefaultSnapshotInterval
+	}
+	if r.flushInterval == 0 {
+		r.flushInterval = DefaultFlushInterval
+	}
+	if r.bufferSize == 0 {
+		r.bufferSize = DefaultBufferSize
+	}
+	maxSize, maxAge := cfg.MaxFileSize, cfg.MaxFileAge
+	if maxSize == 0 {
+		maxSize = DefaultMaxFileSize
+	}
+	if maxAge == 0 {
+		maxAge = DefaultMaxFileAge
+	}
+	var err error
+	r.writer, err = archive.NewRotatingGzipWriter(Dir(cfg.Directory, exchange, a, p), filePrefix, fileExtension, maxSize, maxAge)
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Start observes the depth and writes its changes until stopped. The current
+// book is written as a snapshot when the depth holds a valid book, otherwise
+// writing begins with the next snapshot loaded
+func (r *Recorder) Start() error {
+	if r == nil {
+		return fmt.Errorf("%T %w", r, common.ErrNilPointer)
+	}
+	r.m.Lock()
+	defer r.m.Unlock()
+	if r.observer != nil {
+		return errRecorderAlreadyStarted
+	}
+	o, err := r.depth.ObserveBuffered(r.bufferSize)
+	if err != nil {
+		return err
+	}
+	r.observer = o
+	r.shutdown = make(chan struct{})
+	r.wg.Add(1)
+	go r.run(o, r.shutdown)
+	return nil
+}
+
+// Stop stops observing the depth, writes any buffered changes and closes the
+// current file
+func (r *Recorder) Stop() error {
+	if r == nil {
+		return fmt.Errorf("%T %w", r, common.ErrNilPointer)
+	}
+	r.m.Lock()
+	defer r.m.Unlock()
+	if r.observer == nil {
+		return errRecorderNotStarted
+	}
+	r.observer.Close()
+	close(r.shutdown)
+	r.wg.Wait()
+	r.dropped.Add(r.observer.Dropped())
+	r.observer = nil
+	return r.writer.Close()
+}
+
+// IsRunning returns whether the recorder is observing its depth
+func (r *Recorder) IsRunning() bool {
+	if r == nil {
+		return false
+	}
+	r.m.Lock()
+	defer r.m.Unlock()
+	return r.observer != nil
+}
+
+// Stats returns the number of changes written and dropped and the file being
+// written to
+func (r *Recorder) Stats() Stats {
+	r.m.Lock()
+	defer r.m.Unlock()
+	s := Stats{
+		Written: r.written.Load(),
+		Dropped: r.dropped.Load(),
+		File:    r.writer.Filename(),
+	}
+	if r.observer != nil {
+		s.Dropped += r.observer.Dropped()
+	}
+	return s
+}
+
+// run writes buffered changes, periodic snapshots and flushes the current file
+// until shutdown
+func (r *Recorder) run(o *orderbook.BufferedObserver, shutdown <-chan struct{}) {
+	defer r.wg.Done()
+	snapshotTicker := time.NewTicker(r.snapshotInterval)
+	defer snapshotTicker.Stop()
+	flushTicker := time.NewTicker(r.flushInterval)
+	defer flushTicker.Stop()
+	// synced is set once a snapshot is written and unset when changes are
+	// dropped. rotating is set while waiting on a snapshot to begin a new file
+	var synced, rotating bool
+	changes := o.Changes()
+	for {
+		select {
+		case <-shutdown:
+			for {
+				select {
+				case c := <-changes:
+					r.write(nil, c, &synced, &rotating)
+				default:
+					return
+				}
+			}
+		case c := <-changes:
+			r.write(o, c, &synced, &rotating)
+		case <-snapshotTicker.C:
+			r.requestSnapshot(o)
+		case <-flushTicker.C:
+			if err := r.writer.Flush(); err != nil {
+				log.Errorf(log.OrderBook, "Orderbook capture %s %s %s flush error: %v", r.exchange, r.asset, r.pair, err)
+			}
+		}
+		if o.Resyncing() {
+			if synced {
+				synced = false
+				log.Warnf(log.OrderBook, "Orderbook capture %s %s %s dropped updates, waiting on a snapshot to resume", r.exchange, r.asset, r.pair)
+			}
+			r.requestSnapshot(o)
+		}
+	}
+}
+
+// write writes a change to the current file. A new file is started with the
+// first snapshot written once the current file is due to be rotated
+func (r *Recorder) write(o *orderbook.BufferedObserver, c *orderbook.Change, synced, rotating *bool) {
+	if r.writer.RotationDue() {
+		if c.Snapshot {
+			if err := r.writer.Rotate(); err != nil {
+				log.Errorf(log.OrderBook, "Orderbook capture %s %s %s rotate error: %v", r.exchange, r.asset, r.pair, err)
+			}
+			*rotating = false
+		} else if !*rotating {
+			*rotating = true
+			r.requestSnapshot(o)
+		}
+	}
+	data, err := json.Marshal(r.toRecord(c))
+	if err != nil {
+		log.Errorf(log.OrderBook, "Orderbook capture %s %s %s encode error: %v", r.exchange, r.asset, r.pair, err)
+		return
+	}
+	if _, err := r.writer.Write(append(data, '\n')); err != nil {
+		log.Errorf(log.OrderBook, "Orderbook capture %s %s %s write error: %v", r.exchange, r.asset, r.pair, err)
+		return
+	}
+	if c.Snapshot {
+		*synced = true
+	}
+	r.written.Add(1)
+}
+
+// requestSnapshot requests the current book from the observer, a nil observer
+// is ignored while buffered changes are written on shutdown
+func (r *Recorder) requestSnapshot(o *orderbook.BufferedObserver) {
+	if o == nil {
+		return
+	}
+	if err := o.Snapshot(); err != nil {
+		log.Errorf(log.OrderBook, "Orderbook capture %s %s %s snapshot error: %v", r.exchange, r.asset, r.pair, err)
+	}
+}
+
+func (r *Recorder) toRecord(c *orderbook.Change) *record {
+	rec := &record{
+		Exchange:    r.exchange,
+		Asset:       r.asset.String(),
+		Pair:        formatPair(r.pair),
+		Timestamp:   c.UpdateTime.UnixNano(),
+		UpdateID:    c.UpdateID,
+		Snapshot:    c.Snapshot,
+		Invalidated: c.Invalidated,
+		MaxDepth:    c.MaxDepth,
+		Bids:        orderbook.LevelsArrayPriceAmount(c.Bids),
+		Asks:        orderbook.LevelsArrayPriceAmount(c.Asks),
+	}
+	if !c.LastPushed.IsZero() {
+		rec.Pushed = c.LastPushed.UnixNano()
+	}
+	return rec
+}
+
+func formatPair(p currency.Pair) string {
+	return p.Format(currency.PairFormat{Delimiter: currency.DashDelimiter, Uppercase: true}).String()
+}
@@ -0,0 +1,167 @@
+package capture
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+var (
+	testPair  = currency.NewBTCUSDT()
+	testStart = time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+)
+
+func newTestDepth(t *testing.T) *orderbook.Depth {
+	t.Helper()
+	d := orderbook.NewDepth(uuid.Must(uuid.NewV4()))
+	d.AssignOptions(&orderbook.Book{Exchange: "Test", Pair: testPair, Asset: asset.Spot})
+	return d
+}
+
+func TestDir(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, filepath.Join("root", "test", "spot", "BTC-USDT"), Dir("root", "Test", asset.Spot, currency.NewPairWithDelimiter("btc", "usdt", "_")))
+}
+
+func TestNewRecorder(t *testing.T) {
+	t.Parallel()
+	d := newTestDepth(t)
+	cfg := &Config{Directory: t.TempDir()}
+	_, err := NewRecorder(nil, "Test", asset.Spot, testPair, cfg)
+	assert.ErrorIs(t, err, errNilDepth)
+	_, err = NewRecorder(d, "", asset.Spot, testPair, cfg)
+	assert.ErrorIs(t, err, errExchangeNameUnset)
+	_, err = NewRecorder(d, "Test", asset.Empty, testPair, cfg)
+	assert.ErrorIs(t, err, asset.ErrNotSupported)
+	_, err = NewRecorder(d, "Test", asset.Spot, currency.EMPTYPAIR, cfg)
+	assert.ErrorIs(t, err, currency.ErrCurrencyPairEmpty)
+	_, err = NewRecorder(d, "Test", asset.Spot, testPair, nil)
+	assert.ErrorIs(t, err, errDirectoryNotSet)
+	_, err = NewRecorder(d, "Test", asset.Spot, testPair, &Config{Directory: cfg.Directory, SnapshotInterval: -1})
+	assert.ErrorIs(t, err, errInvalidInterval)
+	_, err = NewRecorder(d, "Test", asset.Spot, testPair, &Config{Directory: cfg.Directory, BufferSize: -1})
+	assert.ErrorIs(t, err, errInvalidBufferSize)
+	_, err = NewRecorder(d, "Test", asset.Spot, testPair, &Config{Directory: cfg.Directory, MaxFileSize: -1})
+	assert.Error(t, err)
+
+	r, err := NewRecorder(d, "Test", asset.Spot, testPair, cfg)
+	require.NoError(t, err)
+	assert.Equal(t, DefaultSnapshotInterval, r.snapshotInterval)
+	assert.Equal(t, DefaultFlushInterval, r.flushInterval)
+	assert.Equal(t, DefaultBufferSize, r.bufferSize)
+}
+
+func TestRecorderStartStop(t *testing.T) {
+	t.Parallel()
+	var r *Recorder
+	assert.ErrorIs(t, r.Start(), common.ErrNilPointer)
+	assert.ErrorIs(t, r.Stop(), common.ErrNilPointer)
+	assert.False(t, r.IsRunning())
+
+	r, err := NewRecorder(newTestDepth(t), "Test", asset.Spot, testPair, &Config{Directory: t.TempDir()})
+	require.NoError(t, err)
+	assert.ErrorIs(t, r.Stop(), errRecorderNotStarted)
+	require.NoError(t, r.Start())
+	assert.True(t, r.IsRunning())
+	assert.ErrorIs(t, r.Start(), errRecorderAlreadyStarted)
+	require.NoError(t, r.Stop())
+	assert.False(t, r.IsRunning())
+	require.NoError(t, r.Start(), "Start should restart a stopped recorder")
+	require.NoError(t, r.Stop())
+}
+
+func TestRecorder(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	d := newTestDepth(t)
+	require.NoError(t, d.LoadSnapshot(&orderbook.Book{
+		Bids:         orderbook.Levels{{Price: 100, Amount: 1}, {Price: 99, Amount: 2}},
+		Asks:         orderbook.Levels{{Price: 101, Amount: 1}, {Price: 102, Amount: 2}},
+		LastUpdated:  testStart,
+		LastUpdateID: 1,
+	}))
+
+	// A max file size of one byte rotates the file with each snapshot written
+	r, err := NewRecorder(d, "Test", asset.Spot, testPair, &Config{Directory: dir, MaxFileSize: 1, SnapshotInterval: time.Hour})
+	require.NoError(t, err)
+	require.NoError(t, r.Start())
+	require.Eventually(t, func() bool { return r.Stats().Written == 1 }, time.Second, time.Millisecond, "Start should write the current book")
+
+	require.NoError(t, d.ProcessUpdate(&orderbook.Update{UpdateID: 2, UpdateTime: testStart.Add(time.Second), Asset: asset.Spot, Bids: orderbook.Levels{{Price: 100, Amount: 0}}}))
+	// The update requests a snapshot to begin the next file with
+	require.Eventually(t, func() bool { return r.Stats().Written == 3 }, time.Second, time.Millisecond)
+	require.NoError(t, d.ProcessUpdate(&orderbook.Update{UpdateID: 3, UpdateTime: testStart.Add(2 * time.Second), Asset: asset.Spot, Asks: orderbook.Levels{{Price: 101, Amount: 5}}}))
+	require.Eventually(t, func() bool { return r.Stats().Written == 5 }, time.Second, time.Millisecond)
+	require.NoError(t, r.Stop())
+	assert.Zero(t, r.Stats().Dropped)
+
+	reader, err := NewReader(dir, "Test", asset.Spot, testPair)
+	require.NoError(t, err)
+	assert.Len(t, reader.Files(), 3, "Each rotated file should begin with a snapshot")
+
+	var changes []*orderbook.Change
+	require.NoError(t, reader.Changes(func(c *orderbook.Change) error {
+		changes = append(changes, c)
+		return nil
+	}))
+	require.Len(t, changes, 5)
+	assert.True(t, changes[0].Snapshot)
+	assert.False(t, changes[1].Snapshot)
+	assert.Equal(t, int64(2), changes[1].UpdateID)
+	assert.True(t, changes[2].Snapshot)
+	assert.Equal(t, int64(2), changes[2].UpdateID)
+
+	book, err := reader.BookAt(testStart.Add(time.Second))
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), book.LastUpdateID)
+	assert.Equal(t, orderbook.Levels{{Price: 99, Amount: 2}}, book.Bids)
+
+	book, err = reader.BookAt(testStart.Add(time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), book.LastUpdateID)
+	assert.Equal(t, orderbook.Levels{{Price: 101, Amount: 5}, {Price: 102, Amount: 2}}, book.Asks)
+}
+
+func TestRecorderInvalidated(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	d := newTestDepth(t)
+	require.NoError(t, d.LoadSnapshot(&orderbook.Book{
+		Bids:         orderbook.Levels{{Price: 100, Amount: 1}},
+		Asks:         orderbook.Levels{{Price: 101, Amount: 1}},
+		LastUpdated:  testStart,
+		LastUpdateID: 1,
+	}))
+	r, err := NewRecorder(d, "Test", asset.Spot, testPair, &Config{Directory: dir, SnapshotInterval: time.Hour})
+	require.NoError(t, err)
+	require.NoError(t, r.Start())
+	require.Eventually(t, func() bool { return r.Stats().Written == 1 }, time.Second, time.Millisecond)
+
+	require.ErrorIs(t, d.Invalidate(nil), orderbook.ErrOrderbookInvalid)
+	require.Eventually(t, func() bool { return r.Stats().Written == 2 }, time.Second, time.Millisecond, "Invalidating the depth should be written")
+	require.NoError(t, r.Stop())
+
+	reader, err := NewReader(dir, "Test", asset.Spot, testPair)
+	require.NoError(t, err)
+	var changes []*orderbook.Change
+	require.NoError(t, reader.Changes(func(c *orderbook.Change) error {
+		changes = append(changes, c)
+		return nil
+	}))
+	require.Len(t, changes, 2)
+	assert.True(t, changes[1].Invalidated)
+
+	_, err = reader.BookAt(changes[1].UpdateTime)
+	assert.ErrorIs(t, err, ErrCaptureGap, "BookAt should error once the depth was invalidated")
+	book, err := reader.BookAt(changes[1].UpdateTime.Add(-time.Nanosecond))
+	require.NoError(t, err, "BookAt must not error before the depth was invalidated")
+	assert.Equal(t, int64(1), book.LastUpdateID)
+}
@@ -46,6 +46,8 @@ type Depth struct {
 	// validationError defines current book state and why it was invalidated.
 	validationError error
 
+	observers []*Observer
+
 	m sync.RWMutex
 }
 
@@ -105,6 +107,7 @@ func (d *Depth) LoadSnapshot(incoming *Book) error {
 	d.askLevels.load(incoming.Asks)
 	d.validationError = nil
 	d.Alert()
+	d.notifySnapshot()
 	return nil
 }
 
@@ -118,11 +121,15 @@ func (d *Depth) Invalidate(withReason error) error {
 // invalidate initialises the Depth, with a error to explain why it was invalid
 // NOTE: This requires locking.
 func (d *Depth) invalidate(withReason error) error {
+	loaded := d.validationError == nil && !d.lastUpdated.IsZero()
 	d.lastUpdateID = 0
 	d.lastUpdated = time.Time{}
 	d.bidLevels.load(nil)
 	d.askLevels.load(nil)
 	d.validationError = fmt.Errorf("%s %s %s Reason: [%w]", d.exchange, d.pair, d.asset, common.AppendError(ErrOrderbookInvalid, withReason))
+	if loaded {
+		d.notifyInvalidated()
+	}
 	d.Alert()
 	return d.validationError
 }
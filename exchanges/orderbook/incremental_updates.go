@@ -98,6 +98,7 @@ func (d *Depth) ProcessUpdate(u *Update) error {
 	}
 
 	if !d.validateOrderbook {
+		d.notifyUpdate(u)
 		return nil
 	}
 
@@ -114,6 +115,7 @@ func (d *Depth) ProcessUpdate(u *Update) error {
 		return d.invalidate(err)
 	}
 
+	d.notifyUpdate(u)
 	return nil
 }
 
@@ -0,0 +1,277 @@
+package orderbook
+
+import (
+	"errors"
+	"slices"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+var (
+	errNilObserverFunc = errors.New("observer func is nil")
+	errObserverClosed  = errors.New("observer closed")
+	errInvalidBuffer   = errors.New("observer buffer size must be positive")
+)
+
+// Change is a change applied to an orderbook depth. Snapshot changes hold the
+// full book, otherwise Bids and Asks hold the price levels updated, with an
+// amount of zero removing the price level. Invalidated changes hold no levels
+// and mark the book as unknown from UpdateTime until the next snapshot
+type Change struct {
+	Exchange   string
+	Pair       currency.Pair
+	Asset      asset.Item
+	UpdateID   int64
+	UpdateTime time.Time
+	LastPushed time.Time
+	Snapshot   bool
+	// Invalidated is set when the depth was invalidated, or changes were
+	// missed, and the book must be discarded until the next snapshot
+	Invalidated bool
+	Bids        Levels
+	Asks        Levels
+	// MaxDepth is the number of levels each side of the book is truncated to
+	// after an update, zero is unlimited
+	MaxDepth int
+}
+
+// Observer receives every change applied to a depth until it is closed
+type Observer struct {
+	depth  *Depth
+	fn     func(*Change)
+	closed bool
+}
+
+// BufferedObserver buffers the changes applied to a depth so they can be
+// consumed without blocking it. Once a change is dropped, updates are dropped
+// until a snapshot is buffered so consumers never miss an update. The snapshot
+// is preceded by an invalidated change from when the first change was dropped
+type BufferedObserver struct {
+	observer *Observer
+	changes  chan *Change
+	size     int
+	// resync is set when a change is dropped, further updates are dropped
+	// until a snapshot is buffered
+	resync atomic.Bool
+	// gap is the invalidated change buffered ahead of the snapshot which ends
+	// a resync, it is only accessed by observe while the depth is locked
+	gap     *Change
+	dropped atomic.Uint64
+}
+
+// Observe registers fn to be called with every snapshot and incremental update
+// applied to the depth, and an invalidated change when a loaded depth is
+// invalidated. When the depth holds a valid book it is sent to fn as a
+// snapshot before Observe returns. Updates which act on level IDs rather
+// than prices are sent as snapshots. fn is called while the depth is locked so
+// it must not block or call the depth, and changes are shared between
+// observers so must not be modified
+func (d *Depth) Observe(fn func(*Change)) (*Observer, error) {
+	if fn == nil {
+		return nil, errNilObserverFunc
+	}
+	o := &Observer{depth: d, fn: fn}
+	d.m.Lock()
+	defer d.m.Unlock()
+	d.observers = append(d.observers, o)
+	o.snapshot()
+	return o, nil
+}
+
+// ObserveBuffered registers a buffered observer holding up to size changes.
+// Changes are dropped until the first snapshot is buffered, which is the
+// current book when the depth holds a valid book
+func (d *Depth) ObserveBuffered(size int) (*BufferedObserver, error) {
+	if size <= 0 {
+		return nil, errInvalidBuffer
+	}
+	// The buffer holds an extra change so there is always room for the gap
+	// ahead of a snapshot
+	b := &BufferedObserver{changes: make(chan *Change, size+1), size: size}
+	b.resync.Store(true)
+	o, err := d.Observe(b.observe)
+	if err != nil {
+		return nil, err
+	}
+	b.observer = o
+	return b, nil
+}
+
+// Snapshot sends the current book to the observer as a snapshot change,
+// ordered with the changes applied to the depth. Nothing is sent when the
+// depth is invalid or has not been loaded
+func (o *Observer) Snapshot() error {
+	o.depth.m.Lock()
+	defer o.depth.m.Unlock()
+	if o.closed {
+		return errObserverClosed
+	}
+	o.snapshot()
+	return nil
+}
+
+// Close stops changes being sent to the observer
+func (o *Observer) Close() {
+	o.depth.m.Lock()
+	defer o.depth.m.Unlock()
+	if o.closed {
+		return
+	}
+	o.closed = true
+	o.depth.observers = slices.DeleteFunc(o.depth.observers, func(x *Observer) bool { return x == o })
+}
+
+// Changes returns the buffered changes. It is not closed when the observer is
+// closed, so changes buffered beforehand can still be received
+func (b *BufferedObserver) Changes() <-chan *Change {
+	return b.changes
+}
+
+// Resyncing returns whether changes have been dropped and updates are being
+// dropped until a snapshot is buffered
+func (b *BufferedObserver) Resyncing() bool {
+	return b.resync.Load()
+}
+
+// Dropped returns the number of changes dropped
+func (b *BufferedObserver) Dropped() uint64 {
+	return b.dropped.Load()
+}
+
+// Snapshot buffers the current book as a snapshot change, ending a resync
+func (b *BufferedObserver) Snapshot() error {
+	return b.observer.Snapshot()
+}
+
+// Close stops changes being buffered
+func (b *BufferedObserver) Close() {
+	b.observer.Close()
+}
+
+// observe buffers a change without blocking the depth
+func (b *BufferedObserver) observe(c *Change) {
+	resync := b.resync.Load()
+	if (resync && !c.Snapshot) || len(b.changes) >= b.size {
+		b.drop(c)
+		return
+	}
+	if resync && b.gap != nil {
+		b.changes <- b.gap
+		b.gap = nil
+	}
+	b.changes <- c
+	if c.Snapshot {
+		b.resync.Store(false)
+	}
+}
+
+// drop counts a dropped change and begins a resync, recording when the first
+// change was dropped
+func (b *BufferedObserver) drop(c *Change) {
+	b.dropped.Add(1)
+	if b.gap == nil && !b.resync.Load() {
+		t := c.UpdateTime
+		if t.IsZero() {
+			t = time.Now()
+		}
+		b.gap = &Change{
+			Exchange:    c.Exchange,
+			Pair:        c.Pair,
+			Asset:       c.Asset,
+			UpdateTime:  t,
+			Invalidated: true,
+		}
+	}
+	b.resync.Store(true)
+}
+
+// snapshot sends the current book to the observer. NOTE: This requires
+// locking.
+func (o *Observer) snapshot() {
+	if c := o.depth.snapshotChange(); c != nil {
+		o.fn(c)
+	}
+}
+
+// snapshotChange returns the current book as a snapshot change or nil if the
+// book is invalid or has not been loaded. NOTE: This requires locking.
+func (d *Depth) snapshotChange() *Change {
+	if d.validationError != nil || d.lastUpdated.IsZero() {
+		return nil
+	}
+	return &Change{
+		Exchange:   d.exchange,
+		Pair:       d.pair,
+		Asset:      d.asset,
+		UpdateID:   d.lastUpdateID,
+		UpdateTime: d.lastUpdated,
+		LastPushed: d.lastPushed,
+		Snapshot:   true,
+		Bids:       d.bidLevels.retrieve(0),
+		Asks:       d.askLevels.retrieve(0),
+		MaxDepth:   d.maxDepth,
+	}
+}
+
+// notifySnapshot sends the current book to all observers. NOTE: This requires
+// locking.
+func (d *Depth) notifySnapshot() {
+	if len(d.observers) == 0 {
+		return
+	}
+	c := d.snapshotChange()
+	if c == nil {
+		return
+	}
+	for _, o := range d.observers {
+		o.fn(c)
+	}
+}
+
+// notifyInvalidated sends an invalidated change to all observers. NOTE: This
+// requires locking.
+func (d *Depth) notifyInvalidated() {
+	if len(d.observers) == 0 {
+		return
+	}
+	c := &Change{
+		Exchange:    d.exchange,
+		Pair:        d.pair,
+		Asset:       d.asset,
+		UpdateTime:  time.Now(),
+		Invalidated: true,
+		MaxDepth:    d.maxDepth,
+	}
+	for _, o := range d.observers {
+		o.fn(c)
+	}
+}
+
+// notifyUpdate sends an applied update to all observers. NOTE: This requires
+// locking.
+func (d *Depth) notifyUpdate(u *Update) {
+	if len(d.observers) == 0 {
+		return
+	}
+	if u.Action != UnknownAction {
+		d.notifySnapshot()
+		return
+	}
+	c := &Change{
+		Exchange:   d.exchange,
+		Pair:       d.pair,
+		Asset:      d.asset,
+		UpdateID:   u.UpdateID,
+		UpdateTime: u.UpdateTime,
+		LastPushed: u.LastPushed,
+		Bids:       slices.Clone(u.Bids),
+		Asks:       slices.Clone(u.Asks),
+		MaxDepth:   d.maxDepth,
+	}
+	for _, o := range d.observers {
+		o.fn(c)
+	}
+}
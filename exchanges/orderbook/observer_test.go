@@ -0,0 +1,133 @@
+package orderbook
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+func TestObserve(t *testing.T) {
+	t.Parallel()
+	d := NewDepth(id)
+	d.AssignOptions(&Book{Exchange: "test", Pair: currency.NewBTCUSDT(), Asset: asset.Spot, ValidateOrderbook: true})
+
+	_, err := d.Observe(nil)
+	require.ErrorIs(t, err, errNilObserverFunc)
+
+	var changes []*Change
+	o, err := d.Observe(func(c *Change) { changes = append(changes, c) })
+	require.NoError(t, err)
+	assert.Empty(t, changes, "Observe should not send a snapshot before the book is loaded")
+
+	now := time.Now()
+	err = d.LoadSnapshot(&Book{Bids: Levels{{Price: 10, Amount: 1}}, Asks: Levels{{Price: 11, Amount: 1}}, LastUpdated: now, LastUpdateID: 1})
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+	assert.True(t, changes[0].Snapshot)
+	assert.Equal(t, "test", changes[0].Exchange)
+	assert.Equal(t, int64(1), changes[0].UpdateID)
+	assert.Equal(t, Levels{{Price: 10, Amount: 1}}, changes[0].Bids)
+
+	err = d.ProcessUpdate(&Update{UpdateID: 2, UpdateTime: now.Add(time.Second), Asset: asset.Spot, Bids: Levels{{Price: 10, Amount: 0}, {Price: 9, Amount: 2}}})
+	require.NoError(t, err)
+	require.Len(t, changes, 2)
+	assert.False(t, changes[1].Snapshot)
+	assert.Equal(t, int64(2), changes[1].UpdateID)
+	assert.Equal(t, now.Add(time.Second), changes[1].UpdateTime)
+	assert.Equal(t, Levels{{Price: 10, Amount: 0}, {Price: 9, Amount: 2}}, changes[1].Bids)
+	assert.Empty(t, changes[1].Asks)
+
+	err = d.ProcessUpdate(&Update{UpdateID: 3, UpdateTime: now.Add(2 * time.Second), Asset: asset.Spot, Action: UpdateOrInsertAction, Asks: Levels{{Price: 12, Amount: 3, ID: 12}}})
+	require.NoError(t, err)
+	require.Len(t, changes, 3)
+	assert.True(t, changes[2].Snapshot, "ID based updates should be sent as snapshots")
+	assert.Equal(t, int64(3), changes[2].UpdateID)
+	assert.Len(t, changes[2].Asks, 2)
+
+	err = d.ProcessUpdate(&Update{UpdateID: 4, UpdateTime: now.Add(3 * time.Second), Asset: asset.Spot, Action: UpdateAction, Bids: Levels{{Price: 20, Amount: 1, ID: 1337}}})
+	require.ErrorIs(t, err, errUpdateFailed)
+	require.Len(t, changes, 4, "Rejected updates should not be sent")
+	assert.True(t, changes[3].Invalidated, "Invalidating the depth should be sent")
+	assert.False(t, changes[3].Snapshot)
+	assert.Empty(t, changes[3].Bids)
+	assert.False(t, changes[3].UpdateTime.IsZero(), "Invalidated changes should be timestamped")
+
+	require.NoError(t, o.Snapshot())
+	assert.Len(t, changes, 4, "Snapshot should not send an invalid book")
+	_ = d.Invalidate(nil)
+	assert.Len(t, changes, 4, "Invalidating an invalid depth should not be sent again")
+
+	err = d.LoadSnapshot(&Book{Bids: Levels{{Price: 10, Amount: 1}}, LastUpdated: now.Add(4 * time.Second), LastUpdateID: 5})
+	require.NoError(t, err)
+	require.Len(t, changes, 5)
+	require.NoError(t, o.Snapshot())
+	require.Len(t, changes, 6)
+	assert.Equal(t, int64(5), changes[5].UpdateID)
+
+	late, err := d.Observe(func(*Change) {})
+	require.NoError(t, err)
+	late.Close()
+	late.Close()
+
+	o.Close()
+	require.ErrorIs(t, o.Snapshot(), errObserverClosed)
+	err = d.ProcessUpdate(&Update{UpdateID: 6, UpdateTime: now.Add(5 * time.Second), Asset: asset.Spot, Bids: Levels{{Price: 9, Amount: 1}}})
+	require.NoError(t, err)
+	assert.Len(t, changes, 6, "Closed observers should not receive changes")
+	assert.Empty(t, d.observers)
+}
+
+func TestObserveBuffered(t *testing.T) {
+	t.Parallel()
+	d := NewDepth(id)
+	d.AssignOptions(&Book{Exchange: "test", Pair: currency.NewBTCUSDT(), Asset: asset.Spot, ValidateOrderbook: true})
+
+	_, err := d.ObserveBuffered(0)
+	require.ErrorIs(t, err, errInvalidBuffer)
+
+	b, err := d.ObserveBuffered(1)
+	require.NoError(t, err)
+	assert.True(t, b.Resyncing(), "Changes should be dropped until the first snapshot")
+
+	now := time.Now()
+	b.observe(&Change{})
+	assert.Empty(t, b.Changes(), "Updates should be dropped before a snapshot is buffered")
+	b.observe(&Change{Snapshot: true})
+	assert.Len(t, b.Changes(), 1)
+	assert.False(t, b.Resyncing())
+
+	b.observe(&Change{UpdateTime: now})
+	assert.True(t, b.Resyncing(), "A full buffer should require a resync")
+	<-b.Changes()
+	b.observe(&Change{})
+	assert.Empty(t, b.Changes(), "Updates should be dropped until resynced")
+	b.observe(&Change{Snapshot: true})
+	require.Len(t, b.Changes(), 2, "The snapshot should be preceded by the gap")
+	gap := <-b.Changes()
+	assert.True(t, gap.Invalidated)
+	assert.Equal(t, now, gap.UpdateTime, "The gap should begin with the first dropped change")
+	assert.True(t, (<-b.Changes()).Snapshot)
+	assert.False(t, b.Resyncing())
+	assert.Equal(t, uint64(3), b.Dropped())
+
+	b.observe(&Change{Invalidated: true})
+	require.Len(t, b.Changes(), 1, "Invalidated changes should be buffered")
+	<-b.Changes()
+
+	err = d.LoadSnapshot(&Book{Bids: Levels{{Price: 10, Amount: 1}}, Asks: Levels{{Price: 11, Amount: 1}}, LastUpdated: now, LastUpdateID: 1})
+	require.NoError(t, err)
+	require.Len(t, b.Changes(), 1, "Changes applied to the depth should be buffered")
+	assert.True(t, (<-b.Changes()).Snapshot)
+	require.NoError(t, b.Snapshot())
+	require.Len(t, b.Changes(), 1, "Snapshot should buffer the current book")
+	<-b.Changes()
+
+	b.Close()
+	require.NoError(t, d.LoadSnapshot(&Book{Bids: Levels{{Price: 10, Amount: 2}}, Asks: Levels{{Price: 11, Amount: 1}}, LastUpdated: now, LastUpdateID: 2}))
+	assert.Empty(t, b.Changes(), "Changes should not be buffered once closed")
+	assert.ErrorIs(t, b.Snapshot(), errObserverClosed)
+}
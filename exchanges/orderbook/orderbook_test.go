@@ -579,3 +579,19 @@ func TestLevelsArrayPriceAmountUnmarshalJSON(t *testing.T) {
 	err = asks.UnmarshalJSON([]byte(`invalid`))
 	assert.Error(t, err)
 }
+
+func TestLevelsArrayPriceAmountMarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	data, err := LevelsArrayPriceAmount{{Price: 1.5, Amount: 2}, {Price: 3, Amount: 0.0001}}.MarshalJSON()
+	require.NoError(t, err)
+	assert.Equal(t, `[[1.5,2],[3,0.0001]]`, string(data))
+
+	var levels LevelsArrayPriceAmount
+	require.NoError(t, levels.UnmarshalJSON(data))
+	assert.Equal(t, Levels{{Price: 1.5, Amount: 2}, {Price: 3, Amount: 0.0001}}, levels.Levels())
+
+	data, err = LevelsArrayPriceAmount{}.MarshalJSON()
+	require.NoError(t, err)
+	assert.Equal(t, `[]`, string(data))
+}
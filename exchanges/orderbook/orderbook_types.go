@@ -2,6 +2,7 @@ package orderbook
 
 import (
 	"errors"
+	"strconv"
 	"sync"
 	"time"
 
@@ -205,6 +206,23 @@ func (l *LevelsArrayPriceAmount) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements json.Marshaler
+func (l LevelsArrayPriceAmount) MarshalJSON() ([]byte, error) {
+	b := make([]byte, 0, 2+len(l)*24)
+	b = append(b, '[')
+	for x := range l {
+		if x != 0 {
+			b = append(b, ',')
+		}
+		b = append(b, '[')
+		b = strconv.AppendFloat(b, l[x].Price, 'f', -1, 64)
+		b = append(b, ',')
+		b = strconv.AppendFloat(b, l[x].Amount, 'f', -1, 64)
+		b = append(b, ']')
+	}
+	return append(b, ']'), nil
+}
+
 // Levels converts the LevelsArrayPriceAmount to a orderbook.Levels type
 func (l *LevelsArrayPriceAmount) Levels() Levels {
 	return Levels(*l)
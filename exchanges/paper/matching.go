@@ -0,0 +1,456 @@
+package paper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchange/accounts"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// submit matches a new order against the book, holding funds for any limit order remainder which rests
+// Fills crossing the book are taker fills at the level prices, the order is stored once it is accepted
+func (e *Exchange) submit(ctx context.Context, s *order.Submit) (*paperOrder, error) {
+	isBuy := s.Side.IsLong()
+	levels, updated, err := e.depth(s.Pair, s.AssetType, isBuy)
+	if err != nil {
+		return nil, err
+	}
+	id, err := uuid.NewV7()
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	o := &paperOrder{
+		Detail: order.Detail{
+			Exchange:      e.GetName(),
+			OrderID:       id.String(),
+			ClientOrderID: s.ClientOrderID,
+			ClientID:      s.ClientID,
+			Type:          s.Type,
+			Side:          s.Side,
+			Pair:          s.Pair,
+			AssetType:     s.AssetType,
+			TimeInForce:   s.TimeInForce,
+			Price:         s.Price,
+			Amount:        s.Amount,
+			QuoteAmount:   s.QuoteAmount,
+			Status:        order.New,
+			Date:          now,
+			LastUpdated:   now,
+		},
+		bookUpdated: updated,
+	}
+
+	var fills []fill
+	switch s.Type {
+	case order.Market:
+		o.Price = 0
+		fills = matchLevels(levels, isBuy, s.Amount, s.QuoteAmount, 0)
+		filled := filledAmount(fills)
+		if filled == 0 || (s.TimeInForce.Is(order.FillOrKill) && s.Amount > 0 && s.Amount-filled > balanceTolerance) {
+			return nil, fmt.Errorf("%w for %s %s %s", errNoLiquidity, s.Pair, s.AssetType, s.Side)
+		}
+		if s.Amount == 0 {
+			o.Amount = filled
+		}
+		c, spend := o.Pair.Quote, filledCost(fills)
+		if !isBuy {
+			c, spend = o.Pair.Base, filled
+		}
+		if err := e.checkFree(o.AssetType, c, spend); err != nil {
+			return nil, err
+		}
+	case order.Limit:
+		if o.Amount == 0 {
+			o.Amount = s.QuoteAmount / s.Price
+		}
+		fills = matchLevels(levels, isBuy, o.Amount, 0, s.Price)
+		if s.TimeInForce.Is(order.PostOnly) && len(fills) > 0 {
+			return nil, errPostOnlyWouldCross
+		}
+		if s.TimeInForce.Is(order.FillOrKill) && o.Amount-filledAmount(fills) > balanceTolerance {
+			return nil, fmt.Errorf("%w for %s %s %s", errNoLiquidity, s.Pair, s.AssetType, s.Side)
+		}
+		if err := e.adjustBalances(ctx, o.AssetType, o.holdDeltas(o.Amount, o.Price, 1)...); err != nil {
+			return nil, err
+		}
+	}
+	o.RemainingAmount = o.Amount
+
+	fees, err := e.fees(ctx, o.Pair, fills, false)
+	if err != nil {
+		if o.Type == order.Limit {
+			if relErr := e.adjustBalances(ctx, o.AssetType, o.holdDeltas(o.Amount, o.Price, -1)...); relErr != nil {
+				err = errors.Join(err, relErr)
+			}
+		}
+		return nil, err
+	}
+	e.orders[o.OrderID] = o
+	for i := range fills {
+		if err := e.applyFill(ctx, o, fills[i], fees[i], false); err != nil {
+			log.Errorf(log.ExchangeSys, "%s paper trading order %s fill error: %v", e.GetName(), o.OrderID, err)
+			break
+		}
+	}
+
+	if o.IsActive() {
+		switch {
+		case o.Type == order.Market:
+			o.Status = order.PartiallyFilledCancelled
+		case s.TimeInForce.Is(order.ImmediateOrCancel):
+			if err := e.adjustBalances(ctx, o.AssetType, o.holdDeltas(o.RemainingAmount, o.Price, -1)...); err != nil {
+				return nil, err
+			}
+			o.Status = order.Cancelled
+			if o.ExecutedAmount > 0 {
+				o.Status = order.PartiallyFilledCancelled
+			}
+		}
+		if !o.IsActive() {
+			o.CloseTime = time.Now()
+		}
+	}
+	e.emit(o)
+	return o, nil
+}
+
+// modify changes the price and amount of an active limit order, adjusting its held funds
+// A zero price or amount leaves that value unchanged
+func (e *Exchange) modify(ctx context.Context, o *paperOrder, price, amount float64) error {
+	if !o.IsActive() {
+		return fmt.Errorf("%w: %s %s", errOrderInactive, o.OrderID, o.Status)
+	}
+	if o.Type != order.Limit {
+		return fmt.Errorf("%w: %s", order.ErrUnsupportedOrderType, o.Type)
+	}
+	if price <= 0 {
+		price = o.Price
+	}
+	if amount <= 0 {
+		amount = o.Amount
+	}
+	if amount <= o.ExecutedAmount {
+		return fmt.Errorf("%w: %v must be more than the executed amount %v", order.ErrAmountIsInvalid, amount, o.ExecutedAmount)
+	}
+
+	remaining := amount - o.ExecutedAmount
+	deltas := o.holdDeltas(remaining, price, 1)
+	for i, d := range o.holdDeltas(o.RemainingAmount, o.Price, -1) {
+		deltas[i].free += d.free
+		deltas[i].hold += d.hold
+	}
+	if err := e.adjustBalances(ctx, o.AssetType, deltas...); err != nil {
+		return err
+	}
+	o.Price = price
+	o.Amount = amount
+	o.RemainingAmount = remaining
+	o.LastUpdated = time.Now()
+	o.bookUpdated = time.Time{}
+	e.emit(o)
+	return nil
+}
+
+// cancel cancels an active order and releases its held funds
+func (e *Exchange) cancel(ctx context.Context, o *paperOrder) error {
+	if !o.IsActive() {
+		return fmt.Errorf("%w: %s %s", errOrderInactive, o.OrderID, o.Status)
+	}
+	if err := e.adjustBalances(ctx, o.AssetType, o.holdDeltas(o.RemainingAmount, o.Price, -1)...); err != nil {
+		return err
+	}
+	o.Status = order.Cancelled
+	o.LastUpdated = time.Now()
+	o.CloseTime = o.LastUpdated
+	e.emit(o)
+	return nil
+}
+
+// matchOrders matches all resting orders against fresh market data and removes inactive orders which have passed the
+// order retention period
+func (e *Exchange) matchOrders(ctx context.Context) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	expired := time.Now().Add(-e.orderRetention)
+	for id, o := range e.orders {
+		if !o.IsActive() {
+			if o.LastUpdated.Before(expired) {
+				delete(e.orders, id)
+			}
+			continue
+		}
+		if err := e.matchResting(ctx, o); err != nil {
+			log.Errorf(log.ExchangeSys, "%s paper trading order %s matching error: %v", e.GetName(), o.OrderID, err)
+		}
+	}
+}
+
+// matchResting fills a resting limit order at its price when the book has crossed it since it was last matched
+// The fill is a maker fill sized by the depth available at or through the order price
+func (e *Exchange) matchResting(ctx context.Context, o *paperOrder) error {
+	isBuy := o.Side.IsLong()
+	levels, updated, err := e.depth(o.Pair, o.AssetType, isBuy)
+	if err != nil {
+		return err
+	}
+	if !o.bookUpdated.IsZero() && !updated.After(o.bookUpdated) {
+		return nil
+	}
+	o.bookUpdated = updated
+	amount := filledAmount(matchLevels(levels, isBuy, o.RemainingAmount, 0, o.Price))
+	if amount == 0 {
+		return nil
+	}
+	f := fill{price: o.Price, amount: amount}
+	fees, err := e.fees(ctx, o.Pair, []fill{f}, true)
+	if err != nil {
+		return err
+	}
+	if err := e.applyFill(ctx, o, f, fees[0], true); err != nil {
+		return err
+	}
+	if !o.IsActive() {
+		o.CloseTime = o.LastUpdated
+	}
+	e.emit(o)
+	return nil
+}
+
+// applyFill settles a fill against the order and balances
+// Fees are charged in the currency received, funds held by limit orders are released at the order price
+func (e *Exchange) applyFill(ctx context.Context, o *paperOrder, f fill, feeQuote float64, isMaker bool) error {
+	base, quote := o.Pair.Base, o.Pair.Quote
+	spent, received := balanceDelta{currency: quote}, balanceDelta{currency: base}
+	fee, feeAsset := feeQuote/f.price, base
+	if o.Side.IsLong() {
+		if o.Type == order.Limit {
+			spent.hold = -f.amount * o.Price
+			spent.free = f.amount * (o.Price - f.price)
+		} else {
+			spent.free = -f.amount * f.price
+		}
+		received.free = f.amount - fee
+	} else {
+		spent, received = balanceDelta{currency: base}, balanceDelta{currency: quote}
+		fee, feeAsset = feeQuote, quote
+		if o.Type == order.Limit {
+			spent.hold = -f.amount
+		} else {
+			spent.free = -f.amount
+		}
+		received.free = f.amount*f.price - fee
+	}
+	if err := e.adjustBalances(ctx, o.AssetType, spent, received); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	o.ExecutedAmount += f.amount
+	o.RemainingAmount = o.Amount - o.ExecutedAmount
+	o.Cost += f.amount * f.price
+	o.CostAsset = quote
+	o.AverageExecutedPrice = o.Cost / o.ExecutedAmount
+	o.Fee += fee
+	o.FeeAsset = feeAsset
+	o.Trades = append(o.Trades, order.TradeHistory{
+		Price:     f.price,
+		Amount:    f.amount,
+		Fee:       fee,
+		Exchange:  o.Exchange,
+		TID:       o.OrderID + "-" + strconv.Itoa(len(o.Trades)+1),
+		Type:      o.Type,
+		Side:      o.Side,
+		Timestamp: now,
+		IsMaker:   isMaker,
+		FeeAsset:  feeAsset.String(),
+		Total:     f.amount * f.price,
+	})
+	o.Status = order.PartiallyFilled
+	if o.RemainingAmount <= balanceTolerance {
+		o.ExecutedAmount = o.Amount
+		o.RemainingAmount = 0
+		o.Status = order.Filled
+	}
+	o.LastUpdated = now
+	return nil
+}
+
+// holdDeltas returns the balance changes to hold, or release with a negative sign, funds for amount at price
+func (o *paperOrder) holdDeltas(amount, price, sign float64) []balanceDelta {
+	if o.Side.IsLong() {
+		return []balanceDelta{{currency: o.Pair.Quote, free: -sign * amount * price, hold: sign * amount * price}}
+	}
+	return []balanceDelta{{currency: o.Pair.Base, free: -sign * amount, hold: sign * amount}}
+}
+
+// fees returns the fee in quote currency for each fill, using the configured fee rates or the exchange fee schedule
+func (e *Exchange) fees(ctx context.Context, p currency.Pair, fills []fill, isMaker bool) ([]float64, error) {
+	rate := e.takerFee
+	if isMaker {
+		rate = e.makerFee
+	}
+	fees := make([]float64, len(fills))
+	for i, f := range fills {
+		if rate != nil {
+			fees[i] = *rate * f.price * f.amount
+			continue
+		}
+		fee, err := e.GetFeeByType(ctx, &exchange.FeeBuilder{
+			FeeType:       exchange.OfflineTradeFee,
+			Pair:          p,
+			IsMaker:       isMaker,
+			PurchasePrice: f.price,
+			Amount:        f.amount,
+		})
+		if err != nil {
+			return nil, err
+		}
+		fees[i] = fee
+	}
+	return fees, nil
+}
+
+// checkFree returns an error if the free balance of a currency is less than amount
+func (e *Exchange) checkFree(a asset.Item, c currency.Code, amount float64) error {
+	b, err := e.accounts.GetBalance("", paperCredentials, a, c)
+	if err != nil && !errors.Is(err, accounts.ErrNoBalances) {
+		return err
+	}
+	if b.Free-amount < -balanceTolerance {
+		return fmt.Errorf("%w: %s %s free %v required %v", errInsufficientBalance, a, c, b.Free, amount)
+	}
+	return nil
+}
+
+// adjustBalances applies changes to free and held balances, returning an error without changing any balances if a
+// balance would become negative
+func (e *Exchange) adjustBalances(ctx context.Context, a asset.Item, deltas ...balanceDelta) error {
+	s := accounts.NewSubAccount(a, "")
+	for _, d := range deltas {
+		b, ok := s.Balances[d.currency]
+		if !ok {
+			var err error
+			if b, err = e.accounts.GetBalance("", paperCredentials, a, d.currency); err != nil && !errors.Is(err, accounts.ErrNoBalances) {
+				return err
+			}
+		}
+		b.Free += d.free
+		b.Hold += d.hold
+		if b.Free < -balanceTolerance || b.Hold < -balanceTolerance {
+			return fmt.Errorf("%w: %s %s free %v held %v", errInsufficientBalance, a, d.currency, b.Free-d.free, b.Hold-d.hold)
+		}
+		b.Free = max(b.Free, 0)
+		b.Hold = max(b.Hold, 0)
+		b.Total = b.Free + b.Hold
+		b.AvailableWithoutBorrow = b.Free
+		b.UpdatedAt = time.Time{}
+		s.Balances.Set(d.currency, b)
+	}
+	return e.accounts.Save(ctx, accounts.SubAccounts{s}, false)
+}
+
+// depth returns the levels an order would fill against, best price first, and when they were last updated
+// When no orderbook is available the ticker price is used as a single level with unlimited depth
+func (e *Exchange) depth(p currency.Pair, a asset.Item, isBuy bool) (orderbook.Levels, time.Time, error) {
+	if b, err := e.GetCachedOrderbook(p, a); err == nil {
+		levels := b.Bids
+		if isBuy {
+			levels = b.Asks
+		}
+		if len(levels) > 0 {
+			return levels, b.LastUpdated, nil
+		}
+	}
+	t, err := e.GetCachedTicker(p, a)
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("%w for %s %s: %w", errNoMarketData, p, a, err)
+	}
+	price := t.Bid
+	if isBuy {
+		price = t.Ask
+	}
+	if price <= 0 {
+		price = t.Last
+	}
+	if price <= 0 {
+		return nil, time.Time{}, fmt.Errorf("%w for %s %s", errNoMarketData, p, a)
+	}
+	return orderbook.Levels{{Price: price, Amount: math.Inf(1)}}, t.LastUpdated, nil
+}
+
+// matchLevels returns the fills for amount, or for quoteAmount when amount is zero, walking levels best price first
+// Levels beyond limit are not matched, a zero limit matches the whole book
+func matchLevels(levels orderbook.Levels, isBuy bool, amount, quoteAmount, limit float64) []fill {
+	byQuote := amount <= 0
+	var fills []fill
+	for _, l := range levels {
+		if (byQuote && quoteAmount <= balanceTolerance) || (!byQuote && amount <= balanceTolerance) {
+			break
+		}
+		if limit > 0 && ((isBuy && l.Price > limit) || (!isBuy && l.Price < limit)) {
+			break
+		}
+		if l.Price <= 0 || l.Amount <= 0 {
+			continue
+		}
+		var size float64
+		if byQuote {
+			size = min(l.Amount, quoteAmount/l.Price)
+			quoteAmount -= size * l.Price
+		} else {
+			size = min(l.Amount, amount)
+			amount -= size
+		}
+		fills = append(fills, fill{price: l.Price, amount: size})
+	}
+	return fills
+}
+
+// filledAmount returns the total base amount of fills
+func filledAmount(fills []fill) float64 {
+	var amount float64
+	for _, f := range fills {
+		amount += f.amount
+	}
+	return amount
+}
+
+// filledCost returns the total quote cost of fills
+func filledCost(fills []fill) float64 {
+	var cost float64
+	for _, f := range fills {
+		cost += f.amount * f.price
+	}
+	return cost
+}
+
+// getOrder returns an order by order ID, or by client order ID when the order ID is empty
+func (e *Exchange) getOrder(orderID, clientOrderID string) (*paperOrder, error) {
+	if orderID != "" {
+		if o, ok := e.orders[orderID]; ok {
+			return o, nil
+		}
+		return nil, fmt.Errorf("%w: %s", order.ErrOrderNotFound, orderID)
+	}
+	if clientOrderID == "" {
+		return nil, order.ErrOrderIDNotSet
+	}
+	for _, o := range e.orders {
+		if o.ClientOrderID == clientOrderID {
+			return o, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: client order ID %s", order.ErrOrderNotFound, clientOrderID)
+}
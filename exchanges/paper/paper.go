@@ -0,0 +1,425 @@
+package paper
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchange/accounts"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/collateral"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/deposit"
+	exchangefill "github.com/thrasher-corp/gocryptotrader/exchanges/fill"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/margin"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
+)
+
+// New returns an exchange which paper trades against the live market data of e
+// Starting balances are loaded from the config and resting orders are matched every MatchInterval until Shutdown
+// Inactive orders are removed once OrderRetention has passed since their last update
+func New(e exchange.IBotExchange, cfg *config.PaperTradingConfig) (*Exchange, error) {
+	if err := common.NilGuard(e, cfg); err != nil {
+		return nil, err
+	}
+	if !cfg.Enabled {
+		return nil, fmt.Errorf("%s: %w", e.GetName(), errPaperTradingDisabled)
+	}
+	for _, fee := range []*float64{cfg.MakerFee, cfg.TakerFee} {
+		if fee != nil && *fee < 0 {
+			return nil, fmt.Errorf("%s: %w: %v", e.GetName(), errInvalidFeeRate, *fee)
+		}
+	}
+
+	a, err := accounts.NewAccounts(accountOwner{name: e.GetName()}, dispatch.GetNewMux(nil))
+	if err != nil {
+		return nil, err
+	}
+	p := &Exchange{
+		IBotExchange:   e,
+		makerFee:       cfg.MakerFee,
+		takerFee:       cfg.TakerFee,
+		matchInterval:  cfg.MatchInterval,
+		orderRetention: cfg.OrderRetention,
+		accounts:       a,
+		orders:         make(map[string]*paperOrder),
+		shutdown:       make(chan struct{}),
+	}
+	if p.matchInterval <= 0 {
+		p.matchInterval = defaultMatchInterval
+	}
+	if p.orderRetention <= 0 {
+		p.orderRetention = defaultOrderRetention
+	}
+	if err := p.loadBalances(cfg.Balances); err != nil {
+		return nil, err
+	}
+	// Account order and balance streams describe the real account, so authenticated subscriptions are not made
+	if b := e.GetBase(); b != nil {
+		b.API.AuthenticatedWebsocketSupport = false
+		if b.Websocket != nil {
+			b.Websocket.SetCanUseAuthenticatedEndpoints(false)
+		}
+	}
+
+	p.wg.Add(1)
+	go p.run()
+	return p, nil
+}
+
+// loadBalances saves the starting balances to the accounts store
+func (e *Exchange) loadBalances(balances []config.PaperTradingBalance) error {
+	var subAccts accounts.SubAccounts
+	for i := range balances {
+		b := &balances[i]
+		if !b.Asset.IsValid() || b.Asset.IsFutures() || b.Currency.IsEmpty() || b.Amount < 0 {
+			return fmt.Errorf("%s: %w: %s %s %v", e.GetName(), errInvalidBalance, b.Asset, b.Currency, b.Amount)
+		}
+		s := accounts.NewSubAccount(b.Asset, "")
+		s.Balances.Set(b.Currency, accounts.Balance{Total: b.Amount, Free: b.Amount, AvailableWithoutBorrow: b.Amount})
+		subAccts = subAccts.Merge(s)
+	}
+	if len(subAccts) == 0 {
+		return nil
+	}
+	return e.accounts.Save(context.Background(), subAccts, true)
+}
+
+// Shutdown stops matching resting orders and shuts down the wrapped exchange
+func (e *Exchange) Shutdown() error {
+	e.stopOnce.Do(func() {
+		close(e.shutdown)
+		e.wg.Wait()
+	})
+	return e.IBotExchange.Shutdown()
+}
+
+// IsRESTAuthenticationSupported returns true as account and order functionality is simulated
+func (e *Exchange) IsRESTAuthenticationSupported() bool {
+	return true
+}
+
+// AuthenticateWebsocket is not available while paper trading
+func (e *Exchange) AuthenticateWebsocket(context.Context) error {
+	return fmt.Errorf("%s websocket authentication %w", e.GetName(), ErrNotAvailable)
+}
+
+// CanUseAuthenticatedWebsocketEndpoints returns false as the exchange account websocket streams are not used
+func (e *Exchange) CanUseAuthenticatedWebsocketEndpoints() bool {
+	return false
+}
+
+// IsWebsocketDataAllowed returns whether websocket data may be relayed to the engine while paper trading
+// Order, fill and balance updates for the exchange account are rejected so only simulated orders reach the engine
+func (e *Exchange) IsWebsocketDataAllowed(data any) bool {
+	switch d := data.(type) {
+	case *order.Detail:
+		return d != nil && e.isSimulatedOrder(d.OrderID)
+	case []order.Detail:
+		for i := range d {
+			if !e.isSimulatedOrder(d[i].OrderID) {
+				return false
+			}
+		}
+		return true
+	case order.Detail, []accounts.Change, accounts.Change, []exchangefill.Data:
+		return false
+	}
+	return true
+}
+
+// isSimulatedOrder returns whether an order ID belongs to the matching engine
+func (e *Exchange) isSimulatedOrder(orderID string) bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	_, ok := e.orders[orderID]
+	return ok
+}
+
+// SubmitOrder submits an order to the matching engine
+func (e *Exchange) SubmitOrder(ctx context.Context, s *order.Submit) (*order.SubmitResponse, error) {
+	if err := s.Validate(e.GetTradingRequirements()); err != nil {
+		return nil, err
+	}
+	if s.AssetType.IsFutures() {
+		return nil, fmt.Errorf("%s %w", s.AssetType, asset.ErrNotSupported)
+	}
+	if s.Type != order.Market && s.Type != order.Limit {
+		return nil, fmt.Errorf("%w: %s", order.ErrUnsupportedOrderType, s.Type)
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	o, err := e.submit(ctx, s)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.DeriveSubmitResponse(o.OrderID)
+	if err != nil {
+		return nil, err
+	}
+	resp.Amount = o.Amount
+	resp.Status = o.Status
+	resp.RemainingAmount = o.RemainingAmount
+	resp.AverageExecutedPrice = o.AverageExecutedPrice
+	resp.Trades = slices.Clone(o.Trades)
+	resp.Fee = o.Fee
+	resp.FeeAsset = o.FeeAsset
+	resp.Cost = o.Cost
+	resp.Date = o.Date
+	resp.LastUpdated = o.LastUpdated
+	return resp, nil
+}
+
+// WebsocketSubmitOrder submits an order to the matching engine
+func (e *Exchange) WebsocketSubmitOrder(ctx context.Context, s *order.Submit) (*order.SubmitResponse, error) {
+	return e.SubmitOrder(ctx, s)
+}
+
+// WebsocketSubmitOrders submits orders to the matching engine, stopping at the first error
+func (e *Exchange) WebsocketSubmitOrders(ctx context.Context, orders []*order.Submit) ([]*order.SubmitResponse, error) {
+	resps := make([]*order.SubmitResponse, 0, len(orders))
+	for _, s := range orders {
+		resp, err := e.SubmitOrder(ctx, s)
+		if err != nil {
+			return resps, err
+		}
+		resps = append(resps, resp)
+	}
+	return resps, nil
+}
+
+// ModifyOrder changes the price or amount of an active limit order
+// Modified orders are matched as resting orders when the matching engine next runs
+func (e *Exchange) ModifyOrder(ctx context.Context, m *order.Modify) (*order.ModifyResponse, error) {
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	o, err := e.getOrder(m.OrderID, m.ClientOrderID)
+	if err != nil {
+		return nil, err
+	}
+	if err := e.modify(ctx, o, m.Price, m.Amount); err != nil {
+		return nil, err
+	}
+	resp, err := m.DeriveModifyResponse()
+	if err != nil {
+		return nil, err
+	}
+	resp.OrderID = o.OrderID
+	resp.ClientOrderID = o.ClientOrderID
+	resp.Type = o.Type
+	resp.Side = o.Side
+	resp.Status = o.Status
+	resp.Price = o.Price
+	resp.Amount = o.Amount
+	resp.RemainingAmount = o.RemainingAmount
+	resp.Date = o.Date
+	resp.LastUpdated = o.LastUpdated
+	return resp, nil
+}
+
+// WebsocketModifyOrder changes the price or amount of an active limit order
+func (e *Exchange) WebsocketModifyOrder(ctx context.Context, m *order.Modify) (*order.ModifyResponse, error) {
+	return e.ModifyOrder(ctx, m)
+}
+
+// CancelOrder cancels an active order, releasing its held funds
+func (e *Exchange) CancelOrder(ctx context.Context, c *order.Cancel) error {
+	if err := c.Validate(); err != nil {
+		return err
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	o, err := e.getOrder(c.OrderID, c.ClientOrderID)
+	if err != nil {
+		return err
+	}
+	return e.cancel(ctx, o)
+}
+
+// WebsocketCancelOrder cancels an active order, releasing its held funds
+func (e *Exchange) WebsocketCancelOrder(ctx context.Context, c *order.Cancel) error {
+	return e.CancelOrder(ctx, c)
+}
+
+// CancelBatchOrders cancels orders, returning the status of each cancellation by order ID
+func (e *Exchange) CancelBatchOrders(ctx context.Context, cancels []order.Cancel) (*order.CancelBatchResponse, error) {
+	resp := &order.CancelBatchResponse{Status: make(map[string]string, len(cancels))}
+	for i := range cancels {
+		id := cancels[i].OrderID
+		if id == "" {
+			id = cancels[i].ClientOrderID
+		}
+		if err := e.CancelOrder(ctx, &cancels[i]); err != nil {
+			resp.Status[id] = err.Error()
+			continue
+		}
+		resp.Status[id] = order.Cancelled.String()
+	}
+	return resp, nil
+}
+
+// CancelAllOrders cancels all active orders, optionally filtered by the pair and asset type of c
+func (e *Exchange) CancelAllOrders(ctx context.Context, c *order.Cancel) (order.CancelAllResponse, error) {
+	if err := c.Validate(); err != nil {
+		return order.CancelAllResponse{}, err
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	var resp order.CancelAllResponse
+	for _, o := range e.orders {
+		if !o.IsActive() ||
+			(!c.Pair.IsEmpty() && !c.Pair.Equal(o.Pair)) ||
+			(c.AssetType != asset.Empty && c.AssetType != o.AssetType) {
+			continue
+		}
+		if err := e.cancel(ctx, o); err != nil {
+			resp.Add(o.OrderID, err.Error())
+			continue
+		}
+		resp.Add(o.OrderID, order.Cancelled.String())
+	}
+	return resp, nil
+}
+
+// GetOrderInfo returns a simulated order
+func (e *Exchange) GetOrderInfo(_ context.Context, orderID string, _ currency.Pair, _ asset.Item) (*order.Detail, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	o, err := e.getOrder(orderID, "")
+	if err != nil {
+		return nil, err
+	}
+	return o.CopyToPointer(), nil
+}
+
+// GetActiveOrders returns the simulated orders which are still active
+func (e *Exchange) GetActiveOrders(_ context.Context, req *order.MultiOrderRequest) (order.FilteredOrders, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	return req.Filter(e.GetName(), e.getOrders(req.AssetType, true)), nil
+}
+
+// GetOrderHistory returns the simulated orders which are no longer active
+func (e *Exchange) GetOrderHistory(_ context.Context, req *order.MultiOrderRequest) (order.FilteredOrders, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	return req.Filter(e.GetName(), e.getOrders(req.AssetType, false)), nil
+}
+
+// getOrders returns copies of the active or inactive orders for an asset
+func (e *Exchange) getOrders(a asset.Item, active bool) []order.Detail {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	orders := make([]order.Detail, 0, len(e.orders))
+	for _, o := range e.orders {
+		if o.AssetType == a && o.IsActive() == active {
+			orders = append(orders, o.Copy())
+		}
+	}
+	return orders
+}
+
+// UpdateAccountBalances returns the simulated balances
+func (e *Exchange) UpdateAccountBalances(_ context.Context, a asset.Item) (accounts.SubAccounts, error) {
+	return e.accounts.SubAccounts(nil, a)
+}
+
+// GetCachedSubAccounts returns the simulated balances
+func (e *Exchange) GetCachedSubAccounts(_ context.Context, a asset.Item) (accounts.SubAccounts, error) {
+	return e.accounts.SubAccounts(nil, a)
+}
+
+// GetCachedCurrencyBalances returns the simulated balances grouped by currency
+func (e *Exchange) GetCachedCurrencyBalances(_ context.Context, a asset.Item) (accounts.CurrencyBalances, error) {
+	return e.accounts.CurrencyBalances(nil, a)
+}
+
+// SubscribeAccountBalances subscribes to changes in the simulated balances
+func (e *Exchange) SubscribeAccountBalances() (dispatch.Pipe, error) {
+	return e.accounts.Subscribe()
+}
+
+// GetDepositAddress is not available while paper trading as exchanges may generate a new address
+func (e *Exchange) GetDepositAddress(context.Context, currency.Code, string, string) (*deposit.Address, error) {
+	return nil, fmt.Errorf("%s deposit addresses %w", e.GetName(), ErrNotAvailable)
+}
+
+// WithdrawCryptocurrencyFunds is not available while paper trading
+func (e *Exchange) WithdrawCryptocurrencyFunds(context.Context, *withdraw.Request) (*withdraw.ExchangeResponse, error) {
+	return nil, fmt.Errorf("%s withdrawals %w", e.GetName(), ErrNotAvailable)
+}
+
+// WithdrawFiatFunds is not available while paper trading
+func (e *Exchange) WithdrawFiatFunds(context.Context, *withdraw.Request) (*withdraw.ExchangeResponse, error) {
+	return nil, fmt.Errorf("%s withdrawals %w", e.GetName(), ErrNotAvailable)
+}
+
+// WithdrawFiatFundsToInternationalBank is not available while paper trading
+func (e *Exchange) WithdrawFiatFundsToInternationalBank(context.Context, *withdraw.Request) (*withdraw.ExchangeResponse, error) {
+	return nil, fmt.Errorf("%s withdrawals %w", e.GetName(), ErrNotAvailable)
+}
+
+// SetLeverage is not available while paper trading
+func (e *Exchange) SetLeverage(context.Context, asset.Item, currency.Pair, margin.Type, float64, order.Side) error {
+	return fmt.Errorf("%s leverage %w", e.GetName(), ErrNotAvailable)
+}
+
+// SetCollateralMode is not available while paper trading
+func (e *Exchange) SetCollateralMode(context.Context, asset.Item, collateral.Mode) error {
+	return fmt.Errorf("%s collateral mode %w", e.GetName(), ErrNotAvailable)
+}
+
+// SetMarginType is not available while paper trading
+func (e *Exchange) SetMarginType(context.Context, asset.Item, currency.Pair, margin.Type) error {
+	return fmt.Errorf("%s margin type %w", e.GetName(), ErrNotAvailable)
+}
+
+// ChangePositionMargin is not available while paper trading
+func (e *Exchange) ChangePositionMargin(context.Context, *margin.PositionChangeRequest) (*margin.PositionChangeResponse, error) {
+	return nil, fmt.Errorf("%s position margin %w", e.GetName(), ErrNotAvailable)
+}
+
+// emit sends an order update to the websocket data handler like an exchange order update
+// Updates are dropped rather than blocking matching when the data handler is not being read
+func (e *Exchange) emit(o *paperOrder) {
+	ws, err := e.GetWebsocket()
+	if err != nil || ws == nil || ws.DataHandler == nil {
+		return
+	}
+	select {
+	case ws.DataHandler <- o.CopyToPointer():
+	default:
+		log.Warnf(log.ExchangeSys, "%s paper trading order update dropped for order %s, data handler is full", e.GetName(), o.OrderID)
+	}
+}
+
+// run matches resting orders until shutdown
+func (e *Exchange) run() {
+	defer e.wg.Done()
+	t := time.NewTicker(e.matchInterval)
+	defer t.Stop()
+	for {
+		select {
+		case <-e.shutdown:
+			return
+		case <-t.C:
+			e.matchOrders(context.Background())
+		}
+	}
+}
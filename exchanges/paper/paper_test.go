@@ -0,0 +1,406 @@
+package paper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchange/accounts"
+	"github.com/thrasher-corp/gocryptotrader/exchange/websocket"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	exchangefill "github.com/thrasher-corp/gocryptotrader/exchanges/fill"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/sharedtestvalues"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+)
+
+const testExchangeName = "customex"
+
+type testExchange struct {
+	sharedtestvalues.CustomEx
+	ws *websocket.Manager
+}
+
+func (t *testExchange) GetWebsocket() (*websocket.Manager, error) {
+	return t.ws, nil
+}
+
+func (t *testExchange) GetBase() *exchange.Base {
+	return &t.Base
+}
+
+func (t *testExchange) Shutdown() error {
+	return nil
+}
+
+var (
+	takerFee = 0.001
+	makerFee = 0.0005
+)
+
+// newTestExchange returns a paper exchange holding 1000 quote and 1 base with an orderbook loaded for the pair
+func newTestExchange(t *testing.T, p currency.Pair) (*Exchange, chan any) {
+	t.Helper()
+	dataHandler := make(chan any, 100)
+	inner := &testExchange{
+		CustomEx: sharedtestvalues.CustomEx{Base: exchange.Base{Name: testExchangeName}},
+		ws:       &websocket.Manager{DataHandler: dataHandler},
+	}
+	e, err := New(inner, &config.PaperTradingConfig{
+		Enabled:       true,
+		MatchInterval: time.Hour,
+		MakerFee:      &makerFee,
+		TakerFee:      &takerFee,
+		Balances: []config.PaperTradingBalance{
+			{Asset: asset.Spot, Currency: p.Quote, Amount: 1000},
+			{Asset: asset.Spot, Currency: p.Base, Amount: 1},
+		},
+	})
+	require.NoError(t, err, "New must not error")
+	t.Cleanup(func() { assert.NoError(t, e.Shutdown(), "Shutdown should not error") })
+	loadBook(t, p, orderbook.Levels{{Price: 99, Amount: 1}, {Price: 98, Amount: 2}}, orderbook.Levels{{Price: 100, Amount: 1}, {Price: 101, Amount: 2}})
+	return e, dataHandler
+}
+
+func loadBook(t *testing.T, p currency.Pair, bids, asks orderbook.Levels) {
+	t.Helper()
+	b := &orderbook.Book{
+		Exchange:    testExchangeName,
+		Pair:        p,
+		Asset:       asset.Spot,
+		Bids:        bids,
+		Asks:        asks,
+		LastUpdated: time.Now(),
+	}
+	require.NoError(t, b.Process(), "orderbook Process must not error")
+}
+
+func balance(t *testing.T, e *Exchange, c currency.Code) (free, hold float64) {
+	t.Helper()
+	b, err := e.accounts.GetBalance("", paperCredentials, asset.Spot, c)
+	require.NoError(t, err, "GetBalance must not error")
+	assert.InDelta(t, b.Free+b.Hold, b.Total, 1e-9, "Total should be the sum of free and held funds")
+	return b.Free, b.Hold
+}
+
+func submit(p currency.Pair, side order.Side, t order.Type, price, amount float64) *order.Submit {
+	return &order.Submit{
+		Exchange:  testExchangeName,
+		Type:      t,
+		Side:      side,
+		Pair:      p,
+		AssetType: asset.Spot,
+		Price:     price,
+		Amount:    amount,
+	}
+}
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+	inner := &testExchange{CustomEx: sharedtestvalues.CustomEx{Base: exchange.Base{Name: testExchangeName}}}
+	inner.API.AuthenticatedWebsocketSupport = true
+	_, err := New(nil, &config.PaperTradingConfig{})
+	assert.ErrorIs(t, err, common.ErrNilPointer)
+	_, err = New(inner, &config.PaperTradingConfig{})
+	assert.ErrorIs(t, err, errPaperTradingDisabled)
+	negative := -0.1
+	_, err = New(inner, &config.PaperTradingConfig{Enabled: true, MakerFee: &negative})
+	assert.ErrorIs(t, err, errInvalidFeeRate)
+	_, err = New(inner, &config.PaperTradingConfig{Enabled: true, Balances: []config.PaperTradingBalance{{Asset: asset.Futures, Currency: currency.USDT, Amount: 1}}})
+	assert.ErrorIs(t, err, errInvalidBalance)
+
+	e, err := New(inner, &config.PaperTradingConfig{Enabled: true, Balances: []config.PaperTradingBalance{
+		{Asset: asset.Spot, Currency: currency.USDT, Amount: 100},
+		{Asset: asset.Spot, Currency: currency.BTC, Amount: 2},
+	}})
+	require.NoError(t, err, "New must not error")
+	assert.Equal(t, defaultMatchInterval, e.matchInterval, "matchInterval should default")
+	assert.True(t, e.IsRESTAuthenticationSupported(), "IsRESTAuthenticationSupported should return true")
+	assert.False(t, inner.GetBase().API.AuthenticatedWebsocketSupport, "New should disable authenticated websocket support on the wrapped exchange")
+	balances, err := e.GetCachedCurrencyBalances(t.Context(), asset.Spot)
+	require.NoError(t, err, "GetCachedCurrencyBalances must not error")
+	assert.Equal(t, 100.0, balances[currency.USDT].Free, "USDT balance should be loaded")
+	assert.Equal(t, 2.0, balances[currency.BTC].Total, "BTC balance should be loaded")
+	subAccts, err := e.UpdateAccountBalances(t.Context(), asset.Spot)
+	require.NoError(t, err, "UpdateAccountBalances must not error")
+	assert.Len(t, subAccts, 1, "UpdateAccountBalances should return the spot account")
+	require.NoError(t, dispatch.EnsureRunning(dispatch.DefaultMaxWorkers, dispatch.DefaultJobsLimit), "dispatch.EnsureRunning must not error")
+	_, err = e.SubscribeAccountBalances()
+	assert.NoError(t, err, "SubscribeAccountBalances should not error")
+	require.NoError(t, e.Shutdown(), "Shutdown must not error")
+	assert.NoError(t, e.Shutdown(), "Shutdown should not error when called twice")
+}
+
+func TestSubmitOrderMarket(t *testing.T) {
+	t.Parallel()
+	p := currency.NewPair(currency.BTC, currency.USDT)
+	e, dataHandler := newTestExchange(t, p)
+
+	resp, err := e.SubmitOrder(t.Context(), submit(p, order.Buy, order.Market, 0, 2))
+	require.NoError(t, err, "SubmitOrder must not error")
+	assert.Equal(t, order.Filled, resp.Status, "market order should be filled")
+	assert.Equal(t, 100.5, resp.AverageExecutedPrice, "market order should walk the book")
+	assert.Equal(t, 201.0, resp.Cost, "Cost should be correct")
+	assert.InDelta(t, 0.002, resp.Fee, 1e-12, "taker fee should be charged in the base currency")
+	assert.Equal(t, currency.BTC, resp.FeeAsset, "FeeAsset should be the received currency")
+	require.Len(t, resp.Trades, 2, "a trade must be returned for each level")
+	assert.False(t, resp.Trades[0].IsMaker, "market fills should be taker fills")
+	free, hold := balance(t, e, currency.USDT)
+	assert.Equal(t, 799.0, free, "USDT should be spent")
+	assert.Zero(t, hold, "USDT should not be held")
+	free, _ = balance(t, e, currency.BTC)
+	assert.InDelta(t, 2.998, free, 1e-12, "BTC should be received less fees")
+
+	select {
+	case d := <-dataHandler:
+		require.IsType(t, &order.Detail{}, d, "order updates must be sent as order details")
+		assert.Equal(t, resp.OrderID, d.(*order.Detail).OrderID, "order update should be for the order")
+		assert.Equal(t, order.Filled, d.(*order.Detail).Status, "order update should have the order status")
+	default:
+		require.Fail(t, "SubmitOrder must send an order update")
+	}
+
+	resp, err = e.SubmitOrder(t.Context(), &order.Submit{Exchange: testExchangeName, Type: order.Market, Side: order.Sell, Pair: p, AssetType: asset.Spot, QuoteAmount: 49.5})
+	require.NoError(t, err, "SubmitOrder must not error with a quote amount")
+	assert.Equal(t, 0.5, resp.Amount, "quote amount should be converted to a base amount")
+	free, _ = balance(t, e, currency.USDT)
+	assert.InDelta(t, 799+49.5-0.0495, free, 1e-9, "USDT should be received less fees")
+
+	_, err = e.SubmitOrder(t.Context(), submit(p, order.Sell, order.Market, 0, 2.6))
+	assert.ErrorIs(t, err, errInsufficientBalance)
+
+	s := submit(p, order.Sell, order.Market, 0, 2)
+	s.TimeInForce = order.FillOrKill
+	_, err = e.SubmitOrder(t.Context(), s)
+	require.NoError(t, err, "SubmitOrder must not error when a fill or kill order can be filled")
+	s.Side = order.Buy
+	s.Amount = 4
+	_, err = e.SubmitOrder(t.Context(), s)
+	assert.ErrorIs(t, err, errNoLiquidity)
+
+	resp, err = e.SubmitOrder(t.Context(), submit(p, order.Buy, order.Market, 0, 4))
+	require.NoError(t, err, "SubmitOrder must not error")
+	assert.Equal(t, order.PartiallyFilledCancelled, resp.Status, "market order should be cancelled once the book is exhausted")
+	assert.InDelta(t, 1, resp.RemainingAmount, 1e-9, "RemainingAmount should be correct")
+}
+
+func TestSubmitOrderLimit(t *testing.T) {
+	t.Parallel()
+	p := currency.NewPair(currency.ETH, currency.USDT)
+	e, _ := newTestExchange(t, p)
+
+	resp, err := e.SubmitOrder(t.Context(), submit(p, order.Buy, order.Limit, 99, 1))
+	require.NoError(t, err, "SubmitOrder must not error")
+	assert.Equal(t, order.New, resp.Status, "limit order should rest")
+	free, hold := balance(t, e, currency.USDT)
+	assert.Equal(t, 901.0, free, "USDT should be held")
+	assert.Equal(t, 99.0, hold, "USDT should be held")
+
+	s := submit(p, order.Buy, order.Limit, 100, 1)
+	s.TimeInForce = order.PostOnly
+	_, err = e.SubmitOrder(t.Context(), s)
+	assert.ErrorIs(t, err, errPostOnlyWouldCross)
+
+	s = submit(p, order.Buy, order.Limit, 100, 2)
+	s.TimeInForce = order.ImmediateOrCancel
+	resp, err = e.SubmitOrder(t.Context(), s)
+	require.NoError(t, err, "SubmitOrder must not error")
+	assert.Equal(t, order.PartiallyFilledCancelled, resp.Status, "immediate or cancel order should cancel the remainder")
+	assert.Equal(t, 1.0, resp.RemainingAmount, "RemainingAmount should be correct")
+	free, hold = balance(t, e, currency.USDT)
+	assert.Equal(t, 801.0, free, "IOC remainder should be released")
+	assert.Equal(t, 99.0, hold, "only the resting order should be held")
+
+	resp, err = e.SubmitOrder(t.Context(), submit(p, order.Sell, order.Limit, 98.5, 0.5))
+	require.NoError(t, err, "SubmitOrder must not error")
+	assert.Equal(t, order.Filled, resp.Status, "crossing limit order should fill")
+	assert.Equal(t, 99.0, resp.AverageExecutedPrice, "crossing limit order should fill at the book price")
+
+	_, err = e.SubmitOrder(t.Context(), submit(p, order.Buy, order.Limit, 90, 10))
+	assert.ErrorIs(t, err, errInsufficientBalance)
+
+	_, err = e.SubmitOrder(t.Context(), submit(p, order.Buy, order.Stop, 90, 1))
+	assert.ErrorIs(t, err, order.ErrUnsupportedOrderType)
+
+	s = submit(p, order.Buy, order.Limit, 90, 1)
+	s.AssetType = asset.Futures
+	_, err = e.SubmitOrder(t.Context(), s)
+	assert.ErrorIs(t, err, asset.ErrNotSupported)
+
+	_, err = e.SubmitOrder(t.Context(), submit(currency.NewPair(currency.LTC, currency.USDT), order.Buy, order.Limit, 90, 1))
+	assert.ErrorIs(t, err, errNoMarketData)
+}
+
+func TestTickerFallback(t *testing.T) {
+	t.Parallel()
+	p := currency.NewPair(currency.XRP, currency.USDT)
+	e, _ := newTestExchange(t, currency.NewPair(currency.XRP, currency.BTC))
+	require.NoError(t, ticker.ProcessTicker(&ticker.Price{ExchangeName: testExchangeName, Pair: p, AssetType: asset.Spot, Bid: 9, Ask: 10, Last: 9.5, LastUpdated: time.Now()}), "ProcessTicker must not error")
+	require.NoError(t, e.adjustBalances(t.Context(), asset.Spot, balanceDelta{currency: currency.USDT, free: 1000}), "adjustBalances must not error")
+
+	resp, err := e.SubmitOrder(t.Context(), submit(p, order.Buy, order.Market, 0, 50))
+	require.NoError(t, err, "SubmitOrder must not error")
+	assert.Equal(t, order.Filled, resp.Status, "market order should fill against the ticker")
+	assert.Equal(t, 10.0, resp.AverageExecutedPrice, "market buy should fill at the ticker ask")
+}
+
+func TestMatchOrders(t *testing.T) {
+	t.Parallel()
+	p := currency.NewPair(currency.SOL, currency.USDT)
+	e, dataHandler := newTestExchange(t, p)
+
+	resp, err := e.SubmitOrder(t.Context(), submit(p, order.Buy, order.Limit, 99, 1))
+	require.NoError(t, err, "SubmitOrder must not error")
+	<-dataHandler
+
+	e.matchOrders(t.Context())
+	o, err := e.GetOrderInfo(t.Context(), resp.OrderID, p, asset.Spot)
+	require.NoError(t, err, "GetOrderInfo must not error")
+	assert.Equal(t, order.New, o.Status, "resting order should not fill without a book update")
+
+	loadBook(t, p, orderbook.Levels{{Price: 98, Amount: 1}}, orderbook.Levels{{Price: 98.5, Amount: 0.25}, {Price: 99, Amount: 0.15}, {Price: 100, Amount: 1}})
+	e.matchOrders(t.Context())
+	e.matchOrders(t.Context())
+	o, err = e.GetOrderInfo(t.Context(), resp.OrderID, p, asset.Spot)
+	require.NoError(t, err, "GetOrderInfo must not error")
+	assert.Equal(t, order.PartiallyFilled, o.Status, "resting order should partially fill")
+	assert.InDelta(t, 0.4, o.ExecutedAmount, 1e-12, "resting order should only fill once per book update")
+	require.Len(t, o.Trades, 1, "resting order must have a single fill")
+	assert.True(t, o.Trades[0].IsMaker, "resting fills should be maker fills")
+	assert.Equal(t, 99.0, o.Trades[0].Price, "resting fills should be at the order price")
+	assert.InDelta(t, 0.4*makerFee, o.Fee, 1e-12, "maker fee should be charged")
+	select {
+	case d := <-dataHandler:
+		assert.Equal(t, order.PartiallyFilled, d.(*order.Detail).Status, "order update should be sent for the fill")
+	default:
+		require.Fail(t, "matchOrders must send an order update")
+	}
+
+	loadBook(t, p, orderbook.Levels{{Price: 97, Amount: 1}}, orderbook.Levels{{Price: 98, Amount: 5}})
+	e.matchOrders(t.Context())
+	o, err = e.GetOrderInfo(t.Context(), resp.OrderID, p, asset.Spot)
+	require.NoError(t, err, "GetOrderInfo must not error")
+	assert.Equal(t, order.Filled, o.Status, "resting order should fill")
+	free, hold := balance(t, e, currency.USDT)
+	assert.InDelta(t, 901.0, free, 1e-9, "USDT should be spent at the order price")
+	assert.InDelta(t, 0, hold, 1e-9, "USDT should no longer be held")
+	free, _ = balance(t, e, currency.SOL)
+	assert.InDelta(t, 2-makerFee, free, 1e-9, "SOL should be received less fees")
+
+	e.matchOrders(t.Context())
+	assert.True(t, e.isSimulatedOrder(resp.OrderID), "inactive orders should be kept within the order retention period")
+	e.mu.Lock()
+	e.orders[resp.OrderID].LastUpdated = time.Now().Add(-e.orderRetention - time.Second)
+	e.mu.Unlock()
+	e.matchOrders(t.Context())
+	assert.False(t, e.isSimulatedOrder(resp.OrderID), "inactive orders should be removed after the order retention period")
+}
+
+func TestModifyAndCancelOrders(t *testing.T) {
+	t.Parallel()
+	p := currency.NewPair(currency.ADA, currency.USDT)
+	e, _ := newTestExchange(t, p)
+
+	s := submit(p, order.Buy, order.Limit, 90, 1)
+	s.ClientOrderID = "client"
+	buy, err := e.SubmitOrder(t.Context(), s)
+	require.NoError(t, err, "SubmitOrder must not error")
+	sell, err := e.SubmitOrder(t.Context(), submit(p, order.Sell, order.Limit, 110, 0.5))
+	require.NoError(t, err, "SubmitOrder must not error")
+
+	mResp, err := e.ModifyOrder(t.Context(), &order.Modify{ClientOrderID: "client", Pair: p, AssetType: asset.Spot, Price: 95, Amount: 2})
+	require.NoError(t, err, "ModifyOrder must not error")
+	assert.Equal(t, buy.OrderID, mResp.OrderID, "ModifyOrder should find the order by client order ID")
+	assert.Equal(t, 2.0, mResp.Amount, "Amount should be modified")
+	_, hold := balance(t, e, currency.USDT)
+	assert.Equal(t, 190.0, hold, "held funds should be adjusted")
+	_, err = e.WebsocketModifyOrder(t.Context(), &order.Modify{OrderID: buy.OrderID, Pair: p, AssetType: asset.Spot, Price: 100, Amount: 20})
+	assert.ErrorIs(t, err, errInsufficientBalance)
+	_, err = e.ModifyOrder(t.Context(), &order.Modify{OrderID: "missing", Pair: p, AssetType: asset.Spot})
+	assert.ErrorIs(t, err, order.ErrOrderNotFound)
+
+	active, err := e.GetActiveOrders(t.Context(), &order.MultiOrderRequest{AssetType: asset.Spot, Side: order.AnySide, Type: order.AnyType, Pairs: currency.Pairs{p}})
+	require.NoError(t, err, "GetActiveOrders must not error")
+	assert.Len(t, active, 2, "GetActiveOrders should return both orders")
+
+	require.NoError(t, e.WebsocketCancelOrder(t.Context(), &order.Cancel{OrderID: buy.OrderID}), "WebsocketCancelOrder must not error")
+	free, hold := balance(t, e, currency.USDT)
+	assert.Equal(t, 1000.0, free, "held funds should be released")
+	assert.Zero(t, hold, "held funds should be released")
+	assert.ErrorIs(t, e.CancelOrder(t.Context(), &order.Cancel{OrderID: buy.OrderID}), errOrderInactive)
+	assert.ErrorIs(t, e.CancelOrder(t.Context(), &order.Cancel{}), order.ErrOrderIDNotSet)
+
+	batch, err := e.CancelBatchOrders(t.Context(), []order.Cancel{{OrderID: sell.OrderID}, {OrderID: "missing"}})
+	require.NoError(t, err, "CancelBatchOrders must not error")
+	assert.Equal(t, order.Cancelled.String(), batch.Status[sell.OrderID], "CancelBatchOrders should cancel the order")
+	assert.Contains(t, batch.Status["missing"], order.ErrOrderNotFound.Error(), "CancelBatchOrders should return the error for a missing order")
+
+	history, err := e.GetOrderHistory(t.Context(), &order.MultiOrderRequest{AssetType: asset.Spot, Side: order.AnySide, Type: order.AnyType})
+	require.NoError(t, err, "GetOrderHistory must not error")
+	assert.Len(t, history, 2, "GetOrderHistory should return the cancelled orders")
+
+	_, err = e.SubmitOrder(t.Context(), submit(p, order.Sell, order.Limit, 120, 0.25))
+	require.NoError(t, err, "SubmitOrder must not error")
+	all, err := e.CancelAllOrders(t.Context(), &order.Cancel{Pair: p, AssetType: asset.Spot})
+	require.NoError(t, err, "CancelAllOrders must not error")
+	assert.Len(t, all.Status, 1, "CancelAllOrders should cancel the active order")
+	free, hold = balance(t, e, currency.ADA)
+	assert.Equal(t, 1.0, free, "held funds should be released")
+	assert.Zero(t, hold, "held funds should be released")
+}
+
+func TestNotAvailable(t *testing.T) {
+	t.Parallel()
+	e, _ := newTestExchange(t, currency.NewPair(currency.DOGE, currency.USDT))
+	_, err := e.WithdrawCryptocurrencyFunds(t.Context(), nil)
+	assert.ErrorIs(t, err, ErrNotAvailable)
+	_, err = e.WithdrawFiatFunds(t.Context(), nil)
+	assert.ErrorIs(t, err, ErrNotAvailable)
+	_, err = e.WithdrawFiatFundsToInternationalBank(t.Context(), nil)
+	assert.ErrorIs(t, err, ErrNotAvailable)
+	assert.ErrorIs(t, e.SetLeverage(t.Context(), asset.Spot, currency.EMPTYPAIR, 0, 1, order.Buy), ErrNotAvailable)
+	assert.ErrorIs(t, e.SetCollateralMode(t.Context(), asset.Spot, 0), ErrNotAvailable)
+	assert.ErrorIs(t, e.SetMarginType(t.Context(), asset.Spot, currency.EMPTYPAIR, 0), ErrNotAvailable)
+	_, err = e.ChangePositionMargin(t.Context(), nil)
+	assert.ErrorIs(t, err, ErrNotAvailable)
+	_, err = e.GetDepositAddress(t.Context(), currency.USDT, "", "")
+	assert.ErrorIs(t, err, ErrNotAvailable)
+	assert.ErrorIs(t, e.AuthenticateWebsocket(t.Context()), ErrNotAvailable)
+	assert.False(t, e.CanUseAuthenticatedWebsocketEndpoints(), "CanUseAuthenticatedWebsocketEndpoints should return false")
+}
+
+func TestIsWebsocketDataAllowed(t *testing.T) {
+	t.Parallel()
+	p := currency.NewPair(currency.DOT, currency.USDT)
+	e, _ := newTestExchange(t, p)
+	resp, err := e.SubmitOrder(t.Context(), submit(p, order.Buy, order.Limit, 90, 1))
+	require.NoError(t, err, "SubmitOrder must not error")
+
+	assert.True(t, e.IsWebsocketDataAllowed(&order.Detail{OrderID: resp.OrderID}), "simulated order updates should be allowed")
+	assert.True(t, e.IsWebsocketDataAllowed([]order.Detail{{OrderID: resp.OrderID}}), "simulated order updates should be allowed")
+	assert.False(t, e.IsWebsocketDataAllowed(&order.Detail{OrderID: "exchange"}), "exchange order updates should be rejected")
+	assert.False(t, e.IsWebsocketDataAllowed([]order.Detail{{OrderID: resp.OrderID}, {OrderID: "exchange"}}), "exchange order updates should be rejected")
+	assert.False(t, e.IsWebsocketDataAllowed((*order.Detail)(nil)), "nil order updates should be rejected")
+	assert.False(t, e.IsWebsocketDataAllowed(accounts.Change{}), "exchange balance updates should be rejected")
+	assert.False(t, e.IsWebsocketDataAllowed([]accounts.Change{}), "exchange balance updates should be rejected")
+	assert.False(t, e.IsWebsocketDataAllowed([]exchangefill.Data{}), "exchange fills should be rejected")
+	assert.True(t, e.IsWebsocketDataAllowed(&ticker.Price{}), "market data should be allowed")
+}
+
+func TestMatchLevels(t *testing.T) {
+	t.Parallel()
+	asks := orderbook.Levels{{Price: 100, Amount: 1}, {Price: 101, Amount: 2}, {Price: 102, Amount: 3}}
+	assert.Equal(t, []fill{{100, 1}, {101, 0.5}}, matchLevels(asks, true, 1.5, 0, 0), "matchLevels should fill the amount")
+	assert.Equal(t, []fill{{100, 1}, {101, 1}}, matchLevels(asks, true, 0, 201, 0), "matchLevels should fill the quote amount")
+	assert.Equal(t, []fill{{100, 1}, {101, 2}}, matchLevels(asks, true, 10, 0, 101), "matchLevels should stop at the limit price")
+	assert.Empty(t, matchLevels(asks, true, 1, 0, 99), "matchLevels should not fill when the limit does not cross")
+	bids := orderbook.Levels{{Price: 99, Amount: 1}, {Price: 98, Amount: 1}}
+	assert.Equal(t, []fill{{99, 1}}, matchLevels(bids, false, 5, 0, 98.5), "matchLevels should stop at the limit price for sells")
+}
@@ -0,0 +1,93 @@
+package paper
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchange/accounts"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+// Public errors
+var (
+	ErrNotAvailable = errors.New("not available while paper trading")
+)
+
+var (
+	errPaperTradingDisabled = errors.New("paper trading is not enabled")
+	errInvalidFeeRate       = errors.New("fee rate must not be negative")
+	errInvalidBalance       = errors.New("starting balance is invalid")
+	errInsufficientBalance  = errors.New("insufficient balance")
+	errNoLiquidity          = errors.New("insufficient liquidity to fill order")
+	errNoMarketData         = errors.New("no orderbook or ticker available")
+	errPostOnlyWouldCross   = errors.New("post only order would cross the book")
+	errOrderInactive        = errors.New("order is not active")
+)
+
+const (
+	defaultMatchInterval  = time.Second
+	defaultOrderRetention = 24 * time.Hour
+	// balanceTolerance absorbs floating point drift when releasing held funds
+	balanceTolerance = 1e-9
+)
+
+// paperCredentials identifies the simulated balances in the accounts store
+var paperCredentials = &accounts.Credentials{Key: "paper"}
+
+// Exchange decorates an exchange, routing order management to a simulated matching engine which fills orders against
+// the exchange's live orderbooks and tickers. All other functionality is passed through to the wrapped exchange
+type Exchange struct {
+	exchange.IBotExchange
+
+	makerFee       *float64
+	takerFee       *float64
+	matchInterval  time.Duration
+	orderRetention time.Duration
+	accounts       *accounts.Accounts
+
+	mu       sync.Mutex
+	orders   map[string]*paperOrder
+	shutdown chan struct{}
+	wg       sync.WaitGroup
+	stopOnce sync.Once
+}
+
+// paperOrder is an order held by the matching engine
+type paperOrder struct {
+	order.Detail
+	// bookUpdated is the orderbook update time when the order was last matched, so resting orders are only matched
+	// against fresh depth
+	bookUpdated time.Time
+}
+
+// fill is an execution against a single price level
+type fill struct {
+	price  float64
+	amount float64
+}
+
+// balanceDelta is a change to the free and held balance of a currency
+type balanceDelta struct {
+	currency currency.Code
+	free     float64
+	hold     float64
+}
+
+// accountOwner owns the simulated balances in an accounts store, keeping them apart from the exchange's holdings
+type accountOwner struct {
+	name string
+}
+
+// GetName returns the exchange name
+func (a accountOwner) GetName() string {
+	return a.name
+}
+
+// GetCredentials returns the paper trading credentials
+func (a accountOwner) GetCredentials(context.Context) (*accounts.Credentials, error) {
+	return paperCredentials, nil
+}
@@ -1,17 +1,60 @@
 package request
 
 import (
+	"sync"
 	"time"
 )
 
+var globalReporterMtx sync.RWMutex
+
 // Reporter interface groups observability functionality over
 // HTTP request latency.
 type Reporter interface {
 	Latency(name, method, path string, t time.Duration)
 }
 
+// ErrorReporter is an optional Reporter extension which is notified when an
+// HTTP request fails to send or returns an unsuccessful status code. A status
+// code of zero denotes the request did not receive a response
+type ErrorReporter interface {
+	RequestError(name, method, path string, statusCode int)
+}
+
+// RateLimitReporter is an optional Reporter extension which is notified of
+// the time spent waiting on the rate limiter before a request is sent
+type RateLimitReporter interface {
+	RateLimitWait(name string, t time.Duration)
+}
+
 // SetupGlobalReporter sets a reporter interface to be used
-// for all exchange requests
+// for all exchange requests which do not have their own reporter. A nil
+// reporter disables reporting
 func SetupGlobalReporter(r Reporter) {
+	globalReporterMtx.Lock()
 	globalReporter = r
+	globalReporterMtx.Unlock()
+}
+
+// GetGlobalReporter returns the reporter used for all exchange requests which
+// do not have their own reporter
+func GetGlobalReporter() Reporter {
+	globalReporterMtx.RLock()
+	defer globalReporterMtx.RUnlock()
+	return globalReporter
+}
+
+// getReporter returns the requester's reporter, falling back to the global
+// reporter so reporting can be enabled after requesters are created
+func (r *Requester) getReporter() Reporter {
+	if r.reporter != nil {
+		return r.reporter
+	}
+	return GetGlobalReporter()
+}
+
+// reportError notifies the reporter of a failed request if it supports it
+func (r *Requester) reportError(method, path string, statusCode int) {
+	if rep, ok := r.getReporter().(ErrorReporter); ok {
+		rep.RequestError(r.name, method, path, statusCode)
+	}
 }
@@ -65,7 +65,6 @@ func New(name string, httpRequester *http.Client, opts ...RequesterOption) (*Req
 		retryPolicy: DefaultRetryPolicy,
 		maxRetries:  MaxRetryAttempts,
 		timedLock:   timedmutex.NewTimedMutex(DefaultMutexLockTimeout),
-		reporter:    globalReporter,
 	}
 
 	for _, o := range opts {
@@ -152,10 +151,14 @@ func (r *Requester) doRequest(ctx context.Context, endpoint EndpointLimit, newRe
 
 		if r.limiter != nil {
 			// Initiate a rate limit reservation and sleep on requested endpoint
+			waitStart := time.Now()
 			err := r.InitiateRateLimit(ctx, endpoint)
 			if err != nil {
 				return fmt.Errorf("failed to rate limit HTTP request: %w", err)
 			}
+			if rep, ok := r.getReporter().(RateLimitReporter); ok {
+				rep.RateLimitWait(r.name, time.Since(waitStart))
+			}
 		}
 
 		p, err := newRequest()
@@ -197,14 +200,17 @@ func (r *Requester) doRequest(ctx context.Context, endpoint EndpointLimit, newRe
 
 		resp, err := r._HTTPClient.do(req)
 
-		if r.reporter != nil && err == nil {
-			r.reporter.Latency(r.name, p.Method, p.Path, time.Since(start))
+		if err != nil {
+			r.reportError(p.Method, p.Path, 0)
+		} else if rep := r.getReporter(); rep != nil {
+			rep.Latency(r.name, p.Method, p.Path, time.Since(start))
 		}
 
 		if retry, checkErr := r.retryPolicy(resp, err); checkErr != nil {
 			return checkErr
 		} else if retry {
 			if err == nil {
+				r.reportError(p.Method, p.Path, resp.StatusCode)
 				// If the body isn't fully read, the connection cannot be reused
 				r.drainBody(resp.Body)
 			}
@@ -268,6 +274,7 @@ func (r *Requester) doRequest(ctx context.Context, endpoint EndpointLimit, newRe
 
 		if resp.StatusCode < http.StatusOK ||
 			resp.StatusCode > http.StatusNoContent {
+			r.reportError(p.Method, p.Path, resp.StatusCode)
 			return fmt.Errorf("%s %w: %d raw response: %s",
 				r.name,
 				ErrBadStatus,
@@ -13,6 +13,7 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -381,6 +382,65 @@ func TestDoRequest_NotRetryable(t *testing.T) {
 	require.ErrorIs(t, err, notRetryErr)
 }
 
+type testReporter struct {
+	m         sync.Mutex
+	latencies []string
+	errors    []int
+	waits     int
+}
+
+func (r *testReporter) Latency(_, _, path string, _ time.Duration) {
+	r.m.Lock()
+	r.latencies = append(r.latencies, path)
+	r.m.Unlock()
+}
+
+func (r *testReporter) RequestError(_, _, _ string, statusCode int) {
+	r.m.Lock()
+	r.errors = append(r.errors, statusCode)
+	r.m.Unlock()
+}
+
+func (r *testReporter) RateLimitWait(string, time.Duration) {
+	r.m.Lock()
+	r.waits++
+	r.m.Unlock()
+}
+
+func TestReporter(t *testing.T) {
+	r, err := New("test", new(http.Client), WithLimiter(NewBasicRateLimit(time.Millisecond, 100, 1)))
+	require.NoError(t, err, "New requester must not error")
+
+	rep := &testReporter{}
+	SetupGlobalReporter(rep)
+	t.Cleanup(func() { SetupGlobalReporter(nil) })
+	assert.Same(t, rep, GetGlobalReporter(), "GetGlobalReporter should return the global reporter")
+
+	err = r.SendPayload(t.Context(), Unset, func() (*Item, error) {
+		return &Item{Method: http.MethodGet, Path: testURL}, nil
+	}, UnauthenticatedRequest)
+	require.NoError(t, err, "SendPayload must not error")
+
+	err = r.SendPayload(t.Context(), Unset, func() (*Item, error) {
+		return &Item{Method: http.MethodGet, Path: testURL + "/error"}, nil
+	}, UnauthenticatedRequest)
+	require.ErrorIs(t, err, ErrBadStatus)
+
+	assert.Equal(t, []string{testURL, testURL + "/error"}, rep.latencies, "Latency should be reported for each response received")
+	assert.Equal(t, []int{http.StatusBadRequest}, rep.errors, "RequestError should be reported for the unsuccessful status")
+	assert.Equal(t, 2, rep.waits, "RateLimitWait should be reported for each request")
+
+	other := &testReporter{}
+	r, err = New("test", new(http.Client), WithReporter(other))
+	require.NoError(t, err, "New requester must not error")
+	err = r.SendPayload(t.Context(), Unset, func() (*Item, error) {
+		return &Item{Method: http.MethodGet, Path: testURL}, nil
+	}, UnauthenticatedRequest)
+	require.NoError(t, err, "SendPayload must not error")
+	assert.Len(t, other.latencies, 1, "Requester reporter should take precedence over the global reporter")
+	assert.Len(t, rep.latencies, 2, "Global reporter should not be called when a requester reporter is set")
+}
+
 func TestGetNonce(t *testing.T) {
 	t.Parallel()
 	r, err := New("test", new(http.Client), WithLimiter(globalshell))
@@ -0,0 +1,151 @@
+package trade
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/key"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+)
+
+// NewCandleBuilder returns a CandleBuilder for the supplied intervals
+func NewCandleBuilder(intervals ...kline.Interval) (*CandleBuilder, error) {
+	if len(intervals) == 0 {
+		return nil, errNoIntervals
+	}
+	for i := range intervals {
+		if intervals[i] <= 0 {
+			return nil, fmt.Errorf("%w: %s", errInvalidInterval, intervals[i])
+		}
+		if slices.Contains(intervals[:i], intervals[i]) {
+			return nil, fmt.Errorf("%w: %s", errDuplicateInterval, intervals[i])
+		}
+	}
+	return &CandleBuilder{
+		intervals: slices.Clone(intervals),
+		candles:   make(map[candleKey]*candleState),
+	}, nil
+}
+
+// Intervals returns the intervals candles are built for
+func (b *CandleBuilder) Intervals() []kline.Interval {
+	if b == nil {
+		return nil
+	}
+	return slices.Clone(b.intervals)
+}
+
+// Add applies trades to the open candles of every interval and returns any
+// candles closed because a trade arrived for a later interval. Trades for an
+// interval which has already closed or which precedes the open candle are
+// dropped
+func (b *CandleBuilder) Add(trades ...Data) ([]ClosedCandle, error) {
+	if b == nil {
+		return nil, fmt.Errorf("%w: %T", common.ErrNilPointer, b)
+	}
+	if len(trades) == 0 {
+		return nil, nil
+	}
+	trades = slices.Clone(trades)
+	slices.SortStableFunc(trades, func(x, y Data) int { return x.Timestamp.Compare(y.Timestamp) })
+
+	b.m.Lock()
+	defer b.m.Unlock()
+	var closed []ClosedCandle
+	for i := range trades {
+		price, amount := math.Abs(trades[i].Price), math.Abs(trades[i].Amount)
+		if price == 0 || trades[i].Timestamp.IsZero() || trades[i].Exchange == "" {
+			continue
+		}
+		for _, interval := range b.intervals {
+			k := candleKey{
+				ExchangeAssetPair: key.NewExchangeAssetPair(strings.ToLower(trades[i].Exchange), trades[i].AssetType, trades[i].CurrencyPair),
+				Interval:          interval,
+			}
+			s, ok := b.candles[k]
+			if !ok {
+				s = &candleState{exchange: trades[i].Exchange, pair: trades[i].CurrencyPair}
+				b.candles[k] = s
+			}
+			if trades[i].Timestamp.Before(s.closedUntil) {
+				continue
+			}
+			start := trades[i].Timestamp.Truncate(interval.Duration())
+			if s.open && start.Before(s.candle.Time) {
+				continue
+			}
+			if s.open && start.After(s.candle.Time) {
+				closed = append(closed, s.close(k))
+			}
+			if !s.open {
+				s.candle = kline.Candle{Time: start, Open: price, High: price, Low: price, Close: price}
+				s.first, s.last = trades[i].Timestamp, trades[i].Timestamp
+				s.open = true
+			}
+			if trades[i].Timestamp.Before(s.first) {
+				s.candle.Open, s.first = price, trades[i].Timestamp
+			}
+			if !trades[i].Timestamp.Before(s.last) {
+				s.candle.Close, s.last = price, trades[i].Timestamp
+			}
+			s.candle.High = max(s.candle.High, price)
+			s.candle.Low = min(s.candle.Low, price)
+			s.candle.Volume += amount
+		}
+	}
+	sortClosedCandles(closed)
+	return closed, nil
+}
+
+// Close closes and returns every open candle whose interval has ended by the
+// supplied time
+func (b *CandleBuilder) Close(now time.Time) ([]ClosedCandle, error) {
+	if b == nil {
+		return nil, fmt.Errorf("%w: %T", common.ErrNilPointer, b)
+	}
+	b.m.Lock()
+	defer b.m.Unlock()
+	var closed []ClosedCandle
+	for k, s := range b.candles {
+		if s.open && !now.Before(s.candle.Time.Add(k.Interval.Duration())) {
+			closed = append(closed, s.close(k))
+		}
+	}
+	sortClosedCandles(closed)
+	return closed, nil
+}
+
+// close marks the open candle as closed and returns it
+func (s *candleState) close(k candleKey) ClosedCandle {
+	s.open = false
+	s.closedUntil = s.candle.Time.Add(k.Interval.Duration())
+	return ClosedCandle{
+		Exchange: s.exchange,
+		Pair:     s.pair,
+		Asset:    k.Asset,
+		Interval: k.Interval,
+		Candle:   s.candle,
+	}
+}
+
+// sortClosedCandles orders candles by time and then by interval so they are
+// published and stored deterministically
+func sortClosedCandles(c []ClosedCandle) {
+	slices.SortFunc(c, func(x, y ClosedCandle) int {
+		if n := x.Time.Compare(y.Time); n != 0 {
+			return n
+		}
+		if n := cmp.Compare(x.Interval, y.Interval); n != 0 {
+			return n
+		}
+		if n := strings.Compare(x.Exchange, y.Exchange); n != 0 {
+			return n
+		}
+		return strings.Compare(x.Pair.String(), y.Pair.String())
+	})
+}
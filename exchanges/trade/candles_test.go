@@ -0,0 +1,123 @@
+package trade
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+)
+
+func TestNewCandleBuilder(t *testing.T) {
+	t.Parallel()
+	_, err := NewCandleBuilder()
+	assert.ErrorIs(t, err, errNoIntervals)
+
+	_, err = NewCandleBuilder(kline.OneMin, 0)
+	assert.ErrorIs(t, err, errInvalidInterval)
+
+	_, err = NewCandleBuilder(kline.OneMin, kline.OneMin)
+	assert.ErrorIs(t, err, errDuplicateInterval)
+
+	b, err := NewCandleBuilder(kline.OneMin, kline.FiveMin)
+	require.NoError(t, err, "NewCandleBuilder must not error")
+	assert.Equal(t, []kline.Interval{kline.OneMin, kline.FiveMin}, b.Intervals())
+	assert.Nil(t, (*CandleBuilder)(nil).Intervals())
+}
+
+func TestCandleBuilderAdd(t *testing.T) {
+	t.Parallel()
+	_, err := (*CandleBuilder)(nil).Add()
+	assert.ErrorIs(t, err, common.ErrNilPointer)
+
+	b, err := NewCandleBuilder(kline.OneMin, kline.FiveMin)
+	require.NoError(t, err, "NewCandleBuilder must not error")
+
+	pair := currency.NewBTCUSD()
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	tr := func(offset time.Duration, price, amount float64) Data {
+		return Data{Exchange: "Bitstamp", CurrencyPair: pair, AssetType: asset.Spot, Price: price, Amount: amount, Timestamp: start.Add(offset)}
+	}
+
+	closed, err := b.Add()
+	require.NoError(t, err)
+	assert.Empty(t, closed)
+
+	closed, err = b.Add(tr(time.Second*30, 102, 1), tr(time.Second*10, 100, -2), tr(time.Second*20, 99, 0.5), Data{Exchange: "Bitstamp", Timestamp: start})
+	require.NoError(t, err, "Add must not error")
+	assert.Empty(t, closed, "no candles should close within the first interval")
+
+	closed, err = b.Add(tr(time.Minute+time.Second, 103, 1))
+	require.NoError(t, err, "Add must not error")
+	require.Len(t, closed, 1, "a trade for the next minute must close the one minute candle")
+	c := closed[0]
+	assert.Equal(t, "Bitstamp", c.Exchange)
+	assert.True(t, c.Pair.Equal(pair), "pair should be set")
+	assert.Equal(t, asset.Spot, c.Asset)
+	assert.Equal(t, kline.OneMin, c.Interval)
+	assert.Equal(t, start, c.Time)
+	assert.Equal(t, 100.0, c.Open, "trades should be applied in time order")
+	assert.Equal(t, 102.0, c.High)
+	assert.Equal(t, 99.0, c.Low)
+	assert.Equal(t, 102.0, c.Close)
+	assert.Equal(t, 3.5, c.Volume, "negative amounts should be treated as absolute")
+
+	closed, err = b.Add(tr(time.Second*59, 1, 1))
+	require.NoError(t, err, "Add must not error")
+	assert.Empty(t, closed, "late trades should not close candles")
+
+	closed, err = b.Close(start.Add(time.Minute * 2))
+	require.NoError(t, err, "Close must not error")
+	require.Len(t, closed, 1, "Close must only close candles whose interval has ended")
+	assert.Equal(t, start.Add(time.Minute), closed[0].Time)
+	assert.Equal(t, 103.0, closed[0].Open)
+	assert.Equal(t, 1.0, closed[0].Volume)
+
+	closed, err = b.Close(start.Add(time.Minute * 5))
+	require.NoError(t, err, "Close must not error")
+	require.Len(t, closed, 1)
+	c = closed[0]
+	assert.Equal(t, kline.FiveMin, c.Interval)
+	assert.Equal(t, start, c.Time)
+	assert.Equal(t, 1.0, c.Low, "late trades should still apply to open candles of longer intervals")
+	assert.Equal(t, 100.0, c.Open)
+	assert.Equal(t, 103.0, c.Close, "late trades should not replace the close of a later trade")
+	assert.Equal(t, 5.5, c.Volume)
+
+	closed, err = b.Close(start.Add(time.Hour))
+	require.NoError(t, err, "Close must not error")
+	assert.Empty(t, closed, "closed candles must not be closed again")
+
+	_, err = (*CandleBuilder)(nil).Close(start)
+	assert.ErrorIs(t, err, common.ErrNilPointer)
+}
+
+func TestSubscribeToExchangeCandles(t *testing.T) {
+	t.Parallel()
+	_, err := SubscribeToExchangeCandles("")
+	assert.ErrorIs(t, err, common.ErrExchangeNameNotSet)
+
+	require.NoError(t, dispatch.EnsureRunning(dispatch.DefaultMaxWorkers, dispatch.DefaultJobsLimit))
+	pipe, err := SubscribeToExchangeCandles("CandleFeedTest")
+	require.NoError(t, err)
+	defer func() { assert.NoError(t, pipe.Release()) }()
+
+	require.NoError(t, PublishCandles(
+		ClosedCandle{Exchange: "candlefeedtest", Interval: kline.OneMin, Candle: kline.Candle{Close: 1}},
+		ClosedCandle{Exchange: "othercandleexchange", Interval: kline.OneMin, Candle: kline.Candle{Close: 2}},
+	))
+	select {
+	case data := <-pipe.Channel():
+		candles, ok := data.([]ClosedCandle)
+		require.True(t, ok, "pipe must receive closed candles")
+		require.Len(t, candles, 1, "pipe must only receive candles for the subscribed exchange")
+		assert.Equal(t, 1.0, candles[0].Close)
+	case <-time.After(time.Second):
+		require.Fail(t, "pipe must receive published candles")
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"slices"
 	"sort"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -14,6 +15,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/database"
 	tradesql "github.com/thrasher-corp/gocryptotrader/database/repository/trade"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
@@ -47,6 +49,10 @@ func (t *Trade) Update(save bool, data ...Data) error {
 		t.dataHandler <- data
 	}
 
+	if err := publishByExchange(&feed, data, func(d *Data) string { return d.Exchange }); err != nil {
+		return err
+	}
+
 	if save {
 		if err := AddTradesToBuffer(data...); err != nil {
 			return err
@@ -56,6 +62,75 @@ func (t *Trade) Update(save bool, data ...Data) error {
 	return nil
 }
 
+// SubscribeToExchangeTrades returns a pipe which receives each batch of trades
+// processed for an exchange
+func SubscribeToExchangeTrades(exchange string) (dispatch.Pipe, error) {
+	return feed.subscribe(exchange)
+}
+
+// SubscribeToExchangeCandles returns a pipe which receives each batch of
+// candles built from trades and published for an exchange
+func SubscribeToExchangeCandles(exchange string) (dispatch.Pipe, error) {
+	return candleFeed.subscribe(exchange)
+}
+
+// PublishCandles sends closed candles to the subscribers of each exchange they
+// belong to
+func PublishCandles(candles ...ClosedCandle) error {
+	return publishByExchange(&candleFeed, candles, func(c *ClosedCandle) string { return c.Exchange })
+}
+
+// subscribe returns a pipe for an exchange's published data
+func (f *exchangeFeed) subscribe(exchange string) (dispatch.Pipe, error) {
+	if exchange == "" {
+		return dispatch.Pipe{}, common.ErrExchangeNameNotSet
+	}
+	id, err := f.getID(exchange)
+	if err != nil {
+		return dispatch.Pipe{}, err
+	}
+	return f.mux.Subscribe(id)
+}
+
+// getID returns the routing ID for an exchange's data, creating it if required
+func (f *exchangeFeed) getID(exchange string) (uuid.UUID, error) {
+	exchange = strings.ToLower(exchange)
+	f.m.Lock()
+	defer f.m.Unlock()
+	if id, ok := f.exchanges[exchange]; ok {
+		return id, nil
+	}
+	id, err := f.mux.GetID()
+	if err != nil {
+		return uuid.Nil, err
+	}
+	f.exchanges[exchange] = id
+	return id, nil
+}
+
+// publishByExchange sends data to the subscribers of each exchange it belongs
+// to
+func publishByExchange[T any](f *exchangeFeed, data []T, exchangeName func(*T) string) error {
+	byExchange := make(map[string][]T)
+	for i := range data {
+		exchange := strings.ToLower(exchangeName(&data[i]))
+		byExchange[exchange] = append(byExchange[exchange], data[i])
+	}
+	var errs error
+	f.m.Lock()
+	defer f.m.Unlock()
+	for exchange, items := range byExchange {
+		id, ok := f.exchanges[exchange]
+		if !ok {
+			continue
+		}
+		if err := f.mux.Publish(items, id); err != nil {
+			errs = common.AppendError(errs, fmt.Errorf("%w for %s", err, exchange))
+		}
+	}
+	return errs
+}
+
 // AddTradesToBuffer will push trade data onto the buffer
 func AddTradesToBuffer(data ...Data) error {
 	cfg := database.DB.GetConfig()
@@ -149,6 +224,25 @@ func SaveTradesToDatabase(trades ...Data) error {
 	return tradesql.Insert(sqlTrades...)
 }
 
+// DeleteTradesFromDatabase removes stored trades from the database by their
+// database ID
+func DeleteTradesFromDatabase(trades ...Data) error {
+	if len(trades) == 0 {
+		return ErrNoTradesSupplied
+	}
+	sqlTrades := make([]tradesql.Data, len(trades))
+	for i := range trades {
+		if trades[i].ID.IsNil() {
+			return fmt.Errorf("%w at %s", errTradeIDUnset, trades[i].Timestamp)
+		}
+		sqlTrades[i] = tradesql.Data{ID: trades[i].ID.String()}
+	}
+	if !database.DB.IsConnected() {
+		return fmt.Errorf("cannot delete trades as %w", database.ErrDatabaseNotConnected)
+	}
+	return tradesql.DeleteTrades(sqlTrades...)
+}
+
 // GetTradesInRange calls db function to return trades in range
 // to minimise tradesql package usage
 func GetTradesInRange(exchangeName, assetType, base, quote string, startDate, endDate time.Time) ([]Data, error) {
@@ -7,10 +7,14 @@ import (
 	"time"
 
 	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/database"
 	"github.com/thrasher-corp/gocryptotrader/database/drivers"
 	sqltrade "github.com/thrasher-corp/gocryptotrader/database/repository/trade"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
@@ -242,6 +246,12 @@ func TestSaveTradesToDatabase(t *testing.T) {
 	}
 }
 
+func TestDeleteTradesFromDatabase(t *testing.T) {
+	t.Parallel()
+	assert.ErrorIs(t, DeleteTradesFromDatabase(), ErrNoTradesSupplied)
+	assert.ErrorIs(t, DeleteTradesFromDatabase(Data{}), errTradeIDUnset)
+}
+
 func TestGetTradesInRange(t *testing.T) {
 	t.Parallel()
 	_, err := GetTradesInRange("", "", "", "", time.Time{}, time.Time{})
@@ -249,3 +259,30 @@ func TestGetTradesInRange(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+func TestSubscribeToExchangeTrades(t *testing.T) {
+	t.Parallel()
+	_, err := SubscribeToExchangeTrades("")
+	assert.ErrorIs(t, err, common.ErrExchangeNameNotSet)
+
+	require.NoError(t, dispatch.EnsureRunning(dispatch.DefaultMaxWorkers, dispatch.DefaultJobsLimit))
+	pipe, err := SubscribeToExchangeTrades("TradeFeedTest")
+	require.NoError(t, err)
+	defer func() { assert.NoError(t, pipe.Release()) }()
+
+	var tr Trade
+	pair := currency.NewBTCUSD()
+	require.NoError(t, tr.Update(false,
+		Data{Exchange: "tradefeedtest", CurrencyPair: pair, AssetType: asset.Spot, Price: 1, Amount: 2},
+		Data{Exchange: "otherexchange", CurrencyPair: pair, AssetType: asset.Spot, Price: 3, Amount: 4},
+	))
+	select {
+	case data := <-pipe.Channel():
+		trades, ok := data.([]Data)
+		require.True(t, ok, "pipe must receive trade data")
+		require.Len(t, trades, 1, "pipe must only receive trades for the subscribed exchange")
+		assert.Equal(t, 1.0, trades[0].Price)
+	case <-time.After(time.Second):
+		require.Fail(t, "pipe must receive published trades")
+	}
+}
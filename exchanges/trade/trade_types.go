@@ -6,8 +6,11 @@ import (
 	"time"
 
 	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/common/key"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 )
 
@@ -16,12 +19,19 @@ import (
 const DefaultProcessorIntervalTime = time.Second * 15
 
 var (
-	processor Processor
+	processor  Processor
+	feed       = exchangeFeed{exchanges: make(map[string]uuid.UUID), mux: dispatch.GetNewMux(nil)}
+	candleFeed = exchangeFeed{exchanges: make(map[string]uuid.UUID), mux: dispatch.GetNewMux(nil)}
 	// BufferProcessorIntervalTime is the interval to save trade buffer data to the database.
 	// Change this by changing the runtime param `-tradeprocessinginterval=15s`
 	BufferProcessorIntervalTime = DefaultProcessorIntervalTime
 	// ErrNoTradesSupplied is returned when an attempt is made to process trades, but is an empty slice
 	ErrNoTradesSupplied = errors.New("no trades supplied")
+
+	errNoIntervals       = errors.New("no candle intervals supplied")
+	errInvalidInterval   = errors.New("invalid candle interval")
+	errDuplicateInterval = errors.New("duplicate candle interval")
+	errTradeIDUnset      = errors.New("trade database ID not set")
 )
 
 // Trade used to hold data and methods related to trade dissemination and
@@ -53,6 +63,51 @@ type Processor struct {
 	buffer                  []Data
 }
 
+// exchangeFeed routes processed data to dispatch subscribers by exchange
+type exchangeFeed struct {
+	m         sync.Mutex
+	exchanges map[string]uuid.UUID
+	mux       *dispatch.Mux
+}
+
+// CandleBuilder builds candles from live trades for multiple intervals.
+// Candles are closed when a trade arrives for a later interval or when Close is
+// called after the interval has ended
+type CandleBuilder struct {
+	m         sync.Mutex
+	intervals []kline.Interval
+	candles   map[candleKey]*candleState
+}
+
+// candleKey identifies the candle being built for an exchange, asset, pair
+// and interval
+type candleKey struct {
+	key.ExchangeAssetPair
+	Interval kline.Interval
+}
+
+// candleState holds the open candle for a key, the times of the trades which
+// set its open and close prices and the end of the last candle closed. Trades
+// before closedUntil arrived too late and are dropped
+type candleState struct {
+	exchange    string
+	pair        currency.Pair
+	candle      kline.Candle
+	first       time.Time
+	last        time.Time
+	open        bool
+	closedUntil time.Time
+}
+
+// ClosedCandle is a completed candle built from trades
+type ClosedCandle struct {
+	Exchange string
+	Pair     currency.Pair
+	Asset    asset.Item
+	Interval kline.Interval
+	kline.Candle
+}
+
 // ByDate sorts trades by date ascending
 type ByDate []Data
 
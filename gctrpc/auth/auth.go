@@ -2,9 +2,16 @@ package auth
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/sha256"
 	"encoding/base64"
+	"encoding/hex"
+
+	"golang.org/x/crypto/bcrypt"
 )
 
+const tokenLength = 32
+
 // BasicAuth stores a basic auth username/password
 type BasicAuth struct {
 	Username string
@@ -24,3 +31,51 @@ func (b BasicAuth) GetRequestMetadata(_ context.Context, _ ...string) (map[strin
 func (BasicAuth) RequireTransportSecurity() bool {
 	return true
 }
+
+// BearerToken stores a bearer token
+type BearerToken struct {
+	Token string
+}
+
+// GetRequestMetadata is a implementation of the GetRequestMetadata function
+func (b BearerToken) GetRequestMetadata(_ context.Context, _ ...string) (map[string]string, error) {
+	return map[string]string{
+		"authorization": "Bearer " + b.Token,
+	}, nil
+}
+
+// RequireTransportSecurity is required for bearer tokens
+func (BearerToken) RequireTransportSecurity() bool {
+	return true
+}
+
+// HashPassword returns the bcrypt hash of a password
+func HashPassword(password string) (string, error) {
+	h, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return "", err
+	}
+	return string(h), nil
+}
+
+// CheckPassword returns true if the password matches the bcrypt hash
+func CheckPassword(hash, password string) bool {
+	return bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)) == nil
+}
+
+// HashToken returns the hex encoded SHA-256 hash of a bearer token. Tokens are
+// randomly generated so a fast hash is sufficient
+func HashToken(token string) string {
+	h := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(h[:])
+}
+
+// GenerateToken returns a random bearer token and its hash
+func GenerateToken() (token, hash string, err error) {
+	b := make([]byte, tokenLength)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", err
+	}
+	token = base64.RawURLEncoding.EncodeToString(b)
+	return token, HashToken(token), nil
+}
@@ -0,0 +1,46 @@
+package auth
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBasicAuth(t *testing.T) {
+	t.Parallel()
+	md, err := BasicAuth{Username: "admin", Password: "Password"}.GetRequestMetadata(t.Context())
+	require.NoError(t, err, "GetRequestMetadata must not error")
+	assert.Equal(t, "Basic YWRtaW46UGFzc3dvcmQ=", md["authorization"], "authorization should be set correctly")
+	assert.True(t, BasicAuth{}.RequireTransportSecurity(), "RequireTransportSecurity should return true")
+}
+
+func TestBearerToken(t *testing.T) {
+	t.Parallel()
+	md, err := BearerToken{Token: "token"}.GetRequestMetadata(t.Context())
+	require.NoError(t, err, "GetRequestMetadata must not error")
+	assert.Equal(t, "Bearer token", md["authorization"], "authorization should be set correctly")
+	assert.True(t, BearerToken{}.RequireTransportSecurity(), "RequireTransportSecurity should return true")
+}
+
+func TestHashPassword(t *testing.T) {
+	t.Parallel()
+	h, err := HashPassword("Password")
+	require.NoError(t, err, "HashPassword must not error")
+	assert.True(t, CheckPassword(h, "Password"), "CheckPassword should return true for the correct password")
+	assert.False(t, CheckPassword(h, "password"), "CheckPassword should return false for an incorrect password")
+	assert.False(t, CheckPassword("invalid", "Password"), "CheckPassword should return false for an invalid hash")
+}
+
+func TestGenerateToken(t *testing.T) {
+	t.Parallel()
+	token, hash, err := GenerateToken()
+	require.NoError(t, err, "GenerateToken must not error")
+	assert.Len(t, token, 43, "token should be the correct length")
+	assert.Equal(t, HashToken(token), hash, "hash should be the hash of the token")
+	assert.Len(t, hash, 64, "hash should be a hex encoded SHA-256 hash")
+
+	other, _, err := GenerateToken()
+	require.NoError(t, err, "GenerateToken must not error")
+	assert.NotEqual(t, token, other, "GenerateToken should return a different token each call")
+}
@@ -3040,27 +3040,30 @@ func (x *GetForexRatesResponse) GetForexRates() []*ForexRatesConversion {
 }
 
 type OrderDetails struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Exchange       string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Id             string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
-	ClientOrderId  string                 `protobuf:"bytes,3,opt,name=client_order_id,json=clientOrderId,proto3" json:"client_order_id,omitempty"`
-	BaseCurrency   string                 `protobuf:"bytes,4,opt,name=base_currency,json=baseCurrency,proto3" json:"base_currency,omitempty"`
-	QuoteCurrency  string                 `protobuf:"bytes,5,opt,name=quote_currency,json=quoteCurrency,proto3" json:"quote_currency,omitempty"`
-	AssetType      string                 `protobuf:"bytes,6,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
-	OrderSide      string                 `protobuf:"bytes,7,opt,name=order_side,json=orderSide,proto3" json:"order_side,omitempty"`
-	OrderType      string                 `protobuf:"bytes,8,opt,name=order_type,json=orderType,proto3" json:"order_type,omitempty"`
-	CreationTime   string                 `protobuf:"bytes,9,opt,name=creation_time,json=creationTime,proto3" json:"creation_time,omitempty"`
-	UpdateTime     string                 `protobuf:"bytes,10,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
-	Status         string                 `protobuf:"bytes,11,opt,name=status,proto3" json:"status,omitempty"`
-	Price          float64                `protobuf:"fixed64,12,opt,name=price,proto3" json:"price,omitempty"`
-	Amount         float64                `protobuf:"fixed64,13,opt,name=amount,proto3" json:"amount,omitempty"`
-	OpenVolume     float64                `protobuf:"fixed64,14,opt,name=open_volume,json=openVolume,proto3" json:"open_volume,omitempty"`
-	Fee            float64                `protobuf:"fixed64,15,opt,name=fee,proto3" json:"fee,omitempty"`
-	Cost           float64                `protobuf:"fixed64,16,opt,name=cost,proto3" json:"cost,omitempty"`
-	Trades         []*TradeHistory        `protobuf:"bytes,17,rep,name=trades,proto3" json:"trades,omitempty"`
-	ContractAmount float64                `protobuf:"fixed64,18,opt,name=contract_amount,json=contractAmount,proto3" json:"contract_amount,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Exchange             string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Id                   string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	ClientOrderId        string                 `protobuf:"bytes,3,opt,name=client_order_id,json=clientOrderId,proto3" json:"client_order_id,omitempty"`
+	BaseCurrency         string                 `protobuf:"bytes,4,opt,name=base_currency,json=baseCurrency,proto3" json:"base_currency,omitempty"`
+	QuoteCurrency        string                 `protobuf:"bytes,5,opt,name=quote_currency,json=quoteCurrency,proto3" json:"quote_currency,omitempty"`
+	AssetType            string                 `protobuf:"bytes,6,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	OrderSide            string                 `protobuf:"bytes,7,opt,name=order_side,json=orderSide,proto3" json:"order_side,omitempty"`
+	OrderType            string                 `protobuf:"bytes,8,opt,name=order_type,json=orderType,proto3" json:"order_type,omitempty"`
+	CreationTime         string                 `protobuf:"bytes,9,opt,name=creation_time,json=creationTime,proto3" json:"creation_time,omitempty"`
+	UpdateTime           string                 `protobuf:"bytes,10,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	Status               string                 `protobuf:"bytes,11,opt,name=status,proto3" json:"status,omitempty"`
+	Price                float64                `protobuf:"fixed64,12,opt,name=price,proto3" json:"price,omitempty"`
+	Amount               float64                `protobuf:"fixed64,13,opt,name=amount,proto3" json:"amount,omitempty"`
+	OpenVolume           float64                `protobuf:"fixed64,14,opt,name=open_volume,json=openVolume,proto3" json:"open_volume,omitempty"`
+	Fee                  float64                `protobuf:"fixed64,15,opt,name=fee,proto3" json:"fee,omitempty"`
+	Cost                 float64                `protobuf:"fixed64,16,opt,name=cost,proto3" json:"cost,omitempty"`
+	Trades               []*TradeHistory        `protobuf:"bytes,17,rep,name=trades,proto3" json:"trades,omitempty"`
+	ContractAmount       float64                `protobuf:"fixed64,18,opt,name=contract_amount,json=contractAmount,proto3" json:"contract_amount,omitempty"`
+	ExecutedAmount       float64                `protobuf:"fixed64,19,opt,name=executed_amount,json=executedAmount,proto3" json:"executed_amount,omitempty"`
+	AverageExecutedPrice float64                `protobuf:"fixed64,20,opt,name=average_executed_price,json=averageExecutedPrice,proto3" json:"average_executed_price,omitempty"`
+	FeeAsset             string                 `protobuf:"bytes,21,opt,name=fee_asset,json=feeAsset,proto3" json:"fee_asset,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
 func (x *OrderDetails) Reset() {
@@ -3219,6 +3222,27 @@ func (x *OrderDetails) GetContractAmount() float64 {
 	return 0
 }
 
+func (x *OrderDetails) GetExecutedAmount() float64 {
+	if x != nil {
+		return x.ExecutedAmount
+	}
+	return 0
+}
+
+func (x *OrderDetails) GetAverageExecutedPrice() float64 {
+	if x != nil {
+		return x.AverageExecutedPrice
+	}
+	return 0
+}
+
+func (x *OrderDetails) GetFeeAsset() string {
+	if x != nil {
+		return x.FeeAsset
+	}
+	return ""
+}
+
 type TradeHistory struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	CreationTime  int64                  `protobuf:"varint,1,opt,name=creation_time,json=creationTime,proto3" json:"creation_time,omitempty"`
@@ -3328,14 +3352,15 @@ func (x *TradeHistory) GetTotal() float64 {
 }
 
 type GetOrdersRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	AssetType     string                 `protobuf:"bytes,2,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
-	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	StartDate     string                 `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
-	EndDate       string                 `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Exchange       string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	AssetType      string                 `protobuf:"bytes,2,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Pair           *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	StartDate      string                 `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate        string                 `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	IncludeHistory bool                   `protobuf:"varint,6,opt,name=include_history,json=includeHistory,proto3" json:"include_history,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *GetOrdersRequest) Reset() {
@@ -3403,6 +3428,13 @@ func (x *GetOrdersRequest) GetEndDate() string {
 	return ""
 }
 
+func (x *GetOrdersRequest) GetIncludeHistory() bool {
+	if x != nil {
+		return x.IncludeHistory
+	}
+	return false
+}
+
 type GetOrdersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Orders        []*OrderDetails        `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
@@ -4384,14 +4416,23 @@ func (*GetEventsRequest) Descriptor() ([]byte, []int) {
 }
 
 type ConditionParams struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Condition       string                 `protobuf:"bytes,1,opt,name=condition,proto3" json:"condition,omitempty"`
-	Price           float64                `protobuf:"fixed64,2,opt,name=price,proto3" json:"price,omitempty"`
-	CheckBids       bool                   `protobuf:"varint,3,opt,name=check_bids,json=checkBids,proto3" json:"check_bids,omitempty"`
-	CheckAsks       bool                   `protobuf:"varint,4,opt,name=check_asks,json=checkAsks,proto3" json:"check_asks,omitempty"`
-	OrderbookAmount float64                `protobuf:"fixed64,5,opt,name=orderbook_amount,json=orderbookAmount,proto3" json:"orderbook_amount,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Condition          string                 `protobuf:"bytes,1,opt,name=condition,proto3" json:"condition,omitempty"`
+	Price              float64                `protobuf:"fixed64,2,opt,name=price,proto3" json:"price,omitempty"`
+	CheckBids          bool                   `protobuf:"varint,3,opt,name=check_bids,json=checkBids,proto3" json:"check_bids,omitempty"`
+	CheckAsks          bool                   `protobuf:"varint,4,opt,name=check_asks,json=checkAsks,proto3" json:"check_asks,omitempty"`
+	OrderbookAmount    float64                `protobuf:"fixed64,5,opt,name=orderbook_amount,json=orderbookAmount,proto3" json:"orderbook_amount,omitempty"`
+	Interval           int64                  `protobuf:"varint,6,opt,name=interval,proto3" json:"interval,omitempty"`
+	Period             int64                  `protobuf:"varint,7,opt,name=period,proto3" json:"period,omitempty"`
+	FastPeriod         int64                  `protobuf:"varint,8,opt,name=fast_period,json=fastPeriod,proto3" json:"fast_period,omitempty"`
+	SlowPeriod         int64                  `protobuf:"varint,9,opt,name=slow_period,json=slowPeriod,proto3" json:"slow_period,omitempty"`
+	SignalPeriod       int64                  `protobuf:"varint,10,opt,name=signal_period,json=signalPeriod,proto3" json:"signal_period,omitempty"`
+	StandardDeviations float64                `protobuf:"fixed64,11,opt,name=standard_deviations,json=standardDeviations,proto3" json:"standard_deviations,omitempty"`
+	Direction          string                 `protobuf:"bytes,12,opt,name=direction,proto3" json:"direction,omitempty"`
+	Window             int64                  `protobuf:"varint,13,opt,name=window,proto3" json:"window,omitempty"`
+	Value              float64                `protobuf:"fixed64,14,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *ConditionParams) Reset() {
@@ -4459,125 +4500,94 @@ func (x *ConditionParams) GetOrderbookAmount() float64 {
 	return 0
 }
 
-type GetEventsResponse struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Id              int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Exchange        string                 `protobuf:"bytes,2,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Item            string                 `protobuf:"bytes,3,opt,name=item,proto3" json:"item,omitempty"`
-	ConditionParams *ConditionParams       `protobuf:"bytes,4,opt,name=condition_params,json=conditionParams,proto3" json:"condition_params,omitempty"`
-	Pair            *CurrencyPair          `protobuf:"bytes,5,opt,name=pair,proto3" json:"pair,omitempty"`
-	Action          string                 `protobuf:"bytes,6,opt,name=action,proto3" json:"action,omitempty"`
-	Executed        bool                   `protobuf:"varint,7,opt,name=executed,proto3" json:"executed,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
-}
-
-func (x *GetEventsResponse) Reset() {
-	*x = GetEventsResponse{}
-	mi := &file_rpc_proto_msgTypes[75]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetEventsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetEventsResponse) ProtoMessage() {}
-
-func (x *GetEventsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[75]
+func (x *ConditionParams) GetInterval() int64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Interval
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use GetEventsResponse.ProtoReflect.Descriptor instead.
-func (*GetEventsResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{75}
+func (x *ConditionParams) GetPeriod() int64 {
+	if x != nil {
+		return x.Period
+	}
+	return 0
 }
 
-func (x *GetEventsResponse) GetId() int64 {
+func (x *ConditionParams) GetFastPeriod() int64 {
 	if x != nil {
-		return x.Id
+		return x.FastPeriod
 	}
 	return 0
 }
 
-func (x *GetEventsResponse) GetExchange() string {
+func (x *ConditionParams) GetSlowPeriod() int64 {
 	if x != nil {
-		return x.Exchange
+		return x.SlowPeriod
 	}
-	return ""
+	return 0
 }
 
-func (x *GetEventsResponse) GetItem() string {
+func (x *ConditionParams) GetSignalPeriod() int64 {
 	if x != nil {
-		return x.Item
+		return x.SignalPeriod
 	}
-	return ""
+	return 0
 }
 
-func (x *GetEventsResponse) GetConditionParams() *ConditionParams {
+func (x *ConditionParams) GetStandardDeviations() float64 {
 	if x != nil {
-		return x.ConditionParams
+		return x.StandardDeviations
 	}
-	return nil
+	return 0
 }
 
-func (x *GetEventsResponse) GetPair() *CurrencyPair {
+func (x *ConditionParams) GetDirection() string {
 	if x != nil {
-		return x.Pair
+		return x.Direction
 	}
-	return nil
+	return ""
 }
 
-func (x *GetEventsResponse) GetAction() string {
+func (x *ConditionParams) GetWindow() int64 {
 	if x != nil {
-		return x.Action
+		return x.Window
 	}
-	return ""
+	return 0
 }
 
-func (x *GetEventsResponse) GetExecuted() bool {
+func (x *ConditionParams) GetValue() float64 {
 	if x != nil {
-		return x.Executed
+		return x.Value
 	}
-	return false
+	return 0
 }
 
-type AddEventRequest struct {
+type EventCondition struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
-	Exchange        string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Item            string                 `protobuf:"bytes,2,opt,name=item,proto3" json:"item,omitempty"`
-	ConditionParams *ConditionParams       `protobuf:"bytes,3,opt,name=condition_params,json=conditionParams,proto3" json:"condition_params,omitempty"`
-	Pair            *CurrencyPair          `protobuf:"bytes,4,opt,name=pair,proto3" json:"pair,omitempty"`
-	AssetType       string                 `protobuf:"bytes,5,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
-	Action          string                 `protobuf:"bytes,6,opt,name=action,proto3" json:"action,omitempty"`
+	Item            string                 `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	ConditionParams *ConditionParams       `protobuf:"bytes,2,opt,name=condition_params,json=conditionParams,proto3" json:"condition_params,omitempty"`
+	Operator        string                 `protobuf:"bytes,3,opt,name=operator,proto3" json:"operator,omitempty"`
+	Conditions      []*EventCondition      `protobuf:"bytes,4,rep,name=conditions,proto3" json:"conditions,omitempty"`
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
 
-func (x *AddEventRequest) Reset() {
-	*x = AddEventRequest{}
-	mi := &file_rpc_proto_msgTypes[76]
+func (x *EventCondition) Reset() {
+	*x = EventCondition{}
+	mi := &file_rpc_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddEventRequest) String() string {
+func (x *EventCondition) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddEventRequest) ProtoMessage() {}
+func (*EventCondition) ProtoMessage() {}
 
-func (x *AddEventRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[76]
+func (x *EventCondition) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4588,75 +4598,69 @@ func (x *AddEventRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddEventRequest.ProtoReflect.Descriptor instead.
-func (*AddEventRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{76}
-}
-
-func (x *AddEventRequest) GetExchange() string {
-	if x != nil {
-		return x.Exchange
-	}
-	return ""
+// Deprecated: Use EventCondition.ProtoReflect.Descriptor instead.
+func (*EventCondition) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *AddEventRequest) GetItem() string {
+func (x *EventCondition) GetItem() string {
 	if x != nil {
 		return x.Item
 	}
 	return ""
 }
 
-func (x *AddEventRequest) GetConditionParams() *ConditionParams {
+func (x *EventCondition) GetConditionParams() *ConditionParams {
 	if x != nil {
 		return x.ConditionParams
 	}
 	return nil
 }
 
-func (x *AddEventRequest) GetPair() *CurrencyPair {
-	if x != nil {
-		return x.Pair
-	}
-	return nil
-}
-
-func (x *AddEventRequest) GetAssetType() string {
+func (x *EventCondition) GetOperator() string {
 	if x != nil {
-		return x.AssetType
+		return x.Operator
 	}
 	return ""
 }
 
-func (x *AddEventRequest) GetAction() string {
+func (x *EventCondition) GetConditions() []*EventCondition {
 	if x != nil {
-		return x.Action
+		return x.Conditions
 	}
-	return ""
+	return nil
 }
 
-type AddEventResponse struct {
+type EventAction struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Action        string                 `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	Side          string                 `protobuf:"bytes,2,opt,name=side,proto3" json:"side,omitempty"`
+	OrderType     string                 `protobuf:"bytes,3,opt,name=order_type,json=orderType,proto3" json:"order_type,omitempty"`
+	Amount        float64                `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Price         float64                `protobuf:"fixed64,5,opt,name=price,proto3" json:"price,omitempty"`
+	OrderId       string                 `protobuf:"bytes,6,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Script        string                 `protobuf:"bytes,7,opt,name=script,proto3" json:"script,omitempty"`
+	Relayer       string                 `protobuf:"bytes,8,opt,name=relayer,proto3" json:"relayer,omitempty"`
+	Message       string                 `protobuf:"bytes,9,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddEventResponse) Reset() {
-	*x = AddEventResponse{}
-	mi := &file_rpc_proto_msgTypes[77]
+func (x *EventAction) Reset() {
+	*x = EventAction{}
+	mi := &file_rpc_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddEventResponse) String() string {
+func (x *EventAction) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddEventResponse) ProtoMessage() {}
+func (*EventAction) ProtoMessage() {}
 
-func (x *AddEventResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[77]
+func (x *EventAction) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4667,84 +4671,107 @@ func (x *AddEventResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddEventResponse.ProtoReflect.Descriptor instead.
-func (*AddEventResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{77}
+// Deprecated: Use EventAction.ProtoReflect.Descriptor instead.
+func (*EventAction) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *AddEventResponse) GetId() int64 {
+func (x *EventAction) GetAction() string {
 	if x != nil {
-		return x.Id
+		return x.Action
 	}
-	return 0
+	return ""
 }
 
-type RemoveEventRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *EventAction) GetSide() string {
+	if x != nil {
+		return x.Side
+	}
+	return ""
 }
 
-func (x *RemoveEventRequest) Reset() {
-	*x = RemoveEventRequest{}
-	mi := &file_rpc_proto_msgTypes[78]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *EventAction) GetOrderType() string {
+	if x != nil {
+		return x.OrderType
+	}
+	return ""
 }
 
-func (x *RemoveEventRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *EventAction) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
 }
 
-func (*RemoveEventRequest) ProtoMessage() {}
+func (x *EventAction) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
 
-func (x *RemoveEventRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[78]
+func (x *EventAction) GetOrderId() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.OrderId
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use RemoveEventRequest.ProtoReflect.Descriptor instead.
-func (*RemoveEventRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{78}
+func (x *EventAction) GetScript() string {
+	if x != nil {
+		return x.Script
+	}
+	return ""
 }
 
-func (x *RemoveEventRequest) GetId() int64 {
+func (x *EventAction) GetRelayer() string {
 	if x != nil {
-		return x.Id
+		return x.Relayer
 	}
-	return 0
+	return ""
 }
 
-type GetCryptocurrencyDepositAddressesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *EventAction) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
 }
 
-func (x *GetCryptocurrencyDepositAddressesRequest) Reset() {
-	*x = GetCryptocurrencyDepositAddressesRequest{}
-	mi := &file_rpc_proto_msgTypes[79]
+type EventDetails struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Exchange        string                 `protobuf:"bytes,2,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Item            string                 `protobuf:"bytes,3,opt,name=item,proto3" json:"item,omitempty"`
+	ConditionParams *ConditionParams       `protobuf:"bytes,4,opt,name=condition_params,json=conditionParams,proto3" json:"condition_params,omitempty"`
+	Pair            *CurrencyPair          `protobuf:"bytes,5,opt,name=pair,proto3" json:"pair,omitempty"`
+	Action          string                 `protobuf:"bytes,6,opt,name=action,proto3" json:"action,omitempty"`
+	Executed        bool                   `protobuf:"varint,7,opt,name=executed,proto3" json:"executed,omitempty"`
+	AssetType       string                 `protobuf:"bytes,8,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Operator        string                 `protobuf:"bytes,9,opt,name=operator,proto3" json:"operator,omitempty"`
+	Conditions      []*EventCondition      `protobuf:"bytes,10,rep,name=conditions,proto3" json:"conditions,omitempty"`
+	Actions         []*EventAction         `protobuf:"bytes,11,rep,name=actions,proto3" json:"actions,omitempty"`
+	CreatedAt       string                 `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *EventDetails) Reset() {
+	*x = EventDetails{}
+	mi := &file_rpc_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCryptocurrencyDepositAddressesRequest) String() string {
+func (x *EventDetails) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCryptocurrencyDepositAddressesRequest) ProtoMessage() {}
+func (*EventDetails) ProtoMessage() {}
 
-func (x *GetCryptocurrencyDepositAddressesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[79]
+func (x *EventDetails) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4755,100 +4782,117 @@ func (x *GetCryptocurrencyDepositAddressesRequest) ProtoReflect() protoreflect.M
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCryptocurrencyDepositAddressesRequest.ProtoReflect.Descriptor instead.
-func (*GetCryptocurrencyDepositAddressesRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{79}
+// Deprecated: Use EventDetails.ProtoReflect.Descriptor instead.
+func (*EventDetails) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{77}
 }
 
-func (x *GetCryptocurrencyDepositAddressesRequest) GetExchange() string {
+func (x *EventDetails) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *EventDetails) GetExchange() string {
 	if x != nil {
 		return x.Exchange
 	}
 	return ""
 }
 
-type DepositAddress struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Address       string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Tag           string                 `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
-	Chain         string                 `protobuf:"bytes,3,opt,name=chain,proto3" json:"chain,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *EventDetails) GetItem() string {
+	if x != nil {
+		return x.Item
+	}
+	return ""
 }
 
-func (x *DepositAddress) Reset() {
-	*x = DepositAddress{}
-	mi := &file_rpc_proto_msgTypes[80]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *EventDetails) GetConditionParams() *ConditionParams {
+	if x != nil {
+		return x.ConditionParams
+	}
+	return nil
 }
 
-func (x *DepositAddress) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *EventDetails) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
 }
 
-func (*DepositAddress) ProtoMessage() {}
-
-func (x *DepositAddress) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[80]
+func (x *EventDetails) GetAction() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Action
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use DepositAddress.ProtoReflect.Descriptor instead.
-func (*DepositAddress) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{80}
+func (x *EventDetails) GetExecuted() bool {
+	if x != nil {
+		return x.Executed
+	}
+	return false
 }
 
-func (x *DepositAddress) GetAddress() string {
+func (x *EventDetails) GetAssetType() string {
 	if x != nil {
-		return x.Address
+		return x.AssetType
 	}
 	return ""
 }
 
-func (x *DepositAddress) GetTag() string {
+func (x *EventDetails) GetOperator() string {
 	if x != nil {
-		return x.Tag
+		return x.Operator
 	}
 	return ""
 }
 
-func (x *DepositAddress) GetChain() string {
+func (x *EventDetails) GetConditions() []*EventCondition {
 	if x != nil {
-		return x.Chain
+		return x.Conditions
+	}
+	return nil
+}
+
+func (x *EventDetails) GetActions() []*EventAction {
+	if x != nil {
+		return x.Actions
+	}
+	return nil
+}
+
+func (x *EventDetails) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
 	}
 	return ""
 }
 
-type DepositAddresses struct {
+type GetEventsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Addresses     []*DepositAddress      `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	Events        []*EventDetails        `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DepositAddresses) Reset() {
-	*x = DepositAddresses{}
-	mi := &file_rpc_proto_msgTypes[81]
+func (x *GetEventsResponse) Reset() {
+	*x = GetEventsResponse{}
+	mi := &file_rpc_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DepositAddresses) String() string {
+func (x *GetEventsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DepositAddresses) ProtoMessage() {}
+func (*GetEventsResponse) ProtoMessage() {}
 
-func (x *DepositAddresses) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[81]
+func (x *GetEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4859,40 +4903,48 @@ func (x *DepositAddresses) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DepositAddresses.ProtoReflect.Descriptor instead.
-func (*DepositAddresses) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{81}
+// Deprecated: Use GetEventsResponse.ProtoReflect.Descriptor instead.
+func (*GetEventsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *DepositAddresses) GetAddresses() []*DepositAddress {
+func (x *GetEventsResponse) GetEvents() []*EventDetails {
 	if x != nil {
-		return x.Addresses
+		return x.Events
 	}
 	return nil
 }
 
-type GetCryptocurrencyDepositAddressesResponse struct {
-	state         protoimpl.MessageState       `protogen:"open.v1"`
-	Addresses     map[string]*DepositAddresses `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type AddEventRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Exchange        string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Item            string                 `protobuf:"bytes,2,opt,name=item,proto3" json:"item,omitempty"`
+	ConditionParams *ConditionParams       `protobuf:"bytes,3,opt,name=condition_params,json=conditionParams,proto3" json:"condition_params,omitempty"`
+	Pair            *CurrencyPair          `protobuf:"bytes,4,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType       string                 `protobuf:"bytes,5,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Action          string                 `protobuf:"bytes,6,opt,name=action,proto3" json:"action,omitempty"`
+	Operator        string                 `protobuf:"bytes,7,opt,name=operator,proto3" json:"operator,omitempty"`
+	Conditions      []*EventCondition      `protobuf:"bytes,8,rep,name=conditions,proto3" json:"conditions,omitempty"`
+	Actions         []*EventAction         `protobuf:"bytes,9,rep,name=actions,proto3" json:"actions,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *GetCryptocurrencyDepositAddressesResponse) Reset() {
-	*x = GetCryptocurrencyDepositAddressesResponse{}
-	mi := &file_rpc_proto_msgTypes[82]
+func (x *AddEventRequest) Reset() {
+	*x = AddEventRequest{}
+	mi := &file_rpc_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCryptocurrencyDepositAddressesResponse) String() string {
+func (x *AddEventRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCryptocurrencyDepositAddressesResponse) ProtoMessage() {}
+func (*AddEventRequest) ProtoMessage() {}
 
-func (x *GetCryptocurrencyDepositAddressesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[82]
+func (x *AddEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4903,109 +4955,96 @@ func (x *GetCryptocurrencyDepositAddressesResponse) ProtoReflect() protoreflect.
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCryptocurrencyDepositAddressesResponse.ProtoReflect.Descriptor instead.
-func (*GetCryptocurrencyDepositAddressesResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{82}
+// Deprecated: Use AddEventRequest.ProtoReflect.Descriptor instead.
+func (*AddEventRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *GetCryptocurrencyDepositAddressesResponse) GetAddresses() map[string]*DepositAddresses {
+func (x *AddEventRequest) GetExchange() string {
 	if x != nil {
-		return x.Addresses
+		return x.Exchange
 	}
-	return nil
-}
-
-type GetCryptocurrencyDepositAddressRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Exchange       string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Cryptocurrency string                 `protobuf:"bytes,2,opt,name=cryptocurrency,proto3" json:"cryptocurrency,omitempty"`
-	Chain          string                 `protobuf:"bytes,3,opt,name=chain,proto3" json:"chain,omitempty"`
-	Bypass         bool                   `protobuf:"varint,4,opt,name=bypass,proto3" json:"bypass,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	return ""
 }
 
-func (x *GetCryptocurrencyDepositAddressRequest) Reset() {
-	*x = GetCryptocurrencyDepositAddressRequest{}
-	mi := &file_rpc_proto_msgTypes[83]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *AddEventRequest) GetItem() string {
+	if x != nil {
+		return x.Item
+	}
+	return ""
 }
 
-func (x *GetCryptocurrencyDepositAddressRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *AddEventRequest) GetConditionParams() *ConditionParams {
+	if x != nil {
+		return x.ConditionParams
+	}
+	return nil
 }
 
-func (*GetCryptocurrencyDepositAddressRequest) ProtoMessage() {}
-
-func (x *GetCryptocurrencyDepositAddressRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[83]
+func (x *AddEventRequest) GetPair() *CurrencyPair {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Pair
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use GetCryptocurrencyDepositAddressRequest.ProtoReflect.Descriptor instead.
-func (*GetCryptocurrencyDepositAddressRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{83}
+func (x *AddEventRequest) GetAssetType() string {
+	if x != nil {
+		return x.AssetType
+	}
+	return ""
 }
 
-func (x *GetCryptocurrencyDepositAddressRequest) GetExchange() string {
+func (x *AddEventRequest) GetAction() string {
 	if x != nil {
-		return x.Exchange
+		return x.Action
 	}
 	return ""
 }
 
-func (x *GetCryptocurrencyDepositAddressRequest) GetCryptocurrency() string {
+func (x *AddEventRequest) GetOperator() string {
 	if x != nil {
-		return x.Cryptocurrency
+		return x.Operator
 	}
 	return ""
 }
 
-func (x *GetCryptocurrencyDepositAddressRequest) GetChain() string {
+func (x *AddEventRequest) GetConditions() []*EventCondition {
 	if x != nil {
-		return x.Chain
+		return x.Conditions
 	}
-	return ""
+	return nil
 }
 
-func (x *GetCryptocurrencyDepositAddressRequest) GetBypass() bool {
+func (x *AddEventRequest) GetActions() []*EventAction {
 	if x != nil {
-		return x.Bypass
+		return x.Actions
 	}
-	return false
+	return nil
 }
 
-type GetCryptocurrencyDepositAddressResponse struct {
+type AddEventResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Address       string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Tag           string                 `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCryptocurrencyDepositAddressResponse) Reset() {
-	*x = GetCryptocurrencyDepositAddressResponse{}
-	mi := &file_rpc_proto_msgTypes[84]
+func (x *AddEventResponse) Reset() {
+	*x = AddEventResponse{}
+	mi := &file_rpc_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCryptocurrencyDepositAddressResponse) String() string {
+func (x *AddEventResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCryptocurrencyDepositAddressResponse) ProtoMessage() {}
+func (*AddEventResponse) ProtoMessage() {}
 
-func (x *GetCryptocurrencyDepositAddressResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[84]
+func (x *AddEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5016,48 +5055,40 @@ func (x *GetCryptocurrencyDepositAddressResponse) ProtoReflect() protoreflect.Me
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCryptocurrencyDepositAddressResponse.ProtoReflect.Descriptor instead.
-func (*GetCryptocurrencyDepositAddressResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{84}
+// Deprecated: Use AddEventResponse.ProtoReflect.Descriptor instead.
+func (*AddEventResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{80}
 }
 
-func (x *GetCryptocurrencyDepositAddressResponse) GetAddress() string {
+func (x *AddEventResponse) GetId() int64 {
 	if x != nil {
-		return x.Address
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *GetCryptocurrencyDepositAddressResponse) GetTag() string {
-	if x != nil {
-		return x.Tag
-	}
-	return ""
+type RemoveEventRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-type GetAvailableTransferChainsRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Exchange       string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Cryptocurrency string                 `protobuf:"bytes,2,opt,name=cryptocurrency,proto3" json:"cryptocurrency,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *RemoveEventRequest) Reset() {
+	*x = RemoveEventRequest{}
+	mi := &file_rpc_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAvailableTransferChainsRequest) Reset() {
-	*x = GetAvailableTransferChainsRequest{}
-	mi := &file_rpc_proto_msgTypes[85]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetAvailableTransferChainsRequest) String() string {
+func (x *RemoveEventRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAvailableTransferChainsRequest) ProtoMessage() {}
+func (*RemoveEventRequest) ProtoMessage() {}
 
-func (x *GetAvailableTransferChainsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[85]
+func (x *RemoveEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5068,47 +5099,40 @@ func (x *GetAvailableTransferChainsRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAvailableTransferChainsRequest.ProtoReflect.Descriptor instead.
-func (*GetAvailableTransferChainsRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{85}
-}
-
-func (x *GetAvailableTransferChainsRequest) GetExchange() string {
-	if x != nil {
-		return x.Exchange
-	}
-	return ""
+// Deprecated: Use RemoveEventRequest.ProtoReflect.Descriptor instead.
+func (*RemoveEventRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{81}
 }
 
-func (x *GetAvailableTransferChainsRequest) GetCryptocurrency() string {
+func (x *RemoveEventRequest) GetId() int64 {
 	if x != nil {
-		return x.Cryptocurrency
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-type GetAvailableTransferChainsResponse struct {
+type GetCryptocurrencyDepositAddressesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Chains        []string               `protobuf:"bytes,1,rep,name=chains,proto3" json:"chains,omitempty"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAvailableTransferChainsResponse) Reset() {
-	*x = GetAvailableTransferChainsResponse{}
-	mi := &file_rpc_proto_msgTypes[86]
+func (x *GetCryptocurrencyDepositAddressesRequest) Reset() {
+	*x = GetCryptocurrencyDepositAddressesRequest{}
+	mi := &file_rpc_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAvailableTransferChainsResponse) String() string {
+func (x *GetCryptocurrencyDepositAddressesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAvailableTransferChainsResponse) ProtoMessage() {}
+func (*GetCryptocurrencyDepositAddressesRequest) ProtoMessage() {}
 
-func (x *GetAvailableTransferChainsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[86]
+func (x *GetCryptocurrencyDepositAddressesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5119,44 +5143,42 @@ func (x *GetAvailableTransferChainsResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAvailableTransferChainsResponse.ProtoReflect.Descriptor instead.
-func (*GetAvailableTransferChainsResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{86}
+// Deprecated: Use GetCryptocurrencyDepositAddressesRequest.ProtoReflect.Descriptor instead.
+func (*GetCryptocurrencyDepositAddressesRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{82}
 }
 
-func (x *GetAvailableTransferChainsResponse) GetChains() []string {
+func (x *GetCryptocurrencyDepositAddressesRequest) GetExchange() string {
 	if x != nil {
-		return x.Chains
+		return x.Exchange
 	}
-	return nil
+	return ""
 }
 
-type WithdrawFiatRequest struct {
+type DepositAddress struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Currency      string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
-	Amount        float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
-	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	BankAccountId string                 `protobuf:"bytes,5,opt,name=bank_account_id,json=bankAccountId,proto3" json:"bank_account_id,omitempty"`
+	Address       string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Tag           string                 `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+	Chain         string                 `protobuf:"bytes,3,opt,name=chain,proto3" json:"chain,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WithdrawFiatRequest) Reset() {
-	*x = WithdrawFiatRequest{}
-	mi := &file_rpc_proto_msgTypes[87]
+func (x *DepositAddress) Reset() {
+	*x = DepositAddress{}
+	mi := &file_rpc_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WithdrawFiatRequest) String() string {
+func (x *DepositAddress) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WithdrawFiatRequest) ProtoMessage() {}
+func (*DepositAddress) ProtoMessage() {}
 
-func (x *WithdrawFiatRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[87]
+func (x *DepositAddress) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5167,75 +5189,54 @@ func (x *WithdrawFiatRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WithdrawFiatRequest.ProtoReflect.Descriptor instead.
-func (*WithdrawFiatRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{87}
-}
-
-func (x *WithdrawFiatRequest) GetExchange() string {
-	if x != nil {
-		return x.Exchange
-	}
-	return ""
+// Deprecated: Use DepositAddress.ProtoReflect.Descriptor instead.
+func (*DepositAddress) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{83}
 }
 
-func (x *WithdrawFiatRequest) GetCurrency() string {
+func (x *DepositAddress) GetAddress() string {
 	if x != nil {
-		return x.Currency
+		return x.Address
 	}
 	return ""
 }
 
-func (x *WithdrawFiatRequest) GetAmount() float64 {
-	if x != nil {
-		return x.Amount
-	}
-	return 0
-}
-
-func (x *WithdrawFiatRequest) GetDescription() string {
+func (x *DepositAddress) GetTag() string {
 	if x != nil {
-		return x.Description
+		return x.Tag
 	}
 	return ""
 }
 
-func (x *WithdrawFiatRequest) GetBankAccountId() string {
+func (x *DepositAddress) GetChain() string {
 	if x != nil {
-		return x.BankAccountId
+		return x.Chain
 	}
 	return ""
 }
 
-type WithdrawCryptoRequest struct {
+type DepositAddresses struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Address       string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	AddressTag    string                 `protobuf:"bytes,3,opt,name=address_tag,json=addressTag,proto3" json:"address_tag,omitempty"`
-	Currency      string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
-	Amount        float64                `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount,omitempty"`
-	Fee           float64                `protobuf:"fixed64,6,opt,name=fee,proto3" json:"fee,omitempty"`
-	Description   string                 `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
-	Chain         string                 `protobuf:"bytes,8,opt,name=chain,proto3" json:"chain,omitempty"`
+	Addresses     []*DepositAddress      `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WithdrawCryptoRequest) Reset() {
-	*x = WithdrawCryptoRequest{}
-	mi := &file_rpc_proto_msgTypes[88]
+func (x *DepositAddresses) Reset() {
+	*x = DepositAddresses{}
+	mi := &file_rpc_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WithdrawCryptoRequest) String() string {
+func (x *DepositAddresses) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WithdrawCryptoRequest) ProtoMessage() {}
+func (*DepositAddresses) ProtoMessage() {}
 
-func (x *WithdrawCryptoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[88]
+func (x *DepositAddresses) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5246,90 +5247,87 @@ func (x *WithdrawCryptoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WithdrawCryptoRequest.ProtoReflect.Descriptor instead.
-func (*WithdrawCryptoRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{88}
+// Deprecated: Use DepositAddresses.ProtoReflect.Descriptor instead.
+func (*DepositAddresses) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{84}
 }
 
-func (x *WithdrawCryptoRequest) GetExchange() string {
+func (x *DepositAddresses) GetAddresses() []*DepositAddress {
 	if x != nil {
-		return x.Exchange
+		return x.Addresses
 	}
-	return ""
+	return nil
 }
 
-func (x *WithdrawCryptoRequest) GetAddress() string {
-	if x != nil {
-		return x.Address
-	}
-	return ""
+type GetCryptocurrencyDepositAddressesResponse struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	Addresses     map[string]*DepositAddresses `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WithdrawCryptoRequest) GetAddressTag() string {
-	if x != nil {
-		return x.AddressTag
-	}
-	return ""
+func (x *GetCryptocurrencyDepositAddressesResponse) Reset() {
+	*x = GetCryptocurrencyDepositAddressesResponse{}
+	mi := &file_rpc_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *WithdrawCryptoRequest) GetCurrency() string {
-	if x != nil {
-		return x.Currency
-	}
-	return ""
+func (x *GetCryptocurrencyDepositAddressesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *WithdrawCryptoRequest) GetAmount() float64 {
-	if x != nil {
-		return x.Amount
-	}
-	return 0
-}
+func (*GetCryptocurrencyDepositAddressesResponse) ProtoMessage() {}
 
-func (x *WithdrawCryptoRequest) GetFee() float64 {
+func (x *GetCryptocurrencyDepositAddressesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[85]
 	if x != nil {
-		return x.Fee
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *WithdrawCryptoRequest) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
+// Deprecated: Use GetCryptocurrencyDepositAddressesResponse.ProtoReflect.Descriptor instead.
+func (*GetCryptocurrencyDepositAddressesResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{85}
 }
 
-func (x *WithdrawCryptoRequest) GetChain() string {
+func (x *GetCryptocurrencyDepositAddressesResponse) GetAddresses() map[string]*DepositAddresses {
 	if x != nil {
-		return x.Chain
+		return x.Addresses
 	}
-	return ""
+	return nil
 }
 
-type WithdrawResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type GetCryptocurrencyDepositAddressRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Exchange       string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Cryptocurrency string                 `protobuf:"bytes,2,opt,name=cryptocurrency,proto3" json:"cryptocurrency,omitempty"`
+	Chain          string                 `protobuf:"bytes,3,opt,name=chain,proto3" json:"chain,omitempty"`
+	Bypass         bool                   `protobuf:"varint,4,opt,name=bypass,proto3" json:"bypass,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *WithdrawResponse) Reset() {
-	*x = WithdrawResponse{}
-	mi := &file_rpc_proto_msgTypes[89]
+func (x *GetCryptocurrencyDepositAddressRequest) Reset() {
+	*x = GetCryptocurrencyDepositAddressRequest{}
+	mi := &file_rpc_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WithdrawResponse) String() string {
+func (x *GetCryptocurrencyDepositAddressRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WithdrawResponse) ProtoMessage() {}
+func (*GetCryptocurrencyDepositAddressRequest) ProtoMessage() {}
 
-func (x *WithdrawResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[89]
+func (x *GetCryptocurrencyDepositAddressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5340,47 +5338,62 @@ func (x *WithdrawResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WithdrawResponse.ProtoReflect.Descriptor instead.
-func (*WithdrawResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{89}
+// Deprecated: Use GetCryptocurrencyDepositAddressRequest.ProtoReflect.Descriptor instead.
+func (*GetCryptocurrencyDepositAddressRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{86}
 }
 
-func (x *WithdrawResponse) GetId() string {
+func (x *GetCryptocurrencyDepositAddressRequest) GetExchange() string {
 	if x != nil {
-		return x.Id
+		return x.Exchange
 	}
 	return ""
 }
 
-func (x *WithdrawResponse) GetStatus() string {
+func (x *GetCryptocurrencyDepositAddressRequest) GetCryptocurrency() string {
 	if x != nil {
-		return x.Status
+		return x.Cryptocurrency
 	}
 	return ""
 }
 
-type WithdrawalEventByIDRequest struct {
+func (x *GetCryptocurrencyDepositAddressRequest) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+func (x *GetCryptocurrencyDepositAddressRequest) GetBypass() bool {
+	if x != nil {
+		return x.Bypass
+	}
+	return false
+}
+
+type GetCryptocurrencyDepositAddressResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Address       string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Tag           string                 `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WithdrawalEventByIDRequest) Reset() {
-	*x = WithdrawalEventByIDRequest{}
-	mi := &file_rpc_proto_msgTypes[90]
+func (x *GetCryptocurrencyDepositAddressResponse) Reset() {
+	*x = GetCryptocurrencyDepositAddressResponse{}
+	mi := &file_rpc_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WithdrawalEventByIDRequest) String() string {
+func (x *GetCryptocurrencyDepositAddressResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WithdrawalEventByIDRequest) ProtoMessage() {}
+func (*GetCryptocurrencyDepositAddressResponse) ProtoMessage() {}
 
-func (x *WithdrawalEventByIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[90]
+func (x *GetCryptocurrencyDepositAddressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5391,40 +5404,99 @@ func (x *WithdrawalEventByIDRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WithdrawalEventByIDRequest.ProtoReflect.Descriptor instead.
-func (*WithdrawalEventByIDRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{90}
+// Deprecated: Use GetCryptocurrencyDepositAddressResponse.ProtoReflect.Descriptor instead.
+func (*GetCryptocurrencyDepositAddressResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{87}
 }
 
-func (x *WithdrawalEventByIDRequest) GetId() string {
+func (x *GetCryptocurrencyDepositAddressResponse) GetAddress() string {
 	if x != nil {
-		return x.Id
+		return x.Address
 	}
 	return ""
 }
 
-type WithdrawalEventByIDResponse struct {
-	state         protoimpl.MessageState   `protogen:"open.v1"`
-	Event         *WithdrawalEventResponse `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
+func (x *GetCryptocurrencyDepositAddressResponse) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+type GetAvailableTransferChainsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Exchange       string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Cryptocurrency string                 `protobuf:"bytes,2,opt,name=cryptocurrency,proto3" json:"cryptocurrency,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetAvailableTransferChainsRequest) Reset() {
+	*x = GetAvailableTransferChainsRequest{}
+	mi := &file_rpc_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAvailableTransferChainsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAvailableTransferChainsRequest) ProtoMessage() {}
+
+func (x *GetAvailableTransferChainsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAvailableTransferChainsRequest.ProtoReflect.Descriptor instead.
+func (*GetAvailableTransferChainsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *GetAvailableTransferChainsRequest) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *GetAvailableTransferChainsRequest) GetCryptocurrency() string {
+	if x != nil {
+		return x.Cryptocurrency
+	}
+	return ""
+}
+
+type GetAvailableTransferChainsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Chains        []string               `protobuf:"bytes,1,rep,name=chains,proto3" json:"chains,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WithdrawalEventByIDResponse) Reset() {
-	*x = WithdrawalEventByIDResponse{}
-	mi := &file_rpc_proto_msgTypes[91]
+func (x *GetAvailableTransferChainsResponse) Reset() {
+	*x = GetAvailableTransferChainsResponse{}
+	mi := &file_rpc_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WithdrawalEventByIDResponse) String() string {
+func (x *GetAvailableTransferChainsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WithdrawalEventByIDResponse) ProtoMessage() {}
+func (*GetAvailableTransferChainsResponse) ProtoMessage() {}
 
-func (x *WithdrawalEventByIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[91]
+func (x *GetAvailableTransferChainsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5435,44 +5507,44 @@ func (x *WithdrawalEventByIDResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WithdrawalEventByIDResponse.ProtoReflect.Descriptor instead.
-func (*WithdrawalEventByIDResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{91}
+// Deprecated: Use GetAvailableTransferChainsResponse.ProtoReflect.Descriptor instead.
+func (*GetAvailableTransferChainsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{89}
 }
 
-func (x *WithdrawalEventByIDResponse) GetEvent() *WithdrawalEventResponse {
+func (x *GetAvailableTransferChainsResponse) GetChains() []string {
 	if x != nil {
-		return x.Event
+		return x.Chains
 	}
 	return nil
 }
 
-type WithdrawalEventsByExchangeRequest struct {
+type WithdrawFiatRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
-	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
-	Currency      string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
-	AssetType     string                 `protobuf:"bytes,5,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Currency      string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	Amount        float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	BankAccountId string                 `protobuf:"bytes,5,opt,name=bank_account_id,json=bankAccountId,proto3" json:"bank_account_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WithdrawalEventsByExchangeRequest) Reset() {
-	*x = WithdrawalEventsByExchangeRequest{}
-	mi := &file_rpc_proto_msgTypes[92]
+func (x *WithdrawFiatRequest) Reset() {
+	*x = WithdrawFiatRequest{}
+	mi := &file_rpc_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WithdrawalEventsByExchangeRequest) String() string {
+func (x *WithdrawFiatRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WithdrawalEventsByExchangeRequest) ProtoMessage() {}
+func (*WithdrawFiatRequest) ProtoMessage() {}
 
-func (x *WithdrawalEventsByExchangeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[92]
+func (x *WithdrawFiatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5483,71 +5555,75 @@ func (x *WithdrawalEventsByExchangeRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WithdrawalEventsByExchangeRequest.ProtoReflect.Descriptor instead.
-func (*WithdrawalEventsByExchangeRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{92}
+// Deprecated: Use WithdrawFiatRequest.ProtoReflect.Descriptor instead.
+func (*WithdrawFiatRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{90}
 }
 
-func (x *WithdrawalEventsByExchangeRequest) GetExchange() string {
+func (x *WithdrawFiatRequest) GetExchange() string {
 	if x != nil {
 		return x.Exchange
 	}
 	return ""
 }
 
-func (x *WithdrawalEventsByExchangeRequest) GetId() string {
+func (x *WithdrawFiatRequest) GetCurrency() string {
 	if x != nil {
-		return x.Id
+		return x.Currency
 	}
 	return ""
 }
 
-func (x *WithdrawalEventsByExchangeRequest) GetLimit() int32 {
+func (x *WithdrawFiatRequest) GetAmount() float64 {
 	if x != nil {
-		return x.Limit
+		return x.Amount
 	}
 	return 0
 }
 
-func (x *WithdrawalEventsByExchangeRequest) GetCurrency() string {
+func (x *WithdrawFiatRequest) GetDescription() string {
 	if x != nil {
-		return x.Currency
+		return x.Description
 	}
 	return ""
 }
 
-func (x *WithdrawalEventsByExchangeRequest) GetAssetType() string {
+func (x *WithdrawFiatRequest) GetBankAccountId() string {
 	if x != nil {
-		return x.AssetType
+		return x.BankAccountId
 	}
 	return ""
 }
 
-type WithdrawalEventsByDateRequest struct {
+type WithdrawCryptoRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Start         string                 `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
-	End           string                 `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`
-	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	Address       string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	AddressTag    string                 `protobuf:"bytes,3,opt,name=address_tag,json=addressTag,proto3" json:"address_tag,omitempty"`
+	Currency      string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	Amount        float64                `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	Fee           float64                `protobuf:"fixed64,6,opt,name=fee,proto3" json:"fee,omitempty"`
+	Description   string                 `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
+	Chain         string                 `protobuf:"bytes,8,opt,name=chain,proto3" json:"chain,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WithdrawalEventsByDateRequest) Reset() {
-	*x = WithdrawalEventsByDateRequest{}
-	mi := &file_rpc_proto_msgTypes[93]
+func (x *WithdrawCryptoRequest) Reset() {
+	*x = WithdrawCryptoRequest{}
+	mi := &file_rpc_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WithdrawalEventsByDateRequest) String() string {
+func (x *WithdrawCryptoRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WithdrawalEventsByDateRequest) ProtoMessage() {}
+func (*WithdrawCryptoRequest) ProtoMessage() {}
 
-func (x *WithdrawalEventsByDateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[93]
+func (x *WithdrawCryptoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5558,109 +5634,90 @@ func (x *WithdrawalEventsByDateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WithdrawalEventsByDateRequest.ProtoReflect.Descriptor instead.
-func (*WithdrawalEventsByDateRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{93}
+// Deprecated: Use WithdrawCryptoRequest.ProtoReflect.Descriptor instead.
+func (*WithdrawCryptoRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{91}
 }
 
-func (x *WithdrawalEventsByDateRequest) GetExchange() string {
+func (x *WithdrawCryptoRequest) GetExchange() string {
 	if x != nil {
 		return x.Exchange
 	}
 	return ""
 }
 
-func (x *WithdrawalEventsByDateRequest) GetStart() string {
+func (x *WithdrawCryptoRequest) GetAddress() string {
 	if x != nil {
-		return x.Start
+		return x.Address
 	}
 	return ""
 }
 
-func (x *WithdrawalEventsByDateRequest) GetEnd() string {
+func (x *WithdrawCryptoRequest) GetAddressTag() string {
 	if x != nil {
-		return x.End
+		return x.AddressTag
 	}
 	return ""
 }
 
-func (x *WithdrawalEventsByDateRequest) GetLimit() int32 {
+func (x *WithdrawCryptoRequest) GetCurrency() string {
 	if x != nil {
-		return x.Limit
+		return x.Currency
 	}
-	return 0
+	return ""
 }
 
-type WithdrawalEventsByExchangeResponse struct {
-	state         protoimpl.MessageState     `protogen:"open.v1"`
-	Event         []*WithdrawalEventResponse `protobuf:"bytes,2,rep,name=event,proto3" json:"event,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *WithdrawCryptoRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
 }
 
-func (x *WithdrawalEventsByExchangeResponse) Reset() {
-	*x = WithdrawalEventsByExchangeResponse{}
-	mi := &file_rpc_proto_msgTypes[94]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *WithdrawCryptoRequest) GetFee() float64 {
+	if x != nil {
+		return x.Fee
+	}
+	return 0
 }
 
-func (x *WithdrawalEventsByExchangeResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *WithdrawCryptoRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
 }
 
-func (*WithdrawalEventsByExchangeResponse) ProtoMessage() {}
-
-func (x *WithdrawalEventsByExchangeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[94]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use WithdrawalEventsByExchangeResponse.ProtoReflect.Descriptor instead.
-func (*WithdrawalEventsByExchangeResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{94}
-}
-
-func (x *WithdrawalEventsByExchangeResponse) GetEvent() []*WithdrawalEventResponse {
+func (x *WithdrawCryptoRequest) GetChain() string {
 	if x != nil {
-		return x.Event
+		return x.Chain
 	}
-	return nil
+	return ""
 }
 
-type WithdrawalEventResponse struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	Id            string                  `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
-	Exchange      *WithdrawlExchangeEvent `protobuf:"bytes,3,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Request       *WithdrawalRequestEvent `protobuf:"bytes,4,opt,name=request,proto3" json:"request,omitempty"`
-	CreatedAt     *timestamppb.Timestamp  `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp  `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+type WithdrawResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WithdrawalEventResponse) Reset() {
-	*x = WithdrawalEventResponse{}
-	mi := &file_rpc_proto_msgTypes[95]
+func (x *WithdrawResponse) Reset() {
+	*x = WithdrawResponse{}
+	mi := &file_rpc_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WithdrawalEventResponse) String() string {
+func (x *WithdrawResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WithdrawalEventResponse) ProtoMessage() {}
+func (*WithdrawResponse) ProtoMessage() {}
 
-func (x *WithdrawalEventResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[95]
+func (x *WithdrawResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5671,70 +5728,47 @@ func (x *WithdrawalEventResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WithdrawalEventResponse.ProtoReflect.Descriptor instead.
-func (*WithdrawalEventResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{95}
+// Deprecated: Use WithdrawResponse.ProtoReflect.Descriptor instead.
+func (*WithdrawResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{92}
 }
 
-func (x *WithdrawalEventResponse) GetId() string {
+func (x *WithdrawResponse) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *WithdrawalEventResponse) GetExchange() *WithdrawlExchangeEvent {
-	if x != nil {
-		return x.Exchange
-	}
-	return nil
-}
-
-func (x *WithdrawalEventResponse) GetRequest() *WithdrawalRequestEvent {
-	if x != nil {
-		return x.Request
-	}
-	return nil
-}
-
-func (x *WithdrawalEventResponse) GetCreatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return nil
-}
-
-func (x *WithdrawalEventResponse) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *WithdrawResponse) GetStatus() string {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Status
 	}
-	return nil
+	return ""
 }
 
-type WithdrawlExchangeEvent struct {
+type WithdrawalEventByIDRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
-	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WithdrawlExchangeEvent) Reset() {
-	*x = WithdrawlExchangeEvent{}
-	mi := &file_rpc_proto_msgTypes[96]
+func (x *WithdrawalEventByIDRequest) Reset() {
+	*x = WithdrawalEventByIDRequest{}
+	mi := &file_rpc_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WithdrawlExchangeEvent) String() string {
+func (x *WithdrawalEventByIDRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WithdrawlExchangeEvent) ProtoMessage() {}
+func (*WithdrawalEventByIDRequest) ProtoMessage() {}
 
-func (x *WithdrawlExchangeEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[96]
+func (x *WithdrawalEventByIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5745,59 +5779,88 @@ func (x *WithdrawlExchangeEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WithdrawlExchangeEvent.ProtoReflect.Descriptor instead.
-func (*WithdrawlExchangeEvent) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{96}
+// Deprecated: Use WithdrawalEventByIDRequest.ProtoReflect.Descriptor instead.
+func (*WithdrawalEventByIDRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{93}
 }
 
-func (x *WithdrawlExchangeEvent) GetName() string {
+func (x *WithdrawalEventByIDRequest) GetId() string {
 	if x != nil {
-		return x.Name
+		return x.Id
 	}
 	return ""
 }
 
-func (x *WithdrawlExchangeEvent) GetId() string {
+type WithdrawalEventByIDResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Event         *WithdrawalEventResponse `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WithdrawalEventByIDResponse) Reset() {
+	*x = WithdrawalEventByIDResponse{}
+	mi := &file_rpc_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WithdrawalEventByIDResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WithdrawalEventByIDResponse) ProtoMessage() {}
+
+func (x *WithdrawalEventByIDResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[94]
 	if x != nil {
-		return x.Id
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *WithdrawlExchangeEvent) GetStatus() string {
+// Deprecated: Use WithdrawalEventByIDResponse.ProtoReflect.Descriptor instead.
+func (*WithdrawalEventByIDResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *WithdrawalEventByIDResponse) GetEvent() *WithdrawalEventResponse {
 	if x != nil {
-		return x.Status
+		return x.Event
 	}
-	return ""
+	return nil
 }
 
-type WithdrawalRequestEvent struct {
+type WithdrawalEventsByExchangeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Currency      string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Amount        float64                `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
-	Type          int64                  `protobuf:"varint,5,opt,name=type,proto3" json:"type,omitempty"`
-	Fiat          *FiatWithdrawalEvent   `protobuf:"bytes,6,opt,name=fiat,proto3" json:"fiat,omitempty"`
-	Crypto        *CryptoWithdrawalEvent `protobuf:"bytes,7,opt,name=crypto,proto3" json:"crypto,omitempty"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Currency      string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	AssetType     string                 `protobuf:"bytes,5,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WithdrawalRequestEvent) Reset() {
-	*x = WithdrawalRequestEvent{}
-	mi := &file_rpc_proto_msgTypes[97]
+func (x *WithdrawalEventsByExchangeRequest) Reset() {
+	*x = WithdrawalEventsByExchangeRequest{}
+	mi := &file_rpc_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WithdrawalRequestEvent) String() string {
+func (x *WithdrawalEventsByExchangeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WithdrawalRequestEvent) ProtoMessage() {}
+func (*WithdrawalEventsByExchangeRequest) ProtoMessage() {}
 
-func (x *WithdrawalRequestEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[97]
+func (x *WithdrawalEventsByExchangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5808,80 +5871,71 @@ func (x *WithdrawalRequestEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WithdrawalRequestEvent.ProtoReflect.Descriptor instead.
-func (*WithdrawalRequestEvent) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{97}
+// Deprecated: Use WithdrawalEventsByExchangeRequest.ProtoReflect.Descriptor instead.
+func (*WithdrawalEventsByExchangeRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{95}
 }
 
-func (x *WithdrawalRequestEvent) GetCurrency() string {
+func (x *WithdrawalEventsByExchangeRequest) GetExchange() string {
 	if x != nil {
-		return x.Currency
+		return x.Exchange
 	}
 	return ""
 }
 
-func (x *WithdrawalRequestEvent) GetDescription() string {
+func (x *WithdrawalEventsByExchangeRequest) GetId() string {
 	if x != nil {
-		return x.Description
+		return x.Id
 	}
 	return ""
 }
 
-func (x *WithdrawalRequestEvent) GetAmount() float64 {
-	if x != nil {
-		return x.Amount
-	}
-	return 0
-}
-
-func (x *WithdrawalRequestEvent) GetType() int64 {
+func (x *WithdrawalEventsByExchangeRequest) GetLimit() int32 {
 	if x != nil {
-		return x.Type
+		return x.Limit
 	}
 	return 0
 }
 
-func (x *WithdrawalRequestEvent) GetFiat() *FiatWithdrawalEvent {
+func (x *WithdrawalEventsByExchangeRequest) GetCurrency() string {
 	if x != nil {
-		return x.Fiat
+		return x.Currency
 	}
-	return nil
+	return ""
 }
 
-func (x *WithdrawalRequestEvent) GetCrypto() *CryptoWithdrawalEvent {
+func (x *WithdrawalEventsByExchangeRequest) GetAssetType() string {
 	if x != nil {
-		return x.Crypto
+		return x.AssetType
 	}
-	return nil
+	return ""
 }
 
-type FiatWithdrawalEvent struct {
+type WithdrawalEventsByDateRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	BankName      string                 `protobuf:"bytes,1,opt,name=bank_name,json=bankName,proto3" json:"bank_name,omitempty"`
-	AccountName   string                 `protobuf:"bytes,2,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
-	AccountNumber string                 `protobuf:"bytes,3,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
-	Bsb           string                 `protobuf:"bytes,4,opt,name=bsb,proto3" json:"bsb,omitempty"`
-	Swift         string                 `protobuf:"bytes,5,opt,name=swift,proto3" json:"swift,omitempty"`
-	Iban          string                 `protobuf:"bytes,6,opt,name=iban,proto3" json:"iban,omitempty"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Start         string                 `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+	End           string                 `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FiatWithdrawalEvent) Reset() {
-	*x = FiatWithdrawalEvent{}
-	mi := &file_rpc_proto_msgTypes[98]
+func (x *WithdrawalEventsByDateRequest) Reset() {
+	*x = WithdrawalEventsByDateRequest{}
+	mi := &file_rpc_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FiatWithdrawalEvent) String() string {
+func (x *WithdrawalEventsByDateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FiatWithdrawalEvent) ProtoMessage() {}
+func (*WithdrawalEventsByDateRequest) ProtoMessage() {}
 
-func (x *FiatWithdrawalEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[98]
+func (x *WithdrawalEventsByDateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5892,78 +5946,61 @@ func (x *FiatWithdrawalEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FiatWithdrawalEvent.ProtoReflect.Descriptor instead.
-func (*FiatWithdrawalEvent) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{98}
+// Deprecated: Use WithdrawalEventsByDateRequest.ProtoReflect.Descriptor instead.
+func (*WithdrawalEventsByDateRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{96}
 }
 
-func (x *FiatWithdrawalEvent) GetBankName() string {
+func (x *WithdrawalEventsByDateRequest) GetExchange() string {
 	if x != nil {
-		return x.BankName
-	}
-	return ""
-}
-
-func (x *FiatWithdrawalEvent) GetAccountName() string {
-	if x != nil {
-		return x.AccountName
-	}
-	return ""
-}
-
-func (x *FiatWithdrawalEvent) GetAccountNumber() string {
-	if x != nil {
-		return x.AccountNumber
+		return x.Exchange
 	}
 	return ""
 }
 
-func (x *FiatWithdrawalEvent) GetBsb() string {
+func (x *WithdrawalEventsByDateRequest) GetStart() string {
 	if x != nil {
-		return x.Bsb
+		return x.Start
 	}
 	return ""
 }
 
-func (x *FiatWithdrawalEvent) GetSwift() string {
+func (x *WithdrawalEventsByDateRequest) GetEnd() string {
 	if x != nil {
-		return x.Swift
+		return x.End
 	}
 	return ""
 }
 
-func (x *FiatWithdrawalEvent) GetIban() string {
+func (x *WithdrawalEventsByDateRequest) GetLimit() int32 {
 	if x != nil {
-		return x.Iban
+		return x.Limit
 	}
-	return ""
+	return 0
 }
 
-type CryptoWithdrawalEvent struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Address       string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	AddressTag    string                 `protobuf:"bytes,2,opt,name=address_tag,json=addressTag,proto3" json:"address_tag,omitempty"`
-	Fee           float64                `protobuf:"fixed64,3,opt,name=fee,proto3" json:"fee,omitempty"`
-	TxId          string                 `protobuf:"bytes,4,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+type WithdrawalEventsByExchangeResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Event         []*WithdrawalEventResponse `protobuf:"bytes,2,rep,name=event,proto3" json:"event,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CryptoWithdrawalEvent) Reset() {
-	*x = CryptoWithdrawalEvent{}
-	mi := &file_rpc_proto_msgTypes[99]
+func (x *WithdrawalEventsByExchangeResponse) Reset() {
+	*x = WithdrawalEventsByExchangeResponse{}
+	mi := &file_rpc_proto_msgTypes[97]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CryptoWithdrawalEvent) String() string {
+func (x *WithdrawalEventsByExchangeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CryptoWithdrawalEvent) ProtoMessage() {}
+func (*WithdrawalEventsByExchangeResponse) ProtoMessage() {}
 
-func (x *CryptoWithdrawalEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[99]
+func (x *WithdrawalEventsByExchangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[97]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5974,61 +6011,44 @@ func (x *CryptoWithdrawalEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CryptoWithdrawalEvent.ProtoReflect.Descriptor instead.
-func (*CryptoWithdrawalEvent) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{99}
-}
-
-func (x *CryptoWithdrawalEvent) GetAddress() string {
-	if x != nil {
-		return x.Address
-	}
-	return ""
-}
-
-func (x *CryptoWithdrawalEvent) GetAddressTag() string {
-	if x != nil {
-		return x.AddressTag
-	}
-	return ""
-}
-
-func (x *CryptoWithdrawalEvent) GetFee() float64 {
-	if x != nil {
-		return x.Fee
-	}
-	return 0
+// Deprecated: Use WithdrawalEventsByExchangeResponse.ProtoReflect.Descriptor instead.
+func (*WithdrawalEventsByExchangeResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{97}
 }
 
-func (x *CryptoWithdrawalEvent) GetTxId() string {
+func (x *WithdrawalEventsByExchangeResponse) GetEvent() []*WithdrawalEventResponse {
 	if x != nil {
-		return x.TxId
+		return x.Event
 	}
-	return ""
+	return nil
 }
 
-type GetLoggerDetailsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Logger        string                 `protobuf:"bytes,1,opt,name=logger,proto3" json:"logger,omitempty"`
+type WithdrawalEventResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Id            string                  `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Exchange      *WithdrawlExchangeEvent `protobuf:"bytes,3,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Request       *WithdrawalRequestEvent `protobuf:"bytes,4,opt,name=request,proto3" json:"request,omitempty"`
+	CreatedAt     *timestamppb.Timestamp  `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp  `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetLoggerDetailsRequest) Reset() {
-	*x = GetLoggerDetailsRequest{}
-	mi := &file_rpc_proto_msgTypes[100]
+func (x *WithdrawalEventResponse) Reset() {
+	*x = WithdrawalEventResponse{}
+	mi := &file_rpc_proto_msgTypes[98]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetLoggerDetailsRequest) String() string {
+func (x *WithdrawalEventResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLoggerDetailsRequest) ProtoMessage() {}
+func (*WithdrawalEventResponse) ProtoMessage() {}
 
-func (x *GetLoggerDetailsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[100]
+func (x *WithdrawalEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[98]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6039,109 +6059,70 @@ func (x *GetLoggerDetailsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLoggerDetailsRequest.ProtoReflect.Descriptor instead.
-func (*GetLoggerDetailsRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{100}
+// Deprecated: Use WithdrawalEventResponse.ProtoReflect.Descriptor instead.
+func (*WithdrawalEventResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{98}
 }
 
-func (x *GetLoggerDetailsRequest) GetLogger() string {
+func (x *WithdrawalEventResponse) GetId() string {
 	if x != nil {
-		return x.Logger
+		return x.Id
 	}
 	return ""
 }
 
-type GetLoggerDetailsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Info          bool                   `protobuf:"varint,1,opt,name=info,proto3" json:"info,omitempty"`
-	Debug         bool                   `protobuf:"varint,2,opt,name=debug,proto3" json:"debug,omitempty"`
-	Warn          bool                   `protobuf:"varint,3,opt,name=warn,proto3" json:"warn,omitempty"`
-	Error         bool                   `protobuf:"varint,4,opt,name=error,proto3" json:"error,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetLoggerDetailsResponse) Reset() {
-	*x = GetLoggerDetailsResponse{}
-	mi := &file_rpc_proto_msgTypes[101]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetLoggerDetailsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetLoggerDetailsResponse) ProtoMessage() {}
-
-func (x *GetLoggerDetailsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[101]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetLoggerDetailsResponse.ProtoReflect.Descriptor instead.
-func (*GetLoggerDetailsResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{101}
-}
-
-func (x *GetLoggerDetailsResponse) GetInfo() bool {
+func (x *WithdrawalEventResponse) GetExchange() *WithdrawlExchangeEvent {
 	if x != nil {
-		return x.Info
+		return x.Exchange
 	}
-	return false
+	return nil
 }
 
-func (x *GetLoggerDetailsResponse) GetDebug() bool {
+func (x *WithdrawalEventResponse) GetRequest() *WithdrawalRequestEvent {
 	if x != nil {
-		return x.Debug
+		return x.Request
 	}
-	return false
+	return nil
 }
 
-func (x *GetLoggerDetailsResponse) GetWarn() bool {
+func (x *WithdrawalEventResponse) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Warn
+		return x.CreatedAt
 	}
-	return false
+	return nil
 }
 
-func (x *GetLoggerDetailsResponse) GetError() bool {
+func (x *WithdrawalEventResponse) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Error
+		return x.UpdatedAt
 	}
-	return false
+	return nil
 }
 
-type SetLoggerDetailsRequest struct {
+type WithdrawlExchangeEvent struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Logger        string                 `protobuf:"bytes,1,opt,name=logger,proto3" json:"logger,omitempty"`
-	Level         string                 `protobuf:"bytes,2,opt,name=level,proto3" json:"level,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetLoggerDetailsRequest) Reset() {
-	*x = SetLoggerDetailsRequest{}
-	mi := &file_rpc_proto_msgTypes[102]
+func (x *WithdrawlExchangeEvent) Reset() {
+	*x = WithdrawlExchangeEvent{}
+	mi := &file_rpc_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetLoggerDetailsRequest) String() string {
+func (x *WithdrawlExchangeEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetLoggerDetailsRequest) ProtoMessage() {}
+func (*WithdrawlExchangeEvent) ProtoMessage() {}
 
-func (x *SetLoggerDetailsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[102]
+func (x *WithdrawlExchangeEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6152,48 +6133,59 @@ func (x *SetLoggerDetailsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetLoggerDetailsRequest.ProtoReflect.Descriptor instead.
-func (*SetLoggerDetailsRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{102}
+// Deprecated: Use WithdrawlExchangeEvent.ProtoReflect.Descriptor instead.
+func (*WithdrawlExchangeEvent) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{99}
 }
 
-func (x *SetLoggerDetailsRequest) GetLogger() string {
+func (x *WithdrawlExchangeEvent) GetName() string {
 	if x != nil {
-		return x.Logger
+		return x.Name
 	}
 	return ""
 }
 
-func (x *SetLoggerDetailsRequest) GetLevel() string {
+func (x *WithdrawlExchangeEvent) GetId() string {
 	if x != nil {
-		return x.Level
+		return x.Id
 	}
 	return ""
 }
 
-type GetExchangePairsRequest struct {
+func (x *WithdrawlExchangeEvent) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type WithdrawalRequestEvent struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Currency      string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Amount        float64                `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Type          int64                  `protobuf:"varint,5,opt,name=type,proto3" json:"type,omitempty"`
+	Fiat          *FiatWithdrawalEvent   `protobuf:"bytes,6,opt,name=fiat,proto3" json:"fiat,omitempty"`
+	Crypto        *CryptoWithdrawalEvent `protobuf:"bytes,7,opt,name=crypto,proto3" json:"crypto,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetExchangePairsRequest) Reset() {
-	*x = GetExchangePairsRequest{}
-	mi := &file_rpc_proto_msgTypes[103]
+func (x *WithdrawalRequestEvent) Reset() {
+	*x = WithdrawalRequestEvent{}
+	mi := &file_rpc_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetExchangePairsRequest) String() string {
+func (x *WithdrawalRequestEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetExchangePairsRequest) ProtoMessage() {}
+func (*WithdrawalRequestEvent) ProtoMessage() {}
 
-func (x *GetExchangePairsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[103]
+func (x *WithdrawalRequestEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6204,94 +6196,80 @@ func (x *GetExchangePairsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetExchangePairsRequest.ProtoReflect.Descriptor instead.
-func (*GetExchangePairsRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{103}
+// Deprecated: Use WithdrawalRequestEvent.ProtoReflect.Descriptor instead.
+func (*WithdrawalRequestEvent) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{100}
 }
 
-func (x *GetExchangePairsRequest) GetExchange() string {
+func (x *WithdrawalRequestEvent) GetCurrency() string {
 	if x != nil {
-		return x.Exchange
+		return x.Currency
 	}
 	return ""
 }
 
-func (x *GetExchangePairsRequest) GetAsset() string {
+func (x *WithdrawalRequestEvent) GetDescription() string {
 	if x != nil {
-		return x.Asset
+		return x.Description
 	}
 	return ""
 }
 
-type GetExchangePairsResponse struct {
-	state           protoimpl.MessageState     `protogen:"open.v1"`
-	SupportedAssets map[string]*PairsSupported `protobuf:"bytes,1,rep,name=supported_assets,json=supportedAssets,proto3" json:"supported_assets,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
-}
-
-func (x *GetExchangePairsResponse) Reset() {
-	*x = GetExchangePairsResponse{}
-	mi := &file_rpc_proto_msgTypes[104]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetExchangePairsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *WithdrawalRequestEvent) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
 }
 
-func (*GetExchangePairsResponse) ProtoMessage() {}
-
-func (x *GetExchangePairsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[104]
+func (x *WithdrawalRequestEvent) GetType() int64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Type
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use GetExchangePairsResponse.ProtoReflect.Descriptor instead.
-func (*GetExchangePairsResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{104}
+func (x *WithdrawalRequestEvent) GetFiat() *FiatWithdrawalEvent {
+	if x != nil {
+		return x.Fiat
+	}
+	return nil
 }
 
-func (x *GetExchangePairsResponse) GetSupportedAssets() map[string]*PairsSupported {
+func (x *WithdrawalRequestEvent) GetCrypto() *CryptoWithdrawalEvent {
 	if x != nil {
-		return x.SupportedAssets
+		return x.Crypto
 	}
 	return nil
 }
 
-type SetExchangePairRequest struct {
+type FiatWithdrawalEvent struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	AssetType     string                 `protobuf:"bytes,2,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
-	Pairs         []*CurrencyPair        `protobuf:"bytes,3,rep,name=pairs,proto3" json:"pairs,omitempty"`
-	Enable        bool                   `protobuf:"varint,4,opt,name=enable,proto3" json:"enable,omitempty"`
+	BankName      string                 `protobuf:"bytes,1,opt,name=bank_name,json=bankName,proto3" json:"bank_name,omitempty"`
+	AccountName   string                 `protobuf:"bytes,2,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	AccountNumber string                 `protobuf:"bytes,3,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	Bsb           string                 `protobuf:"bytes,4,opt,name=bsb,proto3" json:"bsb,omitempty"`
+	Swift         string                 `protobuf:"bytes,5,opt,name=swift,proto3" json:"swift,omitempty"`
+	Iban          string                 `protobuf:"bytes,6,opt,name=iban,proto3" json:"iban,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetExchangePairRequest) Reset() {
-	*x = SetExchangePairRequest{}
-	mi := &file_rpc_proto_msgTypes[105]
+func (x *FiatWithdrawalEvent) Reset() {
+	*x = FiatWithdrawalEvent{}
+	mi := &file_rpc_proto_msgTypes[101]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetExchangePairRequest) String() string {
+func (x *FiatWithdrawalEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetExchangePairRequest) ProtoMessage() {}
+func (*FiatWithdrawalEvent) ProtoMessage() {}
 
-func (x *SetExchangePairRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[105]
+func (x *FiatWithdrawalEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[101]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6302,63 +6280,78 @@ func (x *SetExchangePairRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetExchangePairRequest.ProtoReflect.Descriptor instead.
-func (*SetExchangePairRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{105}
+// Deprecated: Use FiatWithdrawalEvent.ProtoReflect.Descriptor instead.
+func (*FiatWithdrawalEvent) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{101}
 }
 
-func (x *SetExchangePairRequest) GetExchange() string {
+func (x *FiatWithdrawalEvent) GetBankName() string {
 	if x != nil {
-		return x.Exchange
+		return x.BankName
 	}
 	return ""
 }
 
-func (x *SetExchangePairRequest) GetAssetType() string {
+func (x *FiatWithdrawalEvent) GetAccountName() string {
 	if x != nil {
-		return x.AssetType
+		return x.AccountName
 	}
 	return ""
 }
 
-func (x *SetExchangePairRequest) GetPairs() []*CurrencyPair {
+func (x *FiatWithdrawalEvent) GetAccountNumber() string {
 	if x != nil {
-		return x.Pairs
+		return x.AccountNumber
 	}
-	return nil
+	return ""
 }
 
-func (x *SetExchangePairRequest) GetEnable() bool {
+func (x *FiatWithdrawalEvent) GetBsb() string {
 	if x != nil {
-		return x.Enable
+		return x.Bsb
 	}
-	return false
+	return ""
 }
 
-type GetOrderbookStreamRequest struct {
+func (x *FiatWithdrawalEvent) GetSwift() string {
+	if x != nil {
+		return x.Swift
+	}
+	return ""
+}
+
+func (x *FiatWithdrawalEvent) GetIban() string {
+	if x != nil {
+		return x.Iban
+	}
+	return ""
+}
+
+type CryptoWithdrawalEvent struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Pair          *CurrencyPair          `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
-	AssetType     string                 `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Address       string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	AddressTag    string                 `protobuf:"bytes,2,opt,name=address_tag,json=addressTag,proto3" json:"address_tag,omitempty"`
+	Fee           float64                `protobuf:"fixed64,3,opt,name=fee,proto3" json:"fee,omitempty"`
+	TxId          string                 `protobuf:"bytes,4,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOrderbookStreamRequest) Reset() {
-	*x = GetOrderbookStreamRequest{}
-	mi := &file_rpc_proto_msgTypes[106]
+func (x *CryptoWithdrawalEvent) Reset() {
+	*x = CryptoWithdrawalEvent{}
+	mi := &file_rpc_proto_msgTypes[102]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOrderbookStreamRequest) String() string {
+func (x *CryptoWithdrawalEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOrderbookStreamRequest) ProtoMessage() {}
+func (*CryptoWithdrawalEvent) ProtoMessage() {}
 
-func (x *GetOrderbookStreamRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[106]
+func (x *CryptoWithdrawalEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[102]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6369,54 +6362,60 @@ func (x *GetOrderbookStreamRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOrderbookStreamRequest.ProtoReflect.Descriptor instead.
-func (*GetOrderbookStreamRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{106}
+// Deprecated: Use CryptoWithdrawalEvent.ProtoReflect.Descriptor instead.
+func (*CryptoWithdrawalEvent) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{102}
 }
 
-func (x *GetOrderbookStreamRequest) GetExchange() string {
+func (x *CryptoWithdrawalEvent) GetAddress() string {
 	if x != nil {
-		return x.Exchange
+		return x.Address
 	}
 	return ""
 }
 
-func (x *GetOrderbookStreamRequest) GetPair() *CurrencyPair {
+func (x *CryptoWithdrawalEvent) GetAddressTag() string {
 	if x != nil {
-		return x.Pair
+		return x.AddressTag
 	}
-	return nil
+	return ""
 }
 
-func (x *GetOrderbookStreamRequest) GetAssetType() string {
+func (x *CryptoWithdrawalEvent) GetFee() float64 {
 	if x != nil {
-		return x.AssetType
+		return x.Fee
+	}
+	return 0
+}
+
+func (x *CryptoWithdrawalEvent) GetTxId() string {
+	if x != nil {
+		return x.TxId
 	}
 	return ""
 }
 
-type GetExchangeOrderbookStreamRequest struct {
+type GetPendingWithdrawalsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetExchangeOrderbookStreamRequest) Reset() {
-	*x = GetExchangeOrderbookStreamRequest{}
-	mi := &file_rpc_proto_msgTypes[107]
+func (x *GetPendingWithdrawalsRequest) Reset() {
+	*x = GetPendingWithdrawalsRequest{}
+	mi := &file_rpc_proto_msgTypes[103]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetExchangeOrderbookStreamRequest) String() string {
+func (x *GetPendingWithdrawalsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetExchangeOrderbookStreamRequest) ProtoMessage() {}
+func (*GetPendingWithdrawalsRequest) ProtoMessage() {}
 
-func (x *GetExchangeOrderbookStreamRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[107]
+func (x *GetPendingWithdrawalsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[103]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6427,42 +6426,34 @@ func (x *GetExchangeOrderbookStreamRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetExchangeOrderbookStreamRequest.ProtoReflect.Descriptor instead.
-func (*GetExchangeOrderbookStreamRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{107}
-}
-
-func (x *GetExchangeOrderbookStreamRequest) GetExchange() string {
-	if x != nil {
-		return x.Exchange
-	}
-	return ""
+// Deprecated: Use GetPendingWithdrawalsRequest.ProtoReflect.Descriptor instead.
+func (*GetPendingWithdrawalsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{103}
 }
 
-type GetTickerStreamRequest struct {
+type WithdrawalApproval struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Pair          *CurrencyPair          `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
-	AssetType     string                 `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Approver      string                 `protobuf:"bytes,1,opt,name=approver,proto3" json:"approver,omitempty"`
+	Time          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTickerStreamRequest) Reset() {
-	*x = GetTickerStreamRequest{}
-	mi := &file_rpc_proto_msgTypes[108]
+func (x *WithdrawalApproval) Reset() {
+	*x = WithdrawalApproval{}
+	mi := &file_rpc_proto_msgTypes[104]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTickerStreamRequest) String() string {
+func (x *WithdrawalApproval) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTickerStreamRequest) ProtoMessage() {}
+func (*WithdrawalApproval) ProtoMessage() {}
 
-func (x *GetTickerStreamRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[108]
+func (x *WithdrawalApproval) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[104]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6473,54 +6464,53 @@ func (x *GetTickerStreamRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTickerStreamRequest.ProtoReflect.Descriptor instead.
-func (*GetTickerStreamRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{108}
+// Deprecated: Use WithdrawalApproval.ProtoReflect.Descriptor instead.
+func (*WithdrawalApproval) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{104}
 }
 
-func (x *GetTickerStreamRequest) GetExchange() string {
+func (x *WithdrawalApproval) GetApprover() string {
 	if x != nil {
-		return x.Exchange
+		return x.Approver
 	}
 	return ""
 }
 
-func (x *GetTickerStreamRequest) GetPair() *CurrencyPair {
+func (x *WithdrawalApproval) GetTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Pair
+		return x.Time
 	}
 	return nil
 }
 
-func (x *GetTickerStreamRequest) GetAssetType() string {
-	if x != nil {
-		return x.AssetType
-	}
-	return ""
-}
-
-type GetExchangeTickerStreamRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type PendingWithdrawal struct {
+	state             protoimpl.MessageState  `protogen:"open.v1"`
+	Id                string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Exchange          string                  `protobuf:"bytes,2,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Request           *WithdrawalRequestEvent `protobuf:"bytes,3,opt,name=request,proto3" json:"request,omitempty"`
+	Approvals         []*WithdrawalApproval   `protobuf:"bytes,4,rep,name=approvals,proto3" json:"approvals,omitempty"`
+	RequiredApprovals int64                   `protobuf:"varint,5,opt,name=required_approvals,json=requiredApprovals,proto3" json:"required_approvals,omitempty"`
+	CreatedAt         *timestamppb.Timestamp  `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ExpiresAt         *timestamppb.Timestamp  `protobuf:"bytes,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *GetExchangeTickerStreamRequest) Reset() {
-	*x = GetExchangeTickerStreamRequest{}
-	mi := &file_rpc_proto_msgTypes[109]
+func (x *PendingWithdrawal) Reset() {
+	*x = PendingWithdrawal{}
+	mi := &file_rpc_proto_msgTypes[105]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetExchangeTickerStreamRequest) String() string {
+func (x *PendingWithdrawal) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetExchangeTickerStreamRequest) ProtoMessage() {}
+func (*PendingWithdrawal) ProtoMessage() {}
 
-func (x *GetExchangeTickerStreamRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[109]
+func (x *PendingWithdrawal) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[105]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6531,116 +6521,82 @@ func (x *GetExchangeTickerStreamRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetExchangeTickerStreamRequest.ProtoReflect.Descriptor instead.
-func (*GetExchangeTickerStreamRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{109}
+// Deprecated: Use PendingWithdrawal.ProtoReflect.Descriptor instead.
+func (*PendingWithdrawal) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{105}
 }
 
-func (x *GetExchangeTickerStreamRequest) GetExchange() string {
+func (x *PendingWithdrawal) GetId() string {
 	if x != nil {
-		return x.Exchange
+		return x.Id
 	}
 	return ""
 }
 
-type GetAuditEventRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	StartDate     string                 `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
-	EndDate       string                 `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
-	OrderBy       string                 `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
-	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset        int32                  `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *PendingWithdrawal) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
 }
 
-func (x *GetAuditEventRequest) Reset() {
-	*x = GetAuditEventRequest{}
-	mi := &file_rpc_proto_msgTypes[110]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetAuditEventRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetAuditEventRequest) ProtoMessage() {}
-
-func (x *GetAuditEventRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[110]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetAuditEventRequest.ProtoReflect.Descriptor instead.
-func (*GetAuditEventRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{110}
-}
-
-func (x *GetAuditEventRequest) GetStartDate() string {
+func (x *PendingWithdrawal) GetRequest() *WithdrawalRequestEvent {
 	if x != nil {
-		return x.StartDate
+		return x.Request
 	}
-	return ""
+	return nil
 }
 
-func (x *GetAuditEventRequest) GetEndDate() string {
+func (x *PendingWithdrawal) GetApprovals() []*WithdrawalApproval {
 	if x != nil {
-		return x.EndDate
+		return x.Approvals
 	}
-	return ""
+	return nil
 }
 
-func (x *GetAuditEventRequest) GetOrderBy() string {
+func (x *PendingWithdrawal) GetRequiredApprovals() int64 {
 	if x != nil {
-		return x.OrderBy
+		return x.RequiredApprovals
 	}
-	return ""
+	return 0
 }
 
-func (x *GetAuditEventRequest) GetLimit() int32 {
+func (x *PendingWithdrawal) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Limit
+		return x.CreatedAt
 	}
-	return 0
+	return nil
 }
 
-func (x *GetAuditEventRequest) GetOffset() int32 {
+func (x *PendingWithdrawal) GetExpiresAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Offset
+		return x.ExpiresAt
 	}
-	return 0
+	return nil
 }
 
-type GetAuditEventResponse struct {
+type GetPendingWithdrawalsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Events        []*AuditEvent          `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	Pending       []*PendingWithdrawal   `protobuf:"bytes,1,rep,name=pending,proto3" json:"pending,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAuditEventResponse) Reset() {
-	*x = GetAuditEventResponse{}
-	mi := &file_rpc_proto_msgTypes[111]
+func (x *GetPendingWithdrawalsResponse) Reset() {
+	*x = GetPendingWithdrawalsResponse{}
+	mi := &file_rpc_proto_msgTypes[106]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAuditEventResponse) String() string {
+func (x *GetPendingWithdrawalsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAuditEventResponse) ProtoMessage() {}
+func (*GetPendingWithdrawalsResponse) ProtoMessage() {}
 
-func (x *GetAuditEventResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[111]
+func (x *GetPendingWithdrawalsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[106]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6651,44 +6607,41 @@ func (x *GetAuditEventResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAuditEventResponse.ProtoReflect.Descriptor instead.
-func (*GetAuditEventResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{111}
+// Deprecated: Use GetPendingWithdrawalsResponse.ProtoReflect.Descriptor instead.
+func (*GetPendingWithdrawalsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{106}
 }
 
-func (x *GetAuditEventResponse) GetEvents() []*AuditEvent {
+func (x *GetPendingWithdrawalsResponse) GetPending() []*PendingWithdrawal {
 	if x != nil {
-		return x.Events
+		return x.Pending
 	}
 	return nil
 }
 
-type GetSavedTradesRequest struct {
+type ApproveWithdrawalRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Pair          *CurrencyPair          `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
-	AssetType     string                 `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
-	Start         string                 `protobuf:"bytes,4,opt,name=start,proto3" json:"start,omitempty"`
-	End           string                 `protobuf:"bytes,5,opt,name=end,proto3" json:"end,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Approver      string                 `protobuf:"bytes,2,opt,name=approver,proto3" json:"approver,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSavedTradesRequest) Reset() {
-	*x = GetSavedTradesRequest{}
-	mi := &file_rpc_proto_msgTypes[112]
+func (x *ApproveWithdrawalRequest) Reset() {
+	*x = ApproveWithdrawalRequest{}
+	mi := &file_rpc_proto_msgTypes[107]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSavedTradesRequest) String() string {
+func (x *ApproveWithdrawalRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSavedTradesRequest) ProtoMessage() {}
+func (*ApproveWithdrawalRequest) ProtoMessage() {}
 
-func (x *GetSavedTradesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[112]
+func (x *ApproveWithdrawalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[107]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6699,72 +6652,49 @@ func (x *GetSavedTradesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSavedTradesRequest.ProtoReflect.Descriptor instead.
-func (*GetSavedTradesRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{112}
-}
-
-func (x *GetSavedTradesRequest) GetExchange() string {
-	if x != nil {
-		return x.Exchange
-	}
-	return ""
-}
-
-func (x *GetSavedTradesRequest) GetPair() *CurrencyPair {
-	if x != nil {
-		return x.Pair
-	}
-	return nil
-}
-
-func (x *GetSavedTradesRequest) GetAssetType() string {
-	if x != nil {
-		return x.AssetType
-	}
-	return ""
+// Deprecated: Use ApproveWithdrawalRequest.ProtoReflect.Descriptor instead.
+func (*ApproveWithdrawalRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{107}
 }
 
-func (x *GetSavedTradesRequest) GetStart() string {
+func (x *ApproveWithdrawalRequest) GetId() string {
 	if x != nil {
-		return x.Start
+		return x.Id
 	}
 	return ""
 }
 
-func (x *GetSavedTradesRequest) GetEnd() string {
+func (x *ApproveWithdrawalRequest) GetApprover() string {
 	if x != nil {
-		return x.End
+		return x.Approver
 	}
 	return ""
 }
 
-type SavedTrades struct {
+type RejectWithdrawalRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Price         float64                `protobuf:"fixed64,1,opt,name=price,proto3" json:"price,omitempty"`
-	Amount        float64                `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	Side          string                 `protobuf:"bytes,3,opt,name=side,proto3" json:"side,omitempty"`
-	Timestamp     string                 `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	TradeId       string                 `protobuf:"bytes,5,opt,name=trade_id,json=tradeId,proto3" json:"trade_id,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Approver      string                 `protobuf:"bytes,2,opt,name=approver,proto3" json:"approver,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SavedTrades) Reset() {
-	*x = SavedTrades{}
-	mi := &file_rpc_proto_msgTypes[113]
+func (x *RejectWithdrawalRequest) Reset() {
+	*x = RejectWithdrawalRequest{}
+	mi := &file_rpc_proto_msgTypes[108]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SavedTrades) String() string {
+func (x *RejectWithdrawalRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SavedTrades) ProtoMessage() {}
+func (*RejectWithdrawalRequest) ProtoMessage() {}
 
-func (x *SavedTrades) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[113]
+func (x *RejectWithdrawalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[108]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6775,71 +6705,54 @@ func (x *SavedTrades) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SavedTrades.ProtoReflect.Descriptor instead.
-func (*SavedTrades) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{113}
-}
-
-func (x *SavedTrades) GetPrice() float64 {
-	if x != nil {
-		return x.Price
-	}
-	return 0
-}
-
-func (x *SavedTrades) GetAmount() float64 {
-	if x != nil {
-		return x.Amount
-	}
-	return 0
+// Deprecated: Use RejectWithdrawalRequest.ProtoReflect.Descriptor instead.
+func (*RejectWithdrawalRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{108}
 }
 
-func (x *SavedTrades) GetSide() string {
+func (x *RejectWithdrawalRequest) GetId() string {
 	if x != nil {
-		return x.Side
+		return x.Id
 	}
 	return ""
 }
 
-func (x *SavedTrades) GetTimestamp() string {
+func (x *RejectWithdrawalRequest) GetApprover() string {
 	if x != nil {
-		return x.Timestamp
+		return x.Approver
 	}
 	return ""
 }
 
-func (x *SavedTrades) GetTradeId() string {
+func (x *RejectWithdrawalRequest) GetReason() string {
 	if x != nil {
-		return x.TradeId
+		return x.Reason
 	}
 	return ""
 }
 
-type SavedTradesResponse struct {
+type GetLoggerDetailsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ExchangeName  string                 `protobuf:"bytes,1,opt,name=exchange_name,json=exchangeName,proto3" json:"exchange_name,omitempty"`
-	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	Trades        []*SavedTrades         `protobuf:"bytes,4,rep,name=trades,proto3" json:"trades,omitempty"`
+	Logger        string                 `protobuf:"bytes,1,opt,name=logger,proto3" json:"logger,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SavedTradesResponse) Reset() {
-	*x = SavedTradesResponse{}
-	mi := &file_rpc_proto_msgTypes[114]
+func (x *GetLoggerDetailsRequest) Reset() {
+	*x = GetLoggerDetailsRequest{}
+	mi := &file_rpc_proto_msgTypes[109]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SavedTradesResponse) String() string {
+func (x *GetLoggerDetailsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SavedTradesResponse) ProtoMessage() {}
+func (*GetLoggerDetailsRequest) ProtoMessage() {}
 
-func (x *SavedTradesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[114]
+func (x *GetLoggerDetailsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[109]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6850,68 +6763,43 @@ func (x *SavedTradesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SavedTradesResponse.ProtoReflect.Descriptor instead.
-func (*SavedTradesResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{114}
-}
-
-func (x *SavedTradesResponse) GetExchangeName() string {
-	if x != nil {
-		return x.ExchangeName
-	}
-	return ""
+// Deprecated: Use GetLoggerDetailsRequest.ProtoReflect.Descriptor instead.
+func (*GetLoggerDetailsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{109}
 }
 
-func (x *SavedTradesResponse) GetAsset() string {
+func (x *GetLoggerDetailsRequest) GetLogger() string {
 	if x != nil {
-		return x.Asset
+		return x.Logger
 	}
 	return ""
 }
 
-func (x *SavedTradesResponse) GetPair() *CurrencyPair {
-	if x != nil {
-		return x.Pair
-	}
-	return nil
-}
-
-func (x *SavedTradesResponse) GetTrades() []*SavedTrades {
-	if x != nil {
-		return x.Trades
-	}
-	return nil
-}
-
-type ConvertTradesToCandlesRequest struct {
+type GetLoggerDetailsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Pair          *CurrencyPair          `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
-	AssetType     string                 `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
-	Start         string                 `protobuf:"bytes,4,opt,name=start,proto3" json:"start,omitempty"`
-	End           string                 `protobuf:"bytes,5,opt,name=end,proto3" json:"end,omitempty"`
-	TimeInterval  int64                  `protobuf:"varint,6,opt,name=time_interval,json=timeInterval,proto3" json:"time_interval,omitempty"`
-	Sync          bool                   `protobuf:"varint,7,opt,name=sync,proto3" json:"sync,omitempty"`
-	Force         bool                   `protobuf:"varint,8,opt,name=force,proto3" json:"force,omitempty"`
+	Info          bool                   `protobuf:"varint,1,opt,name=info,proto3" json:"info,omitempty"`
+	Debug         bool                   `protobuf:"varint,2,opt,name=debug,proto3" json:"debug,omitempty"`
+	Warn          bool                   `protobuf:"varint,3,opt,name=warn,proto3" json:"warn,omitempty"`
+	Error         bool                   `protobuf:"varint,4,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ConvertTradesToCandlesRequest) Reset() {
-	*x = ConvertTradesToCandlesRequest{}
-	mi := &file_rpc_proto_msgTypes[115]
+func (x *GetLoggerDetailsResponse) Reset() {
+	*x = GetLoggerDetailsResponse{}
+	mi := &file_rpc_proto_msgTypes[110]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ConvertTradesToCandlesRequest) String() string {
+func (x *GetLoggerDetailsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConvertTradesToCandlesRequest) ProtoMessage() {}
+func (*GetLoggerDetailsResponse) ProtoMessage() {}
 
-func (x *ConvertTradesToCandlesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[115]
+func (x *GetLoggerDetailsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[110]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6922,99 +6810,114 @@ func (x *ConvertTradesToCandlesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConvertTradesToCandlesRequest.ProtoReflect.Descriptor instead.
-func (*ConvertTradesToCandlesRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{115}
+// Deprecated: Use GetLoggerDetailsResponse.ProtoReflect.Descriptor instead.
+func (*GetLoggerDetailsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{110}
 }
 
-func (x *ConvertTradesToCandlesRequest) GetExchange() string {
+func (x *GetLoggerDetailsResponse) GetInfo() bool {
 	if x != nil {
-		return x.Exchange
+		return x.Info
 	}
-	return ""
+	return false
 }
 
-func (x *ConvertTradesToCandlesRequest) GetPair() *CurrencyPair {
+func (x *GetLoggerDetailsResponse) GetDebug() bool {
 	if x != nil {
-		return x.Pair
+		return x.Debug
 	}
-	return nil
+	return false
 }
 
-func (x *ConvertTradesToCandlesRequest) GetAssetType() string {
+func (x *GetLoggerDetailsResponse) GetWarn() bool {
 	if x != nil {
-		return x.AssetType
+		return x.Warn
 	}
-	return ""
+	return false
 }
 
-func (x *ConvertTradesToCandlesRequest) GetStart() string {
+func (x *GetLoggerDetailsResponse) GetError() bool {
 	if x != nil {
-		return x.Start
+		return x.Error
 	}
-	return ""
+	return false
 }
 
-func (x *ConvertTradesToCandlesRequest) GetEnd() string {
-	if x != nil {
-		return x.End
-	}
-	return ""
+type SetLoggerDetailsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Logger        string                 `protobuf:"bytes,1,opt,name=logger,proto3" json:"logger,omitempty"`
+	Level         string                 `protobuf:"bytes,2,opt,name=level,proto3" json:"level,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ConvertTradesToCandlesRequest) GetTimeInterval() int64 {
+func (x *SetLoggerDetailsRequest) Reset() {
+	*x = SetLoggerDetailsRequest{}
+	mi := &file_rpc_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetLoggerDetailsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLoggerDetailsRequest) ProtoMessage() {}
+
+func (x *SetLoggerDetailsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[111]
 	if x != nil {
-		return x.TimeInterval
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *ConvertTradesToCandlesRequest) GetSync() bool {
+// Deprecated: Use SetLoggerDetailsRequest.ProtoReflect.Descriptor instead.
+func (*SetLoggerDetailsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *SetLoggerDetailsRequest) GetLogger() string {
 	if x != nil {
-		return x.Sync
+		return x.Logger
 	}
-	return false
+	return ""
 }
 
-func (x *ConvertTradesToCandlesRequest) GetForce() bool {
+func (x *SetLoggerDetailsRequest) GetLevel() string {
 	if x != nil {
-		return x.Force
+		return x.Level
 	}
-	return false
+	return ""
 }
 
-type GetHistoricCandlesRequest struct {
-	state                 protoimpl.MessageState `protogen:"open.v1"`
-	Exchange              string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Pair                  *CurrencyPair          `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
-	AssetType             string                 `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
-	Start                 string                 `protobuf:"bytes,4,opt,name=start,proto3" json:"start,omitempty"`
-	End                   string                 `protobuf:"bytes,5,opt,name=end,proto3" json:"end,omitempty"`
-	TimeInterval          int64                  `protobuf:"varint,6,opt,name=time_interval,json=timeInterval,proto3" json:"time_interval,omitempty"`
-	ExRequest             bool                   `protobuf:"varint,7,opt,name=ex_request,json=exRequest,proto3" json:"ex_request,omitempty"`
-	Sync                  bool                   `protobuf:"varint,8,opt,name=sync,proto3" json:"sync,omitempty"`
-	UseDb                 bool                   `protobuf:"varint,9,opt,name=use_db,json=useDb,proto3" json:"use_db,omitempty"`
-	FillMissingWithTrades bool                   `protobuf:"varint,10,opt,name=fill_missing_with_trades,json=fillMissingWithTrades,proto3" json:"fill_missing_with_trades,omitempty"`
-	Force                 bool                   `protobuf:"varint,11,opt,name=force,proto3" json:"force,omitempty"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+type GetExchangePairsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetHistoricCandlesRequest) Reset() {
-	*x = GetHistoricCandlesRequest{}
-	mi := &file_rpc_proto_msgTypes[116]
+func (x *GetExchangePairsRequest) Reset() {
+	*x = GetExchangePairsRequest{}
+	mi := &file_rpc_proto_msgTypes[112]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetHistoricCandlesRequest) String() string {
+func (x *GetExchangePairsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetHistoricCandlesRequest) ProtoMessage() {}
+func (*GetExchangePairsRequest) ProtoMessage() {}
 
-func (x *GetHistoricCandlesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[116]
+func (x *GetExchangePairsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[112]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7025,115 +6928,161 @@ func (x *GetHistoricCandlesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetHistoricCandlesRequest.ProtoReflect.Descriptor instead.
-func (*GetHistoricCandlesRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{116}
+// Deprecated: Use GetExchangePairsRequest.ProtoReflect.Descriptor instead.
+func (*GetExchangePairsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{112}
 }
 
-func (x *GetHistoricCandlesRequest) GetExchange() string {
+func (x *GetExchangePairsRequest) GetExchange() string {
 	if x != nil {
 		return x.Exchange
 	}
 	return ""
 }
 
-func (x *GetHistoricCandlesRequest) GetPair() *CurrencyPair {
+func (x *GetExchangePairsRequest) GetAsset() string {
 	if x != nil {
-		return x.Pair
+		return x.Asset
 	}
-	return nil
+	return ""
 }
 
-func (x *GetHistoricCandlesRequest) GetAssetType() string {
-	if x != nil {
-		return x.AssetType
-	}
-	return ""
+type GetExchangePairsResponse struct {
+	state           protoimpl.MessageState     `protogen:"open.v1"`
+	SupportedAssets map[string]*PairsSupported `protobuf:"bytes,1,rep,name=supported_assets,json=supportedAssets,proto3" json:"supported_assets,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *GetHistoricCandlesRequest) GetStart() string {
-	if x != nil {
-		return x.Start
-	}
-	return ""
+func (x *GetExchangePairsResponse) Reset() {
+	*x = GetExchangePairsResponse{}
+	mi := &file_rpc_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetHistoricCandlesRequest) GetEnd() string {
+func (x *GetExchangePairsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExchangePairsResponse) ProtoMessage() {}
+
+func (x *GetExchangePairsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[113]
 	if x != nil {
-		return x.End
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *GetHistoricCandlesRequest) GetTimeInterval() int64 {
+// Deprecated: Use GetExchangePairsResponse.ProtoReflect.Descriptor instead.
+func (*GetExchangePairsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *GetExchangePairsResponse) GetSupportedAssets() map[string]*PairsSupported {
 	if x != nil {
-		return x.TimeInterval
+		return x.SupportedAssets
 	}
-	return 0
+	return nil
 }
 
-func (x *GetHistoricCandlesRequest) GetExRequest() bool {
+type SetExchangePairRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	AssetType     string                 `protobuf:"bytes,2,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Pairs         []*CurrencyPair        `protobuf:"bytes,3,rep,name=pairs,proto3" json:"pairs,omitempty"`
+	Enable        bool                   `protobuf:"varint,4,opt,name=enable,proto3" json:"enable,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetExchangePairRequest) Reset() {
+	*x = SetExchangePairRequest{}
+	mi := &file_rpc_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetExchangePairRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetExchangePairRequest) ProtoMessage() {}
+
+func (x *SetExchangePairRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[114]
 	if x != nil {
-		return x.ExRequest
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *GetHistoricCandlesRequest) GetSync() bool {
+// Deprecated: Use SetExchangePairRequest.ProtoReflect.Descriptor instead.
+func (*SetExchangePairRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *SetExchangePairRequest) GetExchange() string {
 	if x != nil {
-		return x.Sync
+		return x.Exchange
 	}
-	return false
+	return ""
 }
 
-func (x *GetHistoricCandlesRequest) GetUseDb() bool {
+func (x *SetExchangePairRequest) GetAssetType() string {
 	if x != nil {
-		return x.UseDb
+		return x.AssetType
 	}
-	return false
+	return ""
 }
 
-func (x *GetHistoricCandlesRequest) GetFillMissingWithTrades() bool {
+func (x *SetExchangePairRequest) GetPairs() []*CurrencyPair {
 	if x != nil {
-		return x.FillMissingWithTrades
+		return x.Pairs
 	}
-	return false
+	return nil
 }
 
-func (x *GetHistoricCandlesRequest) GetForce() bool {
+func (x *SetExchangePairRequest) GetEnable() bool {
 	if x != nil {
-		return x.Force
+		return x.Enable
 	}
 	return false
 }
 
-type GetHistoricCandlesResponse struct {
+type GetOrderbookStreamRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
 	Pair          *CurrencyPair          `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
-	Start         string                 `protobuf:"bytes,3,opt,name=start,proto3" json:"start,omitempty"`
-	End           string                 `protobuf:"bytes,4,opt,name=end,proto3" json:"end,omitempty"`
-	Interval      string                 `protobuf:"bytes,6,opt,name=interval,proto3" json:"interval,omitempty"`
-	Candle        []*Candle              `protobuf:"bytes,5,rep,name=candle,proto3" json:"candle,omitempty"`
+	AssetType     string                 `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetHistoricCandlesResponse) Reset() {
-	*x = GetHistoricCandlesResponse{}
-	mi := &file_rpc_proto_msgTypes[117]
+func (x *GetOrderbookStreamRequest) Reset() {
+	*x = GetOrderbookStreamRequest{}
+	mi := &file_rpc_proto_msgTypes[115]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetHistoricCandlesResponse) String() string {
+func (x *GetOrderbookStreamRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetHistoricCandlesResponse) ProtoMessage() {}
+func (*GetOrderbookStreamRequest) ProtoMessage() {}
 
-func (x *GetHistoricCandlesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[117]
+func (x *GetOrderbookStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[115]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7144,81 +7093,100 @@ func (x *GetHistoricCandlesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetHistoricCandlesResponse.ProtoReflect.Descriptor instead.
-func (*GetHistoricCandlesResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{117}
+// Deprecated: Use GetOrderbookStreamRequest.ProtoReflect.Descriptor instead.
+func (*GetOrderbookStreamRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{115}
 }
 
-func (x *GetHistoricCandlesResponse) GetExchange() string {
+func (x *GetOrderbookStreamRequest) GetExchange() string {
 	if x != nil {
 		return x.Exchange
 	}
 	return ""
 }
 
-func (x *GetHistoricCandlesResponse) GetPair() *CurrencyPair {
+func (x *GetOrderbookStreamRequest) GetPair() *CurrencyPair {
 	if x != nil {
 		return x.Pair
 	}
 	return nil
 }
 
-func (x *GetHistoricCandlesResponse) GetStart() string {
+func (x *GetOrderbookStreamRequest) GetAssetType() string {
 	if x != nil {
-		return x.Start
+		return x.AssetType
 	}
 	return ""
 }
 
-func (x *GetHistoricCandlesResponse) GetEnd() string {
-	if x != nil {
-		return x.End
-	}
-	return ""
+type GetExchangeOrderbookStreamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetHistoricCandlesResponse) GetInterval() string {
+func (x *GetExchangeOrderbookStreamRequest) Reset() {
+	*x = GetExchangeOrderbookStreamRequest{}
+	mi := &file_rpc_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetExchangeOrderbookStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExchangeOrderbookStreamRequest) ProtoMessage() {}
+
+func (x *GetExchangeOrderbookStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[116]
 	if x != nil {
-		return x.Interval
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *GetHistoricCandlesResponse) GetCandle() []*Candle {
+// Deprecated: Use GetExchangeOrderbookStreamRequest.ProtoReflect.Descriptor instead.
+func (*GetExchangeOrderbookStreamRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *GetExchangeOrderbookStreamRequest) GetExchange() string {
 	if x != nil {
-		return x.Candle
+		return x.Exchange
 	}
-	return nil
+	return ""
 }
 
-type Candle struct {
+type GetTickerStreamRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Time          string                 `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
-	Low           float64                `protobuf:"fixed64,2,opt,name=low,proto3" json:"low,omitempty"`
-	High          float64                `protobuf:"fixed64,3,opt,name=high,proto3" json:"high,omitempty"`
-	Open          float64                `protobuf:"fixed64,4,opt,name=open,proto3" json:"open,omitempty"`
-	Close         float64                `protobuf:"fixed64,5,opt,name=close,proto3" json:"close,omitempty"`
-	Volume        float64                `protobuf:"fixed64,6,opt,name=volume,proto3" json:"volume,omitempty"`
-	IsPartial     bool                   `protobuf:"varint,7,opt,name=is_partial,json=isPartial,proto3" json:"is_partial,omitempty"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair          *CurrencyPair          `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType     string                 `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Candle) Reset() {
-	*x = Candle{}
-	mi := &file_rpc_proto_msgTypes[118]
+func (x *GetTickerStreamRequest) Reset() {
+	*x = GetTickerStreamRequest{}
+	mi := &file_rpc_proto_msgTypes[117]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Candle) String() string {
+func (x *GetTickerStreamRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Candle) ProtoMessage() {}
+func (*GetTickerStreamRequest) ProtoMessage() {}
 
-func (x *Candle) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[118]
+func (x *GetTickerStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[117]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7229,84 +7197,100 @@ func (x *Candle) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Candle.ProtoReflect.Descriptor instead.
-func (*Candle) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{118}
+// Deprecated: Use GetTickerStreamRequest.ProtoReflect.Descriptor instead.
+func (*GetTickerStreamRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{117}
 }
 
-func (x *Candle) GetTime() string {
+func (x *GetTickerStreamRequest) GetExchange() string {
 	if x != nil {
-		return x.Time
+		return x.Exchange
 	}
 	return ""
 }
 
-func (x *Candle) GetLow() float64 {
+func (x *GetTickerStreamRequest) GetPair() *CurrencyPair {
 	if x != nil {
-		return x.Low
+		return x.Pair
 	}
-	return 0
+	return nil
 }
 
-func (x *Candle) GetHigh() float64 {
+func (x *GetTickerStreamRequest) GetAssetType() string {
 	if x != nil {
-		return x.High
+		return x.AssetType
 	}
-	return 0
+	return ""
 }
 
-func (x *Candle) GetOpen() float64 {
-	if x != nil {
-		return x.Open
-	}
-	return 0
+type GetExchangeTickerStreamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Candle) GetClose() float64 {
-	if x != nil {
-		return x.Close
-	}
-	return 0
+func (x *GetExchangeTickerStreamRequest) Reset() {
+	*x = GetExchangeTickerStreamRequest{}
+	mi := &file_rpc_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Candle) GetVolume() float64 {
+func (x *GetExchangeTickerStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExchangeTickerStreamRequest) ProtoMessage() {}
+
+func (x *GetExchangeTickerStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[118]
 	if x != nil {
-		return x.Volume
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Candle) GetIsPartial() bool {
+// Deprecated: Use GetExchangeTickerStreamRequest.ProtoReflect.Descriptor instead.
+func (*GetExchangeTickerStreamRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *GetExchangeTickerStreamRequest) GetExchange() string {
 	if x != nil {
-		return x.IsPartial
+		return x.Exchange
 	}
-	return false
+	return ""
 }
 
-type AuditEvent struct {
+type GetOrderStreamRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
-	Identifier    string                 `protobuf:"bytes,2,opt,name=identifier,proto3" json:"identifier,omitempty"`
-	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
-	Timestamp     string                 `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	AssetType     string                 `protobuf:"bytes,2,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	Statuses      []string               `protobuf:"bytes,4,rep,name=statuses,proto3" json:"statuses,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AuditEvent) Reset() {
-	*x = AuditEvent{}
+func (x *GetOrderStreamRequest) Reset() {
+	*x = GetOrderStreamRequest{}
 	mi := &file_rpc_proto_msgTypes[119]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AuditEvent) String() string {
+func (x *GetOrderStreamRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuditEvent) ProtoMessage() {}
+func (*GetOrderStreamRequest) ProtoMessage() {}
 
-func (x *AuditEvent) ProtoReflect() protoreflect.Message {
+func (x *GetOrderStreamRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[119]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7318,63 +7302,62 @@ func (x *AuditEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuditEvent.ProtoReflect.Descriptor instead.
-func (*AuditEvent) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetOrderStreamRequest.ProtoReflect.Descriptor instead.
+func (*GetOrderStreamRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{119}
 }
 
-func (x *AuditEvent) GetType() string {
+func (x *GetOrderStreamRequest) GetExchange() string {
 	if x != nil {
-		return x.Type
+		return x.Exchange
 	}
 	return ""
 }
 
-func (x *AuditEvent) GetIdentifier() string {
+func (x *GetOrderStreamRequest) GetAssetType() string {
 	if x != nil {
-		return x.Identifier
+		return x.AssetType
 	}
 	return ""
 }
 
-func (x *AuditEvent) GetMessage() string {
+func (x *GetOrderStreamRequest) GetPair() *CurrencyPair {
 	if x != nil {
-		return x.Message
+		return x.Pair
 	}
-	return ""
+	return nil
 }
 
-func (x *AuditEvent) GetTimestamp() string {
+func (x *GetOrderStreamRequest) GetStatuses() []string {
 	if x != nil {
-		return x.Timestamp
+		return x.Statuses
 	}
-	return ""
+	return nil
 }
 
-type GCTScript struct {
+type AggregatedOrderbookSource struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Path          string                 `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
-	NextRun       string                 `protobuf:"bytes,4,opt,name=next_run,json=nextRun,proto3" json:"next_run,omitempty"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair          *CurrencyPair          `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	Fee           float64                `protobuf:"fixed64,3,opt,name=fee,proto3" json:"fee,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GCTScript) Reset() {
-	*x = GCTScript{}
+func (x *AggregatedOrderbookSource) Reset() {
+	*x = AggregatedOrderbookSource{}
 	mi := &file_rpc_proto_msgTypes[120]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GCTScript) String() string {
+func (x *AggregatedOrderbookSource) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GCTScript) ProtoMessage() {}
+func (*AggregatedOrderbookSource) ProtoMessage() {}
 
-func (x *GCTScript) ProtoReflect() protoreflect.Message {
+func (x *AggregatedOrderbookSource) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[120]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7386,60 +7369,58 @@ func (x *GCTScript) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GCTScript.ProtoReflect.Descriptor instead.
-func (*GCTScript) Descriptor() ([]byte, []int) {
+// Deprecated: Use AggregatedOrderbookSource.ProtoReflect.Descriptor instead.
+func (*AggregatedOrderbookSource) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{120}
 }
 
-func (x *GCTScript) GetUuid() string {
-	if x != nil {
-		return x.Uuid
-	}
-	return ""
-}
-
-func (x *GCTScript) GetName() string {
+func (x *AggregatedOrderbookSource) GetExchange() string {
 	if x != nil {
-		return x.Name
+		return x.Exchange
 	}
 	return ""
 }
 
-func (x *GCTScript) GetPath() string {
+func (x *AggregatedOrderbookSource) GetPair() *CurrencyPair {
 	if x != nil {
-		return x.Path
+		return x.Pair
 	}
-	return ""
+	return nil
 }
 
-func (x *GCTScript) GetNextRun() string {
+func (x *AggregatedOrderbookSource) GetFee() float64 {
 	if x != nil {
-		return x.NextRun
+		return x.Fee
 	}
-	return ""
+	return 0
 }
 
-type GCTScriptExecuteRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Script        *GCTScript             `protobuf:"bytes,1,opt,name=script,proto3" json:"script,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type GetAggregatedOrderbookStreamRequest struct {
+	state          protoimpl.MessageState       `protogen:"open.v1"`
+	Sources        []*AggregatedOrderbookSource `protobuf:"bytes,1,rep,name=sources,proto3" json:"sources,omitempty"`
+	Pair           *CurrencyPair                `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType      string                       `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	NormaliseQuote bool                         `protobuf:"varint,4,opt,name=normalise_quote,json=normaliseQuote,proto3" json:"normalise_quote,omitempty"`
+	IncludeFees    bool                         `protobuf:"varint,5,opt,name=include_fees,json=includeFees,proto3" json:"include_fees,omitempty"`
+	MaxLevels      int32                        `protobuf:"varint,6,opt,name=max_levels,json=maxLevels,proto3" json:"max_levels,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *GCTScriptExecuteRequest) Reset() {
-	*x = GCTScriptExecuteRequest{}
+func (x *GetAggregatedOrderbookStreamRequest) Reset() {
+	*x = GetAggregatedOrderbookStreamRequest{}
 	mi := &file_rpc_proto_msgTypes[121]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GCTScriptExecuteRequest) String() string {
+func (x *GetAggregatedOrderbookStreamRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GCTScriptExecuteRequest) ProtoMessage() {}
+func (*GetAggregatedOrderbookStreamRequest) ProtoMessage() {}
 
-func (x *GCTScriptExecuteRequest) ProtoReflect() protoreflect.Message {
+func (x *GetAggregatedOrderbookStreamRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[121]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7451,82 +7432,160 @@ func (x *GCTScriptExecuteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GCTScriptExecuteRequest.ProtoReflect.Descriptor instead.
-func (*GCTScriptExecuteRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetAggregatedOrderbookStreamRequest.ProtoReflect.Descriptor instead.
+func (*GetAggregatedOrderbookStreamRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{121}
 }
 
-func (x *GCTScriptExecuteRequest) GetScript() *GCTScript {
+func (x *GetAggregatedOrderbookStreamRequest) GetSources() []*AggregatedOrderbookSource {
 	if x != nil {
-		return x.Script
+		return x.Sources
 	}
 	return nil
 }
 
-type GCTScriptStopRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Script        *GCTScript             `protobuf:"bytes,1,opt,name=script,proto3" json:"script,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *GetAggregatedOrderbookStreamRequest) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
 }
 
-func (x *GCTScriptStopRequest) Reset() {
-	*x = GCTScriptStopRequest{}
-	mi := &file_rpc_proto_msgTypes[122]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *GetAggregatedOrderbookStreamRequest) GetAssetType() string {
+	if x != nil {
+		return x.AssetType
+	}
+	return ""
 }
 
-func (x *GCTScriptStopRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GetAggregatedOrderbookStreamRequest) GetNormaliseQuote() bool {
+	if x != nil {
+		return x.NormaliseQuote
+	}
+	return false
 }
 
-func (*GCTScriptStopRequest) ProtoMessage() {}
-
-func (x *GCTScriptStopRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[122]
+func (x *GetAggregatedOrderbookStreamRequest) GetIncludeFees() bool {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.IncludeFees
 	}
-	return mi.MessageOf(x)
+	return false
 }
 
-// Deprecated: Use GCTScriptStopRequest.ProtoReflect.Descriptor instead.
-func (*GCTScriptStopRequest) Descriptor() ([]byte, []int) {
+func (x *GetAggregatedOrderbookStreamRequest) GetMaxLevels() int32 {
+	if x != nil {
+		return x.MaxLevels
+	}
+	return 0
+}
+
+type AggregatedOrderbookLevelSource struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Exchange       string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair           *CurrencyPair          `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	Price          float64                `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"`
+	Amount         float64                `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Fee            float64                `protobuf:"fixed64,5,opt,name=fee,proto3" json:"fee,omitempty"`
+	EffectivePrice float64                `protobuf:"fixed64,6,opt,name=effective_price,json=effectivePrice,proto3" json:"effective_price,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AggregatedOrderbookLevelSource) Reset() {
+	*x = AggregatedOrderbookLevelSource{}
+	mi := &file_rpc_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AggregatedOrderbookLevelSource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregatedOrderbookLevelSource) ProtoMessage() {}
+
+func (x *AggregatedOrderbookLevelSource) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[122]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregatedOrderbookLevelSource.ProtoReflect.Descriptor instead.
+func (*AggregatedOrderbookLevelSource) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{122}
 }
 
-func (x *GCTScriptStopRequest) GetScript() *GCTScript {
+func (x *AggregatedOrderbookLevelSource) GetExchange() string {
 	if x != nil {
-		return x.Script
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *AggregatedOrderbookLevelSource) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
 	}
 	return nil
 }
 
-type GCTScriptStopAllRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+func (x *AggregatedOrderbookLevelSource) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *AggregatedOrderbookLevelSource) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *AggregatedOrderbookLevelSource) GetFee() float64 {
+	if x != nil {
+		return x.Fee
+	}
+	return 0
+}
+
+func (x *AggregatedOrderbookLevelSource) GetEffectivePrice() float64 {
+	if x != nil {
+		return x.EffectivePrice
+	}
+	return 0
+}
+
+type AggregatedOrderbookLevel struct {
+	state         protoimpl.MessageState            `protogen:"open.v1"`
+	Price         float64                           `protobuf:"fixed64,1,opt,name=price,proto3" json:"price,omitempty"`
+	Amount        float64                           `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Sources       []*AggregatedOrderbookLevelSource `protobuf:"bytes,3,rep,name=sources,proto3" json:"sources,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GCTScriptStopAllRequest) Reset() {
-	*x = GCTScriptStopAllRequest{}
+func (x *AggregatedOrderbookLevel) Reset() {
+	*x = AggregatedOrderbookLevel{}
 	mi := &file_rpc_proto_msgTypes[123]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GCTScriptStopAllRequest) String() string {
+func (x *AggregatedOrderbookLevel) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GCTScriptStopAllRequest) ProtoMessage() {}
+func (*AggregatedOrderbookLevel) ProtoMessage() {}
 
-func (x *GCTScriptStopAllRequest) ProtoReflect() protoreflect.Message {
+func (x *AggregatedOrderbookLevel) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[123]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7538,31 +7597,58 @@ func (x *GCTScriptStopAllRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GCTScriptStopAllRequest.ProtoReflect.Descriptor instead.
-func (*GCTScriptStopAllRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AggregatedOrderbookLevel.ProtoReflect.Descriptor instead.
+func (*AggregatedOrderbookLevel) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{123}
 }
 
-type GCTScriptStatusRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+func (x *AggregatedOrderbookLevel) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *AggregatedOrderbookLevel) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *AggregatedOrderbookLevel) GetSources() []*AggregatedOrderbookLevelSource {
+	if x != nil {
+		return x.Sources
+	}
+	return nil
+}
+
+type AggregatedOrderbookResponse struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Pair          *CurrencyPair               `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType     string                      `protobuf:"bytes,2,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Bids          []*AggregatedOrderbookLevel `protobuf:"bytes,3,rep,name=bids,proto3" json:"bids,omitempty"`
+	Asks          []*AggregatedOrderbookLevel `protobuf:"bytes,4,rep,name=asks,proto3" json:"asks,omitempty"`
+	LastUpdated   int64                       `protobuf:"varint,5,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
+	Unavailable   map[string]string           `protobuf:"bytes,6,rep,name=unavailable,proto3" json:"unavailable,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GCTScriptStatusRequest) Reset() {
-	*x = GCTScriptStatusRequest{}
+func (x *AggregatedOrderbookResponse) Reset() {
+	*x = AggregatedOrderbookResponse{}
 	mi := &file_rpc_proto_msgTypes[124]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GCTScriptStatusRequest) String() string {
+func (x *AggregatedOrderbookResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GCTScriptStatusRequest) ProtoMessage() {}
+func (*AggregatedOrderbookResponse) ProtoMessage() {}
 
-func (x *GCTScriptStatusRequest) ProtoReflect() protoreflect.Message {
+func (x *AggregatedOrderbookResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[124]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7574,73 +7660,79 @@ func (x *GCTScriptStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GCTScriptStatusRequest.ProtoReflect.Descriptor instead.
-func (*GCTScriptStatusRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AggregatedOrderbookResponse.ProtoReflect.Descriptor instead.
+func (*AggregatedOrderbookResponse) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{124}
 }
 
-type GCTScriptListAllRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *AggregatedOrderbookResponse) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
 }
 
-func (x *GCTScriptListAllRequest) Reset() {
-	*x = GCTScriptListAllRequest{}
-	mi := &file_rpc_proto_msgTypes[125]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *AggregatedOrderbookResponse) GetAssetType() string {
+	if x != nil {
+		return x.AssetType
+	}
+	return ""
 }
 
-func (x *GCTScriptListAllRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *AggregatedOrderbookResponse) GetBids() []*AggregatedOrderbookLevel {
+	if x != nil {
+		return x.Bids
+	}
+	return nil
 }
 
-func (*GCTScriptListAllRequest) ProtoMessage() {}
+func (x *AggregatedOrderbookResponse) GetAsks() []*AggregatedOrderbookLevel {
+	if x != nil {
+		return x.Asks
+	}
+	return nil
+}
 
-func (x *GCTScriptListAllRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[125]
+func (x *AggregatedOrderbookResponse) GetLastUpdated() int64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.LastUpdated
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use GCTScriptListAllRequest.ProtoReflect.Descriptor instead.
-func (*GCTScriptListAllRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{125}
+func (x *AggregatedOrderbookResponse) GetUnavailable() map[string]string {
+	if x != nil {
+		return x.Unavailable
+	}
+	return nil
 }
 
-type GCTScriptUploadRequest struct {
+type GetAuditEventRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ScriptName    string                 `protobuf:"bytes,1,opt,name=script_name,json=scriptName,proto3" json:"script_name,omitempty"`
-	ScriptData    string                 `protobuf:"bytes,2,opt,name=script_data,json=scriptData,proto3" json:"script_data,omitempty"`
-	Data          []byte                 `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
-	Archived      bool                   `protobuf:"varint,4,opt,name=archived,proto3" json:"archived,omitempty"`
-	Overwrite     bool                   `protobuf:"varint,5,opt,name=overwrite,proto3" json:"overwrite,omitempty"`
+	StartDate     string                 `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       string                 `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	OrderBy       string                 `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GCTScriptUploadRequest) Reset() {
-	*x = GCTScriptUploadRequest{}
-	mi := &file_rpc_proto_msgTypes[126]
+func (x *GetAuditEventRequest) Reset() {
+	*x = GetAuditEventRequest{}
+	mi := &file_rpc_proto_msgTypes[125]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GCTScriptUploadRequest) String() string {
+func (x *GetAuditEventRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GCTScriptUploadRequest) ProtoMessage() {}
+func (*GetAuditEventRequest) ProtoMessage() {}
 
-func (x *GCTScriptUploadRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[126]
+func (x *GetAuditEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[125]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7651,68 +7743,68 @@ func (x *GCTScriptUploadRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GCTScriptUploadRequest.ProtoReflect.Descriptor instead.
-func (*GCTScriptUploadRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{126}
+// Deprecated: Use GetAuditEventRequest.ProtoReflect.Descriptor instead.
+func (*GetAuditEventRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{125}
 }
 
-func (x *GCTScriptUploadRequest) GetScriptName() string {
+func (x *GetAuditEventRequest) GetStartDate() string {
 	if x != nil {
-		return x.ScriptName
+		return x.StartDate
 	}
 	return ""
 }
 
-func (x *GCTScriptUploadRequest) GetScriptData() string {
+func (x *GetAuditEventRequest) GetEndDate() string {
 	if x != nil {
-		return x.ScriptData
+		return x.EndDate
 	}
 	return ""
 }
 
-func (x *GCTScriptUploadRequest) GetData() []byte {
+func (x *GetAuditEventRequest) GetOrderBy() string {
 	if x != nil {
-		return x.Data
+		return x.OrderBy
 	}
-	return nil
+	return ""
 }
 
-func (x *GCTScriptUploadRequest) GetArchived() bool {
+func (x *GetAuditEventRequest) GetLimit() int32 {
 	if x != nil {
-		return x.Archived
+		return x.Limit
 	}
-	return false
+	return 0
 }
 
-func (x *GCTScriptUploadRequest) GetOverwrite() bool {
+func (x *GetAuditEventRequest) GetOffset() int32 {
 	if x != nil {
-		return x.Overwrite
+		return x.Offset
 	}
-	return false
+	return 0
 }
 
-type GCTScriptReadScriptRequest struct {
+type GetAuditEventResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Script        *GCTScript             `protobuf:"bytes,1,opt,name=script,proto3" json:"script,omitempty"`
+	Events        []*AuditEvent          `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GCTScriptReadScriptRequest) Reset() {
-	*x = GCTScriptReadScriptRequest{}
-	mi := &file_rpc_proto_msgTypes[127]
+func (x *GetAuditEventResponse) Reset() {
+	*x = GetAuditEventResponse{}
+	mi := &file_rpc_proto_msgTypes[126]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GCTScriptReadScriptRequest) String() string {
+func (x *GetAuditEventResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GCTScriptReadScriptRequest) ProtoMessage() {}
+func (*GetAuditEventResponse) ProtoMessage() {}
 
-func (x *GCTScriptReadScriptRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[127]
+func (x *GetAuditEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[126]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7723,40 +7815,44 @@ func (x *GCTScriptReadScriptRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GCTScriptReadScriptRequest.ProtoReflect.Descriptor instead.
-func (*GCTScriptReadScriptRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{127}
+// Deprecated: Use GetAuditEventResponse.ProtoReflect.Descriptor instead.
+func (*GetAuditEventResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{126}
 }
 
-func (x *GCTScriptReadScriptRequest) GetScript() *GCTScript {
+func (x *GetAuditEventResponse) GetEvents() []*AuditEvent {
 	if x != nil {
-		return x.Script
+		return x.Events
 	}
 	return nil
 }
 
-type GCTScriptQueryRequest struct {
+type GetSavedTradesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Script        *GCTScript             `protobuf:"bytes,1,opt,name=script,proto3" json:"script,omitempty"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair          *CurrencyPair          `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType     string                 `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Start         string                 `protobuf:"bytes,4,opt,name=start,proto3" json:"start,omitempty"`
+	End           string                 `protobuf:"bytes,5,opt,name=end,proto3" json:"end,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GCTScriptQueryRequest) Reset() {
-	*x = GCTScriptQueryRequest{}
-	mi := &file_rpc_proto_msgTypes[128]
+func (x *GetSavedTradesRequest) Reset() {
+	*x = GetSavedTradesRequest{}
+	mi := &file_rpc_proto_msgTypes[127]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GCTScriptQueryRequest) String() string {
+func (x *GetSavedTradesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GCTScriptQueryRequest) ProtoMessage() {}
+func (*GetSavedTradesRequest) ProtoMessage() {}
 
-func (x *GCTScriptQueryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[128]
+func (x *GetSavedTradesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[127]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7767,93 +7863,72 @@ func (x *GCTScriptQueryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GCTScriptQueryRequest.ProtoReflect.Descriptor instead.
-func (*GCTScriptQueryRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{128}
+// Deprecated: Use GetSavedTradesRequest.ProtoReflect.Descriptor instead.
+func (*GetSavedTradesRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{127}
 }
 
-func (x *GCTScriptQueryRequest) GetScript() *GCTScript {
+func (x *GetSavedTradesRequest) GetExchange() string {
 	if x != nil {
-		return x.Script
+		return x.Exchange
 	}
-	return nil
-}
-
-type GCTScriptAutoLoadRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Script        string                 `protobuf:"bytes,1,opt,name=script,proto3" json:"script,omitempty"`
-	Status        bool                   `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GCTScriptAutoLoadRequest) Reset() {
-	*x = GCTScriptAutoLoadRequest{}
-	mi := &file_rpc_proto_msgTypes[129]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GCTScriptAutoLoadRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return ""
 }
 
-func (*GCTScriptAutoLoadRequest) ProtoMessage() {}
-
-func (x *GCTScriptAutoLoadRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[129]
+func (x *GetSavedTradesRequest) GetPair() *CurrencyPair {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Pair
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use GCTScriptAutoLoadRequest.ProtoReflect.Descriptor instead.
-func (*GCTScriptAutoLoadRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{129}
+func (x *GetSavedTradesRequest) GetAssetType() string {
+	if x != nil {
+		return x.AssetType
+	}
+	return ""
 }
 
-func (x *GCTScriptAutoLoadRequest) GetScript() string {
+func (x *GetSavedTradesRequest) GetStart() string {
 	if x != nil {
-		return x.Script
+		return x.Start
 	}
 	return ""
 }
 
-func (x *GCTScriptAutoLoadRequest) GetStatus() bool {
+func (x *GetSavedTradesRequest) GetEnd() string {
 	if x != nil {
-		return x.Status
+		return x.End
 	}
-	return false
+	return ""
 }
 
-type GCTScriptStatusResponse struct {
+type SavedTrades struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Scripts       []*GCTScript           `protobuf:"bytes,2,rep,name=scripts,proto3" json:"scripts,omitempty"`
+	Price         float64                `protobuf:"fixed64,1,opt,name=price,proto3" json:"price,omitempty"`
+	Amount        float64                `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Side          string                 `protobuf:"bytes,3,opt,name=side,proto3" json:"side,omitempty"`
+	Timestamp     string                 `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	TradeId       string                 `protobuf:"bytes,5,opt,name=trade_id,json=tradeId,proto3" json:"trade_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GCTScriptStatusResponse) Reset() {
-	*x = GCTScriptStatusResponse{}
-	mi := &file_rpc_proto_msgTypes[130]
+func (x *SavedTrades) Reset() {
+	*x = SavedTrades{}
+	mi := &file_rpc_proto_msgTypes[128]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GCTScriptStatusResponse) String() string {
+func (x *SavedTrades) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GCTScriptStatusResponse) ProtoMessage() {}
+func (*SavedTrades) ProtoMessage() {}
 
-func (x *GCTScriptStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[130]
+func (x *SavedTrades) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[128]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7864,108 +7939,71 @@ func (x *GCTScriptStatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GCTScriptStatusResponse.ProtoReflect.Descriptor instead.
-func (*GCTScriptStatusResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{130}
-}
-
-func (x *GCTScriptStatusResponse) GetStatus() string {
-	if x != nil {
-		return x.Status
-	}
-	return ""
+// Deprecated: Use SavedTrades.ProtoReflect.Descriptor instead.
+func (*SavedTrades) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{128}
 }
 
-func (x *GCTScriptStatusResponse) GetScripts() []*GCTScript {
+func (x *SavedTrades) GetPrice() float64 {
 	if x != nil {
-		return x.Scripts
+		return x.Price
 	}
-	return nil
-}
-
-type GCTScriptQueryResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Script        *GCTScript             `protobuf:"bytes,2,opt,name=script,proto3" json:"script,omitempty"`
-	Data          string                 `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GCTScriptQueryResponse) Reset() {
-	*x = GCTScriptQueryResponse{}
-	mi := &file_rpc_proto_msgTypes[131]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GCTScriptQueryResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return 0
 }
 
-func (*GCTScriptQueryResponse) ProtoMessage() {}
-
-func (x *GCTScriptQueryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[131]
+func (x *SavedTrades) GetAmount() float64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Amount
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GCTScriptQueryResponse.ProtoReflect.Descriptor instead.
-func (*GCTScriptQueryResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{131}
+	return 0
 }
 
-func (x *GCTScriptQueryResponse) GetStatus() string {
+func (x *SavedTrades) GetSide() string {
 	if x != nil {
-		return x.Status
+		return x.Side
 	}
 	return ""
 }
 
-func (x *GCTScriptQueryResponse) GetScript() *GCTScript {
+func (x *SavedTrades) GetTimestamp() string {
 	if x != nil {
-		return x.Script
+		return x.Timestamp
 	}
-	return nil
+	return ""
 }
 
-func (x *GCTScriptQueryResponse) GetData() string {
+func (x *SavedTrades) GetTradeId() string {
 	if x != nil {
-		return x.Data
+		return x.TradeId
 	}
 	return ""
 }
 
-type GenericResponse struct {
+type SavedTradesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Data          string                 `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	ExchangeName  string                 `protobuf:"bytes,1,opt,name=exchange_name,json=exchangeName,proto3" json:"exchange_name,omitempty"`
+	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	Trades        []*SavedTrades         `protobuf:"bytes,4,rep,name=trades,proto3" json:"trades,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GenericResponse) Reset() {
-	*x = GenericResponse{}
-	mi := &file_rpc_proto_msgTypes[132]
+func (x *SavedTradesResponse) Reset() {
+	*x = SavedTradesResponse{}
+	mi := &file_rpc_proto_msgTypes[129]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GenericResponse) String() string {
+func (x *SavedTradesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenericResponse) ProtoMessage() {}
+func (*SavedTradesResponse) ProtoMessage() {}
 
-func (x *GenericResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[132]
+func (x *SavedTradesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[129]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7976,49 +8014,68 @@ func (x *GenericResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GenericResponse.ProtoReflect.Descriptor instead.
-func (*GenericResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{132}
+// Deprecated: Use SavedTradesResponse.ProtoReflect.Descriptor instead.
+func (*SavedTradesResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{129}
 }
 
-func (x *GenericResponse) GetStatus() string {
+func (x *SavedTradesResponse) GetExchangeName() string {
 	if x != nil {
-		return x.Status
+		return x.ExchangeName
 	}
 	return ""
 }
 
-func (x *GenericResponse) GetData() string {
+func (x *SavedTradesResponse) GetAsset() string {
 	if x != nil {
-		return x.Data
+		return x.Asset
 	}
 	return ""
 }
 
-type SetExchangeAssetRequest struct {
+func (x *SavedTradesResponse) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *SavedTradesResponse) GetTrades() []*SavedTrades {
+	if x != nil {
+		return x.Trades
+	}
+	return nil
+}
+
+type ConvertTradesToCandlesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Enable        bool                   `protobuf:"varint,3,opt,name=enable,proto3" json:"enable,omitempty"`
+	Pair          *CurrencyPair          `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType     string                 `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Start         string                 `protobuf:"bytes,4,opt,name=start,proto3" json:"start,omitempty"`
+	End           string                 `protobuf:"bytes,5,opt,name=end,proto3" json:"end,omitempty"`
+	TimeInterval  int64                  `protobuf:"varint,6,opt,name=time_interval,json=timeInterval,proto3" json:"time_interval,omitempty"`
+	Sync          bool                   `protobuf:"varint,7,opt,name=sync,proto3" json:"sync,omitempty"`
+	Force         bool                   `protobuf:"varint,8,opt,name=force,proto3" json:"force,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetExchangeAssetRequest) Reset() {
-	*x = SetExchangeAssetRequest{}
-	mi := &file_rpc_proto_msgTypes[133]
+func (x *ConvertTradesToCandlesRequest) Reset() {
+	*x = ConvertTradesToCandlesRequest{}
+	mi := &file_rpc_proto_msgTypes[130]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetExchangeAssetRequest) String() string {
+func (x *ConvertTradesToCandlesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetExchangeAssetRequest) ProtoMessage() {}
+func (*ConvertTradesToCandlesRequest) ProtoMessage() {}
 
-func (x *SetExchangeAssetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[133]
+func (x *ConvertTradesToCandlesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[130]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8029,55 +8086,99 @@ func (x *SetExchangeAssetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetExchangeAssetRequest.ProtoReflect.Descriptor instead.
-func (*SetExchangeAssetRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{133}
+// Deprecated: Use ConvertTradesToCandlesRequest.ProtoReflect.Descriptor instead.
+func (*ConvertTradesToCandlesRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{130}
 }
 
-func (x *SetExchangeAssetRequest) GetExchange() string {
+func (x *ConvertTradesToCandlesRequest) GetExchange() string {
 	if x != nil {
 		return x.Exchange
 	}
 	return ""
 }
 
-func (x *SetExchangeAssetRequest) GetAsset() string {
+func (x *ConvertTradesToCandlesRequest) GetPair() *CurrencyPair {
 	if x != nil {
-		return x.Asset
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *ConvertTradesToCandlesRequest) GetAssetType() string {
+	if x != nil {
+		return x.AssetType
 	}
 	return ""
 }
 
-func (x *SetExchangeAssetRequest) GetEnable() bool {
+func (x *ConvertTradesToCandlesRequest) GetStart() string {
 	if x != nil {
-		return x.Enable
+		return x.Start
 	}
-	return false
+	return ""
 }
 
-type SetExchangeAllPairsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Enable        bool                   `protobuf:"varint,2,opt,name=enable,proto3" json:"enable,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ConvertTradesToCandlesRequest) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
 }
 
-func (x *SetExchangeAllPairsRequest) Reset() {
-	*x = SetExchangeAllPairsRequest{}
-	mi := &file_rpc_proto_msgTypes[134]
+func (x *ConvertTradesToCandlesRequest) GetTimeInterval() int64 {
+	if x != nil {
+		return x.TimeInterval
+	}
+	return 0
+}
+
+func (x *ConvertTradesToCandlesRequest) GetSync() bool {
+	if x != nil {
+		return x.Sync
+	}
+	return false
+}
+
+func (x *ConvertTradesToCandlesRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+type GetHistoricCandlesRequest struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Exchange              string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair                  *CurrencyPair          `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType             string                 `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Start                 string                 `protobuf:"bytes,4,opt,name=start,proto3" json:"start,omitempty"`
+	End                   string                 `protobuf:"bytes,5,opt,name=end,proto3" json:"end,omitempty"`
+	TimeInterval          int64                  `protobuf:"varint,6,opt,name=time_interval,json=timeInterval,proto3" json:"time_interval,omitempty"`
+	ExRequest             bool                   `protobuf:"varint,7,opt,name=ex_request,json=exRequest,proto3" json:"ex_request,omitempty"`
+	Sync                  bool                   `protobuf:"varint,8,opt,name=sync,proto3" json:"sync,omitempty"`
+	UseDb                 bool                   `protobuf:"varint,9,opt,name=use_db,json=useDb,proto3" json:"use_db,omitempty"`
+	FillMissingWithTrades bool                   `protobuf:"varint,10,opt,name=fill_missing_with_trades,json=fillMissingWithTrades,proto3" json:"fill_missing_with_trades,omitempty"`
+	Force                 bool                   `protobuf:"varint,11,opt,name=force,proto3" json:"force,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *GetHistoricCandlesRequest) Reset() {
+	*x = GetHistoricCandlesRequest{}
+	mi := &file_rpc_proto_msgTypes[131]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetExchangeAllPairsRequest) String() string {
+func (x *GetHistoricCandlesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetExchangeAllPairsRequest) ProtoMessage() {}
+func (*GetHistoricCandlesRequest) ProtoMessage() {}
 
-func (x *SetExchangeAllPairsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[134]
+func (x *GetHistoricCandlesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[131]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8088,91 +8189,115 @@ func (x *SetExchangeAllPairsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetExchangeAllPairsRequest.ProtoReflect.Descriptor instead.
-func (*SetExchangeAllPairsRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{134}
+// Deprecated: Use GetHistoricCandlesRequest.ProtoReflect.Descriptor instead.
+func (*GetHistoricCandlesRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{131}
 }
 
-func (x *SetExchangeAllPairsRequest) GetExchange() string {
+func (x *GetHistoricCandlesRequest) GetExchange() string {
 	if x != nil {
 		return x.Exchange
 	}
 	return ""
 }
 
-func (x *SetExchangeAllPairsRequest) GetEnable() bool {
+func (x *GetHistoricCandlesRequest) GetPair() *CurrencyPair {
 	if x != nil {
-		return x.Enable
+		return x.Pair
 	}
-	return false
+	return nil
 }
 
-type UpdateExchangeSupportedPairsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *GetHistoricCandlesRequest) GetAssetType() string {
+	if x != nil {
+		return x.AssetType
+	}
+	return ""
 }
 
-func (x *UpdateExchangeSupportedPairsRequest) Reset() {
-	*x = UpdateExchangeSupportedPairsRequest{}
-	mi := &file_rpc_proto_msgTypes[135]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *GetHistoricCandlesRequest) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
 }
 
-func (x *UpdateExchangeSupportedPairsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GetHistoricCandlesRequest) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
 }
 
-func (*UpdateExchangeSupportedPairsRequest) ProtoMessage() {}
+func (x *GetHistoricCandlesRequest) GetTimeInterval() int64 {
+	if x != nil {
+		return x.TimeInterval
+	}
+	return 0
+}
 
-func (x *UpdateExchangeSupportedPairsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[135]
+func (x *GetHistoricCandlesRequest) GetExRequest() bool {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.ExRequest
 	}
-	return mi.MessageOf(x)
+	return false
 }
 
-// Deprecated: Use UpdateExchangeSupportedPairsRequest.ProtoReflect.Descriptor instead.
-func (*UpdateExchangeSupportedPairsRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{135}
+func (x *GetHistoricCandlesRequest) GetSync() bool {
+	if x != nil {
+		return x.Sync
+	}
+	return false
 }
 
-func (x *UpdateExchangeSupportedPairsRequest) GetExchange() string {
+func (x *GetHistoricCandlesRequest) GetUseDb() bool {
 	if x != nil {
-		return x.Exchange
+		return x.UseDb
 	}
-	return ""
+	return false
 }
 
-type GetExchangeAssetsRequest struct {
+func (x *GetHistoricCandlesRequest) GetFillMissingWithTrades() bool {
+	if x != nil {
+		return x.FillMissingWithTrades
+	}
+	return false
+}
+
+func (x *GetHistoricCandlesRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+type GetHistoricCandlesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair          *CurrencyPair          `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	Start         string                 `protobuf:"bytes,3,opt,name=start,proto3" json:"start,omitempty"`
+	End           string                 `protobuf:"bytes,4,opt,name=end,proto3" json:"end,omitempty"`
+	Interval      string                 `protobuf:"bytes,6,opt,name=interval,proto3" json:"interval,omitempty"`
+	Candle        []*Candle              `protobuf:"bytes,5,rep,name=candle,proto3" json:"candle,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetExchangeAssetsRequest) Reset() {
-	*x = GetExchangeAssetsRequest{}
-	mi := &file_rpc_proto_msgTypes[136]
+func (x *GetHistoricCandlesResponse) Reset() {
+	*x = GetHistoricCandlesResponse{}
+	mi := &file_rpc_proto_msgTypes[132]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetExchangeAssetsRequest) String() string {
+func (x *GetHistoricCandlesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetExchangeAssetsRequest) ProtoMessage() {}
+func (*GetHistoricCandlesResponse) ProtoMessage() {}
 
-func (x *GetExchangeAssetsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[136]
+func (x *GetHistoricCandlesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[132]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8183,84 +8308,81 @@ func (x *GetExchangeAssetsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetExchangeAssetsRequest.ProtoReflect.Descriptor instead.
-func (*GetExchangeAssetsRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{136}
+// Deprecated: Use GetHistoricCandlesResponse.ProtoReflect.Descriptor instead.
+func (*GetHistoricCandlesResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{132}
 }
 
-func (x *GetExchangeAssetsRequest) GetExchange() string {
+func (x *GetHistoricCandlesResponse) GetExchange() string {
 	if x != nil {
 		return x.Exchange
 	}
 	return ""
 }
 
-type GetExchangeAssetsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Assets        string                 `protobuf:"bytes,1,opt,name=assets,proto3" json:"assets,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetExchangeAssetsResponse) Reset() {
-	*x = GetExchangeAssetsResponse{}
-	mi := &file_rpc_proto_msgTypes[137]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *GetHistoricCandlesResponse) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
 }
 
-func (x *GetExchangeAssetsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GetHistoricCandlesResponse) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
 }
 
-func (*GetExchangeAssetsResponse) ProtoMessage() {}
-
-func (x *GetExchangeAssetsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[137]
+func (x *GetHistoricCandlesResponse) GetEnd() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.End
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GetExchangeAssetsResponse.ProtoReflect.Descriptor instead.
-func (*GetExchangeAssetsResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{137}
+func (x *GetHistoricCandlesResponse) GetInterval() string {
+	if x != nil {
+		return x.Interval
+	}
+	return ""
 }
 
-func (x *GetExchangeAssetsResponse) GetAssets() string {
+func (x *GetHistoricCandlesResponse) GetCandle() []*Candle {
 	if x != nil {
-		return x.Assets
+		return x.Candle
 	}
-	return ""
+	return nil
 }
 
-type WebsocketGetInfoRequest struct {
+type Candle struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Time          string                 `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	Low           float64                `protobuf:"fixed64,2,opt,name=low,proto3" json:"low,omitempty"`
+	High          float64                `protobuf:"fixed64,3,opt,name=high,proto3" json:"high,omitempty"`
+	Open          float64                `protobuf:"fixed64,4,opt,name=open,proto3" json:"open,omitempty"`
+	Close         float64                `protobuf:"fixed64,5,opt,name=close,proto3" json:"close,omitempty"`
+	Volume        float64                `protobuf:"fixed64,6,opt,name=volume,proto3" json:"volume,omitempty"`
+	IsPartial     bool                   `protobuf:"varint,7,opt,name=is_partial,json=isPartial,proto3" json:"is_partial,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WebsocketGetInfoRequest) Reset() {
-	*x = WebsocketGetInfoRequest{}
-	mi := &file_rpc_proto_msgTypes[138]
+func (x *Candle) Reset() {
+	*x = Candle{}
+	mi := &file_rpc_proto_msgTypes[133]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WebsocketGetInfoRequest) String() string {
+func (x *Candle) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WebsocketGetInfoRequest) ProtoMessage() {}
+func (*Candle) ProtoMessage() {}
 
-func (x *WebsocketGetInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[138]
+func (x *Candle) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[133]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8271,133 +8393,85 @@ func (x *WebsocketGetInfoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WebsocketGetInfoRequest.ProtoReflect.Descriptor instead.
-func (*WebsocketGetInfoRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{138}
-}
-
-func (x *WebsocketGetInfoRequest) GetExchange() string {
-	if x != nil {
-		return x.Exchange
-	}
-	return ""
-}
-
-type WebsocketGetInfoResponse struct {
-	state                  protoimpl.MessageState `protogen:"open.v1"`
-	Exchange               string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Supported              bool                   `protobuf:"varint,2,opt,name=supported,proto3" json:"supported,omitempty"`
-	Enabled                bool                   `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
-	AuthenticatedSupported bool                   `protobuf:"varint,4,opt,name=authenticated_supported,json=authenticatedSupported,proto3" json:"authenticated_supported,omitempty"`
-	Authenticated          bool                   `protobuf:"varint,5,opt,name=authenticated,proto3" json:"authenticated,omitempty"`
-	RunningUrl             string                 `protobuf:"bytes,6,opt,name=running_url,json=runningUrl,proto3" json:"running_url,omitempty"`
-	ProxyAddress           string                 `protobuf:"bytes,7,opt,name=proxy_address,json=proxyAddress,proto3" json:"proxy_address,omitempty"`
-	unknownFields          protoimpl.UnknownFields
-	sizeCache              protoimpl.SizeCache
-}
-
-func (x *WebsocketGetInfoResponse) Reset() {
-	*x = WebsocketGetInfoResponse{}
-	mi := &file_rpc_proto_msgTypes[139]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *WebsocketGetInfoResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*WebsocketGetInfoResponse) ProtoMessage() {}
-
-func (x *WebsocketGetInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[139]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use WebsocketGetInfoResponse.ProtoReflect.Descriptor instead.
-func (*WebsocketGetInfoResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{139}
+// Deprecated: Use Candle.ProtoReflect.Descriptor instead.
+func (*Candle) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{133}
 }
 
-func (x *WebsocketGetInfoResponse) GetExchange() string {
+func (x *Candle) GetTime() string {
 	if x != nil {
-		return x.Exchange
+		return x.Time
 	}
 	return ""
 }
 
-func (x *WebsocketGetInfoResponse) GetSupported() bool {
+func (x *Candle) GetLow() float64 {
 	if x != nil {
-		return x.Supported
+		return x.Low
 	}
-	return false
+	return 0
 }
 
-func (x *WebsocketGetInfoResponse) GetEnabled() bool {
+func (x *Candle) GetHigh() float64 {
 	if x != nil {
-		return x.Enabled
+		return x.High
 	}
-	return false
+	return 0
 }
 
-func (x *WebsocketGetInfoResponse) GetAuthenticatedSupported() bool {
+func (x *Candle) GetOpen() float64 {
 	if x != nil {
-		return x.AuthenticatedSupported
+		return x.Open
 	}
-	return false
+	return 0
 }
 
-func (x *WebsocketGetInfoResponse) GetAuthenticated() bool {
+func (x *Candle) GetClose() float64 {
 	if x != nil {
-		return x.Authenticated
+		return x.Close
 	}
-	return false
+	return 0
 }
 
-func (x *WebsocketGetInfoResponse) GetRunningUrl() string {
+func (x *Candle) GetVolume() float64 {
 	if x != nil {
-		return x.RunningUrl
+		return x.Volume
 	}
-	return ""
+	return 0
 }
 
-func (x *WebsocketGetInfoResponse) GetProxyAddress() string {
+func (x *Candle) GetIsPartial() bool {
 	if x != nil {
-		return x.ProxyAddress
+		return x.IsPartial
 	}
-	return ""
+	return false
 }
 
-type WebsocketSetEnabledRequest struct {
+type AuditEvent struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Enable        bool                   `protobuf:"varint,2,opt,name=enable,proto3" json:"enable,omitempty"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Identifier    string                 `protobuf:"bytes,2,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Timestamp     string                 `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WebsocketSetEnabledRequest) Reset() {
-	*x = WebsocketSetEnabledRequest{}
-	mi := &file_rpc_proto_msgTypes[140]
+func (x *AuditEvent) Reset() {
+	*x = AuditEvent{}
+	mi := &file_rpc_proto_msgTypes[134]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WebsocketSetEnabledRequest) String() string {
+func (x *AuditEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WebsocketSetEnabledRequest) ProtoMessage() {}
+func (*AuditEvent) ProtoMessage() {}
 
-func (x *WebsocketSetEnabledRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[140]
+func (x *AuditEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[134]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8408,94 +8482,64 @@ func (x *WebsocketSetEnabledRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WebsocketSetEnabledRequest.ProtoReflect.Descriptor instead.
-func (*WebsocketSetEnabledRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{140}
+// Deprecated: Use AuditEvent.ProtoReflect.Descriptor instead.
+func (*AuditEvent) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{134}
 }
 
-func (x *WebsocketSetEnabledRequest) GetExchange() string {
+func (x *AuditEvent) GetType() string {
 	if x != nil {
-		return x.Exchange
+		return x.Type
 	}
 	return ""
 }
 
-func (x *WebsocketSetEnabledRequest) GetEnable() bool {
+func (x *AuditEvent) GetIdentifier() string {
 	if x != nil {
-		return x.Enable
+		return x.Identifier
 	}
-	return false
-}
-
-type WebsocketGetSubscriptionsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *WebsocketGetSubscriptionsRequest) Reset() {
-	*x = WebsocketGetSubscriptionsRequest{}
-	mi := &file_rpc_proto_msgTypes[141]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *WebsocketGetSubscriptionsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return ""
 }
 
-func (*WebsocketGetSubscriptionsRequest) ProtoMessage() {}
-
-func (x *WebsocketGetSubscriptionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[141]
+func (x *AuditEvent) GetMessage() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Message
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use WebsocketGetSubscriptionsRequest.ProtoReflect.Descriptor instead.
-func (*WebsocketGetSubscriptionsRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{141}
+	return ""
 }
 
-func (x *WebsocketGetSubscriptionsRequest) GetExchange() string {
+func (x *AuditEvent) GetTimestamp() string {
 	if x != nil {
-		return x.Exchange
+		return x.Timestamp
 	}
 	return ""
 }
 
-type WebsocketSubscription struct {
+type GCTScript struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Channel       string                 `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
-	Pairs         string                 `protobuf:"bytes,2,opt,name=pairs,proto3" json:"pairs,omitempty"`
-	Asset         string                 `protobuf:"bytes,3,opt,name=asset,proto3" json:"asset,omitempty"`
-	Params        string                 `protobuf:"bytes,4,opt,name=params,proto3" json:"params,omitempty"`
+	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Path          string                 `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	NextRun       string                 `protobuf:"bytes,4,opt,name=next_run,json=nextRun,proto3" json:"next_run,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WebsocketSubscription) Reset() {
-	*x = WebsocketSubscription{}
-	mi := &file_rpc_proto_msgTypes[142]
+func (x *GCTScript) Reset() {
+	*x = GCTScript{}
+	mi := &file_rpc_proto_msgTypes[135]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WebsocketSubscription) String() string {
+func (x *GCTScript) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WebsocketSubscription) ProtoMessage() {}
+func (*GCTScript) ProtoMessage() {}
 
-func (x *WebsocketSubscription) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[142]
+func (x *GCTScript) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[135]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8506,62 +8550,61 @@ func (x *WebsocketSubscription) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WebsocketSubscription.ProtoReflect.Descriptor instead.
-func (*WebsocketSubscription) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{142}
+// Deprecated: Use GCTScript.ProtoReflect.Descriptor instead.
+func (*GCTScript) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{135}
 }
 
-func (x *WebsocketSubscription) GetChannel() string {
+func (x *GCTScript) GetUuid() string {
 	if x != nil {
-		return x.Channel
+		return x.Uuid
 	}
 	return ""
 }
 
-func (x *WebsocketSubscription) GetPairs() string {
+func (x *GCTScript) GetName() string {
 	if x != nil {
-		return x.Pairs
+		return x.Name
 	}
 	return ""
 }
 
-func (x *WebsocketSubscription) GetAsset() string {
+func (x *GCTScript) GetPath() string {
 	if x != nil {
-		return x.Asset
+		return x.Path
 	}
 	return ""
 }
 
-func (x *WebsocketSubscription) GetParams() string {
+func (x *GCTScript) GetNextRun() string {
 	if x != nil {
-		return x.Params
+		return x.NextRun
 	}
 	return ""
 }
 
-type WebsocketGetSubscriptionsResponse struct {
-	state         protoimpl.MessageState   `protogen:"open.v1"`
-	Exchange      string                   `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Subscriptions []*WebsocketSubscription `protobuf:"bytes,2,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+type GCTScriptExecuteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Script        *GCTScript             `protobuf:"bytes,1,opt,name=script,proto3" json:"script,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WebsocketGetSubscriptionsResponse) Reset() {
-	*x = WebsocketGetSubscriptionsResponse{}
-	mi := &file_rpc_proto_msgTypes[143]
+func (x *GCTScriptExecuteRequest) Reset() {
+	*x = GCTScriptExecuteRequest{}
+	mi := &file_rpc_proto_msgTypes[136]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WebsocketGetSubscriptionsResponse) String() string {
+func (x *GCTScriptExecuteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WebsocketGetSubscriptionsResponse) ProtoMessage() {}
+func (*GCTScriptExecuteRequest) ProtoMessage() {}
 
-func (x *WebsocketGetSubscriptionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[143]
+func (x *GCTScriptExecuteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[136]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8572,48 +8615,40 @@ func (x *WebsocketGetSubscriptionsResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WebsocketGetSubscriptionsResponse.ProtoReflect.Descriptor instead.
-func (*WebsocketGetSubscriptionsResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{143}
-}
-
-func (x *WebsocketGetSubscriptionsResponse) GetExchange() string {
-	if x != nil {
-		return x.Exchange
-	}
-	return ""
+// Deprecated: Use GCTScriptExecuteRequest.ProtoReflect.Descriptor instead.
+func (*GCTScriptExecuteRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{136}
 }
 
-func (x *WebsocketGetSubscriptionsResponse) GetSubscriptions() []*WebsocketSubscription {
+func (x *GCTScriptExecuteRequest) GetScript() *GCTScript {
 	if x != nil {
-		return x.Subscriptions
+		return x.Script
 	}
 	return nil
 }
 
-type WebsocketSetProxyRequest struct {
+type GCTScriptStopRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Proxy         string                 `protobuf:"bytes,2,opt,name=proxy,proto3" json:"proxy,omitempty"`
+	Script        *GCTScript             `protobuf:"bytes,1,opt,name=script,proto3" json:"script,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WebsocketSetProxyRequest) Reset() {
-	*x = WebsocketSetProxyRequest{}
-	mi := &file_rpc_proto_msgTypes[144]
+func (x *GCTScriptStopRequest) Reset() {
+	*x = GCTScriptStopRequest{}
+	mi := &file_rpc_proto_msgTypes[137]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WebsocketSetProxyRequest) String() string {
+func (x *GCTScriptStopRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WebsocketSetProxyRequest) ProtoMessage() {}
+func (*GCTScriptStopRequest) ProtoMessage() {}
 
-func (x *WebsocketSetProxyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[144]
+func (x *GCTScriptStopRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[137]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8624,48 +8659,39 @@ func (x *WebsocketSetProxyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WebsocketSetProxyRequest.ProtoReflect.Descriptor instead.
-func (*WebsocketSetProxyRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{144}
-}
-
-func (x *WebsocketSetProxyRequest) GetExchange() string {
-	if x != nil {
-		return x.Exchange
-	}
-	return ""
+// Deprecated: Use GCTScriptStopRequest.ProtoReflect.Descriptor instead.
+func (*GCTScriptStopRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{137}
 }
 
-func (x *WebsocketSetProxyRequest) GetProxy() string {
+func (x *GCTScriptStopRequest) GetScript() *GCTScript {
 	if x != nil {
-		return x.Proxy
+		return x.Script
 	}
-	return ""
+	return nil
 }
 
-type WebsocketSetURLRequest struct {
+type GCTScriptStopAllRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WebsocketSetURLRequest) Reset() {
-	*x = WebsocketSetURLRequest{}
-	mi := &file_rpc_proto_msgTypes[145]
+func (x *GCTScriptStopAllRequest) Reset() {
+	*x = GCTScriptStopAllRequest{}
+	mi := &file_rpc_proto_msgTypes[138]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WebsocketSetURLRequest) String() string {
+func (x *GCTScriptStopAllRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WebsocketSetURLRequest) ProtoMessage() {}
+func (*GCTScriptStopAllRequest) ProtoMessage() {}
 
-func (x *WebsocketSetURLRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[145]
+func (x *GCTScriptStopAllRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[138]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8676,52 +8702,32 @@ func (x *WebsocketSetURLRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WebsocketSetURLRequest.ProtoReflect.Descriptor instead.
-func (*WebsocketSetURLRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{145}
-}
-
-func (x *WebsocketSetURLRequest) GetExchange() string {
-	if x != nil {
-		return x.Exchange
-	}
-	return ""
-}
-
-func (x *WebsocketSetURLRequest) GetUrl() string {
-	if x != nil {
-		return x.Url
-	}
-	return ""
+// Deprecated: Use GCTScriptStopAllRequest.ProtoReflect.Descriptor instead.
+func (*GCTScriptStopAllRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{138}
 }
 
-type FindMissingCandlePeriodsRequest struct {
+type GCTScriptStatusRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ExchangeName  string                 `protobuf:"bytes,1,opt,name=exchange_name,json=exchangeName,proto3" json:"exchange_name,omitempty"`
-	AssetType     string                 `protobuf:"bytes,2,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
-	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	Interval      int64                  `protobuf:"varint,4,opt,name=interval,proto3" json:"interval,omitempty"`
-	Start         string                 `protobuf:"bytes,5,opt,name=start,proto3" json:"start,omitempty"`
-	End           string                 `protobuf:"bytes,6,opt,name=end,proto3" json:"end,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FindMissingCandlePeriodsRequest) Reset() {
-	*x = FindMissingCandlePeriodsRequest{}
-	mi := &file_rpc_proto_msgTypes[146]
+func (x *GCTScriptStatusRequest) Reset() {
+	*x = GCTScriptStatusRequest{}
+	mi := &file_rpc_proto_msgTypes[139]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FindMissingCandlePeriodsRequest) String() string {
+func (x *GCTScriptStatusRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FindMissingCandlePeriodsRequest) ProtoMessage() {}
+func (*GCTScriptStatusRequest) ProtoMessage() {}
 
-func (x *FindMissingCandlePeriodsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[146]
+func (x *GCTScriptStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[139]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8732,79 +8738,73 @@ func (x *FindMissingCandlePeriodsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FindMissingCandlePeriodsRequest.ProtoReflect.Descriptor instead.
-func (*FindMissingCandlePeriodsRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{146}
+// Deprecated: Use GCTScriptStatusRequest.ProtoReflect.Descriptor instead.
+func (*GCTScriptStatusRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{139}
 }
 
-func (x *FindMissingCandlePeriodsRequest) GetExchangeName() string {
-	if x != nil {
-		return x.ExchangeName
-	}
-	return ""
+type GCTScriptListAllRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FindMissingCandlePeriodsRequest) GetAssetType() string {
-	if x != nil {
-		return x.AssetType
-	}
-	return ""
+func (x *GCTScriptListAllRequest) Reset() {
+	*x = GCTScriptListAllRequest{}
+	mi := &file_rpc_proto_msgTypes[140]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *FindMissingCandlePeriodsRequest) GetPair() *CurrencyPair {
-	if x != nil {
-		return x.Pair
-	}
-	return nil
+func (x *GCTScriptListAllRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *FindMissingCandlePeriodsRequest) GetInterval() int64 {
-	if x != nil {
-		return x.Interval
-	}
-	return 0
-}
+func (*GCTScriptListAllRequest) ProtoMessage() {}
 
-func (x *FindMissingCandlePeriodsRequest) GetStart() string {
+func (x *GCTScriptListAllRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[140]
 	if x != nil {
-		return x.Start
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *FindMissingCandlePeriodsRequest) GetEnd() string {
-	if x != nil {
-		return x.End
-	}
-	return ""
+// Deprecated: Use GCTScriptListAllRequest.ProtoReflect.Descriptor instead.
+func (*GCTScriptListAllRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{140}
 }
 
-type FindMissingTradePeriodsRequest struct {
+type GCTScriptUploadRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ExchangeName  string                 `protobuf:"bytes,1,opt,name=exchange_name,json=exchangeName,proto3" json:"exchange_name,omitempty"`
-	AssetType     string                 `protobuf:"bytes,2,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
-	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	Start         string                 `protobuf:"bytes,4,opt,name=start,proto3" json:"start,omitempty"`
-	End           string                 `protobuf:"bytes,5,opt,name=end,proto3" json:"end,omitempty"`
+	ScriptName    string                 `protobuf:"bytes,1,opt,name=script_name,json=scriptName,proto3" json:"script_name,omitempty"`
+	ScriptData    string                 `protobuf:"bytes,2,opt,name=script_data,json=scriptData,proto3" json:"script_data,omitempty"`
+	Data          []byte                 `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Archived      bool                   `protobuf:"varint,4,opt,name=archived,proto3" json:"archived,omitempty"`
+	Overwrite     bool                   `protobuf:"varint,5,opt,name=overwrite,proto3" json:"overwrite,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FindMissingTradePeriodsRequest) Reset() {
-	*x = FindMissingTradePeriodsRequest{}
-	mi := &file_rpc_proto_msgTypes[147]
+func (x *GCTScriptUploadRequest) Reset() {
+	*x = GCTScriptUploadRequest{}
+	mi := &file_rpc_proto_msgTypes[141]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FindMissingTradePeriodsRequest) String() string {
+func (x *GCTScriptUploadRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FindMissingTradePeriodsRequest) ProtoMessage() {}
+func (*GCTScriptUploadRequest) ProtoMessage() {}
 
-func (x *FindMissingTradePeriodsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[147]
+func (x *GCTScriptUploadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[141]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8815,72 +8815,68 @@ func (x *FindMissingTradePeriodsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FindMissingTradePeriodsRequest.ProtoReflect.Descriptor instead.
-func (*FindMissingTradePeriodsRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{147}
+// Deprecated: Use GCTScriptUploadRequest.ProtoReflect.Descriptor instead.
+func (*GCTScriptUploadRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{141}
 }
 
-func (x *FindMissingTradePeriodsRequest) GetExchangeName() string {
+func (x *GCTScriptUploadRequest) GetScriptName() string {
 	if x != nil {
-		return x.ExchangeName
+		return x.ScriptName
 	}
 	return ""
 }
 
-func (x *FindMissingTradePeriodsRequest) GetAssetType() string {
+func (x *GCTScriptUploadRequest) GetScriptData() string {
 	if x != nil {
-		return x.AssetType
+		return x.ScriptData
 	}
 	return ""
 }
 
-func (x *FindMissingTradePeriodsRequest) GetPair() *CurrencyPair {
+func (x *GCTScriptUploadRequest) GetData() []byte {
 	if x != nil {
-		return x.Pair
+		return x.Data
 	}
 	return nil
 }
 
-func (x *FindMissingTradePeriodsRequest) GetStart() string {
+func (x *GCTScriptUploadRequest) GetArchived() bool {
 	if x != nil {
-		return x.Start
+		return x.Archived
 	}
-	return ""
+	return false
 }
 
-func (x *FindMissingTradePeriodsRequest) GetEnd() string {
+func (x *GCTScriptUploadRequest) GetOverwrite() bool {
 	if x != nil {
-		return x.End
+		return x.Overwrite
 	}
-	return ""
+	return false
 }
 
-type FindMissingIntervalsResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	ExchangeName   string                 `protobuf:"bytes,1,opt,name=exchange_name,json=exchangeName,proto3" json:"exchange_name,omitempty"`
-	AssetType      string                 `protobuf:"bytes,2,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
-	Pair           *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	MissingPeriods []string               `protobuf:"bytes,4,rep,name=missing_periods,json=missingPeriods,proto3" json:"missing_periods,omitempty"`
-	Status         string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type GCTScriptReadScriptRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Script        *GCTScript             `protobuf:"bytes,1,opt,name=script,proto3" json:"script,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FindMissingIntervalsResponse) Reset() {
-	*x = FindMissingIntervalsResponse{}
-	mi := &file_rpc_proto_msgTypes[148]
+func (x *GCTScriptReadScriptRequest) Reset() {
+	*x = GCTScriptReadScriptRequest{}
+	mi := &file_rpc_proto_msgTypes[142]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FindMissingIntervalsResponse) String() string {
+func (x *GCTScriptReadScriptRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FindMissingIntervalsResponse) ProtoMessage() {}
+func (*GCTScriptReadScriptRequest) ProtoMessage() {}
 
-func (x *FindMissingIntervalsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[148]
+func (x *GCTScriptReadScriptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[142]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8891,69 +8887,85 @@ func (x *FindMissingIntervalsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FindMissingIntervalsResponse.ProtoReflect.Descriptor instead.
-func (*FindMissingIntervalsResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{148}
-}
-
-func (x *FindMissingIntervalsResponse) GetExchangeName() string {
-	if x != nil {
-		return x.ExchangeName
-	}
-	return ""
+// Deprecated: Use GCTScriptReadScriptRequest.ProtoReflect.Descriptor instead.
+func (*GCTScriptReadScriptRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{142}
 }
 
-func (x *FindMissingIntervalsResponse) GetAssetType() string {
+func (x *GCTScriptReadScriptRequest) GetScript() *GCTScript {
 	if x != nil {
-		return x.AssetType
+		return x.Script
 	}
-	return ""
+	return nil
 }
 
-func (x *FindMissingIntervalsResponse) GetPair() *CurrencyPair {
-	if x != nil {
-		return x.Pair
-	}
-	return nil
+type GCTScriptQueryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Script        *GCTScript             `protobuf:"bytes,1,opt,name=script,proto3" json:"script,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FindMissingIntervalsResponse) GetMissingPeriods() []string {
+func (x *GCTScriptQueryRequest) Reset() {
+	*x = GCTScriptQueryRequest{}
+	mi := &file_rpc_proto_msgTypes[143]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GCTScriptQueryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GCTScriptQueryRequest) ProtoMessage() {}
+
+func (x *GCTScriptQueryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[143]
 	if x != nil {
-		return x.MissingPeriods
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *FindMissingIntervalsResponse) GetStatus() string {
+// Deprecated: Use GCTScriptQueryRequest.ProtoReflect.Descriptor instead.
+func (*GCTScriptQueryRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{143}
+}
+
+func (x *GCTScriptQueryRequest) GetScript() *GCTScript {
 	if x != nil {
-		return x.Status
+		return x.Script
 	}
-	return ""
+	return nil
 }
 
-type SetExchangeTradeProcessingRequest struct {
+type GCTScriptAutoLoadRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Script        string                 `protobuf:"bytes,1,opt,name=script,proto3" json:"script,omitempty"`
 	Status        bool                   `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetExchangeTradeProcessingRequest) Reset() {
-	*x = SetExchangeTradeProcessingRequest{}
-	mi := &file_rpc_proto_msgTypes[149]
+func (x *GCTScriptAutoLoadRequest) Reset() {
+	*x = GCTScriptAutoLoadRequest{}
+	mi := &file_rpc_proto_msgTypes[144]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetExchangeTradeProcessingRequest) String() string {
+func (x *GCTScriptAutoLoadRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetExchangeTradeProcessingRequest) ProtoMessage() {}
+func (*GCTScriptAutoLoadRequest) ProtoMessage() {}
 
-func (x *SetExchangeTradeProcessingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[149]
+func (x *GCTScriptAutoLoadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[144]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8964,65 +8976,48 @@ func (x *SetExchangeTradeProcessingRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetExchangeTradeProcessingRequest.ProtoReflect.Descriptor instead.
-func (*SetExchangeTradeProcessingRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{149}
+// Deprecated: Use GCTScriptAutoLoadRequest.ProtoReflect.Descriptor instead.
+func (*GCTScriptAutoLoadRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{144}
 }
 
-func (x *SetExchangeTradeProcessingRequest) GetExchange() string {
+func (x *GCTScriptAutoLoadRequest) GetScript() string {
 	if x != nil {
-		return x.Exchange
+		return x.Script
 	}
 	return ""
 }
 
-func (x *SetExchangeTradeProcessingRequest) GetStatus() bool {
+func (x *GCTScriptAutoLoadRequest) GetStatus() bool {
 	if x != nil {
 		return x.Status
 	}
 	return false
 }
 
-type UpsertDataHistoryJobRequest struct {
-	state                    protoimpl.MessageState `protogen:"open.v1"`
-	Nickname                 string                 `protobuf:"bytes,1,opt,name=nickname,proto3" json:"nickname,omitempty"`
-	Exchange                 string                 `protobuf:"bytes,2,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset                    string                 `protobuf:"bytes,3,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair                     *CurrencyPair          `protobuf:"bytes,4,opt,name=pair,proto3" json:"pair,omitempty"`
-	StartDate                string                 `protobuf:"bytes,5,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
-	EndDate                  string                 `protobuf:"bytes,6,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
-	Interval                 int64                  `protobuf:"varint,7,opt,name=interval,proto3" json:"interval,omitempty"`
-	RequestSizeLimit         uint64                 `protobuf:"varint,8,opt,name=request_size_limit,json=requestSizeLimit,proto3" json:"request_size_limit,omitempty"`
-	DataType                 int64                  `protobuf:"varint,9,opt,name=data_type,json=dataType,proto3" json:"data_type,omitempty"`
-	MaxRetryAttempts         uint64                 `protobuf:"varint,10,opt,name=max_retry_attempts,json=maxRetryAttempts,proto3" json:"max_retry_attempts,omitempty"`
-	BatchSize                uint64                 `protobuf:"varint,11,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
-	InsertOnly               bool                   `protobuf:"varint,12,opt,name=insert_only,json=insertOnly,proto3" json:"insert_only,omitempty"`
-	ConversionInterval       int64                  `protobuf:"varint,13,opt,name=conversion_interval,json=conversionInterval,proto3" json:"conversion_interval,omitempty"`
-	OverwriteExistingData    bool                   `protobuf:"varint,14,opt,name=overwrite_existing_data,json=overwriteExistingData,proto3" json:"overwrite_existing_data,omitempty"`
-	PrerequisiteJobNickname  string                 `protobuf:"bytes,15,opt,name=prerequisite_job_nickname,json=prerequisiteJobNickname,proto3" json:"prerequisite_job_nickname,omitempty"`
-	DecimalPlaceComparison   uint64                 `protobuf:"varint,16,opt,name=decimal_place_comparison,json=decimalPlaceComparison,proto3" json:"decimal_place_comparison,omitempty"`
-	SecondaryExchangeName    string                 `protobuf:"bytes,17,opt,name=secondary_exchange_name,json=secondaryExchangeName,proto3" json:"secondary_exchange_name,omitempty"`
-	IssueTolerancePercentage float64                `protobuf:"fixed64,18,opt,name=issue_tolerance_percentage,json=issueTolerancePercentage,proto3" json:"issue_tolerance_percentage,omitempty"`
-	ReplaceOnIssue           bool                   `protobuf:"varint,19,opt,name=replace_on_issue,json=replaceOnIssue,proto3" json:"replace_on_issue,omitempty"`
-	unknownFields            protoimpl.UnknownFields
-	sizeCache                protoimpl.SizeCache
+type GCTScriptStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Scripts       []*GCTScript           `protobuf:"bytes,2,rep,name=scripts,proto3" json:"scripts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpsertDataHistoryJobRequest) Reset() {
-	*x = UpsertDataHistoryJobRequest{}
-	mi := &file_rpc_proto_msgTypes[150]
+func (x *GCTScriptStatusResponse) Reset() {
+	*x = GCTScriptStatusResponse{}
+	mi := &file_rpc_proto_msgTypes[145]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpsertDataHistoryJobRequest) String() string {
+func (x *GCTScriptStatusResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpsertDataHistoryJobRequest) ProtoMessage() {}
+func (*GCTScriptStatusResponse) ProtoMessage() {}
 
-func (x *UpsertDataHistoryJobRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[150]
+func (x *GCTScriptStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[145]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9033,166 +9028,220 @@ func (x *UpsertDataHistoryJobRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpsertDataHistoryJobRequest.ProtoReflect.Descriptor instead.
-func (*UpsertDataHistoryJobRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{150}
+// Deprecated: Use GCTScriptStatusResponse.ProtoReflect.Descriptor instead.
+func (*GCTScriptStatusResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{145}
 }
 
-func (x *UpsertDataHistoryJobRequest) GetNickname() string {
+func (x *GCTScriptStatusResponse) GetStatus() string {
 	if x != nil {
-		return x.Nickname
+		return x.Status
 	}
 	return ""
 }
 
-func (x *UpsertDataHistoryJobRequest) GetExchange() string {
+func (x *GCTScriptStatusResponse) GetScripts() []*GCTScript {
 	if x != nil {
-		return x.Exchange
+		return x.Scripts
 	}
-	return ""
+	return nil
 }
 
-func (x *UpsertDataHistoryJobRequest) GetAsset() string {
-	if x != nil {
-		return x.Asset
-	}
-	return ""
+type GCTScriptQueryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Script        *GCTScript             `protobuf:"bytes,2,opt,name=script,proto3" json:"script,omitempty"`
+	Data          string                 `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpsertDataHistoryJobRequest) GetPair() *CurrencyPair {
-	if x != nil {
-		return x.Pair
-	}
-	return nil
+func (x *GCTScriptQueryResponse) Reset() {
+	*x = GCTScriptQueryResponse{}
+	mi := &file_rpc_proto_msgTypes[146]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpsertDataHistoryJobRequest) GetStartDate() string {
+func (x *GCTScriptQueryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GCTScriptQueryResponse) ProtoMessage() {}
+
+func (x *GCTScriptQueryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[146]
 	if x != nil {
-		return x.StartDate
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *UpsertDataHistoryJobRequest) GetEndDate() string {
+// Deprecated: Use GCTScriptQueryResponse.ProtoReflect.Descriptor instead.
+func (*GCTScriptQueryResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{146}
+}
+
+func (x *GCTScriptQueryResponse) GetStatus() string {
 	if x != nil {
-		return x.EndDate
+		return x.Status
 	}
 	return ""
 }
 
-func (x *UpsertDataHistoryJobRequest) GetInterval() int64 {
+func (x *GCTScriptQueryResponse) GetScript() *GCTScript {
 	if x != nil {
-		return x.Interval
+		return x.Script
 	}
-	return 0
+	return nil
 }
 
-func (x *UpsertDataHistoryJobRequest) GetRequestSizeLimit() uint64 {
+func (x *GCTScriptQueryResponse) GetData() string {
 	if x != nil {
-		return x.RequestSizeLimit
+		return x.Data
 	}
-	return 0
+	return ""
 }
 
-func (x *UpsertDataHistoryJobRequest) GetDataType() int64 {
-	if x != nil {
-		return x.DataType
-	}
-	return 0
+type GenericResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Data          string                 `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpsertDataHistoryJobRequest) GetMaxRetryAttempts() uint64 {
-	if x != nil {
-		return x.MaxRetryAttempts
-	}
-	return 0
+func (x *GenericResponse) Reset() {
+	*x = GenericResponse{}
+	mi := &file_rpc_proto_msgTypes[147]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpsertDataHistoryJobRequest) GetBatchSize() uint64 {
-	if x != nil {
-		return x.BatchSize
-	}
-	return 0
+func (x *GenericResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *UpsertDataHistoryJobRequest) GetInsertOnly() bool {
+func (*GenericResponse) ProtoMessage() {}
+
+func (x *GenericResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[147]
 	if x != nil {
-		return x.InsertOnly
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *UpsertDataHistoryJobRequest) GetConversionInterval() int64 {
-	if x != nil {
-		return x.ConversionInterval
-	}
-	return 0
+// Deprecated: Use GenericResponse.ProtoReflect.Descriptor instead.
+func (*GenericResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{147}
 }
 
-func (x *UpsertDataHistoryJobRequest) GetOverwriteExistingData() bool {
+func (x *GenericResponse) GetStatus() string {
 	if x != nil {
-		return x.OverwriteExistingData
+		return x.Status
 	}
-	return false
+	return ""
 }
 
-func (x *UpsertDataHistoryJobRequest) GetPrerequisiteJobNickname() string {
+func (x *GenericResponse) GetData() string {
 	if x != nil {
-		return x.PrerequisiteJobNickname
+		return x.Data
 	}
 	return ""
 }
 
-func (x *UpsertDataHistoryJobRequest) GetDecimalPlaceComparison() uint64 {
-	if x != nil {
-		return x.DecimalPlaceComparison
+type SetExchangeAssetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Enable        bool                   `protobuf:"varint,3,opt,name=enable,proto3" json:"enable,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetExchangeAssetRequest) Reset() {
+	*x = SetExchangeAssetRequest{}
+	mi := &file_rpc_proto_msgTypes[148]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetExchangeAssetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetExchangeAssetRequest) ProtoMessage() {}
+
+func (x *SetExchangeAssetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[148]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *UpsertDataHistoryJobRequest) GetSecondaryExchangeName() string {
+// Deprecated: Use SetExchangeAssetRequest.ProtoReflect.Descriptor instead.
+func (*SetExchangeAssetRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{148}
+}
+
+func (x *SetExchangeAssetRequest) GetExchange() string {
 	if x != nil {
-		return x.SecondaryExchangeName
+		return x.Exchange
 	}
 	return ""
 }
 
-func (x *UpsertDataHistoryJobRequest) GetIssueTolerancePercentage() float64 {
+func (x *SetExchangeAssetRequest) GetAsset() string {
 	if x != nil {
-		return x.IssueTolerancePercentage
+		return x.Asset
 	}
-	return 0
+	return ""
 }
 
-func (x *UpsertDataHistoryJobRequest) GetReplaceOnIssue() bool {
+func (x *SetExchangeAssetRequest) GetEnable() bool {
 	if x != nil {
-		return x.ReplaceOnIssue
+		return x.Enable
 	}
 	return false
 }
 
-type InsertSequentialJobsRequest struct {
-	state         protoimpl.MessageState         `protogen:"open.v1"`
-	Jobs          []*UpsertDataHistoryJobRequest `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+type SetExchangeAllPairsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Enable        bool                   `protobuf:"varint,2,opt,name=enable,proto3" json:"enable,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InsertSequentialJobsRequest) Reset() {
-	*x = InsertSequentialJobsRequest{}
-	mi := &file_rpc_proto_msgTypes[151]
+func (x *SetExchangeAllPairsRequest) Reset() {
+	*x = SetExchangeAllPairsRequest{}
+	mi := &file_rpc_proto_msgTypes[149]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InsertSequentialJobsRequest) String() string {
+func (x *SetExchangeAllPairsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InsertSequentialJobsRequest) ProtoMessage() {}
+func (*SetExchangeAllPairsRequest) ProtoMessage() {}
 
-func (x *InsertSequentialJobsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[151]
+func (x *SetExchangeAllPairsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[149]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9203,40 +9252,47 @@ func (x *InsertSequentialJobsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InsertSequentialJobsRequest.ProtoReflect.Descriptor instead.
-func (*InsertSequentialJobsRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{151}
+// Deprecated: Use SetExchangeAllPairsRequest.ProtoReflect.Descriptor instead.
+func (*SetExchangeAllPairsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{149}
 }
 
-func (x *InsertSequentialJobsRequest) GetJobs() []*UpsertDataHistoryJobRequest {
+func (x *SetExchangeAllPairsRequest) GetExchange() string {
 	if x != nil {
-		return x.Jobs
+		return x.Exchange
 	}
-	return nil
+	return ""
 }
 
-type InsertSequentialJobsResponse struct {
-	state         protoimpl.MessageState          `protogen:"open.v1"`
-	Jobs          []*UpsertDataHistoryJobResponse `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+func (x *SetExchangeAllPairsRequest) GetEnable() bool {
+	if x != nil {
+		return x.Enable
+	}
+	return false
+}
+
+type UpdateExchangeSupportedPairsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InsertSequentialJobsResponse) Reset() {
-	*x = InsertSequentialJobsResponse{}
-	mi := &file_rpc_proto_msgTypes[152]
+func (x *UpdateExchangeSupportedPairsRequest) Reset() {
+	*x = UpdateExchangeSupportedPairsRequest{}
+	mi := &file_rpc_proto_msgTypes[150]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InsertSequentialJobsResponse) String() string {
+func (x *UpdateExchangeSupportedPairsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InsertSequentialJobsResponse) ProtoMessage() {}
+func (*UpdateExchangeSupportedPairsRequest) ProtoMessage() {}
 
-func (x *InsertSequentialJobsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[152]
+func (x *UpdateExchangeSupportedPairsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[150]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9247,41 +9303,40 @@ func (x *InsertSequentialJobsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InsertSequentialJobsResponse.ProtoReflect.Descriptor instead.
-func (*InsertSequentialJobsResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{152}
+// Deprecated: Use UpdateExchangeSupportedPairsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateExchangeSupportedPairsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{150}
 }
 
-func (x *InsertSequentialJobsResponse) GetJobs() []*UpsertDataHistoryJobResponse {
+func (x *UpdateExchangeSupportedPairsRequest) GetExchange() string {
 	if x != nil {
-		return x.Jobs
+		return x.Exchange
 	}
-	return nil
+	return ""
 }
 
-type UpsertDataHistoryJobResponse struct {
+type GetExchangeAssetsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
-	JobId         string                 `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpsertDataHistoryJobResponse) Reset() {
-	*x = UpsertDataHistoryJobResponse{}
-	mi := &file_rpc_proto_msgTypes[153]
+func (x *GetExchangeAssetsRequest) Reset() {
+	*x = GetExchangeAssetsRequest{}
+	mi := &file_rpc_proto_msgTypes[151]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpsertDataHistoryJobResponse) String() string {
+func (x *GetExchangeAssetsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpsertDataHistoryJobResponse) ProtoMessage() {}
+func (*GetExchangeAssetsRequest) ProtoMessage() {}
 
-func (x *UpsertDataHistoryJobResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[153]
+func (x *GetExchangeAssetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[151]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9292,49 +9347,40 @@ func (x *UpsertDataHistoryJobResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpsertDataHistoryJobResponse.ProtoReflect.Descriptor instead.
-func (*UpsertDataHistoryJobResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{153}
-}
-
-func (x *UpsertDataHistoryJobResponse) GetMessage() string {
-	if x != nil {
-		return x.Message
-	}
-	return ""
+// Deprecated: Use GetExchangeAssetsRequest.ProtoReflect.Descriptor instead.
+func (*GetExchangeAssetsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{151}
 }
 
-func (x *UpsertDataHistoryJobResponse) GetJobId() string {
+func (x *GetExchangeAssetsRequest) GetExchange() string {
 	if x != nil {
-		return x.JobId
+		return x.Exchange
 	}
 	return ""
 }
 
-type GetDataHistoryJobDetailsRequest struct {
+type GetExchangeAssetsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Nickname      string                 `protobuf:"bytes,2,opt,name=nickname,proto3" json:"nickname,omitempty"`
-	FullDetails   bool                   `protobuf:"varint,3,opt,name=full_details,json=fullDetails,proto3" json:"full_details,omitempty"`
+	Assets        string                 `protobuf:"bytes,1,opt,name=assets,proto3" json:"assets,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetDataHistoryJobDetailsRequest) Reset() {
-	*x = GetDataHistoryJobDetailsRequest{}
-	mi := &file_rpc_proto_msgTypes[154]
+func (x *GetExchangeAssetsResponse) Reset() {
+	*x = GetExchangeAssetsResponse{}
+	mi := &file_rpc_proto_msgTypes[152]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDataHistoryJobDetailsRequest) String() string {
+func (x *GetExchangeAssetsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDataHistoryJobDetailsRequest) ProtoMessage() {}
+func (*GetExchangeAssetsResponse) ProtoMessage() {}
 
-func (x *GetDataHistoryJobDetailsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[154]
+func (x *GetExchangeAssetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[152]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9345,75 +9391,40 @@ func (x *GetDataHistoryJobDetailsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDataHistoryJobDetailsRequest.ProtoReflect.Descriptor instead.
-func (*GetDataHistoryJobDetailsRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{154}
-}
-
-func (x *GetDataHistoryJobDetailsRequest) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+// Deprecated: Use GetExchangeAssetsResponse.ProtoReflect.Descriptor instead.
+func (*GetExchangeAssetsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{152}
 }
 
-func (x *GetDataHistoryJobDetailsRequest) GetNickname() string {
+func (x *GetExchangeAssetsResponse) GetAssets() string {
 	if x != nil {
-		return x.Nickname
+		return x.Assets
 	}
 	return ""
 }
 
-func (x *GetDataHistoryJobDetailsRequest) GetFullDetails() bool {
-	if x != nil {
-		return x.FullDetails
-	}
-	return false
-}
-
-type DataHistoryJob struct {
-	state                    protoimpl.MessageState  `protogen:"open.v1"`
-	Id                       string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Nickname                 string                  `protobuf:"bytes,2,opt,name=nickname,proto3" json:"nickname,omitempty"`
-	Exchange                 string                  `protobuf:"bytes,3,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset                    string                  `protobuf:"bytes,4,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair                     *CurrencyPair           `protobuf:"bytes,5,opt,name=pair,proto3" json:"pair,omitempty"`
-	StartDate                string                  `protobuf:"bytes,6,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
-	EndDate                  string                  `protobuf:"bytes,7,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
-	Interval                 int64                   `protobuf:"varint,8,opt,name=interval,proto3" json:"interval,omitempty"`
-	RequestSizeLimit         uint64                  `protobuf:"varint,9,opt,name=request_size_limit,json=requestSizeLimit,proto3" json:"request_size_limit,omitempty"`
-	MaxRetryAttempts         uint64                  `protobuf:"varint,10,opt,name=max_retry_attempts,json=maxRetryAttempts,proto3" json:"max_retry_attempts,omitempty"`
-	BatchSize                uint64                  `protobuf:"varint,11,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
-	Status                   string                  `protobuf:"bytes,12,opt,name=status,proto3" json:"status,omitempty"`
-	DataType                 string                  `protobuf:"bytes,13,opt,name=data_type,json=dataType,proto3" json:"data_type,omitempty"`
-	ConversionInterval       int64                   `protobuf:"varint,14,opt,name=conversion_interval,json=conversionInterval,proto3" json:"conversion_interval,omitempty"`
-	OverwriteExistingData    bool                    `protobuf:"varint,15,opt,name=overwrite_existing_data,json=overwriteExistingData,proto3" json:"overwrite_existing_data,omitempty"`
-	PrerequisiteJobNickname  string                  `protobuf:"bytes,16,opt,name=prerequisite_job_nickname,json=prerequisiteJobNickname,proto3" json:"prerequisite_job_nickname,omitempty"`
-	DecimalPlaceComparison   uint64                  `protobuf:"varint,17,opt,name=decimal_place_comparison,json=decimalPlaceComparison,proto3" json:"decimal_place_comparison,omitempty"`
-	SecondaryExchangeName    string                  `protobuf:"bytes,18,opt,name=secondary_exchange_name,json=secondaryExchangeName,proto3" json:"secondary_exchange_name,omitempty"`
-	IssueTolerancePercentage float64                 `protobuf:"fixed64,19,opt,name=issue_tolerance_percentage,json=issueTolerancePercentage,proto3" json:"issue_tolerance_percentage,omitempty"`
-	ReplaceOnIssue           bool                    `protobuf:"varint,20,opt,name=replace_on_issue,json=replaceOnIssue,proto3" json:"replace_on_issue,omitempty"`
-	JobResults               []*DataHistoryJobResult `protobuf:"bytes,21,rep,name=job_results,json=jobResults,proto3" json:"job_results,omitempty"`
-	ResultSummaries          []string                `protobuf:"bytes,22,rep,name=result_summaries,json=resultSummaries,proto3" json:"result_summaries,omitempty"`
-	unknownFields            protoimpl.UnknownFields
-	sizeCache                protoimpl.SizeCache
+type WebsocketGetInfoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DataHistoryJob) Reset() {
-	*x = DataHistoryJob{}
-	mi := &file_rpc_proto_msgTypes[155]
+func (x *WebsocketGetInfoRequest) Reset() {
+	*x = WebsocketGetInfoRequest{}
+	mi := &file_rpc_proto_msgTypes[153]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DataHistoryJob) String() string {
+func (x *WebsocketGetInfoRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DataHistoryJob) ProtoMessage() {}
+func (*WebsocketGetInfoRequest) ProtoMessage() {}
 
-func (x *DataHistoryJob) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[155]
+func (x *WebsocketGetInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[153]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9424,190 +9435,183 @@ func (x *DataHistoryJob) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DataHistoryJob.ProtoReflect.Descriptor instead.
-func (*DataHistoryJob) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{155}
+// Deprecated: Use WebsocketGetInfoRequest.ProtoReflect.Descriptor instead.
+func (*WebsocketGetInfoRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{153}
 }
 
-func (x *DataHistoryJob) GetId() string {
+func (x *WebsocketGetInfoRequest) GetExchange() string {
 	if x != nil {
-		return x.Id
+		return x.Exchange
 	}
 	return ""
 }
 
-func (x *DataHistoryJob) GetNickname() string {
-	if x != nil {
-		return x.Nickname
-	}
-	return ""
+type WebsocketGetInfoResponse struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	Exchange               string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Supported              bool                   `protobuf:"varint,2,opt,name=supported,proto3" json:"supported,omitempty"`
+	Enabled                bool                   `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	AuthenticatedSupported bool                   `protobuf:"varint,4,opt,name=authenticated_supported,json=authenticatedSupported,proto3" json:"authenticated_supported,omitempty"`
+	Authenticated          bool                   `protobuf:"varint,5,opt,name=authenticated,proto3" json:"authenticated,omitempty"`
+	RunningUrl             string                 `protobuf:"bytes,6,opt,name=running_url,json=runningUrl,proto3" json:"running_url,omitempty"`
+	ProxyAddress           string                 `protobuf:"bytes,7,opt,name=proxy_address,json=proxyAddress,proto3" json:"proxy_address,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
-func (x *DataHistoryJob) GetExchange() string {
-	if x != nil {
-		return x.Exchange
-	}
-	return ""
+func (x *WebsocketGetInfoResponse) Reset() {
+	*x = WebsocketGetInfoResponse{}
+	mi := &file_rpc_proto_msgTypes[154]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *DataHistoryJob) GetAsset() string {
-	if x != nil {
-		return x.Asset
-	}
-	return ""
+func (x *WebsocketGetInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *DataHistoryJob) GetPair() *CurrencyPair {
+func (*WebsocketGetInfoResponse) ProtoMessage() {}
+
+func (x *WebsocketGetInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[154]
 	if x != nil {
-		return x.Pair
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *DataHistoryJob) GetStartDate() string {
-	if x != nil {
-		return x.StartDate
-	}
-	return ""
+// Deprecated: Use WebsocketGetInfoResponse.ProtoReflect.Descriptor instead.
+func (*WebsocketGetInfoResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{154}
 }
 
-func (x *DataHistoryJob) GetEndDate() string {
+func (x *WebsocketGetInfoResponse) GetExchange() string {
 	if x != nil {
-		return x.EndDate
+		return x.Exchange
 	}
 	return ""
 }
 
-func (x *DataHistoryJob) GetInterval() int64 {
+func (x *WebsocketGetInfoResponse) GetSupported() bool {
 	if x != nil {
-		return x.Interval
+		return x.Supported
 	}
-	return 0
+	return false
 }
 
-func (x *DataHistoryJob) GetRequestSizeLimit() uint64 {
+func (x *WebsocketGetInfoResponse) GetEnabled() bool {
 	if x != nil {
-		return x.RequestSizeLimit
+		return x.Enabled
 	}
-	return 0
+	return false
 }
 
-func (x *DataHistoryJob) GetMaxRetryAttempts() uint64 {
+func (x *WebsocketGetInfoResponse) GetAuthenticatedSupported() bool {
 	if x != nil {
-		return x.MaxRetryAttempts
+		return x.AuthenticatedSupported
 	}
-	return 0
+	return false
 }
 
-func (x *DataHistoryJob) GetBatchSize() uint64 {
+func (x *WebsocketGetInfoResponse) GetAuthenticated() bool {
 	if x != nil {
-		return x.BatchSize
+		return x.Authenticated
 	}
-	return 0
+	return false
 }
 
-func (x *DataHistoryJob) GetStatus() string {
+func (x *WebsocketGetInfoResponse) GetRunningUrl() string {
 	if x != nil {
-		return x.Status
+		return x.RunningUrl
 	}
 	return ""
 }
 
-func (x *DataHistoryJob) GetDataType() string {
+func (x *WebsocketGetInfoResponse) GetProxyAddress() string {
 	if x != nil {
-		return x.DataType
+		return x.ProxyAddress
 	}
 	return ""
 }
 
-func (x *DataHistoryJob) GetConversionInterval() int64 {
-	if x != nil {
-		return x.ConversionInterval
-	}
-	return 0
-}
-
-func (x *DataHistoryJob) GetOverwriteExistingData() bool {
-	if x != nil {
-		return x.OverwriteExistingData
-	}
-	return false
+type WebsocketSetEnabledRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Enable        bool                   `protobuf:"varint,2,opt,name=enable,proto3" json:"enable,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DataHistoryJob) GetPrerequisiteJobNickname() string {
-	if x != nil {
-		return x.PrerequisiteJobNickname
-	}
-	return ""
+func (x *WebsocketSetEnabledRequest) Reset() {
+	*x = WebsocketSetEnabledRequest{}
+	mi := &file_rpc_proto_msgTypes[155]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *DataHistoryJob) GetDecimalPlaceComparison() uint64 {
-	if x != nil {
-		return x.DecimalPlaceComparison
-	}
-	return 0
+func (x *WebsocketSetEnabledRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *DataHistoryJob) GetSecondaryExchangeName() string {
-	if x != nil {
-		return x.SecondaryExchangeName
-	}
-	return ""
-}
+func (*WebsocketSetEnabledRequest) ProtoMessage() {}
 
-func (x *DataHistoryJob) GetIssueTolerancePercentage() float64 {
+func (x *WebsocketSetEnabledRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[155]
 	if x != nil {
-		return x.IssueTolerancePercentage
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *DataHistoryJob) GetReplaceOnIssue() bool {
-	if x != nil {
-		return x.ReplaceOnIssue
-	}
-	return false
+// Deprecated: Use WebsocketSetEnabledRequest.ProtoReflect.Descriptor instead.
+func (*WebsocketSetEnabledRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{155}
 }
 
-func (x *DataHistoryJob) GetJobResults() []*DataHistoryJobResult {
+func (x *WebsocketSetEnabledRequest) GetExchange() string {
 	if x != nil {
-		return x.JobResults
+		return x.Exchange
 	}
-	return nil
+	return ""
 }
 
-func (x *DataHistoryJob) GetResultSummaries() []string {
+func (x *WebsocketSetEnabledRequest) GetEnable() bool {
 	if x != nil {
-		return x.ResultSummaries
+		return x.Enable
 	}
-	return nil
+	return false
 }
 
-type DataHistoryJobResult struct {
+type WebsocketGetSubscriptionsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	StartDate     string                 `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
-	EndDate       string                 `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
-	HasData       bool                   `protobuf:"varint,3,opt,name=has_data,json=hasData,proto3" json:"has_data,omitempty"`
-	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
-	RunDate       string                 `protobuf:"bytes,5,opt,name=run_date,json=runDate,proto3" json:"run_date,omitempty"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DataHistoryJobResult) Reset() {
-	*x = DataHistoryJobResult{}
+func (x *WebsocketGetSubscriptionsRequest) Reset() {
+	*x = WebsocketGetSubscriptionsRequest{}
 	mi := &file_rpc_proto_msgTypes[156]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DataHistoryJobResult) String() string {
+func (x *WebsocketGetSubscriptionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DataHistoryJobResult) ProtoMessage() {}
+func (*WebsocketGetSubscriptionsRequest) ProtoMessage() {}
 
-func (x *DataHistoryJobResult) ProtoReflect() protoreflect.Message {
+func (x *WebsocketGetSubscriptionsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[156]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -9619,67 +9623,42 @@ func (x *DataHistoryJobResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DataHistoryJobResult.ProtoReflect.Descriptor instead.
-func (*DataHistoryJobResult) Descriptor() ([]byte, []int) {
+// Deprecated: Use WebsocketGetSubscriptionsRequest.ProtoReflect.Descriptor instead.
+func (*WebsocketGetSubscriptionsRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{156}
 }
 
-func (x *DataHistoryJobResult) GetStartDate() string {
-	if x != nil {
-		return x.StartDate
-	}
-	return ""
-}
-
-func (x *DataHistoryJobResult) GetEndDate() string {
-	if x != nil {
-		return x.EndDate
-	}
-	return ""
-}
-
-func (x *DataHistoryJobResult) GetHasData() bool {
-	if x != nil {
-		return x.HasData
-	}
-	return false
-}
-
-func (x *DataHistoryJobResult) GetMessage() string {
-	if x != nil {
-		return x.Message
-	}
-	return ""
-}
-
-func (x *DataHistoryJobResult) GetRunDate() string {
+func (x *WebsocketGetSubscriptionsRequest) GetExchange() string {
 	if x != nil {
-		return x.RunDate
+		return x.Exchange
 	}
 	return ""
 }
 
-type DataHistoryJobs struct {
+type WebsocketSubscription struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Results       []*DataHistoryJob      `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Channel       string                 `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	Pairs         string                 `protobuf:"bytes,2,opt,name=pairs,proto3" json:"pairs,omitempty"`
+	Asset         string                 `protobuf:"bytes,3,opt,name=asset,proto3" json:"asset,omitempty"`
+	Params        string                 `protobuf:"bytes,4,opt,name=params,proto3" json:"params,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DataHistoryJobs) Reset() {
-	*x = DataHistoryJobs{}
+func (x *WebsocketSubscription) Reset() {
+	*x = WebsocketSubscription{}
 	mi := &file_rpc_proto_msgTypes[157]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DataHistoryJobs) String() string {
+func (x *WebsocketSubscription) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DataHistoryJobs) ProtoMessage() {}
+func (*WebsocketSubscription) ProtoMessage() {}
 
-func (x *DataHistoryJobs) ProtoReflect() protoreflect.Message {
+func (x *WebsocketSubscription) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[157]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -9691,40 +9670,61 @@ func (x *DataHistoryJobs) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DataHistoryJobs.ProtoReflect.Descriptor instead.
-func (*DataHistoryJobs) Descriptor() ([]byte, []int) {
+// Deprecated: Use WebsocketSubscription.ProtoReflect.Descriptor instead.
+func (*WebsocketSubscription) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{157}
 }
 
-func (x *DataHistoryJobs) GetResults() []*DataHistoryJob {
+func (x *WebsocketSubscription) GetChannel() string {
 	if x != nil {
-		return x.Results
+		return x.Channel
 	}
-	return nil
-}
-
-type GetDataHistoryJobsBetweenRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	StartDate     string                 `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
-	EndDate       string                 `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	return ""
 }
 
-func (x *GetDataHistoryJobsBetweenRequest) Reset() {
-	*x = GetDataHistoryJobsBetweenRequest{}
+func (x *WebsocketSubscription) GetPairs() string {
+	if x != nil {
+		return x.Pairs
+	}
+	return ""
+}
+
+func (x *WebsocketSubscription) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *WebsocketSubscription) GetParams() string {
+	if x != nil {
+		return x.Params
+	}
+	return ""
+}
+
+type WebsocketGetSubscriptionsResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Exchange      string                   `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Subscriptions []*WebsocketSubscription `protobuf:"bytes,2,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebsocketGetSubscriptionsResponse) Reset() {
+	*x = WebsocketGetSubscriptionsResponse{}
 	mi := &file_rpc_proto_msgTypes[158]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDataHistoryJobsBetweenRequest) String() string {
+func (x *WebsocketGetSubscriptionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDataHistoryJobsBetweenRequest) ProtoMessage() {}
+func (*WebsocketGetSubscriptionsResponse) ProtoMessage() {}
 
-func (x *GetDataHistoryJobsBetweenRequest) ProtoReflect() protoreflect.Message {
+func (x *WebsocketGetSubscriptionsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[158]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -9736,48 +9736,47 @@ func (x *GetDataHistoryJobsBetweenRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDataHistoryJobsBetweenRequest.ProtoReflect.Descriptor instead.
-func (*GetDataHistoryJobsBetweenRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use WebsocketGetSubscriptionsResponse.ProtoReflect.Descriptor instead.
+func (*WebsocketGetSubscriptionsResponse) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{158}
 }
 
-func (x *GetDataHistoryJobsBetweenRequest) GetStartDate() string {
+func (x *WebsocketGetSubscriptionsResponse) GetExchange() string {
 	if x != nil {
-		return x.StartDate
+		return x.Exchange
 	}
 	return ""
 }
 
-func (x *GetDataHistoryJobsBetweenRequest) GetEndDate() string {
+func (x *WebsocketGetSubscriptionsResponse) GetSubscriptions() []*WebsocketSubscription {
 	if x != nil {
-		return x.EndDate
+		return x.Subscriptions
 	}
-	return ""
+	return nil
 }
 
-type SetDataHistoryJobStatusRequest struct {
+type WebsocketSetProxyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Nickname      string                 `protobuf:"bytes,2,opt,name=nickname,proto3" json:"nickname,omitempty"`
-	Status        int64                  `protobuf:"varint,3,opt,name=status,proto3" json:"status,omitempty"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Proxy         string                 `protobuf:"bytes,2,opt,name=proxy,proto3" json:"proxy,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetDataHistoryJobStatusRequest) Reset() {
-	*x = SetDataHistoryJobStatusRequest{}
+func (x *WebsocketSetProxyRequest) Reset() {
+	*x = WebsocketSetProxyRequest{}
 	mi := &file_rpc_proto_msgTypes[159]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetDataHistoryJobStatusRequest) String() string {
+func (x *WebsocketSetProxyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetDataHistoryJobStatusRequest) ProtoMessage() {}
+func (*WebsocketSetProxyRequest) ProtoMessage() {}
 
-func (x *SetDataHistoryJobStatusRequest) ProtoReflect() protoreflect.Message {
+func (x *WebsocketSetProxyRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[159]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -9789,54 +9788,47 @@ func (x *SetDataHistoryJobStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetDataHistoryJobStatusRequest.ProtoReflect.Descriptor instead.
-func (*SetDataHistoryJobStatusRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use WebsocketSetProxyRequest.ProtoReflect.Descriptor instead.
+func (*WebsocketSetProxyRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{159}
 }
 
-func (x *SetDataHistoryJobStatusRequest) GetId() string {
+func (x *WebsocketSetProxyRequest) GetExchange() string {
 	if x != nil {
-		return x.Id
+		return x.Exchange
 	}
 	return ""
 }
 
-func (x *SetDataHistoryJobStatusRequest) GetNickname() string {
+func (x *WebsocketSetProxyRequest) GetProxy() string {
 	if x != nil {
-		return x.Nickname
+		return x.Proxy
 	}
 	return ""
 }
 
-func (x *SetDataHistoryJobStatusRequest) GetStatus() int64 {
-	if x != nil {
-		return x.Status
-	}
-	return 0
-}
-
-type UpdateDataHistoryJobPrerequisiteRequest struct {
-	state                   protoimpl.MessageState `protogen:"open.v1"`
-	Nickname                string                 `protobuf:"bytes,1,opt,name=nickname,proto3" json:"nickname,omitempty"`
-	PrerequisiteJobNickname string                 `protobuf:"bytes,2,opt,name=prerequisite_job_nickname,json=prerequisiteJobNickname,proto3" json:"prerequisite_job_nickname,omitempty"`
-	unknownFields           protoimpl.UnknownFields
-	sizeCache               protoimpl.SizeCache
+type WebsocketSetURLRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateDataHistoryJobPrerequisiteRequest) Reset() {
-	*x = UpdateDataHistoryJobPrerequisiteRequest{}
+func (x *WebsocketSetURLRequest) Reset() {
+	*x = WebsocketSetURLRequest{}
 	mi := &file_rpc_proto_msgTypes[160]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateDataHistoryJobPrerequisiteRequest) String() string {
+func (x *WebsocketSetURLRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateDataHistoryJobPrerequisiteRequest) ProtoMessage() {}
+func (*WebsocketSetURLRequest) ProtoMessage() {}
 
-func (x *UpdateDataHistoryJobPrerequisiteRequest) ProtoReflect() protoreflect.Message {
+func (x *WebsocketSetURLRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[160]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -9848,51 +9840,51 @@ func (x *UpdateDataHistoryJobPrerequisiteRequest) ProtoReflect() protoreflect.Me
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateDataHistoryJobPrerequisiteRequest.ProtoReflect.Descriptor instead.
-func (*UpdateDataHistoryJobPrerequisiteRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use WebsocketSetURLRequest.ProtoReflect.Descriptor instead.
+func (*WebsocketSetURLRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{160}
 }
 
-func (x *UpdateDataHistoryJobPrerequisiteRequest) GetNickname() string {
+func (x *WebsocketSetURLRequest) GetExchange() string {
 	if x != nil {
-		return x.Nickname
+		return x.Exchange
 	}
 	return ""
 }
 
-func (x *UpdateDataHistoryJobPrerequisiteRequest) GetPrerequisiteJobNickname() string {
+func (x *WebsocketSetURLRequest) GetUrl() string {
 	if x != nil {
-		return x.PrerequisiteJobNickname
+		return x.Url
 	}
 	return ""
 }
 
-type ModifyOrderRequest struct {
+type FindMissingCandlePeriodsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	OrderId       string                 `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	ExchangeName  string                 `protobuf:"bytes,1,opt,name=exchange_name,json=exchangeName,proto3" json:"exchange_name,omitempty"`
+	AssetType     string                 `protobuf:"bytes,2,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
 	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	Asset         string                 `protobuf:"bytes,4,opt,name=asset,proto3" json:"asset,omitempty"`
-	Amount        float64                `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount,omitempty"`
-	Price         float64                `protobuf:"fixed64,6,opt,name=price,proto3" json:"price,omitempty"`
+	Interval      int64                  `protobuf:"varint,4,opt,name=interval,proto3" json:"interval,omitempty"`
+	Start         string                 `protobuf:"bytes,5,opt,name=start,proto3" json:"start,omitempty"`
+	End           string                 `protobuf:"bytes,6,opt,name=end,proto3" json:"end,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ModifyOrderRequest) Reset() {
-	*x = ModifyOrderRequest{}
+func (x *FindMissingCandlePeriodsRequest) Reset() {
+	*x = FindMissingCandlePeriodsRequest{}
 	mi := &file_rpc_proto_msgTypes[161]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ModifyOrderRequest) String() string {
+func (x *FindMissingCandlePeriodsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ModifyOrderRequest) ProtoMessage() {}
+func (*FindMissingCandlePeriodsRequest) ProtoMessage() {}
 
-func (x *ModifyOrderRequest) ProtoReflect() protoreflect.Message {
+func (x *FindMissingCandlePeriodsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[161]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -9904,74 +9896,78 @@ func (x *ModifyOrderRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ModifyOrderRequest.ProtoReflect.Descriptor instead.
-func (*ModifyOrderRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use FindMissingCandlePeriodsRequest.ProtoReflect.Descriptor instead.
+func (*FindMissingCandlePeriodsRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{161}
 }
 
-func (x *ModifyOrderRequest) GetExchange() string {
+func (x *FindMissingCandlePeriodsRequest) GetExchangeName() string {
 	if x != nil {
-		return x.Exchange
+		return x.ExchangeName
 	}
 	return ""
 }
 
-func (x *ModifyOrderRequest) GetOrderId() string {
+func (x *FindMissingCandlePeriodsRequest) GetAssetType() string {
 	if x != nil {
-		return x.OrderId
+		return x.AssetType
 	}
 	return ""
 }
 
-func (x *ModifyOrderRequest) GetPair() *CurrencyPair {
+func (x *FindMissingCandlePeriodsRequest) GetPair() *CurrencyPair {
 	if x != nil {
 		return x.Pair
 	}
 	return nil
 }
 
-func (x *ModifyOrderRequest) GetAsset() string {
+func (x *FindMissingCandlePeriodsRequest) GetInterval() int64 {
 	if x != nil {
-		return x.Asset
+		return x.Interval
 	}
-	return ""
+	return 0
 }
 
-func (x *ModifyOrderRequest) GetAmount() float64 {
+func (x *FindMissingCandlePeriodsRequest) GetStart() string {
 	if x != nil {
-		return x.Amount
+		return x.Start
 	}
-	return 0
+	return ""
 }
 
-func (x *ModifyOrderRequest) GetPrice() float64 {
+func (x *FindMissingCandlePeriodsRequest) GetEnd() string {
 	if x != nil {
-		return x.Price
+		return x.End
 	}
-	return 0
+	return ""
 }
 
-type ModifyOrderResponse struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	ModifiedOrderId string                 `protobuf:"bytes,1,opt,name=modified_order_id,json=modifiedOrderId,proto3" json:"modified_order_id,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type FindMissingTradePeriodsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExchangeName  string                 `protobuf:"bytes,1,opt,name=exchange_name,json=exchangeName,proto3" json:"exchange_name,omitempty"`
+	AssetType     string                 `protobuf:"bytes,2,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	Start         string                 `protobuf:"bytes,4,opt,name=start,proto3" json:"start,omitempty"`
+	End           string                 `protobuf:"bytes,5,opt,name=end,proto3" json:"end,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ModifyOrderResponse) Reset() {
-	*x = ModifyOrderResponse{}
+func (x *FindMissingTradePeriodsRequest) Reset() {
+	*x = FindMissingTradePeriodsRequest{}
 	mi := &file_rpc_proto_msgTypes[162]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ModifyOrderResponse) String() string {
+func (x *FindMissingTradePeriodsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ModifyOrderResponse) ProtoMessage() {}
+func (*FindMissingTradePeriodsRequest) ProtoMessage() {}
 
-func (x *ModifyOrderResponse) ProtoReflect() protoreflect.Message {
+func (x *FindMissingTradePeriodsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[162]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -9983,86 +9979,72 @@ func (x *ModifyOrderResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ModifyOrderResponse.ProtoReflect.Descriptor instead.
-func (*ModifyOrderResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use FindMissingTradePeriodsRequest.ProtoReflect.Descriptor instead.
+func (*FindMissingTradePeriodsRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{162}
 }
 
-func (x *ModifyOrderResponse) GetModifiedOrderId() string {
+func (x *FindMissingTradePeriodsRequest) GetExchangeName() string {
 	if x != nil {
-		return x.ModifiedOrderId
+		return x.ExchangeName
 	}
 	return ""
 }
 
-type CurrencyStateGetAllRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *CurrencyStateGetAllRequest) Reset() {
-	*x = CurrencyStateGetAllRequest{}
-	mi := &file_rpc_proto_msgTypes[163]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *CurrencyStateGetAllRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *FindMissingTradePeriodsRequest) GetAssetType() string {
+	if x != nil {
+		return x.AssetType
+	}
+	return ""
 }
 
-func (*CurrencyStateGetAllRequest) ProtoMessage() {}
-
-func (x *CurrencyStateGetAllRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[163]
+func (x *FindMissingTradePeriodsRequest) GetPair() *CurrencyPair {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Pair
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use CurrencyStateGetAllRequest.ProtoReflect.Descriptor instead.
-func (*CurrencyStateGetAllRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{163}
+func (x *FindMissingTradePeriodsRequest) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
 }
 
-func (x *CurrencyStateGetAllRequest) GetExchange() string {
+func (x *FindMissingTradePeriodsRequest) GetEnd() string {
 	if x != nil {
-		return x.Exchange
+		return x.End
 	}
 	return ""
 }
 
-type CurrencyStateTradingRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
-	Asset         string                 `protobuf:"bytes,3,opt,name=asset,proto3" json:"asset,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type FindMissingIntervalsResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ExchangeName   string                 `protobuf:"bytes,1,opt,name=exchange_name,json=exchangeName,proto3" json:"exchange_name,omitempty"`
+	AssetType      string                 `protobuf:"bytes,2,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Pair           *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	MissingPeriods []string               `protobuf:"bytes,4,rep,name=missing_periods,json=missingPeriods,proto3" json:"missing_periods,omitempty"`
+	Status         string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *CurrencyStateTradingRequest) Reset() {
-	*x = CurrencyStateTradingRequest{}
-	mi := &file_rpc_proto_msgTypes[164]
+func (x *FindMissingIntervalsResponse) Reset() {
+	*x = FindMissingIntervalsResponse{}
+	mi := &file_rpc_proto_msgTypes[163]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CurrencyStateTradingRequest) String() string {
+func (x *FindMissingIntervalsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CurrencyStateTradingRequest) ProtoMessage() {}
+func (*FindMissingIntervalsResponse) ProtoMessage() {}
 
-func (x *CurrencyStateTradingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[164]
+func (x *FindMissingIntervalsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[163]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10073,56 +10055,69 @@ func (x *CurrencyStateTradingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CurrencyStateTradingRequest.ProtoReflect.Descriptor instead.
-func (*CurrencyStateTradingRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{164}
+// Deprecated: Use FindMissingIntervalsResponse.ProtoReflect.Descriptor instead.
+func (*FindMissingIntervalsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{163}
 }
 
-func (x *CurrencyStateTradingRequest) GetExchange() string {
+func (x *FindMissingIntervalsResponse) GetExchangeName() string {
 	if x != nil {
-		return x.Exchange
+		return x.ExchangeName
 	}
 	return ""
 }
 
-func (x *CurrencyStateTradingRequest) GetCode() string {
+func (x *FindMissingIntervalsResponse) GetAssetType() string {
 	if x != nil {
-		return x.Code
+		return x.AssetType
 	}
 	return ""
 }
 
-func (x *CurrencyStateTradingRequest) GetAsset() string {
+func (x *FindMissingIntervalsResponse) GetPair() *CurrencyPair {
 	if x != nil {
-		return x.Asset
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *FindMissingIntervalsResponse) GetMissingPeriods() []string {
+	if x != nil {
+		return x.MissingPeriods
+	}
+	return nil
+}
+
+func (x *FindMissingIntervalsResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
 	}
 	return ""
 }
 
-type CurrencyStateTradingPairRequest struct {
+type SetExchangeTradeProcessingRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Pair          string                 `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
-	Asset         string                 `protobuf:"bytes,3,opt,name=asset,proto3" json:"asset,omitempty"`
+	Status        bool                   `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CurrencyStateTradingPairRequest) Reset() {
-	*x = CurrencyStateTradingPairRequest{}
-	mi := &file_rpc_proto_msgTypes[165]
+func (x *SetExchangeTradeProcessingRequest) Reset() {
+	*x = SetExchangeTradeProcessingRequest{}
+	mi := &file_rpc_proto_msgTypes[164]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CurrencyStateTradingPairRequest) String() string {
+func (x *SetExchangeTradeProcessingRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CurrencyStateTradingPairRequest) ProtoMessage() {}
+func (*SetExchangeTradeProcessingRequest) ProtoMessage() {}
 
-func (x *CurrencyStateTradingPairRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[165]
+func (x *SetExchangeTradeProcessingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[164]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10133,56 +10128,65 @@ func (x *CurrencyStateTradingPairRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CurrencyStateTradingPairRequest.ProtoReflect.Descriptor instead.
-func (*CurrencyStateTradingPairRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{165}
+// Deprecated: Use SetExchangeTradeProcessingRequest.ProtoReflect.Descriptor instead.
+func (*SetExchangeTradeProcessingRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{164}
 }
 
-func (x *CurrencyStateTradingPairRequest) GetExchange() string {
+func (x *SetExchangeTradeProcessingRequest) GetExchange() string {
 	if x != nil {
 		return x.Exchange
 	}
 	return ""
 }
 
-func (x *CurrencyStateTradingPairRequest) GetPair() string {
-	if x != nil {
-		return x.Pair
-	}
-	return ""
-}
-
-func (x *CurrencyStateTradingPairRequest) GetAsset() string {
+func (x *SetExchangeTradeProcessingRequest) GetStatus() bool {
 	if x != nil {
-		return x.Asset
+		return x.Status
 	}
-	return ""
+	return false
 }
 
-type CurrencyStateWithdrawRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
-	Asset         string                 `protobuf:"bytes,3,opt,name=asset,proto3" json:"asset,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type UpsertDataHistoryJobRequest struct {
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	Nickname                 string                 `protobuf:"bytes,1,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	Exchange                 string                 `protobuf:"bytes,2,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset                    string                 `protobuf:"bytes,3,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair                     *CurrencyPair          `protobuf:"bytes,4,opt,name=pair,proto3" json:"pair,omitempty"`
+	StartDate                string                 `protobuf:"bytes,5,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate                  string                 `protobuf:"bytes,6,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Interval                 int64                  `protobuf:"varint,7,opt,name=interval,proto3" json:"interval,omitempty"`
+	RequestSizeLimit         uint64                 `protobuf:"varint,8,opt,name=request_size_limit,json=requestSizeLimit,proto3" json:"request_size_limit,omitempty"`
+	DataType                 int64                  `protobuf:"varint,9,opt,name=data_type,json=dataType,proto3" json:"data_type,omitempty"`
+	MaxRetryAttempts         uint64                 `protobuf:"varint,10,opt,name=max_retry_attempts,json=maxRetryAttempts,proto3" json:"max_retry_attempts,omitempty"`
+	BatchSize                uint64                 `protobuf:"varint,11,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	InsertOnly               bool                   `protobuf:"varint,12,opt,name=insert_only,json=insertOnly,proto3" json:"insert_only,omitempty"`
+	ConversionInterval       int64                  `protobuf:"varint,13,opt,name=conversion_interval,json=conversionInterval,proto3" json:"conversion_interval,omitempty"`
+	OverwriteExistingData    bool                   `protobuf:"varint,14,opt,name=overwrite_existing_data,json=overwriteExistingData,proto3" json:"overwrite_existing_data,omitempty"`
+	PrerequisiteJobNickname  string                 `protobuf:"bytes,15,opt,name=prerequisite_job_nickname,json=prerequisiteJobNickname,proto3" json:"prerequisite_job_nickname,omitempty"`
+	DecimalPlaceComparison   uint64                 `protobuf:"varint,16,opt,name=decimal_place_comparison,json=decimalPlaceComparison,proto3" json:"decimal_place_comparison,omitempty"`
+	SecondaryExchangeName    string                 `protobuf:"bytes,17,opt,name=secondary_exchange_name,json=secondaryExchangeName,proto3" json:"secondary_exchange_name,omitempty"`
+	IssueTolerancePercentage float64                `protobuf:"fixed64,18,opt,name=issue_tolerance_percentage,json=issueTolerancePercentage,proto3" json:"issue_tolerance_percentage,omitempty"`
+	ReplaceOnIssue           bool                   `protobuf:"varint,19,opt,name=replace_on_issue,json=replaceOnIssue,proto3" json:"replace_on_issue,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
 }
 
-func (x *CurrencyStateWithdrawRequest) Reset() {
-	*x = CurrencyStateWithdrawRequest{}
-	mi := &file_rpc_proto_msgTypes[166]
+func (x *UpsertDataHistoryJobRequest) Reset() {
+	*x = UpsertDataHistoryJobRequest{}
+	mi := &file_rpc_proto_msgTypes[165]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CurrencyStateWithdrawRequest) String() string {
+func (x *UpsertDataHistoryJobRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CurrencyStateWithdrawRequest) ProtoMessage() {}
+func (*UpsertDataHistoryJobRequest) ProtoMessage() {}
 
-func (x *CurrencyStateWithdrawRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[166]
+func (x *UpsertDataHistoryJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[165]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10193,162 +10197,166 @@ func (x *CurrencyStateWithdrawRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CurrencyStateWithdrawRequest.ProtoReflect.Descriptor instead.
-func (*CurrencyStateWithdrawRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{166}
+// Deprecated: Use UpsertDataHistoryJobRequest.ProtoReflect.Descriptor instead.
+func (*UpsertDataHistoryJobRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{165}
 }
 
-func (x *CurrencyStateWithdrawRequest) GetExchange() string {
+func (x *UpsertDataHistoryJobRequest) GetNickname() string {
 	if x != nil {
-		return x.Exchange
+		return x.Nickname
 	}
 	return ""
 }
 
-func (x *CurrencyStateWithdrawRequest) GetCode() string {
+func (x *UpsertDataHistoryJobRequest) GetExchange() string {
 	if x != nil {
-		return x.Code
+		return x.Exchange
 	}
 	return ""
 }
 
-func (x *CurrencyStateWithdrawRequest) GetAsset() string {
+func (x *UpsertDataHistoryJobRequest) GetAsset() string {
 	if x != nil {
 		return x.Asset
 	}
 	return ""
 }
 
-type CurrencyStateDepositRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
-	Asset         string                 `protobuf:"bytes,3,opt,name=asset,proto3" json:"asset,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *UpsertDataHistoryJobRequest) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
 }
 
-func (x *CurrencyStateDepositRequest) Reset() {
-	*x = CurrencyStateDepositRequest{}
-	mi := &file_rpc_proto_msgTypes[167]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *UpsertDataHistoryJobRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
 }
 
-func (x *CurrencyStateDepositRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *UpsertDataHistoryJobRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
 }
 
-func (*CurrencyStateDepositRequest) ProtoMessage() {}
-
-func (x *CurrencyStateDepositRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[167]
+func (x *UpsertDataHistoryJobRequest) GetInterval() int64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Interval
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use CurrencyStateDepositRequest.ProtoReflect.Descriptor instead.
-func (*CurrencyStateDepositRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{167}
+func (x *UpsertDataHistoryJobRequest) GetRequestSizeLimit() uint64 {
+	if x != nil {
+		return x.RequestSizeLimit
+	}
+	return 0
 }
 
-func (x *CurrencyStateDepositRequest) GetExchange() string {
+func (x *UpsertDataHistoryJobRequest) GetDataType() int64 {
 	if x != nil {
-		return x.Exchange
+		return x.DataType
 	}
-	return ""
+	return 0
 }
 
-func (x *CurrencyStateDepositRequest) GetCode() string {
+func (x *UpsertDataHistoryJobRequest) GetMaxRetryAttempts() uint64 {
 	if x != nil {
-		return x.Code
+		return x.MaxRetryAttempts
 	}
-	return ""
+	return 0
 }
 
-func (x *CurrencyStateDepositRequest) GetAsset() string {
+func (x *UpsertDataHistoryJobRequest) GetBatchSize() uint64 {
 	if x != nil {
-		return x.Asset
+		return x.BatchSize
 	}
-	return ""
+	return 0
 }
 
-type CurrencyStateResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	CurrencyStates []*CurrencyState       `protobuf:"bytes,1,rep,name=currency_states,json=currencyStates,proto3" json:"currency_states,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *UpsertDataHistoryJobRequest) GetInsertOnly() bool {
+	if x != nil {
+		return x.InsertOnly
+	}
+	return false
 }
 
-func (x *CurrencyStateResponse) Reset() {
-	*x = CurrencyStateResponse{}
-	mi := &file_rpc_proto_msgTypes[168]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *UpsertDataHistoryJobRequest) GetConversionInterval() int64 {
+	if x != nil {
+		return x.ConversionInterval
+	}
+	return 0
 }
 
-func (x *CurrencyStateResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *UpsertDataHistoryJobRequest) GetOverwriteExistingData() bool {
+	if x != nil {
+		return x.OverwriteExistingData
+	}
+	return false
 }
 
-func (*CurrencyStateResponse) ProtoMessage() {}
-
-func (x *CurrencyStateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[168]
+func (x *UpsertDataHistoryJobRequest) GetPrerequisiteJobNickname() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.PrerequisiteJobNickname
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use CurrencyStateResponse.ProtoReflect.Descriptor instead.
-func (*CurrencyStateResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{168}
+func (x *UpsertDataHistoryJobRequest) GetDecimalPlaceComparison() uint64 {
+	if x != nil {
+		return x.DecimalPlaceComparison
+	}
+	return 0
 }
 
-func (x *CurrencyStateResponse) GetCurrencyStates() []*CurrencyState {
+func (x *UpsertDataHistoryJobRequest) GetSecondaryExchangeName() string {
 	if x != nil {
-		return x.CurrencyStates
+		return x.SecondaryExchangeName
 	}
-	return nil
+	return ""
 }
 
-type CurrencyState struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Currency        string                 `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
-	Asset           string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	WithdrawEnabled bool                   `protobuf:"varint,3,opt,name=withdraw_enabled,json=withdrawEnabled,proto3" json:"withdraw_enabled,omitempty"`
-	DepositEnabled  bool                   `protobuf:"varint,4,opt,name=deposit_enabled,json=depositEnabled,proto3" json:"deposit_enabled,omitempty"`
-	TradingEnabled  bool                   `protobuf:"varint,5,opt,name=trading_enabled,json=tradingEnabled,proto3" json:"trading_enabled,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *UpsertDataHistoryJobRequest) GetIssueTolerancePercentage() float64 {
+	if x != nil {
+		return x.IssueTolerancePercentage
+	}
+	return 0
 }
 
-func (x *CurrencyState) Reset() {
-	*x = CurrencyState{}
-	mi := &file_rpc_proto_msgTypes[169]
+func (x *UpsertDataHistoryJobRequest) GetReplaceOnIssue() bool {
+	if x != nil {
+		return x.ReplaceOnIssue
+	}
+	return false
+}
+
+type InsertSequentialJobsRequest struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Jobs          []*UpsertDataHistoryJobRequest `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InsertSequentialJobsRequest) Reset() {
+	*x = InsertSequentialJobsRequest{}
+	mi := &file_rpc_proto_msgTypes[166]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CurrencyState) String() string {
+func (x *InsertSequentialJobsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CurrencyState) ProtoMessage() {}
+func (*InsertSequentialJobsRequest) ProtoMessage() {}
 
-func (x *CurrencyState) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[169]
+func (x *InsertSequentialJobsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[166]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10359,70 +10367,85 @@ func (x *CurrencyState) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CurrencyState.ProtoReflect.Descriptor instead.
-func (*CurrencyState) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{169}
+// Deprecated: Use InsertSequentialJobsRequest.ProtoReflect.Descriptor instead.
+func (*InsertSequentialJobsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{166}
 }
 
-func (x *CurrencyState) GetCurrency() string {
+func (x *InsertSequentialJobsRequest) GetJobs() []*UpsertDataHistoryJobRequest {
 	if x != nil {
-		return x.Currency
+		return x.Jobs
 	}
-	return ""
+	return nil
 }
 
-func (x *CurrencyState) GetAsset() string {
-	if x != nil {
-		return x.Asset
-	}
-	return ""
+type InsertSequentialJobsResponse struct {
+	state         protoimpl.MessageState          `protogen:"open.v1"`
+	Jobs          []*UpsertDataHistoryJobResponse `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CurrencyState) GetWithdrawEnabled() bool {
-	if x != nil {
-		return x.WithdrawEnabled
-	}
-	return false
+func (x *InsertSequentialJobsResponse) Reset() {
+	*x = InsertSequentialJobsResponse{}
+	mi := &file_rpc_proto_msgTypes[167]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *CurrencyState) GetDepositEnabled() bool {
+func (x *InsertSequentialJobsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InsertSequentialJobsResponse) ProtoMessage() {}
+
+func (x *InsertSequentialJobsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[167]
 	if x != nil {
-		return x.DepositEnabled
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *CurrencyState) GetTradingEnabled() bool {
+// Deprecated: Use InsertSequentialJobsResponse.ProtoReflect.Descriptor instead.
+func (*InsertSequentialJobsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{167}
+}
+
+func (x *InsertSequentialJobsResponse) GetJobs() []*UpsertDataHistoryJobResponse {
 	if x != nil {
-		return x.TradingEnabled
+		return x.Jobs
 	}
-	return false
+	return nil
 }
 
-type FundingRate struct {
+type UpsertDataHistoryJobResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
-	Rate          string                 `protobuf:"bytes,2,opt,name=rate,proto3" json:"rate,omitempty"`
-	Payment       string                 `protobuf:"bytes,3,opt,name=payment,proto3" json:"payment,omitempty"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	JobId         string                 `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FundingRate) Reset() {
-	*x = FundingRate{}
-	mi := &file_rpc_proto_msgTypes[170]
+func (x *UpsertDataHistoryJobResponse) Reset() {
+	*x = UpsertDataHistoryJobResponse{}
+	mi := &file_rpc_proto_msgTypes[168]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FundingRate) String() string {
+func (x *UpsertDataHistoryJobResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FundingRate) ProtoMessage() {}
+func (*UpsertDataHistoryJobResponse) ProtoMessage() {}
 
-func (x *FundingRate) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[170]
+func (x *UpsertDataHistoryJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[168]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10433,65 +10456,49 @@ func (x *FundingRate) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FundingRate.ProtoReflect.Descriptor instead.
-func (*FundingRate) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{170}
-}
-
-func (x *FundingRate) GetDate() string {
-	if x != nil {
-		return x.Date
-	}
-	return ""
+// Deprecated: Use UpsertDataHistoryJobResponse.ProtoReflect.Descriptor instead.
+func (*UpsertDataHistoryJobResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{168}
 }
 
-func (x *FundingRate) GetRate() string {
+func (x *UpsertDataHistoryJobResponse) GetMessage() string {
 	if x != nil {
-		return x.Rate
+		return x.Message
 	}
 	return ""
 }
 
-func (x *FundingRate) GetPayment() string {
+func (x *UpsertDataHistoryJobResponse) GetJobId() string {
 	if x != nil {
-		return x.Payment
+		return x.JobId
 	}
 	return ""
 }
 
-type FundingData struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Exchange        string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset           string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair            *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	PaymentCurrency string                 `protobuf:"bytes,4,opt,name=payment_currency,json=paymentCurrency,proto3" json:"payment_currency,omitempty"`
-	StartDate       string                 `protobuf:"bytes,5,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
-	EndDate         string                 `protobuf:"bytes,6,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
-	Rates           []*FundingRate         `protobuf:"bytes,7,rep,name=rates,proto3" json:"rates,omitempty"`
-	LatestRate      *FundingRate           `protobuf:"bytes,8,opt,name=latest_rate,json=latestRate,proto3" json:"latest_rate,omitempty"`
-	UpcomingRate    *FundingRate           `protobuf:"bytes,9,opt,name=upcoming_rate,json=upcomingRate,proto3" json:"upcoming_rate,omitempty"`
-	PaymentSum      string                 `protobuf:"bytes,10,opt,name=payment_sum,json=paymentSum,proto3" json:"payment_sum,omitempty"`
-	PaymentMessage  string                 `protobuf:"bytes,11,opt,name=payment_message,json=paymentMessage,proto3" json:"payment_message,omitempty"`
-	TimeOfNextRate  string                 `protobuf:"bytes,12,opt,name=time_of_next_rate,json=timeOfNextRate,proto3" json:"time_of_next_rate,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type GetDataHistoryJobDetailsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Nickname      string                 `protobuf:"bytes,2,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	FullDetails   bool                   `protobuf:"varint,3,opt,name=full_details,json=fullDetails,proto3" json:"full_details,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FundingData) Reset() {
-	*x = FundingData{}
-	mi := &file_rpc_proto_msgTypes[171]
+func (x *GetDataHistoryJobDetailsRequest) Reset() {
+	*x = GetDataHistoryJobDetailsRequest{}
+	mi := &file_rpc_proto_msgTypes[169]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FundingData) String() string {
+func (x *GetDataHistoryJobDetailsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FundingData) ProtoMessage() {}
+func (*GetDataHistoryJobDetailsRequest) ProtoMessage() {}
 
-func (x *FundingData) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[171]
+func (x *GetDataHistoryJobDetailsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[169]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10502,143 +10509,75 @@ func (x *FundingData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FundingData.ProtoReflect.Descriptor instead.
-func (*FundingData) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{171}
-}
-
-func (x *FundingData) GetExchange() string {
-	if x != nil {
-		return x.Exchange
-	}
-	return ""
-}
-
-func (x *FundingData) GetAsset() string {
-	if x != nil {
-		return x.Asset
-	}
-	return ""
-}
-
-func (x *FundingData) GetPair() *CurrencyPair {
-	if x != nil {
-		return x.Pair
-	}
-	return nil
-}
-
-func (x *FundingData) GetPaymentCurrency() string {
-	if x != nil {
-		return x.PaymentCurrency
-	}
-	return ""
+// Deprecated: Use GetDataHistoryJobDetailsRequest.ProtoReflect.Descriptor instead.
+func (*GetDataHistoryJobDetailsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{169}
 }
 
-func (x *FundingData) GetStartDate() string {
+func (x *GetDataHistoryJobDetailsRequest) GetId() string {
 	if x != nil {
-		return x.StartDate
+		return x.Id
 	}
 	return ""
 }
 
-func (x *FundingData) GetEndDate() string {
+func (x *GetDataHistoryJobDetailsRequest) GetNickname() string {
 	if x != nil {
-		return x.EndDate
+		return x.Nickname
 	}
 	return ""
 }
 
-func (x *FundingData) GetRates() []*FundingRate {
+func (x *GetDataHistoryJobDetailsRequest) GetFullDetails() bool {
 	if x != nil {
-		return x.Rates
+		return x.FullDetails
 	}
-	return nil
+	return false
 }
 
-func (x *FundingData) GetLatestRate() *FundingRate {
-	if x != nil {
-		return x.LatestRate
-	}
-	return nil
-}
-
-func (x *FundingData) GetUpcomingRate() *FundingRate {
-	if x != nil {
-		return x.UpcomingRate
-	}
-	return nil
-}
-
-func (x *FundingData) GetPaymentSum() string {
-	if x != nil {
-		return x.PaymentSum
-	}
-	return ""
-}
-
-func (x *FundingData) GetPaymentMessage() string {
-	if x != nil {
-		return x.PaymentMessage
-	}
-	return ""
-}
-
-func (x *FundingData) GetTimeOfNextRate() string {
-	if x != nil {
-		return x.TimeOfNextRate
-	}
-	return ""
-}
-
-type FuturesPositionStats struct {
-	state                        protoimpl.MessageState `protogen:"open.v1"`
-	MaintenanceMarginRequirement string                 `protobuf:"bytes,1,opt,name=maintenance_margin_requirement,json=maintenanceMarginRequirement,proto3" json:"maintenance_margin_requirement,omitempty"`
-	InitialMarginRequirement     string                 `protobuf:"bytes,2,opt,name=initial_margin_requirement,json=initialMarginRequirement,proto3" json:"initial_margin_requirement,omitempty"`
-	EstimatedLiquidationPrice    string                 `protobuf:"bytes,3,opt,name=estimated_liquidation_price,json=estimatedLiquidationPrice,proto3" json:"estimated_liquidation_price,omitempty"`
-	CollateralUsed               string                 `protobuf:"bytes,4,opt,name=collateral_used,json=collateralUsed,proto3" json:"collateral_used,omitempty"`
-	MarkPrice                    string                 `protobuf:"bytes,5,opt,name=mark_price,json=markPrice,proto3" json:"mark_price,omitempty"`
-	CurrentSize                  string                 `protobuf:"bytes,6,opt,name=current_size,json=currentSize,proto3" json:"current_size,omitempty"`
-	ContractSize                 string                 `protobuf:"bytes,7,opt,name=contract_size,json=contractSize,proto3" json:"contract_size,omitempty"`
-	ContractMultiplier           string                 `protobuf:"bytes,8,opt,name=contract_multiplier,json=contractMultiplier,proto3" json:"contract_multiplier,omitempty"`
-	ContractSettlementType       string                 `protobuf:"bytes,9,opt,name=contract_settlement_type,json=contractSettlementType,proto3" json:"contract_settlement_type,omitempty"`
-	BreakEvenPrice               string                 `protobuf:"bytes,10,opt,name=break_even_price,json=breakEvenPrice,proto3" json:"break_even_price,omitempty"`
-	AverageOpenPrice             string                 `protobuf:"bytes,11,opt,name=average_open_price,json=averageOpenPrice,proto3" json:"average_open_price,omitempty"`
-	RecentPnl                    string                 `protobuf:"bytes,12,opt,name=recent_pnl,json=recentPnl,proto3" json:"recent_pnl,omitempty"`
-	MarginFraction               string                 `protobuf:"bytes,13,opt,name=margin_fraction,json=marginFraction,proto3" json:"margin_fraction,omitempty"`
-	FreeCollateral               string                 `protobuf:"bytes,14,opt,name=free_collateral,json=freeCollateral,proto3" json:"free_collateral,omitempty"`
-	TotalCollateral              string                 `protobuf:"bytes,15,opt,name=total_collateral,json=totalCollateral,proto3" json:"total_collateral,omitempty"`
-	FrozenBalance                string                 `protobuf:"bytes,16,opt,name=frozen_balance,json=frozenBalance,proto3" json:"frozen_balance,omitempty"`
-	EquityOfCurrency             string                 `protobuf:"bytes,17,opt,name=equity_of_currency,json=equityOfCurrency,proto3" json:"equity_of_currency,omitempty"`
-	AvailableEquity              string                 `protobuf:"bytes,18,opt,name=available_equity,json=availableEquity,proto3" json:"available_equity,omitempty"`
-	CashBalance                  string                 `protobuf:"bytes,19,opt,name=cash_balance,json=cashBalance,proto3" json:"cash_balance,omitempty"`
-	DiscountEquity               string                 `protobuf:"bytes,20,opt,name=discount_equity,json=discountEquity,proto3" json:"discount_equity,omitempty"`
-	EquityUsd                    string                 `protobuf:"bytes,21,opt,name=equity_usd,json=equityUsd,proto3" json:"equity_usd,omitempty"`
-	IsolatedEquity               string                 `protobuf:"bytes,22,opt,name=isolated_equity,json=isolatedEquity,proto3" json:"isolated_equity,omitempty"`
-	IsolatedLiabilities          string                 `protobuf:"bytes,23,opt,name=isolated_liabilities,json=isolatedLiabilities,proto3" json:"isolated_liabilities,omitempty"`
-	IsolatedUpl                  string                 `protobuf:"bytes,24,opt,name=isolated_upl,json=isolatedUpl,proto3" json:"isolated_upl,omitempty"`
-	NotionalLeverage             string                 `protobuf:"bytes,25,opt,name=notional_leverage,json=notionalLeverage,proto3" json:"notional_leverage,omitempty"`
-	TotalEquity                  string                 `protobuf:"bytes,26,opt,name=total_equity,json=totalEquity,proto3" json:"total_equity,omitempty"`
-	StrategyEquity               string                 `protobuf:"bytes,27,opt,name=strategy_equity,json=strategyEquity,proto3" json:"strategy_equity,omitempty"`
-	unknownFields                protoimpl.UnknownFields
-	sizeCache                    protoimpl.SizeCache
+type DataHistoryJob struct {
+	state                    protoimpl.MessageState  `protogen:"open.v1"`
+	Id                       string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Nickname                 string                  `protobuf:"bytes,2,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	Exchange                 string                  `protobuf:"bytes,3,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset                    string                  `protobuf:"bytes,4,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair                     *CurrencyPair           `protobuf:"bytes,5,opt,name=pair,proto3" json:"pair,omitempty"`
+	StartDate                string                  `protobuf:"bytes,6,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate                  string                  `protobuf:"bytes,7,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Interval                 int64                   `protobuf:"varint,8,opt,name=interval,proto3" json:"interval,omitempty"`
+	RequestSizeLimit         uint64                  `protobuf:"varint,9,opt,name=request_size_limit,json=requestSizeLimit,proto3" json:"request_size_limit,omitempty"`
+	MaxRetryAttempts         uint64                  `protobuf:"varint,10,opt,name=max_retry_attempts,json=maxRetryAttempts,proto3" json:"max_retry_attempts,omitempty"`
+	BatchSize                uint64                  `protobuf:"varint,11,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	Status                   string                  `protobuf:"bytes,12,opt,name=status,proto3" json:"status,omitempty"`
+	DataType                 string                  `protobuf:"bytes,13,opt,name=data_type,json=dataType,proto3" json:"data_type,omitempty"`
+	ConversionInterval       int64                   `protobuf:"varint,14,opt,name=conversion_interval,json=conversionInterval,proto3" json:"conversion_interval,omitempty"`
+	OverwriteExistingData    bool                    `protobuf:"varint,15,opt,name=overwrite_existing_data,json=overwriteExistingData,proto3" json:"overwrite_existing_data,omitempty"`
+	PrerequisiteJobNickname  string                  `protobuf:"bytes,16,opt,name=prerequisite_job_nickname,json=prerequisiteJobNickname,proto3" json:"prerequisite_job_nickname,omitempty"`
+	DecimalPlaceComparison   uint64                  `protobuf:"varint,17,opt,name=decimal_place_comparison,json=decimalPlaceComparison,proto3" json:"decimal_place_comparison,omitempty"`
+	SecondaryExchangeName    string                  `protobuf:"bytes,18,opt,name=secondary_exchange_name,json=secondaryExchangeName,proto3" json:"secondary_exchange_name,omitempty"`
+	IssueTolerancePercentage float64                 `protobuf:"fixed64,19,opt,name=issue_tolerance_percentage,json=issueTolerancePercentage,proto3" json:"issue_tolerance_percentage,omitempty"`
+	ReplaceOnIssue           bool                    `protobuf:"varint,20,opt,name=replace_on_issue,json=replaceOnIssue,proto3" json:"replace_on_issue,omitempty"`
+	JobResults               []*DataHistoryJobResult `protobuf:"bytes,21,rep,name=job_results,json=jobResults,proto3" json:"job_results,omitempty"`
+	ResultSummaries          []string                `protobuf:"bytes,22,rep,name=result_summaries,json=resultSummaries,proto3" json:"result_summaries,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
 }
 
-func (x *FuturesPositionStats) Reset() {
-	*x = FuturesPositionStats{}
-	mi := &file_rpc_proto_msgTypes[172]
+func (x *DataHistoryJob) Reset() {
+	*x = DataHistoryJob{}
+	mi := &file_rpc_proto_msgTypes[170]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FuturesPositionStats) String() string {
+func (x *DataHistoryJob) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FuturesPositionStats) ProtoMessage() {}
+func (*DataHistoryJob) ProtoMessage() {}
 
-func (x *FuturesPositionStats) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[172]
+func (x *DataHistoryJob) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[170]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10649,240 +10588,191 @@ func (x *FuturesPositionStats) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FuturesPositionStats.ProtoReflect.Descriptor instead.
-func (*FuturesPositionStats) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{172}
+// Deprecated: Use DataHistoryJob.ProtoReflect.Descriptor instead.
+func (*DataHistoryJob) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{170}
 }
 
-func (x *FuturesPositionStats) GetMaintenanceMarginRequirement() string {
+func (x *DataHistoryJob) GetId() string {
 	if x != nil {
-		return x.MaintenanceMarginRequirement
+		return x.Id
 	}
 	return ""
 }
 
-func (x *FuturesPositionStats) GetInitialMarginRequirement() string {
+func (x *DataHistoryJob) GetNickname() string {
 	if x != nil {
-		return x.InitialMarginRequirement
+		return x.Nickname
 	}
 	return ""
 }
 
-func (x *FuturesPositionStats) GetEstimatedLiquidationPrice() string {
+func (x *DataHistoryJob) GetExchange() string {
 	if x != nil {
-		return x.EstimatedLiquidationPrice
+		return x.Exchange
 	}
 	return ""
 }
 
-func (x *FuturesPositionStats) GetCollateralUsed() string {
+func (x *DataHistoryJob) GetAsset() string {
 	if x != nil {
-		return x.CollateralUsed
+		return x.Asset
 	}
 	return ""
 }
 
-func (x *FuturesPositionStats) GetMarkPrice() string {
+func (x *DataHistoryJob) GetPair() *CurrencyPair {
 	if x != nil {
-		return x.MarkPrice
+		return x.Pair
 	}
-	return ""
+	return nil
 }
 
-func (x *FuturesPositionStats) GetCurrentSize() string {
+func (x *DataHistoryJob) GetStartDate() string {
 	if x != nil {
-		return x.CurrentSize
+		return x.StartDate
 	}
 	return ""
 }
 
-func (x *FuturesPositionStats) GetContractSize() string {
+func (x *DataHistoryJob) GetEndDate() string {
 	if x != nil {
-		return x.ContractSize
+		return x.EndDate
 	}
 	return ""
 }
 
-func (x *FuturesPositionStats) GetContractMultiplier() string {
+func (x *DataHistoryJob) GetInterval() int64 {
 	if x != nil {
-		return x.ContractMultiplier
+		return x.Interval
 	}
-	return ""
+	return 0
 }
 
-func (x *FuturesPositionStats) GetContractSettlementType() string {
+func (x *DataHistoryJob) GetRequestSizeLimit() uint64 {
 	if x != nil {
-		return x.ContractSettlementType
+		return x.RequestSizeLimit
 	}
-	return ""
+	return 0
 }
 
-func (x *FuturesPositionStats) GetBreakEvenPrice() string {
+func (x *DataHistoryJob) GetMaxRetryAttempts() uint64 {
 	if x != nil {
-		return x.BreakEvenPrice
+		return x.MaxRetryAttempts
 	}
-	return ""
+	return 0
 }
 
-func (x *FuturesPositionStats) GetAverageOpenPrice() string {
+func (x *DataHistoryJob) GetBatchSize() uint64 {
 	if x != nil {
-		return x.AverageOpenPrice
+		return x.BatchSize
 	}
-	return ""
+	return 0
 }
 
-func (x *FuturesPositionStats) GetRecentPnl() string {
+func (x *DataHistoryJob) GetStatus() string {
 	if x != nil {
-		return x.RecentPnl
+		return x.Status
 	}
 	return ""
 }
 
-func (x *FuturesPositionStats) GetMarginFraction() string {
+func (x *DataHistoryJob) GetDataType() string {
 	if x != nil {
-		return x.MarginFraction
+		return x.DataType
 	}
 	return ""
 }
 
-func (x *FuturesPositionStats) GetFreeCollateral() string {
+func (x *DataHistoryJob) GetConversionInterval() int64 {
 	if x != nil {
-		return x.FreeCollateral
+		return x.ConversionInterval
 	}
-	return ""
+	return 0
 }
 
-func (x *FuturesPositionStats) GetTotalCollateral() string {
+func (x *DataHistoryJob) GetOverwriteExistingData() bool {
 	if x != nil {
-		return x.TotalCollateral
+		return x.OverwriteExistingData
 	}
-	return ""
+	return false
 }
 
-func (x *FuturesPositionStats) GetFrozenBalance() string {
+func (x *DataHistoryJob) GetPrerequisiteJobNickname() string {
 	if x != nil {
-		return x.FrozenBalance
+		return x.PrerequisiteJobNickname
 	}
 	return ""
 }
 
-func (x *FuturesPositionStats) GetEquityOfCurrency() string {
+func (x *DataHistoryJob) GetDecimalPlaceComparison() uint64 {
 	if x != nil {
-		return x.EquityOfCurrency
+		return x.DecimalPlaceComparison
 	}
-	return ""
+	return 0
 }
 
-func (x *FuturesPositionStats) GetAvailableEquity() string {
+func (x *DataHistoryJob) GetSecondaryExchangeName() string {
 	if x != nil {
-		return x.AvailableEquity
+		return x.SecondaryExchangeName
 	}
 	return ""
 }
 
-func (x *FuturesPositionStats) GetCashBalance() string {
+func (x *DataHistoryJob) GetIssueTolerancePercentage() float64 {
 	if x != nil {
-		return x.CashBalance
+		return x.IssueTolerancePercentage
 	}
-	return ""
+	return 0
 }
 
-func (x *FuturesPositionStats) GetDiscountEquity() string {
+func (x *DataHistoryJob) GetReplaceOnIssue() bool {
 	if x != nil {
-		return x.DiscountEquity
+		return x.ReplaceOnIssue
 	}
-	return ""
+	return false
 }
 
-func (x *FuturesPositionStats) GetEquityUsd() string {
+func (x *DataHistoryJob) GetJobResults() []*DataHistoryJobResult {
 	if x != nil {
-		return x.EquityUsd
+		return x.JobResults
 	}
-	return ""
+	return nil
 }
 
-func (x *FuturesPositionStats) GetIsolatedEquity() string {
+func (x *DataHistoryJob) GetResultSummaries() []string {
 	if x != nil {
-		return x.IsolatedEquity
+		return x.ResultSummaries
 	}
-	return ""
+	return nil
 }
 
-func (x *FuturesPositionStats) GetIsolatedLiabilities() string {
-	if x != nil {
-		return x.IsolatedLiabilities
-	}
-	return ""
+type DataHistoryJobResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StartDate     string                 `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       string                 `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	HasData       bool                   `protobuf:"varint,3,opt,name=has_data,json=hasData,proto3" json:"has_data,omitempty"`
+	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	RunDate       string                 `protobuf:"bytes,5,opt,name=run_date,json=runDate,proto3" json:"run_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FuturesPositionStats) GetIsolatedUpl() string {
-	if x != nil {
-		return x.IsolatedUpl
-	}
-	return ""
+func (x *DataHistoryJobResult) Reset() {
+	*x = DataHistoryJobResult{}
+	mi := &file_rpc_proto_msgTypes[171]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *FuturesPositionStats) GetNotionalLeverage() string {
-	if x != nil {
-		return x.NotionalLeverage
-	}
-	return ""
+func (x *DataHistoryJobResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *FuturesPositionStats) GetTotalEquity() string {
-	if x != nil {
-		return x.TotalEquity
-	}
-	return ""
-}
-
-func (x *FuturesPositionStats) GetStrategyEquity() string {
-	if x != nil {
-		return x.StrategyEquity
-	}
-	return ""
-}
-
-type FuturePosition struct {
-	state                  protoimpl.MessageState `protogen:"open.v1"`
-	Exchange               string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset                  string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair                   *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	Status                 string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
-	OpeningDate            string                 `protobuf:"bytes,5,opt,name=opening_date,json=openingDate,proto3" json:"opening_date,omitempty"`
-	OpeningDirection       string                 `protobuf:"bytes,6,opt,name=opening_direction,json=openingDirection,proto3" json:"opening_direction,omitempty"`
-	OpeningPrice           string                 `protobuf:"bytes,7,opt,name=opening_price,json=openingPrice,proto3" json:"opening_price,omitempty"`
-	OpeningSize            string                 `protobuf:"bytes,8,opt,name=opening_size,json=openingSize,proto3" json:"opening_size,omitempty"`
-	CurrentDirection       string                 `protobuf:"bytes,9,opt,name=current_direction,json=currentDirection,proto3" json:"current_direction,omitempty"`
-	CurrentPrice           string                 `protobuf:"bytes,10,opt,name=current_price,json=currentPrice,proto3" json:"current_price,omitempty"`
-	CurrentSize            string                 `protobuf:"bytes,11,opt,name=current_size,json=currentSize,proto3" json:"current_size,omitempty"`
-	UnrealisedPnl          string                 `protobuf:"bytes,12,opt,name=unrealised_pnl,json=unrealisedPnl,proto3" json:"unrealised_pnl,omitempty"`
-	RealisedPnl            string                 `protobuf:"bytes,13,opt,name=realised_pnl,json=realisedPnl,proto3" json:"realised_pnl,omitempty"`
-	ClosingDate            string                 `protobuf:"bytes,14,opt,name=closing_date,json=closingDate,proto3" json:"closing_date,omitempty"`
-	OrderCount             int64                  `protobuf:"varint,15,opt,name=order_count,json=orderCount,proto3" json:"order_count,omitempty"`
-	ContractSettlementType string                 `protobuf:"bytes,16,opt,name=contract_settlement_type,json=contractSettlementType,proto3" json:"contract_settlement_type,omitempty"`
-	Orders                 []*OrderDetails        `protobuf:"bytes,17,rep,name=orders,proto3" json:"orders,omitempty"`
-	PositionStats          *FuturesPositionStats  `protobuf:"bytes,18,opt,name=position_stats,json=positionStats,proto3" json:"position_stats,omitempty"`
-	FundingData            *FundingData           `protobuf:"bytes,19,opt,name=funding_data,json=fundingData,proto3" json:"funding_data,omitempty"`
-	unknownFields          protoimpl.UnknownFields
-	sizeCache              protoimpl.SizeCache
-}
-
-func (x *FuturePosition) Reset() {
-	*x = FuturePosition{}
-	mi := &file_rpc_proto_msgTypes[173]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *FuturePosition) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*FuturePosition) ProtoMessage() {}
+func (*DataHistoryJobResult) ProtoMessage() {}
 
-func (x *FuturePosition) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[173]
+func (x *DataHistoryJobResult) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[171]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10893,171 +10783,165 @@ func (x *FuturePosition) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FuturePosition.ProtoReflect.Descriptor instead.
-func (*FuturePosition) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{173}
+// Deprecated: Use DataHistoryJobResult.ProtoReflect.Descriptor instead.
+func (*DataHistoryJobResult) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{171}
 }
 
-func (x *FuturePosition) GetExchange() string {
+func (x *DataHistoryJobResult) GetStartDate() string {
 	if x != nil {
-		return x.Exchange
+		return x.StartDate
 	}
 	return ""
 }
 
-func (x *FuturePosition) GetAsset() string {
+func (x *DataHistoryJobResult) GetEndDate() string {
 	if x != nil {
-		return x.Asset
+		return x.EndDate
 	}
 	return ""
 }
 
-func (x *FuturePosition) GetPair() *CurrencyPair {
+func (x *DataHistoryJobResult) GetHasData() bool {
 	if x != nil {
-		return x.Pair
+		return x.HasData
 	}
-	return nil
+	return false
 }
 
-func (x *FuturePosition) GetStatus() string {
+func (x *DataHistoryJobResult) GetMessage() string {
 	if x != nil {
-		return x.Status
+		return x.Message
 	}
 	return ""
 }
 
-func (x *FuturePosition) GetOpeningDate() string {
+func (x *DataHistoryJobResult) GetRunDate() string {
 	if x != nil {
-		return x.OpeningDate
+		return x.RunDate
 	}
 	return ""
 }
 
-func (x *FuturePosition) GetOpeningDirection() string {
-	if x != nil {
-		return x.OpeningDirection
-	}
-	return ""
+type DataHistoryJobs struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*DataHistoryJob      `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FuturePosition) GetOpeningPrice() string {
-	if x != nil {
-		return x.OpeningPrice
-	}
-	return ""
+func (x *DataHistoryJobs) Reset() {
+	*x = DataHistoryJobs{}
+	mi := &file_rpc_proto_msgTypes[172]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *FuturePosition) GetOpeningSize() string {
-	if x != nil {
-		return x.OpeningSize
-	}
-	return ""
+func (x *DataHistoryJobs) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *FuturePosition) GetCurrentDirection() string {
-	if x != nil {
-		return x.CurrentDirection
-	}
-	return ""
-}
+func (*DataHistoryJobs) ProtoMessage() {}
 
-func (x *FuturePosition) GetCurrentPrice() string {
+func (x *DataHistoryJobs) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[172]
 	if x != nil {
-		return x.CurrentPrice
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *FuturePosition) GetCurrentSize() string {
-	if x != nil {
-		return x.CurrentSize
-	}
-	return ""
+// Deprecated: Use DataHistoryJobs.ProtoReflect.Descriptor instead.
+func (*DataHistoryJobs) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{172}
 }
 
-func (x *FuturePosition) GetUnrealisedPnl() string {
+func (x *DataHistoryJobs) GetResults() []*DataHistoryJob {
 	if x != nil {
-		return x.UnrealisedPnl
+		return x.Results
 	}
-	return ""
+	return nil
 }
 
-func (x *FuturePosition) GetRealisedPnl() string {
-	if x != nil {
-		return x.RealisedPnl
-	}
-	return ""
+type GetDataHistoryJobsBetweenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StartDate     string                 `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       string                 `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FuturePosition) GetClosingDate() string {
-	if x != nil {
-		return x.ClosingDate
-	}
-	return ""
+func (x *GetDataHistoryJobsBetweenRequest) Reset() {
+	*x = GetDataHistoryJobsBetweenRequest{}
+	mi := &file_rpc_proto_msgTypes[173]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *FuturePosition) GetOrderCount() int64 {
-	if x != nil {
-		return x.OrderCount
-	}
-	return 0
+func (x *GetDataHistoryJobsBetweenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *FuturePosition) GetContractSettlementType() string {
+func (*GetDataHistoryJobsBetweenRequest) ProtoMessage() {}
+
+func (x *GetDataHistoryJobsBetweenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[173]
 	if x != nil {
-		return x.ContractSettlementType
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *FuturePosition) GetOrders() []*OrderDetails {
-	if x != nil {
-		return x.Orders
-	}
-	return nil
+// Deprecated: Use GetDataHistoryJobsBetweenRequest.ProtoReflect.Descriptor instead.
+func (*GetDataHistoryJobsBetweenRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{173}
 }
 
-func (x *FuturePosition) GetPositionStats() *FuturesPositionStats {
+func (x *GetDataHistoryJobsBetweenRequest) GetStartDate() string {
 	if x != nil {
-		return x.PositionStats
+		return x.StartDate
 	}
-	return nil
+	return ""
 }
 
-func (x *FuturePosition) GetFundingData() *FundingData {
+func (x *GetDataHistoryJobsBetweenRequest) GetEndDate() string {
 	if x != nil {
-		return x.FundingData
+		return x.EndDate
 	}
-	return nil
+	return ""
 }
 
-type GetManagedPositionRequest struct {
-	state                   protoimpl.MessageState `protogen:"open.v1"`
-	Exchange                string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset                   string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair                    *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	IncludeFullOrderData    bool                   `protobuf:"varint,4,opt,name=include_full_order_data,json=includeFullOrderData,proto3" json:"include_full_order_data,omitempty"`
-	GetFundingPayments      bool                   `protobuf:"varint,5,opt,name=get_funding_payments,json=getFundingPayments,proto3" json:"get_funding_payments,omitempty"`
-	IncludeFullFundingRates bool                   `protobuf:"varint,6,opt,name=include_full_funding_rates,json=includeFullFundingRates,proto3" json:"include_full_funding_rates,omitempty"`
-	IncludePredictedRate    bool                   `protobuf:"varint,7,opt,name=include_predicted_rate,json=includePredictedRate,proto3" json:"include_predicted_rate,omitempty"`
-	unknownFields           protoimpl.UnknownFields
-	sizeCache               protoimpl.SizeCache
+type SetDataHistoryJobStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Nickname      string                 `protobuf:"bytes,2,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	Status        int64                  `protobuf:"varint,3,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetManagedPositionRequest) Reset() {
-	*x = GetManagedPositionRequest{}
+func (x *SetDataHistoryJobStatusRequest) Reset() {
+	*x = SetDataHistoryJobStatusRequest{}
 	mi := &file_rpc_proto_msgTypes[174]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetManagedPositionRequest) String() string {
+func (x *SetDataHistoryJobStatusRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetManagedPositionRequest) ProtoMessage() {}
+func (*SetDataHistoryJobStatusRequest) ProtoMessage() {}
 
-func (x *GetManagedPositionRequest) ProtoReflect() protoreflect.Message {
+func (x *SetDataHistoryJobStatusRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[174]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -11069,84 +10953,54 @@ func (x *GetManagedPositionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetManagedPositionRequest.ProtoReflect.Descriptor instead.
-func (*GetManagedPositionRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetDataHistoryJobStatusRequest.ProtoReflect.Descriptor instead.
+func (*SetDataHistoryJobStatusRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{174}
 }
 
-func (x *GetManagedPositionRequest) GetExchange() string {
+func (x *SetDataHistoryJobStatusRequest) GetId() string {
 	if x != nil {
-		return x.Exchange
+		return x.Id
 	}
 	return ""
 }
 
-func (x *GetManagedPositionRequest) GetAsset() string {
+func (x *SetDataHistoryJobStatusRequest) GetNickname() string {
 	if x != nil {
-		return x.Asset
+		return x.Nickname
 	}
 	return ""
 }
 
-func (x *GetManagedPositionRequest) GetPair() *CurrencyPair {
-	if x != nil {
-		return x.Pair
-	}
-	return nil
-}
-
-func (x *GetManagedPositionRequest) GetIncludeFullOrderData() bool {
+func (x *SetDataHistoryJobStatusRequest) GetStatus() int64 {
 	if x != nil {
-		return x.IncludeFullOrderData
+		return x.Status
 	}
-	return false
+	return 0
 }
 
-func (x *GetManagedPositionRequest) GetGetFundingPayments() bool {
-	if x != nil {
-		return x.GetFundingPayments
-	}
-	return false
-}
-
-func (x *GetManagedPositionRequest) GetIncludeFullFundingRates() bool {
-	if x != nil {
-		return x.IncludeFullFundingRates
-	}
-	return false
-}
-
-func (x *GetManagedPositionRequest) GetIncludePredictedRate() bool {
-	if x != nil {
-		return x.IncludePredictedRate
-	}
-	return false
-}
-
-type GetAllManagedPositionsRequest struct {
+type UpdateDataHistoryJobPrerequisiteRequest struct {
 	state                   protoimpl.MessageState `protogen:"open.v1"`
-	IncludeFullOrderData    bool                   `protobuf:"varint,1,opt,name=include_full_order_data,json=includeFullOrderData,proto3" json:"include_full_order_data,omitempty"`
-	GetFundingPayments      bool                   `protobuf:"varint,2,opt,name=get_funding_payments,json=getFundingPayments,proto3" json:"get_funding_payments,omitempty"`
-	IncludeFullFundingRates bool                   `protobuf:"varint,3,opt,name=include_full_funding_rates,json=includeFullFundingRates,proto3" json:"include_full_funding_rates,omitempty"`
-	IncludePredictedRate    bool                   `protobuf:"varint,4,opt,name=include_predicted_rate,json=includePredictedRate,proto3" json:"include_predicted_rate,omitempty"`
+	Nickname                string                 `protobuf:"bytes,1,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	PrerequisiteJobNickname string                 `protobuf:"bytes,2,opt,name=prerequisite_job_nickname,json=prerequisiteJobNickname,proto3" json:"prerequisite_job_nickname,omitempty"`
 	unknownFields           protoimpl.UnknownFields
 	sizeCache               protoimpl.SizeCache
 }
 
-func (x *GetAllManagedPositionsRequest) Reset() {
-	*x = GetAllManagedPositionsRequest{}
+func (x *UpdateDataHistoryJobPrerequisiteRequest) Reset() {
+	*x = UpdateDataHistoryJobPrerequisiteRequest{}
 	mi := &file_rpc_proto_msgTypes[175]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAllManagedPositionsRequest) String() string {
+func (x *UpdateDataHistoryJobPrerequisiteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllManagedPositionsRequest) ProtoMessage() {}
+func (*UpdateDataHistoryJobPrerequisiteRequest) ProtoMessage() {}
 
-func (x *GetAllManagedPositionsRequest) ProtoReflect() protoreflect.Message {
+func (x *UpdateDataHistoryJobPrerequisiteRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[175]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -11158,60 +11012,51 @@ func (x *GetAllManagedPositionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllManagedPositionsRequest.ProtoReflect.Descriptor instead.
-func (*GetAllManagedPositionsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateDataHistoryJobPrerequisiteRequest.ProtoReflect.Descriptor instead.
+func (*UpdateDataHistoryJobPrerequisiteRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{175}
 }
 
-func (x *GetAllManagedPositionsRequest) GetIncludeFullOrderData() bool {
-	if x != nil {
-		return x.IncludeFullOrderData
-	}
-	return false
-}
-
-func (x *GetAllManagedPositionsRequest) GetGetFundingPayments() bool {
-	if x != nil {
-		return x.GetFundingPayments
-	}
-	return false
-}
-
-func (x *GetAllManagedPositionsRequest) GetIncludeFullFundingRates() bool {
+func (x *UpdateDataHistoryJobPrerequisiteRequest) GetNickname() string {
 	if x != nil {
-		return x.IncludeFullFundingRates
+		return x.Nickname
 	}
-	return false
+	return ""
 }
 
-func (x *GetAllManagedPositionsRequest) GetIncludePredictedRate() bool {
+func (x *UpdateDataHistoryJobPrerequisiteRequest) GetPrerequisiteJobNickname() string {
 	if x != nil {
-		return x.IncludePredictedRate
+		return x.PrerequisiteJobNickname
 	}
-	return false
+	return ""
 }
 
-type GetManagedPositionsResponse struct {
+type ModifyOrderRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Positions     []*FuturePosition      `protobuf:"bytes,1,rep,name=positions,proto3" json:"positions,omitempty"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	OrderId       string                 `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	Asset         string                 `protobuf:"bytes,4,opt,name=asset,proto3" json:"asset,omitempty"`
+	Amount        float64                `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	Price         float64                `protobuf:"fixed64,6,opt,name=price,proto3" json:"price,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetManagedPositionsResponse) Reset() {
-	*x = GetManagedPositionsResponse{}
+func (x *ModifyOrderRequest) Reset() {
+	*x = ModifyOrderRequest{}
 	mi := &file_rpc_proto_msgTypes[176]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetManagedPositionsResponse) String() string {
+func (x *ModifyOrderRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetManagedPositionsResponse) ProtoMessage() {}
+func (*ModifyOrderRequest) ProtoMessage() {}
 
-func (x *GetManagedPositionsResponse) ProtoReflect() protoreflect.Message {
+func (x *ModifyOrderRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[176]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -11223,42 +11068,74 @@ func (x *GetManagedPositionsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetManagedPositionsResponse.ProtoReflect.Descriptor instead.
-func (*GetManagedPositionsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ModifyOrderRequest.ProtoReflect.Descriptor instead.
+func (*ModifyOrderRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{176}
 }
 
-func (x *GetManagedPositionsResponse) GetPositions() []*FuturePosition {
+func (x *ModifyOrderRequest) GetExchange() string {
 	if x != nil {
-		return x.Positions
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *ModifyOrderRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *ModifyOrderRequest) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
 	}
 	return nil
 }
 
-type GetFuturesPositionsSummaryRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Exchange       string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset          string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair           *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	UnderlyingPair *CurrencyPair          `protobuf:"bytes,4,opt,name=underlying_pair,json=underlyingPair,proto3" json:"underlying_pair,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *ModifyOrderRequest) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
 }
 
-func (x *GetFuturesPositionsSummaryRequest) Reset() {
-	*x = GetFuturesPositionsSummaryRequest{}
+func (x *ModifyOrderRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *ModifyOrderRequest) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+type ModifyOrderResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ModifiedOrderId string                 `protobuf:"bytes,1,opt,name=modified_order_id,json=modifiedOrderId,proto3" json:"modified_order_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ModifyOrderResponse) Reset() {
+	*x = ModifyOrderResponse{}
 	mi := &file_rpc_proto_msgTypes[177]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetFuturesPositionsSummaryRequest) String() string {
+func (x *ModifyOrderResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFuturesPositionsSummaryRequest) ProtoMessage() {}
+func (*ModifyOrderResponse) ProtoMessage() {}
 
-func (x *GetFuturesPositionsSummaryRequest) ProtoReflect() protoreflect.Message {
+func (x *ModifyOrderResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[177]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -11270,64 +11147,86 @@ func (x *GetFuturesPositionsSummaryRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFuturesPositionsSummaryRequest.ProtoReflect.Descriptor instead.
-func (*GetFuturesPositionsSummaryRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ModifyOrderResponse.ProtoReflect.Descriptor instead.
+func (*ModifyOrderResponse) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{177}
 }
 
-func (x *GetFuturesPositionsSummaryRequest) GetExchange() string {
+func (x *ModifyOrderResponse) GetModifiedOrderId() string {
 	if x != nil {
-		return x.Exchange
+		return x.ModifiedOrderId
 	}
 	return ""
 }
 
-func (x *GetFuturesPositionsSummaryRequest) GetAsset() string {
-	if x != nil {
-		return x.Asset
-	}
-	return ""
+type CurrencyStateGetAllRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetFuturesPositionsSummaryRequest) GetPair() *CurrencyPair {
+func (x *CurrencyStateGetAllRequest) Reset() {
+	*x = CurrencyStateGetAllRequest{}
+	mi := &file_rpc_proto_msgTypes[178]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CurrencyStateGetAllRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CurrencyStateGetAllRequest) ProtoMessage() {}
+
+func (x *CurrencyStateGetAllRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[178]
 	if x != nil {
-		return x.Pair
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *GetFuturesPositionsSummaryRequest) GetUnderlyingPair() *CurrencyPair {
+// Deprecated: Use CurrencyStateGetAllRequest.ProtoReflect.Descriptor instead.
+func (*CurrencyStateGetAllRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{178}
+}
+
+func (x *CurrencyStateGetAllRequest) GetExchange() string {
 	if x != nil {
-		return x.UnderlyingPair
+		return x.Exchange
 	}
-	return nil
+	return ""
 }
 
-type GetFuturesPositionsSummaryResponse struct {
+type CurrencyStateTradingRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	PositionStats *FuturesPositionStats  `protobuf:"bytes,4,opt,name=position_stats,json=positionStats,proto3" json:"position_stats,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Asset         string                 `protobuf:"bytes,3,opt,name=asset,proto3" json:"asset,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetFuturesPositionsSummaryResponse) Reset() {
-	*x = GetFuturesPositionsSummaryResponse{}
-	mi := &file_rpc_proto_msgTypes[178]
+func (x *CurrencyStateTradingRequest) Reset() {
+	*x = CurrencyStateTradingRequest{}
+	mi := &file_rpc_proto_msgTypes[179]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetFuturesPositionsSummaryResponse) String() string {
+func (x *CurrencyStateTradingRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFuturesPositionsSummaryResponse) ProtoMessage() {}
+func (*CurrencyStateTradingRequest) ProtoMessage() {}
 
-func (x *GetFuturesPositionsSummaryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[178]
+func (x *CurrencyStateTradingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[179]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11338,37 +11237,1302 @@ func (x *GetFuturesPositionsSummaryResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFuturesPositionsSummaryResponse.ProtoReflect.Descriptor instead.
-func (*GetFuturesPositionsSummaryResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{178}
+// Deprecated: Use CurrencyStateTradingRequest.ProtoReflect.Descriptor instead.
+func (*CurrencyStateTradingRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{179}
 }
 
-func (x *GetFuturesPositionsSummaryResponse) GetExchange() string {
+func (x *CurrencyStateTradingRequest) GetExchange() string {
 	if x != nil {
 		return x.Exchange
 	}
 	return ""
 }
 
-func (x *GetFuturesPositionsSummaryResponse) GetAsset() string {
+func (x *CurrencyStateTradingRequest) GetCode() string {
 	if x != nil {
-		return x.Asset
+		return x.Code
 	}
 	return ""
 }
 
-func (x *GetFuturesPositionsSummaryResponse) GetPair() *CurrencyPair {
+func (x *CurrencyStateTradingRequest) GetAsset() string {
 	if x != nil {
-		return x.Pair
+		return x.Asset
 	}
-	return nil
+	return ""
 }
 
-func (x *GetFuturesPositionsSummaryResponse) GetPositionStats() *FuturesPositionStats {
-	if x != nil {
-		return x.PositionStats
-	}
-	return nil
+type CurrencyStateTradingPairRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair          string                 `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	Asset         string                 `protobuf:"bytes,3,opt,name=asset,proto3" json:"asset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CurrencyStateTradingPairRequest) Reset() {
+	*x = CurrencyStateTradingPairRequest{}
+	mi := &file_rpc_proto_msgTypes[180]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CurrencyStateTradingPairRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CurrencyStateTradingPairRequest) ProtoMessage() {}
+
+func (x *CurrencyStateTradingPairRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[180]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CurrencyStateTradingPairRequest.ProtoReflect.Descriptor instead.
+func (*CurrencyStateTradingPairRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{180}
+}
+
+func (x *CurrencyStateTradingPairRequest) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *CurrencyStateTradingPairRequest) GetPair() string {
+	if x != nil {
+		return x.Pair
+	}
+	return ""
+}
+
+func (x *CurrencyStateTradingPairRequest) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+type CurrencyStateWithdrawRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Asset         string                 `protobuf:"bytes,3,opt,name=asset,proto3" json:"asset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CurrencyStateWithdrawRequest) Reset() {
+	*x = CurrencyStateWithdrawRequest{}
+	mi := &file_rpc_proto_msgTypes[181]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CurrencyStateWithdrawRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CurrencyStateWithdrawRequest) ProtoMessage() {}
+
+func (x *CurrencyStateWithdrawRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[181]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CurrencyStateWithdrawRequest.ProtoReflect.Descriptor instead.
+func (*CurrencyStateWithdrawRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{181}
+}
+
+func (x *CurrencyStateWithdrawRequest) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *CurrencyStateWithdrawRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CurrencyStateWithdrawRequest) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+type CurrencyStateDepositRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Asset         string                 `protobuf:"bytes,3,opt,name=asset,proto3" json:"asset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CurrencyStateDepositRequest) Reset() {
+	*x = CurrencyStateDepositRequest{}
+	mi := &file_rpc_proto_msgTypes[182]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CurrencyStateDepositRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CurrencyStateDepositRequest) ProtoMessage() {}
+
+func (x *CurrencyStateDepositRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[182]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CurrencyStateDepositRequest.ProtoReflect.Descriptor instead.
+func (*CurrencyStateDepositRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{182}
+}
+
+func (x *CurrencyStateDepositRequest) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *CurrencyStateDepositRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CurrencyStateDepositRequest) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+type CurrencyStateResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	CurrencyStates []*CurrencyState       `protobuf:"bytes,1,rep,name=currency_states,json=currencyStates,proto3" json:"currency_states,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CurrencyStateResponse) Reset() {
+	*x = CurrencyStateResponse{}
+	mi := &file_rpc_proto_msgTypes[183]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CurrencyStateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CurrencyStateResponse) ProtoMessage() {}
+
+func (x *CurrencyStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[183]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CurrencyStateResponse.ProtoReflect.Descriptor instead.
+func (*CurrencyStateResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{183}
+}
+
+func (x *CurrencyStateResponse) GetCurrencyStates() []*CurrencyState {
+	if x != nil {
+		return x.CurrencyStates
+	}
+	return nil
+}
+
+type CurrencyState struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Currency        string                 `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	Asset           string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	WithdrawEnabled bool                   `protobuf:"varint,3,opt,name=withdraw_enabled,json=withdrawEnabled,proto3" json:"withdraw_enabled,omitempty"`
+	DepositEnabled  bool                   `protobuf:"varint,4,opt,name=deposit_enabled,json=depositEnabled,proto3" json:"deposit_enabled,omitempty"`
+	TradingEnabled  bool                   `protobuf:"varint,5,opt,name=trading_enabled,json=tradingEnabled,proto3" json:"trading_enabled,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CurrencyState) Reset() {
+	*x = CurrencyState{}
+	mi := &file_rpc_proto_msgTypes[184]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CurrencyState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CurrencyState) ProtoMessage() {}
+
+func (x *CurrencyState) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[184]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CurrencyState.ProtoReflect.Descriptor instead.
+func (*CurrencyState) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{184}
+}
+
+func (x *CurrencyState) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *CurrencyState) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *CurrencyState) GetWithdrawEnabled() bool {
+	if x != nil {
+		return x.WithdrawEnabled
+	}
+	return false
+}
+
+func (x *CurrencyState) GetDepositEnabled() bool {
+	if x != nil {
+		return x.DepositEnabled
+	}
+	return false
+}
+
+func (x *CurrencyState) GetTradingEnabled() bool {
+	if x != nil {
+		return x.TradingEnabled
+	}
+	return false
+}
+
+type FundingRate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Rate          string                 `protobuf:"bytes,2,opt,name=rate,proto3" json:"rate,omitempty"`
+	Payment       string                 `protobuf:"bytes,3,opt,name=payment,proto3" json:"payment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FundingRate) Reset() {
+	*x = FundingRate{}
+	mi := &file_rpc_proto_msgTypes[185]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FundingRate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FundingRate) ProtoMessage() {}
+
+func (x *FundingRate) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[185]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FundingRate.ProtoReflect.Descriptor instead.
+func (*FundingRate) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{185}
+}
+
+func (x *FundingRate) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *FundingRate) GetRate() string {
+	if x != nil {
+		return x.Rate
+	}
+	return ""
+}
+
+func (x *FundingRate) GetPayment() string {
+	if x != nil {
+		return x.Payment
+	}
+	return ""
+}
+
+type FundingData struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Exchange        string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset           string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair            *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	PaymentCurrency string                 `protobuf:"bytes,4,opt,name=payment_currency,json=paymentCurrency,proto3" json:"payment_currency,omitempty"`
+	StartDate       string                 `protobuf:"bytes,5,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate         string                 `protobuf:"bytes,6,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Rates           []*FundingRate         `protobuf:"bytes,7,rep,name=rates,proto3" json:"rates,omitempty"`
+	LatestRate      *FundingRate           `protobuf:"bytes,8,opt,name=latest_rate,json=latestRate,proto3" json:"latest_rate,omitempty"`
+	UpcomingRate    *FundingRate           `protobuf:"bytes,9,opt,name=upcoming_rate,json=upcomingRate,proto3" json:"upcoming_rate,omitempty"`
+	PaymentSum      string                 `protobuf:"bytes,10,opt,name=payment_sum,json=paymentSum,proto3" json:"payment_sum,omitempty"`
+	PaymentMessage  string                 `protobuf:"bytes,11,opt,name=payment_message,json=paymentMessage,proto3" json:"payment_message,omitempty"`
+	TimeOfNextRate  string                 `protobuf:"bytes,12,opt,name=time_of_next_rate,json=timeOfNextRate,proto3" json:"time_of_next_rate,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *FundingData) Reset() {
+	*x = FundingData{}
+	mi := &file_rpc_proto_msgTypes[186]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FundingData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FundingData) ProtoMessage() {}
+
+func (x *FundingData) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[186]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FundingData.ProtoReflect.Descriptor instead.
+func (*FundingData) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{186}
+}
+
+func (x *FundingData) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *FundingData) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *FundingData) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *FundingData) GetPaymentCurrency() string {
+	if x != nil {
+		return x.PaymentCurrency
+	}
+	return ""
+}
+
+func (x *FundingData) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *FundingData) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *FundingData) GetRates() []*FundingRate {
+	if x != nil {
+		return x.Rates
+	}
+	return nil
+}
+
+func (x *FundingData) GetLatestRate() *FundingRate {
+	if x != nil {
+		return x.LatestRate
+	}
+	return nil
+}
+
+func (x *FundingData) GetUpcomingRate() *FundingRate {
+	if x != nil {
+		return x.UpcomingRate
+	}
+	return nil
+}
+
+func (x *FundingData) GetPaymentSum() string {
+	if x != nil {
+		return x.PaymentSum
+	}
+	return ""
+}
+
+func (x *FundingData) GetPaymentMessage() string {
+	if x != nil {
+		return x.PaymentMessage
+	}
+	return ""
+}
+
+func (x *FundingData) GetTimeOfNextRate() string {
+	if x != nil {
+		return x.TimeOfNextRate
+	}
+	return ""
+}
+
+type FuturesPositionStats struct {
+	state                        protoimpl.MessageState `protogen:"open.v1"`
+	MaintenanceMarginRequirement string                 `protobuf:"bytes,1,opt,name=maintenance_margin_requirement,json=maintenanceMarginRequirement,proto3" json:"maintenance_margin_requirement,omitempty"`
+	InitialMarginRequirement     string                 `protobuf:"bytes,2,opt,name=initial_margin_requirement,json=initialMarginRequirement,proto3" json:"initial_margin_requirement,omitempty"`
+	EstimatedLiquidationPrice    string                 `protobuf:"bytes,3,opt,name=estimated_liquidation_price,json=estimatedLiquidationPrice,proto3" json:"estimated_liquidation_price,omitempty"`
+	CollateralUsed               string                 `protobuf:"bytes,4,opt,name=collateral_used,json=collateralUsed,proto3" json:"collateral_used,omitempty"`
+	MarkPrice                    string                 `protobuf:"bytes,5,opt,name=mark_price,json=markPrice,proto3" json:"mark_price,omitempty"`
+	CurrentSize                  string                 `protobuf:"bytes,6,opt,name=current_size,json=currentSize,proto3" json:"current_size,omitempty"`
+	ContractSize                 string                 `protobuf:"bytes,7,opt,name=contract_size,json=contractSize,proto3" json:"contract_size,omitempty"`
+	ContractMultiplier           string                 `protobuf:"bytes,8,opt,name=contract_multiplier,json=contractMultiplier,proto3" json:"contract_multiplier,omitempty"`
+	ContractSettlementType       string                 `protobuf:"bytes,9,opt,name=contract_settlement_type,json=contractSettlementType,proto3" json:"contract_settlement_type,omitempty"`
+	BreakEvenPrice               string                 `protobuf:"bytes,10,opt,name=break_even_price,json=breakEvenPrice,proto3" json:"break_even_price,omitempty"`
+	AverageOpenPrice             string                 `protobuf:"bytes,11,opt,name=average_open_price,json=averageOpenPrice,proto3" json:"average_open_price,omitempty"`
+	RecentPnl                    string                 `protobuf:"bytes,12,opt,name=recent_pnl,json=recentPnl,proto3" json:"recent_pnl,omitempty"`
+	MarginFraction               string                 `protobuf:"bytes,13,opt,name=margin_fraction,json=marginFraction,proto3" json:"margin_fraction,omitempty"`
+	FreeCollateral               string                 `protobuf:"bytes,14,opt,name=free_collateral,json=freeCollateral,proto3" json:"free_collateral,omitempty"`
+	TotalCollateral              string                 `protobuf:"bytes,15,opt,name=total_collateral,json=totalCollateral,proto3" json:"total_collateral,omitempty"`
+	FrozenBalance                string                 `protobuf:"bytes,16,opt,name=frozen_balance,json=frozenBalance,proto3" json:"frozen_balance,omitempty"`
+	EquityOfCurrency             string                 `protobuf:"bytes,17,opt,name=equity_of_currency,json=equityOfCurrency,proto3" json:"equity_of_currency,omitempty"`
+	AvailableEquity              string                 `protobuf:"bytes,18,opt,name=available_equity,json=availableEquity,proto3" json:"available_equity,omitempty"`
+	CashBalance                  string                 `protobuf:"bytes,19,opt,name=cash_balance,json=cashBalance,proto3" json:"cash_balance,omitempty"`
+	DiscountEquity               string                 `protobuf:"bytes,20,opt,name=discount_equity,json=discountEquity,proto3" json:"discount_equity,omitempty"`
+	EquityUsd                    string                 `protobuf:"bytes,21,opt,name=equity_usd,json=equityUsd,proto3" json:"equity_usd,omitempty"`
+	IsolatedEquity               string                 `protobuf:"bytes,22,opt,name=isolated_equity,json=isolatedEquity,proto3" json:"isolated_equity,omitempty"`
+	IsolatedLiabilities          string                 `protobuf:"bytes,23,opt,name=isolated_liabilities,json=isolatedLiabilities,proto3" json:"isolated_liabilities,omitempty"`
+	IsolatedUpl                  string                 `protobuf:"bytes,24,opt,name=isolated_upl,json=isolatedUpl,proto3" json:"isolated_upl,omitempty"`
+	NotionalLeverage             string                 `protobuf:"bytes,25,opt,name=notional_leverage,json=notionalLeverage,proto3" json:"notional_leverage,omitempty"`
+	TotalEquity                  string                 `protobuf:"bytes,26,opt,name=total_equity,json=totalEquity,proto3" json:"total_equity,omitempty"`
+	StrategyEquity               string                 `protobuf:"bytes,27,opt,name=strategy_equity,json=strategyEquity,proto3" json:"strategy_equity,omitempty"`
+	unknownFields                protoimpl.UnknownFields
+	sizeCache                    protoimpl.SizeCache
+}
+
+func (x *FuturesPositionStats) Reset() {
+	*x = FuturesPositionStats{}
+	mi := &file_rpc_proto_msgTypes[187]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FuturesPositionStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FuturesPositionStats) ProtoMessage() {}
+
+func (x *FuturesPositionStats) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[187]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FuturesPositionStats.ProtoReflect.Descriptor instead.
+func (*FuturesPositionStats) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{187}
+}
+
+func (x *FuturesPositionStats) GetMaintenanceMarginRequirement() string {
+	if x != nil {
+		return x.MaintenanceMarginRequirement
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetInitialMarginRequirement() string {
+	if x != nil {
+		return x.InitialMarginRequirement
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetEstimatedLiquidationPrice() string {
+	if x != nil {
+		return x.EstimatedLiquidationPrice
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetCollateralUsed() string {
+	if x != nil {
+		return x.CollateralUsed
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetMarkPrice() string {
+	if x != nil {
+		return x.MarkPrice
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetCurrentSize() string {
+	if x != nil {
+		return x.CurrentSize
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetContractSize() string {
+	if x != nil {
+		return x.ContractSize
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetContractMultiplier() string {
+	if x != nil {
+		return x.ContractMultiplier
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetContractSettlementType() string {
+	if x != nil {
+		return x.ContractSettlementType
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetBreakEvenPrice() string {
+	if x != nil {
+		return x.BreakEvenPrice
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetAverageOpenPrice() string {
+	if x != nil {
+		return x.AverageOpenPrice
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetRecentPnl() string {
+	if x != nil {
+		return x.RecentPnl
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetMarginFraction() string {
+	if x != nil {
+		return x.MarginFraction
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetFreeCollateral() string {
+	if x != nil {
+		return x.FreeCollateral
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetTotalCollateral() string {
+	if x != nil {
+		return x.TotalCollateral
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetFrozenBalance() string {
+	if x != nil {
+		return x.FrozenBalance
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetEquityOfCurrency() string {
+	if x != nil {
+		return x.EquityOfCurrency
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetAvailableEquity() string {
+	if x != nil {
+		return x.AvailableEquity
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetCashBalance() string {
+	if x != nil {
+		return x.CashBalance
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetDiscountEquity() string {
+	if x != nil {
+		return x.DiscountEquity
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetEquityUsd() string {
+	if x != nil {
+		return x.EquityUsd
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetIsolatedEquity() string {
+	if x != nil {
+		return x.IsolatedEquity
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetIsolatedLiabilities() string {
+	if x != nil {
+		return x.IsolatedLiabilities
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetIsolatedUpl() string {
+	if x != nil {
+		return x.IsolatedUpl
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetNotionalLeverage() string {
+	if x != nil {
+		return x.NotionalLeverage
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetTotalEquity() string {
+	if x != nil {
+		return x.TotalEquity
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetStrategyEquity() string {
+	if x != nil {
+		return x.StrategyEquity
+	}
+	return ""
+}
+
+type FuturePosition struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	Exchange               string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset                  string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair                   *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	Status                 string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	OpeningDate            string                 `protobuf:"bytes,5,opt,name=opening_date,json=openingDate,proto3" json:"opening_date,omitempty"`
+	OpeningDirection       string                 `protobuf:"bytes,6,opt,name=opening_direction,json=openingDirection,proto3" json:"opening_direction,omitempty"`
+	OpeningPrice           string                 `protobuf:"bytes,7,opt,name=opening_price,json=openingPrice,proto3" json:"opening_price,omitempty"`
+	OpeningSize            string                 `protobuf:"bytes,8,opt,name=opening_size,json=openingSize,proto3" json:"opening_size,omitempty"`
+	CurrentDirection       string                 `protobuf:"bytes,9,opt,name=current_direction,json=currentDirection,proto3" json:"current_direction,omitempty"`
+	CurrentPrice           string                 `protobuf:"bytes,10,opt,name=current_price,json=currentPrice,proto3" json:"current_price,omitempty"`
+	CurrentSize            string                 `protobuf:"bytes,11,opt,name=current_size,json=currentSize,proto3" json:"current_size,omitempty"`
+	UnrealisedPnl          string                 `protobuf:"bytes,12,opt,name=unrealised_pnl,json=unrealisedPnl,proto3" json:"unrealised_pnl,omitempty"`
+	RealisedPnl            string                 `protobuf:"bytes,13,opt,name=realised_pnl,json=realisedPnl,proto3" json:"realised_pnl,omitempty"`
+	ClosingDate            string                 `protobuf:"bytes,14,opt,name=closing_date,json=closingDate,proto3" json:"closing_date,omitempty"`
+	OrderCount             int64                  `protobuf:"varint,15,opt,name=order_count,json=orderCount,proto3" json:"order_count,omitempty"`
+	ContractSettlementType string                 `protobuf:"bytes,16,opt,name=contract_settlement_type,json=contractSettlementType,proto3" json:"contract_settlement_type,omitempty"`
+	Orders                 []*OrderDetails        `protobuf:"bytes,17,rep,name=orders,proto3" json:"orders,omitempty"`
+	PositionStats          *FuturesPositionStats  `protobuf:"bytes,18,opt,name=position_stats,json=positionStats,proto3" json:"position_stats,omitempty"`
+	FundingData            *FundingData           `protobuf:"bytes,19,opt,name=funding_data,json=fundingData,proto3" json:"funding_data,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *FuturePosition) Reset() {
+	*x = FuturePosition{}
+	mi := &file_rpc_proto_msgTypes[188]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FuturePosition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FuturePosition) ProtoMessage() {}
+
+func (x *FuturePosition) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[188]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FuturePosition.ProtoReflect.Descriptor instead.
+func (*FuturePosition) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{188}
+}
+
+func (x *FuturePosition) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *FuturePosition) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *FuturePosition) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *FuturePosition) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *FuturePosition) GetOpeningDate() string {
+	if x != nil {
+		return x.OpeningDate
+	}
+	return ""
+}
+
+func (x *FuturePosition) GetOpeningDirection() string {
+	if x != nil {
+		return x.OpeningDirection
+	}
+	return ""
+}
+
+func (x *FuturePosition) GetOpeningPrice() string {
+	if x != nil {
+		return x.OpeningPrice
+	}
+	return ""
+}
+
+func (x *FuturePosition) GetOpeningSize() string {
+	if x != nil {
+		return x.OpeningSize
+	}
+	return ""
+}
+
+func (x *FuturePosition) GetCurrentDirection() string {
+	if x != nil {
+		return x.CurrentDirection
+	}
+	return ""
+}
+
+func (x *FuturePosition) GetCurrentPrice() string {
+	if x != nil {
+		return x.CurrentPrice
+	}
+	return ""
+}
+
+func (x *FuturePosition) GetCurrentSize() string {
+	if x != nil {
+		return x.CurrentSize
+	}
+	return ""
+}
+
+func (x *FuturePosition) GetUnrealisedPnl() string {
+	if x != nil {
+		return x.UnrealisedPnl
+	}
+	return ""
+}
+
+func (x *FuturePosition) GetRealisedPnl() string {
+	if x != nil {
+		return x.RealisedPnl
+	}
+	return ""
+}
+
+func (x *FuturePosition) GetClosingDate() string {
+	if x != nil {
+		return x.ClosingDate
+	}
+	return ""
+}
+
+func (x *FuturePosition) GetOrderCount() int64 {
+	if x != nil {
+		return x.OrderCount
+	}
+	return 0
+}
+
+func (x *FuturePosition) GetContractSettlementType() string {
+	if x != nil {
+		return x.ContractSettlementType
+	}
+	return ""
+}
+
+func (x *FuturePosition) GetOrders() []*OrderDetails {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+func (x *FuturePosition) GetPositionStats() *FuturesPositionStats {
+	if x != nil {
+		return x.PositionStats
+	}
+	return nil
+}
+
+func (x *FuturePosition) GetFundingData() *FundingData {
+	if x != nil {
+		return x.FundingData
+	}
+	return nil
+}
+
+type GetManagedPositionRequest struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	Exchange                string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset                   string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair                    *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	IncludeFullOrderData    bool                   `protobuf:"varint,4,opt,name=include_full_order_data,json=includeFullOrderData,proto3" json:"include_full_order_data,omitempty"`
+	GetFundingPayments      bool                   `protobuf:"varint,5,opt,name=get_funding_payments,json=getFundingPayments,proto3" json:"get_funding_payments,omitempty"`
+	IncludeFullFundingRates bool                   `protobuf:"varint,6,opt,name=include_full_funding_rates,json=includeFullFundingRates,proto3" json:"include_full_funding_rates,omitempty"`
+	IncludePredictedRate    bool                   `protobuf:"varint,7,opt,name=include_predicted_rate,json=includePredictedRate,proto3" json:"include_predicted_rate,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *GetManagedPositionRequest) Reset() {
+	*x = GetManagedPositionRequest{}
+	mi := &file_rpc_proto_msgTypes[189]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetManagedPositionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetManagedPositionRequest) ProtoMessage() {}
+
+func (x *GetManagedPositionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[189]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetManagedPositionRequest.ProtoReflect.Descriptor instead.
+func (*GetManagedPositionRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{189}
+}
+
+func (x *GetManagedPositionRequest) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *GetManagedPositionRequest) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *GetManagedPositionRequest) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *GetManagedPositionRequest) GetIncludeFullOrderData() bool {
+	if x != nil {
+		return x.IncludeFullOrderData
+	}
+	return false
+}
+
+func (x *GetManagedPositionRequest) GetGetFundingPayments() bool {
+	if x != nil {
+		return x.GetFundingPayments
+	}
+	return false
+}
+
+func (x *GetManagedPositionRequest) GetIncludeFullFundingRates() bool {
+	if x != nil {
+		return x.IncludeFullFundingRates
+	}
+	return false
+}
+
+func (x *GetManagedPositionRequest) GetIncludePredictedRate() bool {
+	if x != nil {
+		return x.IncludePredictedRate
+	}
+	return false
+}
+
+type GetAllManagedPositionsRequest struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	IncludeFullOrderData    bool                   `protobuf:"varint,1,opt,name=include_full_order_data,json=includeFullOrderData,proto3" json:"include_full_order_data,omitempty"`
+	GetFundingPayments      bool                   `protobuf:"varint,2,opt,name=get_funding_payments,json=getFundingPayments,proto3" json:"get_funding_payments,omitempty"`
+	IncludeFullFundingRates bool                   `protobuf:"varint,3,opt,name=include_full_funding_rates,json=includeFullFundingRates,proto3" json:"include_full_funding_rates,omitempty"`
+	IncludePredictedRate    bool                   `protobuf:"varint,4,opt,name=include_predicted_rate,json=includePredictedRate,proto3" json:"include_predicted_rate,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *GetAllManagedPositionsRequest) Reset() {
+	*x = GetAllManagedPositionsRequest{}
+	mi := &file_rpc_proto_msgTypes[190]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllManagedPositionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllManagedPositionsRequest) ProtoMessage() {}
+
+func (x *GetAllManagedPositionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[190]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllManagedPositionsRequest.ProtoReflect.Descriptor instead.
+func (*GetAllManagedPositionsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{190}
+}
+
+func (x *GetAllManagedPositionsRequest) GetIncludeFullOrderData() bool {
+	if x != nil {
+		return x.IncludeFullOrderData
+	}
+	return false
+}
+
+func (x *GetAllManagedPositionsRequest) GetGetFundingPayments() bool {
+	if x != nil {
+		return x.GetFundingPayments
+	}
+	return false
+}
+
+func (x *GetAllManagedPositionsRequest) GetIncludeFullFundingRates() bool {
+	if x != nil {
+		return x.IncludeFullFundingRates
+	}
+	return false
+}
+
+func (x *GetAllManagedPositionsRequest) GetIncludePredictedRate() bool {
+	if x != nil {
+		return x.IncludePredictedRate
+	}
+	return false
+}
+
+type GetManagedPositionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Positions     []*FuturePosition      `protobuf:"bytes,1,rep,name=positions,proto3" json:"positions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetManagedPositionsResponse) Reset() {
+	*x = GetManagedPositionsResponse{}
+	mi := &file_rpc_proto_msgTypes[191]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetManagedPositionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetManagedPositionsResponse) ProtoMessage() {}
+
+func (x *GetManagedPositionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[191]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetManagedPositionsResponse.ProtoReflect.Descriptor instead.
+func (*GetManagedPositionsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{191}
+}
+
+func (x *GetManagedPositionsResponse) GetPositions() []*FuturePosition {
+	if x != nil {
+		return x.Positions
+	}
+	return nil
+}
+
+type GetFuturesPositionsSummaryRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Exchange       string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset          string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair           *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	UnderlyingPair *CurrencyPair          `protobuf:"bytes,4,opt,name=underlying_pair,json=underlyingPair,proto3" json:"underlying_pair,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetFuturesPositionsSummaryRequest) Reset() {
+	*x = GetFuturesPositionsSummaryRequest{}
+	mi := &file_rpc_proto_msgTypes[192]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFuturesPositionsSummaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFuturesPositionsSummaryRequest) ProtoMessage() {}
+
+func (x *GetFuturesPositionsSummaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[192]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFuturesPositionsSummaryRequest.ProtoReflect.Descriptor instead.
+func (*GetFuturesPositionsSummaryRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{192}
+}
+
+func (x *GetFuturesPositionsSummaryRequest) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *GetFuturesPositionsSummaryRequest) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *GetFuturesPositionsSummaryRequest) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *GetFuturesPositionsSummaryRequest) GetUnderlyingPair() *CurrencyPair {
+	if x != nil {
+		return x.UnderlyingPair
+	}
+	return nil
+}
+
+type GetFuturesPositionsSummaryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	PositionStats *FuturesPositionStats  `protobuf:"bytes,4,opt,name=position_stats,json=positionStats,proto3" json:"position_stats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFuturesPositionsSummaryResponse) Reset() {
+	*x = GetFuturesPositionsSummaryResponse{}
+	mi := &file_rpc_proto_msgTypes[193]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFuturesPositionsSummaryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFuturesPositionsSummaryResponse) ProtoMessage() {}
+
+func (x *GetFuturesPositionsSummaryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[193]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFuturesPositionsSummaryResponse.ProtoReflect.Descriptor instead.
+func (*GetFuturesPositionsSummaryResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{193}
+}
+
+func (x *GetFuturesPositionsSummaryResponse) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *GetFuturesPositionsSummaryResponse) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *GetFuturesPositionsSummaryResponse) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *GetFuturesPositionsSummaryResponse) GetPositionStats() *FuturesPositionStats {
+	if x != nil {
+		return x.PositionStats
+	}
+	return nil
 }
 
 type GetFuturesPositionsOrdersRequest struct {
@@ -11385,21 +12549,2322 @@ type GetFuturesPositionsOrdersRequest struct {
 	sizeCache                 protoimpl.SizeCache
 }
 
-func (x *GetFuturesPositionsOrdersRequest) Reset() {
-	*x = GetFuturesPositionsOrdersRequest{}
-	mi := &file_rpc_proto_msgTypes[179]
+func (x *GetFuturesPositionsOrdersRequest) Reset() {
+	*x = GetFuturesPositionsOrdersRequest{}
+	mi := &file_rpc_proto_msgTypes[194]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFuturesPositionsOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFuturesPositionsOrdersRequest) ProtoMessage() {}
+
+func (x *GetFuturesPositionsOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[194]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFuturesPositionsOrdersRequest.ProtoReflect.Descriptor instead.
+func (*GetFuturesPositionsOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{194}
+}
+
+func (x *GetFuturesPositionsOrdersRequest) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *GetFuturesPositionsOrdersRequest) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *GetFuturesPositionsOrdersRequest) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *GetFuturesPositionsOrdersRequest) GetUnderlyingPair() *CurrencyPair {
+	if x != nil {
+		return x.UnderlyingPair
+	}
+	return nil
+}
+
+func (x *GetFuturesPositionsOrdersRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *GetFuturesPositionsOrdersRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *GetFuturesPositionsOrdersRequest) GetRespectOrderHistoryLimits() bool {
+	if x != nil {
+		return x.RespectOrderHistoryLimits
+	}
+	return false
+}
+
+func (x *GetFuturesPositionsOrdersRequest) GetSyncWithOrderManager() bool {
+	if x != nil {
+		return x.SyncWithOrderManager
+	}
+	return false
+}
+
+type GetFuturesPositionsOrdersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Positions     []*FuturePosition      `protobuf:"bytes,6,rep,name=positions,proto3" json:"positions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFuturesPositionsOrdersResponse) Reset() {
+	*x = GetFuturesPositionsOrdersResponse{}
+	mi := &file_rpc_proto_msgTypes[195]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFuturesPositionsOrdersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFuturesPositionsOrdersResponse) ProtoMessage() {}
+
+func (x *GetFuturesPositionsOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[195]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFuturesPositionsOrdersResponse.ProtoReflect.Descriptor instead.
+func (*GetFuturesPositionsOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{195}
+}
+
+func (x *GetFuturesPositionsOrdersResponse) GetPositions() []*FuturePosition {
+	if x != nil {
+		return x.Positions
+	}
+	return nil
+}
+
+type GetCollateralModeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCollateralModeRequest) Reset() {
+	*x = GetCollateralModeRequest{}
+	mi := &file_rpc_proto_msgTypes[196]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCollateralModeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCollateralModeRequest) ProtoMessage() {}
+
+func (x *GetCollateralModeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[196]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCollateralModeRequest.ProtoReflect.Descriptor instead.
+func (*GetCollateralModeRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{196}
+}
+
+func (x *GetCollateralModeRequest) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *GetCollateralModeRequest) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+type GetCollateralModeResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Exchange       string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset          string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	CollateralMode string                 `protobuf:"bytes,3,opt,name=collateral_mode,json=collateralMode,proto3" json:"collateral_mode,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetCollateralModeResponse) Reset() {
+	*x = GetCollateralModeResponse{}
+	mi := &file_rpc_proto_msgTypes[197]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCollateralModeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCollateralModeResponse) ProtoMessage() {}
+
+func (x *GetCollateralModeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[197]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCollateralModeResponse.ProtoReflect.Descriptor instead.
+func (*GetCollateralModeResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{197}
+}
+
+func (x *GetCollateralModeResponse) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *GetCollateralModeResponse) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *GetCollateralModeResponse) GetCollateralMode() string {
+	if x != nil {
+		return x.CollateralMode
+	}
+	return ""
+}
+
+type SetCollateralModeRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Exchange       string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset          string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	CollateralMode string                 `protobuf:"bytes,3,opt,name=collateral_mode,json=collateralMode,proto3" json:"collateral_mode,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SetCollateralModeRequest) Reset() {
+	*x = SetCollateralModeRequest{}
+	mi := &file_rpc_proto_msgTypes[198]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCollateralModeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCollateralModeRequest) ProtoMessage() {}
+
+func (x *SetCollateralModeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[198]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCollateralModeRequest.ProtoReflect.Descriptor instead.
+func (*SetCollateralModeRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{198}
+}
+
+func (x *SetCollateralModeRequest) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *SetCollateralModeRequest) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *SetCollateralModeRequest) GetCollateralMode() string {
+	if x != nil {
+		return x.CollateralMode
+	}
+	return ""
+}
+
+type SetCollateralModeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Success       bool                   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetCollateralModeResponse) Reset() {
+	*x = SetCollateralModeResponse{}
+	mi := &file_rpc_proto_msgTypes[199]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCollateralModeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCollateralModeResponse) ProtoMessage() {}
+
+func (x *SetCollateralModeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[199]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCollateralModeResponse.ProtoReflect.Descriptor instead.
+func (*SetCollateralModeResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{199}
+}
+
+func (x *SetCollateralModeResponse) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *SetCollateralModeResponse) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *SetCollateralModeResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type GetMarginTypeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMarginTypeRequest) Reset() {
+	*x = GetMarginTypeRequest{}
+	mi := &file_rpc_proto_msgTypes[200]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMarginTypeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMarginTypeRequest) ProtoMessage() {}
+
+func (x *GetMarginTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[200]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMarginTypeRequest.ProtoReflect.Descriptor instead.
+func (*GetMarginTypeRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{200}
+}
+
+func (x *GetMarginTypeRequest) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *GetMarginTypeRequest) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *GetMarginTypeRequest) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+type GetMarginTypeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	MarginType    string                 `protobuf:"bytes,4,opt,name=margin_type,json=marginType,proto3" json:"margin_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMarginTypeResponse) Reset() {
+	*x = GetMarginTypeResponse{}
+	mi := &file_rpc_proto_msgTypes[201]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMarginTypeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMarginTypeResponse) ProtoMessage() {}
+
+func (x *GetMarginTypeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[201]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMarginTypeResponse.ProtoReflect.Descriptor instead.
+func (*GetMarginTypeResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{201}
+}
+
+func (x *GetMarginTypeResponse) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *GetMarginTypeResponse) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *GetMarginTypeResponse) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *GetMarginTypeResponse) GetMarginType() string {
+	if x != nil {
+		return x.MarginType
+	}
+	return ""
+}
+
+type ChangePositionMarginRequest struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	Exchange                string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset                   string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair                    *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	MarginType              string                 `protobuf:"bytes,4,opt,name=margin_type,json=marginType,proto3" json:"margin_type,omitempty"`
+	OriginalAllocatedMargin float64                `protobuf:"fixed64,5,opt,name=original_allocated_margin,json=originalAllocatedMargin,proto3" json:"original_allocated_margin,omitempty"`
+	NewAllocatedMargin      float64                `protobuf:"fixed64,6,opt,name=new_allocated_margin,json=newAllocatedMargin,proto3" json:"new_allocated_margin,omitempty"`
+	MarginSide              string                 `protobuf:"bytes,7,opt,name=margin_side,json=marginSide,proto3" json:"margin_side,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *ChangePositionMarginRequest) Reset() {
+	*x = ChangePositionMarginRequest{}
+	mi := &file_rpc_proto_msgTypes[202]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangePositionMarginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangePositionMarginRequest) ProtoMessage() {}
+
+func (x *ChangePositionMarginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[202]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangePositionMarginRequest.ProtoReflect.Descriptor instead.
+func (*ChangePositionMarginRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{202}
+}
+
+func (x *ChangePositionMarginRequest) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *ChangePositionMarginRequest) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *ChangePositionMarginRequest) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *ChangePositionMarginRequest) GetMarginType() string {
+	if x != nil {
+		return x.MarginType
+	}
+	return ""
+}
+
+func (x *ChangePositionMarginRequest) GetOriginalAllocatedMargin() float64 {
+	if x != nil {
+		return x.OriginalAllocatedMargin
+	}
+	return 0
+}
+
+func (x *ChangePositionMarginRequest) GetNewAllocatedMargin() float64 {
+	if x != nil {
+		return x.NewAllocatedMargin
+	}
+	return 0
+}
+
+func (x *ChangePositionMarginRequest) GetMarginSide() string {
+	if x != nil {
+		return x.MarginSide
+	}
+	return ""
+}
+
+type ChangePositionMarginResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Exchange           string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset              string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair               *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	MarginType         string                 `protobuf:"bytes,4,opt,name=margin_type,json=marginType,proto3" json:"margin_type,omitempty"`
+	NewAllocatedMargin float64                `protobuf:"fixed64,5,opt,name=new_allocated_margin,json=newAllocatedMargin,proto3" json:"new_allocated_margin,omitempty"`
+	MarginSide         string                 `protobuf:"bytes,6,opt,name=margin_side,json=marginSide,proto3" json:"margin_side,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ChangePositionMarginResponse) Reset() {
+	*x = ChangePositionMarginResponse{}
+	mi := &file_rpc_proto_msgTypes[203]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangePositionMarginResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangePositionMarginResponse) ProtoMessage() {}
+
+func (x *ChangePositionMarginResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[203]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangePositionMarginResponse.ProtoReflect.Descriptor instead.
+func (*ChangePositionMarginResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{203}
+}
+
+func (x *ChangePositionMarginResponse) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *ChangePositionMarginResponse) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *ChangePositionMarginResponse) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *ChangePositionMarginResponse) GetMarginType() string {
+	if x != nil {
+		return x.MarginType
+	}
+	return ""
+}
+
+func (x *ChangePositionMarginResponse) GetNewAllocatedMargin() float64 {
+	if x != nil {
+		return x.NewAllocatedMargin
+	}
+	return 0
+}
+
+func (x *ChangePositionMarginResponse) GetMarginSide() string {
+	if x != nil {
+		return x.MarginSide
+	}
+	return ""
+}
+
+type SetMarginTypeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	MarginType    string                 `protobuf:"bytes,4,opt,name=margin_type,json=marginType,proto3" json:"margin_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetMarginTypeRequest) Reset() {
+	*x = SetMarginTypeRequest{}
+	mi := &file_rpc_proto_msgTypes[204]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMarginTypeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMarginTypeRequest) ProtoMessage() {}
+
+func (x *SetMarginTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[204]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMarginTypeRequest.ProtoReflect.Descriptor instead.
+func (*SetMarginTypeRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{204}
+}
+
+func (x *SetMarginTypeRequest) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *SetMarginTypeRequest) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *SetMarginTypeRequest) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *SetMarginTypeRequest) GetMarginType() string {
+	if x != nil {
+		return x.MarginType
+	}
+	return ""
+}
+
+type SetMarginTypeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	Success       bool                   `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetMarginTypeResponse) Reset() {
+	*x = SetMarginTypeResponse{}
+	mi := &file_rpc_proto_msgTypes[205]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMarginTypeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMarginTypeResponse) ProtoMessage() {}
+
+func (x *SetMarginTypeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[205]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMarginTypeResponse.ProtoReflect.Descriptor instead.
+func (*SetMarginTypeResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{205}
+}
+
+func (x *SetMarginTypeResponse) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *SetMarginTypeResponse) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *SetMarginTypeResponse) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *SetMarginTypeResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type GetLeverageRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Exchange       string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset          string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair           *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	UnderlyingPair *CurrencyPair          `protobuf:"bytes,4,opt,name=underlying_pair,json=underlyingPair,proto3" json:"underlying_pair,omitempty"`
+	MarginType     string                 `protobuf:"bytes,5,opt,name=margin_type,json=marginType,proto3" json:"margin_type,omitempty"`
+	OrderSide      string                 `protobuf:"bytes,6,opt,name=order_side,json=orderSide,proto3" json:"order_side,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetLeverageRequest) Reset() {
+	*x = GetLeverageRequest{}
+	mi := &file_rpc_proto_msgTypes[206]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLeverageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLeverageRequest) ProtoMessage() {}
+
+func (x *GetLeverageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[206]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLeverageRequest.ProtoReflect.Descriptor instead.
+func (*GetLeverageRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{206}
+}
+
+func (x *GetLeverageRequest) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *GetLeverageRequest) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *GetLeverageRequest) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *GetLeverageRequest) GetUnderlyingPair() *CurrencyPair {
+	if x != nil {
+		return x.UnderlyingPair
+	}
+	return nil
+}
+
+func (x *GetLeverageRequest) GetMarginType() string {
+	if x != nil {
+		return x.MarginType
+	}
+	return ""
+}
+
+func (x *GetLeverageRequest) GetOrderSide() string {
+	if x != nil {
+		return x.OrderSide
+	}
+	return ""
+}
+
+type GetLeverageResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Exchange       string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset          string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair           *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	UnderlyingPair *CurrencyPair          `protobuf:"bytes,4,opt,name=underlying_pair,json=underlyingPair,proto3" json:"underlying_pair,omitempty"`
+	MarginType     string                 `protobuf:"bytes,5,opt,name=margin_type,json=marginType,proto3" json:"margin_type,omitempty"`
+	Leverage       float64                `protobuf:"fixed64,6,opt,name=leverage,proto3" json:"leverage,omitempty"`
+	OrderSide      string                 `protobuf:"bytes,7,opt,name=order_side,json=orderSide,proto3" json:"order_side,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetLeverageResponse) Reset() {
+	*x = GetLeverageResponse{}
+	mi := &file_rpc_proto_msgTypes[207]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLeverageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLeverageResponse) ProtoMessage() {}
+
+func (x *GetLeverageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[207]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLeverageResponse.ProtoReflect.Descriptor instead.
+func (*GetLeverageResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{207}
+}
+
+func (x *GetLeverageResponse) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *GetLeverageResponse) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *GetLeverageResponse) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *GetLeverageResponse) GetUnderlyingPair() *CurrencyPair {
+	if x != nil {
+		return x.UnderlyingPair
+	}
+	return nil
+}
+
+func (x *GetLeverageResponse) GetMarginType() string {
+	if x != nil {
+		return x.MarginType
+	}
+	return ""
+}
+
+func (x *GetLeverageResponse) GetLeverage() float64 {
+	if x != nil {
+		return x.Leverage
+	}
+	return 0
+}
+
+func (x *GetLeverageResponse) GetOrderSide() string {
+	if x != nil {
+		return x.OrderSide
+	}
+	return ""
+}
+
+type SetLeverageRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Exchange       string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset          string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair           *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	UnderlyingPair *CurrencyPair          `protobuf:"bytes,4,opt,name=underlying_pair,json=underlyingPair,proto3" json:"underlying_pair,omitempty"`
+	MarginType     string                 `protobuf:"bytes,5,opt,name=margin_type,json=marginType,proto3" json:"margin_type,omitempty"`
+	Leverage       float64                `protobuf:"fixed64,6,opt,name=leverage,proto3" json:"leverage,omitempty"`
+	OrderSide      string                 `protobuf:"bytes,7,opt,name=order_side,json=orderSide,proto3" json:"order_side,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SetLeverageRequest) Reset() {
+	*x = SetLeverageRequest{}
+	mi := &file_rpc_proto_msgTypes[208]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetLeverageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLeverageRequest) ProtoMessage() {}
+
+func (x *SetLeverageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[208]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLeverageRequest.ProtoReflect.Descriptor instead.
+func (*SetLeverageRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{208}
+}
+
+func (x *SetLeverageRequest) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *SetLeverageRequest) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *SetLeverageRequest) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *SetLeverageRequest) GetUnderlyingPair() *CurrencyPair {
+	if x != nil {
+		return x.UnderlyingPair
+	}
+	return nil
+}
+
+func (x *SetLeverageRequest) GetMarginType() string {
+	if x != nil {
+		return x.MarginType
+	}
+	return ""
+}
+
+func (x *SetLeverageRequest) GetLeverage() float64 {
+	if x != nil {
+		return x.Leverage
+	}
+	return 0
+}
+
+func (x *SetLeverageRequest) GetOrderSide() string {
+	if x != nil {
+		return x.OrderSide
+	}
+	return ""
+}
+
+type SetLeverageResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Exchange       string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset          string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair           *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	UnderlyingPair *CurrencyPair          `protobuf:"bytes,4,opt,name=underlying_pair,json=underlyingPair,proto3" json:"underlying_pair,omitempty"`
+	MarginType     string                 `protobuf:"bytes,5,opt,name=margin_type,json=marginType,proto3" json:"margin_type,omitempty"`
+	OrderSide      string                 `protobuf:"bytes,6,opt,name=order_side,json=orderSide,proto3" json:"order_side,omitempty"`
+	Success        bool                   `protobuf:"varint,7,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SetLeverageResponse) Reset() {
+	*x = SetLeverageResponse{}
+	mi := &file_rpc_proto_msgTypes[209]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetLeverageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLeverageResponse) ProtoMessage() {}
+
+func (x *SetLeverageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[209]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLeverageResponse.ProtoReflect.Descriptor instead.
+func (*SetLeverageResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{209}
+}
+
+func (x *SetLeverageResponse) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *SetLeverageResponse) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *SetLeverageResponse) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *SetLeverageResponse) GetUnderlyingPair() *CurrencyPair {
+	if x != nil {
+		return x.UnderlyingPair
+	}
+	return nil
+}
+
+func (x *SetLeverageResponse) GetMarginType() string {
+	if x != nil {
+		return x.MarginType
+	}
+	return ""
+}
+
+func (x *SetLeverageResponse) GetOrderSide() string {
+	if x != nil {
+		return x.OrderSide
+	}
+	return ""
+}
+
+func (x *SetLeverageResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type GetCollateralRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Exchange          string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset             string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	IncludeBreakdown  bool                   `protobuf:"varint,3,opt,name=include_breakdown,json=includeBreakdown,proto3" json:"include_breakdown,omitempty"`
+	CalculateOffline  bool                   `protobuf:"varint,4,opt,name=calculate_offline,json=calculateOffline,proto3" json:"calculate_offline,omitempty"`
+	IncludeZeroValues bool                   `protobuf:"varint,5,opt,name=include_zero_values,json=includeZeroValues,proto3" json:"include_zero_values,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetCollateralRequest) Reset() {
+	*x = GetCollateralRequest{}
+	mi := &file_rpc_proto_msgTypes[210]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCollateralRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCollateralRequest) ProtoMessage() {}
+
+func (x *GetCollateralRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[210]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCollateralRequest.ProtoReflect.Descriptor instead.
+func (*GetCollateralRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{210}
+}
+
+func (x *GetCollateralRequest) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *GetCollateralRequest) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *GetCollateralRequest) GetIncludeBreakdown() bool {
+	if x != nil {
+		return x.IncludeBreakdown
+	}
+	return false
+}
+
+func (x *GetCollateralRequest) GetCalculateOffline() bool {
+	if x != nil {
+		return x.CalculateOffline
+	}
+	return false
+}
+
+func (x *GetCollateralRequest) GetIncludeZeroValues() bool {
+	if x != nil {
+		return x.IncludeZeroValues
+	}
+	return false
+}
+
+type GetCollateralResponse struct {
+	state                                       protoimpl.MessageState   `protogen:"open.v1"`
+	SubAccount                                  string                   `protobuf:"bytes,1,opt,name=sub_account,json=subAccount,proto3" json:"sub_account,omitempty"`
+	CollateralCurrency                          string                   `protobuf:"bytes,2,opt,name=collateral_currency,json=collateralCurrency,proto3" json:"collateral_currency,omitempty"`
+	TotalValueOfPositiveSpotBalances            string                   `protobuf:"bytes,3,opt,name=total_value_of_positive_spot_balances,json=totalValueOfPositiveSpotBalances,proto3" json:"total_value_of_positive_spot_balances,omitempty"`
+	CollateralContributedByPositiveSpotBalances string                   `protobuf:"bytes,4,opt,name=collateral_contributed_by_positive_spot_balances,json=collateralContributedByPositiveSpotBalances,proto3" json:"collateral_contributed_by_positive_spot_balances,omitempty"`
+	UsedCollateral                              string                   `protobuf:"bytes,5,opt,name=used_collateral,json=usedCollateral,proto3" json:"used_collateral,omitempty"`
+	UsedBreakdown                               *CollateralUsedBreakdown `protobuf:"bytes,6,opt,name=used_breakdown,json=usedBreakdown,proto3" json:"used_breakdown,omitempty"`
+	AvailableCollateral                         string                   `protobuf:"bytes,7,opt,name=available_collateral,json=availableCollateral,proto3" json:"available_collateral,omitempty"`
+	MaintenanceCollateral                       string                   `protobuf:"bytes,8,opt,name=maintenance_collateral,json=maintenanceCollateral,proto3" json:"maintenance_collateral,omitempty"`
+	UnrealisedPnl                               string                   `protobuf:"bytes,9,opt,name=unrealised_pnl,json=unrealisedPnl,proto3" json:"unrealised_pnl,omitempty"`
+	CurrencyBreakdown                           []*CollateralForCurrency `protobuf:"bytes,10,rep,name=currency_breakdown,json=currencyBreakdown,proto3" json:"currency_breakdown,omitempty"`
+	PositionBreakdown                           []*CollateralByPosition  `protobuf:"bytes,11,rep,name=position_breakdown,json=positionBreakdown,proto3" json:"position_breakdown,omitempty"`
+	unknownFields                               protoimpl.UnknownFields
+	sizeCache                                   protoimpl.SizeCache
+}
+
+func (x *GetCollateralResponse) Reset() {
+	*x = GetCollateralResponse{}
+	mi := &file_rpc_proto_msgTypes[211]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCollateralResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCollateralResponse) ProtoMessage() {}
+
+func (x *GetCollateralResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[211]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCollateralResponse.ProtoReflect.Descriptor instead.
+func (*GetCollateralResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{211}
+}
+
+func (x *GetCollateralResponse) GetSubAccount() string {
+	if x != nil {
+		return x.SubAccount
+	}
+	return ""
+}
+
+func (x *GetCollateralResponse) GetCollateralCurrency() string {
+	if x != nil {
+		return x.CollateralCurrency
+	}
+	return ""
+}
+
+func (x *GetCollateralResponse) GetTotalValueOfPositiveSpotBalances() string {
+	if x != nil {
+		return x.TotalValueOfPositiveSpotBalances
+	}
+	return ""
+}
+
+func (x *GetCollateralResponse) GetCollateralContributedByPositiveSpotBalances() string {
+	if x != nil {
+		return x.CollateralContributedByPositiveSpotBalances
+	}
+	return ""
+}
+
+func (x *GetCollateralResponse) GetUsedCollateral() string {
+	if x != nil {
+		return x.UsedCollateral
+	}
+	return ""
+}
+
+func (x *GetCollateralResponse) GetUsedBreakdown() *CollateralUsedBreakdown {
+	if x != nil {
+		return x.UsedBreakdown
+	}
+	return nil
+}
+
+func (x *GetCollateralResponse) GetAvailableCollateral() string {
+	if x != nil {
+		return x.AvailableCollateral
+	}
+	return ""
+}
+
+func (x *GetCollateralResponse) GetMaintenanceCollateral() string {
+	if x != nil {
+		return x.MaintenanceCollateral
+	}
+	return ""
+}
+
+func (x *GetCollateralResponse) GetUnrealisedPnl() string {
+	if x != nil {
+		return x.UnrealisedPnl
+	}
+	return ""
+}
+
+func (x *GetCollateralResponse) GetCurrencyBreakdown() []*CollateralForCurrency {
+	if x != nil {
+		return x.CurrencyBreakdown
+	}
+	return nil
+}
+
+func (x *GetCollateralResponse) GetPositionBreakdown() []*CollateralByPosition {
+	if x != nil {
+		return x.PositionBreakdown
+	}
+	return nil
+}
+
+type CollateralForCurrency struct {
+	state                       protoimpl.MessageState   `protogen:"open.v1"`
+	Currency                    string                   `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	ExcludedFromCollateral      bool                     `protobuf:"varint,2,opt,name=excluded_from_collateral,json=excludedFromCollateral,proto3" json:"excluded_from_collateral,omitempty"`
+	TotalFunds                  string                   `protobuf:"bytes,3,opt,name=total_funds,json=totalFunds,proto3" json:"total_funds,omitempty"`
+	AvailableForUseAsCollateral string                   `protobuf:"bytes,4,opt,name=available_for_use_as_collateral,json=availableForUseAsCollateral,proto3" json:"available_for_use_as_collateral,omitempty"`
+	ApproxFairMarketValue       string                   `protobuf:"bytes,5,opt,name=approx_fair_market_value,json=approxFairMarketValue,proto3" json:"approx_fair_market_value,omitempty"`
+	Weighting                   string                   `protobuf:"bytes,6,opt,name=weighting,proto3" json:"weighting,omitempty"`
+	CollateralContribution      string                   `protobuf:"bytes,7,opt,name=collateral_contribution,json=collateralContribution,proto3" json:"collateral_contribution,omitempty"`
+	ScaledToCurrency            string                   `protobuf:"bytes,8,opt,name=scaled_to_currency,json=scaledToCurrency,proto3" json:"scaled_to_currency,omitempty"`
+	UnrealisedPnl               string                   `protobuf:"bytes,9,opt,name=unrealised_pnl,json=unrealisedPnl,proto3" json:"unrealised_pnl,omitempty"`
+	FundsInUse                  string                   `protobuf:"bytes,10,opt,name=funds_in_use,json=fundsInUse,proto3" json:"funds_in_use,omitempty"`
+	AdditionalCollateralUsed    string                   `protobuf:"bytes,11,opt,name=additional_collateral_used,json=additionalCollateralUsed,proto3" json:"additional_collateral_used,omitempty"`
+	UsedBreakdown               *CollateralUsedBreakdown `protobuf:"bytes,12,opt,name=used_breakdown,json=usedBreakdown,proto3" json:"used_breakdown,omitempty"`
+	Error                       string                   `protobuf:"bytes,13,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
+}
+
+func (x *CollateralForCurrency) Reset() {
+	*x = CollateralForCurrency{}
+	mi := &file_rpc_proto_msgTypes[212]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollateralForCurrency) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollateralForCurrency) ProtoMessage() {}
+
+func (x *CollateralForCurrency) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[212]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollateralForCurrency.ProtoReflect.Descriptor instead.
+func (*CollateralForCurrency) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{212}
+}
+
+func (x *CollateralForCurrency) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *CollateralForCurrency) GetExcludedFromCollateral() bool {
+	if x != nil {
+		return x.ExcludedFromCollateral
+	}
+	return false
+}
+
+func (x *CollateralForCurrency) GetTotalFunds() string {
+	if x != nil {
+		return x.TotalFunds
+	}
+	return ""
+}
+
+func (x *CollateralForCurrency) GetAvailableForUseAsCollateral() string {
+	if x != nil {
+		return x.AvailableForUseAsCollateral
+	}
+	return ""
+}
+
+func (x *CollateralForCurrency) GetApproxFairMarketValue() string {
+	if x != nil {
+		return x.ApproxFairMarketValue
+	}
+	return ""
+}
+
+func (x *CollateralForCurrency) GetWeighting() string {
+	if x != nil {
+		return x.Weighting
+	}
+	return ""
+}
+
+func (x *CollateralForCurrency) GetCollateralContribution() string {
+	if x != nil {
+		return x.CollateralContribution
+	}
+	return ""
+}
+
+func (x *CollateralForCurrency) GetScaledToCurrency() string {
+	if x != nil {
+		return x.ScaledToCurrency
+	}
+	return ""
+}
+
+func (x *CollateralForCurrency) GetUnrealisedPnl() string {
+	if x != nil {
+		return x.UnrealisedPnl
+	}
+	return ""
+}
+
+func (x *CollateralForCurrency) GetFundsInUse() string {
+	if x != nil {
+		return x.FundsInUse
+	}
+	return ""
+}
+
+func (x *CollateralForCurrency) GetAdditionalCollateralUsed() string {
+	if x != nil {
+		return x.AdditionalCollateralUsed
+	}
+	return ""
+}
+
+func (x *CollateralForCurrency) GetUsedBreakdown() *CollateralUsedBreakdown {
+	if x != nil {
+		return x.UsedBreakdown
+	}
+	return nil
+}
+
+func (x *CollateralForCurrency) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type CollateralByPosition struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Currency            string                 `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	Size                string                 `protobuf:"bytes,2,opt,name=size,proto3" json:"size,omitempty"`
+	OpenOrderSize       string                 `protobuf:"bytes,3,opt,name=open_order_size,json=openOrderSize,proto3" json:"open_order_size,omitempty"`
+	PositionSize        string                 `protobuf:"bytes,4,opt,name=position_size,json=positionSize,proto3" json:"position_size,omitempty"`
+	MarkPrice           string                 `protobuf:"bytes,5,opt,name=mark_price,json=markPrice,proto3" json:"mark_price,omitempty"`
+	RequiredMargin      string                 `protobuf:"bytes,6,opt,name=required_margin,json=requiredMargin,proto3" json:"required_margin,omitempty"`
+	TotalCollateralUsed string                 `protobuf:"bytes,7,opt,name=total_collateral_used,json=totalCollateralUsed,proto3" json:"total_collateral_used,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *CollateralByPosition) Reset() {
+	*x = CollateralByPosition{}
+	mi := &file_rpc_proto_msgTypes[213]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollateralByPosition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollateralByPosition) ProtoMessage() {}
+
+func (x *CollateralByPosition) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[213]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollateralByPosition.ProtoReflect.Descriptor instead.
+func (*CollateralByPosition) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{213}
+}
+
+func (x *CollateralByPosition) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *CollateralByPosition) GetSize() string {
+	if x != nil {
+		return x.Size
+	}
+	return ""
+}
+
+func (x *CollateralByPosition) GetOpenOrderSize() string {
+	if x != nil {
+		return x.OpenOrderSize
+	}
+	return ""
+}
+
+func (x *CollateralByPosition) GetPositionSize() string {
+	if x != nil {
+		return x.PositionSize
+	}
+	return ""
+}
+
+func (x *CollateralByPosition) GetMarkPrice() string {
+	if x != nil {
+		return x.MarkPrice
+	}
+	return ""
+}
+
+func (x *CollateralByPosition) GetRequiredMargin() string {
+	if x != nil {
+		return x.RequiredMargin
+	}
+	return ""
+}
+
+func (x *CollateralByPosition) GetTotalCollateralUsed() string {
+	if x != nil {
+		return x.TotalCollateralUsed
+	}
+	return ""
+}
+
+type CollateralUsedBreakdown struct {
+	state                           protoimpl.MessageState `protogen:"open.v1"`
+	LockedInStakes                  string                 `protobuf:"bytes,1,opt,name=locked_in_stakes,json=lockedInStakes,proto3" json:"locked_in_stakes,omitempty"`
+	LockedInNftBids                 string                 `protobuf:"bytes,2,opt,name=locked_in_nft_bids,json=lockedInNftBids,proto3" json:"locked_in_nft_bids,omitempty"`
+	LockedInFeeVoucher              string                 `protobuf:"bytes,3,opt,name=locked_in_fee_voucher,json=lockedInFeeVoucher,proto3" json:"locked_in_fee_voucher,omitempty"`
+	LockedInSpotMarginFundingOffers string                 `protobuf:"bytes,4,opt,name=locked_in_spot_margin_funding_offers,json=lockedInSpotMarginFundingOffers,proto3" json:"locked_in_spot_margin_funding_offers,omitempty"`
+	LockedInSpotOrders              string                 `protobuf:"bytes,5,opt,name=locked_in_spot_orders,json=lockedInSpotOrders,proto3" json:"locked_in_spot_orders,omitempty"`
+	LockedAsCollateral              string                 `protobuf:"bytes,6,opt,name=locked_as_collateral,json=lockedAsCollateral,proto3" json:"locked_as_collateral,omitempty"`
+	UsedInFutures                   string                 `protobuf:"bytes,7,opt,name=used_in_futures,json=usedInFutures,proto3" json:"used_in_futures,omitempty"`
+	UsedInSpotMargin                string                 `protobuf:"bytes,8,opt,name=used_in_spot_margin,json=usedInSpotMargin,proto3" json:"used_in_spot_margin,omitempty"`
+	unknownFields                   protoimpl.UnknownFields
+	sizeCache                       protoimpl.SizeCache
+}
+
+func (x *CollateralUsedBreakdown) Reset() {
+	*x = CollateralUsedBreakdown{}
+	mi := &file_rpc_proto_msgTypes[214]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollateralUsedBreakdown) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollateralUsedBreakdown) ProtoMessage() {}
+
+func (x *CollateralUsedBreakdown) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[214]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollateralUsedBreakdown.ProtoReflect.Descriptor instead.
+func (*CollateralUsedBreakdown) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{214}
+}
+
+func (x *CollateralUsedBreakdown) GetLockedInStakes() string {
+	if x != nil {
+		return x.LockedInStakes
+	}
+	return ""
+}
+
+func (x *CollateralUsedBreakdown) GetLockedInNftBids() string {
+	if x != nil {
+		return x.LockedInNftBids
+	}
+	return ""
+}
+
+func (x *CollateralUsedBreakdown) GetLockedInFeeVoucher() string {
+	if x != nil {
+		return x.LockedInFeeVoucher
+	}
+	return ""
+}
+
+func (x *CollateralUsedBreakdown) GetLockedInSpotMarginFundingOffers() string {
+	if x != nil {
+		return x.LockedInSpotMarginFundingOffers
+	}
+	return ""
+}
+
+func (x *CollateralUsedBreakdown) GetLockedInSpotOrders() string {
+	if x != nil {
+		return x.LockedInSpotOrders
+	}
+	return ""
+}
+
+func (x *CollateralUsedBreakdown) GetLockedAsCollateral() string {
+	if x != nil {
+		return x.LockedAsCollateral
+	}
+	return ""
+}
+
+func (x *CollateralUsedBreakdown) GetUsedInFutures() string {
+	if x != nil {
+		return x.UsedInFutures
+	}
+	return ""
+}
+
+func (x *CollateralUsedBreakdown) GetUsedInSpotMargin() string {
+	if x != nil {
+		return x.UsedInSpotMargin
+	}
+	return ""
+}
+
+type GetFundingRatesRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Exchange             string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset                string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair                 *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	StartDate            string                 `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate              string                 `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	PaymentCurrency      string                 `protobuf:"bytes,6,opt,name=payment_currency,json=paymentCurrency,proto3" json:"payment_currency,omitempty"`
+	IncludePredicted     bool                   `protobuf:"varint,7,opt,name=include_predicted,json=includePredicted,proto3" json:"include_predicted,omitempty"`
+	IncludePayments      bool                   `protobuf:"varint,8,opt,name=include_payments,json=includePayments,proto3" json:"include_payments,omitempty"`
+	RespectHistoryLimits bool                   `protobuf:"varint,9,opt,name=respect_history_limits,json=respectHistoryLimits,proto3" json:"respect_history_limits,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *GetFundingRatesRequest) Reset() {
+	*x = GetFundingRatesRequest{}
+	mi := &file_rpc_proto_msgTypes[215]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFundingRatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFundingRatesRequest) ProtoMessage() {}
+
+func (x *GetFundingRatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[215]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFundingRatesRequest.ProtoReflect.Descriptor instead.
+func (*GetFundingRatesRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{215}
+}
+
+func (x *GetFundingRatesRequest) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *GetFundingRatesRequest) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *GetFundingRatesRequest) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *GetFundingRatesRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *GetFundingRatesRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *GetFundingRatesRequest) GetPaymentCurrency() string {
+	if x != nil {
+		return x.PaymentCurrency
+	}
+	return ""
+}
+
+func (x *GetFundingRatesRequest) GetIncludePredicted() bool {
+	if x != nil {
+		return x.IncludePredicted
+	}
+	return false
+}
+
+func (x *GetFundingRatesRequest) GetIncludePayments() bool {
+	if x != nil {
+		return x.IncludePayments
+	}
+	return false
+}
+
+func (x *GetFundingRatesRequest) GetRespectHistoryLimits() bool {
+	if x != nil {
+		return x.RespectHistoryLimits
+	}
+	return false
+}
+
+type GetFundingRatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rates         *FundingData           `protobuf:"bytes,1,opt,name=rates,proto3" json:"rates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFundingRatesResponse) Reset() {
+	*x = GetFundingRatesResponse{}
+	mi := &file_rpc_proto_msgTypes[216]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFundingRatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFundingRatesResponse) ProtoMessage() {}
+
+func (x *GetFundingRatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[216]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFundingRatesResponse.ProtoReflect.Descriptor instead.
+func (*GetFundingRatesResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{216}
+}
+
+func (x *GetFundingRatesResponse) GetRates() *FundingData {
+	if x != nil {
+		return x.Rates
+	}
+	return nil
+}
+
+type GetLatestFundingRateRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Exchange         string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset            string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair             *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	IncludePredicted bool                   `protobuf:"varint,4,opt,name=include_predicted,json=includePredicted,proto3" json:"include_predicted,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetLatestFundingRateRequest) Reset() {
+	*x = GetLatestFundingRateRequest{}
+	mi := &file_rpc_proto_msgTypes[217]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLatestFundingRateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLatestFundingRateRequest) ProtoMessage() {}
+
+func (x *GetLatestFundingRateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[217]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLatestFundingRateRequest.ProtoReflect.Descriptor instead.
+func (*GetLatestFundingRateRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{217}
+}
+
+func (x *GetLatestFundingRateRequest) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *GetLatestFundingRateRequest) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *GetLatestFundingRateRequest) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *GetLatestFundingRateRequest) GetIncludePredicted() bool {
+	if x != nil {
+		return x.IncludePredicted
+	}
+	return false
+}
+
+type GetLatestFundingRateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rate          *FundingData           `protobuf:"bytes,1,opt,name=rate,proto3" json:"rate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLatestFundingRateResponse) Reset() {
+	*x = GetLatestFundingRateResponse{}
+	mi := &file_rpc_proto_msgTypes[218]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLatestFundingRateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLatestFundingRateResponse) ProtoMessage() {}
+
+func (x *GetLatestFundingRateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[218]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLatestFundingRateResponse.ProtoReflect.Descriptor instead.
+func (*GetLatestFundingRateResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{218}
+}
+
+func (x *GetLatestFundingRateResponse) GetRate() *FundingData {
+	if x != nil {
+		return x.Rate
+	}
+	return nil
+}
+
+type ShutdownRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShutdownRequest) Reset() {
+	*x = ShutdownRequest{}
+	mi := &file_rpc_proto_msgTypes[219]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShutdownRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShutdownRequest) ProtoMessage() {}
+
+func (x *ShutdownRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[219]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShutdownRequest.ProtoReflect.Descriptor instead.
+func (*ShutdownRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{219}
+}
+
+type ShutdownResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShutdownResponse) Reset() {
+	*x = ShutdownResponse{}
+	mi := &file_rpc_proto_msgTypes[220]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShutdownResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShutdownResponse) ProtoMessage() {}
+
+func (x *ShutdownResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[220]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShutdownResponse.ProtoReflect.Descriptor instead.
+func (*ShutdownResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{220}
+}
+
+type GetTechnicalAnalysisRequest struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Exchange              string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair                  *CurrencyPair          `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType             string                 `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	AlgorithmType         string                 `protobuf:"bytes,4,opt,name=algorithm_type,json=algorithmType,proto3" json:"algorithm_type,omitempty"`
+	Interval              int64                  `protobuf:"varint,5,opt,name=interval,proto3" json:"interval,omitempty"`
+	Start                 *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=start,proto3" json:"start,omitempty"`
+	End                   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=end,proto3" json:"end,omitempty"`
+	Period                int64                  `protobuf:"varint,8,opt,name=period,proto3" json:"period,omitempty"`
+	FastPeriod            int64                  `protobuf:"varint,9,opt,name=fast_period,json=fastPeriod,proto3" json:"fast_period,omitempty"`
+	SlowPeriod            int64                  `protobuf:"varint,10,opt,name=slow_period,json=slowPeriod,proto3" json:"slow_period,omitempty"`
+	StandardDeviationUp   float64                `protobuf:"fixed64,11,opt,name=standard_deviation_up,json=standardDeviationUp,proto3" json:"standard_deviation_up,omitempty"`
+	StandardDeviationDown float64                `protobuf:"fixed64,12,opt,name=standard_deviation_down,json=standardDeviationDown,proto3" json:"standard_deviation_down,omitempty"`
+	MovingAverageType     int64                  `protobuf:"varint,13,opt,name=moving_average_type,json=movingAverageType,proto3" json:"moving_average_type,omitempty"`
+	OtherExchange         string                 `protobuf:"bytes,14,opt,name=other_exchange,json=otherExchange,proto3" json:"other_exchange,omitempty"`
+	OtherPair             *CurrencyPair          `protobuf:"bytes,15,opt,name=other_pair,json=otherPair,proto3" json:"other_pair,omitempty"`
+	OtherAssetType        string                 `protobuf:"bytes,16,opt,name=other_asset_type,json=otherAssetType,proto3" json:"other_asset_type,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *GetTechnicalAnalysisRequest) Reset() {
+	*x = GetTechnicalAnalysisRequest{}
+	mi := &file_rpc_proto_msgTypes[221]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTechnicalAnalysisRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTechnicalAnalysisRequest) ProtoMessage() {}
+
+func (x *GetTechnicalAnalysisRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[221]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTechnicalAnalysisRequest.ProtoReflect.Descriptor instead.
+func (*GetTechnicalAnalysisRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{221}
+}
+
+func (x *GetTechnicalAnalysisRequest) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *GetTechnicalAnalysisRequest) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *GetTechnicalAnalysisRequest) GetAssetType() string {
+	if x != nil {
+		return x.AssetType
+	}
+	return ""
+}
+
+func (x *GetTechnicalAnalysisRequest) GetAlgorithmType() string {
+	if x != nil {
+		return x.AlgorithmType
+	}
+	return ""
+}
+
+func (x *GetTechnicalAnalysisRequest) GetInterval() int64 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
+}
+
+func (x *GetTechnicalAnalysisRequest) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *GetTechnicalAnalysisRequest) GetEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+func (x *GetTechnicalAnalysisRequest) GetPeriod() int64 {
+	if x != nil {
+		return x.Period
+	}
+	return 0
+}
+
+func (x *GetTechnicalAnalysisRequest) GetFastPeriod() int64 {
+	if x != nil {
+		return x.FastPeriod
+	}
+	return 0
+}
+
+func (x *GetTechnicalAnalysisRequest) GetSlowPeriod() int64 {
+	if x != nil {
+		return x.SlowPeriod
+	}
+	return 0
+}
+
+func (x *GetTechnicalAnalysisRequest) GetStandardDeviationUp() float64 {
+	if x != nil {
+		return x.StandardDeviationUp
+	}
+	return 0
+}
+
+func (x *GetTechnicalAnalysisRequest) GetStandardDeviationDown() float64 {
+	if x != nil {
+		return x.StandardDeviationDown
+	}
+	return 0
+}
+
+func (x *GetTechnicalAnalysisRequest) GetMovingAverageType() int64 {
+	if x != nil {
+		return x.MovingAverageType
+	}
+	return 0
+}
+
+func (x *GetTechnicalAnalysisRequest) GetOtherExchange() string {
+	if x != nil {
+		return x.OtherExchange
+	}
+	return ""
+}
+
+func (x *GetTechnicalAnalysisRequest) GetOtherPair() *CurrencyPair {
+	if x != nil {
+		return x.OtherPair
+	}
+	return nil
+}
+
+func (x *GetTechnicalAnalysisRequest) GetOtherAssetType() string {
+	if x != nil {
+		return x.OtherAssetType
+	}
+	return ""
+}
+
+type ListOfSignals struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Signals       []float64              `protobuf:"fixed64,1,rep,packed,name=signals,proto3" json:"signals,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOfSignals) Reset() {
+	*x = ListOfSignals{}
+	mi := &file_rpc_proto_msgTypes[222]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOfSignals) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOfSignals) ProtoMessage() {}
+
+func (x *ListOfSignals) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[222]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOfSignals.ProtoReflect.Descriptor instead.
+func (*ListOfSignals) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{222}
+}
+
+func (x *ListOfSignals) GetSignals() []float64 {
+	if x != nil {
+		return x.Signals
+	}
+	return nil
+}
+
+type GetTechnicalAnalysisResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Signals       map[string]*ListOfSignals `protobuf:"bytes,1,rep,name=signals,proto3" json:"signals,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTechnicalAnalysisResponse) Reset() {
+	*x = GetTechnicalAnalysisResponse{}
+	mi := &file_rpc_proto_msgTypes[223]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTechnicalAnalysisResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTechnicalAnalysisResponse) ProtoMessage() {}
+
+func (x *GetTechnicalAnalysisResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[223]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTechnicalAnalysisResponse.ProtoReflect.Descriptor instead.
+func (*GetTechnicalAnalysisResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{223}
+}
+
+func (x *GetTechnicalAnalysisResponse) GetSignals() map[string]*ListOfSignals {
+	if x != nil {
+		return x.Signals
+	}
+	return nil
+}
+
+type GetMarginRatesHistoryRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Exchange           string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset              string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Currency           string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	StartDate          string                 `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate            string                 `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	GetPredictedRate   bool                   `protobuf:"varint,6,opt,name=get_predicted_rate,json=getPredictedRate,proto3" json:"get_predicted_rate,omitempty"`
+	GetLendingPayments bool                   `protobuf:"varint,7,opt,name=get_lending_payments,json=getLendingPayments,proto3" json:"get_lending_payments,omitempty"`
+	GetBorrowRates     bool                   `protobuf:"varint,8,opt,name=get_borrow_rates,json=getBorrowRates,proto3" json:"get_borrow_rates,omitempty"`
+	GetBorrowCosts     bool                   `protobuf:"varint,9,opt,name=get_borrow_costs,json=getBorrowCosts,proto3" json:"get_borrow_costs,omitempty"`
+	IncludeAllRates    bool                   `protobuf:"varint,10,opt,name=include_all_rates,json=includeAllRates,proto3" json:"include_all_rates,omitempty"`
+	CalculateOffline   bool                   `protobuf:"varint,11,opt,name=calculate_offline,json=calculateOffline,proto3" json:"calculate_offline,omitempty"`
+	TakerFeeRate       string                 `protobuf:"bytes,12,opt,name=taker_fee_rate,json=takerFeeRate,proto3" json:"taker_fee_rate,omitempty"`
+	Rates              []*MarginRate          `protobuf:"bytes,13,rep,name=rates,proto3" json:"rates,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *GetMarginRatesHistoryRequest) Reset() {
+	*x = GetMarginRatesHistoryRequest{}
+	mi := &file_rpc_proto_msgTypes[224]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetFuturesPositionsOrdersRequest) String() string {
+func (x *GetMarginRatesHistoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFuturesPositionsOrdersRequest) ProtoMessage() {}
+func (*GetMarginRatesHistoryRequest) ProtoMessage() {}
 
-func (x *GetFuturesPositionsOrdersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[179]
+func (x *GetMarginRatesHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[224]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11410,89 +14875,125 @@ func (x *GetFuturesPositionsOrdersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFuturesPositionsOrdersRequest.ProtoReflect.Descriptor instead.
-func (*GetFuturesPositionsOrdersRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{179}
+// Deprecated: Use GetMarginRatesHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetMarginRatesHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{224}
 }
 
-func (x *GetFuturesPositionsOrdersRequest) GetExchange() string {
+func (x *GetMarginRatesHistoryRequest) GetExchange() string {
 	if x != nil {
 		return x.Exchange
 	}
 	return ""
 }
 
-func (x *GetFuturesPositionsOrdersRequest) GetAsset() string {
+func (x *GetMarginRatesHistoryRequest) GetAsset() string {
 	if x != nil {
 		return x.Asset
 	}
 	return ""
 }
 
-func (x *GetFuturesPositionsOrdersRequest) GetPair() *CurrencyPair {
+func (x *GetMarginRatesHistoryRequest) GetCurrency() string {
 	if x != nil {
-		return x.Pair
+		return x.Currency
 	}
-	return nil
+	return ""
 }
 
-func (x *GetFuturesPositionsOrdersRequest) GetUnderlyingPair() *CurrencyPair {
+func (x *GetMarginRatesHistoryRequest) GetStartDate() string {
 	if x != nil {
-		return x.UnderlyingPair
+		return x.StartDate
 	}
-	return nil
+	return ""
 }
 
-func (x *GetFuturesPositionsOrdersRequest) GetStartDate() string {
+func (x *GetMarginRatesHistoryRequest) GetEndDate() string {
 	if x != nil {
-		return x.StartDate
+		return x.EndDate
 	}
 	return ""
 }
 
-func (x *GetFuturesPositionsOrdersRequest) GetEndDate() string {
+func (x *GetMarginRatesHistoryRequest) GetGetPredictedRate() bool {
 	if x != nil {
-		return x.EndDate
+		return x.GetPredictedRate
 	}
-	return ""
+	return false
 }
 
-func (x *GetFuturesPositionsOrdersRequest) GetRespectOrderHistoryLimits() bool {
+func (x *GetMarginRatesHistoryRequest) GetGetLendingPayments() bool {
 	if x != nil {
-		return x.RespectOrderHistoryLimits
+		return x.GetLendingPayments
 	}
 	return false
 }
 
-func (x *GetFuturesPositionsOrdersRequest) GetSyncWithOrderManager() bool {
+func (x *GetMarginRatesHistoryRequest) GetGetBorrowRates() bool {
 	if x != nil {
-		return x.SyncWithOrderManager
+		return x.GetBorrowRates
 	}
 	return false
 }
 
-type GetFuturesPositionsOrdersResponse struct {
+func (x *GetMarginRatesHistoryRequest) GetGetBorrowCosts() bool {
+	if x != nil {
+		return x.GetBorrowCosts
+	}
+	return false
+}
+
+func (x *GetMarginRatesHistoryRequest) GetIncludeAllRates() bool {
+	if x != nil {
+		return x.IncludeAllRates
+	}
+	return false
+}
+
+func (x *GetMarginRatesHistoryRequest) GetCalculateOffline() bool {
+	if x != nil {
+		return x.CalculateOffline
+	}
+	return false
+}
+
+func (x *GetMarginRatesHistoryRequest) GetTakerFeeRate() string {
+	if x != nil {
+		return x.TakerFeeRate
+	}
+	return ""
+}
+
+func (x *GetMarginRatesHistoryRequest) GetRates() []*MarginRate {
+	if x != nil {
+		return x.Rates
+	}
+	return nil
+}
+
+type LendingPayment struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Positions     []*FuturePosition      `protobuf:"bytes,6,rep,name=positions,proto3" json:"positions,omitempty"`
+	Payment       string                 `protobuf:"bytes,1,opt,name=payment,proto3" json:"payment,omitempty"`
+	Size          string                 `protobuf:"bytes,2,opt,name=size,proto3" json:"size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetFuturesPositionsOrdersResponse) Reset() {
-	*x = GetFuturesPositionsOrdersResponse{}
-	mi := &file_rpc_proto_msgTypes[180]
+func (x *LendingPayment) Reset() {
+	*x = LendingPayment{}
+	mi := &file_rpc_proto_msgTypes[225]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetFuturesPositionsOrdersResponse) String() string {
+func (x *LendingPayment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFuturesPositionsOrdersResponse) ProtoMessage() {}
+func (*LendingPayment) ProtoMessage() {}
 
-func (x *GetFuturesPositionsOrdersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[180]
+func (x *LendingPayment) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[225]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11503,41 +15004,48 @@ func (x *GetFuturesPositionsOrdersResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFuturesPositionsOrdersResponse.ProtoReflect.Descriptor instead.
-func (*GetFuturesPositionsOrdersResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{180}
+// Deprecated: Use LendingPayment.ProtoReflect.Descriptor instead.
+func (*LendingPayment) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{225}
 }
 
-func (x *GetFuturesPositionsOrdersResponse) GetPositions() []*FuturePosition {
+func (x *LendingPayment) GetPayment() string {
 	if x != nil {
-		return x.Positions
+		return x.Payment
 	}
-	return nil
+	return ""
 }
 
-type GetCollateralModeRequest struct {
+func (x *LendingPayment) GetSize() string {
+	if x != nil {
+		return x.Size
+	}
+	return ""
+}
+
+type BorrowCost struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Cost          string                 `protobuf:"bytes,1,opt,name=cost,proto3" json:"cost,omitempty"`
+	Size          string                 `protobuf:"bytes,2,opt,name=size,proto3" json:"size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCollateralModeRequest) Reset() {
-	*x = GetCollateralModeRequest{}
-	mi := &file_rpc_proto_msgTypes[181]
+func (x *BorrowCost) Reset() {
+	*x = BorrowCost{}
+	mi := &file_rpc_proto_msgTypes[226]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCollateralModeRequest) String() string {
+func (x *BorrowCost) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCollateralModeRequest) ProtoMessage() {}
+func (*BorrowCost) ProtoMessage() {}
 
-func (x *GetCollateralModeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[181]
+func (x *BorrowCost) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[226]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11548,49 +15056,54 @@ func (x *GetCollateralModeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCollateralModeRequest.ProtoReflect.Descriptor instead.
-func (*GetCollateralModeRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{181}
+// Deprecated: Use BorrowCost.ProtoReflect.Descriptor instead.
+func (*BorrowCost) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{226}
 }
 
-func (x *GetCollateralModeRequest) GetExchange() string {
+func (x *BorrowCost) GetCost() string {
 	if x != nil {
-		return x.Exchange
+		return x.Cost
 	}
 	return ""
 }
 
-func (x *GetCollateralModeRequest) GetAsset() string {
+func (x *BorrowCost) GetSize() string {
 	if x != nil {
-		return x.Asset
+		return x.Size
 	}
 	return ""
 }
 
-type GetCollateralModeResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Exchange       string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset          string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	CollateralMode string                 `protobuf:"bytes,3,opt,name=collateral_mode,json=collateralMode,proto3" json:"collateral_mode,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type MarginRate struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Time             string                 `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	MarketBorrowSize string                 `protobuf:"bytes,2,opt,name=market_borrow_size,json=marketBorrowSize,proto3" json:"market_borrow_size,omitempty"`
+	HourlyRate       string                 `protobuf:"bytes,3,opt,name=hourly_rate,json=hourlyRate,proto3" json:"hourly_rate,omitempty"`
+	YearlyRate       string                 `protobuf:"bytes,4,opt,name=yearly_rate,json=yearlyRate,proto3" json:"yearly_rate,omitempty"`
+	HourlyBorrowRate string                 `protobuf:"bytes,5,opt,name=hourly_borrow_rate,json=hourlyBorrowRate,proto3" json:"hourly_borrow_rate,omitempty"`
+	YearlyBorrowRate string                 `protobuf:"bytes,6,opt,name=yearly_borrow_rate,json=yearlyBorrowRate,proto3" json:"yearly_borrow_rate,omitempty"`
+	LendingPayment   *LendingPayment        `protobuf:"bytes,7,opt,name=lending_payment,json=lendingPayment,proto3" json:"lending_payment,omitempty"`
+	BorrowCost       *BorrowCost            `protobuf:"bytes,8,opt,name=borrow_cost,json=borrowCost,proto3" json:"borrow_cost,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *GetCollateralModeResponse) Reset() {
-	*x = GetCollateralModeResponse{}
-	mi := &file_rpc_proto_msgTypes[182]
+func (x *MarginRate) Reset() {
+	*x = MarginRate{}
+	mi := &file_rpc_proto_msgTypes[227]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCollateralModeResponse) String() string {
+func (x *MarginRate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCollateralModeResponse) ProtoMessage() {}
+func (*MarginRate) ProtoMessage() {}
 
-func (x *GetCollateralModeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[182]
+func (x *MarginRate) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[227]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11601,56 +15114,97 @@ func (x *GetCollateralModeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCollateralModeResponse.ProtoReflect.Descriptor instead.
-func (*GetCollateralModeResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{182}
+// Deprecated: Use MarginRate.ProtoReflect.Descriptor instead.
+func (*MarginRate) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{227}
 }
 
-func (x *GetCollateralModeResponse) GetExchange() string {
+func (x *MarginRate) GetTime() string {
 	if x != nil {
-		return x.Exchange
+		return x.Time
 	}
 	return ""
 }
 
-func (x *GetCollateralModeResponse) GetAsset() string {
+func (x *MarginRate) GetMarketBorrowSize() string {
 	if x != nil {
-		return x.Asset
+		return x.MarketBorrowSize
 	}
 	return ""
 }
 
-func (x *GetCollateralModeResponse) GetCollateralMode() string {
+func (x *MarginRate) GetHourlyRate() string {
 	if x != nil {
-		return x.CollateralMode
+		return x.HourlyRate
 	}
 	return ""
 }
 
-type SetCollateralModeRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Exchange       string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset          string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	CollateralMode string                 `protobuf:"bytes,3,opt,name=collateral_mode,json=collateralMode,proto3" json:"collateral_mode,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *MarginRate) GetYearlyRate() string {
+	if x != nil {
+		return x.YearlyRate
+	}
+	return ""
+}
+
+func (x *MarginRate) GetHourlyBorrowRate() string {
+	if x != nil {
+		return x.HourlyBorrowRate
+	}
+	return ""
+}
+
+func (x *MarginRate) GetYearlyBorrowRate() string {
+	if x != nil {
+		return x.YearlyBorrowRate
+	}
+	return ""
+}
+
+func (x *MarginRate) GetLendingPayment() *LendingPayment {
+	if x != nil {
+		return x.LendingPayment
+	}
+	return nil
+}
+
+func (x *MarginRate) GetBorrowCost() *BorrowCost {
+	if x != nil {
+		return x.BorrowCost
+	}
+	return nil
+}
+
+type GetMarginRatesHistoryResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Rates              []*MarginRate          `protobuf:"bytes,1,rep,name=rates,proto3" json:"rates,omitempty"`
+	TotalRates         int64                  `protobuf:"varint,2,opt,name=total_rates,json=totalRates,proto3" json:"total_rates,omitempty"`
+	SumBorrowCosts     string                 `protobuf:"bytes,3,opt,name=sum_borrow_costs,json=sumBorrowCosts,proto3" json:"sum_borrow_costs,omitempty"`
+	AvgBorrowSize      string                 `protobuf:"bytes,4,opt,name=avg_borrow_size,json=avgBorrowSize,proto3" json:"avg_borrow_size,omitempty"`
+	SumLendingPayments string                 `protobuf:"bytes,5,opt,name=sum_lending_payments,json=sumLendingPayments,proto3" json:"sum_lending_payments,omitempty"`
+	AvgLendingSize     string                 `protobuf:"bytes,6,opt,name=avg_lending_size,json=avgLendingSize,proto3" json:"avg_lending_size,omitempty"`
+	LatestRate         *MarginRate            `protobuf:"bytes,7,opt,name=latest_rate,json=latestRate,proto3" json:"latest_rate,omitempty"`
+	PredictedRate      *MarginRate            `protobuf:"bytes,8,opt,name=predicted_rate,json=predictedRate,proto3" json:"predicted_rate,omitempty"`
+	TakerFeeRate       string                 `protobuf:"bytes,9,opt,name=taker_fee_rate,json=takerFeeRate,proto3" json:"taker_fee_rate,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
-func (x *SetCollateralModeRequest) Reset() {
-	*x = SetCollateralModeRequest{}
-	mi := &file_rpc_proto_msgTypes[183]
+func (x *GetMarginRatesHistoryResponse) Reset() {
+	*x = GetMarginRatesHistoryResponse{}
+	mi := &file_rpc_proto_msgTypes[228]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetCollateralModeRequest) String() string {
+func (x *GetMarginRatesHistoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetCollateralModeRequest) ProtoMessage() {}
+func (*GetMarginRatesHistoryResponse) ProtoMessage() {}
 
-func (x *SetCollateralModeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[183]
+func (x *GetMarginRatesHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[228]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11661,116 +15215,102 @@ func (x *SetCollateralModeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetCollateralModeRequest.ProtoReflect.Descriptor instead.
-func (*SetCollateralModeRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{183}
+// Deprecated: Use GetMarginRatesHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetMarginRatesHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{228}
 }
 
-func (x *SetCollateralModeRequest) GetExchange() string {
+func (x *GetMarginRatesHistoryResponse) GetRates() []*MarginRate {
 	if x != nil {
-		return x.Exchange
+		return x.Rates
 	}
-	return ""
+	return nil
 }
 
-func (x *SetCollateralModeRequest) GetAsset() string {
+func (x *GetMarginRatesHistoryResponse) GetTotalRates() int64 {
 	if x != nil {
-		return x.Asset
+		return x.TotalRates
 	}
-	return ""
+	return 0
 }
 
-func (x *SetCollateralModeRequest) GetCollateralMode() string {
+func (x *GetMarginRatesHistoryResponse) GetSumBorrowCosts() string {
 	if x != nil {
-		return x.CollateralMode
+		return x.SumBorrowCosts
 	}
 	return ""
 }
 
-type SetCollateralModeResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Success       bool                   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *SetCollateralModeResponse) Reset() {
-	*x = SetCollateralModeResponse{}
-	mi := &file_rpc_proto_msgTypes[184]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *SetCollateralModeResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GetMarginRatesHistoryResponse) GetAvgBorrowSize() string {
+	if x != nil {
+		return x.AvgBorrowSize
+	}
+	return ""
 }
 
-func (*SetCollateralModeResponse) ProtoMessage() {}
-
-func (x *SetCollateralModeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[184]
+func (x *GetMarginRatesHistoryResponse) GetSumLendingPayments() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.SumLendingPayments
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use SetCollateralModeResponse.ProtoReflect.Descriptor instead.
-func (*SetCollateralModeResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{184}
+func (x *GetMarginRatesHistoryResponse) GetAvgLendingSize() string {
+	if x != nil {
+		return x.AvgLendingSize
+	}
+	return ""
 }
 
-func (x *SetCollateralModeResponse) GetExchange() string {
+func (x *GetMarginRatesHistoryResponse) GetLatestRate() *MarginRate {
 	if x != nil {
-		return x.Exchange
+		return x.LatestRate
 	}
-	return ""
+	return nil
 }
 
-func (x *SetCollateralModeResponse) GetAsset() string {
+func (x *GetMarginRatesHistoryResponse) GetPredictedRate() *MarginRate {
 	if x != nil {
-		return x.Asset
+		return x.PredictedRate
 	}
-	return ""
+	return nil
 }
 
-func (x *SetCollateralModeResponse) GetSuccess() bool {
+func (x *GetMarginRatesHistoryResponse) GetTakerFeeRate() string {
 	if x != nil {
-		return x.Success
+		return x.TakerFeeRate
 	}
-	return false
+	return ""
 }
 
-type GetMarginTypeRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type GetOrderbookMovementRequest struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Exchange              string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset                 string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair                  *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	Amount                float64                `protobuf:"fixed64,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	Sell                  bool                   `protobuf:"varint,7,opt,name=sell,proto3" json:"sell,omitempty"`
+	RequiresRestOrderbook bool                   `protobuf:"varint,8,opt,name=requires_rest_orderbook,json=requiresRestOrderbook,proto3" json:"requires_rest_orderbook,omitempty"`
+	Purchase              bool                   `protobuf:"varint,9,opt,name=purchase,proto3" json:"purchase,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
-func (x *GetMarginTypeRequest) Reset() {
-	*x = GetMarginTypeRequest{}
-	mi := &file_rpc_proto_msgTypes[185]
+func (x *GetOrderbookMovementRequest) Reset() {
+	*x = GetOrderbookMovementRequest{}
+	mi := &file_rpc_proto_msgTypes[229]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMarginTypeRequest) String() string {
+func (x *GetOrderbookMovementRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMarginTypeRequest) ProtoMessage() {}
+func (*GetOrderbookMovementRequest) ProtoMessage() {}
 
-func (x *GetMarginTypeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[185]
+func (x *GetOrderbookMovementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[229]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11781,57 +15321,95 @@ func (x *GetMarginTypeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMarginTypeRequest.ProtoReflect.Descriptor instead.
-func (*GetMarginTypeRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{185}
+// Deprecated: Use GetOrderbookMovementRequest.ProtoReflect.Descriptor instead.
+func (*GetOrderbookMovementRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{229}
 }
 
-func (x *GetMarginTypeRequest) GetExchange() string {
+func (x *GetOrderbookMovementRequest) GetExchange() string {
 	if x != nil {
 		return x.Exchange
 	}
 	return ""
 }
 
-func (x *GetMarginTypeRequest) GetAsset() string {
+func (x *GetOrderbookMovementRequest) GetAsset() string {
 	if x != nil {
 		return x.Asset
 	}
 	return ""
 }
 
-func (x *GetMarginTypeRequest) GetPair() *CurrencyPair {
+func (x *GetOrderbookMovementRequest) GetPair() *CurrencyPair {
 	if x != nil {
 		return x.Pair
 	}
 	return nil
 }
 
-type GetMarginTypeResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	MarginType    string                 `protobuf:"bytes,4,opt,name=margin_type,json=marginType,proto3" json:"margin_type,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *GetOrderbookMovementRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
 }
 
-func (x *GetMarginTypeResponse) Reset() {
-	*x = GetMarginTypeResponse{}
-	mi := &file_rpc_proto_msgTypes[186]
+func (x *GetOrderbookMovementRequest) GetSell() bool {
+	if x != nil {
+		return x.Sell
+	}
+	return false
+}
+
+func (x *GetOrderbookMovementRequest) GetRequiresRestOrderbook() bool {
+	if x != nil {
+		return x.RequiresRestOrderbook
+	}
+	return false
+}
+
+func (x *GetOrderbookMovementRequest) GetPurchase() bool {
+	if x != nil {
+		return x.Purchase
+	}
+	return false
+}
+
+type GetOrderbookMovementResponse struct {
+	state                     protoimpl.MessageState `protogen:"open.v1"`
+	NominalPercentage         float64                `protobuf:"fixed64,1,opt,name=nominal_percentage,json=nominalPercentage,proto3" json:"nominal_percentage,omitempty"`
+	ImpactPercentage          float64                `protobuf:"fixed64,2,opt,name=impact_percentage,json=impactPercentage,proto3" json:"impact_percentage,omitempty"`
+	SlippageCost              float64                `protobuf:"fixed64,3,opt,name=slippage_cost,json=slippageCost,proto3" json:"slippage_cost,omitempty"`
+	CurrencyBought            string                 `protobuf:"bytes,4,opt,name=currency_bought,json=currencyBought,proto3" json:"currency_bought,omitempty"`
+	Bought                    float64                `protobuf:"fixed64,5,opt,name=bought,proto3" json:"bought,omitempty"`
+	CurrencySold              string                 `protobuf:"bytes,6,opt,name=currency_sold,json=currencySold,proto3" json:"currency_sold,omitempty"`
+	Sold                      float64                `protobuf:"fixed64,7,opt,name=sold,proto3" json:"sold,omitempty"`
+	SideAffected              string                 `protobuf:"bytes,8,opt,name=side_affected,json=sideAffected,proto3" json:"side_affected,omitempty"`
+	UpdateProtocol            string                 `protobuf:"bytes,9,opt,name=update_protocol,json=updateProtocol,proto3" json:"update_protocol,omitempty"`
+	FullOrderbookSideConsumed bool                   `protobuf:"varint,10,opt,name=full_orderbook_side_consumed,json=fullOrderbookSideConsumed,proto3" json:"full_orderbook_side_consumed,omitempty"`
+	StartPrice                float64                `protobuf:"fixed64,11,opt,name=start_price,json=startPrice,proto3" json:"start_price,omitempty"`
+	EndPrice                  float64                `protobuf:"fixed64,12,opt,name=end_price,json=endPrice,proto3" json:"end_price,omitempty"`
+	NoSlippageOccurred        bool                   `protobuf:"varint,13,opt,name=no_slippage_occurred,json=noSlippageOccurred,proto3" json:"no_slippage_occurred,omitempty"`
+	AverageOrderCost          float64                `protobuf:"fixed64,14,opt,name=average_order_cost,json=averageOrderCost,proto3" json:"average_order_cost,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *GetOrderbookMovementResponse) Reset() {
+	*x = GetOrderbookMovementResponse{}
+	mi := &file_rpc_proto_msgTypes[230]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMarginTypeResponse) String() string {
+func (x *GetOrderbookMovementResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMarginTypeResponse) ProtoMessage() {}
+func (*GetOrderbookMovementResponse) ProtoMessage() {}
 
-func (x *GetMarginTypeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[186]
+func (x *GetOrderbookMovementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[230]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11842,158 +15420,136 @@ func (x *GetMarginTypeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMarginTypeResponse.ProtoReflect.Descriptor instead.
-func (*GetMarginTypeResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{186}
+// Deprecated: Use GetOrderbookMovementResponse.ProtoReflect.Descriptor instead.
+func (*GetOrderbookMovementResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{230}
 }
 
-func (x *GetMarginTypeResponse) GetExchange() string {
+func (x *GetOrderbookMovementResponse) GetNominalPercentage() float64 {
 	if x != nil {
-		return x.Exchange
+		return x.NominalPercentage
 	}
-	return ""
+	return 0
 }
 
-func (x *GetMarginTypeResponse) GetAsset() string {
+func (x *GetOrderbookMovementResponse) GetImpactPercentage() float64 {
 	if x != nil {
-		return x.Asset
+		return x.ImpactPercentage
 	}
-	return ""
+	return 0
 }
 
-func (x *GetMarginTypeResponse) GetPair() *CurrencyPair {
+func (x *GetOrderbookMovementResponse) GetSlippageCost() float64 {
 	if x != nil {
-		return x.Pair
+		return x.SlippageCost
 	}
-	return nil
+	return 0
 }
 
-func (x *GetMarginTypeResponse) GetMarginType() string {
+func (x *GetOrderbookMovementResponse) GetCurrencyBought() string {
 	if x != nil {
-		return x.MarginType
+		return x.CurrencyBought
 	}
 	return ""
 }
 
-type ChangePositionMarginRequest struct {
-	state                   protoimpl.MessageState `protogen:"open.v1"`
-	Exchange                string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset                   string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair                    *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	MarginType              string                 `protobuf:"bytes,4,opt,name=margin_type,json=marginType,proto3" json:"margin_type,omitempty"`
-	OriginalAllocatedMargin float64                `protobuf:"fixed64,5,opt,name=original_allocated_margin,json=originalAllocatedMargin,proto3" json:"original_allocated_margin,omitempty"`
-	NewAllocatedMargin      float64                `protobuf:"fixed64,6,opt,name=new_allocated_margin,json=newAllocatedMargin,proto3" json:"new_allocated_margin,omitempty"`
-	MarginSide              string                 `protobuf:"bytes,7,opt,name=margin_side,json=marginSide,proto3" json:"margin_side,omitempty"`
-	unknownFields           protoimpl.UnknownFields
-	sizeCache               protoimpl.SizeCache
-}
-
-func (x *ChangePositionMarginRequest) Reset() {
-	*x = ChangePositionMarginRequest{}
-	mi := &file_rpc_proto_msgTypes[187]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ChangePositionMarginRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GetOrderbookMovementResponse) GetBought() float64 {
+	if x != nil {
+		return x.Bought
+	}
+	return 0
 }
 
-func (*ChangePositionMarginRequest) ProtoMessage() {}
-
-func (x *ChangePositionMarginRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[187]
+func (x *GetOrderbookMovementResponse) GetCurrencySold() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.CurrencySold
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use ChangePositionMarginRequest.ProtoReflect.Descriptor instead.
-func (*ChangePositionMarginRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{187}
+func (x *GetOrderbookMovementResponse) GetSold() float64 {
+	if x != nil {
+		return x.Sold
+	}
+	return 0
 }
 
-func (x *ChangePositionMarginRequest) GetExchange() string {
+func (x *GetOrderbookMovementResponse) GetSideAffected() string {
 	if x != nil {
-		return x.Exchange
+		return x.SideAffected
 	}
 	return ""
 }
 
-func (x *ChangePositionMarginRequest) GetAsset() string {
+func (x *GetOrderbookMovementResponse) GetUpdateProtocol() string {
 	if x != nil {
-		return x.Asset
+		return x.UpdateProtocol
 	}
 	return ""
 }
 
-func (x *ChangePositionMarginRequest) GetPair() *CurrencyPair {
+func (x *GetOrderbookMovementResponse) GetFullOrderbookSideConsumed() bool {
 	if x != nil {
-		return x.Pair
+		return x.FullOrderbookSideConsumed
 	}
-	return nil
+	return false
 }
 
-func (x *ChangePositionMarginRequest) GetMarginType() string {
+func (x *GetOrderbookMovementResponse) GetStartPrice() float64 {
 	if x != nil {
-		return x.MarginType
+		return x.StartPrice
 	}
-	return ""
+	return 0
 }
 
-func (x *ChangePositionMarginRequest) GetOriginalAllocatedMargin() float64 {
+func (x *GetOrderbookMovementResponse) GetEndPrice() float64 {
 	if x != nil {
-		return x.OriginalAllocatedMargin
+		return x.EndPrice
 	}
 	return 0
 }
 
-func (x *ChangePositionMarginRequest) GetNewAllocatedMargin() float64 {
+func (x *GetOrderbookMovementResponse) GetNoSlippageOccurred() bool {
 	if x != nil {
-		return x.NewAllocatedMargin
+		return x.NoSlippageOccurred
 	}
-	return 0
+	return false
 }
 
-func (x *ChangePositionMarginRequest) GetMarginSide() string {
+func (x *GetOrderbookMovementResponse) GetAverageOrderCost() float64 {
 	if x != nil {
-		return x.MarginSide
+		return x.AverageOrderCost
 	}
-	return ""
+	return 0
 }
 
-type ChangePositionMarginResponse struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	Exchange           string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset              string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair               *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	MarginType         string                 `protobuf:"bytes,4,opt,name=margin_type,json=marginType,proto3" json:"margin_type,omitempty"`
-	NewAllocatedMargin float64                `protobuf:"fixed64,5,opt,name=new_allocated_margin,json=newAllocatedMargin,proto3" json:"new_allocated_margin,omitempty"`
-	MarginSide         string                 `protobuf:"bytes,6,opt,name=margin_side,json=marginSide,proto3" json:"margin_side,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+type GetOrderbookAmountByNominalRequest struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Exchange              string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset                 string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair                  *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	NominalPercentage     float64                `protobuf:"fixed64,6,opt,name=nominal_percentage,json=nominalPercentage,proto3" json:"nominal_percentage,omitempty"`
+	Sell                  bool                   `protobuf:"varint,7,opt,name=sell,proto3" json:"sell,omitempty"`
+	RequiresRestOrderbook bool                   `protobuf:"varint,8,opt,name=requires_rest_orderbook,json=requiresRestOrderbook,proto3" json:"requires_rest_orderbook,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
-func (x *ChangePositionMarginResponse) Reset() {
-	*x = ChangePositionMarginResponse{}
-	mi := &file_rpc_proto_msgTypes[188]
+func (x *GetOrderbookAmountByNominalRequest) Reset() {
+	*x = GetOrderbookAmountByNominalRequest{}
+	mi := &file_rpc_proto_msgTypes[231]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ChangePositionMarginResponse) String() string {
+func (x *GetOrderbookAmountByNominalRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChangePositionMarginResponse) ProtoMessage() {}
+func (*GetOrderbookAmountByNominalRequest) ProtoMessage() {}
 
-func (x *ChangePositionMarginResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[188]
+func (x *GetOrderbookAmountByNominalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[231]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12004,78 +15560,85 @@ func (x *ChangePositionMarginResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChangePositionMarginResponse.ProtoReflect.Descriptor instead.
-func (*ChangePositionMarginResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{188}
+// Deprecated: Use GetOrderbookAmountByNominalRequest.ProtoReflect.Descriptor instead.
+func (*GetOrderbookAmountByNominalRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{231}
 }
 
-func (x *ChangePositionMarginResponse) GetExchange() string {
+func (x *GetOrderbookAmountByNominalRequest) GetExchange() string {
 	if x != nil {
 		return x.Exchange
 	}
 	return ""
 }
 
-func (x *ChangePositionMarginResponse) GetAsset() string {
+func (x *GetOrderbookAmountByNominalRequest) GetAsset() string {
 	if x != nil {
 		return x.Asset
 	}
 	return ""
 }
 
-func (x *ChangePositionMarginResponse) GetPair() *CurrencyPair {
+func (x *GetOrderbookAmountByNominalRequest) GetPair() *CurrencyPair {
 	if x != nil {
 		return x.Pair
 	}
 	return nil
 }
 
-func (x *ChangePositionMarginResponse) GetMarginType() string {
+func (x *GetOrderbookAmountByNominalRequest) GetNominalPercentage() float64 {
 	if x != nil {
-		return x.MarginType
+		return x.NominalPercentage
 	}
-	return ""
+	return 0
 }
 
-func (x *ChangePositionMarginResponse) GetNewAllocatedMargin() float64 {
+func (x *GetOrderbookAmountByNominalRequest) GetSell() bool {
 	if x != nil {
-		return x.NewAllocatedMargin
+		return x.Sell
 	}
-	return 0
+	return false
 }
 
-func (x *ChangePositionMarginResponse) GetMarginSide() string {
+func (x *GetOrderbookAmountByNominalRequest) GetRequiresRestOrderbook() bool {
 	if x != nil {
-		return x.MarginSide
+		return x.RequiresRestOrderbook
 	}
-	return ""
+	return false
 }
 
-type SetMarginTypeRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	MarginType    string                 `protobuf:"bytes,4,opt,name=margin_type,json=marginType,proto3" json:"margin_type,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type GetOrderbookAmountByNominalResponse struct {
+	state                                protoimpl.MessageState `protogen:"open.v1"`
+	AmountRequired                       float64                `protobuf:"fixed64,1,opt,name=amount_required,json=amountRequired,proto3" json:"amount_required,omitempty"`
+	CurrencySelling                      string                 `protobuf:"bytes,2,opt,name=currency_selling,json=currencySelling,proto3" json:"currency_selling,omitempty"`
+	AmountReceived                       float64                `protobuf:"fixed64,3,opt,name=amount_received,json=amountReceived,proto3" json:"amount_received,omitempty"`
+	CurrencyBuying                       string                 `protobuf:"bytes,4,opt,name=currency_buying,json=currencyBuying,proto3" json:"currency_buying,omitempty"`
+	StartPrice                           float64                `protobuf:"fixed64,5,opt,name=start_price,json=startPrice,proto3" json:"start_price,omitempty"`
+	EndPrice                             float64                `protobuf:"fixed64,6,opt,name=end_price,json=endPrice,proto3" json:"end_price,omitempty"`
+	AverageOrderCost                     float64                `protobuf:"fixed64,7,opt,name=average_order_cost,json=averageOrderCost,proto3" json:"average_order_cost,omitempty"`
+	SideAffected                         string                 `protobuf:"bytes,8,opt,name=side_affected,json=sideAffected,proto3" json:"side_affected,omitempty"`
+	ApproximateNominalSlippagePercentage float64                `protobuf:"fixed64,9,opt,name=approximate_nominal_slippage_percentage,json=approximateNominalSlippagePercentage,proto3" json:"approximate_nominal_slippage_percentage,omitempty"`
+	UpdateProtocol                       string                 `protobuf:"bytes,10,opt,name=update_protocol,json=updateProtocol,proto3" json:"update_protocol,omitempty"`
+	FullOrderbookSideConsumed            bool                   `protobuf:"varint,11,opt,name=full_orderbook_side_consumed,json=fullOrderbookSideConsumed,proto3" json:"full_orderbook_side_consumed,omitempty"`
+	unknownFields                        protoimpl.UnknownFields
+	sizeCache                            protoimpl.SizeCache
 }
 
-func (x *SetMarginTypeRequest) Reset() {
-	*x = SetMarginTypeRequest{}
-	mi := &file_rpc_proto_msgTypes[189]
+func (x *GetOrderbookAmountByNominalResponse) Reset() {
+	*x = GetOrderbookAmountByNominalResponse{}
+	mi := &file_rpc_proto_msgTypes[232]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetMarginTypeRequest) String() string {
+func (x *GetOrderbookAmountByNominalResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetMarginTypeRequest) ProtoMessage() {}
+func (*GetOrderbookAmountByNominalResponse) ProtoMessage() {}
 
-func (x *SetMarginTypeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[189]
+func (x *GetOrderbookAmountByNominalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[232]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12086,134 +15649,115 @@ func (x *SetMarginTypeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetMarginTypeRequest.ProtoReflect.Descriptor instead.
-func (*SetMarginTypeRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{189}
+// Deprecated: Use GetOrderbookAmountByNominalResponse.ProtoReflect.Descriptor instead.
+func (*GetOrderbookAmountByNominalResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{232}
 }
 
-func (x *SetMarginTypeRequest) GetExchange() string {
+func (x *GetOrderbookAmountByNominalResponse) GetAmountRequired() float64 {
 	if x != nil {
-		return x.Exchange
+		return x.AmountRequired
 	}
-	return ""
+	return 0
 }
 
-func (x *SetMarginTypeRequest) GetAsset() string {
+func (x *GetOrderbookAmountByNominalResponse) GetCurrencySelling() string {
 	if x != nil {
-		return x.Asset
+		return x.CurrencySelling
 	}
 	return ""
 }
 
-func (x *SetMarginTypeRequest) GetPair() *CurrencyPair {
+func (x *GetOrderbookAmountByNominalResponse) GetAmountReceived() float64 {
 	if x != nil {
-		return x.Pair
+		return x.AmountReceived
 	}
-	return nil
+	return 0
 }
 
-func (x *SetMarginTypeRequest) GetMarginType() string {
+func (x *GetOrderbookAmountByNominalResponse) GetCurrencyBuying() string {
 	if x != nil {
-		return x.MarginType
+		return x.CurrencyBuying
 	}
 	return ""
 }
 
-type SetMarginTypeResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	Success       bool                   `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *SetMarginTypeResponse) Reset() {
-	*x = SetMarginTypeResponse{}
-	mi := &file_rpc_proto_msgTypes[190]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *SetMarginTypeResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GetOrderbookAmountByNominalResponse) GetStartPrice() float64 {
+	if x != nil {
+		return x.StartPrice
+	}
+	return 0
 }
 
-func (*SetMarginTypeResponse) ProtoMessage() {}
-
-func (x *SetMarginTypeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[190]
+func (x *GetOrderbookAmountByNominalResponse) GetEndPrice() float64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.EndPrice
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use SetMarginTypeResponse.ProtoReflect.Descriptor instead.
-func (*SetMarginTypeResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{190}
+func (x *GetOrderbookAmountByNominalResponse) GetAverageOrderCost() float64 {
+	if x != nil {
+		return x.AverageOrderCost
+	}
+	return 0
 }
 
-func (x *SetMarginTypeResponse) GetExchange() string {
+func (x *GetOrderbookAmountByNominalResponse) GetSideAffected() string {
 	if x != nil {
-		return x.Exchange
+		return x.SideAffected
 	}
 	return ""
 }
 
-func (x *SetMarginTypeResponse) GetAsset() string {
+func (x *GetOrderbookAmountByNominalResponse) GetApproximateNominalSlippagePercentage() float64 {
 	if x != nil {
-		return x.Asset
+		return x.ApproximateNominalSlippagePercentage
 	}
-	return ""
+	return 0
 }
 
-func (x *SetMarginTypeResponse) GetPair() *CurrencyPair {
+func (x *GetOrderbookAmountByNominalResponse) GetUpdateProtocol() string {
 	if x != nil {
-		return x.Pair
+		return x.UpdateProtocol
 	}
-	return nil
+	return ""
 }
 
-func (x *SetMarginTypeResponse) GetSuccess() bool {
+func (x *GetOrderbookAmountByNominalResponse) GetFullOrderbookSideConsumed() bool {
 	if x != nil {
-		return x.Success
+		return x.FullOrderbookSideConsumed
 	}
 	return false
 }
 
-type GetLeverageRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Exchange       string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset          string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair           *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	UnderlyingPair *CurrencyPair          `protobuf:"bytes,4,opt,name=underlying_pair,json=underlyingPair,proto3" json:"underlying_pair,omitempty"`
-	MarginType     string                 `protobuf:"bytes,5,opt,name=margin_type,json=marginType,proto3" json:"margin_type,omitempty"`
-	OrderSide      string                 `protobuf:"bytes,6,opt,name=order_side,json=orderSide,proto3" json:"order_side,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
-}
-
-func (x *GetLeverageRequest) Reset() {
-	*x = GetLeverageRequest{}
-	mi := &file_rpc_proto_msgTypes[191]
+type GetOrderbookAmountByImpactRequest struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Exchange              string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset                 string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair                  *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	ImpactPercentage      float64                `protobuf:"fixed64,6,opt,name=impact_percentage,json=impactPercentage,proto3" json:"impact_percentage,omitempty"`
+	Sell                  bool                   `protobuf:"varint,7,opt,name=sell,proto3" json:"sell,omitempty"`
+	RequiresRestOrderbook bool                   `protobuf:"varint,8,opt,name=requires_rest_orderbook,json=requiresRestOrderbook,proto3" json:"requires_rest_orderbook,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *GetOrderbookAmountByImpactRequest) Reset() {
+	*x = GetOrderbookAmountByImpactRequest{}
+	mi := &file_rpc_proto_msgTypes[233]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetLeverageRequest) String() string {
+func (x *GetOrderbookAmountByImpactRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLeverageRequest) ProtoMessage() {}
+func (*GetOrderbookAmountByImpactRequest) ProtoMessage() {}
 
-func (x *GetLeverageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[191]
+func (x *GetOrderbookAmountByImpactRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[233]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12224,81 +15768,85 @@ func (x *GetLeverageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLeverageRequest.ProtoReflect.Descriptor instead.
-func (*GetLeverageRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{191}
+// Deprecated: Use GetOrderbookAmountByImpactRequest.ProtoReflect.Descriptor instead.
+func (*GetOrderbookAmountByImpactRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{233}
 }
 
-func (x *GetLeverageRequest) GetExchange() string {
+func (x *GetOrderbookAmountByImpactRequest) GetExchange() string {
 	if x != nil {
 		return x.Exchange
 	}
 	return ""
 }
 
-func (x *GetLeverageRequest) GetAsset() string {
+func (x *GetOrderbookAmountByImpactRequest) GetAsset() string {
 	if x != nil {
 		return x.Asset
 	}
 	return ""
 }
 
-func (x *GetLeverageRequest) GetPair() *CurrencyPair {
+func (x *GetOrderbookAmountByImpactRequest) GetPair() *CurrencyPair {
 	if x != nil {
 		return x.Pair
 	}
 	return nil
 }
 
-func (x *GetLeverageRequest) GetUnderlyingPair() *CurrencyPair {
+func (x *GetOrderbookAmountByImpactRequest) GetImpactPercentage() float64 {
 	if x != nil {
-		return x.UnderlyingPair
+		return x.ImpactPercentage
 	}
-	return nil
+	return 0
 }
 
-func (x *GetLeverageRequest) GetMarginType() string {
+func (x *GetOrderbookAmountByImpactRequest) GetSell() bool {
 	if x != nil {
-		return x.MarginType
+		return x.Sell
 	}
-	return ""
+	return false
 }
 
-func (x *GetLeverageRequest) GetOrderSide() string {
+func (x *GetOrderbookAmountByImpactRequest) GetRequiresRestOrderbook() bool {
 	if x != nil {
-		return x.OrderSide
+		return x.RequiresRestOrderbook
 	}
-	return ""
+	return false
 }
 
-type GetLeverageResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Exchange       string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset          string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair           *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	UnderlyingPair *CurrencyPair          `protobuf:"bytes,4,opt,name=underlying_pair,json=underlyingPair,proto3" json:"underlying_pair,omitempty"`
-	MarginType     string                 `protobuf:"bytes,5,opt,name=margin_type,json=marginType,proto3" json:"margin_type,omitempty"`
-	Leverage       float64                `protobuf:"fixed64,6,opt,name=leverage,proto3" json:"leverage,omitempty"`
-	OrderSide      string                 `protobuf:"bytes,7,opt,name=order_side,json=orderSide,proto3" json:"order_side,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type GetOrderbookAmountByImpactResponse struct {
+	state                               protoimpl.MessageState `protogen:"open.v1"`
+	AmountRequired                      float64                `protobuf:"fixed64,1,opt,name=amount_required,json=amountRequired,proto3" json:"amount_required,omitempty"`
+	CurrencySelling                     string                 `protobuf:"bytes,2,opt,name=currency_selling,json=currencySelling,proto3" json:"currency_selling,omitempty"`
+	AmountReceived                      float64                `protobuf:"fixed64,3,opt,name=amount_received,json=amountReceived,proto3" json:"amount_received,omitempty"`
+	CurrencyBuying                      string                 `protobuf:"bytes,4,opt,name=currency_buying,json=currencyBuying,proto3" json:"currency_buying,omitempty"`
+	StartPrice                          float64                `protobuf:"fixed64,5,opt,name=start_price,json=startPrice,proto3" json:"start_price,omitempty"`
+	EndPrice                            float64                `protobuf:"fixed64,6,opt,name=end_price,json=endPrice,proto3" json:"end_price,omitempty"`
+	AverageOrderCost                    float64                `protobuf:"fixed64,7,opt,name=average_order_cost,json=averageOrderCost,proto3" json:"average_order_cost,omitempty"`
+	SideAffected                        string                 `protobuf:"bytes,8,opt,name=side_affected,json=sideAffected,proto3" json:"side_affected,omitempty"`
+	ApproximateImpactSlippagePercentage float64                `protobuf:"fixed64,9,opt,name=approximate_impact_slippage_percentage,json=approximateImpactSlippagePercentage,proto3" json:"approximate_impact_slippage_percentage,omitempty"`
+	UpdateProtocol                      string                 `protobuf:"bytes,10,opt,name=update_protocol,json=updateProtocol,proto3" json:"update_protocol,omitempty"`
+	FullOrderbookSideConsumed           bool                   `protobuf:"varint,11,opt,name=full_orderbook_side_consumed,json=fullOrderbookSideConsumed,proto3" json:"full_orderbook_side_consumed,omitempty"`
+	unknownFields                       protoimpl.UnknownFields
+	sizeCache                           protoimpl.SizeCache
 }
 
-func (x *GetLeverageResponse) Reset() {
-	*x = GetLeverageResponse{}
-	mi := &file_rpc_proto_msgTypes[192]
+func (x *GetOrderbookAmountByImpactResponse) Reset() {
+	*x = GetOrderbookAmountByImpactResponse{}
+	mi := &file_rpc_proto_msgTypes[234]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetLeverageResponse) String() string {
+func (x *GetOrderbookAmountByImpactResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLeverageResponse) ProtoMessage() {}
+func (*GetOrderbookAmountByImpactResponse) ProtoMessage() {}
 
-func (x *GetLeverageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[192]
+func (x *GetOrderbookAmountByImpactResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[234]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12309,88 +15857,111 @@ func (x *GetLeverageResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLeverageResponse.ProtoReflect.Descriptor instead.
-func (*GetLeverageResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{192}
+// Deprecated: Use GetOrderbookAmountByImpactResponse.ProtoReflect.Descriptor instead.
+func (*GetOrderbookAmountByImpactResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{234}
 }
 
-func (x *GetLeverageResponse) GetExchange() string {
+func (x *GetOrderbookAmountByImpactResponse) GetAmountRequired() float64 {
 	if x != nil {
-		return x.Exchange
+		return x.AmountRequired
+	}
+	return 0
+}
+
+func (x *GetOrderbookAmountByImpactResponse) GetCurrencySelling() string {
+	if x != nil {
+		return x.CurrencySelling
 	}
 	return ""
 }
 
-func (x *GetLeverageResponse) GetAsset() string {
+func (x *GetOrderbookAmountByImpactResponse) GetAmountReceived() float64 {
 	if x != nil {
-		return x.Asset
+		return x.AmountReceived
+	}
+	return 0
+}
+
+func (x *GetOrderbookAmountByImpactResponse) GetCurrencyBuying() string {
+	if x != nil {
+		return x.CurrencyBuying
 	}
 	return ""
 }
 
-func (x *GetLeverageResponse) GetPair() *CurrencyPair {
+func (x *GetOrderbookAmountByImpactResponse) GetStartPrice() float64 {
 	if x != nil {
-		return x.Pair
+		return x.StartPrice
 	}
-	return nil
+	return 0
 }
 
-func (x *GetLeverageResponse) GetUnderlyingPair() *CurrencyPair {
+func (x *GetOrderbookAmountByImpactResponse) GetEndPrice() float64 {
 	if x != nil {
-		return x.UnderlyingPair
+		return x.EndPrice
 	}
-	return nil
+	return 0
 }
 
-func (x *GetLeverageResponse) GetMarginType() string {
+func (x *GetOrderbookAmountByImpactResponse) GetAverageOrderCost() float64 {
 	if x != nil {
-		return x.MarginType
+		return x.AverageOrderCost
+	}
+	return 0
+}
+
+func (x *GetOrderbookAmountByImpactResponse) GetSideAffected() string {
+	if x != nil {
+		return x.SideAffected
 	}
 	return ""
 }
 
-func (x *GetLeverageResponse) GetLeverage() float64 {
+func (x *GetOrderbookAmountByImpactResponse) GetApproximateImpactSlippagePercentage() float64 {
 	if x != nil {
-		return x.Leverage
+		return x.ApproximateImpactSlippagePercentage
 	}
 	return 0
 }
 
-func (x *GetLeverageResponse) GetOrderSide() string {
+func (x *GetOrderbookAmountByImpactResponse) GetUpdateProtocol() string {
 	if x != nil {
-		return x.OrderSide
+		return x.UpdateProtocol
 	}
 	return ""
 }
 
-type SetLeverageRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Exchange       string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset          string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair           *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	UnderlyingPair *CurrencyPair          `protobuf:"bytes,4,opt,name=underlying_pair,json=underlyingPair,proto3" json:"underlying_pair,omitempty"`
-	MarginType     string                 `protobuf:"bytes,5,opt,name=margin_type,json=marginType,proto3" json:"margin_type,omitempty"`
-	Leverage       float64                `protobuf:"fixed64,6,opt,name=leverage,proto3" json:"leverage,omitempty"`
-	OrderSide      string                 `protobuf:"bytes,7,opt,name=order_side,json=orderSide,proto3" json:"order_side,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *GetOrderbookAmountByImpactResponse) GetFullOrderbookSideConsumed() bool {
+	if x != nil {
+		return x.FullOrderbookSideConsumed
+	}
+	return false
 }
 
-func (x *SetLeverageRequest) Reset() {
-	*x = SetLeverageRequest{}
-	mi := &file_rpc_proto_msgTypes[193]
+type GetOpenInterestRequest struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Exchange      string                     `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Data          []*OpenInterestDataRequest `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOpenInterestRequest) Reset() {
+	*x = GetOpenInterestRequest{}
+	mi := &file_rpc_proto_msgTypes[235]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetLeverageRequest) String() string {
+func (x *GetOpenInterestRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetLeverageRequest) ProtoMessage() {}
+func (*GetOpenInterestRequest) ProtoMessage() {}
 
-func (x *SetLeverageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[193]
+func (x *GetOpenInterestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[235]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12401,88 +15972,48 @@ func (x *SetLeverageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetLeverageRequest.ProtoReflect.Descriptor instead.
-func (*SetLeverageRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{193}
+// Deprecated: Use GetOpenInterestRequest.ProtoReflect.Descriptor instead.
+func (*GetOpenInterestRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{235}
 }
 
-func (x *SetLeverageRequest) GetExchange() string {
+func (x *GetOpenInterestRequest) GetExchange() string {
 	if x != nil {
 		return x.Exchange
 	}
 	return ""
 }
 
-func (x *SetLeverageRequest) GetAsset() string {
-	if x != nil {
-		return x.Asset
-	}
-	return ""
-}
-
-func (x *SetLeverageRequest) GetPair() *CurrencyPair {
-	if x != nil {
-		return x.Pair
-	}
-	return nil
-}
-
-func (x *SetLeverageRequest) GetUnderlyingPair() *CurrencyPair {
+func (x *GetOpenInterestRequest) GetData() []*OpenInterestDataRequest {
 	if x != nil {
-		return x.UnderlyingPair
+		return x.Data
 	}
 	return nil
 }
 
-func (x *SetLeverageRequest) GetMarginType() string {
-	if x != nil {
-		return x.MarginType
-	}
-	return ""
-}
-
-func (x *SetLeverageRequest) GetLeverage() float64 {
-	if x != nil {
-		return x.Leverage
-	}
-	return 0
-}
-
-func (x *SetLeverageRequest) GetOrderSide() string {
-	if x != nil {
-		return x.OrderSide
-	}
-	return ""
-}
-
-type SetLeverageResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Exchange       string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset          string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair           *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	UnderlyingPair *CurrencyPair          `protobuf:"bytes,4,opt,name=underlying_pair,json=underlyingPair,proto3" json:"underlying_pair,omitempty"`
-	MarginType     string                 `protobuf:"bytes,5,opt,name=margin_type,json=marginType,proto3" json:"margin_type,omitempty"`
-	OrderSide      string                 `protobuf:"bytes,6,opt,name=order_side,json=orderSide,proto3" json:"order_side,omitempty"`
-	Success        bool                   `protobuf:"varint,7,opt,name=success,proto3" json:"success,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type OpenInterestDataRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Asset         string                 `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair          *CurrencyPair          `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetLeverageResponse) Reset() {
-	*x = SetLeverageResponse{}
-	mi := &file_rpc_proto_msgTypes[194]
+func (x *OpenInterestDataRequest) Reset() {
+	*x = OpenInterestDataRequest{}
+	mi := &file_rpc_proto_msgTypes[236]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetLeverageResponse) String() string {
+func (x *OpenInterestDataRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetLeverageResponse) ProtoMessage() {}
+func (*OpenInterestDataRequest) ProtoMessage() {}
 
-func (x *SetLeverageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[194]
+func (x *OpenInterestDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[236]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12493,86 +16024,94 @@ func (x *SetLeverageResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetLeverageResponse.ProtoReflect.Descriptor instead.
-func (*SetLeverageResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{194}
-}
-
-func (x *SetLeverageResponse) GetExchange() string {
-	if x != nil {
-		return x.Exchange
-	}
-	return ""
+// Deprecated: Use OpenInterestDataRequest.ProtoReflect.Descriptor instead.
+func (*OpenInterestDataRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{236}
 }
 
-func (x *SetLeverageResponse) GetAsset() string {
+func (x *OpenInterestDataRequest) GetAsset() string {
 	if x != nil {
 		return x.Asset
 	}
 	return ""
 }
 
-func (x *SetLeverageResponse) GetPair() *CurrencyPair {
+func (x *OpenInterestDataRequest) GetPair() *CurrencyPair {
 	if x != nil {
 		return x.Pair
 	}
 	return nil
 }
 
-func (x *SetLeverageResponse) GetUnderlyingPair() *CurrencyPair {
-	if x != nil {
-		return x.UnderlyingPair
-	}
-	return nil
+type GetOpenInterestResponse struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Data          []*OpenInterestDataResponse `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetLeverageResponse) GetMarginType() string {
-	if x != nil {
-		return x.MarginType
-	}
-	return ""
+func (x *GetOpenInterestResponse) Reset() {
+	*x = GetOpenInterestResponse{}
+	mi := &file_rpc_proto_msgTypes[237]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetLeverageResponse) GetOrderSide() string {
+func (x *GetOpenInterestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOpenInterestResponse) ProtoMessage() {}
+
+func (x *GetOpenInterestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[237]
 	if x != nil {
-		return x.OrderSide
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *SetLeverageResponse) GetSuccess() bool {
+// Deprecated: Use GetOpenInterestResponse.ProtoReflect.Descriptor instead.
+func (*GetOpenInterestResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{237}
+}
+
+func (x *GetOpenInterestResponse) GetData() []*OpenInterestDataResponse {
 	if x != nil {
-		return x.Success
+		return x.Data
 	}
-	return false
+	return nil
 }
 
-type GetCollateralRequest struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	Exchange          string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset             string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	IncludeBreakdown  bool                   `protobuf:"varint,3,opt,name=include_breakdown,json=includeBreakdown,proto3" json:"include_breakdown,omitempty"`
-	CalculateOffline  bool                   `protobuf:"varint,4,opt,name=calculate_offline,json=calculateOffline,proto3" json:"calculate_offline,omitempty"`
-	IncludeZeroValues bool                   `protobuf:"varint,5,opt,name=include_zero_values,json=includeZeroValues,proto3" json:"include_zero_values,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+type OpenInterestDataResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	OpenInterest  float64                `protobuf:"fixed64,4,opt,name=open_interest,json=openInterest,proto3" json:"open_interest,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCollateralRequest) Reset() {
-	*x = GetCollateralRequest{}
-	mi := &file_rpc_proto_msgTypes[195]
+func (x *OpenInterestDataResponse) Reset() {
+	*x = OpenInterestDataResponse{}
+	mi := &file_rpc_proto_msgTypes[238]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCollateralRequest) String() string {
+func (x *OpenInterestDataResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCollateralRequest) ProtoMessage() {}
+func (*OpenInterestDataResponse) ProtoMessage() {}
 
-func (x *GetCollateralRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[195]
+func (x *OpenInterestDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[238]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12583,78 +16122,63 @@ func (x *GetCollateralRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCollateralRequest.ProtoReflect.Descriptor instead.
-func (*GetCollateralRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{195}
+// Deprecated: Use OpenInterestDataResponse.ProtoReflect.Descriptor instead.
+func (*OpenInterestDataResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{238}
 }
 
-func (x *GetCollateralRequest) GetExchange() string {
+func (x *OpenInterestDataResponse) GetExchange() string {
 	if x != nil {
 		return x.Exchange
 	}
 	return ""
 }
 
-func (x *GetCollateralRequest) GetAsset() string {
+func (x *OpenInterestDataResponse) GetAsset() string {
 	if x != nil {
 		return x.Asset
 	}
 	return ""
 }
 
-func (x *GetCollateralRequest) GetIncludeBreakdown() bool {
-	if x != nil {
-		return x.IncludeBreakdown
-	}
-	return false
-}
-
-func (x *GetCollateralRequest) GetCalculateOffline() bool {
+func (x *OpenInterestDataResponse) GetPair() *CurrencyPair {
 	if x != nil {
-		return x.CalculateOffline
+		return x.Pair
 	}
-	return false
+	return nil
 }
 
-func (x *GetCollateralRequest) GetIncludeZeroValues() bool {
+func (x *OpenInterestDataResponse) GetOpenInterest() float64 {
 	if x != nil {
-		return x.IncludeZeroValues
+		return x.OpenInterest
 	}
-	return false
+	return 0
 }
 
-type GetCollateralResponse struct {
-	state                                       protoimpl.MessageState   `protogen:"open.v1"`
-	SubAccount                                  string                   `protobuf:"bytes,1,opt,name=sub_account,json=subAccount,proto3" json:"sub_account,omitempty"`
-	CollateralCurrency                          string                   `protobuf:"bytes,2,opt,name=collateral_currency,json=collateralCurrency,proto3" json:"collateral_currency,omitempty"`
-	TotalValueOfPositiveSpotBalances            string                   `protobuf:"bytes,3,opt,name=total_value_of_positive_spot_balances,json=totalValueOfPositiveSpotBalances,proto3" json:"total_value_of_positive_spot_balances,omitempty"`
-	CollateralContributedByPositiveSpotBalances string                   `protobuf:"bytes,4,opt,name=collateral_contributed_by_positive_spot_balances,json=collateralContributedByPositiveSpotBalances,proto3" json:"collateral_contributed_by_positive_spot_balances,omitempty"`
-	UsedCollateral                              string                   `protobuf:"bytes,5,opt,name=used_collateral,json=usedCollateral,proto3" json:"used_collateral,omitempty"`
-	UsedBreakdown                               *CollateralUsedBreakdown `protobuf:"bytes,6,opt,name=used_breakdown,json=usedBreakdown,proto3" json:"used_breakdown,omitempty"`
-	AvailableCollateral                         string                   `protobuf:"bytes,7,opt,name=available_collateral,json=availableCollateral,proto3" json:"available_collateral,omitempty"`
-	MaintenanceCollateral                       string                   `protobuf:"bytes,8,opt,name=maintenance_collateral,json=maintenanceCollateral,proto3" json:"maintenance_collateral,omitempty"`
-	UnrealisedPnl                               string                   `protobuf:"bytes,9,opt,name=unrealised_pnl,json=unrealisedPnl,proto3" json:"unrealised_pnl,omitempty"`
-	CurrencyBreakdown                           []*CollateralForCurrency `protobuf:"bytes,10,rep,name=currency_breakdown,json=currencyBreakdown,proto3" json:"currency_breakdown,omitempty"`
-	PositionBreakdown                           []*CollateralByPosition  `protobuf:"bytes,11,rep,name=position_breakdown,json=positionBreakdown,proto3" json:"position_breakdown,omitempty"`
-	unknownFields                               protoimpl.UnknownFields
-	sizeCache                                   protoimpl.SizeCache
+type GetCurrencyTradeURLRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCollateralResponse) Reset() {
-	*x = GetCollateralResponse{}
-	mi := &file_rpc_proto_msgTypes[196]
+func (x *GetCurrencyTradeURLRequest) Reset() {
+	*x = GetCurrencyTradeURLRequest{}
+	mi := &file_rpc_proto_msgTypes[239]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCollateralResponse) String() string {
+func (x *GetCurrencyTradeURLRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCollateralResponse) ProtoMessage() {}
+func (*GetCurrencyTradeURLRequest) ProtoMessage() {}
 
-func (x *GetCollateralResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[196]
+func (x *GetCurrencyTradeURLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[239]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12665,122 +16189,117 @@ func (x *GetCollateralResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCollateralResponse.ProtoReflect.Descriptor instead.
-func (*GetCollateralResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{196}
-}
-
-func (x *GetCollateralResponse) GetSubAccount() string {
-	if x != nil {
-		return x.SubAccount
-	}
-	return ""
-}
-
-func (x *GetCollateralResponse) GetCollateralCurrency() string {
-	if x != nil {
-		return x.CollateralCurrency
-	}
-	return ""
-}
-
-func (x *GetCollateralResponse) GetTotalValueOfPositiveSpotBalances() string {
-	if x != nil {
-		return x.TotalValueOfPositiveSpotBalances
-	}
-	return ""
+// Deprecated: Use GetCurrencyTradeURLRequest.ProtoReflect.Descriptor instead.
+func (*GetCurrencyTradeURLRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{239}
 }
 
-func (x *GetCollateralResponse) GetCollateralContributedByPositiveSpotBalances() string {
+func (x *GetCurrencyTradeURLRequest) GetExchange() string {
 	if x != nil {
-		return x.CollateralContributedByPositiveSpotBalances
+		return x.Exchange
 	}
 	return ""
 }
 
-func (x *GetCollateralResponse) GetUsedCollateral() string {
+func (x *GetCurrencyTradeURLRequest) GetAsset() string {
 	if x != nil {
-		return x.UsedCollateral
+		return x.Asset
 	}
 	return ""
 }
 
-func (x *GetCollateralResponse) GetUsedBreakdown() *CollateralUsedBreakdown {
+func (x *GetCurrencyTradeURLRequest) GetPair() *CurrencyPair {
 	if x != nil {
-		return x.UsedBreakdown
+		return x.Pair
 	}
 	return nil
 }
 
-func (x *GetCollateralResponse) GetAvailableCollateral() string {
-	if x != nil {
-		return x.AvailableCollateral
-	}
-	return ""
+type GetCurrencyTradeURLResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCollateralResponse) GetMaintenanceCollateral() string {
-	if x != nil {
-		return x.MaintenanceCollateral
-	}
-	return ""
+func (x *GetCurrencyTradeURLResponse) Reset() {
+	*x = GetCurrencyTradeURLResponse{}
+	mi := &file_rpc_proto_msgTypes[240]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCollateralResponse) GetUnrealisedPnl() string {
-	if x != nil {
-		return x.UnrealisedPnl
-	}
-	return ""
+func (x *GetCurrencyTradeURLResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *GetCollateralResponse) GetCurrencyBreakdown() []*CollateralForCurrency {
-	if x != nil {
-		return x.CurrencyBreakdown
-	}
-	return nil
-}
+func (*GetCurrencyTradeURLResponse) ProtoMessage() {}
 
-func (x *GetCollateralResponse) GetPositionBreakdown() []*CollateralByPosition {
+func (x *GetCurrencyTradeURLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[240]
 	if x != nil {
-		return x.PositionBreakdown
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-type CollateralForCurrency struct {
-	state                       protoimpl.MessageState   `protogen:"open.v1"`
-	Currency                    string                   `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
-	ExcludedFromCollateral      bool                     `protobuf:"varint,2,opt,name=excluded_from_collateral,json=excludedFromCollateral,proto3" json:"excluded_from_collateral,omitempty"`
-	TotalFunds                  string                   `protobuf:"bytes,3,opt,name=total_funds,json=totalFunds,proto3" json:"total_funds,omitempty"`
-	AvailableForUseAsCollateral string                   `protobuf:"bytes,4,opt,name=available_for_use_as_collateral,json=availableForUseAsCollateral,proto3" json:"available_for_use_as_collateral,omitempty"`
-	ApproxFairMarketValue       string                   `protobuf:"bytes,5,opt,name=approx_fair_market_value,json=approxFairMarketValue,proto3" json:"approx_fair_market_value,omitempty"`
-	Weighting                   string                   `protobuf:"bytes,6,opt,name=weighting,proto3" json:"weighting,omitempty"`
-	CollateralContribution      string                   `protobuf:"bytes,7,opt,name=collateral_contribution,json=collateralContribution,proto3" json:"collateral_contribution,omitempty"`
-	ScaledToCurrency            string                   `protobuf:"bytes,8,opt,name=scaled_to_currency,json=scaledToCurrency,proto3" json:"scaled_to_currency,omitempty"`
-	UnrealisedPnl               string                   `protobuf:"bytes,9,opt,name=unrealised_pnl,json=unrealisedPnl,proto3" json:"unrealised_pnl,omitempty"`
-	FundsInUse                  string                   `protobuf:"bytes,10,opt,name=funds_in_use,json=fundsInUse,proto3" json:"funds_in_use,omitempty"`
-	AdditionalCollateralUsed    string                   `protobuf:"bytes,11,opt,name=additional_collateral_used,json=additionalCollateralUsed,proto3" json:"additional_collateral_used,omitempty"`
-	UsedBreakdown               *CollateralUsedBreakdown `protobuf:"bytes,12,opt,name=used_breakdown,json=usedBreakdown,proto3" json:"used_breakdown,omitempty"`
-	Error                       string                   `protobuf:"bytes,13,opt,name=error,proto3" json:"error,omitempty"`
-	unknownFields               protoimpl.UnknownFields
-	sizeCache                   protoimpl.SizeCache
+// Deprecated: Use GetCurrencyTradeURLResponse.ProtoReflect.Descriptor instead.
+func (*GetCurrencyTradeURLResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{240}
 }
 
-func (x *CollateralForCurrency) Reset() {
-	*x = CollateralForCurrency{}
-	mi := &file_rpc_proto_msgTypes[197]
+func (x *GetCurrencyTradeURLResponse) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type ConditionalOrder struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Exchange        string                 `protobuf:"bytes,2,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair            *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	Asset           string                 `protobuf:"bytes,4,opt,name=asset,proto3" json:"asset,omitempty"`
+	Type            string                 `protobuf:"bytes,5,opt,name=type,proto3" json:"type,omitempty"`
+	Side            string                 `protobuf:"bytes,6,opt,name=side,proto3" json:"side,omitempty"`
+	Amount          float64                `protobuf:"fixed64,7,opt,name=amount,proto3" json:"amount,omitempty"`
+	TriggerPrice    float64                `protobuf:"fixed64,8,opt,name=trigger_price,json=triggerPrice,proto3" json:"trigger_price,omitempty"`
+	LimitPrice      float64                `protobuf:"fixed64,9,opt,name=limit_price,json=limitPrice,proto3" json:"limit_price,omitempty"`
+	TakeProfitPrice float64                `protobuf:"fixed64,10,opt,name=take_profit_price,json=takeProfitPrice,proto3" json:"take_profit_price,omitempty"`
+	EntryPrice      float64                `protobuf:"fixed64,11,opt,name=entry_price,json=entryPrice,proto3" json:"entry_price,omitempty"`
+	TrailingAmount  float64                `protobuf:"fixed64,12,opt,name=trailing_amount,json=trailingAmount,proto3" json:"trailing_amount,omitempty"`
+	TrailingPercent float64                `protobuf:"fixed64,13,opt,name=trailing_percent,json=trailingPercent,proto3" json:"trailing_percent,omitempty"`
+	ReferencePrice  float64                `protobuf:"fixed64,14,opt,name=reference_price,json=referencePrice,proto3" json:"reference_price,omitempty"`
+	EntryOrderId    string                 `protobuf:"bytes,15,opt,name=entry_order_id,json=entryOrderId,proto3" json:"entry_order_id,omitempty"`
+	OrderId         string                 `protobuf:"bytes,16,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Status          string                 `protobuf:"bytes,17,opt,name=status,proto3" json:"status,omitempty"`
+	FailureReason   string                 `protobuf:"bytes,18,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,19,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,20,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ConditionalOrder) Reset() {
+	*x = ConditionalOrder{}
+	mi := &file_rpc_proto_msgTypes[241]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CollateralForCurrency) String() string {
+func (x *ConditionalOrder) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CollateralForCurrency) ProtoMessage() {}
+func (*ConditionalOrder) ProtoMessage() {}
 
-func (x *CollateralForCurrency) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[197]
+func (x *ConditionalOrder) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[241]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12791,223 +16310,184 @@ func (x *CollateralForCurrency) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CollateralForCurrency.ProtoReflect.Descriptor instead.
-func (*CollateralForCurrency) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{197}
+// Deprecated: Use ConditionalOrder.ProtoReflect.Descriptor instead.
+func (*ConditionalOrder) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{241}
 }
 
-func (x *CollateralForCurrency) GetCurrency() string {
+func (x *ConditionalOrder) GetId() string {
 	if x != nil {
-		return x.Currency
+		return x.Id
 	}
 	return ""
 }
 
-func (x *CollateralForCurrency) GetExcludedFromCollateral() bool {
-	if x != nil {
-		return x.ExcludedFromCollateral
-	}
-	return false
-}
-
-func (x *CollateralForCurrency) GetTotalFunds() string {
+func (x *ConditionalOrder) GetExchange() string {
 	if x != nil {
-		return x.TotalFunds
+		return x.Exchange
 	}
 	return ""
 }
 
-func (x *CollateralForCurrency) GetAvailableForUseAsCollateral() string {
+func (x *ConditionalOrder) GetPair() *CurrencyPair {
 	if x != nil {
-		return x.AvailableForUseAsCollateral
+		return x.Pair
 	}
-	return ""
+	return nil
 }
 
-func (x *CollateralForCurrency) GetApproxFairMarketValue() string {
+func (x *ConditionalOrder) GetAsset() string {
 	if x != nil {
-		return x.ApproxFairMarketValue
+		return x.Asset
 	}
 	return ""
 }
 
-func (x *CollateralForCurrency) GetWeighting() string {
+func (x *ConditionalOrder) GetType() string {
 	if x != nil {
-		return x.Weighting
+		return x.Type
 	}
 	return ""
 }
 
-func (x *CollateralForCurrency) GetCollateralContribution() string {
+func (x *ConditionalOrder) GetSide() string {
 	if x != nil {
-		return x.CollateralContribution
+		return x.Side
 	}
 	return ""
 }
 
-func (x *CollateralForCurrency) GetScaledToCurrency() string {
+func (x *ConditionalOrder) GetAmount() float64 {
 	if x != nil {
-		return x.ScaledToCurrency
+		return x.Amount
 	}
-	return ""
+	return 0
 }
 
-func (x *CollateralForCurrency) GetUnrealisedPnl() string {
+func (x *ConditionalOrder) GetTriggerPrice() float64 {
 	if x != nil {
-		return x.UnrealisedPnl
+		return x.TriggerPrice
 	}
-	return ""
+	return 0
 }
 
-func (x *CollateralForCurrency) GetFundsInUse() string {
+func (x *ConditionalOrder) GetLimitPrice() float64 {
 	if x != nil {
-		return x.FundsInUse
+		return x.LimitPrice
 	}
-	return ""
+	return 0
 }
 
-func (x *CollateralForCurrency) GetAdditionalCollateralUsed() string {
+func (x *ConditionalOrder) GetTakeProfitPrice() float64 {
 	if x != nil {
-		return x.AdditionalCollateralUsed
+		return x.TakeProfitPrice
 	}
-	return ""
+	return 0
 }
 
-func (x *CollateralForCurrency) GetUsedBreakdown() *CollateralUsedBreakdown {
+func (x *ConditionalOrder) GetEntryPrice() float64 {
 	if x != nil {
-		return x.UsedBreakdown
+		return x.EntryPrice
 	}
-	return nil
+	return 0
 }
 
-func (x *CollateralForCurrency) GetError() string {
+func (x *ConditionalOrder) GetTrailingAmount() float64 {
 	if x != nil {
-		return x.Error
+		return x.TrailingAmount
 	}
-	return ""
-}
-
-type CollateralByPosition struct {
-	state               protoimpl.MessageState `protogen:"open.v1"`
-	Currency            string                 `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
-	Size                string                 `protobuf:"bytes,2,opt,name=size,proto3" json:"size,omitempty"`
-	OpenOrderSize       string                 `protobuf:"bytes,3,opt,name=open_order_size,json=openOrderSize,proto3" json:"open_order_size,omitempty"`
-	PositionSize        string                 `protobuf:"bytes,4,opt,name=position_size,json=positionSize,proto3" json:"position_size,omitempty"`
-	MarkPrice           string                 `protobuf:"bytes,5,opt,name=mark_price,json=markPrice,proto3" json:"mark_price,omitempty"`
-	RequiredMargin      string                 `protobuf:"bytes,6,opt,name=required_margin,json=requiredMargin,proto3" json:"required_margin,omitempty"`
-	TotalCollateralUsed string                 `protobuf:"bytes,7,opt,name=total_collateral_used,json=totalCollateralUsed,proto3" json:"total_collateral_used,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
-}
-
-func (x *CollateralByPosition) Reset() {
-	*x = CollateralByPosition{}
-	mi := &file_rpc_proto_msgTypes[198]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *CollateralByPosition) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return 0
 }
 
-func (*CollateralByPosition) ProtoMessage() {}
-
-func (x *CollateralByPosition) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[198]
+func (x *ConditionalOrder) GetTrailingPercent() float64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.TrailingPercent
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use CollateralByPosition.ProtoReflect.Descriptor instead.
-func (*CollateralByPosition) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{198}
+	return 0
 }
 
-func (x *CollateralByPosition) GetCurrency() string {
+func (x *ConditionalOrder) GetReferencePrice() float64 {
 	if x != nil {
-		return x.Currency
+		return x.ReferencePrice
 	}
-	return ""
+	return 0
 }
 
-func (x *CollateralByPosition) GetSize() string {
+func (x *ConditionalOrder) GetEntryOrderId() string {
 	if x != nil {
-		return x.Size
+		return x.EntryOrderId
 	}
 	return ""
 }
 
-func (x *CollateralByPosition) GetOpenOrderSize() string {
+func (x *ConditionalOrder) GetOrderId() string {
 	if x != nil {
-		return x.OpenOrderSize
+		return x.OrderId
 	}
 	return ""
 }
 
-func (x *CollateralByPosition) GetPositionSize() string {
+func (x *ConditionalOrder) GetStatus() string {
 	if x != nil {
-		return x.PositionSize
+		return x.Status
 	}
 	return ""
 }
 
-func (x *CollateralByPosition) GetMarkPrice() string {
+func (x *ConditionalOrder) GetFailureReason() string {
 	if x != nil {
-		return x.MarkPrice
+		return x.FailureReason
 	}
 	return ""
 }
 
-func (x *CollateralByPosition) GetRequiredMargin() string {
+func (x *ConditionalOrder) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.RequiredMargin
+		return x.CreatedAt
 	}
-	return ""
+	return nil
 }
 
-func (x *CollateralByPosition) GetTotalCollateralUsed() string {
+func (x *ConditionalOrder) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.TotalCollateralUsed
+		return x.UpdatedAt
 	}
-	return ""
+	return nil
 }
 
-type CollateralUsedBreakdown struct {
-	state                           protoimpl.MessageState `protogen:"open.v1"`
-	LockedInStakes                  string                 `protobuf:"bytes,1,opt,name=locked_in_stakes,json=lockedInStakes,proto3" json:"locked_in_stakes,omitempty"`
-	LockedInNftBids                 string                 `protobuf:"bytes,2,opt,name=locked_in_nft_bids,json=lockedInNftBids,proto3" json:"locked_in_nft_bids,omitempty"`
-	LockedInFeeVoucher              string                 `protobuf:"bytes,3,opt,name=locked_in_fee_voucher,json=lockedInFeeVoucher,proto3" json:"locked_in_fee_voucher,omitempty"`
-	LockedInSpotMarginFundingOffers string                 `protobuf:"bytes,4,opt,name=locked_in_spot_margin_funding_offers,json=lockedInSpotMarginFundingOffers,proto3" json:"locked_in_spot_margin_funding_offers,omitempty"`
-	LockedInSpotOrders              string                 `protobuf:"bytes,5,opt,name=locked_in_spot_orders,json=lockedInSpotOrders,proto3" json:"locked_in_spot_orders,omitempty"`
-	LockedAsCollateral              string                 `protobuf:"bytes,6,opt,name=locked_as_collateral,json=lockedAsCollateral,proto3" json:"locked_as_collateral,omitempty"`
-	UsedInFutures                   string                 `protobuf:"bytes,7,opt,name=used_in_futures,json=usedInFutures,proto3" json:"used_in_futures,omitempty"`
-	UsedInSpotMargin                string                 `protobuf:"bytes,8,opt,name=used_in_spot_margin,json=usedInSpotMargin,proto3" json:"used_in_spot_margin,omitempty"`
-	unknownFields                   protoimpl.UnknownFields
-	sizeCache                       protoimpl.SizeCache
+type AddConditionalOrderRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Exchange        string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair            *CurrencyPair          `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	Asset           string                 `protobuf:"bytes,3,opt,name=asset,proto3" json:"asset,omitempty"`
+	Type            string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Side            string                 `protobuf:"bytes,5,opt,name=side,proto3" json:"side,omitempty"`
+	Amount          float64                `protobuf:"fixed64,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	TriggerPrice    float64                `protobuf:"fixed64,7,opt,name=trigger_price,json=triggerPrice,proto3" json:"trigger_price,omitempty"`
+	LimitPrice      float64                `protobuf:"fixed64,8,opt,name=limit_price,json=limitPrice,proto3" json:"limit_price,omitempty"`
+	TakeProfitPrice float64                `protobuf:"fixed64,9,opt,name=take_profit_price,json=takeProfitPrice,proto3" json:"take_profit_price,omitempty"`
+	EntryPrice      float64                `protobuf:"fixed64,10,opt,name=entry_price,json=entryPrice,proto3" json:"entry_price,omitempty"`
+	TrailingAmount  float64                `protobuf:"fixed64,11,opt,name=trailing_amount,json=trailingAmount,proto3" json:"trailing_amount,omitempty"`
+	TrailingPercent float64                `protobuf:"fixed64,12,opt,name=trailing_percent,json=trailingPercent,proto3" json:"trailing_percent,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *CollateralUsedBreakdown) Reset() {
-	*x = CollateralUsedBreakdown{}
-	mi := &file_rpc_proto_msgTypes[199]
+func (x *AddConditionalOrderRequest) Reset() {
+	*x = AddConditionalOrderRequest{}
+	mi := &file_rpc_proto_msgTypes[242]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CollateralUsedBreakdown) String() string {
+func (x *AddConditionalOrderRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CollateralUsedBreakdown) ProtoMessage() {}
+func (*AddConditionalOrderRequest) ProtoMessage() {}
 
-func (x *CollateralUsedBreakdown) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[199]
+func (x *AddConditionalOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[242]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13018,197 +16498,169 @@ func (x *CollateralUsedBreakdown) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CollateralUsedBreakdown.ProtoReflect.Descriptor instead.
-func (*CollateralUsedBreakdown) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{199}
+// Deprecated: Use AddConditionalOrderRequest.ProtoReflect.Descriptor instead.
+func (*AddConditionalOrderRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{242}
 }
 
-func (x *CollateralUsedBreakdown) GetLockedInStakes() string {
+func (x *AddConditionalOrderRequest) GetExchange() string {
 	if x != nil {
-		return x.LockedInStakes
+		return x.Exchange
 	}
 	return ""
 }
 
-func (x *CollateralUsedBreakdown) GetLockedInNftBids() string {
+func (x *AddConditionalOrderRequest) GetPair() *CurrencyPair {
 	if x != nil {
-		return x.LockedInNftBids
+		return x.Pair
 	}
-	return ""
+	return nil
 }
 
-func (x *CollateralUsedBreakdown) GetLockedInFeeVoucher() string {
+func (x *AddConditionalOrderRequest) GetAsset() string {
 	if x != nil {
-		return x.LockedInFeeVoucher
+		return x.Asset
 	}
 	return ""
 }
 
-func (x *CollateralUsedBreakdown) GetLockedInSpotMarginFundingOffers() string {
+func (x *AddConditionalOrderRequest) GetType() string {
 	if x != nil {
-		return x.LockedInSpotMarginFundingOffers
+		return x.Type
 	}
 	return ""
 }
 
-func (x *CollateralUsedBreakdown) GetLockedInSpotOrders() string {
+func (x *AddConditionalOrderRequest) GetSide() string {
 	if x != nil {
-		return x.LockedInSpotOrders
+		return x.Side
 	}
 	return ""
 }
 
-func (x *CollateralUsedBreakdown) GetLockedAsCollateral() string {
+func (x *AddConditionalOrderRequest) GetAmount() float64 {
 	if x != nil {
-		return x.LockedAsCollateral
+		return x.Amount
 	}
-	return ""
+	return 0
 }
 
-func (x *CollateralUsedBreakdown) GetUsedInFutures() string {
+func (x *AddConditionalOrderRequest) GetTriggerPrice() float64 {
 	if x != nil {
-		return x.UsedInFutures
+		return x.TriggerPrice
 	}
-	return ""
+	return 0
 }
 
-func (x *CollateralUsedBreakdown) GetUsedInSpotMargin() string {
+func (x *AddConditionalOrderRequest) GetLimitPrice() float64 {
 	if x != nil {
-		return x.UsedInSpotMargin
+		return x.LimitPrice
 	}
-	return ""
-}
-
-type GetFundingRatesRequest struct {
-	state                protoimpl.MessageState `protogen:"open.v1"`
-	Exchange             string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset                string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair                 *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	StartDate            string                 `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
-	EndDate              string                 `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
-	PaymentCurrency      string                 `protobuf:"bytes,6,opt,name=payment_currency,json=paymentCurrency,proto3" json:"payment_currency,omitempty"`
-	IncludePredicted     bool                   `protobuf:"varint,7,opt,name=include_predicted,json=includePredicted,proto3" json:"include_predicted,omitempty"`
-	IncludePayments      bool                   `protobuf:"varint,8,opt,name=include_payments,json=includePayments,proto3" json:"include_payments,omitempty"`
-	RespectHistoryLimits bool                   `protobuf:"varint,9,opt,name=respect_history_limits,json=respectHistoryLimits,proto3" json:"respect_history_limits,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
-}
-
-func (x *GetFundingRatesRequest) Reset() {
-	*x = GetFundingRatesRequest{}
-	mi := &file_rpc_proto_msgTypes[200]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetFundingRatesRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return 0
 }
 
-func (*GetFundingRatesRequest) ProtoMessage() {}
-
-func (x *GetFundingRatesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[200]
+func (x *AddConditionalOrderRequest) GetTakeProfitPrice() float64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.TakeProfitPrice
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetFundingRatesRequest.ProtoReflect.Descriptor instead.
-func (*GetFundingRatesRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{200}
+	return 0
 }
 
-func (x *GetFundingRatesRequest) GetExchange() string {
+func (x *AddConditionalOrderRequest) GetEntryPrice() float64 {
 	if x != nil {
-		return x.Exchange
+		return x.EntryPrice
 	}
-	return ""
+	return 0
 }
 
-func (x *GetFundingRatesRequest) GetAsset() string {
+func (x *AddConditionalOrderRequest) GetTrailingAmount() float64 {
 	if x != nil {
-		return x.Asset
+		return x.TrailingAmount
 	}
-	return ""
+	return 0
 }
 
-func (x *GetFundingRatesRequest) GetPair() *CurrencyPair {
+func (x *AddConditionalOrderRequest) GetTrailingPercent() float64 {
 	if x != nil {
-		return x.Pair
+		return x.TrailingPercent
 	}
-	return nil
+	return 0
+}
+
+type GetConditionalOrdersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	ActiveOnly    bool                   `protobuf:"varint,2,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetFundingRatesRequest) GetStartDate() string {
-	if x != nil {
-		return x.StartDate
-	}
-	return ""
+func (x *GetConditionalOrdersRequest) Reset() {
+	*x = GetConditionalOrdersRequest{}
+	mi := &file_rpc_proto_msgTypes[243]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetFundingRatesRequest) GetEndDate() string {
-	if x != nil {
-		return x.EndDate
-	}
-	return ""
+func (x *GetConditionalOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *GetFundingRatesRequest) GetPaymentCurrency() string {
+func (*GetConditionalOrdersRequest) ProtoMessage() {}
+
+func (x *GetConditionalOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[243]
 	if x != nil {
-		return x.PaymentCurrency
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *GetFundingRatesRequest) GetIncludePredicted() bool {
-	if x != nil {
-		return x.IncludePredicted
-	}
-	return false
+// Deprecated: Use GetConditionalOrdersRequest.ProtoReflect.Descriptor instead.
+func (*GetConditionalOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{243}
 }
 
-func (x *GetFundingRatesRequest) GetIncludePayments() bool {
+func (x *GetConditionalOrdersRequest) GetExchange() string {
 	if x != nil {
-		return x.IncludePayments
+		return x.Exchange
 	}
-	return false
+	return ""
 }
 
-func (x *GetFundingRatesRequest) GetRespectHistoryLimits() bool {
+func (x *GetConditionalOrdersRequest) GetActiveOnly() bool {
 	if x != nil {
-		return x.RespectHistoryLimits
+		return x.ActiveOnly
 	}
 	return false
 }
 
-type GetFundingRatesResponse struct {
+type GetConditionalOrdersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Rates         *FundingData           `protobuf:"bytes,1,opt,name=rates,proto3" json:"rates,omitempty"`
+	Orders        []*ConditionalOrder    `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetFundingRatesResponse) Reset() {
-	*x = GetFundingRatesResponse{}
-	mi := &file_rpc_proto_msgTypes[201]
+func (x *GetConditionalOrdersResponse) Reset() {
+	*x = GetConditionalOrdersResponse{}
+	mi := &file_rpc_proto_msgTypes[244]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetFundingRatesResponse) String() string {
+func (x *GetConditionalOrdersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFundingRatesResponse) ProtoMessage() {}
+func (*GetConditionalOrdersResponse) ProtoMessage() {}
 
-func (x *GetFundingRatesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[201]
+func (x *GetConditionalOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[244]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13219,43 +16671,40 @@ func (x *GetFundingRatesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFundingRatesResponse.ProtoReflect.Descriptor instead.
-func (*GetFundingRatesResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{201}
+// Deprecated: Use GetConditionalOrdersResponse.ProtoReflect.Descriptor instead.
+func (*GetConditionalOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{244}
 }
 
-func (x *GetFundingRatesResponse) GetRates() *FundingData {
+func (x *GetConditionalOrdersResponse) GetOrders() []*ConditionalOrder {
 	if x != nil {
-		return x.Rates
+		return x.Orders
 	}
 	return nil
 }
 
-type GetLatestFundingRateRequest struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Exchange         string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset            string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair             *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	IncludePredicted bool                   `protobuf:"varint,4,opt,name=include_predicted,json=includePredicted,proto3" json:"include_predicted,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type CancelConditionalOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetLatestFundingRateRequest) Reset() {
-	*x = GetLatestFundingRateRequest{}
-	mi := &file_rpc_proto_msgTypes[202]
+func (x *CancelConditionalOrderRequest) Reset() {
+	*x = CancelConditionalOrderRequest{}
+	mi := &file_rpc_proto_msgTypes[245]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetLatestFundingRateRequest) String() string {
+func (x *CancelConditionalOrderRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLatestFundingRateRequest) ProtoMessage() {}
+func (*CancelConditionalOrderRequest) ProtoMessage() {}
 
-func (x *GetLatestFundingRateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[202]
+func (x *CancelConditionalOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[245]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13266,61 +16715,98 @@ func (x *GetLatestFundingRateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLatestFundingRateRequest.ProtoReflect.Descriptor instead.
-func (*GetLatestFundingRateRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{202}
+// Deprecated: Use CancelConditionalOrderRequest.ProtoReflect.Descriptor instead.
+func (*CancelConditionalOrderRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{245}
 }
 
-func (x *GetLatestFundingRateRequest) GetExchange() string {
+func (x *CancelConditionalOrderRequest) GetId() string {
 	if x != nil {
-		return x.Exchange
+		return x.Id
 	}
 	return ""
 }
 
-func (x *GetLatestFundingRateRequest) GetAsset() string {
+type SetKillSwitchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Enabled       bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	CancelOrders  bool                   `protobuf:"varint,2,opt,name=cancel_orders,json=cancelOrders,proto3" json:"cancel_orders,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetKillSwitchRequest) Reset() {
+	*x = SetKillSwitchRequest{}
+	mi := &file_rpc_proto_msgTypes[246]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetKillSwitchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetKillSwitchRequest) ProtoMessage() {}
+
+func (x *SetKillSwitchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[246]
 	if x != nil {
-		return x.Asset
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *GetLatestFundingRateRequest) GetPair() *CurrencyPair {
+// Deprecated: Use SetKillSwitchRequest.ProtoReflect.Descriptor instead.
+func (*SetKillSwitchRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{246}
+}
+
+func (x *SetKillSwitchRequest) GetEnabled() bool {
 	if x != nil {
-		return x.Pair
+		return x.Enabled
 	}
-	return nil
+	return false
 }
 
-func (x *GetLatestFundingRateRequest) GetIncludePredicted() bool {
+func (x *SetKillSwitchRequest) GetCancelOrders() bool {
 	if x != nil {
-		return x.IncludePredicted
+		return x.CancelOrders
 	}
 	return false
 }
 
-type GetLatestFundingRateResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Rate          *FundingData           `protobuf:"bytes,1,opt,name=rate,proto3" json:"rate,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type RouteOrderRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Pair           *CurrencyPair          `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType      string                 `protobuf:"bytes,2,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Side           string                 `protobuf:"bytes,3,opt,name=side,proto3" json:"side,omitempty"`
+	Amount         float64                `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Exchanges      []string               `protobuf:"bytes,5,rep,name=exchanges,proto3" json:"exchanges,omitempty"`
+	IgnoreBalances bool                   `protobuf:"varint,6,opt,name=ignore_balances,json=ignoreBalances,proto3" json:"ignore_balances,omitempty"`
+	Execute        bool                   `protobuf:"varint,7,opt,name=execute,proto3" json:"execute,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *GetLatestFundingRateResponse) Reset() {
-	*x = GetLatestFundingRateResponse{}
-	mi := &file_rpc_proto_msgTypes[203]
+func (x *RouteOrderRequest) Reset() {
+	*x = RouteOrderRequest{}
+	mi := &file_rpc_proto_msgTypes[247]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetLatestFundingRateResponse) String() string {
+func (x *RouteOrderRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLatestFundingRateResponse) ProtoMessage() {}
+func (*RouteOrderRequest) ProtoMessage() {}
 
-func (x *GetLatestFundingRateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[203]
+func (x *RouteOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[247]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13331,75 +16817,91 @@ func (x *GetLatestFundingRateResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLatestFundingRateResponse.ProtoReflect.Descriptor instead.
-func (*GetLatestFundingRateResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{203}
+// Deprecated: Use RouteOrderRequest.ProtoReflect.Descriptor instead.
+func (*RouteOrderRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{247}
 }
 
-func (x *GetLatestFundingRateResponse) GetRate() *FundingData {
+func (x *RouteOrderRequest) GetPair() *CurrencyPair {
 	if x != nil {
-		return x.Rate
+		return x.Pair
 	}
 	return nil
 }
 
-type ShutdownRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *RouteOrderRequest) GetAssetType() string {
+	if x != nil {
+		return x.AssetType
+	}
+	return ""
 }
 
-func (x *ShutdownRequest) Reset() {
-	*x = ShutdownRequest{}
-	mi := &file_rpc_proto_msgTypes[204]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *RouteOrderRequest) GetSide() string {
+	if x != nil {
+		return x.Side
+	}
+	return ""
 }
 
-func (x *ShutdownRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *RouteOrderRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
 }
 
-func (*ShutdownRequest) ProtoMessage() {}
+func (x *RouteOrderRequest) GetExchanges() []string {
+	if x != nil {
+		return x.Exchanges
+	}
+	return nil
+}
 
-func (x *ShutdownRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[204]
+func (x *RouteOrderRequest) GetIgnoreBalances() bool {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.IgnoreBalances
 	}
-	return mi.MessageOf(x)
+	return false
 }
 
-// Deprecated: Use ShutdownRequest.ProtoReflect.Descriptor instead.
-func (*ShutdownRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{204}
+func (x *RouteOrderRequest) GetExecute() bool {
+	if x != nil {
+		return x.Execute
+	}
+	return false
 }
 
-type ShutdownResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type RouteOrderLeg struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Exchange       string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Amount         float64                `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	AveragePrice   float64                `protobuf:"fixed64,3,opt,name=average_price,json=averagePrice,proto3" json:"average_price,omitempty"`
+	WorstPrice     float64                `protobuf:"fixed64,4,opt,name=worst_price,json=worstPrice,proto3" json:"worst_price,omitempty"`
+	EffectivePrice float64                `protobuf:"fixed64,5,opt,name=effective_price,json=effectivePrice,proto3" json:"effective_price,omitempty"`
+	Cost           float64                `protobuf:"fixed64,6,opt,name=cost,proto3" json:"cost,omitempty"`
+	Fee            float64                `protobuf:"fixed64,7,opt,name=fee,proto3" json:"fee,omitempty"`
+	Orders         []*OrderbookItem       `protobuf:"bytes,8,rep,name=orders,proto3" json:"orders,omitempty"`
+	OrderId        string                 `protobuf:"bytes,9,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Error          string                 `protobuf:"bytes,10,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *ShutdownResponse) Reset() {
-	*x = ShutdownResponse{}
-	mi := &file_rpc_proto_msgTypes[205]
+func (x *RouteOrderLeg) Reset() {
+	*x = RouteOrderLeg{}
+	mi := &file_rpc_proto_msgTypes[248]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ShutdownResponse) String() string {
+func (x *RouteOrderLeg) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ShutdownResponse) ProtoMessage() {}
+func (*RouteOrderLeg) ProtoMessage() {}
 
-func (x *ShutdownResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[205]
+func (x *RouteOrderLeg) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[248]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13410,48 +16912,104 @@ func (x *ShutdownResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ShutdownResponse.ProtoReflect.Descriptor instead.
-func (*ShutdownResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{205}
+// Deprecated: Use RouteOrderLeg.ProtoReflect.Descriptor instead.
+func (*RouteOrderLeg) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{248}
 }
 
-type GetTechnicalAnalysisRequest struct {
-	state                 protoimpl.MessageState `protogen:"open.v1"`
-	Exchange              string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Pair                  *CurrencyPair          `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
-	AssetType             string                 `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
-	AlgorithmType         string                 `protobuf:"bytes,4,opt,name=algorithm_type,json=algorithmType,proto3" json:"algorithm_type,omitempty"`
-	Interval              int64                  `protobuf:"varint,5,opt,name=interval,proto3" json:"interval,omitempty"`
-	Start                 *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=start,proto3" json:"start,omitempty"`
-	End                   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=end,proto3" json:"end,omitempty"`
-	Period                int64                  `protobuf:"varint,8,opt,name=period,proto3" json:"period,omitempty"`
-	FastPeriod            int64                  `protobuf:"varint,9,opt,name=fast_period,json=fastPeriod,proto3" json:"fast_period,omitempty"`
-	SlowPeriod            int64                  `protobuf:"varint,10,opt,name=slow_period,json=slowPeriod,proto3" json:"slow_period,omitempty"`
-	StandardDeviationUp   float64                `protobuf:"fixed64,11,opt,name=standard_deviation_up,json=standardDeviationUp,proto3" json:"standard_deviation_up,omitempty"`
-	StandardDeviationDown float64                `protobuf:"fixed64,12,opt,name=standard_deviation_down,json=standardDeviationDown,proto3" json:"standard_deviation_down,omitempty"`
-	MovingAverageType     int64                  `protobuf:"varint,13,opt,name=moving_average_type,json=movingAverageType,proto3" json:"moving_average_type,omitempty"`
-	OtherExchange         string                 `protobuf:"bytes,14,opt,name=other_exchange,json=otherExchange,proto3" json:"other_exchange,omitempty"`
-	OtherPair             *CurrencyPair          `protobuf:"bytes,15,opt,name=other_pair,json=otherPair,proto3" json:"other_pair,omitempty"`
-	OtherAssetType        string                 `protobuf:"bytes,16,opt,name=other_asset_type,json=otherAssetType,proto3" json:"other_asset_type,omitempty"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+func (x *RouteOrderLeg) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *RouteOrderLeg) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *RouteOrderLeg) GetAveragePrice() float64 {
+	if x != nil {
+		return x.AveragePrice
+	}
+	return 0
+}
+
+func (x *RouteOrderLeg) GetWorstPrice() float64 {
+	if x != nil {
+		return x.WorstPrice
+	}
+	return 0
+}
+
+func (x *RouteOrderLeg) GetEffectivePrice() float64 {
+	if x != nil {
+		return x.EffectivePrice
+	}
+	return 0
+}
+
+func (x *RouteOrderLeg) GetCost() float64 {
+	if x != nil {
+		return x.Cost
+	}
+	return 0
+}
+
+func (x *RouteOrderLeg) GetFee() float64 {
+	if x != nil {
+		return x.Fee
+	}
+	return 0
+}
+
+func (x *RouteOrderLeg) GetOrders() []*OrderbookItem {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+func (x *RouteOrderLeg) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
 }
 
-func (x *GetTechnicalAnalysisRequest) Reset() {
-	*x = GetTechnicalAnalysisRequest{}
-	mi := &file_rpc_proto_msgTypes[206]
+func (x *RouteOrderLeg) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type RouteOrderSkipped struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RouteOrderSkipped) Reset() {
+	*x = RouteOrderSkipped{}
+	mi := &file_rpc_proto_msgTypes[249]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTechnicalAnalysisRequest) String() string {
+func (x *RouteOrderSkipped) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTechnicalAnalysisRequest) ProtoMessage() {}
+func (*RouteOrderSkipped) ProtoMessage() {}
 
-func (x *GetTechnicalAnalysisRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[206]
+func (x *RouteOrderSkipped) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[249]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13462,189 +17020,187 @@ func (x *GetTechnicalAnalysisRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTechnicalAnalysisRequest.ProtoReflect.Descriptor instead.
-func (*GetTechnicalAnalysisRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{206}
+// Deprecated: Use RouteOrderSkipped.ProtoReflect.Descriptor instead.
+func (*RouteOrderSkipped) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{249}
 }
 
-func (x *GetTechnicalAnalysisRequest) GetExchange() string {
+func (x *RouteOrderSkipped) GetExchange() string {
 	if x != nil {
 		return x.Exchange
 	}
 	return ""
 }
 
-func (x *GetTechnicalAnalysisRequest) GetPair() *CurrencyPair {
+func (x *RouteOrderSkipped) GetReason() string {
 	if x != nil {
-		return x.Pair
+		return x.Reason
 	}
-	return nil
+	return ""
 }
 
-func (x *GetTechnicalAnalysisRequest) GetAssetType() string {
-	if x != nil {
-		return x.AssetType
-	}
-	return ""
+type RouteOrderResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Pair            *CurrencyPair          `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType       string                 `protobuf:"bytes,2,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Side            string                 `protobuf:"bytes,3,opt,name=side,proto3" json:"side,omitempty"`
+	RequestedAmount float64                `protobuf:"fixed64,4,opt,name=requested_amount,json=requestedAmount,proto3" json:"requested_amount,omitempty"`
+	RoutedAmount    float64                `protobuf:"fixed64,5,opt,name=routed_amount,json=routedAmount,proto3" json:"routed_amount,omitempty"`
+	UnroutedAmount  float64                `protobuf:"fixed64,6,opt,name=unrouted_amount,json=unroutedAmount,proto3" json:"unrouted_amount,omitempty"`
+	AveragePrice    float64                `protobuf:"fixed64,7,opt,name=average_price,json=averagePrice,proto3" json:"average_price,omitempty"`
+	EffectivePrice  float64                `protobuf:"fixed64,8,opt,name=effective_price,json=effectivePrice,proto3" json:"effective_price,omitempty"`
+	TotalFee        float64                `protobuf:"fixed64,9,opt,name=total_fee,json=totalFee,proto3" json:"total_fee,omitempty"`
+	Legs            []*RouteOrderLeg       `protobuf:"bytes,10,rep,name=legs,proto3" json:"legs,omitempty"`
+	Skipped         []*RouteOrderSkipped   `protobuf:"bytes,11,rep,name=skipped,proto3" json:"skipped,omitempty"`
+	Status          string                 `protobuf:"bytes,12,opt,name=status,proto3" json:"status,omitempty"`
+	Executed        bool                   `protobuf:"varint,13,opt,name=executed,proto3" json:"executed,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *GetTechnicalAnalysisRequest) GetAlgorithmType() string {
-	if x != nil {
-		return x.AlgorithmType
-	}
-	return ""
+func (x *RouteOrderResponse) Reset() {
+	*x = RouteOrderResponse{}
+	mi := &file_rpc_proto_msgTypes[250]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTechnicalAnalysisRequest) GetInterval() int64 {
+func (x *RouteOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RouteOrderResponse) ProtoMessage() {}
+
+func (x *RouteOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[250]
 	if x != nil {
-		return x.Interval
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *GetTechnicalAnalysisRequest) GetStart() *timestamppb.Timestamp {
+// Deprecated: Use RouteOrderResponse.ProtoReflect.Descriptor instead.
+func (*RouteOrderResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{250}
+}
+
+func (x *RouteOrderResponse) GetPair() *CurrencyPair {
 	if x != nil {
-		return x.Start
+		return x.Pair
 	}
 	return nil
 }
 
-func (x *GetTechnicalAnalysisRequest) GetEnd() *timestamppb.Timestamp {
+func (x *RouteOrderResponse) GetAssetType() string {
 	if x != nil {
-		return x.End
+		return x.AssetType
 	}
-	return nil
+	return ""
 }
 
-func (x *GetTechnicalAnalysisRequest) GetPeriod() int64 {
+func (x *RouteOrderResponse) GetSide() string {
 	if x != nil {
-		return x.Period
+		return x.Side
 	}
-	return 0
+	return ""
 }
 
-func (x *GetTechnicalAnalysisRequest) GetFastPeriod() int64 {
+func (x *RouteOrderResponse) GetRequestedAmount() float64 {
 	if x != nil {
-		return x.FastPeriod
+		return x.RequestedAmount
 	}
 	return 0
 }
 
-func (x *GetTechnicalAnalysisRequest) GetSlowPeriod() int64 {
+func (x *RouteOrderResponse) GetRoutedAmount() float64 {
 	if x != nil {
-		return x.SlowPeriod
+		return x.RoutedAmount
 	}
 	return 0
 }
 
-func (x *GetTechnicalAnalysisRequest) GetStandardDeviationUp() float64 {
+func (x *RouteOrderResponse) GetUnroutedAmount() float64 {
 	if x != nil {
-		return x.StandardDeviationUp
+		return x.UnroutedAmount
 	}
 	return 0
 }
 
-func (x *GetTechnicalAnalysisRequest) GetStandardDeviationDown() float64 {
+func (x *RouteOrderResponse) GetAveragePrice() float64 {
 	if x != nil {
-		return x.StandardDeviationDown
+		return x.AveragePrice
 	}
 	return 0
 }
 
-func (x *GetTechnicalAnalysisRequest) GetMovingAverageType() int64 {
+func (x *RouteOrderResponse) GetEffectivePrice() float64 {
 	if x != nil {
-		return x.MovingAverageType
+		return x.EffectivePrice
 	}
 	return 0
 }
 
-func (x *GetTechnicalAnalysisRequest) GetOtherExchange() string {
+func (x *RouteOrderResponse) GetTotalFee() float64 {
 	if x != nil {
-		return x.OtherExchange
+		return x.TotalFee
 	}
-	return ""
+	return 0
 }
 
-func (x *GetTechnicalAnalysisRequest) GetOtherPair() *CurrencyPair {
+func (x *RouteOrderResponse) GetLegs() []*RouteOrderLeg {
 	if x != nil {
-		return x.OtherPair
+		return x.Legs
 	}
 	return nil
 }
 
-func (x *GetTechnicalAnalysisRequest) GetOtherAssetType() string {
+func (x *RouteOrderResponse) GetSkipped() []*RouteOrderSkipped {
 	if x != nil {
-		return x.OtherAssetType
+		return x.Skipped
 	}
-	return ""
-}
-
-type ListOfSignals struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Signals       []float64              `protobuf:"fixed64,1,rep,packed,name=signals,proto3" json:"signals,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ListOfSignals) Reset() {
-	*x = ListOfSignals{}
-	mi := &file_rpc_proto_msgTypes[207]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ListOfSignals) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return nil
 }
 
-func (*ListOfSignals) ProtoMessage() {}
-
-func (x *ListOfSignals) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[207]
+func (x *RouteOrderResponse) GetStatus() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Status
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ListOfSignals.ProtoReflect.Descriptor instead.
-func (*ListOfSignals) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{207}
+	return ""
 }
 
-func (x *ListOfSignals) GetSignals() []float64 {
+func (x *RouteOrderResponse) GetExecuted() bool {
 	if x != nil {
-		return x.Signals
+		return x.Executed
 	}
-	return nil
+	return false
 }
 
-type GetTechnicalAnalysisResponse struct {
-	state         protoimpl.MessageState    `protogen:"open.v1"`
-	Signals       map[string]*ListOfSignals `protobuf:"bytes,1,rep,name=signals,proto3" json:"signals,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+type GetLedgerPNLRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Currency      string                 `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTechnicalAnalysisResponse) Reset() {
-	*x = GetTechnicalAnalysisResponse{}
-	mi := &file_rpc_proto_msgTypes[208]
+func (x *GetLedgerPNLRequest) Reset() {
+	*x = GetLedgerPNLRequest{}
+	mi := &file_rpc_proto_msgTypes[251]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTechnicalAnalysisResponse) String() string {
+func (x *GetLedgerPNLRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTechnicalAnalysisResponse) ProtoMessage() {}
+func (*GetLedgerPNLRequest) ProtoMessage() {}
 
-func (x *GetTechnicalAnalysisResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[208]
+func (x *GetLedgerPNLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[251]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13655,52 +17211,51 @@ func (x *GetTechnicalAnalysisResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTechnicalAnalysisResponse.ProtoReflect.Descriptor instead.
-func (*GetTechnicalAnalysisResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{208}
+// Deprecated: Use GetLedgerPNLRequest.ProtoReflect.Descriptor instead.
+func (*GetLedgerPNLRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{251}
 }
 
-func (x *GetTechnicalAnalysisResponse) GetSignals() map[string]*ListOfSignals {
+func (x *GetLedgerPNLRequest) GetCurrency() string {
 	if x != nil {
-		return x.Signals
+		return x.Currency
 	}
-	return nil
+	return ""
 }
 
-type GetMarginRatesHistoryRequest struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	Exchange           string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset              string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Currency           string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
-	StartDate          string                 `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
-	EndDate            string                 `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
-	GetPredictedRate   bool                   `protobuf:"varint,6,opt,name=get_predicted_rate,json=getPredictedRate,proto3" json:"get_predicted_rate,omitempty"`
-	GetLendingPayments bool                   `protobuf:"varint,7,opt,name=get_lending_payments,json=getLendingPayments,proto3" json:"get_lending_payments,omitempty"`
-	GetBorrowRates     bool                   `protobuf:"varint,8,opt,name=get_borrow_rates,json=getBorrowRates,proto3" json:"get_borrow_rates,omitempty"`
-	GetBorrowCosts     bool                   `protobuf:"varint,9,opt,name=get_borrow_costs,json=getBorrowCosts,proto3" json:"get_borrow_costs,omitempty"`
-	IncludeAllRates    bool                   `protobuf:"varint,10,opt,name=include_all_rates,json=includeAllRates,proto3" json:"include_all_rates,omitempty"`
-	CalculateOffline   bool                   `protobuf:"varint,11,opt,name=calculate_offline,json=calculateOffline,proto3" json:"calculate_offline,omitempty"`
-	TakerFeeRate       string                 `protobuf:"bytes,12,opt,name=taker_fee_rate,json=takerFeeRate,proto3" json:"taker_fee_rate,omitempty"`
-	Rates              []*MarginRate          `protobuf:"bytes,13,rep,name=rates,proto3" json:"rates,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+type LedgerCurrencyPNL struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Currency          string                 `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	Holdings          string                 `protobuf:"bytes,2,opt,name=holdings,proto3" json:"holdings,omitempty"`
+	CostBasis         string                 `protobuf:"bytes,3,opt,name=cost_basis,json=costBasis,proto3" json:"cost_basis,omitempty"`
+	AverageCost       string                 `protobuf:"bytes,4,opt,name=average_cost,json=averageCost,proto3" json:"average_cost,omitempty"`
+	RealisedPnl       string                 `protobuf:"bytes,5,opt,name=realised_pnl,json=realisedPnl,proto3" json:"realised_pnl,omitempty"`
+	Valued            bool                   `protobuf:"varint,6,opt,name=valued,proto3" json:"valued,omitempty"`
+	MarketValue       string                 `protobuf:"bytes,7,opt,name=market_value,json=marketValue,proto3" json:"market_value,omitempty"`
+	UnrealisedPnl     string                 `protobuf:"bytes,8,opt,name=unrealised_pnl,json=unrealisedPnl,proto3" json:"unrealised_pnl,omitempty"`
+	UnmatchedAmount   string                 `protobuf:"bytes,9,opt,name=unmatched_amount,json=unmatchedAmount,proto3" json:"unmatched_amount,omitempty"`
+	UnmatchedProceeds string                 `protobuf:"bytes,10,opt,name=unmatched_proceeds,json=unmatchedProceeds,proto3" json:"unmatched_proceeds,omitempty"`
+	UnvaluedHoldings  string                 `protobuf:"bytes,11,opt,name=unvalued_holdings,json=unvaluedHoldings,proto3" json:"unvalued_holdings,omitempty"`
+	UnvaluedDisposed  string                 `protobuf:"bytes,12,opt,name=unvalued_disposed,json=unvaluedDisposed,proto3" json:"unvalued_disposed,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *GetMarginRatesHistoryRequest) Reset() {
-	*x = GetMarginRatesHistoryRequest{}
-	mi := &file_rpc_proto_msgTypes[209]
+func (x *LedgerCurrencyPNL) Reset() {
+	*x = LedgerCurrencyPNL{}
+	mi := &file_rpc_proto_msgTypes[252]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMarginRatesHistoryRequest) String() string {
+func (x *LedgerCurrencyPNL) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMarginRatesHistoryRequest) ProtoMessage() {}
+func (*LedgerCurrencyPNL) ProtoMessage() {}
 
-func (x *GetMarginRatesHistoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[209]
+func (x *LedgerCurrencyPNL) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[252]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13711,125 +17266,121 @@ func (x *GetMarginRatesHistoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMarginRatesHistoryRequest.ProtoReflect.Descriptor instead.
-func (*GetMarginRatesHistoryRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{209}
+// Deprecated: Use LedgerCurrencyPNL.ProtoReflect.Descriptor instead.
+func (*LedgerCurrencyPNL) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{252}
 }
 
-func (x *GetMarginRatesHistoryRequest) GetExchange() string {
+func (x *LedgerCurrencyPNL) GetCurrency() string {
 	if x != nil {
-		return x.Exchange
+		return x.Currency
 	}
 	return ""
 }
 
-func (x *GetMarginRatesHistoryRequest) GetAsset() string {
+func (x *LedgerCurrencyPNL) GetHoldings() string {
 	if x != nil {
-		return x.Asset
+		return x.Holdings
 	}
 	return ""
 }
 
-func (x *GetMarginRatesHistoryRequest) GetCurrency() string {
+func (x *LedgerCurrencyPNL) GetCostBasis() string {
 	if x != nil {
-		return x.Currency
+		return x.CostBasis
 	}
 	return ""
 }
 
-func (x *GetMarginRatesHistoryRequest) GetStartDate() string {
+func (x *LedgerCurrencyPNL) GetAverageCost() string {
 	if x != nil {
-		return x.StartDate
+		return x.AverageCost
 	}
 	return ""
 }
 
-func (x *GetMarginRatesHistoryRequest) GetEndDate() string {
+func (x *LedgerCurrencyPNL) GetRealisedPnl() string {
 	if x != nil {
-		return x.EndDate
+		return x.RealisedPnl
 	}
 	return ""
 }
 
-func (x *GetMarginRatesHistoryRequest) GetGetPredictedRate() bool {
-	if x != nil {
-		return x.GetPredictedRate
-	}
-	return false
-}
-
-func (x *GetMarginRatesHistoryRequest) GetGetLendingPayments() bool {
+func (x *LedgerCurrencyPNL) GetValued() bool {
 	if x != nil {
-		return x.GetLendingPayments
+		return x.Valued
 	}
 	return false
 }
 
-func (x *GetMarginRatesHistoryRequest) GetGetBorrowRates() bool {
+func (x *LedgerCurrencyPNL) GetMarketValue() string {
 	if x != nil {
-		return x.GetBorrowRates
+		return x.MarketValue
 	}
-	return false
+	return ""
 }
 
-func (x *GetMarginRatesHistoryRequest) GetGetBorrowCosts() bool {
+func (x *LedgerCurrencyPNL) GetUnrealisedPnl() string {
 	if x != nil {
-		return x.GetBorrowCosts
+		return x.UnrealisedPnl
 	}
-	return false
+	return ""
 }
 
-func (x *GetMarginRatesHistoryRequest) GetIncludeAllRates() bool {
+func (x *LedgerCurrencyPNL) GetUnmatchedAmount() string {
 	if x != nil {
-		return x.IncludeAllRates
+		return x.UnmatchedAmount
 	}
-	return false
+	return ""
 }
 
-func (x *GetMarginRatesHistoryRequest) GetCalculateOffline() bool {
+func (x *LedgerCurrencyPNL) GetUnmatchedProceeds() string {
 	if x != nil {
-		return x.CalculateOffline
+		return x.UnmatchedProceeds
 	}
-	return false
+	return ""
 }
 
-func (x *GetMarginRatesHistoryRequest) GetTakerFeeRate() string {
+func (x *LedgerCurrencyPNL) GetUnvaluedHoldings() string {
 	if x != nil {
-		return x.TakerFeeRate
+		return x.UnvaluedHoldings
 	}
 	return ""
 }
 
-func (x *GetMarginRatesHistoryRequest) GetRates() []*MarginRate {
+func (x *LedgerCurrencyPNL) GetUnvaluedDisposed() string {
 	if x != nil {
-		return x.Rates
+		return x.UnvaluedDisposed
 	}
-	return nil
+	return ""
 }
 
-type LendingPayment struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Payment       string                 `protobuf:"bytes,1,opt,name=payment,proto3" json:"payment,omitempty"`
-	Size          string                 `protobuf:"bytes,2,opt,name=size,proto3" json:"size,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type GetLedgerPNLResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Method            string                 `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
+	ReportingCurrency string                 `protobuf:"bytes,2,opt,name=reporting_currency,json=reportingCurrency,proto3" json:"reporting_currency,omitempty"`
+	Currencies        []*LedgerCurrencyPNL   `protobuf:"bytes,3,rep,name=currencies,proto3" json:"currencies,omitempty"`
+	RealisedPnl       string                 `protobuf:"bytes,4,opt,name=realised_pnl,json=realisedPnl,proto3" json:"realised_pnl,omitempty"`
+	UnrealisedPnl     string                 `protobuf:"bytes,5,opt,name=unrealised_pnl,json=unrealisedPnl,proto3" json:"unrealised_pnl,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *LendingPayment) Reset() {
-	*x = LendingPayment{}
-	mi := &file_rpc_proto_msgTypes[210]
+func (x *GetLedgerPNLResponse) Reset() {
+	*x = GetLedgerPNLResponse{}
+	mi := &file_rpc_proto_msgTypes[253]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LendingPayment) String() string {
+func (x *GetLedgerPNLResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LendingPayment) ProtoMessage() {}
+func (*GetLedgerPNLResponse) ProtoMessage() {}
 
-func (x *LendingPayment) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[210]
+func (x *GetLedgerPNLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[253]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13837,51 +17388,72 @@ func (x *LendingPayment) ProtoReflect() protoreflect.Message {
 		}
 		return ms
 	}
-	return mi.MessageOf(x)
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLedgerPNLResponse.ProtoReflect.Descriptor instead.
+func (*GetLedgerPNLResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{253}
+}
+
+func (x *GetLedgerPNLResponse) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *GetLedgerPNLResponse) GetReportingCurrency() string {
+	if x != nil {
+		return x.ReportingCurrency
+	}
+	return ""
 }
 
-// Deprecated: Use LendingPayment.ProtoReflect.Descriptor instead.
-func (*LendingPayment) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{210}
+func (x *GetLedgerPNLResponse) GetCurrencies() []*LedgerCurrencyPNL {
+	if x != nil {
+		return x.Currencies
+	}
+	return nil
 }
 
-func (x *LendingPayment) GetPayment() string {
+func (x *GetLedgerPNLResponse) GetRealisedPnl() string {
 	if x != nil {
-		return x.Payment
+		return x.RealisedPnl
 	}
 	return ""
 }
 
-func (x *LendingPayment) GetSize() string {
+func (x *GetLedgerPNLResponse) GetUnrealisedPnl() string {
 	if x != nil {
-		return x.Size
+		return x.UnrealisedPnl
 	}
 	return ""
 }
 
-type BorrowCost struct {
+type GetLedgerLotsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Cost          string                 `protobuf:"bytes,1,opt,name=cost,proto3" json:"cost,omitempty"`
-	Size          string                 `protobuf:"bytes,2,opt,name=size,proto3" json:"size,omitempty"`
+	Currency      string                 `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	IncludeClosed bool                   `protobuf:"varint,2,opt,name=include_closed,json=includeClosed,proto3" json:"include_closed,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BorrowCost) Reset() {
-	*x = BorrowCost{}
-	mi := &file_rpc_proto_msgTypes[211]
+func (x *GetLedgerLotsRequest) Reset() {
+	*x = GetLedgerLotsRequest{}
+	mi := &file_rpc_proto_msgTypes[254]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BorrowCost) String() string {
+func (x *GetLedgerLotsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BorrowCost) ProtoMessage() {}
+func (*GetLedgerLotsRequest) ProtoMessage() {}
 
-func (x *BorrowCost) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[211]
+func (x *GetLedgerLotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[254]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13892,54 +17464,56 @@ func (x *BorrowCost) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BorrowCost.ProtoReflect.Descriptor instead.
-func (*BorrowCost) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{211}
+// Deprecated: Use GetLedgerLotsRequest.ProtoReflect.Descriptor instead.
+func (*GetLedgerLotsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{254}
 }
 
-func (x *BorrowCost) GetCost() string {
+func (x *GetLedgerLotsRequest) GetCurrency() string {
 	if x != nil {
-		return x.Cost
+		return x.Currency
 	}
 	return ""
 }
 
-func (x *BorrowCost) GetSize() string {
+func (x *GetLedgerLotsRequest) GetIncludeClosed() bool {
 	if x != nil {
-		return x.Size
+		return x.IncludeClosed
 	}
-	return ""
+	return false
 }
 
-type MarginRate struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Time             string                 `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
-	MarketBorrowSize string                 `protobuf:"bytes,2,opt,name=market_borrow_size,json=marketBorrowSize,proto3" json:"market_borrow_size,omitempty"`
-	HourlyRate       string                 `protobuf:"bytes,3,opt,name=hourly_rate,json=hourlyRate,proto3" json:"hourly_rate,omitempty"`
-	YearlyRate       string                 `protobuf:"bytes,4,opt,name=yearly_rate,json=yearlyRate,proto3" json:"yearly_rate,omitempty"`
-	HourlyBorrowRate string                 `protobuf:"bytes,5,opt,name=hourly_borrow_rate,json=hourlyBorrowRate,proto3" json:"hourly_borrow_rate,omitempty"`
-	YearlyBorrowRate string                 `protobuf:"bytes,6,opt,name=yearly_borrow_rate,json=yearlyBorrowRate,proto3" json:"yearly_borrow_rate,omitempty"`
-	LendingPayment   *LendingPayment        `protobuf:"bytes,7,opt,name=lending_payment,json=lendingPayment,proto3" json:"lending_payment,omitempty"`
-	BorrowCost       *BorrowCost            `protobuf:"bytes,8,opt,name=borrow_cost,json=borrowCost,proto3" json:"borrow_cost,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type LedgerDisposal struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair          *CurrencyPair          `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	OrderId       string                 `protobuf:"bytes,3,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	TradeId       string                 `protobuf:"bytes,4,opt,name=trade_id,json=tradeId,proto3" json:"trade_id,omitempty"`
+	Time          *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=time,proto3" json:"time,omitempty"`
+	Amount        string                 `protobuf:"bytes,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	Proceeds      string                 `protobuf:"bytes,7,opt,name=proceeds,proto3" json:"proceeds,omitempty"`
+	CostBasis     string                 `protobuf:"bytes,8,opt,name=cost_basis,json=costBasis,proto3" json:"cost_basis,omitempty"`
+	RealisedPnl   string                 `protobuf:"bytes,9,opt,name=realised_pnl,json=realisedPnl,proto3" json:"realised_pnl,omitempty"`
+	Valued        bool                   `protobuf:"varint,10,opt,name=valued,proto3" json:"valued,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MarginRate) Reset() {
-	*x = MarginRate{}
-	mi := &file_rpc_proto_msgTypes[212]
+func (x *LedgerDisposal) Reset() {
+	*x = LedgerDisposal{}
+	mi := &file_rpc_proto_msgTypes[255]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MarginRate) String() string {
+func (x *LedgerDisposal) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MarginRate) ProtoMessage() {}
+func (*LedgerDisposal) ProtoMessage() {}
 
-func (x *MarginRate) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[212]
+func (x *LedgerDisposal) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[255]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13950,97 +17524,115 @@ func (x *MarginRate) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MarginRate.ProtoReflect.Descriptor instead.
-func (*MarginRate) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{212}
+// Deprecated: Use LedgerDisposal.ProtoReflect.Descriptor instead.
+func (*LedgerDisposal) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{255}
 }
 
-func (x *MarginRate) GetTime() string {
+func (x *LedgerDisposal) GetExchange() string {
 	if x != nil {
-		return x.Time
+		return x.Exchange
 	}
 	return ""
 }
 
-func (x *MarginRate) GetMarketBorrowSize() string {
+func (x *LedgerDisposal) GetPair() *CurrencyPair {
 	if x != nil {
-		return x.MarketBorrowSize
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *LedgerDisposal) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
 	}
 	return ""
 }
 
-func (x *MarginRate) GetHourlyRate() string {
+func (x *LedgerDisposal) GetTradeId() string {
 	if x != nil {
-		return x.HourlyRate
+		return x.TradeId
 	}
 	return ""
 }
 
-func (x *MarginRate) GetYearlyRate() string {
+func (x *LedgerDisposal) GetTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.YearlyRate
+		return x.Time
+	}
+	return nil
+}
+
+func (x *LedgerDisposal) GetAmount() string {
+	if x != nil {
+		return x.Amount
 	}
 	return ""
 }
 
-func (x *MarginRate) GetHourlyBorrowRate() string {
+func (x *LedgerDisposal) GetProceeds() string {
 	if x != nil {
-		return x.HourlyBorrowRate
+		return x.Proceeds
 	}
 	return ""
 }
 
-func (x *MarginRate) GetYearlyBorrowRate() string {
+func (x *LedgerDisposal) GetCostBasis() string {
 	if x != nil {
-		return x.YearlyBorrowRate
+		return x.CostBasis
 	}
 	return ""
 }
 
-func (x *MarginRate) GetLendingPayment() *LendingPayment {
+func (x *LedgerDisposal) GetRealisedPnl() string {
 	if x != nil {
-		return x.LendingPayment
+		return x.RealisedPnl
 	}
-	return nil
+	return ""
 }
 
-func (x *MarginRate) GetBorrowCost() *BorrowCost {
+func (x *LedgerDisposal) GetValued() bool {
 	if x != nil {
-		return x.BorrowCost
+		return x.Valued
 	}
-	return nil
+	return false
 }
 
-type GetMarginRatesHistoryResponse struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	Rates              []*MarginRate          `protobuf:"bytes,1,rep,name=rates,proto3" json:"rates,omitempty"`
-	TotalRates         int64                  `protobuf:"varint,2,opt,name=total_rates,json=totalRates,proto3" json:"total_rates,omitempty"`
-	SumBorrowCosts     string                 `protobuf:"bytes,3,opt,name=sum_borrow_costs,json=sumBorrowCosts,proto3" json:"sum_borrow_costs,omitempty"`
-	AvgBorrowSize      string                 `protobuf:"bytes,4,opt,name=avg_borrow_size,json=avgBorrowSize,proto3" json:"avg_borrow_size,omitempty"`
-	SumLendingPayments string                 `protobuf:"bytes,5,opt,name=sum_lending_payments,json=sumLendingPayments,proto3" json:"sum_lending_payments,omitempty"`
-	AvgLendingSize     string                 `protobuf:"bytes,6,opt,name=avg_lending_size,json=avgLendingSize,proto3" json:"avg_lending_size,omitempty"`
-	LatestRate         *MarginRate            `protobuf:"bytes,7,opt,name=latest_rate,json=latestRate,proto3" json:"latest_rate,omitempty"`
-	PredictedRate      *MarginRate            `protobuf:"bytes,8,opt,name=predicted_rate,json=predictedRate,proto3" json:"predicted_rate,omitempty"`
-	TakerFeeRate       string                 `protobuf:"bytes,9,opt,name=taker_fee_rate,json=takerFeeRate,proto3" json:"taker_fee_rate,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+type LedgerLot struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Currency      string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	Exchange      string                 `protobuf:"bytes,3,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair          *CurrencyPair          `protobuf:"bytes,4,opt,name=pair,proto3" json:"pair,omitempty"`
+	OrderId       string                 `protobuf:"bytes,5,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	TradeId       string                 `protobuf:"bytes,6,opt,name=trade_id,json=tradeId,proto3" json:"trade_id,omitempty"`
+	AcquiredAt    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=acquired_at,json=acquiredAt,proto3" json:"acquired_at,omitempty"`
+	Amount        string                 `protobuf:"bytes,8,opt,name=amount,proto3" json:"amount,omitempty"`
+	Remaining     string                 `protobuf:"bytes,9,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	CostBasis     string                 `protobuf:"bytes,10,opt,name=cost_basis,json=costBasis,proto3" json:"cost_basis,omitempty"`
+	UnitCost      string                 `protobuf:"bytes,11,opt,name=unit_cost,json=unitCost,proto3" json:"unit_cost,omitempty"`
+	Disposals     []*LedgerDisposal      `protobuf:"bytes,12,rep,name=disposals,proto3" json:"disposals,omitempty"`
+	Valued        bool                   `protobuf:"varint,13,opt,name=valued,proto3" json:"valued,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMarginRatesHistoryResponse) Reset() {
-	*x = GetMarginRatesHistoryResponse{}
-	mi := &file_rpc_proto_msgTypes[213]
+func (x *LedgerLot) Reset() {
+	*x = LedgerLot{}
+	mi := &file_rpc_proto_msgTypes[256]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMarginRatesHistoryResponse) String() string {
+func (x *LedgerLot) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMarginRatesHistoryResponse) ProtoMessage() {}
+func (*LedgerLot) ProtoMessage() {}
 
-func (x *GetMarginRatesHistoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[213]
+func (x *LedgerLot) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[256]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14051,102 +17643,126 @@ func (x *GetMarginRatesHistoryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMarginRatesHistoryResponse.ProtoReflect.Descriptor instead.
-func (*GetMarginRatesHistoryResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{213}
+// Deprecated: Use LedgerLot.ProtoReflect.Descriptor instead.
+func (*LedgerLot) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{256}
 }
 
-func (x *GetMarginRatesHistoryResponse) GetRates() []*MarginRate {
+func (x *LedgerLot) GetId() uint64 {
 	if x != nil {
-		return x.Rates
+		return x.Id
+	}
+	return 0
+}
+
+func (x *LedgerLot) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *LedgerLot) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *LedgerLot) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
 	}
 	return nil
 }
 
-func (x *GetMarginRatesHistoryResponse) GetTotalRates() int64 {
+func (x *LedgerLot) GetOrderId() string {
 	if x != nil {
-		return x.TotalRates
+		return x.OrderId
 	}
-	return 0
+	return ""
 }
 
-func (x *GetMarginRatesHistoryResponse) GetSumBorrowCosts() string {
+func (x *LedgerLot) GetTradeId() string {
 	if x != nil {
-		return x.SumBorrowCosts
+		return x.TradeId
 	}
 	return ""
 }
 
-func (x *GetMarginRatesHistoryResponse) GetAvgBorrowSize() string {
+func (x *LedgerLot) GetAcquiredAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.AvgBorrowSize
+		return x.AcquiredAt
+	}
+	return nil
+}
+
+func (x *LedgerLot) GetAmount() string {
+	if x != nil {
+		return x.Amount
 	}
 	return ""
 }
 
-func (x *GetMarginRatesHistoryResponse) GetSumLendingPayments() string {
+func (x *LedgerLot) GetRemaining() string {
 	if x != nil {
-		return x.SumLendingPayments
+		return x.Remaining
 	}
 	return ""
 }
 
-func (x *GetMarginRatesHistoryResponse) GetAvgLendingSize() string {
+func (x *LedgerLot) GetCostBasis() string {
 	if x != nil {
-		return x.AvgLendingSize
+		return x.CostBasis
 	}
 	return ""
 }
 
-func (x *GetMarginRatesHistoryResponse) GetLatestRate() *MarginRate {
+func (x *LedgerLot) GetUnitCost() string {
 	if x != nil {
-		return x.LatestRate
+		return x.UnitCost
 	}
-	return nil
+	return ""
 }
 
-func (x *GetMarginRatesHistoryResponse) GetPredictedRate() *MarginRate {
+func (x *LedgerLot) GetDisposals() []*LedgerDisposal {
 	if x != nil {
-		return x.PredictedRate
+		return x.Disposals
 	}
 	return nil
 }
 
-func (x *GetMarginRatesHistoryResponse) GetTakerFeeRate() string {
+func (x *LedgerLot) GetValued() bool {
 	if x != nil {
-		return x.TakerFeeRate
+		return x.Valued
 	}
-	return ""
+	return false
 }
 
-type GetOrderbookMovementRequest struct {
-	state                 protoimpl.MessageState `protogen:"open.v1"`
-	Exchange              string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset                 string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair                  *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	Amount                float64                `protobuf:"fixed64,6,opt,name=amount,proto3" json:"amount,omitempty"`
-	Sell                  bool                   `protobuf:"varint,7,opt,name=sell,proto3" json:"sell,omitempty"`
-	RequiresRestOrderbook bool                   `protobuf:"varint,8,opt,name=requires_rest_orderbook,json=requiresRestOrderbook,proto3" json:"requires_rest_orderbook,omitempty"`
-	Purchase              bool                   `protobuf:"varint,9,opt,name=purchase,proto3" json:"purchase,omitempty"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+type GetLedgerLotsResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Method            string                 `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
+	ReportingCurrency string                 `protobuf:"bytes,2,opt,name=reporting_currency,json=reportingCurrency,proto3" json:"reporting_currency,omitempty"`
+	Lots              []*LedgerLot           `protobuf:"bytes,3,rep,name=lots,proto3" json:"lots,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *GetOrderbookMovementRequest) Reset() {
-	*x = GetOrderbookMovementRequest{}
-	mi := &file_rpc_proto_msgTypes[214]
+func (x *GetLedgerLotsResponse) Reset() {
+	*x = GetLedgerLotsResponse{}
+	mi := &file_rpc_proto_msgTypes[257]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOrderbookMovementRequest) String() string {
+func (x *GetLedgerLotsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOrderbookMovementRequest) ProtoMessage() {}
+func (*GetLedgerLotsResponse) ProtoMessage() {}
 
-func (x *GetOrderbookMovementRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[214]
+func (x *GetLedgerLotsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[257]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14157,95 +17773,56 @@ func (x *GetOrderbookMovementRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOrderbookMovementRequest.ProtoReflect.Descriptor instead.
-func (*GetOrderbookMovementRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{214}
+// Deprecated: Use GetLedgerLotsResponse.ProtoReflect.Descriptor instead.
+func (*GetLedgerLotsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{257}
 }
 
-func (x *GetOrderbookMovementRequest) GetExchange() string {
+func (x *GetLedgerLotsResponse) GetMethod() string {
 	if x != nil {
-		return x.Exchange
+		return x.Method
 	}
 	return ""
 }
 
-func (x *GetOrderbookMovementRequest) GetAsset() string {
+func (x *GetLedgerLotsResponse) GetReportingCurrency() string {
 	if x != nil {
-		return x.Asset
+		return x.ReportingCurrency
 	}
 	return ""
 }
 
-func (x *GetOrderbookMovementRequest) GetPair() *CurrencyPair {
+func (x *GetLedgerLotsResponse) GetLots() []*LedgerLot {
 	if x != nil {
-		return x.Pair
+		return x.Lots
 	}
 	return nil
 }
 
-func (x *GetOrderbookMovementRequest) GetAmount() float64 {
-	if x != nil {
-		return x.Amount
-	}
-	return 0
-}
-
-func (x *GetOrderbookMovementRequest) GetSell() bool {
-	if x != nil {
-		return x.Sell
-	}
-	return false
-}
-
-func (x *GetOrderbookMovementRequest) GetRequiresRestOrderbook() bool {
-	if x != nil {
-		return x.RequiresRestOrderbook
-	}
-	return false
-}
-
-func (x *GetOrderbookMovementRequest) GetPurchase() bool {
-	if x != nil {
-		return x.Purchase
-	}
-	return false
-}
-
-type GetOrderbookMovementResponse struct {
-	state                     protoimpl.MessageState `protogen:"open.v1"`
-	NominalPercentage         float64                `protobuf:"fixed64,1,opt,name=nominal_percentage,json=nominalPercentage,proto3" json:"nominal_percentage,omitempty"`
-	ImpactPercentage          float64                `protobuf:"fixed64,2,opt,name=impact_percentage,json=impactPercentage,proto3" json:"impact_percentage,omitempty"`
-	SlippageCost              float64                `protobuf:"fixed64,3,opt,name=slippage_cost,json=slippageCost,proto3" json:"slippage_cost,omitempty"`
-	CurrencyBought            string                 `protobuf:"bytes,4,opt,name=currency_bought,json=currencyBought,proto3" json:"currency_bought,omitempty"`
-	Bought                    float64                `protobuf:"fixed64,5,opt,name=bought,proto3" json:"bought,omitempty"`
-	CurrencySold              string                 `protobuf:"bytes,6,opt,name=currency_sold,json=currencySold,proto3" json:"currency_sold,omitempty"`
-	Sold                      float64                `protobuf:"fixed64,7,opt,name=sold,proto3" json:"sold,omitempty"`
-	SideAffected              string                 `protobuf:"bytes,8,opt,name=side_affected,json=sideAffected,proto3" json:"side_affected,omitempty"`
-	UpdateProtocol            string                 `protobuf:"bytes,9,opt,name=update_protocol,json=updateProtocol,proto3" json:"update_protocol,omitempty"`
-	FullOrderbookSideConsumed bool                   `protobuf:"varint,10,opt,name=full_orderbook_side_consumed,json=fullOrderbookSideConsumed,proto3" json:"full_orderbook_side_consumed,omitempty"`
-	StartPrice                float64                `protobuf:"fixed64,11,opt,name=start_price,json=startPrice,proto3" json:"start_price,omitempty"`
-	EndPrice                  float64                `protobuf:"fixed64,12,opt,name=end_price,json=endPrice,proto3" json:"end_price,omitempty"`
-	NoSlippageOccurred        bool                   `protobuf:"varint,13,opt,name=no_slippage_occurred,json=noSlippageOccurred,proto3" json:"no_slippage_occurred,omitempty"`
-	AverageOrderCost          float64                `protobuf:"fixed64,14,opt,name=average_order_cost,json=averageOrderCost,proto3" json:"average_order_cost,omitempty"`
-	unknownFields             protoimpl.UnknownFields
-	sizeCache                 protoimpl.SizeCache
+type ExportLedgerCSVRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Report        string                 `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
+	Currency      string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	IncludeClosed bool                   `protobuf:"varint,3,opt,name=include_closed,json=includeClosed,proto3" json:"include_closed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOrderbookMovementResponse) Reset() {
-	*x = GetOrderbookMovementResponse{}
-	mi := &file_rpc_proto_msgTypes[215]
+func (x *ExportLedgerCSVRequest) Reset() {
+	*x = ExportLedgerCSVRequest{}
+	mi := &file_rpc_proto_msgTypes[258]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOrderbookMovementResponse) String() string {
+func (x *ExportLedgerCSVRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOrderbookMovementResponse) ProtoMessage() {}
+func (*ExportLedgerCSVRequest) ProtoMessage() {}
 
-func (x *GetOrderbookMovementResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[215]
+func (x *ExportLedgerCSVRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[258]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14256,136 +17833,229 @@ func (x *GetOrderbookMovementResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOrderbookMovementResponse.ProtoReflect.Descriptor instead.
-func (*GetOrderbookMovementResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{215}
+// Deprecated: Use ExportLedgerCSVRequest.ProtoReflect.Descriptor instead.
+func (*ExportLedgerCSVRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{258}
 }
 
-func (x *GetOrderbookMovementResponse) GetNominalPercentage() float64 {
+func (x *ExportLedgerCSVRequest) GetReport() string {
 	if x != nil {
-		return x.NominalPercentage
+		return x.Report
 	}
-	return 0
+	return ""
 }
 
-func (x *GetOrderbookMovementResponse) GetImpactPercentage() float64 {
+func (x *ExportLedgerCSVRequest) GetCurrency() string {
 	if x != nil {
-		return x.ImpactPercentage
+		return x.Currency
 	}
-	return 0
+	return ""
 }
 
-func (x *GetOrderbookMovementResponse) GetSlippageCost() float64 {
+func (x *ExportLedgerCSVRequest) GetIncludeClosed() bool {
 	if x != nil {
-		return x.SlippageCost
+		return x.IncludeClosed
 	}
-	return 0
+	return false
 }
 
-func (x *GetOrderbookMovementResponse) GetCurrencyBought() string {
-	if x != nil {
-		return x.CurrencyBought
-	}
-	return ""
+type ExportLedgerCSVResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Report        string                 `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
+	Csv           string                 `protobuf:"bytes,2,opt,name=csv,proto3" json:"csv,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOrderbookMovementResponse) GetBought() float64 {
-	if x != nil {
-		return x.Bought
-	}
-	return 0
+func (x *ExportLedgerCSVResponse) Reset() {
+	*x = ExportLedgerCSVResponse{}
+	mi := &file_rpc_proto_msgTypes[259]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOrderbookMovementResponse) GetCurrencySold() string {
-	if x != nil {
-		return x.CurrencySold
-	}
-	return ""
+func (x *ExportLedgerCSVResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *GetOrderbookMovementResponse) GetSold() float64 {
+func (*ExportLedgerCSVResponse) ProtoMessage() {}
+
+func (x *ExportLedgerCSVResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[259]
 	if x != nil {
-		return x.Sold
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *GetOrderbookMovementResponse) GetSideAffected() string {
+// Deprecated: Use ExportLedgerCSVResponse.ProtoReflect.Descriptor instead.
+func (*ExportLedgerCSVResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{259}
+}
+
+func (x *ExportLedgerCSVResponse) GetReport() string {
 	if x != nil {
-		return x.SideAffected
+		return x.Report
 	}
 	return ""
 }
 
-func (x *GetOrderbookMovementResponse) GetUpdateProtocol() string {
+func (x *ExportLedgerCSVResponse) GetCsv() string {
 	if x != nil {
-		return x.UpdateProtocol
+		return x.Csv
 	}
 	return ""
 }
 
-func (x *GetOrderbookMovementResponse) GetFullOrderbookSideConsumed() bool {
-	if x != nil {
-		return x.FullOrderbookSideConsumed
-	}
-	return false
+type PreviewRebalanceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOrderbookMovementResponse) GetStartPrice() float64 {
+func (x *PreviewRebalanceRequest) Reset() {
+	*x = PreviewRebalanceRequest{}
+	mi := &file_rpc_proto_msgTypes[260]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewRebalanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewRebalanceRequest) ProtoMessage() {}
+
+func (x *PreviewRebalanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[260]
 	if x != nil {
-		return x.StartPrice
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *GetOrderbookMovementResponse) GetEndPrice() float64 {
+// Deprecated: Use PreviewRebalanceRequest.ProtoReflect.Descriptor instead.
+func (*PreviewRebalanceRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{260}
+}
+
+type GetRebalancePlanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRebalancePlanRequest) Reset() {
+	*x = GetRebalancePlanRequest{}
+	mi := &file_rpc_proto_msgTypes[261]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRebalancePlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRebalancePlanRequest) ProtoMessage() {}
+
+func (x *GetRebalancePlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[261]
 	if x != nil {
-		return x.EndPrice
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *GetOrderbookMovementResponse) GetNoSlippageOccurred() bool {
+// Deprecated: Use GetRebalancePlanRequest.ProtoReflect.Descriptor instead.
+func (*GetRebalancePlanRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{261}
+}
+
+type ApproveRebalancePlanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveRebalancePlanRequest) Reset() {
+	*x = ApproveRebalancePlanRequest{}
+	mi := &file_rpc_proto_msgTypes[262]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveRebalancePlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveRebalancePlanRequest) ProtoMessage() {}
+
+func (x *ApproveRebalancePlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[262]
 	if x != nil {
-		return x.NoSlippageOccurred
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *GetOrderbookMovementResponse) GetAverageOrderCost() float64 {
+// Deprecated: Use ApproveRebalancePlanRequest.ProtoReflect.Descriptor instead.
+func (*ApproveRebalancePlanRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{262}
+}
+
+func (x *ApproveRebalancePlanRequest) GetId() string {
 	if x != nil {
-		return x.AverageOrderCost
+		return x.Id
 	}
-	return 0
+	return ""
 }
 
-type GetOrderbookAmountByNominalRequest struct {
-	state                 protoimpl.MessageState `protogen:"open.v1"`
-	Exchange              string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset                 string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair                  *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	NominalPercentage     float64                `protobuf:"fixed64,6,opt,name=nominal_percentage,json=nominalPercentage,proto3" json:"nominal_percentage,omitempty"`
-	Sell                  bool                   `protobuf:"varint,7,opt,name=sell,proto3" json:"sell,omitempty"`
-	RequiresRestOrderbook bool                   `protobuf:"varint,8,opt,name=requires_rest_orderbook,json=requiresRestOrderbook,proto3" json:"requires_rest_orderbook,omitempty"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+type RebalanceAllocation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Currency      string                 `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	Exchange      string                 `protobuf:"bytes,2,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Amount        float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Value         float64                `protobuf:"fixed64,4,opt,name=value,proto3" json:"value,omitempty"`
+	Weight        float64                `protobuf:"fixed64,5,opt,name=weight,proto3" json:"weight,omitempty"`
+	TargetWeight  float64                `protobuf:"fixed64,6,opt,name=target_weight,json=targetWeight,proto3" json:"target_weight,omitempty"`
+	Drift         float64                `protobuf:"fixed64,7,opt,name=drift,proto3" json:"drift,omitempty"`
+	TradeValue    float64                `protobuf:"fixed64,8,opt,name=trade_value,json=tradeValue,proto3" json:"trade_value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOrderbookAmountByNominalRequest) Reset() {
-	*x = GetOrderbookAmountByNominalRequest{}
-	mi := &file_rpc_proto_msgTypes[216]
+func (x *RebalanceAllocation) Reset() {
+	*x = RebalanceAllocation{}
+	mi := &file_rpc_proto_msgTypes[263]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOrderbookAmountByNominalRequest) String() string {
+func (x *RebalanceAllocation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOrderbookAmountByNominalRequest) ProtoMessage() {}
+func (*RebalanceAllocation) ProtoMessage() {}
 
-func (x *GetOrderbookAmountByNominalRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[216]
+func (x *RebalanceAllocation) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[263]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14396,85 +18066,96 @@ func (x *GetOrderbookAmountByNominalRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOrderbookAmountByNominalRequest.ProtoReflect.Descriptor instead.
-func (*GetOrderbookAmountByNominalRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{216}
+// Deprecated: Use RebalanceAllocation.ProtoReflect.Descriptor instead.
+func (*RebalanceAllocation) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{263}
 }
 
-func (x *GetOrderbookAmountByNominalRequest) GetExchange() string {
+func (x *RebalanceAllocation) GetCurrency() string {
 	if x != nil {
-		return x.Exchange
+		return x.Currency
 	}
 	return ""
 }
 
-func (x *GetOrderbookAmountByNominalRequest) GetAsset() string {
+func (x *RebalanceAllocation) GetExchange() string {
 	if x != nil {
-		return x.Asset
+		return x.Exchange
 	}
 	return ""
 }
 
-func (x *GetOrderbookAmountByNominalRequest) GetPair() *CurrencyPair {
+func (x *RebalanceAllocation) GetAmount() float64 {
 	if x != nil {
-		return x.Pair
+		return x.Amount
 	}
-	return nil
+	return 0
 }
 
-func (x *GetOrderbookAmountByNominalRequest) GetNominalPercentage() float64 {
+func (x *RebalanceAllocation) GetValue() float64 {
 	if x != nil {
-		return x.NominalPercentage
+		return x.Value
 	}
 	return 0
 }
 
-func (x *GetOrderbookAmountByNominalRequest) GetSell() bool {
+func (x *RebalanceAllocation) GetWeight() float64 {
 	if x != nil {
-		return x.Sell
+		return x.Weight
 	}
-	return false
+	return 0
 }
 
-func (x *GetOrderbookAmountByNominalRequest) GetRequiresRestOrderbook() bool {
+func (x *RebalanceAllocation) GetTargetWeight() float64 {
 	if x != nil {
-		return x.RequiresRestOrderbook
+		return x.TargetWeight
 	}
-	return false
+	return 0
 }
 
-type GetOrderbookAmountByNominalResponse struct {
-	state                                protoimpl.MessageState `protogen:"open.v1"`
-	AmountRequired                       float64                `protobuf:"fixed64,1,opt,name=amount_required,json=amountRequired,proto3" json:"amount_required,omitempty"`
-	CurrencySelling                      string                 `protobuf:"bytes,2,opt,name=currency_selling,json=currencySelling,proto3" json:"currency_selling,omitempty"`
-	AmountReceived                       float64                `protobuf:"fixed64,3,opt,name=amount_received,json=amountReceived,proto3" json:"amount_received,omitempty"`
-	CurrencyBuying                       string                 `protobuf:"bytes,4,opt,name=currency_buying,json=currencyBuying,proto3" json:"currency_buying,omitempty"`
-	StartPrice                           float64                `protobuf:"fixed64,5,opt,name=start_price,json=startPrice,proto3" json:"start_price,omitempty"`
-	EndPrice                             float64                `protobuf:"fixed64,6,opt,name=end_price,json=endPrice,proto3" json:"end_price,omitempty"`
-	AverageOrderCost                     float64                `protobuf:"fixed64,7,opt,name=average_order_cost,json=averageOrderCost,proto3" json:"average_order_cost,omitempty"`
-	SideAffected                         string                 `protobuf:"bytes,8,opt,name=side_affected,json=sideAffected,proto3" json:"side_affected,omitempty"`
-	ApproximateNominalSlippagePercentage float64                `protobuf:"fixed64,9,opt,name=approximate_nominal_slippage_percentage,json=approximateNominalSlippagePercentage,proto3" json:"approximate_nominal_slippage_percentage,omitempty"`
-	UpdateProtocol                       string                 `protobuf:"bytes,10,opt,name=update_protocol,json=updateProtocol,proto3" json:"update_protocol,omitempty"`
-	FullOrderbookSideConsumed            bool                   `protobuf:"varint,11,opt,name=full_orderbook_side_consumed,json=fullOrderbookSideConsumed,proto3" json:"full_orderbook_side_consumed,omitempty"`
-	unknownFields                        protoimpl.UnknownFields
-	sizeCache                            protoimpl.SizeCache
+func (x *RebalanceAllocation) GetDrift() float64 {
+	if x != nil {
+		return x.Drift
+	}
+	return 0
 }
 
-func (x *GetOrderbookAmountByNominalResponse) Reset() {
-	*x = GetOrderbookAmountByNominalResponse{}
-	mi := &file_rpc_proto_msgTypes[217]
+func (x *RebalanceAllocation) GetTradeValue() float64 {
+	if x != nil {
+		return x.TradeValue
+	}
+	return 0
+}
+
+type RebalanceTrade struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair          *CurrencyPair          `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	Side          string                 `protobuf:"bytes,3,opt,name=side,proto3" json:"side,omitempty"`
+	Amount        float64                `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Price         float64                `protobuf:"fixed64,5,opt,name=price,proto3" json:"price,omitempty"`
+	Value         float64                `protobuf:"fixed64,6,opt,name=value,proto3" json:"value,omitempty"`
+	OrderId       string                 `protobuf:"bytes,7,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Error         string                 `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RebalanceTrade) Reset() {
+	*x = RebalanceTrade{}
+	mi := &file_rpc_proto_msgTypes[264]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOrderbookAmountByNominalResponse) String() string {
+func (x *RebalanceTrade) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOrderbookAmountByNominalResponse) ProtoMessage() {}
+func (*RebalanceTrade) ProtoMessage() {}
 
-func (x *GetOrderbookAmountByNominalResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[217]
+func (x *RebalanceTrade) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[264]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14485,115 +18166,93 @@ func (x *GetOrderbookAmountByNominalResponse) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOrderbookAmountByNominalResponse.ProtoReflect.Descriptor instead.
-func (*GetOrderbookAmountByNominalResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{217}
-}
-
-func (x *GetOrderbookAmountByNominalResponse) GetAmountRequired() float64 {
-	if x != nil {
-		return x.AmountRequired
-	}
-	return 0
-}
-
-func (x *GetOrderbookAmountByNominalResponse) GetCurrencySelling() string {
-	if x != nil {
-		return x.CurrencySelling
-	}
-	return ""
-}
-
-func (x *GetOrderbookAmountByNominalResponse) GetAmountReceived() float64 {
-	if x != nil {
-		return x.AmountReceived
-	}
-	return 0
+// Deprecated: Use RebalanceTrade.ProtoReflect.Descriptor instead.
+func (*RebalanceTrade) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{264}
 }
 
-func (x *GetOrderbookAmountByNominalResponse) GetCurrencyBuying() string {
+func (x *RebalanceTrade) GetExchange() string {
 	if x != nil {
-		return x.CurrencyBuying
+		return x.Exchange
 	}
 	return ""
 }
 
-func (x *GetOrderbookAmountByNominalResponse) GetStartPrice() float64 {
+func (x *RebalanceTrade) GetPair() *CurrencyPair {
 	if x != nil {
-		return x.StartPrice
+		return x.Pair
 	}
-	return 0
+	return nil
 }
 
-func (x *GetOrderbookAmountByNominalResponse) GetEndPrice() float64 {
+func (x *RebalanceTrade) GetSide() string {
 	if x != nil {
-		return x.EndPrice
+		return x.Side
 	}
-	return 0
+	return ""
 }
 
-func (x *GetOrderbookAmountByNominalResponse) GetAverageOrderCost() float64 {
+func (x *RebalanceTrade) GetAmount() float64 {
 	if x != nil {
-		return x.AverageOrderCost
+		return x.Amount
 	}
 	return 0
 }
 
-func (x *GetOrderbookAmountByNominalResponse) GetSideAffected() string {
+func (x *RebalanceTrade) GetPrice() float64 {
 	if x != nil {
-		return x.SideAffected
+		return x.Price
 	}
-	return ""
+	return 0
 }
 
-func (x *GetOrderbookAmountByNominalResponse) GetApproximateNominalSlippagePercentage() float64 {
+func (x *RebalanceTrade) GetValue() float64 {
 	if x != nil {
-		return x.ApproximateNominalSlippagePercentage
+		return x.Value
 	}
 	return 0
 }
 
-func (x *GetOrderbookAmountByNominalResponse) GetUpdateProtocol() string {
+func (x *RebalanceTrade) GetOrderId() string {
 	if x != nil {
-		return x.UpdateProtocol
+		return x.OrderId
 	}
 	return ""
 }
 
-func (x *GetOrderbookAmountByNominalResponse) GetFullOrderbookSideConsumed() bool {
+func (x *RebalanceTrade) GetError() string {
 	if x != nil {
-		return x.FullOrderbookSideConsumed
+		return x.Error
 	}
-	return false
+	return ""
 }
 
-type GetOrderbookAmountByImpactRequest struct {
-	state                 protoimpl.MessageState `protogen:"open.v1"`
-	Exchange              string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset                 string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair                  *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	ImpactPercentage      float64                `protobuf:"fixed64,6,opt,name=impact_percentage,json=impactPercentage,proto3" json:"impact_percentage,omitempty"`
-	Sell                  bool                   `protobuf:"varint,7,opt,name=sell,proto3" json:"sell,omitempty"`
-	RequiresRestOrderbook bool                   `protobuf:"varint,8,opt,name=requires_rest_orderbook,json=requiresRestOrderbook,proto3" json:"requires_rest_orderbook,omitempty"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+type RebalanceSkipped struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Sell          string                 `protobuf:"bytes,2,opt,name=sell,proto3" json:"sell,omitempty"`
+	Buy           string                 `protobuf:"bytes,3,opt,name=buy,proto3" json:"buy,omitempty"`
+	Value         float64                `protobuf:"fixed64,4,opt,name=value,proto3" json:"value,omitempty"`
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOrderbookAmountByImpactRequest) Reset() {
-	*x = GetOrderbookAmountByImpactRequest{}
-	mi := &file_rpc_proto_msgTypes[218]
+func (x *RebalanceSkipped) Reset() {
+	*x = RebalanceSkipped{}
+	mi := &file_rpc_proto_msgTypes[265]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOrderbookAmountByImpactRequest) String() string {
+func (x *RebalanceSkipped) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOrderbookAmountByImpactRequest) ProtoMessage() {}
+func (*RebalanceSkipped) ProtoMessage() {}
 
-func (x *GetOrderbookAmountByImpactRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[218]
+func (x *RebalanceSkipped) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[265]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14604,85 +18263,77 @@ func (x *GetOrderbookAmountByImpactRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOrderbookAmountByImpactRequest.ProtoReflect.Descriptor instead.
-func (*GetOrderbookAmountByImpactRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{218}
+// Deprecated: Use RebalanceSkipped.ProtoReflect.Descriptor instead.
+func (*RebalanceSkipped) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{265}
 }
 
-func (x *GetOrderbookAmountByImpactRequest) GetExchange() string {
+func (x *RebalanceSkipped) GetExchange() string {
 	if x != nil {
 		return x.Exchange
 	}
 	return ""
 }
 
-func (x *GetOrderbookAmountByImpactRequest) GetAsset() string {
+func (x *RebalanceSkipped) GetSell() string {
 	if x != nil {
-		return x.Asset
+		return x.Sell
 	}
 	return ""
 }
 
-func (x *GetOrderbookAmountByImpactRequest) GetPair() *CurrencyPair {
+func (x *RebalanceSkipped) GetBuy() string {
 	if x != nil {
-		return x.Pair
+		return x.Buy
 	}
-	return nil
+	return ""
 }
 
-func (x *GetOrderbookAmountByImpactRequest) GetImpactPercentage() float64 {
+func (x *RebalanceSkipped) GetValue() float64 {
 	if x != nil {
-		return x.ImpactPercentage
+		return x.Value
 	}
 	return 0
 }
 
-func (x *GetOrderbookAmountByImpactRequest) GetSell() bool {
-	if x != nil {
-		return x.Sell
-	}
-	return false
-}
-
-func (x *GetOrderbookAmountByImpactRequest) GetRequiresRestOrderbook() bool {
+func (x *RebalanceSkipped) GetReason() string {
 	if x != nil {
-		return x.RequiresRestOrderbook
+		return x.Reason
 	}
-	return false
+	return ""
 }
 
-type GetOrderbookAmountByImpactResponse struct {
-	state                               protoimpl.MessageState `protogen:"open.v1"`
-	AmountRequired                      float64                `protobuf:"fixed64,1,opt,name=amount_required,json=amountRequired,proto3" json:"amount_required,omitempty"`
-	CurrencySelling                     string                 `protobuf:"bytes,2,opt,name=currency_selling,json=currencySelling,proto3" json:"currency_selling,omitempty"`
-	AmountReceived                      float64                `protobuf:"fixed64,3,opt,name=amount_received,json=amountReceived,proto3" json:"amount_received,omitempty"`
-	CurrencyBuying                      string                 `protobuf:"bytes,4,opt,name=currency_buying,json=currencyBuying,proto3" json:"currency_buying,omitempty"`
-	StartPrice                          float64                `protobuf:"fixed64,5,opt,name=start_price,json=startPrice,proto3" json:"start_price,omitempty"`
-	EndPrice                            float64                `protobuf:"fixed64,6,opt,name=end_price,json=endPrice,proto3" json:"end_price,omitempty"`
-	AverageOrderCost                    float64                `protobuf:"fixed64,7,opt,name=average_order_cost,json=averageOrderCost,proto3" json:"average_order_cost,omitempty"`
-	SideAffected                        string                 `protobuf:"bytes,8,opt,name=side_affected,json=sideAffected,proto3" json:"side_affected,omitempty"`
-	ApproximateImpactSlippagePercentage float64                `protobuf:"fixed64,9,opt,name=approximate_impact_slippage_percentage,json=approximateImpactSlippagePercentage,proto3" json:"approximate_impact_slippage_percentage,omitempty"`
-	UpdateProtocol                      string                 `protobuf:"bytes,10,opt,name=update_protocol,json=updateProtocol,proto3" json:"update_protocol,omitempty"`
-	FullOrderbookSideConsumed           bool                   `protobuf:"varint,11,opt,name=full_orderbook_side_consumed,json=fullOrderbookSideConsumed,proto3" json:"full_orderbook_side_consumed,omitempty"`
-	unknownFields                       protoimpl.UnknownFields
-	sizeCache                           protoimpl.SizeCache
+type RebalancePlanResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status            string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	ReportingCurrency string                 `protobuf:"bytes,3,opt,name=reporting_currency,json=reportingCurrency,proto3" json:"reporting_currency,omitempty"`
+	TotalValue        float64                `protobuf:"fixed64,4,opt,name=total_value,json=totalValue,proto3" json:"total_value,omitempty"`
+	Allocations       []*RebalanceAllocation `protobuf:"bytes,5,rep,name=allocations,proto3" json:"allocations,omitempty"`
+	Trades            []*RebalanceTrade      `protobuf:"bytes,6,rep,name=trades,proto3" json:"trades,omitempty"`
+	Skipped           []*RebalanceSkipped    `protobuf:"bytes,7,rep,name=skipped,proto3" json:"skipped,omitempty"`
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ExpiresAt         *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	ExecutedAt        *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=executed_at,json=executedAt,proto3" json:"executed_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *GetOrderbookAmountByImpactResponse) Reset() {
-	*x = GetOrderbookAmountByImpactResponse{}
-	mi := &file_rpc_proto_msgTypes[219]
+func (x *RebalancePlanResponse) Reset() {
+	*x = RebalancePlanResponse{}
+	mi := &file_rpc_proto_msgTypes[266]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOrderbookAmountByImpactResponse) String() string {
+func (x *RebalancePlanResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOrderbookAmountByImpactResponse) ProtoMessage() {}
+func (*RebalancePlanResponse) ProtoMessage() {}
 
-func (x *GetOrderbookAmountByImpactResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[219]
+func (x *RebalancePlanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[266]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14693,111 +18344,189 @@ func (x *GetOrderbookAmountByImpactResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOrderbookAmountByImpactResponse.ProtoReflect.Descriptor instead.
-func (*GetOrderbookAmountByImpactResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{219}
+// Deprecated: Use RebalancePlanResponse.ProtoReflect.Descriptor instead.
+func (*RebalancePlanResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{266}
 }
 
-func (x *GetOrderbookAmountByImpactResponse) GetAmountRequired() float64 {
+func (x *RebalancePlanResponse) GetId() string {
 	if x != nil {
-		return x.AmountRequired
+		return x.Id
 	}
-	return 0
+	return ""
 }
 
-func (x *GetOrderbookAmountByImpactResponse) GetCurrencySelling() string {
+func (x *RebalancePlanResponse) GetStatus() string {
 	if x != nil {
-		return x.CurrencySelling
+		return x.Status
 	}
 	return ""
 }
 
-func (x *GetOrderbookAmountByImpactResponse) GetAmountReceived() float64 {
+func (x *RebalancePlanResponse) GetReportingCurrency() string {
 	if x != nil {
-		return x.AmountReceived
+		return x.ReportingCurrency
+	}
+	return ""
+}
+
+func (x *RebalancePlanResponse) GetTotalValue() float64 {
+	if x != nil {
+		return x.TotalValue
 	}
 	return 0
 }
 
-func (x *GetOrderbookAmountByImpactResponse) GetCurrencyBuying() string {
+func (x *RebalancePlanResponse) GetAllocations() []*RebalanceAllocation {
 	if x != nil {
-		return x.CurrencyBuying
+		return x.Allocations
 	}
-	return ""
+	return nil
 }
 
-func (x *GetOrderbookAmountByImpactResponse) GetStartPrice() float64 {
+func (x *RebalancePlanResponse) GetTrades() []*RebalanceTrade {
 	if x != nil {
-		return x.StartPrice
+		return x.Trades
 	}
-	return 0
+	return nil
 }
 
-func (x *GetOrderbookAmountByImpactResponse) GetEndPrice() float64 {
+func (x *RebalancePlanResponse) GetSkipped() []*RebalanceSkipped {
 	if x != nil {
-		return x.EndPrice
+		return x.Skipped
 	}
-	return 0
+	return nil
 }
 
-func (x *GetOrderbookAmountByImpactResponse) GetAverageOrderCost() float64 {
+func (x *RebalancePlanResponse) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.AverageOrderCost
+		return x.CreatedAt
 	}
-	return 0
+	return nil
 }
 
-func (x *GetOrderbookAmountByImpactResponse) GetSideAffected() string {
+func (x *RebalancePlanResponse) GetExpiresAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.SideAffected
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *RebalancePlanResponse) GetExecutedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExecutedAt
+	}
+	return nil
+}
+
+type GetOrderbookAnalyticsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair          *CurrencyPair          `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType     string                 `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Bands         []float64              `protobuf:"fixed64,4,rep,packed,name=bands,proto3" json:"bands,omitempty"`
+	Levels        int64                  `protobuf:"varint,5,opt,name=levels,proto3" json:"levels,omitempty"`
+	Window        int64                  `protobuf:"varint,6,opt,name=window,proto3" json:"window,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrderbookAnalyticsRequest) Reset() {
+	*x = GetOrderbookAnalyticsRequest{}
+	mi := &file_rpc_proto_msgTypes[267]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrderbookAnalyticsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderbookAnalyticsRequest) ProtoMessage() {}
+
+func (x *GetOrderbookAnalyticsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[267]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrderbookAnalyticsRequest.ProtoReflect.Descriptor instead.
+func (*GetOrderbookAnalyticsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{267}
+}
+
+func (x *GetOrderbookAnalyticsRequest) GetExchange() string {
+	if x != nil {
+		return x.Exchange
 	}
 	return ""
 }
 
-func (x *GetOrderbookAmountByImpactResponse) GetApproximateImpactSlippagePercentage() float64 {
+func (x *GetOrderbookAnalyticsRequest) GetPair() *CurrencyPair {
 	if x != nil {
-		return x.ApproximateImpactSlippagePercentage
+		return x.Pair
 	}
-	return 0
+	return nil
 }
 
-func (x *GetOrderbookAmountByImpactResponse) GetUpdateProtocol() string {
+func (x *GetOrderbookAnalyticsRequest) GetAssetType() string {
 	if x != nil {
-		return x.UpdateProtocol
+		return x.AssetType
 	}
 	return ""
 }
 
-func (x *GetOrderbookAmountByImpactResponse) GetFullOrderbookSideConsumed() bool {
+func (x *GetOrderbookAnalyticsRequest) GetBands() []float64 {
 	if x != nil {
-		return x.FullOrderbookSideConsumed
+		return x.Bands
 	}
-	return false
+	return nil
 }
 
-type GetOpenInterestRequest struct {
-	state         protoimpl.MessageState     `protogen:"open.v1"`
-	Exchange      string                     `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Data          []*OpenInterestDataRequest `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
+func (x *GetOrderbookAnalyticsRequest) GetLevels() int64 {
+	if x != nil {
+		return x.Levels
+	}
+	return 0
+}
+
+func (x *GetOrderbookAnalyticsRequest) GetWindow() int64 {
+	if x != nil {
+		return x.Window
+	}
+	return 0
+}
+
+type UntrackOrderbookAnalyticsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair          *CurrencyPair          `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType     string                 `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOpenInterestRequest) Reset() {
-	*x = GetOpenInterestRequest{}
-	mi := &file_rpc_proto_msgTypes[220]
+func (x *UntrackOrderbookAnalyticsRequest) Reset() {
+	*x = UntrackOrderbookAnalyticsRequest{}
+	mi := &file_rpc_proto_msgTypes[268]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOpenInterestRequest) String() string {
+func (x *UntrackOrderbookAnalyticsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOpenInterestRequest) ProtoMessage() {}
+func (*UntrackOrderbookAnalyticsRequest) ProtoMessage() {}
 
-func (x *GetOpenInterestRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[220]
+func (x *UntrackOrderbookAnalyticsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[268]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14808,48 +18537,57 @@ func (x *GetOpenInterestRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOpenInterestRequest.ProtoReflect.Descriptor instead.
-func (*GetOpenInterestRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{220}
+// Deprecated: Use UntrackOrderbookAnalyticsRequest.ProtoReflect.Descriptor instead.
+func (*UntrackOrderbookAnalyticsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{268}
 }
 
-func (x *GetOpenInterestRequest) GetExchange() string {
+func (x *UntrackOrderbookAnalyticsRequest) GetExchange() string {
 	if x != nil {
 		return x.Exchange
 	}
 	return ""
 }
 
-func (x *GetOpenInterestRequest) GetData() []*OpenInterestDataRequest {
+func (x *UntrackOrderbookAnalyticsRequest) GetPair() *CurrencyPair {
 	if x != nil {
-		return x.Data
+		return x.Pair
 	}
 	return nil
 }
 
-type OpenInterestDataRequest struct {
+func (x *UntrackOrderbookAnalyticsRequest) GetAssetType() string {
+	if x != nil {
+		return x.AssetType
+	}
+	return ""
+}
+
+type OrderbookAnalyticsSpreadStats struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Asset         string                 `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair          *CurrencyPair          `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	Mean          float64                `protobuf:"fixed64,1,opt,name=mean,proto3" json:"mean,omitempty"`
+	StdDev        float64                `protobuf:"fixed64,2,opt,name=std_dev,json=stdDev,proto3" json:"std_dev,omitempty"`
+	Min           float64                `protobuf:"fixed64,3,opt,name=min,proto3" json:"min,omitempty"`
+	Max           float64                `protobuf:"fixed64,4,opt,name=max,proto3" json:"max,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OpenInterestDataRequest) Reset() {
-	*x = OpenInterestDataRequest{}
-	mi := &file_rpc_proto_msgTypes[221]
+func (x *OrderbookAnalyticsSpreadStats) Reset() {
+	*x = OrderbookAnalyticsSpreadStats{}
+	mi := &file_rpc_proto_msgTypes[269]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OpenInterestDataRequest) String() string {
+func (x *OrderbookAnalyticsSpreadStats) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OpenInterestDataRequest) ProtoMessage() {}
+func (*OrderbookAnalyticsSpreadStats) ProtoMessage() {}
 
-func (x *OpenInterestDataRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[221]
+func (x *OrderbookAnalyticsSpreadStats) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[269]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14860,47 +18598,66 @@ func (x *OpenInterestDataRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OpenInterestDataRequest.ProtoReflect.Descriptor instead.
-func (*OpenInterestDataRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{221}
+// Deprecated: Use OrderbookAnalyticsSpreadStats.ProtoReflect.Descriptor instead.
+func (*OrderbookAnalyticsSpreadStats) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{269}
 }
 
-func (x *OpenInterestDataRequest) GetAsset() string {
+func (x *OrderbookAnalyticsSpreadStats) GetMean() float64 {
 	if x != nil {
-		return x.Asset
+		return x.Mean
 	}
-	return ""
+	return 0
 }
 
-func (x *OpenInterestDataRequest) GetPair() *CurrencyPair {
+func (x *OrderbookAnalyticsSpreadStats) GetStdDev() float64 {
 	if x != nil {
-		return x.Pair
+		return x.StdDev
 	}
-	return nil
+	return 0
+}
+
+func (x *OrderbookAnalyticsSpreadStats) GetMin() float64 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+func (x *OrderbookAnalyticsSpreadStats) GetMax() float64 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
 }
 
-type GetOpenInterestResponse struct {
-	state         protoimpl.MessageState      `protogen:"open.v1"`
-	Data          []*OpenInterestDataResponse `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+type OrderbookAnalyticsBand struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bps           float64                `protobuf:"fixed64,1,opt,name=bps,proto3" json:"bps,omitempty"`
+	BidAmount     float64                `protobuf:"fixed64,2,opt,name=bid_amount,json=bidAmount,proto3" json:"bid_amount,omitempty"`
+	AskAmount     float64                `protobuf:"fixed64,3,opt,name=ask_amount,json=askAmount,proto3" json:"ask_amount,omitempty"`
+	BidValue      float64                `protobuf:"fixed64,4,opt,name=bid_value,json=bidValue,proto3" json:"bid_value,omitempty"`
+	AskValue      float64                `protobuf:"fixed64,5,opt,name=ask_value,json=askValue,proto3" json:"ask_value,omitempty"`
+	Imbalance     float64                `protobuf:"fixed64,6,opt,name=imbalance,proto3" json:"imbalance,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOpenInterestResponse) Reset() {
-	*x = GetOpenInterestResponse{}
-	mi := &file_rpc_proto_msgTypes[222]
+func (x *OrderbookAnalyticsBand) Reset() {
+	*x = OrderbookAnalyticsBand{}
+	mi := &file_rpc_proto_msgTypes[270]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOpenInterestResponse) String() string {
+func (x *OrderbookAnalyticsBand) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOpenInterestResponse) ProtoMessage() {}
+func (*OrderbookAnalyticsBand) ProtoMessage() {}
 
-func (x *GetOpenInterestResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[222]
+func (x *OrderbookAnalyticsBand) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[270]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14911,43 +18668,78 @@ func (x *GetOpenInterestResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOpenInterestResponse.ProtoReflect.Descriptor instead.
-func (*GetOpenInterestResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{222}
+// Deprecated: Use OrderbookAnalyticsBand.ProtoReflect.Descriptor instead.
+func (*OrderbookAnalyticsBand) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{270}
 }
 
-func (x *GetOpenInterestResponse) GetData() []*OpenInterestDataResponse {
+func (x *OrderbookAnalyticsBand) GetBps() float64 {
 	if x != nil {
-		return x.Data
+		return x.Bps
 	}
-	return nil
+	return 0
 }
 
-type OpenInterestDataResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	OpenInterest  float64                `protobuf:"fixed64,4,opt,name=open_interest,json=openInterest,proto3" json:"open_interest,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *OrderbookAnalyticsBand) GetBidAmount() float64 {
+	if x != nil {
+		return x.BidAmount
+	}
+	return 0
 }
 
-func (x *OpenInterestDataResponse) Reset() {
-	*x = OpenInterestDataResponse{}
-	mi := &file_rpc_proto_msgTypes[223]
+func (x *OrderbookAnalyticsBand) GetAskAmount() float64 {
+	if x != nil {
+		return x.AskAmount
+	}
+	return 0
+}
+
+func (x *OrderbookAnalyticsBand) GetBidValue() float64 {
+	if x != nil {
+		return x.BidValue
+	}
+	return 0
+}
+
+func (x *OrderbookAnalyticsBand) GetAskValue() float64 {
+	if x != nil {
+		return x.AskValue
+	}
+	return 0
+}
+
+func (x *OrderbookAnalyticsBand) GetImbalance() float64 {
+	if x != nil {
+		return x.Imbalance
+	}
+	return 0
+}
+
+type OrderbookAnalyticsFlow struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	ReplenishedPerSecond    float64                `protobuf:"fixed64,1,opt,name=replenished_per_second,json=replenishedPerSecond,proto3" json:"replenished_per_second,omitempty"`
+	CancelledPerSecond      float64                `protobuf:"fixed64,2,opt,name=cancelled_per_second,json=cancelledPerSecond,proto3" json:"cancelled_per_second,omitempty"`
+	ReplenishmentsPerSecond float64                `protobuf:"fixed64,3,opt,name=replenishments_per_second,json=replenishmentsPerSecond,proto3" json:"replenishments_per_second,omitempty"`
+	CancellationsPerSecond  float64                `protobuf:"fixed64,4,opt,name=cancellations_per_second,json=cancellationsPerSecond,proto3" json:"cancellations_per_second,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *OrderbookAnalyticsFlow) Reset() {
+	*x = OrderbookAnalyticsFlow{}
+	mi := &file_rpc_proto_msgTypes[271]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OpenInterestDataResponse) String() string {
+func (x *OrderbookAnalyticsFlow) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OpenInterestDataResponse) ProtoMessage() {}
+func (*OrderbookAnalyticsFlow) ProtoMessage() {}
 
-func (x *OpenInterestDataResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[223]
+func (x *OrderbookAnalyticsFlow) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[271]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14958,63 +18750,78 @@ func (x *OpenInterestDataResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OpenInterestDataResponse.ProtoReflect.Descriptor instead.
-func (*OpenInterestDataResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{223}
+// Deprecated: Use OrderbookAnalyticsFlow.ProtoReflect.Descriptor instead.
+func (*OrderbookAnalyticsFlow) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{271}
 }
 
-func (x *OpenInterestDataResponse) GetExchange() string {
+func (x *OrderbookAnalyticsFlow) GetReplenishedPerSecond() float64 {
 	if x != nil {
-		return x.Exchange
+		return x.ReplenishedPerSecond
 	}
-	return ""
+	return 0
 }
 
-func (x *OpenInterestDataResponse) GetAsset() string {
+func (x *OrderbookAnalyticsFlow) GetCancelledPerSecond() float64 {
 	if x != nil {
-		return x.Asset
+		return x.CancelledPerSecond
 	}
-	return ""
+	return 0
 }
 
-func (x *OpenInterestDataResponse) GetPair() *CurrencyPair {
+func (x *OrderbookAnalyticsFlow) GetReplenishmentsPerSecond() float64 {
 	if x != nil {
-		return x.Pair
+		return x.ReplenishmentsPerSecond
 	}
-	return nil
+	return 0
 }
 
-func (x *OpenInterestDataResponse) GetOpenInterest() float64 {
+func (x *OrderbookAnalyticsFlow) GetCancellationsPerSecond() float64 {
 	if x != nil {
-		return x.OpenInterest
+		return x.CancellationsPerSecond
 	}
 	return 0
 }
 
-type GetCurrencyTradeURLRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type OrderbookAnalyticsResponse struct {
+	state            protoimpl.MessageState         `protogen:"open.v1"`
+	Exchange         string                         `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair             *CurrencyPair                  `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType        string                         `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	UpdateId         int64                          `protobuf:"varint,4,opt,name=update_id,json=updateId,proto3" json:"update_id,omitempty"`
+	LastUpdated      *timestamppb.Timestamp         `protobuf:"bytes,5,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
+	BestBid          float64                        `protobuf:"fixed64,6,opt,name=best_bid,json=bestBid,proto3" json:"best_bid,omitempty"`
+	BestAsk          float64                        `protobuf:"fixed64,7,opt,name=best_ask,json=bestAsk,proto3" json:"best_ask,omitempty"`
+	MidPrice         float64                        `protobuf:"fixed64,8,opt,name=mid_price,json=midPrice,proto3" json:"mid_price,omitempty"`
+	WeightedMidPrice float64                        `protobuf:"fixed64,9,opt,name=weighted_mid_price,json=weightedMidPrice,proto3" json:"weighted_mid_price,omitempty"`
+	Microprice       float64                        `protobuf:"fixed64,10,opt,name=microprice,proto3" json:"microprice,omitempty"`
+	Spread           float64                        `protobuf:"fixed64,11,opt,name=spread,proto3" json:"spread,omitempty"`
+	SpreadBps        float64                        `protobuf:"fixed64,12,opt,name=spread_bps,json=spreadBps,proto3" json:"spread_bps,omitempty"`
+	Imbalance        float64                        `protobuf:"fixed64,13,opt,name=imbalance,proto3" json:"imbalance,omitempty"`
+	SpreadStats      *OrderbookAnalyticsSpreadStats `protobuf:"bytes,14,opt,name=spread_stats,json=spreadStats,proto3" json:"spread_stats,omitempty"`
+	Bands            []*OrderbookAnalyticsBand      `protobuf:"bytes,15,rep,name=bands,proto3" json:"bands,omitempty"`
+	Bids             *OrderbookAnalyticsFlow        `protobuf:"bytes,16,opt,name=bids,proto3" json:"bids,omitempty"`
+	Asks             *OrderbookAnalyticsFlow        `protobuf:"bytes,17,opt,name=asks,proto3" json:"asks,omitempty"`
+	Window           int64                          `protobuf:"varint,18,opt,name=window,proto3" json:"window,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *GetCurrencyTradeURLRequest) Reset() {
-	*x = GetCurrencyTradeURLRequest{}
-	mi := &file_rpc_proto_msgTypes[224]
+func (x *OrderbookAnalyticsResponse) Reset() {
+	*x = OrderbookAnalyticsResponse{}
+	mi := &file_rpc_proto_msgTypes[272]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCurrencyTradeURLRequest) String() string {
+func (x *OrderbookAnalyticsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCurrencyTradeURLRequest) ProtoMessage() {}
+func (*OrderbookAnalyticsResponse) ProtoMessage() {}
 
-func (x *GetCurrencyTradeURLRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[224]
+func (x *OrderbookAnalyticsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[272]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -15025,74 +18832,135 @@ func (x *GetCurrencyTradeURLRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCurrencyTradeURLRequest.ProtoReflect.Descriptor instead.
-func (*GetCurrencyTradeURLRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{224}
+// Deprecated: Use OrderbookAnalyticsResponse.ProtoReflect.Descriptor instead.
+func (*OrderbookAnalyticsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{272}
 }
 
-func (x *GetCurrencyTradeURLRequest) GetExchange() string {
+func (x *OrderbookAnalyticsResponse) GetExchange() string {
 	if x != nil {
 		return x.Exchange
 	}
 	return ""
 }
 
-func (x *GetCurrencyTradeURLRequest) GetAsset() string {
+func (x *OrderbookAnalyticsResponse) GetPair() *CurrencyPair {
 	if x != nil {
-		return x.Asset
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *OrderbookAnalyticsResponse) GetAssetType() string {
+	if x != nil {
+		return x.AssetType
 	}
 	return ""
 }
 
-func (x *GetCurrencyTradeURLRequest) GetPair() *CurrencyPair {
+func (x *OrderbookAnalyticsResponse) GetUpdateId() int64 {
 	if x != nil {
-		return x.Pair
+		return x.UpdateId
+	}
+	return 0
+}
+
+func (x *OrderbookAnalyticsResponse) GetLastUpdated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUpdated
 	}
 	return nil
 }
 
-type GetCurrencyTradeURLResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *OrderbookAnalyticsResponse) GetBestBid() float64 {
+	if x != nil {
+		return x.BestBid
+	}
+	return 0
 }
 
-func (x *GetCurrencyTradeURLResponse) Reset() {
-	*x = GetCurrencyTradeURLResponse{}
-	mi := &file_rpc_proto_msgTypes[225]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *OrderbookAnalyticsResponse) GetBestAsk() float64 {
+	if x != nil {
+		return x.BestAsk
+	}
+	return 0
 }
 
-func (x *GetCurrencyTradeURLResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *OrderbookAnalyticsResponse) GetMidPrice() float64 {
+	if x != nil {
+		return x.MidPrice
+	}
+	return 0
 }
 
-func (*GetCurrencyTradeURLResponse) ProtoMessage() {}
+func (x *OrderbookAnalyticsResponse) GetWeightedMidPrice() float64 {
+	if x != nil {
+		return x.WeightedMidPrice
+	}
+	return 0
+}
 
-func (x *GetCurrencyTradeURLResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[225]
+func (x *OrderbookAnalyticsResponse) GetMicroprice() float64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Microprice
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use GetCurrencyTradeURLResponse.ProtoReflect.Descriptor instead.
-func (*GetCurrencyTradeURLResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{225}
+func (x *OrderbookAnalyticsResponse) GetSpread() float64 {
+	if x != nil {
+		return x.Spread
+	}
+	return 0
 }
 
-func (x *GetCurrencyTradeURLResponse) GetUrl() string {
+func (x *OrderbookAnalyticsResponse) GetSpreadBps() float64 {
 	if x != nil {
-		return x.Url
+		return x.SpreadBps
 	}
-	return ""
+	return 0
+}
+
+func (x *OrderbookAnalyticsResponse) GetImbalance() float64 {
+	if x != nil {
+		return x.Imbalance
+	}
+	return 0
+}
+
+func (x *OrderbookAnalyticsResponse) GetSpreadStats() *OrderbookAnalyticsSpreadStats {
+	if x != nil {
+		return x.SpreadStats
+	}
+	return nil
+}
+
+func (x *OrderbookAnalyticsResponse) GetBands() []*OrderbookAnalyticsBand {
+	if x != nil {
+		return x.Bands
+	}
+	return nil
+}
+
+func (x *OrderbookAnalyticsResponse) GetBids() *OrderbookAnalyticsFlow {
+	if x != nil {
+		return x.Bids
+	}
+	return nil
+}
+
+func (x *OrderbookAnalyticsResponse) GetAsks() *OrderbookAnalyticsFlow {
+	if x != nil {
+		return x.Asks
+	}
+	return nil
+}
+
+func (x *OrderbookAnalyticsResponse) GetWindow() int64 {
+	if x != nil {
+		return x.Window
+	}
+	return 0
 }
 
 var File_rpc_proto protoreflect.FileDescriptor
@@ -15335,7 +19203,7 @@ const file_rpc_proto_rawDesc = "" +
 	"\finverse_rate\x18\x04 \x01(\x01R\vinverseRate\"V\n" +
 	"\x15GetForexRatesResponse\x12=\n" +
 	"\vforex_rates\x18\x01 \x03(\v2\x1c.gctrpc.ForexRatesConversionR\n" +
-	"forexRates\"\xb5\x04\n" +
+	"forexRates\"\xb1\x05\n" +
 	"\fOrderDetails\x12\x1a\n" +
 	"\bexchange\x18\x01 \x01(\tR\bexchange\x12\x0e\n" +
 	"\x02id\x18\x02 \x01(\tR\x02id\x12&\n" +
@@ -15360,7 +19228,10 @@ const file_rpc_proto_rawDesc = "" +
 	"\x03fee\x18\x0f \x01(\x01R\x03fee\x12\x12\n" +
 	"\x04cost\x18\x10 \x01(\x01R\x04cost\x12,\n" +
 	"\x06trades\x18\x11 \x03(\v2\x14.gctrpc.TradeHistoryR\x06trades\x12'\n" +
-	"\x0fcontract_amount\x18\x12 \x01(\x01R\x0econtractAmount\"\xf3\x01\n" +
+	"\x0fcontract_amount\x18\x12 \x01(\x01R\x0econtractAmount\x12'\n" +
+	"\x0fexecuted_amount\x18\x13 \x01(\x01R\x0eexecutedAmount\x124\n" +
+	"\x16average_executed_price\x18\x14 \x01(\x01R\x14averageExecutedPrice\x12\x1b\n" +
+	"\tfee_asset\x18\x15 \x01(\tR\bfeeAsset\"\xf3\x01\n" +
 	"\fTradeHistory\x12#\n" +
 	"\rcreation_time\x18\x01 \x01(\x03R\fcreationTime\x12\x0e\n" +
 	"\x02id\x18\x02 \x01(\tR\x02id\x12\x14\n" +
@@ -15372,7 +19243,7 @@ const file_rpc_proto_rawDesc = "" +
 	"\n" +
 	"order_side\x18\a \x01(\tR\torderSide\x12\x10\n" +
 	"\x03fee\x18\b \x01(\x01R\x03fee\x12\x14\n" +
-	"\x05total\x18\t \x01(\x01R\x05total\"\xb1\x01\n" +
+	"\x05total\x18\t \x01(\x01R\x05total\"\xda\x01\n" +
 	"\x10GetOrdersRequest\x12\x1a\n" +
 	"\bexchange\x18\x01 \x01(\tR\bexchange\x12\x1d\n" +
 	"\n" +
@@ -15380,7 +19251,8 @@ const file_rpc_proto_rawDesc = "" +
 	"\x04pair\x18\x03 \x01(\v2\x14.gctrpc.CurrencyPairR\x04pair\x12\x1d\n" +
 	"\n" +
 	"start_date\x18\x04 \x01(\tR\tstartDate\x12\x19\n" +
-	"\bend_date\x18\x05 \x01(\tR\aendDate\"A\n" +
+	"\bend_date\x18\x05 \x01(\tR\aendDate\x12'\n" +
+	"\x0finclude_history\x18\x06 \x01(\bR\x0eincludeHistory\"A\n" +
 	"\x11GetOrdersResponse\x12,\n" +
 	"\x06orders\x18\x01 \x03(\v2\x14.gctrpc.OrderDetailsR\x06orders\"\x88\x01\n" +
 	"\x0fGetOrderRequest\x12\x1a\n" +
@@ -15462,7 +19334,7 @@ const file_rpc_proto_rawDesc = "" +
 	"\x17CancelAllOrdersResponse\x12&\n" +
 	"\x06orders\x18\x01 \x03(\v2\x0e.gctrpc.OrdersR\x06orders\x12\x14\n" +
 	"\x05count\x18\x02 \x01(\x03R\x05count\"\x12\n" +
-	"\x10GetEventsRequest\"\xae\x01\n" +
+	"\x10GetEventsRequest\"\xc6\x03\n" +
 	"\x0fConditionParams\x12\x1c\n" +
 	"\tcondition\x18\x01 \x01(\tR\tcondition\x12\x14\n" +
 	"\x05price\x18\x02 \x01(\x01R\x05price\x12\x1d\n" +
@@ -15470,15 +19342,57 @@ const file_rpc_proto_rawDesc = "" +
 	"check_bids\x18\x03 \x01(\bR\tcheckBids\x12\x1d\n" +
 	"\n" +
 	"check_asks\x18\x04 \x01(\bR\tcheckAsks\x12)\n" +
-	"\x10orderbook_amount\x18\x05 \x01(\x01R\x0forderbookAmount\"\xf5\x01\n" +
-	"\x11GetEventsResponse\x12\x0e\n" +
+	"\x10orderbook_amount\x18\x05 \x01(\x01R\x0forderbookAmount\x12\x1a\n" +
+	"\binterval\x18\x06 \x01(\x03R\binterval\x12\x16\n" +
+	"\x06period\x18\a \x01(\x03R\x06period\x12\x1f\n" +
+	"\vfast_period\x18\b \x01(\x03R\n" +
+	"fastPeriod\x12\x1f\n" +
+	"\vslow_period\x18\t \x01(\x03R\n" +
+	"slowPeriod\x12#\n" +
+	"\rsignal_period\x18\n" +
+	" \x01(\x03R\fsignalPeriod\x12/\n" +
+	"\x13standard_deviations\x18\v \x01(\x01R\x12standardDeviations\x12\x1c\n" +
+	"\tdirection\x18\f \x01(\tR\tdirection\x12\x16\n" +
+	"\x06window\x18\r \x01(\x03R\x06window\x12\x14\n" +
+	"\x05value\x18\x0e \x01(\x01R\x05value\"\xbc\x01\n" +
+	"\x0eEventCondition\x12\x12\n" +
+	"\x04item\x18\x01 \x01(\tR\x04item\x12B\n" +
+	"\x10condition_params\x18\x02 \x01(\v2\x17.gctrpc.ConditionParamsR\x0fconditionParams\x12\x1a\n" +
+	"\boperator\x18\x03 \x01(\tR\boperator\x126\n" +
+	"\n" +
+	"conditions\x18\x04 \x03(\v2\x16.gctrpc.EventConditionR\n" +
+	"conditions\"\xed\x01\n" +
+	"\vEventAction\x12\x16\n" +
+	"\x06action\x18\x01 \x01(\tR\x06action\x12\x12\n" +
+	"\x04side\x18\x02 \x01(\tR\x04side\x12\x1d\n" +
+	"\n" +
+	"order_type\x18\x03 \x01(\tR\torderType\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x01R\x06amount\x12\x14\n" +
+	"\x05price\x18\x05 \x01(\x01R\x05price\x12\x19\n" +
+	"\border_id\x18\x06 \x01(\tR\aorderId\x12\x16\n" +
+	"\x06script\x18\a \x01(\tR\x06script\x12\x18\n" +
+	"\arelayer\x18\b \x01(\tR\arelayer\x12\x18\n" +
+	"\amessage\x18\t \x01(\tR\amessage\"\xb1\x03\n" +
+	"\fEventDetails\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1a\n" +
 	"\bexchange\x18\x02 \x01(\tR\bexchange\x12\x12\n" +
 	"\x04item\x18\x03 \x01(\tR\x04item\x12B\n" +
 	"\x10condition_params\x18\x04 \x01(\v2\x17.gctrpc.ConditionParamsR\x0fconditionParams\x12(\n" +
 	"\x04pair\x18\x05 \x01(\v2\x14.gctrpc.CurrencyPairR\x04pair\x12\x16\n" +
 	"\x06action\x18\x06 \x01(\tR\x06action\x12\x1a\n" +
-	"\bexecuted\x18\a \x01(\bR\bexecuted\"\xe6\x01\n" +
+	"\bexecuted\x18\a \x01(\bR\bexecuted\x12\x1d\n" +
+	"\n" +
+	"asset_type\x18\b \x01(\tR\tassetType\x12\x1a\n" +
+	"\boperator\x18\t \x01(\tR\boperator\x126\n" +
+	"\n" +
+	"conditions\x18\n" +
+	" \x03(\v2\x16.gctrpc.EventConditionR\n" +
+	"conditions\x12-\n" +
+	"\aactions\x18\v \x03(\v2\x13.gctrpc.EventActionR\aactions\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\f \x01(\tR\tcreatedAt\"A\n" +
+	"\x11GetEventsResponse\x12,\n" +
+	"\x06events\x18\x01 \x03(\v2\x14.gctrpc.EventDetailsR\x06events\"\xe9\x02\n" +
 	"\x0fAddEventRequest\x12\x1a\n" +
 	"\bexchange\x18\x01 \x01(\tR\bexchange\x12\x12\n" +
 	"\x04item\x18\x02 \x01(\tR\x04item\x12B\n" +
@@ -15486,7 +19400,12 @@ const file_rpc_proto_rawDesc = "" +
 	"\x04pair\x18\x04 \x01(\v2\x14.gctrpc.CurrencyPairR\x04pair\x12\x1d\n" +
 	"\n" +
 	"asset_type\x18\x05 \x01(\tR\tassetType\x12\x16\n" +
-	"\x06action\x18\x06 \x01(\tR\x06action\"\"\n" +
+	"\x06action\x18\x06 \x01(\tR\x06action\x12\x1a\n" +
+	"\boperator\x18\a \x01(\tR\boperator\x126\n" +
+	"\n" +
+	"conditions\x18\b \x03(\v2\x16.gctrpc.EventConditionR\n" +
+	"conditions\x12-\n" +
+	"\aactions\x18\t \x03(\v2\x13.gctrpc.EventActionR\aactions\"\"\n" +
 	"\x10AddEventResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\"$\n" +
 	"\x12RemoveEventRequest\x12\x0e\n" +
@@ -15585,7 +19504,30 @@ const file_rpc_proto_rawDesc = "" +
 	"\vaddress_tag\x18\x02 \x01(\tR\n" +
 	"addressTag\x12\x10\n" +
 	"\x03fee\x18\x03 \x01(\x01R\x03fee\x12\x13\n" +
-	"\x05tx_id\x18\x04 \x01(\tR\x04txId\"1\n" +
+	"\x05tx_id\x18\x04 \x01(\tR\x04txId\"\x1e\n" +
+	"\x1cGetPendingWithdrawalsRequest\"`\n" +
+	"\x12WithdrawalApproval\x12\x1a\n" +
+	"\bapprover\x18\x01 \x01(\tR\bapprover\x12.\n" +
+	"\x04time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\"\xd8\x02\n" +
+	"\x11PendingWithdrawal\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\bexchange\x18\x02 \x01(\tR\bexchange\x128\n" +
+	"\arequest\x18\x03 \x01(\v2\x1e.gctrpc.WithdrawalRequestEventR\arequest\x128\n" +
+	"\tapprovals\x18\x04 \x03(\v2\x1a.gctrpc.WithdrawalApprovalR\tapprovals\x12-\n" +
+	"\x12required_approvals\x18\x05 \x01(\x03R\x11requiredApprovals\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"expires_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"T\n" +
+	"\x1dGetPendingWithdrawalsResponse\x123\n" +
+	"\apending\x18\x01 \x03(\v2\x19.gctrpc.PendingWithdrawalR\apending\"F\n" +
+	"\x18ApproveWithdrawalRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\bapprover\x18\x02 \x01(\tR\bapprover\"]\n" +
+	"\x17RejectWithdrawalRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\bapprover\x18\x02 \x01(\tR\bapprover\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"1\n" +
 	"\x17GetLoggerDetailsRequest\x12\x16\n" +
 	"\x06logger\x18\x01 \x01(\tR\x06logger\"n\n" +
 	"\x18GetLoggerDetailsResponse\x12\x12\n" +
@@ -15623,7 +19565,48 @@ const file_rpc_proto_rawDesc = "" +
 	"\n" +
 	"asset_type\x18\x03 \x01(\tR\tassetType\"<\n" +
 	"\x1eGetExchangeTickerStreamRequest\x12\x1a\n" +
-	"\bexchange\x18\x01 \x01(\tR\bexchange\"\x99\x01\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\"\x98\x01\n" +
+	"\x15GetOrderStreamRequest\x12\x1a\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\x12\x1d\n" +
+	"\n" +
+	"asset_type\x18\x02 \x01(\tR\tassetType\x12(\n" +
+	"\x04pair\x18\x03 \x01(\v2\x14.gctrpc.CurrencyPairR\x04pair\x12\x1a\n" +
+	"\bstatuses\x18\x04 \x03(\tR\bstatuses\"s\n" +
+	"\x19AggregatedOrderbookSource\x12\x1a\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\x12(\n" +
+	"\x04pair\x18\x02 \x01(\v2\x14.gctrpc.CurrencyPairR\x04pair\x12\x10\n" +
+	"\x03fee\x18\x03 \x01(\x01R\x03fee\"\x96\x02\n" +
+	"#GetAggregatedOrderbookStreamRequest\x12;\n" +
+	"\asources\x18\x01 \x03(\v2!.gctrpc.AggregatedOrderbookSourceR\asources\x12(\n" +
+	"\x04pair\x18\x02 \x01(\v2\x14.gctrpc.CurrencyPairR\x04pair\x12\x1d\n" +
+	"\n" +
+	"asset_type\x18\x03 \x01(\tR\tassetType\x12'\n" +
+	"\x0fnormalise_quote\x18\x04 \x01(\bR\x0enormaliseQuote\x12!\n" +
+	"\finclude_fees\x18\x05 \x01(\bR\vincludeFees\x12\x1d\n" +
+	"\n" +
+	"max_levels\x18\x06 \x01(\x05R\tmaxLevels\"\xcf\x01\n" +
+	"\x1eAggregatedOrderbookLevelSource\x12\x1a\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\x12(\n" +
+	"\x04pair\x18\x02 \x01(\v2\x14.gctrpc.CurrencyPairR\x04pair\x12\x14\n" +
+	"\x05price\x18\x03 \x01(\x01R\x05price\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x01R\x06amount\x12\x10\n" +
+	"\x03fee\x18\x05 \x01(\x01R\x03fee\x12'\n" +
+	"\x0feffective_price\x18\x06 \x01(\x01R\x0eeffectivePrice\"\x8a\x01\n" +
+	"\x18AggregatedOrderbookLevel\x12\x14\n" +
+	"\x05price\x18\x01 \x01(\x01R\x05price\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x01R\x06amount\x12@\n" +
+	"\asources\x18\x03 \x03(\v2&.gctrpc.AggregatedOrderbookLevelSourceR\asources\"\x8d\x03\n" +
+	"\x1bAggregatedOrderbookResponse\x12(\n" +
+	"\x04pair\x18\x01 \x01(\v2\x14.gctrpc.CurrencyPairR\x04pair\x12\x1d\n" +
+	"\n" +
+	"asset_type\x18\x02 \x01(\tR\tassetType\x124\n" +
+	"\x04bids\x18\x03 \x03(\v2 .gctrpc.AggregatedOrderbookLevelR\x04bids\x124\n" +
+	"\x04asks\x18\x04 \x03(\v2 .gctrpc.AggregatedOrderbookLevelR\x04asks\x12!\n" +
+	"\flast_updated\x18\x05 \x01(\x03R\vlastUpdated\x12V\n" +
+	"\vunavailable\x18\x06 \x03(\v24.gctrpc.AggregatedOrderbookResponse.UnavailableEntryR\vunavailable\x1a>\n" +
+	"\x10UnavailableEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x99\x01\n" +
 	"\x14GetAuditEventRequest\x12\x1d\n" +
 	"\n" +
 	"start_date\x18\x01 \x01(\tR\tstartDate\x12\x19\n" +
@@ -16367,7 +20350,271 @@ const file_rpc_proto_rawDesc = "" +
 	"\x05asset\x18\x02 \x01(\tR\x05asset\x12(\n" +
 	"\x04pair\x18\x03 \x01(\v2\x14.gctrpc.CurrencyPairR\x04pair\"/\n" +
 	"\x1bGetCurrencyTradeURLResponse\x12\x10\n" +
-	"\x03url\x18\x01 \x01(\tR\x03url2\xccl\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\"\xc4\x05\n" +
+	"\x10ConditionalOrder\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\bexchange\x18\x02 \x01(\tR\bexchange\x12(\n" +
+	"\x04pair\x18\x03 \x01(\v2\x14.gctrpc.CurrencyPairR\x04pair\x12\x14\n" +
+	"\x05asset\x18\x04 \x01(\tR\x05asset\x12\x12\n" +
+	"\x04type\x18\x05 \x01(\tR\x04type\x12\x12\n" +
+	"\x04side\x18\x06 \x01(\tR\x04side\x12\x16\n" +
+	"\x06amount\x18\a \x01(\x01R\x06amount\x12#\n" +
+	"\rtrigger_price\x18\b \x01(\x01R\ftriggerPrice\x12\x1f\n" +
+	"\vlimit_price\x18\t \x01(\x01R\n" +
+	"limitPrice\x12*\n" +
+	"\x11take_profit_price\x18\n" +
+	" \x01(\x01R\x0ftakeProfitPrice\x12\x1f\n" +
+	"\ventry_price\x18\v \x01(\x01R\n" +
+	"entryPrice\x12'\n" +
+	"\x0ftrailing_amount\x18\f \x01(\x01R\x0etrailingAmount\x12)\n" +
+	"\x10trailing_percent\x18\r \x01(\x01R\x0ftrailingPercent\x12'\n" +
+	"\x0freference_price\x18\x0e \x01(\x01R\x0ereferencePrice\x12$\n" +
+	"\x0eentry_order_id\x18\x0f \x01(\tR\fentryOrderId\x12\x19\n" +
+	"\border_id\x18\x10 \x01(\tR\aorderId\x12\x16\n" +
+	"\x06status\x18\x11 \x01(\tR\x06status\x12%\n" +
+	"\x0efailure_reason\x18\x12 \x01(\tR\rfailureReason\x129\n" +
+	"\n" +
+	"created_at\x18\x13 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x14 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x9f\x03\n" +
+	"\x1aAddConditionalOrderRequest\x12\x1a\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\x12(\n" +
+	"\x04pair\x18\x02 \x01(\v2\x14.gctrpc.CurrencyPairR\x04pair\x12\x14\n" +
+	"\x05asset\x18\x03 \x01(\tR\x05asset\x12\x12\n" +
+	"\x04type\x18\x04 \x01(\tR\x04type\x12\x12\n" +
+	"\x04side\x18\x05 \x01(\tR\x04side\x12\x16\n" +
+	"\x06amount\x18\x06 \x01(\x01R\x06amount\x12#\n" +
+	"\rtrigger_price\x18\a \x01(\x01R\ftriggerPrice\x12\x1f\n" +
+	"\vlimit_price\x18\b \x01(\x01R\n" +
+	"limitPrice\x12*\n" +
+	"\x11take_profit_price\x18\t \x01(\x01R\x0ftakeProfitPrice\x12\x1f\n" +
+	"\ventry_price\x18\n" +
+	" \x01(\x01R\n" +
+	"entryPrice\x12'\n" +
+	"\x0ftrailing_amount\x18\v \x01(\x01R\x0etrailingAmount\x12)\n" +
+	"\x10trailing_percent\x18\f \x01(\x01R\x0ftrailingPercent\"Z\n" +
+	"\x1bGetConditionalOrdersRequest\x12\x1a\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\x12\x1f\n" +
+	"\vactive_only\x18\x02 \x01(\bR\n" +
+	"activeOnly\"P\n" +
+	"\x1cGetConditionalOrdersResponse\x120\n" +
+	"\x06orders\x18\x01 \x03(\v2\x18.gctrpc.ConditionalOrderR\x06orders\"/\n" +
+	"\x1dCancelConditionalOrderRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"U\n" +
+	"\x14SetKillSwitchRequest\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12#\n" +
+	"\rcancel_orders\x18\x02 \x01(\bR\fcancelOrders\"\xe9\x01\n" +
+	"\x11RouteOrderRequest\x12(\n" +
+	"\x04pair\x18\x01 \x01(\v2\x14.gctrpc.CurrencyPairR\x04pair\x12\x1d\n" +
+	"\n" +
+	"asset_type\x18\x02 \x01(\tR\tassetType\x12\x12\n" +
+	"\x04side\x18\x03 \x01(\tR\x04side\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x01R\x06amount\x12\x1c\n" +
+	"\texchanges\x18\x05 \x03(\tR\texchanges\x12'\n" +
+	"\x0fignore_balances\x18\x06 \x01(\bR\x0eignoreBalances\x12\x18\n" +
+	"\aexecute\x18\a \x01(\bR\aexecute\"\xb8\x02\n" +
+	"\rRouteOrderLeg\x12\x1a\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x01R\x06amount\x12#\n" +
+	"\raverage_price\x18\x03 \x01(\x01R\faveragePrice\x12\x1f\n" +
+	"\vworst_price\x18\x04 \x01(\x01R\n" +
+	"worstPrice\x12'\n" +
+	"\x0feffective_price\x18\x05 \x01(\x01R\x0eeffectivePrice\x12\x12\n" +
+	"\x04cost\x18\x06 \x01(\x01R\x04cost\x12\x10\n" +
+	"\x03fee\x18\a \x01(\x01R\x03fee\x12-\n" +
+	"\x06orders\x18\b \x03(\v2\x15.gctrpc.OrderbookItemR\x06orders\x12\x19\n" +
+	"\border_id\x18\t \x01(\tR\aorderId\x12\x14\n" +
+	"\x05error\x18\n" +
+	" \x01(\tR\x05error\"G\n" +
+	"\x11RouteOrderSkipped\x12\x1a\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"\xe9\x03\n" +
+	"\x12RouteOrderResponse\x12(\n" +
+	"\x04pair\x18\x01 \x01(\v2\x14.gctrpc.CurrencyPairR\x04pair\x12\x1d\n" +
+	"\n" +
+	"asset_type\x18\x02 \x01(\tR\tassetType\x12\x12\n" +
+	"\x04side\x18\x03 \x01(\tR\x04side\x12)\n" +
+	"\x10requested_amount\x18\x04 \x01(\x01R\x0frequestedAmount\x12#\n" +
+	"\rrouted_amount\x18\x05 \x01(\x01R\froutedAmount\x12'\n" +
+	"\x0funrouted_amount\x18\x06 \x01(\x01R\x0eunroutedAmount\x12#\n" +
+	"\raverage_price\x18\a \x01(\x01R\faveragePrice\x12'\n" +
+	"\x0feffective_price\x18\b \x01(\x01R\x0eeffectivePrice\x12\x1b\n" +
+	"\ttotal_fee\x18\t \x01(\x01R\btotalFee\x12)\n" +
+	"\x04legs\x18\n" +
+	" \x03(\v2\x15.gctrpc.RouteOrderLegR\x04legs\x123\n" +
+	"\askipped\x18\v \x03(\v2\x19.gctrpc.RouteOrderSkippedR\askipped\x12\x16\n" +
+	"\x06status\x18\f \x01(\tR\x06status\x12\x1a\n" +
+	"\bexecuted\x18\r \x01(\bR\bexecuted\"1\n" +
+	"\x13GetLedgerPNLRequest\x12\x1a\n" +
+	"\bcurrency\x18\x01 \x01(\tR\bcurrency\"\xc6\x03\n" +
+	"\x11LedgerCurrencyPNL\x12\x1a\n" +
+	"\bcurrency\x18\x01 \x01(\tR\bcurrency\x12\x1a\n" +
+	"\bholdings\x18\x02 \x01(\tR\bholdings\x12\x1d\n" +
+	"\n" +
+	"cost_basis\x18\x03 \x01(\tR\tcostBasis\x12!\n" +
+	"\faverage_cost\x18\x04 \x01(\tR\vaverageCost\x12!\n" +
+	"\frealised_pnl\x18\x05 \x01(\tR\vrealisedPnl\x12\x16\n" +
+	"\x06valued\x18\x06 \x01(\bR\x06valued\x12!\n" +
+	"\fmarket_value\x18\a \x01(\tR\vmarketValue\x12%\n" +
+	"\x0eunrealised_pnl\x18\b \x01(\tR\runrealisedPnl\x12)\n" +
+	"\x10unmatched_amount\x18\t \x01(\tR\x0funmatchedAmount\x12-\n" +
+	"\x12unmatched_proceeds\x18\n" +
+	" \x01(\tR\x11unmatchedProceeds\x12+\n" +
+	"\x11unvalued_holdings\x18\v \x01(\tR\x10unvaluedHoldings\x12+\n" +
+	"\x11unvalued_disposed\x18\f \x01(\tR\x10unvaluedDisposed\"\xe2\x01\n" +
+	"\x14GetLedgerPNLResponse\x12\x16\n" +
+	"\x06method\x18\x01 \x01(\tR\x06method\x12-\n" +
+	"\x12reporting_currency\x18\x02 \x01(\tR\x11reportingCurrency\x129\n" +
+	"\n" +
+	"currencies\x18\x03 \x03(\v2\x19.gctrpc.LedgerCurrencyPNLR\n" +
+	"currencies\x12!\n" +
+	"\frealised_pnl\x18\x04 \x01(\tR\vrealisedPnl\x12%\n" +
+	"\x0eunrealised_pnl\x18\x05 \x01(\tR\runrealisedPnl\"Y\n" +
+	"\x14GetLedgerLotsRequest\x12\x1a\n" +
+	"\bcurrency\x18\x01 \x01(\tR\bcurrency\x12%\n" +
+	"\x0einclude_closed\x18\x02 \x01(\bR\rincludeClosed\"\xca\x02\n" +
+	"\x0eLedgerDisposal\x12\x1a\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\x12(\n" +
+	"\x04pair\x18\x02 \x01(\v2\x14.gctrpc.CurrencyPairR\x04pair\x12\x19\n" +
+	"\border_id\x18\x03 \x01(\tR\aorderId\x12\x19\n" +
+	"\btrade_id\x18\x04 \x01(\tR\atradeId\x12.\n" +
+	"\x04time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\x12\x16\n" +
+	"\x06amount\x18\x06 \x01(\tR\x06amount\x12\x1a\n" +
+	"\bproceeds\x18\a \x01(\tR\bproceeds\x12\x1d\n" +
+	"\n" +
+	"cost_basis\x18\b \x01(\tR\tcostBasis\x12!\n" +
+	"\frealised_pnl\x18\t \x01(\tR\vrealisedPnl\x12\x16\n" +
+	"\x06valued\x18\n" +
+	" \x01(\bR\x06valued\"\xb0\x03\n" +
+	"\tLedgerLot\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\x12\x1a\n" +
+	"\bexchange\x18\x03 \x01(\tR\bexchange\x12(\n" +
+	"\x04pair\x18\x04 \x01(\v2\x14.gctrpc.CurrencyPairR\x04pair\x12\x19\n" +
+	"\border_id\x18\x05 \x01(\tR\aorderId\x12\x19\n" +
+	"\btrade_id\x18\x06 \x01(\tR\atradeId\x12;\n" +
+	"\vacquired_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"acquiredAt\x12\x16\n" +
+	"\x06amount\x18\b \x01(\tR\x06amount\x12\x1c\n" +
+	"\tremaining\x18\t \x01(\tR\tremaining\x12\x1d\n" +
+	"\n" +
+	"cost_basis\x18\n" +
+	" \x01(\tR\tcostBasis\x12\x1b\n" +
+	"\tunit_cost\x18\v \x01(\tR\bunitCost\x124\n" +
+	"\tdisposals\x18\f \x03(\v2\x16.gctrpc.LedgerDisposalR\tdisposals\x12\x16\n" +
+	"\x06valued\x18\r \x01(\bR\x06valued\"\x85\x01\n" +
+	"\x15GetLedgerLotsResponse\x12\x16\n" +
+	"\x06method\x18\x01 \x01(\tR\x06method\x12-\n" +
+	"\x12reporting_currency\x18\x02 \x01(\tR\x11reportingCurrency\x12%\n" +
+	"\x04lots\x18\x03 \x03(\v2\x11.gctrpc.LedgerLotR\x04lots\"s\n" +
+	"\x16ExportLedgerCSVRequest\x12\x16\n" +
+	"\x06report\x18\x01 \x01(\tR\x06report\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\x12%\n" +
+	"\x0einclude_closed\x18\x03 \x01(\bR\rincludeClosed\"C\n" +
+	"\x17ExportLedgerCSVResponse\x12\x16\n" +
+	"\x06report\x18\x01 \x01(\tR\x06report\x12\x10\n" +
+	"\x03csv\x18\x02 \x01(\tR\x03csv\"\x19\n" +
+	"\x17PreviewRebalanceRequest\"\x19\n" +
+	"\x17GetRebalancePlanRequest\"-\n" +
+	"\x1bApproveRebalancePlanRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xef\x01\n" +
+	"\x13RebalanceAllocation\x12\x1a\n" +
+	"\bcurrency\x18\x01 \x01(\tR\bcurrency\x12\x1a\n" +
+	"\bexchange\x18\x02 \x01(\tR\bexchange\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x01R\x06amount\x12\x14\n" +
+	"\x05value\x18\x04 \x01(\x01R\x05value\x12\x16\n" +
+	"\x06weight\x18\x05 \x01(\x01R\x06weight\x12#\n" +
+	"\rtarget_weight\x18\x06 \x01(\x01R\ftargetWeight\x12\x14\n" +
+	"\x05drift\x18\a \x01(\x01R\x05drift\x12\x1f\n" +
+	"\vtrade_value\x18\b \x01(\x01R\n" +
+	"tradeValue\"\xdf\x01\n" +
+	"\x0eRebalanceTrade\x12\x1a\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\x12(\n" +
+	"\x04pair\x18\x02 \x01(\v2\x14.gctrpc.CurrencyPairR\x04pair\x12\x12\n" +
+	"\x04side\x18\x03 \x01(\tR\x04side\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x01R\x06amount\x12\x14\n" +
+	"\x05price\x18\x05 \x01(\x01R\x05price\x12\x14\n" +
+	"\x05value\x18\x06 \x01(\x01R\x05value\x12\x19\n" +
+	"\border_id\x18\a \x01(\tR\aorderId\x12\x14\n" +
+	"\x05error\x18\b \x01(\tR\x05error\"\x82\x01\n" +
+	"\x10RebalanceSkipped\x12\x1a\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\x12\x12\n" +
+	"\x04sell\x18\x02 \x01(\tR\x04sell\x12\x10\n" +
+	"\x03buy\x18\x03 \x01(\tR\x03buy\x12\x14\n" +
+	"\x05value\x18\x04 \x01(\x01R\x05value\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\"\xe5\x03\n" +
+	"\x15RebalancePlanResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12-\n" +
+	"\x12reporting_currency\x18\x03 \x01(\tR\x11reportingCurrency\x12\x1f\n" +
+	"\vtotal_value\x18\x04 \x01(\x01R\n" +
+	"totalValue\x12=\n" +
+	"\vallocations\x18\x05 \x03(\v2\x1b.gctrpc.RebalanceAllocationR\vallocations\x12.\n" +
+	"\x06trades\x18\x06 \x03(\v2\x16.gctrpc.RebalanceTradeR\x06trades\x122\n" +
+	"\askipped\x18\a \x03(\v2\x18.gctrpc.RebalanceSkippedR\askipped\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"expires_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12;\n" +
+	"\vexecuted_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"executedAt\"\xc9\x01\n" +
+	"\x1cGetOrderbookAnalyticsRequest\x12\x1a\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\x12(\n" +
+	"\x04pair\x18\x02 \x01(\v2\x14.gctrpc.CurrencyPairR\x04pair\x12\x1d\n" +
+	"\n" +
+	"asset_type\x18\x03 \x01(\tR\tassetType\x12\x14\n" +
+	"\x05bands\x18\x04 \x03(\x01R\x05bands\x12\x16\n" +
+	"\x06levels\x18\x05 \x01(\x03R\x06levels\x12\x16\n" +
+	"\x06window\x18\x06 \x01(\x03R\x06window\"\x87\x01\n" +
+	" UntrackOrderbookAnalyticsRequest\x12\x1a\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\x12(\n" +
+	"\x04pair\x18\x02 \x01(\v2\x14.gctrpc.CurrencyPairR\x04pair\x12\x1d\n" +
+	"\n" +
+	"asset_type\x18\x03 \x01(\tR\tassetType\"p\n" +
+	"\x1dOrderbookAnalyticsSpreadStats\x12\x12\n" +
+	"\x04mean\x18\x01 \x01(\x01R\x04mean\x12\x17\n" +
+	"\astd_dev\x18\x02 \x01(\x01R\x06stdDev\x12\x10\n" +
+	"\x03min\x18\x03 \x01(\x01R\x03min\x12\x10\n" +
+	"\x03max\x18\x04 \x01(\x01R\x03max\"\xc0\x01\n" +
+	"\x16OrderbookAnalyticsBand\x12\x10\n" +
+	"\x03bps\x18\x01 \x01(\x01R\x03bps\x12\x1d\n" +
+	"\n" +
+	"bid_amount\x18\x02 \x01(\x01R\tbidAmount\x12\x1d\n" +
+	"\n" +
+	"ask_amount\x18\x03 \x01(\x01R\taskAmount\x12\x1b\n" +
+	"\tbid_value\x18\x04 \x01(\x01R\bbidValue\x12\x1b\n" +
+	"\task_value\x18\x05 \x01(\x01R\baskValue\x12\x1c\n" +
+	"\timbalance\x18\x06 \x01(\x01R\timbalance\"\xf6\x01\n" +
+	"\x16OrderbookAnalyticsFlow\x124\n" +
+	"\x16replenished_per_second\x18\x01 \x01(\x01R\x14replenishedPerSecond\x120\n" +
+	"\x14cancelled_per_second\x18\x02 \x01(\x01R\x12cancelledPerSecond\x12:\n" +
+	"\x19replenishments_per_second\x18\x03 \x01(\x01R\x17replenishmentsPerSecond\x128\n" +
+	"\x18cancellations_per_second\x18\x04 \x01(\x01R\x16cancellationsPerSecond\"\xd3\x05\n" +
+	"\x1aOrderbookAnalyticsResponse\x12\x1a\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\x12(\n" +
+	"\x04pair\x18\x02 \x01(\v2\x14.gctrpc.CurrencyPairR\x04pair\x12\x1d\n" +
+	"\n" +
+	"asset_type\x18\x03 \x01(\tR\tassetType\x12\x1b\n" +
+	"\tupdate_id\x18\x04 \x01(\x03R\bupdateId\x12=\n" +
+	"\flast_updated\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\vlastUpdated\x12\x19\n" +
+	"\bbest_bid\x18\x06 \x01(\x01R\abestBid\x12\x19\n" +
+	"\bbest_ask\x18\a \x01(\x01R\abestAsk\x12\x1b\n" +
+	"\tmid_price\x18\b \x01(\x01R\bmidPrice\x12,\n" +
+	"\x12weighted_mid_price\x18\t \x01(\x01R\x10weightedMidPrice\x12\x1e\n" +
+	"\n" +
+	"microprice\x18\n" +
+	" \x01(\x01R\n" +
+	"microprice\x12\x16\n" +
+	"\x06spread\x18\v \x01(\x01R\x06spread\x12\x1d\n" +
+	"\n" +
+	"spread_bps\x18\f \x01(\x01R\tspreadBps\x12\x1c\n" +
+	"\timbalance\x18\r \x01(\x01R\timbalance\x12H\n" +
+	"\fspread_stats\x18\x0e \x01(\v2%.gctrpc.OrderbookAnalyticsSpreadStatsR\vspreadStats\x124\n" +
+	"\x05bands\x18\x0f \x03(\v2\x1e.gctrpc.OrderbookAnalyticsBandR\x05bands\x122\n" +
+	"\x04bids\x18\x10 \x01(\v2\x1e.gctrpc.OrderbookAnalyticsFlowR\x04bids\x122\n" +
+	"\x04asks\x18\x11 \x01(\v2\x1e.gctrpc.OrderbookAnalyticsFlowR\x04asks\x12\x16\n" +
+	"\x06window\x18\x12 \x01(\x03R\x06window2\xcf~\n" +
 	"\x15GoCryptoTraderService\x12O\n" +
 	"\aGetInfo\x12\x16.gctrpc.GetInfoRequest\x1a\x17.gctrpc.GetInfoResponse\"\x13\x82\xd3\xe4\x93\x02\r\x12\v/v1/getinfo\x12g\n" +
 	"\rGetSubsystems\x12\x1c.gctrpc.GetSubsystemsRequest\x1a\x1d.gctrpc.GetSusbsytemsResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/getsubsystems\x12h\n" +
@@ -16414,7 +20661,10 @@ const file_rpc_proto_rawDesc = "" +
 	"\x1bWithdrawCryptocurrencyFunds\x12\x1d.gctrpc.WithdrawCryptoRequest\x1a\x18.gctrpc.WithdrawResponse\"3\x82\xd3\xe4\x93\x02-:\x01*\"(/v1/withdrawithdrawcryptofundswfiatfunds\x12\x82\x01\n" +
 	"\x13WithdrawalEventByID\x12\".gctrpc.WithdrawalEventByIDRequest\x1a#.gctrpc.WithdrawalEventByIDResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/v1/withdrawaleventbyid\x12\x9d\x01\n" +
 	"\x1aWithdrawalEventsByExchange\x12).gctrpc.WithdrawalEventsByExchangeRequest\x1a*.gctrpc.WithdrawalEventsByExchangeResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/v1/withdrawaleventbyexchange\x12\x91\x01\n" +
-	"\x16WithdrawalEventsByDate\x12%.gctrpc.WithdrawalEventsByDateRequest\x1a*.gctrpc.WithdrawalEventsByExchangeResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/v1/withdrawaleventbydate\x12s\n" +
+	"\x16WithdrawalEventsByDate\x12%.gctrpc.WithdrawalEventsByDateRequest\x1a*.gctrpc.WithdrawalEventsByExchangeResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/v1/withdrawaleventbydate\x12\x87\x01\n" +
+	"\x15GetPendingWithdrawals\x12$.gctrpc.GetPendingWithdrawalsRequest\x1a%.gctrpc.GetPendingWithdrawalsResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/v1/getpendingwithdrawals\x12q\n" +
+	"\x11ApproveWithdrawal\x12 .gctrpc.ApproveWithdrawalRequest\x1a\x18.gctrpc.WithdrawResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/approvewithdrawal\x12m\n" +
+	"\x10RejectWithdrawal\x12\x1f.gctrpc.RejectWithdrawalRequest\x1a\x17.gctrpc.GenericResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/v1/rejectwithdrawal\x12s\n" +
 	"\x10GetLoggerDetails\x12\x1f.gctrpc.GetLoggerDetailsRequest\x1a .gctrpc.GetLoggerDetailsResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/v1/getloggerdetails\x12v\n" +
 	"\x10SetLoggerDetails\x12\x1f.gctrpc.SetLoggerDetailsRequest\x1a .gctrpc.GetLoggerDetailsResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/v1/setloggerdetails\x12v\n" +
 	"\x10GetExchangePairs\x12\x1f.gctrpc.GetExchangePairsRequest\x1a .gctrpc.GetExchangePairsResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/v1/getexchangepairs\x12j\n" +
@@ -16422,7 +20672,9 @@ const file_rpc_proto_rawDesc = "" +
 	"\x12GetOrderbookStream\x12!.gctrpc.GetOrderbookStreamRequest\x1a\x19.gctrpc.OrderbookResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/v1/getorderbookstream0\x01\x12\x8c\x01\n" +
 	"\x1aGetExchangeOrderbookStream\x12).gctrpc.GetExchangeOrderbookStreamRequest\x1a\x19.gctrpc.OrderbookResponse\"&\x82\xd3\xe4\x93\x02 \x12\x1e/v1/getexchangeorderbookstream0\x01\x12h\n" +
 	"\x0fGetTickerStream\x12\x1e.gctrpc.GetTickerStreamRequest\x1a\x16.gctrpc.TickerResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/gettickerstream0\x01\x12\x80\x01\n" +
-	"\x17GetExchangeTickerStream\x12&.gctrpc.GetExchangeTickerStreamRequest\x1a\x16.gctrpc.TickerResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/v1/getexchangetickerstream0\x01\x12g\n" +
+	"\x17GetExchangeTickerStream\x12&.gctrpc.GetExchangeTickerStreamRequest\x1a\x16.gctrpc.TickerResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/v1/getexchangetickerstream0\x01\x12c\n" +
+	"\x0eGetOrderStream\x12\x1d.gctrpc.GetOrderStreamRequest\x1a\x14.gctrpc.OrderDetails\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/v1/getorderstream0\x01\x12\x9c\x01\n" +
+	"\x1cGetAggregatedOrderbookStream\x12+.gctrpc.GetAggregatedOrderbookStreamRequest\x1a#.gctrpc.AggregatedOrderbookResponse\"(\x82\xd3\xe4\x93\x02\"\x12 /v1/getaggregatedorderbookstream0\x01\x12g\n" +
 	"\rGetAuditEvent\x12\x1c.gctrpc.GetAuditEventRequest\x1a\x1d.gctrpc.GetAuditEventResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/getauditevent\x12k\n" +
 	"\x10GCTScriptExecute\x12\x1f.gctrpc.GCTScriptExecuteRequest\x1a\x17.gctrpc.GenericResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/v1/gctscript/execute\x12k\n" +
 	"\x0fGCTScriptUpload\x12\x1e.gctrpc.GCTScriptUploadRequest\x1a\x17.gctrpc.GenericResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/v1/gctscript/upload\x12x\n" +
@@ -16484,7 +20736,22 @@ const file_rpc_proto_rawDesc = "" +
 	"\vSetLeverage\x12\x1a.gctrpc.SetLeverageRequest\x1a\x1b.gctrpc.SetLeverageResponse\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/v1/getleverage\x12\x86\x01\n" +
 	"\x14ChangePositionMargin\x12#.gctrpc.ChangePositionMarginRequest\x1a$.gctrpc.ChangePositionMarginResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/changepositionmargin\x12o\n" +
 	"\x0fGetOpenInterest\x12\x1e.gctrpc.GetOpenInterestRequest\x1a\x1f.gctrpc.GetOpenInterestResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/getopeninterest\x12\x7f\n" +
-	"\x13GetCurrencyTradeURL\x12\".gctrpc.GetCurrencyTradeURLRequest\x1a#.gctrpc.GetCurrencyTradeURLResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/v1/getcurrencytradeurlB0Z.github.com/thrasher-corp/gocryptotrader/gctrpcb\x06proto3"
+	"\x13GetCurrencyTradeURL\x12\".gctrpc.GetCurrencyTradeURLRequest\x1a#.gctrpc.GetCurrencyTradeURLResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/v1/getcurrencytradeurl\x12w\n" +
+	"\x13AddConditionalOrder\x12\".gctrpc.AddConditionalOrderRequest\x1a\x18.gctrpc.ConditionalOrder\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/v1/addconditionalorder\x12\x83\x01\n" +
+	"\x14GetConditionalOrders\x12#.gctrpc.GetConditionalOrdersRequest\x1a$.gctrpc.GetConditionalOrdersResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/v1/getconditionalorders\x12\x80\x01\n" +
+	"\x16CancelConditionalOrder\x12%.gctrpc.CancelConditionalOrderRequest\x1a\x18.gctrpc.ConditionalOrder\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/v1/cancelconditionalorder\x12d\n" +
+	"\rSetKillSwitch\x12\x1c.gctrpc.SetKillSwitchRequest\x1a\x17.gctrpc.GenericResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/v1/setkillswitch\x12^\n" +
+	"\n" +
+	"RouteOrder\x12\x19.gctrpc.RouteOrderRequest\x1a\x1a.gctrpc.RouteOrderResponse\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/v1/routeorder\x12c\n" +
+	"\fGetLedgerPNL\x12\x1b.gctrpc.GetLedgerPNLRequest\x1a\x1c.gctrpc.GetLedgerPNLResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/getledgerpnl\x12g\n" +
+	"\rGetLedgerLots\x12\x1c.gctrpc.GetLedgerLotsRequest\x1a\x1d.gctrpc.GetLedgerLotsResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/getledgerlots\x12o\n" +
+	"\x0fExportLedgerCSV\x12\x1e.gctrpc.ExportLedgerCSVRequest\x1a\x1f.gctrpc.ExportLedgerCSVResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/exportledgercsv\x12p\n" +
+	"\x10PreviewRebalance\x12\x1f.gctrpc.PreviewRebalanceRequest\x1a\x1d.gctrpc.RebalancePlanResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/v1/previewrebalance\x12p\n" +
+	"\x10GetRebalancePlan\x12\x1f.gctrpc.GetRebalancePlanRequest\x1a\x1d.gctrpc.RebalancePlanResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/v1/getrebalanceplan\x12\x7f\n" +
+	"\x14ApproveRebalancePlan\x12#.gctrpc.ApproveRebalancePlanRequest\x1a\x1d.gctrpc.RebalancePlanResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/approverebalanceplan\x12\x84\x01\n" +
+	"\x15GetOrderbookAnalytics\x12$.gctrpc.GetOrderbookAnalyticsRequest\x1a\".gctrpc.OrderbookAnalyticsResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/v1/getorderbookanalytics\x12\x92\x01\n" +
+	"\x1bGetOrderbookAnalyticsStream\x12$.gctrpc.GetOrderbookAnalyticsRequest\x1a\".gctrpc.OrderbookAnalyticsResponse\"'\x82\xd3\xe4\x93\x02!\x12\x1f/v1/getorderbookanalyticsstream0\x01\x12\x88\x01\n" +
+	"\x19UntrackOrderbookAnalytics\x12(.gctrpc.UntrackOrderbookAnalyticsRequest\x1a\x17.gctrpc.GenericResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/v1/untrackorderbookanalyticsB0Z.github.com/thrasher-corp/gocryptotrader/gctrpcb\x06proto3"
 
 var (
 	file_rpc_proto_rawDescOnce sync.Once
@@ -16498,7 +20765,7 @@ func file_rpc_proto_rawDescGZIP() []byte {
 	return file_rpc_proto_rawDescData
 }
 
-var file_rpc_proto_msgTypes = make([]protoimpl.MessageInfo, 240)
+var file_rpc_proto_msgTypes = make([]protoimpl.MessageInfo, 288)
 var file_rpc_proto_goTypes = []any{
 	(*GetInfoRequest)(nil),                            // 0: gctrpc.GetInfoRequest
 	(*GetInfoResponse)(nil),                           // 1: gctrpc.GetInfoResponse
@@ -16575,181 +20842,229 @@ var file_rpc_proto_goTypes = []any{
 	(*CancelAllOrdersResponse)(nil),                   // 72: gctrpc.CancelAllOrdersResponse
 	(*GetEventsRequest)(nil),                          // 73: gctrpc.GetEventsRequest
 	(*ConditionParams)(nil),                           // 74: gctrpc.ConditionParams
-	(*GetEventsResponse)(nil),                         // 75: gctrpc.GetEventsResponse
-	(*AddEventRequest)(nil),                           // 76: gctrpc.AddEventRequest
-	(*AddEventResponse)(nil),                          // 77: gctrpc.AddEventResponse
-	(*RemoveEventRequest)(nil),                        // 78: gctrpc.RemoveEventRequest
-	(*GetCryptocurrencyDepositAddressesRequest)(nil),  // 79: gctrpc.GetCryptocurrencyDepositAddressesRequest
-	(*DepositAddress)(nil),                            // 80: gctrpc.DepositAddress
-	(*DepositAddresses)(nil),                          // 81: gctrpc.DepositAddresses
-	(*GetCryptocurrencyDepositAddressesResponse)(nil), // 82: gctrpc.GetCryptocurrencyDepositAddressesResponse
-	(*GetCryptocurrencyDepositAddressRequest)(nil),    // 83: gctrpc.GetCryptocurrencyDepositAddressRequest
-	(*GetCryptocurrencyDepositAddressResponse)(nil),   // 84: gctrpc.GetCryptocurrencyDepositAddressResponse
-	(*GetAvailableTransferChainsRequest)(nil),         // 85: gctrpc.GetAvailableTransferChainsRequest
-	(*GetAvailableTransferChainsResponse)(nil),        // 86: gctrpc.GetAvailableTransferChainsResponse
-	(*WithdrawFiatRequest)(nil),                       // 87: gctrpc.WithdrawFiatRequest
-	(*WithdrawCryptoRequest)(nil),                     // 88: gctrpc.WithdrawCryptoRequest
-	(*WithdrawResponse)(nil),                          // 89: gctrpc.WithdrawResponse
-	(*WithdrawalEventByIDRequest)(nil),                // 90: gctrpc.WithdrawalEventByIDRequest
-	(*WithdrawalEventByIDResponse)(nil),               // 91: gctrpc.WithdrawalEventByIDResponse
-	(*WithdrawalEventsByExchangeRequest)(nil),         // 92: gctrpc.WithdrawalEventsByExchangeRequest
-	(*WithdrawalEventsByDateRequest)(nil),             // 93: gctrpc.WithdrawalEventsByDateRequest
-	(*WithdrawalEventsByExchangeResponse)(nil),        // 94: gctrpc.WithdrawalEventsByExchangeResponse
-	(*WithdrawalEventResponse)(nil),                   // 95: gctrpc.WithdrawalEventResponse
-	(*WithdrawlExchangeEvent)(nil),                    // 96: gctrpc.WithdrawlExchangeEvent
-	(*WithdrawalRequestEvent)(nil),                    // 97: gctrpc.WithdrawalRequestEvent
-	(*FiatWithdrawalEvent)(nil),                       // 98: gctrpc.FiatWithdrawalEvent
-	(*CryptoWithdrawalEvent)(nil),                     // 99: gctrpc.CryptoWithdrawalEvent
-	(*GetLoggerDetailsRequest)(nil),                   // 100: gctrpc.GetLoggerDetailsRequest
-	(*GetLoggerDetailsResponse)(nil),                  // 101: gctrpc.GetLoggerDetailsResponse
-	(*SetLoggerDetailsRequest)(nil),                   // 102: gctrpc.SetLoggerDetailsRequest
-	(*GetExchangePairsRequest)(nil),                   // 103: gctrpc.GetExchangePairsRequest
-	(*GetExchangePairsResponse)(nil),                  // 104: gctrpc.GetExchangePairsResponse
-	(*SetExchangePairRequest)(nil),                    // 105: gctrpc.SetExchangePairRequest
-	(*GetOrderbookStreamRequest)(nil),                 // 106: gctrpc.GetOrderbookStreamRequest
-	(*GetExchangeOrderbookStreamRequest)(nil),         // 107: gctrpc.GetExchangeOrderbookStreamRequest
-	(*GetTickerStreamRequest)(nil),                    // 108: gctrpc.GetTickerStreamRequest
-	(*GetExchangeTickerStreamRequest)(nil),            // 109: gctrpc.GetExchangeTickerStreamRequest
-	(*GetAuditEventRequest)(nil),                      // 110: gctrpc.GetAuditEventRequest
-	(*GetAuditEventResponse)(nil),                     // 111: gctrpc.GetAuditEventResponse
-	(*GetSavedTradesRequest)(nil),                     // 112: gctrpc.GetSavedTradesRequest
-	(*SavedTrades)(nil),                               // 113: gctrpc.SavedTrades
-	(*SavedTradesResponse)(nil),                       // 114: gctrpc.SavedTradesResponse
-	(*ConvertTradesToCandlesRequest)(nil),             // 115: gctrpc.ConvertTradesToCandlesRequest
-	(*GetHistoricCandlesRequest)(nil),                 // 116: gctrpc.GetHistoricCandlesRequest
-	(*GetHistoricCandlesResponse)(nil),                // 117: gctrpc.GetHistoricCandlesResponse
-	(*Candle)(nil),                                    // 118: gctrpc.Candle
-	(*AuditEvent)(nil),                                // 119: gctrpc.AuditEvent
-	(*GCTScript)(nil),                                 // 120: gctrpc.GCTScript
-	(*GCTScriptExecuteRequest)(nil),                   // 121: gctrpc.GCTScriptExecuteRequest
-	(*GCTScriptStopRequest)(nil),                      // 122: gctrpc.GCTScriptStopRequest
-	(*GCTScriptStopAllRequest)(nil),                   // 123: gctrpc.GCTScriptStopAllRequest
-	(*GCTScriptStatusRequest)(nil),                    // 124: gctrpc.GCTScriptStatusRequest
-	(*GCTScriptListAllRequest)(nil),                   // 125: gctrpc.GCTScriptListAllRequest
-	(*GCTScriptUploadRequest)(nil),                    // 126: gctrpc.GCTScriptUploadRequest
-	(*GCTScriptReadScriptRequest)(nil),                // 127: gctrpc.GCTScriptReadScriptRequest
-	(*GCTScriptQueryRequest)(nil),                     // 128: gctrpc.GCTScriptQueryRequest
-	(*GCTScriptAutoLoadRequest)(nil),                  // 129: gctrpc.GCTScriptAutoLoadRequest
-	(*GCTScriptStatusResponse)(nil),                   // 130: gctrpc.GCTScriptStatusResponse
-	(*GCTScriptQueryResponse)(nil),                    // 131: gctrpc.GCTScriptQueryResponse
-	(*GenericResponse)(nil),                           // 132: gctrpc.GenericResponse
-	(*SetExchangeAssetRequest)(nil),                   // 133: gctrpc.SetExchangeAssetRequest
-	(*SetExchangeAllPairsRequest)(nil),                // 134: gctrpc.SetExchangeAllPairsRequest
-	(*UpdateExchangeSupportedPairsRequest)(nil),       // 135: gctrpc.UpdateExchangeSupportedPairsRequest
-	(*GetExchangeAssetsRequest)(nil),                  // 136: gctrpc.GetExchangeAssetsRequest
-	(*GetExchangeAssetsResponse)(nil),                 // 137: gctrpc.GetExchangeAssetsResponse
-	(*WebsocketGetInfoRequest)(nil),                   // 138: gctrpc.WebsocketGetInfoRequest
-	(*WebsocketGetInfoResponse)(nil),                  // 139: gctrpc.WebsocketGetInfoResponse
-	(*WebsocketSetEnabledRequest)(nil),                // 140: gctrpc.WebsocketSetEnabledRequest
-	(*WebsocketGetSubscriptionsRequest)(nil),          // 141: gctrpc.WebsocketGetSubscriptionsRequest
-	(*WebsocketSubscription)(nil),                     // 142: gctrpc.WebsocketSubscription
-	(*WebsocketGetSubscriptionsResponse)(nil),         // 143: gctrpc.WebsocketGetSubscriptionsResponse
-	(*WebsocketSetProxyRequest)(nil),                  // 144: gctrpc.WebsocketSetProxyRequest
-	(*WebsocketSetURLRequest)(nil),                    // 145: gctrpc.WebsocketSetURLRequest
-	(*FindMissingCandlePeriodsRequest)(nil),           // 146: gctrpc.FindMissingCandlePeriodsRequest
-	(*FindMissingTradePeriodsRequest)(nil),            // 147: gctrpc.FindMissingTradePeriodsRequest
-	(*FindMissingIntervalsResponse)(nil),              // 148: gctrpc.FindMissingIntervalsResponse
-	(*SetExchangeTradeProcessingRequest)(nil),         // 149: gctrpc.SetExchangeTradeProcessingRequest
-	(*UpsertDataHistoryJobRequest)(nil),               // 150: gctrpc.UpsertDataHistoryJobRequest
-	(*InsertSequentialJobsRequest)(nil),               // 151: gctrpc.InsertSequentialJobsRequest
-	(*InsertSequentialJobsResponse)(nil),              // 152: gctrpc.InsertSequentialJobsResponse
-	(*UpsertDataHistoryJobResponse)(nil),              // 153: gctrpc.UpsertDataHistoryJobResponse
-	(*GetDataHistoryJobDetailsRequest)(nil),           // 154: gctrpc.GetDataHistoryJobDetailsRequest
-	(*DataHistoryJob)(nil),                            // 155: gctrpc.DataHistoryJob
-	(*DataHistoryJobResult)(nil),                      // 156: gctrpc.DataHistoryJobResult
-	(*DataHistoryJobs)(nil),                           // 157: gctrpc.DataHistoryJobs
-	(*GetDataHistoryJobsBetweenRequest)(nil),          // 158: gctrpc.GetDataHistoryJobsBetweenRequest
-	(*SetDataHistoryJobStatusRequest)(nil),            // 159: gctrpc.SetDataHistoryJobStatusRequest
-	(*UpdateDataHistoryJobPrerequisiteRequest)(nil),   // 160: gctrpc.UpdateDataHistoryJobPrerequisiteRequest
-	(*ModifyOrderRequest)(nil),                        // 161: gctrpc.ModifyOrderRequest
-	(*ModifyOrderResponse)(nil),                       // 162: gctrpc.ModifyOrderResponse
-	(*CurrencyStateGetAllRequest)(nil),                // 163: gctrpc.CurrencyStateGetAllRequest
-	(*CurrencyStateTradingRequest)(nil),               // 164: gctrpc.CurrencyStateTradingRequest
-	(*CurrencyStateTradingPairRequest)(nil),           // 165: gctrpc.CurrencyStateTradingPairRequest
-	(*CurrencyStateWithdrawRequest)(nil),              // 166: gctrpc.CurrencyStateWithdrawRequest
-	(*CurrencyStateDepositRequest)(nil),               // 167: gctrpc.CurrencyStateDepositRequest
-	(*CurrencyStateResponse)(nil),                     // 168: gctrpc.CurrencyStateResponse
-	(*CurrencyState)(nil),                             // 169: gctrpc.CurrencyState
-	(*FundingRate)(nil),                               // 170: gctrpc.FundingRate
-	(*FundingData)(nil),                               // 171: gctrpc.FundingData
-	(*FuturesPositionStats)(nil),                      // 172: gctrpc.FuturesPositionStats
-	(*FuturePosition)(nil),                            // 173: gctrpc.FuturePosition
-	(*GetManagedPositionRequest)(nil),                 // 174: gctrpc.GetManagedPositionRequest
-	(*GetAllManagedPositionsRequest)(nil),             // 175: gctrpc.GetAllManagedPositionsRequest
-	(*GetManagedPositionsResponse)(nil),               // 176: gctrpc.GetManagedPositionsResponse
-	(*GetFuturesPositionsSummaryRequest)(nil),         // 177: gctrpc.GetFuturesPositionsSummaryRequest
-	(*GetFuturesPositionsSummaryResponse)(nil),        // 178: gctrpc.GetFuturesPositionsSummaryResponse
-	(*GetFuturesPositionsOrdersRequest)(nil),          // 179: gctrpc.GetFuturesPositionsOrdersRequest
-	(*GetFuturesPositionsOrdersResponse)(nil),         // 180: gctrpc.GetFuturesPositionsOrdersResponse
-	(*GetCollateralModeRequest)(nil),                  // 181: gctrpc.GetCollateralModeRequest
-	(*GetCollateralModeResponse)(nil),                 // 182: gctrpc.GetCollateralModeResponse
-	(*SetCollateralModeRequest)(nil),                  // 183: gctrpc.SetCollateralModeRequest
-	(*SetCollateralModeResponse)(nil),                 // 184: gctrpc.SetCollateralModeResponse
-	(*GetMarginTypeRequest)(nil),                      // 185: gctrpc.GetMarginTypeRequest
-	(*GetMarginTypeResponse)(nil),                     // 186: gctrpc.GetMarginTypeResponse
-	(*ChangePositionMarginRequest)(nil),               // 187: gctrpc.ChangePositionMarginRequest
-	(*ChangePositionMarginResponse)(nil),              // 188: gctrpc.ChangePositionMarginResponse
-	(*SetMarginTypeRequest)(nil),                      // 189: gctrpc.SetMarginTypeRequest
-	(*SetMarginTypeResponse)(nil),                     // 190: gctrpc.SetMarginTypeResponse
-	(*GetLeverageRequest)(nil),                        // 191: gctrpc.GetLeverageRequest
-	(*GetLeverageResponse)(nil),                       // 192: gctrpc.GetLeverageResponse
-	(*SetLeverageRequest)(nil),                        // 193: gctrpc.SetLeverageRequest
-	(*SetLeverageResponse)(nil),                       // 194: gctrpc.SetLeverageResponse
-	(*GetCollateralRequest)(nil),                      // 195: gctrpc.GetCollateralRequest
-	(*GetCollateralResponse)(nil),                     // 196: gctrpc.GetCollateralResponse
-	(*CollateralForCurrency)(nil),                     // 197: gctrpc.CollateralForCurrency
-	(*CollateralByPosition)(nil),                      // 198: gctrpc.CollateralByPosition
-	(*CollateralUsedBreakdown)(nil),                   // 199: gctrpc.CollateralUsedBreakdown
-	(*GetFundingRatesRequest)(nil),                    // 200: gctrpc.GetFundingRatesRequest
-	(*GetFundingRatesResponse)(nil),                   // 201: gctrpc.GetFundingRatesResponse
-	(*GetLatestFundingRateRequest)(nil),               // 202: gctrpc.GetLatestFundingRateRequest
-	(*GetLatestFundingRateResponse)(nil),              // 203: gctrpc.GetLatestFundingRateResponse
-	(*ShutdownRequest)(nil),                           // 204: gctrpc.ShutdownRequest
-	(*ShutdownResponse)(nil),                          // 205: gctrpc.ShutdownResponse
-	(*GetTechnicalAnalysisRequest)(nil),               // 206: gctrpc.GetTechnicalAnalysisRequest
-	(*ListOfSignals)(nil),                             // 207: gctrpc.ListOfSignals
-	(*GetTechnicalAnalysisResponse)(nil),              // 208: gctrpc.GetTechnicalAnalysisResponse
-	(*GetMarginRatesHistoryRequest)(nil),              // 209: gctrpc.GetMarginRatesHistoryRequest
-	(*LendingPayment)(nil),                            // 210: gctrpc.LendingPayment
-	(*BorrowCost)(nil),                                // 211: gctrpc.BorrowCost
-	(*MarginRate)(nil),                                // 212: gctrpc.MarginRate
-	(*GetMarginRatesHistoryResponse)(nil),             // 213: gctrpc.GetMarginRatesHistoryResponse
-	(*GetOrderbookMovementRequest)(nil),               // 214: gctrpc.GetOrderbookMovementRequest
-	(*GetOrderbookMovementResponse)(nil),              // 215: gctrpc.GetOrderbookMovementResponse
-	(*GetOrderbookAmountByNominalRequest)(nil),        // 216: gctrpc.GetOrderbookAmountByNominalRequest
-	(*GetOrderbookAmountByNominalResponse)(nil),       // 217: gctrpc.GetOrderbookAmountByNominalResponse
-	(*GetOrderbookAmountByImpactRequest)(nil),         // 218: gctrpc.GetOrderbookAmountByImpactRequest
-	(*GetOrderbookAmountByImpactResponse)(nil),        // 219: gctrpc.GetOrderbookAmountByImpactResponse
-	(*GetOpenInterestRequest)(nil),                    // 220: gctrpc.GetOpenInterestRequest
-	(*OpenInterestDataRequest)(nil),                   // 221: gctrpc.OpenInterestDataRequest
-	(*GetOpenInterestResponse)(nil),                   // 222: gctrpc.GetOpenInterestResponse
-	(*OpenInterestDataResponse)(nil),                  // 223: gctrpc.OpenInterestDataResponse
-	(*GetCurrencyTradeURLRequest)(nil),                // 224: gctrpc.GetCurrencyTradeURLRequest
-	(*GetCurrencyTradeURLResponse)(nil),               // 225: gctrpc.GetCurrencyTradeURLResponse
-	nil,                                               // 226: gctrpc.GetInfoResponse.SubsystemStatusEntry
-	nil,                                               // 227: gctrpc.GetInfoResponse.RpcEndpointsEntry
-	nil,                                               // 228: gctrpc.GetCommunicationRelayersResponse.CommunicationRelayersEntry
-	nil,                                               // 229: gctrpc.GetSusbsytemsResponse.SubsystemsStatusEntry
-	nil,                                               // 230: gctrpc.GetRPCEndpointsResponse.EndpointsEntry
-	nil,                                               // 231: gctrpc.GetExchangeOTPsResponse.OtpCodesEntry
-	nil,                                               // 232: gctrpc.GetExchangeInfoResponse.SupportedAssetsEntry
-	nil,                                               // 233: gctrpc.OnlineCoins.CoinsEntry
-	nil,                                               // 234: gctrpc.GetPortfolioSummaryResponse.CoinsOfflineSummaryEntry
-	nil,                                               // 235: gctrpc.GetPortfolioSummaryResponse.CoinsOnlineSummaryEntry
-	nil,                                               // 236: gctrpc.Orders.OrderStatusEntry
-	nil,                                               // 237: gctrpc.GetCryptocurrencyDepositAddressesResponse.AddressesEntry
-	nil,                                               // 238: gctrpc.GetExchangePairsResponse.SupportedAssetsEntry
-	nil,                                               // 239: gctrpc.GetTechnicalAnalysisResponse.SignalsEntry
-	(*timestamppb.Timestamp)(nil),                     // 240: google.protobuf.Timestamp
+	(*EventCondition)(nil),                            // 75: gctrpc.EventCondition
+	(*EventAction)(nil),                               // 76: gctrpc.EventAction
+	(*EventDetails)(nil),                              // 77: gctrpc.EventDetails
+	(*GetEventsResponse)(nil),                         // 78: gctrpc.GetEventsResponse
+	(*AddEventRequest)(nil),                           // 79: gctrpc.AddEventRequest
+	(*AddEventResponse)(nil),                          // 80: gctrpc.AddEventResponse
+	(*RemoveEventRequest)(nil),                        // 81: gctrpc.RemoveEventRequest
+	(*GetCryptocurrencyDepositAddressesRequest)(nil),  // 82: gctrpc.GetCryptocurrencyDepositAddressesRequest
+	(*DepositAddress)(nil),                            // 83: gctrpc.DepositAddress
+	(*DepositAddresses)(nil),                          // 84: gctrpc.DepositAddresses
+	(*GetCryptocurrencyDepositAddressesResponse)(nil), // 85: gctrpc.GetCryptocurrencyDepositAddressesResponse
+	(*GetCryptocurrencyDepositAddressRequest)(nil),    // 86: gctrpc.GetCryptocurrencyDepositAddressRequest
+	(*GetCryptocurrencyDepositAddressResponse)(nil),   // 87: gctrpc.GetCryptocurrencyDepositAddressResponse
+	(*GetAvailableTransferChainsRequest)(nil),         // 88: gctrpc.GetAvailableTransferChainsRequest
+	(*GetAvailableTransferChainsResponse)(nil),        // 89: gctrpc.GetAvailableTransferChainsResponse
+	(*WithdrawFiatRequest)(nil),                       // 90: gctrpc.WithdrawFiatRequest
+	(*WithdrawCryptoRequest)(nil),                     // 91: gctrpc.WithdrawCryptoRequest
+	(*WithdrawResponse)(nil),                          // 92: gctrpc.WithdrawResponse
+	(*WithdrawalEventByIDRequest)(nil),                // 93: gctrpc.WithdrawalEventByIDRequest
+	(*WithdrawalEventByIDResponse)(nil),               // 94: gctrpc.WithdrawalEventByIDResponse
+	(*WithdrawalEventsByExchangeRequest)(nil),         // 95: gctrpc.WithdrawalEventsByExchangeRequest
+	(*WithdrawalEventsByDateRequest)(nil),             // 96: gctrpc.WithdrawalEventsByDateRequest
+	(*WithdrawalEventsByExchangeResponse)(nil),        // 97: gctrpc.WithdrawalEventsByExchangeResponse
+	(*WithdrawalEventResponse)(nil),                   // 98: gctrpc.WithdrawalEventResponse
+	(*WithdrawlExchangeEvent)(nil),                    // 99: gctrpc.WithdrawlExchangeEvent
+	(*WithdrawalRequestEvent)(nil),                    // 100: gctrpc.WithdrawalRequestEvent
+	(*FiatWithdrawalEvent)(nil),                       // 101: gctrpc.FiatWithdrawalEvent
+	(*CryptoWithdrawalEvent)(nil),                     // 102: gctrpc.CryptoWithdrawalEvent
+	(*GetPendingWithdrawalsRequest)(nil),              // 103: gctrpc.GetPendingWithdrawalsRequest
+	(*WithdrawalApproval)(nil),                        // 104: gctrpc.WithdrawalApproval
+	(*PendingWithdrawal)(nil),                         // 105: gctrpc.PendingWithdrawal
+	(*GetPendingWithdrawalsResponse)(nil),             // 106: gctrpc.GetPendingWithdrawalsResponse
+	(*ApproveWithdrawalRequest)(nil),                  // 107: gctrpc.ApproveWithdrawalRequest
+	(*RejectWithdrawalRequest)(nil),                   // 108: gctrpc.RejectWithdrawalRequest
+	(*GetLoggerDetailsRequest)(nil),                   // 109: gctrpc.GetLoggerDetailsRequest
+	(*GetLoggerDetailsResponse)(nil),                  // 110: gctrpc.GetLoggerDetailsResponse
+	(*SetLoggerDetailsRequest)(nil),                   // 111: gctrpc.SetLoggerDetailsRequest
+	(*GetExchangePairsRequest)(nil),                   // 112: gctrpc.GetExchangePairsRequest
+	(*GetExchangePairsResponse)(nil),                  // 113: gctrpc.GetExchangePairsResponse
+	(*SetExchangePairRequest)(nil),                    // 114: gctrpc.SetExchangePairRequest
+	(*GetOrderbookStreamRequest)(nil),                 // 115: gctrpc.GetOrderbookStreamRequest
+	(*GetExchangeOrderbookStreamRequest)(nil),         // 116: gctrpc.GetExchangeOrderbookStreamRequest
+	(*GetTickerStreamRequest)(nil),                    // 117: gctrpc.GetTickerStreamRequest
+	(*GetExchangeTickerStreamRequest)(nil),            // 118: gctrpc.GetExchangeTickerStreamRequest
+	(*GetOrderStreamRequest)(nil),                     // 119: gctrpc.GetOrderStreamRequest
+	(*AggregatedOrderbookSource)(nil),                 // 120: gctrpc.AggregatedOrderbookSource
+	(*GetAggregatedOrderbookStreamRequest)(nil),       // 121: gctrpc.GetAggregatedOrderbookStreamRequest
+	(*AggregatedOrderbookLevelSource)(nil),            // 122: gctrpc.AggregatedOrderbookLevelSource
+	(*AggregatedOrderbookLevel)(nil),                  // 123: gctrpc.AggregatedOrderbookLevel
+	(*AggregatedOrderbookResponse)(nil),               // 124: gctrpc.AggregatedOrderbookResponse
+	(*GetAuditEventRequest)(nil),                      // 125: gctrpc.GetAuditEventRequest
+	(*GetAuditEventResponse)(nil),                     // 126: gctrpc.GetAuditEventResponse
+	(*GetSavedTradesRequest)(nil),                     // 127: gctrpc.GetSavedTradesRequest
+	(*SavedTrades)(nil),                               // 128: gctrpc.SavedTrades
+	(*SavedTradesResponse)(nil),                       // 129: gctrpc.SavedTradesResponse
+	(*ConvertTradesToCandlesRequest)(nil),             // 130: gctrpc.ConvertTradesToCandlesRequest
+	(*GetHistoricCandlesRequest)(nil),                 // 131: gctrpc.GetHistoricCandlesRequest
+	(*GetHistoricCandlesResponse)(nil),                // 132: gctrpc.GetHistoricCandlesResponse
+	(*Candle)(nil),                                    // 133: gctrpc.Candle
+	(*AuditEvent)(nil),                                // 134: gctrpc.AuditEvent
+	(*GCTScript)(nil),                                 // 135: gctrpc.GCTScript
+	(*GCTScriptExecuteRequest)(nil),                   // 136: gctrpc.GCTScriptExecuteRequest
+	(*GCTScriptStopRequest)(nil),                      // 137: gctrpc.GCTScriptStopRequest
+	(*GCTScriptStopAllRequest)(nil),                   // 138: gctrpc.GCTScriptStopAllRequest
+	(*GCTScriptStatusRequest)(nil),                    // 139: gctrpc.GCTScriptStatusRequest
+	(*GCTScriptListAllRequest)(nil),                   // 140: gctrpc.GCTScriptListAllRequest
+	(*GCTScriptUploadRequest)(nil),                    // 141: gctrpc.GCTScriptUploadRequest
+	(*GCTScriptReadScriptRequest)(nil),                // 142: gctrpc.GCTScriptReadScriptRequest
+	(*GCTScriptQueryRequest)(nil),                     // 143: gctrpc.GCTScriptQueryRequest
+	(*GCTScriptAutoLoadRequest)(nil),                  // 144: gctrpc.GCTScriptAutoLoadRequest
+	(*GCTScriptStatusResponse)(nil),                   // 145: gctrpc.GCTScriptStatusResponse
+	(*GCTScriptQueryResponse)(nil),                    // 146: gctrpc.GCTScriptQueryResponse
+	(*GenericResponse)(nil),                           // 147: gctrpc.GenericResponse
+	(*SetExchangeAssetRequest)(nil),                   // 148: gctrpc.SetExchangeAssetRequest
+	(*SetExchangeAllPairsRequest)(nil),                // 149: gctrpc.SetExchangeAllPairsRequest
+	(*UpdateExchangeSupportedPairsRequest)(nil),       // 150: gctrpc.UpdateExchangeSupportedPairsRequest
+	(*GetExchangeAssetsRequest)(nil),                  // 151: gctrpc.GetExchangeAssetsRequest
+	(*GetExchangeAssetsResponse)(nil),                 // 152: gctrpc.GetExchangeAssetsResponse
+	(*WebsocketGetInfoRequest)(nil),                   // 153: gctrpc.WebsocketGetInfoRequest
+	(*WebsocketGetInfoResponse)(nil),                  // 154: gctrpc.WebsocketGetInfoResponse
+	(*WebsocketSetEnabledRequest)(nil),                // 155: gctrpc.WebsocketSetEnabledRequest
+	(*WebsocketGetSubscriptionsRequest)(nil),          // 156: gctrpc.WebsocketGetSubscriptionsRequest
+	(*WebsocketSubscription)(nil),                     // 157: gctrpc.WebsocketSubscription
+	(*WebsocketGetSubscriptionsResponse)(nil),         // 158: gctrpc.WebsocketGetSubscriptionsResponse
+	(*WebsocketSetProxyRequest)(nil),                  // 159: gctrpc.WebsocketSetProxyRequest
+	(*WebsocketSetURLRequest)(nil),                    // 160: gctrpc.WebsocketSetURLRequest
+	(*FindMissingCandlePeriodsRequest)(nil),           // 161: gctrpc.FindMissingCandlePeriodsRequest
+	(*FindMissingTradePeriodsRequest)(nil),            // 162: gctrpc.FindMissingTradePeriodsRequest
+	(*FindMissingIntervalsResponse)(nil),              // 163: gctrpc.FindMissingIntervalsResponse
+	(*SetExchangeTradeProcessingRequest)(nil),         // 164: gctrpc.SetExchangeTradeProcessingRequest
+	(*UpsertDataHistoryJobRequest)(nil),               // 165: gctrpc.UpsertDataHistoryJobRequest
+	(*InsertSequentialJobsRequest)(nil),               // 166: gctrpc.InsertSequentialJobsRequest
+	(*InsertSequentialJobsResponse)(nil),              // 167: gctrpc.InsertSequentialJobsResponse
+	(*UpsertDataHistoryJobResponse)(nil),              // 168: gctrpc.UpsertDataHistoryJobResponse
+	(*GetDataHistoryJobDetailsRequest)(nil),           // 169: gctrpc.GetDataHistoryJobDetailsRequest
+	(*DataHistoryJob)(nil),                            // 170: gctrpc.DataHistoryJob
+	(*DataHistoryJobResult)(nil),                      // 171: gctrpc.DataHistoryJobResult
+	(*DataHistoryJobs)(nil),                           // 172: gctrpc.DataHistoryJobs
+	(*GetDataHistoryJobsBetweenRequest)(nil),          // 173: gctrpc.GetDataHistoryJobsBetweenRequest
+	(*SetDataHistoryJobStatusRequest)(nil),            // 174: gctrpc.SetDataHistoryJobStatusRequest
+	(*UpdateDataHistoryJobPrerequisiteRequest)(nil),   // 175: gctrpc.UpdateDataHistoryJobPrerequisiteRequest
+	(*ModifyOrderRequest)(nil),                        // 176: gctrpc.ModifyOrderRequest
+	(*ModifyOrderResponse)(nil),                       // 177: gctrpc.ModifyOrderResponse
+	(*CurrencyStateGetAllRequest)(nil),                // 178: gctrpc.CurrencyStateGetAllRequest
+	(*CurrencyStateTradingRequest)(nil),               // 179: gctrpc.CurrencyStateTradingRequest
+	(*CurrencyStateTradingPairRequest)(nil),           // 180: gctrpc.CurrencyStateTradingPairRequest
+	(*CurrencyStateWithdrawRequest)(nil),              // 181: gctrpc.CurrencyStateWithdrawRequest
+	(*CurrencyStateDepositRequest)(nil),               // 182: gctrpc.CurrencyStateDepositRequest
+	(*CurrencyStateResponse)(nil),                     // 183: gctrpc.CurrencyStateResponse
+	(*CurrencyState)(nil),                             // 184: gctrpc.CurrencyState
+	(*FundingRate)(nil),                               // 185: gctrpc.FundingRate
+	(*FundingData)(nil),                               // 186: gctrpc.FundingData
+	(*FuturesPositionStats)(nil),                      // 187: gctrpc.FuturesPositionStats
+	(*FuturePosition)(nil),                            // 188: gctrpc.FuturePosition
+	(*GetManagedPositionRequest)(nil),                 // 189: gctrpc.GetManagedPositionRequest
+	(*GetAllManagedPositionsRequest)(nil),             // 190: gctrpc.GetAllManagedPositionsRequest
+	(*GetManagedPositionsResponse)(nil),               // 191: gctrpc.GetManagedPositionsResponse
+	(*GetFuturesPositionsSummaryRequest)(nil),         // 192: gctrpc.GetFuturesPositionsSummaryRequest
+	(*GetFuturesPositionsSummaryResponse)(nil),        // 193: gctrpc.GetFuturesPositionsSummaryResponse
+	(*GetFuturesPositionsOrdersRequest)(nil),          // 194: gctrpc.GetFuturesPositionsOrdersRequest
+	(*GetFuturesPositionsOrdersResponse)(nil),         // 195: gctrpc.GetFuturesPositionsOrdersResponse
+	(*GetCollateralModeRequest)(nil),                  // 196: gctrpc.GetCollateralModeRequest
+	(*GetCollateralModeResponse)(nil),                 // 197: gctrpc.GetCollateralModeResponse
+	(*SetCollateralModeRequest)(nil),                  // 198: gctrpc.SetCollateralModeRequest
+	(*SetCollateralModeResponse)(nil),                 // 199: gctrpc.SetCollateralModeResponse
+	(*GetMarginTypeRequest)(nil),                      // 200: gctrpc.GetMarginTypeRequest
+	(*GetMarginTypeResponse)(nil),                     // 201: gctrpc.GetMarginTypeResponse
+	(*ChangePositionMarginRequest)(nil),               // 202: gctrpc.ChangePositionMarginRequest
+	(*ChangePositionMarginResponse)(nil),              // 203: gctrpc.ChangePositionMarginResponse
+	(*SetMarginTypeRequest)(nil),                      // 204: gctrpc.SetMarginTypeRequest
+	(*SetMarginTypeResponse)(nil),                     // 205: gctrpc.SetMarginTypeResponse
+	(*GetLeverageRequest)(nil),                        // 206: gctrpc.GetLeverageRequest
+	(*GetLeverageResponse)(nil),                       // 207: gctrpc.GetLeverageResponse
+	(*SetLeverageRequest)(nil),                        // 208: gctrpc.SetLeverageRequest
+	(*SetLeverageResponse)(nil),                       // 209: gctrpc.SetLeverageResponse
+	(*GetCollateralRequest)(nil),                      // 210: gctrpc.GetCollateralRequest
+	(*GetCollateralResponse)(nil),                     // 211: gctrpc.GetCollateralResponse
+	(*CollateralForCurrency)(nil),                     // 212: gctrpc.CollateralForCurrency
+	(*CollateralByPosition)(nil),                      // 213: gctrpc.CollateralByPosition
+	(*CollateralUsedBreakdown)(nil),                   // 214: gctrpc.CollateralUsedBreakdown
+	(*GetFundingRatesRequest)(nil),                    // 215: gctrpc.GetFundingRatesRequest
+	(*GetFundingRatesResponse)(nil),                   // 216: gctrpc.GetFundingRatesResponse
+	(*GetLatestFundingRateRequest)(nil),               // 217: gctrpc.GetLatestFundingRateRequest
+	(*GetLatestFundingRateResponse)(nil),              // 218: gctrpc.GetLatestFundingRateResponse
+	(*ShutdownRequest)(nil),                           // 219: gctrpc.ShutdownRequest
+	(*ShutdownResponse)(nil),                          // 220: gctrpc.ShutdownResponse
+	(*GetTechnicalAnalysisRequest)(nil),               // 221: gctrpc.GetTechnicalAnalysisRequest
+	(*ListOfSignals)(nil),                             // 222: gctrpc.ListOfSignals
+	(*GetTechnicalAnalysisResponse)(nil),              // 223: gctrpc.GetTechnicalAnalysisResponse
+	(*GetMarginRatesHistoryRequest)(nil),              // 224: gctrpc.GetMarginRatesHistoryRequest
+	(*LendingPayment)(nil),                            // 225: gctrpc.LendingPayment
+	(*BorrowCost)(nil),                                // 226: gctrpc.BorrowCost
+	(*MarginRate)(nil),                                // 227: gctrpc.MarginRate
+	(*GetMarginRatesHistoryResponse)(nil),             // 228: gctrpc.GetMarginRatesHistoryResponse
+	(*GetOrderbookMovementRequest)(nil),               // 229: gctrpc.GetOrderbookMovementRequest
+	(*GetOrderbookMovementResponse)(nil),              // 230: gctrpc.GetOrderbookMovementResponse
+	(*GetOrderbookAmountByNominalRequest)(nil),        // 231: gctrpc.GetOrderbookAmountByNominalRequest
+	(*GetOrderbookAmountByNominalResponse)(nil),       // 232: gctrpc.GetOrderbookAmountByNominalResponse
+	(*GetOrderbookAmountByImpactRequest)(nil),         // 233: gctrpc.GetOrderbookAmountByImpactRequest
+	(*GetOrderbookAmountByImpactResponse)(nil),        // 234: gctrpc.GetOrderbookAmountByImpactResponse
+	(*GetOpenInterestRequest)(nil),                    // 235: gctrpc.GetOpenInterestRequest
+	(*OpenInterestDataRequest)(nil),                   // 236: gctrpc.OpenInterestDataRequest
+	(*GetOpenInterestResponse)(nil),                   // 237: gctrpc.GetOpenInterestResponse
+	(*OpenInterestDataResponse)(nil),                  // 238: gctrpc.OpenInterestDataResponse
+	(*GetCurrencyTradeURLRequest)(nil),                // 239: gctrpc.GetCurrencyTradeURLRequest
+	(*GetCurrencyTradeURLResponse)(nil),               // 240: gctrpc.GetCurrencyTradeURLResponse
+	(*ConditionalOrder)(nil),                          // 241: gctrpc.ConditionalOrder
+	(*AddConditionalOrderRequest)(nil),                // 242: gctrpc.AddConditionalOrderRequest
+	(*GetConditionalOrdersRequest)(nil),               // 243: gctrpc.GetConditionalOrdersRequest
+	(*GetConditionalOrdersResponse)(nil),              // 244: gctrpc.GetConditionalOrdersResponse
+	(*CancelConditionalOrderRequest)(nil),             // 245: gctrpc.CancelConditionalOrderRequest
+	(*SetKillSwitchRequest)(nil),                      // 246: gctrpc.SetKillSwitchRequest
+	(*RouteOrderRequest)(nil),                         // 247: gctrpc.RouteOrderRequest
+	(*RouteOrderLeg)(nil),                             // 248: gctrpc.RouteOrderLeg
+	(*RouteOrderSkipped)(nil),                         // 249: gctrpc.RouteOrderSkipped
+	(*RouteOrderResponse)(nil),                        // 250: gctrpc.RouteOrderResponse
+	(*GetLedgerPNLRequest)(nil),                       // 251: gctrpc.GetLedgerPNLRequest
+	(*LedgerCurrencyPNL)(nil),                         // 252: gctrpc.LedgerCurrencyPNL
+	(*GetLedgerPNLResponse)(nil),                      // 253: gctrpc.GetLedgerPNLResponse
+	(*GetLedgerLotsRequest)(nil),                      // 254: gctrpc.GetLedgerLotsRequest
+	(*LedgerDisposal)(nil),                            // 255: gctrpc.LedgerDisposal
+	(*LedgerLot)(nil),                                 // 256: gctrpc.LedgerLot
+	(*GetLedgerLotsResponse)(nil),                     // 257: gctrpc.GetLedgerLotsResponse
+	(*ExportLedgerCSVRequest)(nil),                    // 258: gctrpc.ExportLedgerCSVRequest
+	(*ExportLedgerCSVResponse)(nil),                   // 259: gctrpc.ExportLedgerCSVResponse
+	(*PreviewRebalanceRequest)(nil),                   // 260: gctrpc.PreviewRebalanceRequest
+	(*GetRebalancePlanRequest)(nil),                   // 261: gctrpc.GetRebalancePlanRequest
+	(*ApproveRebalancePlanRequest)(nil),               // 262: gctrpc.ApproveRebalancePlanRequest
+	(*RebalanceAllocation)(nil),                       // 263: gctrpc.RebalanceAllocation
+	(*RebalanceTrade)(nil),                            // 264: gctrpc.RebalanceTrade
+	(*RebalanceSkipped)(nil),                          // 265: gctrpc.RebalanceSkipped
+	(*RebalancePlanResponse)(nil),                     // 266: gctrpc.RebalancePlanResponse
+	(*GetOrderbookAnalyticsRequest)(nil),              // 267: gctrpc.GetOrderbookAnalyticsRequest
+	(*UntrackOrderbookAnalyticsRequest)(nil),          // 268: gctrpc.UntrackOrderbookAnalyticsRequest
+	(*OrderbookAnalyticsSpreadStats)(nil),             // 269: gctrpc.OrderbookAnalyticsSpreadStats
+	(*OrderbookAnalyticsBand)(nil),                    // 270: gctrpc.OrderbookAnalyticsBand
+	(*OrderbookAnalyticsFlow)(nil),                    // 271: gctrpc.OrderbookAnalyticsFlow
+	(*OrderbookAnalyticsResponse)(nil),                // 272: gctrpc.OrderbookAnalyticsResponse
+	nil,                                               // 273: gctrpc.GetInfoResponse.SubsystemStatusEntry
+	nil,                                               // 274: gctrpc.GetInfoResponse.RpcEndpointsEntry
+	nil,                                               // 275: gctrpc.GetCommunicationRelayersResponse.CommunicationRelayersEntry
+	nil,                                               // 276: gctrpc.GetSusbsytemsResponse.SubsystemsStatusEntry
+	nil,                                               // 277: gctrpc.GetRPCEndpointsResponse.EndpointsEntry
+	nil,                                               // 278: gctrpc.GetExchangeOTPsResponse.OtpCodesEntry
+	nil,                                               // 279: gctrpc.GetExchangeInfoResponse.SupportedAssetsEntry
+	nil,                                               // 280: gctrpc.OnlineCoins.CoinsEntry
+	nil,                                               // 281: gctrpc.GetPortfolioSummaryResponse.CoinsOfflineSummaryEntry
+	nil,                                               // 282: gctrpc.GetPortfolioSummaryResponse.CoinsOnlineSummaryEntry
+	nil,                                               // 283: gctrpc.Orders.OrderStatusEntry
+	nil,                                               // 284: gctrpc.GetCryptocurrencyDepositAddressesResponse.AddressesEntry
+	nil,                                               // 285: gctrpc.GetExchangePairsResponse.SupportedAssetsEntry
+	nil,                                               // 286: gctrpc.AggregatedOrderbookResponse.UnavailableEntry
+	nil,                                               // 287: gctrpc.GetTechnicalAnalysisResponse.SignalsEntry
+	(*timestamppb.Timestamp)(nil),                     // 288: google.protobuf.Timestamp
 }
 var file_rpc_proto_depIdxs = []int32{
-	226, // 0: gctrpc.GetInfoResponse.subsystem_status:type_name -> gctrpc.GetInfoResponse.SubsystemStatusEntry
-	227, // 1: gctrpc.GetInfoResponse.rpc_endpoints:type_name -> gctrpc.GetInfoResponse.RpcEndpointsEntry
-	228, // 2: gctrpc.GetCommunicationRelayersResponse.communication_relayers:type_name -> gctrpc.GetCommunicationRelayersResponse.CommunicationRelayersEntry
-	229, // 3: gctrpc.GetSusbsytemsResponse.subsystems_status:type_name -> gctrpc.GetSusbsytemsResponse.SubsystemsStatusEntry
-	230, // 4: gctrpc.GetRPCEndpointsResponse.endpoints:type_name -> gctrpc.GetRPCEndpointsResponse.EndpointsEntry
-	231, // 5: gctrpc.GetExchangeOTPsResponse.otp_codes:type_name -> gctrpc.GetExchangeOTPsResponse.OtpCodesEntry
-	232, // 6: gctrpc.GetExchangeInfoResponse.supported_assets:type_name -> gctrpc.GetExchangeInfoResponse.SupportedAssetsEntry
+	273, // 0: gctrpc.GetInfoResponse.subsystem_status:type_name -> gctrpc.GetInfoResponse.SubsystemStatusEntry
+	274, // 1: gctrpc.GetInfoResponse.rpc_endpoints:type_name -> gctrpc.GetInfoResponse.RpcEndpointsEntry
+	275, // 2: gctrpc.GetCommunicationRelayersResponse.communication_relayers:type_name -> gctrpc.GetCommunicationRelayersResponse.CommunicationRelayersEntry
+	276, // 3: gctrpc.GetSusbsytemsResponse.subsystems_status:type_name -> gctrpc.GetSusbsytemsResponse.SubsystemsStatusEntry
+	277, // 4: gctrpc.GetRPCEndpointsResponse.endpoints:type_name -> gctrpc.GetRPCEndpointsResponse.EndpointsEntry
+	278, // 5: gctrpc.GetExchangeOTPsResponse.otp_codes:type_name -> gctrpc.GetExchangeOTPsResponse.OtpCodesEntry
+	279, // 6: gctrpc.GetExchangeInfoResponse.supported_assets:type_name -> gctrpc.GetExchangeInfoResponse.SupportedAssetsEntry
 	21,  // 7: gctrpc.GetTickerRequest.pair:type_name -> gctrpc.CurrencyPair
 	21,  // 8: gctrpc.TickerResponse.pair:type_name -> gctrpc.CurrencyPair
 	22,  // 9: gctrpc.Tickers.tickers:type_name -> gctrpc.TickerResponse
@@ -16761,16 +21076,16 @@ var file_rpc_proto_depIdxs = []int32{
 	28,  // 15: gctrpc.Orderbooks.orderbooks:type_name -> gctrpc.OrderbookResponse
 	30,  // 16: gctrpc.GetOrderbooksResponse.orderbooks:type_name -> gctrpc.Orderbooks
 	34,  // 17: gctrpc.Account.currencies:type_name -> gctrpc.AccountCurrencyInfo
-	240, // 18: gctrpc.AccountCurrencyInfo.updated_at:type_name -> google.protobuf.Timestamp
+	288, // 18: gctrpc.AccountCurrencyInfo.updated_at:type_name -> google.protobuf.Timestamp
 	33,  // 19: gctrpc.GetAccountBalancesResponse.accounts:type_name -> gctrpc.Account
 	38,  // 20: gctrpc.GetPortfolioResponse.portfolio:type_name -> gctrpc.PortfolioAddress
 	43,  // 21: gctrpc.OfflineCoins.addresses:type_name -> gctrpc.OfflineCoinSummary
-	233, // 22: gctrpc.OnlineCoins.coins:type_name -> gctrpc.OnlineCoins.CoinsEntry
+	280, // 22: gctrpc.OnlineCoins.coins:type_name -> gctrpc.OnlineCoins.CoinsEntry
 	42,  // 23: gctrpc.GetPortfolioSummaryResponse.coin_totals:type_name -> gctrpc.Coin
 	42,  // 24: gctrpc.GetPortfolioSummaryResponse.coins_offline:type_name -> gctrpc.Coin
-	234, // 25: gctrpc.GetPortfolioSummaryResponse.coins_offline_summary:type_name -> gctrpc.GetPortfolioSummaryResponse.CoinsOfflineSummaryEntry
+	281, // 25: gctrpc.GetPortfolioSummaryResponse.coins_offline_summary:type_name -> gctrpc.GetPortfolioSummaryResponse.CoinsOfflineSummaryEntry
 	42,  // 26: gctrpc.GetPortfolioSummaryResponse.coins_online:type_name -> gctrpc.Coin
-	235, // 27: gctrpc.GetPortfolioSummaryResponse.coins_online_summary:type_name -> gctrpc.GetPortfolioSummaryResponse.CoinsOnlineSummaryEntry
+	282, // 27: gctrpc.GetPortfolioSummaryResponse.coins_online_summary:type_name -> gctrpc.GetPortfolioSummaryResponse.CoinsOnlineSummaryEntry
 	51,  // 28: gctrpc.GetForexProvidersResponse.forex_providers:type_name -> gctrpc.ForexProvider
 	54,  // 29: gctrpc.GetForexRatesResponse.forex_rates:type_name -> gctrpc.ForexRatesConversion
 	57,  // 30: gctrpc.OrderDetails.trades:type_name -> gctrpc.TradeHistory
@@ -16784,356 +21099,449 @@ var file_rpc_proto_depIdxs = []int32{
 	21,  // 38: gctrpc.WhaleBombRequest.pair:type_name -> gctrpc.CurrencyPair
 	21,  // 39: gctrpc.CancelOrderRequest.pair:type_name -> gctrpc.CurrencyPair
 	21,  // 40: gctrpc.CancelBatchOrdersRequest.pair:type_name -> gctrpc.CurrencyPair
-	236, // 41: gctrpc.Orders.order_status:type_name -> gctrpc.Orders.OrderStatusEntry
+	283, // 41: gctrpc.Orders.order_status:type_name -> gctrpc.Orders.OrderStatusEntry
 	69,  // 42: gctrpc.CancelBatchOrdersResponse.orders:type_name -> gctrpc.Orders
 	69,  // 43: gctrpc.CancelAllOrdersResponse.orders:type_name -> gctrpc.Orders
-	74,  // 44: gctrpc.GetEventsResponse.condition_params:type_name -> gctrpc.ConditionParams
-	21,  // 45: gctrpc.GetEventsResponse.pair:type_name -> gctrpc.CurrencyPair
-	74,  // 46: gctrpc.AddEventRequest.condition_params:type_name -> gctrpc.ConditionParams
-	21,  // 47: gctrpc.AddEventRequest.pair:type_name -> gctrpc.CurrencyPair
-	80,  // 48: gctrpc.DepositAddresses.addresses:type_name -> gctrpc.DepositAddress
-	237, // 49: gctrpc.GetCryptocurrencyDepositAddressesResponse.addresses:type_name -> gctrpc.GetCryptocurrencyDepositAddressesResponse.AddressesEntry
-	95,  // 50: gctrpc.WithdrawalEventByIDResponse.event:type_name -> gctrpc.WithdrawalEventResponse
-	95,  // 51: gctrpc.WithdrawalEventsByExchangeResponse.event:type_name -> gctrpc.WithdrawalEventResponse
-	96,  // 52: gctrpc.WithdrawalEventResponse.exchange:type_name -> gctrpc.WithdrawlExchangeEvent
-	97,  // 53: gctrpc.WithdrawalEventResponse.request:type_name -> gctrpc.WithdrawalRequestEvent
-	240, // 54: gctrpc.WithdrawalEventResponse.created_at:type_name -> google.protobuf.Timestamp
-	240, // 55: gctrpc.WithdrawalEventResponse.updated_at:type_name -> google.protobuf.Timestamp
-	98,  // 56: gctrpc.WithdrawalRequestEvent.fiat:type_name -> gctrpc.FiatWithdrawalEvent
-	99,  // 57: gctrpc.WithdrawalRequestEvent.crypto:type_name -> gctrpc.CryptoWithdrawalEvent
-	238, // 58: gctrpc.GetExchangePairsResponse.supported_assets:type_name -> gctrpc.GetExchangePairsResponse.SupportedAssetsEntry
-	21,  // 59: gctrpc.SetExchangePairRequest.pairs:type_name -> gctrpc.CurrencyPair
-	21,  // 60: gctrpc.GetOrderbookStreamRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 61: gctrpc.GetTickerStreamRequest.pair:type_name -> gctrpc.CurrencyPair
-	119, // 62: gctrpc.GetAuditEventResponse.events:type_name -> gctrpc.AuditEvent
-	21,  // 63: gctrpc.GetSavedTradesRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 64: gctrpc.SavedTradesResponse.pair:type_name -> gctrpc.CurrencyPair
-	113, // 65: gctrpc.SavedTradesResponse.trades:type_name -> gctrpc.SavedTrades
-	21,  // 66: gctrpc.ConvertTradesToCandlesRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 67: gctrpc.GetHistoricCandlesRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 68: gctrpc.GetHistoricCandlesResponse.pair:type_name -> gctrpc.CurrencyPair
-	118, // 69: gctrpc.GetHistoricCandlesResponse.candle:type_name -> gctrpc.Candle
-	120, // 70: gctrpc.GCTScriptExecuteRequest.script:type_name -> gctrpc.GCTScript
-	120, // 71: gctrpc.GCTScriptStopRequest.script:type_name -> gctrpc.GCTScript
-	120, // 72: gctrpc.GCTScriptReadScriptRequest.script:type_name -> gctrpc.GCTScript
-	120, // 73: gctrpc.GCTScriptQueryRequest.script:type_name -> gctrpc.GCTScript
-	120, // 74: gctrpc.GCTScriptStatusResponse.scripts:type_name -> gctrpc.GCTScript
-	120, // 75: gctrpc.GCTScriptQueryResponse.script:type_name -> gctrpc.GCTScript
-	142, // 76: gctrpc.WebsocketGetSubscriptionsResponse.subscriptions:type_name -> gctrpc.WebsocketSubscription
-	21,  // 77: gctrpc.FindMissingCandlePeriodsRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 78: gctrpc.FindMissingTradePeriodsRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 79: gctrpc.FindMissingIntervalsResponse.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 80: gctrpc.UpsertDataHistoryJobRequest.pair:type_name -> gctrpc.CurrencyPair
-	150, // 81: gctrpc.InsertSequentialJobsRequest.jobs:type_name -> gctrpc.UpsertDataHistoryJobRequest
-	153, // 82: gctrpc.InsertSequentialJobsResponse.jobs:type_name -> gctrpc.UpsertDataHistoryJobResponse
-	21,  // 83: gctrpc.DataHistoryJob.pair:type_name -> gctrpc.CurrencyPair
-	156, // 84: gctrpc.DataHistoryJob.job_results:type_name -> gctrpc.DataHistoryJobResult
-	155, // 85: gctrpc.DataHistoryJobs.results:type_name -> gctrpc.DataHistoryJob
-	21,  // 86: gctrpc.ModifyOrderRequest.pair:type_name -> gctrpc.CurrencyPair
-	169, // 87: gctrpc.CurrencyStateResponse.currency_states:type_name -> gctrpc.CurrencyState
-	21,  // 88: gctrpc.FundingData.pair:type_name -> gctrpc.CurrencyPair
-	170, // 89: gctrpc.FundingData.rates:type_name -> gctrpc.FundingRate
-	170, // 90: gctrpc.FundingData.latest_rate:type_name -> gctrpc.FundingRate
-	170, // 91: gctrpc.FundingData.upcoming_rate:type_name -> gctrpc.FundingRate
-	21,  // 92: gctrpc.FuturePosition.pair:type_name -> gctrpc.CurrencyPair
-	56,  // 93: gctrpc.FuturePosition.orders:type_name -> gctrpc.OrderDetails
-	172, // 94: gctrpc.FuturePosition.position_stats:type_name -> gctrpc.FuturesPositionStats
-	171, // 95: gctrpc.FuturePosition.funding_data:type_name -> gctrpc.FundingData
-	21,  // 96: gctrpc.GetManagedPositionRequest.pair:type_name -> gctrpc.CurrencyPair
-	173, // 97: gctrpc.GetManagedPositionsResponse.positions:type_name -> gctrpc.FuturePosition
-	21,  // 98: gctrpc.GetFuturesPositionsSummaryRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 99: gctrpc.GetFuturesPositionsSummaryRequest.underlying_pair:type_name -> gctrpc.CurrencyPair
-	21,  // 100: gctrpc.GetFuturesPositionsSummaryResponse.pair:type_name -> gctrpc.CurrencyPair
-	172, // 101: gctrpc.GetFuturesPositionsSummaryResponse.position_stats:type_name -> gctrpc.FuturesPositionStats
-	21,  // 102: gctrpc.GetFuturesPositionsOrdersRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 103: gctrpc.GetFuturesPositionsOrdersRequest.underlying_pair:type_name -> gctrpc.CurrencyPair
-	173, // 104: gctrpc.GetFuturesPositionsOrdersResponse.positions:type_name -> gctrpc.FuturePosition
-	21,  // 105: gctrpc.GetMarginTypeRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 106: gctrpc.GetMarginTypeResponse.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 107: gctrpc.ChangePositionMarginRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 108: gctrpc.ChangePositionMarginResponse.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 109: gctrpc.SetMarginTypeRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 110: gctrpc.SetMarginTypeResponse.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 111: gctrpc.GetLeverageRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 112: gctrpc.GetLeverageRequest.underlying_pair:type_name -> gctrpc.CurrencyPair
-	21,  // 113: gctrpc.GetLeverageResponse.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 114: gctrpc.GetLeverageResponse.underlying_pair:type_name -> gctrpc.CurrencyPair
-	21,  // 115: gctrpc.SetLeverageRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 116: gctrpc.SetLeverageRequest.underlying_pair:type_name -> gctrpc.CurrencyPair
-	21,  // 117: gctrpc.SetLeverageResponse.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 118: gctrpc.SetLeverageResponse.underlying_pair:type_name -> gctrpc.CurrencyPair
-	199, // 119: gctrpc.GetCollateralResponse.used_breakdown:type_name -> gctrpc.CollateralUsedBreakdown
-	197, // 120: gctrpc.GetCollateralResponse.currency_breakdown:type_name -> gctrpc.CollateralForCurrency
-	198, // 121: gctrpc.GetCollateralResponse.position_breakdown:type_name -> gctrpc.CollateralByPosition
-	199, // 122: gctrpc.CollateralForCurrency.used_breakdown:type_name -> gctrpc.CollateralUsedBreakdown
-	21,  // 123: gctrpc.GetFundingRatesRequest.pair:type_name -> gctrpc.CurrencyPair
-	171, // 124: gctrpc.GetFundingRatesResponse.rates:type_name -> gctrpc.FundingData
-	21,  // 125: gctrpc.GetLatestFundingRateRequest.pair:type_name -> gctrpc.CurrencyPair
-	171, // 126: gctrpc.GetLatestFundingRateResponse.rate:type_name -> gctrpc.FundingData
-	21,  // 127: gctrpc.GetTechnicalAnalysisRequest.pair:type_name -> gctrpc.CurrencyPair
-	240, // 128: gctrpc.GetTechnicalAnalysisRequest.start:type_name -> google.protobuf.Timestamp
-	240, // 129: gctrpc.GetTechnicalAnalysisRequest.end:type_name -> google.protobuf.Timestamp
-	21,  // 130: gctrpc.GetTechnicalAnalysisRequest.other_pair:type_name -> gctrpc.CurrencyPair
-	239, // 131: gctrpc.GetTechnicalAnalysisResponse.signals:type_name -> gctrpc.GetTechnicalAnalysisResponse.SignalsEntry
-	212, // 132: gctrpc.GetMarginRatesHistoryRequest.rates:type_name -> gctrpc.MarginRate
-	210, // 133: gctrpc.MarginRate.lending_payment:type_name -> gctrpc.LendingPayment
-	211, // 134: gctrpc.MarginRate.borrow_cost:type_name -> gctrpc.BorrowCost
-	212, // 135: gctrpc.GetMarginRatesHistoryResponse.rates:type_name -> gctrpc.MarginRate
-	212, // 136: gctrpc.GetMarginRatesHistoryResponse.latest_rate:type_name -> gctrpc.MarginRate
-	212, // 137: gctrpc.GetMarginRatesHistoryResponse.predicted_rate:type_name -> gctrpc.MarginRate
-	21,  // 138: gctrpc.GetOrderbookMovementRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 139: gctrpc.GetOrderbookAmountByNominalRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 140: gctrpc.GetOrderbookAmountByImpactRequest.pair:type_name -> gctrpc.CurrencyPair
-	221, // 141: gctrpc.GetOpenInterestRequest.data:type_name -> gctrpc.OpenInterestDataRequest
-	21,  // 142: gctrpc.OpenInterestDataRequest.pair:type_name -> gctrpc.CurrencyPair
-	223, // 143: gctrpc.GetOpenInterestResponse.data:type_name -> gctrpc.OpenInterestDataResponse
-	21,  // 144: gctrpc.OpenInterestDataResponse.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 145: gctrpc.GetCurrencyTradeURLRequest.pair:type_name -> gctrpc.CurrencyPair
-	9,   // 146: gctrpc.GetInfoResponse.RpcEndpointsEntry.value:type_name -> gctrpc.RPCEndpoint
-	3,   // 147: gctrpc.GetCommunicationRelayersResponse.CommunicationRelayersEntry.value:type_name -> gctrpc.CommunicationRelayer
-	9,   // 148: gctrpc.GetRPCEndpointsResponse.EndpointsEntry.value:type_name -> gctrpc.RPCEndpoint
-	18,  // 149: gctrpc.GetExchangeInfoResponse.SupportedAssetsEntry.value:type_name -> gctrpc.PairsSupported
-	44,  // 150: gctrpc.OnlineCoins.CoinsEntry.value:type_name -> gctrpc.OnlineCoinSummary
-	45,  // 151: gctrpc.GetPortfolioSummaryResponse.CoinsOfflineSummaryEntry.value:type_name -> gctrpc.OfflineCoins
-	46,  // 152: gctrpc.GetPortfolioSummaryResponse.CoinsOnlineSummaryEntry.value:type_name -> gctrpc.OnlineCoins
-	81,  // 153: gctrpc.GetCryptocurrencyDepositAddressesResponse.AddressesEntry.value:type_name -> gctrpc.DepositAddresses
-	18,  // 154: gctrpc.GetExchangePairsResponse.SupportedAssetsEntry.value:type_name -> gctrpc.PairsSupported
-	207, // 155: gctrpc.GetTechnicalAnalysisResponse.SignalsEntry.value:type_name -> gctrpc.ListOfSignals
-	0,   // 156: gctrpc.GoCryptoTraderService.GetInfo:input_type -> gctrpc.GetInfoRequest
-	6,   // 157: gctrpc.GoCryptoTraderService.GetSubsystems:input_type -> gctrpc.GetSubsystemsRequest
-	5,   // 158: gctrpc.GoCryptoTraderService.EnableSubsystem:input_type -> gctrpc.GenericSubsystemRequest
-	5,   // 159: gctrpc.GoCryptoTraderService.DisableSubsystem:input_type -> gctrpc.GenericSubsystemRequest
-	8,   // 160: gctrpc.GoCryptoTraderService.GetRPCEndpoints:input_type -> gctrpc.GetRPCEndpointsRequest
-	2,   // 161: gctrpc.GoCryptoTraderService.GetCommunicationRelayers:input_type -> gctrpc.GetCommunicationRelayersRequest
-	12,  // 162: gctrpc.GoCryptoTraderService.GetExchanges:input_type -> gctrpc.GetExchangesRequest
-	11,  // 163: gctrpc.GoCryptoTraderService.DisableExchange:input_type -> gctrpc.GenericExchangeNameRequest
-	11,  // 164: gctrpc.GoCryptoTraderService.GetExchangeInfo:input_type -> gctrpc.GenericExchangeNameRequest
-	11,  // 165: gctrpc.GoCryptoTraderService.GetExchangeOTPCode:input_type -> gctrpc.GenericExchangeNameRequest
-	15,  // 166: gctrpc.GoCryptoTraderService.GetExchangeOTPCodes:input_type -> gctrpc.GetExchangeOTPsRequest
-	11,  // 167: gctrpc.GoCryptoTraderService.EnableExchange:input_type -> gctrpc.GenericExchangeNameRequest
-	20,  // 168: gctrpc.GoCryptoTraderService.GetTicker:input_type -> gctrpc.GetTickerRequest
-	23,  // 169: gctrpc.GoCryptoTraderService.GetTickers:input_type -> gctrpc.GetTickersRequest
-	26,  // 170: gctrpc.GoCryptoTraderService.GetOrderbook:input_type -> gctrpc.GetOrderbookRequest
-	29,  // 171: gctrpc.GoCryptoTraderService.GetOrderbooks:input_type -> gctrpc.GetOrderbooksRequest
-	32,  // 172: gctrpc.GoCryptoTraderService.GetAccountBalances:input_type -> gctrpc.GetAccountBalancesRequest
-	32,  // 173: gctrpc.GoCryptoTraderService.UpdateAccountBalances:input_type -> gctrpc.GetAccountBalancesRequest
-	32,  // 174: gctrpc.GoCryptoTraderService.GetAccountBalancesStream:input_type -> gctrpc.GetAccountBalancesRequest
-	36,  // 175: gctrpc.GoCryptoTraderService.GetConfig:input_type -> gctrpc.GetConfigRequest
-	39,  // 176: gctrpc.GoCryptoTraderService.GetPortfolio:input_type -> gctrpc.GetPortfolioRequest
-	41,  // 177: gctrpc.GoCryptoTraderService.GetPortfolioSummary:input_type -> gctrpc.GetPortfolioSummaryRequest
-	48,  // 178: gctrpc.GoCryptoTraderService.AddPortfolioAddress:input_type -> gctrpc.AddPortfolioAddressRequest
-	49,  // 179: gctrpc.GoCryptoTraderService.RemovePortfolioAddress:input_type -> gctrpc.RemovePortfolioAddressRequest
-	50,  // 180: gctrpc.GoCryptoTraderService.GetForexProviders:input_type -> gctrpc.GetForexProvidersRequest
-	53,  // 181: gctrpc.GoCryptoTraderService.GetForexRates:input_type -> gctrpc.GetForexRatesRequest
-	58,  // 182: gctrpc.GoCryptoTraderService.GetOrders:input_type -> gctrpc.GetOrdersRequest
-	60,  // 183: gctrpc.GoCryptoTraderService.GetOrder:input_type -> gctrpc.GetOrderRequest
-	61,  // 184: gctrpc.GoCryptoTraderService.SubmitOrder:input_type -> gctrpc.SubmitOrderRequest
-	64,  // 185: gctrpc.GoCryptoTraderService.SimulateOrder:input_type -> gctrpc.SimulateOrderRequest
-	66,  // 186: gctrpc.GoCryptoTraderService.WhaleBomb:input_type -> gctrpc.WhaleBombRequest
-	67,  // 187: gctrpc.GoCryptoTraderService.CancelOrder:input_type -> gctrpc.CancelOrderRequest
-	68,  // 188: gctrpc.GoCryptoTraderService.CancelBatchOrders:input_type -> gctrpc.CancelBatchOrdersRequest
-	71,  // 189: gctrpc.GoCryptoTraderService.CancelAllOrders:input_type -> gctrpc.CancelAllOrdersRequest
-	73,  // 190: gctrpc.GoCryptoTraderService.GetEvents:input_type -> gctrpc.GetEventsRequest
-	76,  // 191: gctrpc.GoCryptoTraderService.AddEvent:input_type -> gctrpc.AddEventRequest
-	78,  // 192: gctrpc.GoCryptoTraderService.RemoveEvent:input_type -> gctrpc.RemoveEventRequest
-	79,  // 193: gctrpc.GoCryptoTraderService.GetCryptocurrencyDepositAddresses:input_type -> gctrpc.GetCryptocurrencyDepositAddressesRequest
-	83,  // 194: gctrpc.GoCryptoTraderService.GetCryptocurrencyDepositAddress:input_type -> gctrpc.GetCryptocurrencyDepositAddressRequest
-	85,  // 195: gctrpc.GoCryptoTraderService.GetAvailableTransferChains:input_type -> gctrpc.GetAvailableTransferChainsRequest
-	87,  // 196: gctrpc.GoCryptoTraderService.WithdrawFiatFunds:input_type -> gctrpc.WithdrawFiatRequest
-	88,  // 197: gctrpc.GoCryptoTraderService.WithdrawCryptocurrencyFunds:input_type -> gctrpc.WithdrawCryptoRequest
-	90,  // 198: gctrpc.GoCryptoTraderService.WithdrawalEventByID:input_type -> gctrpc.WithdrawalEventByIDRequest
-	92,  // 199: gctrpc.GoCryptoTraderService.WithdrawalEventsByExchange:input_type -> gctrpc.WithdrawalEventsByExchangeRequest
-	93,  // 200: gctrpc.GoCryptoTraderService.WithdrawalEventsByDate:input_type -> gctrpc.WithdrawalEventsByDateRequest
-	100, // 201: gctrpc.GoCryptoTraderService.GetLoggerDetails:input_type -> gctrpc.GetLoggerDetailsRequest
-	102, // 202: gctrpc.GoCryptoTraderService.SetLoggerDetails:input_type -> gctrpc.SetLoggerDetailsRequest
-	103, // 203: gctrpc.GoCryptoTraderService.GetExchangePairs:input_type -> gctrpc.GetExchangePairsRequest
-	105, // 204: gctrpc.GoCryptoTraderService.SetExchangePair:input_type -> gctrpc.SetExchangePairRequest
-	106, // 205: gctrpc.GoCryptoTraderService.GetOrderbookStream:input_type -> gctrpc.GetOrderbookStreamRequest
-	107, // 206: gctrpc.GoCryptoTraderService.GetExchangeOrderbookStream:input_type -> gctrpc.GetExchangeOrderbookStreamRequest
-	108, // 207: gctrpc.GoCryptoTraderService.GetTickerStream:input_type -> gctrpc.GetTickerStreamRequest
-	109, // 208: gctrpc.GoCryptoTraderService.GetExchangeTickerStream:input_type -> gctrpc.GetExchangeTickerStreamRequest
-	110, // 209: gctrpc.GoCryptoTraderService.GetAuditEvent:input_type -> gctrpc.GetAuditEventRequest
-	121, // 210: gctrpc.GoCryptoTraderService.GCTScriptExecute:input_type -> gctrpc.GCTScriptExecuteRequest
-	126, // 211: gctrpc.GoCryptoTraderService.GCTScriptUpload:input_type -> gctrpc.GCTScriptUploadRequest
-	127, // 212: gctrpc.GoCryptoTraderService.GCTScriptReadScript:input_type -> gctrpc.GCTScriptReadScriptRequest
-	124, // 213: gctrpc.GoCryptoTraderService.GCTScriptStatus:input_type -> gctrpc.GCTScriptStatusRequest
-	128, // 214: gctrpc.GoCryptoTraderService.GCTScriptQuery:input_type -> gctrpc.GCTScriptQueryRequest
-	122, // 215: gctrpc.GoCryptoTraderService.GCTScriptStop:input_type -> gctrpc.GCTScriptStopRequest
-	123, // 216: gctrpc.GoCryptoTraderService.GCTScriptStopAll:input_type -> gctrpc.GCTScriptStopAllRequest
-	125, // 217: gctrpc.GoCryptoTraderService.GCTScriptListAll:input_type -> gctrpc.GCTScriptListAllRequest
-	129, // 218: gctrpc.GoCryptoTraderService.GCTScriptAutoLoadToggle:input_type -> gctrpc.GCTScriptAutoLoadRequest
-	116, // 219: gctrpc.GoCryptoTraderService.GetHistoricCandles:input_type -> gctrpc.GetHistoricCandlesRequest
-	133, // 220: gctrpc.GoCryptoTraderService.SetExchangeAsset:input_type -> gctrpc.SetExchangeAssetRequest
-	134, // 221: gctrpc.GoCryptoTraderService.SetAllExchangePairs:input_type -> gctrpc.SetExchangeAllPairsRequest
-	135, // 222: gctrpc.GoCryptoTraderService.UpdateExchangeSupportedPairs:input_type -> gctrpc.UpdateExchangeSupportedPairsRequest
-	136, // 223: gctrpc.GoCryptoTraderService.GetExchangeAssets:input_type -> gctrpc.GetExchangeAssetsRequest
-	138, // 224: gctrpc.GoCryptoTraderService.WebsocketGetInfo:input_type -> gctrpc.WebsocketGetInfoRequest
-	140, // 225: gctrpc.GoCryptoTraderService.WebsocketSetEnabled:input_type -> gctrpc.WebsocketSetEnabledRequest
-	141, // 226: gctrpc.GoCryptoTraderService.WebsocketGetSubscriptions:input_type -> gctrpc.WebsocketGetSubscriptionsRequest
-	144, // 227: gctrpc.GoCryptoTraderService.WebsocketSetProxy:input_type -> gctrpc.WebsocketSetProxyRequest
-	145, // 228: gctrpc.GoCryptoTraderService.WebsocketSetURL:input_type -> gctrpc.WebsocketSetURLRequest
-	112, // 229: gctrpc.GoCryptoTraderService.GetRecentTrades:input_type -> gctrpc.GetSavedTradesRequest
-	112, // 230: gctrpc.GoCryptoTraderService.GetHistoricTrades:input_type -> gctrpc.GetSavedTradesRequest
-	112, // 231: gctrpc.GoCryptoTraderService.GetSavedTrades:input_type -> gctrpc.GetSavedTradesRequest
-	115, // 232: gctrpc.GoCryptoTraderService.ConvertTradesToCandles:input_type -> gctrpc.ConvertTradesToCandlesRequest
-	146, // 233: gctrpc.GoCryptoTraderService.FindMissingSavedCandleIntervals:input_type -> gctrpc.FindMissingCandlePeriodsRequest
-	147, // 234: gctrpc.GoCryptoTraderService.FindMissingSavedTradeIntervals:input_type -> gctrpc.FindMissingTradePeriodsRequest
-	149, // 235: gctrpc.GoCryptoTraderService.SetExchangeTradeProcessing:input_type -> gctrpc.SetExchangeTradeProcessingRequest
-	150, // 236: gctrpc.GoCryptoTraderService.UpsertDataHistoryJob:input_type -> gctrpc.UpsertDataHistoryJobRequest
-	154, // 237: gctrpc.GoCryptoTraderService.GetDataHistoryJobDetails:input_type -> gctrpc.GetDataHistoryJobDetailsRequest
-	0,   // 238: gctrpc.GoCryptoTraderService.GetActiveDataHistoryJobs:input_type -> gctrpc.GetInfoRequest
-	158, // 239: gctrpc.GoCryptoTraderService.GetDataHistoryJobsBetween:input_type -> gctrpc.GetDataHistoryJobsBetweenRequest
-	154, // 240: gctrpc.GoCryptoTraderService.GetDataHistoryJobSummary:input_type -> gctrpc.GetDataHistoryJobDetailsRequest
-	159, // 241: gctrpc.GoCryptoTraderService.SetDataHistoryJobStatus:input_type -> gctrpc.SetDataHistoryJobStatusRequest
-	160, // 242: gctrpc.GoCryptoTraderService.UpdateDataHistoryJobPrerequisite:input_type -> gctrpc.UpdateDataHistoryJobPrerequisiteRequest
-	58,  // 243: gctrpc.GoCryptoTraderService.GetManagedOrders:input_type -> gctrpc.GetOrdersRequest
-	161, // 244: gctrpc.GoCryptoTraderService.ModifyOrder:input_type -> gctrpc.ModifyOrderRequest
-	163, // 245: gctrpc.GoCryptoTraderService.CurrencyStateGetAll:input_type -> gctrpc.CurrencyStateGetAllRequest
-	164, // 246: gctrpc.GoCryptoTraderService.CurrencyStateTrading:input_type -> gctrpc.CurrencyStateTradingRequest
-	167, // 247: gctrpc.GoCryptoTraderService.CurrencyStateDeposit:input_type -> gctrpc.CurrencyStateDepositRequest
-	166, // 248: gctrpc.GoCryptoTraderService.CurrencyStateWithdraw:input_type -> gctrpc.CurrencyStateWithdrawRequest
-	165, // 249: gctrpc.GoCryptoTraderService.CurrencyStateTradingPair:input_type -> gctrpc.CurrencyStateTradingPairRequest
-	177, // 250: gctrpc.GoCryptoTraderService.GetFuturesPositionsSummary:input_type -> gctrpc.GetFuturesPositionsSummaryRequest
-	179, // 251: gctrpc.GoCryptoTraderService.GetFuturesPositionsOrders:input_type -> gctrpc.GetFuturesPositionsOrdersRequest
-	195, // 252: gctrpc.GoCryptoTraderService.GetCollateral:input_type -> gctrpc.GetCollateralRequest
-	204, // 253: gctrpc.GoCryptoTraderService.Shutdown:input_type -> gctrpc.ShutdownRequest
-	206, // 254: gctrpc.GoCryptoTraderService.GetTechnicalAnalysis:input_type -> gctrpc.GetTechnicalAnalysisRequest
-	209, // 255: gctrpc.GoCryptoTraderService.GetMarginRatesHistory:input_type -> gctrpc.GetMarginRatesHistoryRequest
-	174, // 256: gctrpc.GoCryptoTraderService.GetManagedPosition:input_type -> gctrpc.GetManagedPositionRequest
-	175, // 257: gctrpc.GoCryptoTraderService.GetAllManagedPositions:input_type -> gctrpc.GetAllManagedPositionsRequest
-	200, // 258: gctrpc.GoCryptoTraderService.GetFundingRates:input_type -> gctrpc.GetFundingRatesRequest
-	202, // 259: gctrpc.GoCryptoTraderService.GetLatestFundingRate:input_type -> gctrpc.GetLatestFundingRateRequest
-	214, // 260: gctrpc.GoCryptoTraderService.GetOrderbookMovement:input_type -> gctrpc.GetOrderbookMovementRequest
-	216, // 261: gctrpc.GoCryptoTraderService.GetOrderbookAmountByNominal:input_type -> gctrpc.GetOrderbookAmountByNominalRequest
-	218, // 262: gctrpc.GoCryptoTraderService.GetOrderbookAmountByImpact:input_type -> gctrpc.GetOrderbookAmountByImpactRequest
-	181, // 263: gctrpc.GoCryptoTraderService.GetCollateralMode:input_type -> gctrpc.GetCollateralModeRequest
-	191, // 264: gctrpc.GoCryptoTraderService.GetLeverage:input_type -> gctrpc.GetLeverageRequest
-	183, // 265: gctrpc.GoCryptoTraderService.SetCollateralMode:input_type -> gctrpc.SetCollateralModeRequest
-	189, // 266: gctrpc.GoCryptoTraderService.SetMarginType:input_type -> gctrpc.SetMarginTypeRequest
-	193, // 267: gctrpc.GoCryptoTraderService.SetLeverage:input_type -> gctrpc.SetLeverageRequest
-	187, // 268: gctrpc.GoCryptoTraderService.ChangePositionMargin:input_type -> gctrpc.ChangePositionMarginRequest
-	220, // 269: gctrpc.GoCryptoTraderService.GetOpenInterest:input_type -> gctrpc.GetOpenInterestRequest
-	224, // 270: gctrpc.GoCryptoTraderService.GetCurrencyTradeURL:input_type -> gctrpc.GetCurrencyTradeURLRequest
-	1,   // 271: gctrpc.GoCryptoTraderService.GetInfo:output_type -> gctrpc.GetInfoResponse
-	7,   // 272: gctrpc.GoCryptoTraderService.GetSubsystems:output_type -> gctrpc.GetSusbsytemsResponse
-	132, // 273: gctrpc.GoCryptoTraderService.EnableSubsystem:output_type -> gctrpc.GenericResponse
-	132, // 274: gctrpc.GoCryptoTraderService.DisableSubsystem:output_type -> gctrpc.GenericResponse
-	10,  // 275: gctrpc.GoCryptoTraderService.GetRPCEndpoints:output_type -> gctrpc.GetRPCEndpointsResponse
-	4,   // 276: gctrpc.GoCryptoTraderService.GetCommunicationRelayers:output_type -> gctrpc.GetCommunicationRelayersResponse
-	13,  // 277: gctrpc.GoCryptoTraderService.GetExchanges:output_type -> gctrpc.GetExchangesResponse
-	132, // 278: gctrpc.GoCryptoTraderService.DisableExchange:output_type -> gctrpc.GenericResponse
-	19,  // 279: gctrpc.GoCryptoTraderService.GetExchangeInfo:output_type -> gctrpc.GetExchangeInfoResponse
-	14,  // 280: gctrpc.GoCryptoTraderService.GetExchangeOTPCode:output_type -> gctrpc.GetExchangeOTPResponse
-	16,  // 281: gctrpc.GoCryptoTraderService.GetExchangeOTPCodes:output_type -> gctrpc.GetExchangeOTPsResponse
-	132, // 282: gctrpc.GoCryptoTraderService.EnableExchange:output_type -> gctrpc.GenericResponse
-	22,  // 283: gctrpc.GoCryptoTraderService.GetTicker:output_type -> gctrpc.TickerResponse
-	25,  // 284: gctrpc.GoCryptoTraderService.GetTickers:output_type -> gctrpc.GetTickersResponse
-	28,  // 285: gctrpc.GoCryptoTraderService.GetOrderbook:output_type -> gctrpc.OrderbookResponse
-	31,  // 286: gctrpc.GoCryptoTraderService.GetOrderbooks:output_type -> gctrpc.GetOrderbooksResponse
-	35,  // 287: gctrpc.GoCryptoTraderService.GetAccountBalances:output_type -> gctrpc.GetAccountBalancesResponse
-	35,  // 288: gctrpc.GoCryptoTraderService.UpdateAccountBalances:output_type -> gctrpc.GetAccountBalancesResponse
-	35,  // 289: gctrpc.GoCryptoTraderService.GetAccountBalancesStream:output_type -> gctrpc.GetAccountBalancesResponse
-	37,  // 290: gctrpc.GoCryptoTraderService.GetConfig:output_type -> gctrpc.GetConfigResponse
-	40,  // 291: gctrpc.GoCryptoTraderService.GetPortfolio:output_type -> gctrpc.GetPortfolioResponse
-	47,  // 292: gctrpc.GoCryptoTraderService.GetPortfolioSummary:output_type -> gctrpc.GetPortfolioSummaryResponse
-	132, // 293: gctrpc.GoCryptoTraderService.AddPortfolioAddress:output_type -> gctrpc.GenericResponse
-	132, // 294: gctrpc.GoCryptoTraderService.RemovePortfolioAddress:output_type -> gctrpc.GenericResponse
-	52,  // 295: gctrpc.GoCryptoTraderService.GetForexProviders:output_type -> gctrpc.GetForexProvidersResponse
-	55,  // 296: gctrpc.GoCryptoTraderService.GetForexRates:output_type -> gctrpc.GetForexRatesResponse
-	59,  // 297: gctrpc.GoCryptoTraderService.GetOrders:output_type -> gctrpc.GetOrdersResponse
-	56,  // 298: gctrpc.GoCryptoTraderService.GetOrder:output_type -> gctrpc.OrderDetails
-	63,  // 299: gctrpc.GoCryptoTraderService.SubmitOrder:output_type -> gctrpc.SubmitOrderResponse
-	65,  // 300: gctrpc.GoCryptoTraderService.SimulateOrder:output_type -> gctrpc.SimulateOrderResponse
-	65,  // 301: gctrpc.GoCryptoTraderService.WhaleBomb:output_type -> gctrpc.SimulateOrderResponse
-	132, // 302: gctrpc.GoCryptoTraderService.CancelOrder:output_type -> gctrpc.GenericResponse
-	70,  // 303: gctrpc.GoCryptoTraderService.CancelBatchOrders:output_type -> gctrpc.CancelBatchOrdersResponse
-	72,  // 304: gctrpc.GoCryptoTraderService.CancelAllOrders:output_type -> gctrpc.CancelAllOrdersResponse
-	75,  // 305: gctrpc.GoCryptoTraderService.GetEvents:output_type -> gctrpc.GetEventsResponse
-	77,  // 306: gctrpc.GoCryptoTraderService.AddEvent:output_type -> gctrpc.AddEventResponse
-	132, // 307: gctrpc.GoCryptoTraderService.RemoveEvent:output_type -> gctrpc.GenericResponse
-	82,  // 308: gctrpc.GoCryptoTraderService.GetCryptocurrencyDepositAddresses:output_type -> gctrpc.GetCryptocurrencyDepositAddressesResponse
-	84,  // 309: gctrpc.GoCryptoTraderService.GetCryptocurrencyDepositAddress:output_type -> gctrpc.GetCryptocurrencyDepositAddressResponse
-	86,  // 310: gctrpc.GoCryptoTraderService.GetAvailableTransferChains:output_type -> gctrpc.GetAvailableTransferChainsResponse
-	89,  // 311: gctrpc.GoCryptoTraderService.WithdrawFiatFunds:output_type -> gctrpc.WithdrawResponse
-	89,  // 312: gctrpc.GoCryptoTraderService.WithdrawCryptocurrencyFunds:output_type -> gctrpc.WithdrawResponse
-	91,  // 313: gctrpc.GoCryptoTraderService.WithdrawalEventByID:output_type -> gctrpc.WithdrawalEventByIDResponse
-	94,  // 314: gctrpc.GoCryptoTraderService.WithdrawalEventsByExchange:output_type -> gctrpc.WithdrawalEventsByExchangeResponse
-	94,  // 315: gctrpc.GoCryptoTraderService.WithdrawalEventsByDate:output_type -> gctrpc.WithdrawalEventsByExchangeResponse
-	101, // 316: gctrpc.GoCryptoTraderService.GetLoggerDetails:output_type -> gctrpc.GetLoggerDetailsResponse
-	101, // 317: gctrpc.GoCryptoTraderService.SetLoggerDetails:output_type -> gctrpc.GetLoggerDetailsResponse
-	104, // 318: gctrpc.GoCryptoTraderService.GetExchangePairs:output_type -> gctrpc.GetExchangePairsResponse
-	132, // 319: gctrpc.GoCryptoTraderService.SetExchangePair:output_type -> gctrpc.GenericResponse
-	28,  // 320: gctrpc.GoCryptoTraderService.GetOrderbookStream:output_type -> gctrpc.OrderbookResponse
-	28,  // 321: gctrpc.GoCryptoTraderService.GetExchangeOrderbookStream:output_type -> gctrpc.OrderbookResponse
-	22,  // 322: gctrpc.GoCryptoTraderService.GetTickerStream:output_type -> gctrpc.TickerResponse
-	22,  // 323: gctrpc.GoCryptoTraderService.GetExchangeTickerStream:output_type -> gctrpc.TickerResponse
-	111, // 324: gctrpc.GoCryptoTraderService.GetAuditEvent:output_type -> gctrpc.GetAuditEventResponse
-	132, // 325: gctrpc.GoCryptoTraderService.GCTScriptExecute:output_type -> gctrpc.GenericResponse
-	132, // 326: gctrpc.GoCryptoTraderService.GCTScriptUpload:output_type -> gctrpc.GenericResponse
-	131, // 327: gctrpc.GoCryptoTraderService.GCTScriptReadScript:output_type -> gctrpc.GCTScriptQueryResponse
-	130, // 328: gctrpc.GoCryptoTraderService.GCTScriptStatus:output_type -> gctrpc.GCTScriptStatusResponse
-	131, // 329: gctrpc.GoCryptoTraderService.GCTScriptQuery:output_type -> gctrpc.GCTScriptQueryResponse
-	132, // 330: gctrpc.GoCryptoTraderService.GCTScriptStop:output_type -> gctrpc.GenericResponse
-	132, // 331: gctrpc.GoCryptoTraderService.GCTScriptStopAll:output_type -> gctrpc.GenericResponse
-	130, // 332: gctrpc.GoCryptoTraderService.GCTScriptListAll:output_type -> gctrpc.GCTScriptStatusResponse
-	132, // 333: gctrpc.GoCryptoTraderService.GCTScriptAutoLoadToggle:output_type -> gctrpc.GenericResponse
-	117, // 334: gctrpc.GoCryptoTraderService.GetHistoricCandles:output_type -> gctrpc.GetHistoricCandlesResponse
-	132, // 335: gctrpc.GoCryptoTraderService.SetExchangeAsset:output_type -> gctrpc.GenericResponse
-	132, // 336: gctrpc.GoCryptoTraderService.SetAllExchangePairs:output_type -> gctrpc.GenericResponse
-	132, // 337: gctrpc.GoCryptoTraderService.UpdateExchangeSupportedPairs:output_type -> gctrpc.GenericResponse
-	137, // 338: gctrpc.GoCryptoTraderService.GetExchangeAssets:output_type -> gctrpc.GetExchangeAssetsResponse
-	139, // 339: gctrpc.GoCryptoTraderService.WebsocketGetInfo:output_type -> gctrpc.WebsocketGetInfoResponse
-	132, // 340: gctrpc.GoCryptoTraderService.WebsocketSetEnabled:output_type -> gctrpc.GenericResponse
-	143, // 341: gctrpc.GoCryptoTraderService.WebsocketGetSubscriptions:output_type -> gctrpc.WebsocketGetSubscriptionsResponse
-	132, // 342: gctrpc.GoCryptoTraderService.WebsocketSetProxy:output_type -> gctrpc.GenericResponse
-	132, // 343: gctrpc.GoCryptoTraderService.WebsocketSetURL:output_type -> gctrpc.GenericResponse
-	114, // 344: gctrpc.GoCryptoTraderService.GetRecentTrades:output_type -> gctrpc.SavedTradesResponse
-	114, // 345: gctrpc.GoCryptoTraderService.GetHistoricTrades:output_type -> gctrpc.SavedTradesResponse
-	114, // 346: gctrpc.GoCryptoTraderService.GetSavedTrades:output_type -> gctrpc.SavedTradesResponse
-	117, // 347: gctrpc.GoCryptoTraderService.ConvertTradesToCandles:output_type -> gctrpc.GetHistoricCandlesResponse
-	148, // 348: gctrpc.GoCryptoTraderService.FindMissingSavedCandleIntervals:output_type -> gctrpc.FindMissingIntervalsResponse
-	148, // 349: gctrpc.GoCryptoTraderService.FindMissingSavedTradeIntervals:output_type -> gctrpc.FindMissingIntervalsResponse
-	132, // 350: gctrpc.GoCryptoTraderService.SetExchangeTradeProcessing:output_type -> gctrpc.GenericResponse
-	153, // 351: gctrpc.GoCryptoTraderService.UpsertDataHistoryJob:output_type -> gctrpc.UpsertDataHistoryJobResponse
-	155, // 352: gctrpc.GoCryptoTraderService.GetDataHistoryJobDetails:output_type -> gctrpc.DataHistoryJob
-	157, // 353: gctrpc.GoCryptoTraderService.GetActiveDataHistoryJobs:output_type -> gctrpc.DataHistoryJobs
-	157, // 354: gctrpc.GoCryptoTraderService.GetDataHistoryJobsBetween:output_type -> gctrpc.DataHistoryJobs
-	155, // 355: gctrpc.GoCryptoTraderService.GetDataHistoryJobSummary:output_type -> gctrpc.DataHistoryJob
-	132, // 356: gctrpc.GoCryptoTraderService.SetDataHistoryJobStatus:output_type -> gctrpc.GenericResponse
-	132, // 357: gctrpc.GoCryptoTraderService.UpdateDataHistoryJobPrerequisite:output_type -> gctrpc.GenericResponse
-	59,  // 358: gctrpc.GoCryptoTraderService.GetManagedOrders:output_type -> gctrpc.GetOrdersResponse
-	162, // 359: gctrpc.GoCryptoTraderService.ModifyOrder:output_type -> gctrpc.ModifyOrderResponse
-	168, // 360: gctrpc.GoCryptoTraderService.CurrencyStateGetAll:output_type -> gctrpc.CurrencyStateResponse
-	132, // 361: gctrpc.GoCryptoTraderService.CurrencyStateTrading:output_type -> gctrpc.GenericResponse
-	132, // 362: gctrpc.GoCryptoTraderService.CurrencyStateDeposit:output_type -> gctrpc.GenericResponse
-	132, // 363: gctrpc.GoCryptoTraderService.CurrencyStateWithdraw:output_type -> gctrpc.GenericResponse
-	132, // 364: gctrpc.GoCryptoTraderService.CurrencyStateTradingPair:output_type -> gctrpc.GenericResponse
-	178, // 365: gctrpc.GoCryptoTraderService.GetFuturesPositionsSummary:output_type -> gctrpc.GetFuturesPositionsSummaryResponse
-	180, // 366: gctrpc.GoCryptoTraderService.GetFuturesPositionsOrders:output_type -> gctrpc.GetFuturesPositionsOrdersResponse
-	196, // 367: gctrpc.GoCryptoTraderService.GetCollateral:output_type -> gctrpc.GetCollateralResponse
-	205, // 368: gctrpc.GoCryptoTraderService.Shutdown:output_type -> gctrpc.ShutdownResponse
-	208, // 369: gctrpc.GoCryptoTraderService.GetTechnicalAnalysis:output_type -> gctrpc.GetTechnicalAnalysisResponse
-	213, // 370: gctrpc.GoCryptoTraderService.GetMarginRatesHistory:output_type -> gctrpc.GetMarginRatesHistoryResponse
-	176, // 371: gctrpc.GoCryptoTraderService.GetManagedPosition:output_type -> gctrpc.GetManagedPositionsResponse
-	176, // 372: gctrpc.GoCryptoTraderService.GetAllManagedPositions:output_type -> gctrpc.GetManagedPositionsResponse
-	201, // 373: gctrpc.GoCryptoTraderService.GetFundingRates:output_type -> gctrpc.GetFundingRatesResponse
-	203, // 374: gctrpc.GoCryptoTraderService.GetLatestFundingRate:output_type -> gctrpc.GetLatestFundingRateResponse
-	215, // 375: gctrpc.GoCryptoTraderService.GetOrderbookMovement:output_type -> gctrpc.GetOrderbookMovementResponse
-	217, // 376: gctrpc.GoCryptoTraderService.GetOrderbookAmountByNominal:output_type -> gctrpc.GetOrderbookAmountByNominalResponse
-	219, // 377: gctrpc.GoCryptoTraderService.GetOrderbookAmountByImpact:output_type -> gctrpc.GetOrderbookAmountByImpactResponse
-	182, // 378: gctrpc.GoCryptoTraderService.GetCollateralMode:output_type -> gctrpc.GetCollateralModeResponse
-	192, // 379: gctrpc.GoCryptoTraderService.GetLeverage:output_type -> gctrpc.GetLeverageResponse
-	184, // 380: gctrpc.GoCryptoTraderService.SetCollateralMode:output_type -> gctrpc.SetCollateralModeResponse
-	190, // 381: gctrpc.GoCryptoTraderService.SetMarginType:output_type -> gctrpc.SetMarginTypeResponse
-	194, // 382: gctrpc.GoCryptoTraderService.SetLeverage:output_type -> gctrpc.SetLeverageResponse
-	188, // 383: gctrpc.GoCryptoTraderService.ChangePositionMargin:output_type -> gctrpc.ChangePositionMarginResponse
-	222, // 384: gctrpc.GoCryptoTraderService.GetOpenInterest:output_type -> gctrpc.GetOpenInterestResponse
-	225, // 385: gctrpc.GoCryptoTraderService.GetCurrencyTradeURL:output_type -> gctrpc.GetCurrencyTradeURLResponse
-	271, // [271:386] is the sub-list for method output_type
-	156, // [156:271] is the sub-list for method input_type
-	156, // [156:156] is the sub-list for extension type_name
-	156, // [156:156] is the sub-list for extension extendee
-	0,   // [0:156] is the sub-list for field type_name
+	74,  // 44: gctrpc.EventCondition.condition_params:type_name -> gctrpc.ConditionParams
+	75,  // 45: gctrpc.EventCondition.conditions:type_name -> gctrpc.EventCondition
+	74,  // 46: gctrpc.EventDetails.condition_params:type_name -> gctrpc.ConditionParams
+	21,  // 47: gctrpc.EventDetails.pair:type_name -> gctrpc.CurrencyPair
+	75,  // 48: gctrpc.EventDetails.conditions:type_name -> gctrpc.EventCondition
+	76,  // 49: gctrpc.EventDetails.actions:type_name -> gctrpc.EventAction
+	77,  // 50: gctrpc.GetEventsResponse.events:type_name -> gctrpc.EventDetails
+	74,  // 51: gctrpc.AddEventRequest.condition_params:type_name -> gctrpc.ConditionParams
+	21,  // 52: gctrpc.AddEventRequest.pair:type_name -> gctrpc.CurrencyPair
+	75,  // 53: gctrpc.AddEventRequest.conditions:type_name -> gctrpc.EventCondition
+	76,  // 54: gctrpc.AddEventRequest.actions:type_name -> gctrpc.EventAction
+	83,  // 55: gctrpc.DepositAddresses.addresses:type_name -> gctrpc.DepositAddress
+	284, // 56: gctrpc.GetCryptocurrencyDepositAddressesResponse.addresses:type_name -> gctrpc.GetCryptocurrencyDepositAddressesResponse.AddressesEntry
+	98,  // 57: gctrpc.WithdrawalEventByIDResponse.event:type_name -> gctrpc.WithdrawalEventResponse
+	98,  // 58: gctrpc.WithdrawalEventsByExchangeResponse.event:type_name -> gctrpc.WithdrawalEventResponse
+	99,  // 59: gctrpc.WithdrawalEventResponse.exchange:type_name -> gctrpc.WithdrawlExchangeEvent
+	100, // 60: gctrpc.WithdrawalEventResponse.request:type_name -> gctrpc.WithdrawalRequestEvent
+	288, // 61: gctrpc.WithdrawalEventResponse.created_at:type_name -> google.protobuf.Timestamp
+	288, // 62: gctrpc.WithdrawalEventResponse.updated_at:type_name -> google.protobuf.Timestamp
+	101, // 63: gctrpc.WithdrawalRequestEvent.fiat:type_name -> gctrpc.FiatWithdrawalEvent
+	102, // 64: gctrpc.WithdrawalRequestEvent.crypto:type_name -> gctrpc.CryptoWithdrawalEvent
+	288, // 65: gctrpc.WithdrawalApproval.time:type_name -> google.protobuf.Timestamp
+	100, // 66: gctrpc.PendingWithdrawal.request:type_name -> gctrpc.WithdrawalRequestEvent
+	104, // 67: gctrpc.PendingWithdrawal.approvals:type_name -> gctrpc.WithdrawalApproval
+	288, // 68: gctrpc.PendingWithdrawal.created_at:type_name -> google.protobuf.Timestamp
+	288, // 69: gctrpc.PendingWithdrawal.expires_at:type_name -> google.protobuf.Timestamp
+	105, // 70: gctrpc.GetPendingWithdrawalsResponse.pending:type_name -> gctrpc.PendingWithdrawal
+	285, // 71: gctrpc.GetExchangePairsResponse.supported_assets:type_name -> gctrpc.GetExchangePairsResponse.SupportedAssetsEntry
+	21,  // 72: gctrpc.SetExchangePairRequest.pairs:type_name -> gctrpc.CurrencyPair
+	21,  // 73: gctrpc.GetOrderbookStreamRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 74: gctrpc.GetTickerStreamRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 75: gctrpc.GetOrderStreamRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 76: gctrpc.AggregatedOrderbookSource.pair:type_name -> gctrpc.CurrencyPair
+	120, // 77: gctrpc.GetAggregatedOrderbookStreamRequest.sources:type_name -> gctrpc.AggregatedOrderbookSource
+	21,  // 78: gctrpc.GetAggregatedOrderbookStreamRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 79: gctrpc.AggregatedOrderbookLevelSource.pair:type_name -> gctrpc.CurrencyPair
+	122, // 80: gctrpc.AggregatedOrderbookLevel.sources:type_name -> gctrpc.AggregatedOrderbookLevelSource
+	21,  // 81: gctrpc.AggregatedOrderbookResponse.pair:type_name -> gctrpc.CurrencyPair
+	123, // 82: gctrpc.AggregatedOrderbookResponse.bids:type_name -> gctrpc.AggregatedOrderbookLevel
+	123, // 83: gctrpc.AggregatedOrderbookResponse.asks:type_name -> gctrpc.AggregatedOrderbookLevel
+	286, // 84: gctrpc.AggregatedOrderbookResponse.unavailable:type_name -> gctrpc.AggregatedOrderbookResponse.UnavailableEntry
+	134, // 85: gctrpc.GetAuditEventResponse.events:type_name -> gctrpc.AuditEvent
+	21,  // 86: gctrpc.GetSavedTradesRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 87: gctrpc.SavedTradesResponse.pair:type_name -> gctrpc.CurrencyPair
+	128, // 88: gctrpc.SavedTradesResponse.trades:type_name -> gctrpc.SavedTrades
+	21,  // 89: gctrpc.ConvertTradesToCandlesRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 90: gctrpc.GetHistoricCandlesRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 91: gctrpc.GetHistoricCandlesResponse.pair:type_name -> gctrpc.CurrencyPair
+	133, // 92: gctrpc.GetHistoricCandlesResponse.candle:type_name -> gctrpc.Candle
+	135, // 93: gctrpc.GCTScriptExecuteRequest.script:type_name -> gctrpc.GCTScript
+	135, // 94: gctrpc.GCTScriptStopRequest.script:type_name -> gctrpc.GCTScript
+	135, // 95: gctrpc.GCTScriptReadScriptRequest.script:type_name -> gctrpc.GCTScript
+	135, // 96: gctrpc.GCTScriptQueryRequest.script:type_name -> gctrpc.GCTScript
+	135, // 97: gctrpc.GCTScriptStatusResponse.scripts:type_name -> gctrpc.GCTScript
+	135, // 98: gctrpc.GCTScriptQueryResponse.script:type_name -> gctrpc.GCTScript
+	157, // 99: gctrpc.WebsocketGetSubscriptionsResponse.subscriptions:type_name -> gctrpc.WebsocketSubscription
+	21,  // 100: gctrpc.FindMissingCandlePeriodsRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 101: gctrpc.FindMissingTradePeriodsRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 102: gctrpc.FindMissingIntervalsResponse.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 103: gctrpc.UpsertDataHistoryJobRequest.pair:type_name -> gctrpc.CurrencyPair
+	165, // 104: gctrpc.InsertSequentialJobsRequest.jobs:type_name -> gctrpc.UpsertDataHistoryJobRequest
+	168, // 105: gctrpc.InsertSequentialJobsResponse.jobs:type_name -> gctrpc.UpsertDataHistoryJobResponse
+	21,  // 106: gctrpc.DataHistoryJob.pair:type_name -> gctrpc.CurrencyPair
+	171, // 107: gctrpc.DataHistoryJob.job_results:type_name -> gctrpc.DataHistoryJobResult
+	170, // 108: gctrpc.DataHistoryJobs.results:type_name -> gctrpc.DataHistoryJob
+	21,  // 109: gctrpc.ModifyOrderRequest.pair:type_name -> gctrpc.CurrencyPair
+	184, // 110: gctrpc.CurrencyStateResponse.currency_states:type_name -> gctrpc.CurrencyState
+	21,  // 111: gctrpc.FundingData.pair:type_name -> gctrpc.CurrencyPair
+	185, // 112: gctrpc.FundingData.rates:type_name -> gctrpc.FundingRate
+	185, // 113: gctrpc.FundingData.latest_rate:type_name -> gctrpc.FundingRate
+	185, // 114: gctrpc.FundingData.upcoming_rate:type_name -> gctrpc.FundingRate
+	21,  // 115: gctrpc.FuturePosition.pair:type_name -> gctrpc.CurrencyPair
+	56,  // 116: gctrpc.FuturePosition.orders:type_name -> gctrpc.OrderDetails
+	187, // 117: gctrpc.FuturePosition.position_stats:type_name -> gctrpc.FuturesPositionStats
+	186, // 118: gctrpc.FuturePosition.funding_data:type_name -> gctrpc.FundingData
+	21,  // 119: gctrpc.GetManagedPositionRequest.pair:type_name -> gctrpc.CurrencyPair
+	188, // 120: gctrpc.GetManagedPositionsResponse.positions:type_name -> gctrpc.FuturePosition
+	21,  // 121: gctrpc.GetFuturesPositionsSummaryRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 122: gctrpc.GetFuturesPositionsSummaryRequest.underlying_pair:type_name -> gctrpc.CurrencyPair
+	21,  // 123: gctrpc.GetFuturesPositionsSummaryResponse.pair:type_name -> gctrpc.CurrencyPair
+	187, // 124: gctrpc.GetFuturesPositionsSummaryResponse.position_stats:type_name -> gctrpc.FuturesPositionStats
+	21,  // 125: gctrpc.GetFuturesPositionsOrdersRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 126: gctrpc.GetFuturesPositionsOrdersRequest.underlying_pair:type_name -> gctrpc.CurrencyPair
+	188, // 127: gctrpc.GetFuturesPositionsOrdersResponse.positions:type_name -> gctrpc.FuturePosition
+	21,  // 128: gctrpc.GetMarginTypeRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 129: gctrpc.GetMarginTypeResponse.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 130: gctrpc.ChangePositionMarginRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 131: gctrpc.ChangePositionMarginResponse.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 132: gctrpc.SetMarginTypeRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 133: gctrpc.SetMarginTypeResponse.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 134: gctrpc.GetLeverageRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 135: gctrpc.GetLeverageRequest.underlying_pair:type_name -> gctrpc.CurrencyPair
+	21,  // 136: gctrpc.GetLeverageResponse.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 137: gctrpc.GetLeverageResponse.underlying_pair:type_name -> gctrpc.CurrencyPair
+	21,  // 138: gctrpc.SetLeverageRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 139: gctrpc.SetLeverageRequest.underlying_pair:type_name -> gctrpc.CurrencyPair
+	21,  // 140: gctrpc.SetLeverageResponse.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 141: gctrpc.SetLeverageResponse.underlying_pair:type_name -> gctrpc.CurrencyPair
+	214, // 142: gctrpc.GetCollateralResponse.used_breakdown:type_name -> gctrpc.CollateralUsedBreakdown
+	212, // 143: gctrpc.GetCollateralResponse.currency_breakdown:type_name -> gctrpc.CollateralForCurrency
+	213, // 144: gctrpc.GetCollateralResponse.position_breakdown:type_name -> gctrpc.CollateralByPosition
+	214, // 145: gctrpc.CollateralForCurrency.used_breakdown:type_name -> gctrpc.CollateralUsedBreakdown
+	21,  // 146: gctrpc.GetFundingRatesRequest.pair:type_name -> gctrpc.CurrencyPair
+	186, // 147: gctrpc.GetFundingRatesResponse.rates:type_name -> gctrpc.FundingData
+	21,  // 148: gctrpc.GetLatestFundingRateRequest.pair:type_name -> gctrpc.CurrencyPair
+	186, // 149: gctrpc.GetLatestFundingRateResponse.rate:type_name -> gctrpc.FundingData
+	21,  // 150: gctrpc.GetTechnicalAnalysisRequest.pair:type_name -> gctrpc.CurrencyPair
+	288, // 151: gctrpc.GetTechnicalAnalysisRequest.start:type_name -> google.protobuf.Timestamp
+	288, // 152: gctrpc.GetTechnicalAnalysisRequest.end:type_name -> google.protobuf.Timestamp
+	21,  // 153: gctrpc.GetTechnicalAnalysisRequest.other_pair:type_name -> gctrpc.CurrencyPair
+	287, // 154: gctrpc.GetTechnicalAnalysisResponse.signals:type_name -> gctrpc.GetTechnicalAnalysisResponse.SignalsEntry
+	227, // 155: gctrpc.GetMarginRatesHistoryRequest.rates:type_name -> gctrpc.MarginRate
+	225, // 156: gctrpc.MarginRate.lending_payment:type_name -> gctrpc.LendingPayment
+	226, // 157: gctrpc.MarginRate.borrow_cost:type_name -> gctrpc.BorrowCost
+	227, // 158: gctrpc.GetMarginRatesHistoryResponse.rates:type_name -> gctrpc.MarginRate
+	227, // 159: gctrpc.GetMarginRatesHistoryResponse.latest_rate:type_name -> gctrpc.MarginRate
+	227, // 160: gctrpc.GetMarginRatesHistoryResponse.predicted_rate:type_name -> gctrpc.MarginRate
+	21,  // 161: gctrpc.GetOrderbookMovementRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 162: gctrpc.GetOrderbookAmountByNominalRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 163: gctrpc.GetOrderbookAmountByImpactRequest.pair:type_name -> gctrpc.CurrencyPair
+	236, // 164: gctrpc.GetOpenInterestRequest.data:type_name -> gctrpc.OpenInterestDataRequest
+	21,  // 165: gctrpc.OpenInterestDataRequest.pair:type_name -> gctrpc.CurrencyPair
+	238, // 166: gctrpc.GetOpenInterestResponse.data:type_name -> gctrpc.OpenInterestDataResponse
+	21,  // 167: gctrpc.OpenInterestDataResponse.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 168: gctrpc.GetCurrencyTradeURLRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 169: gctrpc.ConditionalOrder.pair:type_name -> gctrpc.CurrencyPair
+	288, // 170: gctrpc.ConditionalOrder.created_at:type_name -> google.protobuf.Timestamp
+	288, // 171: gctrpc.ConditionalOrder.updated_at:type_name -> google.protobuf.Timestamp
+	21,  // 172: gctrpc.AddConditionalOrderRequest.pair:type_name -> gctrpc.CurrencyPair
+	241, // 173: gctrpc.GetConditionalOrdersResponse.orders:type_name -> gctrpc.ConditionalOrder
+	21,  // 174: gctrpc.RouteOrderRequest.pair:type_name -> gctrpc.CurrencyPair
+	27,  // 175: gctrpc.RouteOrderLeg.orders:type_name -> gctrpc.OrderbookItem
+	21,  // 176: gctrpc.RouteOrderResponse.pair:type_name -> gctrpc.CurrencyPair
+	248, // 177: gctrpc.RouteOrderResponse.legs:type_name -> gctrpc.RouteOrderLeg
+	249, // 178: gctrpc.RouteOrderResponse.skipped:type_name -> gctrpc.RouteOrderSkipped
+	252, // 179: gctrpc.GetLedgerPNLResponse.currencies:type_name -> gctrpc.LedgerCurrencyPNL
+	21,  // 180: gctrpc.LedgerDisposal.pair:type_name -> gctrpc.CurrencyPair
+	288, // 181: gctrpc.LedgerDisposal.time:type_name -> google.protobuf.Timestamp
+	21,  // 182: gctrpc.LedgerLot.pair:type_name -> gctrpc.CurrencyPair
+	288, // 183: gctrpc.LedgerLot.acquired_at:type_name -> google.protobuf.Timestamp
+	255, // 184: gctrpc.LedgerLot.disposals:type_name -> gctrpc.LedgerDisposal
+	256, // 185: gctrpc.GetLedgerLotsResponse.lots:type_name -> gctrpc.LedgerLot
+	21,  // 186: gctrpc.RebalanceTrade.pair:type_name -> gctrpc.CurrencyPair
+	263, // 187: gctrpc.RebalancePlanResponse.allocations:type_name -> gctrpc.RebalanceAllocation
+	264, // 188: gctrpc.RebalancePlanResponse.trades:type_name -> gctrpc.RebalanceTrade
+	265, // 189: gctrpc.RebalancePlanResponse.skipped:type_name -> gctrpc.RebalanceSkipped
+	288, // 190: gctrpc.RebalancePlanResponse.created_at:type_name -> google.protobuf.Timestamp
+	288, // 191: gctrpc.RebalancePlanResponse.expires_at:type_name -> google.protobuf.Timestamp
+	288, // 192: gctrpc.RebalancePlanResponse.executed_at:type_name -> google.protobuf.Timestamp
+	21,  // 193: gctrpc.GetOrderbookAnalyticsRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 194: gctrpc.UntrackOrderbookAnalyticsRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 195: gctrpc.OrderbookAnalyticsResponse.pair:type_name -> gctrpc.CurrencyPair
+	288, // 196: gctrpc.OrderbookAnalyticsResponse.last_updated:type_name -> google.protobuf.Timestamp
+	269, // 197: gctrpc.OrderbookAnalyticsResponse.spread_stats:type_name -> gctrpc.OrderbookAnalyticsSpreadStats
+	270, // 198: gctrpc.OrderbookAnalyticsResponse.bands:type_name -> gctrpc.OrderbookAnalyticsBand
+	271, // 199: gctrpc.OrderbookAnalyticsResponse.bids:type_name -> gctrpc.OrderbookAnalyticsFlow
+	271, // 200: gctrpc.OrderbookAnalyticsResponse.asks:type_name -> gctrpc.OrderbookAnalyticsFlow
+	9,   // 201: gctrpc.GetInfoResponse.RpcEndpointsEntry.value:type_name -> gctrpc.RPCEndpoint
+	3,   // 202: gctrpc.GetCommunicationRelayersResponse.CommunicationRelayersEntry.value:type_name -> gctrpc.CommunicationRelayer
+	9,   // 203: gctrpc.GetRPCEndpointsResponse.EndpointsEntry.value:type_name -> gctrpc.RPCEndpoint
+	18,  // 204: gctrpc.GetExchangeInfoResponse.SupportedAssetsEntry.value:type_name -> gctrpc.PairsSupported
+	44,  // 205: gctrpc.OnlineCoins.CoinsEntry.value:type_name -> gctrpc.OnlineCoinSummary
+	45,  // 206: gctrpc.GetPortfolioSummaryResponse.CoinsOfflineSummaryEntry.value:type_name -> gctrpc.OfflineCoins
+	46,  // 207: gctrpc.GetPortfolioSummaryResponse.CoinsOnlineSummaryEntry.value:type_name -> gctrpc.OnlineCoins
+	84,  // 208: gctrpc.GetCryptocurrencyDepositAddressesResponse.AddressesEntry.value:type_name -> gctrpc.DepositAddresses
+	18,  // 209: gctrpc.GetExchangePairsResponse.SupportedAssetsEntry.value:type_name -> gctrpc.PairsSupported
+	222, // 210: gctrpc.GetTechnicalAnalysisResponse.SignalsEntry.value:type_name -> gctrpc.ListOfSignals
+	0,   // 211: gctrpc.GoCryptoTraderService.GetInfo:input_type -> gctrpc.GetInfoRequest
+	6,   // 212: gctrpc.GoCryptoTraderService.GetSubsystems:input_type -> gctrpc.GetSubsystemsRequest
+	5,   // 213: gctrpc.GoCryptoTraderService.EnableSubsystem:input_type -> gctrpc.GenericSubsystemRequest
+	5,   // 214: gctrpc.GoCryptoTraderService.DisableSubsystem:input_type -> gctrpc.GenericSubsystemRequest
+	8,   // 215: gctrpc.GoCryptoTraderService.GetRPCEndpoints:input_type -> gctrpc.GetRPCEndpointsRequest
+	2,   // 216: gctrpc.GoCryptoTraderService.GetCommunicationRelayers:input_type -> gctrpc.GetCommunicationRelayersRequest
+	12,  // 217: gctrpc.GoCryptoTraderService.GetExchanges:input_type -> gctrpc.GetExchangesRequest
+	11,  // 218: gctrpc.GoCryptoTraderService.DisableExchange:input_type -> gctrpc.GenericExchangeNameRequest
+	11,  // 219: gctrpc.GoCryptoTraderService.GetExchangeInfo:input_type -> gctrpc.GenericExchangeNameRequest
+	11,  // 220: gctrpc.GoCryptoTraderService.GetExchangeOTPCode:input_type -> gctrpc.GenericExchangeNameRequest
+	15,  // 221: gctrpc.GoCryptoTraderService.GetExchangeOTPCodes:input_type -> gctrpc.GetExchangeOTPsRequest
+	11,  // 222: gctrpc.GoCryptoTraderService.EnableExchange:input_type -> gctrpc.GenericExchangeNameRequest
+	20,  // 223: gctrpc.GoCryptoTraderService.GetTicker:input_type -> gctrpc.GetTickerRequest
+	23,  // 224: gctrpc.GoCryptoTraderService.GetTickers:input_type -> gctrpc.GetTickersRequest
+	26,  // 225: gctrpc.GoCryptoTraderService.GetOrderbook:input_type -> gctrpc.GetOrderbookRequest
+	29,  // 226: gctrpc.GoCryptoTraderService.GetOrderbooks:input_type -> gctrpc.GetOrderbooksRequest
+	32,  // 227: gctrpc.GoCryptoTraderService.GetAccountBalances:input_type -> gctrpc.GetAccountBalancesRequest
+	32,  // 228: gctrpc.GoCryptoTraderService.UpdateAccountBalances:input_type -> gctrpc.GetAccountBalancesRequest
+	32,  // 229: gctrpc.GoCryptoTraderService.GetAccountBalancesStream:input_type -> gctrpc.GetAccountBalancesRequest
+	36,  // 230: gctrpc.GoCryptoTraderService.GetConfig:input_type -> gctrpc.GetConfigRequest
+	39,  // 231: gctrpc.GoCryptoTraderService.GetPortfolio:input_type -> gctrpc.GetPortfolioRequest
+	41,  // 232: gctrpc.GoCryptoTraderService.GetPortfolioSummary:input_type -> gctrpc.GetPortfolioSummaryRequest
+	48,  // 233: gctrpc.GoCryptoTraderService.AddPortfolioAddress:input_type -> gctrpc.AddPortfolioAddressRequest
+	49,  // 234: gctrpc.GoCryptoTraderService.RemovePortfolioAddress:input_type -> gctrpc.RemovePortfolioAddressRequest
+	50,  // 235: gctrpc.GoCryptoTraderService.GetForexProviders:input_type -> gctrpc.GetForexProvidersRequest
+	53,  // 236: gctrpc.GoCryptoTraderService.GetForexRates:input_type -> gctrpc.GetForexRatesRequest
+	58,  // 237: gctrpc.GoCryptoTraderService.GetOrders:input_type -> gctrpc.GetOrdersRequest
+	60,  // 238: gctrpc.GoCryptoTraderService.GetOrder:input_type -> gctrpc.GetOrderRequest
+	61,  // 239: gctrpc.GoCryptoTraderService.SubmitOrder:input_type -> gctrpc.SubmitOrderRequest
+	64,  // 240: gctrpc.GoCryptoTraderService.SimulateOrder:input_type -> gctrpc.SimulateOrderRequest
+	66,  // 241: gctrpc.GoCryptoTraderService.WhaleBomb:input_type -> gctrpc.WhaleBombRequest
+	67,  // 242: gctrpc.GoCryptoTraderService.CancelOrder:input_type -> gctrpc.CancelOrderRequest
+	68,  // 243: gctrpc.GoCryptoTraderService.CancelBatchOrders:input_type -> gctrpc.CancelBatchOrdersRequest
+	71,  // 244: gctrpc.GoCryptoTraderService.CancelAllOrders:input_type -> gctrpc.CancelAllOrdersRequest
+	73,  // 245: gctrpc.GoCryptoTraderService.GetEvents:input_type -> gctrpc.GetEventsRequest
+	79,  // 246: gctrpc.GoCryptoTraderService.AddEvent:input_type -> gctrpc.AddEventRequest
+	81,  // 247: gctrpc.GoCryptoTraderService.RemoveEvent:input_type -> gctrpc.RemoveEventRequest
+	82,  // 248: gctrpc.GoCryptoTraderService.GetCryptocurrencyDepositAddresses:input_type -> gctrpc.GetCryptocurrencyDepositAddressesRequest
+	86,  // 249: gctrpc.GoCryptoTraderService.GetCryptocurrencyDepositAddress:input_type -> gctrpc.GetCryptocurrencyDepositAddressRequest
+	88,  // 250: gctrpc.GoCryptoTraderService.GetAvailableTransferChains:input_type -> gctrpc.GetAvailableTransferChainsRequest
+	90,  // 251: gctrpc.GoCryptoTraderService.WithdrawFiatFunds:input_type -> gctrpc.WithdrawFiatRequest
+	91,  // 252: gctrpc.GoCryptoTraderService.WithdrawCryptocurrencyFunds:input_type -> gctrpc.WithdrawCryptoRequest
+	93,  // 253: gctrpc.GoCryptoTraderService.WithdrawalEventByID:input_type -> gctrpc.WithdrawalEventByIDRequest
+	95,  // 254: gctrpc.GoCryptoTraderService.WithdrawalEventsByExchange:input_type -> gctrpc.WithdrawalEventsByExchangeRequest
+	96,  // 255: gctrpc.GoCryptoTraderService.WithdrawalEventsByDate:input_type -> gctrpc.WithdrawalEventsByDateRequest
+	103, // 256: gctrpc.GoCryptoTraderService.GetPendingWithdrawals:input_type -> gctrpc.GetPendingWithdrawalsRequest
+	107, // 257: gctrpc.GoCryptoTraderService.ApproveWithdrawal:input_type -> gctrpc.ApproveWithdrawalRequest
+	108, // 258: gctrpc.GoCryptoTraderService.RejectWithdrawal:input_type -> gctrpc.RejectWithdrawalRequest
+	109, // 259: gctrpc.GoCryptoTraderService.GetLoggerDetails:input_type -> gctrpc.GetLoggerDetailsRequest
+	111, // 260: gctrpc.GoCryptoTraderService.SetLoggerDetails:input_type -> gctrpc.SetLoggerDetailsRequest
+	112, // 261: gctrpc.GoCryptoTraderService.GetExchangePairs:input_type -> gctrpc.GetExchangePairsRequest
+	114, // 262: gctrpc.GoCryptoTraderService.SetExchangePair:input_type -> gctrpc.SetExchangePairRequest
+	115, // 263: gctrpc.GoCryptoTraderService.GetOrderbookStream:input_type -> gctrpc.GetOrderbookStreamRequest
+	116, // 264: gctrpc.GoCryptoTraderService.GetExchangeOrderbookStream:input_type -> gctrpc.GetExchangeOrderbookStreamRequest
+	117, // 265: gctrpc.GoCryptoTraderService.GetTickerStream:input_type -> gctrpc.GetTickerStreamRequest
+	118, // 266: gctrpc.GoCryptoTraderService.GetExchangeTickerStream:input_type -> gctrpc.GetExchangeTickerStreamRequest
+	119, // 267: gctrpc.GoCryptoTraderService.GetOrderStream:input_type -> gctrpc.GetOrderStreamRequest
+	121, // 268: gctrpc.GoCryptoTraderService.GetAggregatedOrderbookStream:input_type -> gctrpc.GetAggregatedOrderbookStreamRequest
+	125, // 269: gctrpc.GoCryptoTraderService.GetAuditEvent:input_type -> gctrpc.GetAuditEventRequest
+	136, // 270: gctrpc.GoCryptoTraderService.GCTScriptExecute:input_type -> gctrpc.GCTScriptExecuteRequest
+	141, // 271: gctrpc.GoCryptoTraderService.GCTScriptUpload:input_type -> gctrpc.GCTScriptUploadRequest
+	142, // 272: gctrpc.GoCryptoTraderService.GCTScriptReadScript:input_type -> gctrpc.GCTScriptReadScriptRequest
+	139, // 273: gctrpc.GoCryptoTraderService.GCTScriptStatus:input_type -> gctrpc.GCTScriptStatusRequest
+	143, // 274: gctrpc.GoCryptoTraderService.GCTScriptQuery:input_type -> gctrpc.GCTScriptQueryRequest
+	137, // 275: gctrpc.GoCryptoTraderService.GCTScriptStop:input_type -> gctrpc.GCTScriptStopRequest
+	138, // 276: gctrpc.GoCryptoTraderService.GCTScriptStopAll:input_type -> gctrpc.GCTScriptStopAllRequest
+	140, // 277: gctrpc.GoCryptoTraderService.GCTScriptListAll:input_type -> gctrpc.GCTScriptListAllRequest
+	144, // 278: gctrpc.GoCryptoTraderService.GCTScriptAutoLoadToggle:input_type -> gctrpc.GCTScriptAutoLoadRequest
+	131, // 279: gctrpc.GoCryptoTraderService.GetHistoricCandles:input_type -> gctrpc.GetHistoricCandlesRequest
+	148, // 280: gctrpc.GoCryptoTraderService.SetExchangeAsset:input_type -> gctrpc.SetExchangeAssetRequest
+	149, // 281: gctrpc.GoCryptoTraderService.SetAllExchangePairs:input_type -> gctrpc.SetExchangeAllPairsRequest
+	150, // 282: gctrpc.GoCryptoTraderService.UpdateExchangeSupportedPairs:input_type -> gctrpc.UpdateExchangeSupportedPairsRequest
+	151, // 283: gctrpc.GoCryptoTraderService.GetExchangeAssets:input_type -> gctrpc.GetExchangeAssetsRequest
+	153, // 284: gctrpc.GoCryptoTraderService.WebsocketGetInfo:input_type -> gctrpc.WebsocketGetInfoRequest
+	155, // 285: gctrpc.GoCryptoTraderService.WebsocketSetEnabled:input_type -> gctrpc.WebsocketSetEnabledRequest
+	156, // 286: gctrpc.GoCryptoTraderService.WebsocketGetSubscriptions:input_type -> gctrpc.WebsocketGetSubscriptionsRequest
+	159, // 287: gctrpc.GoCryptoTraderService.WebsocketSetProxy:input_type -> gctrpc.WebsocketSetProxyRequest
+	160, // 288: gctrpc.GoCryptoTraderService.WebsocketSetURL:input_type -> gctrpc.WebsocketSetURLRequest
+	127, // 289: gctrpc.GoCryptoTraderService.GetRecentTrades:input_type -> gctrpc.GetSavedTradesRequest
+	127, // 290: gctrpc.GoCryptoTraderService.GetHistoricTrades:input_type -> gctrpc.GetSavedTradesRequest
+	127, // 291: gctrpc.GoCryptoTraderService.GetSavedTrades:input_type -> gctrpc.GetSavedTradesRequest
+	130, // 292: gctrpc.GoCryptoTraderService.ConvertTradesToCandles:input_type -> gctrpc.ConvertTradesToCandlesRequest
+	161, // 293: gctrpc.GoCryptoTraderService.FindMissingSavedCandleIntervals:input_type -> gctrpc.FindMissingCandlePeriodsRequest
+	162, // 294: gctrpc.GoCryptoTraderService.FindMissingSavedTradeIntervals:input_type -> gctrpc.FindMissingTradePeriodsRequest
+	164, // 295: gctrpc.GoCryptoTraderService.SetExchangeTradeProcessing:input_type -> gctrpc.SetExchangeTradeProcessingRequest
+	165, // 296: gctrpc.GoCryptoTraderService.UpsertDataHistoryJob:input_type -> gctrpc.UpsertDataHistoryJobRequest
+	169, // 297: gctrpc.GoCryptoTraderService.GetDataHistoryJobDetails:input_type -> gctrpc.GetDataHistoryJobDetailsRequest
+	0,   // 298: gctrpc.GoCryptoTraderService.GetActiveDataHistoryJobs:input_type -> gctrpc.GetInfoRequest
+	173, // 299: gctrpc.GoCryptoTraderService.GetDataHistoryJobsBetween:input_type -> gctrpc.GetDataHistoryJobsBetweenRequest
+	169, // 300: gctrpc.GoCryptoTraderService.GetDataHistoryJobSummary:input_type -> gctrpc.GetDataHistoryJobDetailsRequest
+	174, // 301: gctrpc.GoCryptoTraderService.SetDataHistoryJobStatus:input_type -> gctrpc.SetDataHistoryJobStatusRequest
+	175, // 302: gctrpc.GoCryptoTraderService.UpdateDataHistoryJobPrerequisite:input_type -> gctrpc.UpdateDataHistoryJobPrerequisiteRequest
+	58,  // 303: gctrpc.GoCryptoTraderService.GetManagedOrders:input_type -> gctrpc.GetOrdersRequest
+	176, // 304: gctrpc.GoCryptoTraderService.ModifyOrder:input_type -> gctrpc.ModifyOrderRequest
+	178, // 305: gctrpc.GoCryptoTraderService.CurrencyStateGetAll:input_type -> gctrpc.CurrencyStateGetAllRequest
+	179, // 306: gctrpc.GoCryptoTraderService.CurrencyStateTrading:input_type -> gctrpc.CurrencyStateTradingRequest
+	182, // 307: gctrpc.GoCryptoTraderService.CurrencyStateDeposit:input_type -> gctrpc.CurrencyStateDepositRequest
+	181, // 308: gctrpc.GoCryptoTraderService.CurrencyStateWithdraw:input_type -> gctrpc.CurrencyStateWithdrawRequest
+	180, // 309: gctrpc.GoCryptoTraderService.CurrencyStateTradingPair:input_type -> gctrpc.CurrencyStateTradingPairRequest
+	192, // 310: gctrpc.GoCryptoTraderService.GetFuturesPositionsSummary:input_type -> gctrpc.GetFuturesPositionsSummaryRequest
+	194, // 311: gctrpc.GoCryptoTraderService.GetFuturesPositionsOrders:input_type -> gctrpc.GetFuturesPositionsOrdersRequest
+	210, // 312: gctrpc.GoCryptoTraderService.GetCollateral:input_type -> gctrpc.GetCollateralRequest
+	219, // 313: gctrpc.GoCryptoTraderService.Shutdown:input_type -> gctrpc.ShutdownRequest
+	221, // 314: gctrpc.GoCryptoTraderService.GetTechnicalAnalysis:input_type -> gctrpc.GetTechnicalAnalysisRequest
+	224, // 315: gctrpc.GoCryptoTraderService.GetMarginRatesHistory:input_type -> gctrpc.GetMarginRatesHistoryRequest
+	189, // 316: gctrpc.GoCryptoTraderService.GetManagedPosition:input_type -> gctrpc.GetManagedPositionRequest
+	190, // 317: gctrpc.GoCryptoTraderService.GetAllManagedPositions:input_type -> gctrpc.GetAllManagedPositionsRequest
+	215, // 318: gctrpc.GoCryptoTraderService.GetFundingRates:input_type -> gctrpc.GetFundingRatesRequest
+	217, // 319: gctrpc.GoCryptoTraderService.GetLatestFundingRate:input_type -> gctrpc.GetLatestFundingRateRequest
+	229, // 320: gctrpc.GoCryptoTraderService.GetOrderbookMovement:input_type -> gctrpc.GetOrderbookMovementRequest
+	231, // 321: gctrpc.GoCryptoTraderService.GetOrderbookAmountByNominal:input_type -> gctrpc.GetOrderbookAmountByNominalRequest
+	233, // 322: gctrpc.GoCryptoTraderService.GetOrderbookAmountByImpact:input_type -> gctrpc.GetOrderbookAmountByImpactRequest
+	196, // 323: gctrpc.GoCryptoTraderService.GetCollateralMode:input_type -> gctrpc.GetCollateralModeRequest
+	206, // 324: gctrpc.GoCryptoTraderService.GetLeverage:input_type -> gctrpc.GetLeverageRequest
+	198, // 325: gctrpc.GoCryptoTraderService.SetCollateralMode:input_type -> gctrpc.SetCollateralModeRequest
+	204, // 326: gctrpc.GoCryptoTraderService.SetMarginType:input_type -> gctrpc.SetMarginTypeRequest
+	208, // 327: gctrpc.GoCryptoTraderService.SetLeverage:input_type -> gctrpc.SetLeverageRequest
+	202, // 328: gctrpc.GoCryptoTraderService.ChangePositionMargin:input_type -> gctrpc.ChangePositionMarginRequest
+	235, // 329: gctrpc.GoCryptoTraderService.GetOpenInterest:input_type -> gctrpc.GetOpenInterestRequest
+	239, // 330: gctrpc.GoCryptoTraderService.GetCurrencyTradeURL:input_type -> gctrpc.GetCurrencyTradeURLRequest
+	242, // 331: gctrpc.GoCryptoTraderService.AddConditionalOrder:input_type -> gctrpc.AddConditionalOrderRequest
+	243, // 332: gctrpc.GoCryptoTraderService.GetConditionalOrders:input_type -> gctrpc.GetConditionalOrdersRequest
+	245, // 333: gctrpc.GoCryptoTraderService.CancelConditionalOrder:input_type -> gctrpc.CancelConditionalOrderRequest
+	246, // 334: gctrpc.GoCryptoTraderService.SetKillSwitch:input_type -> gctrpc.SetKillSwitchRequest
+	247, // 335: gctrpc.GoCryptoTraderService.RouteOrder:input_type -> gctrpc.RouteOrderRequest
+	251, // 336: gctrpc.GoCryptoTraderService.GetLedgerPNL:input_type -> gctrpc.GetLedgerPNLRequest
+	254, // 337: gctrpc.GoCryptoTraderService.GetLedgerLots:input_type -> gctrpc.GetLedgerLotsRequest
+	258, // 338: gctrpc.GoCryptoTraderService.ExportLedgerCSV:input_type -> gctrpc.ExportLedgerCSVRequest
+	260, // 339: gctrpc.GoCryptoTraderService.PreviewRebalance:input_type -> gctrpc.PreviewRebalanceRequest
+	261, // 340: gctrpc.GoCryptoTraderService.GetRebalancePlan:input_type -> gctrpc.GetRebalancePlanRequest
+	262, // 341: gctrpc.GoCryptoTraderService.ApproveRebalancePlan:input_type -> gctrpc.ApproveRebalancePlanRequest
+	267, // 342: gctrpc.GoCryptoTraderService.GetOrderbookAnalytics:input_type -> gctrpc.GetOrderbookAnalyticsRequest
+	267, // 343: gctrpc.GoCryptoTraderService.GetOrderbookAnalyticsStream:input_type -> gctrpc.GetOrderbookAnalyticsRequest
+	268, // 344: gctrpc.GoCryptoTraderService.UntrackOrderbookAnalytics:input_type -> gctrpc.UntrackOrderbookAnalyticsRequest
+	1,   // 345: gctrpc.GoCryptoTraderService.GetInfo:output_type -> gctrpc.GetInfoResponse
+	7,   // 346: gctrpc.GoCryptoTraderService.GetSubsystems:output_type -> gctrpc.GetSusbsytemsResponse
+	147, // 347: gctrpc.GoCryptoTraderService.EnableSubsystem:output_type -> gctrpc.GenericResponse
+	147, // 348: gctrpc.GoCryptoTraderService.DisableSubsystem:output_type -> gctrpc.GenericResponse
+	10,  // 349: gctrpc.GoCryptoTraderService.GetRPCEndpoints:output_type -> gctrpc.GetRPCEndpointsResponse
+	4,   // 350: gctrpc.GoCryptoTraderService.GetCommunicationRelayers:output_type -> gctrpc.GetCommunicationRelayersResponse
+	13,  // 351: gctrpc.GoCryptoTraderService.GetExchanges:output_type -> gctrpc.GetExchangesResponse
+	147, // 352: gctrpc.GoCryptoTraderService.DisableExchange:output_type -> gctrpc.GenericResponse
+	19,  // 353: gctrpc.GoCryptoTraderService.GetExchangeInfo:output_type -> gctrpc.GetExchangeInfoResponse
+	14,  // 354: gctrpc.GoCryptoTraderService.GetExchangeOTPCode:output_type -> gctrpc.GetExchangeOTPResponse
+	16,  // 355: gctrpc.GoCryptoTraderService.GetExchangeOTPCodes:output_type -> gctrpc.GetExchangeOTPsResponse
+	147, // 356: gctrpc.GoCryptoTraderService.EnableExchange:output_type -> gctrpc.GenericResponse
+	22,  // 357: gctrpc.GoCryptoTraderService.GetTicker:output_type -> gctrpc.TickerResponse
+	25,  // 358: gctrpc.GoCryptoTraderService.GetTickers:output_type -> gctrpc.GetTickersResponse
+	28,  // 359: gctrpc.GoCryptoTraderService.GetOrderbook:output_type -> gctrpc.OrderbookResponse
+	31,  // 360: gctrpc.GoCryptoTraderService.GetOrderbooks:output_type -> gctrpc.GetOrderbooksResponse
+	35,  // 361: gctrpc.GoCryptoTraderService.GetAccountBalances:output_type -> gctrpc.GetAccountBalancesResponse
+	35,  // 362: gctrpc.GoCryptoTraderService.UpdateAccountBalances:output_type -> gctrpc.GetAccountBalancesResponse
+	35,  // 363: gctrpc.GoCryptoTraderService.GetAccountBalancesStream:output_type -> gctrpc.GetAccountBalancesResponse
+	37,  // 364: gctrpc.GoCryptoTraderService.GetConfig:output_type -> gctrpc.GetConfigResponse
+	40,  // 365: gctrpc.GoCryptoTraderService.GetPortfolio:output_type -> gctrpc.GetPortfolioResponse
+	47,  // 366: gctrpc.GoCryptoTraderService.GetPortfolioSummary:output_type -> gctrpc.GetPortfolioSummaryResponse
+	147, // 367: gctrpc.GoCryptoTraderService.AddPortfolioAddress:output_type -> gctrpc.GenericResponse
+	147, // 368: gctrpc.GoCryptoTraderService.RemovePortfolioAddress:output_type -> gctrpc.GenericResponse
+	52,  // 369: gctrpc.GoCryptoTraderService.GetForexProviders:output_type -> gctrpc.GetForexProvidersResponse
+	55,  // 370: gctrpc.GoCryptoTraderService.GetForexRates:output_type -> gctrpc.GetForexRatesResponse
+	59,  // 371: gctrpc.GoCryptoTraderService.GetOrders:output_type -> gctrpc.GetOrdersResponse
+	56,  // 372: gctrpc.GoCryptoTraderService.GetOrder:output_type -> gctrpc.OrderDetails
+	63,  // 373: gctrpc.GoCryptoTraderService.SubmitOrder:output_type -> gctrpc.SubmitOrderResponse
+	65,  // 374: gctrpc.GoCryptoTraderService.SimulateOrder:output_type -> gctrpc.SimulateOrderResponse
+	65,  // 375: gctrpc.GoCryptoTraderService.WhaleBomb:output_type -> gctrpc.SimulateOrderResponse
+	147, // 376: gctrpc.GoCryptoTraderService.CancelOrder:output_type -> gctrpc.GenericResponse
+	70,  // 377: gctrpc.GoCryptoTraderService.CancelBatchOrders:output_type -> gctrpc.CancelBatchOrdersResponse
+	72,  // 378: gctrpc.GoCryptoTraderService.CancelAllOrders:output_type -> gctrpc.CancelAllOrdersResponse
+	78,  // 379: gctrpc.GoCryptoTraderService.GetEvents:output_type -> gctrpc.GetEventsResponse
+	80,  // 380: gctrpc.GoCryptoTraderService.AddEvent:output_type -> gctrpc.AddEventResponse
+	147, // 381: gctrpc.GoCryptoTraderService.RemoveEvent:output_type -> gctrpc.GenericResponse
+	85,  // 382: gctrpc.GoCryptoTraderService.GetCryptocurrencyDepositAddresses:output_type -> gctrpc.GetCryptocurrencyDepositAddressesResponse
+	87,  // 383: gctrpc.GoCryptoTraderService.GetCryptocurrencyDepositAddress:output_type -> gctrpc.GetCryptocurrencyDepositAddressResponse
+	89,  // 384: gctrpc.GoCryptoTraderService.GetAvailableTransferChains:output_type -> gctrpc.GetAvailableTransferChainsResponse
+	92,  // 385: gctrpc.GoCryptoTraderService.WithdrawFiatFunds:output_type -> gctrpc.WithdrawResponse
+	92,  // 386: gctrpc.GoCryptoTraderService.WithdrawCryptocurrencyFunds:output_type -> gctrpc.WithdrawResponse
+	94,  // 387: gctrpc.GoCryptoTraderService.WithdrawalEventByID:output_type -> gctrpc.WithdrawalEventByIDResponse
+	97,  // 388: gctrpc.GoCryptoTraderService.WithdrawalEventsByExchange:output_type -> gctrpc.WithdrawalEventsByExchangeResponse
+	97,  // 389: gctrpc.GoCryptoTraderService.WithdrawalEventsByDate:output_type -> gctrpc.WithdrawalEventsByExchangeResponse
+	106, // 390: gctrpc.GoCryptoTraderService.GetPendingWithdrawals:output_type -> gctrpc.GetPendingWithdrawalsResponse
+	92,  // 391: gctrpc.GoCryptoTraderService.ApproveWithdrawal:output_type -> gctrpc.WithdrawResponse
+	147, // 392: gctrpc.GoCryptoTraderService.RejectWithdrawal:output_type -> gctrpc.GenericResponse
+	110, // 393: gctrpc.GoCryptoTraderService.GetLoggerDetails:output_type -> gctrpc.GetLoggerDetailsResponse
+	110, // 394: gctrpc.GoCryptoTraderService.SetLoggerDetails:output_type -> gctrpc.GetLoggerDetailsResponse
+	113, // 395: gctrpc.GoCryptoTraderService.GetExchangePairs:output_type -> gctrpc.GetExchangePairsResponse
+	147, // 396: gctrpc.GoCryptoTraderService.SetExchangePair:output_type -> gctrpc.GenericResponse
+	28,  // 397: gctrpc.GoCryptoTraderService.GetOrderbookStream:output_type -> gctrpc.OrderbookResponse
+	28,  // 398: gctrpc.GoCryptoTraderService.GetExchangeOrderbookStream:output_type -> gctrpc.OrderbookResponse
+	22,  // 399: gctrpc.GoCryptoTraderService.GetTickerStream:output_type -> gctrpc.TickerResponse
+	22,  // 400: gctrpc.GoCryptoTraderService.GetExchangeTickerStream:output_type -> gctrpc.TickerResponse
+	56,  // 401: gctrpc.GoCryptoTraderService.GetOrderStream:output_type -> gctrpc.OrderDetails
+	124, // 402: gctrpc.GoCryptoTraderService.GetAggregatedOrderbookStream:output_type -> gctrpc.AggregatedOrderbookResponse
+	126, // 403: gctrpc.GoCryptoTraderService.GetAuditEvent:output_type -> gctrpc.GetAuditEventResponse
+	147, // 404: gctrpc.GoCryptoTraderService.GCTScriptExecute:output_type -> gctrpc.GenericResponse
+	147, // 405: gctrpc.GoCryptoTraderService.GCTScriptUpload:output_type -> gctrpc.GenericResponse
+	146, // 406: gctrpc.GoCryptoTraderService.GCTScriptReadScript:output_type -> gctrpc.GCTScriptQueryResponse
+	145, // 407: gctrpc.GoCryptoTraderService.GCTScriptStatus:output_type -> gctrpc.GCTScriptStatusResponse
+	146, // 408: gctrpc.GoCryptoTraderService.GCTScriptQuery:output_type -> gctrpc.GCTScriptQueryResponse
+	147, // 409: gctrpc.GoCryptoTraderService.GCTScriptStop:output_type -> gctrpc.GenericResponse
+	147, // 410: gctrpc.GoCryptoTraderService.GCTScriptStopAll:output_type -> gctrpc.GenericResponse
+	145, // 411: gctrpc.GoCryptoTraderService.GCTScriptListAll:output_type -> gctrpc.GCTScriptStatusResponse
+	147, // 412: gctrpc.GoCryptoTraderService.GCTScriptAutoLoadToggle:output_type -> gctrpc.GenericResponse
+	132, // 413: gctrpc.GoCryptoTraderService.GetHistoricCandles:output_type -> gctrpc.GetHistoricCandlesResponse
+	147, // 414: gctrpc.GoCryptoTraderService.SetExchangeAsset:output_type -> gctrpc.GenericResponse
+	147, // 415: gctrpc.GoCryptoTraderService.SetAllExchangePairs:output_type -> gctrpc.GenericResponse
+	147, // 416: gctrpc.GoCryptoTraderService.UpdateExchangeSupportedPairs:output_type -> gctrpc.GenericResponse
+	152, // 417: gctrpc.GoCryptoTraderService.GetExchangeAssets:output_type -> gctrpc.GetExchangeAssetsResponse
+	154, // 418: gctrpc.GoCryptoTraderService.WebsocketGetInfo:output_type -> gctrpc.WebsocketGetInfoResponse
+	147, // 419: gctrpc.GoCryptoTraderService.WebsocketSetEnabled:output_type -> gctrpc.GenericResponse
+	158, // 420: gctrpc.GoCryptoTraderService.WebsocketGetSubscriptions:output_type -> gctrpc.WebsocketGetSubscriptionsResponse
+	147, // 421: gctrpc.GoCryptoTraderService.WebsocketSetProxy:output_type -> gctrpc.GenericResponse
+	147, // 422: gctrpc.GoCryptoTraderService.WebsocketSetURL:output_type -> gctrpc.GenericResponse
+	129, // 423: gctrpc.GoCryptoTraderService.GetRecentTrades:output_type -> gctrpc.SavedTradesResponse
+	129, // 424: gctrpc.GoCryptoTraderService.GetHistoricTrades:output_type -> gctrpc.SavedTradesResponse
+	129, // 425: gctrpc.GoCryptoTraderService.GetSavedTrades:output_type -> gctrpc.SavedTradesResponse
+	132, // 426: gctrpc.GoCryptoTraderService.ConvertTradesToCandles:output_type -> gctrpc.GetHistoricCandlesResponse
+	163, // 427: gctrpc.GoCryptoTraderService.FindMissingSavedCandleIntervals:output_type -> gctrpc.FindMissingIntervalsResponse
+	163, // 428: gctrpc.GoCryptoTraderService.FindMissingSavedTradeIntervals:output_type -> gctrpc.FindMissingIntervalsResponse
+	147, // 429: gctrpc.GoCryptoTraderService.SetExchangeTradeProcessing:output_type -> gctrpc.GenericResponse
+	168, // 430: gctrpc.GoCryptoTraderService.UpsertDataHistoryJob:output_type -> gctrpc.UpsertDataHistoryJobResponse
+	170, // 431: gctrpc.GoCryptoTraderService.GetDataHistoryJobDetails:output_type -> gctrpc.DataHistoryJob
+	172, // 432: gctrpc.GoCryptoTraderService.GetActiveDataHistoryJobs:output_type -> gctrpc.DataHistoryJobs
+	172, // 433: gctrpc.GoCryptoTraderService.GetDataHistoryJobsBetween:output_type -> gctrpc.DataHistoryJobs
+	170, // 434: gctrpc.GoCryptoTraderService.GetDataHistoryJobSummary:output_type -> gctrpc.DataHistoryJob
+	147, // 435: gctrpc.GoCryptoTraderService.SetDataHistoryJobStatus:output_type -> gctrpc.GenericResponse
+	147, // 436: gctrpc.GoCryptoTraderService.UpdateDataHistoryJobPrerequisite:output_type -> gctrpc.GenericResponse
+	59,  // 437: gctrpc.GoCryptoTraderService.GetManagedOrders:output_type -> gctrpc.GetOrdersResponse
+	177, // 438: gctrpc.GoCryptoTraderService.ModifyOrder:output_type -> gctrpc.ModifyOrderResponse
+	183, // 439: gctrpc.GoCryptoTraderService.CurrencyStateGetAll:output_type -> gctrpc.CurrencyStateResponse
+	147, // 440: gctrpc.GoCryptoTraderService.CurrencyStateTrading:output_type -> gctrpc.GenericResponse
+	147, // 441: gctrpc.GoCryptoTraderService.CurrencyStateDeposit:output_type -> gctrpc.GenericResponse
+	147, // 442: gctrpc.GoCryptoTraderService.CurrencyStateWithdraw:output_type -> gctrpc.GenericResponse
+	147, // 443: gctrpc.GoCryptoTraderService.CurrencyStateTradingPair:output_type -> gctrpc.GenericResponse
+	193, // 444: gctrpc.GoCryptoTraderService.GetFuturesPositionsSummary:output_type -> gctrpc.GetFuturesPositionsSummaryResponse
+	195, // 445: gctrpc.GoCryptoTraderService.GetFuturesPositionsOrders:output_type -> gctrpc.GetFuturesPositionsOrdersResponse
+	211, // 446: gctrpc.GoCryptoTraderService.GetCollateral:output_type -> gctrpc.GetCollateralResponse
+	220, // 447: gctrpc.GoCryptoTraderService.Shutdown:output_type -> gctrpc.ShutdownResponse
+	223, // 448: gctrpc.GoCryptoTraderService.GetTechnicalAnalysis:output_type -> gctrpc.GetTechnicalAnalysisResponse
+	228, // 449: gctrpc.GoCryptoTraderService.GetMarginRatesHistory:output_type -> gctrpc.GetMarginRatesHistoryResponse
+	191, // 450: gctrpc.GoCryptoTraderService.GetManagedPosition:output_type -> gctrpc.GetManagedPositionsResponse
+	191, // 451: gctrpc.GoCryptoTraderService.GetAllManagedPositions:output_type -> gctrpc.GetManagedPositionsResponse
+	216, // 452: gctrpc.GoCryptoTraderService.GetFundingRates:output_type -> gctrpc.GetFundingRatesResponse
+	218, // 453: gctrpc.GoCryptoTraderService.GetLatestFundingRate:output_type -> gctrpc.GetLatestFundingRateResponse
+	230, // 454: gctrpc.GoCryptoTraderService.GetOrderbookMovement:output_type -> gctrpc.GetOrderbookMovementResponse
+	232, // 455: gctrpc.GoCryptoTraderService.GetOrderbookAmountByNominal:output_type -> gctrpc.GetOrderbookAmountByNominalResponse
+	234, // 456: gctrpc.GoCryptoTraderService.GetOrderbookAmountByImpact:output_type -> gctrpc.GetOrderbookAmountByImpactResponse
+	197, // 457: gctrpc.GoCryptoTraderService.GetCollateralMode:output_type -> gctrpc.GetCollateralModeResponse
+	207, // 458: gctrpc.GoCryptoTraderService.GetLeverage:output_type -> gctrpc.GetLeverageResponse
+	199, // 459: gctrpc.GoCryptoTraderService.SetCollateralMode:output_type -> gctrpc.SetCollateralModeResponse
+	205, // 460: gctrpc.GoCryptoTraderService.SetMarginType:output_type -> gctrpc.SetMarginTypeResponse
+	209, // 461: gctrpc.GoCryptoTraderService.SetLeverage:output_type -> gctrpc.SetLeverageResponse
+	203, // 462: gctrpc.GoCryptoTraderService.ChangePositionMargin:output_type -> gctrpc.ChangePositionMarginResponse
+	237, // 463: gctrpc.GoCryptoTraderService.GetOpenInterest:output_type -> gctrpc.GetOpenInterestResponse
+	240, // 464: gctrpc.GoCryptoTraderService.GetCurrencyTradeURL:output_type -> gctrpc.GetCurrencyTradeURLResponse
+	241, // 465: gctrpc.GoCryptoTraderService.AddConditionalOrder:output_type -> gctrpc.ConditionalOrder
+	244, // 466: gctrpc.GoCryptoTraderService.GetConditionalOrders:output_type -> gctrpc.GetConditionalOrdersResponse
+	241, // 467: gctrpc.GoCryptoTraderService.CancelConditionalOrder:output_type -> gctrpc.ConditionalOrder
+	147, // 468: gctrpc.GoCryptoTraderService.SetKillSwitch:output_type -> gctrpc.GenericResponse
+	250, // 469: gctrpc.GoCryptoTraderService.RouteOrder:output_type -> gctrpc.RouteOrderResponse
+	253, // 470: gctrpc.GoCryptoTraderService.GetLedgerPNL:output_type -> gctrpc.GetLedgerPNLResponse
+	257, // 471: gctrpc.GoCryptoTraderService.GetLedgerLots:output_type -> gctrpc.GetLedgerLotsResponse
+	259, // 472: gctrpc.GoCryptoTraderService.ExportLedgerCSV:output_type -> gctrpc.ExportLedgerCSVResponse
+	266, // 473: gctrpc.GoCryptoTraderService.PreviewRebalance:output_type -> gctrpc.RebalancePlanResponse
+	266, // 474: gctrpc.GoCryptoTraderService.GetRebalancePlan:output_type -> gctrpc.RebalancePlanResponse
+	266, // 475: gctrpc.GoCryptoTraderService.ApproveRebalancePlan:output_type -> gctrpc.RebalancePlanResponse
+	272, // 476: gctrpc.GoCryptoTraderService.GetOrderbookAnalytics:output_type -> gctrpc.OrderbookAnalyticsResponse
+	272, // 477: gctrpc.GoCryptoTraderService.GetOrderbookAnalyticsStream:output_type -> gctrpc.OrderbookAnalyticsResponse
+	147, // 478: gctrpc.GoCryptoTraderService.UntrackOrderbookAnalytics:output_type -> gctrpc.GenericResponse
+	345, // [345:479] is the sub-list for method output_type
+	211, // [211:345] is the sub-list for method input_type
+	211, // [211:211] is the sub-list for extension type_name
+	211, // [211:211] is the sub-list for extension extendee
+	0,   // [0:211] is the sub-list for field type_name
 }
 
 func init() { file_rpc_proto_init() }
@@ -17147,7 +21555,7 @@ func file_rpc_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_proto_rawDesc), len(file_rpc_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   240,
+			NumMessages:   288,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
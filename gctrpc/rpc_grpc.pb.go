@@ -64,6 +64,9 @@ const (
 	GoCryptoTraderService_WithdrawalEventByID_FullMethodName               = "/gctrpc.GoCryptoTraderService/WithdrawalEventByID"
 	GoCryptoTraderService_WithdrawalEventsByExchange_FullMethodName        = "/gctrpc.GoCryptoTraderService/WithdrawalEventsByExchange"
 	GoCryptoTraderService_WithdrawalEventsByDate_FullMethodName            = "/gctrpc.GoCryptoTraderService/WithdrawalEventsByDate"
+	GoCryptoTraderService_GetPendingWithdrawals_FullMethodName             = "/gctrpc.GoCryptoTraderService/GetPendingWithdrawals"
+	GoCryptoTraderService_ApproveWithdrawal_FullMethodName                 = "/gctrpc.GoCryptoTraderService/ApproveWithdrawal"
+	GoCryptoTraderService_RejectWithdrawal_FullMethodName                  = "/gctrpc.GoCryptoTraderService/RejectWithdrawal"
 	GoCryptoTraderService_GetLoggerDetails_FullMethodName                  = "/gctrpc.GoCryptoTraderService/GetLoggerDetails"
 	GoCryptoTraderService_SetLoggerDetails_FullMethodName                  = "/gctrpc.GoCryptoTraderService/SetLoggerDetails"
 	GoCryptoTraderService_GetExchangePairs_FullMethodName                  = "/gctrpc.GoCryptoTraderService/GetExchangePairs"
@@ -72,6 +75,8 @@ const (
 	GoCryptoTraderService_GetExchangeOrderbookStream_FullMethodName        = "/gctrpc.GoCryptoTraderService/GetExchangeOrderbookStream"
 	GoCryptoTraderService_GetTickerStream_FullMethodName                   = "/gctrpc.GoCryptoTraderService/GetTickerStream"
 	GoCryptoTraderService_GetExchangeTickerStream_FullMethodName           = "/gctrpc.GoCryptoTraderService/GetExchangeTickerStream"
+	GoCryptoTraderService_GetOrderStream_FullMethodName                    = "/gctrpc.GoCryptoTraderService/GetOrderStream"
+	GoCryptoTraderService_GetAggregatedOrderbookStream_FullMethodName      = "/gctrpc.GoCryptoTraderService/GetAggregatedOrderbookStream"
 	GoCryptoTraderService_GetAuditEvent_FullMethodName                     = "/gctrpc.GoCryptoTraderService/GetAuditEvent"
 	GoCryptoTraderService_GCTScriptExecute_FullMethodName                  = "/gctrpc.GoCryptoTraderService/GCTScriptExecute"
 	GoCryptoTraderService_GCTScriptUpload_FullMethodName                   = "/gctrpc.GoCryptoTraderService/GCTScriptUpload"
@@ -134,6 +139,20 @@ const (
 	GoCryptoTraderService_ChangePositionMargin_FullMethodName              = "/gctrpc.GoCryptoTraderService/ChangePositionMargin"
 	GoCryptoTraderService_GetOpenInterest_FullMethodName                   = "/gctrpc.GoCryptoTraderService/GetOpenInterest"
 	GoCryptoTraderService_GetCurrencyTradeURL_FullMethodName               = "/gctrpc.GoCryptoTraderService/GetCurrencyTradeURL"
+	GoCryptoTraderService_AddConditionalOrder_FullMethodName               = "/gctrpc.GoCryptoTraderService/AddConditionalOrder"
+	GoCryptoTraderService_GetConditionalOrders_FullMethodName              = "/gctrpc.GoCryptoTraderService/GetConditionalOrders"
+	GoCryptoTraderService_CancelConditionalOrder_FullMethodName            = "/gctrpc.GoCryptoTraderService/CancelConditionalOrder"
+	GoCryptoTraderService_SetKillSwitch_FullMethodName                     = "/gctrpc.GoCryptoTraderService/SetKillSwitch"
+	GoCryptoTraderService_RouteOrder_FullMethodName                        = "/gctrpc.GoCryptoTraderService/RouteOrder"
+	GoCryptoTraderService_GetLedgerPNL_FullMethodName                      = "/gctrpc.GoCryptoTraderService/GetLedgerPNL"
+	GoCryptoTraderService_GetLedgerLots_FullMethodName                     = "/gctrpc.GoCryptoTraderService/GetLedgerLots"
+	GoCryptoTraderService_ExportLedgerCSV_FullMethodName                   = "/gctrpc.GoCryptoTraderService/ExportLedgerCSV"
+	GoCryptoTraderService_PreviewRebalance_FullMethodName                  = "/gctrpc.GoCryptoTraderService/PreviewRebalance"
+	GoCryptoTraderService_GetRebalancePlan_FullMethodName                  = "/gctrpc.GoCryptoTraderService/GetRebalancePlan"
+	GoCryptoTraderService_ApproveRebalancePlan_FullMethodName              = "/gctrpc.GoCryptoTraderService/ApproveRebalancePlan"
+	GoCryptoTraderService_GetOrderbookAnalytics_FullMethodName             = "/gctrpc.GoCryptoTraderService/GetOrderbookAnalytics"
+	GoCryptoTraderService_GetOrderbookAnalyticsStream_FullMethodName       = "/gctrpc.GoCryptoTraderService/GetOrderbookAnalyticsStream"
+	GoCryptoTraderService_UntrackOrderbookAnalytics_FullMethodName         = "/gctrpc.GoCryptoTraderService/UntrackOrderbookAnalytics"
 )
 
 // GoCryptoTraderServiceClient is the client API for GoCryptoTraderService service.
@@ -185,6 +204,9 @@ type GoCryptoTraderServiceClient interface {
 	WithdrawalEventByID(ctx context.Context, in *WithdrawalEventByIDRequest, opts ...grpc.CallOption) (*WithdrawalEventByIDResponse, error)
 	WithdrawalEventsByExchange(ctx context.Context, in *WithdrawalEventsByExchangeRequest, opts ...grpc.CallOption) (*WithdrawalEventsByExchangeResponse, error)
 	WithdrawalEventsByDate(ctx context.Context, in *WithdrawalEventsByDateRequest, opts ...grpc.CallOption) (*WithdrawalEventsByExchangeResponse, error)
+	GetPendingWithdrawals(ctx context.Context, in *GetPendingWithdrawalsRequest, opts ...grpc.CallOption) (*GetPendingWithdrawalsResponse, error)
+	ApproveWithdrawal(ctx context.Context, in *ApproveWithdrawalRequest, opts ...grpc.CallOption) (*WithdrawResponse, error)
+	RejectWithdrawal(ctx context.Context, in *RejectWithdrawalRequest, opts ...grpc.CallOption) (*GenericResponse, error)
 	GetLoggerDetails(ctx context.Context, in *GetLoggerDetailsRequest, opts ...grpc.CallOption) (*GetLoggerDetailsResponse, error)
 	SetLoggerDetails(ctx context.Context, in *SetLoggerDetailsRequest, opts ...grpc.CallOption) (*GetLoggerDetailsResponse, error)
 	GetExchangePairs(ctx context.Context, in *GetExchangePairsRequest, opts ...grpc.CallOption) (*GetExchangePairsResponse, error)
@@ -193,6 +215,8 @@ type GoCryptoTraderServiceClient interface {
 	GetExchangeOrderbookStream(ctx context.Context, in *GetExchangeOrderbookStreamRequest, opts ...grpc.CallOption) (GoCryptoTraderService_GetExchangeOrderbookStreamClient, error)
 	GetTickerStream(ctx context.Context, in *GetTickerStreamRequest, opts ...grpc.CallOption) (GoCryptoTraderService_GetTickerStreamClient, error)
 	GetExchangeTickerStream(ctx context.Context, in *GetExchangeTickerStreamRequest, opts ...grpc.CallOption) (GoCryptoTraderService_GetExchangeTickerStreamClient, error)
+	GetOrderStream(ctx context.Context, in *GetOrderStreamRequest, opts ...grpc.CallOption) (GoCryptoTraderService_GetOrderStreamClient, error)
+	GetAggregatedOrderbookStream(ctx context.Context, in *GetAggregatedOrderbookStreamRequest, opts ...grpc.CallOption) (GoCryptoTraderService_GetAggregatedOrderbookStreamClient, error)
 	GetAuditEvent(ctx context.Context, in *GetAuditEventRequest, opts ...grpc.CallOption) (*GetAuditEventResponse, error)
 	GCTScriptExecute(ctx context.Context, in *GCTScriptExecuteRequest, opts ...grpc.CallOption) (*GenericResponse, error)
 	GCTScriptUpload(ctx context.Context, in *GCTScriptUploadRequest, opts ...grpc.CallOption) (*GenericResponse, error)
@@ -255,6 +279,20 @@ type GoCryptoTraderServiceClient interface {
 	ChangePositionMargin(ctx context.Context, in *ChangePositionMarginRequest, opts ...grpc.CallOption) (*ChangePositionMarginResponse, error)
 	GetOpenInterest(ctx context.Context, in *GetOpenInterestRequest, opts ...grpc.CallOption) (*GetOpenInterestResponse, error)
 	GetCurrencyTradeURL(ctx context.Context, in *GetCurrencyTradeURLRequest, opts ...grpc.CallOption) (*GetCurrencyTradeURLResponse, error)
+	AddConditionalOrder(ctx context.Context, in *AddConditionalOrderRequest, opts ...grpc.CallOption) (*ConditionalOrder, error)
+	GetConditionalOrders(ctx context.Context, in *GetConditionalOrdersRequest, opts ...grpc.CallOption) (*GetConditionalOrdersResponse, error)
+	CancelConditionalOrder(ctx context.Context, in *CancelConditionalOrderRequest, opts ...grpc.CallOption) (*ConditionalOrder, error)
+	SetKillSwitch(ctx context.Context, in *SetKillSwitchRequest, opts ...grpc.CallOption) (*GenericResponse, error)
+	RouteOrder(ctx context.Context, in *RouteOrderRequest, opts ...grpc.CallOption) (*RouteOrderResponse, error)
+	GetLedgerPNL(ctx context.Context, in *GetLedgerPNLRequest, opts ...grpc.CallOption) (*GetLedgerPNLResponse, error)
+	GetLedgerLots(ctx context.Context, in *GetLedgerLotsRequest, opts ...grpc.CallOption) (*GetLedgerLotsResponse, error)
+	ExportLedgerCSV(ctx context.Context, in *ExportLedgerCSVRequest, opts ...grpc.CallOption) (*ExportLedgerCSVResponse, error)
+	PreviewRebalance(ctx context.Context, in *PreviewRebalanceRequest, opts ...grpc.CallOption) (*RebalancePlanResponse, error)
+	GetRebalancePlan(ctx context.Context, in *GetRebalancePlanRequest, opts ...grpc.CallOption) (*RebalancePlanResponse, error)
+	ApproveRebalancePlan(ctx context.Context, in *ApproveRebalancePlanRequest, opts ...grpc.CallOption) (*RebalancePlanResponse, error)
+	GetOrderbookAnalytics(ctx context.Context, in *GetOrderbookAnalyticsRequest, opts ...grpc.CallOption) (*OrderbookAnalyticsResponse, error)
+	GetOrderbookAnalyticsStream(ctx context.Context, in *GetOrderbookAnalyticsRequest, opts ...grpc.CallOption) (GoCryptoTraderService_GetOrderbookAnalyticsStreamClient, error)
+	UntrackOrderbookAnalytics(ctx context.Context, in *UntrackOrderbookAnalyticsRequest, opts ...grpc.CallOption) (*GenericResponse, error)
 }
 
 type goCryptoTraderServiceClient struct {
@@ -693,6 +731,33 @@ func (c *goCryptoTraderServiceClient) WithdrawalEventsByDate(ctx context.Context
 	return out, nil
 }
 
+func (c *goCryptoTraderServiceClient) GetPendingWithdrawals(ctx context.Context, in *GetPendingWithdrawalsRequest, opts ...grpc.CallOption) (*GetPendingWithdrawalsResponse, error) {
+	out := new(GetPendingWithdrawalsResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_GetPendingWithdrawals_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) ApproveWithdrawal(ctx context.Context, in *ApproveWithdrawalRequest, opts ...grpc.CallOption) (*WithdrawResponse, error) {
+	out := new(WithdrawResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_ApproveWithdrawal_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) RejectWithdrawal(ctx context.Context, in *RejectWithdrawalRequest, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_RejectWithdrawal_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *goCryptoTraderServiceClient) GetLoggerDetails(ctx context.Context, in *GetLoggerDetailsRequest, opts ...grpc.CallOption) (*GetLoggerDetailsResponse, error) {
 	out := new(GetLoggerDetailsResponse)
 	err := c.cc.Invoke(ctx, GoCryptoTraderService_GetLoggerDetails_FullMethodName, in, out, opts...)
@@ -857,6 +922,70 @@ func (x *goCryptoTraderServiceGetExchangeTickerStreamClient) Recv() (*TickerResp
 	return m, nil
 }
 
+func (c *goCryptoTraderServiceClient) GetOrderStream(ctx context.Context, in *GetOrderStreamRequest, opts ...grpc.CallOption) (GoCryptoTraderService_GetOrderStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GoCryptoTraderService_ServiceDesc.Streams[5], GoCryptoTraderService_GetOrderStream_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &goCryptoTraderServiceGetOrderStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GoCryptoTraderService_GetOrderStreamClient interface {
+	Recv() (*OrderDetails, error)
+	grpc.ClientStream
+}
+
+type goCryptoTraderServiceGetOrderStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *goCryptoTraderServiceGetOrderStreamClient) Recv() (*OrderDetails, error) {
+	m := new(OrderDetails)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *goCryptoTraderServiceClient) GetAggregatedOrderbookStream(ctx context.Context, in *GetAggregatedOrderbookStreamRequest, opts ...grpc.CallOption) (GoCryptoTraderService_GetAggregatedOrderbookStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GoCryptoTraderService_ServiceDesc.Streams[6], GoCryptoTraderService_GetAggregatedOrderbookStream_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &goCryptoTraderServiceGetAggregatedOrderbookStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GoCryptoTraderService_GetAggregatedOrderbookStreamClient interface {
+	Recv() (*AggregatedOrderbookResponse, error)
+	grpc.ClientStream
+}
+
+type goCryptoTraderServiceGetAggregatedOrderbookStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *goCryptoTraderServiceGetAggregatedOrderbookStreamClient) Recv() (*AggregatedOrderbookResponse, error) {
+	m := new(AggregatedOrderbookResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *goCryptoTraderServiceClient) GetAuditEvent(ctx context.Context, in *GetAuditEventRequest, opts ...grpc.CallOption) (*GetAuditEventResponse, error) {
 	out := new(GetAuditEventResponse)
 	err := c.cc.Invoke(ctx, GoCryptoTraderService_GetAuditEvent_FullMethodName, in, out, opts...)
@@ -1047,7 +1176,7 @@ func (c *goCryptoTraderServiceClient) GetRecentTrades(ctx context.Context, in *G
 }
 
 func (c *goCryptoTraderServiceClient) GetHistoricTrades(ctx context.Context, in *GetSavedTradesRequest, opts ...grpc.CallOption) (GoCryptoTraderService_GetHistoricTradesClient, error) {
-	stream, err := c.cc.NewStream(ctx, &GoCryptoTraderService_ServiceDesc.Streams[5], GoCryptoTraderService_GetHistoricTrades_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &GoCryptoTraderService_ServiceDesc.Streams[7], GoCryptoTraderService_GetHistoricTrades_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1438,6 +1567,155 @@ func (c *goCryptoTraderServiceClient) GetCurrencyTradeURL(ctx context.Context, i
 	return out, nil
 }
 
+func (c *goCryptoTraderServiceClient) AddConditionalOrder(ctx context.Context, in *AddConditionalOrderRequest, opts ...grpc.CallOption) (*ConditionalOrder, error) {
+	out := new(ConditionalOrder)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_AddConditionalOrder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) GetConditionalOrders(ctx context.Context, in *GetConditionalOrdersRequest, opts ...grpc.CallOption) (*GetConditionalOrdersResponse, error) {
+	out := new(GetConditionalOrdersResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_GetConditionalOrders_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) CancelConditionalOrder(ctx context.Context, in *CancelConditionalOrderRequest, opts ...grpc.CallOption) (*ConditionalOrder, error) {
+	out := new(ConditionalOrder)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_CancelConditionalOrder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) SetKillSwitch(ctx context.Context, in *SetKillSwitchRequest, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_SetKillSwitch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) RouteOrder(ctx context.Context, in *RouteOrderRequest, opts ...grpc.CallOption) (*RouteOrderResponse, error) {
+	out := new(RouteOrderResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_RouteOrder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) GetLedgerPNL(ctx context.Context, in *GetLedgerPNLRequest, opts ...grpc.CallOption) (*GetLedgerPNLResponse, error) {
+	out := new(GetLedgerPNLResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_GetLedgerPNL_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) GetLedgerLots(ctx context.Context, in *GetLedgerLotsRequest, opts ...grpc.CallOption) (*GetLedgerLotsResponse, error) {
+	out := new(GetLedgerLotsResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_GetLedgerLots_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) ExportLedgerCSV(ctx context.Context, in *ExportLedgerCSVRequest, opts ...grpc.CallOption) (*ExportLedgerCSVResponse, error) {
+	out := new(ExportLedgerCSVResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_ExportLedgerCSV_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) PreviewRebalance(ctx context.Context, in *PreviewRebalanceRequest, opts ...grpc.CallOption) (*RebalancePlanResponse, error) {
+	out := new(RebalancePlanResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_PreviewRebalance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) GetRebalancePlan(ctx context.Context, in *GetRebalancePlanRequest, opts ...grpc.CallOption) (*RebalancePlanResponse, error) {
+	out := new(RebalancePlanResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_GetRebalancePlan_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) ApproveRebalancePlan(ctx context.Context, in *ApproveRebalancePlanRequest, opts ...grpc.CallOption) (*RebalancePlanResponse, error) {
+	out := new(RebalancePlanResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_ApproveRebalancePlan_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) GetOrderbookAnalytics(ctx context.Context, in *GetOrderbookAnalyticsRequest, opts ...grpc.CallOption) (*OrderbookAnalyticsResponse, error) {
+	out := new(OrderbookAnalyticsResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_GetOrderbookAnalytics_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) GetOrderbookAnalyticsStream(ctx context.Context, in *GetOrderbookAnalyticsRequest, opts ...grpc.CallOption) (GoCryptoTraderService_GetOrderbookAnalyticsStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GoCryptoTraderService_ServiceDesc.Streams[8], GoCryptoTraderService_GetOrderbookAnalyticsStream_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &goCryptoTraderServiceGetOrderbookAnalyticsStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GoCryptoTraderService_GetOrderbookAnalyticsStreamClient interface {
+	Recv() (*OrderbookAnalyticsResponse, error)
+	grpc.ClientStream
+}
+
+type goCryptoTraderServiceGetOrderbookAnalyticsStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *goCryptoTraderServiceGetOrderbookAnalyticsStreamClient) Recv() (*OrderbookAnalyticsResponse, error) {
+	m := new(OrderbookAnalyticsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *goCryptoTraderServiceClient) UntrackOrderbookAnalytics(ctx context.Context, in *UntrackOrderbookAnalyticsRequest, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_UntrackOrderbookAnalytics_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // GoCryptoTraderServiceServer is the server API for GoCryptoTraderService service.
 // All implementations must embed UnimplementedGoCryptoTraderServiceServer
 // for forward compatibility
@@ -1487,6 +1765,9 @@ type GoCryptoTraderServiceServer interface {
 	WithdrawalEventByID(context.Context, *WithdrawalEventByIDRequest) (*WithdrawalEventByIDResponse, error)
 	WithdrawalEventsByExchange(context.Context, *WithdrawalEventsByExchangeRequest) (*WithdrawalEventsByExchangeResponse, error)
 	WithdrawalEventsByDate(context.Context, *WithdrawalEventsByDateRequest) (*WithdrawalEventsByExchangeResponse, error)
+	GetPendingWithdrawals(context.Context, *GetPendingWithdrawalsRequest) (*GetPendingWithdrawalsResponse, error)
+	ApproveWithdrawal(context.Context, *ApproveWithdrawalRequest) (*WithdrawResponse, error)
+	RejectWithdrawal(context.Context, *RejectWithdrawalRequest) (*GenericResponse, error)
 	GetLoggerDetails(context.Context, *GetLoggerDetailsRequest) (*GetLoggerDetailsResponse, error)
 	SetLoggerDetails(context.Context, *SetLoggerDetailsRequest) (*GetLoggerDetailsResponse, error)
 	GetExchangePairs(context.Context, *GetExchangePairsRequest) (*GetExchangePairsResponse, error)
@@ -1495,6 +1776,8 @@ type GoCryptoTraderServiceServer interface {
 	GetExchangeOrderbookStream(*GetExchangeOrderbookStreamRequest, GoCryptoTraderService_GetExchangeOrderbookStreamServer) error
 	GetTickerStream(*GetTickerStreamRequest, GoCryptoTraderService_GetTickerStreamServer) error
 	GetExchangeTickerStream(*GetExchangeTickerStreamRequest, GoCryptoTraderService_GetExchangeTickerStreamServer) error
+	GetOrderStream(*GetOrderStreamRequest, GoCryptoTraderService_GetOrderStreamServer) error
+	GetAggregatedOrderbookStream(*GetAggregatedOrderbookStreamRequest, GoCryptoTraderService_GetAggregatedOrderbookStreamServer) error
 	GetAuditEvent(context.Context, *GetAuditEventRequest) (*GetAuditEventResponse, error)
 	GCTScriptExecute(context.Context, *GCTScriptExecuteRequest) (*GenericResponse, error)
 	GCTScriptUpload(context.Context, *GCTScriptUploadRequest) (*GenericResponse, error)
@@ -1557,6 +1840,20 @@ type GoCryptoTraderServiceServer interface {
 	ChangePositionMargin(context.Context, *ChangePositionMarginRequest) (*ChangePositionMarginResponse, error)
 	GetOpenInterest(context.Context, *GetOpenInterestRequest) (*GetOpenInterestResponse, error)
 	GetCurrencyTradeURL(context.Context, *GetCurrencyTradeURLRequest) (*GetCurrencyTradeURLResponse, error)
+	AddConditionalOrder(context.Context, *AddConditionalOrderRequest) (*ConditionalOrder, error)
+	GetConditionalOrders(context.Context, *GetConditionalOrdersRequest) (*GetConditionalOrdersResponse, error)
+	CancelConditionalOrder(context.Context, *CancelConditionalOrderRequest) (*ConditionalOrder, error)
+	SetKillSwitch(context.Context, *SetKillSwitchRequest) (*GenericResponse, error)
+	RouteOrder(context.Context, *RouteOrderRequest) (*RouteOrderResponse, error)
+	GetLedgerPNL(context.Context, *GetLedgerPNLRequest) (*GetLedgerPNLResponse, error)
+	GetLedgerLots(context.Context, *GetLedgerLotsRequest) (*GetLedgerLotsResponse, error)
+	ExportLedgerCSV(context.Context, *ExportLedgerCSVRequest) (*ExportLedgerCSVResponse, error)
+	PreviewRebalance(context.Context, *PreviewRebalanceRequest) (*RebalancePlanResponse, error)
+	GetRebalancePlan(context.Context, *GetRebalancePlanRequest) (*RebalancePlanResponse, error)
+	ApproveRebalancePlan(context.Context, *ApproveRebalancePlanRequest) (*RebalancePlanResponse, error)
+	GetOrderbookAnalytics(context.Context, *GetOrderbookAnalyticsRequest) (*OrderbookAnalyticsResponse, error)
+	GetOrderbookAnalyticsStream(*GetOrderbookAnalyticsRequest, GoCryptoTraderService_GetOrderbookAnalyticsStreamServer) error
+	UntrackOrderbookAnalytics(context.Context, *UntrackOrderbookAnalyticsRequest) (*GenericResponse, error)
 	mustEmbedUnimplementedGoCryptoTraderServiceServer()
 }
 
@@ -1699,6 +1996,15 @@ func (UnimplementedGoCryptoTraderServiceServer) WithdrawalEventsByExchange(conte
 func (UnimplementedGoCryptoTraderServiceServer) WithdrawalEventsByDate(context.Context, *WithdrawalEventsByDateRequest) (*WithdrawalEventsByExchangeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WithdrawalEventsByDate not implemented")
 }
+func (UnimplementedGoCryptoTraderServiceServer) GetPendingWithdrawals(context.Context, *GetPendingWithdrawalsRequest) (*GetPendingWithdrawalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPendingWithdrawals not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) ApproveWithdrawal(context.Context, *ApproveWithdrawalRequest) (*WithdrawResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveWithdrawal not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) RejectWithdrawal(context.Context, *RejectWithdrawalRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectWithdrawal not implemented")
+}
 func (UnimplementedGoCryptoTraderServiceServer) GetLoggerDetails(context.Context, *GetLoggerDetailsRequest) (*GetLoggerDetailsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetLoggerDetails not implemented")
 }
@@ -1723,6 +2029,12 @@ func (UnimplementedGoCryptoTraderServiceServer) GetTickerStream(*GetTickerStream
 func (UnimplementedGoCryptoTraderServiceServer) GetExchangeTickerStream(*GetExchangeTickerStreamRequest, GoCryptoTraderService_GetExchangeTickerStreamServer) error {
 	return status.Errorf(codes.Unimplemented, "method GetExchangeTickerStream not implemented")
 }
+func (UnimplementedGoCryptoTraderServiceServer) GetOrderStream(*GetOrderStreamRequest, GoCryptoTraderService_GetOrderStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetOrderStream not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetAggregatedOrderbookStream(*GetAggregatedOrderbookStreamRequest, GoCryptoTraderService_GetAggregatedOrderbookStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetAggregatedOrderbookStream not implemented")
+}
 func (UnimplementedGoCryptoTraderServiceServer) GetAuditEvent(context.Context, *GetAuditEventRequest) (*GetAuditEventResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAuditEvent not implemented")
 }
@@ -1909,6 +2221,48 @@ func (UnimplementedGoCryptoTraderServiceServer) GetOpenInterest(context.Context,
 func (UnimplementedGoCryptoTraderServiceServer) GetCurrencyTradeURL(context.Context, *GetCurrencyTradeURLRequest) (*GetCurrencyTradeURLResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCurrencyTradeURL not implemented")
 }
+func (UnimplementedGoCryptoTraderServiceServer) AddConditionalOrder(context.Context, *AddConditionalOrderRequest) (*ConditionalOrder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddConditionalOrder not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetConditionalOrders(context.Context, *GetConditionalOrdersRequest) (*GetConditionalOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConditionalOrders not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) CancelConditionalOrder(context.Context, *CancelConditionalOrderRequest) (*ConditionalOrder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelConditionalOrder not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) SetKillSwitch(context.Context, *SetKillSwitchRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetKillSwitch not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) RouteOrder(context.Context, *RouteOrderRequest) (*RouteOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RouteOrder not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetLedgerPNL(context.Context, *GetLedgerPNLRequest) (*GetLedgerPNLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLedgerPNL not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetLedgerLots(context.Context, *GetLedgerLotsRequest) (*GetLedgerLotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLedgerLots not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) ExportLedgerCSV(context.Context, *ExportLedgerCSVRequest) (*ExportLedgerCSVResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportLedgerCSV not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) PreviewRebalance(context.Context, *PreviewRebalanceRequest) (*RebalancePlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewRebalance not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetRebalancePlan(context.Context, *GetRebalancePlanRequest) (*RebalancePlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRebalancePlan not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) ApproveRebalancePlan(context.Context, *ApproveRebalancePlanRequest) (*RebalancePlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveRebalancePlan not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetOrderbookAnalytics(context.Context, *GetOrderbookAnalyticsRequest) (*OrderbookAnalyticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderbookAnalytics not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetOrderbookAnalyticsStream(*GetOrderbookAnalyticsRequest, GoCryptoTraderService_GetOrderbookAnalyticsStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetOrderbookAnalyticsStream not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) UntrackOrderbookAnalytics(context.Context, *UntrackOrderbookAnalyticsRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UntrackOrderbookAnalytics not implemented")
+}
 func (UnimplementedGoCryptoTraderServiceServer) mustEmbedUnimplementedGoCryptoTraderServiceServer() {}
 
 // UnsafeGoCryptoTraderServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -2735,6 +3089,60 @@ func _GoCryptoTraderService_WithdrawalEventsByDate_Handler(srv interface{}, ctx
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GoCryptoTraderService_GetPendingWithdrawals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPendingWithdrawalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).GetPendingWithdrawals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_GetPendingWithdrawals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).GetPendingWithdrawals(ctx, req.(*GetPendingWithdrawalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_ApproveWithdrawal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveWithdrawalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).ApproveWithdrawal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_ApproveWithdrawal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).ApproveWithdrawal(ctx, req.(*ApproveWithdrawalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_RejectWithdrawal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectWithdrawalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).RejectWithdrawal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_RejectWithdrawal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).RejectWithdrawal(ctx, req.(*RejectWithdrawalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _GoCryptoTraderService_GetLoggerDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetLoggerDetailsRequest)
 	if err := dec(in); err != nil {
@@ -2891,6 +3299,48 @@ func (x *goCryptoTraderServiceGetExchangeTickerStreamServer) Send(m *TickerRespo
 	return x.ServerStream.SendMsg(m)
 }
 
+func _GoCryptoTraderService_GetOrderStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetOrderStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GoCryptoTraderServiceServer).GetOrderStream(m, &goCryptoTraderServiceGetOrderStreamServer{stream})
+}
+
+type GoCryptoTraderService_GetOrderStreamServer interface {
+	Send(*OrderDetails) error
+	grpc.ServerStream
+}
+
+type goCryptoTraderServiceGetOrderStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *goCryptoTraderServiceGetOrderStreamServer) Send(m *OrderDetails) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GoCryptoTraderService_GetAggregatedOrderbookStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetAggregatedOrderbookStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GoCryptoTraderServiceServer).GetAggregatedOrderbookStream(m, &goCryptoTraderServiceGetAggregatedOrderbookStreamServer{stream})
+}
+
+type GoCryptoTraderService_GetAggregatedOrderbookStreamServer interface {
+	Send(*AggregatedOrderbookResponse) error
+	grpc.ServerStream
+}
+
+type goCryptoTraderServiceGetAggregatedOrderbookStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *goCryptoTraderServiceGetAggregatedOrderbookStreamServer) Send(m *AggregatedOrderbookResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _GoCryptoTraderService_GetAuditEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetAuditEventRequest)
 	if err := dec(in); err != nil {
@@ -4010,6 +4460,261 @@ func _GoCryptoTraderService_GetCurrencyTradeURL_Handler(srv interface{}, ctx con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GoCryptoTraderService_AddConditionalOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddConditionalOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).AddConditionalOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_AddConditionalOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).AddConditionalOrder(ctx, req.(*AddConditionalOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_GetConditionalOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConditionalOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).GetConditionalOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_GetConditionalOrders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).GetConditionalOrders(ctx, req.(*GetConditionalOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_CancelConditionalOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelConditionalOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).CancelConditionalOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_CancelConditionalOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).CancelConditionalOrder(ctx, req.(*CancelConditionalOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_SetKillSwitch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetKillSwitchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).SetKillSwitch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_SetKillSwitch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).SetKillSwitch(ctx, req.(*SetKillSwitchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_RouteOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RouteOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).RouteOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_RouteOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).RouteOrder(ctx, req.(*RouteOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_GetLedgerPNL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLedgerPNLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).GetLedgerPNL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_GetLedgerPNL_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).GetLedgerPNL(ctx, req.(*GetLedgerPNLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_GetLedgerLots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLedgerLotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).GetLedgerLots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_GetLedgerLots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).GetLedgerLots(ctx, req.(*GetLedgerLotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_ExportLedgerCSV_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportLedgerCSVRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).ExportLedgerCSV(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_ExportLedgerCSV_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).ExportLedgerCSV(ctx, req.(*ExportLedgerCSVRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_PreviewRebalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewRebalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).PreviewRebalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_PreviewRebalance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).PreviewRebalance(ctx, req.(*PreviewRebalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_GetRebalancePlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRebalancePlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).GetRebalancePlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_GetRebalancePlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).GetRebalancePlan(ctx, req.(*GetRebalancePlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_ApproveRebalancePlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveRebalancePlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).ApproveRebalancePlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_ApproveRebalancePlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).ApproveRebalancePlan(ctx, req.(*ApproveRebalancePlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_GetOrderbookAnalytics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderbookAnalyticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).GetOrderbookAnalytics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_GetOrderbookAnalytics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).GetOrderbookAnalytics(ctx, req.(*GetOrderbookAnalyticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_GetOrderbookAnalyticsStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetOrderbookAnalyticsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GoCryptoTraderServiceServer).GetOrderbookAnalyticsStream(m, &goCryptoTraderServiceGetOrderbookAnalyticsStreamServer{stream})
+}
+
+type GoCryptoTraderService_GetOrderbookAnalyticsStreamServer interface {
+	Send(*OrderbookAnalyticsResponse) error
+	grpc.ServerStream
+}
+
+type goCryptoTraderServiceGetOrderbookAnalyticsStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *goCryptoTraderServiceGetOrderbookAnalyticsStreamServer) Send(m *OrderbookAnalyticsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GoCryptoTraderService_UntrackOrderbookAnalytics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UntrackOrderbookAnalyticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).UntrackOrderbookAnalytics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_UntrackOrderbookAnalytics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).UntrackOrderbookAnalytics(ctx, req.(*UntrackOrderbookAnalyticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // GoCryptoTraderService_ServiceDesc is the grpc.ServiceDesc for GoCryptoTraderService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -4193,6 +4898,18 @@ var GoCryptoTraderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "WithdrawalEventsByDate",
 			Handler:    _GoCryptoTraderService_WithdrawalEventsByDate_Handler,
 		},
+		{
+			MethodName: "GetPendingWithdrawals",
+			Handler:    _GoCryptoTraderService_GetPendingWithdrawals_Handler,
+		},
+		{
+			MethodName: "ApproveWithdrawal",
+			Handler:    _GoCryptoTraderService_ApproveWithdrawal_Handler,
+		},
+		{
+			MethodName: "RejectWithdrawal",
+			Handler:    _GoCryptoTraderService_RejectWithdrawal_Handler,
+		},
 		{
 			MethodName: "GetLoggerDetails",
 			Handler:    _GoCryptoTraderService_GetLoggerDetails_Handler,
@@ -4453,6 +5170,58 @@ var GoCryptoTraderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetCurrencyTradeURL",
 			Handler:    _GoCryptoTraderService_GetCurrencyTradeURL_Handler,
 		},
+		{
+			MethodName: "AddConditionalOrder",
+			Handler:    _GoCryptoTraderService_AddConditionalOrder_Handler,
+		},
+		{
+			MethodName: "GetConditionalOrders",
+			Handler:    _GoCryptoTraderService_GetConditionalOrders_Handler,
+		},
+		{
+			MethodName: "CancelConditionalOrder",
+			Handler:    _GoCryptoTraderService_CancelConditionalOrder_Handler,
+		},
+		{
+			MethodName: "SetKillSwitch",
+			Handler:    _GoCryptoTraderService_SetKillSwitch_Handler,
+		},
+		{
+			MethodName: "RouteOrder",
+			Handler:    _GoCryptoTraderService_RouteOrder_Handler,
+		},
+		{
+			MethodName: "GetLedgerPNL",
+			Handler:    _GoCryptoTraderService_GetLedgerPNL_Handler,
+		},
+		{
+			MethodName: "GetLedgerLots",
+			Handler:    _GoCryptoTraderService_GetLedgerLots_Handler,
+		},
+		{
+			MethodName: "ExportLedgerCSV",
+			Handler:    _GoCryptoTraderService_ExportLedgerCSV_Handler,
+		},
+		{
+			MethodName: "PreviewRebalance",
+			Handler:    _GoCryptoTraderService_PreviewRebalance_Handler,
+		},
+		{
+			MethodName: "GetRebalancePlan",
+			Handler:    _GoCryptoTraderService_GetRebalancePlan_Handler,
+		},
+		{
+			MethodName: "ApproveRebalancePlan",
+			Handler:    _GoCryptoTraderService_ApproveRebalancePlan_Handler,
+		},
+		{
+			MethodName: "GetOrderbookAnalytics",
+			Handler:    _GoCryptoTraderService_GetOrderbookAnalytics_Handler,
+		},
+		{
+			MethodName: "UntrackOrderbookAnalytics",
+			Handler:    _GoCryptoTraderService_UntrackOrderbookAnalytics_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -4480,11 +5249,26 @@ var GoCryptoTraderService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _GoCryptoTraderService_GetExchangeTickerStream_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "GetOrderStream",
+			Handler:       _GoCryptoTraderService_GetOrderStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetAggregatedOrderbookStream",
+			Handler:       _GoCryptoTraderService_GetAggregatedOrderbookStream_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "GetHistoricTrades",
 			Handler:       _GoCryptoTraderService_GetHistoricTrades_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "GetOrderbookAnalyticsStream",
+			Handler:       _GoCryptoTraderService_GetOrderbookAnalyticsStream_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "rpc.proto",
 }
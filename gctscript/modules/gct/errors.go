@@ -13,6 +13,9 @@ const standardFormatting = "%s"
 var (
 	errFormatStringIsEmpty = errors.New("format string is empty")
 	errNoArguments         = errors.New("no arguments for error response")
+	errUnsupportedEvent    = errors.New("unsupported script event")
+	errScriptNameNotSet    = errors.New("script name not set")
+	errStoreKeyEmpty       = errors.New("store key is empty")
 )
 
 // errorResponsef is a helper function to apply error details to a return object
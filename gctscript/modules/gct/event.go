@@ -0,0 +1,264 @@
+package gct
+
+import (
+	"fmt"
+	"strings"
+
+	objects "github.com/d5/tengo/v2"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchange/accounts"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules"
+)
+
+const (
+	onTickerFunc        = "on_ticker"
+	onOrderbookFunc     = "on_orderbook"
+	onTradeFunc         = "on_trade"
+	onOrderUpdateFunc   = "on_order_update"
+	onBalanceChangeFunc = "on_balance_change"
+)
+
+var eventModule = map[string]objects.Object{
+	onTickerFunc:        &objects.UserFunction{Name: onTickerFunc, Value: EventOnTicker},
+	onOrderbookFunc:     &objects.UserFunction{Name: onOrderbookFunc, Value: EventOnOrderbook},
+	onTradeFunc:         &objects.UserFunction{Name: onTradeFunc, Value: EventOnTrade},
+	onOrderUpdateFunc:   &objects.UserFunction{Name: onOrderUpdateFunc, Value: EventOnOrderUpdate},
+	onBalanceChangeFunc: &objects.UserFunction{Name: onBalanceChangeFunc, Value: EventOnBalanceChange},
+}
+
+// EventOnTicker registers a handler which is called with each ticker update
+// for an exchange, currency pair and asset
+// Params: scriptCTX, exchangeName, currencyPair, delimiter, asset, handler
+func EventOnTicker(args ...objects.Object) (objects.Object, error) {
+	return registerMarketEvent(modules.EventTicker, onTickerFunc, args...)
+}
+
+// EventOnOrderbook registers a handler which is called with each orderbook
+// update for an exchange, currency pair and asset
+// Params: scriptCTX, exchangeName, currencyPair, delimiter, asset, handler
+func EventOnOrderbook(args ...objects.Object) (objects.Object, error) {
+	return registerMarketEvent(modules.EventOrderbook, onOrderbookFunc, args...)
+}
+
+// EventOnTrade registers a handler which is called with each trade for an
+// exchange, currency pair and asset
+// Params: scriptCTX, exchangeName, currencyPair, delimiter, asset, handler
+func EventOnTrade(args ...objects.Object) (objects.Object, error) {
+	return registerMarketEvent(modules.EventTrade, onTradeFunc, args...)
+}
+
+// EventOnOrderUpdate registers a handler which is called whenever an order on
+// an exchange changes
+// Params: scriptCTX, exchangeName, handler
+func EventOnOrderUpdate(args ...objects.Object) (objects.Object, error) {
+	return registerAccountEvent(modules.EventOrderUpdate, onOrderUpdateFunc, args...)
+}
+
+// EventOnBalanceChange registers a handler which is called whenever account
+// balances on an exchange change
+// Params: scriptCTX, exchangeName, handler
+func EventOnBalanceChange(args ...objects.Object) (objects.Object, error) {
+	return registerAccountEvent(modules.EventBalanceChange, onBalanceChangeFunc, args...)
+}
+
+func registerMarketEvent(event, funcName string, args ...objects.Object) (objects.Object, error) {
+	if len(args) != 6 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	scriptCtx, ok := objects.ToInterface(args[0]).(*Context)
+	if !ok {
+		return nil, constructRuntimeError(1, funcName, "*gct.Context", args[0])
+	}
+	exchangeName, ok := objects.ToString(args[1])
+	if !ok {
+		return nil, constructRuntimeError(2, funcName, "string", args[1])
+	}
+	currencyPair, ok := objects.ToString(args[2])
+	if !ok {
+		return nil, constructRuntimeError(3, funcName, "string", args[2])
+	}
+	delimiter, ok := objects.ToString(args[3])
+	if !ok {
+		return nil, constructRuntimeError(4, funcName, "string", args[3])
+	}
+	assetTypeParam, ok := objects.ToString(args[4])
+	if !ok {
+		return nil, constructRuntimeError(5, funcName, "string", args[4])
+	}
+	if !args[5].CanCall() {
+		return nil, constructRuntimeError(6, funcName, "function", args[5])
+	}
+
+	pair, err := currency.NewPairDelimiter(currencyPair, delimiter)
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+
+	assetType, err := asset.New(assetTypeParam)
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+
+	scriptCtx.addEventHandler(EventHandler{
+		Event:    event,
+		Exchange: exchangeName,
+		Pair:     pair,
+		Asset:    assetType,
+		Function: args[5],
+	})
+	return nil, nil
+}
+
+func registerAccountEvent(event, funcName string, args ...objects.Object) (objects.Object, error) {
+	if len(args) != 3 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	scriptCtx, ok := objects.ToInterface(args[0]).(*Context)
+	if !ok {
+		return nil, constructRuntimeError(1, funcName, "*gct.Context", args[0])
+	}
+	exchangeName, ok := objects.ToString(args[1])
+	if !ok {
+		return nil, constructRuntimeError(2, funcName, "string", args[1])
+	}
+	if !args[2].CanCall() {
+		return nil, constructRuntimeError(3, funcName, "function", args[2])
+	}
+
+	scriptCtx.addEventHandler(EventHandler{
+		Event:    event,
+		Exchange: exchangeName,
+		Function: args[2],
+	})
+	return nil, nil
+}
+
+// addEventHandler registers an event handler, replacing any handler already
+// registered for the same event, exchange, pair and asset so scripts which
+// run on a timer do not register duplicates
+func (c *Context) addEventHandler(h EventHandler) {
+	c.m.Lock()
+	defer c.m.Unlock()
+	for i := range c.handlers {
+		if c.handlers[i].Event == h.Event &&
+			strings.EqualFold(c.handlers[i].Exchange, h.Exchange) &&
+			c.handlers[i].Pair.Equal(h.Pair) &&
+			c.handlers[i].Asset == h.Asset {
+			c.handlers[i] = h
+			return
+		}
+	}
+	c.handlers = append(c.handlers, h)
+}
+
+// EventHandlers returns the event handlers registered by the script
+func (c *Context) EventHandlers() []EventHandler {
+	if c == nil {
+		return nil
+	}
+	c.m.Lock()
+	defer c.m.Unlock()
+	handlers := make([]EventHandler, len(c.handlers))
+	copy(handlers, c.handlers)
+	return handlers
+}
+
+// Matches returns true if the update is for the handler's exchange and, when
+// set, its currency pair and asset
+func (h *EventHandler) Matches(u *EventUpdate) bool {
+	if !strings.EqualFold(h.Exchange, u.Exchange) {
+		return false
+	}
+	if !h.Pair.IsEmpty() && !h.Pair.Equal(u.Pair) {
+		return false
+	}
+	return h.Asset == asset.Empty || h.Asset == u.Asset
+}
+
+// NewEventUpdates converts an update received from an exchange event feed to
+// the objects passed to script event handlers
+func NewEventUpdates(event, exch string, data any) ([]EventUpdate, error) {
+	switch event {
+	case modules.EventTicker:
+		tx, ok := data.(*ticker.Price)
+		if !ok {
+			return nil, common.GetTypeAssertError("*ticker.Price", data)
+		}
+		return []EventUpdate{{Exchange: exch, Pair: tx.Pair, Asset: tx.AssetType, Data: tickerToObject(tx)}}, nil
+	case modules.EventOrderbook:
+		depth, ok := data.(*orderbook.Depth)
+		if !ok {
+			return nil, common.GetTypeAssertError("*orderbook.Depth", data)
+		}
+		ob, err := depth.Retrieve()
+		if err != nil {
+			return nil, err
+		}
+		return []EventUpdate{{Exchange: exch, Pair: ob.Pair, Asset: ob.Asset, Data: orderbookToObject(ob)}}, nil
+	case modules.EventTrade:
+		trades, ok := data.([]trade.Data)
+		if !ok {
+			return nil, common.GetTypeAssertError("[]trade.Data", data)
+		}
+		updates := make([]EventUpdate, len(trades))
+		for i := range trades {
+			updates[i] = EventUpdate{Exchange: exch, Pair: trades[i].CurrencyPair, Asset: trades[i].AssetType, Data: tradeToObject(&trades[i])}
+		}
+		return updates, nil
+	case modules.EventOrderUpdate:
+		od, ok := data.(*order.Detail)
+		if !ok {
+			return nil, common.GetTypeAssertError("*order.Detail", data)
+		}
+		return []EventUpdate{{Exchange: exch, Pair: od.Pair, Asset: od.AssetType, Data: orderToObject(od)}}, nil
+	case modules.EventBalanceChange:
+		sub, ok := data.(*accounts.SubAccount)
+		if !ok {
+			return nil, common.GetTypeAssertError("*accounts.SubAccount", data)
+		}
+		return []EventUpdate{{Exchange: exch, Asset: sub.AssetType, Data: subAccountToObject(exch, sub)}}, nil
+	}
+	return nil, fmt.Errorf("%w %q", errUnsupportedEvent, event)
+}
+
+// tradeToObject converts a trade to a script object
+func tradeToObject(t *trade.Data) objects.Object {
+	data := make(map[string]objects.Object, 8)
+	data["exchange"] = &objects.String{Value: t.Exchange}
+	data["id"] = &objects.String{Value: t.TID}
+	data["pair"] = &objects.String{Value: t.CurrencyPair.String()}
+	data["asset"] = &objects.String{Value: t.AssetType.String()}
+	data["side"] = &objects.String{Value: t.Side.String()}
+	data["price"] = &objects.Float{Value: t.Price}
+	data["amount"] = &objects.Float{Value: t.Amount}
+	data["timestamp"] = &objects.Time{Value: t.Timestamp}
+	return &objects.Map{Value: data}
+}
+
+// subAccountToObject converts sub account balances to a script object
+func subAccountToObject(exch string, sub *accounts.SubAccount) objects.Object {
+	funds := objects.Array{Value: make([]objects.Object, 0, len(sub.Balances))}
+	for curr, bal := range sub.Balances {
+		funds.Value = append(funds.Value, &objects.Map{Value: map[string]objects.Object{
+			"name":  &objects.String{Value: curr.String()},
+			"total": &objects.Float{Value: bal.Total},
+			"hold":  &objects.Float{Value: bal.Hold},
+			"free":  &objects.Float{Value: bal.Free},
+		}})
+	}
+
+	data := make(map[string]objects.Object, 4)
+	data["exchange"] = &objects.String{Value: exch}
+	data["accountid"] = &objects.String{Value: sub.ID}
+	data["asset"] = &objects.String{Value: sub.AssetType.String()}
+	data["currencies"] = &funds
+	return &objects.Map{Value: data}
+}
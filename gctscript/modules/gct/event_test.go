@@ -0,0 +1,142 @@
+package gct
+
+import (
+	"testing"
+	"time"
+
+	objects "github.com/d5/tengo/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchange/accounts"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules"
+)
+
+var testHandler = &objects.UserFunction{Name: "handler", Value: func(...objects.Object) (objects.Object, error) { return nil, nil }}
+
+func TestEventOnMarket(t *testing.T) {
+	t.Parallel()
+	for _, fn := range []objects.CallableFunc{EventOnTicker, EventOnOrderbook, EventOnTrade} {
+		c := &Context{}
+		_, err := fn(c, exch, currencyPair, delimiter)
+		assert.ErrorIs(t, err, objects.ErrWrongNumArguments)
+
+		_, err = fn(tv, exch, currencyPair, delimiter, assetType, testHandler)
+		assert.Error(t, err, "Should error on an invalid script context")
+
+		_, err = fn(c, exch, currencyPair, delimiter, assetType, tv)
+		assert.Error(t, err, "Should error on a handler which cannot be called")
+
+		resp, err := fn(c, exch, currencyPair, delimiter, blank, testHandler)
+		require.NoError(t, err)
+		assert.IsType(t, &objects.Error{}, resp, "Should return an error object on an invalid asset")
+
+		resp, err = fn(c, exch, currencyPair, delimiter, assetType, testHandler)
+		require.NoError(t, err)
+		assert.Nil(t, resp)
+		require.Len(t, c.EventHandlers(), 1)
+
+		_, err = fn(c, exch, currencyPair, delimiter, assetType, testHandler)
+		require.NoError(t, err)
+		assert.Len(t, c.EventHandlers(), 1, "Should replace an existing handler for the same market")
+	}
+}
+
+func TestEventOnAccount(t *testing.T) {
+	t.Parallel()
+	for _, fn := range []objects.CallableFunc{EventOnOrderUpdate, EventOnBalanceChange} {
+		c := &Context{}
+		_, err := fn(c, exch)
+		assert.ErrorIs(t, err, objects.ErrWrongNumArguments)
+
+		_, err = fn(tv, exch, testHandler)
+		assert.Error(t, err, "Should error on an invalid script context")
+
+		_, err = fn(c, exch, tv)
+		assert.Error(t, err, "Should error on a handler which cannot be called")
+
+		_, err = fn(c, exch, testHandler)
+		require.NoError(t, err)
+		h := c.EventHandlers()
+		require.Len(t, h, 1)
+		assert.True(t, h[0].Pair.IsEmpty())
+		assert.Equal(t, asset.Empty, h[0].Asset)
+	}
+}
+
+func TestEventHandlers(t *testing.T) {
+	t.Parallel()
+	var c *Context
+	assert.Nil(t, c.EventHandlers())
+
+	c = &Context{}
+	c.addEventHandler(EventHandler{Event: modules.EventTicker, Exchange: "Bitstamp"})
+	c.addEventHandler(EventHandler{Event: modules.EventTicker, Exchange: "bitstamp", Function: testHandler})
+	c.addEventHandler(EventHandler{Event: modules.EventTrade, Exchange: "bitstamp"})
+	h := c.EventHandlers()
+	require.Len(t, h, 2)
+	assert.Equal(t, testHandler, h[0].Function, "Should replace the handler for the same event and exchange")
+}
+
+func TestEventHandlerMatches(t *testing.T) {
+	t.Parallel()
+	btcusd := currency.NewBTCUSD()
+	u := &EventUpdate{Exchange: "Bitstamp", Pair: btcusd, Asset: asset.Spot}
+	assert.True(t, (&EventHandler{Exchange: "bitstamp"}).Matches(u))
+	assert.True(t, (&EventHandler{Exchange: "bitstamp", Pair: btcusd, Asset: asset.Spot}).Matches(u))
+	assert.False(t, (&EventHandler{Exchange: "binance"}).Matches(u))
+	assert.False(t, (&EventHandler{Exchange: "bitstamp", Pair: currency.NewPair(currency.ETH, currency.USD)}).Matches(u))
+	assert.False(t, (&EventHandler{Exchange: "bitstamp", Asset: asset.Futures}).Matches(u))
+}
+
+func TestNewEventUpdates(t *testing.T) {
+	t.Parallel()
+	btcusd := currency.NewBTCUSD()
+
+	_, err := NewEventUpdates("bruh", "bitstamp", nil)
+	assert.ErrorIs(t, err, errUnsupportedEvent)
+
+	for _, event := range []string{modules.EventTicker, modules.EventOrderbook, modules.EventTrade, modules.EventOrderUpdate, modules.EventBalanceChange} {
+		_, err = NewEventUpdates(event, "bitstamp", "bruh")
+		assert.ErrorIs(t, err, common.ErrTypeAssertFailure, event)
+	}
+
+	u, err := NewEventUpdates(modules.EventTicker, "bitstamp", &ticker.Price{Pair: btcusd, AssetType: asset.Spot, Last: 1337})
+	require.NoError(t, err)
+	require.Len(t, u, 1)
+	assert.Equal(t, btcusd, u[0].Pair)
+	assert.Equal(t, asset.Spot, u[0].Asset)
+	m, ok := u[0].Data.(*objects.Map)
+	require.True(t, ok)
+	assert.Equal(t, &objects.Float{Value: 1337}, m.Value["last"])
+
+	u, err = NewEventUpdates(modules.EventTrade, "bitstamp", []trade.Data{
+		{TID: "1", CurrencyPair: btcusd, AssetType: asset.Spot, Price: 1, Amount: 2, Timestamp: time.Now()},
+		{TID: "2", CurrencyPair: btcusd, AssetType: asset.Spot, Price: 3, Amount: 4, Timestamp: time.Now()},
+	})
+	require.NoError(t, err)
+	require.Len(t, u, 2, "Should return an update for each trade")
+	m, ok = u[1].Data.(*objects.Map)
+	require.True(t, ok)
+	assert.Equal(t, &objects.String{Value: "2"}, m.Value["id"])
+
+	u, err = NewEventUpdates(modules.EventOrderUpdate, "bitstamp", &order.Detail{OrderID: "1337", Pair: btcusd, AssetType: asset.Spot})
+	require.NoError(t, err)
+	require.Len(t, u, 1)
+	m, ok = u[0].Data.(*objects.Map)
+	require.True(t, ok)
+	assert.Equal(t, &objects.String{Value: "1337"}, m.Value["id"])
+
+	u, err = NewEventUpdates(modules.EventBalanceChange, "bitstamp", &accounts.SubAccount{ID: "1", AssetType: asset.Spot})
+	require.NoError(t, err)
+	require.Len(t, u, 1)
+	assert.True(t, u[0].Pair.IsEmpty())
+	m, ok = u[0].Data.(*objects.Map)
+	require.True(t, ok)
+	assert.Equal(t, &objects.String{Value: "1"}, m.Value["accountid"])
+}
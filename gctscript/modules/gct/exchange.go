@@ -2,6 +2,7 @@ package gct
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	objects "github.com/d5/tengo/v2"
@@ -10,6 +11,9 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook/analytics"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/gctscript/modules/ta/indicators"
 	"github.com/thrasher-corp/gocryptotrader/gctscript/wrappers"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
@@ -28,6 +32,20 @@ const (
 	withdrawCryptoFunc  = "withdrawcrypto"
 	withdrawFiatFunc    = "withdrawfiat"
 	ohlcvFunc           = "ohlcv"
+	orderModifyFunc     = "ordermodify"
+	activeOrdersFunc    = "activeorders"
+	orderHistoryFunc    = "orderhistory"
+	futuresPositionFunc = "futuresposition"
+	fundingRateFunc     = "fundingrate"
+	openInterestFunc    = "openinterest"
+	collateralModeFunc  = "collateralmode"
+	setCollateralFunc   = "setcollateralmode"
+	leverageFunc        = "leverage"
+	setLeverageFunc     = "setleverage"
+	setMarginTypeFunc   = "setmargintype"
+	aggregatedBookFunc  = "aggregatedorderbook"
+	bookAnalyticsFunc   = "orderbookanalytics"
+	untrackBookFunc     = "untrackorderbookanalytics"
 )
 
 var exchangeModule = map[string]objects.Object{
@@ -43,6 +61,20 @@ var exchangeModule = map[string]objects.Object{
 	withdrawCryptoFunc:  &objects.UserFunction{Name: withdrawCryptoFunc, Value: ExchangeWithdrawCrypto},
 	withdrawFiatFunc:    &objects.UserFunction{Name: withdrawFiatFunc, Value: ExchangeWithdrawFiat},
 	ohlcvFunc:           &objects.UserFunction{Name: ohlcvFunc, Value: exchangeOHLCV},
+	orderModifyFunc:     &objects.UserFunction{Name: orderModifyFunc, Value: ExchangeOrderModify},
+	activeOrdersFunc:    &objects.UserFunction{Name: activeOrdersFunc, Value: ExchangeActiveOrders},
+	orderHistoryFunc:    &objects.UserFunction{Name: orderHistoryFunc, Value: ExchangeOrderHistory},
+	futuresPositionFunc: &objects.UserFunction{Name: futuresPositionFunc, Value: ExchangeFuturesPosition},
+	fundingRateFunc:     &objects.UserFunction{Name: fundingRateFunc, Value: ExchangeFundingRate},
+	openInterestFunc:    &objects.UserFunction{Name: openInterestFunc, Value: ExchangeOpenInterest},
+	collateralModeFunc:  &objects.UserFunction{Name: collateralModeFunc, Value: ExchangeCollateralMode},
+	setCollateralFunc:   &objects.UserFunction{Name: setCollateralFunc, Value: ExchangeSetCollateralMode},
+	leverageFunc:        &objects.UserFunction{Name: leverageFunc, Value: ExchangeLeverage},
+	setLeverageFunc:     &objects.UserFunction{Name: setLeverageFunc, Value: ExchangeSetLeverage},
+	setMarginTypeFunc:   &objects.UserFunction{Name: setMarginTypeFunc, Value: ExchangeSetMarginType},
+	aggregatedBookFunc:  &objects.UserFunction{Name: aggregatedBookFunc, Value: ExchangeAggregatedOrderbook},
+	bookAnalyticsFunc:   &objects.UserFunction{Name: bookAnalyticsFunc, Value: ExchangeOrderbookAnalytics},
+	untrackBookFunc:     &objects.UserFunction{Name: untrackBookFunc, Value: ExchangeUntrackOrderbookAnalytics},
 }
 
 // ExchangeOrderbook returns orderbook for requested exchange & currencypair
@@ -88,30 +120,182 @@ func ExchangeOrderbook(args ...objects.Object) (objects.Object, error) {
 		return errorResponsef(standardFormatting, err)
 	}
 
-	asks := objects.Array{Value: make([]objects.Object, len(ob.Asks))}
-	for x := range ob.Asks {
-		temp := make(map[string]objects.Object, 2)
-		temp["amount"] = &objects.Float{Value: ob.Asks[x].Amount}
-		temp["price"] = &objects.Float{Value: ob.Asks[x].Price}
-		asks.Value[x] = &objects.Map{Value: temp}
+	return orderbookToObject(ob), nil
+}
+
+// ExchangeAggregatedOrderbook returns an orderbook consolidated across exchanges
+// with each level attributed to the exchanges providing its liquidity. Sources
+// are exchange names, optionally suffixed with the exchange's pair e.g.
+// "binance:BTC-USDT" when normalising quote currencies
+// Params: scriptCTX, sources, currencyPair, delimiter, asset, [normaliseQuote]
+func ExchangeAggregatedOrderbook(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 5 && len(args) != 6 {
+		return nil, objects.ErrWrongNumArguments
 	}
 
-	bids := objects.Array{Value: make([]objects.Object, len(ob.Bids))}
-	for x := range ob.Bids {
-		temp := make(map[string]objects.Object, 2)
-		temp["amount"] = &objects.Float{Value: ob.Bids[x].Amount}
-		temp["price"] = &objects.Float{Value: ob.Bids[x].Price}
-		bids.Value[x] = &objects.Map{Value: temp}
+	scriptCtx, ok := objects.ToInterface(args[0]).(*Context)
+	if !ok {
+		return nil, constructRuntimeError(1, aggregatedBookFunc, "*gct.Context", args[0])
+	}
+	var sourceParams []string
+	switch v := args[1].(type) {
+	case *objects.Array:
+		sourceParams = make([]string, len(v.Value))
+		for i := range v.Value {
+			if sourceParams[i], ok = objects.ToString(v.Value[i]); !ok {
+				return nil, constructRuntimeError(2, aggregatedBookFunc, "[]string", args[1])
+			}
+		}
+	default:
+		source, ok := objects.ToString(v)
+		if !ok {
+			return nil, constructRuntimeError(2, aggregatedBookFunc, "[]string", args[1])
+		}
+		sourceParams = strings.Split(source, ",")
+	}
+	currencyPair, ok := objects.ToString(args[2])
+	if !ok {
+		return nil, constructRuntimeError(3, aggregatedBookFunc, "string", args[2])
+	}
+	delimiter, ok := objects.ToString(args[3])
+	if !ok {
+		return nil, constructRuntimeError(4, aggregatedBookFunc, "string", args[3])
+	}
+	assetTypeParam, ok := objects.ToString(args[4])
+	if !ok {
+		return nil, constructRuntimeError(5, aggregatedBookFunc, "string", args[4])
+	}
+	var normaliseQuote bool
+	if len(args) == 6 {
+		normaliseQuote = !args[5].IsFalsy()
 	}
 
-	data := make(map[string]objects.Object, 5)
-	data["exchange"] = &objects.String{Value: ob.Exchange}
-	data["pair"] = &objects.String{Value: ob.Pair.String()}
-	data["asks"] = &asks
-	data["bids"] = &bids
-	data["asset"] = &objects.String{Value: ob.Asset.String()}
+	pair, err := currency.NewPairDelimiter(currencyPair, delimiter)
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+	assetType, err := asset.New(assetTypeParam)
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
 
-	return &objects.Map{Value: data}, nil
+	sources := make([]orderbook.AggregatorSource, len(sourceParams))
+	for i := range sourceParams {
+		exchangeName, sourcePair, hasPair := strings.Cut(sourceParams[i], ":")
+		sources[i].Exchange = exchangeName
+		if hasPair {
+			if sources[i].Pair, err = currency.NewPairDelimiter(sourcePair, delimiter); err != nil {
+				return errorResponsef(standardFormatting, err)
+			}
+		}
+	}
+
+	ctx := processScriptContext(scriptCtx)
+	book, err := wrappers.GetWrapper().AggregatedOrderbook(ctx, &orderbook.AggregatorConfig{
+		Pair:           pair,
+		Asset:          assetType,
+		Sources:        sources,
+		NormaliseQuote: normaliseQuote,
+	})
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+	return aggregatedBookToObject(book), nil
+}
+
+// ExchangeOrderbookAnalytics returns the latest microstructure analytics of an
+// orderbook, tracking the orderbook if it is not already tracked. Bands are
+// basis point distances from the mid price and window is a duration e.g. "5m",
+// both are only applied when the orderbook is first tracked
+// Params: scriptCTX, exchangeName, currencyPair, delimiter, asset, [bands, levels, window]
+func ExchangeOrderbookAnalytics(args ...objects.Object) (objects.Object, error) {
+	if len(args) < 5 || len(args) > 8 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	scriptCtx, ok := objects.ToInterface(args[0]).(*Context)
+	if !ok {
+		return nil, constructRuntimeError(1, bookAnalyticsFunc, "*gct.Context", args[0])
+	}
+	exchangeName, ok := objects.ToString(args[1])
+	if !ok {
+		return nil, constructRuntimeError(2, bookAnalyticsFunc, "string", args[1])
+	}
+	currencyPair, ok := objects.ToString(args[2])
+	if !ok {
+		return nil, constructRuntimeError(3, bookAnalyticsFunc, "string", args[2])
+	}
+	delimiter, ok := objects.ToString(args[3])
+	if !ok {
+		return nil, constructRuntimeError(4, bookAnalyticsFunc, "string", args[3])
+	}
+	assetTypeParam, ok := objects.ToString(args[4])
+	if !ok {
+		return nil, constructRuntimeError(5, bookAnalyticsFunc, "string", args[4])
+	}
+
+	var cfg *analytics.Config
+	if len(args) > 5 {
+		cfg = &analytics.Config{}
+		bands, ok := args[5].(*objects.Array)
+		if !ok {
+			return nil, constructRuntimeError(6, bookAnalyticsFunc, "[]float", args[5])
+		}
+		cfg.Bands = make([]float64, len(bands.Value))
+		for i := range bands.Value {
+			if cfg.Bands[i], ok = objects.ToFloat64(bands.Value[i]); !ok {
+				return nil, constructRuntimeError(6, bookAnalyticsFunc, "[]float", args[5])
+			}
+		}
+	}
+	if len(args) > 6 {
+		if cfg.Levels, ok = objects.ToInt(args[6]); !ok {
+			return nil, constructRuntimeError(7, bookAnalyticsFunc, "int", args[6])
+		}
+	}
+	if len(args) > 7 {
+		window, ok := objects.ToString(args[7])
+		if !ok {
+			return nil, constructRuntimeError(8, bookAnalyticsFunc, "string", args[7])
+		}
+		var err error
+		if cfg.Window, err = time.ParseDuration(window); err != nil {
+			return errorResponsef(standardFormatting, err)
+		}
+	}
+
+	pair, err := currency.NewPairDelimiter(currencyPair, delimiter)
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+	assetType, err := asset.New(assetTypeParam)
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+
+	ctx := processScriptContext(scriptCtx)
+	m, err := wrappers.GetWrapper().OrderbookAnalytics(ctx, exchangeName, pair, assetType, cfg)
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+	return orderbookAnalyticsToObject(m), nil
+}
+
+// ExchangeUntrackOrderbookAnalytics stops tracking the analytics of an
+// orderbook tracked by orderbookanalytics
+// Params: scriptCTX, exchangeName, currencyPair, delimiter, asset
+func ExchangeUntrackOrderbookAnalytics(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 5 {
+		return nil, objects.ErrWrongNumArguments
+	}
+	m, errResp, err := parseMarketArgs(untrackBookFunc, args...)
+	if errResp != nil || err != nil {
+		return errResp, err
+	}
+	if err := wrappers.GetWrapper().UntrackOrderbookAnalytics(m.exchange, m.pair, m.asset); err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+	return objects.TrueValue, nil
 }
 
 // ExchangeTicker returns ticker data for requested exchange and currency pair
@@ -158,25 +342,7 @@ func ExchangeTicker(args ...objects.Object) (objects.Object, error) {
 		return errorResponsef(standardFormatting, err)
 	}
 
-	data := make(map[string]objects.Object, 14)
-	data["exchange"] = &objects.String{Value: tx.ExchangeName}
-	data["last"] = &objects.Float{Value: tx.Last}
-	data["High"] = &objects.Float{Value: tx.High}
-	data["Low"] = &objects.Float{Value: tx.Low}
-	data["bid"] = &objects.Float{Value: tx.Bid}
-	data["ask"] = &objects.Float{Value: tx.Ask}
-	data["volume"] = &objects.Float{Value: tx.Volume}
-	data["quotevolume"] = &objects.Float{Value: tx.QuoteVolume}
-	data["priceath"] = &objects.Float{Value: tx.PriceATH}
-	data["open"] = &objects.Float{Value: tx.Open}
-	data["close"] = &objects.Float{Value: tx.Close}
-	data["pair"] = &objects.String{Value: tx.Pair.String()}
-	data["asset"] = &objects.String{Value: tx.AssetType.String()}
-	data["updated"] = &objects.Time{Value: tx.LastUpdated}
-
-	return &objects.Map{
-		Value: data,
-	}, nil
+	return tickerToObject(tx), nil
 }
 
 // ExchangeExchanges returns list of exchanges either enabled or all
@@ -339,37 +505,7 @@ func ExchangeOrderQuery(args ...objects.Object) (objects.Object, error) {
 		return errorResponsef(standardFormatting, err)
 	}
 
-	var tradeHistory objects.Array
-	tradeHistory.Value = make([]objects.Object, len(orderDetails.Trades))
-	for x := range orderDetails.Trades {
-		temp := make(map[string]objects.Object, 7)
-		temp["timestamp"] = &objects.Time{Value: orderDetails.Trades[x].Timestamp}
-		temp["price"] = &objects.Float{Value: orderDetails.Trades[x].Price}
-		temp["fee"] = &objects.Float{Value: orderDetails.Trades[x].Fee}
-		temp["amount"] = &objects.Float{Value: orderDetails.Trades[x].Amount}
-		temp["type"] = &objects.String{Value: orderDetails.Trades[x].Type.String()}
-		temp["side"] = &objects.String{Value: orderDetails.Trades[x].Side.String()}
-		temp["description"] = &objects.String{Value: orderDetails.Trades[x].Description}
-		tradeHistory.Value[x] = &objects.Map{Value: temp}
-	}
-
-	data := make(map[string]objects.Object, 14)
-	data["exchange"] = &objects.String{Value: orderDetails.Exchange}
-	data["id"] = &objects.String{Value: orderDetails.OrderID}
-	data["accountid"] = &objects.String{Value: orderDetails.AccountID}
-	data["currencypair"] = &objects.String{Value: orderDetails.Pair.String()}
-	data["price"] = &objects.Float{Value: orderDetails.Price}
-	data["amount"] = &objects.Float{Value: orderDetails.Amount}
-	data["amountexecuted"] = &objects.Float{Value: orderDetails.ExecutedAmount}
-	data["amountremaining"] = &objects.Float{Value: orderDetails.RemainingAmount}
-	data["fee"] = &objects.Float{Value: orderDetails.Fee}
-	data["side"] = &objects.String{Value: orderDetails.Side.String()}
-	data["type"] = &objects.String{Value: orderDetails.Type.String()}
-	data["date"] = &objects.String{Value: orderDetails.Date.String()}
-	data["status"] = &objects.String{Value: orderDetails.Status.String()}
-	data["trades"] = &tradeHistory
-
-	return &objects.Map{Value: data}, nil
+	return orderToObject(orderDetails), nil
 }
 
 // ExchangeOrderCancel cancels order on requested exchange
@@ -527,6 +663,189 @@ func ExchangeOrderSubmit(args ...objects.Object) (objects.Object, error) {
 	return &objects.Map{Value: data}, nil
 }
 
+// ExchangeOrderModify amends the price and amount of an order on requested
+// exchange
+// Params: scriptCTX, exchangeName, orderID, currencyPair, delimiter, asset, price, amount
+func ExchangeOrderModify(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 8 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	scriptCtx, ok := objects.ToInterface(args[0]).(*Context)
+	if !ok {
+		return nil, constructRuntimeError(1, orderModifyFunc, "*gct.Context", args[0])
+	}
+	exchangeName, ok := objects.ToString(args[1])
+	if !ok {
+		return nil, constructRuntimeError(2, orderModifyFunc, "string", args[1])
+	}
+	orderID, ok := objects.ToString(args[2])
+	if !ok {
+		return nil, constructRuntimeError(3, orderModifyFunc, "string", args[2])
+	}
+	if orderID == "" {
+		return nil, fmt.Errorf(ErrEmptyParameter, "orderID")
+	}
+	currencyPair, ok := objects.ToString(args[3])
+	if !ok {
+		return nil, constructRuntimeError(4, orderModifyFunc, "string", args[3])
+	}
+	delimiter, ok := objects.ToString(args[4])
+	if !ok {
+		return nil, constructRuntimeError(5, orderModifyFunc, "string", args[4])
+	}
+	assetType, ok := objects.ToString(args[5])
+	if !ok {
+		return nil, constructRuntimeError(6, orderModifyFunc, "string", args[5])
+	}
+	price, ok := objects.ToFloat64(args[6])
+	if !ok {
+		return nil, constructRuntimeError(7, orderModifyFunc, "float64", args[6])
+	}
+	amount, ok := objects.ToFloat64(args[7])
+	if !ok {
+		return nil, constructRuntimeError(8, orderModifyFunc, "float64", args[7])
+	}
+
+	pair, err := currency.NewPairDelimiter(currencyPair, delimiter)
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+
+	a, err := asset.New(assetType)
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+
+	ctx := processScriptContext(scriptCtx)
+	resp, err := wrappers.GetWrapper().ModifyOrder(ctx, &order.Modify{
+		Exchange:  exchangeName,
+		OrderID:   orderID,
+		Pair:      pair,
+		AssetType: a,
+		Price:     price,
+		Amount:    amount,
+	})
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+
+	data := make(map[string]objects.Object, 4)
+	data["orderid"] = &objects.String{Value: resp.OrderID}
+	data["status"] = &objects.String{Value: resp.Status.String()}
+	data["price"] = &objects.Float{Value: resp.Price}
+	data["amount"] = &objects.Float{Value: resp.Amount}
+	return &objects.Map{Value: data}, nil
+}
+
+// ExchangeActiveOrders returns open orders on requested exchange, optionally
+// filtered by currency pair
+// Params: scriptCTX, exchangeName, asset, [currencyPair, delimiter]
+func ExchangeActiveOrders(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 3 && len(args) != 5 {
+		return nil, objects.ErrWrongNumArguments
+	}
+	scriptCtx, exchangeName, req, errResp, err := parseOrdersRequest(activeOrdersFunc, args...)
+	if errResp != nil || err != nil {
+		return errResp, err
+	}
+
+	ctx := processScriptContext(scriptCtx)
+	orders, err := wrappers.GetWrapper().ActiveOrders(ctx, exchangeName, req)
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+	return ordersToObject(orders), nil
+}
+
+// ExchangeOrderHistory returns closed orders on requested exchange, optionally
+// filtered by currency pair and time range
+// Params: scriptCTX, exchangeName, asset, [currencyPair, delimiter, [start, end]]
+func ExchangeOrderHistory(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 3 && len(args) != 5 && len(args) != 7 {
+		return nil, objects.ErrWrongNumArguments
+	}
+	scriptCtx, exchangeName, req, errResp, err := parseOrdersRequest(orderHistoryFunc, args[:min(len(args), 5)]...)
+	if errResp != nil || err != nil {
+		return errResp, err
+	}
+	if len(args) == 7 {
+		var ok bool
+		req.StartTime, ok = objects.ToTime(args[5])
+		if !ok {
+			return nil, constructRuntimeError(6, orderHistoryFunc, "time.Time", args[5])
+		}
+		req.EndTime, ok = objects.ToTime(args[6])
+		if !ok {
+			return nil, constructRuntimeError(7, orderHistoryFunc, "time.Time", args[6])
+		}
+		if err := common.StartEndTimeCheck(req.StartTime, req.EndTime); err != nil {
+			return errorResponsef(standardFormatting, err)
+		}
+	}
+
+	ctx := processScriptContext(scriptCtx)
+	orders, err := wrappers.GetWrapper().OrderHistory(ctx, exchangeName, req)
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+	return ordersToObject(orders), nil
+}
+
+// parseOrdersRequest parses the script context, exchange name, asset and
+// optional currency pair arguments for requesting multiple orders. A non nil
+// object is returned for errors which are handled script side
+func parseOrdersRequest(funcName string, args ...objects.Object) (*Context, string, *order.MultiOrderRequest, objects.Object, error) {
+	scriptCtx, ok := objects.ToInterface(args[0]).(*Context)
+	if !ok {
+		return nil, "", nil, nil, constructRuntimeError(1, funcName, "*gct.Context", args[0])
+	}
+	exchangeName, ok := objects.ToString(args[1])
+	if !ok {
+		return nil, "", nil, nil, constructRuntimeError(2, funcName, "string", args[1])
+	}
+	assetType, ok := objects.ToString(args[2])
+	if !ok {
+		return nil, "", nil, nil, constructRuntimeError(3, funcName, "string", args[2])
+	}
+	a, err := asset.New(assetType)
+	if err != nil {
+		errResp, err := errorResponsef(standardFormatting, err)
+		return nil, "", nil, errResp, err
+	}
+	req := &order.MultiOrderRequest{
+		AssetType: a,
+		Type:      order.AnyType,
+		Side:      order.AnySide,
+	}
+	if len(args) > 3 {
+		currencyPair, ok := objects.ToString(args[3])
+		if !ok {
+			return nil, "", nil, nil, constructRuntimeError(4, funcName, "string", args[3])
+		}
+		delimiter, ok := objects.ToString(args[4])
+		if !ok {
+			return nil, "", nil, nil, constructRuntimeError(5, funcName, "string", args[4])
+		}
+		pair, err := currency.NewPairDelimiter(currencyPair, delimiter)
+		if err != nil {
+			errResp, err := errorResponsef(standardFormatting, err)
+			return nil, "", nil, errResp, err
+		}
+		req.Pairs = currency.Pairs{pair}
+	}
+	return scriptCtx, exchangeName, req, nil, nil
+}
+
+// ordersToObject converts orders to a script array
+func ordersToObject(orders []order.Detail) objects.Object {
+	r := objects.Array{Value: make([]objects.Object, len(orders))}
+	for i := range orders {
+		r.Value[i] = orderToObject(&orders[i])
+	}
+	return &r
+}
+
 // ExchangeDepositAddress returns deposit address (if supported by exchange)
 func ExchangeDepositAddress(args ...objects.Object) (objects.Object, error) {
 	if len(args) != 3 {
@@ -769,6 +1088,184 @@ func exchangeOHLCV(args ...objects.Object) (objects.Object, error) {
 	return c, nil
 }
 
+// orderbookToObject converts an orderbook to a script object
+func orderbookToObject(ob *orderbook.Book) objects.Object {
+	asks := objects.Array{Value: make([]objects.Object, len(ob.Asks))}
+	for x := range ob.Asks {
+		temp := make(map[string]objects.Object, 2)
+		temp["amount"] = &objects.Float{Value: ob.Asks[x].Amount}
+		temp["price"] = &objects.Float{Value: ob.Asks[x].Price}
+		asks.Value[x] = &objects.Map{Value: temp}
+	}
+
+	bids := objects.Array{Value: make([]objects.Object, len(ob.Bids))}
+	for x := range ob.Bids {
+		temp := make(map[string]objects.Object, 2)
+		temp["amount"] = &objects.Float{Value: ob.Bids[x].Amount}
+		temp["price"] = &objects.Float{Value: ob.Bids[x].Price}
+		bids.Value[x] = &objects.Map{Value: temp}
+	}
+
+	data := make(map[string]objects.Object, 5)
+	data["exchange"] = &objects.String{Value: ob.Exchange}
+	data["pair"] = &objects.String{Value: ob.Pair.String()}
+	data["asks"] = &asks
+	data["bids"] = &bids
+	data["asset"] = &objects.String{Value: ob.Asset.String()}
+
+	return &objects.Map{Value: data}
+}
+
+// aggregatedBookToObject converts an aggregated orderbook to a script object
+func aggregatedBookToObject(book *orderbook.AggregatedBook) objects.Object {
+	unavailable := make(map[string]objects.Object, len(book.Unavailable))
+	for exch, err := range book.Unavailable {
+		unavailable[exch] = &objects.String{Value: err.Error()}
+	}
+
+	data := make(map[string]objects.Object, 6)
+	data["pair"] = &objects.String{Value: book.Pair.String()}
+	data["asset"] = &objects.String{Value: book.Asset.String()}
+	data["bids"] = aggregatedLevelsToObject(book.Bids)
+	data["asks"] = aggregatedLevelsToObject(book.Asks)
+	data["lastupdated"] = &objects.Time{Value: book.LastUpdated}
+	data["unavailable"] = &objects.Map{Value: unavailable}
+	return &objects.Map{Value: data}
+}
+
+// aggregatedLevelsToObject converts aggregated orderbook levels to a script
+// object
+func aggregatedLevelsToObject(levels []orderbook.AggregatedLevel) objects.Object {
+	out := objects.Array{Value: make([]objects.Object, len(levels))}
+	for x := range levels {
+		sources := objects.Array{Value: make([]objects.Object, len(levels[x].Sources))}
+		for y, src := range levels[x].Sources {
+			temp := make(map[string]objects.Object, 6)
+			temp["exchange"] = &objects.String{Value: src.Exchange}
+			temp["pair"] = &objects.String{Value: src.Pair.String()}
+			temp["price"] = &objects.Float{Value: src.Price}
+			temp["amount"] = &objects.Float{Value: src.Amount}
+			temp["fee"] = &objects.Float{Value: src.Fee}
+			temp["effectiveprice"] = &objects.Float{Value: src.EffectivePrice}
+			sources.Value[y] = &objects.Map{Value: temp}
+		}
+		temp := make(map[string]objects.Object, 3)
+		temp["price"] = &objects.Float{Value: levels[x].Price}
+		temp["amount"] = &objects.Float{Value: levels[x].Amount}
+		temp["sources"] = &sources
+		out.Value[x] = &objects.Map{Value: temp}
+	}
+	return &out
+}
+
+// orderbookAnalyticsToObject converts orderbook analytics to a script object
+func orderbookAnalyticsToObject(m *analytics.Metrics) objects.Object {
+	bands := objects.Array{Value: make([]objects.Object, len(m.Bands))}
+	for x := range m.Bands {
+		temp := make(map[string]objects.Object, 6)
+		temp["bps"] = &objects.Float{Value: m.Bands[x].Bps}
+		temp["bidamount"] = &objects.Float{Value: m.Bands[x].BidAmount}
+		temp["askamount"] = &objects.Float{Value: m.Bands[x].AskAmount}
+		temp["bidvalue"] = &objects.Float{Value: m.Bands[x].BidValue}
+		temp["askvalue"] = &objects.Float{Value: m.Bands[x].AskValue}
+		temp["imbalance"] = &objects.Float{Value: m.Bands[x].Imbalance}
+		bands.Value[x] = &objects.Map{Value: temp}
+	}
+
+	spreadStats := make(map[string]objects.Object, 4)
+	spreadStats["mean"] = &objects.Float{Value: m.SpreadStats.Mean}
+	spreadStats["stddev"] = &objects.Float{Value: m.SpreadStats.StdDev}
+	spreadStats["min"] = &objects.Float{Value: m.SpreadStats.Min}
+	spreadStats["max"] = &objects.Float{Value: m.SpreadStats.Max}
+
+	data := make(map[string]objects.Object, 18)
+	data["exchange"] = &objects.String{Value: m.Exchange}
+	data["pair"] = &objects.String{Value: m.Pair.String()}
+	data["asset"] = &objects.String{Value: m.Asset.String()}
+	data["updateid"] = &objects.Int{Value: m.UpdateID}
+	data["lastupdated"] = &objects.Time{Value: m.LastUpdated}
+	data["bestbid"] = &objects.Float{Value: m.BestBid}
+	data["bestask"] = &objects.Float{Value: m.BestAsk}
+	data["midprice"] = &objects.Float{Value: m.MidPrice}
+	data["weightedmidprice"] = &objects.Float{Value: m.WeightedMidPrice}
+	data["microprice"] = &objects.Float{Value: m.Microprice}
+	data["spread"] = &objects.Float{Value: m.Spread}
+	data["spreadbps"] = &objects.Float{Value: m.SpreadBps}
+	data["imbalance"] = &objects.Float{Value: m.Imbalance}
+	data["spreadstats"] = &objects.Map{Value: spreadStats}
+	data["bands"] = &bands
+	data["bids"] = bookFlowToObject(&m.Bids)
+	data["asks"] = bookFlowToObject(&m.Asks)
+	data["window"] = &objects.String{Value: m.Window.String()}
+	return &objects.Map{Value: data}
+}
+
+// bookFlowToObject converts orderbook liquidity flow to a script object
+func bookFlowToObject(f *analytics.Flow) objects.Object {
+	data := make(map[string]objects.Object, 4)
+	data["replenishedpersecond"] = &objects.Float{Value: f.ReplenishedPerSecond}
+	data["cancelledpersecond"] = &objects.Float{Value: f.CancelledPerSecond}
+	data["replenishmentspersecond"] = &objects.Float{Value: f.ReplenishmentsPerSecond}
+	data["cancellationspersecond"] = &objects.Float{Value: f.CancellationsPerSecond}
+	return &objects.Map{Value: data}
+}
+
+// tickerToObject converts a ticker to a script object
+func tickerToObject(tx *ticker.Price) objects.Object {
+	data := make(map[string]objects.Object, 14)
+	data["exchange"] = &objects.String{Value: tx.ExchangeName}
+	data["last"] = &objects.Float{Value: tx.Last}
+	data["High"] = &objects.Float{Value: tx.High}
+	data["Low"] = &objects.Float{Value: tx.Low}
+	data["bid"] = &objects.Float{Value: tx.Bid}
+	data["ask"] = &objects.Float{Value: tx.Ask}
+	data["volume"] = &objects.Float{Value: tx.Volume}
+	data["quotevolume"] = &objects.Float{Value: tx.QuoteVolume}
+	data["priceath"] = &objects.Float{Value: tx.PriceATH}
+	data["open"] = &objects.Float{Value: tx.Open}
+	data["close"] = &objects.Float{Value: tx.Close}
+	data["pair"] = &objects.String{Value: tx.Pair.String()}
+	data["asset"] = &objects.String{Value: tx.AssetType.String()}
+	data["updated"] = &objects.Time{Value: tx.LastUpdated}
+
+	return &objects.Map{Value: data}
+}
+
+// orderToObject converts order details to a script object
+func orderToObject(orderDetails *order.Detail) objects.Object {
+	var tradeHistory objects.Array
+	tradeHistory.Value = make([]objects.Object, len(orderDetails.Trades))
+	for x := range orderDetails.Trades {
+		temp := make(map[string]objects.Object, 7)
+		temp["timestamp"] = &objects.Time{Value: orderDetails.Trades[x].Timestamp}
+		temp["price"] = &objects.Float{Value: orderDetails.Trades[x].Price}
+		temp["fee"] = &objects.Float{Value: orderDetails.Trades[x].Fee}
+		temp["amount"] = &objects.Float{Value: orderDetails.Trades[x].Amount}
+		temp["type"] = &objects.String{Value: orderDetails.Trades[x].Type.String()}
+		temp["side"] = &objects.String{Value: orderDetails.Trades[x].Side.String()}
+		temp["description"] = &objects.String{Value: orderDetails.Trades[x].Description}
+		tradeHistory.Value[x] = &objects.Map{Value: temp}
+	}
+
+	data := make(map[string]objects.Object, 14)
+	data["exchange"] = &objects.String{Value: orderDetails.Exchange}
+	data["id"] = &objects.String{Value: orderDetails.OrderID}
+	data["accountid"] = &objects.String{Value: orderDetails.AccountID}
+	data["currencypair"] = &objects.String{Value: orderDetails.Pair.String()}
+	data["price"] = &objects.Float{Value: orderDetails.Price}
+	data["amount"] = &objects.Float{Value: orderDetails.Amount}
+	data["amountexecuted"] = &objects.Float{Value: orderDetails.ExecutedAmount}
+	data["amountremaining"] = &objects.Float{Value: orderDetails.RemainingAmount}
+	data["fee"] = &objects.Float{Value: orderDetails.Fee}
+	data["side"] = &objects.String{Value: orderDetails.Side.String()}
+	data["type"] = &objects.String{Value: orderDetails.Type.String()}
+	data["date"] = &objects.String{Value: orderDetails.Date.String()}
+	data["status"] = &objects.String{Value: orderDetails.Status.String()}
+	data["trades"] = &tradeHistory
+
+	return &objects.Map{Value: data}
+}
+
 // parseInterval will parse the interval param of indictors that have them and convert to time.Duration
 func parseInterval(in string) (time.Duration, error) {
 	if !common.StringSliceContainsInsensitive(supportedDurations, in) {
@@ -0,0 +1,345 @@
+package gct
+
+import (
+	objects "github.com/d5/tengo/v2"
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/collateral"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/margin"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/wrappers"
+)
+
+// marketArgs holds the common arguments for functions which act on a single
+// exchange market
+type marketArgs struct {
+	ctx      *Context
+	exchange string
+	pair     currency.Pair
+	asset    asset.Item
+}
+
+// ExchangeFuturesPosition returns a summary of the open position for a
+// futures contract
+// Params: scriptCTX, exchangeName, currencyPair, delimiter, asset
+func ExchangeFuturesPosition(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 5 {
+		return nil, objects.ErrWrongNumArguments
+	}
+	m, errResp, err := parseMarketArgs(futuresPositionFunc, args...)
+	if errResp != nil || err != nil {
+		return errResp, err
+	}
+
+	ctx := processScriptContext(m.ctx)
+	pos, err := wrappers.GetWrapper().FuturesPosition(ctx, m.exchange, m.pair, m.asset)
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+	return positionToObject(m.exchange, pos), nil
+}
+
+// ExchangeFundingRate returns the latest funding rate for a perpetual contract
+// Params: scriptCTX, exchangeName, currencyPair, delimiter, asset, [includePredicted]
+func ExchangeFundingRate(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 5 && len(args) != 6 {
+		return nil, objects.ErrWrongNumArguments
+	}
+	m, errResp, err := parseMarketArgs(fundingRateFunc, args[:5]...)
+	if errResp != nil || err != nil {
+		return errResp, err
+	}
+	var includePredicted bool
+	if len(args) == 6 {
+		includePredicted = !args[5].IsFalsy()
+	}
+
+	ctx := processScriptContext(m.ctx)
+	rate, err := wrappers.GetWrapper().LatestFundingRate(ctx, m.exchange, m.pair, m.asset, includePredicted)
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+
+	data := make(map[string]objects.Object, 8)
+	data["exchange"] = &objects.String{Value: m.exchange}
+	data["pair"] = &objects.String{Value: rate.Pair.String()}
+	data["asset"] = &objects.String{Value: rate.Asset.String()}
+	data["rate"] = &objects.Float{Value: rate.LatestRate.Rate.InexactFloat64()}
+	data["time"] = &objects.Time{Value: rate.LatestRate.Time}
+	data["nextfunding"] = &objects.Time{Value: rate.TimeOfNextRate}
+	if !rate.PredictedUpcomingRate.Time.IsZero() {
+		data["predictedrate"] = &objects.Float{Value: rate.PredictedUpcomingRate.Rate.InexactFloat64()}
+		data["predictedtime"] = &objects.Time{Value: rate.PredictedUpcomingRate.Time}
+	}
+	return &objects.Map{Value: data}, nil
+}
+
+// ExchangeOpenInterest returns the open interest for a futures contract
+// Params: scriptCTX, exchangeName, currencyPair, delimiter, asset
+func ExchangeOpenInterest(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 5 {
+		return nil, objects.ErrWrongNumArguments
+	}
+	m, errResp, err := parseMarketArgs(openInterestFunc, args...)
+	if errResp != nil || err != nil {
+		return errResp, err
+	}
+
+	ctx := processScriptContext(m.ctx)
+	oi, err := wrappers.GetWrapper().OpenInterest(ctx, m.exchange, m.pair, m.asset)
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+	return &objects.Float{Value: oi}, nil
+}
+
+// ExchangeCollateralMode returns the collateral mode of an account asset
+// Params: scriptCTX, exchangeName, asset
+func ExchangeCollateralMode(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 3 {
+		return nil, objects.ErrWrongNumArguments
+	}
+	scriptCtx, exchangeName, a, errResp, err := parseAccountAssetArgs(collateralModeFunc, args...)
+	if errResp != nil || err != nil {
+		return errResp, err
+	}
+
+	ctx := processScriptContext(scriptCtx)
+	mode, err := wrappers.GetWrapper().CollateralMode(ctx, exchangeName, a)
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+	return &objects.String{Value: mode.String()}, nil
+}
+
+// ExchangeSetCollateralMode sets the collateral mode of an account asset
+// Params: scriptCTX, exchangeName, asset, collateralMode
+func ExchangeSetCollateralMode(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 4 {
+		return nil, objects.ErrWrongNumArguments
+	}
+	scriptCtx, exchangeName, a, errResp, err := parseAccountAssetArgs(setCollateralFunc, args[:3]...)
+	if errResp != nil || err != nil {
+		return errResp, err
+	}
+	modeParam, ok := objects.ToString(args[3])
+	if !ok {
+		return nil, constructRuntimeError(4, setCollateralFunc, "string", args[3])
+	}
+	mode, err := collateral.StringToMode(modeParam)
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+
+	ctx := processScriptContext(scriptCtx)
+	if err := wrappers.GetWrapper().SetCollateralMode(ctx, exchangeName, a, mode); err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+	return objects.TrueValue, nil
+}
+
+// ExchangeLeverage returns the leverage set for an account asset pair
+// Params: scriptCTX, exchangeName, currencyPair, delimiter, asset, marginType, [orderSide]
+func ExchangeLeverage(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 6 && len(args) != 7 {
+		return nil, objects.ErrWrongNumArguments
+	}
+	m, errResp, err := parseMarketArgs(leverageFunc, args[:5]...)
+	if errResp != nil || err != nil {
+		return errResp, err
+	}
+	marginType, errResp, err := parseMarginType(leverageFunc, 6, args[5])
+	if errResp != nil || err != nil {
+		return errResp, err
+	}
+	var side order.Side
+	if len(args) == 7 {
+		side, errResp, err = parseOrderSide(leverageFunc, 7, args[6])
+		if errResp != nil || err != nil {
+			return errResp, err
+		}
+	}
+
+	ctx := processScriptContext(m.ctx)
+	leverage, err := wrappers.GetWrapper().Leverage(ctx, m.exchange, m.pair, m.asset, marginType, side)
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+	return &objects.Float{Value: leverage}, nil
+}
+
+// ExchangeSetLeverage sets the leverage for an account asset pair
+// Params: scriptCTX, exchangeName, currencyPair, delimiter, asset, marginType, leverage, [orderSide]
+func ExchangeSetLeverage(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 7 && len(args) != 8 {
+		return nil, objects.ErrWrongNumArguments
+	}
+	m, errResp, err := parseMarketArgs(setLeverageFunc, args[:5]...)
+	if errResp != nil || err != nil {
+		return errResp, err
+	}
+	marginType, errResp, err := parseMarginType(setLeverageFunc, 6, args[5])
+	if errResp != nil || err != nil {
+		return errResp, err
+	}
+	leverage, ok := objects.ToFloat64(args[6])
+	if !ok {
+		return nil, constructRuntimeError(7, setLeverageFunc, "float64", args[6])
+	}
+	var side order.Side
+	if len(args) == 8 {
+		side, errResp, err = parseOrderSide(setLeverageFunc, 8, args[7])
+		if errResp != nil || err != nil {
+			return errResp, err
+		}
+	}
+
+	ctx := processScriptContext(m.ctx)
+	if err := wrappers.GetWrapper().SetLeverage(ctx, m.exchange, m.pair, m.asset, marginType, side, leverage); err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+	return objects.TrueValue, nil
+}
+
+// ExchangeSetMarginType sets the margin type for an account asset pair
+// Params: scriptCTX, exchangeName, currencyPair, delimiter, asset, marginType
+func ExchangeSetMarginType(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 6 {
+		return nil, objects.ErrWrongNumArguments
+	}
+	m, errResp, err := parseMarketArgs(setMarginTypeFunc, args[:5]...)
+	if errResp != nil || err != nil {
+		return errResp, err
+	}
+	marginType, errResp, err := parseMarginType(setMarginTypeFunc, 6, args[5])
+	if errResp != nil || err != nil {
+		return errResp, err
+	}
+
+	ctx := processScriptContext(m.ctx)
+	if err := wrappers.GetWrapper().SetMarginType(ctx, m.exchange, m.pair, m.asset, marginType); err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+	return objects.TrueValue, nil
+}
+
+// parseMarketArgs parses the script context, exchange name, currency pair,
+// delimiter and asset arguments. A non nil object is returned for errors which
+// are handled script side
+func parseMarketArgs(funcName string, args ...objects.Object) (*marketArgs, objects.Object, error) {
+	scriptCtx, ok := objects.ToInterface(args[0]).(*Context)
+	if !ok {
+		return nil, nil, constructRuntimeError(1, funcName, "*gct.Context", args[0])
+	}
+	exchangeName, ok := objects.ToString(args[1])
+	if !ok {
+		return nil, nil, constructRuntimeError(2, funcName, "string", args[1])
+	}
+	currencyPair, ok := objects.ToString(args[2])
+	if !ok {
+		return nil, nil, constructRuntimeError(3, funcName, "string", args[2])
+	}
+	delimiter, ok := objects.ToString(args[3])
+	if !ok {
+		return nil, nil, constructRuntimeError(4, funcName, "string", args[3])
+	}
+	assetTypeParam, ok := objects.ToString(args[4])
+	if !ok {
+		return nil, nil, constructRuntimeError(5, funcName, "string", args[4])
+	}
+
+	pair, err := currency.NewPairDelimiter(currencyPair, delimiter)
+	if err != nil {
+		errResp, err := errorResponsef(standardFormatting, err)
+		return nil, errResp, err
+	}
+	assetType, err := asset.New(assetTypeParam)
+	if err != nil {
+		errResp, err := errorResponsef(standardFormatting, err)
+		return nil, errResp, err
+	}
+	return &marketArgs{ctx: scriptCtx, exchange: exchangeName, pair: pair, asset: assetType}, nil, nil
+}
+
+// parseAccountAssetArgs parses the script context, exchange name and asset
+// arguments. A non nil object is returned for errors which are handled script
+// side
+func parseAccountAssetArgs(funcName string, args ...objects.Object) (*Context, string, asset.Item, objects.Object, error) {
+	scriptCtx, ok := objects.ToInterface(args[0]).(*Context)
+	if !ok {
+		return nil, "", asset.Empty, nil, constructRuntimeError(1, funcName, "*gct.Context", args[0])
+	}
+	exchangeName, ok := objects.ToString(args[1])
+	if !ok {
+		return nil, "", asset.Empty, nil, constructRuntimeError(2, funcName, "string", args[1])
+	}
+	assetTypeParam, ok := objects.ToString(args[2])
+	if !ok {
+		return nil, "", asset.Empty, nil, constructRuntimeError(3, funcName, "string", args[2])
+	}
+	assetType, err := asset.New(assetTypeParam)
+	if err != nil {
+		errResp, err := errorResponsef(standardFormatting, err)
+		return nil, "", asset.Empty, errResp, err
+	}
+	return scriptCtx, exchangeName, assetType, nil, nil
+}
+
+// parseMarginType parses a margin type argument
+func parseMarginType(funcName string, position int, arg objects.Object) (margin.Type, objects.Object, error) {
+	marginTypeParam, ok := objects.ToString(arg)
+	if !ok {
+		return margin.Unset, nil, constructRuntimeError(position, funcName, "string", arg)
+	}
+	marginType, err := margin.StringToMarginType(marginTypeParam)
+	if err != nil {
+		errResp, err := errorResponsef(standardFormatting, err)
+		return margin.Unset, errResp, err
+	}
+	return marginType, nil, nil
+}
+
+// parseOrderSide parses an order side argument
+func parseOrderSide(funcName string, position int, arg objects.Object) (order.Side, objects.Object, error) {
+	sideParam, ok := objects.ToString(arg)
+	if !ok {
+		return order.UnknownSide, nil, constructRuntimeError(position, funcName, "string", arg)
+	}
+	side, err := order.StringToOrderSide(sideParam)
+	if err != nil {
+		errResp, err := errorResponsef(standardFormatting, err)
+		return order.UnknownSide, errResp, err
+	}
+	return side, nil, nil
+}
+
+// positionToObject converts a futures position summary to a script object
+func positionToObject(exch string, pos *futures.PositionSummary) objects.Object {
+	data := make(map[string]objects.Object, 18)
+	data["exchange"] = &objects.String{Value: exch}
+	data["pair"] = &objects.String{Value: pos.Pair.String()}
+	data["asset"] = &objects.String{Value: pos.Asset.String()}
+	data["margintype"] = &objects.String{Value: pos.MarginType.String()}
+	data["collateralmode"] = &objects.String{Value: pos.CollateralMode.String()}
+	data["currency"] = &objects.String{Value: pos.Currency.String()}
+	data["size"] = decimalToObject(pos.CurrentSize)
+	data["notionalsize"] = decimalToObject(pos.NotionalSize)
+	data["leverage"] = decimalToObject(pos.Leverage)
+	data["openprice"] = decimalToObject(pos.AverageOpenPrice)
+	data["markprice"] = decimalToObject(pos.MarkPrice)
+	data["liquidationprice"] = decimalToObject(pos.EstimatedLiquidationPrice)
+	data["unrealisedpnl"] = decimalToObject(pos.UnrealisedPNL)
+	data["realisedpnl"] = decimalToObject(pos.RealisedPNL)
+	data["initialmargin"] = decimalToObject(pos.InitialMarginRequirement)
+	data["maintenancemargin"] = decimalToObject(pos.MaintenanceMarginRequirement)
+	data["collateralused"] = decimalToObject(pos.CollateralUsed)
+	data["freecollateral"] = decimalToObject(pos.FreeCollateral)
+	return &objects.Map{Value: data}
+}
+
+// decimalToObject converts a decimal to a script float
+func decimalToObject(d decimal.Decimal) objects.Object {
+	return &objects.Float{Value: d.InexactFloat64()}
+}
@@ -0,0 +1,156 @@
+package gct
+
+import (
+	"testing"
+
+	objects "github.com/d5/tengo/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+var (
+	perpAsset  = &objects.String{Value: asset.PerpetualSwap.String()}
+	marginType = &objects.String{Value: "isolated"}
+)
+
+func TestExchangeFuturesPosition(t *testing.T) {
+	t.Parallel()
+	_, err := ExchangeFuturesPosition(ctx, exch)
+	assert.ErrorIs(t, err, objects.ErrWrongNumArguments)
+
+	_, err = ExchangeFuturesPosition(tv, exch, currencyPair, delimiter, perpAsset)
+	assert.Error(t, err, "ExchangeFuturesPosition should error on an invalid script context")
+
+	resp, err := ExchangeFuturesPosition(ctx, exch, currencyPair, delimiter, blank)
+	require.NoError(t, err)
+	assert.IsType(t, &objects.Error{}, resp, "ExchangeFuturesPosition should return an error object on an invalid asset")
+
+	resp, err = ExchangeFuturesPosition(ctx, validatorError, currencyPair, delimiter, perpAsset)
+	require.NoError(t, err)
+	assert.IsType(t, &objects.Error{}, resp)
+
+	resp, err = ExchangeFuturesPosition(ctx, exch, currencyPair, delimiter, perpAsset)
+	require.NoError(t, err)
+	m, ok := resp.(*objects.Map)
+	require.True(t, ok, "ExchangeFuturesPosition must return a map")
+	assert.Equal(t, &objects.Float{Value: 1}, m.Value["size"])
+	assert.Equal(t, &objects.String{Value: "isolated"}, m.Value["margintype"])
+}
+
+func TestExchangeFundingRate(t *testing.T) {
+	t.Parallel()
+	_, err := ExchangeFundingRate(ctx, exch)
+	assert.ErrorIs(t, err, objects.ErrWrongNumArguments)
+
+	resp, err := ExchangeFundingRate(ctx, validatorError, currencyPair, delimiter, perpAsset)
+	require.NoError(t, err)
+	assert.IsType(t, &objects.Error{}, resp)
+
+	resp, err = ExchangeFundingRate(ctx, exch, currencyPair, delimiter, perpAsset)
+	require.NoError(t, err)
+	m, ok := resp.(*objects.Map)
+	require.True(t, ok, "ExchangeFundingRate must return a map")
+	assert.Contains(t, m.Value, "rate")
+	assert.NotContains(t, m.Value, "predictedrate")
+
+	resp, err = ExchangeFundingRate(ctx, exch, currencyPair, delimiter, perpAsset, tv)
+	require.NoError(t, err)
+	m, ok = resp.(*objects.Map)
+	require.True(t, ok, "ExchangeFundingRate must return a map")
+	assert.Contains(t, m.Value, "predictedrate")
+}
+
+func TestExchangeOpenInterest(t *testing.T) {
+	t.Parallel()
+	_, err := ExchangeOpenInterest(ctx, exch)
+	assert.ErrorIs(t, err, objects.ErrWrongNumArguments)
+
+	resp, err := ExchangeOpenInterest(ctx, validatorError, currencyPair, delimiter, perpAsset)
+	require.NoError(t, err)
+	assert.IsType(t, &objects.Error{}, resp)
+
+	resp, err = ExchangeOpenInterest(ctx, exch, currencyPair, delimiter, perpAsset)
+	require.NoError(t, err)
+	assert.IsType(t, &objects.Float{}, resp)
+}
+
+func TestExchangeCollateralMode(t *testing.T) {
+	t.Parallel()
+	_, err := ExchangeCollateralMode(ctx, exch)
+	assert.ErrorIs(t, err, objects.ErrWrongNumArguments)
+
+	_, err = ExchangeCollateralMode(tv, exch, perpAsset)
+	assert.Error(t, err, "ExchangeCollateralMode should error on an invalid script context")
+
+	resp, err := ExchangeCollateralMode(ctx, validatorError, perpAsset)
+	require.NoError(t, err)
+	assert.IsType(t, &objects.Error{}, resp)
+
+	resp, err = ExchangeCollateralMode(ctx, exch, perpAsset)
+	require.NoError(t, err)
+	assert.Equal(t, &objects.String{Value: "single"}, resp)
+}
+
+func TestExchangeSetCollateralMode(t *testing.T) {
+	t.Parallel()
+	_, err := ExchangeSetCollateralMode(ctx, exch, perpAsset)
+	assert.ErrorIs(t, err, objects.ErrWrongNumArguments)
+
+	resp, err := ExchangeSetCollateralMode(ctx, exch, perpAsset, &objects.String{Value: "bruh"})
+	require.NoError(t, err)
+	assert.IsType(t, &objects.Error{}, resp, "ExchangeSetCollateralMode should return an error object on an invalid mode")
+
+	resp, err = ExchangeSetCollateralMode(ctx, exch, perpAsset, &objects.String{Value: "multi"})
+	require.NoError(t, err)
+	assert.Equal(t, objects.TrueValue, resp)
+}
+
+func TestExchangeLeverage(t *testing.T) {
+	t.Parallel()
+	_, err := ExchangeLeverage(ctx, exch, currencyPair, delimiter, perpAsset)
+	assert.ErrorIs(t, err, objects.ErrWrongNumArguments)
+
+	resp, err := ExchangeLeverage(ctx, exch, currencyPair, delimiter, perpAsset, &objects.String{Value: "bruh"})
+	require.NoError(t, err)
+	assert.IsType(t, &objects.Error{}, resp, "ExchangeLeverage should return an error object on an invalid margin type")
+
+	resp, err = ExchangeLeverage(ctx, exch, currencyPair, delimiter, perpAsset, marginType, &objects.String{Value: "bruh"})
+	require.NoError(t, err)
+	assert.IsType(t, &objects.Error{}, resp, "ExchangeLeverage should return an error object on an invalid order side")
+
+	resp, err = ExchangeLeverage(ctx, exch, currencyPair, delimiter, perpAsset, marginType, &objects.String{Value: "long"})
+	require.NoError(t, err)
+	assert.IsType(t, &objects.Float{}, resp)
+}
+
+func TestExchangeSetLeverage(t *testing.T) {
+	t.Parallel()
+	_, err := ExchangeSetLeverage(ctx, exch, currencyPair, delimiter, perpAsset, marginType)
+	assert.ErrorIs(t, err, objects.ErrWrongNumArguments)
+
+	_, err = ExchangeSetLeverage(ctx, exch, currencyPair, delimiter, perpAsset, marginType, tv)
+	assert.Error(t, err, "ExchangeSetLeverage should error on an invalid leverage")
+
+	resp, err := ExchangeSetLeverage(ctx, exch, currencyPair, delimiter, perpAsset, marginType, &objects.Float{Value: 0})
+	require.NoError(t, err)
+	assert.IsType(t, &objects.Error{}, resp)
+
+	resp, err = ExchangeSetLeverage(ctx, exch, currencyPair, delimiter, perpAsset, marginType, &objects.Float{Value: 5})
+	require.NoError(t, err)
+	assert.Equal(t, objects.TrueValue, resp)
+}
+
+func TestExchangeSetMarginType(t *testing.T) {
+	t.Parallel()
+	_, err := ExchangeSetMarginType(ctx, exch, currencyPair, delimiter, perpAsset)
+	assert.ErrorIs(t, err, objects.ErrWrongNumArguments)
+
+	resp, err := ExchangeSetMarginType(ctx, exch, currencyPair, delimiter, perpAsset, &objects.String{Value: "bruh"})
+	require.NoError(t, err)
+	assert.IsType(t, &objects.Error{}, resp)
+
+	resp, err = ExchangeSetMarginType(ctx, exch, currencyPair, delimiter, perpAsset, marginType)
+	require.NoError(t, err)
+	assert.Equal(t, objects.TrueValue, resp)
+}
@@ -39,6 +39,10 @@ var (
 	blank = &objects.String{
 		Value: "",
 	}
+	// validatorError is the exchange name the validator wrapper returns errors for
+	validatorError = &objects.String{
+		Value: `""`,
+	}
 
 	tv = objects.TrueValue
 	fv = objects.FalseValue
@@ -58,6 +62,108 @@ func TestExchangeOrderbook(t *testing.T) {
 	assert.ErrorIs(t, err, objects.ErrWrongNumArguments)
 }
 
+func TestExchangeAggregatedOrderbook(t *testing.T) {
+	t.Parallel()
+	_, err := ExchangeAggregatedOrderbook()
+	assert.ErrorIs(t, err, objects.ErrWrongNumArguments)
+
+	_, err = ExchangeAggregatedOrderbook(tv, exch, currencyPair, delimiter, assetType)
+	assert.Error(t, err, "ExchangeAggregatedOrderbook should error on an invalid script context")
+
+	_, err = ExchangeAggregatedOrderbook(ctx, &objects.Array{Value: []objects.Object{objects.UndefinedValue}}, currencyPair, delimiter, assetType)
+	assert.Error(t, err, "ExchangeAggregatedOrderbook should error on invalid sources")
+
+	resp, err := ExchangeAggregatedOrderbook(ctx, exch, currencyPair, delimiter, blank)
+	require.NoError(t, err)
+	assert.IsType(t, &objects.Error{}, resp, "ExchangeAggregatedOrderbook should return an error object on an invalid asset")
+
+	resp, err = ExchangeAggregatedOrderbook(ctx, &objects.String{Value: "binance:bad"}, currencyPair, delimiter, assetType)
+	require.NoError(t, err)
+	assert.IsType(t, &objects.Error{}, resp, "ExchangeAggregatedOrderbook should return an error object on an invalid source pair")
+
+	resp, err = ExchangeAggregatedOrderbook(ctx, validatorError, currencyPair, delimiter, assetType)
+	require.NoError(t, err)
+	assert.IsType(t, &objects.Error{}, resp)
+
+	sources := &objects.Array{Value: []objects.Object{exch, &objects.String{Value: "binance:BTC-USDT"}}}
+	resp, err = ExchangeAggregatedOrderbook(ctx, sources, currencyPair, delimiter, assetType, tv)
+	require.NoError(t, err)
+	m, ok := resp.(*objects.Map)
+	require.True(t, ok, "ExchangeAggregatedOrderbook must return a map")
+	bids, ok := m.Value["bids"].(*objects.Array)
+	require.True(t, ok, "bids must be an array")
+	require.Len(t, bids.Value, 1)
+	level, ok := bids.Value[0].(*objects.Map)
+	require.True(t, ok, "level must be a map")
+	levelSources, ok := level.Value["sources"].(*objects.Array)
+	require.True(t, ok, "level sources must be an array")
+	require.Len(t, levelSources.Value, 2)
+	src, ok := levelSources.Value[1].(*objects.Map)
+	require.True(t, ok, "level source must be a map")
+	assert.Equal(t, &objects.String{Value: "binance"}, src.Value["exchange"])
+	assert.Equal(t, &objects.String{Value: "BTC-USDT"}, src.Value["pair"])
+}
+
+func TestExchangeOrderbookAnalytics(t *testing.T) {
+	t.Parallel()
+	_, err := ExchangeOrderbookAnalytics()
+	assert.ErrorIs(t, err, objects.ErrWrongNumArguments)
+
+	_, err = ExchangeOrderbookAnalytics(tv, exch, currencyPair, delimiter, assetType)
+	assert.Error(t, err, "ExchangeOrderbookAnalytics should error on an invalid script context")
+
+	_, err = ExchangeOrderbookAnalytics(ctx, exch, currencyPair, delimiter, assetType, exch)
+	assert.Error(t, err, "ExchangeOrderbookAnalytics should error on invalid bands")
+
+	bands := &objects.Array{Value: []objects.Object{&objects.Int{Value: 10}, &objects.Float{Value: 2.5}}}
+	_, err = ExchangeOrderbookAnalytics(ctx, exch, currencyPair, delimiter, assetType, bands, exch)
+	assert.Error(t, err, "ExchangeOrderbookAnalytics should error on invalid levels")
+
+	resp, err := ExchangeOrderbookAnalytics(ctx, exch, currencyPair, delimiter, assetType, bands, &objects.Int{Value: 3}, exch)
+	require.NoError(t, err)
+	assert.IsType(t, &objects.Error{}, resp, "ExchangeOrderbookAnalytics should return an error object on an invalid window")
+
+	resp, err = ExchangeOrderbookAnalytics(ctx, exch, currencyPair, delimiter, blank)
+	require.NoError(t, err)
+	assert.IsType(t, &objects.Error{}, resp, "ExchangeOrderbookAnalytics should return an error object on an invalid asset")
+
+	resp, err = ExchangeOrderbookAnalytics(ctx, validatorError, currencyPair, delimiter, assetType)
+	require.NoError(t, err)
+	assert.IsType(t, &objects.Error{}, resp)
+
+	resp, err = ExchangeOrderbookAnalytics(ctx, exch, currencyPair, delimiter, assetType, bands, &objects.Int{Value: 3}, &objects.String{Value: "5m"})
+	require.NoError(t, err)
+	m, ok := resp.(*objects.Map)
+	require.True(t, ok, "ExchangeOrderbookAnalytics must return a map")
+	assert.Equal(t, &objects.Float{Value: 1.5}, m.Value["microprice"])
+	respBands, ok := m.Value["bands"].(*objects.Array)
+	require.True(t, ok, "bands must be an array")
+	require.Len(t, respBands.Value, 2)
+	band, ok := respBands.Value[1].(*objects.Map)
+	require.True(t, ok, "band must be a map")
+	assert.Equal(t, &objects.Float{Value: 2.5}, band.Value["bps"])
+	flow, ok := m.Value["bids"].(*objects.Map)
+	require.True(t, ok, "bids must be a map")
+	assert.Contains(t, flow.Value, "cancelledpersecond")
+}
+
+func TestExchangeUntrackOrderbookAnalytics(t *testing.T) {
+	t.Parallel()
+	_, err := ExchangeUntrackOrderbookAnalytics()
+	assert.ErrorIs(t, err, objects.ErrWrongNumArguments)
+
+	_, err = ExchangeUntrackOrderbookAnalytics(tv, exch, currencyPair, delimiter, assetType)
+	assert.Error(t, err, "ExchangeUntrackOrderbookAnalytics should error on an invalid script context")
+
+	resp, err := ExchangeUntrackOrderbookAnalytics(ctx, validatorError, currencyPair, delimiter, assetType)
+	require.NoError(t, err)
+	assert.IsType(t, &objects.Error{}, resp)
+
+	resp, err = ExchangeUntrackOrderbookAnalytics(ctx, exch, currencyPair, delimiter, assetType)
+	require.NoError(t, err)
+	assert.Equal(t, objects.TrueValue, resp)
+}
+
 func TestExchangeTicker(t *testing.T) {
 	t.Parallel()
 	_, err := ExchangeTicker(ctx, exch, currencyPair, delimiter, assetType)
@@ -183,6 +289,78 @@ func TestExchangeOrderSubmit(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestExchangeOrderModify(t *testing.T) {
+	t.Parallel()
+	_, err := ExchangeOrderModify()
+	assert.ErrorIs(t, err, objects.ErrWrongNumArguments)
+
+	price := &objects.Float{Value: 1}
+	amount := &objects.Float{Value: 2}
+
+	_, err = ExchangeOrderModify(ctx, exch, blank, currencyPair, delimiter, assetType, price, amount)
+	assert.Error(t, err, "ExchangeOrderModify should error on an empty order ID")
+
+	_, err = ExchangeOrderModify(ctx, exch, orderID, currencyPair, delimiter, assetType, tv, amount)
+	assert.Error(t, err, "ExchangeOrderModify should error on an invalid price")
+
+	resp, err := ExchangeOrderModify(ctx, validatorError, orderID, currencyPair, delimiter, assetType, price, amount)
+	require.NoError(t, err)
+	assert.IsType(t, &objects.Error{}, resp)
+
+	resp, err = ExchangeOrderModify(ctx, exch, orderID, currencyPair, delimiter, assetType, price, amount)
+	require.NoError(t, err)
+	m, ok := resp.(*objects.Map)
+	require.True(t, ok, "ExchangeOrderModify must return a map")
+	assert.Equal(t, &objects.Float{Value: 1}, m.Value["price"])
+}
+
+func TestExchangeActiveOrders(t *testing.T) {
+	t.Parallel()
+	_, err := ExchangeActiveOrders(ctx, exch)
+	assert.ErrorIs(t, err, objects.ErrWrongNumArguments)
+
+	_, err = ExchangeActiveOrders(tv, exch, assetType)
+	assert.Error(t, err, "ExchangeActiveOrders should error on an invalid script context")
+
+	resp, err := ExchangeActiveOrders(ctx, exch, blank)
+	require.NoError(t, err)
+	assert.IsType(t, &objects.Error{}, resp, "ExchangeActiveOrders should return an error object on an invalid asset")
+
+	resp, err = ExchangeActiveOrders(ctx, exch, assetType)
+	require.NoError(t, err)
+	a, ok := resp.(*objects.Array)
+	require.True(t, ok, "ExchangeActiveOrders must return an array")
+	assert.Len(t, a.Value, 1)
+
+	resp, err = ExchangeActiveOrders(ctx, exch, assetType, currencyPair, delimiter)
+	require.NoError(t, err)
+	assert.IsType(t, &objects.Array{}, resp)
+}
+
+func TestExchangeOrderHistory(t *testing.T) {
+	t.Parallel()
+	_, err := ExchangeOrderHistory(ctx, exch, assetType, currencyPair)
+	assert.ErrorIs(t, err, objects.ErrWrongNumArguments)
+
+	start := &objects.Time{Value: time.Now().Add(-time.Hour)}
+	end := &objects.Time{Value: time.Now()}
+
+	_, err = ExchangeOrderHistory(ctx, exch, assetType, currencyPair, delimiter, tv, end)
+	assert.Error(t, err, "ExchangeOrderHistory should error on an invalid start time")
+
+	resp, err := ExchangeOrderHistory(ctx, exch, assetType, currencyPair, delimiter, end, start)
+	require.NoError(t, err)
+	assert.IsType(t, &objects.Error{}, resp, "ExchangeOrderHistory should return an error object when start is after end")
+
+	resp, err = ExchangeOrderHistory(ctx, validatorError, assetType)
+	require.NoError(t, err)
+	assert.IsType(t, &objects.Error{}, resp)
+
+	resp, err = ExchangeOrderHistory(ctx, exch, assetType, currencyPair, delimiter, start, end)
+	require.NoError(t, err)
+	assert.IsType(t, &objects.Array{}, resp)
+}
+
 func TestAllModuleNames(t *testing.T) {
 	t.Parallel()
 	require.NotEmpty(t, AllModuleNames(), "AllModuleNames must not return an empty slice")
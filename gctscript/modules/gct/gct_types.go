@@ -1,7 +1,11 @@
 package gct
 
 import (
+	"sync"
+
 	objects "github.com/d5/tengo/v2"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 )
 
 const (
@@ -18,9 +22,33 @@ var Modules = map[string]map[string]objects.Object{
 	"exchange": exchangeModule,
 	"common":   commonModule,
 	"global":   globalModules,
+	"event":    eventModule,
+	"store":    storeModule,
 }
 
 // Context defines a juncture for script context to go context awareness
 type Context struct {
 	objects.Map
+	// Name is the script file name used to key persistent script state
+	Name     string
+	m        sync.Mutex
+	handlers []EventHandler
+}
+
+// EventHandler holds a script function registered to receive engine updates
+type EventHandler struct {
+	Event    string
+	Exchange string
+	// Pair and Asset are empty for events which are not specific to a market
+	Pair     currency.Pair
+	Asset    asset.Item
+	Function objects.Object
+}
+
+// EventUpdate holds an engine update converted for script event handlers
+type EventUpdate struct {
+	Exchange string
+	Pair     currency.Pair
+	Asset    asset.Item
+	Data     objects.Object
 }
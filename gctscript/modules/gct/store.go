@@ -0,0 +1,251 @@
+package gct
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+
+	objects "github.com/d5/tengo/v2"
+	"github.com/thrasher-corp/gocryptotrader/common/file"
+	"github.com/thrasher-corp/gocryptotrader/encoding/json"
+)
+
+const (
+	storeGetFunc    = "get"
+	storeSetFunc    = "set"
+	storeDeleteFunc = "delete"
+	storeKeysFunc   = "keys"
+)
+
+var storeModule = map[string]objects.Object{
+	storeGetFunc:    &objects.UserFunction{Name: storeGetFunc, Value: StoreGet},
+	storeSetFunc:    &objects.UserFunction{Name: storeSetFunc, Value: StoreSet},
+	storeDeleteFunc: &objects.UserFunction{Name: storeDeleteFunc, Value: StoreDelete},
+	storeKeysFunc:   &objects.UserFunction{Name: storeKeysFunc, Value: StoreKeys},
+}
+
+// StateDir is the directory scripts persist their key/value store to
+var StateDir string
+
+var stores = struct {
+	m sync.Mutex
+	s map[string]*scriptStore
+}{s: make(map[string]*scriptStore)}
+
+// scriptStore holds a script's key/value store. Values are held JSON encoded
+// so they are returned the same way before and after a restart
+type scriptStore struct {
+	m      sync.Mutex
+	path   string
+	values map[string]json.RawMessage
+}
+
+// StoreGet returns the value stored for a key, or undefined if the key is not
+// set
+// Params: scriptCTX, key
+func StoreGet(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 2 {
+		return nil, objects.ErrWrongNumArguments
+	}
+	name, key, err := storeArgs(storeGetFunc, args...)
+	if err != nil {
+		return nil, err
+	}
+	s, err := getScriptStore(name)
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+	value, err := s.get(key)
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+	if value == nil {
+		return objects.UndefinedValue, nil
+	}
+	return objects.FromInterface(value)
+}
+
+// StoreSet stores a value for a key and persists the script's store
+// Params: scriptCTX, key, value
+func StoreSet(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 3 {
+		return nil, objects.ErrWrongNumArguments
+	}
+	name, key, err := storeArgs(storeSetFunc, args[:2]...)
+	if err != nil {
+		return nil, err
+	}
+	s, err := getScriptStore(name)
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+	if err := s.set(key, objects.ToInterface(args[2])); err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+	return objects.TrueValue, nil
+}
+
+// StoreDelete removes a key and persists the script's store
+// Params: scriptCTX, key
+func StoreDelete(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 2 {
+		return nil, objects.ErrWrongNumArguments
+	}
+	name, key, err := storeArgs(storeDeleteFunc, args...)
+	if err != nil {
+		return nil, err
+	}
+	s, err := getScriptStore(name)
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+	if err := s.delete(key); err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+	return objects.TrueValue, nil
+}
+
+// StoreKeys returns all keys in the script's store in alphabetical order
+// Params: scriptCTX
+func StoreKeys(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 1 {
+		return nil, objects.ErrWrongNumArguments
+	}
+	scriptCtx, ok := objects.ToInterface(args[0]).(*Context)
+	if !ok {
+		return nil, constructRuntimeError(1, storeKeysFunc, "*gct.Context", args[0])
+	}
+	s, err := getScriptStore(scriptCtx.Name)
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+	keys := s.keys()
+	r := objects.Array{Value: make([]objects.Object, len(keys))}
+	for i := range keys {
+		r.Value[i] = &objects.String{Value: keys[i]}
+	}
+	return &r, nil
+}
+
+// storeArgs returns the script name and key from the script context and key
+// arguments
+func storeArgs(funcName string, args ...objects.Object) (name, key string, err error) {
+	scriptCtx, ok := objects.ToInterface(args[0]).(*Context)
+	if !ok {
+		return "", "", constructRuntimeError(1, funcName, "*gct.Context", args[0])
+	}
+	key, ok = objects.ToString(args[1])
+	if !ok {
+		return "", "", constructRuntimeError(2, funcName, "string", args[1])
+	}
+	return scriptCtx.Name, key, nil
+}
+
+// getScriptStore returns the store for a script, loading it from disk the
+// first time it is used
+func getScriptStore(name string) (*scriptStore, error) {
+	if name == "" {
+		return nil, errScriptNameNotSet
+	}
+	name = strings.TrimSuffix(name, filepath.Ext(name))
+	stores.m.Lock()
+	defer stores.m.Unlock()
+	if s, ok := stores.s[name]; ok {
+		return s, nil
+	}
+	s := &scriptStore{
+		path:   filepath.Join(StateDir, name+".json"),
+		values: make(map[string]json.RawMessage),
+	}
+	data, err := os.ReadFile(s.path)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+	case err != nil:
+		return nil, err
+	default:
+		if err := json.Unmarshal(data, &s.values); err != nil {
+			return nil, fmt.Errorf("unable to load script state %s: %w", s.path, err)
+		}
+	}
+	stores.s[name] = s
+	return s, nil
+}
+
+func (s *scriptStore) get(key string) (any, error) {
+	if key == "" {
+		return nil, errStoreKeyEmpty
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+	data, ok := s.values[key]
+	if !ok {
+		return nil, nil
+	}
+	var value any
+	return value, json.Unmarshal(data, &value)
+}
+
+func (s *scriptStore) set(key string, value any) error {
+	if key == "" {
+		return errStoreKeyEmpty
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+	prev, existed := s.values[key]
+	s.values[key] = data
+	if err := s.save(); err != nil {
+		if existed {
+			s.values[key] = prev
+		} else {
+			delete(s.values, key)
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *scriptStore) delete(key string) error {
+	if key == "" {
+		return errStoreKeyEmpty
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+	prev, ok := s.values[key]
+	if !ok {
+		return nil
+	}
+	delete(s.values, key)
+	if err := s.save(); err != nil {
+		s.values[key] = prev
+		return err
+	}
+	return nil
+}
+
+func (s *scriptStore) keys() []string {
+	s.m.Lock()
+	defer s.m.Unlock()
+	keys := make([]string, 0, len(s.values))
+	for k := range s.values {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// save writes the store to disk. The store must be locked
+func (s *scriptStore) save() error {
+	data, err := json.Marshal(s.values)
+	if err != nil {
+		return err
+	}
+	return file.Write(s.path, data)
+}
@@ -0,0 +1,70 @@
+package gct
+
+import (
+	"testing"
+
+	objects "github.com/d5/tengo/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStore(t *testing.T) {
+	StateDir = t.TempDir()
+	c := &Context{Name: "store_test.gct"}
+	key := &objects.String{Value: "key"}
+
+	_, err := StoreGet(c)
+	assert.ErrorIs(t, err, objects.ErrWrongNumArguments)
+	_, err = StoreSet(c, key)
+	assert.ErrorIs(t, err, objects.ErrWrongNumArguments)
+	_, err = StoreDelete(c)
+	assert.ErrorIs(t, err, objects.ErrWrongNumArguments)
+	_, err = StoreKeys()
+	assert.ErrorIs(t, err, objects.ErrWrongNumArguments)
+
+	_, err = StoreGet(tv, key)
+	assert.Error(t, err, "Should error on an invalid script context")
+	_, err = StoreSet(c, objects.UndefinedValue, tv)
+	assert.Error(t, err, "Should error on an invalid key")
+
+	resp, err := StoreGet(&Context{}, key)
+	require.NoError(t, err)
+	assert.IsType(t, &objects.Error{}, resp, "Should return an error object when the script name is not set")
+
+	resp, err = StoreSet(c, blank, tv)
+	require.NoError(t, err)
+	assert.IsType(t, &objects.Error{}, resp, "Should return an error object on an empty key")
+
+	resp, err = StoreGet(c, key)
+	require.NoError(t, err)
+	assert.Equal(t, objects.UndefinedValue, resp)
+
+	value := &objects.Map{Value: map[string]objects.Object{"price": &objects.Float{Value: 1337}}}
+	resp, err = StoreSet(c, key, value)
+	require.NoError(t, err)
+	assert.Equal(t, objects.TrueValue, resp)
+	_, err = StoreSet(c, &objects.String{Value: "another"}, tv)
+	require.NoError(t, err)
+
+	// Drop the cached store so values are loaded from disk
+	stores.m.Lock()
+	clear(stores.s)
+	stores.m.Unlock()
+
+	resp, err = StoreGet(c, key)
+	require.NoError(t, err)
+	m, ok := resp.(*objects.Map)
+	require.True(t, ok, "Should return a map")
+	assert.Equal(t, &objects.Float{Value: 1337}, m.Value["price"])
+
+	resp, err = StoreKeys(c)
+	require.NoError(t, err)
+	assert.Equal(t, &objects.Array{Value: []objects.Object{&objects.String{Value: "another"}, &objects.String{Value: "key"}}}, resp)
+
+	resp, err = StoreDelete(c, key)
+	require.NoError(t, err)
+	assert.Equal(t, objects.TrueValue, resp)
+	resp, err = StoreGet(c, key)
+	require.NoError(t, err)
+	assert.Equal(t, objects.UndefinedValue, resp)
+}
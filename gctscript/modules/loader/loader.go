@@ -42,3 +42,8 @@ func GetModuleMap() *tengo.ModuleMap {
 func SetDefaultScriptOutput(path string) {
 	gct.OutputDir = path
 }
+
+// SetDefaultScriptState sets the folder scripts persist their state to
+func SetDefaultScriptState(path string) {
+	gct.StateDir = path
+}
@@ -7,10 +7,15 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchange/accounts"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/collateral"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/deposit"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fundingrate"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/margin"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook/analytics"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
 )
@@ -22,6 +27,15 @@ const (
 	ErrParameterWithPositionConvertFailed = "%v at position %v failed conversion"
 )
 
+// Event types script handlers can be registered for
+const (
+	EventTicker        = "ticker"
+	EventOrderbook     = "orderbook"
+	EventTrade         = "trade"
+	EventOrderUpdate   = "order_update"
+	EventBalanceChange = "balance_change"
+)
+
 // Wrapper instance of GCT to use for modules
 var Wrapper GCTExchange
 
@@ -30,16 +44,37 @@ type GCTExchange interface {
 	Exchanges(enabledOnly bool) []string
 	IsEnabled(exch string) bool
 	Orderbook(ctx context.Context, exch string, pair currency.Pair, item asset.Item) (*orderbook.Book, error)
+	AggregatedOrderbook(ctx context.Context, cfg *orderbook.AggregatorConfig) (*orderbook.AggregatedBook, error)
+	OrderbookAnalytics(ctx context.Context, exch string, pair currency.Pair, item asset.Item, cfg *analytics.Config) (*analytics.Metrics, error)
+	UntrackOrderbookAnalytics(exch string, pair currency.Pair, item asset.Item) error
 	Ticker(ctx context.Context, exch string, pair currency.Pair, item asset.Item) (*ticker.Price, error)
 	Pairs(exch string, enabledOnly bool, item asset.Item) (*currency.Pairs, error)
 	QueryOrder(ctx context.Context, exch, orderid string, pair currency.Pair, assetType asset.Item) (*order.Detail, error)
 	SubmitOrder(ctx context.Context, submit *order.Submit) (*order.SubmitResponse, error)
 	CancelOrder(ctx context.Context, exch, orderid string, pair currency.Pair, item asset.Item) (bool, error)
+	ModifyOrder(ctx context.Context, mod *order.Modify) (*order.ModifyResponse, error)
+	ActiveOrders(ctx context.Context, exch string, req *order.MultiOrderRequest) (order.FilteredOrders, error)
+	OrderHistory(ctx context.Context, exch string, req *order.MultiOrderRequest) (order.FilteredOrders, error)
 	AccountBalances(ctx context.Context, exch string, assetType asset.Item) (accounts.SubAccounts, error)
 	DepositAddress(exch, chain string, currencyCode currency.Code) (*deposit.Address, error)
 	WithdrawalFiatFunds(ctx context.Context, bankAccountID string, request *withdraw.Request) (out string, err error)
 	WithdrawalCryptoFunds(ctx context.Context, request *withdraw.Request) (out string, err error)
 	OHLCV(ctx context.Context, exch string, pair currency.Pair, item asset.Item, start, end time.Time, interval kline.Interval) (*kline.Item, error)
+	SubscribeEvents(exch, event string) (EventFeed, error)
+	FuturesPosition(ctx context.Context, exch string, pair currency.Pair, item asset.Item) (*futures.PositionSummary, error)
+	LatestFundingRate(ctx context.Context, exch string, pair currency.Pair, item asset.Item, includePredicted bool) (*fundingrate.LatestRateResponse, error)
+	OpenInterest(ctx context.Context, exch string, pair currency.Pair, item asset.Item) (float64, error)
+	CollateralMode(ctx context.Context, exch string, item asset.Item) (collateral.Mode, error)
+	SetCollateralMode(ctx context.Context, exch string, item asset.Item, mode collateral.Mode) error
+	Leverage(ctx context.Context, exch string, pair currency.Pair, item asset.Item, marginType margin.Type, side order.Side) (float64, error)
+	SetLeverage(ctx context.Context, exch string, pair currency.Pair, item asset.Item, marginType margin.Type, side order.Side, leverage float64) error
+	SetMarginType(ctx context.Context, exch string, pair currency.Pair, item asset.Item, marginType margin.Type) error
+}
+
+// EventFeed defines a subscription to engine updates for script event handlers
+type EventFeed interface {
+	Channel() <-chan any
+	Release() error
 }
 
 // SetModuleWrapper link the wrapper and interface to use for modules
@@ -63,6 +63,12 @@ func SetDefaultScriptOutput() {
 	loader.SetDefaultScriptOutput(filepath.Join(ScriptPath, "output"))
 }
 
+// SetDefaultScriptState sets the default directory scripts persist their
+// key/value store to
+func SetDefaultScriptState() {
+	loader.SetDefaultScriptState(filepath.Join(ScriptPath, "state"))
+}
+
 // Load parses and creates a new instance of tengo script vm
 func (vm *VM) Load(file string) error {
 	if vm == nil {
@@ -84,9 +90,9 @@ func (vm *VM) Load(file string) error {
 
 	vm.File = file
 	vm.Path = filepath.Dir(file)
-	vm.Script = tengo.NewScript(code)
+	vm.Script = tengo.NewScript(append([]byte(eventPrelude), code...))
 
-	scriptCtx := &gct.Context{}
+	scriptCtx := &gct.Context{Name: vm.ShortName()}
 	scriptCtx.Value = map[string]tengo.Object{
 		"script": &tengo.String{Value: vm.ShortName() + "-" + vm.ID.String()},
 	}
@@ -95,6 +101,11 @@ func (vm *VM) Load(file string) error {
 	if err != nil {
 		return err
 	}
+	vm.ctx = scriptCtx
+
+	if err = vm.addEventVariables(); err != nil {
+		return err
+	}
 
 	vm.Script.SetImports(loader.GetModuleMap())
 	vm.Hash = vm.getHash()
@@ -117,6 +128,8 @@ func (vm *VM) Compile() (err error) {
 
 // RunCtx runs compiled byte code with context.Context support.
 func (vm *VM) RunCtx() (err error) {
+	vm.m.Lock()
+	defer vm.m.Unlock()
 	ctx, cancel := context.WithTimeout(context.Background(), vm.config.ScriptTimeout)
 	defer cancel()
 
@@ -172,6 +185,8 @@ func (vm *VM) CompileAndRun() {
 		}
 		if vm.T > 0 {
 			vm.runner()
+			// Timed scripts can register event handlers on any run
+			vm.subscribeEvents()
 			return
 		}
 
@@ -179,6 +194,11 @@ func (vm *VM) CompileAndRun() {
 			log.Errorln(log.GCTScriptMgr, "Repeat timer cannot be under 1 nano second")
 		}
 	}
+	if len(vm.ctx.EventHandlers()) > 0 {
+		vm.S = make(chan struct{}, 1)
+		vm.subscribeEvents()
+		return
+	}
 	err = vm.Shutdown()
 	if err != nil {
 		log.Errorln(log.GCTScriptMgr, err)
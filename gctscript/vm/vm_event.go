@@ -0,0 +1,181 @@
+package vm
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/d5/tengo/v2"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules/gct"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/wrappers"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+const (
+	eventHandlerVariable = "gct_event_handler"
+	eventDataVariable    = "gct_event"
+	eventDoneVariable    = "gct_event_done"
+	// eventPrelude is prepended to every script. When an event is being
+	// dispatched it calls the registered handler and halts the script before
+	// its body runs again. It is kept on one line so error positions reported
+	// for the rest of the script are unchanged
+	eventPrelude = "if !is_undefined(" + eventHandlerVariable + ") { " + eventHandlerVariable + "(" + eventDataVariable + "); " + eventDoneVariable + "() }; "
+	// eventResubscribeInterval is how often feeds which could not be
+	// subscribed to, or have been closed, are retried
+	eventResubscribeInterval = time.Second * 10
+)
+
+var (
+	errEventHandled  = errors.New("event handled")
+	errWrapperNotSet = errors.New("script wrapper not set")
+)
+
+// addEventVariables adds the variables used by the event prelude to the script
+func (vm *VM) addEventVariables() error {
+	if err := vm.Script.Add(eventHandlerVariable, nil); err != nil {
+		return err
+	}
+	if err := vm.Script.Add(eventDataVariable, nil); err != nil {
+		return err
+	}
+	return vm.Script.Add(eventDoneVariable, &tengo.UserFunction{
+		Name: eventDoneVariable,
+		Value: func(...tengo.Object) (tengo.Object, error) {
+			return nil, errEventHandled
+		},
+	})
+}
+
+// subscribeEvents subscribes to the feeds required by the script's event
+// handlers and keeps retrying any which are unavailable until the script is
+// shut down
+func (vm *VM) subscribeEvents() {
+	vm.resubscribeEvents(true)
+	shutdown := vm.S
+	go func() {
+		t := time.NewTicker(eventResubscribeInterval)
+		defer t.Stop()
+		for {
+			select {
+			case <-shutdown:
+				return
+			case <-t.C:
+				vm.resubscribeEvents(vm.config.Verbose)
+			}
+		}
+	}()
+}
+
+// resubscribeEvents subscribes to each exchange event feed required by the
+// script's event handlers which is not already subscribed
+func (vm *VM) resubscribeEvents(logErrors bool) {
+	handlers := vm.ctx.EventHandlers()
+	if len(handlers) == 0 {
+		return
+	}
+	w := wrappers.GetWrapper()
+	if w == nil {
+		if logErrors {
+			log.Errorf(log.GCTScriptMgr, "Script %s unable to subscribe to events: %v", vm.ShortName(), errWrapperNotSet)
+		}
+		return
+	}
+	vm.feedsMu.Lock()
+	defer vm.feedsMu.Unlock()
+	if vm.feeds == nil {
+		vm.feeds = make(map[string]modules.EventFeed)
+	}
+	for i := range handlers {
+		key := handlers[i].Event + ":" + strings.ToLower(handlers[i].Exchange)
+		if _, ok := vm.feeds[key]; ok {
+			continue
+		}
+		feed, err := w.SubscribeEvents(handlers[i].Exchange, handlers[i].Event)
+		if err != nil {
+			if logErrors {
+				log.Errorf(log.GCTScriptMgr, "Script %s unable to subscribe to %s %s events: %v", vm.ShortName(), handlers[i].Exchange, handlers[i].Event, err)
+			}
+			continue
+		}
+		vm.feeds[key] = feed
+		go vm.listenEventFeed(key, handlers[i].Event, handlers[i].Exchange, feed, vm.S)
+	}
+}
+
+// listenEventFeed dispatches each update received from an event feed to the
+// script's matching event handlers until the script is shut down
+func (vm *VM) listenEventFeed(key, event, exch string, feed modules.EventFeed, shutdown <-chan struct{}) {
+	defer func() {
+		if err := feed.Release(); err != nil && vm.config.Verbose {
+			log.Debugf(log.GCTScriptMgr, "Script %s %s %s event feed release: %v", vm.ShortName(), exch, event, err)
+		}
+		vm.feedsMu.Lock()
+		delete(vm.feeds, key)
+		vm.feedsMu.Unlock()
+	}()
+	for {
+		select {
+		case <-shutdown:
+			return
+		case data, ok := <-feed.Channel():
+			if !ok {
+				return
+			}
+			vm.handleEvent(event, exch, data)
+		}
+	}
+}
+
+// handleEvent runs every event handler which matches an update
+func (vm *VM) handleEvent(event, exch string, data any) {
+	updates, err := gct.NewEventUpdates(event, exch, data)
+	if err != nil {
+		log.Errorf(log.GCTScriptMgr, "Script %s %s %s event error: %v", vm.ShortName(), exch, event, err)
+		return
+	}
+	handlers := vm.ctx.EventHandlers()
+	for i := range updates {
+		for j := range handlers {
+			if handlers[j].Event != event || !handlers[j].Matches(&updates[i]) {
+				continue
+			}
+			if err := vm.runEventHandler(handlers[j].Function, updates[i].Data); err != nil {
+				log.Errorln(log.GCTScriptMgr, err)
+			}
+		}
+	}
+}
+
+// runEventHandler runs the compiled script with the event prelude set to call
+// the handler with the update data. The script's globals are shared with
+// every other run so handlers can keep state between events
+func (vm *VM) runEventHandler(fn, data tengo.Object) error {
+	vm.m.Lock()
+	defer vm.m.Unlock()
+	if vm.Compiled == nil {
+		return ErrNoVMLoaded
+	}
+	if err := vm.Compiled.Set(eventHandlerVariable, fn); err != nil {
+		return err
+	}
+	if err := vm.Compiled.Set(eventDataVariable, data); err != nil {
+		return err
+	}
+	defer func() {
+		if err := vm.Compiled.Set(eventHandlerVariable, nil); err != nil {
+			log.Errorln(log.GCTScriptMgr, err)
+		}
+		if err := vm.Compiled.Set(eventDataVariable, nil); err != nil {
+			log.Errorln(log.GCTScriptMgr, err)
+		}
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), vm.config.ScriptTimeout)
+	defer cancel()
+	if err := vm.Compiled.RunContext(ctx); err != nil && !errors.Is(err, errEventHandled) {
+		return Error{Script: vm.ShortName(), Action: "RunEventHandler", Cause: err}
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+package vm
+
+import (
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/d5/tengo/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules/gct"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules/loader"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/wrappers/validator"
+)
+
+var testEventScript = filepath.Join("..", "..", "testdata", "gctscript", "event.gct")
+
+type testEventFeed struct {
+	c        chan any
+	released chan struct{}
+	once     sync.Once
+}
+
+func (f *testEventFeed) Channel() <-chan any { return f.c }
+
+func (f *testEventFeed) Release() error {
+	f.once.Do(func() { close(f.released) })
+	return nil
+}
+
+type testEventWrapper struct {
+	validator.Wrapper
+	m     sync.Mutex
+	feeds map[string]*testEventFeed
+}
+
+func (w *testEventWrapper) SubscribeEvents(exch, event string) (modules.EventFeed, error) {
+	w.m.Lock()
+	defer w.m.Unlock()
+	f := &testEventFeed{c: make(chan any), released: make(chan struct{})}
+	w.feeds[exch+":"+event] = f
+	return f, nil
+}
+
+func (w *testEventWrapper) feed(t *testing.T, exch, event string) *testEventFeed {
+	t.Helper()
+	w.m.Lock()
+	defer w.m.Unlock()
+	f, ok := w.feeds[exch+":"+event]
+	require.Truef(t, ok, "%s %s feed must be subscribed", exch, event)
+	return f
+}
+
+func TestVMEvents(t *testing.T) {
+	w := &testEventWrapper{feeds: make(map[string]*testEventFeed)}
+	modules.SetModuleWrapper(w)
+	t.Cleanup(func() { modules.SetModuleWrapper(nil) })
+	loader.SetDefaultScriptState(t.TempDir())
+
+	manager := GctScriptManager{
+		config:  configHelper(true, true, maxTestVirtualMachines),
+		started: 1,
+	}
+	testVM := manager.New()
+	require.NoError(t, testVM.Load(testEventScript))
+	testVM.CompileAndRun()
+	require.Len(t, testVM.ctx.EventHandlers(), 2, "Script must register its event handlers")
+
+	tickers := w.feed(t, "bitstamp", modules.EventTicker)
+	orders := w.feed(t, "bitstamp", modules.EventOrderUpdate)
+
+	btcusd := currency.NewBTCUSD()
+	tickers.c <- &ticker.Price{Pair: btcusd, AssetType: asset.Spot, Last: 1337}
+	tickers.c <- &ticker.Price{Pair: currency.NewPair(currency.ETH, currency.USD), AssetType: asset.Spot, Last: 1}
+	tickers.c <- &ticker.Price{Pair: btcusd, AssetType: asset.Spot, Last: 1338}
+	orders.c <- &order.Detail{OrderID: "1", Pair: btcusd, AssetType: asset.Spot}
+
+	assert.Eventually(t, func() bool {
+		return testVM.Compiled.Get("count").Int() == 2
+	}, time.Second, time.Millisecond*10, "Ticker handler should only be called for matching updates")
+	assert.Equal(t, 1, testVM.Compiled.Get("runs").Int(), "Script body should not run again for events")
+
+	last, err := gct.StoreGet(testVM.ctx, &tengo.String{Value: "last"})
+	require.NoError(t, err)
+	assert.Equal(t, &tengo.Float{Value: 1338}, last)
+	assert.Eventually(t, func() bool {
+		id, err := gct.StoreGet(testVM.ctx, &tengo.String{Value: "order"})
+		return err == nil && id.String() == `"1"`
+	}, time.Second, time.Millisecond*10, "Order update handler should store the order ID")
+
+	require.NoError(t, testVM.Shutdown())
+	for _, f := range []*testEventFeed{tickers, orders} {
+		select {
+		case <-f.released:
+		case <-time.After(time.Second):
+			assert.Fail(t, "Event feed should be released on shutdown")
+		}
+	}
+}
+
+func TestRunEventHandler(t *testing.T) {
+	t.Parallel()
+	var vm VM
+	assert.ErrorIs(t, vm.runEventHandler(tengo.UndefinedValue, tengo.UndefinedValue), ErrNoVMLoaded)
+}
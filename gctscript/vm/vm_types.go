@@ -6,6 +6,8 @@ import (
 
 	"github.com/d5/tengo/v2"
 	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules/gct"
 )
 
 const (
@@ -54,4 +56,10 @@ type VM struct {
 	S          chan struct{}
 	config     *Config
 	unregister func() error
+	ctx        *gct.Context
+	// m serialises script runs so event handlers and timed runs do not
+	// interleave on the same globals
+	m       sync.Mutex
+	feedsMu sync.Mutex
+	feeds   map[string]modules.EventFeed
 }
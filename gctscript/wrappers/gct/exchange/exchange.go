@@ -3,24 +3,39 @@ package exchange
 import (
 	"context"
 	"errors"
+	"fmt"
 	"sort"
 	"strconv"
+	"strings"
+	"sync"
 	"time"
 
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/key"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
 	"github.com/thrasher-corp/gocryptotrader/engine"
 	"github.com/thrasher-corp/gocryptotrader/exchange/accounts"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/collateral"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/deposit"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fundingrate"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/margin"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook/analytics"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/banking"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
 )
 
+var errUnsupportedEvent = errors.New("unsupported script event")
+
 // Exchange implements all required methods for Wrapper
 type Exchange struct{}
 
@@ -53,6 +68,30 @@ func (e Exchange) Orderbook(ctx context.Context, exch string, pair currency.Pair
 	return ex.UpdateOrderbook(ctx, pair, a)
 }
 
+// AggregatedOrderbook returns an orderbook consolidated across exchanges
+func (e Exchange) AggregatedOrderbook(ctx context.Context, cfg *orderbook.AggregatorConfig) (*orderbook.AggregatedBook, error) {
+	agg, err := engine.Bot.NewOrderbookAggregator(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	return agg.Book()
+}
+
+// OrderbookAnalytics returns the latest analytics of an orderbook, tracking it
+// if not already tracked
+func (e Exchange) OrderbookAnalytics(ctx context.Context, exch string, pair currency.Pair, a asset.Item, cfg *analytics.Config) (*analytics.Metrics, error) {
+	t, err := engine.Bot.TrackOrderbookAnalytics(ctx, exch, pair, a, cfg)
+	if err != nil {
+		return nil, err
+	}
+	return t.Metrics()
+}
+
+// UntrackOrderbookAnalytics stops tracking the analytics of an orderbook
+func (e Exchange) UntrackOrderbookAnalytics(exch string, pair currency.Pair, a asset.Item) error {
+	return engine.Bot.UntrackOrderbookAnalytics(exch, pair, a)
+}
+
 // Ticker returns ticker for provided currency pair & asset type
 func (e Exchange) Ticker(ctx context.Context, exch string, pair currency.Pair, a asset.Item) (*ticker.Price, error) {
 	ex, err := e.GetExchange(exch)
@@ -130,6 +169,29 @@ func (e Exchange) CancelOrder(ctx context.Context, exch, orderID string, cp curr
 	return true, nil
 }
 
+// ModifyOrder amends an existing order on an exchange
+func (e Exchange) ModifyOrder(ctx context.Context, mod *order.Modify) (*order.ModifyResponse, error) {
+	return engine.Bot.OrderManager.Modify(ctx, mod)
+}
+
+// ActiveOrders returns open orders from an exchange
+func (e Exchange) ActiveOrders(ctx context.Context, exch string, req *order.MultiOrderRequest) (order.FilteredOrders, error) {
+	ex, err := e.GetExchange(exch)
+	if err != nil {
+		return nil, err
+	}
+	return ex.GetActiveOrders(ctx, req)
+}
+
+// OrderHistory returns closed orders from an exchange
+func (e Exchange) OrderHistory(ctx context.Context, exch string, req *order.MultiOrderRequest) (order.FilteredOrders, error) {
+	ex, err := e.GetExchange(exch)
+	if err != nil {
+		return nil, err
+	}
+	return ex.GetOrderHistory(ctx, req)
+}
+
 // AccountBalances returns account balances for requested exchange
 func (e Exchange) AccountBalances(ctx context.Context, exch string, assetType asset.Item) (accounts.SubAccounts, error) {
 	ex, err := e.GetExchange(exch)
@@ -236,3 +298,189 @@ func (e Exchange) OHLCV(ctx context.Context, exch string, pair currency.Pair, it
 	ret.FormatDates()
 	return ret, nil
 }
+
+// FuturesPosition returns a summary of the open position for a futures
+// contract
+func (e Exchange) FuturesPosition(ctx context.Context, exch string, pair currency.Pair, item asset.Item) (*futures.PositionSummary, error) {
+	if !item.IsFutures() {
+		return nil, fmt.Errorf("%s %w", item, futures.ErrNotFuturesAsset)
+	}
+	ex, err := e.GetExchange(exch)
+	if err != nil {
+		return nil, err
+	}
+	return ex.GetFuturesPositionSummary(ctx, &futures.PositionSummaryRequest{
+		Asset: item,
+		Pair:  pair,
+	})
+}
+
+// LatestFundingRate returns the current funding rate for a perpetual contract
+func (e Exchange) LatestFundingRate(ctx context.Context, exch string, pair currency.Pair, item asset.Item, includePredicted bool) (*fundingrate.LatestRateResponse, error) {
+	if !item.IsFutures() {
+		return nil, fmt.Errorf("%s %w", item, futures.ErrNotFuturesAsset)
+	}
+	ex, err := e.GetExchange(exch)
+	if err != nil {
+		return nil, err
+	}
+	rates, err := ex.GetLatestFundingRates(ctx, &fundingrate.LatestRateRequest{
+		Asset:                item,
+		Pair:                 pair,
+		IncludePredictedRate: includePredicted,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for i := range rates {
+		if rates[i].Pair.Equal(pair) {
+			return &rates[i], nil
+		}
+	}
+	return nil, fmt.Errorf("%w for %s %s %s", fundingrate.ErrNoFundingRatesFound, exch, item, pair)
+}
+
+// OpenInterest returns the open interest for a futures contract
+func (e Exchange) OpenInterest(ctx context.Context, exch string, pair currency.Pair, item asset.Item) (float64, error) {
+	if !item.IsFutures() {
+		return 0, fmt.Errorf("%s %w", item, futures.ErrNotFuturesAsset)
+	}
+	ex, err := e.GetExchange(exch)
+	if err != nil {
+		return 0, err
+	}
+	oi, err := ex.GetOpenInterest(ctx, key.PairAsset{
+		Base:  pair.Base.Item,
+		Quote: pair.Quote.Item,
+		Asset: item,
+	})
+	if err != nil {
+		return 0, err
+	}
+	for i := range oi {
+		if oi[i].Key.MatchesPairAsset(pair, item) {
+			return oi[i].OpenInterest, nil
+		}
+	}
+	return 0, fmt.Errorf("%w open interest for %s %s %s", common.ErrNoResults, exch, item, pair)
+}
+
+// CollateralMode returns the collateral mode of an account asset
+func (e Exchange) CollateralMode(ctx context.Context, exch string, item asset.Item) (collateral.Mode, error) {
+	ex, err := e.GetExchange(exch)
+	if err != nil {
+		return collateral.UnsetMode, err
+	}
+	return ex.GetCollateralMode(ctx, item)
+}
+
+// SetCollateralMode sets the collateral mode of an account asset
+func (e Exchange) SetCollateralMode(ctx context.Context, exch string, item asset.Item, mode collateral.Mode) error {
+	ex, err := e.GetExchange(exch)
+	if err != nil {
+		return err
+	}
+	return ex.SetCollateralMode(ctx, item, mode)
+}
+
+// Leverage returns the leverage set for an account asset pair
+func (e Exchange) Leverage(ctx context.Context, exch string, pair currency.Pair, item asset.Item, marginType margin.Type, side order.Side) (float64, error) {
+	ex, err := e.GetExchange(exch)
+	if err != nil {
+		return 0, err
+	}
+	return ex.GetLeverage(ctx, item, pair, marginType, side)
+}
+
+// SetLeverage sets the leverage for an account asset pair
+func (e Exchange) SetLeverage(ctx context.Context, exch string, pair currency.Pair, item asset.Item, marginType margin.Type, side order.Side, leverage float64) error {
+	ex, err := e.GetExchange(exch)
+	if err != nil {
+		return err
+	}
+	return ex.SetLeverage(ctx, item, pair, marginType, leverage, side)
+}
+
+// SetMarginType sets the margin type for an account asset pair
+func (e Exchange) SetMarginType(ctx context.Context, exch string, pair currency.Pair, item asset.Item, marginType margin.Type) error {
+	ex, err := e.GetExchange(exch)
+	if err != nil {
+		return err
+	}
+	return ex.SetMarginType(ctx, item, pair, marginType)
+}
+
+// SubscribeEvents returns a feed of engine updates for an exchange which are
+// relayed to script event handlers
+func (e Exchange) SubscribeEvents(exch, event string) (modules.EventFeed, error) {
+	ex, err := e.GetExchange(exch)
+	if err != nil {
+		return nil, err
+	}
+	var pipe dispatch.Pipe
+	switch event {
+	case modules.EventTicker:
+		pipe, err = ticker.SubscribeToExchangeTickers(ex.GetName())
+	case modules.EventOrderbook:
+		pipe, err = orderbook.SubscribeToExchangeOrderbooks(ex.GetName())
+	case modules.EventTrade:
+		pipe, err = trade.SubscribeToExchangeTrades(ex.GetName())
+	case modules.EventBalanceChange:
+		pipe, err = ex.GetBase().Accounts.Subscribe()
+	case modules.EventOrderUpdate:
+		return subscribeOrderUpdates(ex.GetName())
+	default:
+		return nil, fmt.Errorf("%w %q", errUnsupportedEvent, event)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &pipe, nil
+}
+
+// orderUpdateFeed relays order manager updates for a single exchange
+type orderUpdateFeed struct {
+	pipe     *engine.OrderUpdatePipe
+	c        chan any
+	shutdown chan struct{}
+	once     sync.Once
+}
+
+// subscribeOrderUpdates returns a feed of order manager updates for an
+// exchange
+func subscribeOrderUpdates(exch string) (*orderUpdateFeed, error) {
+	pipe, err := engine.Bot.OrderManager.SubscribeOrderUpdates()
+	if err != nil {
+		return nil, err
+	}
+	f := &orderUpdateFeed{
+		pipe:     pipe,
+		c:        make(chan any),
+		shutdown: make(chan struct{}),
+	}
+	go func() {
+		defer close(f.c)
+		for od := range pipe.Channel() {
+			if !strings.EqualFold(od.Exchange, exch) {
+				continue
+			}
+			select {
+			case f.c <- od:
+			case <-f.shutdown:
+				return
+			}
+		}
+	}()
+	return f, nil
+}
+
+// Channel returns the channel which receives order updates
+func (f *orderUpdateFeed) Channel() <-chan any {
+	return f.c
+}
+
+// Release stops order updates being sent to the feed
+func (f *orderUpdateFeed) Release() error {
+	f.once.Do(func() { close(f.shutdown) })
+	return f.pipe.Release()
+}
@@ -8,11 +8,20 @@ import (
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
 	"github.com/thrasher-corp/gocryptotrader/engine"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/collateral"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/margin"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook/analytics"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules"
 )
 
 // change these if you wish to test another exchange and/or currency pair
@@ -107,6 +116,62 @@ func TestExchange_Orderbook(t *testing.T) {
 	}
 }
 
+func TestExchange_AggregatedOrderbook(t *testing.T) {
+	t.Parallel()
+	p := currency.NewPair(currency.LTC, currency.AUD)
+	d, err := orderbook.DeployDepth(exchName, p, assetType)
+	require.NoError(t, err)
+	require.NoError(t, d.LoadSnapshot(&orderbook.Book{
+		Bids:        orderbook.Levels{{Price: 100, Amount: 1}},
+		Asks:        orderbook.Levels{{Price: 101, Amount: 1}},
+		LastUpdated: time.Now(),
+	}))
+
+	_, err = exchangeTest.AggregatedOrderbook(t.Context(), &orderbook.AggregatorConfig{
+		Pair:    p,
+		Asset:   assetType,
+		Sources: []orderbook.AggregatorSource{{Exchange: "hello world"}},
+	})
+	assert.Error(t, err, "AggregatedOrderbook should error for an unknown exchange")
+
+	book, err := exchangeTest.AggregatedOrderbook(t.Context(), &orderbook.AggregatorConfig{
+		Pair:    p,
+		Asset:   assetType,
+		Sources: []orderbook.AggregatorSource{{Exchange: exchName, Fee: 0.001}},
+	})
+	require.NoError(t, err)
+	require.Len(t, book.Bids, 1)
+	assert.Equal(t, exchName, book.Bids[0].Sources[0].Exchange)
+	require.Len(t, book.Asks, 1)
+	assert.Equal(t, 101.0, book.Asks[0].Price)
+}
+
+func TestExchange_OrderbookAnalytics(t *testing.T) {
+	t.Parallel()
+	p := currency.NewPair(currency.XRP, currency.AUD)
+	d, err := orderbook.DeployDepth(exchName, p, assetType)
+	require.NoError(t, err)
+	require.NoError(t, d.LoadSnapshot(&orderbook.Book{
+		Bids:        orderbook.Levels{{Price: 100, Amount: 1}},
+		Asks:        orderbook.Levels{{Price: 101, Amount: 1}},
+		LastUpdated: time.Now(),
+	}))
+
+	_, err = exchangeTest.OrderbookAnalytics(t.Context(), "hello world", p, assetType, nil)
+	assert.Error(t, err, "OrderbookAnalytics should error for an unknown exchange")
+
+	m, err := exchangeTest.OrderbookAnalytics(t.Context(), exchName, p, assetType, &analytics.Config{Bands: []float64{100}})
+	require.NoError(t, err)
+	assert.Equal(t, exchName, m.Exchange)
+	assert.Equal(t, 100.5, m.MidPrice)
+	require.Len(t, m.Bands, 1)
+	assert.Equal(t, 1.0, m.Bands[0].BidAmount)
+
+	assert.Error(t, exchangeTest.UntrackOrderbookAnalytics("hello world", p, assetType), "UntrackOrderbookAnalytics should error for an unknown exchange")
+	require.NoError(t, exchangeTest.UntrackOrderbookAnalytics(exchName, p, assetType))
+	assert.ErrorIs(t, exchangeTest.UntrackOrderbookAnalytics(exchName, p, assetType), analytics.ErrNotTracked)
+}
+
 func TestExchange_Pairs(t *testing.T) {
 	t.Parallel()
 	_, err := exchangeTest.Pairs(exchName, false, assetType)
@@ -195,6 +260,84 @@ func TestOHLCV(t *testing.T) {
 	}
 }
 
+func TestSubscribeEvents(t *testing.T) {
+	t.Parallel()
+	_, err := exchangeTest.SubscribeEvents("hello world", modules.EventTrade)
+	assert.Error(t, err, "SubscribeEvents should error for an unknown exchange")
+
+	_, err = exchangeTest.SubscribeEvents(exchName, "bruh")
+	assert.ErrorIs(t, err, errUnsupportedEvent)
+
+	_, err = exchangeTest.SubscribeEvents(exchName, modules.EventOrderUpdate)
+	assert.ErrorIs(t, err, engine.ErrNilSubsystem, "SubscribeEvents should error when the order manager is not set up")
+
+	require.NoError(t, dispatch.EnsureRunning(dispatch.DefaultMaxWorkers, dispatch.DefaultJobsLimit))
+	feed, err := exchangeTest.SubscribeEvents(exchName, modules.EventTrade)
+	require.NoError(t, err)
+	assert.NoError(t, feed.Release())
+}
+
+func TestModifyOrder(t *testing.T) {
+	t.Parallel()
+	_, err := exchangeTest.ModifyOrder(t.Context(), &order.Modify{Exchange: exchName})
+	assert.ErrorIs(t, err, engine.ErrNilSubsystem, "ModifyOrder should error when the order manager is not set up")
+}
+
+func TestActiveOrders(t *testing.T) {
+	if !configureExchangeKeys() {
+		t.Skip("no exchange configured test skipped")
+	}
+	t.Parallel()
+	_, err := exchangeTest.ActiveOrders(t.Context(), exchName, &order.MultiOrderRequest{
+		AssetType: assetType,
+		Type:      order.AnyType,
+		Side:      order.AnySide,
+	})
+	assert.NoError(t, err)
+}
+
+func TestOrderHistory(t *testing.T) {
+	if !configureExchangeKeys() {
+		t.Skip("no exchange configured test skipped")
+	}
+	t.Parallel()
+	_, err := exchangeTest.OrderHistory(t.Context(), exchName, &order.MultiOrderRequest{
+		AssetType: assetType,
+		Type:      order.AnyType,
+		Side:      order.AnySide,
+	})
+	assert.NoError(t, err)
+}
+
+func TestFuturesFunctions(t *testing.T) {
+	t.Parallel()
+	cp := currency.NewPair(currency.BTC, currency.AUD)
+
+	_, err := exchangeTest.FuturesPosition(t.Context(), exchName, cp, asset.Spot)
+	assert.ErrorIs(t, err, futures.ErrNotFuturesAsset)
+	_, err = exchangeTest.LatestFundingRate(t.Context(), exchName, cp, asset.Spot, false)
+	assert.ErrorIs(t, err, futures.ErrNotFuturesAsset)
+	_, err = exchangeTest.OpenInterest(t.Context(), exchName, cp, asset.Spot)
+	assert.ErrorIs(t, err, futures.ErrNotFuturesAsset)
+
+	_, err = exchangeTest.FuturesPosition(t.Context(), "hello world", cp, asset.PerpetualSwap)
+	assert.Error(t, err, "FuturesPosition should error for an unknown exchange")
+	_, err = exchangeTest.LatestFundingRate(t.Context(), "hello world", cp, asset.PerpetualSwap, false)
+	assert.Error(t, err, "LatestFundingRate should error for an unknown exchange")
+	_, err = exchangeTest.OpenInterest(t.Context(), "hello world", cp, asset.PerpetualSwap)
+	assert.Error(t, err, "OpenInterest should error for an unknown exchange")
+	_, err = exchangeTest.CollateralMode(t.Context(), "hello world", asset.PerpetualSwap)
+	assert.Error(t, err, "CollateralMode should error for an unknown exchange")
+	err = exchangeTest.SetCollateralMode(t.Context(), "hello world", asset.PerpetualSwap, collateral.SingleMode)
+	assert.Error(t, err, "SetCollateralMode should error for an unknown exchange")
+	_, err = exchangeTest.Leverage(t.Context(), "hello world", cp, asset.PerpetualSwap, margin.Isolated, order.UnknownSide)
+	assert.Error(t, err, "Leverage should error for an unknown exchange")
+	err = exchangeTest.SetLeverage(t.Context(), "hello world", cp, asset.PerpetualSwap, margin.Isolated, order.UnknownSide, 10)
+	assert.Error(t, err, "SetLeverage should error for an unknown exchange")
+	err = exchangeTest.SetMarginType(t.Context(), "hello world", cp, asset.PerpetualSwap, margin.Isolated)
+	assert.Error(t, err, "SetMarginType should error for an unknown exchange")
+}
+
 func setupEngine() (err error) {
 	engine.Bot, err = engine.NewFromSettings(&settings, nil)
 	if err != nil {
@@ -5,15 +5,22 @@ import (
 	"math/rand"
 	"time"
 
+	"github.com/shopspring/decimal"
 	"github.com/thrasher-corp/gocryptotrader/core"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchange/accounts"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/collateral"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/deposit"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fundingrate"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/margin"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook/analytics"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
 )
 
@@ -70,6 +77,67 @@ func (w Wrapper) Orderbook(_ context.Context, exch string, pair currency.Pair, i
 	}, nil
 }
 
+// AggregatedOrderbook validator for test execution/scripts
+func (w Wrapper) AggregatedOrderbook(_ context.Context, cfg *orderbook.AggregatorConfig) (*orderbook.AggregatedBook, error) {
+	book := &orderbook.AggregatedBook{
+		Pair:        cfg.Pair,
+		Asset:       cfg.Asset,
+		LastUpdated: time.Now(),
+		Bids:        []orderbook.AggregatedLevel{{Price: 1}},
+		Asks:        []orderbook.AggregatedLevel{{Price: 2}},
+	}
+	for i := range cfg.Sources {
+		if cfg.Sources[i].Exchange == exchError.String() {
+			return nil, errTestFailed
+		}
+		src := orderbook.LevelSource{Exchange: cfg.Sources[i].Exchange, Pair: cfg.Sources[i].Pair, Amount: 1}
+		src.Price, src.EffectivePrice = 1, 1
+		book.Bids[0].Sources = append(book.Bids[0].Sources, src)
+		book.Bids[0].Amount++
+		src.Price, src.EffectivePrice = 2, 2
+		book.Asks[0].Sources = append(book.Asks[0].Sources, src)
+		book.Asks[0].Amount++
+	}
+	return book, nil
+}
+
+// OrderbookAnalytics validator for test execution/scripts
+func (w Wrapper) OrderbookAnalytics(_ context.Context, exch string, pair currency.Pair, item asset.Item, cfg *analytics.Config) (*analytics.Metrics, error) {
+	if exch == exchError.String() {
+		return nil, errTestFailed
+	}
+	m := &analytics.Metrics{
+		Exchange:         exch,
+		Pair:             pair,
+		Asset:            item,
+		LastUpdated:      time.Now(),
+		BestBid:          1,
+		BestAsk:          2,
+		MidPrice:         1.5,
+		WeightedMidPrice: 1.5,
+		Microprice:       1.5,
+		Spread:           1,
+		SpreadBps:        6666.666666666667,
+		SpreadStats:      analytics.SpreadStats{Mean: 6666.666666666667, Min: 6666.666666666667, Max: 6666.666666666667},
+	}
+	bands := analytics.DefaultBands
+	if cfg != nil && len(cfg.Bands) > 0 {
+		bands = cfg.Bands
+	}
+	for _, bps := range bands {
+		m.Bands = append(m.Bands, analytics.Band{Bps: bps, BidAmount: 1, AskAmount: 1, BidValue: 1, AskValue: 2})
+	}
+	return m, nil
+}
+
+// UntrackOrderbookAnalytics validator for test execution/scripts
+func (w Wrapper) UntrackOrderbookAnalytics(exch string, _ currency.Pair, _ asset.Item) error {
+	if exch == exchError.String() {
+		return errTestFailed
+	}
+	return nil
+}
+
 // Ticker validator for test execution/scripts
 func (w Wrapper) Ticker(_ context.Context, exch string, pair currency.Pair, item asset.Item) (*ticker.Price, error) {
 	if exch == exchError.String() {
@@ -184,6 +252,42 @@ func (w Wrapper) CancelOrder(_ context.Context, exch, orderid string, cp currenc
 	return true, nil
 }
 
+// ModifyOrder validator for test execution/scripts
+func (w Wrapper) ModifyOrder(_ context.Context, mod *order.Modify) (*order.ModifyResponse, error) {
+	if mod == nil {
+		return nil, errTestFailed
+	}
+	if mod.Exchange == exchError.String() {
+		return nil, errTestFailed
+	}
+	return mod.DeriveModifyResponse()
+}
+
+// ActiveOrders validator for test execution/scripts
+func (w Wrapper) ActiveOrders(ctx context.Context, exch string, req *order.MultiOrderRequest) (order.FilteredOrders, error) {
+	if req == nil {
+		return nil, errTestFailed
+	}
+	od, err := w.QueryOrder(ctx, exch, "", currency.EMPTYPAIR, req.AssetType)
+	if err != nil {
+		return nil, err
+	}
+	od.Status = order.Active
+	return order.FilteredOrders{*od}, nil
+}
+
+// OrderHistory validator for test execution/scripts
+func (w Wrapper) OrderHistory(ctx context.Context, exch string, req *order.MultiOrderRequest) (order.FilteredOrders, error) {
+	if req == nil {
+		return nil, errTestFailed
+	}
+	od, err := w.QueryOrder(ctx, exch, "", currency.EMPTYPAIR, req.AssetType)
+	if err != nil {
+		return nil, err
+	}
+	return order.FilteredOrders{*od}, nil
+}
+
 // AccountBalances validator for test execution/scripts
 func (w Wrapper) AccountBalances(_ context.Context, exch string, assetType asset.Item) (accounts.SubAccounts, error) {
 	if exch == exchError.String() {
@@ -277,3 +381,109 @@ func (w Wrapper) OHLCV(_ context.Context, exch string, p currency.Pair, a asset.
 		Candles:  candles,
 	}, nil
 }
+
+// FuturesPosition validator for test execution/scripts
+func (w Wrapper) FuturesPosition(_ context.Context, exch string, pair currency.Pair, item asset.Item) (*futures.PositionSummary, error) {
+	if exch == exchError.String() {
+		return nil, errTestFailed
+	}
+	return &futures.PositionSummary{
+		Pair:             pair,
+		Asset:            item,
+		MarginType:       margin.Isolated,
+		CollateralMode:   collateral.SingleMode,
+		Currency:         pair.Quote,
+		Leverage:         decimal.NewFromInt(10),
+		CurrentSize:      decimal.NewFromInt(1),
+		AverageOpenPrice: decimal.NewFromFloat(validatorOpen),
+		MarkPrice:        decimal.NewFromFloat(validatorClose),
+		UnrealisedPNL:    decimal.NewFromFloat(validatorClose - validatorOpen),
+	}, nil
+}
+
+// LatestFundingRate validator for test execution/scripts
+func (w Wrapper) LatestFundingRate(_ context.Context, exch string, pair currency.Pair, item asset.Item, includePredicted bool) (*fundingrate.LatestRateResponse, error) {
+	if exch == exchError.String() {
+		return nil, errTestFailed
+	}
+	now := time.Now()
+	resp := &fundingrate.LatestRateResponse{
+		Exchange:       exch,
+		Asset:          item,
+		Pair:           pair,
+		LatestRate:     fundingrate.Rate{Time: now.Truncate(time.Hour * 8), Rate: decimal.NewFromFloat(0.0001)},
+		TimeOfNextRate: now.Truncate(time.Hour * 8).Add(time.Hour * 8),
+		TimeChecked:    now,
+	}
+	if includePredicted {
+		resp.PredictedUpcomingRate = fundingrate.Rate{Time: resp.TimeOfNextRate, Rate: decimal.NewFromFloat(0.0002)}
+	}
+	return resp, nil
+}
+
+// OpenInterest validator for test execution/scripts
+func (w Wrapper) OpenInterest(_ context.Context, exch string, _ currency.Pair, _ asset.Item) (float64, error) {
+	if exch == exchError.String() {
+		return 0, errTestFailed
+	}
+	return validatorVol * validatorClose, nil
+}
+
+// CollateralMode validator for test execution/scripts
+func (w Wrapper) CollateralMode(_ context.Context, exch string, _ asset.Item) (collateral.Mode, error) {
+	if exch == exchError.String() {
+		return collateral.UnsetMode, errTestFailed
+	}
+	return collateral.SingleMode, nil
+}
+
+// SetCollateralMode validator for test execution/scripts
+func (w Wrapper) SetCollateralMode(_ context.Context, exch string, _ asset.Item, mode collateral.Mode) error {
+	if exch == exchError.String() || !mode.Valid() {
+		return errTestFailed
+	}
+	return nil
+}
+
+// Leverage validator for test execution/scripts
+func (w Wrapper) Leverage(_ context.Context, exch string, _ currency.Pair, _ asset.Item, _ margin.Type, _ order.Side) (float64, error) {
+	if exch == exchError.String() {
+		return 0, errTestFailed
+	}
+	return 10, nil
+}
+
+// SetLeverage validator for test execution/scripts
+func (w Wrapper) SetLeverage(_ context.Context, exch string, _ currency.Pair, _ asset.Item, _ margin.Type, _ order.Side, leverage float64) error {
+	if exch == exchError.String() || leverage <= 0 {
+		return errTestFailed
+	}
+	return nil
+}
+
+// SetMarginType validator for test execution/scripts
+func (w Wrapper) SetMarginType(_ context.Context, exch string, _ currency.Pair, _ asset.Item, marginType margin.Type) error {
+	if exch == exchError.String() || !marginType.Valid() {
+		return errTestFailed
+	}
+	return nil
+}
+
+// SubscribeEvents returns a feed which never receives updates for test execution/scripts
+func (w Wrapper) SubscribeEvents(exch, _ string) (modules.EventFeed, error) {
+	if exch == exchError.String() {
+		return nil, errTestFailed
+	}
+	return &eventFeed{c: make(chan any)}, nil
+}
+
+// Channel returns the feed's channel
+func (f *eventFeed) Channel() <-chan any {
+	return f.c
+}
+
+// Release closes the feed's channel
+func (f *eventFeed) Release() error {
+	close(f.c)
+	return nil
+}
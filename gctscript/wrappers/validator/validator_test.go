@@ -8,8 +8,13 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/collateral"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/margin"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook/analytics"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
 )
 
@@ -137,6 +142,44 @@ func TestWrapper_Orderbook(t *testing.T) {
 	}
 }
 
+func TestWrapper_AggregatedOrderbook(t *testing.T) {
+	t.Parallel()
+	cfg := &orderbook.AggregatorConfig{
+		Pair:    currencyPair,
+		Asset:   assetType,
+		Sources: []orderbook.AggregatorSource{{Exchange: exchName}},
+	}
+	book, err := testWrapper.AggregatedOrderbook(t.Context(), cfg)
+	require.NoError(t, err)
+	require.Len(t, book.Bids, 1)
+	assert.Len(t, book.Bids[0].Sources, 1)
+
+	cfg.Sources = append(cfg.Sources, orderbook.AggregatorSource{Exchange: exchError.String()})
+	_, err = testWrapper.AggregatedOrderbook(t.Context(), cfg)
+	assert.ErrorIs(t, err, errTestFailed)
+}
+
+func TestWrapper_OrderbookAnalytics(t *testing.T) {
+	t.Parallel()
+	m, err := testWrapper.OrderbookAnalytics(t.Context(), exchName, currencyPair, assetType, nil)
+	require.NoError(t, err)
+	assert.Len(t, m.Bands, len(analytics.DefaultBands))
+
+	m, err = testWrapper.OrderbookAnalytics(t.Context(), exchName, currencyPair, assetType, &analytics.Config{Bands: []float64{5}})
+	require.NoError(t, err)
+	require.Len(t, m.Bands, 1)
+	assert.Equal(t, 5.0, m.Bands[0].Bps)
+
+	_, err = testWrapper.OrderbookAnalytics(t.Context(), exchError.String(), currencyPair, assetType, nil)
+	assert.ErrorIs(t, err, errTestFailed)
+}
+
+func TestWrapper_UntrackOrderbookAnalytics(t *testing.T) {
+	t.Parallel()
+	assert.NoError(t, testWrapper.UntrackOrderbookAnalytics(exchName, currencyPair, assetType))
+	assert.ErrorIs(t, testWrapper.UntrackOrderbookAnalytics(exchError.String(), currencyPair, assetType), errTestFailed)
+}
+
 func TestWrapper_Pairs(t *testing.T) {
 	t.Parallel()
 	_, err := testWrapper.Pairs(exchName, false, assetType)
@@ -260,3 +303,131 @@ func TestWrapper_OHLCV(t *testing.T) {
 		t.Fatal("expected OHLCV to return error with invalid name")
 	}
 }
+
+func TestWrapper_SubscribeEvents(t *testing.T) {
+	_, err := testWrapper.SubscribeEvents(exchError.String(), modules.EventTicker)
+	assert.ErrorIs(t, err, errTestFailed)
+
+	feed, err := testWrapper.SubscribeEvents(exchName, modules.EventTicker)
+	require.NoError(t, err)
+	require.NotNil(t, feed.Channel())
+	require.NoError(t, feed.Release())
+	_, ok := <-feed.Channel()
+	assert.False(t, ok, "Release should close the feed channel")
+}
+
+func TestWrapper_ModifyOrder(t *testing.T) {
+	t.Parallel()
+	_, err := testWrapper.ModifyOrder(t.Context(), nil)
+	assert.ErrorIs(t, err, errTestFailed)
+
+	_, err = testWrapper.ModifyOrder(t.Context(), &order.Modify{Exchange: exchError.String()})
+	assert.ErrorIs(t, err, errTestFailed)
+
+	resp, err := testWrapper.ModifyOrder(t.Context(), &order.Modify{
+		Exchange:  exchName,
+		OrderID:   orderID,
+		Pair:      currencyPair,
+		AssetType: assetType,
+		Price:     orderPrice,
+		Amount:    orderAmount,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, orderID, resp.OrderID)
+}
+
+func TestWrapper_ActiveOrders(t *testing.T) {
+	t.Parallel()
+	_, err := testWrapper.ActiveOrders(t.Context(), exchName, nil)
+	assert.ErrorIs(t, err, errTestFailed)
+
+	_, err = testWrapper.ActiveOrders(t.Context(), exchError.String(), &order.MultiOrderRequest{AssetType: assetType})
+	assert.ErrorIs(t, err, errTestFailed)
+
+	orders, err := testWrapper.ActiveOrders(t.Context(), exchName, &order.MultiOrderRequest{AssetType: assetType})
+	require.NoError(t, err)
+	require.Len(t, orders, 1)
+	assert.Equal(t, order.Active, orders[0].Status)
+}
+
+func TestWrapper_OrderHistory(t *testing.T) {
+	t.Parallel()
+	_, err := testWrapper.OrderHistory(t.Context(), exchName, nil)
+	assert.ErrorIs(t, err, errTestFailed)
+
+	_, err = testWrapper.OrderHistory(t.Context(), exchError.String(), &order.MultiOrderRequest{AssetType: assetType})
+	assert.ErrorIs(t, err, errTestFailed)
+
+	orders, err := testWrapper.OrderHistory(t.Context(), exchName, &order.MultiOrderRequest{AssetType: assetType})
+	require.NoError(t, err)
+	assert.Len(t, orders, 1)
+}
+
+func TestWrapper_FuturesPosition(t *testing.T) {
+	t.Parallel()
+	_, err := testWrapper.FuturesPosition(t.Context(), exchError.String(), currencyPair, asset.PerpetualSwap)
+	assert.ErrorIs(t, err, errTestFailed)
+
+	pos, err := testWrapper.FuturesPosition(t.Context(), exchName, currencyPair, asset.PerpetualSwap)
+	require.NoError(t, err)
+	assert.Equal(t, currencyPair, pos.Pair)
+}
+
+func TestWrapper_LatestFundingRate(t *testing.T) {
+	t.Parallel()
+	_, err := testWrapper.LatestFundingRate(t.Context(), exchError.String(), currencyPair, asset.PerpetualSwap, false)
+	assert.ErrorIs(t, err, errTestFailed)
+
+	rate, err := testWrapper.LatestFundingRate(t.Context(), exchName, currencyPair, asset.PerpetualSwap, false)
+	require.NoError(t, err)
+	assert.True(t, rate.PredictedUpcomingRate.Time.IsZero())
+
+	rate, err = testWrapper.LatestFundingRate(t.Context(), exchName, currencyPair, asset.PerpetualSwap, true)
+	require.NoError(t, err)
+	assert.False(t, rate.PredictedUpcomingRate.Time.IsZero())
+}
+
+func TestWrapper_OpenInterest(t *testing.T) {
+	t.Parallel()
+	_, err := testWrapper.OpenInterest(t.Context(), exchError.String(), currencyPair, asset.PerpetualSwap)
+	assert.ErrorIs(t, err, errTestFailed)
+
+	oi, err := testWrapper.OpenInterest(t.Context(), exchName, currencyPair, asset.PerpetualSwap)
+	require.NoError(t, err)
+	assert.Positive(t, oi)
+}
+
+func TestWrapper_CollateralMode(t *testing.T) {
+	t.Parallel()
+	_, err := testWrapper.CollateralMode(t.Context(), exchError.String(), asset.PerpetualSwap)
+	assert.ErrorIs(t, err, errTestFailed)
+
+	mode, err := testWrapper.CollateralMode(t.Context(), exchName, asset.PerpetualSwap)
+	require.NoError(t, err)
+	assert.Equal(t, collateral.SingleMode, mode)
+
+	err = testWrapper.SetCollateralMode(t.Context(), exchName, asset.PerpetualSwap, collateral.UnsetMode)
+	assert.ErrorIs(t, err, errTestFailed)
+	assert.NoError(t, testWrapper.SetCollateralMode(t.Context(), exchName, asset.PerpetualSwap, collateral.MultiMode))
+}
+
+func TestWrapper_Leverage(t *testing.T) {
+	t.Parallel()
+	_, err := testWrapper.Leverage(t.Context(), exchError.String(), currencyPair, asset.PerpetualSwap, margin.Isolated, order.UnknownSide)
+	assert.ErrorIs(t, err, errTestFailed)
+
+	leverage, err := testWrapper.Leverage(t.Context(), exchName, currencyPair, asset.PerpetualSwap, margin.Isolated, order.UnknownSide)
+	require.NoError(t, err)
+	assert.Positive(t, leverage)
+
+	err = testWrapper.SetLeverage(t.Context(), exchName, currencyPair, asset.PerpetualSwap, margin.Isolated, order.UnknownSide, 0)
+	assert.ErrorIs(t, err, errTestFailed)
+	assert.NoError(t, testWrapper.SetLeverage(t.Context(), exchName, currencyPair, asset.PerpetualSwap, margin.Isolated, order.UnknownSide, 10))
+}
+
+func TestWrapper_SetMarginType(t *testing.T) {
+	t.Parallel()
+	err := testWrapper.SetMarginType(t.Context(), exchName, currencyPair, asset.PerpetualSwap, margin.Unset)
+	assert.ErrorIs(t, err, errTestFailed)
+	assert.NoError(t, testWrapper.SetMarginType(t.Context(), exchName, currencyPair, asset.PerpetualSwap, margin.Isolated))
+}
@@ -19,3 +19,8 @@ var (
 
 // Wrapper for validator interface
 type Wrapper struct{}
+
+// eventFeed is an event subscription which never receives updates
+type eventFeed struct {
+	c chan any
+}
@@ -130,6 +130,61 @@ func MockWsInstance[T any, PT interface {
 	return e
 }
 
+// wsRecordingFile is a consistent path under each exchange to find the websocket recording
+const wsRecordingFile = "testdata/websocket.json"
+
+// MockWsReplayInstance creates a new Exchange instance with its websocket connected to a server replaying the exchange's
+// websocket recording. See ReplayWs
+func MockWsReplayInstance[T any, PT interface {
+	*T
+	exchange.IBotExchange
+}](tb testing.TB, opts *mock.WebsocketReplayOptions) (*T, *mock.WebsocketReplayServer) {
+	tb.Helper()
+
+	e := PT(new(T))
+	require.NoError(tb, Setup(e), "Test exchange Setup must not error")
+	return e, ReplayWs(tb, e, opts)
+}
+
+// ReplayWs connects an exchange's websocket to a server replaying the exchange's websocket recording
+// Connections are redirected to the server keeping their path, so multi-connection exchanges are supported
+// Subscriptions are generated as usual, so they should match those recorded
+// The replay server is returned so tests can check Err and Remaining
+func ReplayWs(tb testing.TB, e exchange.IBotExchange, opts *mock.WebsocketReplayOptions) *mock.WebsocketReplayServer {
+	tb.Helper()
+
+	s, err := mock.NewWebsocketReplayServer(wsRecordingFile, opts)
+	require.NoError(tb, err, "NewWebsocketReplayServer must not error")
+	tb.Cleanup(s.Close)
+
+	b := e.GetBase()
+	b.SkipAuthCheck = true
+	require.NoError(tb, b.Websocket.RedirectConnections(s.WebsocketURL()), "RedirectConnections must not error")
+	require.NoError(tb, b.Websocket.Connect(), "Connect must not error")
+	tb.Cleanup(func() {
+		if b.Websocket.IsConnected() {
+			assert.NoError(tb, b.Websocket.Shutdown(), "Websocket Shutdown should not error")
+		}
+	})
+
+	return s
+}
+
+// RecordWs records the websocket frames of an exchange and saves them to the exchange's websocket recording when the
+// test completes. It must be called before the websocket connects and should only be used against live endpoints
+// Frames beyond maxFrames per connection are not recorded, zero records all frames
+// Outbound frames are recorded verbatim, so authentication frames must be scrubbed before recordings are committed
+func RecordWs(tb testing.TB, e exchange.IBotExchange, maxFrames int) *mock.WebsocketRecorder {
+	tb.Helper()
+
+	rec := mock.NewWebsocketRecorder(maxFrames)
+	e.GetBase().Websocket.SetFrameRecorder(rec)
+	tb.Cleanup(func() {
+		assert.NoError(tb, rec.Save(wsRecordingFile), "Saving the websocket recording should not error")
+	})
+	return rec
+}
+
 // FixtureError contains an error and the message that caused it
 type FixtureError struct {
 	Err error
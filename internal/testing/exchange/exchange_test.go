@@ -1,14 +1,18 @@
 package exchange
 
 import (
+	"path/filepath"
 	"testing"
+	"time"
 
 	gws "github.com/gorilla/websocket"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/binance"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/mock"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/sharedtestvalues"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/subscription"
 	mockws "github.com/thrasher-corp/gocryptotrader/internal/testing/websocket"
 )
 
@@ -35,3 +39,50 @@ func TestMockWsInstance(t *testing.T) {
 	b := MockWsInstance[binance.Exchange](t, mockws.CurryWsMockUpgrader(t, func(_ testing.TB, _ []byte, _ *gws.Conn) error { return nil }))
 	require.NotNil(t, b, "MockWsInstance must not be nil")
 }
+
+// newReplayTestExchange returns a binance instance without subscriptions so it matches the test recording
+func newReplayTestExchange(t *testing.T) *binance.Exchange {
+	t.Helper()
+	b := new(binance.Exchange)
+	require.NoError(t, Setup(b), "Test exchange Setup must not error")
+	b.Features.Subscriptions = subscription.List{}
+	return b
+}
+
+// TestReplayWs exercises ReplayWs
+func TestReplayWs(t *testing.T) {
+	b := newReplayTestExchange(t)
+	s := ReplayWs(t, b, nil)
+	require.NotNil(t, s, "ReplayWs must return the replay server")
+	assert.True(t, b.Websocket.IsConnected(), "Websocket should be connected")
+	assert.Empty(t, s.Remaining(), "All conversations should be replayed")
+	assert.NoError(t, s.Err(), "Replay should not error")
+}
+
+// TestRecordWs exercises RecordWs
+func TestRecordWs(t *testing.T) {
+	b := newReplayTestExchange(t)
+	live := mock.NewWebsocketReplayServerFromRecording(&mock.WebsocketRecording{Conversations: []mock.WebsocketConversation{
+		{URL: "wss://test.com/stream", Frames: []mock.WebsocketFrame{{Type: gws.TextMessage, Data: []byte(`{"result":null,"id":"recorded"}`)}}},
+	}}, nil)
+	defer live.Close()
+
+	dir := t.TempDir()
+	t.Run("record", func(t *testing.T) {
+		t.Chdir(dir)
+		rec := RecordWs(t, b, 0)
+		require.NoError(t, b.Websocket.RedirectConnections(live.WebsocketURL()), "RedirectConnections must not error")
+		require.NoError(t, b.Websocket.Connect(), "Connect must not error")
+		require.Eventually(t, func() bool {
+			r := rec.Recording()
+			return len(r.Conversations) == 1 && len(r.Conversations[0].Frames) == 1
+		}, time.Second, time.Millisecond, "Recording must contain the received frame")
+		require.NoError(t, b.Websocket.Shutdown(), "Websocket Shutdown must not error")
+	})
+
+	r, err := mock.LoadWebsocketRecording(filepath.Join(dir, wsRecordingFile))
+	require.NoError(t, err, "LoadWebsocketRecording must not error")
+	require.Len(t, r.Conversations, 1, "Recording must contain the conversation")
+	assert.Contains(t, r.Conversations[0].URL, "/stream", "Recording should contain the connection URL")
+	assert.JSONEq(t, `{"result":null,"id":"recorded"}`, string(r.Conversations[0].Frames[0].Data), "Recording should contain the received frame")
+}
@@ -0,0 +1,799 @@
+package ledger
+
+import (
+	"cmp"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+// String implements the stringer interface
+func (m Method) String() string {
+	switch m {
+	case FIFO:
+		return "fifo"
+	case LIFO:
+		return "lifo"
+	case AverageCost:
+		return "average"
+	default:
+		return "unset"
+	}
+}
+
+// ParseMethod returns the cost basis method matching the name
+func ParseMethod(s string) (Method, error) {
+	switch strings.ToLower(s) {
+	case "fifo":
+		return FIFO, nil
+	case "lifo":
+		return LIFO, nil
+	case "average", "averagecost":
+		return AverageCost, nil
+	}
+	return UnsetMethod, fmt.Errorf("%w: %q", errInvalidMethod, s)
+}
+
+// ConvertCurrency converts amounts between fiat currencies using the current
+// foreign exchange rates held by the currency package. Stablecoins are
+// converted at parity with USD
+func ConvertCurrency(amount float64, from, to currency.Code) (float64, error) {
+	if AtParity(from, to) {
+		return amount, nil
+	}
+	f, t := from, to
+	if f.IsStableCurrency() {
+		f = currency.USD
+	}
+	if t.IsStableCurrency() {
+		t = currency.USD
+	}
+	if !f.IsFiatCurrency() || !t.IsFiatCurrency() {
+		return 0, fmt.Errorf("%w from %s to %s", errNoConversionRate, from, to)
+	}
+	return currency.ConvertFiat(amount, f, t)
+}
+
+// AtParity returns whether two currencies are the same or are stablecoins or
+// USD, which are converted at parity regardless of time
+func AtParity(from, to currency.Code) bool {
+	if from.Equal(to) {
+		return true
+	}
+	return (from.IsStableCurrency() || from.Equal(currency.USD)) && (to.IsStableCurrency() || to.Equal(currency.USD))
+}
+
+// New returns a ledger which matches disposals against lots using the method
+// and values fills in the reporting currency using the converter
+func New(method Method, reporting currency.Code, convert Converter) (*Ledger, error) {
+	if method == UnsetMethod || method > AverageCost {
+		return nil, fmt.Errorf("%w: %d", errInvalidMethod, method)
+	}
+	if reporting.IsEmpty() {
+		return nil, errReportingCurrencyUnset
+	}
+	if convert == nil {
+		return nil, errNilConverter
+	}
+	return &Ledger{
+		method:    method,
+		reporting: reporting,
+		convert:   convert,
+		fills:     make(map[string]struct{}),
+		orders:    make(map[string]*orderProgress),
+		positions: make(map[*currency.Item]*position),
+	}, nil
+}
+
+// Method returns the cost basis method used by the ledger
+func (l *Ledger) Method() Method {
+	return l.method
+}
+
+// ReportingCurrency returns the currency PNL is reported in
+func (l *Ledger) ReportingCurrency() currency.Code {
+	return l.reporting
+}
+
+// AddFill adds a fill to the ledger. Fills are identified by their exchange,
+// order ID and trade ID and a fill which has already been added returns
+// ErrDuplicateFill
+func (l *Ledger) AddFill(f *Fill) error {
+	if l == nil {
+		return fmt.Errorf("%w: %T", common.ErrNilPointer, l)
+	}
+	if f == nil {
+		return errNilFill
+	}
+	if f.OrderID == "" && f.TradeID == "" {
+		return errOrderIDUnset
+	}
+	l.m.Lock()
+	defer l.m.Unlock()
+	return l.addFill(f, fillKey(f.Exchange, f.OrderID, f.TradeID))
+}
+
+// AddOrder adds the fills of a spot order which have not already been added.
+// The order's trades are used when it has any, otherwise fills are derived
+// from changes to its executed amount, average price and fee. It returns the
+// number of fills added
+func (l *Ledger) AddOrder(od *order.Detail) (int, error) {
+	if l == nil {
+		return 0, fmt.Errorf("%w: %T", common.ErrNilPointer, l)
+	}
+	if od == nil {
+		return 0, errNilOrder
+	}
+	if od.AssetType != asset.Spot {
+		return 0, fmt.Errorf("%w: %s", errUnsupportedAsset, od.AssetType)
+	}
+	if od.OrderID == "" {
+		return 0, errOrderIDUnset
+	}
+	l.m.Lock()
+	defer l.m.Unlock()
+	key := fillKey(od.Exchange, od.OrderID, "")
+	p, ok := l.orders[key]
+	if !ok {
+		// Orders keep the source of their first fills so trades reported
+		// after executed amounts, or vice versa, are not added twice
+		p = &orderProgress{byTrades: len(od.Trades) > 0}
+	}
+	var added int
+	var err error
+	if p.byTrades {
+		added, err = l.addTrades(od)
+	} else {
+		added, err = l.addExecuted(od, p)
+	}
+	if added > 0 {
+		l.orders[key] = p
+	}
+	return added, err
+}
+
+// addTrades adds each trade of an order which has not already been added. The
+// ledger must be locked
+func (l *Ledger) addTrades(od *order.Detail) (int, error) {
+	var added int
+	var errs error
+	for i := range od.Trades {
+		t := &od.Trades[i]
+		side := t.Side
+		if !side.IsLong() && !side.IsShort() {
+			side = od.Side
+		}
+		tradeID := t.TID
+		if tradeID == "" {
+			tradeID = "#" + strconv.Itoa(i)
+		}
+		ts := t.Timestamp
+		if ts.IsZero() {
+			ts = orderTime(od)
+		}
+		f := &Fill{
+			Exchange: od.Exchange,
+			Pair:     od.Pair,
+			Side:     side,
+			Price:    t.Price,
+			Amount:   t.Amount,
+			Fee:      t.Fee,
+			Time:     ts,
+			OrderID:  od.OrderID,
+			TradeID:  t.TID,
+		}
+		if t.FeeAsset != "" {
+			f.FeeAsset = currency.NewCode(t.FeeAsset)
+		} else {
+			f.FeeAsset = od.FeeAsset
+		}
+		if err := l.addFill(f, fillKey(od.Exchange, od.OrderID, tradeID)); err != nil {
+			if !errors.Is(err, ErrDuplicateFill) {
+				errs = common.AppendError(errs, err)
+			}
+			continue
+		}
+		added++
+	}
+	return added, errs
+}
+
+// addExecuted adds the amount executed by an order since it was last added.
+// The ledger must be locked
+func (l *Ledger) addExecuted(od *order.Detail, p *orderProgress) (int, error) {
+	if od.ExecutedAmount <= p.executed {
+		return 0, nil
+	}
+	price := od.AverageExecutedPrice
+	if price <= 0 {
+		price = od.Price
+	}
+	if price <= 0 {
+		return 0, fmt.Errorf("%s order %s %w", od.Exchange, od.OrderID, errInvalidPrice)
+	}
+	cost := od.ExecutedAmount * price
+	amount := od.ExecutedAmount - p.executed
+	fillPrice := (cost - p.cost) / amount
+	if fillPrice <= 0 {
+		fillPrice = price
+	}
+	f := &Fill{
+		Exchange: od.Exchange,
+		Pair:     od.Pair,
+		Side:     od.Side,
+		Price:    fillPrice,
+		Amount:   amount,
+		Fee:      max(od.Fee-p.fee, 0),
+		FeeAsset: od.FeeAsset,
+		Time:     orderTime(od),
+		OrderID:  od.OrderID,
+	}
+	// The cumulative executed amount identifies each partial fill
+	key := fillKey(od.Exchange, od.OrderID, strconv.FormatFloat(od.ExecutedAmount, 'f', -1, 64))
+	if err := l.addFill(f, key); err != nil {
+		if errors.Is(err, ErrDuplicateFill) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	p.executed = od.ExecutedAmount
+	p.cost = cost
+	p.fee = max(od.Fee, p.fee)
+	return 1, nil
+}
+
+// addFill values a fill and records the movements of its currencies. The
+// ledger must be locked
+func (l *Ledger) addFill(f *Fill, key string) error {
+	if err := validateFill(f); err != nil {
+		return err
+	}
+	if _, ok := l.fills[key]; ok {
+		return fmt.Errorf("%w: %s order %s trade %s", ErrDuplicateFill, f.Exchange, f.OrderID, f.TradeID)
+	}
+	// Fills are valued at the time they were filled. Fills which cannot be
+	// valued are still recorded so holdings are correct, but are excluded
+	// from cost basis and PNL
+	notional := f.Price * f.Amount
+	value, err := l.value(notional, f.Pair.Quote, f.Time)
+	valued := err == nil
+	fee := decimal.Zero
+	feeValued := true
+	baseFee := f.Fee > 0 && f.FeeAsset.Equal(f.Pair.Base)
+	quoteFee := f.Fee > 0 && (f.FeeAsset.IsEmpty() || f.FeeAsset.Equal(f.Pair.Quote))
+	if f.Fee > 0 && !baseFee {
+		if quoteFee {
+			fee, err = l.value(f.Fee, f.Pair.Quote, f.Time)
+		} else {
+			fee, err = l.value(f.Fee, f.FeeAsset, f.Time)
+		}
+		feeValued = err == nil
+	}
+	base := movement{
+		currency: f.Pair.Base,
+		acquire:  f.Side.IsLong(),
+		amount:   decimal.NewFromFloat(f.Amount),
+		valued:   valued && feeValued,
+		exchange: f.Exchange,
+		pair:     f.Pair,
+		orderID:  f.OrderID,
+		tradeID:  f.TradeID,
+		time:     f.Time,
+	}
+	// Fees paid in the base currency reduce the amount acquired or add to the
+	// amount disposed. Other fees are added to the cost of acquisitions and
+	// deducted from the proceeds of disposals
+	switch {
+	case baseFee && base.acquire:
+		base.amount = base.amount.Sub(decimal.NewFromFloat(f.Fee))
+	case baseFee:
+		base.amount = base.amount.Add(decimal.NewFromFloat(f.Fee))
+	}
+	switch {
+	case !base.valued:
+	case baseFee:
+		base.value = value
+	case base.acquire:
+		base.value = value.Add(fee)
+	default:
+		base.value = value.Sub(fee)
+	}
+	movements := []movement{base}
+	if !l.isCash(f.Pair.Quote) {
+		// Crypto quoted fills also dispose of or acquire the quote currency,
+		// fees paid in the quote currency add to the amount disposed or reduce
+		// the amount acquired
+		quote := base
+		quote.currency = f.Pair.Quote
+		quote.acquire = !base.acquire
+		quote.amount = decimal.NewFromFloat(notional)
+		quote.value = value
+		quote.valued = valued
+		if quoteFee {
+			quote.valued = base.valued
+			quote.value = base.value
+			if quote.acquire {
+				quote.amount = quote.amount.Sub(decimal.NewFromFloat(f.Fee))
+			} else {
+				quote.amount = quote.amount.Add(decimal.NewFromFloat(f.Fee))
+			}
+		}
+		movements = append(movements, quote)
+	}
+	l.fills[key] = struct{}{}
+	l.record(movements)
+	return nil
+}
+
+// validateFill ensures a fill can be added to the ledger
+func validateFill(f *Fill) error {
+	switch {
+	case f.Exchange == "":
+		return errExchangeNameUnset
+	case f.Pair.IsEmpty():
+		return errCurrencyPairEmpty
+	case !f.Side.IsLong() && !f.Side.IsShort():
+		return fmt.Errorf("%w: %s", errUnsupportedSide, f.Side)
+	case f.Amount <= 0:
+		return errInvalidAmount
+	case f.Price <= 0:
+		return errInvalidPrice
+	case f.Fee < 0:
+		return errInvalidFee
+	case f.Side.IsLong() && f.FeeAsset.Equal(f.Pair.Base) && f.Fee >= f.Amount:
+		return fmt.Errorf("%w: base fee exceeds the amount bought", errInvalidFee)
+	}
+	return nil
+}
+
+// value converts an amount to the reporting currency at the rate at a time, or
+// the current rate when the time is zero
+func (l *Ledger) value(amount float64, c currency.Code, at time.Time) (decimal.Decimal, error) {
+	if c.Equal(l.reporting) {
+		return decimal.NewFromFloat(amount), nil
+	}
+	v, err := l.convert(amount, c, l.reporting, at)
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("unable to convert %s to %s: %w", c, l.reporting, err)
+	}
+	return decimal.NewFromFloat(v), nil
+}
+
+// isCash returns whether a currency is held as cash rather than in lots
+func (l *Ledger) isCash(c currency.Code) bool {
+	return c.Equal(l.reporting) || c.IsFiatCurrency() || c.IsStableCurrency()
+}
+
+// record applies movements to the positions. Movements older than the latest
+// recorded cause every position to be rebuilt in time order. The ledger must
+// be locked
+func (l *Ledger) record(movements []movement) {
+	rebuild := false
+	for i := range movements {
+		l.seq++
+		movements[i].seq = l.seq
+		if n := len(l.movements); n > 0 && movements[i].time.Before(l.movements[n-1].time) {
+			rebuild = true
+		}
+		l.movements = append(l.movements, movements[i])
+	}
+	if !rebuild {
+		for i := range movements {
+			l.apply(&movements[i])
+		}
+		return
+	}
+	slices.SortStableFunc(l.movements, func(a, b movement) int {
+		return a.time.Compare(b.time)
+	})
+	clear(l.positions)
+	l.lotID = 0
+	for i := range l.movements {
+		l.apply(&l.movements[i])
+	}
+}
+
+// apply adds a lot for an acquisition or matches a disposal against open lots
+func (l *Ledger) apply(mv *movement) {
+	p, ok := l.positions[mv.currency.Item]
+	if !ok {
+		p = &position{}
+		l.positions[mv.currency.Item] = p
+	}
+	if mv.acquire {
+		l.lotID++
+		p.lots = append(p.lots, &Lot{
+			ID:         l.lotID,
+			Currency:   mv.currency,
+			Exchange:   mv.exchange,
+			Pair:       mv.pair,
+			OrderID:    mv.orderID,
+			TradeID:    mv.tradeID,
+			AcquiredAt: mv.time,
+			Amount:     mv.amount,
+			Remaining:  mv.amount,
+			CostBasis:  mv.value,
+			Valued:     mv.valued,
+		})
+		return
+	}
+	var unmatched decimal.Decimal
+	switch l.method {
+	case AverageCost:
+		unmatched = p.disposeAverage(mv)
+	default:
+		unmatched = p.disposeInOrder(mv, l.method == LIFO)
+	}
+	if !unmatched.GreaterThan(dust) {
+		return
+	}
+	p.unmatchedAmount = p.unmatchedAmount.Add(unmatched)
+	if mv.valued {
+		p.unmatchedProceeds = p.unmatchedProceeds.Add(mv.value.Mul(unmatched).Div(mv.amount))
+	} else {
+		p.unvaluedDisposed = p.unvaluedDisposed.Add(unmatched)
+	}
+}
+
+// disposeInOrder matches a disposal against open lots from the oldest, or
+// newest when newestFirst is set, and returns the amount left unmatched
+func (p *position) disposeInOrder(mv *movement, newestFirst bool) decimal.Decimal {
+	remaining := mv.amount
+	for i := range p.lots {
+		if !remaining.GreaterThan(dust) {
+			break
+		}
+		lot := p.lots[i]
+		if newestFirst {
+			lot = p.lots[len(p.lots)-1-i]
+		}
+		if !lot.Remaining.IsPositive() {
+			continue
+		}
+		take := decimal.Min(lot.Remaining, remaining)
+		p.dispose(lot, mv, take, lot.CostBasis.Mul(take).Div(lot.Amount))
+		remaining = remaining.Sub(take)
+	}
+	return remaining
+}
+
+// disposeAverage matches a disposal against all open lots in proportion to
+// their remaining amounts at the average cost of the open valued lots and
+// returns the amount left unmatched
+func (p *position) disposeAverage(mv *movement) decimal.Decimal {
+	holdings, valuedHoldings, openCost := p.open()
+	if !holdings.IsPositive() {
+		return mv.amount
+	}
+	matched := decimal.Min(holdings, mv.amount)
+	totalCost := openCost.Mul(matched).Div(holdings)
+	valuedMatched := valuedHoldings.Mul(matched).Div(holdings)
+	last, lastValued := -1, -1
+	for i := range p.lots {
+		if p.lots[i].Remaining.IsPositive() {
+			last = i
+			if p.lots[i].Valued {
+				lastValued = i
+			}
+		}
+	}
+	var taken, costed decimal.Decimal
+	for i := range p.lots {
+		lot := p.lots[i]
+		if !lot.Remaining.IsPositive() {
+			continue
+		}
+		take := lot.Remaining.Mul(matched).Div(holdings)
+		if i == last {
+			// The last lot takes what is left so rounding is not lost
+			take = decimal.Min(matched.Sub(taken), lot.Remaining)
+		}
+		var cost decimal.Decimal
+		if lot.Valued {
+			cost = totalCost.Mul(take).Div(valuedMatched)
+			if i == lastValued {
+				cost = totalCost.Sub(costed)
+			}
+		}
+		taken = taken.Add(take)
+		costed = costed.Add(cost)
+		p.dispose(lot, mv, take, cost)
+	}
+	return mv.amount.Sub(matched)
+}
+
+// dispose removes an amount from a lot and records the realised PNL when both
+// the lot and the disposal were valued
+func (p *position) dispose(lot *Lot, mv *movement, amount, cost decimal.Decimal) {
+	lot.Remaining = lot.Remaining.Sub(amount)
+	if lot.Remaining.LessThanOrEqual(dust) {
+		lot.Remaining = decimal.Zero
+	}
+	d := Disposal{
+		Exchange: mv.exchange,
+		Pair:     mv.pair,
+		OrderID:  mv.orderID,
+		TradeID:  mv.tradeID,
+		Time:     mv.time,
+		Amount:   amount,
+		Valued:   lot.Valued && mv.valued,
+	}
+	if !d.Valued {
+		p.unvaluedDisposed = p.unvaluedDisposed.Add(amount)
+		lot.Disposals = append(lot.Disposals, d)
+		return
+	}
+	d.Proceeds = mv.value.Mul(amount).Div(mv.amount)
+	d.CostBasis = cost
+	d.RealisedPNL = d.Proceeds.Sub(cost)
+	lot.Disposals = append(lot.Disposals, d)
+	p.realised = p.realised.Add(d.RealisedPNL)
+}
+
+// open returns the remaining amount of the open lots and the remaining amount
+// and cost basis of the open valued lots
+func (p *position) open() (holdings, valuedHoldings, cost decimal.Decimal) {
+	for _, lot := range p.lots {
+		if !lot.Remaining.IsPositive() {
+			continue
+		}
+		holdings = holdings.Add(lot.Remaining)
+		if !lot.Valued {
+			continue
+		}
+		valuedHoldings = valuedHoldings.Add(lot.Remaining)
+		cost = cost.Add(lot.CostBasis.Mul(lot.Remaining).Div(lot.Amount))
+	}
+	return holdings, valuedHoldings, cost
+}
+
+// UnitCost returns the cost basis of a single unit of the lot
+func (lot *Lot) UnitCost() decimal.Decimal {
+	if !lot.Amount.IsPositive() {
+		return decimal.Zero
+	}
+	return lot.CostBasis.Div(lot.Amount)
+}
+
+// Summaries returns the holdings and PNL of each currency, or only the
+// currency supplied when it is not empty. Holdings are valued in the
+// reporting currency at the current rate using the ledger's converter
+func (l *Ledger) Summaries(c currency.Code) []Summary {
+	if l == nil {
+		return nil
+	}
+	l.m.Lock()
+	summaries := make([]Summary, 0, len(l.positions))
+	for item, p := range l.positions {
+		if !c.IsEmpty() && item != c.Item {
+			continue
+		}
+		holdings, valuedHoldings, cost := p.open()
+		s := Summary{
+			Currency:          item.Currency(),
+			Reporting:         l.reporting,
+			Holdings:          holdings,
+			CostBasis:         cost,
+			RealisedPNL:       p.realised,
+			UnmatchedAmount:   p.unmatchedAmount,
+			UnmatchedProceeds: p.unmatchedProceeds,
+			UnvaluedHoldings:  holdings.Sub(valuedHoldings),
+			UnvaluedDisposed:  p.unvaluedDisposed,
+		}
+		if valuedHoldings.IsPositive() {
+			s.AverageCost = cost.Div(valuedHoldings)
+		}
+		summaries = append(summaries, s)
+	}
+	l.m.Unlock()
+	slices.SortFunc(summaries, func(a, b Summary) int {
+		return strings.Compare(a.Currency.String(), b.Currency.String())
+	})
+	// Holdings are valued without the ledger locked as the converter may
+	// need to fetch prices
+	for i := range summaries {
+		s := &summaries[i]
+		if !s.Holdings.IsPositive() {
+			s.Valued = true
+			continue
+		}
+		v, err := l.value(s.Holdings.InexactFloat64(), s.Currency, time.Time{})
+		if err != nil {
+			continue
+		}
+		s.Valued = true
+		s.MarketValue = v
+		// Unvalued holdings have no cost basis so their market value is
+		// excluded from the unrealised PNL
+		valued := s.Holdings.Sub(s.UnvaluedHoldings)
+		s.UnrealisedPNL = v.Mul(valued).Div(s.Holdings).Sub(s.CostBasis)
+	}
+	return summaries
+}
+
+// RealisedPNL returns the realised PNL of valued disposals made on an exchange
+// and pair since a time. An empty exchange or pair matches every exchange or
+// pair
+func (l *Ledger) RealisedPNL(exchange string, p currency.Pair, since time.Time) decimal.Decimal {
+	if l == nil {
+		return decimal.Zero
+	}
+	l.m.Lock()
+	defer l.m.Unlock()
+	pnl := decimal.Zero
+	for _, pos := range l.positions {
+		for _, lot := range pos.lots {
+			for i := range lot.Disposals {
+				d := &lot.Disposals[i]
+				if !d.Valued || d.Time.Before(since) ||
+					(exchange != "" && !strings.EqualFold(d.Exchange, exchange)) ||
+					(!p.IsEmpty() && !d.Pair.Equal(p)) {
+					continue
+				}
+				pnl = pnl.Add(d.RealisedPNL)
+			}
+		}
+	}
+	return pnl
+}
+
+// Lots returns a copy of the lots of each currency, or only the currency
+// supplied when it is not empty, ordered by currency and acquisition.
+// Fully disposed of lots are only included when includeClosed is set
+func (l *Ledger) Lots(c currency.Code, includeClosed bool) []Lot {
+	if l == nil {
+		return nil
+	}
+	l.m.Lock()
+	defer l.m.Unlock()
+	var lots []Lot
+	for item, p := range l.positions {
+		if !c.IsEmpty() && item != c.Item {
+			continue
+		}
+		for _, lot := range p.lots {
+			if !includeClosed && !lot.Remaining.IsPositive() {
+				continue
+			}
+			cpy := *lot
+			cpy.Disposals = slices.Clone(lot.Disposals)
+			lots = append(lots, cpy)
+		}
+	}
+	slices.SortFunc(lots, func(a, b Lot) int {
+		if r := strings.Compare(a.Currency.String(), b.Currency.String()); r != 0 {
+			return r
+		}
+		if r := a.AcquiredAt.Compare(b.AcquiredAt); r != 0 {
+			return r
+		}
+		return cmp.Compare(a.ID, b.ID)
+	})
+	return lots
+}
+
+// WriteSummariesCSV writes the summaries of each currency, or only the
+// currency supplied when it is not empty, as CSV. Market value and unrealised
+// PNL are empty for holdings which could not be valued
+func (l *Ledger) WriteSummariesCSV(w io.Writer, c currency.Code) error {
+	summaries := l.Summaries(c)
+	records := make([][]string, 0, len(summaries)+1)
+	records = append(records, []string{"currency", "holdings", "cost_basis", "average_cost", "realised_pnl", "market_value", "unrealised_pnl", "unmatched_amount", "unmatched_proceeds", "unvalued_holdings", "unvalued_disposed", "reporting_currency"})
+	for i := range summaries {
+		s := &summaries[i]
+		var marketValue, unrealised string
+		if s.Valued {
+			marketValue, unrealised = s.MarketValue.String(), s.UnrealisedPNL.String()
+		}
+		records = append(records, []string{
+			s.Currency.String(),
+			s.Holdings.String(),
+			s.CostBasis.String(),
+			s.AverageCost.String(),
+			s.RealisedPNL.String(),
+			marketValue,
+			unrealised,
+			s.UnmatchedAmount.String(),
+			s.UnmatchedProceeds.String(),
+			s.UnvaluedHoldings.String(),
+			s.UnvaluedDisposed.String(),
+			s.Reporting.String(),
+		})
+	}
+	return csv.NewWriter(w).WriteAll(records)
+}
+
+// WriteLotsCSV writes the lots of each currency, or only the currency supplied
+// when it is not empty, as CSV. Cost basis and unit cost are empty for lots
+// which could not be valued
+func (l *Ledger) WriteLotsCSV(w io.Writer, c currency.Code, includeClosed bool) error {
+	lots := l.Lots(c, includeClosed)
+	records := make([][]string, 0, len(lots)+1)
+	records = append(records, []string{"lot_id", "currency", "exchange", "pair", "order_id", "trade_id", "acquired_at", "amount", "remaining", "cost_basis", "unit_cost", "reporting_currency"})
+	for i := range lots {
+		lot := &lots[i]
+		var costBasis, unitCost string
+		if lot.Valued {
+			costBasis, unitCost = lot.CostBasis.String(), lot.UnitCost().String()
+		}
+		records = append(records, []string{
+			strconv.FormatUint(lot.ID, 10),
+			lot.Currency.String(),
+			lot.Exchange,
+			lot.Pair.String(),
+			lot.OrderID,
+			lot.TradeID,
+			formatTime(lot.AcquiredAt),
+			lot.Amount.String(),
+			lot.Remaining.String(),
+			costBasis,
+			unitCost,
+			l.reporting.String(),
+		})
+	}
+	return csv.NewWriter(w).WriteAll(records)
+}
+
+// WriteDisposalsCSV writes the disposals matched against the lots of each
+// currency, or only the currency supplied when it is not empty, as CSV.
+// Proceeds, cost basis and realised PNL are empty for unvalued disposals
+func (l *Ledger) WriteDisposalsCSV(w io.Writer, c currency.Code) error {
+	lots := l.Lots(c, true)
+	records := [][]string{{"lot_id", "currency", "exchange", "pair", "order_id", "trade_id", "time", "amount", "proceeds", "cost_basis", "realised_pnl", "reporting_currency"}}
+	for i := range lots {
+		for j := range lots[i].Disposals {
+			d := &lots[i].Disposals[j]
+			var proceeds, costBasis, pnl string
+			if d.Valued {
+				proceeds, costBasis, pnl = d.Proceeds.String(), d.CostBasis.String(), d.RealisedPNL.String()
+			}
+			records = append(records, []string{
+				strconv.FormatUint(lots[i].ID, 10),
+				lots[i].Currency.String(),
+				d.Exchange,
+				d.Pair.String(),
+				d.OrderID,
+				d.TradeID,
+				formatTime(d.Time),
+				d.Amount.String(),
+				proceeds,
+				costBasis,
+				pnl,
+				l.reporting.String(),
+			})
+		}
+	}
+	return csv.NewWriter(w).WriteAll(records)
+}
+
+// fillKey returns the key identifying a fill
+func fillKey(exch, orderID, tradeID string) string {
+	return strings.ToLower(exch) + "|" + orderID + "|" + tradeID
+}
+
+// orderTime returns when an order was last filled
+func orderTime(od *order.Detail) time.Time {
+	if !od.LastUpdated.IsZero() {
+		return od.LastUpdated
+	}
+	return od.Date
+}
+
+// formatTime formats a time for CSV output, leaving unset times empty
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339Nano)
+}
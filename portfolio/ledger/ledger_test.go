@@ -0,0 +1,515 @@
+package ledger
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+var (
+	testPair  = currency.NewPair(currency.BTC, currency.USD)
+	testStart = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+)
+
+// testConverter values BTC at 300 and ETH at 20 in USD, with no historical
+// rates before testStart
+func testConverter(amount float64, from, to currency.Code, at time.Time) (float64, error) {
+	if !to.Equal(currency.USD) || (!at.IsZero() && at.Before(testStart)) {
+		return 0, errNoConversionRate
+	}
+	switch {
+	case from.Equal(currency.BTC):
+		return amount * 300, nil
+	case from.Equal(currency.ETH):
+		return amount * 20, nil
+	}
+	return 0, fmt.Errorf("%w from %s", errNoConversionRate, from)
+}
+
+func newTestLedger(t *testing.T, method Method) *Ledger {
+	t.Helper()
+	l, err := New(method, currency.USD, testConverter)
+	require.NoError(t, err, "New must not error")
+	return l
+}
+
+func testFill(side order.Side, price, amount float64, at int) *Fill {
+	return &Fill{
+		Exchange: "test",
+		Pair:     testPair,
+		Side:     side,
+		Price:    price,
+		Amount:   amount,
+		Time:     testStart.Add(time.Duration(at) * time.Minute),
+		OrderID:  fmt.Sprintf("order%d", at),
+	}
+}
+
+func TestParseMethod(t *testing.T) {
+	t.Parallel()
+	for _, m := range []Method{FIFO, LIFO, AverageCost} {
+		got, err := ParseMethod(m.String())
+		require.NoError(t, err, "ParseMethod must not error")
+		assert.Equal(t, m, got)
+	}
+	got, err := ParseMethod("FIFO")
+	require.NoError(t, err, "ParseMethod must not error")
+	assert.Equal(t, FIFO, got, "ParseMethod should be case insensitive")
+	_, err = ParseMethod("hifo")
+	assert.ErrorIs(t, err, errInvalidMethod)
+	assert.Equal(t, "unset", UnsetMethod.String())
+}
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+	_, err := New(UnsetMethod, currency.USD, testConverter)
+	assert.ErrorIs(t, err, errInvalidMethod)
+	_, err = New(AverageCost+1, currency.USD, testConverter)
+	assert.ErrorIs(t, err, errInvalidMethod)
+	_, err = New(FIFO, currency.EMPTYCODE, testConverter)
+	assert.ErrorIs(t, err, errReportingCurrencyUnset)
+	_, err = New(FIFO, currency.USD, nil)
+	assert.ErrorIs(t, err, errNilConverter)
+
+	l := newTestLedger(t, LIFO)
+	assert.Equal(t, LIFO, l.Method())
+	assert.Equal(t, currency.USD, l.ReportingCurrency())
+}
+
+func TestConvertCurrency(t *testing.T) {
+	t.Parallel()
+	v, err := ConvertCurrency(10, currency.USD, currency.USD)
+	require.NoError(t, err, "ConvertCurrency must not error")
+	assert.Equal(t, 10.0, v)
+	v, err = ConvertCurrency(10, currency.USDT, currency.USD)
+	require.NoError(t, err, "ConvertCurrency must not error")
+	assert.Equal(t, 10.0, v, "stablecoins should convert at parity")
+	v, err = ConvertCurrency(10, currency.USD, currency.USDC)
+	require.NoError(t, err, "ConvertCurrency must not error")
+	assert.Equal(t, 10.0, v, "stablecoins should convert at parity")
+	_, err = ConvertCurrency(10, currency.BTC, currency.USD)
+	assert.ErrorIs(t, err, errNoConversionRate)
+}
+
+func TestAddFill(t *testing.T) {
+	t.Parallel()
+	var l *Ledger
+	assert.ErrorIs(t, l.AddFill(&Fill{}), common.ErrNilPointer)
+
+	l = newTestLedger(t, FIFO)
+	assert.ErrorIs(t, l.AddFill(nil), errNilFill)
+	assert.ErrorIs(t, l.AddFill(&Fill{}), errOrderIDUnset)
+	for _, tc := range []struct {
+		mutate func(*Fill)
+		err    error
+	}{
+		{func(f *Fill) { f.Exchange = "" }, errExchangeNameUnset},
+		{func(f *Fill) { f.Pair = currency.EMPTYPAIR }, errCurrencyPairEmpty},
+		{func(f *Fill) { f.Side = order.AnySide }, errUnsupportedSide},
+		{func(f *Fill) { f.Amount = 0 }, errInvalidAmount},
+		{func(f *Fill) { f.Price = -1 }, errInvalidPrice},
+		{func(f *Fill) { f.Fee = -1 }, errInvalidFee},
+	} {
+		f := testFill(order.Buy, 100, 1, 0)
+		tc.mutate(f)
+		assert.ErrorIs(t, l.AddFill(f), tc.err)
+	}
+
+	f := testFill(order.Buy, 100, 1, 0)
+	f.Fee = 1
+	require.NoError(t, l.AddFill(f), "AddFill must not error")
+	assert.ErrorIs(t, l.AddFill(f), ErrDuplicateFill)
+
+	f = testFill(order.Bid, 100, 2, 1)
+	f.Fee = 0.01
+	f.FeeAsset = currency.BTC
+	require.NoError(t, l.AddFill(f), "AddFill must not error")
+	f = testFill(order.Buy, 100, 1, 2)
+	f.Fee = 0.5
+	f.FeeAsset = currency.ETH
+	require.NoError(t, l.AddFill(f), "AddFill must not error")
+
+	lots := l.Lots(currency.BTC, false)
+	require.Len(t, lots, 3)
+	assert.Equal(t, "101", lots[0].CostBasis.String(), "quote fees should be added to the cost basis")
+	assert.Equal(t, "1.99", lots[1].Amount.String(), "base fees should reduce the amount acquired")
+	assert.Equal(t, "200", lots[1].CostBasis.String(), "base fees should not be added to the cost basis")
+	assert.Equal(t, "110", lots[2].CostBasis.String(), "other fees should be converted to the reporting currency")
+	for i := range lots {
+		assert.True(t, lots[i].Valued, "lots should be valued")
+	}
+
+	f = testFill(order.Buy, 100, 1, 3)
+	f.Fee = 1
+	f.FeeAsset = currency.BTC
+	assert.ErrorIs(t, l.AddFill(f), errInvalidFee, "base fees should not exceed the amount bought")
+}
+
+func TestAddFillFeeAmounts(t *testing.T) {
+	t.Parallel()
+	l := newTestLedger(t, FIFO)
+	f := testFill(order.Buy, 100, 2, 0)
+	f.Fee = 0.01
+	f.FeeAsset = currency.BTC
+	require.NoError(t, l.AddFill(f), "AddFill must not error")
+	f = testFill(order.Sell, 150, 1.98, 1)
+	f.Fee = 0.01
+	f.FeeAsset = currency.BTC
+	require.NoError(t, l.AddFill(f), "AddFill must not error")
+
+	s := l.Summaries(currency.BTC)
+	require.Len(t, s, 1)
+	assert.True(t, s[0].Holdings.IsZero(), "selling everything held should close the lot when fees are paid in the base currency")
+	assert.True(t, s[0].UnmatchedAmount.IsZero())
+	assert.Equal(t, "97", s[0].RealisedPNL.String(), "proceeds of 297 should be realised against the cost of 200")
+
+	// ETH-BTC fills acquire ETH with BTC, with fees paid in BTC
+	l = newTestLedger(t, FIFO)
+	require.NoError(t, l.AddFill(testFill(order.Buy, 100, 1, 0)), "AddFill must not error")
+	f = testFill(order.Buy, 0.05, 10, 1)
+	f.Pair = currency.NewPair(currency.ETH, currency.BTC)
+	f.Fee = 0.001
+	f.FeeAsset = currency.BTC
+	require.NoError(t, l.AddFill(f), "AddFill must not error")
+	f = testFill(order.Sell, 0.05, 10, 2)
+	f.Pair = currency.NewPair(currency.ETH, currency.BTC)
+	f.Fee = 0.001
+	require.NoError(t, l.AddFill(f), "AddFill must not error")
+
+	s = l.Summaries(currency.BTC)
+	require.Len(t, s, 1)
+	assert.Equal(t, "0.998", s[0].Holdings.String(), "quote fees should add to the quote disposed and reduce the quote acquired")
+	require.Len(t, l.Lots(currency.BTC, true), 2)
+	assert.Equal(t, "0.499", l.Lots(currency.BTC, true)[1].Amount.String(), "quote fees should reduce the quote acquired")
+}
+
+func TestUnvaluedFills(t *testing.T) {
+	t.Parallel()
+	l := newTestLedger(t, FIFO)
+	require.NoError(t, l.AddFill(testFill(order.Buy, 100, 1, -120)), "AddFill must not error")
+	f := testFill(order.Buy, 0.05, 10, -60)
+	f.Pair = currency.NewPair(currency.ETH, currency.BTC)
+	require.NoError(t, l.AddFill(f), "AddFill must not error without a historical rate")
+	f = testFill(order.Sell, 0.05, 4, 0)
+	f.Pair = currency.NewPair(currency.ETH, currency.BTC)
+	require.NoError(t, l.AddFill(f), "AddFill must not error")
+	require.NoError(t, l.AddFill(testFill(order.Sell, 300, 0.7, 1)), "AddFill must not error")
+
+	s := l.Summaries(currency.EMPTYCODE)
+	require.Len(t, s, 2)
+	assert.True(t, s[0].Holdings.IsZero())
+	assert.Equal(t, "100", s[0].RealisedPNL.String(), "unvalued disposals should not be realised")
+	assert.Equal(t, "0.5", s[0].UnvaluedDisposed.String())
+	assert.Equal(t, "6", s[1].Holdings.String())
+	assert.Equal(t, "6", s[1].UnvaluedHoldings.String())
+	assert.Equal(t, "4", s[1].UnvaluedDisposed.String(), "disposals of unvalued lots should not be valued")
+	assert.True(t, s[1].CostBasis.IsZero())
+	assert.True(t, s[1].RealisedPNL.IsZero())
+	assert.True(t, s[1].Valued, "holdings should be valued at the current rate")
+	assert.Equal(t, "120", s[1].MarketValue.String())
+	assert.True(t, s[1].UnrealisedPNL.IsZero(), "unvalued holdings should not be unrealised")
+
+	lots := l.Lots(currency.EMPTYCODE, true)
+	require.Len(t, lots, 3)
+	assert.True(t, lots[0].Valued)
+	require.Len(t, lots[0].Disposals, 2)
+	assert.False(t, lots[0].Disposals[0].Valued, "disposals by unvalued fills should not be valued")
+	assert.True(t, lots[0].Disposals[0].Proceeds.IsZero())
+	assert.True(t, lots[0].Disposals[1].Valued)
+	assert.True(t, lots[1].Valued, "lots acquired by valued fills should be valued")
+	assert.Equal(t, "60", lots[1].CostBasis.String())
+	assert.False(t, lots[2].Valued, "lots acquired without a historical rate should not be valued")
+	assert.True(t, lots[2].CostBasis.IsZero())
+
+	var buf bytes.Buffer
+	require.NoError(t, l.WriteLotsCSV(&buf, currency.ETH, false), "WriteLotsCSV must not error")
+	records, err := csv.NewReader(&buf).ReadAll()
+	require.NoError(t, err, "ReadAll must not error")
+	require.Len(t, records, 2)
+	assert.Equal(t, []string{"2", "ETH", "test", "ETHBTC", "order-60", "", "2023-12-31T23:00:00Z", "10", "6", "", "", "USD"}, records[1])
+
+	buf.Reset()
+	require.NoError(t, l.WriteDisposalsCSV(&buf, currency.ETH), "WriteDisposalsCSV must not error")
+	records, err = csv.NewReader(&buf).ReadAll()
+	require.NoError(t, err, "ReadAll must not error")
+	require.Len(t, records, 2)
+	assert.Equal(t, []string{"2", "ETH", "test", "ETHBTC", "order0", "", "2024-01-01T00:00:00Z", "4", "", "", "", "USD"}, records[1])
+
+	l = newTestLedger(t, AverageCost)
+	f = testFill(order.Buy, 0.05, 1, -60)
+	f.Pair = currency.NewPair(currency.ETH, currency.BTC)
+	require.NoError(t, l.AddFill(f), "AddFill must not error")
+	f = testFill(order.Buy, 20, 1, 0)
+	f.Pair = currency.NewPair(currency.ETH, currency.USD)
+	require.NoError(t, l.AddFill(f), "AddFill must not error")
+	f = testFill(order.Sell, 30, 1, 1)
+	f.Pair = currency.NewPair(currency.ETH, currency.USD)
+	require.NoError(t, l.AddFill(f), "AddFill must not error")
+	s = l.Summaries(currency.ETH)
+	require.Len(t, s, 1)
+	assert.Equal(t, "1", s[0].Holdings.String())
+	assert.Equal(t, "0.5", s[0].UnvaluedHoldings.String())
+	assert.Equal(t, "0.5", s[0].UnvaluedDisposed.String())
+	assert.Equal(t, "10", s[0].CostBasis.String(), "average cost should only include valued lots")
+	assert.Equal(t, "20", s[0].AverageCost.String())
+	assert.Equal(t, "5", s[0].RealisedPNL.String())
+}
+
+func TestDisposalMethods(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		method    Method
+		realised  string
+		remaining string
+		open      []string
+	}{
+		{FIFO, "250", "100", []string{"0", "0.5"}},
+		{LIFO, "200", "50", []string{"0.5", "0"}},
+		{AverageCost, "225", "75", []string{"0.25", "0.25"}},
+	} {
+		t.Run(tc.method.String(), func(t *testing.T) {
+			t.Parallel()
+			l := newTestLedger(t, tc.method)
+			require.NoError(t, l.AddFill(testFill(order.Buy, 100, 1, 0)), "AddFill must not error")
+			require.NoError(t, l.AddFill(testFill(order.Buy, 200, 1, 1)), "AddFill must not error")
+			require.NoError(t, l.AddFill(testFill(order.Sell, 300, 1.5, 2)), "AddFill must not error")
+
+			s := l.Summaries(currency.EMPTYCODE)
+			require.Len(t, s, 1)
+			assert.Equal(t, currency.BTC, s[0].Currency)
+			assert.Equal(t, currency.USD, s[0].Reporting)
+			assert.Equal(t, "0.5", s[0].Holdings.String())
+			assert.Equal(t, tc.realised, s[0].RealisedPNL.String())
+			assert.Equal(t, tc.remaining, s[0].CostBasis.String())
+			assert.True(t, s[0].Valued, "holdings should be valued")
+			assert.Equal(t, "150", s[0].MarketValue.String())
+			assert.True(t, s[0].UnrealisedPNL.Equal(s[0].MarketValue.Sub(s[0].CostBasis)))
+			assert.True(t, s[0].UnmatchedAmount.IsZero())
+
+			lots := l.Lots(currency.BTC, true)
+			require.Len(t, lots, 2)
+			var disposed, realised decimal.Decimal
+			for i := range lots {
+				assert.Equal(t, tc.open[i], lots[i].Remaining.String())
+				for _, d := range lots[i].Disposals {
+					assert.Equal(t, "order2", d.OrderID)
+					disposed = disposed.Add(d.Amount)
+					realised = realised.Add(d.RealisedPNL)
+				}
+			}
+			assert.Equal(t, "1.5", disposed.String())
+			assert.Equal(t, tc.realised, realised.String())
+		})
+	}
+}
+
+func TestOutOfOrderFills(t *testing.T) {
+	t.Parallel()
+	l := newTestLedger(t, FIFO)
+	require.NoError(t, l.AddFill(testFill(order.Sell, 300, 1, 2)), "AddFill must not error")
+	s := l.Summaries(currency.BTC)
+	require.Len(t, s, 1)
+	assert.Equal(t, "1", s[0].UnmatchedAmount.String(), "disposals without lots should be unmatched")
+	assert.Equal(t, "300", s[0].UnmatchedProceeds.String())
+	assert.True(t, s[0].RealisedPNL.IsZero(), "unmatched disposals should not be realised")
+
+	require.NoError(t, l.AddFill(testFill(order.Buy, 200, 1, 1)), "AddFill must not error")
+	require.NoError(t, l.AddFill(testFill(order.Buy, 100, 1, 0)), "AddFill must not error")
+	s = l.Summaries(currency.BTC)
+	require.Len(t, s, 1)
+	assert.True(t, s[0].UnmatchedAmount.IsZero(), "older fills should rebuild the ledger")
+	assert.Equal(t, "200", s[0].RealisedPNL.String())
+	lots := l.Lots(currency.BTC, false)
+	require.Len(t, lots, 1)
+	assert.Equal(t, uint64(2), lots[0].ID)
+	assert.Equal(t, "200", lots[0].CostBasis.String())
+}
+
+func TestCryptoQuotedFills(t *testing.T) {
+	t.Parallel()
+	l := newTestLedger(t, FIFO)
+	f := testFill(order.Buy, 100, 1, 0)
+	require.NoError(t, l.AddFill(f), "AddFill must not error")
+	f = testFill(order.Buy, 0.05, 10, 1)
+	f.Pair = currency.NewPair(currency.ETH, currency.BTC)
+	require.NoError(t, l.AddFill(f), "AddFill must not error")
+
+	s := l.Summaries(currency.EMPTYCODE)
+	require.Len(t, s, 2)
+	assert.Equal(t, currency.BTC, s[0].Currency)
+	assert.Equal(t, "0.5", s[0].Holdings.String(), "buying with BTC should dispose of BTC")
+	assert.Equal(t, "100", s[0].RealisedPNL.String())
+	assert.Equal(t, currency.ETH, s[1].Currency)
+	assert.Equal(t, "10", s[1].Holdings.String())
+	assert.Equal(t, "150", s[1].CostBasis.String())
+	assert.Equal(t, "15", s[1].AverageCost.String())
+}
+
+func TestRealisedPNL(t *testing.T) {
+	t.Parallel()
+	var l *Ledger
+	assert.True(t, l.RealisedPNL("", currency.EMPTYPAIR, time.Time{}).IsZero())
+
+	l = newTestLedger(t, FIFO)
+	require.NoError(t, l.AddFill(testFill(order.Buy, 100, 2, 0)), "AddFill must not error")
+	require.NoError(t, l.AddFill(testFill(order.Sell, 90, 1, 10)), "AddFill must not error")
+	f := testFill(order.Sell, 130, 1, 20)
+	f.Exchange = "other"
+	require.NoError(t, l.AddFill(f), "AddFill must not error")
+
+	assert.Equal(t, "20", l.RealisedPNL("", currency.EMPTYPAIR, time.Time{}).String())
+	assert.Equal(t, "-10", l.RealisedPNL("TEST", testPair, time.Time{}).String(), "exchanges should match case insensitively")
+	assert.Equal(t, "30", l.RealisedPNL("", currency.EMPTYPAIR, testStart.Add(15*time.Minute)).String(), "disposals before the time should be excluded")
+	assert.True(t, l.RealisedPNL("", currency.NewPair(currency.ETH, currency.USD), time.Time{}).IsZero())
+}
+
+func TestAddOrder(t *testing.T) {
+	t.Parallel()
+	var l *Ledger
+	_, err := l.AddOrder(&order.Detail{})
+	assert.ErrorIs(t, err, common.ErrNilPointer)
+
+	l = newTestLedger(t, FIFO)
+	_, err = l.AddOrder(nil)
+	assert.ErrorIs(t, err, errNilOrder)
+	_, err = l.AddOrder(&order.Detail{AssetType: asset.Futures})
+	assert.ErrorIs(t, err, errUnsupportedAsset)
+	_, err = l.AddOrder(&order.Detail{AssetType: asset.Spot})
+	assert.ErrorIs(t, err, errOrderIDUnset)
+
+	od := &order.Detail{
+		Exchange:    "test",
+		OrderID:     "1",
+		Pair:        testPair,
+		AssetType:   asset.Spot,
+		Side:        order.Buy,
+		Price:       110,
+		Amount:      2,
+		LastUpdated: testStart,
+	}
+	n, err := l.AddOrder(od)
+	require.NoError(t, err, "AddOrder must not error")
+	assert.Zero(t, n, "unfilled orders should not add fills")
+
+	od.ExecutedAmount = 1
+	od.AverageExecutedPrice = 100
+	od.Fee = 1
+	n, err = l.AddOrder(od)
+	require.NoError(t, err, "AddOrder must not error")
+	assert.Equal(t, 1, n)
+	n, err = l.AddOrder(od)
+	require.NoError(t, err, "AddOrder must not error")
+	assert.Zero(t, n, "unchanged orders should not add fills")
+
+	od.ExecutedAmount = 2
+	od.AverageExecutedPrice = 150
+	od.Fee = 3
+	od.LastUpdated = testStart.Add(time.Minute)
+	od.Trades = []order.TradeHistory{{TID: "a", Price: 150, Amount: 2}}
+	n, err = l.AddOrder(od)
+	require.NoError(t, err, "AddOrder must not error")
+	assert.Equal(t, 1, n, "orders should keep using executed amounts")
+
+	lots := l.Lots(currency.BTC, false)
+	require.Len(t, lots, 2)
+	assert.Equal(t, "101", lots[0].CostBasis.String())
+	assert.Equal(t, "202", lots[1].CostBasis.String(), "partial fills should be priced from the change in executed cost")
+
+	od = &order.Detail{
+		Exchange:  "test",
+		OrderID:   "2",
+		Pair:      testPair,
+		AssetType: asset.Spot,
+		Side:      order.Sell,
+		Date:      testStart.Add(time.Hour),
+		Trades: []order.TradeHistory{
+			{TID: "a", Price: 300, Amount: 1, Fee: 2, FeeAsset: "USD"},
+			{Price: 300, Amount: 0.5, Side: order.Sell, Timestamp: testStart.Add(2 * time.Hour)},
+			{TID: "c", Price: 0, Amount: 1},
+		},
+	}
+	n, err = l.AddOrder(od)
+	assert.ErrorIs(t, err, errInvalidPrice, "invalid trades should error")
+	assert.Equal(t, 2, n, "valid trades should still be added")
+	od.ExecutedAmount = 2
+	od.AverageExecutedPrice = 300
+	n, err = l.AddOrder(od)
+	assert.ErrorIs(t, err, errInvalidPrice)
+	assert.Zero(t, n, "trades should only be added once")
+
+	s := l.Summaries(currency.BTC)
+	require.Len(t, s, 1)
+	assert.Equal(t, "0.5", s[0].Holdings.String())
+	assert.Equal(t, "246", s[0].RealisedPNL.String())
+}
+
+func TestSummariesUnvalued(t *testing.T) {
+	t.Parallel()
+	l, err := New(FIFO, currency.USD, func(float64, currency.Code, currency.Code, time.Time) (float64, error) {
+		return 0, errNoConversionRate
+	})
+	require.NoError(t, err, "New must not error")
+	require.NoError(t, l.AddFill(testFill(order.Buy, 100, 1, 0)), "AddFill must not error")
+	s := l.Summaries(currency.EMPTYCODE)
+	require.Len(t, s, 1)
+	assert.False(t, s[0].Valued, "holdings which cannot be converted should not be valued")
+	assert.True(t, s[0].MarketValue.IsZero())
+
+	var buf bytes.Buffer
+	require.NoError(t, l.WriteSummariesCSV(&buf, currency.EMPTYCODE), "WriteSummariesCSV must not error")
+	records, err := csv.NewReader(&buf).ReadAll()
+	require.NoError(t, err, "ReadAll must not error")
+	require.Len(t, records, 2)
+	assert.Equal(t, []string{"BTC", "1", "100", "100", "0", "", "", "0", "0", "0", "0", "USD"}, records[1])
+
+	assert.Empty(t, l.Summaries(currency.ETH))
+	var nl *Ledger
+	assert.Nil(t, nl.Summaries(currency.EMPTYCODE))
+	assert.Nil(t, nl.Lots(currency.EMPTYCODE, true))
+}
+
+func TestWriteCSV(t *testing.T) {
+	t.Parallel()
+	l := newTestLedger(t, FIFO)
+	require.NoError(t, l.AddFill(testFill(order.Buy, 100, 1, 0)), "AddFill must not error")
+	require.NoError(t, l.AddFill(testFill(order.Buy, 200, 1, 1)), "AddFill must not error")
+	require.NoError(t, l.AddFill(testFill(order.Sell, 300, 1, 2)), "AddFill must not error")
+
+	var buf bytes.Buffer
+	require.NoError(t, l.WriteLotsCSV(&buf, currency.BTC, false), "WriteLotsCSV must not error")
+	records, err := csv.NewReader(&buf).ReadAll()
+	require.NoError(t, err, "ReadAll must not error")
+	require.Len(t, records, 2, "closed lots should not be written")
+	assert.Equal(t, "lot_id", records[0][0])
+	assert.Equal(t, []string{"2", "BTC", "test", "BTCUSD", "order1", "", "2024-01-01T00:01:00Z", "1", "1", "200", "200", "USD"}, records[1])
+
+	buf.Reset()
+	require.NoError(t, l.WriteLotsCSV(&buf, currency.EMPTYCODE, true), "WriteLotsCSV must not error")
+	records, err = csv.NewReader(&buf).ReadAll()
+	require.NoError(t, err, "ReadAll must not error")
+	assert.Len(t, records, 3)
+
+	buf.Reset()
+	require.NoError(t, l.WriteDisposalsCSV(&buf, currency.EMPTYCODE), "WriteDisposalsCSV must not error")
+	records, err = csv.NewReader(&buf).ReadAll()
+	require.NoError(t, err, "ReadAll must not error")
+	require.Len(t, records, 2)
+	assert.Equal(t, []string{"1", "BTC", "test", "BTCUSD", "order2", "", "2024-01-01T00:02:00Z", "1", "300", "100", "200", "USD"}, records[1])
+
+	buf.Reset()
+	require.NoError(t, l.WriteSummariesCSV(&buf, currency.BTC), "WriteSummariesCSV must not error")
+	records, err = csv.NewReader(&buf).ReadAll()
+	require.NoError(t, err, "ReadAll must not error")
+	require.Len(t, records, 2)
+	assert.Equal(t, []string{"BTC", "1", "200", "200", "200", "300", "100", "0", "0", "0", "0", "USD"}, records[1])
+}
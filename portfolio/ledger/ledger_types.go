@@ -0,0 +1,184 @@
+package ledger
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+// Method defines how disposals are matched against open lots
+type Method uint8
+
+// Supported cost basis methods
+const (
+	UnsetMethod Method = iota
+	// FIFO disposes of the oldest lots first
+	FIFO
+	// LIFO disposes of the newest lots first
+	LIFO
+	// AverageCost disposes of all open lots proportionally at their average
+	// cost
+	AverageCost
+)
+
+// ErrDuplicateFill is returned when a fill has already been added to the
+// ledger
+var ErrDuplicateFill = errors.New("fill already added")
+
+var (
+	errInvalidMethod          = errors.New("invalid cost basis method")
+	errReportingCurrencyUnset = errors.New("reporting currency unset")
+	errNilConverter           = errors.New("nil currency converter")
+	errNilFill                = errors.New("nil fill")
+	errNilOrder               = errors.New("nil order")
+	errExchangeNameUnset      = errors.New("exchange name unset")
+	errOrderIDUnset           = errors.New("order ID unset")
+	errCurrencyPairEmpty      = errors.New("currency pair empty")
+	errUnsupportedAsset       = errors.New("unsupported asset type")
+	errUnsupportedSide        = errors.New("unsupported order side")
+	errInvalidAmount          = errors.New("amount must be greater than zero")
+	errInvalidPrice           = errors.New("price must be greater than zero")
+	errInvalidFee             = errors.New("fee cannot be negative")
+	errNoConversionRate       = errors.New("no conversion rate")
+)
+
+// dust is the amount below which a lot or disposal is considered complete
+var dust = decimal.New(1, -12)
+
+// Converter converts an amount from one currency to another at the rate at a
+// time. A zero time converts at the current rate
+type Converter func(amount float64, from, to currency.Code, at time.Time) (float64, error)
+
+// Fill is an executed trade which acquires or disposes of the base currency
+// of its pair
+type Fill struct {
+	Exchange string
+	Pair     currency.Pair
+	Side     order.Side
+	Price    float64
+	Amount   float64
+	Fee      float64
+	// FeeAsset is the currency the fee was paid in. The quote currency is
+	// assumed when empty
+	FeeAsset currency.Code
+	Time     time.Time
+	OrderID  string
+	TradeID  string
+}
+
+// Lot is an amount of a currency acquired by a single fill
+type Lot struct {
+	ID         uint64
+	Currency   currency.Code
+	Exchange   string
+	Pair       currency.Pair
+	OrderID    string
+	TradeID    string
+	AcquiredAt time.Time
+	Amount     decimal.Decimal
+	Remaining  decimal.Decimal
+	// CostBasis is the value of the acquired amount including fees in the
+	// reporting currency
+	CostBasis decimal.Decimal
+	// Valued is set when the acquiring fill could be converted to the
+	// reporting currency at the time it was filled. Unvalued lots have no
+	// cost basis
+	Valued    bool
+	Disposals []Disposal
+}
+
+// Disposal is the part of a lot consumed by a disposing fill
+type Disposal struct {
+	Exchange    string
+	Pair        currency.Pair
+	OrderID     string
+	TradeID     string
+	Time        time.Time
+	Amount      decimal.Decimal
+	Proceeds    decimal.Decimal
+	CostBasis   decimal.Decimal
+	RealisedPNL decimal.Decimal
+	// Valued is set when both the lot and the disposing fill were valued.
+	// Unvalued disposals have no proceeds, cost basis or realised PNL
+	Valued bool
+}
+
+// Summary holds the holdings and PNL of a currency in the reporting currency
+type Summary struct {
+	Currency    currency.Code
+	Reporting   currency.Code
+	Holdings    decimal.Decimal
+	CostBasis   decimal.Decimal
+	AverageCost decimal.Decimal
+	RealisedPNL decimal.Decimal
+	// Valued is set when the holdings could be converted to the reporting
+	// currency and the market value and unrealised PNL are populated
+	Valued        bool
+	MarketValue   decimal.Decimal
+	UnrealisedPNL decimal.Decimal
+	// UnmatchedAmount is the amount disposed of without an open lot to match
+	// against, such as holdings acquired before the ledger began. Unmatched
+	// disposals are excluded from the realised PNL
+	UnmatchedAmount   decimal.Decimal
+	UnmatchedProceeds decimal.Decimal
+	// UnvaluedHoldings is the amount held in unvalued lots, which is excluded
+	// from the cost basis and unrealised PNL
+	UnvaluedHoldings decimal.Decimal
+	// UnvaluedDisposed is the amount disposed of without a value, which is
+	// excluded from the realised PNL and unmatched proceeds
+	UnvaluedDisposed decimal.Decimal
+}
+
+// Ledger tracks the lots acquired and disposed of by spot fills and the
+// resulting PNL in a reporting currency
+type Ledger struct {
+	method    Method
+	reporting currency.Code
+	convert   Converter
+
+	m         sync.Mutex
+	fills     map[string]struct{}
+	orders    map[string]*orderProgress
+	movements []movement
+	seq       uint64
+	positions map[*currency.Item]*position
+	lotID     uint64
+}
+
+// movement is a currency amount acquired or disposed of by a fill, valued in
+// the reporting currency at the time of the fill when a rate was available
+type movement struct {
+	seq      uint64
+	currency currency.Code
+	acquire  bool
+	amount   decimal.Decimal
+	value    decimal.Decimal
+	valued   bool
+	exchange string
+	pair     currency.Pair
+	orderID  string
+	tradeID  string
+	time     time.Time
+}
+
+// position holds the lots of a single currency
+type position struct {
+	lots              []*Lot
+	realised          decimal.Decimal
+	unmatchedAmount   decimal.Decimal
+	unmatchedProceeds decimal.Decimal
+	unvaluedDisposed  decimal.Decimal
+}
+
+// orderProgress tracks what has been added from an order so updates to it
+// only add new fills
+type orderProgress struct {
+	byTrades bool
+	executed float64
+	cost     float64
+	fee      float64
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/gofrs/uuid"
 	"github.com/thrasher-corp/gocryptotrader/common/cache"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/banking"
 )
 
@@ -109,6 +110,8 @@ type Request struct {
 	Description string        `json:"description"`
 	Amount      float64       `json:"amount"`
 	Type        RequestType   `json:"type"`
+	// Asset is the account the withdrawal is made from, defaulting to spot
+	Asset asset.Item `json:"asset"`
 
 	ClientOrderID string `json:"clientID"`
 